@@ -0,0 +1,251 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/project"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/resourceimport"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/cobra"
+)
+
+const consolePrompt = "> "
+
+var consoleExitCommands = []string{"exit", "quit"}
+
+// The deploy engine operation used to evaluate
+// substitution expressions against a blueprint.
+type consoleDeployEngine interface {
+	EvaluateExpression(
+		ctx context.Context,
+		payload *types.EvaluateExpressionPayload,
+	) (*container.EvaluatedExpression, error)
+}
+
+func setupConsoleCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	consoleCmd := &cobra.Command{
+		Use:   "console",
+		Short: "Interactively evaluate substitution expressions against a blueprint",
+		Long: `Starts an interactive console to evaluate substitution expressions against a blueprint
+using the functions and resource providers of the deploy engine.
+
+Expressions are written as they would be inside a "${..}" substitution in a blueprint,
+for example, resources.ordersTable.spec.arn or cidrsubnet(variables.vpcCidr, 8, 1).
+Enter "exit" or "quit" to leave the console.
+
+When --instance-name or --instance-id is provided, computed fields of resources are resolved
+from the state of the deployed blueprint instance, otherwise values that can only be known
+after deployment are shown as "` + container.KnownOnDeployPlaceholder + `".
+Values that depend on secret variables or values are shown as "` + container.RedactedSecretPlaceholder + `".
+When --trace is set, every reference lookup and function call carried out to evaluate
+an expression is written to stderr along with the inputs and outputs of each step.
+
+Examples:
+  # Evaluate expressions against the blueprint in the current directory
+  bluelink console
+
+  # Evaluate expressions using the state of a deployed instance
+  bluelink console --instance-name my-app-production
+
+  # Show how each expression was evaluated
+  bluelink console --blueprint-file app.blueprint.yml --trace`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			blueprintFile, _ := confProvider.GetString("consoleBlueprintFile")
+			instanceName, _ := confProvider.GetString("consoleInstanceName")
+			instanceID, _ := confProvider.GetString("consoleInstanceID")
+			deployConfigFile, _ := confProvider.GetString("deployConfigFile")
+			trace, _ := confProvider.GetBool("consoleTrace")
+
+			if instanceName != "" && instanceID != "" {
+				return errors.New("only one of --instance-name or --instance-id can be provided")
+			}
+
+			operationConfig, err := resourceimport.LoadOperationConfig(deployConfigFile)
+			if err != nil {
+				return err
+			}
+
+			documentInfo, err := importDocumentInfo(blueprintFile)
+			if err != nil {
+				return err
+			}
+
+			deployEngine, cleanup, err := createConsoleDeployEngine(confProvider)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			cmd.SilenceUsage = true
+
+			return runConsole(
+				cmd.Context(),
+				deployEngine,
+				&types.EvaluateExpressionPayload{
+					BlueprintDocumentInfo: documentInfo,
+					InstanceID:            instanceID,
+					InstanceName:          instanceName,
+					Config:                operationConfig,
+					Trace:                 trace,
+				},
+				cmd.InOrStdin(),
+				cmd.OutOrStdout(),
+				cmd.ErrOrStderr(),
+			)
+		},
+	}
+
+	consoleCmd.Flags().String(
+		"blueprint-file",
+		project.DetectBlueprintFile("."),
+		"The blueprint file to evaluate expressions against.",
+	)
+	confProvider.BindPFlag("consoleBlueprintFile", consoleCmd.Flags().Lookup("blueprint-file"))
+	confProvider.BindEnvVar("consoleBlueprintFile", "BLUELINK_CLI_CONSOLE_BLUEPRINT_FILE")
+
+	consoleCmd.Flags().String(
+		"instance-name",
+		"",
+		"The name of a deployed blueprint instance to resolve computed fields of resources from.",
+	)
+	confProvider.BindPFlag("consoleInstanceName", consoleCmd.Flags().Lookup("instance-name"))
+	confProvider.BindEnvVar("consoleInstanceName", "BLUELINK_CLI_CONSOLE_INSTANCE_NAME")
+
+	consoleCmd.Flags().String(
+		"instance-id",
+		"",
+		"The ID of a deployed blueprint instance to resolve computed fields of resources from.",
+	)
+	confProvider.BindPFlag("consoleInstanceID", consoleCmd.Flags().Lookup("instance-id"))
+	confProvider.BindEnvVar("consoleInstanceID", "BLUELINK_CLI_CONSOLE_INSTANCE_ID")
+
+	consoleCmd.Flags().Bool(
+		"trace",
+		false,
+		"Write the reference lookups and function calls carried out to evaluate "+
+			"each expression to stderr.",
+	)
+	confProvider.BindPFlag("consoleTrace", consoleCmd.Flags().Lookup("trace"))
+	confProvider.BindEnvVar("consoleTrace", "BLUELINK_CLI_CONSOLE_TRACE")
+
+	rootCmd.AddCommand(consoleCmd)
+}
+
+// Reads expressions from the input line by line and writes the
+// evaluated value of each expression to the output until the input
+// is exhausted or an exit command is entered.
+// Errors evaluating an expression are written to the error output
+// so users can correct the expression and continue.
+func runConsole(
+	ctx context.Context,
+	deployEngine consoleDeployEngine,
+	payload *types.EvaluateExpressionPayload,
+	input io.Reader,
+	output io.Writer,
+	errOutput io.Writer,
+) error {
+	scanner := bufio.NewScanner(input)
+	for {
+		if _, err := fmt.Fprint(output, consolePrompt); err != nil {
+			return err
+		}
+
+		if !scanner.Scan() {
+			// Ensure the shell prompt starts on a new line
+			// when the console is closed with end of input.
+			_, err := fmt.Fprintln(output)
+			if err != nil {
+				return err
+			}
+			return scanner.Err()
+		}
+
+		expression := strings.TrimSpace(scanner.Text())
+		if expression == "" {
+			continue
+		}
+
+		if slices.Contains(consoleExitCommands, expression) {
+			return nil
+		}
+
+		expressionPayload := *payload
+		expressionPayload.Expression = trimSubstitutionDelimiters(expression)
+		err := evaluateConsoleExpression(
+			ctx,
+			deployEngine,
+			&expressionPayload,
+			output,
+			errOutput,
+		)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func evaluateConsoleExpression(
+	ctx context.Context,
+	deployEngine consoleDeployEngine,
+	payload *types.EvaluateExpressionPayload,
+	output io.Writer,
+	errOutput io.Writer,
+) error {
+	evaluated, err := deployEngine.EvaluateExpression(ctx, payload)
+	if err != nil {
+		_, writeErr := fmt.Fprintf(errOutput, "error: %s\n", err.Error())
+		return writeErr
+	}
+
+	if payload.Trace {
+		if err := writeRenderTrace(evaluated.Trace, errOutput); err != nil {
+			return fmt.Errorf("failed to write substitution trace: %w", err)
+		}
+	}
+
+	_, err = fmt.Fprintln(output, traceValueString(evaluated.Value))
+	return err
+}
+
+// Allows expressions to be copied from a blueprint
+// with the surrounding "${" and "}" included.
+func trimSubstitutionDelimiters(expression string) string {
+	if strings.HasPrefix(expression, "${") && strings.HasSuffix(expression, "}") {
+		return strings.TrimSpace(expression[2 : len(expression)-1])
+	}
+
+	return expression
+}
+
+func createConsoleDeployEngine(
+	confProvider *config.Provider,
+) (consoleDeployEngine, func(), error) {
+	logger, handle, err := utils.SetupLogger()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployEngine, err := engine.Create(confProvider, logger)
+	if err != nil {
+		handle.Close()
+		return nil, nil, err
+	}
+
+	consoleEngine, supportsConsole := deployEngine.(consoleDeployEngine)
+	if !supportsConsole {
+		handle.Close()
+		return nil, nil, errors.New("the deploy engine client does not support evaluating expressions")
+	}
+
+	return consoleEngine, func() { handle.Close() }, nil
+}
@@ -0,0 +1,187 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConsoleCommandSuite struct {
+	suite.Suite
+}
+
+func (s *ConsoleCommandSuite) Test_console_command_is_registered_with_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"console"})
+	s.Require().NoError(err)
+	s.Equal("console", cmd.Name())
+
+	for _, flagName := range []string{"blueprint-file", "instance-name", "instance-id", "trace"} {
+		s.NotNil(cmd.Flag(flagName), "expected the --%s flag", flagName)
+	}
+}
+
+func (s *ConsoleCommandSuite) Test_fails_when_instance_name_and_id_are_both_provided() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"console",
+		"--instance-name", "orders-production",
+		"--instance-id", "instance-1",
+	})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Equal("only one of --instance-name or --instance-id can be provided", err.Error())
+}
+
+func (s *ConsoleCommandSuite) Test_evaluates_each_expression_until_exit() {
+	engine := &stubConsoleDeployEngine{
+		responses: map[string]*container.EvaluatedExpression{
+			"resources.ordersTable.spec.tableName": {
+				Value: core.MappingNodeFromString("orders"),
+			},
+			"resources.ordersTable.spec.arn": {
+				Value:         core.MappingNodeFromString(container.KnownOnDeployPlaceholder),
+				KnownOnDeploy: true,
+			},
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := runConsole(
+		context.Background(),
+		engine,
+		&types.EvaluateExpressionPayload{InstanceName: "orders-production"},
+		strings.NewReader(
+			"resources.ordersTable.spec.tableName\n\n${resources.ordersTable.spec.arn}\nexit\n"+
+				"resources.ordersTable.spec.tableName\n",
+		),
+		output,
+		&bytes.Buffer{},
+	)
+	s.Require().NoError(err)
+	s.Equal(
+		"> \"orders\"\n> > \"(known on deploy)\"\n> ",
+		output.String(),
+	)
+	s.Equal(
+		[]string{
+			"resources.ordersTable.spec.tableName",
+			"resources.ordersTable.spec.arn",
+		},
+		engine.receivedExpressions,
+	)
+	s.Equal("orders-production", engine.receivedPayloads[0].InstanceName)
+}
+
+func (s *ConsoleCommandSuite) Test_writes_errors_and_continues() {
+	engine := &stubConsoleDeployEngine{
+		responses: map[string]*container.EvaluatedExpression{
+			"values.tablePrefix": {
+				Value: core.MappingNodeFromString("production-orders"),
+			},
+		},
+		errs: map[string]error{
+			"to_upper(": errors.New("failed to evaluate expression: unexpected end of input"),
+		},
+	}
+	output := &bytes.Buffer{}
+	errOutput := &bytes.Buffer{}
+
+	err := runConsole(
+		context.Background(),
+		engine,
+		&types.EvaluateExpressionPayload{},
+		strings.NewReader("to_upper(\nvalues.tablePrefix\n"),
+		output,
+		errOutput,
+	)
+	s.Require().NoError(err)
+	s.Equal("> > \"production-orders\"\n> \n", output.String())
+	s.Equal(
+		"error: failed to evaluate expression: unexpected end of input\n",
+		errOutput.String(),
+	)
+}
+
+func (s *ConsoleCommandSuite) Test_writes_substitution_trace_for_each_expression() {
+	engine := &stubConsoleDeployEngine{
+		responses: map[string]*container.EvaluatedExpression{
+			"to_upper(variables.environment)": {
+				Value: core.MappingNodeFromString("PRODUCTION"),
+				Trace: map[string][]*subengine.SubstitutionTraceEntry{
+					container.EvaluatedExpressionElementName: {
+						{
+							Type:       subengine.SubstitutionTraceEntryTypeFunctionCall,
+							Expression: "to_upper(variables.environment)",
+							Inputs:     []*core.MappingNode{core.MappingNodeFromString("production")},
+							Output:     core.MappingNodeFromString("PRODUCTION"),
+						},
+						{
+							Type:       subengine.SubstitutionTraceEntryTypeReference,
+							Expression: "variables.environment",
+							Depth:      1,
+							Output:     core.MappingNodeFromString("production"),
+						},
+					},
+				},
+			},
+		},
+	}
+	output := &bytes.Buffer{}
+	errOutput := &bytes.Buffer{}
+
+	err := runConsole(
+		context.Background(),
+		engine,
+		&types.EvaluateExpressionPayload{Trace: true},
+		strings.NewReader("to_upper(variables.environment)\nquit\n"),
+		output,
+		errOutput,
+	)
+	s.Require().NoError(err)
+	s.Equal("> \"PRODUCTION\"\n> ", output.String())
+	s.Equal(
+		`expression
+  to_upper(variables.environment) -> "PRODUCTION"
+    inputs: "production"
+    variables.environment -> "production"
+`,
+		errOutput.String(),
+	)
+}
+
+type stubConsoleDeployEngine struct {
+	responses           map[string]*container.EvaluatedExpression
+	errs                map[string]error
+	receivedExpressions []string
+	receivedPayloads    []*types.EvaluateExpressionPayload
+}
+
+func (e *stubConsoleDeployEngine) EvaluateExpression(
+	ctx context.Context,
+	payload *types.EvaluateExpressionPayload,
+) (*container.EvaluatedExpression, error) {
+	e.receivedExpressions = append(e.receivedExpressions, payload.Expression)
+	e.receivedPayloads = append(e.receivedPayloads, payload)
+	if err, hasErr := e.errs[payload.Expression]; hasErr {
+		return nil, err
+	}
+
+	return e.responses[payload.Expression], nil
+}
+
+func TestConsoleCommandSuite(t *testing.T) {
+	suite.Run(t, new(ConsoleCommandSuite))
+}
@@ -0,0 +1,406 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/resourceimport"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	clienterrors "github.com/newstack-cloud/bluelink/libs/deploy-engine-client/errors"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/cobra"
+)
+
+// The deploy engine operations used to deploy a blueprint instance
+// when options that are not supported by the interactive deployment view
+// provided by the deploy CLI SDK are set.
+type deployDeployEngine interface {
+	changeStagingDeployEngine
+	GetBlueprintInstance(
+		ctx context.Context,
+		instanceID string,
+	) (*state.InstanceState, error)
+	CreateBlueprintInstance(
+		ctx context.Context,
+		payload *types.BlueprintInstancePayload,
+	) (*types.BlueprintInstanceResponse, error)
+	UpdateBlueprintInstance(
+		ctx context.Context,
+		instanceID string,
+		payload *types.BlueprintInstancePayload,
+	) (*types.BlueprintInstanceResponse, error)
+	StreamBlueprintInstanceEvents(
+		ctx context.Context,
+		instanceID string,
+		lastEventID string,
+		streamTo chan<- types.BlueprintInstanceEvent,
+		errChan chan<- error,
+	) error
+}
+
+// Limits on the number of changes that a deployment can make,
+// deployments that exceed the limits are refused by the deploy engine
+// unless the override is set.
+type blastRadiusOptions struct {
+	maxChanges int
+	maxDeletes int
+	override   bool
+}
+
+func (o blastRadiusOptions) enabled() bool {
+	return o.maxChanges != 0 || o.maxDeletes != 0 || o.override
+}
+
+// Options read from the flags of the deploy command registered by the deploy CLI SDK
+// along with the blast radius options provided by the Bluelink CLI.
+type deployOptions struct {
+	changesetID  string
+	instanceID   string
+	instanceName string
+	stageFirst   bool
+	autoApprove  bool
+	autoRollback bool
+	force        bool
+	blastRadius  blastRadiusOptions
+}
+
+// Adds the --max-changes, --max-deletes and --override-blast-radius flags
+// to the deploy command registered by the deploy CLI SDK.
+// When any of the flags are set, the deployment is carried out by the Bluelink CLI
+// so that the blast radius limits can be passed to the deploy engine,
+// otherwise the deploy command of the deploy CLI SDK is run as is.
+// This must be called after the deploy command has been added to the root command.
+func setupDeployOptions(rootCmd *cobra.Command, confProvider *config.Provider) {
+	deployCmd, _, err := rootCmd.Find([]string{"deploy"})
+	if err != nil || deployCmd == rootCmd || deployCmd.RunE == nil {
+		return
+	}
+
+	deployCmd.Flags().Int32(
+		"max-changes",
+		0,
+		"The maximum number of resources and child blueprints that can be created, updated, "+
+			"recreated or removed in the deployment. The deployment will not proceed when the "+
+			"change set exceeds this limit unless --override-blast-radius is set. "+
+			"When set to 0, the number of changes is not limited.",
+	)
+	confProvider.BindPFlag("deployMaxChanges", deployCmd.Flags().Lookup("max-changes"))
+	confProvider.BindEnvVar("deployMaxChanges", "BLUELINK_CLI_DEPLOY_MAX_CHANGES")
+
+	deployCmd.Flags().Int32(
+		"max-deletes",
+		0,
+		"The maximum number of resources and child blueprints that can be removed or recreated "+
+			"in the deployment. The deployment will not proceed when the change set exceeds "+
+			"this limit unless --override-blast-radius is set. "+
+			"When set to 0, the number of deletes is not limited.",
+	)
+	confProvider.BindPFlag("deployMaxDeletes", deployCmd.Flags().Lookup("max-deletes"))
+	confProvider.BindEnvVar("deployMaxDeletes", "BLUELINK_CLI_DEPLOY_MAX_DELETES")
+
+	deployCmd.Flags().Bool(
+		"override-blast-radius",
+		false,
+		"Deploy the change set even when it exceeds the limits set with --max-changes or --max-deletes.",
+	)
+	confProvider.BindPFlag("deployOverrideBlastRadius", deployCmd.Flags().Lookup("override-blast-radius"))
+	confProvider.BindEnvVar("deployOverrideBlastRadius", "BLUELINK_CLI_DEPLOY_OVERRIDE_BLAST_RADIUS")
+
+	deployCmd.RunE = withBlastRadiusOptions(deployCmd.RunE, confProvider)
+}
+
+func withBlastRadiusOptions(
+	runE func(cmd *cobra.Command, args []string) error,
+	confProvider *config.Provider,
+) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		opts := readDeployOptions(confProvider)
+		if !opts.blastRadius.enabled() {
+			return runE(cmd, args)
+		}
+
+		if err := validateDeployOptions(opts); err != nil {
+			return err
+		}
+
+		blueprintFile, _ := confProvider.GetString("deployBlueprintFile")
+		deployConfigFile, _ := confProvider.GetString("deployConfigFile")
+
+		operationConfig, err := resourceimport.LoadOperationConfig(deployConfigFile)
+		if err != nil {
+			return err
+		}
+
+		documentInfo, err := importDocumentInfo(blueprintFile)
+		if err != nil {
+			return err
+		}
+
+		deployEngine, cleanup, err := createDeployDeployEngine(confProvider)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		cmd.SilenceUsage = true
+		return deployWithBlastRadius(
+			cmd.Context(),
+			deployEngine,
+			documentInfo,
+			operationConfig,
+			opts,
+			cmd.OutOrStdout(),
+		)
+	}
+}
+
+func readDeployOptions(confProvider *config.Provider) deployOptions {
+	changesetID, _ := confProvider.GetString("deployChangeSetID")
+	instanceID, _ := confProvider.GetString("deployInstanceID")
+	instanceName, _ := confProvider.GetString("deployInstanceName")
+	stageFirst, _ := confProvider.GetBool("deployStage")
+	autoApprove, _ := confProvider.GetBool("deployAutoApprove")
+	autoRollback, _ := confProvider.GetBool("deployAutoRollback")
+	force, _ := confProvider.GetBool("deployForce")
+	maxChanges, _ := confProvider.GetInt32("deployMaxChanges")
+	maxDeletes, _ := confProvider.GetInt32("deployMaxDeletes")
+	overrideBlastRadius, _ := confProvider.GetBool("deployOverrideBlastRadius")
+
+	return deployOptions{
+		changesetID:  changesetID,
+		instanceID:   instanceID,
+		instanceName: instanceName,
+		stageFirst:   stageFirst,
+		autoApprove:  autoApprove,
+		autoRollback: autoRollback,
+		force:        force,
+		blastRadius: blastRadiusOptions{
+			maxChanges: int(maxChanges),
+			maxDeletes: int(maxDeletes),
+			override:   overrideBlastRadius,
+		},
+	}
+}
+
+func validateDeployOptions(opts deployOptions) error {
+	if opts.blastRadius.maxChanges < 0 || opts.blastRadius.maxDeletes < 0 {
+		return errors.New("--max-changes and --max-deletes must not be negative")
+	}
+
+	if opts.instanceID == "" && opts.instanceName == "" {
+		return errors.New(
+			"--instance-id or --instance-name must be provided when deploying with blast radius limits",
+		)
+	}
+
+	if opts.stageFirst == (opts.changesetID != "") {
+		return errors.New(
+			"one of --stage or --change-set-id must be provided when deploying with blast radius limits",
+		)
+	}
+
+	if opts.stageFirst && !opts.autoApprove {
+		return errors.New(
+			"--auto-approve must be provided with --stage when deploying with blast radius limits",
+		)
+	}
+
+	return nil
+}
+
+// Maps the options for the deploy command onto the request payload
+// used to start a deployment in the deploy engine.
+func buildDeployPayload(
+	documentInfo types.BlueprintDocumentInfo,
+	operationConfig *types.BlueprintOperationConfig,
+	opts deployOptions,
+	changesetID string,
+) *types.BlueprintInstancePayload {
+	return &types.BlueprintInstancePayload{
+		BlueprintDocumentInfo: documentInfo,
+		InstanceName:          opts.instanceName,
+		ChangeSetID:           changesetID,
+		AutoRollback:          opts.autoRollback,
+		Force:                 opts.force,
+		MaxChanges:            opts.blastRadius.maxChanges,
+		MaxDeletes:            opts.blastRadius.maxDeletes,
+		OverrideBlastRadius:   opts.blastRadius.override,
+		Config:                operationConfig,
+	}
+}
+
+func deployWithBlastRadius(
+	ctx context.Context,
+	deployEngine deployDeployEngine,
+	documentInfo types.BlueprintDocumentInfo,
+	operationConfig *types.BlueprintOperationConfig,
+	opts deployOptions,
+	output io.Writer,
+) error {
+	existingInstanceID, err := findExistingInstance(ctx, deployEngine, opts)
+	if err != nil {
+		return err
+	}
+
+	changesetID := opts.changesetID
+	if opts.stageFirst {
+		changesetID, _, err = stageChangesUntilComplete(
+			ctx,
+			deployEngine,
+			&types.CreateChangesetPayload{
+				BlueprintDocumentInfo: documentInfo,
+				InstanceID:            existingInstanceID,
+				Config:                operationConfig,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(output, "Staged changes in change set %s\n", changesetID)
+	}
+
+	payload := buildDeployPayload(documentInfo, operationConfig, opts, changesetID)
+	var response *types.BlueprintInstanceResponse
+	if existingInstanceID != "" {
+		response, err = deployEngine.UpdateBlueprintInstance(ctx, existingInstanceID, payload)
+	} else {
+		response, err = deployEngine.CreateBlueprintInstance(ctx, payload)
+	}
+	if err != nil {
+		if _, exceeded := clienterrors.IsBlastRadiusExceededError(err); exceeded {
+			return fmt.Errorf(
+				"change set %s exceeds the blast radius limits for the deployment "+
+					"(max changes: %s, max deletes: %s), "+
+					"review the change set and deploy with --override-blast-radius to proceed",
+				changesetID,
+				blastRadiusLimitLabel(opts.blastRadius.maxChanges),
+				blastRadiusLimitLabel(opts.blastRadius.maxDeletes),
+			)
+		}
+		return err
+	}
+
+	fmt.Fprintf(output, "Deploying blueprint instance %s\n", response.Data.InstanceID)
+
+	finished, err := waitForDeploymentToFinish(
+		ctx,
+		deployEngine,
+		response.Data.InstanceID,
+		response.LastEventID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if finished.Status != core.InstanceStatusDeployed &&
+		finished.Status != core.InstanceStatusUpdated {
+		for _, reason := range finished.FailureReasons {
+			fmt.Fprintf(output, "  %s\n", reason)
+		}
+		return fmt.Errorf("deployment finished with status %s", finished.Status.String())
+	}
+
+	fmt.Fprintf(
+		output,
+		"Deployment of blueprint instance %s finished with status %s\n",
+		response.Data.InstanceID,
+		finished.Status.String(),
+	)
+	return nil
+}
+
+func blastRadiusLimitLabel(limit int) string {
+	if limit == 0 {
+		return "unlimited"
+	}
+
+	return fmt.Sprintf("%d", limit)
+}
+
+// Finds the ID of the blueprint instance that the deployment will update,
+// an empty ID is returned when a new blueprint instance will be created.
+func findExistingInstance(
+	ctx context.Context,
+	deployEngine deployDeployEngine,
+	opts deployOptions,
+) (string, error) {
+	if opts.instanceID != "" {
+		return opts.instanceID, nil
+	}
+
+	instance, err := deployEngine.GetBlueprintInstance(ctx, opts.instanceName)
+	if err != nil {
+		if _, isNotFound := clienterrors.IsNotFoundError(err); isNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return instance.InstanceID, nil
+}
+
+func waitForDeploymentToFinish(
+	ctx context.Context,
+	deployEngine deployDeployEngine,
+	instanceID string,
+	lastEventID string,
+) (*container.DeploymentFinishedMessage, error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan types.BlueprintInstanceEvent)
+	errChan := make(chan error)
+	err := deployEngine.StreamBlueprintInstanceEvents(
+		streamCtx,
+		instanceID,
+		lastEventID,
+		events,
+		errChan,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case err := <-errChan:
+			return nil, err
+		case event := <-events:
+			if finished, isFinished := event.AsFinish(); isFinished {
+				return finished, nil
+			}
+		}
+	}
+}
+
+func createDeployDeployEngine(
+	confProvider *config.Provider,
+) (deployDeployEngine, func(), error) {
+	logger, handle, err := utils.SetupLogger()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployEngine, err := engine.Create(confProvider, logger)
+	if err != nil {
+		handle.Close()
+		return nil, nil, err
+	}
+
+	deployEngineWithLimits, supportsDeploy := deployEngine.(deployDeployEngine)
+	if !supportsDeploy {
+		handle.Close()
+		return nil, nil, errors.New("the deploy engine client does not support deploying blueprint instances")
+	}
+
+	return deployEngineWithLimits, func() { handle.Close() }, nil
+}
@@ -0,0 +1,364 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	clienterrors "github.com/newstack-cloud/bluelink/libs/deploy-engine-client/errors"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeployCommandSuite struct {
+	suite.Suite
+}
+
+func (s *DeployCommandSuite) Test_deploy_command_is_registered_with_blast_radius_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"deploy"})
+	s.Require().NoError(err)
+	s.Equal("deploy", cmd.Name())
+
+	s.NotNil(cmd.Flag("max-changes"), "expected the --max-changes flag")
+	s.Equal("0", cmd.Flag("max-changes").DefValue)
+	s.NotNil(cmd.Flag("max-deletes"), "expected the --max-deletes flag")
+	s.Equal("0", cmd.Flag("max-deletes").DefValue)
+	s.NotNil(cmd.Flag("override-blast-radius"), "expected the --override-blast-radius flag")
+	s.Equal("false", cmd.Flag("override-blast-radius").DefValue)
+}
+
+func (s *DeployCommandSuite) Test_maps_blast_radius_options_onto_deploy_payload() {
+	documentInfo := types.BlueprintDocumentInfo{
+		FileSourceScheme: "file",
+		Directory:        "/projects/orders",
+		BlueprintFile:    "project.blueprint.yaml",
+	}
+	operationConfig := &types.BlueprintOperationConfig{
+		Providers: map[string]map[string]*core.ScalarValue{
+			"aws": {
+				"region": core.ScalarFromString("eu-west-2"),
+			},
+		},
+	}
+
+	payload := buildDeployPayload(
+		documentInfo,
+		operationConfig,
+		deployOptions{
+			instanceName: "orders-prod",
+			autoRollback: true,
+			blastRadius: blastRadiusOptions{
+				maxChanges: 20,
+				maxDeletes: 2,
+				override:   true,
+			},
+		},
+		"changeset-1",
+	)
+	s.Equal(
+		&types.BlueprintInstancePayload{
+			BlueprintDocumentInfo: documentInfo,
+			InstanceName:          "orders-prod",
+			ChangeSetID:           "changeset-1",
+			AutoRollback:          true,
+			MaxChanges:            20,
+			MaxDeletes:            2,
+			OverrideBlastRadius:   true,
+			Config:                operationConfig,
+		},
+		payload,
+	)
+}
+
+func (s *DeployCommandSuite) Test_validates_deploy_options_for_blast_radius_limits() {
+	limits := blastRadiusOptions{maxChanges: 10}
+
+	s.NoError(validateDeployOptions(deployOptions{
+		instanceName: "orders-prod",
+		changesetID:  "changeset-1",
+		blastRadius:  limits,
+	}))
+	s.NoError(validateDeployOptions(deployOptions{
+		instanceID:  "instance-1",
+		stageFirst:  true,
+		autoApprove: true,
+		blastRadius: limits,
+	}))
+
+	s.EqualError(
+		validateDeployOptions(deployOptions{
+			instanceName: "orders-prod",
+			changesetID:  "changeset-1",
+			blastRadius:  blastRadiusOptions{maxDeletes: -1},
+		}),
+		"--max-changes and --max-deletes must not be negative",
+	)
+	s.EqualError(
+		validateDeployOptions(deployOptions{
+			changesetID: "changeset-1",
+			blastRadius: limits,
+		}),
+		"--instance-id or --instance-name must be provided when deploying with blast radius limits",
+	)
+	s.EqualError(
+		validateDeployOptions(deployOptions{
+			instanceName: "orders-prod",
+			blastRadius:  limits,
+		}),
+		"one of --stage or --change-set-id must be provided when deploying with blast radius limits",
+	)
+	s.EqualError(
+		validateDeployOptions(deployOptions{
+			instanceName: "orders-prod",
+			stageFirst:   true,
+			blastRadius:  limits,
+		}),
+		"--auto-approve must be provided with --stage when deploying with blast radius limits",
+	)
+}
+
+func (s *DeployCommandSuite) Test_updates_existing_instance_with_blast_radius_limits() {
+	engine := &stubDeployDeployEngine{
+		instances: map[string]*state.InstanceState{
+			"orders-prod": {InstanceID: "instance-1"},
+		},
+		finishStatus: core.InstanceStatusUpdated,
+	}
+	output := &bytes.Buffer{}
+
+	err := deployWithBlastRadius(
+		context.Background(),
+		engine,
+		types.BlueprintDocumentInfo{},
+		nil,
+		deployOptions{
+			instanceName: "orders-prod",
+			changesetID:  "changeset-1",
+			blastRadius:  blastRadiusOptions{maxChanges: 5, maxDeletes: 1},
+		},
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal("instance-1", engine.updatedInstanceID)
+	s.Require().NotNil(engine.receivedPayload)
+	s.Equal("changeset-1", engine.receivedPayload.ChangeSetID)
+	s.Equal(5, engine.receivedPayload.MaxChanges)
+	s.Equal(1, engine.receivedPayload.MaxDeletes)
+	s.False(engine.receivedPayload.OverrideBlastRadius)
+	s.Equal(
+		"Deploying blueprint instance instance-1\n"+
+			"Deployment of blueprint instance instance-1 finished with status UPDATED\n",
+		output.String(),
+	)
+}
+
+func (s *DeployCommandSuite) Test_stages_changes_before_creating_new_instance() {
+	engine := &stubDeployDeployEngine{
+		changesetID:  "changeset-2",
+		finishStatus: core.InstanceStatusDeployed,
+	}
+	output := &bytes.Buffer{}
+
+	err := deployWithBlastRadius(
+		context.Background(),
+		engine,
+		types.BlueprintDocumentInfo{},
+		nil,
+		deployOptions{
+			instanceName: "orders-preview",
+			stageFirst:   true,
+			autoApprove:  true,
+			blastRadius:  blastRadiusOptions{maxChanges: 50},
+		},
+		output,
+	)
+	s.Require().NoError(err)
+	s.Empty(engine.updatedInstanceID)
+	s.Require().NotNil(engine.receivedPayload)
+	s.Equal("orders-preview", engine.receivedPayload.InstanceName)
+	s.Equal("changeset-2", engine.receivedPayload.ChangeSetID)
+	s.Equal(50, engine.receivedPayload.MaxChanges)
+	s.Contains(output.String(), "Staged changes in change set changeset-2\n")
+}
+
+func (s *DeployCommandSuite) Test_reports_blast_radius_exceeded() {
+	engine := &stubDeployDeployEngine{
+		instances: map[string]*state.InstanceState{
+			"orders-prod": {InstanceID: "instance-1"},
+		},
+		deployErr: &clienterrors.ClientError{
+			StatusCode: http.StatusUnprocessableEntity,
+			Message: "the change set exceeds the blast radius limits for the deployment, " +
+				"set overrideBlastRadius=true to proceed",
+			Code: clienterrors.ErrorCodeBlastRadiusExceeded,
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := deployWithBlastRadius(
+		context.Background(),
+		engine,
+		types.BlueprintDocumentInfo{},
+		nil,
+		deployOptions{
+			instanceName: "orders-prod",
+			changesetID:  "changeset-1",
+			blastRadius:  blastRadiusOptions{maxDeletes: 1},
+		},
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal(
+		"change set changeset-1 exceeds the blast radius limits for the deployment "+
+			"(max changes: unlimited, max deletes: 1), "+
+			"review the change set and deploy with --override-blast-radius to proceed",
+		err.Error(),
+	)
+	s.Empty(output.String())
+}
+
+func (s *DeployCommandSuite) Test_reports_failed_deployment() {
+	engine := &stubDeployDeployEngine{
+		instances: map[string]*state.InstanceState{
+			"orders-prod": {InstanceID: "instance-1"},
+		},
+		finishStatus:   core.InstanceStatusUpdateFailed,
+		failureReasons: []string{"ordersTable: provisioned throughput exceeded"},
+	}
+	output := &bytes.Buffer{}
+
+	err := deployWithBlastRadius(
+		context.Background(),
+		engine,
+		types.BlueprintDocumentInfo{},
+		nil,
+		deployOptions{
+			instanceName: "orders-prod",
+			changesetID:  "changeset-1",
+			blastRadius:  blastRadiusOptions{override: true},
+		},
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal("deployment finished with status UPDATE FAILED", err.Error())
+	s.Equal(
+		"Deploying blueprint instance instance-1\n"+
+			"  ordersTable: provisioned throughput exceeded\n",
+		output.String(),
+	)
+}
+
+type stubDeployDeployEngine struct {
+	changesetID       string
+	instances         map[string]*state.InstanceState
+	deployErr         error
+	finishStatus      core.InstanceStatus
+	failureReasons    []string
+	receivedPayload   *types.BlueprintInstancePayload
+	updatedInstanceID string
+}
+
+func (e *stubDeployDeployEngine) CreateChangeset(
+	ctx context.Context,
+	payload *types.CreateChangesetPayload,
+) (*types.ChangesetResponse, error) {
+	return &types.ChangesetResponse{
+		Data: &manage.Changeset{
+			ID: e.changesetID,
+		},
+	}, nil
+}
+
+func (e *stubDeployDeployEngine) StreamChangeStagingEvents(
+	ctx context.Context,
+	changesetID string,
+	lastEventID string,
+	streamTo chan<- types.ChangeStagingEvent,
+	errChan chan<- error,
+) error {
+	go func() {
+		streamTo <- types.ChangeStagingEvent{
+			CompleteChanges: &types.CompleteChangesEventData{},
+		}
+	}()
+	return nil
+}
+
+func (e *stubDeployDeployEngine) GetBlueprintInstance(
+	ctx context.Context,
+	instanceID string,
+) (*state.InstanceState, error) {
+	instance, exists := e.instances[instanceID]
+	if !exists {
+		return nil, &clienterrors.ClientError{
+			StatusCode: http.StatusNotFound,
+			Message:    "blueprint instance not found",
+		}
+	}
+
+	return instance, nil
+}
+
+func (e *stubDeployDeployEngine) CreateBlueprintInstance(
+	ctx context.Context,
+	payload *types.BlueprintInstancePayload,
+) (*types.BlueprintInstanceResponse, error) {
+	e.receivedPayload = payload
+	return e.deployResponse("new-instance-1")
+}
+
+func (e *stubDeployDeployEngine) UpdateBlueprintInstance(
+	ctx context.Context,
+	instanceID string,
+	payload *types.BlueprintInstancePayload,
+) (*types.BlueprintInstanceResponse, error) {
+	e.receivedPayload = payload
+	e.updatedInstanceID = instanceID
+	return e.deployResponse(instanceID)
+}
+
+func (e *stubDeployDeployEngine) deployResponse(
+	instanceID string,
+) (*types.BlueprintInstanceResponse, error) {
+	if e.deployErr != nil {
+		return nil, e.deployErr
+	}
+
+	return &types.BlueprintInstanceResponse{
+		Data: state.InstanceState{
+			InstanceID: instanceID,
+		},
+	}, nil
+}
+
+func (e *stubDeployDeployEngine) StreamBlueprintInstanceEvents(
+	ctx context.Context,
+	instanceID string,
+	lastEventID string,
+	streamTo chan<- types.BlueprintInstanceEvent,
+	errChan chan<- error,
+) error {
+	go func() {
+		streamTo <- types.BlueprintInstanceEvent{
+			DeployEvent: container.DeployEvent{
+				FinishEvent: &container.DeploymentFinishedMessage{
+					InstanceID:     instanceID,
+					Status:         e.finishStatus,
+					FailureReasons: e.failureReasons,
+				},
+			},
+		}
+	}()
+	return nil
+}
+
+func TestDeployCommandSuite(t *testing.T) {
+	suite.Run(t, new(DeployCommandSuite))
+}
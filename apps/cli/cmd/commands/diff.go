@@ -0,0 +1,186 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/blueprintmigration"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	diffFormatText = "text"
+	diffFormatJSON = "json"
+)
+
+var supportedDiffFormats = []string{diffFormatText, diffFormatJSON}
+
+func setupDiffCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	diffCmd := &cobra.Command{
+		Use:   "diff <old-blueprint-file> <new-blueprint-file>",
+		Short: "Shows the semantic differences between two blueprint files",
+		Long: `Compares two blueprint files and shows the variables, values, child blueprints,
+resources, data sources and exports that have been added, removed or changed.
+Blueprints are compared by their parsed values, so differences in formatting,
+field order and comments are ignored, a YAML blueprint can be compared with
+a JSONC blueprint.
+
+This does not communicate with the deploy engine, to see the changes that would
+be applied to a deployed blueprint instance, use "bluelink stage".
+
+Examples:
+  # Show the differences between two versions of a blueprint
+  bluelink diff project.blueprint.yml project.blueprint.next.yml
+
+  # Output the differences as JSON
+  bluelink diff --format json old.blueprint.yml new.blueprint.jsonc`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, _ := confProvider.GetString("diffFormat")
+			if !slices.Contains(supportedDiffFormats, format) {
+				return fmt.Errorf(
+					"unsupported diff format %q, expected one of: %s",
+					format,
+					strings.Join(supportedDiffFormats, ", "),
+				)
+			}
+
+			cmd.SilenceUsage = true
+
+			oldDoc, err := loadBlueprintForDiff(args[0])
+			if err != nil {
+				return err
+			}
+
+			newDoc, err := loadBlueprintForDiff(args[1])
+			if err != nil {
+				return err
+			}
+
+			diff, err := schema.Diff(oldDoc, newDoc)
+			if err != nil {
+				return err
+			}
+
+			return writeBlueprintDiff(diff, format, cmd.OutOrStdout())
+		},
+	}
+
+	diffCmd.Flags().String(
+		"format",
+		diffFormatText,
+		"The format to output the differences in, one of: "+
+			strings.Join(supportedDiffFormats, ", ")+".",
+	)
+	confProvider.BindPFlag("diffFormat", diffCmd.Flags().Lookup("format"))
+	confProvider.BindEnvVar("diffFormat", "BLUELINK_CLI_DIFF_FORMAT")
+
+	rootCmd.AddCommand(diffCmd)
+}
+
+func loadBlueprintForDiff(path string) (*schema.Blueprint, error) {
+	format, err := blueprintmigration.FormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	blueprint, err := schema.Load(path, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blueprint %q: %w", path, err)
+	}
+
+	return blueprint, nil
+}
+
+func writeBlueprintDiff(
+	diff *schema.BlueprintDiff,
+	format string,
+	output io.Writer,
+) error {
+	if format == diffFormatJSON {
+		encoded, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(output, string(encoded))
+		return err
+	}
+
+	if !diff.HasChanges() {
+		_, err := io.WriteString(output, "No differences found between the blueprints\n")
+		return err
+	}
+
+	sb := &strings.Builder{}
+	writeElementDiffs(sb, "Variables", diff.Variables)
+	writeElementDiffs(sb, "Values", diff.Values)
+	writeElementDiffs(sb, "Include", diff.Include)
+	writeElementDiffs(sb, "Resources", diff.Resources)
+	writeElementDiffs(sb, "Data Sources", diff.DataSources)
+	writeElementDiffs(sb, "Exports", diff.Exports)
+
+	_, err := io.WriteString(output, sb.String())
+	return err
+}
+
+func writeElementDiffs(sb *strings.Builder, heading string, elementDiffs []*schema.ElementDiff) {
+	if len(elementDiffs) == 0 {
+		return
+	}
+
+	if sb.Len() > 0 {
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(sb, "%s:\n", heading)
+	for _, elementDiff := range elementDiffs {
+		fmt.Fprintf(sb, "  %s %s\n", diffChangeSymbol(elementDiff.ChangeType), elementDiff.Name)
+		for _, fieldDiff := range elementDiff.Fields {
+			fmt.Fprintf(
+				sb,
+				"      %s %s: %s\n",
+				diffChangeSymbol(fieldDiff.ChangeType),
+				fieldDiff.Path,
+				diffFieldValues(fieldDiff),
+			)
+		}
+	}
+}
+
+func diffFieldValues(fieldDiff *schema.FieldDiff) string {
+	switch fieldDiff.ChangeType {
+	case schema.DiffChangeTypeAdded:
+		return diffValueString(fieldDiff.NewValue)
+	case schema.DiffChangeTypeRemoved:
+		return diffValueString(fieldDiff.OldValue)
+	default:
+		return fmt.Sprintf(
+			"%s -> %s",
+			diffValueString(fieldDiff.OldValue),
+			diffValueString(fieldDiff.NewValue),
+		)
+	}
+}
+
+func diffValueString(value any) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
+
+func diffChangeSymbol(changeType schema.DiffChangeType) string {
+	switch changeType {
+	case schema.DiffChangeTypeAdded:
+		return "+"
+	case schema.DiffChangeTypeRemoved:
+		return "-"
+	default:
+		return "~"
+	}
+}
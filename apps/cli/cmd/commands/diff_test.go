@@ -0,0 +1,148 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/stretchr/testify/suite"
+)
+
+type DiffCommandSuite struct {
+	suite.Suite
+	tempDir string
+}
+
+func (s *DiffCommandSuite) SetupTest() {
+	tempDir, err := os.MkdirTemp("", "diff-cmd-test-*")
+	s.Require().NoError(err)
+	s.tempDir = tempDir
+
+	s.writeFile(
+		"old.blueprint.yml",
+		"version: 2025-11-02\n"+
+			"resources:\n"+
+			"  ordersTable:\n"+
+			"    type: aws/dynamodb/table\n"+
+			"    spec:\n"+
+			"      billingMode: PAY_PER_REQUEST\n"+
+			"  ordersQueue:\n"+
+			"    type: aws/sqs/queue\n",
+	)
+	s.writeFile(
+		"new.blueprint.jsonc",
+		"{\n"+
+			"  // Orders service\n"+
+			"  \"version\": \"2025-11-02\",\n"+
+			"  \"resources\": {\n"+
+			"    \"ordersTable\": {\n"+
+			"      \"type\": \"aws/dynamodb/table\",\n"+
+			"      \"spec\": { \"billingMode\": \"PROVISIONED\" }\n"+
+			"    }\n"+
+			"  }\n"+
+			"}\n",
+	)
+}
+
+func (s *DiffCommandSuite) TearDownTest() {
+	os.RemoveAll(s.tempDir)
+}
+
+func (s *DiffCommandSuite) Test_diff_command_is_registered_with_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"diff"})
+	s.Require().NoError(err)
+	s.Equal("diff", cmd.Name())
+	s.NotNil(cmd.Flag("format"))
+	s.Equal("text", cmd.Flag("format").DefValue)
+}
+
+func (s *DiffCommandSuite) Test_writes_differences_as_text() {
+	output, err := s.runDiff(
+		s.path("old.blueprint.yml"),
+		s.path("new.blueprint.jsonc"),
+	)
+	s.Require().NoError(err)
+	s.Equal(
+		"Resources:\n"+
+			"  - ordersQueue\n"+
+			"  ~ ordersTable\n"+
+			"      ~ spec.billingMode: \"PAY_PER_REQUEST\" -> \"PROVISIONED\"\n",
+		output,
+	)
+}
+
+func (s *DiffCommandSuite) Test_writes_differences_as_json() {
+	output, err := s.runDiff(
+		"--format",
+		"json",
+		s.path("old.blueprint.yml"),
+		s.path("new.blueprint.jsonc"),
+	)
+	s.Require().NoError(err)
+
+	diff := &schema.BlueprintDiff{}
+	s.Require().NoError(json.Unmarshal([]byte(output), diff))
+	s.Require().Len(diff.Resources, 2)
+	s.Equal("ordersQueue", diff.Resources[0].Name)
+	s.Equal(schema.DiffChangeTypeRemoved, diff.Resources[0].ChangeType)
+	s.Equal("ordersTable", diff.Resources[1].Name)
+	s.Equal(schema.DiffChangeTypeChanged, diff.Resources[1].ChangeType)
+}
+
+func (s *DiffCommandSuite) Test_reports_no_differences_for_same_blueprint() {
+	output, err := s.runDiff(
+		s.path("old.blueprint.yml"),
+		s.path("old.blueprint.yml"),
+	)
+	s.Require().NoError(err)
+	s.Equal("No differences found between the blueprints\n", output)
+}
+
+func (s *DiffCommandSuite) Test_fails_for_unsupported_format() {
+	_, err := s.runDiff(
+		"--format",
+		"yaml",
+		s.path("old.blueprint.yml"),
+		s.path("new.blueprint.jsonc"),
+	)
+	s.Require().Error(err)
+	s.Equal("unsupported diff format \"yaml\", expected one of: text, json", err.Error())
+}
+
+func (s *DiffCommandSuite) Test_fails_for_missing_blueprint_file() {
+	_, err := s.runDiff(
+		s.path("old.blueprint.yml"),
+		s.path("missing.blueprint.yml"),
+	)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "failed to load blueprint")
+}
+
+func (s *DiffCommandSuite) runDiff(args ...string) (string, error) {
+	output := &bytes.Buffer{}
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs(append([]string{"diff"}, args...))
+	rootCmd.SetOut(output)
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	return output.String(), err
+}
+
+func (s *DiffCommandSuite) path(name string) string {
+	return filepath.Join(s.tempDir, name)
+}
+
+func (s *DiffCommandSuite) writeFile(name string, content string) {
+	err := os.WriteFile(s.path(name), []byte(content), 0644)
+	s.Require().NoError(err)
+}
+
+func TestDiffCommandSuite(t *testing.T) {
+	suite.Run(t, new(DiffCommandSuite))
+}
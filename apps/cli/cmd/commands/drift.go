@@ -0,0 +1,298 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/project"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/resourceimport"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/cobra"
+)
+
+const (
+	driftCheckFormatText = "text"
+	driftCheckFormatJSON = "json"
+)
+
+var supportedDriftCheckFormats = []string{driftCheckFormatText, driftCheckFormatJSON}
+
+// The deploy engine operation used to check a blueprint instance
+// for drift and interrupted state.
+type driftCheckDeployEngine interface {
+	CheckReconciliation(
+		ctx context.Context,
+		instanceID string,
+		payload *types.CheckReconciliationPayload,
+	) (*container.ReconciliationCheckResult, error)
+}
+
+func setupDriftCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	driftCmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Commands for detecting drift in blueprint instances",
+	}
+
+	checkCmd := &cobra.Command{
+		Use:   "check",
+		Short: "Checks a blueprint instance for drift and interrupted state",
+		Long: `Checks the resources and links in a blueprint instance for drift from
+the state persisted for the instance and for elements left in an interrupted state.
+
+By default, all resources and links in the blueprint instance are checked.
+Use --resources to check only the named resources, this is useful for fast,
+targeted checks after making changes during incident response.
+
+With --with-dependents, the named resources are expanded to include the resources
+that depend on them in the reference graph, the links connected to them and the
+resources those links update through resource data mappings.
+
+Examples:
+  # Check all resources and links in a blueprint instance
+  bluelink drift check --instance-name orders-prod
+
+  # Check the orders table and everything that depends on it
+  bluelink drift check --instance-name orders-prod \
+    --resources ordersTable --with-dependents`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName, _ := confProvider.GetString("driftCheckInstanceName")
+			blueprintFile, _ := confProvider.GetString("driftCheckBlueprintFile")
+			resourceNames, _ := cmd.Flags().GetStringSlice("resources")
+			withDependents, _ := confProvider.GetBool("driftCheckWithDependents")
+			format, _ := confProvider.GetString("driftCheckFormat")
+			deployConfigFile, _ := confProvider.GetString("deployConfigFile")
+
+			if instanceName == "" {
+				return errors.New("a blueprint instance name must be provided with --instance-name")
+			}
+
+			if withDependents && len(resourceNames) == 0 {
+				return errors.New("--with-dependents requires resources to be provided with --resources")
+			}
+
+			if !slices.Contains(supportedDriftCheckFormats, format) {
+				return fmt.Errorf(
+					"unsupported drift check format %q, expected one of: %s",
+					format,
+					strings.Join(supportedDriftCheckFormats, ", "),
+				)
+			}
+
+			operationConfig, err := resourceimport.LoadOperationConfig(deployConfigFile)
+			if err != nil {
+				return err
+			}
+
+			documentInfo, err := importDocumentInfo(blueprintFile)
+			if err != nil {
+				return err
+			}
+
+			deployEngine, cleanup, err := createDriftCheckDeployEngine(confProvider)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			cmd.SilenceUsage = true
+
+			return checkInstanceDrift(
+				cmd.Context(),
+				deployEngine,
+				instanceName,
+				buildDriftCheckPayload(
+					documentInfo,
+					operationConfig,
+					resourceNames,
+					withDependents,
+				),
+				format,
+				cmd.OutOrStdout(),
+			)
+		},
+	}
+
+	checkCmd.Flags().String(
+		"instance-name",
+		"",
+		"The name or ID of the blueprint instance to check for drift.",
+	)
+	confProvider.BindPFlag("driftCheckInstanceName", checkCmd.Flags().Lookup("instance-name"))
+	confProvider.BindEnvVar("driftCheckInstanceName", "BLUELINK_CLI_DRIFT_CHECK_INSTANCE_NAME")
+
+	checkCmd.Flags().String(
+		"blueprint-file",
+		project.DetectBlueprintFile("."),
+		"The blueprint file that the blueprint instance was deployed from.",
+	)
+	confProvider.BindPFlag("driftCheckBlueprintFile", checkCmd.Flags().Lookup("blueprint-file"))
+	confProvider.BindEnvVar("driftCheckBlueprintFile", "BLUELINK_CLI_DRIFT_CHECK_BLUEPRINT_FILE")
+
+	checkCmd.Flags().StringSlice(
+		"resources",
+		[]string{},
+		"A comma-separated list of the names of resources to check, "+
+			"all resources and links are checked when not provided.",
+	)
+
+	checkCmd.Flags().Bool(
+		"with-dependents",
+		false,
+		"Also check the resources and links that depend on or are linked to the resources "+
+			"provided with --resources.",
+	)
+	confProvider.BindPFlag("driftCheckWithDependents", checkCmd.Flags().Lookup("with-dependents"))
+	confProvider.BindEnvVar("driftCheckWithDependents", "BLUELINK_CLI_DRIFT_CHECK_WITH_DEPENDENTS")
+
+	checkCmd.Flags().String(
+		"format",
+		driftCheckFormatText,
+		"The format to output the results of the drift check in, one of: "+
+			strings.Join(supportedDriftCheckFormats, ", ")+".",
+	)
+	confProvider.BindPFlag("driftCheckFormat", checkCmd.Flags().Lookup("format"))
+	confProvider.BindEnvVar("driftCheckFormat", "BLUELINK_CLI_DRIFT_CHECK_FORMAT")
+
+	driftCmd.AddCommand(checkCmd)
+	setupDriftReportCommand(driftCmd, confProvider)
+	rootCmd.AddCommand(driftCmd)
+}
+
+func buildDriftCheckPayload(
+	documentInfo types.BlueprintDocumentInfo,
+	operationConfig *types.BlueprintOperationConfig,
+	resourceNames []string,
+	withDependents bool,
+) *types.CheckReconciliationPayload {
+	payload := &types.CheckReconciliationPayload{
+		BlueprintDocumentInfo: documentInfo,
+		Scope:                 string(container.ReconciliationScopeAll),
+		Config:                operationConfig,
+	}
+
+	if len(resourceNames) > 0 {
+		payload.Scope = string(container.ReconciliationScopeSpecific)
+		payload.ResourceNames = resourceNames
+		payload.IncludeDependents = withDependents
+	}
+
+	return payload
+}
+
+func checkInstanceDrift(
+	ctx context.Context,
+	deployEngine driftCheckDeployEngine,
+	instanceName string,
+	payload *types.CheckReconciliationPayload,
+	format string,
+	output io.Writer,
+) error {
+	result, err := deployEngine.CheckReconciliation(ctx, instanceName, payload)
+	if err != nil {
+		return err
+	}
+
+	if format == driftCheckFormatJSON {
+		encoded, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(output, string(encoded))
+		return err
+	}
+
+	_, err = io.WriteString(output, formatDriftCheckResult(result, instanceName))
+	return err
+}
+
+func formatDriftCheckResult(
+	result *container.ReconciliationCheckResult,
+	instanceName string,
+) string {
+	sb := &strings.Builder{}
+	if len(result.Resources) == 0 && len(result.Links) == 0 && len(result.DataSources) == 0 {
+		fmt.Fprintf(
+			sb,
+			"No drift or interrupted state found in blueprint instance %q\n",
+			instanceName,
+		)
+		return sb.String()
+	}
+
+	fmt.Fprintf(sb, "Blueprint instance %q needs reconciliation\n", instanceName)
+
+	if len(result.Resources) > 0 {
+		sb.WriteString("\nResources:\n")
+		for _, resource := range result.Resources {
+			fmt.Fprintf(
+				sb,
+				"  %s (%s), recommended action: %s\n",
+				driftElementPath(resource.ChildPath, resource.ResourceName),
+				resource.Type,
+				resource.RecommendedAction,
+			)
+		}
+	}
+
+	if len(result.Links) > 0 {
+		sb.WriteString("\nLinks:\n")
+		for _, link := range result.Links {
+			fmt.Fprintf(
+				sb,
+				"  %s (%s), recommended action: %s\n",
+				driftElementPath(link.ChildPath, link.LinkName),
+				link.Type,
+				link.RecommendedAction,
+			)
+		}
+	}
+
+	if len(result.DataSources) > 0 {
+		sb.WriteString("\nData sources:\n")
+		for _, dataSource := range result.DataSources {
+			fmt.Fprintf(sb, "  %s (drift)\n", dataSource.DataSourceName)
+		}
+	}
+
+	return sb.String()
+}
+
+func driftElementPath(childPath string, elementName string) string {
+	if childPath == "" {
+		return elementName
+	}
+
+	return fmt.Sprintf("%s.%s", childPath, elementName)
+}
+
+func createDriftCheckDeployEngine(
+	confProvider *config.Provider,
+) (driftCheckDeployEngine, func(), error) {
+	logger, handle, err := utils.SetupLogger()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployEngine, err := engine.Create(confProvider, logger)
+	if err != nil {
+		handle.Close()
+		return nil, nil, err
+	}
+
+	driftEngine, supportsDriftCheck := deployEngine.(driftCheckDeployEngine)
+	if !supportsDriftCheck {
+		handle.Close()
+		return nil, nil, errors.New("the deploy engine client does not support checking for drift")
+	}
+
+	return driftEngine, func() { handle.Close() }, nil
+}
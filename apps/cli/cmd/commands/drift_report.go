@@ -0,0 +1,326 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/project"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/resourceimport"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/drift"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/spf13/cobra"
+)
+
+var supportedDriftReportFormats = []string{
+	string(drift.ReportFormatMarkdown),
+	string(drift.ReportFormatJSON),
+	string(drift.ReportFormatHTML),
+}
+
+func setupDriftReportCommand(driftCmd *cobra.Command, confProvider *config.Provider) {
+	reportCmd := &cobra.Command{
+		Use:   "report",
+		Short: "Generates a drift report for a blueprint instance",
+		Long: `Checks the resources and links in a blueprint instance for drift and generates
+a report with the field-level differences between the state persisted for the instance
+and the state of each resource in the upstream provider.
+
+The report includes a summary of the drifted resources for each resource type,
+it can be written as JSON, Markdown or a standalone HTML document to be kept
+as a record for audits.
+Values of fields marked as sensitive are redacted in the report.
+
+Examples:
+  # Write a drift report for a blueprint instance as Markdown
+  bluelink drift report --instance-name orders-prod
+
+  # Write a drift report as an HTML document for an audit
+  bluelink drift report --instance-name orders-prod \
+    --format html --output orders-prod-drift.html`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName, _ := confProvider.GetString("driftReportInstanceName")
+			blueprintFile, _ := confProvider.GetString("driftReportBlueprintFile")
+			format, _ := confProvider.GetString("driftReportFormat")
+			outputFile, _ := confProvider.GetString("driftReportOutput")
+			deployConfigFile, _ := confProvider.GetString("deployConfigFile")
+
+			if instanceName == "" {
+				return errors.New("a blueprint instance name must be provided with --instance-name")
+			}
+
+			if !slices.Contains(supportedDriftReportFormats, format) {
+				return fmt.Errorf(
+					"unsupported drift report format %q, expected one of: %s",
+					format,
+					strings.Join(supportedDriftReportFormats, ", "),
+				)
+			}
+
+			operationConfig, err := resourceimport.LoadOperationConfig(deployConfigFile)
+			if err != nil {
+				return err
+			}
+
+			documentInfo, err := importDocumentInfo(blueprintFile)
+			if err != nil {
+				return err
+			}
+
+			deployEngine, cleanup, err := createDriftCheckDeployEngine(confProvider)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			cmd.SilenceUsage = true
+
+			payload := buildDriftCheckPayload(
+				documentInfo,
+				operationConfig,
+				/* resourceNames */ []string{},
+				/* withDependents */ false,
+			)
+			reportGenerator := drift.NewReportGenerator(core.SystemClock{})
+			if outputFile == "" {
+				return reportInstanceDrift(
+					cmd.Context(),
+					deployEngine,
+					reportGenerator,
+					instanceName,
+					payload,
+					drift.ReportFormat(format),
+					cmd.OutOrStdout(),
+				)
+			}
+
+			// The report is only written to the output file once it has been
+			// generated so a failed drift check does not leave behind an empty report.
+			report := &bytes.Buffer{}
+			err = reportInstanceDrift(
+				cmd.Context(),
+				deployEngine,
+				reportGenerator,
+				instanceName,
+				payload,
+				drift.ReportFormat(format),
+				report,
+			)
+			if err != nil {
+				return err
+			}
+
+			return os.WriteFile(outputFile, report.Bytes(), 0644)
+		},
+	}
+
+	reportCmd.Flags().String(
+		"instance-name",
+		"",
+		"The name or ID of the blueprint instance to generate a drift report for.",
+	)
+	confProvider.BindPFlag("driftReportInstanceName", reportCmd.Flags().Lookup("instance-name"))
+	confProvider.BindEnvVar("driftReportInstanceName", "BLUELINK_CLI_DRIFT_REPORT_INSTANCE_NAME")
+
+	reportCmd.Flags().String(
+		"blueprint-file",
+		project.DetectBlueprintFile("."),
+		"The blueprint file that the blueprint instance was deployed from.",
+	)
+	confProvider.BindPFlag("driftReportBlueprintFile", reportCmd.Flags().Lookup("blueprint-file"))
+	confProvider.BindEnvVar("driftReportBlueprintFile", "BLUELINK_CLI_DRIFT_REPORT_BLUEPRINT_FILE")
+
+	reportCmd.Flags().String(
+		"format",
+		string(drift.ReportFormatMarkdown),
+		"The format to write the drift report in, one of: "+
+			strings.Join(supportedDriftReportFormats, ", ")+".",
+	)
+	confProvider.BindPFlag("driftReportFormat", reportCmd.Flags().Lookup("format"))
+	confProvider.BindEnvVar("driftReportFormat", "BLUELINK_CLI_DRIFT_REPORT_FORMAT")
+
+	reportCmd.Flags().String(
+		"output",
+		"",
+		"The file to write the drift report to, the report is written to stdout when not provided.",
+	)
+	confProvider.BindPFlag("driftReportOutput", reportCmd.Flags().Lookup("output"))
+	confProvider.BindEnvVar("driftReportOutput", "BLUELINK_CLI_DRIFT_REPORT_OUTPUT")
+
+	driftCmd.AddCommand(reportCmd)
+}
+
+func reportInstanceDrift(
+	ctx context.Context,
+	deployEngine driftCheckDeployEngine,
+	reportGenerator drift.ReportGenerator,
+	instanceName string,
+	payload *types.CheckReconciliationPayload,
+	format drift.ReportFormat,
+	output io.Writer,
+) error {
+	result, err := deployEngine.CheckReconciliation(ctx, instanceName, payload)
+	if err != nil {
+		return err
+	}
+
+	report := reportGenerator.Generate(driftReportInput(result, instanceName))
+	return reportGenerator.Render(report, format, output)
+}
+
+// Derives the input for a drift report from the results of a drift check,
+// only resources and links that have drifted are included in the report,
+// elements in an interrupted state are reported by the drift check command.
+func driftReportInput(
+	result *container.ReconciliationCheckResult,
+	instanceName string,
+) *drift.ReportInput {
+	input := &drift.ReportInput{
+		InstanceID:    result.InstanceID,
+		InstanceName:  instanceName,
+		ResourceDrift: map[string]*state.ResourceDriftState{},
+		LinkDrift:     map[string]*state.LinkDriftState{},
+		ResourceTypes: map[string]string{},
+	}
+
+	for _, resource := range result.Resources {
+		if resource.Type != container.ReconciliationTypeDrift {
+			continue
+		}
+
+		input.ResourceDrift[resource.ResourceID] = &state.ResourceDriftState{
+			ResourceID:   resource.ResourceID,
+			ResourceName: driftElementPath(resource.ChildPath, resource.ResourceName),
+			Difference:   resourceDriftChanges(resource.Changes),
+		}
+		input.ResourceTypes[resource.ResourceID] = resource.ResourceType
+	}
+
+	for _, link := range result.Links {
+		if link.Type != container.ReconciliationTypeDrift {
+			continue
+		}
+
+		resourceAName, resourceBName, _ := strings.Cut(link.LinkName, "::")
+		input.LinkDrift[link.LinkID] = &state.LinkDriftState{
+			LinkID:            link.LinkID,
+			LinkName:          driftElementPath(link.ChildPath, link.LinkName),
+			ResourceADrift:    linkResourceDrift(resourceAName, link.ResourceAChanges),
+			ResourceBDrift:    linkResourceDrift(resourceBName, link.ResourceBChanges),
+			IntermediaryDrift: intermediaryDrift(link.IntermediaryChanges),
+		}
+	}
+
+	return input
+}
+
+func resourceDriftChanges(changes *provider.Changes) *state.ResourceDriftChanges {
+	if changes == nil {
+		return nil
+	}
+
+	return &state.ResourceDriftChanges{
+		ModifiedFields:  resourceDriftFieldChanges(changes.ModifiedFields),
+		NewFields:       resourceDriftFieldChanges(changes.NewFields),
+		RemovedFields:   changes.RemovedFields,
+		UnchangedFields: changes.UnchangedFields,
+	}
+}
+
+func resourceDriftFieldChanges(
+	fieldChanges []provider.FieldChange,
+) []*state.ResourceDriftFieldChange {
+	driftFieldChanges := make([]*state.ResourceDriftFieldChange, 0, len(fieldChanges))
+	for _, fieldChange := range fieldChanges {
+		driftFieldChanges = append(driftFieldChanges, &state.ResourceDriftFieldChange{
+			FieldPath:    fieldChange.FieldPath,
+			StateValue:   fieldChange.PrevValue,
+			DriftedValue: fieldChange.NewValue,
+			Sensitive:    fieldChange.Sensitive,
+		})
+	}
+
+	return driftFieldChanges
+}
+
+func linkResourceDrift(
+	resourceName string,
+	changes *provider.Changes,
+) *state.LinkResourceDrift {
+	if changes == nil {
+		return nil
+	}
+
+	mappedFieldChanges := []*state.LinkDriftFieldChange{}
+	for _, fieldChange := range slices.Concat(changes.ModifiedFields, changes.NewFields) {
+		mappedFieldChanges = append(mappedFieldChanges, &state.LinkDriftFieldChange{
+			ResourceFieldPath: fieldChange.FieldPath,
+			LinkDataValue:     fieldChange.PrevValue,
+			ExternalValue:     fieldChange.NewValue,
+		})
+	}
+
+	return &state.LinkResourceDrift{
+		ResourceName:       resourceName,
+		MappedFieldChanges: mappedFieldChanges,
+	}
+}
+
+func intermediaryDrift(
+	intermediaryChanges map[string]*container.IntermediaryReconcileResult,
+) map[string]*state.IntermediaryDriftState {
+	if len(intermediaryChanges) == 0 {
+		return nil
+	}
+
+	driftStates := map[string]*state.IntermediaryDriftState{}
+	for name, intermediary := range intermediaryChanges {
+		if intermediary == nil || intermediary.Changes == nil {
+			continue
+		}
+
+		removedFields := make([]state.IntermediaryFieldChange, 0, len(intermediary.Changes.RemovedFields))
+		for _, fieldPath := range intermediary.Changes.RemovedFields {
+			removedFields = append(removedFields, state.IntermediaryFieldChange{
+				FieldPath: fieldPath,
+			})
+		}
+
+		driftStates[name] = &state.IntermediaryDriftState{
+			ResourceType: intermediary.Type,
+			Exists:       intermediary.Exists,
+			Changes: &state.IntermediaryDriftChanges{
+				ModifiedFields: intermediaryFieldChanges(intermediary.Changes.ModifiedFields),
+				NewFields:      intermediaryFieldChanges(intermediary.Changes.NewFields),
+				RemovedFields:  removedFields,
+			},
+		}
+	}
+
+	return driftStates
+}
+
+func intermediaryFieldChanges(
+	fieldChanges []provider.FieldChange,
+) []state.IntermediaryFieldChange {
+	intermediaryChanges := make([]state.IntermediaryFieldChange, 0, len(fieldChanges))
+	for _, fieldChange := range fieldChanges {
+		intermediaryChanges = append(intermediaryChanges, state.IntermediaryFieldChange{
+			FieldPath: fieldChange.FieldPath,
+			PrevValue: fieldChange.PrevValue,
+			NewValue:  fieldChange.NewValue,
+		})
+	}
+
+	return intermediaryChanges
+}
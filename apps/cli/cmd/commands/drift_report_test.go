@@ -0,0 +1,276 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/drift"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/stretchr/testify/suite"
+)
+
+const testDriftReportTimestamp = 1760601600
+
+type DriftReportCommandSuite struct {
+	suite.Suite
+}
+
+func (s *DriftReportCommandSuite) Test_drift_report_command_is_registered_with_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"drift", "report"})
+	s.Require().NoError(err)
+	s.Equal("report", cmd.Name())
+
+	for _, flagName := range []string{
+		"instance-name",
+		"blueprint-file",
+		"format",
+		"output",
+	} {
+		s.NotNil(cmd.Flag(flagName), "expected the --%s flag", flagName)
+	}
+	s.Equal("markdown", cmd.Flag("format").DefValue)
+}
+
+func (s *DriftReportCommandSuite) Test_fails_for_unsupported_format() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"drift", "report", "--instance-name", "orders-prod", "--format", "pdf",
+	})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Equal(
+		"unsupported drift report format \"pdf\", expected one of: markdown, json, html",
+		err.Error(),
+	)
+}
+
+func (s *DriftReportCommandSuite) Test_fails_without_instance_name() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"drift", "report"})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Equal("a blueprint instance name must be provided with --instance-name", err.Error())
+}
+
+func (s *DriftReportCommandSuite) Test_writes_drift_report_as_json() {
+	engine := &stubDriftCheckDeployEngine{
+		response: testDriftCheckResult(),
+	}
+	payload := &types.CheckReconciliationPayload{
+		Scope: string(container.ReconciliationScopeAll),
+	}
+	output := &bytes.Buffer{}
+
+	err := reportInstanceDrift(
+		context.Background(),
+		engine,
+		drift.NewReportGenerator(&staticDriftReportClock{}),
+		"orders-prod",
+		payload,
+		drift.ReportFormatJSON,
+		output,
+	)
+	s.Require().NoError(err)
+	s.Same(payload, engine.receivedPayload)
+	s.Equal("orders-prod", engine.receivedInstanceID)
+
+	report := &drift.Report{}
+	err = json.Unmarshal(output.Bytes(), report)
+	s.Require().NoError(err)
+	s.Equal(
+		&drift.Report{
+			InstanceID:   "orders-prod-id",
+			InstanceName: "orders-prod",
+			GeneratedAt:  testDriftReportTimestamp,
+			Summary: &drift.ReportSummary{
+				DriftedResourceCount: 1,
+				DriftedLinkCount:     1,
+				ResourceTypes: []*drift.ResourceTypeSummary{
+					{
+						ResourceType:         "aws/dynamodb/table",
+						DriftedResourceCount: 1,
+						ModifiedFieldCount:   1,
+						RemovedFieldCount:    1,
+					},
+				},
+			},
+			Resources: []*drift.ResourceDriftReport{
+				{
+					ResourceID:   "orders-table-id",
+					ResourceName: "ordersTable",
+					ResourceType: "aws/dynamodb/table",
+					FieldChanges: []*drift.FieldDiff{
+						{
+							FieldPath:    "spec.billingMode",
+							ChangeType:   drift.FieldChangeTypeModified,
+							StateValue:   `"PAY_PER_REQUEST"`,
+							DriftedValue: `"PROVISIONED"`,
+						},
+						{
+							FieldPath:  "spec.tags",
+							ChangeType: drift.FieldChangeTypeRemoved,
+						},
+					},
+				},
+			},
+			Links: []*drift.LinkDriftReport{
+				{
+					LinkID:   "orders-link-id",
+					LinkName: "ordersHandler::ordersTable",
+					FieldChanges: []*drift.FieldDiff{
+						{
+							Target:       "ordersHandler",
+							FieldPath:    "spec.environment.variables.TABLE_NAME",
+							ChangeType:   drift.FieldChangeTypeModified,
+							StateValue:   `"orders"`,
+							DriftedValue: `"orders-v2"`,
+						},
+					},
+				},
+			},
+		},
+		report,
+	)
+}
+
+func (s *DriftReportCommandSuite) Test_writes_drift_report_as_markdown() {
+	engine := &stubDriftCheckDeployEngine{
+		response: testDriftCheckResult(),
+	}
+	output := &bytes.Buffer{}
+
+	err := reportInstanceDrift(
+		context.Background(),
+		engine,
+		drift.NewReportGenerator(&staticDriftReportClock{}),
+		"orders-prod",
+		&types.CheckReconciliationPayload{},
+		drift.ReportFormatMarkdown,
+		output,
+	)
+	s.Require().NoError(err)
+	s.Contains(output.String(), "# Drift Report\n")
+	s.Contains(output.String(), "- **Instance:** orders-prod (`orders-prod-id`)\n")
+	s.Contains(output.String(), "### ordersTable (`orders-table-id`)\n")
+	s.Contains(output.String(), "### ordersHandler::ordersTable (`orders-link-id`)\n")
+	s.NotContains(output.String(), "orders-handler-id")
+}
+
+func (s *DriftReportCommandSuite) Test_writes_drift_report_as_html() {
+	engine := &stubDriftCheckDeployEngine{
+		response: testDriftCheckResult(),
+	}
+	output := &bytes.Buffer{}
+
+	err := reportInstanceDrift(
+		context.Background(),
+		engine,
+		drift.NewReportGenerator(&staticDriftReportClock{}),
+		"orders-prod",
+		&types.CheckReconciliationPayload{},
+		drift.ReportFormatHTML,
+		output,
+	)
+	s.Require().NoError(err)
+	s.Contains(output.String(), "<!DOCTYPE html>")
+	s.Contains(output.String(), "spec.billingMode")
+}
+
+func (s *DriftReportCommandSuite) Test_returns_error_from_deploy_engine() {
+	engine := &stubDriftCheckDeployEngine{
+		err: errors.New("instance not found"),
+	}
+	output := &bytes.Buffer{}
+
+	err := reportInstanceDrift(
+		context.Background(),
+		engine,
+		drift.NewReportGenerator(&staticDriftReportClock{}),
+		"orders-prod",
+		&types.CheckReconciliationPayload{},
+		drift.ReportFormatJSON,
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal("instance not found", err.Error())
+	s.Empty(output.String())
+}
+
+// The drift check result contains a resource in an interrupted state
+// that is expected to be left out of the drift report.
+func testDriftCheckResult() *container.ReconciliationCheckResult {
+	return &container.ReconciliationCheckResult{
+		InstanceID: "orders-prod-id",
+		Resources: []container.ResourceReconcileResult{
+			{
+				ResourceID:   "orders-table-id",
+				ResourceName: "ordersTable",
+				ResourceType: "aws/dynamodb/table",
+				Type:         container.ReconciliationTypeDrift,
+				Changes: &provider.Changes{
+					ModifiedFields: []provider.FieldChange{
+						{
+							FieldPath: "spec.billingMode",
+							PrevValue: core.MappingNodeFromString("PAY_PER_REQUEST"),
+							NewValue:  core.MappingNodeFromString("PROVISIONED"),
+						},
+					},
+					RemovedFields: []string{"spec.tags"},
+				},
+			},
+			{
+				ResourceID:   "orders-handler-id",
+				ResourceName: "ordersHandler",
+				ResourceType: "aws/lambda/function",
+				Type:         container.ReconciliationTypeInterrupted,
+			},
+		},
+		Links: []container.LinkReconcileResult{
+			{
+				LinkID:   "orders-link-id",
+				LinkName: "ordersHandler::ordersTable",
+				Type:     container.ReconciliationTypeDrift,
+				ResourceAChanges: &provider.Changes{
+					ModifiedFields: []provider.FieldChange{
+						{
+							FieldPath: "spec.environment.variables.TABLE_NAME",
+							PrevValue: core.MappingNodeFromString("orders"),
+							NewValue:  core.MappingNodeFromString("orders-v2"),
+						},
+					},
+				},
+			},
+		},
+		HasDrift:       true,
+		HasInterrupted: true,
+	}
+}
+
+type staticDriftReportClock struct{}
+
+func (c *staticDriftReportClock) Now() time.Time {
+	return time.Unix(testDriftReportTimestamp, 0)
+}
+
+func (c *staticDriftReportClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func TestDriftReportCommandSuite(t *testing.T) {
+	suite.Run(t, new(DriftReportCommandSuite))
+}
@@ -0,0 +1,201 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type DriftCommandSuite struct {
+	suite.Suite
+}
+
+func (s *DriftCommandSuite) Test_drift_check_command_is_registered_with_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"drift", "check"})
+	s.Require().NoError(err)
+	s.Equal("check", cmd.Name())
+
+	for _, flagName := range []string{
+		"instance-name",
+		"blueprint-file",
+		"resources",
+		"with-dependents",
+		"format",
+	} {
+		s.NotNil(cmd.Flag(flagName), "expected the --%s flag", flagName)
+	}
+}
+
+func (s *DriftCommandSuite) Test_fails_for_with_dependents_without_resources() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"drift", "check", "--instance-name", "orders-prod", "--with-dependents",
+	})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Equal(
+		"--with-dependents requires resources to be provided with --resources",
+		err.Error(),
+	)
+}
+
+func (s *DriftCommandSuite) Test_builds_payload_for_specific_resources_with_dependents() {
+	payload := buildDriftCheckPayload(
+		types.BlueprintDocumentInfo{},
+		&types.BlueprintOperationConfig{},
+		[]string{"ordersTable", "ordersQueue"},
+		/* withDependents */ true,
+	)
+
+	s.Equal(string(container.ReconciliationScopeSpecific), payload.Scope)
+	s.Equal([]string{"ordersTable", "ordersQueue"}, payload.ResourceNames)
+	s.True(payload.IncludeDependents)
+}
+
+func (s *DriftCommandSuite) Test_builds_payload_for_all_resources() {
+	payload := buildDriftCheckPayload(
+		types.BlueprintDocumentInfo{},
+		&types.BlueprintOperationConfig{},
+		[]string{},
+		/* withDependents */ false,
+	)
+
+	s.Equal(string(container.ReconciliationScopeAll), payload.Scope)
+	s.Empty(payload.ResourceNames)
+	s.False(payload.IncludeDependents)
+}
+
+func (s *DriftCommandSuite) Test_writes_drift_check_results() {
+	engine := &stubDriftCheckDeployEngine{
+		response: &container.ReconciliationCheckResult{
+			InstanceID: "orders-prod-id",
+			Resources: []container.ResourceReconcileResult{
+				{
+					ResourceName:      "ordersTable",
+					Type:              container.ReconciliationTypeDrift,
+					RecommendedAction: container.ReconciliationActionAcceptExternal,
+				},
+				{
+					ResourceName:      "ordersHandler",
+					ChildPath:         "api",
+					Type:              container.ReconciliationTypeInterrupted,
+					RecommendedAction: container.ReconciliationActionUpdateStatus,
+				},
+			},
+			Links: []container.LinkReconcileResult{
+				{
+					LinkName:          "ordersHandler::ordersTable",
+					Type:              container.ReconciliationTypeDrift,
+					RecommendedAction: container.ReconciliationActionAcceptExternal,
+				},
+			},
+			HasDrift:       true,
+			HasInterrupted: true,
+		},
+	}
+	payload := &types.CheckReconciliationPayload{
+		Scope:             string(container.ReconciliationScopeSpecific),
+		ResourceNames:     []string{"ordersTable"},
+		IncludeDependents: true,
+	}
+	output := &bytes.Buffer{}
+
+	err := checkInstanceDrift(
+		context.Background(),
+		engine,
+		"orders-prod",
+		payload,
+		"text",
+		output,
+	)
+	s.Require().NoError(err)
+	s.Same(payload, engine.receivedPayload)
+	s.Equal("orders-prod", engine.receivedInstanceID)
+	s.Equal(
+		`Blueprint instance "orders-prod" needs reconciliation
+
+Resources:
+  ordersTable (drift), recommended action: accept_external
+  api.ordersHandler (interrupted), recommended action: update_status
+
+Links:
+  ordersHandler::ordersTable (drift), recommended action: accept_external
+`,
+		output.String(),
+	)
+}
+
+func (s *DriftCommandSuite) Test_writes_message_when_no_drift_is_found() {
+	engine := &stubDriftCheckDeployEngine{
+		response: &container.ReconciliationCheckResult{
+			InstanceID: "orders-prod-id",
+			Resources:  []container.ResourceReconcileResult{},
+			Links:      []container.LinkReconcileResult{},
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := checkInstanceDrift(
+		context.Background(),
+		engine,
+		"orders-prod",
+		&types.CheckReconciliationPayload{},
+		"text",
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal(
+		"No drift or interrupted state found in blueprint instance \"orders-prod\"\n",
+		output.String(),
+	)
+}
+
+func (s *DriftCommandSuite) Test_returns_error_from_deploy_engine() {
+	engine := &stubDriftCheckDeployEngine{
+		err: errors.New("instance not found"),
+	}
+	output := &bytes.Buffer{}
+
+	err := checkInstanceDrift(
+		context.Background(),
+		engine,
+		"orders-prod",
+		&types.CheckReconciliationPayload{},
+		"json",
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal("instance not found", err.Error())
+	s.Empty(output.String())
+}
+
+type stubDriftCheckDeployEngine struct {
+	response           *container.ReconciliationCheckResult
+	err                error
+	receivedInstanceID string
+	receivedPayload    *types.CheckReconciliationPayload
+}
+
+func (e *stubDriftCheckDeployEngine) CheckReconciliation(
+	ctx context.Context,
+	instanceID string,
+	payload *types.CheckReconciliationPayload,
+) (*container.ReconciliationCheckResult, error) {
+	e.receivedInstanceID = instanceID
+	e.receivedPayload = payload
+	return e.response, e.err
+}
+
+func TestDriftCommandSuite(t *testing.T) {
+	suite.Run(t, new(DriftCommandSuite))
+}
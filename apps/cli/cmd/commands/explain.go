@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errorcodes"
+	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	explainFormatText = "text"
+	explainFormatJSON = "json"
+)
+
+var supportedExplainFormats = []string{explainFormatText, explainFormatJSON}
+
+func setupExplainCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	explainCmd := &cobra.Command{
+		Use:   "explain [code]",
+		Short: "Explains the error codes reported when validating and deploying blueprints",
+		Long: `Explains an error code that is reported in the context of errors and diagnostics
+when validating, staging changes for or deploying a blueprint. This includes
+a description of the issue, an example message and suggested actions to resolve it.
+
+When an error code is not provided, every error code in the catalogue is listed.
+The JSON format can be used to export the full catalogue for documentation or tooling.
+
+Examples:
+  # Explain an error code
+  bluelink explain invalid_version
+
+  # Export the full error code catalogue as JSON
+  bluelink explain --format json > error-codes.json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, _ := confProvider.GetString("explainFormat")
+			if !slices.Contains(supportedExplainFormats, format) {
+				return fmt.Errorf(
+					"unsupported explain format %q, expected one of: %s",
+					format,
+					strings.Join(supportedExplainFormats, ", "),
+				)
+			}
+
+			cmd.SilenceUsage = true
+
+			if len(args) == 0 {
+				return writeErrorCodeCatalogue(errorcodes.All(), format, cmd.OutOrStdout())
+			}
+
+			entry, ok := errorcodes.Lookup(bperrors.ErrorReasonCode(args[0]))
+			if !ok {
+				return fmt.Errorf(
+					"unknown error code %q, run \"bluelink explain\" to list all error codes",
+					args[0],
+				)
+			}
+
+			return writeErrorCodeEntry(entry, format, cmd.OutOrStdout())
+		},
+	}
+
+	explainCmd.Flags().String(
+		"format",
+		explainFormatText,
+		"The format to output error code information in, one of: "+
+			strings.Join(supportedExplainFormats, ", ")+".",
+	)
+	confProvider.BindPFlag("explainFormat", explainCmd.Flags().Lookup("format"))
+	confProvider.BindEnvVar("explainFormat", "BLUELINK_CLI_EXPLAIN_FORMAT")
+
+	rootCmd.AddCommand(explainCmd)
+}
+
+func writeErrorCodeCatalogue(
+	entries []*errorcodes.Entry,
+	format string,
+	output io.Writer,
+) error {
+	if format == explainFormatJSON {
+		return writeExplainJSON(entries, output)
+	}
+
+	sb := &strings.Builder{}
+	for _, entry := range entries {
+		fmt.Fprintf(sb, "%s (%s)\n", entry.Code, entry.Package)
+		if entry.Description != "" {
+			fmt.Fprintf(sb, "  %s\n", entry.Description)
+		}
+	}
+	_, err := io.WriteString(output, sb.String())
+	return err
+}
+
+func writeErrorCodeEntry(
+	entry *errorcodes.Entry,
+	format string,
+	output io.Writer,
+) error {
+	if format == explainFormatJSON {
+		return writeExplainJSON(entry, output)
+	}
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "%s (%s.%s)\n\n", entry.Code, entry.Package, entry.ConstantName)
+	if entry.Description != "" {
+		fmt.Fprintf(sb, "%s\n\n", entry.Description)
+	}
+
+	if entry.Category != "" {
+		fmt.Fprintf(sb, "Category: %s\n", entry.Category)
+	}
+
+	if entry.Example != "" {
+		fmt.Fprintf(sb, "Example: %s\n", entry.Example)
+	}
+
+	if len(entry.SuggestedActions) > 0 {
+		sb.WriteString("Suggested actions:\n")
+		for _, action := range entry.SuggestedActions {
+			fmt.Fprintf(sb, "  - %s", action.Title)
+			if action.Description != "" {
+				fmt.Fprintf(sb, ": %s", action.Description)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(output, sb.String())
+	return err
+}
+
+func writeExplainJSON(value any, output io.Writer) error {
+	encoded, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(output, string(encoded))
+	return err
+}
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errorcodes"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExplainCommandSuite struct {
+	suite.Suite
+}
+
+func (s *ExplainCommandSuite) Test_explain_command_is_registered_with_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"explain"})
+	s.Require().NoError(err)
+	s.Equal("explain", cmd.Name())
+	s.NotNil(cmd.Flag("format"))
+	s.Equal("text", cmd.Flag("format").DefValue)
+}
+
+func (s *ExplainCommandSuite) Test_explains_error_code() {
+	output := &bytes.Buffer{}
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"explain", "invalid_spec_ext"})
+	rootCmd.SetOut(output)
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().NoError(err)
+	s.Contains(output.String(), "invalid_spec_ext (container.ErrorReasonCodeInvalidSpecExtension)")
+	s.Contains(
+		output.String(),
+		"Example: unsupported spec file extension in <value>, only json and yaml are supported",
+	)
+}
+
+func (s *ExplainCommandSuite) Test_explains_error_code_with_suggested_actions() {
+	entry, ok := errorcodes.Lookup("variable_invalid_default_value")
+	s.Require().True(ok)
+	output := &bytes.Buffer{}
+
+	err := writeErrorCodeEntry(entry, explainFormatText, output)
+	s.Require().NoError(err)
+	s.Contains(output.String(), "Category: variable_type\n")
+	s.Contains(
+		output.String(),
+		"Suggested actions:\n  - Fix Variable Type: Update the variable type or default value for <value>\n",
+	)
+}
+
+func (s *ExplainCommandSuite) Test_exports_catalogue_as_json() {
+	output := &bytes.Buffer{}
+
+	err := writeErrorCodeCatalogue(errorcodes.All(), explainFormatJSON, output)
+	s.Require().NoError(err)
+
+	exported := []*errorcodes.Entry{}
+	s.Require().NoError(json.Unmarshal(output.Bytes(), &exported))
+	s.Equal(errorcodes.All(), exported)
+}
+
+func (s *ExplainCommandSuite) Test_fails_for_unknown_error_code() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"explain", "not_a_reason_code"})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Equal(
+		"unknown error code \"not_a_reason_code\", run \"bluelink explain\" to list all error codes",
+		err.Error(),
+	)
+}
+
+func TestExplainCommandSuite(t *testing.T) {
+	suite.Run(t, new(ExplainCommandSuite))
+}
@@ -0,0 +1,161 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/exportformat"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/cobra"
+)
+
+// The deploy engine operation used to retrieve
+// the exports of a blueprint instance.
+type exportsDeployEngine interface {
+	GetBlueprintInstanceExports(
+		ctx context.Context,
+		instanceID string,
+	) (map[string]*state.ExportState, error)
+}
+
+func setupExportsCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	exportsCmd := &cobra.Command{
+		Use:   "exports",
+		Short: "Outputs the exports of a blueprint instance",
+		Long: `Outputs the exports of a deployed blueprint instance in a format that can be
+consumed directly by application build tooling and other infrastructure as code tools.
+
+Supported formats:
+  json    A JSON object of export names to values.
+  dotenv  KEY="value" lines that can be loaded from a .env file.
+  shell   export KEY='value' statements that can be evaluated in a POSIX shell.
+  tfvars  Variable definitions that can be used as a Terraform/OpenTofu .tfvars file.
+
+For the dotenv and shell formats, export names are converted to upper snake case
+(e.g. ordersTableName -> ORDERS_TABLE_NAME) and values that are not strings, numbers
+or booleans are written as JSON. For the tfvars format, export names are converted
+to lower snake case.
+
+Examples:
+  # Load exports into the current shell
+  eval "$(bluelink exports --instance-name orders-prod --format shell)"
+
+  # Write a .env file for an application build
+  bluelink exports --instance-name orders-prod --format dotenv --prefix APP_ --output .env`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName, _ := confProvider.GetString("exportsInstanceName")
+			format, _ := confProvider.GetString("exportsFormat")
+			prefix, _ := confProvider.GetString("exportsPrefix")
+			outputFile, _ := confProvider.GetString("exportsOutputFile")
+
+			if instanceName == "" {
+				return errors.New("a blueprint instance name must be provided with --instance-name")
+			}
+
+			if !slices.Contains(exportformat.SupportedFormatNames(), format) {
+				return fmt.Errorf(
+					"unsupported export format %q, expected one of: %s",
+					format,
+					strings.Join(exportformat.SupportedFormatNames(), ", "),
+				)
+			}
+
+			logger, handle, err := utils.SetupLogger()
+			if err != nil {
+				return err
+			}
+			defer handle.Close()
+
+			deployEngine, err := engine.Create(confProvider, logger)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			output := cmd.OutOrStdout()
+			if outputFile != "" {
+				file, err := os.Create(outputFile)
+				if err != nil {
+					return fmt.Errorf("failed to create exports output file: %w", err)
+				}
+				defer file.Close()
+				output = file
+			}
+
+			return writeInstanceExports(
+				cmd.Context(),
+				deployEngine,
+				instanceName,
+				exportformat.Format(format),
+				&exportformat.Options{Prefix: prefix},
+				output,
+			)
+		},
+	}
+
+	exportsCmd.Flags().String(
+		"instance-name",
+		"",
+		"The name or ID of the blueprint instance to output exports for.",
+	)
+	confProvider.BindPFlag("exportsInstanceName", exportsCmd.Flags().Lookup("instance-name"))
+	confProvider.BindEnvVar("exportsInstanceName", "BLUELINK_CLI_EXPORTS_INSTANCE_NAME")
+
+	exportsCmd.Flags().String(
+		"format",
+		string(exportformat.FormatJSON),
+		"The format to output exports in, one of: "+
+			strings.Join(exportformat.SupportedFormatNames(), ", ")+".",
+	)
+	confProvider.BindPFlag("exportsFormat", exportsCmd.Flags().Lookup("format"))
+	confProvider.BindEnvVar("exportsFormat", "BLUELINK_CLI_EXPORTS_FORMAT")
+
+	exportsCmd.Flags().String(
+		"prefix",
+		"",
+		"A prefix to prepend to the name of each export in the dotenv, shell and tfvars formats.",
+	)
+	confProvider.BindPFlag("exportsPrefix", exportsCmd.Flags().Lookup("prefix"))
+	confProvider.BindEnvVar("exportsPrefix", "BLUELINK_CLI_EXPORTS_PREFIX")
+
+	exportsCmd.Flags().String(
+		"output",
+		"",
+		"The file to write exports to, exports are written to stdout when not provided.",
+	)
+	confProvider.BindPFlag("exportsOutputFile", exportsCmd.Flags().Lookup("output"))
+	confProvider.BindEnvVar("exportsOutputFile", "BLUELINK_CLI_EXPORTS_OUTPUT_FILE")
+
+	rootCmd.AddCommand(exportsCmd)
+}
+
+func writeInstanceExports(
+	ctx context.Context,
+	deployEngine exportsDeployEngine,
+	instanceName string,
+	format exportformat.Format,
+	opts *exportformat.Options,
+	output io.Writer,
+) error {
+	exports, err := deployEngine.GetBlueprintInstanceExports(ctx, instanceName)
+	if err != nil {
+		return err
+	}
+
+	rendered, err := exportformat.Render(exports, format, opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = output.Write(rendered)
+	return err
+}
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/exportformat"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExportsCommandSuite struct {
+	suite.Suite
+}
+
+func (s *ExportsCommandSuite) Test_exports_command_is_registered_with_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"exports"})
+	s.Require().NoError(err)
+	s.Equal("exports", cmd.Name())
+
+	for _, flagName := range []string{"instance-name", "format", "prefix", "output"} {
+		s.NotNil(cmd.Flag(flagName), "expected the --%s flag", flagName)
+	}
+	s.Equal("json", cmd.Flag("format").DefValue)
+}
+
+func (s *ExportsCommandSuite) Test_fails_without_instance_name() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"exports", "--format", "dotenv"})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "a blueprint instance name must be provided with --instance-name")
+}
+
+func (s *ExportsCommandSuite) Test_fails_for_unsupported_format() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"exports", "--instance-name", "orders-prod", "--format", "yaml"})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Contains(
+		err.Error(),
+		"unsupported export format \"yaml\", expected one of: json, dotenv, shell, tfvars",
+	)
+}
+
+func (s *ExportsCommandSuite) Test_writes_instance_exports_in_requested_format() {
+	engine := &stubExportsDeployEngine{
+		exports: map[string]*state.ExportState{
+			"ordersTableName": {
+				Type:  schema.ExportTypeString,
+				Value: core.MappingNodeFromString("orders-table"),
+			},
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := writeInstanceExports(
+		context.Background(),
+		engine,
+		"orders-prod",
+		exportformat.FormatShell,
+		&exportformat.Options{Prefix: "APP_"},
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal("orders-prod", engine.requestedInstance)
+	s.Equal("export APP_ORDERS_TABLE_NAME='orders-table'\n", output.String())
+}
+
+func (s *ExportsCommandSuite) Test_returns_error_from_deploy_engine() {
+	engine := &stubExportsDeployEngine{
+		err: errors.New("instance not found"),
+	}
+	output := &bytes.Buffer{}
+
+	err := writeInstanceExports(
+		context.Background(),
+		engine,
+		"orders-prod",
+		exportformat.FormatDotenv,
+		nil,
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal("instance not found", err.Error())
+	s.Empty(output.String())
+}
+
+type stubExportsDeployEngine struct {
+	exports           map[string]*state.ExportState
+	err               error
+	requestedInstance string
+}
+
+func (e *stubExportsDeployEngine) GetBlueprintInstanceExports(
+	ctx context.Context,
+	instanceID string,
+) (map[string]*state.ExportState, error) {
+	e.requestedInstance = instanceID
+	return e.exports, e.err
+}
+
+func TestExportsCommandSuite(t *testing.T) {
+	suite.Run(t, new(ExportsCommandSuite))
+}
@@ -0,0 +1,191 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/project"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/resourceimport"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/cobra"
+)
+
+// The deploy engine operation used to export the
+// dependency graph for a blueprint.
+type graphDeployEngine interface {
+	ExportGraph(
+		ctx context.Context,
+		payload *types.ExportGraphPayload,
+	) (*types.ExportGraphResponse, error)
+}
+
+func setupGraphCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	graphCmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Exports the dependency graph for a blueprint",
+		Long: `Exports the graph of resources, child blueprints and links in a blueprint
+along with the dependencies that determine the order in which they are deployed.
+The graph is resolved in the same way as when staging changes, so resource templates
+are expanded and resource conditions are applied.
+
+Each element is assigned to a group, elements in the same group are deployed
+concurrently and groups are deployed in ascending order.
+
+Examples:
+  # Render the graph with Graphviz
+  bluelink graph --format dot | dot -Tsvg -o graph.svg
+
+  # Write a Mermaid flowchart to a file
+  bluelink graph --format mermaid --output graph.mmd`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			blueprintFile, _ := confProvider.GetString("graphBlueprintFile")
+			format, _ := confProvider.GetString("graphFormat")
+			outputFile, _ := confProvider.GetString("graphOutputFile")
+			deployConfigFile, _ := confProvider.GetString("deployConfigFile")
+
+			if err := validateGraphFormat(format); err != nil {
+				return err
+			}
+
+			operationConfig, err := resourceimport.LoadOperationConfig(deployConfigFile)
+			if err != nil {
+				return err
+			}
+
+			documentInfo, err := importDocumentInfo(blueprintFile)
+			if err != nil {
+				return err
+			}
+
+			deployEngine, cleanup, err := createGraphDeployEngine(confProvider)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			cmd.SilenceUsage = true
+
+			output := cmd.OutOrStdout()
+			if outputFile != "" {
+				file, err := os.Create(outputFile)
+				if err != nil {
+					return fmt.Errorf("failed to create graph output file: %w", err)
+				}
+				defer file.Close()
+				output = file
+			}
+
+			return exportGraph(
+				cmd.Context(),
+				deployEngine,
+				&types.ExportGraphPayload{
+					BlueprintDocumentInfo: documentInfo,
+					Format:                format,
+					Config:                operationConfig,
+				},
+				output,
+			)
+		},
+	}
+
+	graphCmd.Flags().String(
+		"blueprint-file",
+		project.DetectBlueprintFile("."),
+		"The blueprint file to export the dependency graph for.",
+	)
+	confProvider.BindPFlag("graphBlueprintFile", graphCmd.Flags().Lookup("blueprint-file"))
+	confProvider.BindEnvVar("graphBlueprintFile", "BLUELINK_CLI_GRAPH_BLUEPRINT_FILE")
+
+	graphCmd.Flags().String(
+		"format",
+		string(container.GraphFormatDOT),
+		"The format to export the dependency graph in, one of: "+
+			strings.Join(supportedGraphFormats(), ", ")+".",
+	)
+	confProvider.BindPFlag("graphFormat", graphCmd.Flags().Lookup("format"))
+	confProvider.BindEnvVar("graphFormat", "BLUELINK_CLI_GRAPH_FORMAT")
+
+	graphCmd.Flags().String(
+		"output",
+		"",
+		"The file to write the dependency graph to, "+
+			"the graph is written to stdout when not provided.",
+	)
+	confProvider.BindPFlag("graphOutputFile", graphCmd.Flags().Lookup("output"))
+	confProvider.BindEnvVar("graphOutputFile", "BLUELINK_CLI_GRAPH_OUTPUT_FILE")
+
+	rootCmd.AddCommand(graphCmd)
+}
+
+func exportGraph(
+	ctx context.Context,
+	deployEngine graphDeployEngine,
+	payload *types.ExportGraphPayload,
+	output io.Writer,
+) error {
+	response, err := deployEngine.ExportGraph(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	graph := response.Graph
+	if !strings.HasSuffix(graph, "\n") {
+		graph += "\n"
+	}
+
+	_, err = io.WriteString(output, graph)
+	return err
+}
+
+func validateGraphFormat(format string) error {
+	supported := supportedGraphFormats()
+	if !slices.Contains(supported, format) {
+		return fmt.Errorf(
+			"unsupported graph format %q, expected one of: %s",
+			format,
+			strings.Join(supported, ", "),
+		)
+	}
+
+	return nil
+}
+
+func supportedGraphFormats() []string {
+	formats := make([]string, len(container.SupportedGraphFormats))
+	for i, format := range container.SupportedGraphFormats {
+		formats[i] = string(format)
+	}
+	return formats
+}
+
+func createGraphDeployEngine(
+	confProvider *config.Provider,
+) (graphDeployEngine, func(), error) {
+	logger, handle, err := utils.SetupLogger()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployEngine, err := engine.Create(confProvider, logger)
+	if err != nil {
+		handle.Close()
+		return nil, nil, err
+	}
+
+	graphEngine, supportsGraph := deployEngine.(graphDeployEngine)
+	if !supportsGraph {
+		handle.Close()
+		return nil, nil, errors.New("the deploy engine client does not support exporting dependency graphs")
+	}
+
+	return graphEngine, func() { handle.Close() }, nil
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type GraphCommandSuite struct {
+	suite.Suite
+}
+
+func (s *GraphCommandSuite) Test_graph_command_is_registered_with_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"graph"})
+	s.Require().NoError(err)
+	s.Equal("graph", cmd.Name())
+
+	for _, flagName := range []string{"blueprint-file", "format", "output"} {
+		s.NotNil(cmd.Flag(flagName), "expected the --%s flag", flagName)
+	}
+	s.Equal("dot", cmd.Flag("format").DefValue)
+}
+
+func (s *GraphCommandSuite) Test_fails_for_unsupported_format() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"graph", "--format", "svg"})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "unsupported graph format \"svg\", expected one of: dot, mermaid, json")
+}
+
+func (s *GraphCommandSuite) Test_writes_exported_graph_to_output() {
+	engine := &stubGraphDeployEngine{
+		response: &types.ExportGraphResponse{
+			Format: "mermaid",
+			Graph:  "flowchart LR\n  n0[\"ordersTable\"]",
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := exportGraph(
+		context.Background(),
+		engine,
+		&types.ExportGraphPayload{Format: "mermaid"},
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal("mermaid", engine.receivedPayload.Format)
+	s.Equal("flowchart LR\n  n0[\"ordersTable\"]\n", output.String())
+}
+
+func (s *GraphCommandSuite) Test_returns_error_from_deploy_engine() {
+	engine := &stubGraphDeployEngine{
+		err: errors.New("failed to load blueprint"),
+	}
+	output := &bytes.Buffer{}
+
+	err := exportGraph(
+		context.Background(),
+		engine,
+		&types.ExportGraphPayload{Format: "dot"},
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal("failed to load blueprint", err.Error())
+	s.Empty(output.String())
+}
+
+type stubGraphDeployEngine struct {
+	response        *types.ExportGraphResponse
+	err             error
+	receivedPayload *types.ExportGraphPayload
+}
+
+func (e *stubGraphDeployEngine) ExportGraph(
+	ctx context.Context,
+	payload *types.ExportGraphPayload,
+) (*types.ExportGraphResponse, error) {
+	e.receivedPayload = payload
+	return e.response, e.err
+}
+
+func TestGraphCommandSuite(t *testing.T) {
+	suite.Run(t, new(GraphCommandSuite))
+}
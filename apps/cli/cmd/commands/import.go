@@ -0,0 +1,272 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/project"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/resourceimport"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/cobra"
+)
+
+// The deploy engine operations used to find and import
+// existing resources into a blueprint instance.
+type importDeployEngine interface {
+	ListImportCandidates(
+		ctx context.Context,
+		instanceID string,
+		payload *types.ListImportCandidatesPayload,
+	) (*container.ImportCandidatesResult, error)
+	ImportResources(
+		ctx context.Context,
+		instanceID string,
+		payload *types.ImportResourcesPayload,
+	) (*container.ImportResourcesResult, error)
+}
+
+func setupImportCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	importCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Adopts existing resources into the state of a blueprint instance",
+		Long: `Adopts resources that already exist in providers into the state of a
+blueprint instance using provider-specific import IDs (e.g. an ARN for AWS resources).
+The current state of each resource is fetched from its provider and saved as the
+state of the matching resource in the blueprint.
+A new blueprint instance is created when there is no instance with the provided name.
+
+With --interactive, the resources in the blueprint that are not yet tracked in
+the instance state are listed along with existing resources that providers found
+for each of them, so each resource can be mapped without looking up IDs by hand.
+Resource types that do not support listing existing resources can still be
+mapped by entering an import ID.
+
+Examples:
+  # Choose the existing resources to import for each unmatched resource
+  bluelink import --instance-name orders-prod --interactive
+
+  # Import specific resources with known import IDs
+  bluelink import --instance-name orders-prod \
+    --map ordersQueue=arn:aws:sqs:eu-west-2:123456789012:orders`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			blueprintFile, _ := confProvider.GetString("importBlueprintFile")
+			instanceName, _ := confProvider.GetString("importInstanceName")
+			interactive, _ := confProvider.GetBool("importInteractive")
+			maxCandidates, _ := confProvider.GetInt32("importMaxCandidates")
+			deployConfigFile, _ := confProvider.GetString("deployConfigFile")
+			mapValues, _ := cmd.Flags().GetStringArray("map")
+
+			if instanceName == "" {
+				return errors.New("a blueprint instance name must be provided with --instance-name")
+			}
+
+			if !interactive && len(mapValues) == 0 {
+				return errors.New(
+					"resources to import must be provided with --map or chosen with --interactive",
+				)
+			}
+
+			mappings, err := resourceimport.ParseMappings(mapValues)
+			if err != nil {
+				return err
+			}
+
+			operationConfig, err := resourceimport.LoadOperationConfig(deployConfigFile)
+			if err != nil {
+				return err
+			}
+
+			documentInfo, err := importDocumentInfo(blueprintFile)
+			if err != nil {
+				return err
+			}
+
+			deployEngine, cleanup, err := createImportDeployEngine(confProvider)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			cmd.SilenceUsage = true
+
+			if interactive {
+				prompter := resourceimport.NewPrompter(cmd.InOrStdin(), cmd.OutOrStdout())
+				mappings, err = chooseImportMappings(
+					cmd.Context(),
+					deployEngine,
+					prompter,
+					instanceName,
+					&types.ListImportCandidatesPayload{
+						BlueprintDocumentInfo: documentInfo,
+						MaxCandidates:         int(maxCandidates),
+						Config:                operationConfig,
+					},
+					mappings,
+				)
+				if err != nil {
+					return err
+				}
+
+				if len(mappings) == 0 {
+					cmd.Println("No resources were selected to import.")
+					return nil
+				}
+
+				confirmed, err := prompter.Confirm(mappings)
+				if err != nil {
+					return err
+				}
+
+				if !confirmed {
+					cmd.Println("Import cancelled.")
+					return nil
+				}
+			}
+
+			result, err := deployEngine.ImportResources(
+				cmd.Context(),
+				instanceName,
+				&types.ImportResourcesPayload{
+					BlueprintDocumentInfo: documentInfo,
+					Mappings:              mappings,
+					Config:                operationConfig,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println()
+			resourceimport.WriteResult(cmd.OutOrStdout(), instanceName, result)
+			if len(result.Errors) > 0 {
+				return fmt.Errorf("%d resource(s) could not be imported", len(result.Errors))
+			}
+
+			return nil
+		},
+	}
+
+	importCmd.Flags().String(
+		"blueprint-file",
+		project.DetectBlueprintFile("."),
+		"The blueprint file that defines the resources to import.",
+	)
+	confProvider.BindPFlag("importBlueprintFile", importCmd.Flags().Lookup("blueprint-file"))
+	confProvider.BindEnvVar("importBlueprintFile", "BLUELINK_CLI_IMPORT_BLUEPRINT_FILE")
+
+	importCmd.Flags().String(
+		"instance-name",
+		"",
+		"The name of the blueprint instance to import resources into, "+
+			"a new instance is created if one does not exist with this name.",
+	)
+	confProvider.BindPFlag("importInstanceName", importCmd.Flags().Lookup("instance-name"))
+	confProvider.BindEnvVar("importInstanceName", "BLUELINK_CLI_IMPORT_INSTANCE_NAME")
+
+	importCmd.Flags().Bool(
+		"interactive",
+		false,
+		"List the resources in the blueprint that are not tracked in the instance state "+
+			"along with existing resources found by providers and prompt for the resource to import for each.",
+	)
+	confProvider.BindPFlag("importInteractive", importCmd.Flags().Lookup("interactive"))
+	confProvider.BindEnvVar("importInteractive", "BLUELINK_CLI_IMPORT_INTERACTIVE")
+
+	importCmd.Flags().Int32(
+		"max-candidates",
+		10,
+		"The maximum number of existing resources to list for each unmatched resource in interactive mode.",
+	)
+	confProvider.BindPFlag("importMaxCandidates", importCmd.Flags().Lookup("max-candidates"))
+	confProvider.BindEnvVar("importMaxCandidates", "BLUELINK_CLI_IMPORT_MAX_CANDIDATES")
+
+	importCmd.Flags().StringArray(
+		"map",
+		[]string{},
+		"Map a blueprint resource to an existing resource in the format resourceName=importId, "+
+			"this can be provided multiple times. "+
+			"In interactive mode, mapped resources are not prompted for.",
+	)
+
+	rootCmd.AddCommand(importCmd)
+}
+
+// Prompts the user to map the unmatched resources in the blueprint that
+// have not already been mapped with the --map flag.
+func chooseImportMappings(
+	ctx context.Context,
+	deployEngine importDeployEngine,
+	prompter *resourceimport.Prompter,
+	instanceName string,
+	payload *types.ListImportCandidatesPayload,
+	preMapped []*types.ImportResourceMappingPayload,
+) ([]*types.ImportResourceMappingPayload, error) {
+	candidates, err := deployEngine.ListImportCandidates(ctx, instanceName, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	preMappedNames := map[string]bool{}
+	for _, mapping := range preMapped {
+		preMappedNames[mapping.ResourceName] = true
+	}
+
+	remaining := &container.ImportCandidatesResult{
+		InstanceID: candidates.InstanceID,
+		Resources:  []*container.UnmatchedResource{},
+	}
+	for _, resource := range candidates.Resources {
+		if !preMappedNames[resource.ResourceName] {
+			remaining.Resources = append(remaining.Resources, resource)
+		}
+	}
+
+	chosen, err := prompter.MapResources(remaining)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(preMapped, chosen...), nil
+}
+
+func importDocumentInfo(blueprintFile string) (types.BlueprintDocumentInfo, error) {
+	absPath, err := filepath.Abs(blueprintFile)
+	if err != nil {
+		return types.BlueprintDocumentInfo{}, err
+	}
+
+	return types.BlueprintDocumentInfo{
+		FileSourceScheme: "file",
+		Directory:        filepath.Dir(absPath),
+		BlueprintFile:    filepath.Base(absPath),
+	}, nil
+}
+
+func createImportDeployEngine(
+	confProvider *config.Provider,
+) (importDeployEngine, func(), error) {
+	logger, handle, err := utils.SetupLogger()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployEngine, err := engine.Create(confProvider, logger)
+	if err != nil {
+		handle.Close()
+		return nil, nil, err
+	}
+
+	importEngine, supportsImport := deployEngine.(importDeployEngine)
+	if !supportsImport {
+		handle.Close()
+		return nil, nil, errors.New("the deploy engine client does not support importing resources")
+	}
+
+	return importEngine, func() { handle.Close() }, nil
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/blueprintmigration"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/project"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/validation"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+func setupMigrateCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	migrateCmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate project files to newer versions",
+	}
+
+	migrateCmd.AddCommand(newMigrateBlueprintCommand(confProvider))
+	rootCmd.AddCommand(migrateCmd)
+}
+
+func newMigrateBlueprintCommand(confProvider *config.Provider) *cobra.Command {
+	blueprintCmd := &cobra.Command{
+		Use:   "blueprint",
+		Short: "Migrate a blueprint to the latest version of the blueprint specification",
+		Long: `Rewrites a blueprint written for an older version of the blueprint specification
+so that it conforms to the latest version supported by the CLI.
+Comments and formatting are preserved wherever possible.
+
+Only YAML and JSONC blueprint files can be migrated.
+
+Examples:
+  # Migrate the project blueprint in place
+  bluelink migrate blueprint
+
+  # Preview the migrated blueprint without writing any changes
+  bluelink migrate blueprint --blueprint-file app.blueprint.jsonc --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			blueprintFile, _ := confProvider.GetString("migrateBlueprintFile")
+			outputFile, _ := confProvider.GetString("migrateBlueprintOutputFile")
+			dryRun, _ := confProvider.GetBool("migrateBlueprintDryRun")
+
+			cmd.SilenceUsage = true
+
+			fileSystem := afero.NewOsFs()
+			migrator := schema.NewMigrator(validation.LatestVersion)
+			result, err := blueprintmigration.MigrateFile(fileSystem, blueprintFile, migrator)
+			if err != nil {
+				return err
+			}
+
+			if !result.Migrated() {
+				cmd.Println(fmt.Sprintf(
+					"%s already uses the latest blueprint specification version (%s)",
+					blueprintFile,
+					result.ToVersion,
+				))
+				return nil
+			}
+
+			if dryRun {
+				cmd.Print(result.Spec)
+				return nil
+			}
+
+			if outputFile == "" {
+				outputFile = blueprintFile
+			}
+			err = blueprintmigration.WriteResult(fileSystem, outputFile, result)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(fmt.Sprintf(
+				"Migrated %s from blueprint specification version %s to %s",
+				blueprintFile,
+				result.FromVersion,
+				result.ToVersion,
+			))
+			for _, migration := range result.Applied {
+				cmd.Println(fmt.Sprintf(
+					"  - %s -> %s: %s",
+					migration.FromVersion,
+					migration.ToVersion,
+					migration.Description,
+				))
+			}
+			if outputFile != blueprintFile {
+				cmd.Println(fmt.Sprintf("Migrated blueprint written to %s", outputFile))
+			}
+
+			return nil
+		},
+	}
+
+	blueprintCmd.Flags().String(
+		"blueprint-file",
+		project.DetectBlueprintFile("."),
+		"The YAML or JSONC blueprint file to migrate.",
+	)
+	confProvider.BindPFlag("migrateBlueprintFile", blueprintCmd.Flags().Lookup("blueprint-file"))
+	confProvider.BindEnvVar("migrateBlueprintFile", "BLUELINK_CLI_MIGRATE_BLUEPRINT_FILE")
+
+	blueprintCmd.Flags().String(
+		"output-file",
+		"",
+		"The file to write the migrated blueprint to, the blueprint file is overwritten when not set.",
+	)
+	confProvider.BindPFlag("migrateBlueprintOutputFile", blueprintCmd.Flags().Lookup("output-file"))
+	confProvider.BindEnvVar("migrateBlueprintOutputFile", "BLUELINK_CLI_MIGRATE_BLUEPRINT_OUTPUT_FILE")
+
+	blueprintCmd.Flags().Bool(
+		"dry-run",
+		false,
+		"Print the migrated blueprint instead of writing it to a file.",
+	)
+	confProvider.BindPFlag("migrateBlueprintDryRun", blueprintCmd.Flags().Lookup("dry-run"))
+	confProvider.BindEnvVar("migrateBlueprintDryRun", "BLUELINK_CLI_MIGRATE_BLUEPRINT_DRY_RUN")
+
+	return blueprintCmd
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MigrateCommandSuite struct {
+	suite.Suite
+	tempDir     string
+	originalDir string
+}
+
+func (s *MigrateCommandSuite) SetupTest() {
+	tempDir, err := os.MkdirTemp("", "migrate-cmd-test-*")
+	s.Require().NoError(err)
+	s.tempDir = tempDir
+
+	// Create empty default config file to prevent load errors
+	err = os.WriteFile(filepath.Join(tempDir, "bluelink.config.toml"), []byte(""), 0644)
+	s.Require().NoError(err)
+
+	s.originalDir, err = os.Getwd()
+	s.Require().NoError(err)
+	os.Chdir(tempDir)
+}
+
+func (s *MigrateCommandSuite) TearDownTest() {
+	os.Chdir(s.originalDir)
+	os.RemoveAll(s.tempDir)
+}
+
+// Flag tests
+
+func (s *MigrateCommandSuite) Test_has_blueprint_file_flag() {
+	rootCmd := NewRootCmd()
+	migrateCmd, _, _ := rootCmd.Find([]string{"migrate", "blueprint"})
+
+	flag := migrateCmd.Flag("blueprint-file")
+	s.NotNil(flag)
+	s.Equal("project.blueprint.yaml", flag.DefValue)
+}
+
+func (s *MigrateCommandSuite) Test_has_dry_run_flag() {
+	rootCmd := NewRootCmd()
+	migrateCmd, _, _ := rootCmd.Find([]string{"migrate", "blueprint"})
+
+	flag := migrateCmd.Flag("dry-run")
+	s.NotNil(flag)
+	s.Equal("false", flag.DefValue)
+}
+
+// Behavioural tests
+
+func (s *MigrateCommandSuite) Test_reports_blueprint_already_on_latest_version() {
+	spec := "version: 2025-11-02\nresources:\n  queue:\n    type: aws/sqs/queue\n"
+	err := os.WriteFile("project.blueprint.yaml", []byte(spec), 0644)
+	s.Require().NoError(err)
+
+	rootCmd := NewRootCmd()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"migrate", "blueprint", "--blueprint-file", "project.blueprint.yaml"})
+
+	err = rootCmd.Execute()
+	s.Require().NoError(err)
+	s.Contains(buf.String(), "already uses the latest blueprint specification version (2025-11-02)")
+
+	written, err := os.ReadFile("project.blueprint.yaml")
+	s.Require().NoError(err)
+	s.Equal(spec, string(written))
+}
+
+func (s *MigrateCommandSuite) Test_fails_for_unsupported_blueprint_version() {
+	err := os.WriteFile("project.blueprint.yaml", []byte("version: 2020-01-01\n"), 0644)
+	s.Require().NoError(err)
+
+	rootCmd := NewRootCmd()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"migrate", "blueprint", "--blueprint-file", "project.blueprint.yaml"})
+
+	err = rootCmd.Execute()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "no migration path found")
+}
+
+func TestMigrateCommandSuite(t *testing.T) {
+	suite.Run(t, new(MigrateCommandSuite))
+}
@@ -0,0 +1,214 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/cinotify"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/cobra"
+)
+
+// The deploy engine operations used to load the change sets and
+// blueprint instances that notifications are rendered from.
+type notifyDeployEngine interface {
+	GetChangeset(ctx context.Context, changesetID string) (*manage.Changeset, error)
+	GetBlueprintInstance(ctx context.Context, instanceID string) (*state.InstanceState, error)
+}
+
+func setupNotifyCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	notifyCmd := &cobra.Command{
+		Use:   "notify",
+		Short: "Send deployment notifications to GitHub or GitLab from a CI pipeline",
+		Long: `Sends plan summaries and deployment statuses to the source control platform
+that triggered the current CI pipeline.
+
+GitHub Actions and GitLab CI/CD are supported, the platform is detected from
+the environment variables set by the CI runner.
+A GITHUB_TOKEN or GITLAB_TOKEN environment variable must be set
+with permissions to comment on pull requests and set commit statuses.`,
+	}
+
+	notifyCmd.AddCommand(newNotifyPlanCommand(confProvider))
+	notifyCmd.AddCommand(newNotifyStatusCommand(confProvider))
+	rootCmd.AddCommand(notifyCmd)
+}
+
+func newNotifyPlanCommand(confProvider *config.Provider) *cobra.Command {
+	planCmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Posts a summary of a change set as a pull request comment",
+		Long: `Posts a summary of the changes in a staged change set as a comment
+on the pull request or merge request that triggered the CI pipeline.
+
+Examples:
+  bluelink notify plan --changeset-id 0195c3a4-5d7e-7b3e-9f4a-7c1d2e3f4a5b --instance-name orders-prod`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			changesetID, _ := confProvider.GetString("notifyPlanChangesetID")
+			instanceName, _ := confProvider.GetString("notifyPlanInstanceName")
+			if changesetID == "" {
+				return errors.New("a change set ID must be provided with --changeset-id")
+			}
+
+			notifier, err := cinotify.DetectFromEnv(os.Getenv, nil)
+			if err != nil {
+				return err
+			}
+
+			deployEngine, cleanup, err := createNotifyDeployEngine(confProvider)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			cmd.SilenceUsage = true
+
+			changeset, err := deployEngine.GetChangeset(cmd.Context(), changesetID)
+			if err != nil {
+				return err
+			}
+
+			if changeset.Status != manage.ChangesetStatusChangesStaged {
+				return fmt.Errorf(
+					"change set %q has status %s, changes must be staged before a plan summary can be posted",
+					changesetID,
+					changeset.Status,
+				)
+			}
+
+			summary := cinotify.RenderPlanSummary(instanceName, changeset.Changes)
+			err = notifier.PostPullRequestComment(cmd.Context(), summary)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(fmt.Sprintf("Posted plan summary to %s", notifier.Platform()))
+			return nil
+		},
+	}
+
+	planCmd.Flags().String(
+		"changeset-id",
+		"",
+		"The ID of the staged change set to post a summary for.",
+	)
+	confProvider.BindPFlag("notifyPlanChangesetID", planCmd.Flags().Lookup("changeset-id"))
+	confProvider.BindEnvVar("notifyPlanChangesetID", "BLUELINK_CLI_NOTIFY_PLAN_CHANGESET_ID")
+
+	planCmd.Flags().String(
+		"instance-name",
+		"",
+		"The name of the blueprint instance that the change set is for, used in the summary heading.",
+	)
+	confProvider.BindPFlag("notifyPlanInstanceName", planCmd.Flags().Lookup("instance-name"))
+	confProvider.BindEnvVar("notifyPlanInstanceName", "BLUELINK_CLI_NOTIFY_PLAN_INSTANCE_NAME")
+
+	return planCmd
+}
+
+func newNotifyStatusCommand(confProvider *config.Provider) *cobra.Command {
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Sets a commit status from the status of a blueprint instance deployment",
+		Long: `Sets the status of the commit that triggered the CI pipeline based on
+the current status of a blueprint instance, reporting success when the latest
+deployment succeeded and failure when it failed or was rolled back.
+
+Examples:
+  bluelink notify status --instance-name orders-prod --context deploy/prod`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName, _ := confProvider.GetString("notifyStatusInstanceName")
+			statusContext, _ := confProvider.GetString("notifyStatusContext")
+			targetURL, _ := confProvider.GetString("notifyStatusTargetURL")
+			if instanceName == "" {
+				return errors.New("a blueprint instance name must be provided with --instance-name")
+			}
+
+			notifier, err := cinotify.DetectFromEnv(os.Getenv, nil)
+			if err != nil {
+				return err
+			}
+
+			deployEngine, cleanup, err := createNotifyDeployEngine(confProvider)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			cmd.SilenceUsage = true
+
+			instance, err := deployEngine.GetBlueprintInstance(cmd.Context(), instanceName)
+			if err != nil {
+				return err
+			}
+
+			commitStatus := cinotify.CommitStatusFromInstanceStatus(
+				instanceName,
+				instance.Status,
+				statusContext,
+			)
+			commitStatus.TargetURL = targetURL
+			err = notifier.SetCommitStatus(cmd.Context(), commitStatus)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(fmt.Sprintf(
+				"Set %s commit status to %s",
+				notifier.Platform(),
+				commitStatus.State,
+			))
+			return nil
+		},
+	}
+
+	statusCmd.Flags().String(
+		"instance-name",
+		"",
+		"The name of the blueprint instance to report the deployment status for.",
+	)
+	confProvider.BindPFlag("notifyStatusInstanceName", statusCmd.Flags().Lookup("instance-name"))
+	confProvider.BindEnvVar("notifyStatusInstanceName", "BLUELINK_CLI_NOTIFY_STATUS_INSTANCE_NAME")
+
+	statusCmd.Flags().String(
+		"context",
+		cinotify.DefaultStatusContext,
+		"The name that identifies the commit status, "+
+			"use a different name for each environment that is deployed from the same commit.",
+	)
+	confProvider.BindPFlag("notifyStatusContext", statusCmd.Flags().Lookup("context"))
+	confProvider.BindEnvVar("notifyStatusContext", "BLUELINK_CLI_NOTIFY_STATUS_CONTEXT")
+
+	statusCmd.Flags().String(
+		"target-url",
+		"",
+		"An optional link to more details about the deployment to include in the commit status.",
+	)
+	confProvider.BindPFlag("notifyStatusTargetURL", statusCmd.Flags().Lookup("target-url"))
+	confProvider.BindEnvVar("notifyStatusTargetURL", "BLUELINK_CLI_NOTIFY_STATUS_TARGET_URL")
+
+	return statusCmd
+}
+
+func createNotifyDeployEngine(
+	confProvider *config.Provider,
+) (notifyDeployEngine, func(), error) {
+	logger, handle, err := utils.SetupLogger()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployEngine, err := engine.Create(confProvider, logger)
+	if err != nil {
+		handle.Close()
+		return nil, nil, err
+	}
+
+	return deployEngine, func() { handle.Close() }, nil
+}
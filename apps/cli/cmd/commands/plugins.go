@@ -23,19 +23,24 @@ import (
 var errLoginFailed = errors.New("login failed")
 var errInstallFailed = errors.New("install failed")
 var errUninstallFailed = errors.New("uninstall failed")
+var errReinstallFailed = errors.New("reinstall failed")
 var errListPluginsFailed = errors.New("list plugins failed")
 
 func setupPluginsCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
 	pluginsCmd := &cobra.Command{
 		Use:   "plugins",
 		Short: "Manage plugins and login to plugin registries",
-		Long:  `Commands for managing plugins and signing into plugin registries.`,
+		Long: `Commands for managing plugins, signing into plugin registries and
+viewing the documentation for resource types provided by plugins.`,
 	}
 
 	setupPluginsLoginCommand(pluginsCmd)
 	setupPluginsInstallCommand(pluginsCmd, confProvider)
 	setupPluginsUninstallCommand(pluginsCmd)
+	setupPluginsReinstallCommand(pluginsCmd)
 	setupPluginsListCommand(pluginsCmd, confProvider)
+	setupPluginsWhyCommand(pluginsCmd, confProvider)
+	setupPluginsDocsCommand(pluginsCmd, confProvider)
 
 	rootCmd.AddCommand(pluginsCmd)
 }
@@ -373,6 +378,69 @@ func runPluginsUninstall(args []string) error {
 	return nil
 }
 
+func setupPluginsReinstallCommand(pluginsCmd *cobra.Command) {
+	reinstallCmd := &cobra.Command{
+		Use:   "reinstall <plugin-id> [plugin-id] ...",
+		Short: "Reinstall one or more plugins",
+		Long: `Reinstall plugins that are already installed on the local machine.
+
+The currently installed version of each plugin is removed, downloaded again
+from the registry, verified and installed. This can be used to restore plugins
+that have failed integrity verification when being loaded by the deploy engine.
+
+Plugin IDs can be specified in the following formats:
+  - Default registry: bluelink/aws (resolves to registry.bluelink.dev/bluelink/aws)
+  - Custom registry: registry.example.com/my-org/plugin (full host required)
+
+Examples:
+  # Reinstall a single plugin
+  bluelink plugins reinstall bluelink/aws
+
+  # Reinstall multiple plugins
+  bluelink plugins reinstall bluelink/aws bluelink/gcp`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			return runPluginsReinstall(args)
+		},
+	}
+
+	pluginsCmd.AddCommand(reinstallCmd)
+}
+
+func runPluginsReinstall(args []string) error {
+	manager := createPluginManager()
+
+	installArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		id, err := plugins.ParsePluginID(arg)
+		if err != nil {
+			return fmt.Errorf("invalid plugin ID %q: %w", arg, err)
+		}
+
+		installed, installedPlugin, err := manager.IsInstalled(id)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to check installed plugins: %v\n", err)
+			return errReinstallFailed
+		}
+		if !installed {
+			fmt.Fprintf(os.Stderr, "Error: plugin %s is not installed\n", arg)
+			return errReinstallFailed
+		}
+
+		result := manager.Uninstall(id)
+		if result.Status == plugins.UninstallStatusFailed {
+			fmt.Fprintf(os.Stderr, "Error: failed to remove plugin %s: %v\n", arg, result.Error)
+			return errReinstallFailed
+		}
+
+		installArgs = append(installArgs, id.WithVersion(installedPlugin.Version).String())
+	}
+
+	return runPluginsInstall(installArgs, "")
+}
+
 func setupPluginsListCommand(pluginsCmd *cobra.Command, confProvider *config.Provider) {
 	listCmd := &cobra.Command{
 		Use:   "list",
@@ -478,6 +546,97 @@ func runPluginsList(confProvider *config.Provider) error {
 	return nil
 }
 
+func setupPluginsWhyCommand(pluginsCmd *cobra.Command, confProvider *config.Provider) {
+	whyCmd := &cobra.Command{
+		Use:   "why <plugin-id>",
+		Short: "Explain why a plugin is installed",
+		Long: `Explains why a plugin is installed on the local machine.
+
+Lists the dependencies in the deploy config file (--deploy-config-file flag
+or BLUELINK_CLI_DEPLOY_CONFIG_FILE env var) and the installed plugins that require
+the plugin along with the version constraints they requested.
+A plugin that nothing depends on was installed directly.
+
+Examples:
+  # Explain why the AWS provider plugin is installed
+  bluelink plugins why bluelink/aws
+
+  # Explain why a plugin from a custom registry is installed
+  bluelink plugins why registry.example.com/my-org/custom`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			deployConfigFile, _ := confProvider.GetString("deployConfigFile")
+			return runPluginsWhy(args[0], deployConfigFile)
+		},
+	}
+
+	pluginsCmd.AddCommand(whyCmd)
+}
+
+func runPluginsWhy(pluginIDStr string, deployConfigFile string) error {
+	pluginID, err := plugins.ParsePluginID(pluginIDStr)
+	if err != nil {
+		return fmt.Errorf("invalid plugin ID %q: %w", pluginIDStr, err)
+	}
+
+	// The deploy config is optional as plugins can be installed
+	// outside of a project directory.
+	deployConfig, err := plugins.LoadDeployConfig(deployConfigFile)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		deployConfig = nil
+	}
+
+	manager := createPluginManager()
+	explanation, err := manager.Explain(pluginID, deployConfig, deployConfigFile)
+	if err != nil {
+		return err
+	}
+
+	installedID := pluginID.WithVersion(explanation.Plugin.Version).String()
+	if explanation.IsDirectInstall() {
+		fmt.Fprintf(
+			os.Stdout,
+			"%s was installed directly, it is not required by the deploy config or other installed plugins.\n",
+			installedID,
+		)
+		return nil
+	}
+
+	fmt.Fprintf(os.Stdout, "%s is installed because:\n", installedID)
+	for _, requirement := range explanation.Requirements {
+		fmt.Fprintf(os.Stdout, "  - %s\n", describePluginRequirement(requirement, explanation.Plugin))
+	}
+
+	return nil
+}
+
+func describePluginRequirement(
+	requirement *plugins.Requirement,
+	installed *plugins.InstalledPlugin,
+) string {
+	constraint := requirement.Constraint
+	if constraint == "" {
+		constraint = "any version"
+	}
+	if !requirement.Satisfied {
+		constraint = fmt.Sprintf(
+			"%s, not satisfied by the installed version %s",
+			constraint,
+			installed.Version,
+		)
+	}
+
+	if requirement.Source == plugins.RequirementSourceDeployConfig {
+		return fmt.Sprintf("it is a dependency in %s (%s)", requirement.RequiredBy, constraint)
+	}
+
+	return fmt.Sprintf("it is required by %s (%s)", requirement.RequiredBy, constraint)
+}
+
 func createPluginManager() *plugins.Manager {
 	authStore := registries.NewAuthConfigStore()
 	tokenStore := registries.NewTokenStore()
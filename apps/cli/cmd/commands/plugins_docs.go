@@ -0,0 +1,209 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pluginDocsFormatText     = "text"
+	pluginDocsFormatMarkdown = "markdown"
+)
+
+var supportedPluginDocsFormats = []string{pluginDocsFormatText, pluginDocsFormatMarkdown}
+
+// The deploy engine operation used to retrieve the documentation
+// for a resource type from the plugins loaded by the deploy engine.
+type pluginDocsDeployEngine interface {
+	GetResourceTypeDocs(
+		ctx context.Context,
+		resourceType string,
+	) (*types.ResourceTypeDocsResponse, error)
+}
+
+func setupPluginsDocsCommand(pluginsCmd *cobra.Command, confProvider *config.Provider) {
+	docsCmd := &cobra.Command{
+		Use:   "docs <resource-type>",
+		Short: "Show the documentation and examples for a resource type",
+		Long: `Shows the description and examples for a resource type provided by
+one of the plugins loaded by the deploy engine.
+
+Examples include the runnable blueprint examples attached to resource definitions
+by plugin authors, these are validated against the resource schema in plugin CI.
+The deploy engine must be running with the plugin that provides the resource type
+installed.
+
+Examples:
+  # Show the documentation for the AWS Lambda function resource type
+  bluelink plugins docs aws/lambda/function
+
+  # Write the documentation as markdown to a file
+  bluelink plugins docs aws/lambda/function --format markdown > lambda-function.md`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, _ := confProvider.GetString("pluginsDocsFormat")
+			if err := validatePluginDocsFormat(format); err != nil {
+				return err
+			}
+
+			deployEngine, cleanup, err := createPluginDocsDeployEngine(confProvider)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			cmd.SilenceUsage = true
+			return showResourceTypeDocs(
+				cmd.Context(),
+				deployEngine,
+				args[0],
+				format,
+				cmd.OutOrStdout(),
+			)
+		},
+	}
+
+	docsCmd.Flags().String(
+		"format",
+		pluginDocsFormatText,
+		"The format to output the documentation in, one of: "+
+			strings.Join(supportedPluginDocsFormats, ", ")+".",
+	)
+	confProvider.BindPFlag("pluginsDocsFormat", docsCmd.Flags().Lookup("format"))
+	confProvider.BindEnvVar("pluginsDocsFormat", "BLUELINK_CLI_PLUGINS_DOCS_FORMAT")
+
+	pluginsCmd.AddCommand(docsCmd)
+}
+
+func showResourceTypeDocs(
+	ctx context.Context,
+	deployEngine pluginDocsDeployEngine,
+	resourceType string,
+	format string,
+	output io.Writer,
+) error {
+	docs, err := deployEngine.GetResourceTypeDocs(ctx, resourceType)
+	if err != nil {
+		return err
+	}
+
+	if format == pluginDocsFormatMarkdown {
+		_, err = io.WriteString(output, markdownResourceTypeDocs(docs))
+		return err
+	}
+
+	_, err = io.WriteString(output, plainTextResourceTypeDocs(docs))
+	return err
+}
+
+func plainTextResourceTypeDocs(docs *types.ResourceTypeDocsResponse) string {
+	var sb strings.Builder
+	sb.WriteString(docs.Type)
+	sb.WriteString("\n")
+
+	description := firstNonEmpty(docs.PlainTextDescription, docs.PlainTextSummary)
+	if description != "" {
+		sb.WriteString("\n")
+		sb.WriteString(strings.TrimSpace(description))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\nExamples\n")
+	if len(docs.PlainTextExamples) == 0 {
+		sb.WriteString("\nNo examples are available for this resource type.\n")
+		return sb.String()
+	}
+
+	for i, example := range docs.PlainTextExamples {
+		sb.WriteString(fmt.Sprintf("\n%d. ", i+1))
+		sb.WriteString(strings.TrimSpace(example))
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+func markdownResourceTypeDocs(docs *types.ResourceTypeDocsResponse) string {
+	var sb strings.Builder
+	sb.WriteString("# ")
+	sb.WriteString(docs.Type)
+	sb.WriteString("\n")
+
+	description := firstNonEmpty(docs.MarkdownDescription, docs.MarkdownSummary)
+	if description != "" {
+		sb.WriteString("\n")
+		sb.WriteString(strings.TrimSpace(description))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n## Examples\n")
+	if len(docs.MarkdownExamples) == 0 {
+		sb.WriteString("\nNo examples are available for this resource type.\n")
+		return sb.String()
+	}
+
+	for _, example := range docs.MarkdownExamples {
+		sb.WriteString("\n")
+		sb.WriteString(strings.TrimSpace(example))
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func validatePluginDocsFormat(format string) error {
+	if !slices.Contains(supportedPluginDocsFormats, format) {
+		return fmt.Errorf(
+			"unsupported docs format %q, expected one of: %s",
+			format,
+			strings.Join(supportedPluginDocsFormats, ", "),
+		)
+	}
+
+	return nil
+}
+
+func createPluginDocsDeployEngine(
+	confProvider *config.Provider,
+) (pluginDocsDeployEngine, func(), error) {
+	logger, handle, err := utils.SetupLogger()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployEngine, err := engine.Create(confProvider, logger)
+	if err != nil {
+		handle.Close()
+		return nil, nil, err
+	}
+
+	docsEngine, supportsDocs := deployEngine.(pluginDocsDeployEngine)
+	if !supportsDocs {
+		handle.Close()
+		return nil, nil, errors.New(
+			"the deploy engine client does not support retrieving resource type documentation",
+		)
+	}
+
+	return docsEngine, func() { handle.Close() }, nil
+}
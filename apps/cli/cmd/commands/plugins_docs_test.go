@@ -0,0 +1,185 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type PluginsDocsCommandSuite struct {
+	suite.Suite
+}
+
+func (s *PluginsDocsCommandSuite) Test_plugins_docs_command_is_registered_with_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"plugins", "docs"})
+	s.Require().NoError(err)
+	s.Equal("docs <resource-type>", cmd.Use)
+
+	s.NotNil(cmd.Flag("format"), "expected the --format flag")
+	s.Equal("text", cmd.Flag("format").DefValue)
+}
+
+func (s *PluginsDocsCommandSuite) Test_plugins_docs_requires_resource_type_argument() {
+	rootCmd := NewRootCmd()
+	cmd, _, err := rootCmd.Find([]string{"plugins", "docs"})
+	s.Require().NoError(err)
+
+	s.Error(cmd.Args(cmd, []string{}))
+	s.Error(cmd.Args(cmd, []string{"aws/lambda/function", "aws/dynamodb/table"}))
+	s.NoError(cmd.Args(cmd, []string{"aws/lambda/function"}))
+}
+
+func (s *PluginsDocsCommandSuite) Test_fails_for_unsupported_format() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"plugins", "docs", "aws/lambda/function", "--format", "html"})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "unsupported docs format \"html\", expected one of: text, markdown")
+}
+
+func (s *PluginsDocsCommandSuite) Test_writes_resource_type_docs_as_plain_text() {
+	engine := &stubPluginDocsDeployEngine{
+		response: testResourceTypeDocs(),
+	}
+	output := &bytes.Buffer{}
+
+	err := showResourceTypeDocs(
+		context.Background(),
+		engine,
+		"aws/lambda/function",
+		"text",
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal("aws/lambda/function", engine.receivedResourceType)
+	s.Equal(
+		`aws/lambda/function
+
+A Lambda function that runs code in response to events.
+
+Examples
+
+1. Basic function
+
+resources:
+  handler:
+    type: aws/lambda/function
+`,
+		output.String(),
+	)
+}
+
+func (s *PluginsDocsCommandSuite) Test_writes_resource_type_docs_as_markdown() {
+	engine := &stubPluginDocsDeployEngine{
+		response: testResourceTypeDocs(),
+	}
+	output := &bytes.Buffer{}
+
+	err := showResourceTypeDocs(
+		context.Background(),
+		engine,
+		"aws/lambda/function",
+		"markdown",
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal(
+		"# aws/lambda/function\n\n"+
+			"A **Lambda** function that runs code in response to events.\n\n"+
+			"## Examples\n\n"+
+			"**Basic function**\n\n"+
+			"```yaml\nresources:\n  handler:\n    type: aws/lambda/function\n```\n",
+		output.String(),
+	)
+}
+
+func (s *PluginsDocsCommandSuite) Test_reports_resource_type_without_examples() {
+	engine := &stubPluginDocsDeployEngine{
+		response: &types.ResourceTypeDocsResponse{
+			Type:             "aws/sqs/queue",
+			PlainTextSummary: "An SQS queue.",
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := showResourceTypeDocs(
+		context.Background(),
+		engine,
+		"aws/sqs/queue",
+		"text",
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal(
+		`aws/sqs/queue
+
+An SQS queue.
+
+Examples
+
+No examples are available for this resource type.
+`,
+		output.String(),
+	)
+}
+
+func (s *PluginsDocsCommandSuite) Test_returns_deploy_engine_error() {
+	engine := &stubPluginDocsDeployEngine{
+		err: errors.New("resource type \"aws/sqs/queue\" not found in the loaded plugins"),
+	}
+	output := &bytes.Buffer{}
+
+	err := showResourceTypeDocs(
+		context.Background(),
+		engine,
+		"aws/sqs/queue",
+		"text",
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal("resource type \"aws/sqs/queue\" not found in the loaded plugins", err.Error())
+	s.Empty(output.String())
+}
+
+func testResourceTypeDocs() *types.ResourceTypeDocsResponse {
+	return &types.ResourceTypeDocsResponse{
+		Type:                 "aws/lambda/function",
+		PlainTextSummary:     "A Lambda function.",
+		MarkdownSummary:      "A **Lambda** function.",
+		PlainTextDescription: "A Lambda function that runs code in response to events.",
+		MarkdownDescription:  "A **Lambda** function that runs code in response to events.",
+		PlainTextExamples: []string{
+			"Basic function\n\nresources:\n  handler:\n    type: aws/lambda/function",
+		},
+		MarkdownExamples: []string{
+			"**Basic function**\n\n```yaml\nresources:\n  handler:\n    type: aws/lambda/function\n```",
+		},
+	}
+}
+
+type stubPluginDocsDeployEngine struct {
+	response             *types.ResourceTypeDocsResponse
+	err                  error
+	receivedResourceType string
+}
+
+func (e *stubPluginDocsDeployEngine) GetResourceTypeDocs(
+	ctx context.Context,
+	resourceType string,
+) (*types.ResourceTypeDocsResponse, error) {
+	e.receivedResourceType = resourceType
+	return e.response, e.err
+}
+
+func TestPluginsDocsCommandSuite(t *testing.T) {
+	suite.Run(t, new(PluginsDocsCommandSuite))
+}
@@ -182,6 +182,30 @@ func (s *PluginsCommandSuite) Test_plugins_uninstall_accepts_multiple_arguments(
 	s.NoError(err)
 }
 
+// Reinstall command tests
+
+func (s *PluginsCommandSuite) Test_plugins_reinstall_command_exists() {
+	rootCmd := NewRootCmd()
+	reinstallCmd, _, err := rootCmd.Find([]string{"plugins", "reinstall"})
+
+	s.NoError(err)
+	s.NotNil(reinstallCmd)
+	s.Equal("reinstall <plugin-id> [plugin-id] ...", reinstallCmd.Use)
+}
+
+func (s *PluginsCommandSuite) Test_plugins_reinstall_requires_at_least_one_argument() {
+	rootCmd := NewRootCmd()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"plugins", "reinstall"})
+
+	err := rootCmd.Execute()
+
+	s.Error(err)
+	s.Contains(err.Error(), "requires at least 1 arg")
+}
+
 // List command tests
 
 func (s *PluginsCommandSuite) Test_plugins_list_command_exists() {
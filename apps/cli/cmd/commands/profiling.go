@@ -0,0 +1,150 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/profiling"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/spf13/cobra"
+)
+
+// profiledCommand holds the path of a long-running command that
+// profiling and timing flags are added to along with the prefixes
+// used for its config keys and environment variables.
+type profiledCommand struct {
+	path            []string
+	configKeyPrefix string
+	envVarPrefix    string
+}
+
+var profiledCommands = []profiledCommand{
+	{path: []string{"validate"}, configKeyPrefix: "validate", envVarPrefix: "VALIDATE"},
+	{path: []string{"stage"}, configKeyPrefix: "stage", envVarPrefix: "STAGE"},
+	{path: []string{"deploy"}, configKeyPrefix: "deploy", envVarPrefix: "DEPLOY"},
+	{path: []string{"destroy"}, configKeyPrefix: "destroy", envVarPrefix: "DESTROY"},
+	{path: []string{"stacks", "deploy"}, configKeyPrefix: "stacksDeploy", envVarPrefix: "STACKS_DEPLOY"},
+}
+
+// Adds the --profile-cpu, --profile-mem, --trace and --timings flags
+// to long-running commands. This must be called after all commands
+// have been added to the root command.
+func setupProfiling(rootCmd *cobra.Command, confProvider *config.Provider) {
+	for _, profiled := range profiledCommands {
+		cmd, _, err := rootCmd.Find(profiled.path)
+		if err != nil || cmd == rootCmd || cmd.RunE == nil {
+			continue
+		}
+
+		addProfilingFlags(cmd, confProvider, profiled)
+		cmd.RunE = withProfiling(cmd.RunE, confProvider, profiled.configKeyPrefix)
+	}
+}
+
+func addProfilingFlags(
+	cmd *cobra.Command,
+	confProvider *config.Provider,
+	profiled profiledCommand,
+) {
+	cmd.Flags().String(
+		"profile-cpu",
+		"",
+		"Write a pprof CPU profile for the command to the provided file path. "+
+			"The profile covers the work carried out by the CLI process, "+
+			"not the deploy engine.",
+	)
+	bindProfilingFlag(cmd, confProvider, profiled, "profile-cpu", "ProfileCPU", "PROFILE_CPU")
+
+	cmd.Flags().String(
+		"profile-mem",
+		"",
+		"Write a pprof heap profile to the provided file path when the command finishes.",
+	)
+	bindProfilingFlag(cmd, confProvider, profiled, "profile-mem", "ProfileMem", "PROFILE_MEM")
+
+	cmd.Flags().String(
+		"trace",
+		"",
+		"Write a runtime execution trace for the command to the provided file path, "+
+			"the trace can be inspected with \"go tool trace\".",
+	)
+	bindProfilingFlag(cmd, confProvider, profiled, "trace", "TraceFile", "TRACE")
+
+	cmd.Flags().Bool(
+		"timings",
+		false,
+		"Print a breakdown of the time spent in each phase of the command when it finishes, "+
+			"including the time spent deploying resources for each provider where available. "+
+			"The breakdown is written to stderr.",
+	)
+	bindProfilingFlag(cmd, confProvider, profiled, "timings", "Timings", "TIMINGS")
+}
+
+func bindProfilingFlag(
+	cmd *cobra.Command,
+	confProvider *config.Provider,
+	profiled profiledCommand,
+	flagName string,
+	configKeySuffix string,
+	envVarSuffix string,
+) {
+	configKey := profiled.configKeyPrefix + configKeySuffix
+	confProvider.BindPFlag(configKey, cmd.Flags().Lookup(flagName))
+	confProvider.BindEnvVar(
+		configKey,
+		fmt.Sprintf("BLUELINK_CLI_%s_%s", profiled.envVarPrefix, envVarSuffix),
+	)
+}
+
+// Wraps the run function of a command to collect the profiling data
+// and timings requested with the profiling flags.
+// Timings are made available to the command through its context,
+// when a command does not track its own phases, the time spent running
+// the command is reported as a single phase named after the command.
+func withProfiling(
+	runE func(cmd *cobra.Command, args []string) error,
+	confProvider *config.Provider,
+	configKeyPrefix string,
+) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		cpuProfileFile, _ := confProvider.GetString(configKeyPrefix + "ProfileCPU")
+		memProfileFile, _ := confProvider.GetString(configKeyPrefix + "ProfileMem")
+		traceFile, _ := confProvider.GetString(configKeyPrefix + "TraceFile")
+		opts := profiling.Options{
+			CPUProfileFile: strings.TrimSpace(cpuProfileFile),
+			MemProfileFile: strings.TrimSpace(memProfileFile),
+			TraceFile:      strings.TrimSpace(traceFile),
+		}
+
+		if opts.Enabled() {
+			session, err := profiling.Start(opts)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if stopErr := session.Stop(); stopErr != nil {
+					cmd.PrintErrln(fmt.Sprintf("failed to write profiling data: %s", stopErr))
+				}
+			}()
+		}
+
+		showTimings, _ := confProvider.GetBool(configKeyPrefix + "Timings")
+		if !showTimings {
+			return runE(cmd, args)
+		}
+
+		timings := profiling.NewTimings()
+		cmd.SetContext(profiling.ContextWithTimings(cmd.Context(), timings))
+
+		start := time.Now()
+		err := runE(cmd, args)
+		if !timings.HasPhases() {
+			timings.AddPhaseTime(cmd.Name(), time.Since(start))
+		}
+
+		cmd.PrintErrln()
+		timings.WriteReport(cmd.ErrOrStderr())
+		return err
+	}
+}
@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/profiling"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProfilingCommandSuite struct {
+	suite.Suite
+	tempDir string
+}
+
+func (s *ProfilingCommandSuite) SetupTest() {
+	s.tempDir = s.T().TempDir()
+}
+
+func (s *ProfilingCommandSuite) Test_long_running_commands_have_profiling_flags() {
+	rootCmd := NewRootCmd()
+
+	for _, profiled := range profiledCommands {
+		cmd, _, err := rootCmd.Find(profiled.path)
+		s.Require().NoError(err)
+
+		for _, flagName := range []string{"profile-cpu", "profile-mem", "trace", "timings"} {
+			s.NotNil(cmd.Flag(flagName), "expected %q to have the --%s flag", cmd.CommandPath(), flagName)
+		}
+	}
+}
+
+func (s *ProfilingCommandSuite) Test_writes_profiles_and_tracked_phase_timings() {
+	cpuProfileFile := filepath.Join(s.tempDir, "cpu.pprof")
+	memProfileFile := filepath.Join(s.tempDir, "mem.pprof")
+	cmd, stderr := s.createProfiledCommand(func(cmd *cobra.Command, args []string) error {
+		timings := profiling.TimingsFromContext(cmd.Context())
+		timings.Track(profiling.PhaseParse)()
+		timings.Track(profiling.PhaseDeploy)()
+		return nil
+	})
+	cmd.SetArgs([]string{
+		"--profile-cpu", cpuProfileFile,
+		"--profile-mem", memProfileFile,
+		"--timings",
+	})
+
+	err := cmd.Execute()
+	s.Require().NoError(err)
+
+	s.FileExists(cpuProfileFile)
+	s.FileExists(memProfileFile)
+	s.Contains(stderr.String(), "Timings:")
+	s.Contains(stderr.String(), "  parse ")
+	s.Contains(stderr.String(), "  deploy ")
+	s.NotContains(stderr.String(), "  run ")
+}
+
+func (s *ProfilingCommandSuite) Test_reports_command_as_single_phase_when_no_phases_are_tracked() {
+	cmd, stderr := s.createProfiledCommand(func(cmd *cobra.Command, args []string) error {
+		return nil
+	})
+	cmd.SetArgs([]string{"--timings"})
+
+	err := cmd.Execute()
+	s.Require().NoError(err)
+	s.Contains(stderr.String(), "  run ")
+	s.Contains(stderr.String(), "100.0%")
+
+	entries, err := os.ReadDir(s.tempDir)
+	s.Require().NoError(err)
+	s.Empty(entries)
+}
+
+func (s *ProfilingCommandSuite) Test_does_not_report_timings_when_not_requested() {
+	cmd, stderr := s.createProfiledCommand(func(cmd *cobra.Command, args []string) error {
+		s.Nil(profiling.TimingsFromContext(cmd.Context()))
+		return nil
+	})
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+	s.Require().NoError(err)
+	s.Empty(stderr.String())
+}
+
+func (s *ProfilingCommandSuite) createProfiledCommand(
+	runE func(cmd *cobra.Command, args []string) error,
+) (*cobra.Command, *bytes.Buffer) {
+	confProvider := config.NewProvider()
+	cmd := &cobra.Command{
+		Use:  "run",
+		RunE: runE,
+	}
+	profiled := profiledCommand{
+		path:            []string{"run"},
+		configKeyPrefix: "testRun",
+		envVarPrefix:    "TEST_RUN",
+	}
+	addProfilingFlags(cmd, confProvider, profiled)
+	cmd.RunE = withProfiling(cmd.RunE, confProvider, profiled.configKeyPrefix)
+
+	stderr := &bytes.Buffer{}
+	cmd.SetErr(stderr)
+	cmd.SetOut(&bytes.Buffer{})
+	return cmd, stderr
+}
+
+func TestProfilingCommandSuite(t *testing.T) {
+	suite.Run(t, new(ProfilingCommandSuite))
+}
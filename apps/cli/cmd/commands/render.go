@@ -0,0 +1,309 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/project"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/resourceimport"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	renderFormatJSON = "json"
+	renderFormatYAML = "yaml"
+)
+
+var supportedRenderFormats = []string{renderFormatYAML, renderFormatJSON}
+
+// The deploy engine operation used to render
+// a fully resolved version of a blueprint.
+type renderDeployEngine interface {
+	RenderBlueprint(
+		ctx context.Context,
+		payload *types.RenderBlueprintPayload,
+	) (*container.RenderedBlueprint, error)
+}
+
+func setupRenderCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	renderCmd := &cobra.Command{
+		Use:   "render",
+		Short: "Outputs the fully resolved version of a blueprint",
+		Long: `Outputs the fully resolved version of a blueprint where transforms have been applied,
+resource templates have been expanded, resource spec defaults have been populated,
+resource conditions have been applied and substitutions have been resolved where possible.
+
+Values that can only be known after deployment, such as computed fields of resources
+that have not been deployed yet, are rendered as "` + container.KnownOnDeployPlaceholder + `"
+and the paths to them are listed under "resolveOnDeploy".
+Secret variables and values are rendered as "` + container.RedactedSecretPlaceholder + `".
+
+This is useful for debugging substitutions and for feeding the resolved blueprint
+to external policy tools.
+When --trace is set, every reference lookup and function call carried out to resolve
+each field is written to stderr along with the inputs and outputs of each step.
+
+Examples:
+  # Render the blueprint as YAML
+  bluelink render
+
+  # Write the rendered blueprint as JSON to a file
+  bluelink render --format json --output rendered.json
+
+  # Show how each field was resolved
+  bluelink render --trace --output rendered.yml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			blueprintFile, _ := confProvider.GetString("renderBlueprintFile")
+			format, _ := confProvider.GetString("renderFormat")
+			outputFile, _ := confProvider.GetString("renderOutputFile")
+			deployConfigFile, _ := confProvider.GetString("deployConfigFile")
+			trace, _ := confProvider.GetBool("renderTrace")
+
+			if err := validateRenderFormat(format); err != nil {
+				return err
+			}
+
+			operationConfig, err := resourceimport.LoadOperationConfig(deployConfigFile)
+			if err != nil {
+				return err
+			}
+
+			documentInfo, err := importDocumentInfo(blueprintFile)
+			if err != nil {
+				return err
+			}
+
+			deployEngine, cleanup, err := createRenderDeployEngine(confProvider)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			cmd.SilenceUsage = true
+
+			output := cmd.OutOrStdout()
+			if outputFile != "" {
+				file, err := os.Create(outputFile)
+				if err != nil {
+					return fmt.Errorf("failed to create render output file: %w", err)
+				}
+				defer file.Close()
+				output = file
+			}
+
+			return renderBlueprint(
+				cmd.Context(),
+				deployEngine,
+				&types.RenderBlueprintPayload{
+					BlueprintDocumentInfo: documentInfo,
+					Config:                operationConfig,
+					Trace:                 trace,
+				},
+				format,
+				output,
+				cmd.ErrOrStderr(),
+			)
+		},
+	}
+
+	renderCmd.Flags().String(
+		"blueprint-file",
+		project.DetectBlueprintFile("."),
+		"The blueprint file to render.",
+	)
+	confProvider.BindPFlag("renderBlueprintFile", renderCmd.Flags().Lookup("blueprint-file"))
+	confProvider.BindEnvVar("renderBlueprintFile", "BLUELINK_CLI_RENDER_BLUEPRINT_FILE")
+
+	renderCmd.Flags().String(
+		"format",
+		renderFormatYAML,
+		"The format to output the rendered blueprint in, one of: "+
+			strings.Join(supportedRenderFormats, ", ")+".",
+	)
+	confProvider.BindPFlag("renderFormat", renderCmd.Flags().Lookup("format"))
+	confProvider.BindEnvVar("renderFormat", "BLUELINK_CLI_RENDER_FORMAT")
+
+	renderCmd.Flags().String(
+		"output",
+		"",
+		"The file to write the rendered blueprint to, "+
+			"the rendered blueprint is written to stdout when not provided.",
+	)
+	confProvider.BindPFlag("renderOutputFile", renderCmd.Flags().Lookup("output"))
+	confProvider.BindEnvVar("renderOutputFile", "BLUELINK_CLI_RENDER_OUTPUT_FILE")
+
+	renderCmd.Flags().Bool(
+		"trace",
+		false,
+		"Write the reference lookups and function calls carried out to resolve "+
+			"each field to stderr.",
+	)
+	confProvider.BindPFlag("renderTrace", renderCmd.Flags().Lookup("trace"))
+	confProvider.BindEnvVar("renderTrace", "BLUELINK_CLI_RENDER_TRACE")
+
+	rootCmd.AddCommand(renderCmd)
+}
+
+func renderBlueprint(
+	ctx context.Context,
+	deployEngine renderDeployEngine,
+	payload *types.RenderBlueprintPayload,
+	format string,
+	output io.Writer,
+	traceOutput io.Writer,
+) error {
+	rendered, err := deployEngine.RenderBlueprint(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	// The trace is written separately so the rendered blueprint
+	// has the same structure whether or not tracing is enabled.
+	trace := rendered.Trace
+	rendered.Trace = nil
+	if payload.Trace {
+		if err := writeRenderTrace(trace, traceOutput); err != nil {
+			return fmt.Errorf("failed to write substitution trace: %w", err)
+		}
+	}
+
+	renderedBytes, err := json.MarshalIndent(rendered, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode rendered blueprint: %w", err)
+	}
+
+	if format == renderFormatYAML {
+		renderedBytes, err = jsonToYAML(renderedBytes)
+		if err != nil {
+			return fmt.Errorf("failed to encode rendered blueprint: %w", err)
+		}
+	} else {
+		renderedBytes = append(renderedBytes, '\n')
+	}
+
+	_, err = output.Write(renderedBytes)
+	return err
+}
+
+// Writes the steps carried out to resolve each field in the blueprint
+// in a readable format, where nested steps are indented under the step
+// that depends on them.
+func writeRenderTrace(
+	trace map[string][]*subengine.SubstitutionTraceEntry,
+	output io.Writer,
+) error {
+	fieldPaths := slices.Sorted(maps.Keys(trace))
+	for _, fieldPath := range fieldPaths {
+		if _, err := fmt.Fprintln(output, fieldPath); err != nil {
+			return err
+		}
+
+		for _, entry := range trace[fieldPath] {
+			if err := writeRenderTraceEntry(entry, output); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func writeRenderTraceEntry(
+	entry *subengine.SubstitutionTraceEntry,
+	output io.Writer,
+) error {
+	indent := strings.Repeat("  ", entry.Depth+1)
+	result := "-> " + traceValueString(entry.Output)
+	if entry.Error != "" {
+		result = "failed: " + entry.Error
+	}
+
+	if _, err := fmt.Fprintf(output, "%s%s %s\n", indent, entry.Expression, result); err != nil {
+		return err
+	}
+
+	if len(entry.Inputs) == 0 {
+		return nil
+	}
+
+	inputs := make([]string, len(entry.Inputs))
+	for i, input := range entry.Inputs {
+		inputs[i] = traceValueString(input)
+	}
+	_, err := fmt.Fprintf(output, "%s  inputs: %s\n", indent, strings.Join(inputs, ", "))
+	return err
+}
+
+func traceValueString(value *core.MappingNode) string {
+	if value == nil {
+		return "(none)"
+	}
+
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return core.StringValue(value)
+	}
+
+	return string(valueBytes)
+}
+
+// The rendered blueprint is converted from JSON so the output
+// uses the same field names and value representations for both formats.
+func jsonToYAML(jsonBytes []byte) ([]byte, error) {
+	var value any
+	if err := json.Unmarshal(jsonBytes, &value); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(value)
+}
+
+func validateRenderFormat(format string) error {
+	if !slices.Contains(supportedRenderFormats, format) {
+		return fmt.Errorf(
+			"unsupported render format %q, expected one of: %s",
+			format,
+			strings.Join(supportedRenderFormats, ", "),
+		)
+	}
+
+	return nil
+}
+
+func createRenderDeployEngine(
+	confProvider *config.Provider,
+) (renderDeployEngine, func(), error) {
+	logger, handle, err := utils.SetupLogger()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployEngine, err := engine.Create(confProvider, logger)
+	if err != nil {
+		handle.Close()
+		return nil, nil, err
+	}
+
+	renderEngine, supportsRender := deployEngine.(renderDeployEngine)
+	if !supportsRender {
+		handle.Close()
+		return nil, nil, errors.New("the deploy engine client does not support rendering blueprints")
+	}
+
+	return renderEngine, func() { handle.Close() }, nil
+}
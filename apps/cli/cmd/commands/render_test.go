@@ -0,0 +1,219 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type RenderCommandSuite struct {
+	suite.Suite
+}
+
+func (s *RenderCommandSuite) Test_render_command_is_registered_with_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"render"})
+	s.Require().NoError(err)
+	s.Equal("render", cmd.Name())
+
+	for _, flagName := range []string{"blueprint-file", "format", "output", "trace"} {
+		s.NotNil(cmd.Flag(flagName), "expected the --%s flag", flagName)
+	}
+	s.Equal("yaml", cmd.Flag("format").DefValue)
+}
+
+func (s *RenderCommandSuite) Test_fails_for_unsupported_format() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"render", "--format", "toml"})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "unsupported render format \"toml\", expected one of: yaml, json")
+}
+
+func (s *RenderCommandSuite) Test_writes_rendered_blueprint_as_yaml() {
+	engine := &stubRenderDeployEngine{
+		response: testRenderedBlueprint(),
+	}
+	output := &bytes.Buffer{}
+
+	err := renderBlueprint(
+		context.Background(),
+		engine,
+		&types.RenderBlueprintPayload{},
+		"yaml",
+		output,
+		&bytes.Buffer{},
+	)
+	s.Require().NoError(err)
+	s.NotNil(engine.receivedPayload)
+	s.Equal(
+		`resolveOnDeploy:
+    - resources.ordersTable.spec.arn
+resources:
+    ordersTable:
+        spec:
+            arn: (known on deploy)
+            tableName: orders
+        type: aws/dynamodb/table
+version: "2025-11-02"
+`,
+		output.String(),
+	)
+}
+
+func (s *RenderCommandSuite) Test_writes_rendered_blueprint_as_json() {
+	engine := &stubRenderDeployEngine{
+		response: testRenderedBlueprint(),
+	}
+	output := &bytes.Buffer{}
+
+	err := renderBlueprint(
+		context.Background(),
+		engine,
+		&types.RenderBlueprintPayload{},
+		"json",
+		output,
+		&bytes.Buffer{},
+	)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{
+			"version": "2025-11-02",
+			"resources": {
+				"ordersTable": {
+					"type": "aws/dynamodb/table",
+					"spec": {
+						"arn": "(known on deploy)",
+						"tableName": "orders"
+					}
+				}
+			},
+			"resolveOnDeploy": ["resources.ordersTable.spec.arn"]
+		}`,
+		output.String(),
+	)
+}
+
+func (s *RenderCommandSuite) Test_writes_substitution_trace_separately_from_rendered_blueprint() {
+	rendered := testRenderedBlueprint()
+	rendered.Trace = map[string][]*subengine.SubstitutionTraceEntry{
+		"resources.ordersTable.spec.tableName": {
+			{
+				Type:       subengine.SubstitutionTraceEntryTypeFunctionCall,
+				Expression: "to_lower(variables.tableName)",
+				Depth:      0,
+				Inputs:     []*core.MappingNode{core.MappingNodeFromString("Orders")},
+				Output:     core.MappingNodeFromString("orders"),
+			},
+			{
+				Type:       subengine.SubstitutionTraceEntryTypeReference,
+				Expression: "variables.tableName",
+				Depth:      1,
+				Output:     core.MappingNodeFromString("Orders"),
+			},
+		},
+		"resources.ordersTable.spec.arn": {
+			{
+				Type:       subengine.SubstitutionTraceEntryTypeReference,
+				Expression: "resources.ordersTable.spec.id",
+				Error:      "can only be resolved during deployment",
+			},
+		},
+	}
+	engine := &stubRenderDeployEngine{
+		response: rendered,
+	}
+	output := &bytes.Buffer{}
+	traceOutput := &bytes.Buffer{}
+
+	err := renderBlueprint(
+		context.Background(),
+		engine,
+		&types.RenderBlueprintPayload{Trace: true},
+		"json",
+		output,
+		traceOutput,
+	)
+	s.Require().NoError(err)
+	s.NotContains(output.String(), "\"trace\"")
+	s.Equal(
+		`resources.ordersTable.spec.arn
+  resources.ordersTable.spec.id failed: can only be resolved during deployment
+resources.ordersTable.spec.tableName
+  to_lower(variables.tableName) -> "orders"
+    inputs: "Orders"
+    variables.tableName -> "Orders"
+`,
+		traceOutput.String(),
+	)
+}
+
+func (s *RenderCommandSuite) Test_returns_error_from_deploy_engine() {
+	engine := &stubRenderDeployEngine{
+		err: errors.New("failed to load blueprint"),
+	}
+	output := &bytes.Buffer{}
+
+	err := renderBlueprint(
+		context.Background(),
+		engine,
+		&types.RenderBlueprintPayload{},
+		"yaml",
+		output,
+		&bytes.Buffer{},
+	)
+	s.Require().Error(err)
+	s.Equal("failed to load blueprint", err.Error())
+	s.Empty(output.String())
+}
+
+func testRenderedBlueprint() *container.RenderedBlueprint {
+	return &container.RenderedBlueprint{
+		Version: "2025-11-02",
+		Resources: map[string]*provider.ResolvedResource{
+			"ordersTable": {
+				Type: &schema.ResourceTypeWrapper{
+					Value: "aws/dynamodb/table",
+				},
+				Spec: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"tableName": core.MappingNodeFromString("orders"),
+						"arn":       core.MappingNodeFromString(container.KnownOnDeployPlaceholder),
+					},
+				},
+			},
+		},
+		ResolveOnDeploy: []string{"resources.ordersTable.spec.arn"},
+	}
+}
+
+type stubRenderDeployEngine struct {
+	response        *container.RenderedBlueprint
+	err             error
+	receivedPayload *types.RenderBlueprintPayload
+}
+
+func (e *stubRenderDeployEngine) RenderBlueprint(
+	ctx context.Context,
+	payload *types.RenderBlueprintPayload,
+) (*container.RenderedBlueprint, error) {
+	e.receivedPayload = payload
+	return e.response, e.err
+}
+
+func TestRenderCommandSuite(t *testing.T) {
+	suite.Run(t, new(RenderCommandSuite))
+}
@@ -129,14 +129,30 @@ This CLI validates, stages changes for, and deploys blueprints.`,
 	setupVersionCommand(rootCmd)
 	setupInitCommand(rootCmd, confProvider)
 	setupValidateCommand(rootCmd, confProvider)
+	setupExplainCommand(rootCmd, confProvider)
+	setupMigrateCommand(rootCmd, confProvider)
+	setupDiffCommand(rootCmd, confProvider)
 	sdkcommands.SetupStageCommand(rootCmd, confProvider, cliConfig)
 	sdkcommands.SetupDeployCommand(rootCmd, confProvider, cliConfig)
+	setupStacksCommand(rootCmd, confProvider)
+	setupNotifyCommand(rootCmd, confProvider)
+	setupImportCommand(rootCmd, confProvider)
+	setupGraphCommand(rootCmd, confProvider)
+	setupRenderCommand(rootCmd, confProvider)
+	setupConsoleCommand(rootCmd, confProvider)
+	setupExportsCommand(rootCmd, confProvider)
+	setupDriftCommand(rootCmd, confProvider)
 	sdkcommands.SetupDestroyCommand(rootCmd, confProvider, cliConfig)
 	sdkcommands.SetupInstancesCommand(rootCmd, confProvider, cliConfig)
 	sdkcommands.SetupStateCommand(rootCmd, confProvider, cliConfig)
+	setupStateMigrateCommand(rootCmd, confProvider)
+	setupStateShowCommand(rootCmd, confProvider)
 	sdkcommands.SetupCleanupCommand(rootCmd, confProvider, cliConfig)
 	setupPluginsCommand(rootCmd, confProvider)
 	setupTemplatesCommand(rootCmd, confProvider)
+	setupStageOptions(rootCmd, confProvider)
+	setupDeployOptions(rootCmd, confProvider)
+	setupProfiling(rootCmd, confProvider)
 
 	return rootCmd
 }
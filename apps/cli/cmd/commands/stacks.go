@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/hooks"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/profiling"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/stacks"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+func setupStacksCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	stacksCmd := &cobra.Command{
+		Use:   "stacks",
+		Short: "Manage groups of blueprint instances that depend on each other",
+	}
+
+	stacksCmd.AddCommand(newStacksDeployCommand(confProvider))
+	rootCmd.AddCommand(stacksCmd)
+}
+
+func newStacksDeployCommand(confProvider *config.Provider) *cobra.Command {
+	deployCmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploys the blueprint instances declared in a stack file",
+		Long: `Deploys each of the blueprints declared in a stack file to its own blueprint instance.
+Blueprints are deployed in dependency order, exports from deployed blueprint instances
+are passed as variables to the blueprints that depend on them.
+
+When a blueprint fails to deploy, blueprints that depend on it are skipped
+and independent blueprints continue to be deployed.
+
+Examples:
+  # Deploy the stacks declared in the default stack file
+  bluelink stacks deploy
+
+  # Deploy the stacks declared in a specific stack file
+  bluelink stacks deploy --stack-file infra/bluelink.stacks.yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stackFilePath, _ := confProvider.GetString("stacksDeployStackFile")
+			timings := profiling.TimingsFromContext(cmd.Context())
+
+			stopParseTiming := timings.Track(profiling.PhaseParse)
+			stackFile, err := stacks.LoadFile(afero.NewOsFs(), stackFilePath)
+			stopParseTiming()
+			if err != nil {
+				return err
+			}
+
+			logger, handle, err := utils.SetupLogger()
+			if err != nil {
+				return err
+			}
+			defer handle.Close()
+
+			deployEngine, err := engine.Create(confProvider, logger)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			orchestrator := stacks.NewOrchestrator(
+				deployEngine,
+				stackFile,
+				stackOrchestratorOptions(cmd, confProvider)...,
+			)
+			result, err := orchestrator.Deploy(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			printStacksResult(cmd, result)
+			if result.HasFailures() {
+				return errors.New("one or more stacks were not deployed successfully")
+			}
+
+			return nil
+		},
+	}
+
+	deployCmd.Flags().String(
+		"stack-file",
+		stacks.DefaultStackFile,
+		"The stack file that declares the blueprints to deploy and the dependencies between them.",
+	)
+	confProvider.BindPFlag("stacksDeployStackFile", deployCmd.Flags().Lookup("stack-file"))
+	confProvider.BindEnvVar("stacksDeployStackFile", "BLUELINK_CLI_STACKS_DEPLOY_STACK_FILE")
+
+	deployCmd.Flags().Bool(
+		"run-hooks",
+		false,
+		"Run the beforeDeploy and afterDeploy commands defined in the hooks section of each blueprint "+
+			"around the deployment of its stack.",
+	)
+	confProvider.BindPFlag("stacksDeployRunHooks", deployCmd.Flags().Lookup("run-hooks"))
+	confProvider.BindEnvVar("stacksDeployRunHooks", "BLUELINK_CLI_STACKS_DEPLOY_RUN_HOOKS")
+
+	return deployCmd
+}
+
+func stackOrchestratorOptions(
+	cmd *cobra.Command,
+	confProvider *config.Provider,
+) []stacks.OrchestratorOption {
+	opts := []stacks.OrchestratorOption{
+		stacks.WithProgressWriter(cmd.OutOrStdout()),
+		stacks.WithTimings(profiling.TimingsFromContext(cmd.Context())),
+	}
+
+	runHooks, _ := confProvider.GetBool("stacksDeployRunHooks")
+	if runHooks {
+		opts = append(opts, stacks.WithBlueprintHooks(
+			func(blueprintPath string) (stacks.HookRunner, error) {
+				return hooks.LoadRunner(
+					blueprintPath,
+					hooks.WithOutput(cmd.OutOrStdout(), cmd.ErrOrStderr()),
+				)
+			},
+		))
+	}
+
+	return opts
+}
+
+func printStacksResult(cmd *cobra.Command, result *stacks.Result) {
+	cmd.Println()
+	cmd.Println("Stacks:")
+	for _, stackResult := range result.Stacks {
+		cmd.Println(fmt.Sprintf(
+			"  - %s (instance %s): %s",
+			stackResult.StackName,
+			stackResult.InstanceName,
+			stackResult.Status,
+		))
+		if len(stackResult.Reasons) > 0 {
+			cmd.Println(fmt.Sprintf("      %s", strings.Join(stackResult.Reasons, "\n      ")))
+		}
+	}
+}
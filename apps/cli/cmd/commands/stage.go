@@ -0,0 +1,331 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/resourceimport"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/cobra"
+)
+
+// The deploy engine operations used to stage changes for a blueprint
+// and wait for the change staging process to complete.
+type changeStagingDeployEngine interface {
+	CreateChangeset(
+		ctx context.Context,
+		payload *types.CreateChangesetPayload,
+	) (*types.ChangesetResponse, error)
+	StreamChangeStagingEvents(
+		ctx context.Context,
+		changesetID string,
+		lastEventID string,
+		streamTo chan<- types.ChangeStagingEvent,
+		errChan chan<- error,
+	) error
+}
+
+// The deploy engine operations used to stage changes for a blueprint
+// when options that are not supported by the interactive staging view
+// provided by the deploy CLI SDK are set.
+type stageDeployEngine interface {
+	changeStagingDeployEngine
+	GetChangesetPlan(
+		ctx context.Context,
+		changesetID string,
+	) (*changes.Plan, error)
+}
+
+// Options for the stage command that are provided by the Bluelink CLI
+// on top of the stage command registered by the deploy CLI SDK.
+type stageOptions struct {
+	verify  bool
+	outFile string
+}
+
+func (o stageOptions) enabled() bool {
+	return o.verify || o.outFile != ""
+}
+
+// Adds the --verify and --out flags to the stage command registered by the deploy CLI SDK.
+// When either flag is set, changes are staged by the Bluelink CLI so that the
+// verification results and the change plan from the deploy engine can be reported,
+// otherwise the stage command of the deploy CLI SDK is run as is.
+// This must be called after the stage command has been added to the root command.
+func setupStageOptions(rootCmd *cobra.Command, confProvider *config.Provider) {
+	stageCmd, _, err := rootCmd.Find([]string{"stage"})
+	if err != nil || stageCmd == rootCmd || stageCmd.RunE == nil {
+		return
+	}
+
+	stageCmd.Flags().Bool(
+		"verify",
+		false,
+		"Verify the staged changes with the resource providers by carrying out a dry run "+
+			"of the deployment for each resource that will be created or updated. "+
+			"Resources that do not support dry runs are reported as unsupported. "+
+			"When set, the results are written as plain text instead of using the interactive view.",
+	)
+	confProvider.BindPFlag("stageVerify", stageCmd.Flags().Lookup("verify"))
+	confProvider.BindEnvVar("stageVerify", "BLUELINK_CLI_STAGE_VERIFY")
+
+	stageCmd.Flags().String(
+		"out",
+		"",
+		"Write the staged changes as a versioned, machine-readable JSON plan document "+
+			"to the provided file path, this can be used with policy checks and plan review tooling. "+
+			"When set, progress is written as plain text instead of using the interactive view.",
+	)
+	confProvider.BindPFlag("stageOut", stageCmd.Flags().Lookup("out"))
+	confProvider.BindEnvVar("stageOut", "BLUELINK_CLI_STAGE_OUT")
+
+	stageCmd.RunE = withStageOptions(stageCmd.RunE, confProvider)
+}
+
+func withStageOptions(
+	runE func(cmd *cobra.Command, args []string) error,
+	confProvider *config.Provider,
+) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		verify, _ := confProvider.GetBool("stageVerify")
+		outFile, _ := confProvider.GetString("stageOut")
+		opts := stageOptions{
+			verify:  verify,
+			outFile: strings.TrimSpace(outFile),
+		}
+		if !opts.enabled() {
+			return runE(cmd, args)
+		}
+
+		payload, err := buildStagePayload(confProvider, opts)
+		if err != nil {
+			return err
+		}
+
+		deployEngine, cleanup, err := createStageDeployEngine(confProvider)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		cmd.SilenceUsage = true
+		return stageWithOptions(
+			cmd.Context(),
+			deployEngine,
+			payload,
+			opts,
+			cmd.OutOrStdout(),
+		)
+	}
+}
+
+func buildStagePayload(
+	confProvider *config.Provider,
+	opts stageOptions,
+) (*types.CreateChangesetPayload, error) {
+	blueprintFile, _ := confProvider.GetString("stageBlueprintFile")
+	instanceID, _ := confProvider.GetString("stageInstanceID")
+	instanceName, _ := confProvider.GetString("stageInstanceName")
+	destroy, _ := confProvider.GetBool("stageDestroy")
+	skipDriftCheck, _ := confProvider.GetBool("stageSkipDriftCheck")
+	deployConfigFile, _ := confProvider.GetString("deployConfigFile")
+
+	if destroy && instanceID == "" && instanceName == "" {
+		return nil, errors.New(
+			"--instance-id or --instance-name must be provided when staging changes with --destroy",
+		)
+	}
+
+	operationConfig, err := resourceimport.LoadOperationConfig(deployConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
+	documentInfo, err := importDocumentInfo(blueprintFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CreateChangesetPayload{
+		BlueprintDocumentInfo: documentInfo,
+		InstanceID:            instanceID,
+		InstanceName:          instanceName,
+		Destroy:               destroy,
+		SkipDriftCheck:        skipDriftCheck,
+		Verify:                opts.verify,
+		Config:                operationConfig,
+	}, nil
+}
+
+func stageWithOptions(
+	ctx context.Context,
+	deployEngine stageDeployEngine,
+	payload *types.CreateChangesetPayload,
+	opts stageOptions,
+	output io.Writer,
+) error {
+	changesetID, completeChanges, err := stageChangesUntilComplete(ctx, deployEngine, payload)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(output, "Staged changes in change set %s\n", changesetID)
+
+	if opts.outFile != "" {
+		if err := writeChangesetPlan(ctx, deployEngine, changesetID, opts.outFile); err != nil {
+			return err
+		}
+		fmt.Fprintf(output, "Wrote the change plan to %s\n", opts.outFile)
+	}
+
+	if !opts.verify {
+		return nil
+	}
+
+	if completeChanges.Verification == nil {
+		return errors.New("the deploy engine did not return verification results for the staged changes")
+	}
+
+	_, err = io.WriteString(output, formatVerifyChangesResult(completeChanges.Verification))
+	if err != nil {
+		return err
+	}
+
+	if completeChanges.Verification.HasFailures() {
+		return fmt.Errorf(
+			"verification of the staged changes in change set %s failed, "+
+				"see the errors reported by the providers above",
+			changesetID,
+		)
+	}
+
+	return nil
+}
+
+func writeChangesetPlan(
+	ctx context.Context,
+	deployEngine stageDeployEngine,
+	changesetID string,
+	outFile string,
+) error {
+	plan, err := deployEngine.GetChangesetPlan(ctx, changesetID)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(outFile, append(encoded, '\n'), 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write the change plan to %s: %w", outFile, err)
+	}
+
+	return nil
+}
+
+func stageChangesUntilComplete(
+	ctx context.Context,
+	deployEngine changeStagingDeployEngine,
+	payload *types.CreateChangesetPayload,
+) (string, *types.CompleteChangesEventData, error) {
+	response, err := deployEngine.CreateChangeset(ctx, payload)
+	if err != nil {
+		return "", nil, err
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan types.ChangeStagingEvent)
+	errChan := make(chan error)
+	err = deployEngine.StreamChangeStagingEvents(
+		streamCtx,
+		response.Data.ID,
+		response.LastEventID,
+		events,
+		errChan,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", nil, ctx.Err()
+		case err := <-errChan:
+			return "", nil, err
+		case event := <-events:
+			if completeChanges, isComplete := event.AsCompleteChanges(); isComplete {
+				return response.Data.ID, completeChanges, nil
+			}
+
+			if _, hasDrift := event.AsDriftDetected(); hasDrift {
+				return "", nil, errors.New(
+					"drift was detected when staging changes, " +
+						"reconcile the blueprint instance before staging changes again",
+				)
+			}
+		}
+	}
+}
+
+func formatVerifyChangesResult(result *container.VerifyChangesResult) string {
+	sb := &strings.Builder{}
+	if len(result.Resources) == 0 {
+		sb.WriteString("\nNo resources will be created or updated, there are no changes to verify\n")
+		return sb.String()
+	}
+
+	sb.WriteString("\nVerification:\n")
+	for _, resource := range result.Resources {
+		fmt.Fprintf(
+			sb,
+			"  %s (%s): %s\n",
+			driftElementPath(resource.ChildPath, resource.ResourceName),
+			resource.ResourceType,
+			resource.Status,
+		)
+		if resource.Error != "" {
+			fmt.Fprintf(sb, "    %s\n", resource.Error)
+		}
+	}
+
+	return sb.String()
+}
+
+func createStageDeployEngine(
+	confProvider *config.Provider,
+) (stageDeployEngine, func(), error) {
+	logger, handle, err := utils.SetupLogger()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployEngine, err := engine.Create(confProvider, logger)
+	if err != nil {
+		handle.Close()
+		return nil, nil, err
+	}
+
+	stageEngine, supportsStaging := deployEngine.(stageDeployEngine)
+	if !supportsStaging {
+		handle.Close()
+		return nil, nil, errors.New("the deploy engine client does not support staging changes")
+	}
+
+	return stageEngine, func() { handle.Close() }, nil
+}
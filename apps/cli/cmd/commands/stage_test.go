@@ -0,0 +1,309 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type StageCommandSuite struct {
+	suite.Suite
+}
+
+func (s *StageCommandSuite) Test_stage_command_is_registered_with_verify_and_out_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"stage"})
+	s.Require().NoError(err)
+	s.Equal("stage", cmd.Name())
+
+	s.NotNil(cmd.Flag("verify"), "expected the --verify flag")
+	s.Equal("false", cmd.Flag("verify").DefValue)
+
+	s.NotNil(cmd.Flag("out"), "expected the --out flag")
+	s.Equal("", cmd.Flag("out").DefValue)
+}
+
+func (s *StageCommandSuite) Test_writes_change_plan_to_out_file() {
+	engine := &stubStageDeployEngine{
+		changesetID: "changeset-1",
+		events: []types.ChangeStagingEvent{
+			{
+				CompleteChanges: &types.CompleteChangesEventData{},
+			},
+		},
+		plan: testChangesetPlan(),
+	}
+	outFile := filepath.Join(s.T().TempDir(), "plan.json")
+	output := &bytes.Buffer{}
+
+	err := stageWithOptions(
+		context.Background(),
+		engine,
+		&types.CreateChangesetPayload{InstanceName: "orders-prod"},
+		stageOptions{outFile: outFile},
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal("changeset-1", engine.receivedPlanChangesetID)
+	s.False(engine.receivedPayload.Verify)
+	s.Equal(
+		"Staged changes in change set changeset-1\n"+
+			"Wrote the change plan to "+outFile+"\n",
+		output.String(),
+	)
+
+	written, err := os.ReadFile(outFile)
+	s.Require().NoError(err)
+
+	plan := &changes.Plan{}
+	err = json.Unmarshal(written, plan)
+	s.Require().NoError(err)
+	s.Equal(testChangesetPlan(), plan)
+}
+
+func (s *StageCommandSuite) Test_does_not_write_out_file_when_plan_cannot_be_retrieved() {
+	engine := &stubStageDeployEngine{
+		changesetID: "changeset-1",
+		events: []types.ChangeStagingEvent{
+			{
+				CompleteChanges: &types.CompleteChangesEventData{},
+			},
+		},
+		planErr: errors.New("change set \"changeset-1\" not found"),
+	}
+	outFile := filepath.Join(s.T().TempDir(), "plan.json")
+	output := &bytes.Buffer{}
+
+	err := stageWithOptions(
+		context.Background(),
+		engine,
+		&types.CreateChangesetPayload{},
+		stageOptions{outFile: outFile},
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal("change set \"changeset-1\" not found", err.Error())
+	s.NoFileExists(outFile)
+}
+
+func (s *StageCommandSuite) Test_stages_changes_with_verify_option_and_reports_results() {
+	engine := &stubStageDeployEngine{
+		changesetID: "changeset-1",
+		events: []types.ChangeStagingEvent{
+			{
+				CompleteChanges: &types.CompleteChangesEventData{
+					Verification: &container.VerifyChangesResult{
+						Resources: []container.ResourceVerifyResult{
+							{
+								ResourceName: "ordersTable",
+								ResourceType: "aws/dynamodb/table",
+								Status:       container.ResourceVerifyStatusVerified,
+							},
+							{
+								ResourceName: "handler",
+								ResourceType: "aws/lambda/function",
+								ChildPath:    "api",
+								Status:       container.ResourceVerifyStatusUnsupported,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := stageWithOptions(
+		context.Background(),
+		engine,
+		&types.CreateChangesetPayload{
+			InstanceName: "orders-prod",
+			Verify:       true,
+		},
+		stageOptions{verify: true},
+		output,
+	)
+	s.Require().NoError(err)
+	s.Require().NotNil(engine.receivedPayload)
+	s.True(engine.receivedPayload.Verify)
+	s.Equal("orders-prod", engine.receivedPayload.InstanceName)
+	s.Equal(
+		`Staged changes in change set changeset-1
+
+Verification:
+  ordersTable (aws/dynamodb/table): verified
+  api.handler (aws/lambda/function): unsupported
+`,
+		output.String(),
+	)
+}
+
+func (s *StageCommandSuite) Test_fails_when_verification_reports_failures() {
+	engine := &stubStageDeployEngine{
+		changesetID: "changeset-1",
+		events: []types.ChangeStagingEvent{
+			{
+				CompleteChanges: &types.CompleteChangesEventData{
+					Verification: &container.VerifyChangesResult{
+						Resources: []container.ResourceVerifyResult{
+							{
+								ResourceName: "ordersTable",
+								ResourceType: "aws/dynamodb/table",
+								Status:       container.ResourceVerifyStatusFailed,
+								Error:        "billing mode PROVISIONED requires read capacity units",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := stageWithOptions(
+		context.Background(),
+		engine,
+		&types.CreateChangesetPayload{Verify: true},
+		stageOptions{verify: true},
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal(
+		"verification of the staged changes in change set changeset-1 failed, "+
+			"see the errors reported by the providers above",
+		err.Error(),
+	)
+	s.Equal(
+		`Staged changes in change set changeset-1
+
+Verification:
+  ordersTable (aws/dynamodb/table): failed
+    billing mode PROVISIONED requires read capacity units
+`,
+		output.String(),
+	)
+}
+
+func (s *StageCommandSuite) Test_fails_when_drift_is_detected() {
+	engine := &stubStageDeployEngine{
+		changesetID: "changeset-1",
+		events: []types.ChangeStagingEvent{
+			{
+				DriftDetected: &types.DriftDetectedEventData{},
+			},
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := stageWithOptions(
+		context.Background(),
+		engine,
+		&types.CreateChangesetPayload{Verify: true},
+		stageOptions{verify: true},
+		output,
+	)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "drift was detected when staging changes")
+	s.Empty(output.String())
+}
+
+func (s *StageCommandSuite) Test_returns_deploy_engine_error() {
+	engine := &stubStageDeployEngine{
+		createErr: errors.New("blueprint file not found"),
+	}
+	output := &bytes.Buffer{}
+
+	err := stageWithOptions(
+		context.Background(),
+		engine,
+		&types.CreateChangesetPayload{Verify: true},
+		stageOptions{verify: true},
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal("blueprint file not found", err.Error())
+	s.Empty(output.String())
+}
+
+type stubStageDeployEngine struct {
+	changesetID             string
+	events                  []types.ChangeStagingEvent
+	createErr               error
+	plan                    *changes.Plan
+	planErr                 error
+	receivedPayload         *types.CreateChangesetPayload
+	receivedPlanChangesetID string
+}
+
+func (e *stubStageDeployEngine) CreateChangeset(
+	ctx context.Context,
+	payload *types.CreateChangesetPayload,
+) (*types.ChangesetResponse, error) {
+	e.receivedPayload = payload
+	if e.createErr != nil {
+		return nil, e.createErr
+	}
+
+	return &types.ChangesetResponse{
+		Data: &manage.Changeset{
+			ID: e.changesetID,
+		},
+	}, nil
+}
+
+func (e *stubStageDeployEngine) StreamChangeStagingEvents(
+	ctx context.Context,
+	changesetID string,
+	lastEventID string,
+	streamTo chan<- types.ChangeStagingEvent,
+	errChan chan<- error,
+) error {
+	go func() {
+		for _, event := range e.events {
+			streamTo <- event
+		}
+	}()
+	return nil
+}
+
+func (e *stubStageDeployEngine) GetChangesetPlan(
+	ctx context.Context,
+	changesetID string,
+) (*changes.Plan, error) {
+	e.receivedPlanChangesetID = changesetID
+	return e.plan, e.planErr
+}
+
+func testChangesetPlan() *changes.Plan {
+	return &changes.Plan{
+		FormatVersion: changes.PlanFormatVersion,
+		Summary: changes.ChangesSummary{
+			Create: 1,
+		},
+		Resources: []*changes.PlanResourceChange{
+			{
+				ResourceName: "ordersTable",
+				ResourceType: "aws/dynamodb/table",
+				Action:       changes.PlanActionCreate,
+			},
+		},
+		Links:    []*changes.PlanLinkChange{},
+		Children: []*changes.PlanChildChange{},
+		Exports:  []*changes.PlanExportChange{},
+	}
+}
+
+func TestStageCommandSuite(t *testing.T) {
+	suite.Run(t, new(StageCommandSuite))
+}
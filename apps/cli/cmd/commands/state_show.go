@@ -0,0 +1,373 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/newstack-cloud/deploy-cli-sdk/config"
+	"github.com/newstack-cloud/deploy-cli-sdk/engine"
+	"github.com/spf13/cobra"
+)
+
+const (
+	stateShowFormatText = "text"
+	stateShowFormatJSON = "json"
+)
+
+var supportedStateShowFormats = []string{stateShowFormatText, stateShowFormatJSON}
+
+// The deploy engine operation used to retrieve the state of a resource
+// as of a previous point in the revision history of a blueprint instance.
+type stateShowDeployEngine interface {
+	GetResourceStateAt(
+		ctx context.Context,
+		instanceID string,
+		resourceName string,
+		point *manage.RevisionPoint,
+	) (*manage.ResourceStateAtRevision, error)
+}
+
+// setupStateShowCommand attaches the show command to the state command
+// provided by the deploy CLI SDK, a standalone state command is created
+// if the SDK does not provide one.
+func setupStateShowCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
+	stateCmd := findSubcommand(rootCmd, "state")
+	if stateCmd == nil {
+		stateCmd = &cobra.Command{
+			Use:   "state",
+			Short: "Manage blueprint instance state",
+		}
+		rootCmd.AddCommand(stateCmd)
+	}
+
+	showCmd := &cobra.Command{
+		Use:   "show <resource>",
+		Short: "Outputs the state of a resource as of a previous point in time",
+		Long: `Outputs the state and spec of a resource in a blueprint instance as of a previous
+point in time along with the changes that have been made to the resource spec since.
+
+The point in time provided with --at can be a unix timestamp in seconds,
+an RFC3339 timestamp or the ID of a change set that has been deployed to the
+blueprint instance. The state of the resource is reconstructed from the change sets
+that have been deployed to the blueprint instance, each deployed change set is a revision.
+
+Examples:
+  # Show the state of a resource as of a point in time
+  bluelink state show ordersTable --instance-name orders-prod --at 2025-05-03T14:00:00Z
+
+  # Show the state of a resource as of a previous revision as JSON
+  bluelink state show ordersTable --instance-name orders-prod \
+    --at 3e0a4d52-b7d4-4d5b-8ae8-7fd1c4a8b0b1 --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName, _ := confProvider.GetString("stateShowInstanceName")
+			at, _ := confProvider.GetString("stateShowAt")
+			format, _ := confProvider.GetString("stateShowFormat")
+
+			if instanceName == "" {
+				return errors.New("a blueprint instance name must be provided with --instance-name")
+			}
+
+			if at == "" {
+				return errors.New("a timestamp or revision must be provided with --at")
+			}
+
+			if !slices.Contains(supportedStateShowFormats, format) {
+				return fmt.Errorf(
+					"unsupported state show format %q, expected one of: %s",
+					format,
+					strings.Join(supportedStateShowFormats, ", "),
+				)
+			}
+
+			deployEngine, closeLogger, err := createStateShowDeployEngine(confProvider)
+			if err != nil {
+				return err
+			}
+			defer closeLogger()
+
+			cmd.SilenceUsage = true
+
+			return writeResourceStateAt(
+				cmd.Context(),
+				deployEngine,
+				instanceName,
+				args[0],
+				parseRevisionPoint(at),
+				format,
+				cmd.OutOrStdout(),
+			)
+		},
+	}
+
+	showCmd.Flags().String(
+		"instance-name",
+		"",
+		"The name or ID of the blueprint instance that the resource belongs to.",
+	)
+	confProvider.BindPFlag("stateShowInstanceName", showCmd.Flags().Lookup("instance-name"))
+	confProvider.BindEnvVar("stateShowInstanceName", "BLUELINK_CLI_STATE_SHOW_INSTANCE_NAME")
+
+	showCmd.Flags().String(
+		"at",
+		"",
+		"The point in time to show the state of the resource for, "+
+			"a unix timestamp, an RFC3339 timestamp or a revision ID.",
+	)
+	confProvider.BindPFlag("stateShowAt", showCmd.Flags().Lookup("at"))
+	confProvider.BindEnvVar("stateShowAt", "BLUELINK_CLI_STATE_SHOW_AT")
+
+	showCmd.Flags().String(
+		"format",
+		stateShowFormatText,
+		"The format to output the state of the resource in, one of: "+
+			strings.Join(supportedStateShowFormats, ", ")+".",
+	)
+	confProvider.BindPFlag("stateShowFormat", showCmd.Flags().Lookup("format"))
+	confProvider.BindEnvVar("stateShowFormat", "BLUELINK_CLI_STATE_SHOW_FORMAT")
+
+	stateCmd.AddCommand(showCmd)
+}
+
+// parseRevisionPoint parses the value of the --at flag,
+// values that are not unix or RFC3339 timestamps are treated as revision IDs.
+func parseRevisionPoint(at string) *manage.RevisionPoint {
+	timestamp, err := strconv.ParseInt(at, 10, 64)
+	if err == nil {
+		return &manage.RevisionPoint{Timestamp: timestamp}
+	}
+
+	parsedTime, err := time.Parse(time.RFC3339, at)
+	if err == nil {
+		return &manage.RevisionPoint{Timestamp: parsedTime.Unix()}
+	}
+
+	return &manage.RevisionPoint{Revision: at}
+}
+
+func writeResourceStateAt(
+	ctx context.Context,
+	deployEngine stateShowDeployEngine,
+	instanceName string,
+	resourceName string,
+	point *manage.RevisionPoint,
+	format string,
+	output io.Writer,
+) error {
+	resourceStateAt, err := deployEngine.GetResourceStateAt(
+		ctx,
+		instanceName,
+		resourceName,
+		point,
+	)
+	if err != nil {
+		return err
+	}
+
+	if format == stateShowFormatJSON {
+		encoded, err := json.MarshalIndent(resourceStateAt, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(output, string(encoded))
+		return err
+	}
+
+	return writeResourceStateAtText(resourceStateAt, instanceName, output)
+}
+
+func writeResourceStateAtText(
+	resourceStateAt *manage.ResourceStateAtRevision,
+	instanceName string,
+	output io.Writer,
+) error {
+	sb := &strings.Builder{}
+	fmt.Fprintf(
+		sb,
+		"Resource %q in blueprint instance %q\n",
+		resourceStateAt.ResourceName,
+		instanceName,
+	)
+	fmt.Fprintf(
+		sb,
+		"As of %s",
+		time.Unix(resourceStateAt.At, 0).UTC().Format(time.RFC3339),
+	)
+	if resourceStateAt.Revision != "" {
+		fmt.Fprintf(sb, " (revision %s)", resourceStateAt.Revision)
+	}
+	sb.WriteString("\n\n")
+
+	if !resourceStateAt.Existed || resourceStateAt.State == nil {
+		sb.WriteString("The resource did not exist at this point in time.\n")
+		_, err := io.WriteString(output, sb.String())
+		return err
+	}
+
+	fmt.Fprintf(sb, "Resource ID: %s\n", resourceStateAt.State.ResourceID)
+	fmt.Fprintf(sb, "Type: %s\n", resourceStateAt.State.Type)
+	sb.WriteString("Spec:\n")
+	spec, err := json.MarshalIndent(
+		redactSensitiveSpecData(
+			resourceStateAt.State.SpecData,
+			"spec",
+			resourceStateAt.SensitiveFields,
+		),
+		"  ",
+		"  ",
+	)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(sb, "  %s\n\n", spec)
+
+	if resourceStateAt.Current == nil {
+		sb.WriteString("The resource has since been removed from the blueprint instance.\n")
+		_, err := io.WriteString(output, sb.String())
+		return err
+	}
+
+	writeResourceSpecChanges(sb, resourceStateAt)
+	_, err = io.WriteString(output, sb.String())
+	return err
+}
+
+func writeResourceSpecChanges(
+	sb *strings.Builder,
+	resourceStateAt *manage.ResourceStateAtRevision,
+) {
+	if len(resourceStateAt.ModifiedFields) == 0 &&
+		len(resourceStateAt.NewFields) == 0 &&
+		len(resourceStateAt.RemovedFields) == 0 {
+		sb.WriteString("No changes have been made to the resource spec since.\n")
+		return
+	}
+
+	sb.WriteString("Changes since (compared to current):\n")
+	for _, field := range resourceStateAt.ModifiedFields {
+		// Removed fields are also reported as modifications
+		// to a nil value, they are only written as removed fields.
+		if field.NewValue == nil &&
+			slices.Contains(resourceStateAt.RemovedFields, field.FieldPath) {
+			continue
+		}
+		fmt.Fprintf(
+			sb,
+			"  ~ %s: %s -> %s\n",
+			field.FieldPath,
+			formatStateShowFieldValue(field.PrevValue, field.Sensitive),
+			formatStateShowFieldValue(field.NewValue, field.Sensitive),
+		)
+	}
+	for _, field := range resourceStateAt.NewFields {
+		fmt.Fprintf(
+			sb,
+			"  + %s: %s\n",
+			field.FieldPath,
+			formatStateShowFieldValue(field.NewValue, field.Sensitive),
+		)
+	}
+	for _, fieldPath := range resourceStateAt.RemovedFields {
+		fmt.Fprintf(sb, "  - %s\n", fieldPath)
+	}
+}
+
+func formatStateShowFieldValue(value *core.MappingNode, sensitive bool) string {
+	if sensitive && value != nil {
+		return provider.RedactedSensitiveValue
+	}
+
+	return formatStateShowValue(value)
+}
+
+func formatStateShowValue(value *core.MappingNode) string {
+	if value == nil {
+		return "null"
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(encoded)
+}
+
+// Produces a copy of the provided resource spec data where the values
+// of the fields at the given sensitive field paths are redacted.
+func redactSensitiveSpecData(
+	node *core.MappingNode,
+	path string,
+	sensitiveFields []string,
+) *core.MappingNode {
+	if node == nil {
+		return nil
+	}
+
+	if slices.Contains(sensitiveFields, path) {
+		return core.MappingNodeFromString(provider.RedactedSensitiveValue)
+	}
+
+	if node.Fields != nil {
+		fields := make(map[string]*core.MappingNode, len(node.Fields))
+		for fieldName, fieldValue := range node.Fields {
+			fields[fieldName] = redactSensitiveSpecData(
+				fieldValue,
+				substitutions.RenderFieldPath(path, fieldName),
+				sensitiveFields,
+			)
+		}
+		return &core.MappingNode{Fields: fields}
+	}
+
+	if node.Items != nil {
+		items := make([]*core.MappingNode, len(node.Items))
+		for i, item := range node.Items {
+			items[i] = redactSensitiveSpecData(
+				item,
+				fmt.Sprintf("%s[%d]", path, i),
+				sensitiveFields,
+			)
+		}
+		return &core.MappingNode{Items: items}
+	}
+
+	return node
+}
+
+func createStateShowDeployEngine(
+	confProvider *config.Provider,
+) (stateShowDeployEngine, func(), error) {
+	logger, handle, err := utils.SetupLogger()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployEngine, err := engine.Create(confProvider, logger)
+	if err != nil {
+		handle.Close()
+		return nil, nil, err
+	}
+
+	showEngine, supportsShow := deployEngine.(stateShowDeployEngine)
+	if !supportsShow {
+		handle.Close()
+		return nil, nil, errors.New(
+			"the deploy engine client does not support retrieving previous resource state",
+		)
+	}
+
+	return showEngine, func() { handle.Close() }, nil
+}
@@ -0,0 +1,282 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type StateShowCommandSuite struct {
+	suite.Suite
+}
+
+func (s *StateShowCommandSuite) Test_state_show_command_is_registered_with_flags() {
+	rootCmd := NewRootCmd()
+
+	cmd, _, err := rootCmd.Find([]string{"state", "show"})
+	s.Require().NoError(err)
+	s.Equal("show", cmd.Name())
+
+	for _, flagName := range []string{"instance-name", "at", "format"} {
+		s.NotNil(cmd.Flag(flagName), "expected the --%s flag", flagName)
+	}
+	s.Equal("text", cmd.Flag("format").DefValue)
+}
+
+func (s *StateShowCommandSuite) Test_fails_without_point_in_time() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"state", "show", "ordersTable", "--instance-name", "orders-prod"})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Equal("a timestamp or revision must be provided with --at", err.Error())
+}
+
+func (s *StateShowCommandSuite) Test_parses_point_in_time() {
+	s.Equal(
+		&manage.RevisionPoint{Timestamp: 1746282442},
+		parseRevisionPoint("1746282442"),
+	)
+	s.Equal(
+		&manage.RevisionPoint{Timestamp: 1746282442},
+		parseRevisionPoint("2025-05-03T14:27:22Z"),
+	)
+	s.Equal(
+		&manage.RevisionPoint{Revision: "3e0a4d52-b7d4-4d5b-8ae8-7fd1c4a8b0b1"},
+		parseRevisionPoint("3e0a4d52-b7d4-4d5b-8ae8-7fd1c4a8b0b1"),
+	)
+}
+
+func (s *StateShowCommandSuite) Test_writes_resource_state_and_changes_since() {
+	engine := &stubStateShowDeployEngine{
+		response: testResourceStateAt(),
+	}
+	output := &bytes.Buffer{}
+
+	err := writeResourceStateAt(
+		context.Background(),
+		engine,
+		"orders-prod",
+		"ordersTable",
+		&manage.RevisionPoint{Revision: "revision-1"},
+		"text",
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal(&manage.RevisionPoint{Revision: "revision-1"}, engine.receivedPoint)
+	s.Equal(
+		`Resource "ordersTable" in blueprint instance "orders-prod"
+As of 2025-05-03T14:27:22Z (revision revision-1)
+
+Resource ID: orders-table-id
+Type: aws/dynamodb/table
+Spec:
+  {
+    "billingMode": "PROVISIONED",
+    "tableName": "orders"
+  }
+
+Changes since (compared to current):
+  ~ spec.tableName: "orders" -> "orders-v2"
+  + spec.streamEnabled: true
+  - spec.billingMode
+`,
+		output.String(),
+	)
+}
+
+func (s *StateShowCommandSuite) Test_masks_values_of_sensitive_fields() {
+	resourceStateAt := testResourceStateAt()
+	resourceStateAt.SensitiveFields = []string{"spec.tableName"}
+	resourceStateAt.ModifiedFields[1].Sensitive = true
+	engine := &stubStateShowDeployEngine{
+		response: resourceStateAt,
+	}
+	output := &bytes.Buffer{}
+
+	err := writeResourceStateAt(
+		context.Background(),
+		engine,
+		"orders-prod",
+		"ordersTable",
+		&manage.RevisionPoint{Revision: "revision-1"},
+		"text",
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal(
+		`Resource "ordersTable" in blueprint instance "orders-prod"
+As of 2025-05-03T14:27:22Z (revision revision-1)
+
+Resource ID: orders-table-id
+Type: aws/dynamodb/table
+Spec:
+  {
+    "billingMode": "PROVISIONED",
+    "tableName": "(sensitive value)"
+  }
+
+Changes since (compared to current):
+  ~ spec.tableName: (sensitive value) -> (sensitive value)
+  + spec.streamEnabled: true
+  - spec.billingMode
+`,
+		output.String(),
+	)
+}
+
+func (s *StateShowCommandSuite) Test_writes_resource_that_did_not_exist() {
+	engine := &stubStateShowDeployEngine{
+		response: &manage.ResourceStateAtRevision{
+			ResourceName: "ordersQueue",
+			At:           1746282442,
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := writeResourceStateAt(
+		context.Background(),
+		engine,
+		"orders-prod",
+		"ordersQueue",
+		&manage.RevisionPoint{Timestamp: 1746282442},
+		"text",
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal(
+		`Resource "ordersQueue" in blueprint instance "orders-prod"
+As of 2025-05-03T14:27:22Z
+
+The resource did not exist at this point in time.
+`,
+		output.String(),
+	)
+}
+
+func (s *StateShowCommandSuite) Test_writes_resource_state_as_json() {
+	engine := &stubStateShowDeployEngine{
+		response: &manage.ResourceStateAtRevision{
+			ResourceName:   "ordersQueue",
+			At:             1746282442,
+			ModifiedFields: []provider.FieldChange{},
+			NewFields:      []provider.FieldChange{},
+			RemovedFields:  []string{},
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := writeResourceStateAt(
+		context.Background(),
+		engine,
+		"orders-prod",
+		"ordersQueue",
+		&manage.RevisionPoint{Timestamp: 1746282442},
+		"json",
+		output,
+	)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{
+			"resourceName": "ordersQueue",
+			"at": 1746282442,
+			"existed": false,
+			"modifiedFields": [],
+			"newFields": [],
+			"removedFields": []
+		}`,
+		output.String(),
+	)
+}
+
+func (s *StateShowCommandSuite) Test_returns_error_from_deploy_engine() {
+	engine := &stubStateShowDeployEngine{
+		err: errors.New("revision not found"),
+	}
+	output := &bytes.Buffer{}
+
+	err := writeResourceStateAt(
+		context.Background(),
+		engine,
+		"orders-prod",
+		"ordersTable",
+		&manage.RevisionPoint{Revision: "revision-1"},
+		"text",
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal("revision not found", err.Error())
+	s.Empty(output.String())
+}
+
+func testResourceStateAt() *manage.ResourceStateAtRevision {
+	return &manage.ResourceStateAtRevision{
+		ResourceName: "ordersTable",
+		At:           1746282442,
+		Revision:     "revision-1",
+		Existed:      true,
+		State: &state.ResourceState{
+			ResourceID: "orders-table-id",
+			Name:       "ordersTable",
+			Type:       "aws/dynamodb/table",
+			SpecData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"tableName":   core.MappingNodeFromString("orders"),
+					"billingMode": core.MappingNodeFromString("PROVISIONED"),
+				},
+			},
+		},
+		Current: &state.ResourceState{
+			ResourceID: "orders-table-id",
+			Name:       "ordersTable",
+			Type:       "aws/dynamodb/table",
+		},
+		ModifiedFields: []provider.FieldChange{
+			{
+				FieldPath: "spec.billingMode",
+				PrevValue: core.MappingNodeFromString("PROVISIONED"),
+			},
+			{
+				FieldPath: "spec.tableName",
+				PrevValue: core.MappingNodeFromString("orders"),
+				NewValue:  core.MappingNodeFromString("orders-v2"),
+			},
+		},
+		NewFields: []provider.FieldChange{
+			{
+				FieldPath: "spec.streamEnabled",
+				NewValue:  core.MappingNodeFromBool(true),
+			},
+		},
+		RemovedFields: []string{"spec.billingMode"},
+	}
+}
+
+type stubStateShowDeployEngine struct {
+	response      *manage.ResourceStateAtRevision
+	err           error
+	receivedPoint *manage.RevisionPoint
+}
+
+func (e *stubStateShowDeployEngine) GetResourceStateAt(
+	ctx context.Context,
+	instanceID string,
+	resourceName string,
+	point *manage.RevisionPoint,
+) (*manage.ResourceStateAtRevision, error) {
+	e.receivedPoint = point
+	return e.response, e.err
+}
+
+func TestStateShowCommandSuite(t *testing.T) {
+	suite.Run(t, new(StateShowCommandSuite))
+}
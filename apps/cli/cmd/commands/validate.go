@@ -1,22 +1,62 @@
 package commands
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"log"
 	"os"
+	"slices"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/newstack-cloud/bluelink/apps/cli/cmd/utils"
 	bluelinkpreflight "github.com/newstack-cloud/bluelink/apps/cli/internal/preflight"
 	"github.com/newstack-cloud/bluelink/apps/cli/internal/project"
+	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/validation"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
 	"github.com/newstack-cloud/deploy-cli-sdk/config"
 	"github.com/newstack-cloud/deploy-cli-sdk/engine"
 	stylespkg "github.com/newstack-cloud/deploy-cli-sdk/styles"
+	"github.com/newstack-cloud/deploy-cli-sdk/tui/shared"
 	"github.com/newstack-cloud/deploy-cli-sdk/tui/validateui"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 )
 
+const (
+	// The default output format for the validate command
+	// that renders validation results in the terminal UI.
+	validateFormatText = "text"
+	// Renders validation results as a SARIF 2.1.0 log
+	// that can be ingested by tools such as GitHub code scanning.
+	validateFormatSARIF = "sarif"
+)
+
+var validateFormats = []string{
+	validateFormatText,
+	validateFormatSARIF,
+}
+
+// The deploy engine operations used to validate a blueprint
+// without the terminal UI.
+type validateDeployEngine interface {
+	CreateBlueprintValidation(
+		ctx context.Context,
+		payload *types.CreateBlueprintValidationPayload,
+		query *types.CreateBlueprintValidationQuery,
+	) (*types.BlueprintValidationResponse, error)
+	StreamBlueprintValidationEvents(
+		ctx context.Context,
+		validationID string,
+		lastEventID string,
+		streamTo chan<- types.BlueprintValidationEvent,
+		errChan chan<- error,
+	) error
+}
+
 func setupValidateCommand(rootCmd *cobra.Command, confProvider *config.Provider) {
 	validateCmd := &cobra.Command{
 		Use:   "validate",
@@ -25,8 +65,20 @@ func setupValidateCommand(rootCmd *cobra.Command, confProvider *config.Provider)
 	You can use this command to check for issues with a blueprint
 	before deployment.
 
-	It's worth noting that validation is carried out as a part of the deploy command as well.`,
+	It's worth noting that validation is carried out as a part of the deploy command as well.
+
+	Use --format sarif to write validation results to stdout as a SARIF 2.1.0 log
+	that can be uploaded to GitHub code scanning and other tools that support SARIF.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			format, _ := confProvider.GetString("validateFormat")
+			if !slices.Contains(validateFormats, format) {
+				return fmt.Errorf(
+					"unsupported validation output format %q, expected one of: %s",
+					format,
+					strings.Join(validateFormats, ", "),
+				)
+			}
+
 			logger, handle, err := utils.SetupLogger()
 			if err != nil {
 				return err
@@ -41,6 +93,20 @@ func setupValidateCommand(rootCmd *cobra.Command, confProvider *config.Provider)
 			transformSpecPtr := boolPtrIfSet(confProvider, "validateTransformSpec")
 			validateAfterTransformPtr := boolPtrIfSet(confProvider, "validateValidateAfterTransform")
 
+			if format == validateFormatSARIF {
+				cmd.SilenceUsage = true
+				return writeValidationSARIF(
+					cmd.Context(),
+					deployEngine,
+					blueprintFile,
+					&types.ValidationLoaderConfig{
+						TransformSpec:          transformSpecPtr,
+						ValidateAfterTransform: validateAfterTransformPtr,
+					},
+					cmd.OutOrStdout(),
+				)
+			}
+
 			if _, err := tea.LogToFile("bluelink-output.log", "simple"); err != nil {
 				log.Fatal(err)
 			}
@@ -137,9 +203,127 @@ func setupValidateCommand(rootCmd *cobra.Command, confProvider *config.Provider)
 	confProvider.BindPFlag("validateValidateAfterTransform", validateCmd.PersistentFlags().Lookup("validate-after-transform"))
 	confProvider.BindEnvVar("validateValidateAfterTransform", "BLUELINK_CLI_VALIDATE_AFTER_TRANSFORM")
 
+	validateCmd.PersistentFlags().String(
+		"format",
+		validateFormatText,
+		"The format to output validation results in, one of: "+
+			strings.Join(validateFormats, ", ")+". "+
+			"The sarif format writes a SARIF 2.1.0 log to stdout without the interactive terminal UI.",
+	)
+	confProvider.BindPFlag("validateFormat", validateCmd.PersistentFlags().Lookup("format"))
+	confProvider.BindEnvVar("validateFormat", "BLUELINK_CLI_VALIDATE_FORMAT")
+
 	rootCmd.AddCommand(validateCmd)
 }
 
+// Validates a blueprint with the deploy engine and writes the resulting
+// diagnostics to the provided output as a SARIF log.
+// An error is returned after the SARIF log has been written when the blueprint
+// has errors so the CLI exits with a non-zero exit code.
+func writeValidationSARIF(
+	ctx context.Context,
+	deployEngine validateDeployEngine,
+	blueprintFile string,
+	loaderConfig *types.ValidationLoaderConfig,
+	output io.Writer,
+) error {
+	diagnostics, err := collectValidationDiagnostics(
+		ctx,
+		deployEngine,
+		blueprintFile,
+		loaderConfig,
+	)
+	if err != nil {
+		return err
+	}
+
+	sarifJSON, err := validation.ToSARIFJSON(
+		diagnostics,
+		/* err */ nil,
+		&validation.SARIFOptions{
+			BlueprintFile: blueprintFile,
+			ToolVersion:   utils.Version,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err = output.Write(append(sarifJSON, '\n')); err != nil {
+		return err
+	}
+
+	errorCount := 0
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Level == bpcore.DiagnosticLevelError {
+			errorCount += 1
+		}
+	}
+	if errorCount > 0 {
+		return fmt.Errorf("blueprint validation failed with %d error(s)", errorCount)
+	}
+
+	return nil
+}
+
+func collectValidationDiagnostics(
+	ctx context.Context,
+	deployEngine validateDeployEngine,
+	blueprintFile string,
+	loaderConfig *types.ValidationLoaderConfig,
+) ([]*bpcore.Diagnostic, error) {
+	docInfo, err := shared.BuildDocumentInfo(
+		shared.BlueprintSourceFromPath(blueprintFile),
+		blueprintFile,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := deployEngine.CreateBlueprintValidation(
+		ctx,
+		&types.CreateBlueprintValidationPayload{
+			BlueprintDocumentInfo: docInfo,
+			LoaderConfig:          loaderConfig,
+		},
+		&types.CreateBlueprintValidationQuery{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	streamTo := make(chan types.BlueprintValidationEvent)
+	errChan := make(chan error)
+	err = deployEngine.StreamBlueprintValidationEvents(
+		ctx,
+		response.Data.ID,
+		response.LastEventID,
+		streamTo,
+		errChan,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := []*bpcore.Diagnostic{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case err := <-errChan:
+			return nil, err
+		case event := <-streamTo:
+			if event.Message != "" {
+				diagnostic := event.Diagnostic
+				diagnostics = append(diagnostics, &diagnostic)
+			}
+			if event.End {
+				return diagnostics, nil
+			}
+		}
+	}
+}
+
 // Returns a pointer to the resolved bool config value when the
 // user has explicitly provided it (via flag, env var, or config file), and
 // nil when the value comes from the cobra default. The deploy-cli-sdk's
@@ -2,10 +2,17 @@ package commands
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/validation"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -63,6 +70,99 @@ func (s *ValidateCommandSuite) Test_has_validate_after_transform_flag() {
 	s.Equal("false", flag.DefValue)
 }
 
+func (s *ValidateCommandSuite) Test_has_format_flag() {
+	rootCmd := NewRootCmd()
+	validateCmd, _, _ := rootCmd.Find([]string{"validate"})
+
+	flag := validateCmd.Flag("format")
+	s.NotNil(flag)
+	s.Equal("text", flag.DefValue)
+}
+
+func (s *ValidateCommandSuite) Test_fails_for_unsupported_format() {
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"validate", "--format", "junit"})
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+
+	err := rootCmd.Execute()
+	s.Require().Error(err)
+	s.Contains(
+		err.Error(),
+		"unsupported validation output format \"junit\", expected one of: text, sarif",
+	)
+}
+
+// SARIF output tests
+
+func (s *ValidateCommandSuite) Test_writes_validation_results_as_sarif() {
+	engine := &stubValidateDeployEngine{
+		events: []types.BlueprintValidationEvent{
+			{
+				Diagnostic: core.Diagnostic{
+					Level:   core.DiagnosticLevelWarning,
+					Message: "variable \"region\" is defined but is never referenced in the blueprint",
+					Range: &core.DiagnosticRange{
+						Start: &source.Meta{Position: source.Position{Line: 4, Column: 3}},
+					},
+				},
+				End: true,
+			},
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := writeValidationSARIF(
+		context.Background(),
+		engine,
+		"project.blueprint.yaml",
+		&types.ValidationLoaderConfig{},
+		output,
+	)
+	s.Require().NoError(err)
+	s.Equal("file", engine.payload.FileSourceScheme)
+	s.Equal("project.blueprint.yaml", engine.payload.BlueprintFile)
+
+	sarifLog := &validation.SARIFLog{}
+	s.Require().NoError(json.Unmarshal(output.Bytes(), sarifLog))
+	s.Equal(validation.SARIFVersion, sarifLog.Version)
+	s.Require().Len(sarifLog.Runs, 1)
+	s.Require().Len(sarifLog.Runs[0].Results, 1)
+	result := sarifLog.Runs[0].Results[0]
+	s.Equal("warning", result.Level)
+	s.Equal(
+		"project.blueprint.yaml",
+		result.Locations[0].PhysicalLocation.ArtifactLocation.URI,
+	)
+	s.Equal(4, result.Locations[0].PhysicalLocation.Region.StartLine)
+}
+
+func (s *ValidateCommandSuite) Test_writes_sarif_and_fails_when_blueprint_has_errors() {
+	engine := &stubValidateDeployEngine{
+		events: []types.BlueprintValidationEvent{
+			{
+				Diagnostic: core.Diagnostic{
+					Level:   core.DiagnosticLevelError,
+					Message: "validation failed due to a missing version",
+				},
+				End: true,
+			},
+		},
+	}
+	output := &bytes.Buffer{}
+
+	err := writeValidationSARIF(
+		context.Background(),
+		engine,
+		"project.blueprint.yaml",
+		&types.ValidationLoaderConfig{},
+		output,
+	)
+	s.Require().Error(err)
+	s.Equal("blueprint validation failed with 1 error(s)", err.Error())
+	s.Contains(output.String(), "validation failed due to a missing version")
+}
+
 // Help text tests
 
 func (s *ValidateCommandSuite) Test_help_contains_usage_info() {
@@ -77,6 +177,39 @@ func (s *ValidateCommandSuite) Test_help_contains_usage_info() {
 	s.Contains(output, "blueprint")
 }
 
+type stubValidateDeployEngine struct {
+	events  []types.BlueprintValidationEvent
+	payload *types.CreateBlueprintValidationPayload
+}
+
+func (e *stubValidateDeployEngine) CreateBlueprintValidation(
+	ctx context.Context,
+	payload *types.CreateBlueprintValidationPayload,
+	query *types.CreateBlueprintValidationQuery,
+) (*types.BlueprintValidationResponse, error) {
+	e.payload = payload
+	return &types.BlueprintValidationResponse{
+		Data: &manage.BlueprintValidation{
+			ID: "validation-1",
+		},
+	}, nil
+}
+
+func (e *stubValidateDeployEngine) StreamBlueprintValidationEvents(
+	ctx context.Context,
+	validationID string,
+	lastEventID string,
+	streamTo chan<- types.BlueprintValidationEvent,
+	errChan chan<- error,
+) error {
+	go func() {
+		for _, event := range e.events {
+			streamTo <- event
+		}
+	}()
+	return nil
+}
+
 func TestValidateCommandSuite(t *testing.T) {
 	suite.Run(t, new(ValidateCommandSuite))
 }
@@ -261,8 +261,9 @@ func handleServiceDiscovery(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(doc)
+	// Service discovery documents are public but must be revalidated
+	// as the document varies based on the auth_type query parameter.
+	writeCacheableJSON(w, r, doc, "public, no-cache")
 }
 
 func handleAPIKeyVerify(w http.ResponseWriter, r *http.Request) {
@@ -575,7 +576,7 @@ func handlePlugins(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func handleListVersions(w http.ResponseWriter, _ *http.Request, namespace, name string) {
+func handleListVersions(w http.ResponseWriter, r *http.Request, namespace, name string) {
 	plugin := registry.findPlugin(namespace, name)
 	if plugin == nil {
 		w.WriteHeader(http.StatusNotFound)
@@ -587,8 +588,7 @@ func handleListVersions(w http.ResponseWriter, _ *http.Request, namespace, name
 		versions[i] = PluginVersionInfo{Version: v}
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(PluginVersionsResponse{Versions: versions})
+	writeCacheableJSON(w, r, PluginVersionsResponse{Versions: versions}, "private, no-cache")
 }
 
 func handleGetPackageMetadata(
@@ -639,8 +639,30 @@ func handleGetPackageMetadata(
 		metadata.SigningKeys = map[string]string{"gpg_public_key": registry.publicKeyPEM}
 	}
 
+	writeCacheableJSON(w, r, metadata, "private, no-cache")
+}
+
+// writeCacheableJSON writes a JSON response with ETag and Cache-Control headers,
+// responding with 304 Not Modified when the client already holds the current
+// version of the response.
+func writeCacheableJSON(w http.ResponseWriter, r *http.Request, value any, cacheControl string) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := fmt.Sprintf("%q", hex.EncodeToString(sum[:16]))
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cacheControl)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(metadata)
+	w.Write(body)
 }
 
 func (r *pluginRegistry) findPlugin(namespace, name string) *testPlugin {
@@ -0,0 +1,71 @@
+package blueprintmigration
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/spf13/afero"
+)
+
+// FormatFromPath determines the format of a blueprint file
+// from its file extension.
+// Only the YAML and JWCC formats can be migrated, blueprint language
+// files produce an error.
+func FormatFromPath(path string) (schema.SpecFormat, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		return schema.YAMLSpecFormat, nil
+	case ".json", ".jsonc", ".hujson":
+		return schema.JWCCSpecFormat, nil
+	case ".bp", ".blueprint":
+		return "", fmt.Errorf(
+			"blueprint language files can not be migrated, %q must be a YAML or JSONC blueprint file",
+			path,
+		)
+	default:
+		return "", fmt.Errorf(
+			"unsupported blueprint file extension for %q, expected one of "+
+				".yml, .yaml, .json, .jsonc or .hujson",
+			path,
+		)
+	}
+}
+
+// MigrateFile loads the blueprint file at the given path and rewrites it to the
+// target version of the provided migrator.
+// The migrated document is returned in the result and is not written back to disk.
+func MigrateFile(
+	fileSystem afero.Fs,
+	path string,
+	migrator *schema.Migrator,
+) (*schema.MigrationResult, error) {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := afero.ReadFile(fileSystem, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blueprint file: %w", err)
+	}
+
+	return migrator.MigrateString(string(contents), format)
+}
+
+// WriteResult writes the migrated document in the given result to the provided path,
+// keeping the file permissions of an existing file.
+func WriteResult(fileSystem afero.Fs, path string, result *schema.MigrationResult) error {
+	mode := os.FileMode(0644)
+	if info, err := fileSystem.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	if err := afero.WriteFile(fileSystem, path, []byte(result.Spec), mode); err != nil {
+		return fmt.Errorf("failed to write migrated blueprint file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package blueprintmigration
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/suite"
+)
+
+type MigrateSuite struct {
+	suite.Suite
+	fileSystem afero.Fs
+	migrator   *schema.Migrator
+}
+
+func (s *MigrateSuite) SetupTest() {
+	s.fileSystem = afero.NewMemMapFs()
+	s.migrator = schema.NewMigrator(
+		"2025-11-02",
+		schema.WithMigrations(&schema.Migration{
+			FromVersion: "2025-01-01",
+			ToVersion:   "2025-11-02",
+			Description: "Renames the resource dependencies field to dependsOn",
+			Migrate: func(doc schema.MigrationDocument) error {
+				return doc.Rename([]string{"resources", "queue", "dependencies"}, "dependsOn")
+			},
+		}),
+	)
+}
+
+func (s *MigrateSuite) Test_FormatFromPath_detects_yaml_and_jwcc() {
+	for path, expected := range map[string]schema.SpecFormat{
+		"project.blueprint.yaml":  schema.YAMLSpecFormat,
+		"project.blueprint.yml":   schema.YAMLSpecFormat,
+		"project.blueprint.jsonc": schema.JWCCSpecFormat,
+		"project.blueprint.json":  schema.JWCCSpecFormat,
+	} {
+		format, err := FormatFromPath(path)
+		s.Require().NoError(err)
+		s.Equal(expected, format, path)
+	}
+}
+
+func (s *MigrateSuite) Test_FormatFromPath_rejects_blueprint_language_files() {
+	_, err := FormatFromPath("project.bp")
+	s.Require().Error(err)
+	s.Contains(err.Error(), "blueprint language files can not be migrated")
+}
+
+func (s *MigrateSuite) Test_MigrateFile_migrates_and_writes_blueprint() {
+	spec := `version: 2025-01-01
+resources:
+  queue:
+    type: aws/sqs/queue
+    # Created after the table.
+    dependencies: table
+`
+	s.Require().NoError(afero.WriteFile(s.fileSystem, "project.blueprint.yaml", []byte(spec), 0600))
+
+	result, err := MigrateFile(s.fileSystem, "project.blueprint.yaml", s.migrator)
+	s.Require().NoError(err)
+	s.True(result.Migrated())
+
+	err = WriteResult(s.fileSystem, "project.blueprint.yaml", result)
+	s.Require().NoError(err)
+
+	written, err := afero.ReadFile(s.fileSystem, "project.blueprint.yaml")
+	s.Require().NoError(err)
+	s.Contains(string(written), "version: 2025-11-02")
+	s.Contains(string(written), "# Created after the table.\n    dependsOn: table")
+
+	info, err := s.fileSystem.Stat("project.blueprint.yaml")
+	s.Require().NoError(err)
+	s.Equal("-rw-------", info.Mode().Perm().String())
+}
+
+func (s *MigrateSuite) Test_MigrateFile_fails_for_missing_file() {
+	_, err := MigrateFile(s.fileSystem, "missing.blueprint.yaml", s.migrator)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "failed to read blueprint file")
+}
+
+func TestMigrateSuite(t *testing.T) {
+	suite.Run(t, new(MigrateSuite))
+}
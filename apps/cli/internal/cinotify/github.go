@@ -0,0 +1,107 @@
+package cinotify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const defaultGitHubAPIURL = "https://api.github.com"
+
+type gitHubNotifier struct {
+	httpClient  *http.Client
+	apiURL      string
+	token       string
+	repository  string
+	commitSHA   string
+	pullRequest int
+}
+
+func newGitHubNotifierFromEnv(
+	getEnv func(string) string,
+	httpClient *http.Client,
+) (Notifier, error) {
+	token := getEnv("GITHUB_TOKEN")
+	if token == "" {
+		return nil, errors.New(
+			"the GITHUB_TOKEN environment variable must be set to send notifications to GitHub",
+		)
+	}
+
+	apiURL := getEnv("GITHUB_API_URL")
+	if apiURL == "" {
+		apiURL = defaultGitHubAPIURL
+	}
+
+	return &gitHubNotifier{
+		httpClient:  httpClient,
+		apiURL:      strings.TrimSuffix(apiURL, "/"),
+		token:       token,
+		repository:  getEnv("GITHUB_REPOSITORY"),
+		commitSHA:   getEnv("GITHUB_SHA"),
+		pullRequest: pullRequestFromGitHubRef(getEnv("GITHUB_REF")),
+	}, nil
+}
+
+// Pull request workflows run for the "refs/pull/{number}/merge" ref.
+func pullRequestFromGitHubRef(ref string) int {
+	number, found := strings.CutPrefix(ref, "refs/pull/")
+	if !found {
+		return 0
+	}
+
+	number, _, _ = strings.Cut(number, "/")
+	pullRequest, err := strconv.Atoi(number)
+	if err != nil {
+		return 0
+	}
+
+	return pullRequest
+}
+
+func (n *gitHubNotifier) Platform() string {
+	return "GitHub"
+}
+
+func (n *gitHubNotifier) PostPullRequestComment(ctx context.Context, body string) error {
+	if n.pullRequest == 0 {
+		return ErrNoPullRequest
+	}
+
+	return sendJSON(
+		ctx,
+		n.httpClient,
+		fmt.Sprintf("%s/repos/%s/issues/%d/comments", n.apiURL, n.repository, n.pullRequest),
+		n.headers(),
+		map[string]string{"body": body},
+	)
+}
+
+func (n *gitHubNotifier) SetCommitStatus(ctx context.Context, status *CommitStatus) error {
+	payload := map[string]string{
+		"state":       string(status.State),
+		"context":     status.Context,
+		"description": status.Description,
+	}
+	if status.TargetURL != "" {
+		payload["target_url"] = status.TargetURL
+	}
+
+	return sendJSON(
+		ctx,
+		n.httpClient,
+		fmt.Sprintf("%s/repos/%s/statuses/%s", n.apiURL, n.repository, n.commitSHA),
+		n.headers(),
+		payload,
+	)
+}
+
+func (n *gitHubNotifier) headers() map[string]string {
+	return map[string]string{
+		"Authorization": "Bearer " + n.token,
+		"Accept":        "application/vnd.github+json",
+	}
+}
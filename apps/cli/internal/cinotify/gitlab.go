@@ -0,0 +1,103 @@
+package cinotify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type gitLabNotifier struct {
+	httpClient      *http.Client
+	apiURL          string
+	token           string
+	projectID       string
+	commitSHA       string
+	mergeRequestIID string
+}
+
+func newGitLabNotifierFromEnv(
+	getEnv func(string) string,
+	httpClient *http.Client,
+) (Notifier, error) {
+	// Job tokens can not be used to create merge request notes
+	// or commit statuses, so a project or personal access token is required.
+	token := getEnv("GITLAB_TOKEN")
+	if token == "" {
+		return nil, errors.New(
+			"the GITLAB_TOKEN environment variable must be set to send notifications to GitLab",
+		)
+	}
+
+	return &gitLabNotifier{
+		httpClient:      httpClient,
+		apiURL:          strings.TrimSuffix(getEnv("CI_API_V4_URL"), "/"),
+		token:           token,
+		projectID:       getEnv("CI_PROJECT_ID"),
+		commitSHA:       getEnv("CI_COMMIT_SHA"),
+		mergeRequestIID: getEnv("CI_MERGE_REQUEST_IID"),
+	}, nil
+}
+
+func (n *gitLabNotifier) Platform() string {
+	return "GitLab"
+}
+
+func (n *gitLabNotifier) PostPullRequestComment(ctx context.Context, body string) error {
+	if n.mergeRequestIID == "" {
+		return ErrNoPullRequest
+	}
+
+	return sendJSON(
+		ctx,
+		n.httpClient,
+		fmt.Sprintf(
+			"%s/projects/%s/merge_requests/%s/notes",
+			n.apiURL,
+			url.PathEscape(n.projectID),
+			n.mergeRequestIID,
+		),
+		n.headers(),
+		map[string]string{"body": body},
+	)
+}
+
+func (n *gitLabNotifier) SetCommitStatus(ctx context.Context, status *CommitStatus) error {
+	payload := map[string]string{
+		"state":       gitLabCommitState(status.State),
+		"name":        status.Context,
+		"description": status.Description,
+	}
+	if status.TargetURL != "" {
+		payload["target_url"] = status.TargetURL
+	}
+
+	return sendJSON(
+		ctx,
+		n.httpClient,
+		fmt.Sprintf(
+			"%s/projects/%s/statuses/%s",
+			n.apiURL,
+			url.PathEscape(n.projectID),
+			n.commitSHA,
+		),
+		n.headers(),
+		payload,
+	)
+}
+
+func (n *gitLabNotifier) headers() map[string]string {
+	return map[string]string{
+		"PRIVATE-TOKEN": n.token,
+	}
+}
+
+func gitLabCommitState(state CommitState) string {
+	if state == CommitStateFailure {
+		return "failed"
+	}
+
+	return string(state)
+}
@@ -0,0 +1,123 @@
+package cinotify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRequestTimeout = 30 * time.Second
+	// DefaultStatusContext is the name used to identify commit statuses
+	// set by the CLI when a custom name is not provided.
+	DefaultStatusContext = "bluelink/deploy"
+)
+
+// CommitState is the state of a deployment reported as a commit status.
+type CommitState string
+
+const (
+	// CommitStatePending indicates that a deployment is in progress.
+	CommitStatePending CommitState = "pending"
+	// CommitStateSuccess indicates that a deployment was successful.
+	CommitStateSuccess CommitState = "success"
+	// CommitStateFailure indicates that a deployment failed.
+	CommitStateFailure CommitState = "failure"
+)
+
+// CommitStatus holds the information reported for a commit
+// in a source control platform.
+type CommitStatus struct {
+	State CommitState
+	// Context is the name that identifies the status,
+	// setting a status with the same context replaces the previous status.
+	Context     string
+	Description string
+	// TargetURL is an optional link to more details about the deployment.
+	TargetURL string
+}
+
+// Notifier posts deployment information to a source control platform
+// from a CI pipeline.
+type Notifier interface {
+	// Platform returns the name of the source control platform.
+	Platform() string
+	// PostPullRequestComment posts a comment with the provided markdown body
+	// to the pull request (or merge request) that triggered the CI pipeline.
+	PostPullRequestComment(ctx context.Context, body string) error
+	// SetCommitStatus sets the status for the commit that triggered the CI pipeline.
+	SetCommitStatus(ctx context.Context, status *CommitStatus) error
+}
+
+// ErrNoPullRequest is returned when a pull request comment is requested
+// for a CI pipeline that was not triggered by a pull request.
+var ErrNoPullRequest = errors.New(
+	"the CI pipeline was not triggered by a pull request or merge request",
+)
+
+// DetectFromEnv creates a notifier for the CI environment
+// that the CLI is running in, using the tokens and pipeline information
+// provided by the CI platform as environment variables.
+func DetectFromEnv(getEnv func(string) string, httpClient *http.Client) (Notifier, error) {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultRequestTimeout}
+	}
+
+	if getEnv("GITHUB_ACTIONS") == "true" {
+		return newGitHubNotifierFromEnv(getEnv, httpClient)
+	}
+
+	if getEnv("GITLAB_CI") == "true" {
+		return newGitLabNotifierFromEnv(getEnv, httpClient)
+	}
+
+	return nil, errors.New(
+		"unable to detect a supported CI environment, " +
+			"notifications are supported for GitHub Actions and GitLab CI/CD",
+	)
+}
+
+func sendJSON(
+	ctx context.Context,
+	httpClient *http.Client,
+	url string,
+	headers map[string]string,
+	payload any,
+) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf(
+			"request to %s failed with status %d: %s",
+			url,
+			resp.StatusCode,
+			string(respBody),
+		)
+	}
+
+	return nil
+}
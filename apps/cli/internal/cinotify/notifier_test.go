@@ -0,0 +1,301 @@
+package cinotify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/stretchr/testify/suite"
+)
+
+type NotifierSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	requests []*recordedRequest
+}
+
+type recordedRequest struct {
+	path    string
+	headers http.Header
+	body    map[string]string
+}
+
+func TestNotifierSuite(t *testing.T) {
+	suite.Run(t, new(NotifierSuite))
+}
+
+func (s *NotifierSuite) SetupTest() {
+	s.requests = []*recordedRequest{}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		s.requests = append(s.requests, &recordedRequest{
+			path:    r.URL.Path,
+			headers: r.Header,
+			body:    body,
+		})
+		w.WriteHeader(http.StatusCreated)
+	}))
+}
+
+func (s *NotifierSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *NotifierSuite) Test_github_notifier_posts_comment_and_sets_status() {
+	notifier, err := DetectFromEnv(envLookup(map[string]string{
+		"GITHUB_ACTIONS":    "true",
+		"GITHUB_TOKEN":      "gh-token",
+		"GITHUB_API_URL":    s.server.URL,
+		"GITHUB_REPOSITORY": "acme/orders",
+		"GITHUB_SHA":        "abc123",
+		"GITHUB_REF":        "refs/pull/42/merge",
+	}), s.server.Client())
+	s.Require().NoError(err)
+	s.Equal("GitHub", notifier.Platform())
+
+	err = notifier.PostPullRequestComment(context.Background(), "plan summary")
+	s.Require().NoError(err)
+	err = notifier.SetCommitStatus(context.Background(), &CommitStatus{
+		State:       CommitStateFailure,
+		Context:     DefaultStatusContext,
+		Description: "Blueprint instance orders: deploy failed",
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(s.requests, 2)
+	s.Equal("/repos/acme/orders/issues/42/comments", s.requests[0].path)
+	s.Equal("Bearer gh-token", s.requests[0].headers.Get("Authorization"))
+	s.Equal(map[string]string{"body": "plan summary"}, s.requests[0].body)
+	s.Equal("/repos/acme/orders/statuses/abc123", s.requests[1].path)
+	s.Equal(
+		map[string]string{
+			"state":       "failure",
+			"context":     "bluelink/deploy",
+			"description": "Blueprint instance orders: deploy failed",
+		},
+		s.requests[1].body,
+	)
+}
+
+func (s *NotifierSuite) Test_github_notifier_reports_error_when_not_triggered_by_pull_request() {
+	notifier, err := DetectFromEnv(envLookup(map[string]string{
+		"GITHUB_ACTIONS": "true",
+		"GITHUB_TOKEN":   "gh-token",
+		"GITHUB_API_URL": s.server.URL,
+		"GITHUB_REF":     "refs/heads/main",
+	}), s.server.Client())
+	s.Require().NoError(err)
+
+	err = notifier.PostPullRequestComment(context.Background(), "plan summary")
+	s.ErrorIs(err, ErrNoPullRequest)
+	s.Empty(s.requests)
+}
+
+func (s *NotifierSuite) Test_gitlab_notifier_posts_note_and_sets_status() {
+	notifier, err := DetectFromEnv(envLookup(map[string]string{
+		"GITLAB_CI":            "true",
+		"GITLAB_TOKEN":         "gl-token",
+		"CI_API_V4_URL":        s.server.URL + "/api/v4",
+		"CI_PROJECT_ID":        "1234",
+		"CI_COMMIT_SHA":        "def456",
+		"CI_MERGE_REQUEST_IID": "7",
+	}), s.server.Client())
+	s.Require().NoError(err)
+	s.Equal("GitLab", notifier.Platform())
+
+	err = notifier.PostPullRequestComment(context.Background(), "plan summary")
+	s.Require().NoError(err)
+	err = notifier.SetCommitStatus(context.Background(), &CommitStatus{
+		State:       CommitStateFailure,
+		Context:     DefaultStatusContext,
+		Description: "Blueprint instance orders: deploy failed",
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(s.requests, 2)
+	s.Equal("/api/v4/projects/1234/merge_requests/7/notes", s.requests[0].path)
+	s.Equal("gl-token", s.requests[0].headers.Get("PRIVATE-TOKEN"))
+	s.Equal("/api/v4/projects/1234/statuses/def456", s.requests[1].path)
+	s.Equal("failed", s.requests[1].body["state"])
+	s.Equal("bluelink/deploy", s.requests[1].body["name"])
+}
+
+func (s *NotifierSuite) Test_reports_error_for_unsupported_ci_environment() {
+	_, err := DetectFromEnv(envLookup(map[string]string{}), nil)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "unable to detect a supported CI environment")
+}
+
+func (s *NotifierSuite) Test_renders_plan_summary_grouped_by_blueprint() {
+	summary := RenderPlanSummary("orders", &changes.BlueprintChanges{
+		NewResources: map[string]provider.Changes{
+			"ordersTable": {},
+		},
+		ResourceChanges: map[string]provider.Changes{
+			"ordersFunction": {
+				MustRecreate:     true,
+				ReplaceStrategy:  schema.ReplaceStrategyDestroyBeforeCreate,
+				RecreateTriggers: []string{"spec.functionName", "spec.runtime"},
+			},
+		},
+		RemovedResources: []string{"legacyQueue"},
+		NewChildren: map[string]changes.NewBlueprintDefinition{
+			"networking": {
+				NewResources: map[string]provider.Changes{
+					"vpc": {},
+				},
+			},
+		},
+	})
+
+	s.Equal(
+		"### Bluelink plan for `orders`\n\n"+
+			"**2 to create, 0 to update, 1 to recreate, 1 to delete**\n\n"+
+			"#### Root blueprint\n\n"+
+			"| Action | Resource |\n"+
+			"| ------ | -------- |\n"+
+			"| create | `ordersTable` |\n"+
+			"| recreate | `ordersFunction` |\n"+
+			"| delete | `legacyQueue` |\n\n"+
+			"Forced replacements:\n\n"+
+			"- `ordersFunction` (destroyBeforeCreate): `spec.functionName`, `spec.runtime`\n\n"+
+			"#### Child blueprint `networking` (create)\n\n"+
+			"| Action | Resource |\n"+
+			"| ------ | -------- |\n"+
+			"| create | `vpc` |\n",
+		summary,
+	)
+}
+
+func (s *NotifierSuite) Test_renders_plan_summary_with_cost_estimate() {
+	summary := RenderPlanSummary("orders", &changes.BlueprintChanges{
+		NewResources: map[string]provider.Changes{
+			"ordersTable": {},
+		},
+		ResourceChanges: map[string]provider.Changes{
+			"ordersCache": {
+				ModifiedFields: []provider.FieldChange{
+					{FieldPath: "spec.nodeType"},
+				},
+			},
+		},
+		RemovedResources: []string{"legacyQueue"},
+		CostEstimate: changes.NewCostEstimate([]*changes.ResourceCostEstimate{
+			{
+				ResourceName:   "ordersTable",
+				Action:         changes.PlanActionCreate,
+				NewMonthlyCost: 25,
+			},
+			{
+				ResourceName:    "ordersCache",
+				Action:          changes.PlanActionUpdate,
+				PrevMonthlyCost: 12.5,
+				NewMonthlyCost:  50,
+			},
+			{
+				ResourceName:    "legacyQueue",
+				Action:          changes.PlanActionDelete,
+				PrevMonthlyCost: 2.5,
+			},
+		}),
+	})
+
+	s.Equal(
+		"### Bluelink plan for `orders`\n\n"+
+			"**1 to create, 1 to update, 1 to delete**\n\n"+
+			"#### Root blueprint\n\n"+
+			"| Action | Resource |\n"+
+			"| ------ | -------- |\n"+
+			"| create | `ordersTable` |\n"+
+			"| update | `ordersCache` |\n"+
+			"| delete | `legacyQueue` |\n\n"+
+			"#### Estimated monthly cost\n\n"+
+			"**+$60.00 per month**\n\n"+
+			"| Action | Resource | Monthly cost |\n"+
+			"| ------ | -------- | ------------ |\n"+
+			"| delete | `legacyQueue` | -$2.50 |\n"+
+			"| update | `ordersCache` | $12.50 -> $50.00 (+$37.50) |\n"+
+			"| create | `ordersTable` | +$25.00 |\n",
+		summary,
+	)
+}
+
+func (s *NotifierSuite) Test_renders_plan_summary_with_deprecations() {
+	summary := RenderPlanSummary("orders", &changes.BlueprintChanges{
+		NewResources: map[string]provider.Changes{
+			"ordersQueue": {},
+		},
+		ResourceChanges: map[string]provider.Changes{
+			"ordersHandler": {
+				ModifiedFields: []provider.FieldChange{
+					{FieldPath: "spec.runtime"},
+				},
+			},
+		},
+		Deprecations: []*changes.Deprecation{
+			{
+				ResourceName: "ordersHandler",
+				ResourceType: "aws/lambda/function",
+				FieldPath:    "spec.runtime",
+				Message:      "use spec.runtimeVersion instead",
+			},
+			{
+				ResourceName: "ordersQueue",
+				ResourceType: "aws/sqs/legacyQueue",
+			},
+		},
+	})
+
+	s.Equal(
+		"### Bluelink plan for `orders`\n\n"+
+			"**1 to create, 1 to update, 0 to delete**\n\n"+
+			"#### Root blueprint\n\n"+
+			"| Action | Resource |\n"+
+			"| ------ | -------- |\n"+
+			"| create | `ordersQueue` |\n"+
+			"| update | `ordersHandler` |\n\n"+
+			"#### Deprecations\n\n"+
+			"| Resource | Deprecated | Guidance |\n"+
+			"| -------- | ---------- | -------- |\n"+
+			"| `ordersHandler` | `spec.runtime` | use spec.runtimeVersion instead |\n"+
+			"| `ordersQueue` | `aws/sqs/legacyQueue` | - |\n",
+		summary,
+	)
+}
+
+func (s *NotifierSuite) Test_renders_plan_summary_without_changes() {
+	summary := RenderPlanSummary("orders", &changes.BlueprintChanges{})
+	s.Equal("### Bluelink plan for `orders`\n\nNo changes to apply.\n", summary)
+}
+
+func (s *NotifierSuite) Test_derives_commit_status_from_instance_status() {
+	status := CommitStatusFromInstanceStatus("orders", core.InstanceStatusUpdated, "")
+	s.Equal(
+		&CommitStatus{
+			State:       CommitStateSuccess,
+			Context:     DefaultStatusContext,
+			Description: "Blueprint instance orders: updated",
+		},
+		status,
+	)
+
+	status = CommitStatusFromInstanceStatus("orders", core.InstanceStatusUpdateRollbackComplete, "deploy/prod")
+	s.Equal(CommitStateFailure, status.State)
+	s.Equal("deploy/prod", status.Context)
+
+	status = CommitStatusFromInstanceStatus("orders", core.InstanceStatusDeploying, "")
+	s.Equal(CommitStatePending, status.State)
+}
+
+func envLookup(env map[string]string) func(string) string {
+	return func(name string) string {
+		return env[name]
+	}
+}
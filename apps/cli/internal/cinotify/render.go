@@ -0,0 +1,246 @@
+package cinotify
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+// RenderPlanSummary renders a markdown summary of the provided change set
+// to be posted as a pull request comment.
+// Changes are grouped by the blueprint they belong to,
+// listing the resources that will be created, updated, recreated,
+// removed or retained in each blueprint.
+// When the change set includes a cost estimate, the estimated change
+// in monthly cost is rendered after the grouped changes, followed by
+// the deprecated resource types and fields used in the change set.
+func RenderPlanSummary(instanceName string, blueprintChanges *changes.BlueprintChanges) string {
+	groups := changes.GroupChangesByChild(blueprintChanges)
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "### Bluelink plan for `%s`\n\n", instanceName)
+	if len(groups) == 0 || (len(groups) == 1 && groups[0].TotalSummary.Total() == 0) {
+		sb.WriteString("No changes to apply.\n")
+		return sb.String()
+	}
+
+	fmt.Fprintf(sb, "**%s**\n", groups[0].TotalSummary.String())
+	for _, group := range groups {
+		sb.WriteString("\n")
+		if group.ChildPath == "" {
+			sb.WriteString("#### Root blueprint\n\n")
+		} else {
+			fmt.Fprintf(sb, "#### Child blueprint `%s` (%s)\n\n", group.ChildPath, group.Action)
+		}
+
+		rows := planRows(group)
+		if len(rows) == 0 {
+			sb.WriteString("No resource changes.\n")
+			continue
+		}
+
+		sb.WriteString("| Action | Resource |\n")
+		sb.WriteString("| ------ | -------- |\n")
+		for _, row := range rows {
+			fmt.Fprintf(sb, "| %s | `%s` |\n", row.action, row.resourceName)
+		}
+		renderReplacements(sb, group.Replacements)
+	}
+	renderCostEstimate(sb, blueprintChanges.CostEstimate)
+	renderDeprecations(sb, blueprintChanges.Deprecations)
+
+	return sb.String()
+}
+
+// Renders the deprecated resource types and resource spec fields used
+// by resources in the change set so reviewers can plan migrations
+// before deprecated elements are removed by providers.
+func renderDeprecations(sb *strings.Builder, deprecations []*changes.Deprecation) {
+	if len(deprecations) == 0 {
+		return
+	}
+
+	sb.WriteString("\n#### Deprecations\n\n")
+	sb.WriteString("| Resource | Deprecated | Guidance |\n")
+	sb.WriteString("| -------- | ---------- | -------- |\n")
+	for _, deprecation := range deprecations {
+		resourcePath := deprecation.ResourceName
+		if deprecation.ChildPath != "" {
+			resourcePath = fmt.Sprintf("%s.%s", deprecation.ChildPath, deprecation.ResourceName)
+		}
+		deprecated := deprecation.ResourceType
+		if deprecation.FieldPath != "" {
+			deprecated = deprecation.FieldPath
+		}
+		guidance := deprecation.Message
+		if guidance == "" {
+			guidance = "-"
+		}
+		fmt.Fprintf(
+			sb,
+			"| `%s` | `%s` | %s |\n",
+			resourcePath,
+			deprecated,
+			guidance,
+		)
+	}
+}
+
+// Renders the estimated change in monthly cost for resources with resource types
+// that support cost estimation, this is skipped when no estimates are available.
+func renderCostEstimate(sb *strings.Builder, estimate *changes.CostEstimate) {
+	if estimate == nil || len(estimate.Resources) == 0 {
+		return
+	}
+
+	sb.WriteString("\n#### Estimated monthly cost\n\n")
+	fmt.Fprintf(sb, "**%s per month**\n\n", formatCostChange(estimate.MonthlyCostChange))
+	sb.WriteString("| Action | Resource | Monthly cost |\n")
+	sb.WriteString("| ------ | -------- | ------------ |\n")
+	for _, resource := range estimate.Resources {
+		resourcePath := resource.ResourceName
+		if resource.ChildPath != "" {
+			resourcePath = fmt.Sprintf("%s.%s", resource.ChildPath, resource.ResourceName)
+		}
+		fmt.Fprintf(
+			sb,
+			"| %s | `%s` | %s |\n",
+			resource.Action,
+			resourcePath,
+			formatResourceCost(resource),
+		)
+	}
+}
+
+func formatResourceCost(resource *changes.ResourceCostEstimate) string {
+	if resource.Error != "" {
+		return "unable to estimate"
+	}
+
+	switch resource.Action {
+	case changes.PlanActionCreate:
+		return formatCostChange(resource.NewMonthlyCost)
+	case changes.PlanActionDelete:
+		return formatCostChange(-resource.PrevMonthlyCost)
+	default:
+		return fmt.Sprintf(
+			"$%.2f -> $%.2f (%s)",
+			resource.PrevMonthlyCost,
+			resource.NewMonthlyCost,
+			formatCostChange(resource.MonthlyCostChange()),
+		)
+	}
+}
+
+func formatCostChange(amount float64) string {
+	if amount < 0 {
+		return fmt.Sprintf("-$%.2f", -amount)
+	}
+	return fmt.Sprintf("+$%.2f", amount)
+}
+
+// Renders the fields that force resources to be recreated along with
+// the replace strategy set for each resource, this is important information
+// for reviewers as replacing a resource can cause data loss or downtime.
+func renderReplacements(sb *strings.Builder, replacements []*changes.ResourceReplacement) {
+	forced := []*changes.ResourceReplacement{}
+	for _, replacement := range replacements {
+		if len(replacement.TriggerFields) > 0 || replacement.Strategy != "" {
+			forced = append(forced, replacement)
+		}
+	}
+
+	if len(forced) == 0 {
+		return
+	}
+
+	sb.WriteString("\nForced replacements:\n\n")
+	for _, replacement := range forced {
+		fmt.Fprintf(sb, "- `%s`", replacement.ResourceName)
+		if replacement.Strategy != "" {
+			fmt.Fprintf(sb, " (%s)", replacement.Strategy)
+		}
+		if len(replacement.TriggerFields) > 0 {
+			triggerFields := make([]string, len(replacement.TriggerFields))
+			for i, fieldPath := range replacement.TriggerFields {
+				triggerFields[i] = fmt.Sprintf("`%s`", fieldPath)
+			}
+			fmt.Fprintf(sb, ": %s", strings.Join(triggerFields, ", "))
+		}
+		sb.WriteString("\n")
+	}
+}
+
+type planRow struct {
+	action       string
+	resourceName string
+}
+
+func planRows(group *changes.ChildChangesGroup) []planRow {
+	rows := []planRow{}
+	for _, section := range []struct {
+		action    string
+		resources []string
+	}{
+		{action: "create", resources: group.NewResources},
+		{action: "update", resources: group.UpdatedResources},
+		{action: "recreate", resources: group.RecreatedResources},
+		{action: "delete", resources: group.RemovedResources},
+		{action: "retain", resources: group.RetainedResources},
+	} {
+		for _, resourceName := range section.resources {
+			rows = append(rows, planRow{
+				action:       section.action,
+				resourceName: resourceName,
+			})
+		}
+	}
+
+	return rows
+}
+
+// CommitStatusFromInstanceStatus derives the commit status to report
+// for a blueprint instance deployment with the provided status.
+func CommitStatusFromInstanceStatus(
+	instanceName string,
+	status core.InstanceStatus,
+	statusContext string,
+) *CommitStatus {
+	commitStatus := &CommitStatus{
+		Context: statusContext,
+	}
+	if commitStatus.Context == "" {
+		commitStatus.Context = DefaultStatusContext
+	}
+
+	switch status {
+	case core.InstanceStatusDeployed,
+		core.InstanceStatusUpdated,
+		core.InstanceStatusDestroyed:
+		commitStatus.State = CommitStateSuccess
+	case core.InstanceStatusDeployFailed,
+		core.InstanceStatusUpdateFailed,
+		core.InstanceStatusDestroyFailed,
+		core.InstanceStatusDeployRollbackFailed,
+		core.InstanceStatusUpdateRollbackFailed,
+		core.InstanceStatusDestroyRollbackFailed,
+		core.InstanceStatusDeployRollbackComplete,
+		core.InstanceStatusUpdateRollbackComplete,
+		core.InstanceStatusDestroyRollbackComplete,
+		core.InstanceStatusDeployInterrupted,
+		core.InstanceStatusUpdateInterrupted,
+		core.InstanceStatusDestroyInterrupted:
+		commitStatus.State = CommitStateFailure
+	default:
+		commitStatus.State = CommitStatePending
+	}
+
+	commitStatus.Description = fmt.Sprintf(
+		"Blueprint instance %s: %s",
+		instanceName,
+		strings.ToLower(status.String()),
+	)
+	return commitStatus
+}
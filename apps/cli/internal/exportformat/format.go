@@ -0,0 +1,337 @@
+// Package exportformat renders the exports of a blueprint instance
+// in formats that can be consumed directly by application build tooling
+// and other infrastructure as code tools.
+package exportformat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// Format is a format that instance exports can be rendered in.
+type Format string
+
+const (
+	// FormatJSON renders exports as a JSON object of export names to values.
+	FormatJSON Format = "json"
+	// FormatDotenv renders exports as KEY=value lines
+	// that can be loaded from a .env file.
+	FormatDotenv Format = "dotenv"
+	// FormatShell renders exports as shell export statements
+	// that can be evaluated in a POSIX shell.
+	FormatShell Format = "shell"
+	// FormatTFVars renders exports as Terraform/OpenTofu variable
+	// definitions that can be used as a .tfvars file.
+	FormatTFVars Format = "tfvars"
+)
+
+// SupportedFormats holds the formats that instance exports can be rendered in.
+var SupportedFormats = []Format{
+	FormatJSON,
+	FormatDotenv,
+	FormatShell,
+	FormatTFVars,
+}
+
+// Options provides options for rendering instance exports.
+type Options struct {
+	// Prefix is prepended to the name of each export when rendering
+	// in the dotenv, shell or tfvars formats (e.g. "APP_").
+	Prefix string
+}
+
+// Render renders the provided instance exports in the given format.
+// Exports are rendered in order of export name.
+// For the dotenv and shell formats, export names are converted to
+// upper snake case (e.g. "ordersTableName" -> "ORDERS_TABLE_NAME")
+// and values that are not scalars are rendered as JSON strings.
+// For the tfvars format, export names are converted to lower snake case.
+func Render(
+	exports map[string]*state.ExportState,
+	format Format,
+	opts *Options,
+) ([]byte, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	switch format {
+	case FormatJSON:
+		return renderJSON(exports)
+	case FormatDotenv:
+		return renderLines(exports, opts, toEnvVarName, dotenvLine)
+	case FormatShell:
+		return renderLines(exports, opts, toEnvVarName, shellLine)
+	case FormatTFVars:
+		return renderLines(exports, opts, toTFVarName, tfvarsLine)
+	default:
+		return nil, fmt.Errorf(
+			"unsupported export format %q, expected one of: %s",
+			format,
+			strings.Join(SupportedFormatNames(), ", "),
+		)
+	}
+}
+
+// SupportedFormatNames returns the names of the formats that
+// instance exports can be rendered in.
+func SupportedFormatNames() []string {
+	names := make([]string, len(SupportedFormats))
+	for i, format := range SupportedFormats {
+		names[i] = string(format)
+	}
+	return names
+}
+
+func renderJSON(exports map[string]*state.ExportState) ([]byte, error) {
+	values := map[string]*core.MappingNode{}
+	for name, export := range exports {
+		values[name] = exportValue(export)
+	}
+
+	output, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(output, '\n'), nil
+}
+
+func renderLines(
+	exports map[string]*state.ExportState,
+	opts *Options,
+	toName func(prefix string, exportName string) string,
+	renderLine func(name string, value *core.MappingNode) (string, error),
+) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, exportName := range sortedKeys(exports) {
+		line, err := renderLine(
+			toName(opts.Prefix, exportName),
+			exportValue(exports[exportName]),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render export %q: %w", exportName, err)
+		}
+		buf.WriteString(line)
+		buf.WriteString("\n")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func dotenvLine(name string, value *core.MappingNode) (string, error) {
+	strValue, err := plainStringValue(value)
+	if err != nil {
+		return "", err
+	}
+
+	replacer := strings.NewReplacer(
+		"\\", "\\\\",
+		"\"", "\\\"",
+		"\n", "\\n",
+		"\r", "\\r",
+		"$", "\\$",
+	)
+	return fmt.Sprintf("%s=\"%s\"", name, replacer.Replace(strValue)), nil
+}
+
+func shellLine(name string, value *core.MappingNode) (string, error) {
+	strValue, err := plainStringValue(value)
+	if err != nil {
+		return "", err
+	}
+
+	// Single quotes prevent any expansion in the shell,
+	// single quotes in the value are closed, escaped and reopened.
+	quoted := strings.ReplaceAll(strValue, "'", "'\\''")
+	return fmt.Sprintf("export %s='%s'", name, quoted), nil
+}
+
+func tfvarsLine(name string, value *core.MappingNode) (string, error) {
+	renderedValue, err := hclValue(
+		value,
+		/* indent */ "",
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s = %s", name, renderedValue), nil
+}
+
+// Resolves the string value for an export in formats that only support
+// string values, values that are not scalars are rendered as compact JSON.
+func plainStringValue(value *core.MappingNode) (string, error) {
+	if core.IsNilMappingNode(value) {
+		return "", nil
+	}
+
+	if value.Scalar != nil {
+		return value.Scalar.ToString(), nil
+	}
+
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonValue), nil
+}
+
+func hclValue(value *core.MappingNode, indent string) (string, error) {
+	if core.IsNilMappingNode(value) {
+		return "null", nil
+	}
+
+	if value.Scalar != nil {
+		return hclScalarValue(value.Scalar)
+	}
+
+	if value.Items != nil {
+		return hclListValue(value.Items, indent)
+	}
+
+	if value.Fields != nil {
+		return hclObjectValue(value.Fields, indent)
+	}
+
+	return "", fmt.Errorf("export value can not be represented in the tfvars format")
+}
+
+func hclScalarValue(scalar *core.ScalarValue) (string, error) {
+	if scalar.StringValue != nil || scalar.BytesValue != nil {
+		return hclString(scalar.ToString())
+	}
+
+	if scalar.NoneValue != nil {
+		return "null", nil
+	}
+
+	return scalar.ToString(), nil
+}
+
+func hclListValue(items []*core.MappingNode, indent string) (string, error) {
+	if len(items) == 0 {
+		return "[]", nil
+	}
+
+	itemIndent := indent + "  "
+	var sb strings.Builder
+	sb.WriteString("[\n")
+	for _, item := range items {
+		itemValue, err := hclValue(item, itemIndent)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(fmt.Sprintf("%s%s,\n", itemIndent, itemValue))
+	}
+	sb.WriteString(indent + "]")
+
+	return sb.String(), nil
+}
+
+func hclObjectValue(fields map[string]*core.MappingNode, indent string) (string, error) {
+	if len(fields) == 0 {
+		return "{}", nil
+	}
+
+	fieldIndent := indent + "  "
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	for _, fieldName := range sortedKeys(fields) {
+		fieldValue, err := hclValue(fields[fieldName], fieldIndent)
+		if err != nil {
+			return "", err
+		}
+		key, err := hclString(fieldName)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(fmt.Sprintf("%s%s = %s\n", fieldIndent, key, fieldValue))
+	}
+	sb.WriteString(indent + "}")
+
+	return sb.String(), nil
+}
+
+func hclString(value string) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+
+	// JSON string escapes are valid in HCL strings, template sequences
+	// must also be escaped so values are not interpolated.
+	replacer := strings.NewReplacer("${", "$${", "%{", "%%{")
+	return replacer.Replace(strings.TrimSuffix(buf.String(), "\n")), nil
+}
+
+func exportValue(export *state.ExportState) *core.MappingNode {
+	if export == nil || core.IsNilMappingNode(export.Value) {
+		return nil
+	}
+	return export.Value
+}
+
+// Converts an export name to an environment variable name
+// (e.g. "ordersTableName" -> "ORDERS_TABLE_NAME").
+func toEnvVarName(prefix string, exportName string) string {
+	return prefix + strings.ToUpper(toSnakeCase(exportName))
+}
+
+// Converts an export name to a Terraform/OpenTofu variable name
+// (e.g. "ordersTableName" -> "orders_table_name").
+func toTFVarName(prefix string, exportName string) string {
+	return prefix + toSnakeCase(exportName)
+}
+
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			sb.WriteRune('_')
+			continue
+		}
+
+		if unicode.IsUpper(r) && i > 0 && startsNewWord(runes, i) {
+			sb.WriteRune('_')
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
+
+// Determines whether an upper case rune starts a new word in a camel case name,
+// acronyms are kept together (e.g. "apiURLPrefix" -> "api_url_prefix").
+func startsNewWord(runes []rune, i int) bool {
+	prev := runes[i-1]
+	if !unicode.IsLetter(prev) && !unicode.IsDigit(prev) {
+		return false
+	}
+
+	if !unicode.IsUpper(prev) {
+		return true
+	}
+
+	return i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
+
+func sortedKeys[Value any](values map[string]Value) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
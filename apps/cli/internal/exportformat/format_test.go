@@ -0,0 +1,148 @@
+package exportformat
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type RenderSuite struct {
+	suite.Suite
+	exports map[string]*state.ExportState
+}
+
+func (s *RenderSuite) SetupTest() {
+	s.exports = map[string]*state.ExportState{
+		"ordersTableName": {
+			Type:  schema.ExportTypeString,
+			Value: core.MappingNodeFromString("orders-table"),
+		},
+		"apiURLPrefix": {
+			Type:  schema.ExportTypeString,
+			Value: core.MappingNodeFromString("https://api.example.com/${stage}'s \"v1\""),
+		},
+		"maxRetries": {
+			Type:  schema.ExportTypeInteger,
+			Value: core.MappingNodeFromInt(3),
+		},
+		"tracingEnabled": {
+			Type:  schema.ExportTypeBoolean,
+			Value: core.MappingNodeFromBool(true),
+		},
+		"subnetIds": {
+			Type: schema.ExportTypeArray,
+			Value: &core.MappingNode{
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("subnet-1"),
+					core.MappingNodeFromString("subnet-2"),
+				},
+			},
+		},
+		"queue": {
+			Type: schema.ExportTypeObject,
+			Value: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"url":     core.MappingNodeFromString("https://sqs.example.com/orders"),
+					"timeout": core.MappingNodeFromFloat(2.5),
+				},
+			},
+		},
+	}
+}
+
+func (s *RenderSuite) Test_renders_exports_in_dotenv_format() {
+	output, err := Render(s.exports, FormatDotenv, &Options{Prefix: "APP_"})
+	s.Require().NoError(err)
+	s.Equal(
+		`APP_API_URL_PREFIX="https://api.example.com/\${stage}'s \"v1\""
+APP_MAX_RETRIES="3"
+APP_ORDERS_TABLE_NAME="orders-table"
+APP_QUEUE="{\"timeout\":2.5,\"url\":\"https://sqs.example.com/orders\"}"
+APP_SUBNET_IDS="[\"subnet-1\",\"subnet-2\"]"
+APP_TRACING_ENABLED="true"
+`,
+		string(output),
+	)
+}
+
+func (s *RenderSuite) Test_renders_exports_in_shell_format() {
+	output, err := Render(s.exports, FormatShell, nil)
+	s.Require().NoError(err)
+	s.Equal(
+		`export API_URL_PREFIX='https://api.example.com/${stage}'\''s "v1"'
+export MAX_RETRIES='3'
+export ORDERS_TABLE_NAME='orders-table'
+export QUEUE='{"timeout":2.5,"url":"https://sqs.example.com/orders"}'
+export SUBNET_IDS='["subnet-1","subnet-2"]'
+export TRACING_ENABLED='true'
+`,
+		string(output),
+	)
+}
+
+func (s *RenderSuite) Test_renders_exports_in_tfvars_format() {
+	output, err := Render(s.exports, FormatTFVars, nil)
+	s.Require().NoError(err)
+	s.Equal(
+		`api_url_prefix = "https://api.example.com/$${stage}'s \"v1\""
+max_retries = 3
+orders_table_name = "orders-table"
+queue = {
+  "timeout" = 2.5
+  "url" = "https://sqs.example.com/orders"
+}
+subnet_ids = [
+  "subnet-1",
+  "subnet-2",
+]
+tracing_enabled = true
+`,
+		string(output),
+	)
+}
+
+func (s *RenderSuite) Test_renders_exports_in_json_format() {
+	output, err := Render(s.exports, FormatJSON, nil)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{
+			"apiURLPrefix": "https://api.example.com/${stage}'s \"v1\"",
+			"maxRetries": 3,
+			"ordersTableName": "orders-table",
+			"queue": {"timeout": 2.5, "url": "https://sqs.example.com/orders"},
+			"subnetIds": ["subnet-1", "subnet-2"],
+			"tracingEnabled": true
+		}`,
+		string(output),
+	)
+}
+
+func (s *RenderSuite) Test_renders_exports_without_values() {
+	exports := map[string]*state.ExportState{
+		"pendingValue": {Type: schema.ExportTypeString},
+	}
+
+	output, err := Render(exports, FormatDotenv, nil)
+	s.Require().NoError(err)
+	s.Equal("PENDING_VALUE=\"\"\n", string(output))
+
+	output, err = Render(exports, FormatTFVars, nil)
+	s.Require().NoError(err)
+	s.Equal("pending_value = null\n", string(output))
+}
+
+func (s *RenderSuite) Test_fails_to_render_exports_in_unsupported_format() {
+	_, err := Render(s.exports, Format("yaml"), nil)
+	s.Require().Error(err)
+	s.Equal(
+		"unsupported export format \"yaml\", expected one of: json, dotenv, shell, tfvars",
+		err.Error(),
+	)
+}
+
+func TestRenderSuite(t *testing.T) {
+	suite.Run(t, new(RenderSuite))
+}
@@ -0,0 +1,190 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/blueprintmigration"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// Runner runs the shell commands defined in the hooks section of a blueprint.
+// Commands are run with "sh -c" from the directory that contains the blueprint
+// with information about the lifecycle phase passed in environment variables:
+//
+//   - BLUELINK_HOOK_EVENT
+//   - BLUELINK_INSTANCE_ID
+//   - BLUELINK_INSTANCE_NAME
+//   - BLUELINK_RESOURCE_NAME (resource events only)
+//   - BLUELINK_RESOURCE_ID (afterResourceDeploy only)
+type Runner struct {
+	hooks      []*schema.Hook
+	workingDir string
+	stdout     io.Writer
+	stderr     io.Writer
+}
+
+// RunnerOption is a function that configures a hook runner.
+type RunnerOption func(*Runner)
+
+// WithOutput sets the writers that the output of hook commands is written to.
+//
+// When this option is not provided, the output of hook commands is discarded.
+func WithOutput(stdout io.Writer, stderr io.Writer) RunnerOption {
+	return func(r *Runner) {
+		r.stdout = stdout
+		r.stderr = stderr
+	}
+}
+
+// NewRunner creates a hook runner for the provided blueprint hooks
+// that runs commands from the given working directory.
+func NewRunner(hooks []*schema.Hook, workingDir string, opts ...RunnerOption) *Runner {
+	runner := &Runner{
+		hooks:      hooks,
+		workingDir: workingDir,
+		stdout:     io.Discard,
+		stderr:     io.Discard,
+	}
+
+	for _, opt := range opts {
+		opt(runner)
+	}
+
+	return runner
+}
+
+// LoadRunner loads the hooks section of the blueprint file at the given path
+// and creates a hook runner that runs commands from the directory
+// that contains the blueprint file.
+func LoadRunner(blueprintPath string, opts ...RunnerOption) (*Runner, error) {
+	format, err := blueprintmigration.FormatFromPath(blueprintPath)
+	if err != nil {
+		return nil, err
+	}
+
+	blueprint, err := schema.Load(blueprintPath, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load hooks from blueprint %q: %w", blueprintPath, err)
+	}
+
+	blueprintHooks := []*schema.Hook{}
+	if blueprint.Hooks != nil {
+		blueprintHooks = blueprint.Hooks.Values
+	}
+
+	workingDir, err := filepath.Abs(filepath.Dir(blueprintPath))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRunner(blueprintHooks, workingDir, opts...), nil
+}
+
+// Has returns true if at least one hook is defined for the given event.
+func (r *Runner) Has(event schema.HookEvent) bool {
+	for _, hook := range r.hooks {
+		if hookEvent(hook) == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Run runs the commands of the hooks defined for the event in the provided info,
+// in the order they are defined in the blueprint.
+// Running stops at the first command that fails.
+// This has the same signature as a container hook so a runner can be used
+// to run blueprint hooks for a blueprint container that deploys locally.
+func (r *Runner) Run(ctx context.Context, info *container.HookInfo) error {
+	for _, hook := range r.hooks {
+		if !matchesHook(hook, info) {
+			continue
+		}
+
+		err := r.runCommand(ctx, core.StringValueFromScalar(hook.Run), info)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Register registers the runner with a blueprint container's deployment hooks
+// for every event that has at least one hook defined in the blueprint.
+func (r *Runner) Register(deploymentHooks *container.DeploymentHooks) {
+	registerFuncs := map[schema.HookEvent]func(container.Hook){
+		schema.HookEventBeforeDeploy:  deploymentHooks.BeforeDeploy,
+		schema.HookEventAfterDeploy:   deploymentHooks.AfterDeploy,
+		schema.HookEventBeforeDestroy: deploymentHooks.BeforeDestroy,
+		schema.HookEventAfterDestroy:  deploymentHooks.AfterDestroy,
+		schema.HookEventBeforeResourceDeploy: func(hook container.Hook) {
+			deploymentHooks.BeforeResourceDeploy("", hook)
+		},
+		schema.HookEventAfterResourceDeploy: func(hook container.Hook) {
+			deploymentHooks.AfterResourceDeploy("", hook)
+		},
+	}
+
+	for _, event := range schema.HookEvents {
+		if r.Has(event) {
+			registerFuncs[event](r.Run)
+		}
+	}
+}
+
+func (r *Runner) runCommand(
+	ctx context.Context,
+	command string,
+	info *container.HookInfo,
+) error {
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Dir = r.workingDir
+	cmd.Stdout = r.stdout
+	cmd.Stderr = r.stderr
+	cmd.Env = append(os.Environ(), hookEnv(info)...)
+
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("hook command %q failed: %w", command, err)
+	}
+
+	return nil
+}
+
+func hookEnv(info *container.HookInfo) []string {
+	env := []string{
+		fmt.Sprintf("BLUELINK_HOOK_EVENT=%s", info.Event),
+		fmt.Sprintf("BLUELINK_INSTANCE_ID=%s", info.InstanceID),
+		fmt.Sprintf("BLUELINK_INSTANCE_NAME=%s", info.InstanceName),
+	}
+	if info.ResourceName != "" {
+		env = append(env, fmt.Sprintf("BLUELINK_RESOURCE_NAME=%s", info.ResourceName))
+	}
+	if info.ResourceID != "" {
+		env = append(env, fmt.Sprintf("BLUELINK_RESOURCE_ID=%s", info.ResourceID))
+	}
+	return env
+}
+
+func matchesHook(hook *schema.Hook, info *container.HookInfo) bool {
+	if hookEvent(hook) != info.Event {
+		return false
+	}
+
+	resourceName := strings.TrimSpace(core.StringValueFromScalar(hook.Resource))
+	return resourceName == "" || resourceName == info.ResourceName
+}
+
+func hookEvent(hook *schema.Hook) schema.HookEvent {
+	return schema.HookEvent(core.StringValueFromScalar(hook.Event))
+}
@@ -0,0 +1,125 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/stretchr/testify/suite"
+)
+
+type RunnerSuite struct {
+	suite.Suite
+	tempDir string
+}
+
+func (s *RunnerSuite) SetupTest() {
+	s.tempDir = s.T().TempDir()
+}
+
+func (s *RunnerSuite) Test_runs_hook_commands_for_event_with_hook_environment() {
+	blueprintPath := s.writeBlueprint(`version: 2025-11-02
+resources:
+  ordersTable:
+    type: aws/dynamodb/table
+hooks:
+  - event: afterResourceDeploy
+    resource: ordersTable
+    run: echo "$BLUELINK_HOOK_EVENT $BLUELINK_INSTANCE_NAME $BLUELINK_RESOURCE_NAME $BLUELINK_RESOURCE_ID"
+  - event: afterResourceDeploy
+    resource: otherTable
+    run: echo "should not run"
+  - event: afterDeploy
+    run: echo "smoke tests"
+`)
+	stdout := &bytes.Buffer{}
+	runner, err := LoadRunner(blueprintPath, WithOutput(stdout, &bytes.Buffer{}))
+	s.Require().NoError(err)
+
+	err = runner.Run(context.Background(), &container.HookInfo{
+		Event:        schema.HookEventAfterResourceDeploy,
+		InstanceID:   "instance-1",
+		InstanceName: "orders",
+		ResourceName: "ordersTable",
+		ResourceID:   "orders-table-id",
+	})
+	s.Require().NoError(err)
+	s.Equal("afterResourceDeploy orders ordersTable orders-table-id\n", stdout.String())
+}
+
+func (s *RunnerSuite) Test_runs_commands_from_blueprint_directory() {
+	blueprintPath := s.writeBlueprint(`version: 2025-11-02
+resources: {}
+hooks:
+  - event: beforeDeploy
+    run: pwd
+`)
+	stdout := &bytes.Buffer{}
+	runner, err := LoadRunner(blueprintPath, WithOutput(stdout, &bytes.Buffer{}))
+	s.Require().NoError(err)
+
+	err = runner.Run(context.Background(), &container.HookInfo{
+		Event: schema.HookEventBeforeDeploy,
+	})
+	s.Require().NoError(err)
+
+	expectedDir, err := filepath.EvalSymlinks(s.tempDir)
+	s.Require().NoError(err)
+	actualDir, err := filepath.EvalSymlinks(strings.TrimSpace(stdout.String()))
+	s.Require().NoError(err)
+	s.Equal(expectedDir, actualDir)
+}
+
+func (s *RunnerSuite) Test_stops_at_first_failing_command() {
+	blueprintPath := s.writeBlueprint(`version: 2025-11-02
+resources: {}
+hooks:
+  - event: beforeDeploy
+    run: exit 3
+  - event: beforeDeploy
+    run: echo "should not run"
+`)
+	stdout := &bytes.Buffer{}
+	runner, err := LoadRunner(blueprintPath, WithOutput(stdout, &bytes.Buffer{}))
+	s.Require().NoError(err)
+
+	err = runner.Run(context.Background(), &container.HookInfo{
+		Event: schema.HookEventBeforeDeploy,
+	})
+	s.Require().Error(err)
+	s.Contains(err.Error(), `hook command "exit 3" failed`)
+	s.Empty(stdout.String())
+}
+
+func (s *RunnerSuite) Test_registers_hooks_for_defined_events_only() {
+	blueprintPath := s.writeBlueprint(`version: 2025-11-02
+resources: {}
+hooks:
+  - event: afterDestroy
+    run: "true"
+`)
+	runner, err := LoadRunner(blueprintPath)
+	s.Require().NoError(err)
+
+	deploymentHooks := container.NewDeploymentHooks()
+	runner.Register(deploymentHooks)
+	s.True(deploymentHooks.Has(schema.HookEventAfterDestroy))
+	s.False(deploymentHooks.Has(schema.HookEventBeforeDeploy))
+	s.False(deploymentHooks.Has(schema.HookEventAfterResourceDeploy))
+}
+
+func (s *RunnerSuite) writeBlueprint(contents string) string {
+	blueprintPath := filepath.Join(s.tempDir, "project.blueprint.yaml")
+	err := os.WriteFile(blueprintPath, []byte(contents), 0644)
+	s.Require().NoError(err)
+	return blueprintPath
+}
+
+func TestRunnerSuite(t *testing.T) {
+	suite.Run(t, new(RunnerSuite))
+}
@@ -0,0 +1,158 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// checksumManifestFileName is the name of the file in the plugin binaries
+	// directory that holds the checksums of installed plugin binaries.
+	// The deploy engine plugin host verifies plugin binaries against these
+	// checksums every time a plugin is launched.
+	checksumManifestFileName = "checksums.json"
+	// pluginExecutableName is the name of the executable file for a plugin
+	// that is launched by the deploy engine plugin host.
+	pluginExecutableName = "plugin"
+)
+
+// ChecksumManifest holds the checksums of installed plugin binaries,
+// this must be kept in sync with the checksum manifest format
+// used by the plugin host in the plugin framework.
+type ChecksumManifest struct {
+	// Plugins maps the path of a plugin binary relative to the plugin binaries
+	// directory to the checksum recorded when the plugin was installed.
+	// Paths always use "/" as the separator, regardless of the host OS.
+	Plugins map[string]*PluginChecksum `json:"plugins"`
+}
+
+// PluginChecksum holds the checksum recorded for a plugin binary
+// when the plugin was installed.
+type PluginChecksum struct {
+	PluginID string `json:"pluginId"`
+	SHA256   string `json:"sha256"`
+}
+
+func (m *Manager) binDir() string {
+	return filepath.Join(m.pluginsDir, "bin")
+}
+
+// LoadChecksumManifest loads the checksum manifest for installed plugin binaries.
+func (m *Manager) LoadChecksumManifest() (*ChecksumManifest, error) {
+	manifestPath := filepath.Join(m.binDir(), checksumManifestFileName)
+
+	data, err := os.ReadFile(manifestPath)
+	if os.IsNotExist(err) {
+		return &ChecksumManifest{Plugins: make(map[string]*PluginChecksum)}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checksum manifest: %w", err)
+	}
+
+	var manifest ChecksumManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse checksum manifest: %w", err)
+	}
+
+	if manifest.Plugins == nil {
+		manifest.Plugins = make(map[string]*PluginChecksum)
+	}
+
+	return &manifest, nil
+}
+
+// SaveChecksumManifest saves the checksum manifest for installed plugin binaries.
+func (m *Manager) SaveChecksumManifest(manifest *ChecksumManifest) error {
+	if err := os.MkdirAll(m.binDir(), 0755); err != nil {
+		return fmt.Errorf("failed to create plugin binaries directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal checksum manifest: %w", err)
+	}
+
+	manifestPath := filepath.Join(m.binDir(), checksumManifestFileName)
+	if err := os.WriteFile(manifestPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write checksum manifest: %w", err)
+	}
+
+	return nil
+}
+
+// recordChecksums calculates and records the checksums of all plugin
+// executables extracted to the given plugin version directory.
+func (m *Manager) recordChecksums(pluginID *PluginID, versionDir string) error {
+	manifest, err := m.LoadChecksumManifest()
+	if err != nil {
+		return err
+	}
+
+	// Remove stale entries from a previous installation of the same version.
+	removeChecksumsInDir(manifest, m.relativeBinPath(versionDir))
+
+	err = filepath.WalkDir(versionDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() || entry.Name() != pluginExecutableName {
+			return nil
+		}
+
+		checksum, err := m.calculateSHA256(path)
+		if err != nil {
+			return err
+		}
+
+		manifest.Plugins[m.relativeBinPath(path)] = &PluginChecksum{
+			PluginID: pluginID.String(),
+			SHA256:   checksum,
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to calculate plugin checksums: %w", err)
+	}
+
+	return m.SaveChecksumManifest(manifest)
+}
+
+// removeChecksums removes the recorded checksums for all plugin
+// executables in the given plugin version directory.
+func (m *Manager) removeChecksums(versionDir string) error {
+	manifest, err := m.LoadChecksumManifest()
+	if err != nil {
+		return err
+	}
+
+	if !removeChecksumsInDir(manifest, m.relativeBinPath(versionDir)) {
+		return nil
+	}
+
+	return m.SaveChecksumManifest(manifest)
+}
+
+func removeChecksumsInDir(manifest *ChecksumManifest, relativeDir string) bool {
+	removed := false
+	prefix := relativeDir + "/"
+	for path := range manifest.Plugins {
+		if strings.HasPrefix(path, prefix) {
+			delete(manifest.Plugins, path)
+			removed = true
+		}
+	}
+	return removed
+}
+
+func (m *Manager) relativeBinPath(path string) string {
+	relativePath, err := filepath.Rel(m.binDir(), path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(relativePath)
+}
@@ -512,6 +512,12 @@ func (m *Manager) extractAndInstallPlugin(
 		return fmt.Errorf("%w: %v", registries.ErrExtractionFailed, err)
 	}
 
+	// Record checksums of the extracted plugin binaries so the plugin host
+	// can verify binaries have not been modified every time they are launched.
+	if err := m.recordChecksums(pluginID, destDir); err != nil {
+		return fmt.Errorf("failed to record plugin checksums: %w", err)
+	}
+
 	if err := m.addToManifest(pluginID, metadata.Shasum, pluginType, metadata.Dependencies); err != nil {
 		return fmt.Errorf("failed to update manifest: %w", err)
 	}
@@ -691,7 +697,7 @@ func (r *dependencyResolver) resolvePluginDependencies(resolvedID *PluginID) err
 	}
 
 	for depIDStr, depVersion := range metadata.Dependencies {
-		depID, err := r.buildDependencyID(depIDStr, depVersion, resolvedID)
+		depID, err := dependencyPluginID(depIDStr, depVersion, resolvedID)
 		if err != nil {
 			return err
 		}
@@ -702,7 +708,10 @@ func (r *dependencyResolver) resolvePluginDependencies(resolvedID *PluginID) err
 	return nil
 }
 
-func (r *dependencyResolver) buildDependencyID(
+// dependencyPluginID builds the ID for a dependency of the parent plugin,
+// dependencies that do not specify a registry are sourced from the
+// same registry as the parent plugin.
+func dependencyPluginID(
 	depIDStr, depVersion string,
 	parent *PluginID,
 ) (*PluginID, error) {
@@ -1072,6 +1081,10 @@ func (m *Manager) removePluginFiles(plugin *InstalledPlugin) error {
 		return err
 	}
 
+	if err := m.removeChecksums(versionDir); err != nil {
+		return err
+	}
+
 	m.cleanupEmptyParentDirs(versionDir)
 	return nil
 }
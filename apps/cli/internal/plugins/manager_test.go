@@ -1501,6 +1501,86 @@ func (s *ManagerSuite) TestUninstall_removes_plugin_files() {
 	s.True(os.IsNotExist(err))
 }
 
+func (s *ManagerSuite) TestExtractAndInstallPlugin_records_checksums() {
+	pluginsDir := filepath.Join(s.tempDir, "plugins")
+	archivePath := filepath.Join(s.tempDir, "test.tar.gz")
+	err := s.createTestArchive(archivePath, map[string]string{
+		"plugin":    "plugin-binary",
+		"README.md": "# Test Plugin",
+	})
+	s.Require().NoError(err)
+
+	manager := &Manager{pluginsDir: pluginsDir}
+	pluginID := &PluginID{
+		RegistryHost: DefaultRegistryHost,
+		Namespace:    "bluelink",
+		Name:         "aws",
+		Version:      "1.0.0",
+	}
+
+	err = manager.extractAndInstallPlugin(
+		pluginID,
+		archivePath,
+		&registries.PluginPackageMetadata{Shasum: "abc123"},
+		"provider",
+	)
+	s.Require().NoError(err)
+
+	checksums, err := manager.LoadChecksumManifest()
+	s.Require().NoError(err)
+	s.Require().Len(checksums.Plugins, 1)
+
+	expectedSum := sha256.Sum256([]byte("plugin-binary"))
+	checksum, exists := checksums.Plugins["bluelink/aws/1.0.0/plugin"]
+	s.Require().True(exists)
+	s.Equal("bluelink/aws@1.0.0", checksum.PluginID)
+	s.Equal(hex.EncodeToString(expectedSum[:]), checksum.SHA256)
+}
+
+func (s *ManagerSuite) TestUninstall_removes_recorded_checksums() {
+	pluginsDir := filepath.Join(s.tempDir, "plugins")
+	manager := &Manager{pluginsDir: pluginsDir}
+
+	err := manager.SaveManifest(&PluginManifest{
+		Plugins: map[string]*InstalledPlugin{
+			"registry.bluelink.dev/bluelink/aws": {
+				ID:           "bluelink/aws@1.0.0",
+				Version:      "1.0.0",
+				RegistryHost: "registry.bluelink.dev",
+				Shasum:       "abc123",
+				InstalledAt:  time.Now(),
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	err = manager.SaveChecksumManifest(&ChecksumManifest{
+		Plugins: map[string]*PluginChecksum{
+			"bluelink/aws/1.0.0/plugin": {
+				PluginID: "bluelink/aws@1.0.0",
+				SHA256:   "abc123",
+			},
+			"bluelink/gcp/1.0.0/plugin": {
+				PluginID: "bluelink/gcp@1.0.0",
+				SHA256:   "def456",
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	result := manager.Uninstall(&PluginID{
+		RegistryHost: DefaultRegistryHost,
+		Namespace:    "bluelink",
+		Name:         "aws",
+	})
+	s.Equal(UninstallStatusRemoved, result.Status)
+
+	checksums, err := manager.LoadChecksumManifest()
+	s.Require().NoError(err)
+	s.Len(checksums.Plugins, 1)
+	s.Contains(checksums.Plugins, "bluelink/gcp/1.0.0/plugin")
+}
+
 func (s *ManagerSuite) TestUninstall_returns_not_found_for_missing_plugin() {
 	pluginsDir := filepath.Join(s.tempDir, "plugins")
 
@@ -0,0 +1,165 @@
+package plugins
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RequirementSource identifies what requires an installed plugin.
+type RequirementSource string
+
+const (
+	// RequirementSourceDeployConfig is used when a plugin is listed
+	// as a dependency in a project's deploy config file.
+	RequirementSourceDeployConfig RequirementSource = "deploy-config"
+	// RequirementSourcePlugin is used when a plugin is a dependency
+	// of another installed plugin.
+	RequirementSourcePlugin RequirementSource = "plugin"
+)
+
+// Requirement describes a single reason that a plugin is installed.
+type Requirement struct {
+	Source RequirementSource
+	// RequiredBy is the ID of the plugin that depends on the installed plugin
+	// for plugin requirements or the path of the deploy config file
+	// for deploy config requirements.
+	RequiredBy string
+	// Constraint is the version or version constraint that was requested
+	// (e.g. "^1.2.0"), this is empty when any version is accepted.
+	Constraint string
+	// Satisfied is true when the installed version of the plugin
+	// matches the requested constraint.
+	Satisfied bool
+}
+
+// Explanation holds the reasons that a plugin is installed.
+type Explanation struct {
+	Plugin *InstalledPlugin
+	// Requirements holds the deploy config and other installed plugins
+	// that depend on the plugin.
+	// This is empty when the plugin was installed directly
+	// and nothing else depends on it.
+	Requirements []*Requirement
+}
+
+// IsDirectInstall returns true when nothing depends on the plugin,
+// meaning it was installed directly by a user.
+func (e *Explanation) IsDirectInstall() bool {
+	return len(e.Requirements) == 0
+}
+
+// Explain explains why a plugin is installed by finding the deploy config
+// dependencies and the dependencies of other installed plugins that require it.
+// Dependencies of installed plugins are taken from the plugin manifest which records
+// the dependencies that were resolved when each plugin was installed.
+// The deploy config is optional, when provided, deployConfigPath is used to identify
+// the deploy config in the returned requirements.
+func (m *Manager) Explain(
+	pluginID *PluginID,
+	deployConfig *DeployConfig,
+	deployConfigPath string,
+) (*Explanation, error) {
+	manifest, err := m.LoadManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	installed, exists := manifest.Plugins[pluginID.ManifestKey()]
+	if !exists {
+		return nil, fmt.Errorf("plugin %s is not installed", pluginID.String())
+	}
+
+	explanation := &Explanation{
+		Plugin:       installed,
+		Requirements: []*Requirement{},
+	}
+
+	if deployConfig != nil {
+		deployConfigRequirement, err := deployConfigRequirementFor(
+			pluginID,
+			installed,
+			deployConfig,
+			deployConfigPath,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if deployConfigRequirement != nil {
+			explanation.Requirements = append(explanation.Requirements, deployConfigRequirement)
+		}
+	}
+
+	pluginRequirements, err := pluginRequirementsFor(pluginID, installed, manifest)
+	if err != nil {
+		return nil, err
+	}
+	explanation.Requirements = append(explanation.Requirements, pluginRequirements...)
+
+	return explanation, nil
+}
+
+func deployConfigRequirementFor(
+	pluginID *PluginID,
+	installed *InstalledPlugin,
+	deployConfig *DeployConfig,
+	deployConfigPath string,
+) (*Requirement, error) {
+	configPluginIDs, err := deployConfig.GetPluginIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, configPluginID := range configPluginIDs {
+		if configPluginID.ManifestKey() == pluginID.ManifestKey() {
+			return &Requirement{
+				Source:     RequirementSourceDeployConfig,
+				RequiredBy: deployConfigPath,
+				Constraint: configPluginID.Version,
+				Satisfied:  isVersionSatisfied(configPluginID.Version, installed.Version),
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func pluginRequirementsFor(
+	pluginID *PluginID,
+	installed *InstalledPlugin,
+	manifest *PluginManifest,
+) ([]*Requirement, error) {
+	requirements := []*Requirement{}
+	for _, dependent := range manifest.Plugins {
+		if len(dependent.Dependencies) == 0 {
+			continue
+		}
+
+		dependentID, err := ParsePluginID(dependent.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid plugin ID %q in manifest: %w", dependent.ID, err)
+		}
+
+		for depIDStr, depVersion := range dependent.Dependencies {
+			depID, err := dependencyPluginID(depIDStr, depVersion, dependentID)
+			if err != nil {
+				return nil, err
+			}
+
+			if depID.ManifestKey() == pluginID.ManifestKey() {
+				requirements = append(requirements, &Requirement{
+					Source:     RequirementSourcePlugin,
+					RequiredBy: dependent.ID,
+					Constraint: depID.Version,
+					Satisfied:  isVersionSatisfied(depID.Version, installed.Version),
+				})
+			}
+		}
+	}
+
+	// Sort by the dependent plugin ID for consistent output.
+	sort.Slice(requirements, func(i, j int) bool {
+		return requirements[i].RequiredBy < requirements[j].RequiredBy
+	})
+
+	return requirements, nil
+}
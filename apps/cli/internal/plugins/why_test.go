@@ -0,0 +1,104 @@
+package plugins
+
+import (
+	"path/filepath"
+	"time"
+)
+
+func (s *ManagerSuite) TestExplain_not_installed() {
+	manager := &Manager{pluginsDir: filepath.Join(s.tempDir, "plugins")}
+
+	pluginID, err := ParsePluginID("bluelink/aws")
+	s.Require().NoError(err)
+
+	_, err = manager.Explain(pluginID, nil, "")
+	s.Require().Error(err)
+	s.Contains(err.Error(), "plugin bluelink/aws is not installed")
+}
+
+func (s *ManagerSuite) TestExplain_direct_install() {
+	manager := &Manager{pluginsDir: filepath.Join(s.tempDir, "plugins")}
+	s.Require().NoError(manager.SaveManifest(createWhyTestManifest()))
+
+	pluginID, err := ParsePluginID("bluelink/aws-transformer")
+	s.Require().NoError(err)
+
+	explanation, err := manager.Explain(pluginID, &DeployConfig{}, "bluelink.deploy.json")
+	s.Require().NoError(err)
+	s.True(explanation.IsDirectInstall())
+	s.Equal("0.3.0", explanation.Plugin.Version)
+}
+
+func (s *ManagerSuite) TestExplain_required_by_deploy_config_and_plugins() {
+	manager := &Manager{pluginsDir: filepath.Join(s.tempDir, "plugins")}
+	s.Require().NoError(manager.SaveManifest(createWhyTestManifest()))
+
+	pluginID, err := ParsePluginID("bluelink/aws")
+	s.Require().NoError(err)
+
+	deployConfig := &DeployConfig{
+		Dependencies: map[string]string{
+			"bluelink/aws": "^1.0.0",
+		},
+	}
+	explanation, err := manager.Explain(pluginID, deployConfig, "bluelink.deploy.json")
+	s.Require().NoError(err)
+	s.False(explanation.IsDirectInstall())
+	s.Equal(
+		[]*Requirement{
+			{
+				Source:     RequirementSourceDeployConfig,
+				RequiredBy: "bluelink.deploy.json",
+				Constraint: "^1.0.0",
+				Satisfied:  true,
+			},
+			{
+				Source:     RequirementSourcePlugin,
+				RequiredBy: "bluelink/aws-transformer@0.3.0",
+				Constraint: "^1.1.0",
+				Satisfied:  true,
+			},
+			{
+				Source:     RequirementSourcePlugin,
+				RequiredBy: "bluelink/legacy-transformer@0.1.0",
+				Constraint: "~0.9.0",
+				Satisfied:  false,
+			},
+		},
+		explanation.Requirements,
+	)
+}
+
+func createWhyTestManifest() *PluginManifest {
+	return &PluginManifest{
+		Plugins: map[string]*InstalledPlugin{
+			"registry.bluelink.dev/bluelink/aws": {
+				ID:           "bluelink/aws@1.2.0",
+				Version:      "1.2.0",
+				RegistryHost: "registry.bluelink.dev",
+				InstalledAt:  time.Now(),
+				Type:         "provider",
+			},
+			"registry.bluelink.dev/bluelink/aws-transformer": {
+				ID:           "bluelink/aws-transformer@0.3.0",
+				Version:      "0.3.0",
+				RegistryHost: "registry.bluelink.dev",
+				InstalledAt:  time.Now(),
+				Type:         "transformer",
+				Dependencies: map[string]string{
+					"bluelink/aws": "^1.1.0",
+				},
+			},
+			"registry.bluelink.dev/bluelink/legacy-transformer": {
+				ID:           "bluelink/legacy-transformer@0.1.0",
+				Version:      "0.1.0",
+				RegistryHost: "registry.bluelink.dev",
+				InstalledAt:  time.Now(),
+				Type:         "transformer",
+				Dependencies: map[string]string{
+					"bluelink/aws": "~0.9.0",
+				},
+			},
+		},
+	}
+}
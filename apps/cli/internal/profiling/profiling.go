@@ -0,0 +1,124 @@
+package profiling
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+// Options holds the paths of the files that profiling data
+// is written to for a command run.
+// Profiling data is only collected for the options that are set.
+type Options struct {
+	// CPUProfileFile is the path of the file that a pprof CPU profile
+	// is written to.
+	CPUProfileFile string
+	// MemProfileFile is the path of the file that a pprof heap profile
+	// is written to when the session is stopped.
+	MemProfileFile string
+	// TraceFile is the path of the file that a runtime execution trace
+	// is written to.
+	TraceFile string
+}
+
+// Enabled returns true if at least one type of profiling data
+// should be collected.
+func (o Options) Enabled() bool {
+	return o.CPUProfileFile != "" || o.MemProfileFile != "" || o.TraceFile != ""
+}
+
+// Session is a profiling session that collects the profiling data
+// requested in the options it was started with.
+type Session struct {
+	opts       Options
+	cpuProfile *os.File
+	traceFile  *os.File
+}
+
+// Start starts a profiling session, CPU profiling and execution tracing
+// begin immediately while the heap profile is captured when the session
+// is stopped.
+func Start(opts Options) (*Session, error) {
+	session := &Session{opts: opts}
+
+	if opts.CPUProfileFile != "" {
+		file, err := os.Create(opts.CPUProfileFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CPU profile file: %w", err)
+		}
+
+		if err := pprof.StartCPUProfile(file); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to start CPU profile: %w", err)
+		}
+		session.cpuProfile = file
+	}
+
+	if opts.TraceFile != "" {
+		file, err := os.Create(opts.TraceFile)
+		if err != nil {
+			session.stopCPUProfile()
+			return nil, fmt.Errorf("failed to create trace file: %w", err)
+		}
+
+		if err := trace.Start(file); err != nil {
+			file.Close()
+			session.stopCPUProfile()
+			return nil, fmt.Errorf("failed to start trace: %w", err)
+		}
+		session.traceFile = file
+	}
+
+	return session, nil
+}
+
+// Stop stops CPU profiling and execution tracing and writes
+// the heap profile if one was requested.
+// All profiling data is flushed to the configured files
+// before this returns.
+func (s *Session) Stop() error {
+	errs := []error{s.stopCPUProfile()}
+
+	if s.traceFile != nil {
+		trace.Stop()
+		errs = append(errs, s.traceFile.Close())
+		s.traceFile = nil
+	}
+
+	if s.opts.MemProfileFile != "" {
+		errs = append(errs, writeHeapProfile(s.opts.MemProfileFile))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Session) stopCPUProfile() error {
+	if s.cpuProfile == nil {
+		return nil
+	}
+
+	pprof.StopCPUProfile()
+	err := s.cpuProfile.Close()
+	s.cpuProfile = nil
+	return err
+}
+
+func writeHeapProfile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create memory profile file: %w", err)
+	}
+	defer file.Close()
+
+	// Run a garbage collection so the profile reflects
+	// up-to-date allocation statistics.
+	runtime.GC()
+	if err := pprof.WriteHeapProfile(file); err != nil {
+		return fmt.Errorf("failed to write memory profile: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package profiling
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ProfilingSuite struct {
+	suite.Suite
+	tempDir string
+}
+
+func (s *ProfilingSuite) SetupTest() {
+	s.tempDir = s.T().TempDir()
+}
+
+func (s *ProfilingSuite) Test_writes_requested_profiles_when_stopped() {
+	opts := Options{
+		CPUProfileFile: filepath.Join(s.tempDir, "cpu.pprof"),
+		MemProfileFile: filepath.Join(s.tempDir, "mem.pprof"),
+		TraceFile:      filepath.Join(s.tempDir, "trace.out"),
+	}
+	s.True(opts.Enabled())
+
+	session, err := Start(opts)
+	s.Require().NoError(err)
+	s.Require().NoError(session.Stop())
+
+	for _, path := range []string{opts.CPUProfileFile, opts.MemProfileFile, opts.TraceFile} {
+		info, err := os.Stat(path)
+		s.Require().NoError(err)
+		s.Greater(info.Size(), int64(0), "expected %s to contain profiling data", path)
+	}
+}
+
+func (s *ProfilingSuite) Test_only_writes_memory_profile_when_only_memory_profile_requested() {
+	opts := Options{
+		MemProfileFile: filepath.Join(s.tempDir, "mem.pprof"),
+	}
+
+	session, err := Start(opts)
+	s.Require().NoError(err)
+	s.Require().NoError(session.Stop())
+
+	entries, err := os.ReadDir(s.tempDir)
+	s.Require().NoError(err)
+	s.Len(entries, 1)
+	s.Equal("mem.pprof", entries[0].Name())
+}
+
+func (s *ProfilingSuite) Test_fails_to_start_when_profile_file_cannot_be_created() {
+	_, err := Start(Options{
+		CPUProfileFile: filepath.Join(s.tempDir, "missing-dir", "cpu.pprof"),
+	})
+	s.Require().Error(err)
+	s.Contains(err.Error(), "failed to create CPU profile file")
+}
+
+func (s *ProfilingSuite) Test_is_disabled_without_profile_files() {
+	s.False(Options{}.Enabled())
+}
+
+func TestProfilingSuite(t *testing.T) {
+	suite.Run(t, new(ProfilingSuite))
+}
@@ -0,0 +1,197 @@
+package profiling
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	// PhaseParse is the phase in which a blueprint or stack file is parsed.
+	PhaseParse = "parse"
+	// PhaseValidate is the phase in which a blueprint is validated.
+	PhaseValidate = "validate"
+	// PhaseStage is the phase in which changes are staged for a blueprint instance.
+	PhaseStage = "stage"
+	// PhaseDeploy is the phase in which a blueprint instance is deployed.
+	PhaseDeploy = "deploy"
+)
+
+// Timings records how long a command spends in each phase
+// along with the time spent deploying resources for each provider.
+// A nil Timings is valid and records nothing, so callers can track phases
+// without checking whether timings were requested.
+type Timings struct {
+	mu         sync.Mutex
+	phases     []string
+	phaseTimes map[string]time.Duration
+	providers  map[string]time.Duration
+	now        func() time.Time
+}
+
+// TimingsOption is a function that configures a timings recorder.
+type TimingsOption func(*Timings)
+
+// WithClock sets the function used to get the current time
+// when tracking phases.
+//
+// When this option is not provided, time.Now is used.
+func WithClock(now func() time.Time) TimingsOption {
+	return func(t *Timings) {
+		t.now = now
+	}
+}
+
+// NewTimings creates a new timings recorder.
+func NewTimings(opts ...TimingsOption) *Timings {
+	timings := &Timings{
+		phaseTimes: map[string]time.Duration{},
+		providers:  map[string]time.Duration{},
+		now:        time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(timings)
+	}
+
+	return timings
+}
+
+// Track starts timing the given phase and returns a function
+// that stops timing the phase when called.
+// Time spent in a phase across multiple calls is accumulated,
+// this is useful for commands that stage and deploy multiple blueprints.
+func (t *Timings) Track(phase string) func() {
+	if t == nil {
+		return func() {}
+	}
+
+	start := t.now()
+	return func() {
+		t.AddPhaseTime(phase, t.now().Sub(start))
+	}
+}
+
+// AddPhaseTime adds the given duration to the time spent in a phase.
+func (t *Timings) AddPhaseTime(phase string, duration time.Duration) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, hasPhase := t.phaseTimes[phase]; !hasPhase {
+		t.phases = append(t.phases, phase)
+	}
+	t.phaseTimes[phase] += duration
+}
+
+// AddProviderTime adds the time spent deploying a resource
+// of the given type to the total for the provider of the resource type.
+func (t *Timings) AddProviderTime(resourceType string, duration time.Duration) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.providers[ProviderNamespace(resourceType)] += duration
+}
+
+// HasPhases returns true if time has been recorded for at least one phase.
+func (t *Timings) HasPhases() bool {
+	if t == nil {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.phases) > 0
+}
+
+// WriteReport writes a breakdown of the time spent in each phase,
+// in the order the phases were first tracked, followed by
+// the time spent deploying resources for each provider.
+func (t *Timings) WriteReport(w io.Writer) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Timings:")
+
+	total := time.Duration(0)
+	for _, phase := range t.phases {
+		total += t.phaseTimes[phase]
+	}
+	for _, phase := range t.phases {
+		fmt.Fprintf(
+			tw,
+			"  %s\t%s\t%s\n",
+			phase,
+			formatDuration(t.phaseTimes[phase]),
+			formatPercentage(t.phaseTimes[phase], total),
+		)
+	}
+	fmt.Fprintf(tw, "  total\t%s\n", formatDuration(total))
+
+	if len(t.providers) > 0 {
+		fmt.Fprintln(tw)
+		fmt.Fprintln(tw, "Resource deployment time by provider:")
+		for _, provider := range slices.Sorted(maps.Keys(t.providers)) {
+			fmt.Fprintf(tw, "  %s\t%s\n", provider, formatDuration(t.providers[provider]))
+		}
+	}
+
+	tw.Flush()
+}
+
+// ProviderNamespace extracts the provider namespace from a resource type,
+// for example, "aws" for the "aws/lambda/function" resource type.
+func ProviderNamespace(resourceType string) string {
+	namespace, _, _ := strings.Cut(resourceType, "/")
+	return namespace
+}
+
+func formatDuration(duration time.Duration) string {
+	return duration.Round(time.Millisecond).String()
+}
+
+func formatPercentage(duration time.Duration, total time.Duration) string {
+	if total == 0 {
+		return "0.0%"
+	}
+
+	return fmt.Sprintf("%.1f%%", float64(duration)/float64(total)*100)
+}
+
+type timingsContextKey struct{}
+
+// ContextWithTimings returns a copy of the given context
+// that carries the provided timings recorder.
+func ContextWithTimings(ctx context.Context, timings *Timings) context.Context {
+	return context.WithValue(ctx, timingsContextKey{}, timings)
+}
+
+// TimingsFromContext returns the timings recorder carried by the given context,
+// nil is returned when timings were not requested for the current command.
+func TimingsFromContext(ctx context.Context) *Timings {
+	if ctx == nil {
+		return nil
+	}
+
+	timings, _ := ctx.Value(timingsContextKey{}).(*Timings)
+	return timings
+}
@@ -0,0 +1,86 @@
+package profiling
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TimingsSuite struct {
+	suite.Suite
+	currentTime time.Time
+}
+
+func (s *TimingsSuite) SetupTest() {
+	s.currentTime = time.Unix(1760000000, 0)
+}
+
+func (s *TimingsSuite) Test_writes_report_with_accumulated_phase_and_provider_times() {
+	timings := NewTimings(WithClock(s.now))
+
+	s.trackPhase(timings, PhaseParse, 100*time.Millisecond)
+	s.trackPhase(timings, PhaseStage, 600*time.Millisecond)
+	s.trackPhase(timings, PhaseDeploy, 1*time.Second)
+	s.trackPhase(timings, PhaseStage, 300*time.Millisecond)
+	timings.AddProviderTime("aws/lambda/function", 700*time.Millisecond)
+	timings.AddProviderTime("aws/dynamodb/table", 1200*time.Millisecond)
+	timings.AddProviderTime("cloudflare/dns/record", 50*time.Millisecond)
+
+	report := &bytes.Buffer{}
+	timings.WriteReport(report)
+	s.Equal(
+		"Timings:\n"+
+			"  parse   100ms  5.0%\n"+
+			"  stage   900ms  45.0%\n"+
+			"  deploy  1s     50.0%\n"+
+			"  total   2s\n"+
+			"\n"+
+			"Resource deployment time by provider:\n"+
+			"  aws         1.9s\n"+
+			"  cloudflare  50ms\n",
+		report.String(),
+	)
+}
+
+func (s *TimingsSuite) Test_nil_timings_records_nothing() {
+	var timings *Timings
+
+	stop := timings.Track(PhaseDeploy)
+	stop()
+	timings.AddProviderTime("aws/lambda/function", time.Second)
+
+	s.False(timings.HasPhases())
+	report := &bytes.Buffer{}
+	timings.WriteReport(report)
+	s.Empty(report.String())
+}
+
+func (s *TimingsSuite) Test_carries_timings_in_context() {
+	timings := NewTimings()
+	ctx := ContextWithTimings(context.Background(), timings)
+
+	s.Same(timings, TimingsFromContext(ctx))
+	s.Nil(TimingsFromContext(context.Background()))
+}
+
+func (s *TimingsSuite) Test_extracts_provider_namespace_from_resource_type() {
+	s.Equal("aws", ProviderNamespace("aws/lambda/function"))
+	s.Equal("custom", ProviderNamespace("custom"))
+}
+
+func (s *TimingsSuite) trackPhase(timings *Timings, phase string, duration time.Duration) {
+	stop := timings.Track(phase)
+	s.currentTime = s.currentTime.Add(duration)
+	stop()
+}
+
+func (s *TimingsSuite) now() time.Time {
+	return s.currentTime
+}
+
+func TestTimingsSuite(t *testing.T) {
+	suite.Run(t, new(TimingsSuite))
+}
@@ -0,0 +1,324 @@
+package registries
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	cacheableAcceptType = "application/json"
+)
+
+// GetHTTPCachePath returns the platform-specific path for the directory
+// used to cache responses from plugin registries.
+func GetHTTPCachePath() string {
+	if runtime.GOOS == "windows" {
+		return os.ExpandEnv("%LOCALAPPDATA%\\NewStack\\Bluelink\\cache\\registries")
+	}
+	return os.ExpandEnv("$HOME/.bluelink/cache/registries")
+}
+
+// HTTPCache is an on-disk cache for responses from plugin registries
+// that follows standard HTTP caching semantics.
+// Responses are stored along with their ETag, Last-Modified and Cache-Control
+// headers so they can be reused while fresh and revalidated with
+// conditional requests once stale.
+type HTTPCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewHTTPCache creates a new on-disk HTTP cache that stores
+// responses in the given directory.
+func NewHTTPCache(dir string) *HTTPCache {
+	return &HTTPCache{
+		dir: dir,
+		now: time.Now,
+	}
+}
+
+// NewHTTPCacheWithClock creates a new on-disk HTTP cache with a custom
+// function to get the current time.
+// This is primarily useful for testing.
+func NewHTTPCacheWithClock(dir string, now func() time.Time) *HTTPCache {
+	return &HTTPCache{
+		dir: dir,
+		now: now,
+	}
+}
+
+// Dir returns the directory that cached responses are stored in.
+func (c *HTTPCache) Dir() string {
+	return c.dir
+}
+
+// httpCacheEntry is the on-disk representation of a cached response.
+type httpCacheEntry struct {
+	URL          string      `json:"url"`
+	StatusCode   int         `json:"statusCode"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+	ETag         string      `json:"etag,omitempty"`
+	LastModified string      `json:"lastModified,omitempty"`
+	StoredAt     int64       `json:"storedAt"`
+	// MaxAge is the number of seconds the response is fresh for after it was stored,
+	// when this is 0 the response must be revalidated before it is used.
+	MaxAge int64 `json:"maxAge"`
+}
+
+func (e *httpCacheEntry) isFresh(now time.Time) bool {
+	return e.MaxAge > 0 && now.Unix()-e.StoredAt < e.MaxAge
+}
+
+func (e *httpCacheEntry) canRevalidate() bool {
+	return e.ETag != "" || e.LastModified != ""
+}
+
+func (e *httpCacheEntry) toResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+func (c *HTTPCache) get(key string) *httpCacheEntry {
+	data, err := os.ReadFile(c.entryPath(key))
+	if err != nil {
+		return nil
+	}
+
+	var entry httpCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		// Treat corrupted cache entries as cache misses,
+		// they will be replaced by the next successful response.
+		return nil
+	}
+
+	return &entry
+}
+
+func (c *HTTPCache) set(key string, entry *httpCacheEntry) error {
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create HTTP cache directory: %w", err)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal HTTP cache entry: %w", err)
+	}
+
+	if err := os.WriteFile(c.entryPath(key), data, 0600); err != nil {
+		return fmt.Errorf("failed to write HTTP cache entry: %w", err)
+	}
+
+	return nil
+}
+
+func (c *HTTPCache) remove(key string) {
+	_ = os.Remove(c.entryPath(key))
+}
+
+func (c *HTTPCache) entryPath(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+func newCachingHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: NewCachingTransport(
+			http.DefaultTransport,
+			NewHTTPCache(GetHTTPCachePath()),
+		),
+	}
+}
+
+// CachingTransport is an http.RoundTripper that caches JSON responses
+// for GET requests to plugin registries such as service discovery documents,
+// version lists and package metadata.
+// Package downloads and other requests are passed through to the
+// underlying transport without caching.
+type CachingTransport struct {
+	base  http.RoundTripper
+	cache *HTTPCache
+}
+
+// NewCachingTransport creates a new caching transport that wraps the provided
+// transport, using http.DefaultTransport if the provided transport is nil.
+func NewCachingTransport(base http.RoundTripper, cache *HTTPCache) *CachingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &CachingTransport{
+		base:  base,
+		cache: cache,
+	}
+}
+
+// RoundTrip serves fresh responses from the cache, revalidates stale responses
+// with If-None-Match and If-Modified-Since headers and stores cacheable responses.
+func (t *CachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isCacheableRequest(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	key := httpCacheKey(req)
+	entry := t.cache.get(key)
+	if entry != nil && entry.isFresh(t.cache.now()) {
+		return entry.toResponse(req), nil
+	}
+
+	outReq := req
+	if entry != nil && entry.canRevalidate() {
+		outReq = req.Clone(req.Context())
+		if entry.ETag != "" {
+			outReq.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			outReq.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		resp.Body.Close()
+		entry.StoredAt = t.cache.now().Unix()
+		entry.MaxAge = maxAgeFromHeader(resp.Header, entry.MaxAge)
+		_ = t.cache.set(key, entry)
+		return entry.toResponse(req), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	return t.storeResponse(key, req, resp)
+}
+
+func (t *CachingTransport) storeResponse(
+	key string,
+	req *http.Request,
+	resp *http.Response,
+) (*http.Response, error) {
+	cacheControl := parseCacheControl(resp.Header.Get("Cache-Control"))
+	if _, noStore := cacheControl["no-store"]; noStore {
+		t.cache.remove(key)
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	entry := &httpCacheEntry{
+		URL:          req.URL.String(),
+		StatusCode:   resp.StatusCode,
+		Header:       resp.Header.Clone(),
+		Body:         body,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		StoredAt:     t.cache.now().Unix(),
+		MaxAge:       maxAgeFromHeader(resp.Header, 0),
+	}
+
+	if entry.MaxAge == 0 && !entry.canRevalidate() {
+		// Without freshness information or validators,
+		// a cached response could never be safely reused.
+		return resp, nil
+	}
+
+	// Failing to write to the cache should not fail the request.
+	_ = t.cache.set(key, entry)
+	return resp, nil
+}
+
+func isCacheableRequest(req *http.Request) bool {
+	if req.Method != http.MethodGet {
+		return false
+	}
+
+	if req.Header.Get("Range") != "" {
+		return false
+	}
+
+	return strings.HasPrefix(req.Header.Get("Accept"), cacheableAcceptType)
+}
+
+// httpCacheKey derives a cache key from the request URL and credentials
+// to make sure responses for one set of credentials are never served
+// for requests made with a different set of credentials.
+func httpCacheKey(req *http.Request) string {
+	hash := sha256.New()
+	hash.Write([]byte(req.Method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(req.URL.String()))
+	hash.Write([]byte{0})
+	hash.Write([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func maxAgeFromHeader(header http.Header, fallback int64) int64 {
+	cacheControl := parseCacheControl(header.Get("Cache-Control"))
+	if len(cacheControl) == 0 {
+		return fallback
+	}
+
+	if _, noCache := cacheControl["no-cache"]; noCache {
+		return 0
+	}
+
+	maxAgeValue, hasMaxAge := cacheControl["max-age"]
+	if !hasMaxAge {
+		return 0
+	}
+
+	maxAge, err := strconv.ParseInt(maxAgeValue, 10, 64)
+	if err != nil || maxAge < 0 {
+		return 0
+	}
+
+	return maxAge
+}
+
+func parseCacheControl(value string) map[string]string {
+	directives := map[string]string{}
+	for _, directive := range strings.Split(value, ",") {
+		directive = strings.TrimSpace(directive)
+		if directive == "" {
+			continue
+		}
+
+		name, directiveValue, _ := strings.Cut(directive, "=")
+		directives[strings.ToLower(strings.TrimSpace(name))] = strings.Trim(
+			strings.TrimSpace(directiveValue),
+			"\"",
+		)
+	}
+
+	return directives
+}
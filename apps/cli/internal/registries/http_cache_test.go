@@ -0,0 +1,166 @@
+package registries
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const testETag = `"versions-v1"`
+
+type HTTPCacheSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func (s *HTTPCacheSuite) SetupTest() {
+	s.now = time.Unix(1750000000, 0)
+}
+
+func (s *HTTPCacheSuite) newClient(server *httptest.Server) *http.Client {
+	cache := NewHTTPCacheWithClock(s.T().TempDir(), func() time.Time {
+		return s.now
+	})
+	return &http.Client{
+		Transport: NewCachingTransport(server.Client().Transport, cache),
+	}
+}
+
+func (s *HTTPCacheSuite) get(client *http.Client, url string, accept string) (int, string) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	s.Require().NoError(err)
+	req.Header.Set("Accept", accept)
+
+	resp, err := client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	return resp.StatusCode, string(body)
+}
+
+func (s *HTTPCacheSuite) TestRoundTrip_revalidates_with_etag() {
+	var requests, notModified atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("If-None-Match") == testETag {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", testETag)
+		w.Header().Set("Cache-Control", "private, no-cache")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"versions":[{"version":"1.0.0"}]}`))
+	}))
+	defer server.Close()
+
+	client := s.newClient(server)
+
+	status, body := s.get(client, server.URL+"/versions", "application/json")
+	s.Equal(http.StatusOK, status)
+	s.JSONEq(`{"versions":[{"version":"1.0.0"}]}`, body)
+
+	status, body = s.get(client, server.URL+"/versions", "application/json")
+	s.Equal(http.StatusOK, status)
+	s.JSONEq(`{"versions":[{"version":"1.0.0"}]}`, body)
+
+	s.Equal(int32(2), requests.Load())
+	s.Equal(int32(1), notModified.Load())
+}
+
+func (s *HTTPCacheSuite) TestRoundTrip_serves_fresh_responses_from_cache() {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"provider.v1":{"endpoint":"/v1/plugins"}}`))
+	}))
+	defer server.Close()
+
+	client := s.newClient(server)
+
+	s.get(client, server.URL+serviceDiscoveryPath, "application/json")
+	s.now = s.now.Add(2 * time.Minute)
+	status, body := s.get(client, server.URL+serviceDiscoveryPath, "application/json")
+	s.Equal(http.StatusOK, status)
+	s.JSONEq(`{"provider.v1":{"endpoint":"/v1/plugins"}}`, body)
+	s.Equal(int32(1), requests.Load())
+
+	// Once the max age has passed, the response should be fetched again.
+	s.now = s.now.Add(5 * time.Minute)
+	s.get(client, server.URL+serviceDiscoveryPath, "application/json")
+	s.Equal(int32(2), requests.Load())
+}
+
+func (s *HTTPCacheSuite) TestRoundTrip_does_not_store_no_store_responses() {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Cache-Control", "no-store, max-age=300")
+		w.Header().Set("ETag", testETag)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := s.newClient(server)
+
+	s.get(client, server.URL+"/metadata", "application/json")
+	s.get(client, server.URL+"/metadata", "application/json")
+	s.Equal(int32(2), requests.Load())
+}
+
+func (s *HTTPCacheSuite) TestRoundTrip_does_not_cache_package_downloads() {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = w.Write([]byte("archive-content"))
+	}))
+	defer server.Close()
+
+	client := s.newClient(server)
+
+	s.get(client, server.URL+"/download", "application/octet-stream")
+	s.get(client, server.URL+"/download", "application/octet-stream")
+	s.Equal(int32(2), requests.Load())
+}
+
+func (s *HTTPCacheSuite) TestRoundTrip_does_not_share_responses_between_credentials() {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Cache-Control", "private, max-age=300")
+		_, _ = w.Write([]byte(`{"token":"` + r.Header.Get("Authorization") + `"}`))
+	}))
+	defer server.Close()
+
+	client := s.newClient(server)
+
+	for _, token := range []string{"Bearer token-1", "Bearer token-2"} {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/versions", nil)
+		s.Require().NoError(err)
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Authorization", token)
+
+		resp, err := client.Do(req)
+		s.Require().NoError(err)
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		s.Require().NoError(err)
+		s.JSONEq(`{"token":"`+token+`"}`, string(body))
+	}
+
+	s.Equal(int32(2), requests.Load())
+}
+
+func TestHTTPCacheSuite(t *testing.T) {
+	suite.Run(t, new(HTTPCacheSuite))
+}
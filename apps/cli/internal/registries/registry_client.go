@@ -24,15 +24,15 @@ type RegistryClient struct {
 }
 
 // NewRegistryClient creates a new registry client with default settings.
+// Version lists and package metadata are cached on disk based on the cache headers
+// returned by the registry.
 func NewRegistryClient(
 	authConfigStore *AuthConfigStore,
 	tokenStore *TokenStore,
 	discoveryClient *ServiceDiscoveryClient,
 ) *RegistryClient {
 	return &RegistryClient{
-		httpClient: &http.Client{
-			Timeout: defaultRegistryTimeout,
-		},
+		httpClient:      newCachingHTTPClient(defaultRegistryTimeout),
 		authConfigStore: authConfigStore,
 		tokenStore:      tokenStore,
 		discoveryClient: discoveryClient,
@@ -397,18 +397,24 @@ func (c *RegistryClient) getAuthToken(
 	}
 
 	if tokens != nil {
-		if !tokens.IsExpired() {
+		if !tokens.ExpiresWithin(TokenRefreshLeadTime) {
 			return tokens.AccessToken, nil
 		}
 
-		// Try to refresh expired token
+		// Try to refresh tokens that have expired or are close to expiry
 		if tokens.RefreshToken != "" && authConfig != nil {
 			refreshedTokens, err := c.refreshToken(ctx, registryHost, authConfig)
 			if err == nil {
 				return refreshedTokens.AccessToken, nil
 			}
-			// If refresh fails, fall through to other auth methods
 		}
+
+		// A token that is close to expiry can still be used
+		// when it could not be refreshed.
+		if !tokens.IsExpired() {
+			return tokens.AccessToken, nil
+		}
+		// If refresh fails for an expired token, fall through to other auth methods
 	}
 
 	// Check for API key
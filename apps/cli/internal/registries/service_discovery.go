@@ -21,11 +21,11 @@ type ServiceDiscoveryClient struct {
 }
 
 // NewServiceDiscoveryClient creates a new service discovery client with default settings.
+// Service discovery documents are cached on disk based on the cache headers
+// returned by the registry.
 func NewServiceDiscoveryClient() *ServiceDiscoveryClient {
 	return &ServiceDiscoveryClient{
-		httpClient: &http.Client{
-			Timeout: defaultDiscoveryTimeout,
-		},
+		httpClient: newCachingHTTPClient(defaultDiscoveryTimeout),
 	}
 }
 
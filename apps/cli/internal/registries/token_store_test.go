@@ -386,6 +386,26 @@ func (s *TokenStoreSuite) TestGetValidTokens_returns_tokens_when_no_expiry() {
 	s.Equal("test-token", validTokens.AccessToken)
 }
 
+func (s *TokenStoreSuite) TestExpiresWithin_reports_tokens_expiring_within_duration() {
+	expiry := time.Now().Add(3 * time.Minute)
+	tokens := &RegistryTokens{
+		AccessToken: "test-token",
+		TokenExpiry: &expiry,
+	}
+
+	s.True(tokens.ExpiresWithin(TokenRefreshLeadTime))
+	s.False(tokens.ExpiresWithin(time.Minute))
+	s.False(tokens.IsExpired())
+}
+
+func (s *TokenStoreSuite) TestExpiresWithin_returns_false_when_no_expiry() {
+	tokens := &RegistryTokens{
+		AccessToken: "test-token",
+	}
+
+	s.False(tokens.ExpiresWithin(TokenRefreshLeadTime))
+}
+
 func TestTokenStoreSuite(t *testing.T) {
 	suite.Run(t, new(TokenStoreSuite))
 }
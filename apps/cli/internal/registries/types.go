@@ -176,13 +176,26 @@ type RegistryTokens struct {
 	TokenExpiry *time.Time `json:"tokenExpiry,omitempty"`
 }
 
+// TokenRefreshLeadTime is the remaining lifetime of an access token at which
+// the token will be proactively refreshed when a refresh token is available.
+// This avoids tokens expiring part way through long-running operations
+// such as installing multiple large plugins.
+const TokenRefreshLeadTime = 5 * time.Minute
+
 // IsExpired returns true if the access token is expired or about to expire.
 // Considers a token expired if it expires within the next 30 seconds.
 func (t *RegistryTokens) IsExpired() bool {
+	return t.ExpiresWithin(30 * time.Second)
+}
+
+// ExpiresWithin returns true if the access token expires within the
+// given duration from now.
+// Tokens without an expiry time are never considered to expire.
+func (t *RegistryTokens) ExpiresWithin(duration time.Duration) bool {
 	if t == nil || t.TokenExpiry == nil {
 		return false
 	}
-	return time.Now().Add(30 * time.Second).After(*t.TokenExpiry)
+	return time.Now().Add(duration).After(*t.TokenExpiry)
 }
 
 // TokensFile represents the plugins.tokens.json file structure.
@@ -0,0 +1,37 @@
+package resourceimport
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/tailscale/hujson"
+)
+
+// LoadOperationConfig loads the provider configuration, context variables and
+// blueprint variables used to list and import resources from a deploy config file.
+// An empty configuration is returned when the file does not exist.
+func LoadOperationConfig(path string) (*types.BlueprintOperationConfig, error) {
+	config := &types.BlueprintOperationConfig{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return config, nil
+		}
+		return nil, fmt.Errorf("failed to read deploy config: %w", err)
+	}
+
+	data, err = hujson.Standardize(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse deploy config: %w", err)
+	}
+
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse deploy config: %w", err)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,38 @@
+package resourceimport
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+)
+
+// ParseMappings parses resource mappings in the "resourceName=importId" format
+// provided with the --map flag of the import command.
+func ParseMappings(values []string) ([]*types.ImportResourceMappingPayload, error) {
+	mappings := make([]*types.ImportResourceMappingPayload, 0, len(values))
+	seen := map[string]bool{}
+	for _, value := range values {
+		resourceName, importID, hasSeparator := strings.Cut(value, "=")
+		resourceName = strings.TrimSpace(resourceName)
+		importID = strings.TrimSpace(importID)
+		if !hasSeparator || resourceName == "" || importID == "" {
+			return nil, fmt.Errorf(
+				"invalid resource mapping %q, expected the format resourceName=importId",
+				value,
+			)
+		}
+
+		if seen[resourceName] {
+			return nil, fmt.Errorf("resource %q is mapped more than once", resourceName)
+		}
+		seen[resourceName] = true
+
+		mappings = append(mappings, &types.ImportResourceMappingPayload{
+			ResourceName: resourceName,
+			ImportID:     importID,
+		})
+	}
+
+	return mappings, nil
+}
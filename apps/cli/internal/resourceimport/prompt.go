@@ -0,0 +1,161 @@
+package resourceimport
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+)
+
+// Prompter walks users through mapping the unmatched resources
+// in a blueprint to existing resources in providers.
+type Prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// NewPrompter creates a prompter that reads answers from in
+// and writes prompts to out.
+func NewPrompter(in io.Reader, out io.Writer) *Prompter {
+	return &Prompter{
+		in:  bufio.NewReader(in),
+		out: out,
+	}
+}
+
+// MapResources prompts the user to choose an existing resource for each of the
+// unmatched resources, users can pick one of the listed candidates,
+// enter an import ID or skip the resource.
+// When the input is exhausted, the remaining resources are skipped.
+func (p *Prompter) MapResources(
+	candidates *container.ImportCandidatesResult,
+) ([]*types.ImportResourceMappingPayload, error) {
+	mappings := []*types.ImportResourceMappingPayload{}
+	if len(candidates.Resources) == 0 {
+		fmt.Fprintln(p.out, "All resources in the blueprint are already tracked in the blueprint instance.")
+		return mappings, nil
+	}
+
+	for _, resource := range candidates.Resources {
+		p.writeResource(resource)
+
+		importID, err := p.promptImportID(resource)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return mappings, nil
+			}
+			return nil, err
+		}
+
+		if importID != "" {
+			mappings = append(mappings, &types.ImportResourceMappingPayload{
+				ResourceName: resource.ResourceName,
+				ImportID:     importID,
+			})
+		}
+	}
+
+	return mappings, nil
+}
+
+// Confirm asks the user to confirm importing the mapped resources.
+func (p *Prompter) Confirm(mappings []*types.ImportResourceMappingPayload) (bool, error) {
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "The following resources will be imported:")
+	for _, mapping := range mappings {
+		fmt.Fprintf(p.out, "  %s -> %s\n", mapping.ResourceName, mapping.ImportID)
+	}
+
+	fmt.Fprint(p.out, "Import these resources? [y/N]: ")
+	answer, err := p.readLine()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+
+	answer = strings.ToLower(answer)
+	return answer == "y" || answer == "yes", nil
+}
+
+func (p *Prompter) writeResource(resource *container.UnmatchedResource) {
+	fmt.Fprintln(p.out)
+	fmt.Fprintf(p.out, "%s (%s)\n", resource.ResourceName, resource.ResourceType)
+
+	if resource.Error != "" {
+		fmt.Fprintf(p.out, "  Could not list existing resources: %s\n", resource.Error)
+	} else if !resource.ListingSupported {
+		fmt.Fprintln(p.out, "  Listing existing resources is not supported for this resource type.")
+	} else if len(resource.Candidates) == 0 {
+		fmt.Fprintln(p.out, "  No existing resources found.")
+	}
+
+	for i, candidate := range resource.Candidates {
+		fmt.Fprintf(p.out, "  [%d] %s\n", i+1, describeCandidate(candidate.ImportID, candidate.Name, candidate.Description))
+	}
+}
+
+func (p *Prompter) promptImportID(resource *container.UnmatchedResource) (string, error) {
+	for {
+		if len(resource.Candidates) > 0 {
+			fmt.Fprintf(
+				p.out,
+				"Select a resource [1-%d], enter an import ID or press enter to skip: ",
+				len(resource.Candidates),
+			)
+		} else {
+			fmt.Fprint(p.out, "Enter an import ID or press enter to skip: ")
+		}
+
+		answer, err := p.readLine()
+		if err != nil && (!errors.Is(err, io.EOF) || answer == "") {
+			return "", err
+		}
+
+		selected, isNumber := parseSelection(answer)
+		if !isNumber {
+			return answer, nil
+		}
+
+		if selected >= 1 && selected <= len(resource.Candidates) {
+			return resource.Candidates[selected-1].ImportID, nil
+		}
+
+		if len(resource.Candidates) == 0 {
+			// Numeric import IDs are valid for some providers.
+			return answer, nil
+		}
+
+		fmt.Fprintf(p.out, "  %d is not one of the listed resources.\n", selected)
+		if errors.Is(err, io.EOF) {
+			return "", err
+		}
+	}
+}
+
+func (p *Prompter) readLine() (string, error) {
+	line, err := p.in.ReadString('\n')
+	return strings.TrimSpace(line), err
+}
+
+func parseSelection(answer string) (int, bool) {
+	selected, err := strconv.Atoi(answer)
+	return selected, err == nil
+}
+
+func describeCandidate(importID string, name string, description string) string {
+	var sb strings.Builder
+	sb.WriteString(importID)
+	if name != "" && name != importID {
+		sb.WriteString(fmt.Sprintf(" (%s)", name))
+	}
+	if description != "" {
+		sb.WriteString(" - ")
+		sb.WriteString(description)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,121 @@
+package resourceimport
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type PrompterSuite struct {
+	suite.Suite
+	candidates *container.ImportCandidatesResult
+}
+
+func (s *PrompterSuite) SetupTest() {
+	s.candidates = &container.ImportCandidatesResult{
+		Resources: []*container.UnmatchedResource{
+			{
+				ResourceName:     "ordersQueue",
+				ResourceType:     "aws/sqs/queue",
+				ListingSupported: true,
+				Candidates: []*provider.ExternalResource{
+					{ImportID: "arn:aws:sqs:eu-west-2:123456789012:orders", Name: "orders"},
+					{ImportID: "arn:aws:sqs:eu-west-2:123456789012:payments", Name: "payments"},
+				},
+			},
+			{
+				ResourceName: "ordersTable",
+				ResourceType: "aws/dynamodb/table",
+				Candidates:   []*provider.ExternalResource{},
+			},
+			{
+				ResourceName:     "handler",
+				ResourceType:     "aws/lambda/function",
+				ListingSupported: true,
+				Candidates:       []*provider.ExternalResource{},
+				Error:            "access denied",
+			},
+		},
+	}
+}
+
+func (s *PrompterSuite) Test_maps_selected_candidates_and_entered_import_ids() {
+	out := &bytes.Buffer{}
+	prompter := NewPrompter(strings.NewReader("5\n2\norders-table\n\n"), out)
+
+	mappings, err := prompter.MapResources(s.candidates)
+	s.Require().NoError(err)
+	s.Equal(
+		[]*types.ImportResourceMappingPayload{
+			{ResourceName: "ordersQueue", ImportID: "arn:aws:sqs:eu-west-2:123456789012:payments"},
+			{ResourceName: "ordersTable", ImportID: "orders-table"},
+		},
+		mappings,
+	)
+
+	output := out.String()
+	s.Contains(output, "ordersQueue (aws/sqs/queue)")
+	s.Contains(output, "[2] arn:aws:sqs:eu-west-2:123456789012:payments (payments)")
+	s.Contains(output, "5 is not one of the listed resources.")
+	s.Contains(output, "Listing existing resources is not supported for this resource type.")
+	s.Contains(output, "Could not list existing resources: access denied")
+}
+
+func (s *PrompterSuite) Test_skips_remaining_resources_when_input_ends() {
+	prompter := NewPrompter(strings.NewReader("1"), &bytes.Buffer{})
+
+	mappings, err := prompter.MapResources(s.candidates)
+	s.Require().NoError(err)
+	s.Equal(
+		[]*types.ImportResourceMappingPayload{
+			{ResourceName: "ordersQueue", ImportID: "arn:aws:sqs:eu-west-2:123456789012:orders"},
+		},
+		mappings,
+	)
+}
+
+func (s *PrompterSuite) Test_confirms_mappings() {
+	mappings := []*types.ImportResourceMappingPayload{
+		{ResourceName: "ordersTable", ImportID: "orders-table"},
+	}
+
+	out := &bytes.Buffer{}
+	confirmed, err := NewPrompter(strings.NewReader("y\n"), out).Confirm(mappings)
+	s.Require().NoError(err)
+	s.True(confirmed)
+	s.Contains(out.String(), "ordersTable -> orders-table")
+
+	confirmed, err = NewPrompter(strings.NewReader(""), &bytes.Buffer{}).Confirm(mappings)
+	s.Require().NoError(err)
+	s.False(confirmed)
+}
+
+func (s *PrompterSuite) Test_parses_mappings() {
+	mappings, err := ParseMappings([]string{
+		"ordersQueue=arn:aws:sqs:eu-west-2:123456789012:orders",
+		" ordersTable = orders-table=v2 ",
+	})
+	s.Require().NoError(err)
+	s.Equal(
+		[]*types.ImportResourceMappingPayload{
+			{ResourceName: "ordersQueue", ImportID: "arn:aws:sqs:eu-west-2:123456789012:orders"},
+			{ResourceName: "ordersTable", ImportID: "orders-table=v2"},
+		},
+		mappings,
+	)
+
+	_, err = ParseMappings([]string{"ordersQueue"})
+	s.ErrorContains(err, "expected the format resourceName=importId")
+
+	_, err = ParseMappings([]string{"ordersQueue=a", "ordersQueue=b"})
+	s.ErrorContains(err, "mapped more than once")
+}
+
+func TestPrompterSuite(t *testing.T) {
+	suite.Run(t, new(PrompterSuite))
+}
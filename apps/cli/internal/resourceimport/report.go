@@ -0,0 +1,36 @@
+package resourceimport
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+)
+
+// WriteResult writes a summary of the resources that were imported
+// and the resources that could not be imported.
+func WriteResult(w io.Writer, instanceName string, result *container.ImportResourcesResult) {
+	if result.InstanceCreated {
+		fmt.Fprintf(w, "Created blueprint instance %q (%s)\n", instanceName, result.InstanceID)
+	}
+
+	fmt.Fprintln(w, "Imported resources:")
+	for _, imported := range result.Imported {
+		fmt.Fprintf(w, "  - %s: %s\n", imported.ResourceName, imported.ImportID)
+	}
+
+	if len(result.Errors) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "Failed to import:")
+	for _, importErr := range result.Errors {
+		fmt.Fprintf(
+			w,
+			"  - %s: %s\n      %s\n",
+			importErr.ResourceName,
+			importErr.ImportID,
+			importErr.Error,
+		)
+	}
+}
@@ -0,0 +1,548 @@
+package stacks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/profiling"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/errors"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+)
+
+// DeployEngine provides the subset of deploy engine operations
+// that are needed to deploy the stacks in a stack file.
+type DeployEngine interface {
+	CreateChangeset(
+		ctx context.Context,
+		payload *types.CreateChangesetPayload,
+	) (*types.ChangesetResponse, error)
+	StreamChangeStagingEvents(
+		ctx context.Context,
+		changesetID string,
+		lastEventID string,
+		streamTo chan<- types.ChangeStagingEvent,
+		errChan chan<- error,
+	) error
+	CreateBlueprintInstance(
+		ctx context.Context,
+		payload *types.BlueprintInstancePayload,
+	) (*types.BlueprintInstanceResponse, error)
+	UpdateBlueprintInstance(
+		ctx context.Context,
+		instanceID string,
+		payload *types.BlueprintInstancePayload,
+	) (*types.BlueprintInstanceResponse, error)
+	GetBlueprintInstance(
+		ctx context.Context,
+		instanceID string,
+	) (*state.InstanceState, error)
+	StreamBlueprintInstanceEvents(
+		ctx context.Context,
+		instanceID string,
+		lastEventID string,
+		streamTo chan<- types.BlueprintInstanceEvent,
+		errChan chan<- error,
+	) error
+	GetBlueprintInstanceExports(
+		ctx context.Context,
+		instanceID string,
+	) (map[string]*state.ExportState, error)
+}
+
+// HookRunner runs the hooks defined in a blueprint around the deployment
+// of the blueprint instance for a stack.
+type HookRunner interface {
+	Run(ctx context.Context, info *container.HookInfo) error
+}
+
+// HookRunnerLoader loads the hook runner for the blueprint file at the given path.
+type HookRunnerLoader func(blueprintPath string) (HookRunner, error)
+
+// StackStatus is the outcome of deploying a stack.
+type StackStatus string
+
+const (
+	// StackStatusDeployed indicates that the blueprint instance for the stack
+	// was deployed successfully.
+	StackStatusDeployed StackStatus = "deployed"
+	// StackStatusFailed indicates that the deployment of the blueprint
+	// instance for the stack failed.
+	StackStatusFailed StackStatus = "failed"
+	// StackStatusSkipped indicates that the stack was not deployed
+	// because one of the stacks it depends on was not deployed successfully.
+	StackStatusSkipped StackStatus = "skipped"
+)
+
+// StackResult holds the outcome of deploying a single stack.
+type StackResult struct {
+	StackName    string
+	InstanceName string
+	InstanceID   string
+	Status       StackStatus
+	// Reasons holds the reasons a stack failed or was skipped.
+	Reasons []string
+}
+
+// Result holds the aggregate outcome of deploying the stacks in a stack file,
+// in the order the stacks were deployed.
+type Result struct {
+	Stacks []*StackResult
+}
+
+// HasFailures returns true if at least one of the stacks
+// was not deployed successfully.
+func (r *Result) HasFailures() bool {
+	return slices.ContainsFunc(r.Stacks, func(stackResult *StackResult) bool {
+		return stackResult.Status != StackStatusDeployed
+	})
+}
+
+// Orchestrator deploys the stacks in a stack file in dependency order,
+// passing exports from deployed blueprint instances to the blueprints
+// that depend on them.
+type Orchestrator struct {
+	engine         DeployEngine
+	stackFile      *File
+	progressWriter io.Writer
+	loadHookRunner HookRunnerLoader
+	timings        *profiling.Timings
+}
+
+// OrchestratorOption is a function that configures an orchestrator.
+type OrchestratorOption func(*Orchestrator)
+
+// WithProgressWriter sets the writer that progress messages are written to
+// as each stack is deployed.
+func WithProgressWriter(writer io.Writer) OrchestratorOption {
+	return func(o *Orchestrator) {
+		o.progressWriter = writer
+	}
+}
+
+// WithBlueprintHooks enables running the beforeDeploy and afterDeploy hooks
+// defined in each blueprint around the deployment of its stack.
+// Resource hooks are not run as resources are deployed by the deploy engine.
+// A failing beforeDeploy hook causes the stack to fail without being deployed,
+// a failing afterDeploy hook causes the stack to fail after it has been deployed.
+//
+// When this option is not provided, blueprint hooks are not run.
+func WithBlueprintHooks(loadHookRunner HookRunnerLoader) OrchestratorOption {
+	return func(o *Orchestrator) {
+		o.loadHookRunner = loadHookRunner
+	}
+}
+
+// WithTimings sets the recorder that the time spent staging changes
+// and deploying each stack is tracked with.
+// When provided, the time spent deploying the resources of each stack
+// is also recorded for the provider of each resource.
+//
+// When this option is not provided, timings are not recorded.
+func WithTimings(timings *profiling.Timings) OrchestratorOption {
+	return func(o *Orchestrator) {
+		o.timings = timings
+	}
+}
+
+// NewOrchestrator creates a new orchestrator that deploys the stacks
+// in the given stack file with the provided deploy engine.
+func NewOrchestrator(
+	engine DeployEngine,
+	stackFile *File,
+	opts ...OrchestratorOption,
+) *Orchestrator {
+	orchestrator := &Orchestrator{
+		engine:         engine,
+		stackFile:      stackFile,
+		progressWriter: io.Discard,
+	}
+
+	for _, opt := range opts {
+		opt(orchestrator)
+	}
+
+	return orchestrator
+}
+
+// Deploy deploys all the stacks in the stack file in dependency order.
+// A failure to deploy a stack does not stop independent stacks from being
+// deployed, stacks that depend on a stack that failed are skipped.
+// An error is only returned if the deployment order could not be determined.
+func (o *Orchestrator) Deploy(ctx context.Context) (*Result, error) {
+	order, err := DeploymentOrder(o.stackFile)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Stacks: make([]*StackResult, 0, len(order)),
+	}
+	statuses := map[string]StackStatus{}
+	exports := map[string]map[string]*state.ExportState{}
+	for _, stackName := range order {
+		stack := o.stackFile.Stacks[stackName]
+		stackResult := &StackResult{
+			StackName:    stackName,
+			InstanceName: stack.InstanceName,
+		}
+		result.Stacks = append(result.Stacks, stackResult)
+
+		notDeployed := dependenciesNotDeployed(o.stackFile.Dependencies(stackName), statuses)
+		if len(notDeployed) > 0 {
+			stackResult.Status = StackStatusSkipped
+			for _, dependency := range notDeployed {
+				stackResult.Reasons = append(
+					stackResult.Reasons,
+					fmt.Sprintf("dependency %q was not deployed", dependency),
+				)
+			}
+			statuses[stackName] = stackResult.Status
+			fmt.Fprintf(o.progressWriter, "Skipping stack %q\n", stackName)
+			continue
+		}
+
+		fmt.Fprintf(
+			o.progressWriter,
+			"Deploying stack %q to instance %q\n",
+			stackName,
+			stack.InstanceName,
+		)
+		stackExports, err := o.deployStack(ctx, stack, exports, stackResult)
+		if err != nil {
+			stackResult.Status = StackStatusFailed
+			stackResult.Reasons = append(stackResult.Reasons, failureReasons(err)...)
+		} else {
+			stackResult.Status = StackStatusDeployed
+			exports[stackName] = stackExports
+		}
+		statuses[stackName] = stackResult.Status
+		fmt.Fprintf(o.progressWriter, "Stack %q %s\n", stackName, stackResult.Status)
+	}
+
+	return result, nil
+}
+
+func (o *Orchestrator) deployStack(
+	ctx context.Context,
+	stack *Stack,
+	exports map[string]map[string]*state.ExportState,
+	stackResult *StackResult,
+) (map[string]*state.ExportState, error) {
+	variables, err := resolveVariables(stack, exports)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := o.instanceExists(ctx, stack.InstanceName)
+	if err != nil {
+		return nil, err
+	}
+
+	blueprintPath := o.stackFile.BlueprintPath(stack)
+	blueprintDirectory, err := filepath.Abs(filepath.Dir(blueprintPath))
+	if err != nil {
+		return nil, err
+	}
+	documentInfo := types.BlueprintDocumentInfo{
+		FileSourceScheme: "file",
+		Directory:        blueprintDirectory,
+		BlueprintFile:    filepath.Base(blueprintPath),
+	}
+	config := &types.BlueprintOperationConfig{
+		BlueprintVariables: variables,
+	}
+
+	changesetPayload := &types.CreateChangesetPayload{
+		BlueprintDocumentInfo: documentInfo,
+		Config:                config,
+	}
+	if exists {
+		changesetPayload.InstanceName = stack.InstanceName
+	}
+	stopStageTiming := o.timings.Track(profiling.PhaseStage)
+	changesetID, err := o.stageChanges(ctx, changesetPayload)
+	stopStageTiming()
+	if err != nil {
+		return nil, err
+	}
+
+	hookRunner, err := o.hookRunner(blueprintPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = hookRunner.Run(ctx, &container.HookInfo{
+		Event:        schema.HookEventBeforeDeploy,
+		InstanceName: stack.InstanceName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s hook failed: %w", schema.HookEventBeforeDeploy, err)
+	}
+
+	instancePayload := &types.BlueprintInstancePayload{
+		BlueprintDocumentInfo: documentInfo,
+		InstanceName:          stack.InstanceName,
+		ChangeSetID:           changesetID,
+		Config:                config,
+	}
+	stopDeployTiming := o.timings.Track(profiling.PhaseDeploy)
+	var response *types.BlueprintInstanceResponse
+	if exists {
+		response, err = o.engine.UpdateBlueprintInstance(ctx, stack.InstanceName, instancePayload)
+	} else {
+		response, err = o.engine.CreateBlueprintInstance(ctx, instancePayload)
+	}
+	if err != nil {
+		stopDeployTiming()
+		return nil, err
+	}
+	stackResult.InstanceID = response.Data.InstanceID
+
+	err = o.waitForDeployment(ctx, response.Data.InstanceID, response.LastEventID, stackResult)
+	stopDeployTiming()
+	if err != nil {
+		return nil, err
+	}
+
+	o.recordProviderTimings(ctx, response.Data.InstanceID)
+
+	err = hookRunner.Run(ctx, &container.HookInfo{
+		Event:        schema.HookEventAfterDeploy,
+		InstanceID:   response.Data.InstanceID,
+		InstanceName: stack.InstanceName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s hook failed: %w", schema.HookEventAfterDeploy, err)
+	}
+
+	return o.engine.GetBlueprintInstanceExports(ctx, response.Data.InstanceID)
+}
+
+func (o *Orchestrator) recordProviderTimings(ctx context.Context, instanceID string) {
+	if o.timings == nil {
+		return
+	}
+
+	instance, err := o.engine.GetBlueprintInstance(ctx, instanceID)
+	if err != nil {
+		// Timings are diagnostic information, failing to retrieve them
+		// should not cause a successfully deployed stack to fail.
+		return
+	}
+
+	for _, resource := range instance.Resources {
+		if resource.Durations == nil || resource.Durations.TotalDuration == nil {
+			continue
+		}
+
+		o.timings.AddProviderTime(
+			resource.Type,
+			time.Duration(*resource.Durations.TotalDuration*float64(time.Millisecond)),
+		)
+	}
+}
+
+func (o *Orchestrator) hookRunner(blueprintPath string) (HookRunner, error) {
+	if o.loadHookRunner == nil {
+		return noopHookRunner{}, nil
+	}
+
+	return o.loadHookRunner(blueprintPath)
+}
+
+type noopHookRunner struct{}
+
+func (noopHookRunner) Run(ctx context.Context, info *container.HookInfo) error {
+	return nil
+}
+
+func (o *Orchestrator) instanceExists(ctx context.Context, instanceName string) (bool, error) {
+	_, err := o.engine.GetBlueprintInstance(ctx, instanceName)
+	if err != nil {
+		if _, isNotFound := errors.IsNotFoundError(err); isNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (o *Orchestrator) stageChanges(
+	ctx context.Context,
+	payload *types.CreateChangesetPayload,
+) (string, error) {
+	response, err := o.engine.CreateChangeset(ctx, payload)
+	if err != nil {
+		return "", err
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan types.ChangeStagingEvent)
+	errChan := make(chan error)
+	err = o.engine.StreamChangeStagingEvents(
+		streamCtx,
+		response.Data.ID,
+		response.LastEventID,
+		events,
+		errChan,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case err := <-errChan:
+			return "", err
+		case event := <-events:
+			if _, isComplete := event.AsCompleteChanges(); isComplete {
+				return response.Data.ID, nil
+			}
+
+			if _, hasDrift := event.AsDriftDetected(); hasDrift {
+				return "", fmt.Errorf(
+					"drift was detected when staging changes, " +
+						"reconcile the blueprint instance before deploying the stack",
+				)
+			}
+		}
+	}
+}
+
+func (o *Orchestrator) waitForDeployment(
+	ctx context.Context,
+	instanceID string,
+	lastEventID string,
+	stackResult *StackResult,
+) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan types.BlueprintInstanceEvent)
+	errChan := make(chan error)
+	err := o.engine.StreamBlueprintInstanceEvents(
+		streamCtx,
+		instanceID,
+		lastEventID,
+		events,
+		errChan,
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errChan:
+			return err
+		case event := <-events:
+			finished, isFinished := event.AsFinish()
+			if !isFinished {
+				continue
+			}
+
+			if finished.Status == core.InstanceStatusDeployed ||
+				finished.Status == core.InstanceStatusUpdated {
+				return nil
+			}
+
+			stackResult.Reasons = append(stackResult.Reasons, finished.FailureReasons...)
+			return fmt.Errorf("deployment finished with status %s", finished.Status.String())
+		}
+	}
+}
+
+// Derives the reasons a stack failed from an error, validation errors
+// for the blueprint of the stack are grouped by element and reason code
+// so that large blueprints do not produce a long flat list of errors.
+func failureReasons(err error) []string {
+	reasons := []string{err.Error()}
+	formattedGroups := bperrors.FormatErrorGroups(errorGroups(err))
+	for line := range strings.SplitSeq(formattedGroups, "\n") {
+		if line != "" {
+			reasons = append(reasons, line)
+		}
+	}
+
+	return reasons
+}
+
+func errorGroups(err error) []*bperrors.ErrorGroup {
+	switch typedErr := err.(type) {
+	case *errors.ClientError:
+		return typedErr.ValidationErrorGroups
+	case *errors.StreamError:
+		if typedErr.Event != nil {
+			return typedErr.Event.ErrorGroups
+		}
+	}
+
+	return nil
+}
+
+func resolveVariables(
+	stack *Stack,
+	exports map[string]map[string]*state.ExportState,
+) (map[string]*core.ScalarValue, error) {
+	variables := maps.Clone(stack.Variables)
+	if variables == nil {
+		variables = map[string]*core.ScalarValue{}
+	}
+
+	for variableName, input := range stack.Inputs {
+		ref, err := ParseExportReference(input)
+		if err != nil {
+			return nil, err
+		}
+
+		export, hasExport := exports[ref.StackName][ref.ExportName]
+		if !hasExport || export.Value == nil {
+			return nil, fmt.Errorf(
+				"stack %q does not have an export named %q for input %q",
+				ref.StackName,
+				ref.ExportName,
+				variableName,
+			)
+		}
+
+		if export.Value.Scalar == nil {
+			return nil, fmt.Errorf(
+				"export %q of stack %q must be a scalar value to be used for input %q",
+				ref.ExportName,
+				ref.StackName,
+				variableName,
+			)
+		}
+		variables[variableName] = export.Value.Scalar
+	}
+
+	return variables, nil
+}
+
+func dependenciesNotDeployed(dependencies []string, statuses map[string]StackStatus) []string {
+	notDeployed := []string{}
+	for _, dependency := range dependencies {
+		if statuses[dependency] != StackStatusDeployed {
+			notDeployed = append(notDeployed, dependency)
+		}
+	}
+	return notDeployed
+}
@@ -0,0 +1,431 @@
+package stacks
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/apps/cli/internal/profiling"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/errors"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrchestratorSuite struct {
+	suite.Suite
+	engine    *fakeDeployEngine
+	stackFile *File
+}
+
+func (s *OrchestratorSuite) SetupTest() {
+	s.engine = &fakeDeployEngine{
+		existingInstances: map[string]bool{
+			"acme-network": true,
+		},
+		failingInstances: map[string]bool{},
+		exports: map[string]map[string]*state.ExportState{
+			"acme-network-id": {
+				"vpcId": {Value: core.MappingNodeFromString("vpc-123")},
+			},
+			"acme-database-id": {
+				"tableName": {Value: core.MappingNodeFromString("orders")},
+			},
+		},
+		instancePayloads: map[string]*types.BlueprintInstancePayload{},
+	}
+	s.stackFile = &File{
+		Stacks: map[string]*Stack{
+			"network": {
+				BlueprintFile: "network/project.blueprint.yaml",
+				InstanceName:  "acme-network",
+				Variables: map[string]*core.ScalarValue{
+					"region": core.ScalarFromString("eu-west-2"),
+				},
+			},
+			"database": {
+				BlueprintFile: "database/project.blueprint.yaml",
+				InstanceName:  "acme-database",
+				Inputs: map[string]string{
+					"vpcId": "network.vpcId",
+				},
+			},
+			"api": {
+				BlueprintFile: "api/project.blueprint.yaml",
+				InstanceName:  "acme-api",
+				Inputs: map[string]string{
+					"tableName": "database.tableName",
+				},
+			},
+			"docs": {
+				BlueprintFile: "docs/project.blueprint.yaml",
+				InstanceName:  "acme-docs",
+			},
+		},
+		directory: "/infra",
+	}
+}
+
+func (s *OrchestratorSuite) Test_deploys_stacks_in_order_and_passes_exports() {
+	orchestrator := NewOrchestrator(s.engine, s.stackFile)
+
+	result, err := orchestrator.Deploy(context.Background())
+	s.Require().NoError(err)
+	s.False(result.HasFailures())
+	s.Equal(
+		[]*StackResult{
+			{StackName: "docs", InstanceName: "acme-docs", InstanceID: "acme-docs-id", Status: StackStatusDeployed},
+			{StackName: "network", InstanceName: "acme-network", InstanceID: "acme-network-id", Status: StackStatusDeployed},
+			{StackName: "database", InstanceName: "acme-database", InstanceID: "acme-database-id", Status: StackStatusDeployed},
+			{StackName: "api", InstanceName: "acme-api", InstanceID: "acme-api-id", Status: StackStatusDeployed},
+		},
+		result.Stacks,
+	)
+
+	s.Equal([]string{"acme-network"}, s.engine.updatedInstances)
+	s.Equal([]string{"acme-docs", "acme-database", "acme-api"}, s.engine.createdInstances)
+
+	networkPayload := s.engine.instancePayloads["acme-network"]
+	s.Equal("/infra/network", networkPayload.Directory)
+	s.Equal("project.blueprint.yaml", networkPayload.BlueprintFile)
+	s.Equal("eu-west-2", networkPayload.Config.BlueprintVariables["region"].ToString())
+
+	databasePayload := s.engine.instancePayloads["acme-database"]
+	s.Equal("vpc-123", databasePayload.Config.BlueprintVariables["vpcId"].ToString())
+
+	apiPayload := s.engine.instancePayloads["acme-api"]
+	s.Equal("orders", apiPayload.Config.BlueprintVariables["tableName"].ToString())
+}
+
+func (s *OrchestratorSuite) Test_skips_stacks_that_depend_on_a_failed_stack() {
+	s.engine.failingInstances["acme-database"] = true
+	orchestrator := NewOrchestrator(s.engine, s.stackFile)
+
+	result, err := orchestrator.Deploy(context.Background())
+	s.Require().NoError(err)
+	s.True(result.HasFailures())
+	s.Equal(
+		[]*StackResult{
+			{StackName: "docs", InstanceName: "acme-docs", InstanceID: "acme-docs-id", Status: StackStatusDeployed},
+			{StackName: "network", InstanceName: "acme-network", InstanceID: "acme-network-id", Status: StackStatusDeployed},
+			{
+				StackName:    "database",
+				InstanceName: "acme-database",
+				InstanceID:   "acme-database-id",
+				Status:       StackStatusFailed,
+				Reasons: []string{
+					"table quota exceeded",
+					"deployment finished with status DEPLOY FAILED",
+				},
+			},
+			{
+				StackName:    "api",
+				InstanceName: "acme-api",
+				Status:       StackStatusSkipped,
+				Reasons:      []string{`dependency "database" was not deployed`},
+			},
+		},
+		result.Stacks,
+	)
+}
+
+func (s *OrchestratorSuite) Test_reports_failure_for_missing_export() {
+	delete(s.engine.exports, "acme-network-id")
+	orchestrator := NewOrchestrator(s.engine, s.stackFile)
+
+	result, err := orchestrator.Deploy(context.Background())
+	s.Require().NoError(err)
+	s.True(result.HasFailures())
+	s.Equal(StackStatusFailed, result.Stacks[2].Status)
+	s.Equal(
+		[]string{`stack "network" does not have an export named "vpcId" for input "vpcId"`},
+		result.Stacks[2].Reasons,
+	)
+	s.Equal(StackStatusSkipped, result.Stacks[3].Status)
+}
+
+func (s *OrchestratorSuite) Test_reports_grouped_validation_errors_for_failed_stack() {
+	s.engine.deployErrors = map[string]error{
+		"acme-docs": &errors.ClientError{
+			StatusCode: 422,
+			Message:    "failed to load the blueprint document specified in the request",
+			ValidationErrorGroups: []*bperrors.ErrorGroup{
+				{
+					Element:    &bperrors.ErrorElement{Type: "resource", Name: "siteBucket"},
+					ReasonCode: "invalid_resource",
+					Errors: []*bperrors.GroupedError{
+						{
+							Message:  `resource "siteBucket_0" is missing a spec`,
+							Count:    2,
+							Elements: []string{"siteBucket_0", "siteBucket_1"},
+						},
+					},
+				},
+			},
+		},
+	}
+	orchestrator := NewOrchestrator(s.engine, s.stackFile)
+
+	result, err := orchestrator.Deploy(context.Background())
+	s.Require().NoError(err)
+	s.True(result.HasFailures())
+	s.Equal(StackStatusFailed, result.Stacks[0].Status)
+	s.Equal(
+		[]string{
+			"client error: failed to load the blueprint document specified in the request (status code: 422)",
+			`resource "siteBucket" (invalid_resource):`,
+			`  - resource "siteBucket_0" is missing a spec (x2) [siteBucket_0, siteBucket_1]`,
+		},
+		result.Stacks[0].Reasons,
+	)
+}
+
+func (s *OrchestratorSuite) Test_runs_blueprint_hooks_around_stack_deployments() {
+	runner := &fakeHookRunner{}
+	loadedPaths := []string{}
+	orchestrator := NewOrchestrator(
+		s.engine,
+		s.stackFile,
+		WithBlueprintHooks(func(blueprintPath string) (HookRunner, error) {
+			loadedPaths = append(loadedPaths, blueprintPath)
+			return runner, nil
+		}),
+	)
+
+	result, err := orchestrator.Deploy(context.Background())
+	s.Require().NoError(err)
+	s.False(result.HasFailures())
+	s.Len(loadedPaths, 4)
+	s.Equal(
+		[]string{
+			"beforeDeploy:acme-docs",
+			"afterDeploy:acme-docs",
+			"beforeDeploy:acme-network",
+			"afterDeploy:acme-network",
+			"beforeDeploy:acme-database",
+			"afterDeploy:acme-database",
+			"beforeDeploy:acme-api",
+			"afterDeploy:acme-api",
+		},
+		runner.calls,
+	)
+}
+
+func (s *OrchestratorSuite) Test_fails_stack_without_deploying_when_before_deploy_hook_fails() {
+	runner := &fakeHookRunner{
+		failEvent:    schema.HookEventBeforeDeploy,
+		failInstance: "acme-docs",
+	}
+	orchestrator := NewOrchestrator(
+		s.engine,
+		s.stackFile,
+		WithBlueprintHooks(func(blueprintPath string) (HookRunner, error) {
+			return runner, nil
+		}),
+	)
+
+	result, err := orchestrator.Deploy(context.Background())
+	s.Require().NoError(err)
+	s.True(result.HasFailures())
+	s.Equal(StackStatusFailed, result.Stacks[0].Status)
+	s.Equal(
+		[]string{"beforeDeploy hook failed: hook command failed"},
+		result.Stacks[0].Reasons,
+	)
+	s.NotContains(s.engine.createdInstances, "acme-docs")
+}
+
+func (s *OrchestratorSuite) Test_records_phase_and_provider_timings() {
+	s.engine.instanceResources = map[string]map[string]*state.ResourceState{
+		"acme-network-id": {
+			"vpc-resource-id": {
+				Type:      "aws/ec2/vpc",
+				Durations: &state.ResourceCompletionDurations{TotalDuration: floatPtr(1500)},
+			},
+		},
+		"acme-database-id": {
+			"table-resource-id": {
+				Type:      "aws/dynamodb/table",
+				Durations: &state.ResourceCompletionDurations{TotalDuration: floatPtr(2500)},
+			},
+			"dns-resource-id": {
+				Type:      "cloudflare/dns/record",
+				Durations: &state.ResourceCompletionDurations{TotalDuration: floatPtr(250)},
+			},
+		},
+	}
+	timings := profiling.NewTimings()
+	orchestrator := NewOrchestrator(s.engine, s.stackFile, WithTimings(timings))
+
+	result, err := orchestrator.Deploy(context.Background())
+	s.Require().NoError(err)
+	s.False(result.HasFailures())
+
+	report := &bytes.Buffer{}
+	timings.WriteReport(report)
+	s.Contains(report.String(), "  stage ")
+	s.Contains(report.String(), "  deploy ")
+	s.Contains(report.String(), "Resource deployment time by provider:")
+	s.Regexp(`  aws\s+4s`, report.String())
+	s.Regexp(`  cloudflare\s+250ms`, report.String())
+}
+
+func TestOrchestratorSuite(t *testing.T) {
+	suite.Run(t, new(OrchestratorSuite))
+}
+
+type fakeDeployEngine struct {
+	existingInstances map[string]bool
+	failingInstances  map[string]bool
+	exports           map[string]map[string]*state.ExportState
+	instancePayloads  map[string]*types.BlueprintInstancePayload
+	// Errors to return when deploying an instance keyed by instance name.
+	deployErrors map[string]error
+	// Deployed resources keyed by instance ID.
+	instanceResources map[string]map[string]*state.ResourceState
+	createdInstances  []string
+	updatedInstances  []string
+}
+
+func (e *fakeDeployEngine) CreateChangeset(
+	ctx context.Context,
+	payload *types.CreateChangesetPayload,
+) (*types.ChangesetResponse, error) {
+	return &types.ChangesetResponse{
+		Data: &manage.Changeset{
+			ID: "changeset-" + payload.Directory,
+		},
+	}, nil
+}
+
+func (e *fakeDeployEngine) StreamChangeStagingEvents(
+	ctx context.Context,
+	changesetID string,
+	lastEventID string,
+	streamTo chan<- types.ChangeStagingEvent,
+	errChan chan<- error,
+) error {
+	go func() {
+		streamTo <- types.ChangeStagingEvent{
+			CompleteChanges: &types.CompleteChangesEventData{},
+		}
+	}()
+	return nil
+}
+
+func (e *fakeDeployEngine) CreateBlueprintInstance(
+	ctx context.Context,
+	payload *types.BlueprintInstancePayload,
+) (*types.BlueprintInstanceResponse, error) {
+	e.createdInstances = append(e.createdInstances, payload.InstanceName)
+	return e.deployInstance(payload)
+}
+
+func (e *fakeDeployEngine) UpdateBlueprintInstance(
+	ctx context.Context,
+	instanceID string,
+	payload *types.BlueprintInstancePayload,
+) (*types.BlueprintInstanceResponse, error) {
+	e.updatedInstances = append(e.updatedInstances, instanceID)
+	return e.deployInstance(payload)
+}
+
+func (e *fakeDeployEngine) deployInstance(
+	payload *types.BlueprintInstancePayload,
+) (*types.BlueprintInstanceResponse, error) {
+	e.instancePayloads[payload.InstanceName] = payload
+	if deployErr, hasDeployErr := e.deployErrors[payload.InstanceName]; hasDeployErr {
+		return nil, deployErr
+	}
+
+	return &types.BlueprintInstanceResponse{
+		Data: state.InstanceState{
+			InstanceID:   payload.InstanceName + "-id",
+			InstanceName: payload.InstanceName,
+		},
+	}, nil
+}
+
+func (e *fakeDeployEngine) GetBlueprintInstance(
+	ctx context.Context,
+	instanceID string,
+) (*state.InstanceState, error) {
+	if resources, hasResources := e.instanceResources[instanceID]; hasResources {
+		return &state.InstanceState{
+			InstanceID: instanceID,
+			Resources:  resources,
+		}, nil
+	}
+
+	if !e.existingInstances[instanceID] {
+		return nil, &errors.ClientError{StatusCode: 404, Message: "instance not found"}
+	}
+
+	return &state.InstanceState{
+		InstanceID:   instanceID + "-id",
+		InstanceName: instanceID,
+	}, nil
+}
+
+func (e *fakeDeployEngine) StreamBlueprintInstanceEvents(
+	ctx context.Context,
+	instanceID string,
+	lastEventID string,
+	streamTo chan<- types.BlueprintInstanceEvent,
+	errChan chan<- error,
+) error {
+	instanceName := strings.TrimSuffix(instanceID, "-id")
+	finished := &container.DeploymentFinishedMessage{
+		InstanceID: instanceID,
+		Status:     core.InstanceStatusDeployed,
+	}
+	if e.existingInstances[instanceName] {
+		finished.Status = core.InstanceStatusUpdated
+	}
+	if e.failingInstances[instanceName] {
+		finished.Status = core.InstanceStatusDeployFailed
+		finished.FailureReasons = []string{"table quota exceeded"}
+	}
+
+	go func() {
+		streamTo <- types.BlueprintInstanceEvent{
+			DeployEvent: container.DeployEvent{
+				FinishEvent: finished,
+			},
+		}
+	}()
+	return nil
+}
+
+func (e *fakeDeployEngine) GetBlueprintInstanceExports(
+	ctx context.Context,
+	instanceID string,
+) (map[string]*state.ExportState, error) {
+	return e.exports[instanceID], nil
+}
+
+type fakeHookRunner struct {
+	calls        []string
+	failEvent    schema.HookEvent
+	failInstance string
+}
+
+func (r *fakeHookRunner) Run(ctx context.Context, info *container.HookInfo) error {
+	r.calls = append(r.calls, fmt.Sprintf("%s:%s", info.Event, info.InstanceName))
+	if info.Event == r.failEvent && info.InstanceName == r.failInstance {
+		return stderrors.New("hook command failed")
+	}
+	return nil
+}
+
+func floatPtr(value float64) *float64 {
+	return &value
+}
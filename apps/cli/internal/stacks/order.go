@@ -0,0 +1,62 @@
+package stacks
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DeploymentOrder returns the names of the stacks in the given stack file
+// in the order they should be deployed, where each stack comes after
+// all of the stacks it depends on.
+// Stacks that do not depend on each other are ordered by name
+// to produce a consistent order.
+// An error is returned if there is a cycle in the dependencies between stacks.
+func DeploymentOrder(stackFile *File) ([]string, error) {
+	remainingDependencies := map[string]int{}
+	dependents := map[string][]string{}
+	for _, stackName := range sortedStackNames(stackFile) {
+		dependencies := stackFile.Dependencies(stackName)
+		remainingDependencies[stackName] = len(dependencies)
+		for _, dependency := range dependencies {
+			dependents[dependency] = append(dependents[dependency], stackName)
+		}
+	}
+
+	ready := []string{}
+	for _, stackName := range sortedStackNames(stackFile) {
+		if remainingDependencies[stackName] == 0 {
+			ready = append(ready, stackName)
+		}
+	}
+
+	order := make([]string, 0, len(stackFile.Stacks))
+	for len(ready) > 0 {
+		current := ready[0]
+		ready = ready[1:]
+		order = append(order, current)
+
+		for _, dependent := range dependents[current] {
+			remainingDependencies[dependent] -= 1
+			if remainingDependencies[dependent] == 0 {
+				ready = append(ready, dependent)
+				slices.Sort(ready)
+			}
+		}
+	}
+
+	if len(order) < len(stackFile.Stacks) {
+		inCycle := []string{}
+		for _, stackName := range sortedStackNames(stackFile) {
+			if remainingDependencies[stackName] > 0 {
+				inCycle = append(inCycle, stackName)
+			}
+		}
+		return nil, fmt.Errorf(
+			"circular dependency detected between stacks: %s",
+			strings.Join(inCycle, ", "),
+		)
+	}
+
+	return order, nil
+}
@@ -0,0 +1,199 @@
+package stacks
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultStackFile is the stack file name used when a stack file
+// is not explicitly provided.
+const DefaultStackFile = "bluelink.stacks.yaml"
+
+// File is a meta-orchestration file that declares multiple blueprints
+// that are deployed as separate blueprint instances, along with
+// the dependencies between them.
+type File struct {
+	// Stacks holds the blueprints to deploy, keyed by a unique stack name
+	// that is used to reference the stack in dependencies and inputs.
+	Stacks map[string]*Stack `yaml:"stacks"`
+	// directory is the directory that contains the stack file,
+	// relative blueprint file paths are resolved from this directory.
+	directory string
+}
+
+// Stack declares a single blueprint in a stack file that is deployed
+// as a blueprint instance.
+type Stack struct {
+	// BlueprintFile is the path to the blueprint file to deploy,
+	// relative paths are resolved from the directory of the stack file.
+	BlueprintFile string `yaml:"blueprintFile"`
+	// InstanceName is the user-defined name of the blueprint instance
+	// that the blueprint is deployed to.
+	InstanceName string `yaml:"instanceName"`
+	// DependsOn holds the names of stacks that must be deployed
+	// before this stack.
+	DependsOn []string `yaml:"dependsOn,omitempty"`
+	// Variables holds the blueprint variable values to deploy the blueprint with.
+	Variables map[string]*core.ScalarValue `yaml:"variables,omitempty"`
+	// Inputs maps blueprint variable names to exports of other stacks
+	// in the form "{stackName}.{exportName}".
+	// Stacks that are referenced in inputs are implicit dependencies.
+	Inputs map[string]string `yaml:"inputs,omitempty"`
+}
+
+// ExportReference is a reference to an export of another stack
+// that is used as the value for a blueprint variable.
+type ExportReference struct {
+	StackName  string
+	ExportName string
+}
+
+// ParseExportReference parses an input value in the form "{stackName}.{exportName}".
+func ParseExportReference(value string) (*ExportReference, error) {
+	stackName, exportName, hasSeparator := strings.Cut(value, ".")
+	if !hasSeparator || stackName == "" || exportName == "" {
+		return nil, fmt.Errorf(
+			"invalid export reference %q, expected the form {stackName}.{exportName}",
+			value,
+		)
+	}
+
+	return &ExportReference{
+		StackName:  stackName,
+		ExportName: exportName,
+	}, nil
+}
+
+// LoadFile loads and validates the stack file at the given path.
+func LoadFile(fileSystem afero.Fs, path string) (*File, error) {
+	contents, err := afero.ReadFile(fileSystem, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stack file: %w", err)
+	}
+
+	stackFile := &File{}
+	if err := yaml.Unmarshal(contents, stackFile); err != nil {
+		return nil, fmt.Errorf("failed to parse stack file: %w", err)
+	}
+	stackFile.directory = filepath.Dir(path)
+
+	if err := stackFile.validate(); err != nil {
+		return nil, err
+	}
+
+	return stackFile, nil
+}
+
+// BlueprintPath returns the path to the blueprint file for the given stack,
+// resolving relative paths from the directory of the stack file.
+func (f *File) BlueprintPath(stack *Stack) string {
+	if filepath.IsAbs(stack.BlueprintFile) {
+		return stack.BlueprintFile
+	}
+
+	return filepath.Join(f.directory, stack.BlueprintFile)
+}
+
+// Dependencies returns the names of the stacks that the given stack
+// depends on, combining explicit dependencies with the stacks
+// referenced in inputs.
+func (f *File) Dependencies(stackName string) []string {
+	stack, exists := f.Stacks[stackName]
+	if !exists {
+		return nil
+	}
+
+	dependencies := slices.Clone(stack.DependsOn)
+	for _, input := range stack.Inputs {
+		ref, err := ParseExportReference(input)
+		if err == nil && !slices.Contains(dependencies, ref.StackName) {
+			dependencies = append(dependencies, ref.StackName)
+		}
+	}
+	slices.Sort(dependencies)
+
+	return slices.Compact(dependencies)
+}
+
+func (f *File) validate() error {
+	if len(f.Stacks) == 0 {
+		return fmt.Errorf("stack file must declare at least one stack")
+	}
+
+	instanceNames := map[string]string{}
+	for _, stackName := range sortedStackNames(f) {
+		stack := f.Stacks[stackName]
+		if stack == nil || stack.BlueprintFile == "" {
+			return fmt.Errorf("stack %q must have a blueprint file", stackName)
+		}
+
+		if stack.InstanceName == "" {
+			return fmt.Errorf("stack %q must have an instance name", stackName)
+		}
+
+		if otherStack, exists := instanceNames[stack.InstanceName]; exists {
+			return fmt.Errorf(
+				"stacks %q and %q can not be deployed to the same instance %q",
+				otherStack,
+				stackName,
+				stack.InstanceName,
+			)
+		}
+		instanceNames[stack.InstanceName] = stackName
+
+		if err := f.validateStackReferences(stackName, stack); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *File) validateStackReferences(stackName string, stack *Stack) error {
+	for _, dependency := range stack.DependsOn {
+		if _, exists := f.Stacks[dependency]; !exists {
+			return fmt.Errorf("stack %q depends on unknown stack %q", stackName, dependency)
+		}
+	}
+
+	for variableName, input := range stack.Inputs {
+		ref, err := ParseExportReference(input)
+		if err != nil {
+			return fmt.Errorf("input %q for stack %q: %w", variableName, stackName, err)
+		}
+
+		if _, exists := f.Stacks[ref.StackName]; !exists {
+			return fmt.Errorf(
+				"input %q for stack %q references unknown stack %q",
+				variableName,
+				stackName,
+				ref.StackName,
+			)
+		}
+
+		if _, hasVariable := stack.Variables[variableName]; hasVariable {
+			return fmt.Errorf(
+				"variable %q for stack %q can not be provided as both a variable and an input",
+				variableName,
+				stackName,
+			)
+		}
+	}
+
+	return nil
+}
+
+func sortedStackNames(stackFile *File) []string {
+	names := make([]string, 0, len(stackFile.Stacks))
+	for name := range stackFile.Stacks {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
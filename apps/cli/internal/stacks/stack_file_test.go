@@ -0,0 +1,134 @@
+package stacks
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/suite"
+)
+
+type StackFileSuite struct {
+	suite.Suite
+	fileSystem afero.Fs
+}
+
+func (s *StackFileSuite) SetupTest() {
+	s.fileSystem = afero.NewMemMapFs()
+}
+
+func (s *StackFileSuite) writeStackFile(contents string) {
+	err := afero.WriteFile(s.fileSystem, "infra/bluelink.stacks.yaml", []byte(contents), 0600)
+	s.Require().NoError(err)
+}
+
+func (s *StackFileSuite) Test_LoadFile_loads_stacks_and_resolves_dependencies() {
+	s.writeStackFile(`stacks:
+  network:
+    blueprintFile: network/project.blueprint.yaml
+    instanceName: acme-network
+    variables:
+      region: eu-west-2
+  database:
+    blueprintFile: database/project.blueprint.yaml
+    instanceName: acme-database
+    inputs:
+      vpcId: network.vpcId
+  api:
+    blueprintFile: /blueprints/api.blueprint.yaml
+    instanceName: acme-api
+    dependsOn: [network]
+    inputs:
+      tableName: database.tableName
+`)
+
+	stackFile, err := LoadFile(s.fileSystem, "infra/bluelink.stacks.yaml")
+	s.Require().NoError(err)
+	s.Len(stackFile.Stacks, 3)
+	s.Equal("eu-west-2", stackFile.Stacks["network"].Variables["region"].ToString())
+	s.Equal(
+		"infra/network/project.blueprint.yaml",
+		stackFile.BlueprintPath(stackFile.Stacks["network"]),
+	)
+	s.Equal(
+		"/blueprints/api.blueprint.yaml",
+		stackFile.BlueprintPath(stackFile.Stacks["api"]),
+	)
+	s.Equal([]string{"database", "network"}, stackFile.Dependencies("api"))
+
+	order, err := DeploymentOrder(stackFile)
+	s.Require().NoError(err)
+	s.Equal([]string{"network", "database", "api"}, order)
+}
+
+func (s *StackFileSuite) Test_LoadFile_reports_error_for_unknown_dependency() {
+	s.writeStackFile(`stacks:
+  api:
+    blueprintFile: api/project.blueprint.yaml
+    instanceName: acme-api
+    dependsOn: [network]
+`)
+
+	_, err := LoadFile(s.fileSystem, "infra/bluelink.stacks.yaml")
+	s.Require().Error(err)
+	s.Contains(err.Error(), `stack "api" depends on unknown stack "network"`)
+}
+
+func (s *StackFileSuite) Test_LoadFile_reports_error_for_invalid_export_reference() {
+	s.writeStackFile(`stacks:
+  network:
+    blueprintFile: network/project.blueprint.yaml
+    instanceName: acme-network
+  api:
+    blueprintFile: api/project.blueprint.yaml
+    instanceName: acme-api
+    inputs:
+      vpcId: network
+`)
+
+	_, err := LoadFile(s.fileSystem, "infra/bluelink.stacks.yaml")
+	s.Require().Error(err)
+	s.Contains(err.Error(), "expected the form {stackName}.{exportName}")
+}
+
+func (s *StackFileSuite) Test_LoadFile_reports_error_for_shared_instance_name() {
+	s.writeStackFile(`stacks:
+  network:
+    blueprintFile: network/project.blueprint.yaml
+    instanceName: acme
+  api:
+    blueprintFile: api/project.blueprint.yaml
+    instanceName: acme
+`)
+
+	_, err := LoadFile(s.fileSystem, "infra/bluelink.stacks.yaml")
+	s.Require().Error(err)
+	s.Contains(err.Error(), `can not be deployed to the same instance "acme"`)
+}
+
+func (s *StackFileSuite) Test_DeploymentOrder_reports_circular_dependencies() {
+	s.writeStackFile(`stacks:
+  network:
+    blueprintFile: network/project.blueprint.yaml
+    instanceName: acme-network
+    dependsOn: [api]
+  api:
+    blueprintFile: api/project.blueprint.yaml
+    instanceName: acme-api
+    inputs:
+      vpcId: network.vpcId
+  docs:
+    blueprintFile: docs/project.blueprint.yaml
+    instanceName: acme-docs
+`)
+
+	stackFile, err := LoadFile(s.fileSystem, "infra/bluelink.stacks.yaml")
+	s.Require().NoError(err)
+
+	_, err = DeploymentOrder(stackFile)
+	s.Require().Error(err)
+	s.Equal("circular dependency detected between stacks: api, network", err.Error())
+}
+
+func TestStackFileSuite(t *testing.T) {
+	suite.Run(t, new(StackFileSuite))
+}
@@ -0,0 +1,105 @@
+package statemigration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/memfile"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/postgres"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/spf13/afero"
+)
+
+const (
+	// BackendFile is the name of the state backend that stores state
+	// in memory with persistence to files on the local file system.
+	BackendFile = "file"
+	// BackendMemfile is an alias for BackendFile that matches the
+	// storage engine name used in the deploy engine configuration.
+	BackendMemfile = "memfile"
+	// BackendPostgres is the name of the state backend that stores
+	// state in a PostgreSQL database.
+	BackendPostgres = "postgres"
+)
+
+// BackendConfig holds the configuration required to connect to
+// a state backend.
+type BackendConfig struct {
+	// Backend is the name of the state backend,
+	// one of "file", "memfile" or "postgres".
+	Backend string
+	// StateDir is the directory that holds state files
+	// for the file backend.
+	StateDir string
+	// PostgresURL is the connection URL for the postgres backend.
+	PostgresURL string
+}
+
+// LoadContainer creates a state container for the backend in the provided
+// configuration, the returned close function must be called once the
+// container is no longer needed.
+func LoadContainer(
+	ctx context.Context,
+	cfg BackendConfig,
+	fileSystem afero.Fs,
+	logger core.Logger,
+) (state.Container, func(), error) {
+	switch cfg.Backend {
+	case BackendFile, BackendMemfile:
+		return loadFileContainer(cfg, fileSystem, logger)
+	case BackendPostgres:
+		return loadPostgresContainer(ctx, cfg, logger)
+	default:
+		return nil, nil, fmt.Errorf(
+			"unsupported state backend %q, must be one of \"file\", \"memfile\" or \"postgres\"",
+			cfg.Backend,
+		)
+	}
+}
+
+func loadFileContainer(
+	cfg BackendConfig,
+	fileSystem afero.Fs,
+	logger core.Logger,
+) (state.Container, func(), error) {
+	if cfg.StateDir == "" {
+		return nil, nil, fmt.Errorf("a state directory must be provided for the file state backend")
+	}
+
+	err := fileSystem.MkdirAll(cfg.StateDir, 0755)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to prepare state directory: %w", err)
+	}
+
+	container, err := memfile.LoadStateContainer(cfg.StateDir, fileSystem, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load file state: %w", err)
+	}
+
+	return container, func() {}, nil
+}
+
+func loadPostgresContainer(
+	ctx context.Context,
+	cfg BackendConfig,
+	logger core.Logger,
+) (state.Container, func(), error) {
+	if cfg.PostgresURL == "" {
+		return nil, nil, fmt.Errorf("a connection URL must be provided for the postgres state backend")
+	}
+
+	pool, err := pgxpool.New(ctx, cfg.PostgresURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create postgres connection pool: %w", err)
+	}
+
+	container, err := postgres.LoadStateContainer(ctx, pool, logger)
+	if err != nil {
+		pool.Close()
+		return nil, nil, fmt.Errorf("failed to load postgres state: %w", err)
+	}
+
+	return container, pool.Close, nil
+}
@@ -6,6 +6,8 @@
 // - API Key authentication
 // - OAuth2 Client Credentials flow
 // - OAuth2 Authorization Code flow with PKCE
+// - OIDC ID tokens and user info
+// - Configurable scopes, audiences and token lifetimes
 // - Plugin catalog and download endpoints
 //
 // This server is intended for local development and testing only.
@@ -52,9 +54,14 @@ const (
 	defaultAPIKey       = "test-api-key-12345"
 
 	// Token settings
-	tokenExpiry = time.Hour
-	issuer      = "plugin-registry-test-server"
-	keyID       = "test-key-1"
+	defaultTokenLifetime = time.Hour
+	issuer               = "plugin-registry-test-server"
+	keyID                = "test-key-1"
+	testUserSubject      = "test-user"
+
+	// Scope settings
+	defaultSupportedScopes = "openid profile email plugins:read plugins:download"
+	openIDScope            = "openid"
 
 	// Authorization code settings
 	authCodeExpiry = 5 * time.Minute
@@ -67,6 +74,17 @@ var (
 	privateKey   any
 	publicJWKS   []byte
 
+	// OAuth2/OIDC settings that can be changed to simulate
+	// misconfigured authorization servers.
+	accessTokenLifetime time.Duration
+	idTokenLifetime     time.Duration
+	supportedScopes     []string
+	requiredScope       string
+	tokenAudience       string
+	expectedAudience    string
+	idTokenIssuer       string
+	idTokenAudience     string
+
 	// Store for authorization codes (in-memory, for testing only)
 	authCodes     = make(map[string]*authCodeData)
 	authCodeMutex sync.RWMutex
@@ -76,9 +94,29 @@ type authCodeData struct {
 	ClientID     string
 	RedirectURI  string
 	CodeVerifier string // For PKCE
+	Scopes       []string
+	Nonce        string // For OIDC ID tokens
 	ExpiresAt    time.Time
 }
 
+// accessTokenClaims holds the claims of access tokens issued by the server.
+type accessTokenClaims struct {
+	jwt.Claims
+	Scope    string `json:"scope,omitempty"`
+	ClientID string `json:"client_id,omitempty"`
+}
+
+// idTokenClaims holds the claims of OIDC ID tokens issued by the server.
+type idTokenClaims struct {
+	jwt.Claims
+	AuthorizedParty string `json:"azp,omitempty"`
+	Nonce           string `json:"nonce,omitempty"`
+	AuthTime        int64  `json:"auth_time,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Email           string `json:"email,omitempty"`
+	EmailVerified   bool   `json:"email_verified,omitempty"`
+}
+
 // Plugin registry types
 type pluginRegistry struct {
 	gpgKey        *openpgp.Entity
@@ -126,6 +164,8 @@ type TokenResponse struct {
 	TokenType    string `json:"token_type"`
 	ExpiresIn    int    `json:"expires_in"`
 	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
 }
 
 // ErrorResponse represents an OAuth2 error response.
@@ -141,6 +181,15 @@ func main() {
 	apiKey = getEnv("OAUTH2_API_KEY", defaultAPIKey)
 	port := getEnv("PORT", defaultPort)
 
+	accessTokenLifetime = getEnvDuration("OAUTH2_ACCESS_TOKEN_LIFETIME", defaultTokenLifetime)
+	idTokenLifetime = getEnvDuration("OAUTH2_ID_TOKEN_LIFETIME", defaultTokenLifetime)
+	supportedScopes = strings.Fields(getEnv("OAUTH2_SUPPORTED_SCOPES", defaultSupportedScopes))
+	requiredScope = getEnv("OAUTH2_REQUIRED_SCOPE", "")
+	tokenAudience = getEnv("OAUTH2_TOKEN_AUDIENCE", clientID)
+	expectedAudience = getEnv("OAUTH2_EXPECTED_AUDIENCE", tokenAudience)
+	idTokenIssuer = getEnv("OAUTH2_ID_TOKEN_ISSUER", issuer)
+	idTokenAudience = getEnv("OAUTH2_ID_TOKEN_AUDIENCE", clientID)
+
 	// Load keys
 	if err := loadKeys(); err != nil {
 		log.Fatalf("Failed to load keys: %v", err)
@@ -167,6 +216,7 @@ func main() {
 	r.HandleFunc("/oauth2/authorize", handleAuthorize).Methods("GET")
 	r.HandleFunc("/oauth2/authorize/consent", handleAuthorizeConsent).Methods("POST")
 	r.HandleFunc("/oauth2/token", handleToken).Methods("POST")
+	r.HandleFunc("/oauth2/userinfo", handleUserInfo).Methods("GET", "POST")
 
 	// API key verification endpoint
 	r.HandleFunc("/auth/verify", handleAPIKeyVerify).Methods("GET", "POST")
@@ -199,6 +249,16 @@ func main() {
 	log.Printf("  Client Secret: %s", clientSecret)
 	log.Printf("  API Key:       %s", apiKey)
 	log.Printf("")
+	log.Printf("OAuth2/OIDC Settings:")
+	log.Printf("  Supported Scopes:      %s", strings.Join(supportedScopes, " "))
+	log.Printf("  Required Scope:        %s", requiredScope)
+	log.Printf("  Token Audience:        %s", tokenAudience)
+	log.Printf("  Expected Audience:     %s", expectedAudience)
+	log.Printf("  Access Token Lifetime: %s", accessTokenLifetime)
+	log.Printf("  ID Token Issuer:       %s", idTokenIssuer)
+	log.Printf("  ID Token Audience:     %s", idTokenAudience)
+	log.Printf("  ID Token Lifetime:     %s", idTokenLifetime)
+	log.Printf("")
 	log.Printf("Usage:")
 	log.Printf("  bluelink plugins login http://localhost:%s", port)
 	log.Printf("  bluelink plugins install localhost:%s/bluelink/test-provider@1.0.0", port)
@@ -224,6 +284,21 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvDuration parses a duration such as "30s" or "1h" from an environment variable.
+// Negative durations are allowed so that already expired tokens can be issued.
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("Invalid duration %q for %s: %v", value, key, err)
+	}
+	return duration
+}
+
 func loadKeys() error {
 	// Try to load private key from file
 	keyPath := getEnv("OAUTH2_PRIVATE_KEY_PATH", "keys/private.json")
@@ -334,8 +409,9 @@ func handleBluelinkServiceDiscovery(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(doc)
+	// Service discovery documents are public but must be revalidated
+	// so changes to the test server configuration are picked up.
+	writeCacheableJSON(w, r, doc, "public, no-cache")
 }
 
 func handleOpenIDConfiguration(w http.ResponseWriter, r *http.Request) {
@@ -349,12 +425,17 @@ func handleOpenIDConfiguration(w http.ResponseWriter, r *http.Request) {
 		"issuer":                                issuer,
 		"authorization_endpoint":                baseURL + "/oauth2/authorize",
 		"token_endpoint":                        baseURL + "/oauth2/token",
+		"userinfo_endpoint":                     baseURL + "/oauth2/userinfo",
 		"jwks_uri":                              baseURL + "/.well-known/jwks.json",
 		"response_types_supported":              []string{"code"},
-		"grant_types_supported":                 []string{"authorization_code", "client_credentials"},
+		"grant_types_supported":                 []string{"authorization_code", "client_credentials", "refresh_token"},
 		"subject_types_supported":               []string{"public"},
 		"id_token_signing_alg_values_supported": []string{"RS256"},
 		"code_challenge_methods_supported":      []string{"S256"},
+		"scopes_supported":                      supportedScopes,
+		"claims_supported": []string{
+			"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "azp", "name", "email", "email_verified",
+		},
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -374,6 +455,8 @@ func handleAuthorize(w http.ResponseWriter, r *http.Request) {
 	state := r.URL.Query().Get("state")
 	codeChallenge := r.URL.Query().Get("code_challenge")
 	codeChallengeMethod := r.URL.Query().Get("code_challenge_method")
+	scope := r.URL.Query().Get("scope")
+	nonce := r.URL.Query().Get("nonce")
 
 	// Validate request
 	if responseType != "code" {
@@ -397,6 +480,12 @@ func handleAuthorize(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	scopes, err := resolveScopes(scope)
+	if err != nil {
+		errorRedirect(w, r, redirectURI, "invalid_scope", err.Error(), state)
+		return
+	}
+
 	// Show a simple consent page
 	consentHTML := `<!DOCTYPE html>
 <html>
@@ -420,13 +509,16 @@ func handleAuthorize(w http.ResponseWriter, r *http.Request) {
         <p>An application is requesting access to your account.</p>
         <div class="info">
             <strong>Client ID:</strong> <code>{{.ClientID}}</code><br>
-            <strong>Redirect URI:</strong> <code>{{.RedirectURI}}</code>
+            <strong>Redirect URI:</strong> <code>{{.RedirectURI}}</code><br>
+            <strong>Scopes:</strong> <code>{{.Scope}}</code>
         </div>
         <form method="POST" action="/oauth2/authorize/consent">
             <input type="hidden" name="client_id" value="{{.ClientID}}">
             <input type="hidden" name="redirect_uri" value="{{.RedirectURI}}">
             <input type="hidden" name="state" value="{{.State}}">
             <input type="hidden" name="code_challenge" value="{{.CodeChallenge}}">
+            <input type="hidden" name="scope" value="{{.Scope}}">
+            <input type="hidden" name="nonce" value="{{.Nonce}}">
             <button type="submit" name="action" value="approve">Approve</button>
             <button type="submit" name="action" value="deny" class="secondary">Deny</button>
         </form>
@@ -446,6 +538,8 @@ func handleAuthorize(w http.ResponseWriter, r *http.Request) {
 		"RedirectURI":   redirectURI,
 		"State":         state,
 		"CodeChallenge": codeChallenge,
+		"Scope":         strings.Join(scopes, " "),
+		"Nonce":         nonce,
 	})
 }
 
@@ -460,6 +554,8 @@ func handleAuthorizeConsent(w http.ResponseWriter, r *http.Request) {
 	redirectURI := r.FormValue("redirect_uri")
 	state := r.FormValue("state")
 	codeChallenge := r.FormValue("code_challenge")
+	scopes := strings.Fields(r.FormValue("scope"))
+	nonce := r.FormValue("nonce")
 
 	if action == "deny" {
 		errorRedirect(w, r, redirectURI, "access_denied", "User denied the request", state)
@@ -475,6 +571,8 @@ func handleAuthorizeConsent(w http.ResponseWriter, r *http.Request) {
 		ClientID:     reqClientID,
 		RedirectURI:  redirectURI,
 		CodeVerifier: codeChallenge, // Store the challenge, we'll verify against verifier
+		Scopes:       scopes,
+		Nonce:        nonce,
 		ExpiresAt:    time.Now().Add(authCodeExpiry),
 	}
 	authCodeMutex.Unlock()
@@ -526,16 +624,15 @@ func handleClientCredentialsGrant(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Generate tokens
-	accessToken, err := generateToken(reqClientID)
+	scopes, err := resolveScopes(r.Form.Get("scope"))
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "server_error", "Failed to generate token")
+		writeError(w, http.StatusBadRequest, "invalid_scope", err.Error())
 		return
 	}
 
-	refreshToken := generateRandomString(32)
-
-	writeTokenResponse(w, accessToken, refreshToken)
+	// ID tokens are only issued for flows that involve an end user,
+	// the client credentials flow only authenticates the client.
+	writeTokens(w, reqClientID, scopes, "" /* nonce */, false /* withIDToken */)
 }
 
 func handleAuthorizationCodeGrant(w http.ResponseWriter, r *http.Request) {
@@ -596,16 +693,13 @@ func handleAuthorizationCodeGrant(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Generate tokens
-	accessToken, err := generateToken(reqClientID)
-	if err != nil {
-		writeError(w, http.StatusInternalServerError, "server_error", "Failed to generate token")
-		return
-	}
-
-	refreshToken := generateRandomString(32)
-
-	writeTokenResponse(w, accessToken, refreshToken)
+	writeTokens(
+		w,
+		reqClientID,
+		codeData.Scopes,
+		codeData.Nonce,
+		containsScope(codeData.Scopes, openIDScope),
+	)
 }
 
 func handleRefreshTokenGrant(w http.ResponseWriter, r *http.Request) {
@@ -627,16 +721,45 @@ func handleRefreshTokenGrant(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	scopes, err := resolveScopes(r.Form.Get("scope"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_scope", err.Error())
+		return
+	}
+
 	// For testing, accept any refresh token and issue new tokens
-	accessToken, err := generateToken(reqClientID)
+	writeTokens(w, reqClientID, scopes, "" /* nonce */, containsScope(scopes, openIDScope))
+}
+
+func handleUserInfo(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	claims, err := parseAccessToken(token)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "server_error", "Failed to generate token")
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeError(w, http.StatusUnauthorized, "invalid_token", err.Error())
+		return
+	}
+
+	scopes := strings.Fields(claims.Scope)
+	if !containsScope(scopes, openIDScope) {
+		w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="openid"`)
+		writeError(w, http.StatusForbidden, "insufficient_scope", "The openid scope is required")
 		return
 	}
 
-	newRefreshToken := generateRandomString(32)
+	userInfo := map[string]any{
+		"sub": testUserSubject,
+	}
+	if containsScope(scopes, "profile") {
+		userInfo["name"] = "Test User"
+	}
+	if containsScope(scopes, "email") {
+		userInfo["email"] = "test-user@example.com"
+		userInfo["email_verified"] = true
+	}
 
-	writeTokenResponse(w, accessToken, newRefreshToken)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(userInfo)
 }
 
 func handleAPIKeyVerify(w http.ResponseWriter, r *http.Request) {
@@ -683,23 +806,89 @@ func validatePKCE(codeChallenge, codeVerifier string) bool {
 	return constantTimeCompare(codeChallenge, computed)
 }
 
-func generateToken(subject string) (string, error) {
+// resolveScopes validates the space-delimited scopes requested by a client,
+// all supported scopes are granted when no scopes are requested.
+func resolveScopes(requested string) ([]string, error) {
+	scopes := strings.Fields(requested)
+	if len(scopes) == 0 {
+		return supportedScopes, nil
+	}
+
+	for _, scope := range scopes {
+		if !containsScope(supportedScopes, scope) {
+			return nil, fmt.Errorf("Scope '%s' is not supported", scope)
+		}
+	}
+
+	return scopes, nil
+}
+
+func containsScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func writeTokens(w http.ResponseWriter, reqClientID string, scopes []string, nonce string, withIDToken bool) {
+	accessToken, err := generateToken(reqClientID, scopes)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "server_error", "Failed to generate token")
+		return
+	}
+
+	idToken := ""
+	if withIDToken {
+		idToken, err = generateIDToken(reqClientID, scopes, nonce)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "server_error", "Failed to generate ID token")
+			return
+		}
+	}
+
+	refreshToken := generateRandomString(32)
+
+	writeTokenResponse(w, TokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(accessTokenLifetime.Seconds()),
+		RefreshToken: refreshToken,
+		Scope:        strings.Join(scopes, " "),
+		IDToken:      idToken,
+	})
+}
+
+func newSigner() (jose.Signer, error) {
 	sig, err := jose.NewSigner(
 		jose.SigningKey{Algorithm: jose.RS256, Key: privateKey},
 		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", keyID),
 	)
 	if err != nil {
-		return "", fmt.Errorf("failed to create signer: %w", err)
+		return nil, fmt.Errorf("failed to create signer: %w", err)
+	}
+	return sig, nil
+}
+
+func generateToken(subject string, scopes []string) (string, error) {
+	sig, err := newSigner()
+	if err != nil {
+		return "", err
 	}
 
 	now := time.Now()
-	claims := jwt.Claims{
-		Subject:   subject,
-		Issuer:    issuer,
-		Audience:  jwt.Audience{clientID},
-		IssuedAt:  jwt.NewNumericDate(now),
-		NotBefore: jwt.NewNumericDate(now),
-		Expiry:    jwt.NewNumericDate(now.Add(tokenExpiry)),
+	claims := accessTokenClaims{
+		Claims: jwt.Claims{
+			Subject:   subject,
+			Issuer:    issuer,
+			Audience:  jwt.Audience{tokenAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Expiry:    jwt.NewNumericDate(now.Add(accessTokenLifetime)),
+		},
+		Scope:    strings.Join(scopes, " "),
+		ClientID: subject,
 	}
 
 	token, err := jwt.Signed(sig).Claims(claims).CompactSerialize()
@@ -710,6 +899,41 @@ func generateToken(subject string) (string, error) {
 	return token, nil
 }
 
+func generateIDToken(reqClientID string, scopes []string, nonce string) (string, error) {
+	sig, err := newSigner()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims := idTokenClaims{
+		Claims: jwt.Claims{
+			Subject:  testUserSubject,
+			Issuer:   idTokenIssuer,
+			Audience: jwt.Audience{idTokenAudience},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(idTokenLifetime)),
+		},
+		AuthorizedParty: reqClientID,
+		Nonce:           nonce,
+		AuthTime:        now.Unix(),
+	}
+	if containsScope(scopes, "profile") {
+		claims.Name = "Test User"
+	}
+	if containsScope(scopes, "email") {
+		claims.Email = "test-user@example.com"
+		claims.EmailVerified = true
+	}
+
+	token, err := jwt.Signed(sig).Claims(claims).CompactSerialize()
+	if err != nil {
+		return "", fmt.Errorf("failed to sign ID token: %w", err)
+	}
+
+	return token, nil
+}
+
 func generateRandomString(length int) string {
 	b := make([]byte, length)
 	if _, err := io.ReadFull(rand.Reader, b); err != nil {
@@ -719,14 +943,9 @@ func generateRandomString(length int) string {
 	return base64.RawURLEncoding.EncodeToString(b)[:length]
 }
 
-func writeTokenResponse(w http.ResponseWriter, accessToken, refreshToken string) {
+func writeTokenResponse(w http.ResponseWriter, resp TokenResponse) {
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(TokenResponse{
-		AccessToken:  accessToken,
-		TokenType:    "Bearer",
-		ExpiresIn:    int(tokenExpiry.Seconds()),
-		RefreshToken: refreshToken,
-	})
+	json.NewEncoder(w).Encode(resp)
 }
 
 func writeError(w http.ResponseWriter, status int, errCode, errDesc string) {
@@ -880,8 +1099,7 @@ func handleListVersions(w http.ResponseWriter, r *http.Request) {
 		versions[i] = PluginVersionInfo{Version: v}
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(PluginVersionsResponse{Versions: versions})
+	writeCacheableJSON(w, r, PluginVersionsResponse{Versions: versions}, "private, no-cache")
 }
 
 func handleGetPackageMetadata(w http.ResponseWriter, r *http.Request) {
@@ -945,8 +1163,30 @@ func handleGetPackageMetadata(w http.ResponseWriter, r *http.Request) {
 		metadata.SigningKeys = map[string]string{"gpg_public_key": pluginReg.publicKeyPEM}
 	}
 
+	writeCacheableJSON(w, r, metadata, "private, no-cache")
+}
+
+// writeCacheableJSON writes a JSON response with ETag and Cache-Control headers,
+// responding with 304 Not Modified when the client already holds the current
+// version of the response.
+func writeCacheableJSON(w http.ResponseWriter, r *http.Request, value any, cacheControl string) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := fmt.Sprintf("%q", hex.EncodeToString(sum[:16]))
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cacheControl)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(metadata)
+	w.Write(body)
 }
 
 func handleDownload(w http.ResponseWriter, r *http.Request) {
@@ -1038,26 +1278,44 @@ func verifyPluginAuth(r *http.Request) bool {
 }
 
 func validateJWT(tokenString string) bool {
-	token, err := jwt.ParseSigned(tokenString)
+	claims, err := parseAccessToken(tokenString)
 	if err != nil {
+		log.Printf("Rejected access token: %v", err)
 		return false
 	}
 
-	// Verify signature using our public key
-	var claims jwt.Claims
-	if err := token.Claims(privateKey.(*rsa.PrivateKey).Public(), &claims); err != nil {
+	if requiredScope != "" && !containsScope(strings.Fields(claims.Scope), requiredScope) {
+		log.Printf("Rejected access token: missing required scope %q", requiredScope)
 		return false
 	}
 
+	return true
+}
+
+// parseAccessToken verifies the signature of an access token issued by this server
+// and validates the issuer, expiry and audience claims.
+func parseAccessToken(tokenString string) (*accessTokenClaims, error) {
+	token, err := jwt.ParseSigned(tokenString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	// Verify signature using our public key
+	claims := &accessTokenClaims{}
+	if err := token.Claims(privateKey.(*rsa.PrivateKey).Public(), claims); err != nil {
+		return nil, fmt.Errorf("failed to verify token: %w", err)
+	}
+
 	// Validate claims
 	if err := claims.Validate(jwt.Expected{
-		Issuer: issuer,
-		Time:   time.Now(),
+		Issuer:   issuer,
+		Audience: jwt.Audience{expectedAudience},
+		Time:     time.Now(),
 	}); err != nil {
-		return false
+		return nil, fmt.Errorf("invalid token claims: %w", err)
 	}
 
-	return true
+	return claims, nil
 }
 
 // createShasumsContentAllPlatforms generates a SHA256SUMS file with entries for all common platforms.
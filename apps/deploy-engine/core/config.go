@@ -126,6 +126,10 @@ func (p *Config) GetPluginToPluginCallTimeoutMS() int {
 	return p.PluginsV1.PluginToPluginCallTimeoutMS
 }
 
+func (p *Config) GetCapabilityManifestPath() string {
+	return p.PluginsV1.CapabilityManifestPath
+}
+
 func (p *Config) GetDrainTimeout() time.Duration {
 	return time.Duration(p.Blueprints.DrainTimeout) * time.Second
 }
@@ -177,6 +181,12 @@ type PluginsV1Config struct {
 	// through the plugin service.
 	// Defaults to 120,000ms (2 minutes)
 	PluginToPluginCallTimeoutMS int `mapstructure:"plugin_to_plugin_call_timeout_ms"`
+	// CapabilityManifestPath is the path to a JSON capability manifest file
+	// that scopes the host services that each plugin can invoke through
+	// the plugin service.
+	// When not set, plugins can invoke all host services exposed by the
+	// plugin service.
+	CapabilityManifestPath string `mapstructure:"capability_manifest_path"`
 }
 
 // BlueprintConfig provides configuration for the blueprint loader
@@ -221,6 +231,57 @@ type BlueprintConfig struct {
 	// longer drain times to reach finalized states.
 	// Defaults to 120 seconds (2 minutes).
 	DrainTimeout int `mapstructure:"drain_timeout"`
+	// MaxConcurrentResources is the maximum number of resources that can be
+	// deployed or destroyed at the same time across all providers.
+	// This is useful for deployments that target rate-limited APIs.
+	// Waiting for resources to stabilise does not count towards the limit.
+	// Defaults to 0, meaning there is no limit.
+	MaxConcurrentResources int `mapstructure:"max_concurrent_resources"`
+	// ProviderConcurrencyLimits holds the maximum number of resources that can be
+	// deployed or destroyed at the same time for each provider namespace.
+	// This should be a serialised JSON object that maps provider namespaces
+	// to limits (e.g. `{"aws": 10, "gcloud": 5}`).
+	// Provider namespaces without a limit are only bound by MaxConcurrentResources.
+	// No provider limits will be applied if this is not set or the JSON is not
+	// in the correct format.
+	ProviderConcurrencyLimits string `mapstructure:"provider_concurrency_limits"`
+	// PolicyOPAEndpoint is the base URL of an Open Policy Agent (OPA) server
+	// that will be used to evaluate Rego policies against the changes for
+	// a deployment before the deployment proceeds (e.g. "http://localhost:8181").
+	// When not set, policies are not evaluated for deployments.
+	PolicyOPAEndpoint string `mapstructure:"policy_opa_endpoint"`
+	// PolicyOPAPath is the path of the policy package in the OPA server
+	// that defines the "deny" and "warn" rules to evaluate for deployments.
+	// Defaults to "bluelink/deploy".
+	PolicyOPAPath string `mapstructure:"policy_opa_path"`
+	// ChangelogDir is the path of a directory where a CHANGELOG-style Markdown
+	// entry will be written for each deploy or destroy operation.
+	// This allows infrastructure changes to be tracked alongside code.
+	// When not set, changelog entries are not written to a directory.
+	ChangelogDir string `mapstructure:"changelog_dir"`
+	// ChangelogWebhookURL is the URL of a webhook that will receive a POST request
+	// with the summary and a CHANGELOG-style Markdown entry for each deploy
+	// or destroy operation, this can be used to sync infrastructure changes
+	// with external systems such as a CMDB.
+	// When not set, deployment summaries are not published to a webhook.
+	ChangelogWebhookURL string `mapstructure:"changelog_webhook_url"`
+	// MaxResources is the maximum number of resources that can be
+	// defined in a single blueprint, this applies to each child blueprint separately.
+	// Defaults to 0, meaning there is no limit.
+	MaxResources int `mapstructure:"max_resources"`
+	// MaxIncludeDepth is the maximum depth of nested child blueprints
+	// that can be included in a blueprint.
+	// Defaults to 0, meaning there is no limit other than
+	// the maximum blueprint depth enforced by the blueprint framework.
+	MaxIncludeDepth int `mapstructure:"max_include_depth"`
+	// MaxSubstitutionDepth is the maximum nesting depth of function calls
+	// in a substitution.
+	// Defaults to 0, meaning there is no limit.
+	MaxSubstitutionDepth int `mapstructure:"max_substitution_depth"`
+	// MaxReferenceGraphSize is the maximum number of references between
+	// elements in a single blueprint.
+	// Defaults to 0, meaning there is no limit.
+	MaxReferenceGraphSize int `mapstructure:"max_reference_graph_size"`
 }
 
 // StateConfig provides configuration for the state management/persistence
@@ -512,6 +573,13 @@ type MaintenanceConfig struct {
 	//
 	// Defaults to 604,800 seconds (7 days).
 	ReconciliationResultsRetentionPeriod int `mapstructure:"reconciliation_results_retention_period"`
+	// The interval in seconds at which the deploy engine checks for ephemeral
+	// blueprint instances (deployed with a TTL) that have expired
+	// and destroys them.
+	// When set to 0, expired instances will not be destroyed automatically.
+	//
+	// Defaults to 300 seconds (5 minutes).
+	ExpiredInstanceReaperInterval int `mapstructure:"expired_instance_reaper_interval"`
 }
 
 // LoadConfig loads the deploy engine configuration
@@ -625,6 +693,7 @@ func bindEnvVars(viperInstance *viper.Viper) {
 	viperInstance.BindEnv("plugins_v1.total_launch_wait_timeout_ms")
 	viperInstance.BindEnv("plugins_v1.resource_stabilisation_polling_timeout_ms")
 	viperInstance.BindEnv("plugins_v1.plugin_to_plugin_call_timeout_ms")
+	viperInstance.BindEnv("plugins_v1.capability_manifest_path")
 
 	viperInstance.BindEnv("blueprints.validate_after_transform")
 	viperInstance.BindEnv("blueprints.enable_drift_check")
@@ -632,6 +701,16 @@ func bindEnvVars(viperInstance *viper.Viper) {
 	viperInstance.BindEnv("blueprints.default_retry_policy")
 	viperInstance.BindEnv("blueprints.deployment_timeout")
 	viperInstance.BindEnv("blueprints.drain_timeout")
+	viperInstance.BindEnv("blueprints.max_concurrent_resources")
+	viperInstance.BindEnv("blueprints.provider_concurrency_limits")
+	viperInstance.BindEnv("blueprints.policy_opa_endpoint")
+	viperInstance.BindEnv("blueprints.policy_opa_path")
+	viperInstance.BindEnv("blueprints.changelog_dir")
+	viperInstance.BindEnv("blueprints.changelog_webhook_url")
+	viperInstance.BindEnv("blueprints.max_resources")
+	viperInstance.BindEnv("blueprints.max_include_depth")
+	viperInstance.BindEnv("blueprints.max_substitution_depth")
+	viperInstance.BindEnv("blueprints.max_reference_graph_size")
 
 	viperInstance.BindEnv("state.storage_engine")
 	viperInstance.BindEnv("state.recently_queued_events_threshold")
@@ -671,6 +750,7 @@ func bindEnvVars(viperInstance *viper.Viper) {
 	viperInstance.BindEnv("maintenance.changeset_retention_period")
 	viperInstance.BindEnv("maintenance.events_retention_period")
 	viperInstance.BindEnv("maintenance.reconciliation_results_retention_period")
+	viperInstance.BindEnv("maintenance.expired_instance_reaper_interval")
 }
 
 const (
@@ -709,6 +789,12 @@ func setDefaults(viperInstance *viper.Viper) {
 	viperInstance.SetDefault("blueprints.resource_stabilisation_polling_interval_ms", 5*oneSecondMillis)
 	viperInstance.SetDefault("blueprints.deployment_timeout", 3*oneHourSeconds)
 	viperInstance.SetDefault("blueprints.drain_timeout", 2*oneMinuteSeconds)
+	viperInstance.SetDefault("blueprints.max_concurrent_resources", 0)
+	viperInstance.SetDefault("blueprints.max_resources", 0)
+	viperInstance.SetDefault("blueprints.max_include_depth", 0)
+	viperInstance.SetDefault("blueprints.max_substitution_depth", 0)
+	viperInstance.SetDefault("blueprints.max_reference_graph_size", 0)
+	viperInstance.SetDefault("blueprints.policy_opa_path", "bluelink/deploy")
 
 	viperInstance.SetDefault("state.storage_engine", "memfile")
 	viperInstance.SetDefault("state.recently_queued_events_threshold", 5*oneMinuteSeconds)
@@ -728,6 +814,7 @@ func setDefaults(viperInstance *viper.Viper) {
 	viperInstance.SetDefault("maintenance.changeset_retention_period", 7*oneDaySeconds)
 	viperInstance.SetDefault("maintenance.events_retention_period", 7*oneDaySeconds)
 	viperInstance.SetDefault("maintenance.reconciliation_results_retention_period", 7*oneDaySeconds)
+	viperInstance.SetDefault("maintenance.expired_instance_reaper_interval", 300)
 
 	viperInstance.SetDefault("shutdown.drain_timeout", 3*oneMinuteSeconds)
 }
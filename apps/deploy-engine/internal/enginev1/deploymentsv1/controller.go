@@ -57,6 +57,14 @@ const (
 	eventTypePreRollbackState = "preRollbackState"
 )
 
+const (
+	// Optional headers that clients can provide to identify who initiated
+	// a deploy or destroy operation and the version of the client used,
+	// these are recorded in the metadata of deployment runs.
+	initiatedByHeaderName   = "Bluelink-Initiated-By"
+	clientVersionHeaderName = "Bluelink-Client-Version"
+)
+
 type inFlightOp struct {
 	cancel context.CancelFunc
 	done   chan struct{}
@@ -72,6 +80,7 @@ type Controller struct {
 	eventStore                           manage.Events
 	instances                            state.InstancesContainer
 	exports                              state.ExportsContainer
+	summaries                            state.SummariesContainer
 	changesetStore                       manage.Changesets
 	reconciliationResultsStore           manage.ReconciliationResults
 	cleanupOperationsStore               manage.CleanupOperations
@@ -87,6 +96,7 @@ type Controller struct {
 	providerMetadataLookup pluginmeta.Lookup
 	clock                  commoncore.Clock
 	logger                 core.Logger
+	engineVersion          string
 
 	inFlightMu sync.Mutex
 	inFlight   map[string]*inFlightOp
@@ -109,6 +119,7 @@ func NewController(
 		eventStore:                           deps.EventStore,
 		instances:                            deps.Instances,
 		exports:                              deps.Exports,
+		summaries:                            deps.Summaries,
 		changesetStore:                       deps.ChangesetStore,
 		reconciliationResultsStore:           deps.ReconciliationResultsStore,
 		cleanupOperationsStore:               deps.CleanupOperationsStore,
@@ -122,6 +133,7 @@ func NewController(
 		providerMetadataLookup:               deps.ProviderMetadataLookup,
 		clock:                                deps.Clock,
 		logger:                               deps.Logger,
+		engineVersion:                        deps.EngineVersion,
 		inFlight:                             make(map[string]*inFlightOp),
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
@@ -66,6 +67,29 @@ func (c *Controller) ListBlueprintInstancesHandler(
 	httputils.HTTPJSONResponse(w, http.StatusOK, result)
 }
 
+// ListBlueprintInstanceSummariesHandler is the handler for the
+// GET /deployments/instance-summaries endpoint that retrieves a paginated list
+// of status summaries (resource status counts, drift counts and deployment timestamps)
+// for blueprint instances without loading full instance state.
+func (c *Controller) ListBlueprintInstanceSummariesHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	params := parseListInstancesParams(r)
+
+	result, err := c.summaries.List(r.Context(), params)
+	if err != nil {
+		c.logger.Error(
+			"failed to list blueprint instance summaries",
+			core.ErrorLogField("error", err),
+		)
+		httputils.HTTPError(w, http.StatusInternalServerError, utils.UnexpectedErrorMessage)
+		return
+	}
+
+	httputils.HTTPJSONResponse(w, http.StatusOK, result)
+}
+
 func parseListInstancesParams(r *http.Request) state.ListInstancesParams {
 	query := r.URL.Query()
 
@@ -305,6 +329,8 @@ func (c *Controller) DestroyBlueprintInstanceHandler(
 		payload.Force,
 		params,
 		taggingConfig,
+		// The destroy operation does not use a source blueprint document.
+		c.createRunMetadata(r, ""),
 	)
 
 	// The instance status will be updated by the deployment process
@@ -400,6 +426,22 @@ func (c *Controller) handleDeployRequest(
 		}
 	}
 
+	// Rollbacks are not subject to blast radius limits as they revert
+	// changes that have already been applied.
+	if !payload.AsRollback && !payload.OverrideBlastRadius {
+		blastRadiusErr := container.CheckBlastRadius(
+			changeset.Changes,
+			&container.BlastRadiusLimits{
+				MaxChanges: payload.MaxChanges,
+				MaxDeletes: payload.MaxDeletes,
+			},
+		)
+		if blastRadiusErr != nil {
+			respondWithBlastRadiusExceeded(w, changeset)
+			return
+		}
+	}
+
 	// Add blueprint directory to context variables for resolving relative child blueprint paths.
 	finalConfig = internalutils.EnsureBlueprintDirContextVar(finalConfig, payload.BlueprintDocumentInfo.Directory)
 	params := c.paramsProvider.CreateFromRequestConfig(finalConfig)
@@ -419,6 +461,8 @@ func (c *Controller) handleDeployRequest(
 		helpersv1.GetFormat(payload.BlueprintFile),
 		params,
 		taggingConfig,
+		c.createRunMetadata(r, helpersv1.GetBlueprintSource(blueprintInfo)),
+		time.Duration(payload.TTLSeconds)*time.Second,
 	)
 	if err != nil {
 		handleDeployErrorForResponse(w, err, c.logger)
@@ -539,6 +583,8 @@ func (c *Controller) startDeployment(
 	format schema.SpecFormat,
 	params core.BlueprintParams,
 	taggingConfig *provider.TaggingConfig,
+	runMetadata *state.DeploymentRunMetadata,
+	ttl time.Duration,
 ) (string, error) {
 	ctxWithTimeout, cancel := context.WithTimeout(
 		context.Background(),
@@ -571,6 +617,8 @@ func (c *Controller) startDeployment(
 			TaggingConfig:          taggingConfig,
 			ProviderMetadataLookup: pluginmeta.ToLookupFunc(c.providerMetadataLookup),
 			DrainTimeout:           c.drainTimeout,
+			RunMetadata:            runMetadata,
+			TTL:                    ttl,
 		},
 		channels,
 		params,
@@ -650,6 +698,7 @@ func (c *Controller) startDestroy(
 	force bool,
 	params core.BlueprintParams,
 	taggingConfig *provider.TaggingConfig,
+	runMetadata *state.DeploymentRunMetadata,
 ) {
 	ctxWithTimeout, cancel := context.WithTimeout(
 		context.Background(),
@@ -688,6 +737,7 @@ func (c *Controller) startDestroy(
 			TaggingConfig:          taggingConfig,
 			ProviderMetadataLookup: pluginmeta.ToLookupFunc(c.providerMetadataLookup),
 			DrainTimeout:           c.drainTimeout,
+			RunMetadata:            runMetadata,
 		},
 		channels,
 		params,
@@ -802,6 +852,10 @@ func (c *Controller) listenForDeploymentUpdatesWithParams(
 		return
 	}
 
+	if finishMsg != nil {
+		c.markChangesetDeployed(ctx, changeset, instanceID, finishMsg.Status, logger)
+	}
+
 	// Check if auto-rollback should be triggered after deployment failure
 	if finishMsg != nil && autoRollback {
 		shouldRollback, rollbackType := shouldTriggerAutoRollback(finishMsg.Status)
@@ -821,6 +875,36 @@ func (c *Controller) listenForDeploymentUpdatesWithParams(
 	}
 }
 
+// markChangesetDeployed records that the changes in a change set have been
+// successfully deployed to a blueprint instance so that the change set
+// becomes a part of the revision history of the instance.
+func (c *Controller) markChangesetDeployed(
+	ctx context.Context,
+	changeset *manage.Changeset,
+	instanceID string,
+	status core.InstanceStatus,
+	logger core.Logger,
+) {
+	if changeset == nil ||
+		(status != core.InstanceStatusDeployed && status != core.InstanceStatusUpdated) {
+		return
+	}
+
+	deployedChangeset := *changeset
+	// Change sets for new blueprint instances are staged before the instance
+	// exists, the instance ID is only known once the deployment has started.
+	deployedChangeset.InstanceID = instanceID
+	deployedChangeset.Deployed = c.clock.Now().Unix()
+	err := c.changesetStore.Save(ctx, &deployedChangeset)
+	if err != nil {
+		logger.Warn(
+			"failed to record change set as deployed in the revision history of the instance",
+			core.StringLogField("changesetId", changeset.ID),
+			core.ErrorLogField("error", err),
+		)
+	}
+}
+
 func (c *Controller) handleDeploymentResourceUpdateMessage(
 	ctx context.Context,
 	msg container.ResourceDeployUpdateMessage,
@@ -1038,6 +1122,9 @@ func (c *Controller) startDestroyRollback(
 			TaggingConfig:          nil,
 			ProviderMetadataLookup: pluginmeta.ToLookupFunc(c.providerMetadataLookup),
 			DrainTimeout:           c.drainTimeout,
+			// Automatic rollbacks are initiated by the deploy engine
+			// instead of a client request.
+			RunMetadata: c.createRunMetadata(nil, ""),
 		},
 		channels,
 		params,
@@ -1164,6 +1251,7 @@ func (c *Controller) executeUpdateRollback(
 			TaggingConfig:          nil,
 			ProviderMetadataLookup: nil,
 			DrainTimeout:           c.drainTimeout,
+			RunMetadata:            c.createRunMetadata(nil, ""),
 		},
 		channels,
 		blueprint.CreateEmptyBlueprintParams(),
@@ -1213,6 +1301,7 @@ func (c *Controller) handleDeploymentErrorAsEvent(
 	errorMsgEvent := &errorMessageEvent{
 		Message:     deploymentError.Error(),
 		Diagnostics: errDiagnostics,
+		ErrorGroups: utils.ErrorGroupsFromBlueprintValidationError(deploymentError),
 		Timestamp:   c.clock.Now().Unix(),
 	}
 	c.saveDeploymentEvent(
@@ -1308,6 +1397,28 @@ func (c *Controller) respondWithDriftBlocked(
 	)
 }
 
+// respondWithBlastRadiusExceeded sends a 422 Unprocessable Entity response
+// when a deployment is blocked due to the change set exceeding
+// the blast radius limits provided in the request.
+func respondWithBlastRadiusExceeded(
+	w http.ResponseWriter,
+	changeset *manage.Changeset,
+) {
+	blastRadius := container.CalculateBlastRadius(changeset.Changes)
+	httputils.HTTPErrorWithFields(
+		w,
+		http.StatusUnprocessableEntity,
+		"the change set exceeds the blast radius limits for the deployment, "+
+			"set overrideBlastRadius=true to proceed",
+		map[string]any{
+			"code":        "BLAST_RADIUS_EXCEEDED",
+			"changesetId": changeset.ID,
+			"changes":     blastRadius.Changes,
+			"deletes":     blastRadius.Deletes,
+		},
+	)
+}
+
 // createTaggingConfig creates a provider.TaggingConfig from the request's
 // BlueprintOperationConfig. Returns nil if tagging config provider is not configured.
 func (c *Controller) createTaggingConfig(config *types.BlueprintOperationConfig) *provider.TaggingConfig {
@@ -154,6 +154,7 @@ func (c *Controller) CreateChangesetHandler(
 		params,
 		taggingConfig,
 		payload.SkipDriftCheck,
+		payload.Verify,
 		c.logger.Named("changeStagingProcess").WithFields(
 			core.StringLogField("changesetId", changesetID),
 			core.StringLogField("blueprintLocation", blueprintLocation),
@@ -234,6 +235,64 @@ func (c *Controller) GetChangesetHandler(
 	)
 }
 
+// GetChangesetPlanHandler is the handler for the GET /deployments/changes/{id}/plan endpoint
+// that retrieves the changes for a change set as a versioned, machine-readable plan document.
+// The plan is only available once the change staging process has completed successfully.
+func (c *Controller) GetChangesetPlanHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	params := mux.Vars(r)
+	changesetID := params["id"]
+
+	changeset, err := c.changesetStore.Get(
+		r.Context(),
+		changesetID,
+	)
+	if err != nil {
+		notFoundErr := &manage.ChangesetNotFound{}
+		if errors.As(err, &notFoundErr) {
+			httputils.HTTPError(
+				w,
+				http.StatusNotFound,
+				fmt.Sprintf("change set %q not found", changesetID),
+			)
+			return
+		}
+
+		c.logger.Debug(
+			"failed to get change set",
+			core.ErrorLogField("error", err),
+		)
+		httputils.HTTPError(
+			w,
+			http.StatusInternalServerError,
+			utils.UnexpectedErrorMessage,
+		)
+		return
+	}
+
+	if changeset.Status != manage.ChangesetStatusChangesStaged {
+		httputils.HTTPError(
+			w,
+			http.StatusUnprocessableEntity,
+			fmt.Sprintf(
+				"a plan is not available for change set %q with status %q, "+
+					"change staging must complete successfully first",
+				changesetID,
+				changeset.Status,
+			),
+		)
+		return
+	}
+
+	httputils.HTTPJSONResponse(
+		w,
+		http.StatusOK,
+		changes.BuildPlan(changeset.Changes),
+	)
+}
+
 // CleanupChangesetsHandler is the handler for the
 // POST /deployments/changes/cleanup endpoint that cleans up
 // change sets that are older than the configured
@@ -477,6 +536,7 @@ func (c *Controller) startChangeStaging(
 	params core.BlueprintParams,
 	taggingConfig *provider.TaggingConfig,
 	skipDriftCheck bool,
+	verify bool,
 	logger core.Logger,
 ) {
 	ctxWithTimeout, cancel := context.WithTimeout(
@@ -550,13 +610,38 @@ func (c *Controller) startChangeStaging(
 		return
 	}
 
-	c.handleChangesetMessages(ctxWithTimeout, changeset, channels, logger)
+	var verifier changesVerifier
+	if verify {
+		verifier = func(
+			ctx context.Context,
+			blueprintChanges *changes.BlueprintChanges,
+		) (*container.VerifyChangesResult, error) {
+			return blueprintContainer.VerifyChanges(
+				ctx,
+				&container.VerifyChangesInput{
+					InstanceID: changeset.InstanceID,
+					Changes:    blueprintChanges,
+				},
+				params,
+			)
+		}
+	}
+
+	c.handleChangesetMessages(ctxWithTimeout, changeset, channels, verifier, logger)
 }
 
+// changesVerifier verifies staged changes with the resource providers
+// once change staging has completed.
+type changesVerifier func(
+	ctx context.Context,
+	blueprintChanges *changes.BlueprintChanges,
+) (*container.VerifyChangesResult, error)
+
 func (c *Controller) handleChangesetMessages(
 	ctx context.Context,
 	changeset *manage.Changeset,
 	channels *container.ChangeStagingChannels,
+	verifier changesVerifier,
 	logger core.Logger,
 ) {
 	fullChanges := (*changes.BlueprintChanges)(nil)
@@ -570,8 +655,12 @@ func (c *Controller) handleChangesetMessages(
 		case msg := <-channels.LinkChangesChan:
 			c.handleChangesetLinkChangesMessage(ctx, msg, changeset, logger)
 		case changes := <-channels.CompleteChan:
-			c.handleChangesetCompleteMessage(ctx, &changes, changeset, logger)
-			fullChanges = &changes
+			var verification *container.VerifyChangesResult
+			verification, err = verifyStagedChanges(ctx, verifier, &changes)
+			if err == nil {
+				c.handleChangesetCompleteMessage(ctx, &changes, verification, changeset, logger)
+				fullChanges = &changes
+			}
 		case err = <-channels.ErrChan:
 		case <-ctx.Done():
 			err = ctx.Err()
@@ -665,15 +754,29 @@ func (c *Controller) handleChangesetLinkChangesMessage(
 	)
 }
 
+func verifyStagedChanges(
+	ctx context.Context,
+	verifier changesVerifier,
+	blueprintChanges *changes.BlueprintChanges,
+) (*container.VerifyChangesResult, error) {
+	if verifier == nil {
+		return nil, nil
+	}
+
+	return verifier(ctx, blueprintChanges)
+}
+
 func (c *Controller) handleChangesetCompleteMessage(
 	ctx context.Context,
 	changes *changes.BlueprintChanges,
+	verification *container.VerifyChangesResult,
 	changeset *manage.Changeset,
 	logger core.Logger,
 ) {
 	eventData := &changeStagingCompleteEvent{
-		Changes:   changes,
-		Timestamp: c.clock.Now().Unix(),
+		Changes:      changes,
+		Verification: verification,
+		Timestamp:    c.clock.Now().Unix(),
 	}
 	c.saveChangeStagingEvent(
 		ctx,
@@ -703,6 +806,7 @@ func (c *Controller) handleChangesetErrorAsEvent(
 	errorMsgEvent := &errorMessageEvent{
 		Message:     changeStagingError.Error(),
 		Diagnostics: errDiagnostics,
+		ErrorGroups: utils.ErrorGroupsFromBlueprintValidationError(changeStagingError),
 		Timestamp:   c.clock.Now().Unix(),
 	}
 	c.saveChangeStagingEvent(
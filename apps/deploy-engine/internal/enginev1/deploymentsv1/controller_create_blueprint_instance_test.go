@@ -2,6 +2,7 @@ package deploymentsv1
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -85,6 +86,68 @@ func (s *ControllerTestSuite) Test_create_blueprint_instance_handler() {
 	)
 }
 
+func (s *ControllerTestSuite) Test_create_blueprint_instance_handler_marks_changeset_as_deployed() {
+	err := s.saveTestChangeset()
+	s.Require().NoError(err)
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/instances",
+		s.ctrl.CreateBlueprintInstanceHandler,
+	).Methods("POST")
+
+	reqPayload := &BlueprintInstanceRequestPayload{
+		BlueprintDocumentInfo: resolve.BlueprintDocumentInfo{
+			FileSourceScheme: "file",
+			Directory:        "/test/dir",
+			BlueprintFile:    "test.blueprint.yaml",
+		},
+		ChangeSetID: testChangesetID,
+	}
+
+	reqBytes, err := json.Marshal(reqPayload)
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest("POST", "/deployments/instances", bytes.NewReader(reqBytes))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	result := w.Result()
+	defer result.Body.Close()
+	respData, err := io.ReadAll(result.Body)
+	s.Require().NoError(err)
+
+	wrappedResponse := &helpersv1.AsyncOperationResponse[state.InstanceState]{}
+	err = json.Unmarshal(respData, wrappedResponse)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusAccepted, result.StatusCode)
+
+	instanceID := wrappedResponse.Data.InstanceID
+	expectedEvents := deployEventSequence(instanceID)
+	_, err = s.streamDeployEvents(instanceID, len(expectedEvents))
+	s.Require().NoError(err)
+
+	// The change set is marked as deployed after the final deployment event
+	// has been saved, so poll until the change set has been updated.
+	deployedChangeset := (*manage.Changeset)(nil)
+	timeout := time.After(3 * time.Second)
+	for deployedChangeset == nil {
+		select {
+		case <-timeout:
+			s.FailNow("timed out waiting for change set to be marked as deployed")
+		case <-time.After(10 * time.Millisecond):
+			changeset, err := s.changesetStore.Get(context.Background(), testChangesetID)
+			s.Require().NoError(err)
+			if changeset.Deployed > 0 {
+				deployedChangeset = changeset
+			}
+		}
+	}
+
+	s.Assert().Equal(testTime.Unix(), deployedChangeset.Deployed)
+	s.Assert().Equal(instanceID, deployedChangeset.InstanceID)
+}
+
 func (s *ControllerTestSuite) Test_create_blueprint_instance_handler_with_instance_name() {
 	// Create the test change set to be used to start the deployment
 	// process for the new blueprint instance.
@@ -478,6 +541,52 @@ func (s *ControllerTestSuite) Test_create_blueprint_instance_handler_fails_for_d
 	)
 }
 
+func (s *ControllerTestSuite) Test_create_blueprint_instance_handler_fails_when_blast_radius_exceeded() {
+	// The test change set removes 2 resources.
+	err := s.saveTestChangeset()
+	s.Require().NoError(err)
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/instances",
+		s.ctrl.CreateBlueprintInstanceHandler,
+	).Methods("POST")
+
+	reqPayload := &BlueprintInstanceRequestPayload{
+		BlueprintDocumentInfo: resolve.BlueprintDocumentInfo{
+			FileSourceScheme: "file",
+			Directory:        "/test/dir",
+			BlueprintFile:    "test.blueprint.yaml",
+		},
+		ChangeSetID: testChangesetID,
+		MaxDeletes:  1,
+	}
+
+	reqBytes, err := json.Marshal(reqPayload)
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest("POST", "/deployments/instances", bytes.NewReader(reqBytes))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	result := w.Result()
+	defer result.Body.Close()
+	respData, err := io.ReadAll(result.Body)
+	s.Require().NoError(err)
+
+	responseError := map[string]any{}
+	err = json.Unmarshal(respData, &responseError)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(http.StatusUnprocessableEntity, result.StatusCode)
+	s.Assert().Equal(
+		"BLAST_RADIUS_EXCEEDED",
+		responseError["code"],
+	)
+	s.Assert().Equal(testChangesetID, responseError["changesetId"])
+	s.Assert().Equal(float64(2), responseError["deletes"])
+}
+
 func (s *ControllerTestSuite) assertDeployEventsEqual(
 	expected []container.DeployEvent,
 	actual []testutils.DeployEventWrapper,
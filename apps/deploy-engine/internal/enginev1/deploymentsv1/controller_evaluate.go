@@ -0,0 +1,152 @@
+package deploymentsv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/helpersv1"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/inputvalidation"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/httputils"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/resolve"
+	internalutils "github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/utils"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+const (
+	// evaluateExpressionTimeout is the timeout for evaluating
+	// a substitution expression against a blueprint.
+	evaluateExpressionTimeout = 2 * time.Minute
+)
+
+// EvaluateExpressionHandler is the handler for the
+// POST /deployments/evaluate endpoint that evaluates a substitution
+// expression against a blueprint, using the state of an existing
+// blueprint instance to resolve computed fields of resources when
+// an instance is provided.
+// When tracing is requested, the steps carried out to evaluate
+// the expression are included in the response.
+func (c *Controller) EvaluateExpressionHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	payload := &EvaluateExpressionRequestPayload{}
+	responseWritten := httputils.DecodeRequestBody(w, r, payload, c.logger)
+	if responseWritten {
+		return
+	}
+
+	if err := helpersv1.ValidateRequestBody.Struct(payload); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		inputvalidation.HTTPValidationError(w, validationErrors)
+		return
+	}
+
+	helpersv1.PopulateBlueprintDocInfoDefaults(&payload.BlueprintDocumentInfo)
+
+	finalConfig, _, responseWritten := helpersv1.PrepareAndValidatePluginConfig(
+		r,
+		w,
+		payload.Config,
+		/* validate */ true,
+		c.pluginConfigPreparer,
+		c.logger,
+	)
+	if responseWritten {
+		return
+	}
+
+	blueprintInfo, responseWritten := resolve.ResolveBlueprintForRequest(
+		r,
+		w,
+		&payload.BlueprintDocumentInfo,
+		c.blueprintResolver,
+		c.logger,
+	)
+	if responseWritten {
+		return
+	}
+
+	finalConfig = internalutils.EnsureBlueprintDirContextVar(finalConfig, payload.BlueprintDocumentInfo.Directory)
+	blueprintParams := c.paramsProvider.CreateFromRequestConfig(finalConfig)
+
+	ctxWithTimeout, cancel := context.WithTimeout(r.Context(), evaluateExpressionTimeout)
+	defer cancel()
+
+	blueprintContainer, err := c.blueprintLoader.LoadString(
+		ctxWithTimeout,
+		helpersv1.GetBlueprintSource(blueprintInfo),
+		helpersv1.GetFormat(payload.BlueprintFile),
+		blueprintParams,
+	)
+	if err != nil {
+		c.logger.Debug(
+			"failed to load blueprint container",
+			core.ErrorLogField("error", err),
+		)
+		httputils.HTTPError(
+			w,
+			http.StatusInternalServerError,
+			utils.UnexpectedErrorMessage,
+		)
+		return
+	}
+
+	evaluated, err := blueprintContainer.EvaluateExpression(
+		ctxWithTimeout,
+		&container.EvaluateExpressionInput{
+			Expression:   payload.Expression,
+			InstanceID:   payload.InstanceID,
+			InstanceName: payload.InstanceName,
+			Trace:        payload.Trace,
+		},
+		blueprintParams,
+	)
+	if err != nil {
+		c.handleEvaluateExpressionError(w, err, payload)
+		return
+	}
+
+	httputils.HTTPJSONResponse(
+		w,
+		http.StatusOK,
+		evaluated,
+	)
+}
+
+func (c *Controller) handleEvaluateExpressionError(
+	w http.ResponseWriter,
+	err error,
+	payload *EvaluateExpressionRequestPayload,
+) {
+	if state.IsInstanceNotFound(err) {
+		instanceIDOrName := payload.InstanceID
+		if instanceIDOrName == "" {
+			instanceIDOrName = payload.InstanceName
+		}
+		httputils.HTTPError(
+			w,
+			http.StatusNotFound,
+			fmt.Sprintf("blueprint instance %q not found", instanceIDOrName),
+		)
+		return
+	}
+
+	// Errors evaluating the expression are almost always caused by
+	// the expression provided by the user, so the error message is
+	// included in the response to allow the user to correct the expression.
+	c.logger.Debug(
+		"failed to evaluate expression",
+		core.ErrorLogField("error", err),
+	)
+	httputils.HTTPError(
+		w,
+		http.StatusUnprocessableEntity,
+		fmt.Sprintf("failed to evaluate expression: %s", err.Error()),
+	)
+}
@@ -0,0 +1,166 @@
+package deploymentsv1
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+func (s *ControllerTestSuite) Test_evaluate_expression() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/evaluate",
+		ctrl.EvaluateExpressionHandler,
+	).Methods("POST")
+
+	payload := EvaluateExpressionRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		Expression:            "resources.exampleResource.spec.name",
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+	}
+
+	evaluateResp := &container.EvaluatedExpression{}
+	statusCode := s.postImportRequest(
+		router,
+		"/deployments/evaluate",
+		payload,
+		evaluateResp,
+	)
+
+	s.Assert().Equal(http.StatusOK, statusCode)
+	s.Assert().Equal("example-resource", core.StringValue(evaluateResp.Value))
+	s.Assert().False(evaluateResp.KnownOnDeploy)
+	s.Assert().Nil(evaluateResp.Trace)
+}
+
+func (s *ControllerTestSuite) Test_evaluate_expression_known_on_deploy() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/evaluate",
+		ctrl.EvaluateExpressionHandler,
+	).Methods("POST")
+
+	payload := EvaluateExpressionRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		Expression:            "resources.exampleResource.spec.id",
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+	}
+
+	evaluateResp := &container.EvaluatedExpression{}
+	statusCode := s.postImportRequest(
+		router,
+		"/deployments/evaluate",
+		payload,
+		evaluateResp,
+	)
+
+	s.Assert().Equal(http.StatusOK, statusCode)
+	s.Assert().True(evaluateResp.KnownOnDeploy)
+	s.Assert().Equal(
+		container.KnownOnDeployPlaceholder,
+		core.StringValue(evaluateResp.Value),
+	)
+}
+
+func (s *ControllerTestSuite) Test_evaluate_expression_with_trace() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/evaluate",
+		ctrl.EvaluateExpressionHandler,
+	).Methods("POST")
+
+	payload := EvaluateExpressionRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		Expression:            "variables.name",
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+		Trace: true,
+	}
+
+	evaluateResp := &container.EvaluatedExpression{}
+	statusCode := s.postImportRequest(
+		router,
+		"/deployments/evaluate",
+		payload,
+		evaluateResp,
+	)
+
+	s.Assert().Equal(http.StatusOK, statusCode)
+	expressionTrace := evaluateResp.Trace[container.EvaluatedExpressionElementName]
+	s.Require().Len(expressionTrace, 1)
+	s.Assert().Equal("variables.name", expressionTrace[0].Expression)
+}
+
+func (s *ControllerTestSuite) Test_evaluate_expression_fails_for_missing_instance() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/evaluate",
+		ctrl.EvaluateExpressionHandler,
+	).Methods("POST")
+
+	payload := EvaluateExpressionRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		Expression:            "resources.exampleResource.spec.id",
+		InstanceName:          "missing-instance",
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+	}
+
+	errResp := map[string]any{}
+	statusCode := s.postImportRequest(
+		router,
+		"/deployments/evaluate",
+		payload,
+		&errResp,
+	)
+
+	s.Assert().Equal(http.StatusNotFound, statusCode)
+	s.Assert().Equal(
+		"blueprint instance \"missing-instance\" not found",
+		errResp["message"],
+	)
+}
+
+func (s *ControllerTestSuite) Test_evaluate_expression_fails_for_missing_expression() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/evaluate",
+		ctrl.EvaluateExpressionHandler,
+	).Methods("POST")
+
+	payload := EvaluateExpressionRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+	}
+
+	errResp := map[string]any{}
+	statusCode := s.postImportRequest(
+		router,
+		"/deployments/evaluate",
+		payload,
+		&errResp,
+	)
+
+	s.Assert().Equal(http.StatusUnprocessableEntity, statusCode)
+}
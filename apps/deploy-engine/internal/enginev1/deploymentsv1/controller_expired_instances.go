@@ -0,0 +1,82 @@
+package deploymentsv1
+
+import (
+	"context"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// RunExpiredInstanceReaper destroys ephemeral blueprint instances
+// (deployed with a TTL) once they have expired, checking for expired instances
+// every interval until the provided context is cancelled or the controller is shut down.
+// This blocks so is expected to be called in a goroutine.
+//
+// There is no request to source provider configuration from when destroying
+// expired instances, so only the default values for plugin configuration are used,
+// providers are expected to source credentials from the environment
+// of the deploy engine.
+func (c *Controller) RunExpiredInstanceReaper(ctx context.Context, interval time.Duration) {
+	logger := c.logger.Named("expiredInstanceReaper")
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	unregister, err := c.registerInFlight(cancel)
+	if err != nil {
+		logger.Error(
+			"failed to register expired instance reaper",
+			core.ErrorLogField("error", err),
+		)
+		return
+	}
+	defer unregister()
+
+	params := c.expiredInstanceReaperParams(ctxWithCancel, logger)
+	// The destroy operation does not use a source blueprint document,
+	// however, in order to load the blueprint container,
+	// we need to provide a source blueprint document.
+	blueprintContainer, err := c.blueprintLoader.LoadString(
+		ctxWithCancel,
+		placeholderBlueprint,
+		schema.YAMLSpecFormat,
+		params,
+	)
+	if err != nil {
+		logger.Error(
+			"failed to load blueprint container for destroying expired instances",
+			core.ErrorLogField("error", err),
+		)
+		return
+	}
+
+	reaper := container.NewExpiredInstanceReaper(
+		c.instances,
+		blueprintContainer,
+		container.WithReaperLogger(logger),
+		container.WithReaperParams(params),
+	)
+	reaper.Run(ctxWithCancel, interval)
+}
+
+func (c *Controller) expiredInstanceReaperParams(
+	ctx context.Context,
+	logger core.Logger,
+) core.BlueprintParams {
+	preparedConfig, _, err := c.pluginConfigPreparer.Prepare(
+		ctx,
+		&types.BlueprintOperationConfig{},
+		/* validate */ false,
+	)
+	if err != nil {
+		logger.Warn(
+			"failed to populate default plugin configuration for destroying expired instances",
+			core.ErrorLogField("error", err),
+		)
+		return c.paramsProvider.GetDefaultParams()
+	}
+
+	return c.paramsProvider.CreateFromRequestConfig(preparedConfig)
+}
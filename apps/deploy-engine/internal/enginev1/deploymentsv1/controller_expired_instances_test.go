@@ -0,0 +1,110 @@
+package deploymentsv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/resolve"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/testutils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (s *ControllerTestSuite) Test_update_blueprint_instance_handler_deploys_with_ttl() {
+	deployTracker := testutils.NewDeployTracker()
+	ctrl := s.createAutoRollbackTestControllerWithTrackers(
+		core.InstanceStatusUpdated,
+		testutils.NewDestroyTracker(),
+		deployTracker,
+		s.instances,
+	)
+
+	_, err := s.saveTestBlueprintInstance()
+	s.Require().NoError(err)
+
+	err = s.saveTestChangeset()
+	s.Require().NoError(err)
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/instances/{id}",
+		ctrl.UpdateBlueprintInstanceHandler,
+	).Methods("PATCH")
+
+	reqPayload := &BlueprintInstanceRequestPayload{
+		BlueprintDocumentInfo: resolve.BlueprintDocumentInfo{
+			FileSourceScheme: "file",
+			Directory:        "/test/dir",
+			BlueprintFile:    "test.blueprint.yaml",
+		},
+		ChangeSetID: testChangesetID,
+		TTLSeconds:  7200,
+	}
+
+	reqBytes, err := json.Marshal(reqPayload)
+	s.Require().NoError(err)
+
+	path := fmt.Sprintf("/deployments/instances/%s", testInstanceID)
+	req := httptest.NewRequest("PATCH", path, bytes.NewReader(reqBytes))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	result := w.Result()
+	defer result.Body.Close()
+
+	s.Assert().Equal(http.StatusAccepted, result.StatusCode)
+	s.Require().True(deployTracker.WasDeployCalled())
+	s.Assert().Equal(2*time.Hour, deployTracker.DeployCalls[0].TTL)
+}
+
+func (s *ControllerTestSuite) Test_expired_instance_reaper_destroys_expired_instances() {
+	destroyTracker := testutils.NewDestroyTracker()
+	ctrl := s.createAutoRollbackTestControllerWithTrackers(
+		core.InstanceStatusDeployed,
+		destroyTracker,
+		/* deployTracker */ nil,
+		s.instances,
+	)
+
+	err := s.instances.Save(
+		context.Background(),
+		state.InstanceState{
+			InstanceID:   testInstanceID,
+			InstanceName: testInstanceName,
+			Status:       core.InstanceStatusDeployed,
+			ExpiresAt:    int(testTime.Add(-time.Minute).Unix()),
+		},
+	)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reaperDone := make(chan struct{})
+	go func() {
+		defer close(reaperDone)
+		ctrl.RunExpiredInstanceReaper(ctx, 10*time.Millisecond)
+	}()
+
+	s.Require().Eventually(
+		destroyTracker.WasDestroyCalled,
+		2*time.Second,
+		10*time.Millisecond,
+	)
+	cancel()
+	<-reaperDone
+
+	destroyInput := destroyTracker.DestroyCalls[0]
+	s.Assert().Equal(testInstanceID, destroyInput.InstanceID)
+	s.Assert().False(destroyInput.Rollback)
+	s.Require().NotNil(destroyInput.RunMetadata)
+	s.Assert().Equal(
+		container.ExpiredInstanceReaperInitiator,
+		destroyInput.RunMetadata.InitiatedBy,
+	)
+}
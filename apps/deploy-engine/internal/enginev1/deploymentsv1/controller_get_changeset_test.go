@@ -17,6 +17,7 @@ const (
 	testChangesetID        = "bf8de86f-5762-4c59-a878-c17fc93b7651"
 	testDestroyChangesetID = "a1b2c3d4-5762-4c59-a878-c17fc93b7651"
 	nonExistentChangesetID = "13766f10-f82d-4441-a887-e1b6da8028ba"
+	testStagedChangesetID  = "c4e5f6a7-5762-4c59-a878-c17fc93b7651"
 )
 
 func (s *ControllerTestSuite) Test_get_changeset_handler() {
@@ -102,6 +103,95 @@ func (s *ControllerTestSuite) Test_get_changeset_handler_returns_404_not_found()
 	)
 }
 
+func (s *ControllerTestSuite) Test_get_changeset_plan_handler() {
+	err := s.saveStagedChangeset()
+	s.Require().NoError(err)
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/changes/{id}/plan",
+		s.ctrl.GetChangesetPlanHandler,
+	).Methods("GET")
+
+	path := fmt.Sprintf("/deployments/changes/%s/plan", testStagedChangesetID)
+	req := httptest.NewRequest("GET", path, nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	result := w.Result()
+	defer result.Body.Close()
+	respData, err := io.ReadAll(result.Body)
+	s.Require().NoError(err)
+
+	plan := &changes.Plan{}
+	err = json.Unmarshal(respData, plan)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(http.StatusOK, result.StatusCode)
+	s.Assert().Equal(changes.PlanFormatVersion, plan.FormatVersion)
+	s.Assert().Equal(changes.ChangesSummary{Delete: 2}, plan.Summary)
+	s.Assert().Equal(
+		[]*changes.PlanResourceChange{
+			{ResourceName: "resource1", Action: changes.PlanActionDelete},
+			{ResourceName: "resource2", Action: changes.PlanActionDelete},
+		},
+		plan.Resources,
+	)
+}
+
+func (s *ControllerTestSuite) Test_get_changeset_plan_handler_returns_422_when_changes_not_staged() {
+	err := s.saveTestChangeset()
+	s.Require().NoError(err)
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/changes/{id}/plan",
+		s.ctrl.GetChangesetPlanHandler,
+	).Methods("GET")
+
+	path := fmt.Sprintf("/deployments/changes/%s/plan", testChangesetID)
+	req := httptest.NewRequest("GET", path, nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	result := w.Result()
+	defer result.Body.Close()
+	respData, err := io.ReadAll(result.Body)
+	s.Require().NoError(err)
+
+	responseError := map[string]string{}
+	err = json.Unmarshal(respData, &responseError)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(http.StatusUnprocessableEntity, result.StatusCode)
+	s.Assert().Equal(
+		fmt.Sprintf(
+			"a plan is not available for change set %q with status %q, "+
+				"change staging must complete successfully first",
+			testChangesetID,
+			manage.ChangesetStatusStarting,
+		),
+		responseError["message"],
+	)
+}
+
+func (s *ControllerTestSuite) saveStagedChangeset() error {
+	changeset := &manage.Changeset{
+		ID:                testStagedChangesetID,
+		Status:            manage.ChangesetStatusChangesStaged,
+		BlueprintLocation: "file:///test/dir/test.blueprint.yaml",
+		Created:           testTime.Unix(),
+		Changes: &changes.BlueprintChanges{
+			RemovedResources: []string{"resource2", "resource1"},
+		},
+	}
+
+	return s.changesetStore.Save(
+		context.Background(),
+		changeset,
+	)
+}
+
 func (s *ControllerTestSuite) saveTestChangeset() error {
 	changeset := &manage.Changeset{
 		ID:                testChangesetID,
@@ -0,0 +1,247 @@
+package deploymentsv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+const (
+	testRevisionChangesetID1 = "3e0a4d52-b7d4-4d5b-8ae8-7fd1c4a8b0b1"
+	testRevisionChangesetID2 = "b0a1f6f4-2d83-4a51-9d11-9ea3a6cf8e22"
+	testHistoryResourceID    = "c1e4d2a8-6f1b-4b3e-9c0d-2a7e5f9b8d31"
+)
+
+func (s *ControllerTestSuite) Test_get_resource_state_at_handler_for_timestamp() {
+	err := s.saveTestResourceHistory()
+	s.Require().NoError(err)
+
+	result, respData := s.getResourceStateAt(
+		testInstanceName,
+		"ordersTable",
+		fmt.Sprintf("at=%d", testTime.Unix()+150),
+	)
+	s.Require().Equal(http.StatusOK, result.StatusCode)
+
+	resourceStateAt := &manage.ResourceStateAtRevision{}
+	err = json.Unmarshal(respData, resourceStateAt)
+	s.Require().NoError(err)
+
+	s.Equal("ordersTable", resourceStateAt.ResourceName)
+	s.Equal(testRevisionChangesetID1, resourceStateAt.Revision)
+	s.True(resourceStateAt.Existed)
+	s.Require().NotNil(resourceStateAt.State)
+	s.Equal(
+		"orders",
+		core.StringValue(resourceStateAt.State.SpecData.Fields["tableName"]),
+	)
+	s.Require().Len(resourceStateAt.ModifiedFields, 1)
+	s.Equal("spec.tableName", resourceStateAt.ModifiedFields[0].FieldPath)
+	s.Equal("orders-v2", core.StringValue(resourceStateAt.ModifiedFields[0].NewValue))
+}
+
+func (s *ControllerTestSuite) Test_get_resource_state_at_handler_for_revision() {
+	err := s.saveTestResourceHistory()
+	s.Require().NoError(err)
+
+	result, respData := s.getResourceStateAt(
+		testInstanceID,
+		"ordersTable",
+		fmt.Sprintf("revision=%s", testRevisionChangesetID2),
+	)
+	s.Require().Equal(http.StatusOK, result.StatusCode)
+
+	resourceStateAt := &manage.ResourceStateAtRevision{}
+	err = json.Unmarshal(respData, resourceStateAt)
+	s.Require().NoError(err)
+
+	s.Equal(testRevisionChangesetID2, resourceStateAt.Revision)
+	s.Equal(
+		"orders-v2",
+		core.StringValue(resourceStateAt.State.SpecData.Fields["tableName"]),
+	)
+	s.Empty(resourceStateAt.ModifiedFields)
+}
+
+func (s *ControllerTestSuite) Test_get_resource_state_at_handler_returns_400_without_point_in_time() {
+	err := s.saveTestResourceHistory()
+	s.Require().NoError(err)
+
+	result, respData := s.getResourceStateAt(testInstanceID, "ordersTable", "")
+
+	responseError := map[string]string{}
+	err = json.Unmarshal(respData, &responseError)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(http.StatusBadRequest, result.StatusCode)
+	s.Assert().Equal(
+		"either the \"at\" or \"revision\" query parameter must be provided",
+		responseError["message"],
+	)
+}
+
+func (s *ControllerTestSuite) Test_get_resource_state_at_handler_returns_404_for_missing_resource() {
+	err := s.saveTestResourceHistory()
+	s.Require().NoError(err)
+
+	result, respData := s.getResourceStateAt(
+		testInstanceID,
+		"missingResource",
+		fmt.Sprintf("at=%d", testTime.Unix()),
+	)
+
+	responseError := map[string]string{}
+	err = json.Unmarshal(respData, &responseError)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(http.StatusNotFound, result.StatusCode)
+	s.Assert().Equal(
+		fmt.Sprintf(
+			"resource %q not found in blueprint instance %q",
+			"missingResource",
+			testInstanceID,
+		),
+		responseError["message"],
+	)
+}
+
+func (s *ControllerTestSuite) Test_get_resource_state_at_handler_returns_404_for_revision_not_deployed() {
+	err := s.saveTestResourceHistory()
+	s.Require().NoError(err)
+
+	result, respData := s.getResourceStateAt(
+		testInstanceID,
+		"ordersTable",
+		"revision=not-deployed",
+	)
+
+	responseError := map[string]string{}
+	err = json.Unmarshal(respData, &responseError)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(http.StatusNotFound, result.StatusCode)
+	s.Assert().Equal(
+		fmt.Sprintf(
+			"revision %q has not been deployed to blueprint instance %q",
+			"not-deployed",
+			testInstanceID,
+		),
+		responseError["message"],
+	)
+}
+
+func (s *ControllerTestSuite) getResourceStateAt(
+	instanceIDOrName string,
+	resourceName string,
+	query string,
+) (*http.Response, []byte) {
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/instances/{id}/resources/{name}/state",
+		s.ctrl.GetResourceStateAtHandler,
+	).Methods("GET")
+
+	path := fmt.Sprintf(
+		"/deployments/instances/%s/resources/%s/state?%s",
+		instanceIDOrName,
+		resourceName,
+		query,
+	)
+	req := httptest.NewRequest("GET", path, nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	result := w.Result()
+	defer result.Body.Close()
+	respData, err := io.ReadAll(result.Body)
+	s.Require().NoError(err)
+
+	return result, respData
+}
+
+func (s *ControllerTestSuite) saveTestResourceHistory() error {
+	err := s.instances.Save(
+		context.Background(),
+		state.InstanceState{
+			InstanceID:   testInstanceID,
+			InstanceName: testInstanceName,
+			Status:       core.InstanceStatusUpdated,
+			ResourceIDs: map[string]string{
+				"ordersTable": testHistoryResourceID,
+			},
+			Resources: map[string]*state.ResourceState{
+				testHistoryResourceID: historyTestResourceState("orders-v2"),
+			},
+			LastStatusUpdateTimestamp: int(testTime.Unix()),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	revisions := []*manage.Changeset{
+		{
+			ID:                testRevisionChangesetID1,
+			InstanceID:        testInstanceID,
+			Status:            manage.ChangesetStatusChangesStaged,
+			BlueprintLocation: "file:///test/dir/test.blueprint.yaml",
+			Created:           testTime.Unix(),
+			Deployed:          testTime.Unix() + 100,
+			Changes: &changes.BlueprintChanges{
+				NewResources: map[string]provider.Changes{
+					"ordersTable": {},
+				},
+			},
+		},
+		{
+			ID:                testRevisionChangesetID2,
+			InstanceID:        testInstanceID,
+			Status:            manage.ChangesetStatusChangesStaged,
+			BlueprintLocation: "file:///test/dir/test.blueprint.yaml",
+			Created:           testTime.Unix() + 200,
+			Deployed:          testTime.Unix() + 300,
+			Changes: &changes.BlueprintChanges{
+				ResourceChanges: map[string]provider.Changes{
+					"ordersTable": {
+						AppliedResourceInfo: provider.ResourceInfo{
+							ResourceName:         "ordersTable",
+							CurrentResourceState: historyTestResourceState("orders"),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, revision := range revisions {
+		err = s.changesetStore.Save(context.Background(), revision)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func historyTestResourceState(tableName string) *state.ResourceState {
+	return &state.ResourceState{
+		ResourceID: testHistoryResourceID,
+		Name:       "ordersTable",
+		Type:       "aws/dynamodb/table",
+		InstanceID: testInstanceID,
+		SpecData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"tableName": core.MappingNodeFromString(tableName),
+			},
+		},
+	}
+}
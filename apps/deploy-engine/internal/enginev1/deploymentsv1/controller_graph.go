@@ -0,0 +1,131 @@
+package deploymentsv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/helpersv1"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/inputvalidation"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/httputils"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/resolve"
+	internalutils "github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/utils"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/includes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+const (
+	// exportGraphTimeout is the timeout for resolving and rendering
+	// the dependency graph for a blueprint.
+	exportGraphTimeout = 2 * time.Minute
+)
+
+// ExportGraphHandler is the handler for the
+// POST /deployments/graph endpoint that renders the dependency graph
+// of resources, child blueprints and links in a blueprint
+// in DOT, Mermaid or JSON format.
+// The graph is resolved in the same way as when staging changes,
+// so the groups and dependencies reflect the order in which the
+// elements of the blueprint would be deployed.
+func (c *Controller) ExportGraphHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	payload := &ExportGraphRequestPayload{}
+	responseWritten := httputils.DecodeRequestBody(w, r, payload, c.logger)
+	if responseWritten {
+		return
+	}
+
+	if err := helpersv1.ValidateRequestBody.Struct(payload); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		inputvalidation.HTTPValidationError(w, validationErrors)
+		return
+	}
+
+	helpersv1.PopulateBlueprintDocInfoDefaults(&payload.BlueprintDocumentInfo)
+
+	finalConfig, _, responseWritten := helpersv1.PrepareAndValidatePluginConfig(
+		r,
+		w,
+		payload.Config,
+		/* validate */ true,
+		c.pluginConfigPreparer,
+		c.logger,
+	)
+	if responseWritten {
+		return
+	}
+
+	blueprintInfo, responseWritten := resolve.ResolveBlueprintForRequest(
+		r,
+		w,
+		&payload.BlueprintDocumentInfo,
+		c.blueprintResolver,
+		c.logger,
+	)
+	if responseWritten {
+		return
+	}
+
+	finalConfig = internalutils.EnsureBlueprintDirContextVar(finalConfig, payload.BlueprintDocumentInfo.Directory)
+	blueprintParams := c.paramsProvider.CreateFromRequestConfig(finalConfig)
+
+	format := container.GraphFormat(payload.Format)
+	graph, err := c.exportGraph(
+		r.Context(),
+		format,
+		blueprintInfo,
+		helpersv1.GetFormat(payload.BlueprintFile),
+		blueprintParams,
+	)
+	if err != nil {
+		c.logger.Debug(
+			"failed to export dependency graph",
+			core.ErrorLogField("error", err),
+		)
+		httputils.HTTPError(
+			w,
+			http.StatusInternalServerError,
+			utils.UnexpectedErrorMessage,
+		)
+		return
+	}
+
+	httputils.HTTPJSONResponse(
+		w,
+		http.StatusOK,
+		&ExportGraphResponse{
+			Format: payload.Format,
+			Graph:  string(graph),
+		},
+	)
+}
+
+func (c *Controller) exportGraph(
+	ctx context.Context,
+	format container.GraphFormat,
+	blueprintInfo *includes.ChildBlueprintInfo,
+	specFormat schema.SpecFormat,
+	params core.BlueprintParams,
+) ([]byte, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, exportGraphTimeout)
+	defer cancel()
+
+	blueprintContainer, err := c.blueprintLoader.LoadString(
+		ctxWithTimeout,
+		helpersv1.GetBlueprintSource(blueprintInfo),
+		specFormat,
+		params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blueprint container: %w", err)
+	}
+
+	return blueprintContainer.ExportGraph(ctxWithTimeout, format, params)
+}
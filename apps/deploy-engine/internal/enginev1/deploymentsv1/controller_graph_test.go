@@ -0,0 +1,70 @@
+package deploymentsv1
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+func (s *ControllerTestSuite) Test_export_graph_in_mermaid_format() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/graph",
+		ctrl.ExportGraphHandler,
+	).Methods("POST")
+
+	payload := ExportGraphRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		Format:                "mermaid",
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+	}
+
+	graphResp := &ExportGraphResponse{}
+	statusCode := s.postImportRequest(
+		router,
+		"/deployments/graph",
+		payload,
+		graphResp,
+	)
+
+	s.Assert().Equal(http.StatusOK, statusCode)
+	s.Assert().Equal("mermaid", graphResp.Format)
+	s.Assert().Equal(
+		"flowchart LR\n  n0[\"exampleResource<br/>example/resource\"]\n",
+		graphResp.Graph,
+	)
+}
+
+func (s *ControllerTestSuite) Test_export_graph_fails_for_unsupported_format() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/graph",
+		ctrl.ExportGraphHandler,
+	).Methods("POST")
+
+	payload := ExportGraphRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		Format:                "svg",
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+	}
+
+	errResp := map[string]any{}
+	statusCode := s.postImportRequest(
+		router,
+		"/deployments/graph",
+		payload,
+		&errResp,
+	)
+
+	s.Assert().Equal(http.StatusUnprocessableEntity, statusCode)
+}
@@ -0,0 +1,305 @@
+package deploymentsv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/helpersv1"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/inputvalidation"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/httputils"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/resolve"
+	internalutils "github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/utils"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/includes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+const (
+	// importTimeout is the timeout for listing import candidates
+	// and importing resources.
+	importTimeout = 10 * time.Minute
+)
+
+// ListImportCandidatesHandler is the handler for the
+// POST /deployments/instances/{id}/import/candidates endpoint
+// that lists existing resources in providers that the resources
+// in a blueprint that are not yet tracked in the instance state can be imported from.
+// The {id} path parameter can be the ID or name of an instance,
+// when there is no instance with the given ID or name, it is treated
+// as the name of a new instance and all resources in the blueprint are unmatched.
+func (c *Controller) ListImportCandidatesHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	params := mux.Vars(r)
+	instanceIDOrName := params["id"]
+
+	payload := &ListImportCandidatesRequestPayload{}
+	responseWritten := httputils.DecodeRequestBody(w, r, payload, c.logger)
+	if responseWritten {
+		return
+	}
+
+	if err := helpersv1.ValidateRequestBody.Struct(payload); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		inputvalidation.HTTPValidationError(w, validationErrors)
+		return
+	}
+
+	helpersv1.PopulateBlueprintDocInfoDefaults(&payload.BlueprintDocumentInfo)
+
+	finalConfig, _, responseWritten := helpersv1.PrepareAndValidatePluginConfig(
+		r,
+		w,
+		payload.Config,
+		/* validate */ true,
+		c.pluginConfigPreparer,
+		c.logger,
+	)
+	if responseWritten {
+		return
+	}
+
+	blueprintInfo, responseWritten := resolve.ResolveBlueprintForRequest(
+		r,
+		w,
+		&payload.BlueprintDocumentInfo,
+		c.blueprintResolver,
+		c.logger,
+	)
+	if responseWritten {
+		return
+	}
+
+	instanceID, instanceName, responseWritten := c.resolveImportInstance(
+		r.Context(),
+		w,
+		instanceIDOrName,
+	)
+	if responseWritten {
+		return
+	}
+
+	finalConfig = internalutils.EnsureBlueprintDirContextVar(finalConfig, payload.BlueprintDocumentInfo.Directory)
+	blueprintParams := c.paramsProvider.CreateFromRequestConfig(finalConfig)
+
+	result, err := c.listImportCandidates(
+		r.Context(),
+		&container.ListImportCandidatesInput{
+			InstanceID:    instanceID,
+			InstanceName:  instanceName,
+			ResourceNames: payload.ResourceNames,
+			MaxCandidates: payload.MaxCandidates,
+		},
+		blueprintInfo,
+		helpersv1.GetFormat(payload.BlueprintFile),
+		blueprintParams,
+	)
+	if err != nil {
+		c.logger.Debug(
+			"failed to list import candidates",
+			core.ErrorLogField("error", err),
+		)
+		httputils.HTTPError(
+			w,
+			http.StatusInternalServerError,
+			utils.UnexpectedErrorMessage,
+		)
+		return
+	}
+
+	httputils.HTTPJSONResponse(
+		w,
+		http.StatusOK,
+		result,
+	)
+}
+
+// ImportResourcesHandler is the handler for the
+// POST /deployments/instances/{id}/import endpoint
+// that adopts existing resources in providers into the state
+// of a blueprint instance using provider-specific import IDs.
+// The {id} path parameter can be the ID or name of an instance,
+// when there is no instance with the given ID or name, a new instance
+// is created with the path parameter as its name.
+func (c *Controller) ImportResourcesHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	params := mux.Vars(r)
+	instanceIDOrName := params["id"]
+
+	payload := &ImportResourcesRequestPayload{}
+	responseWritten := httputils.DecodeRequestBody(w, r, payload, c.logger)
+	if responseWritten {
+		return
+	}
+
+	if err := helpersv1.ValidateRequestBody.Struct(payload); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		inputvalidation.HTTPValidationError(w, validationErrors)
+		return
+	}
+
+	helpersv1.PopulateBlueprintDocInfoDefaults(&payload.BlueprintDocumentInfo)
+
+	finalConfig, _, responseWritten := helpersv1.PrepareAndValidatePluginConfig(
+		r,
+		w,
+		payload.Config,
+		/* validate */ true,
+		c.pluginConfigPreparer,
+		c.logger,
+	)
+	if responseWritten {
+		return
+	}
+
+	blueprintInfo, responseWritten := resolve.ResolveBlueprintForRequest(
+		r,
+		w,
+		&payload.BlueprintDocumentInfo,
+		c.blueprintResolver,
+		c.logger,
+	)
+	if responseWritten {
+		return
+	}
+
+	instanceID, instanceName, responseWritten := c.resolveImportInstance(
+		r.Context(),
+		w,
+		instanceIDOrName,
+	)
+	if responseWritten {
+		return
+	}
+
+	finalConfig = internalutils.EnsureBlueprintDirContextVar(finalConfig, payload.BlueprintDocumentInfo.Directory)
+	blueprintParams := c.paramsProvider.CreateFromRequestConfig(finalConfig)
+
+	result, err := c.importResources(
+		r.Context(),
+		&container.ImportResourcesInput{
+			InstanceID:   instanceID,
+			InstanceName: instanceName,
+			Mappings:     convertImportMappings(payload.Mappings),
+		},
+		blueprintInfo,
+		helpersv1.GetFormat(payload.BlueprintFile),
+		blueprintParams,
+	)
+	if err != nil {
+		c.logger.Debug(
+			"failed to import resources",
+			core.ErrorLogField("error", err),
+		)
+		httputils.HTTPError(
+			w,
+			http.StatusInternalServerError,
+			utils.UnexpectedErrorMessage,
+		)
+		return
+	}
+
+	httputils.HTTPJSONResponse(
+		w,
+		http.StatusOK,
+		result,
+	)
+}
+
+// Resolves the instance ID and name for an import request,
+// when the instance does not exist, an empty instance ID is returned
+// along with the provided value as the instance name.
+func (c *Controller) resolveImportInstance(
+	ctx context.Context,
+	w http.ResponseWriter,
+	instanceIDOrName string,
+) (string, string, bool) {
+	resolvedInstance, err := c.resolveInstance(ctx, instanceIDOrName)
+	if err != nil {
+		if state.IsInstanceNotFound(err) {
+			return "", instanceIDOrName, false
+		}
+		c.logger.Debug(
+			"failed to resolve instance",
+			core.ErrorLogField("error", err),
+		)
+		httputils.HTTPError(
+			w,
+			http.StatusInternalServerError,
+			utils.UnexpectedErrorMessage,
+		)
+		return "", "", true
+	}
+
+	return resolvedInstance.InstanceID, resolvedInstance.InstanceName, false
+}
+
+func (c *Controller) listImportCandidates(
+	ctx context.Context,
+	input *container.ListImportCandidatesInput,
+	blueprintInfo *includes.ChildBlueprintInfo,
+	format schema.SpecFormat,
+	params core.BlueprintParams,
+) (*container.ImportCandidatesResult, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, importTimeout)
+	defer cancel()
+
+	blueprintContainer, err := c.blueprintLoader.LoadString(
+		ctxWithTimeout,
+		helpersv1.GetBlueprintSource(blueprintInfo),
+		format,
+		params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blueprint container: %w", err)
+	}
+
+	return blueprintContainer.ListImportCandidates(ctxWithTimeout, input, params)
+}
+
+func (c *Controller) importResources(
+	ctx context.Context,
+	input *container.ImportResourcesInput,
+	blueprintInfo *includes.ChildBlueprintInfo,
+	format schema.SpecFormat,
+	params core.BlueprintParams,
+) (*container.ImportResourcesResult, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, importTimeout)
+	defer cancel()
+
+	blueprintContainer, err := c.blueprintLoader.LoadString(
+		ctxWithTimeout,
+		helpersv1.GetBlueprintSource(blueprintInfo),
+		format,
+		params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blueprint container: %w", err)
+	}
+
+	return blueprintContainer.ImportResources(ctxWithTimeout, input, params)
+}
+
+func convertImportMappings(
+	mappings []*ImportResourceMappingPayload,
+) []*container.ImportResourceMapping {
+	converted := make([]*container.ImportResourceMapping, len(mappings))
+	for i, mapping := range mappings {
+		converted[i] = &container.ImportResourceMapping{
+			ResourceName: mapping.ResourceName,
+			ImportID:     mapping.ImportID,
+		}
+	}
+
+	return converted
+}
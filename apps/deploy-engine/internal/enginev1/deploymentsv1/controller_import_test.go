@@ -0,0 +1,133 @@
+package deploymentsv1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+func (s *ControllerTestSuite) Test_list_import_candidates_for_existing_instance() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/instances/{id}/import/candidates",
+		ctrl.ListImportCandidatesHandler,
+	).Methods("POST")
+
+	payload := ListImportCandidatesRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		ResourceNames:         []string{"ordersQueue"},
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+	}
+
+	candidatesResult := &container.ImportCandidatesResult{}
+	statusCode := s.postImportRequest(
+		router,
+		fmt.Sprintf("/deployments/instances/%s/import/candidates", reconciliationTestInstanceName),
+		payload,
+		candidatesResult,
+	)
+
+	s.Assert().Equal(http.StatusOK, statusCode)
+	s.Assert().Equal(reconciliationTestInstanceID, candidatesResult.InstanceID)
+	s.Require().Len(candidatesResult.Resources, 1)
+	s.Assert().Equal("ordersQueue", candidatesResult.Resources[0].ResourceName)
+}
+
+func (s *ControllerTestSuite) Test_import_resources_into_new_instance() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/instances/{id}/import",
+		ctrl.ImportResourcesHandler,
+	).Methods("POST")
+
+	payload := ImportResourcesRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		Mappings: []*ImportResourceMappingPayload{
+			{ResourceName: "ordersQueue", ImportID: "queue-arn-1"},
+		},
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+	}
+
+	importResult := &container.ImportResourcesResult{}
+	statusCode := s.postImportRequest(
+		router,
+		"/deployments/instances/new-import-instance/import",
+		payload,
+		importResult,
+	)
+
+	s.Assert().Equal(http.StatusOK, statusCode)
+	s.Assert().True(importResult.InstanceCreated)
+	s.Require().Len(importResult.Imported, 1)
+	s.Assert().Equal("ordersQueue", importResult.Imported[0].ResourceName)
+	s.Assert().Equal("queue-arn-1", importResult.Imported[0].ImportID)
+}
+
+func (s *ControllerTestSuite) Test_import_resources_fails_for_missing_mappings() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/instances/{id}/import",
+		ctrl.ImportResourcesHandler,
+	).Methods("POST")
+
+	payload := ImportResourcesRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		Mappings:              []*ImportResourceMappingPayload{},
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+	}
+
+	errResp := map[string]any{}
+	statusCode := s.postImportRequest(
+		router,
+		fmt.Sprintf("/deployments/instances/%s/import", reconciliationTestInstanceID),
+		payload,
+		&errResp,
+	)
+
+	s.Assert().Equal(http.StatusUnprocessableEntity, statusCode)
+}
+
+func (s *ControllerTestSuite) postImportRequest(
+	router *mux.Router,
+	path string,
+	payload any,
+	target any,
+) int {
+	payloadBytes, err := json.Marshal(payload)
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest("POST", path, bytes.NewReader(payloadBytes))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	result := w.Result()
+	defer result.Body.Close()
+	respData, err := io.ReadAll(result.Body)
+	s.Require().NoError(err)
+
+	err = json.Unmarshal(respData, target)
+	s.Require().NoError(err)
+
+	return result.StatusCode
+}
@@ -0,0 +1,74 @@
+package deploymentsv1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (s *ControllerTestSuite) Test_list_blueprint_instance_summaries_returns_all_summaries() {
+	s.saveTestInstances()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/instance-summaries",
+		s.ctrl.ListBlueprintInstanceSummariesHandler,
+	).Methods("GET")
+
+	req := httptest.NewRequest("GET", "/deployments/instance-summaries", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	result := w.Result()
+	defer result.Body.Close()
+	respData, err := io.ReadAll(result.Body)
+	s.Require().NoError(err)
+
+	listResult := &state.ListInstanceStatusSummariesResult{}
+	err = json.Unmarshal(respData, listResult)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(http.StatusOK, result.StatusCode)
+	s.Assert().Equal(3, listResult.TotalCount)
+	s.Require().Len(listResult.Summaries, 3)
+	s.Assert().Equal("another-prod", listResult.Summaries[0].InstanceName)
+	s.Assert().Equal(core.InstanceStatusDeploying, listResult.Summaries[0].Status)
+	s.Assert().Equal(int64(1700000200), listResult.Summaries[0].LastDeployedTimestamp)
+}
+
+func (s *ControllerTestSuite) Test_list_blueprint_instance_summaries_filters_and_paginates() {
+	s.saveTestInstances()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/instance-summaries",
+		s.ctrl.ListBlueprintInstanceSummariesHandler,
+	).Methods("GET")
+
+	req := httptest.NewRequest(
+		"GET",
+		"/deployments/instance-summaries?search=prod&limit=1&offset=1",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	result := w.Result()
+	defer result.Body.Close()
+	respData, err := io.ReadAll(result.Body)
+	s.Require().NoError(err)
+
+	listResult := &state.ListInstanceStatusSummariesResult{}
+	err = json.Unmarshal(respData, listResult)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(http.StatusOK, result.StatusCode)
+	s.Assert().Equal(2, listResult.TotalCount)
+	s.Require().Len(listResult.Summaries, 1)
+	s.Assert().Equal("my-app-prod", listResult.Summaries[0].InstanceName)
+}
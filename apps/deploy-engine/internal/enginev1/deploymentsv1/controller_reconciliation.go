@@ -202,6 +202,7 @@ func (c *Controller) ApplyReconciliationHandler(
 	finalConfig = internalutils.EnsureBlueprintDirContextVar(finalConfig, payload.BlueprintDocumentInfo.Directory)
 	blueprintParams := c.paramsProvider.CreateFromRequestConfig(finalConfig)
 
+	startedAt := c.clock.Now()
 	result, err := c.applyReconciliation(
 		r.Context(),
 		resolvedInstance.InstanceID,
@@ -210,6 +211,15 @@ func (c *Controller) ApplyReconciliationHandler(
 		helpersv1.GetFormat(payload.BlueprintFile),
 		blueprintParams,
 	)
+	c.recordReconciliationRun(
+		r,
+		resolvedInstance,
+		payload,
+		c.createRunMetadata(r, helpersv1.GetBlueprintSource(blueprintInfo)),
+		startedAt,
+		result,
+		err,
+	)
 	if err != nil {
 		c.logger.Debug(
 			"failed to apply reconciliation",
@@ -255,13 +265,15 @@ func (c *Controller) performReconciliationCheck(
 	scope := parseReconciliationScope(payload.Scope)
 	taggingConfig := c.createTaggingConfig(payload.Config)
 	input := &container.CheckReconciliationInput{
-		InstanceID:      instanceID,
-		Scope:           scope,
-		ResourceNames:   payload.ResourceNames,
-		LinkNames:       payload.LinkNames,
-		IncludeChildren: payload.IncludeChildren,
-		ChildPath:       payload.ChildPath,
-		TaggingConfig:   taggingConfig,
+		InstanceID:        instanceID,
+		Scope:             scope,
+		ResourceNames:     payload.ResourceNames,
+		LinkNames:         payload.LinkNames,
+		IncludeDependents: payload.IncludeDependents,
+		IncludeChildren:   payload.IncludeChildren,
+		ChildPath:         payload.ChildPath,
+		TaggingConfig:     taggingConfig,
+		CheckDataSources:  payload.CheckDataSources,
 	}
 
 	return blueprintContainer.CheckReconciliation(ctxWithTimeout, input, params)
@@ -298,6 +310,119 @@ func (c *Controller) applyReconciliation(
 	return blueprintContainer.ApplyReconciliation(ctxWithTimeout, input, params)
 }
 
+func (c *Controller) recordReconciliationRun(
+	r *http.Request,
+	instance *state.InstanceState,
+	payload *ApplyReconciliationRequestPayload,
+	runMetadata *state.DeploymentRunMetadata,
+	startedAt time.Time,
+	result *container.ApplyReconciliationResult,
+	reconcileErr error,
+) {
+	runID, err := c.idGenerator.GenerateID()
+	if err != nil {
+		c.logger.Warn(
+			"failed to generate ID for reconciliation run",
+			core.ErrorLogField("error", err),
+		)
+		return
+	}
+
+	finishedAt := c.clock.Now()
+	durationMillis := float64(finishedAt.Sub(startedAt).Microseconds()) / 1000
+	run := state.DeploymentRun{
+		ID:                   runID,
+		InstanceID:           instance.InstanceID,
+		InstanceName:         instance.InstanceName,
+		Operation:            state.DeploymentRunOperationReconcile,
+		Metadata:             runMetadata,
+		Succeeded:            reconcileErr == nil && (result == nil || len(result.Errors) == 0),
+		StartedAt:            startedAt.Unix(),
+		FinishedAt:           finishedAt.Unix(),
+		DurationMilliseconds: &durationMillis,
+		Elements:             reconciliationRunElements(instance, payload, result),
+	}
+	if reconcileErr != nil {
+		run.Errors = []string{reconcileErr.Error()}
+	}
+
+	err = c.instances.SaveRun(r.Context(), run)
+	if err != nil {
+		c.logger.Warn(
+			"failed to save reconciliation run",
+			core.ErrorLogField("error", err),
+			core.StringLogField("instanceId", instance.InstanceID),
+		)
+	}
+}
+
+func reconciliationRunElements(
+	instance *state.InstanceState,
+	payload *ApplyReconciliationRequestPayload,
+	result *container.ApplyReconciliationResult,
+) []*state.DeploymentRunElement {
+	elementErrors := map[string][]string{}
+	if result != nil {
+		for _, reconcileErr := range result.Errors {
+			elementErrors[reconcileErr.ElementID] = append(
+				elementErrors[reconcileErr.ElementID],
+				reconcileErr.Error,
+			)
+		}
+	}
+
+	elements := make(
+		[]*state.DeploymentRunElement,
+		0,
+		len(payload.ResourceActions)+len(payload.LinkActions),
+	)
+	for _, action := range payload.ResourceActions {
+		elements = append(elements, &state.DeploymentRunElement{
+			Type:           state.DeploymentRunElementResource,
+			Name:           reconciledResourceName(instance, action.ResourceID),
+			ID:             action.ResourceID,
+			InstanceID:     instance.InstanceID,
+			Status:         action.NewStatus,
+			FailureReasons: elementErrors[action.ResourceID],
+		})
+	}
+
+	for _, action := range payload.LinkActions {
+		elements = append(elements, &state.DeploymentRunElement{
+			Type:           state.DeploymentRunElementLink,
+			Name:           reconciledLinkName(instance, action.LinkID),
+			ID:             action.LinkID,
+			InstanceID:     instance.InstanceID,
+			Status:         action.NewStatus,
+			FailureReasons: elementErrors[action.LinkID],
+		})
+	}
+
+	return elements
+}
+
+// Resource and link actions for reconciliation are identified by ID,
+// names are only resolved for elements of the top-level instance,
+// the IDs should be used to identify elements in child blueprints.
+func reconciledResourceName(instance *state.InstanceState, resourceID string) string {
+	resource, hasResource := instance.Resources[resourceID]
+	if !hasResource || resource == nil {
+		return ""
+	}
+
+	return resource.Name
+}
+
+func reconciledLinkName(instance *state.InstanceState, linkID string) string {
+	for linkName, link := range instance.Links {
+		if link != nil && link.LinkID == linkID {
+			return linkName
+		}
+	}
+
+	return ""
+}
+
 func (c *Controller) resolveInstance(
 	ctx context.Context,
 	instanceIDOrName string,
@@ -346,6 +471,8 @@ func parseReconciliationAction(action string) container.ReconciliationAction {
 		return container.ReconciliationActionUpdateStatus
 	case "manual_cleanup_required":
 		return container.ReconciliationActionManualCleanupRequired
+	case "reapply_blueprint":
+		return container.ReconciliationActionReapplyBlueprint
 	default:
 		return container.ReconciliationActionUpdateStatus
 	}
@@ -433,6 +560,21 @@ func convertResourceActions(payloadActions []ResourceReconcileActionPayload) []c
 			Action:        parseReconciliationAction(pa.Action),
 			ExternalState: pa.ExternalState,
 			NewStatus:     parsePreciseResourceStatus(pa.NewStatus),
+			FieldActions:  convertFieldActions(pa.FieldActions),
+		}
+	}
+	return actions
+}
+
+func convertFieldActions(payloadActions []FieldReconcileActionPayload) []container.FieldReconcileAction {
+	if len(payloadActions) == 0 {
+		return nil
+	}
+	actions := make([]container.FieldReconcileAction, len(payloadActions))
+	for i, pa := range payloadActions {
+		actions[i] = container.FieldReconcileAction{
+			FieldPath: pa.FieldPath,
+			Action:    container.FieldReconciliationAction(pa.Action),
 		}
 	}
 	return actions
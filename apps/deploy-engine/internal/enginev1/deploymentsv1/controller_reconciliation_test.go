@@ -305,6 +305,7 @@ func (s *ControllerTestSuite) Test_check_reconciliation_specific_scope() {
 		Scope:                 "specific",
 		ResourceNames:         []string{"resource1", "resource2"},
 		LinkNames:             []string{"link1"},
+		IncludeDependents:     true,
 		Config: &types.BlueprintOperationConfig{
 			Providers: map[string]map[string]*core.ScalarValue{},
 		},
@@ -330,6 +331,7 @@ func (s *ControllerTestSuite) Test_check_reconciliation_specific_scope() {
 	s.Assert().Equal(container.ReconciliationScopeSpecific, checkCalls[0].Scope)
 	s.Assert().Equal([]string{"resource1", "resource2"}, checkCalls[0].ResourceNames)
 	s.Assert().Equal([]string{"link1"}, checkCalls[0].LinkNames)
+	s.Assert().True(checkCalls[0].IncludeDependents)
 }
 
 func (s *ControllerTestSuite) Test_check_reconciliation_container_error() {
@@ -448,6 +450,94 @@ func (s *ControllerTestSuite) Test_apply_reconciliation_success() {
 	s.Assert().Len(applyCalls[0].LinkActions, 1)
 }
 
+func (s *ControllerTestSuite) Test_apply_reconciliation_records_deployment_run() {
+	ctrl := s.setupReconciliationTest(
+		testutils.WithApplyReconciliationResult(&container.ApplyReconciliationResult{
+			InstanceID:       reconciliationTestInstanceID,
+			ResourcesUpdated: 1,
+			Errors: []container.ReconciliationError{
+				{
+					ElementID:   "link-1",
+					ElementType: "link",
+					Error:       "link data could not be updated",
+				},
+			},
+		}),
+	)
+	ctrl.engineVersion = "1.2.0"
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/instances/{id}/reconciliation/apply",
+		ctrl.ApplyReconciliationHandler,
+	).Methods("POST")
+
+	payload := ApplyReconciliationRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		ResourceActions: []ResourceReconcileActionPayload{
+			{
+				ResourceID: "resource-1",
+				Action:     "accept_external",
+				NewStatus:  "stable",
+			},
+		},
+		LinkActions: []LinkReconcileActionPayload{
+			{
+				LinkID:    "link-1",
+				Action:    "update_status",
+				NewStatus: "stable",
+			},
+		},
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+	}
+	payloadBytes, err := json.Marshal(payload)
+	s.Require().NoError(err)
+
+	path := fmt.Sprintf("/deployments/instances/%s/reconciliation/apply", reconciliationTestInstanceID)
+	req := httptest.NewRequest("POST", path, bytes.NewReader(payloadBytes))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(initiatedByHeaderName, "ops-user")
+	req.Header.Set(clientVersionHeaderName, "0.9.0")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	result := w.Result()
+	defer result.Body.Close()
+
+	s.Assert().Equal(http.StatusOK, result.StatusCode)
+
+	runs, err := ctrl.instances.ListRuns(
+		context.Background(),
+		reconciliationTestInstanceID,
+		state.ListRunsParams{},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(runs.Runs, 1)
+
+	run := runs.Runs[0]
+	s.Assert().Equal(state.DeploymentRunOperationReconcile, run.Operation)
+	s.Assert().Equal(reconciliationTestInstanceName, run.InstanceName)
+	s.Assert().False(run.Succeeded)
+	s.Assert().Equal(testTime.Unix(), run.StartedAt)
+	s.Require().NotNil(run.Metadata)
+	s.Assert().Equal("ops-user", run.Metadata.InitiatedBy)
+	s.Assert().Equal("0.9.0", run.Metadata.ClientVersion)
+	s.Assert().Equal("1.2.0", run.Metadata.EngineVersion)
+	s.Assert().NotEmpty(run.Metadata.BlueprintSourceHash)
+	s.Require().Len(run.Elements, 2)
+	s.Assert().Equal(state.DeploymentRunElementResource, run.Elements[0].Type)
+	s.Assert().Equal("resource-1", run.Elements[0].ID)
+	s.Assert().Empty(run.Elements[0].FailureReasons)
+	s.Assert().Equal(state.DeploymentRunElementLink, run.Elements[1].Type)
+	s.Assert().Equal("link-1", run.Elements[1].ID)
+	s.Assert().Equal(
+		[]string{"link data could not be updated"},
+		run.Elements[1].FailureReasons,
+	)
+}
+
 func (s *ControllerTestSuite) Test_apply_reconciliation_by_instance_name() {
 	ctrl := s.setupReconciliationTest()
 
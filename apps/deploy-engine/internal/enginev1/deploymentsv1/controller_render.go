@@ -0,0 +1,132 @@
+package deploymentsv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/helpersv1"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/inputvalidation"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/httputils"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/resolve"
+	internalutils "github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/utils"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/includes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+)
+
+const (
+	// renderBlueprintTimeout is the timeout for resolving
+	// a fully rendered version of a blueprint.
+	renderBlueprintTimeout = 2 * time.Minute
+)
+
+// RenderBlueprintHandler is the handler for the
+// POST /deployments/render endpoint that produces a fully resolved
+// version of a blueprint with resource templates expanded, defaults populated,
+// conditions applied and substitutions resolved where possible.
+// Values that can only be known after deployment are marked
+// with a placeholder and the paths to them are listed in the response.
+// When tracing is requested, the steps carried out to resolve
+// each field are included in the response.
+func (c *Controller) RenderBlueprintHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	payload := &RenderBlueprintRequestPayload{}
+	responseWritten := httputils.DecodeRequestBody(w, r, payload, c.logger)
+	if responseWritten {
+		return
+	}
+
+	if err := helpersv1.ValidateRequestBody.Struct(payload); err != nil {
+		validationErrors := err.(validator.ValidationErrors)
+		inputvalidation.HTTPValidationError(w, validationErrors)
+		return
+	}
+
+	helpersv1.PopulateBlueprintDocInfoDefaults(&payload.BlueprintDocumentInfo)
+
+	finalConfig, _, responseWritten := helpersv1.PrepareAndValidatePluginConfig(
+		r,
+		w,
+		payload.Config,
+		/* validate */ true,
+		c.pluginConfigPreparer,
+		c.logger,
+	)
+	if responseWritten {
+		return
+	}
+
+	blueprintInfo, responseWritten := resolve.ResolveBlueprintForRequest(
+		r,
+		w,
+		&payload.BlueprintDocumentInfo,
+		c.blueprintResolver,
+		c.logger,
+	)
+	if responseWritten {
+		return
+	}
+
+	finalConfig = internalutils.EnsureBlueprintDirContextVar(finalConfig, payload.BlueprintDocumentInfo.Directory)
+	blueprintParams := c.paramsProvider.CreateFromRequestConfig(finalConfig)
+
+	renderCtx := r.Context()
+	if payload.Trace {
+		renderCtx = subengine.WithSubstitutionTrace(renderCtx, subengine.NewSubstitutionTrace())
+	}
+
+	rendered, err := c.renderBlueprint(
+		renderCtx,
+		blueprintInfo,
+		helpersv1.GetFormat(payload.BlueprintFile),
+		blueprintParams,
+	)
+	if err != nil {
+		c.logger.Debug(
+			"failed to render blueprint",
+			core.ErrorLogField("error", err),
+		)
+		httputils.HTTPError(
+			w,
+			http.StatusInternalServerError,
+			utils.UnexpectedErrorMessage,
+		)
+		return
+	}
+
+	httputils.HTTPJSONResponse(
+		w,
+		http.StatusOK,
+		rendered,
+	)
+}
+
+func (c *Controller) renderBlueprint(
+	ctx context.Context,
+	blueprintInfo *includes.ChildBlueprintInfo,
+	specFormat schema.SpecFormat,
+	params core.BlueprintParams,
+) (*container.RenderedBlueprint, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, renderBlueprintTimeout)
+	defer cancel()
+
+	blueprintContainer, err := c.blueprintLoader.LoadString(
+		ctxWithTimeout,
+		helpersv1.GetBlueprintSource(blueprintInfo),
+		specFormat,
+		params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blueprint container: %w", err)
+	}
+
+	return blueprintContainer.Render(ctxWithTimeout, params)
+}
@@ -0,0 +1,108 @@
+package deploymentsv1
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/types"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+func (s *ControllerTestSuite) Test_render_blueprint() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/render",
+		ctrl.RenderBlueprintHandler,
+	).Methods("POST")
+
+	payload := RenderBlueprintRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+	}
+
+	renderResp := &container.RenderedBlueprint{}
+	statusCode := s.postImportRequest(
+		router,
+		"/deployments/render",
+		payload,
+		renderResp,
+	)
+
+	s.Assert().Equal(http.StatusOK, statusCode)
+	s.Assert().Equal("2025-11-02", renderResp.Version)
+	s.Require().Contains(renderResp.Resources, "exampleResource")
+	s.Assert().Equal(
+		"example-resource",
+		core.StringValue(renderResp.Resources["exampleResource"].Spec.Fields["name"]),
+	)
+	s.Assert().Equal(
+		container.KnownOnDeployPlaceholder,
+		core.StringValue(renderResp.Resources["exampleResource"].Spec.Fields["id"]),
+	)
+	s.Assert().Equal(
+		[]string{"resources.exampleResource.spec.id"},
+		renderResp.ResolveOnDeploy,
+	)
+	s.Assert().Nil(renderResp.Trace)
+}
+
+func (s *ControllerTestSuite) Test_render_blueprint_with_trace() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/render",
+		ctrl.RenderBlueprintHandler,
+	).Methods("POST")
+
+	payload := RenderBlueprintRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+		Config: &types.BlueprintOperationConfig{
+			Providers: map[string]map[string]*core.ScalarValue{},
+		},
+		Trace: true,
+	}
+
+	renderResp := &container.RenderedBlueprint{}
+	statusCode := s.postImportRequest(
+		router,
+		"/deployments/render",
+		payload,
+		renderResp,
+	)
+
+	s.Assert().Equal(http.StatusOK, statusCode)
+	nameTrace := renderResp.Trace["resources.exampleResource.spec.name"]
+	s.Require().Len(nameTrace, 1)
+	s.Assert().Equal("variables.name", nameTrace[0].Expression)
+	s.Assert().Equal("example-resource", core.StringValue(nameTrace[0].Output))
+}
+
+func (s *ControllerTestSuite) Test_render_blueprint_fails_for_missing_config() {
+	ctrl := s.setupReconciliationTest()
+
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/deployments/render",
+		ctrl.RenderBlueprintHandler,
+	).Methods("POST")
+
+	payload := RenderBlueprintRequestPayload{
+		BlueprintDocumentInfo: testBlueprintDocInfo(),
+	}
+
+	errResp := map[string]any{}
+	statusCode := s.postImportRequest(
+		router,
+		"/deployments/render",
+		payload,
+		&errResp,
+	)
+
+	s.Assert().Equal(http.StatusUnprocessableEntity, statusCode)
+}
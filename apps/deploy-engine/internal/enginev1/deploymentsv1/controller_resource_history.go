@@ -0,0 +1,185 @@
+package deploymentsv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/httputils"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// GetResourceStateAtHandler is the handler for the
+// GET /deployments/instances/{id}/resources/{name}/state endpoint
+// that retrieves the state of a resource in a blueprint instance
+// as of a previous point in time along with the changes that have
+// been made to the resource spec since.
+// The point in time is provided with either the "at" query parameter
+// as a unix timestamp in seconds or the "revision" query parameter
+// as the ID of a change set that has been deployed to the instance.
+// The {id} path parameter can be either an instance ID or an instance name.
+func (c *Controller) GetResourceStateAtHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	params := mux.Vars(r)
+	instanceIDOrName := params["id"]
+	resourceName := params["name"]
+
+	point, errMsg := revisionPointFromQuery(r)
+	if errMsg != "" {
+		httputils.HTTPError(w, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	instance, err := resolveInstance(r.Context(), instanceIDOrName, c.instances)
+	if err != nil {
+		c.handleGetInstanceError(w, err, instanceIDOrName)
+		return
+	}
+
+	revisions, err := c.changesetStore.GetAllByInstanceID(
+		r.Context(),
+		instance.InstanceID,
+	)
+	if err != nil {
+		c.logger.Debug(
+			"failed to retrieve revisions for blueprint instance",
+			core.ErrorLogField("error", err),
+			core.StringLogField("instanceId", instance.InstanceID),
+		)
+		httputils.HTTPError(
+			w,
+			http.StatusInternalServerError,
+			utils.UnexpectedErrorMessage,
+		)
+		return
+	}
+
+	current := currentResourceState(instance, resourceName)
+	if current == nil && !resourceInRevisions(resourceName, revisions) {
+		httputils.HTTPError(
+			w,
+			http.StatusNotFound,
+			fmt.Sprintf(
+				"resource %q not found in blueprint instance %q",
+				resourceName,
+				instanceIDOrName,
+			),
+		)
+		return
+	}
+
+	resourceStateAt, err := manage.ResourceStateAt(
+		resourceName,
+		current,
+		revisions,
+		point,
+	)
+	if err != nil {
+		c.handleResourceStateAtError(w, err, instance.InstanceID)
+		return
+	}
+
+	httputils.HTTPJSONResponse(
+		w,
+		http.StatusOK,
+		resourceStateAt,
+	)
+}
+
+func (c *Controller) handleResourceStateAtError(
+	w http.ResponseWriter,
+	err error,
+	instanceID string,
+) {
+	var revisionNotFoundErr *manage.RevisionNotFound
+	if errors.As(err, &revisionNotFoundErr) {
+		httputils.HTTPError(
+			w,
+			http.StatusNotFound,
+			fmt.Sprintf(
+				"revision %q has not been deployed to blueprint instance %q",
+				revisionNotFoundErr.ID,
+				instanceID,
+			),
+		)
+		return
+	}
+
+	var stateUnavailableErr *manage.ResourceStateUnavailable
+	if errors.As(err, &stateUnavailableErr) {
+		httputils.HTTPError(
+			w,
+			http.StatusUnprocessableEntity,
+			stateUnavailableErr.Error(),
+		)
+		return
+	}
+
+	c.logger.Debug(
+		"failed to reconstruct resource state",
+		core.ErrorLogField("error", err),
+		core.StringLogField("instanceId", instanceID),
+	)
+	httputils.HTTPError(
+		w,
+		http.StatusInternalServerError,
+		utils.UnexpectedErrorMessage,
+	)
+}
+
+func revisionPointFromQuery(r *http.Request) (*manage.RevisionPoint, string) {
+	query := r.URL.Query()
+	revision := query.Get("revision")
+	if revision != "" {
+		return &manage.RevisionPoint{Revision: revision}, ""
+	}
+
+	atStr := query.Get("at")
+	if atStr == "" {
+		return nil, "either the \"at\" or \"revision\" query parameter must be provided"
+	}
+
+	at, err := strconv.ParseInt(atStr, 10, 64)
+	if err != nil || at <= 0 {
+		return nil, "the \"at\" query parameter must be a unix timestamp in seconds"
+	}
+
+	return &manage.RevisionPoint{Timestamp: at}, ""
+}
+
+func currentResourceState(
+	instance state.InstanceState,
+	resourceName string,
+) *state.ResourceState {
+	resourceID, hasResource := instance.ResourceIDs[resourceName]
+	if !hasResource {
+		return nil
+	}
+
+	return instance.Resources[resourceID]
+}
+
+// Determines whether a resource that is no longer in a blueprint instance
+// was a part of any of the revisions deployed to the instance.
+func resourceInRevisions(resourceName string, revisions []*manage.Changeset) bool {
+	for _, revision := range revisions {
+		if revision.Changes == nil {
+			continue
+		}
+
+		_, isNew := revision.Changes.NewResources[resourceName]
+		_, hasChanges := revision.Changes.ResourceChanges[resourceName]
+		if isNew || hasChanges {
+			return true
+		}
+	}
+
+	return false
+}
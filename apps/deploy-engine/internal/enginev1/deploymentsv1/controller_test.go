@@ -75,6 +75,7 @@ func (s *ControllerTestSuite) SetupTest() {
 		),
 		Instances:        s.instances,
 		Exports:         stateContainer.Exports(),
+		Summaries:       stateContainer.Summaries(),
 		IDGenerator:     core.NewUUIDGenerator(),
 		EventIDGenerator: utils.NewUUIDv7Generator(),
 		ValidationLoader: blueprintLoader,
@@ -6,6 +6,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
 )
 
 // CreateChangesetRequestPayload represents the payload
@@ -28,6 +29,12 @@ type CreateChangesetRequestPayload struct {
 	Destroy bool `json:"destroy"`
 	// SkipDriftCheck, when true, skips drift detection during change staging.
 	SkipDriftCheck bool `json:"skipDriftCheck"`
+	// Verify, when true, verifies the staged changes with the resource providers
+	// once change staging has completed.
+	// Resources that support dry run deployments will be validated against the
+	// upstream provider to catch errors that can not be determined by diffing
+	// the blueprint against the current state (e.g. permissions or quota limits).
+	Verify bool `json:"verify"`
 	// Config values for the change staging process
 	// that will be used in plugins and passed into the blueprint.
 	Config *types.BlueprintOperationConfig `json:"config"`
@@ -76,6 +83,28 @@ type BlueprintInstanceRequestPayload struct {
 	// This is an escape hatch for recovering from stuck states where the instance
 	// is in an inconsistent state due to a crash or unexpected termination.
 	Force bool `json:"force"`
+	// MaxChanges is the maximum number of resources and child blueprints
+	// that can be created, updated, recreated or removed in the deployment.
+	// When the change set exceeds this limit, the deployment will not proceed
+	// unless OverrideBlastRadius is set.
+	// When set to 0, the number of changes is not limited.
+	MaxChanges int `json:"maxChanges,omitempty"`
+	// MaxDeletes is the maximum number of resources and child blueprints
+	// that can be removed or recreated in the deployment.
+	// When the change set exceeds this limit, the deployment will not proceed
+	// unless OverrideBlastRadius is set.
+	// When set to 0, the number of deletes is not limited.
+	MaxDeletes int `json:"maxDeletes,omitempty"`
+	// OverrideBlastRadius explicitly allows the deployment to proceed
+	// when the change set exceeds MaxChanges or MaxDeletes.
+	OverrideBlastRadius bool `json:"overrideBlastRadius,omitempty"`
+	// TTLSeconds marks the blueprint instance as ephemeral (e.g. a preview environment
+	// for a pull request), once the deployment succeeds, the instance will be set to
+	// expire after the provided number of seconds and will be destroyed by the
+	// expired instance reaper when it is enabled for the deploy engine.
+	// Each successful deployment with a TTL extends the expiry of the instance.
+	// When set to 0, the current expiry of the instance is left unchanged.
+	TTLSeconds int64 `json:"ttlSeconds,omitempty" validate:"gte=0"`
 	// Config values for the deployment process
 	// that will be used in plugins and passed into the blueprint.
 	Config *types.BlueprintOperationConfig `json:"config"`
@@ -107,9 +136,10 @@ type BlueprintInstanceDestroyRequestPayload struct {
 }
 
 type errorMessageEvent struct {
-	Message     string             `json:"message"`
-	Diagnostics []*core.Diagnostic `json:"diagnostics"`
-	Timestamp   int64              `json:"timestamp"`
+	Message     string                 `json:"message"`
+	Diagnostics []*core.Diagnostic     `json:"diagnostics"`
+	ErrorGroups []*bperrors.ErrorGroup `json:"errorGroups,omitempty"`
+	Timestamp   int64                  `json:"timestamp"`
 }
 
 type resourceChangesEventWithTimestamp struct {
@@ -127,8 +157,9 @@ type linkChangesEventWithTimestamp struct {
 }
 
 type changeStagingCompleteEvent struct {
-	Changes   *changes.BlueprintChanges `json:"changes"`
-	Timestamp int64                     `json:"timestamp"`
+	Changes      *changes.BlueprintChanges      `json:"changes"`
+	Verification *container.VerifyChangesResult `json:"verification,omitempty"`
+	Timestamp    int64                          `json:"timestamp"`
 }
 
 // CheckReconciliationRequestPayload represents the payload for checking
@@ -144,6 +175,12 @@ type CheckReconciliationRequestPayload struct {
 	// LinkNames specifies which links to check when Scope is "specific".
 	// Ignored for other scopes.
 	LinkNames []string `json:"linkNames,omitempty"`
+	// IncludeDependents expands the resources to check when Scope is "specific"
+	// to include the resources that depend on the named resources along with
+	// the links connected to them and the resources those links update
+	// through resource data mappings.
+	// Ignored for other scopes.
+	IncludeDependents bool `json:"includeDependents,omitempty"`
 	// IncludeChildren controls whether to recursively check child blueprints.
 	// If nil or not provided, defaults to true.
 	IncludeChildren *bool `json:"includeChildren,omitempty"`
@@ -151,6 +188,10 @@ type CheckReconciliationRequestPayload struct {
 	// Used when Scope is "specific".
 	// Format: "childA" for first level, "childA.childB" for nested.
 	ChildPath string `json:"childPath,omitempty"`
+	// CheckDataSources enables drift checking for data sources.
+	// Data sources are re-fetched and the fields referenced by blueprint exports
+	// are compared against the export values captured in the instance state.
+	CheckDataSources bool `json:"checkDataSources,omitempty"`
 	// Config values for the reconciliation check
 	// that will be used in plugins.
 	Config *types.BlueprintOperationConfig `json:"config" validate:"required"`
@@ -178,13 +219,28 @@ type ResourceReconcileActionPayload struct {
 	// Format: "childA" for first level, "childA.childB" for nested.
 	ChildPath string `json:"childPath,omitempty"`
 	// Action is the reconciliation action to apply.
-	// Valid values: "accept_external", "update_status", "manual_cleanup_required"
+	// Valid values: "accept_external", "update_status", "manual_cleanup_required", "reapply_blueprint"
 	Action string `json:"action" validate:"required"`
 	// ExternalState is required when Action is "accept_external".
 	// This is the state that will be persisted.
 	ExternalState *core.MappingNode `json:"externalState,omitempty"`
 	// NewStatus is the status to set for the resource.
 	NewStatus string `json:"newStatus" validate:"required"`
+	// FieldActions allows accepting external state for a subset of the resource's
+	// spec fields when Action is "accept_external".
+	// Fields that are not accepted keep their persisted values.
+	// When empty, the entire external state is accepted.
+	FieldActions []FieldReconcileActionPayload `json:"fieldActions,omitempty"`
+}
+
+// FieldReconcileActionPayload specifies the action to take for a single field
+// of a resource's spec.
+type FieldReconcileActionPayload struct {
+	// FieldPath is the path to the field in the resource (e.g. "spec.timeout").
+	FieldPath string `json:"fieldPath" validate:"required"`
+	// Action is the field reconciliation action to apply.
+	// Valid values: "accept_external", "keep_persisted"
+	Action string `json:"action" validate:"required"`
 }
 
 // LinkReconcileActionPayload specifies the action to take for a link.
@@ -196,7 +252,7 @@ type LinkReconcileActionPayload struct {
 	// Format: "childA" for first level, "childA.childB" for nested.
 	ChildPath string `json:"childPath,omitempty"`
 	// Action is the reconciliation action to apply.
-	// Valid values: "accept_external", "update_status", "manual_cleanup_required"
+	// Valid values: "accept_external", "update_status", "manual_cleanup_required", "reapply_blueprint"
 	Action string `json:"action" validate:"required"`
 	// NewStatus is the status to set for the link.
 	NewStatus string `json:"newStatus" validate:"required"`
@@ -222,6 +278,98 @@ type IntermediaryReconcileActionPayload struct {
 	NewStatus string `json:"newStatus" validate:"required"`
 }
 
+// ListImportCandidatesRequestPayload represents the payload for listing
+// existing resources in providers that unmatched blueprint resources
+// can be imported from.
+type ListImportCandidatesRequestPayload struct {
+	resolve.BlueprintDocumentInfo
+	// ResourceNames restricts the unmatched resources to list candidates for,
+	// when empty, candidates are listed for all unmatched resources.
+	ResourceNames []string `json:"resourceNames,omitempty"`
+	// MaxCandidates is the maximum number of candidates to list for each resource.
+	MaxCandidates int `json:"maxCandidates,omitempty" validate:"gte=0"`
+	// Config values for listing import candidates
+	// that will be used in plugins.
+	Config *types.BlueprintOperationConfig `json:"config" validate:"required"`
+}
+
+// ImportResourcesRequestPayload represents the payload for importing
+// existing resources into the state of a blueprint instance.
+type ImportResourcesRequestPayload struct {
+	resolve.BlueprintDocumentInfo
+	// Mappings maps blueprint resources to the provider-specific
+	// import IDs of the existing resources to adopt.
+	Mappings []*ImportResourceMappingPayload `json:"mappings" validate:"required,min=1,dive,required"`
+	// Config values for importing resources
+	// that will be used in plugins.
+	Config *types.BlueprintOperationConfig `json:"config" validate:"required"`
+}
+
+// ImportResourceMappingPayload maps a resource in a blueprint to an existing
+// resource in the upstream provider.
+type ImportResourceMappingPayload struct {
+	// ResourceName is the logical name of the resource in the blueprint.
+	ResourceName string `json:"resourceName" validate:"required"`
+	// ImportID is the provider-specific identifier of the existing resource.
+	ImportID string `json:"importId" validate:"required"`
+}
+
+// ExportGraphRequestPayload represents the payload for exporting
+// the dependency graph of a blueprint.
+type ExportGraphRequestPayload struct {
+	resolve.BlueprintDocumentInfo
+	// Format is the format to render the dependency graph in.
+	Format string `json:"format" validate:"required,oneof=dot mermaid json"`
+	// Config values for resolving the dependency graph
+	// that will be used in plugins.
+	Config *types.BlueprintOperationConfig `json:"config" validate:"required"`
+}
+
+// ExportGraphResponse holds a rendered dependency graph for a blueprint.
+type ExportGraphResponse struct {
+	// Format is the format that the dependency graph was rendered in.
+	Format string `json:"format"`
+	// Graph is the rendered dependency graph.
+	Graph string `json:"graph"`
+}
+
+// RenderBlueprintRequestPayload represents the payload for rendering
+// a fully resolved version of a blueprint.
+type RenderBlueprintRequestPayload struct {
+	resolve.BlueprintDocumentInfo
+	// Config values for resolving the blueprint
+	// that will be used in plugins.
+	Config *types.BlueprintOperationConfig `json:"config" validate:"required"`
+	// Trace determines whether to record the reference lookups and function calls
+	// carried out to resolve each field and include them in the rendered blueprint.
+	Trace bool `json:"trace"`
+}
+
+// EvaluateExpressionRequestPayload represents the payload for evaluating
+// a substitution expression against a blueprint.
+type EvaluateExpressionRequestPayload struct {
+	resolve.BlueprintDocumentInfo
+	// Expression is the substitution to evaluate without the surrounding
+	// "${" and "}" (e.g. "resources.ordersTable.spec.arn").
+	Expression string `json:"expression" validate:"required"`
+	// The ID of an existing blueprint instance to resolve computed
+	// fields of resources from.
+	// If this is not provided and an instance name is not provided,
+	// computed fields of resources will be marked as known on deploy.
+	// This should be left empty if the `instanceName` field is provided.
+	InstanceID string `json:"instanceId"`
+	// The user-defined name of an existing blueprint instance to resolve
+	// computed fields of resources from.
+	// This should be left empty if the `instanceId` field is provided.
+	InstanceName string `json:"instanceName"`
+	// Config values for resolving the expression
+	// that will be used in plugins.
+	Config *types.BlueprintOperationConfig `json:"config" validate:"required"`
+	// Trace determines whether to record the reference lookups and function calls
+	// carried out to evaluate the expression and include them in the response.
+	Trace bool `json:"trace"`
+}
+
 // DriftBlockedResponse is returned when an operation is blocked due to drift detection.
 type DriftBlockedResponse struct {
 	// Message explains why the operation was blocked.
@@ -2,6 +2,8 @@ package deploymentsv1
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
 
 	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/typesv1"
@@ -50,6 +52,7 @@ func handleDeployErrorForResponse(
 	validationErrors := &typesv1.ValidationDiagnosticErrors{
 		Message:               "failed to load the blueprint document specified in the request",
 		ValidationDiagnostics: diagnostics,
+		ValidationErrorGroups: utils.ErrorGroupsFromBlueprintValidationError(err),
 	}
 	httputils.HTTPJSONResponse(
 		w,
@@ -152,3 +155,24 @@ resources:
     spec:
       value: "stubValue"
 `
+
+func (c *Controller) createRunMetadata(
+	r *http.Request,
+	blueprintSource string,
+) *state.DeploymentRunMetadata {
+	metadata := &state.DeploymentRunMetadata{
+		EngineVersion: c.engineVersion,
+	}
+
+	if r != nil {
+		metadata.InitiatedBy = r.Header.Get(initiatedByHeaderName)
+		metadata.ClientVersion = r.Header.Get(clientVersionHeaderName)
+	}
+
+	if blueprintSource != "" {
+		sourceHash := sha256.Sum256([]byte(blueprintSource))
+		metadata.BlueprintSourceHash = hex.EncodeToString(sourceHash[:])
+	}
+
+	return metadata
+}
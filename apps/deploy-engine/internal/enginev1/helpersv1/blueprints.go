@@ -9,6 +9,7 @@ import (
 
 var (
 	yamlFilePattern          = regexp.MustCompile(`\.ya?ml$`)
+	hclFilePattern           = regexp.MustCompile(`\.bp\.hcl$`)
 	blueprintLangFilePattern = regexp.MustCompile(`\.(bp|blueprint)$`)
 )
 
@@ -20,6 +21,10 @@ func GetFormat(
 		return schema.YAMLSpecFormat
 	}
 
+	if hclFilePattern.MatchString(blueprintFileName) {
+		return schema.HCLSpecFormat
+	}
+
 	if blueprintLangFilePattern.MatchString(blueprintFileName) {
 		return schema.BlueprintLangSpecFormat
 	}
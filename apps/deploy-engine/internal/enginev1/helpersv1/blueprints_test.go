@@ -33,6 +33,11 @@ func Test_GetFormat(t *testing.T) {
 			blueprintFileName: "project.blueprint",
 			expected:          schema.BlueprintLangSpecFormat,
 		},
+		{
+			name:              "bp.hcl extension",
+			blueprintFileName: "project.bp.hcl",
+			expected:          schema.HCLSpecFormat,
+		},
 		{
 			name:              "json extension falls back to JWCC",
 			blueprintFileName: "project.blueprint.json",
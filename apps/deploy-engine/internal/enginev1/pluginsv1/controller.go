@@ -0,0 +1,151 @@
+package pluginsv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/typesv1"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/httputils"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/params"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
+)
+
+const (
+	// resourceTypeDocsTimeout is the timeout for retrieving
+	// the documentation for a resource type from the plugin
+	// that provides it.
+	resourceTypeDocsTimeout = 30 * time.Second
+)
+
+// Controller handles HTTP requests
+// for retrieving information about the plugins
+// loaded by the deploy engine.
+type Controller struct {
+	resourceRegistry resourcehelpers.Registry
+	paramsProvider   params.Provider
+	logger           core.Logger
+}
+
+// NewController creates a new plugins Controller
+// instance with the provided dependencies.
+func NewController(
+	deps *typesv1.Dependencies,
+) *Controller {
+	return &Controller{
+		resourceRegistry: deps.ResourceRegistry,
+		paramsProvider:   deps.ParamsProvider,
+		logger:           deps.Logger,
+	}
+}
+
+// GetResourceTypeDocsHandler is the handler for the
+// GET /plugins/resource-types/docs endpoint that retrieves
+// the description and examples for the resource type provided
+// in the `type` query parameter from the provider or transformer
+// plugin that the resource type belongs to.
+func (c *Controller) GetResourceTypeDocsHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	resourceType := r.URL.Query().Get("type")
+	if resourceType == "" {
+		httputils.HTTPError(
+			w,
+			http.StatusBadRequest,
+			"the type query parameter must be provided",
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), resourceTypeDocsTimeout)
+	defer cancel()
+
+	hasResourceType, err := c.resourceRegistry.HasResourceType(ctx, resourceType)
+	if err != nil {
+		c.handleResourceTypeDocsError(w, err)
+		return
+	}
+
+	if !hasResourceType {
+		httputils.HTTPError(
+			w,
+			http.StatusNotFound,
+			fmt.Sprintf("resource type %q not found in the loaded plugins", resourceType),
+		)
+		return
+	}
+
+	docs, err := c.getResourceTypeDocs(ctx, resourceType)
+	if err != nil {
+		c.handleResourceTypeDocsError(w, err)
+		return
+	}
+
+	httputils.HTTPJSONResponse(
+		w,
+		http.StatusOK,
+		docs,
+	)
+}
+
+func (c *Controller) getResourceTypeDocs(
+	ctx context.Context,
+	resourceType string,
+) (*ResourceTypeDocsResponse, error) {
+	providerCtx := provider.NewProviderContextFromParams(
+		provider.ExtractProviderFromItemType(resourceType),
+		c.paramsProvider.GetDefaultParams(),
+	)
+
+	description, err := c.resourceRegistry.GetTypeDescription(
+		ctx,
+		resourceType,
+		&provider.ResourceGetTypeDescriptionInput{
+			ProviderContext: providerCtx,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	examples, err := c.resourceRegistry.GetExamples(
+		ctx,
+		resourceType,
+		&provider.ResourceGetExamplesInput{
+			ProviderContext: providerCtx,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceTypeDocsResponse{
+		Type:                 resourceType,
+		PlainTextSummary:     description.PlainTextSummary,
+		MarkdownSummary:      description.MarkdownSummary,
+		PlainTextDescription: description.PlainTextDescription,
+		MarkdownDescription:  description.MarkdownDescription,
+		PlainTextExamples:    examples.PlainTextExamples,
+		MarkdownExamples:     examples.MarkdownExamples,
+	}, nil
+}
+
+func (c *Controller) handleResourceTypeDocsError(
+	w http.ResponseWriter,
+	err error,
+) {
+	c.logger.Debug(
+		"failed to retrieve resource type documentation",
+		core.ErrorLogField("error", err),
+	)
+	httputils.HTTPError(
+		w,
+		http.StatusInternalServerError,
+		utils.UnexpectedErrorMessage,
+	)
+}
@@ -0,0 +1,151 @@
+package pluginsv1
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/typesv1"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/params"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/testutils"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/utils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testResourceType        = "aws/lambda/function"
+	testFailingResourceType = "aws/dynamodb/table"
+)
+
+type ControllerTestSuite struct {
+	suite.Suite
+	ctrl *Controller
+}
+
+func (s *ControllerTestSuite) SetupTest() {
+	dependencies := &typesv1.Dependencies{
+		ResourceRegistry: testutils.NewMockResourceRegistry(
+			map[string]*testutils.MockResourceTypeDocs{
+				testResourceType: {
+					Description: &provider.ResourceGetTypeDescriptionOutput{
+						PlainTextSummary:     "A Lambda function.",
+						MarkdownSummary:      "A **Lambda** function.",
+						PlainTextDescription: "A Lambda function that runs code in response to events.",
+						MarkdownDescription:  "A **Lambda** function that runs code in response to events.",
+					},
+					Examples: &provider.ResourceGetExamplesOutput{
+						PlainTextExamples: []string{
+							"Basic function\n\nresources:\n  handler:\n    type: aws/lambda/function",
+						},
+						MarkdownExamples: []string{
+							"**Basic function**\n\n```yaml\nresources:\n  handler:\n    type: aws/lambda/function\n```",
+						},
+					},
+				},
+			},
+			map[string]error{
+				testFailingResourceType: errors.New("plugin process exited unexpectedly"),
+			},
+		),
+		ParamsProvider: params.NewDefaultProvider(
+			map[string]*core.ScalarValue{},
+		),
+		Logger: core.NewNopLogger(),
+	}
+	s.ctrl = NewController(dependencies)
+}
+
+func (s *ControllerTestSuite) Test_get_resource_type_docs() {
+	result, respData := s.getResourceTypeDocs(testResourceType)
+	s.Assert().Equal(http.StatusOK, result.StatusCode)
+
+	docs := &ResourceTypeDocsResponse{}
+	err := json.Unmarshal(respData, docs)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(
+		&ResourceTypeDocsResponse{
+			Type:                 testResourceType,
+			PlainTextSummary:     "A Lambda function.",
+			MarkdownSummary:      "A **Lambda** function.",
+			PlainTextDescription: "A Lambda function that runs code in response to events.",
+			MarkdownDescription:  "A **Lambda** function that runs code in response to events.",
+			PlainTextExamples: []string{
+				"Basic function\n\nresources:\n  handler:\n    type: aws/lambda/function",
+			},
+			MarkdownExamples: []string{
+				"**Basic function**\n\n```yaml\nresources:\n  handler:\n    type: aws/lambda/function\n```",
+			},
+		},
+		docs,
+	)
+}
+
+func (s *ControllerTestSuite) Test_get_resource_type_docs_fails_for_missing_type() {
+	result, respData := s.getResourceTypeDocs("")
+	s.Assert().Equal(http.StatusBadRequest, result.StatusCode)
+
+	respMap := map[string]string{}
+	err := json.Unmarshal(respData, &respMap)
+	s.Require().NoError(err)
+	s.Assert().Equal("the type query parameter must be provided", respMap["message"])
+}
+
+func (s *ControllerTestSuite) Test_get_resource_type_docs_fails_for_unknown_resource_type() {
+	result, respData := s.getResourceTypeDocs("aws/sqs/queue")
+	s.Assert().Equal(http.StatusNotFound, result.StatusCode)
+
+	respMap := map[string]string{}
+	err := json.Unmarshal(respData, &respMap)
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		"resource type \"aws/sqs/queue\" not found in the loaded plugins",
+		respMap["message"],
+	)
+}
+
+func (s *ControllerTestSuite) Test_get_resource_type_docs_fails_for_plugin_error() {
+	result, respData := s.getResourceTypeDocs(testFailingResourceType)
+	s.Assert().Equal(http.StatusInternalServerError, result.StatusCode)
+
+	respMap := map[string]string{}
+	err := json.Unmarshal(respData, &respMap)
+	s.Require().NoError(err)
+	s.Assert().Equal(utils.UnexpectedErrorMessage, respMap["message"])
+}
+
+func (s *ControllerTestSuite) getResourceTypeDocs(
+	resourceType string,
+) (*http.Response, []byte) {
+	router := mux.NewRouter()
+	router.HandleFunc(
+		"/plugins/resource-types/docs",
+		s.ctrl.GetResourceTypeDocsHandler,
+	).Methods("GET")
+
+	path := "/plugins/resource-types/docs"
+	if resourceType != "" {
+		path += "?type=" + url.QueryEscape(resourceType)
+	}
+	req := httptest.NewRequest("GET", path, nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	result := w.Result()
+	defer result.Body.Close()
+	respData, err := io.ReadAll(result.Body)
+	s.Require().NoError(err)
+
+	return result, respData
+}
+
+func TestControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ControllerTestSuite))
+}
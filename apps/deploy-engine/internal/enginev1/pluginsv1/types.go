@@ -0,0 +1,25 @@
+package pluginsv1
+
+// ResourceTypeDocsResponse holds the documentation for a resource type
+// provided by one of the plugins loaded by the deploy engine.
+type ResourceTypeDocsResponse struct {
+	// Type is the resource type that the documentation is for.
+	Type string `json:"type"`
+	// PlainTextSummary is a short summary of the resource type in plain text.
+	PlainTextSummary string `json:"plainTextSummary"`
+	// MarkdownSummary is a short summary of the resource type
+	// that can be formatted in markdown.
+	MarkdownSummary string `json:"markdownSummary"`
+	// PlainTextDescription is the full description of the resource type
+	// in plain text.
+	PlainTextDescription string `json:"plainTextDescription"`
+	// MarkdownDescription is the full description of the resource type
+	// that can be formatted in markdown.
+	MarkdownDescription string `json:"markdownDescription"`
+	// PlainTextExamples holds the examples of how to use the resource type
+	// in plain text.
+	PlainTextExamples []string `json:"plainTextExamples"`
+	// MarkdownExamples holds the examples of how to use the resource type
+	// that can be formatted in markdown.
+	MarkdownExamples []string `json:"markdownExamples"`
+}
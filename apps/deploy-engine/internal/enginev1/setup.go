@@ -15,6 +15,7 @@ import (
 	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/deploymentsv1"
 	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/eventsv1"
 	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/helpersv1"
+	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/pluginsv1"
 	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/typesv1"
 	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/enginev1/validationv1"
 	"github.com/newstack-cloud/bluelink/apps/deploy-engine/internal/httputils"
@@ -31,10 +32,15 @@ import (
 	resolverhttps "github.com/newstack-cloud/bluelink/libs/blueprint-resolvers/https"
 	resolverrouter "github.com/newstack-cloud/bluelink/libs/blueprint-resolvers/router"
 	"github.com/newstack-cloud/bluelink/libs/blueprint-resolvers/s3"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changelog"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
 	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/policy"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/providerhelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/validation"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/plugin"
 	"github.com/spf13/afero"
 )
@@ -135,6 +141,7 @@ func Setup(
 			container.WithLoaderTransformSpec(false),
 			container.WithLoaderValidateAfterTransform(false),
 			container.WithLoaderValidateRuntimeValues(false),
+			container.WithLoaderComplexityLimits(createComplexityLimits(config)),
 			container.WithLoaderLogger(logger),
 		}
 		return container.NewDefaultLoader(
@@ -167,6 +174,18 @@ func Setup(
 		container.WithLoaderResourceStabilityPollingConfig(
 			createResourceStabilityPollingConfig(config),
 		),
+		container.WithLoaderConcurrencyLimiter(
+			createConcurrencyLimiter(config, logger.Named("init")),
+		),
+		container.WithLoaderPolicyEngine(createPolicyEngine(config)),
+		container.WithLoaderComplexityLimits(createComplexityLimits(config)),
+		container.WithLoaderDeploymentHooks(
+			createDeploymentHooks(
+				config,
+				stateServices.container.Instances(),
+				idGenerator,
+			),
+		),
 		container.WithLoaderLogger(logger),
 	)
 
@@ -176,6 +195,16 @@ func Setup(
 
 	taggingConfigProvider := tagging.NewConfigProvider(config.Version)
 	providerMetadataLookup := pluginmeta.NewLookup(pluginHostService.Manager())
+	// The resource registry is used to retrieve information about resource types
+	// from the loaded plugins outside of the context of a blueprint,
+	// the default params are used as there is no request-specific configuration.
+	resourceRegistry := resourcehelpers.NewRegistry(
+		pluginMaps.Providers,
+		pluginMaps.Transformers,
+		createResourceStabilityPollingConfig(config).PollingInterval,
+		stateServices.container,
+		paramsProvider.GetDefaultParams(),
+	)
 
 	dependencies := &typesv1.Dependencies{
 		EventStore:                 stateServices.events,
@@ -185,6 +214,7 @@ func Setup(
 		CleanupOperationsStore:     stateServices.cleanupOperations,
 		Instances:                  stateServices.container.Instances(),
 		Exports:                    stateServices.container.Exports(),
+		Summaries:                  stateServices.container.Summaries(),
 		IDGenerator:                idGenerator,
 		EventIDGenerator:           utils.NewUUIDv7Generator(),
 		ValidationLoader:           validateLoader,
@@ -195,8 +225,10 @@ func Setup(
 		PluginConfigPreparer:       pluginConfigPreparer,
 		TaggingConfigProvider:      taggingConfigProvider,
 		ProviderMetadataLookup:     providerMetadataLookup,
+		ResourceRegistry:           resourceRegistry,
 		Clock:                      clock,
 		Logger:                     logger,
+		EngineVersion:              config.Version,
 	}
 
 	healthHandler := setupHealthHandler(
@@ -216,6 +248,7 @@ func Setup(
 		dependencies,
 		config,
 	)
+	startExpiredInstanceReaper(deploymentCtrl, config)
 
 	setupEventManagementHandlers(
 		router,
@@ -223,6 +256,11 @@ func Setup(
 		config,
 	)
 
+	setupPluginHandlers(
+		router,
+		dependencies,
+	)
+
 	authMiddleware, err := setupAuth(
 		&config.Auth,
 		clock,
@@ -241,6 +279,23 @@ func Setup(
 	), nil
 }
 
+// Starts the background process that destroys expired ephemeral blueprint
+// instances, the process is stopped when in-flight deployments are drained
+// on shutdown.
+func startExpiredInstanceReaper(
+	deploymentCtrl *deploymentsv1.Controller,
+	config *core.Config,
+) {
+	interval := time.Duration(
+		config.Maintenance.ExpiredInstanceReaperInterval,
+	) * time.Second
+	if interval <= 0 {
+		return
+	}
+
+	go deploymentCtrl.RunExpiredInstanceReaper(context.Background(), interval)
+}
+
 func drainInFlightDeploymentsFunc(
 	deploymentCtrl *deploymentsv1.Controller,
 	drainTimeout time.Duration,
@@ -352,6 +407,11 @@ func setupDeploymentHandlers(
 		deploymentCtrl.GetChangesetHandler,
 	).Methods("GET")
 
+	router.HandleFunc(
+		"/deployments/changes/{id}/plan",
+		deploymentCtrl.GetChangesetPlanHandler,
+	).Methods("GET")
+
 	router.HandleFunc(
 		"/deployments/changes/cleanup",
 		deploymentCtrl.CleanupChangesetsHandler,
@@ -382,6 +442,11 @@ func setupDeploymentHandlers(
 		deploymentCtrl.CreateBlueprintInstanceHandler,
 	).Methods("POST")
 
+	router.HandleFunc(
+		"/deployments/instance-summaries",
+		deploymentCtrl.ListBlueprintInstanceSummariesHandler,
+	).Methods("GET")
+
 	router.HandleFunc(
 		"/deployments/instances/{id}/stream",
 		deploymentCtrl.StreamDeploymentEventsHandler,
@@ -402,6 +467,11 @@ func setupDeploymentHandlers(
 		deploymentCtrl.GetBlueprintInstanceExportsHandler,
 	).Methods("GET")
 
+	router.HandleFunc(
+		"/deployments/instances/{id}/resources/{name}/state",
+		deploymentCtrl.GetResourceStateAtHandler,
+	).Methods("GET")
+
 	router.HandleFunc(
 		"/deployments/instances/{id}/destroy",
 		deploymentCtrl.DestroyBlueprintInstanceHandler,
@@ -417,6 +487,31 @@ func setupDeploymentHandlers(
 		deploymentCtrl.ApplyReconciliationHandler,
 	).Methods("POST")
 
+	router.HandleFunc(
+		"/deployments/instances/{id}/import/candidates",
+		deploymentCtrl.ListImportCandidatesHandler,
+	).Methods("POST")
+
+	router.HandleFunc(
+		"/deployments/instances/{id}/import",
+		deploymentCtrl.ImportResourcesHandler,
+	).Methods("POST")
+
+	router.HandleFunc(
+		"/deployments/graph",
+		deploymentCtrl.ExportGraphHandler,
+	).Methods("POST")
+
+	router.HandleFunc(
+		"/deployments/render",
+		deploymentCtrl.RenderBlueprintHandler,
+	).Methods("POST")
+
+	router.HandleFunc(
+		"/deployments/evaluate",
+		deploymentCtrl.EvaluateExpressionHandler,
+	).Methods("POST")
+
 	return deploymentCtrl
 }
 
@@ -445,6 +540,20 @@ func setupEventManagementHandlers(
 	).Methods("GET")
 }
 
+func setupPluginHandlers(
+	router *mux.Router,
+	dependencies *typesv1.Dependencies,
+) {
+	pluginsCtrl := pluginsv1.NewController(
+		dependencies,
+	)
+
+	router.HandleFunc(
+		"/plugins/resource-types/docs",
+		pluginsCtrl.GetResourceTypeDocsHandler,
+	).Methods("GET")
+}
+
 func createResourceStabilityPollingConfig(
 	config *core.Config,
 ) *container.ResourceStabilityPollingConfig {
@@ -480,6 +589,83 @@ func parseDefaultRetryPolicy(
 	return retryPolicy
 }
 
+func createConcurrencyLimiter(
+	config *core.Config,
+	logger bpcore.Logger,
+) *container.ConcurrencyLimiter {
+	return container.NewConcurrencyLimiter(&container.ConcurrencyConfig{
+		MaxInFlightResources: config.Blueprints.MaxConcurrentResources,
+		ProviderLimits: parseProviderConcurrencyLimits(
+			config.Blueprints.ProviderConcurrencyLimits,
+			logger,
+		),
+	})
+}
+
+func createComplexityLimits(config *core.Config) *validation.ComplexityLimits {
+	return &validation.ComplexityLimits{
+		MaxResources:          config.Blueprints.MaxResources,
+		MaxIncludeDepth:       config.Blueprints.MaxIncludeDepth,
+		MaxSubstitutionDepth:  config.Blueprints.MaxSubstitutionDepth,
+		MaxReferenceGraphSize: config.Blueprints.MaxReferenceGraphSize,
+	}
+}
+
+func createPolicyEngine(config *core.Config) policy.Engine {
+	if config.Blueprints.PolicyOPAEndpoint == "" {
+		return nil
+	}
+
+	return policy.NewOPAEngine(
+		config.Blueprints.PolicyOPAEndpoint,
+		config.Blueprints.PolicyOPAPath,
+	)
+}
+
+func createDeploymentHooks(
+	config *core.Config,
+	instances state.InstancesContainer,
+	idGenerator bpcore.IDGenerator,
+) *container.DeploymentHooks {
+	hooks := container.NewDeploymentHooks()
+
+	// Deployment runs are always recorded so the history of operations
+	// for a blueprint instance can be used as an audit trail.
+	hooks.AfterRun(container.NewDeploymentRunRecorder(instances, idGenerator))
+
+	if config.Blueprints.ChangelogDir != "" {
+		hooks.AfterRun(changelog.NewDirectoryWriter(config.Blueprints.ChangelogDir))
+	}
+
+	if config.Blueprints.ChangelogWebhookURL != "" {
+		hooks.AfterRun(changelog.NewWebhookPublisher(config.Blueprints.ChangelogWebhookURL))
+	}
+
+	return hooks
+}
+
+func parseProviderConcurrencyLimits(
+	serialised string,
+	logger bpcore.Logger,
+) map[string]int {
+	if strings.TrimSpace(serialised) == "" {
+		return nil
+	}
+
+	limits := map[string]int{}
+	err := json.Unmarshal([]byte(serialised), &limits)
+	if err != nil {
+		logger.Warn(
+			"failed to parse provider concurrency limits from config, "+
+				"no provider limits will be applied",
+			bpcore.ErrorLogField("error", err),
+		)
+		return nil
+	}
+
+	return limits
+}
+
 func getPluginExecutorEnvVars(
 	pluginServiceListener net.Listener,
 ) map[string]string {
@@ -9,6 +9,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/includes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
 	commoncore "github.com/newstack-cloud/bluelink/libs/common/core"
 )
@@ -24,6 +25,7 @@ type Dependencies struct {
 	CleanupOperationsStore     manage.CleanupOperations
 	Instances                  state.InstancesContainer
 	Exports                    state.ExportsContainer
+	Summaries                  state.SummariesContainer
 	IDGenerator                core.IDGenerator
 	EventIDGenerator           core.IDGenerator
 	ValidationLoader           container.Loader
@@ -34,8 +36,12 @@ type Dependencies struct {
 	PluginConfigPreparer       pluginconfig.Preparer
 	TaggingConfigProvider      tagging.ConfigProvider
 	ProviderMetadataLookup     pluginmeta.Lookup
+	ResourceRegistry           resourcehelpers.Registry
 	Clock                      commoncore.Clock
 	Logger                     core.Logger
+	// EngineVersion is the version of the deploy engine,
+	// this is recorded in the metadata of deployment runs.
+	EngineVersion string
 }
 
 // ValidationLoaderFactory builds a one-off validation loader with the
@@ -1,10 +1,17 @@
 package typesv1
 
-import "github.com/newstack-cloud/bluelink/libs/blueprint/core"
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+)
 
 // ValidationDiagnosticErrors is the data type for validation errors
 // that are returned in the response of multiple endpoints.
 type ValidationDiagnosticErrors struct {
 	Message               string             `json:"message"`
 	ValidationDiagnostics []*core.Diagnostic `json:"validationDiagnostics"`
+	// ValidationErrorGroups holds the errors that the validation diagnostics
+	// were derived from grouped by element and reason code,
+	// this is only populated for errors with multiple child errors.
+	ValidationErrorGroups []*errors.ErrorGroup `json:"validationErrorGroups,omitempty"`
 }
@@ -30,4 +30,9 @@ type Config interface {
 	// for waiting for a plugin to respond to a call initiated by another
 	// or the same plugin through the plugin service.
 	GetPluginToPluginCallTimeoutMS() int
+	// GetCapabilityManifestPath returns the path to the capability
+	// manifest that scopes the host services that each plugin can invoke
+	// through the plugin service.
+	// An empty string is returned when plugin service calls are not scoped.
+	GetCapabilityManifestPath() string
 }
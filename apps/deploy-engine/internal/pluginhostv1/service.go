@@ -183,17 +183,35 @@ func (s *serviceImpl) Initialise() error {
 		nil,
 	)
 
-	pluginService := pluginservicev1.NewServiceServer(
-		s.manager,
-		functionRegistry,
-		/* resourceService */ resourceRegistry,
-		hostID,
+	serviceServerOpts := []pluginservicev1.ServiceServerOption{
 		pluginservicev1.WithPluginToPluginCallTimeout(
 			s.config.GetPluginToPluginCallTimeoutMS(),
 		),
 		pluginservicev1.WithResourceStabilisationTimeout(
 			s.config.GetResourceStabilisationPollingTimeoutMS(),
 		),
+	}
+	capabilityManifestPath := s.config.GetCapabilityManifestPath()
+	if capabilityManifestPath != "" {
+		capabilityManifest, err := pluginservicev1.LoadCapabilityManifest(
+			s.fs,
+			capabilityManifestPath,
+		)
+		if err != nil {
+			return err
+		}
+		serviceServerOpts = append(
+			serviceServerOpts,
+			pluginservicev1.WithCapabilityManifest(capabilityManifest),
+		)
+	}
+
+	pluginService := pluginservicev1.NewServiceServer(
+		s.manager,
+		functionRegistry,
+		/* resourceService */ resourceRegistry,
+		hostID,
+		serviceServerOpts...,
 	)
 
 	pluginServiceOpts := []pluginservicev1.ServerOption{}
@@ -11,6 +11,7 @@ func CopyDependencies(deps *typesv1.Dependencies) *typesv1.Dependencies {
 		CleanupOperationsStore:     deps.CleanupOperationsStore,
 		Instances:                  deps.Instances,
 		Exports:                    deps.Exports,
+		Summaries:                  deps.Summaries,
 		IDGenerator:                deps.IDGenerator,
 		EventIDGenerator:           deps.EventIDGenerator,
 		ValidationLoader:           deps.ValidationLoader,
@@ -20,6 +21,7 @@ func CopyDependencies(deps *typesv1.Dependencies) *typesv1.Dependencies {
 		PluginConfigPreparer:       deps.PluginConfigPreparer,
 		TaggingConfigProvider:      deps.TaggingConfigProvider,
 		ProviderMetadataLookup:     deps.ProviderMetadataLookup,
+		ResourceRegistry:           deps.ResourceRegistry,
 		Clock:                      deps.Clock,
 		Logger:                     deps.Logger,
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -22,6 +23,7 @@ type MemoryStateContainer struct {
 	childrenContainer  *memoryChildrenContainer
 	metadataContainer  *memoryMetadataContainer
 	exportsContainer   *memoryExportsContainer
+	summariesContainer *memorySummariesContainer
 }
 
 func NewMemoryStateContainer() state.Container {
@@ -40,6 +42,7 @@ func NewMemoryStateContainer() state.Container {
 			instanceNameIDLookup: instanceNameIDLookup,
 			resources:            resources,
 			links:                links,
+			runs:                 map[string][]*state.DeploymentRun{},
 			mu:                   mu,
 		},
 		resourcesContainer: &memoryResourcesContainer{
@@ -67,6 +70,10 @@ func NewMemoryStateContainer() state.Container {
 			instances: instances,
 			mu:        mu,
 		},
+		summariesContainer: &memorySummariesContainer{
+			instances: instances,
+			mu:        mu,
+		},
 	}
 }
 
@@ -90,6 +97,10 @@ func (c *MemoryStateContainer) Exports() state.ExportsContainer {
 	return c.exportsContainer
 }
 
+func (c *MemoryStateContainer) Summaries() state.SummariesContainer {
+	return c.summariesContainer
+}
+
 func (c *MemoryStateContainer) Children() state.ChildrenContainer {
 	return c.childrenContainer
 }
@@ -99,6 +110,7 @@ type memoryInstancesContainer struct {
 	instanceNameIDLookup map[string]string
 	resources            map[string]*state.ResourceState
 	links                map[string]*state.LinkState
+	runs                 map[string][]*state.DeploymentRun
 	mu                   *sync.RWMutex
 }
 
@@ -201,6 +213,9 @@ func (c *memoryInstancesContainer) UpdateStatus(
 		if statusInfo.Durations != nil {
 			instance.Durations = statusInfo.Durations
 		}
+		if statusInfo.ExpiresAt != nil {
+			instance.ExpiresAt = *statusInfo.ExpiresAt
+		}
 
 		return nil
 	}
@@ -261,6 +276,31 @@ func (c *memoryInstancesContainer) Remove(ctx context.Context, instanceID string
 	return *instance, nil
 }
 
+func (c *memoryInstancesContainer) SaveRun(ctx context.Context, run state.DeploymentRun) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, existing := range c.runs[run.InstanceID] {
+		if existing.ID == run.ID {
+			return nil
+		}
+	}
+
+	c.runs[run.InstanceID] = append(c.runs[run.InstanceID], &run)
+	return nil
+}
+
+func (c *memoryInstancesContainer) ListRuns(
+	ctx context.Context,
+	instanceID string,
+	params state.ListRunsParams,
+) (state.ListRunsResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return state.FilterAndPaginateRuns(c.runs[instanceID], params), nil
+}
+
 func (c *memoryInstancesContainer) List(
 	ctx context.Context,
 	params state.ListInstancesParams,
@@ -278,6 +318,7 @@ func (c *memoryInstancesContainer) List(
 				InstanceName:          inst.InstanceName,
 				Status:                inst.Status,
 				LastDeployedTimestamp: int64(inst.LastDeployedTimestamp),
+				ExpiresAt:             int64(inst.ExpiresAt),
 			})
 		}
 	}
@@ -1158,9 +1199,10 @@ func copyExport(
 	}
 
 	return &state.ExportState{
-		Value: exportState.Value,
-		Type:  exportState.Type,
-		Field: exportState.Field,
+		Value:     exportState.Value,
+		Type:      exportState.Type,
+		Field:     exportState.Field,
+		Sensitive: exportState.Sensitive,
 	}
 }
 
@@ -1182,3 +1224,60 @@ func copyDependencyInfo(
 		DependsOnChildren:  dependsOnChildren,
 	}
 }
+
+type memorySummariesContainer struct {
+	instances map[string]*state.InstanceState
+	mu        *sync.RWMutex
+}
+
+func (c *memorySummariesContainer) Get(
+	ctx context.Context,
+	instanceID string,
+) (state.InstanceStatusSummary, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	instance, ok := c.instances[instanceID]
+	if !ok || instance == nil {
+		return state.InstanceStatusSummary{}, state.InstanceNotFoundError(instanceID)
+	}
+
+	return state.SummariseInstance(instance), nil
+}
+
+func (c *memorySummariesContainer) List(
+	ctx context.Context,
+	params state.ListInstancesParams,
+) (state.ListInstanceStatusSummariesResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	summaries := []state.InstanceStatusSummary{}
+	searchLower := strings.ToLower(params.Search)
+	for _, inst := range c.instances {
+		if params.Search == "" || strings.Contains(strings.ToLower(inst.InstanceName), searchLower) {
+			summaries = append(summaries, state.SummariseInstance(inst))
+		}
+	}
+
+	slices.SortFunc(summaries, func(a, b state.InstanceStatusSummary) int {
+		return strings.Compare(a.InstanceName, b.InstanceName)
+	})
+
+	totalCount := len(summaries)
+	if params.Offset > 0 {
+		if params.Offset >= len(summaries) {
+			summaries = []state.InstanceStatusSummary{}
+		} else {
+			summaries = summaries[params.Offset:]
+		}
+	}
+	if params.Limit > 0 && len(summaries) > params.Limit {
+		summaries = summaries[:params.Limit]
+	}
+
+	return state.ListInstanceStatusSummariesResult{
+		Summaries:  summaries,
+		TotalCount: totalCount,
+	}, nil
+}
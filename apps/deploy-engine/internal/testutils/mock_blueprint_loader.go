@@ -9,10 +9,12 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/speccore"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
 	commoncore "github.com/newstack-cloud/bluelink/libs/common/core"
 )
 
@@ -568,6 +570,102 @@ func (m *MockBlueprintContainer) ResourceTemplates() map[string]string {
 	return map[string]string{}
 }
 
+func (m *MockBlueprintContainer) Hooks() *container.DeploymentHooks {
+	return container.NewDeploymentHooks()
+}
+
+func (m *MockBlueprintContainer) ExportGraph(
+	ctx context.Context,
+	format container.GraphFormat,
+	paramOverrides core.BlueprintParams,
+) ([]byte, error) {
+	return container.RenderDependencyGraph(
+		&container.DependencyGraph{
+			Nodes: []*container.DependencyGraphNode{
+				{
+					ID:           "resources.exampleResource",
+					Name:         "exampleResource",
+					Type:         container.DeploymentNodeTypeResource,
+					ResourceType: "example/resource",
+				},
+			},
+			Edges: []*container.DependencyGraphEdge{},
+		},
+		format,
+	)
+}
+
+func (m *MockBlueprintContainer) Render(
+	ctx context.Context,
+	paramOverrides core.BlueprintParams,
+) (*container.RenderedBlueprint, error) {
+	rendered := &container.RenderedBlueprint{
+		Version: "2025-11-02",
+		Resources: map[string]*provider.ResolvedResource{
+			"exampleResource": {
+				Type: &schema.ResourceTypeWrapper{
+					Value: "example/resource",
+				},
+				Spec: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"name": core.MappingNodeFromString("example-resource"),
+						"id":   core.MappingNodeFromString(container.KnownOnDeployPlaceholder),
+					},
+				},
+			},
+		},
+		ResolveOnDeploy: []string{"resources.exampleResource.spec.id"},
+	}
+
+	if subengine.SubstitutionTraceFromContext(ctx) != nil {
+		rendered.Trace = map[string][]*subengine.SubstitutionTraceEntry{
+			"resources.exampleResource.spec.name": {
+				{
+					Type:       subengine.SubstitutionTraceEntryTypeReference,
+					Expression: "variables.name",
+					Output:     core.MappingNodeFromString("example-resource"),
+				},
+			},
+		}
+	}
+
+	return rendered, nil
+}
+
+func (m *MockBlueprintContainer) EvaluateExpression(
+	ctx context.Context,
+	input *container.EvaluateExpressionInput,
+	paramOverrides core.BlueprintParams,
+) (*container.EvaluatedExpression, error) {
+	if input.InstanceName == "missing-instance" {
+		return nil, state.InstanceNotFoundError(input.InstanceName)
+	}
+
+	if input.Expression == "resources.exampleResource.spec.id" {
+		return &container.EvaluatedExpression{
+			Value:         core.MappingNodeFromString(container.KnownOnDeployPlaceholder),
+			KnownOnDeploy: true,
+		}, nil
+	}
+
+	evaluated := &container.EvaluatedExpression{
+		Value: core.MappingNodeFromString("example-resource"),
+	}
+	if input.Trace {
+		evaluated.Trace = map[string][]*subengine.SubstitutionTraceEntry{
+			container.EvaluatedExpressionElementName: {
+				{
+					Type:       subengine.SubstitutionTraceEntryTypeReference,
+					Expression: input.Expression,
+					Output:     core.MappingNodeFromString("example-resource"),
+				},
+			},
+		}
+	}
+
+	return evaluated, nil
+}
+
 func (m *MockBlueprintContainer) Diagnostics() []*core.Diagnostic {
 	return m.stubDiagnostics
 }
@@ -626,3 +724,167 @@ func (m *MockBlueprintContainer) ApplyReconciliation(
 		Errors:           []container.ReconciliationError{},
 	}, nil
 }
+
+func (m *MockBlueprintContainer) ListImportCandidates(
+	ctx context.Context,
+	input *container.ListImportCandidatesInput,
+	paramOverrides core.BlueprintParams,
+) (*container.ImportCandidatesResult, error) {
+	resources := make([]*container.UnmatchedResource, len(input.ResourceNames))
+	for i, resourceName := range input.ResourceNames {
+		resources[i] = &container.UnmatchedResource{
+			ResourceName: resourceName,
+			Candidates:   []*provider.ExternalResource{},
+		}
+	}
+
+	return &container.ImportCandidatesResult{
+		InstanceID: input.InstanceID,
+		Resources:  resources,
+	}, nil
+}
+
+func (m *MockBlueprintContainer) ImportResources(
+	ctx context.Context,
+	input *container.ImportResourcesInput,
+	paramOverrides core.BlueprintParams,
+) (*container.ImportResourcesResult, error) {
+	imported := make([]*container.ImportedResource, len(input.Mappings))
+	for i, mapping := range input.Mappings {
+		imported[i] = &container.ImportedResource{
+			ResourceName: mapping.ResourceName,
+			ResourceID:   uuid.New().String(),
+			ImportID:     mapping.ImportID,
+		}
+	}
+
+	return &container.ImportResourcesResult{
+		InstanceID:      input.InstanceID,
+		InstanceCreated: input.InstanceID == "",
+		Imported:        imported,
+		Errors:          []*container.ImportError{},
+	}, nil
+}
+
+func (m *MockBlueprintContainer) TaintResource(
+	ctx context.Context,
+	instanceID string,
+	resourceName string,
+) error {
+	return nil
+}
+
+func (m *MockBlueprintContainer) UntaintResource(
+	ctx context.Context,
+	instanceID string,
+	resourceName string,
+) error {
+	return nil
+}
+
+func (m *MockBlueprintContainer) ReapplyLinks(
+	ctx context.Context,
+	input *container.ReapplyLinksInput,
+	paramOverrides core.BlueprintParams,
+) (*container.ReapplyLinksResult, error) {
+	links := make([]container.ReappliedLink, len(input.LinkNames))
+	for i, linkName := range input.LinkNames {
+		links[i] = container.ReappliedLink{
+			LinkID:    uuid.New().String(),
+			LinkName:  linkName,
+			ChildPath: input.ChildPath,
+		}
+	}
+
+	return &container.ReapplyLinksResult{
+		InstanceID: input.InstanceID,
+		Links:      links,
+		Errors:     []container.ReconciliationError{},
+	}, nil
+}
+
+func (m *MockBlueprintContainer) GetOutputs(
+	ctx context.Context,
+	instanceID string,
+) (*container.BlueprintOutputs, error) {
+	return &container.BlueprintOutputs{
+		InstanceID: instanceID,
+		Outputs:    map[string]*container.Output{},
+		Children:   map[string]*container.BlueprintOutputs{},
+	}, nil
+}
+
+func (m *MockBlueprintContainer) ResumeDeployment(
+	ctx context.Context,
+	input *container.ResumeDeploymentInput,
+	channels *container.DeployChannels,
+	paramOverrides core.BlueprintParams,
+) error {
+	return nil
+}
+
+func (m *MockBlueprintContainer) SavePlan(
+	ctx context.Context,
+	input *container.SavePlanInput,
+) (*container.SavedPlan, error) {
+	return &container.SavedPlan{
+		FormatVersion: container.SavedPlanFormatVersion,
+		InstanceID:    input.InstanceID,
+		InstanceName:  input.InstanceName,
+		Changes:       input.Changes,
+	}, nil
+}
+
+func (m *MockBlueprintContainer) DeployFromPlan(
+	ctx context.Context,
+	input *container.DeployFromPlanInput,
+	channels *container.DeployChannels,
+	paramOverrides core.BlueprintParams,
+) error {
+	return nil
+}
+
+func (m *MockBlueprintContainer) CloneInstance(
+	ctx context.Context,
+	input *container.CloneInstanceInput,
+	channels *container.DeployChannels,
+	paramOverrides core.BlueprintParams,
+) error {
+	return nil
+}
+
+func (m *MockBlueprintContainer) VerifyChanges(
+	ctx context.Context,
+	input *container.VerifyChangesInput,
+	paramOverrides core.BlueprintParams,
+) (*container.VerifyChangesResult, error) {
+	return &container.VerifyChangesResult{
+		Resources: []container.ResourceVerifyResult{},
+	}, nil
+}
+
+func (m *MockBlueprintContainer) PlanReconciliation(
+	ctx context.Context,
+	input *container.ApplyReconciliationInput,
+	paramOverrides core.BlueprintParams,
+) (*container.ReconciliationPlan, error) {
+	return &container.ReconciliationPlan{
+		InstanceID: input.InstanceID,
+		Resources:  []container.ResourceReconcilePlan{},
+		Links:      []container.LinkReconcilePlan{},
+		Errors:     []container.ReconciliationError{},
+	}, nil
+}
+
+func (m *MockBlueprintContainer) RefreshState(
+	ctx context.Context,
+	input *container.RefreshStateInput,
+	paramOverrides core.BlueprintParams,
+) (*container.RefreshStateResult, error) {
+	return &container.RefreshStateResult{
+		InstanceID: input.InstanceID,
+		Resources:  []container.ResourceReconcileResult{},
+		Links:      []container.LinkReconcileResult{},
+		Errors:     []container.ReconciliationError{},
+	}, nil
+}
@@ -1,7 +1,9 @@
 package testutils
 
 import (
+	"cmp"
 	"context"
+	"slices"
 	"sync"
 	"time"
 
@@ -35,6 +37,27 @@ func (s *MockChangesetStore) Get(
 	return nil, manage.ChangesetNotFoundError(id)
 }
 
+func (s *MockChangesetStore) GetAllByInstanceID(
+	ctx context.Context,
+	instanceID string,
+) ([]*manage.Changeset, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changesets := []*manage.Changeset{}
+	for _, changeset := range s.Changesets {
+		if changeset.InstanceID == instanceID {
+			changesets = append(changesets, changeset)
+		}
+	}
+
+	slices.SortFunc(changesets, func(a, b *manage.Changeset) int {
+		return cmp.Compare(b.Created, a.Created)
+	})
+
+	return changesets, nil
+}
+
 func (s *MockChangesetStore) Save(
 	ctx context.Context,
 	Changeset *manage.Changeset,
@@ -0,0 +1,88 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
+)
+
+// MockResourceTypeDocs holds the documentation that is returned
+// by the mock resource registry for a resource type.
+type MockResourceTypeDocs struct {
+	Description *provider.ResourceGetTypeDescriptionOutput
+	Examples    *provider.ResourceGetExamplesOutput
+}
+
+// MockResourceRegistry is a resource registry that serves
+// resource type documentation from fixtures.
+// Methods that are not used to retrieve documentation
+// are not implemented and will panic if called.
+type MockResourceRegistry struct {
+	resourcehelpers.Registry
+	// Mapping of resource types to the documentation
+	// that should be returned for the resource type.
+	Fixtures map[string]*MockResourceTypeDocs
+	// Mapping of resource types to errors that should be returned
+	// when retrieving documentation for the resource type.
+	Errors map[string]error
+}
+
+func NewMockResourceRegistry(
+	fixtures map[string]*MockResourceTypeDocs,
+	errors map[string]error,
+) *MockResourceRegistry {
+	return &MockResourceRegistry{
+		Fixtures: fixtures,
+		Errors:   errors,
+	}
+}
+
+func (r *MockResourceRegistry) HasResourceType(
+	ctx context.Context,
+	resourceType string,
+) (bool, error) {
+	_, hasErr := r.Errors[resourceType]
+	_, hasFixture := r.Fixtures[resourceType]
+	return hasErr || hasFixture, nil
+}
+
+func (r *MockResourceRegistry) GetTypeDescription(
+	ctx context.Context,
+	resourceType string,
+	input *provider.ResourceGetTypeDescriptionInput,
+) (*provider.ResourceGetTypeDescriptionOutput, error) {
+	docs, err := r.getDocs(resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return docs.Description, nil
+}
+
+func (r *MockResourceRegistry) GetExamples(
+	ctx context.Context,
+	resourceType string,
+	input *provider.ResourceGetExamplesInput,
+) (*provider.ResourceGetExamplesOutput, error) {
+	docs, err := r.getDocs(resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return docs.Examples, nil
+}
+
+func (r *MockResourceRegistry) getDocs(resourceType string) (*MockResourceTypeDocs, error) {
+	if err, hasErr := r.Errors[resourceType]; hasErr {
+		return nil, err
+	}
+
+	docs, ok := r.Fixtures[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("resource type %q not found", resourceType)
+	}
+
+	return docs, nil
+}
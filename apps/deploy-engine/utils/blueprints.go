@@ -95,6 +95,27 @@ func DiagnosticsFromBlueprintValidationError(
 	return getGeneralErrorDiagnostics(err)
 }
 
+// ErrorGroupsFromBlueprintValidationError groups the child errors of a blueprint
+// validation error by element and reason code, deduplicating identical messages.
+// This returns nil for errors that do not have any child errors to group,
+// in which case, the diagnostics for the error are enough to present it to the user.
+func ErrorGroupsFromBlueprintValidationError(err error) []*bperrors.ErrorGroup {
+	switch typedErr := err.(type) {
+	case *bperrors.LoadError:
+		if len(typedErr.ChildErrors) == 0 {
+			return nil
+		}
+	case *bperrors.RunError:
+		if len(typedErr.ChildErrors) == 0 {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	return bperrors.GroupErrors(err)
+}
+
 func getGeneralErrorDiagnostics(err error) []*core.Diagnostic {
 	level := core.DiagnosticLevelError
 	return []*core.Diagnostic{
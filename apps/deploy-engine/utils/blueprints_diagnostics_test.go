@@ -118,6 +118,39 @@ func (s *DiagnosticsFromErrorTestSuite) Test_returns_diagnostics_extracted_from_
 	s.Require().NoError(err)
 }
 
+func (s *DiagnosticsFromErrorTestSuite) Test_returns_error_groups_for_load_error_with_child_errors() {
+	inputErr := &bperrors.LoadError{
+		ReasonCode: container.ErrorReasonCodeResourceValidationErrors,
+		Err:        fmt.Errorf("validation failed due to multiple errors"),
+		ChildErrors: []error{
+			&bperrors.LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        fmt.Errorf("resource \"queue_0\" has an invalid spec"),
+			},
+			&bperrors.LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        fmt.Errorf("resource \"queue_1\" has an invalid spec"),
+			},
+		},
+	}
+
+	groups := ErrorGroupsFromBlueprintValidationError(inputErr)
+
+	s.Require().Len(groups, 1)
+	s.Assert().Equal(
+		&bperrors.ErrorElement{Type: "resource", Name: "queue"},
+		groups[0].Element,
+	)
+	s.Require().Len(groups[0].Errors, 1)
+	s.Assert().Equal(2, groups[0].Errors[0].Count)
+	s.Assert().Equal([]string{"queue_0", "queue_1"}, groups[0].Errors[0].Elements)
+}
+
+func (s *DiagnosticsFromErrorTestSuite) Test_returns_no_error_groups_for_errors_without_child_errors() {
+	s.Assert().Nil(ErrorGroupsFromBlueprintValidationError(createGeneralError()))
+	s.Assert().Nil(ErrorGroupsFromBlueprintValidationError(createRunError()))
+}
+
 func createChildLoadError(hasDescendants bool, levels int, currentLevel int) error {
 	line := 10
 	column := 1
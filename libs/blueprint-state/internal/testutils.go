@@ -60,6 +60,8 @@ func AssertResourceStatesEqual(expected, actual *state.ResourceState, s *suite.S
 	s.Assert().Equal(expected.LastDeployedTimestamp, actual.LastDeployedTimestamp)
 	s.Assert().Equal(expected.Durations, actual.Durations)
 	s.Assert().Equal(expected.RemovalPolicy, actual.RemovalPolicy)
+	assertSlicesEqual(expected.IgnoreChanges, actual.IgnoreChanges, s)
+	s.Assert().Equal(expected.Tainted, actual.Tainted)
 }
 
 func assertResourceMetadataEqual(
@@ -14,6 +14,13 @@ type Changesets interface {
 	// Get a change set for the given ID.
 	Get(ctx context.Context, id string) (*Changeset, error)
 
+	// GetAllByInstanceID retrieves all change sets that have been staged
+	// or deployed for a blueprint instance, ordered by created desc.
+	// Change sets that have been deployed make up the revision history
+	// of the blueprint instance.
+	// Returns an empty slice if no change sets exist for the instance.
+	GetAllByInstanceID(ctx context.Context, instanceID string) ([]*Changeset, error)
+
 	// Save a new change set for a change staging request.
 	Save(
 		ctx context.Context,
@@ -47,6 +54,10 @@ type Changeset struct {
 	Changes *changes.BlueprintChanges `json:"changes,omitempty"`
 	// The unix timestamp in seconds when the change set was created.
 	Created int64 `json:"created"`
+	// The unix timestamp in seconds when the changes in the change set
+	// were successfully deployed to the blueprint instance.
+	// This will be 0 if the change set has not been deployed.
+	Deployed int64 `json:"deployed,omitempty"`
 }
 
 ////////////////////////////////////////////////////////////////////////////////////
@@ -44,6 +44,60 @@ func ChangesetNotFoundError(id string) error {
 	return &ChangesetNotFound{ID: id}
 }
 
+// RevisionNotFound is an error type
+// that indicates a change set with the specified ID
+// has not been deployed to a blueprint instance.
+type RevisionNotFound struct {
+	ID string
+}
+
+func (r RevisionNotFound) Error() string {
+	return fmt.Sprintf("revision with ID %s not found in the deployed revisions of the instance", r.ID)
+}
+
+// RevisionNotFoundError creates a new RevisionNotFound error with the specified ID.
+// An error can be checked against this type using:
+//
+//	var revisionNotFoundErr *manage.RevisionNotFound
+//	if errors.As(err, &revisionNotFoundErr) {
+//		// Handle the error
+//	}
+func RevisionNotFoundError(id string) error {
+	return &RevisionNotFound{ID: id}
+}
+
+// ResourceStateUnavailable is an error type
+// that indicates the state of a resource at a previous point in time
+// can not be reconstructed from the revision history of a blueprint instance.
+type ResourceStateUnavailable struct {
+	ResourceName string
+	RevisionID   string
+}
+
+func (r ResourceStateUnavailable) Error() string {
+	return fmt.Sprintf(
+		"the previous state of resource %s is not available as "+
+			"the resource was removed in revision %s",
+		r.ResourceName,
+		r.RevisionID,
+	)
+}
+
+// ResourceStateUnavailableError creates a new ResourceStateUnavailable error
+// for the specified resource and revision.
+// An error can be checked against this type using:
+//
+//	var stateUnavailableErr *manage.ResourceStateUnavailable
+//	if errors.As(err, &stateUnavailableErr) {
+//		// Handle the error
+//	}
+func ResourceStateUnavailableError(resourceName string, revisionID string) error {
+	return &ResourceStateUnavailable{
+		ResourceName: resourceName,
+		RevisionID:   revisionID,
+	}
+}
+
 // BlueprintValidationNotFound is an error type
 // that indicates a blueprint validation request with
 // the specified ID was not found.
@@ -0,0 +1,240 @@
+package manage
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// RevisionPoint identifies a previous point in the revision history
+// of a blueprint instance.
+// Only one of Timestamp or Revision should be set,
+// Revision takes precedence when both are set.
+type RevisionPoint struct {
+	// Timestamp is a unix timestamp in seconds, the point in time
+	// will be after all revisions deployed at or before this time.
+	Timestamp int64
+	// Revision is the ID of a change set that has been deployed to
+	// the blueprint instance, the point in time will be
+	// directly after the change set was deployed.
+	Revision string
+}
+
+// ResourceStateAtRevision holds the state of a resource in a blueprint instance
+// as of a previous point in time along with the changes that have been made
+// to the resource spec since.
+type ResourceStateAtRevision struct {
+	// ResourceName is the name of the resource in the blueprint instance.
+	ResourceName string `json:"resourceName"`
+	// At is the unix timestamp in seconds for the point in time
+	// that the state of the resource is for.
+	At int64 `json:"at"`
+	// Revision is the ID of the change set for the latest revision
+	// deployed at or before the point in time.
+	// This will be empty if no revisions had been deployed at the point in time.
+	Revision string `json:"revision,omitempty"`
+	// Existed determines whether the resource existed at the point in time.
+	Existed bool `json:"existed"`
+	// State holds the state of the resource at the point in time.
+	// This will be nil if the resource did not exist at the point in time.
+	State *state.ResourceState `json:"state,omitempty"`
+	// Current holds the current state of the resource.
+	// This will be nil if the resource has since been removed.
+	Current *state.ResourceState `json:"current,omitempty"`
+	// ModifiedFields holds the fields in the resource spec that have been
+	// modified since the point in time.
+	ModifiedFields []provider.FieldChange `json:"modifiedFields"`
+	// NewFields holds the fields in the resource spec that have been
+	// added since the point in time.
+	NewFields []provider.FieldChange `json:"newFields"`
+	// RemovedFields holds the paths of the fields in the resource spec
+	// that have been removed since the point in time.
+	RemovedFields []string `json:"removedFields"`
+	// SensitiveFields holds the paths of the fields in the resource spec
+	// that are known to be sensitive from the changes recorded for the
+	// resource in the revision history of the blueprint instance.
+	// Values of these fields should be redacted when displayed to a user.
+	SensitiveFields []string `json:"sensitiveFields,omitempty"`
+}
+
+// ResourceStateAt reconstructs the state of a resource as of a previous point
+// in the revision history of a blueprint instance.
+//
+// Each deployed change set holds the state of the resources it changed
+// as they were before the changes were deployed, so the state at a point in time
+// is taken from the first revision deployed after the point in time
+// that changed the resource. When no later revisions changed the resource,
+// the current state is the state at the point in time.
+//
+// The provided current state should be nil if the resource no longer exists
+// in the blueprint instance. Only resources at the top level of a blueprint
+// instance are supported, child blueprint resources are not reconstructed.
+func ResourceStateAt(
+	resourceName string,
+	current *state.ResourceState,
+	revisions []*Changeset,
+	point *RevisionPoint,
+) (*ResourceStateAtRevision, error) {
+	deployed := deployedRevisions(revisions)
+
+	at := point.Timestamp
+	laterRevisions := []*Changeset{}
+	revisionID := ""
+	if point.Revision != "" {
+		index := slices.IndexFunc(deployed, func(revision *Changeset) bool {
+			return revision.ID == point.Revision
+		})
+		if index == -1 {
+			return nil, RevisionNotFoundError(point.Revision)
+		}
+		at = deployed[index].Deployed
+		revisionID = point.Revision
+		laterRevisions = deployed[index+1:]
+	} else {
+		for _, revision := range deployed {
+			if revision.Deployed <= at {
+				revisionID = revision.ID
+			} else {
+				laterRevisions = append(laterRevisions, revision)
+			}
+		}
+	}
+
+	result := &ResourceStateAtRevision{
+		ResourceName: resourceName,
+		At:           at,
+		Revision:     revisionID,
+		Existed:      current != nil,
+		State:        current,
+		Current:      current,
+		// Sensitivity is a property of the resource spec schema,
+		// so any revision that recorded changes for the resource
+		// can be used, including revisions that have not been deployed.
+		SensitiveFields: collectSensitiveFields(resourceName, revisions),
+	}
+	for _, revision := range laterRevisions {
+		resolved, err := resourceStateBeforeRevision(resourceName, revision, result)
+		if err != nil {
+			return nil, err
+		}
+		if resolved {
+			break
+		}
+	}
+
+	collectResourceSpecChanges(result)
+	return result, nil
+}
+
+func resourceStateBeforeRevision(
+	resourceName string,
+	revision *Changeset,
+	result *ResourceStateAtRevision,
+) (bool, error) {
+	if revision.Changes == nil {
+		return false, nil
+	}
+
+	if resourceChanges, hasChanges := revision.Changes.ResourceChanges[resourceName]; hasChanges {
+		result.State = resourceChanges.AppliedResourceInfo.CurrentResourceState
+		result.Existed = result.State != nil
+		return true, nil
+	}
+
+	if _, isNew := revision.Changes.NewResources[resourceName]; isNew {
+		result.State = nil
+		result.Existed = false
+		return true, nil
+	}
+
+	if slices.Contains(revision.Changes.RemovedResources, resourceName) {
+		// Change sets only record the names of removed resources,
+		// the state of the resource before it was removed is not available.
+		return false, ResourceStateUnavailableError(resourceName, revision.ID)
+	}
+
+	return false, nil
+}
+
+func collectResourceSpecChanges(result *ResourceStateAtRevision) {
+	result.ModifiedFields = []provider.FieldChange{}
+	result.NewFields = []provider.FieldChange{}
+	result.RemovedFields = []string{}
+	if result.State == nil || result.Current == nil {
+		return
+	}
+
+	specChanges := changes.CompareMappingNodes(
+		result.Current.SpecData,
+		result.State.SpecData,
+		"spec",
+	)
+	result.ModifiedFields = append(result.ModifiedFields, specChanges.ModifiedFields...)
+	result.NewFields = append(result.NewFields, specChanges.NewFields...)
+	result.RemovedFields = append(result.RemovedFields, specChanges.RemovedFields...)
+	markSensitiveFieldChanges(result.ModifiedFields, result.SensitiveFields)
+	markSensitiveFieldChanges(result.NewFields, result.SensitiveFields)
+}
+
+func collectSensitiveFields(resourceName string, revisions []*Changeset) []string {
+	sensitiveFields := []string{}
+	for _, revision := range revisions {
+		if revision.Changes == nil {
+			continue
+		}
+
+		for _, resourceChanges := range []map[string]provider.Changes{
+			revision.Changes.NewResources,
+			revision.Changes.ResourceChanges,
+		} {
+			recordedChanges, hasChanges := resourceChanges[resourceName]
+			if !hasChanges {
+				continue
+			}
+
+			fieldChanges := slices.Concat(recordedChanges.ModifiedFields, recordedChanges.NewFields)
+			for _, fieldChange := range fieldChanges {
+				if fieldChange.Sensitive && !slices.Contains(sensitiveFields, fieldChange.FieldPath) {
+					sensitiveFields = append(sensitiveFields, fieldChange.FieldPath)
+				}
+			}
+		}
+	}
+
+	slices.Sort(sensitiveFields)
+	return sensitiveFields
+}
+
+func markSensitiveFieldChanges(fieldChanges []provider.FieldChange, sensitiveFields []string) {
+	for i := range fieldChanges {
+		if slices.Contains(sensitiveFields, fieldChanges[i].FieldPath) {
+			fieldChanges[i].Sensitive = true
+		}
+	}
+}
+
+// The change sets that have been deployed to a blueprint instance,
+// ordered by the time they were deployed.
+// Change sets for destroying the instance are not included as
+// resources can not be inspected after an instance has been destroyed.
+func deployedRevisions(revisions []*Changeset) []*Changeset {
+	deployed := []*Changeset{}
+	for _, revision := range revisions {
+		if revision.Deployed > 0 && !revision.Destroy {
+			deployed = append(deployed, revision)
+		}
+	}
+
+	slices.SortFunc(deployed, func(a, b *Changeset) int {
+		return cmp.Or(
+			cmp.Compare(a.Deployed, b.Deployed),
+			cmp.Compare(a.Created, b.Created),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+
+	return deployed
+}
@@ -0,0 +1,215 @@
+package manage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResourceHistoryTestSuite struct {
+	revisions []*Changeset
+	suite.Suite
+}
+
+func (s *ResourceHistoryTestSuite) SetupTest() {
+	s.revisions = []*Changeset{
+		// Ordered by created desc as returned by GetAllByInstanceID.
+		{
+			ID:      "revision-4",
+			Created: 1700000400,
+			Changes: &changes.BlueprintChanges{
+				ResourceChanges: map[string]provider.Changes{
+					"ordersTable": resourceChanges(ordersTableState("orders-v2", "PROVISIONED")),
+				},
+			},
+		},
+		{
+			ID:       "revision-3",
+			Created:  1700000300,
+			Deployed: 1700000310,
+			Changes: &changes.BlueprintChanges{
+				ResourceChanges: map[string]provider.Changes{
+					"ordersTable": resourceChanges(ordersTableState("orders", "PROVISIONED")),
+				},
+			},
+		},
+		{
+			ID:       "revision-2",
+			Created:  1700000200,
+			Deployed: 1700000210,
+			Changes: &changes.BlueprintChanges{
+				NewResources: map[string]provider.Changes{
+					"ordersQueue": {},
+				},
+			},
+		},
+		{
+			ID:       "revision-1",
+			Created:  1700000100,
+			Deployed: 1700000110,
+			Changes: &changes.BlueprintChanges{
+				NewResources: map[string]provider.Changes{
+					"ordersTable": {},
+				},
+			},
+		},
+	}
+}
+
+func (s *ResourceHistoryTestSuite) Test_reconstructs_resource_state_at_timestamp() {
+	current := ordersTableState("orders-v2", "PAY_PER_REQUEST")
+
+	result, err := ResourceStateAt(
+		"ordersTable",
+		current,
+		s.revisions,
+		&RevisionPoint{Timestamp: 1700000250},
+	)
+	s.Require().NoError(err)
+
+	s.Equal(int64(1700000250), result.At)
+	s.Equal("revision-2", result.Revision)
+	s.True(result.Existed)
+	s.Equal(ordersTableState("orders", "PROVISIONED"), result.State)
+	s.Same(current, result.Current)
+	s.Require().Len(result.ModifiedFields, 2)
+	s.Equal("spec.billingMode", result.ModifiedFields[0].FieldPath)
+	s.Equal("spec.tableName", result.ModifiedFields[1].FieldPath)
+	s.Equal("orders", core.StringValue(result.ModifiedFields[1].PrevValue))
+	s.Equal("orders-v2", core.StringValue(result.ModifiedFields[1].NewValue))
+}
+
+func (s *ResourceHistoryTestSuite) Test_reconstructs_resource_state_at_revision() {
+	current := ordersTableState("orders-v2", "PAY_PER_REQUEST")
+
+	result, err := ResourceStateAt(
+		"ordersTable",
+		current,
+		s.revisions,
+		&RevisionPoint{Revision: "revision-3"},
+	)
+	s.Require().NoError(err)
+
+	s.Equal(int64(1700000310), result.At)
+	s.Equal("revision-3", result.Revision)
+	s.True(result.Existed)
+	// Revisions that have not been deployed are not a part of the history,
+	// so the current state is the state as of the latest deployed revision.
+	s.Same(current, result.State)
+	s.Empty(result.ModifiedFields)
+	s.Empty(result.NewFields)
+	s.Empty(result.RemovedFields)
+}
+
+func (s *ResourceHistoryTestSuite) Test_marks_sensitive_fields_from_recorded_changes() {
+	revisionChanges := s.revisions[1].Changes.ResourceChanges["ordersTable"]
+	revisionChanges.ModifiedFields = []provider.FieldChange{
+		{
+			FieldPath: "spec.tableName",
+			PrevValue: core.MappingNodeFromString("orders"),
+			NewValue:  core.MappingNodeFromString("orders-v2"),
+			Sensitive: true,
+		},
+	}
+	s.revisions[1].Changes.ResourceChanges["ordersTable"] = revisionChanges
+
+	result, err := ResourceStateAt(
+		"ordersTable",
+		ordersTableState("orders-v2", "PAY_PER_REQUEST"),
+		s.revisions,
+		&RevisionPoint{Timestamp: 1700000250},
+	)
+	s.Require().NoError(err)
+
+	s.Equal([]string{"spec.tableName"}, result.SensitiveFields)
+	s.Require().Len(result.ModifiedFields, 2)
+	s.False(result.ModifiedFields[0].Sensitive)
+	s.Equal("spec.tableName", result.ModifiedFields[1].FieldPath)
+	s.True(result.ModifiedFields[1].Sensitive)
+}
+
+func (s *ResourceHistoryTestSuite) Test_reports_resource_that_did_not_exist_at_point_in_time() {
+	result, err := ResourceStateAt(
+		"ordersQueue",
+		&state.ResourceState{Name: "ordersQueue"},
+		s.revisions,
+		&RevisionPoint{Revision: "revision-1"},
+	)
+	s.Require().NoError(err)
+
+	s.False(result.Existed)
+	s.Nil(result.State)
+	s.Empty(result.ModifiedFields)
+}
+
+func (s *ResourceHistoryTestSuite) Test_fails_for_revision_that_has_not_been_deployed() {
+	_, err := ResourceStateAt(
+		"ordersTable",
+		ordersTableState("orders-v2", "PAY_PER_REQUEST"),
+		s.revisions,
+		&RevisionPoint{Revision: "revision-4"},
+	)
+	s.Require().Error(err)
+	var revisionNotFoundErr *RevisionNotFound
+	s.True(errors.As(err, &revisionNotFoundErr))
+	s.Equal("revision-4", revisionNotFoundErr.ID)
+}
+
+func (s *ResourceHistoryTestSuite) Test_fails_when_resource_was_removed_after_point_in_time() {
+	revisions := append(
+		[]*Changeset{
+			{
+				ID:       "revision-5",
+				Created:  1700000500,
+				Deployed: 1700000510,
+				Changes: &changes.BlueprintChanges{
+					RemovedResources: []string{"ordersQueue"},
+				},
+			},
+		},
+		s.revisions...,
+	)
+
+	_, err := ResourceStateAt(
+		"ordersQueue",
+		nil,
+		revisions,
+		&RevisionPoint{Timestamp: 1700000300},
+	)
+	s.Require().Error(err)
+	var stateUnavailableErr *ResourceStateUnavailable
+	s.True(errors.As(err, &stateUnavailableErr))
+	s.Equal("revision-5", stateUnavailableErr.RevisionID)
+}
+
+func resourceChanges(previousState *state.ResourceState) provider.Changes {
+	return provider.Changes{
+		AppliedResourceInfo: provider.ResourceInfo{
+			ResourceName:         previousState.Name,
+			CurrentResourceState: previousState,
+		},
+	}
+}
+
+func ordersTableState(tableName string, billingMode string) *state.ResourceState {
+	return &state.ResourceState{
+		ResourceID: "orders-table-id",
+		Name:       "ordersTable",
+		Type:       "aws/dynamodb/table",
+		SpecData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"tableName":   core.MappingNodeFromString(tableName),
+				"billingMode": core.MappingNodeFromString(billingMode),
+			},
+		},
+	}
+}
+
+func TestResourceHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ResourceHistoryTestSuite))
+}
@@ -0,0 +1,45 @@
+package manage
+
+import "time"
+
+// RetentionPolicy provides configuration to bound the amount of historical
+// state that is kept for blueprint instances.
+// Retention policies are enforced automatically by state containers
+// when change sets and drift state are saved, removing the need for
+// scheduled cleanup jobs to keep storage growth in check.
+//
+// A zero value retention policy does not apply any limits.
+type RetentionPolicy struct {
+	// MaxRevisionsPerInstance is the maximum number of change sets (revisions)
+	// to keep for each blueprint instance.
+	// When a new change set is saved for an instance that takes the number of
+	// change sets over this limit, the oldest change sets for the instance are removed.
+	// When set to 0 or less, the number of change sets is not limited.
+	MaxRevisionsPerInstance int
+	// MaxDriftHistoryAge is the maximum age of resource and link drift entries.
+	// Drift entries that were detected longer ago than this age are removed
+	// when drift state is saved.
+	// The drift entry for a resource or link that is still marked as drifted
+	// is the current drift state and is never removed, regardless of its age.
+	// When set to 0 or less, drift entries are kept until they are removed
+	// by a drift check or deployment.
+	MaxDriftHistoryAge time.Duration
+}
+
+// LimitsRevisions determines whether the retention policy limits
+// the number of change sets that are kept for each blueprint instance.
+func (p RetentionPolicy) LimitsRevisions() bool {
+	return p.MaxRevisionsPerInstance > 0
+}
+
+// LimitsDriftHistory determines whether the retention policy limits
+// the age of drift entries.
+func (p RetentionPolicy) LimitsDriftHistory() bool {
+	return p.MaxDriftHistoryAge > 0
+}
+
+// DriftExpiryThreshold returns the time before which drift entries
+// should be removed based on the provided current time.
+func (p RetentionPolicy) DriftExpiryThreshold(now time.Time) time.Time {
+	return now.Add(-p.MaxDriftHistoryAge)
+}
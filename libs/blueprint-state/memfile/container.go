@@ -23,6 +23,7 @@ type StateContainer struct {
 	childrenContainer              *statestore.ChildrenContainer
 	metadataContainer              *statestore.MetadataContainer
 	exportContainer                *statestore.ExportsContainer
+	summariesContainer             *statestore.SummariesContainer
 	eventsContainer                *statestore.EventsContainer
 	changesetsContainer            *statestore.ChangesetsContainer
 	validationContainer            *statestore.ValidationsContainer
@@ -75,12 +76,25 @@ func WithRecentlyQueuedEventsThreshold(thresholdSeconds int64) func(*StateContai
 
 // WithClock sets the clock to use for the state container.
 // This is used in tasks like determining the current time when checking for
-// recently queued events.
+// recently queued events and the age of drift entries for retention policies.
 //
 // When not set, the default value is the system clock.
 func WithClock(clock commoncore.Clock) func(*StateContainer) {
 	return func(c *StateContainer) {
 		statestore.WithEventsClock(clock)(c.eventsContainer)
+		statestore.WithRetentionClock(clock)(c.storePersister)
+	}
+}
+
+// WithRetentionPolicy sets the retention policy that is enforced
+// when change sets and drift state are saved.
+// This allows the number of change sets kept for each blueprint instance
+// and the age of drift entries to be bounded without scheduled cleanup jobs.
+//
+// When not set, no retention limits are applied.
+func WithRetentionPolicy(policy manage.RetentionPolicy) func(*StateContainer) {
+	return func(c *StateContainer) {
+		statestore.WithRetentionPolicy(policy)(c.storePersister)
 	}
 }
 
@@ -126,6 +140,7 @@ func LoadStateContainer(
 		childrenContainer:              statestore.NewChildrenContainer(storeState, storePersister, logger),
 		metadataContainer:              statestore.NewMetadataContainer(storeState, storePersister, logger),
 		exportContainer:                statestore.NewExportsContainer(storeState, storePersister, logger),
+		summariesContainer:             statestore.NewSummariesContainer(storeState, logger),
 		eventsContainer:                statestore.NewEventsContainer(storeState, storePersister, logger),
 		changesetsContainer:            statestore.NewChangesetsContainer(storeState, storePersister, logger),
 		validationContainer:            statestore.NewValidationsContainer(storeState, storePersister, logger),
@@ -163,6 +178,10 @@ func (c *StateContainer) Exports() state.ExportsContainer {
 	return c.exportContainer
 }
 
+func (c *StateContainer) Summaries() state.SummariesContainer {
+	return c.summariesContainer
+}
+
 func (c *StateContainer) Events() manage.Events {
 	return c.eventsContainer
 }
@@ -76,6 +76,40 @@ func (s *MemFileStateContainerChangesetsSuite) Test_fails_to_retrieve_non_existe
 	)
 }
 
+func (s *MemFileStateContainerChangesetsSuite) Test_retrieves_all_changesets_for_instance() {
+	changesets := s.container.Changesets()
+	instanceChangesets, err := changesets.GetAllByInstanceID(
+		context.Background(),
+		"46324ee7-b515-4988-98b0-d5445746a997",
+	)
+	s.Require().NoError(err)
+
+	ids := make([]string, len(instanceChangesets))
+	for i, changeset := range instanceChangesets {
+		ids[i] = changeset.ID
+	}
+	s.Assert().Equal(
+		[]string{
+			"2888c908-32e2-4555-af36-319455172c64",
+			"341c10bd-7c1e-4bfe-bdd2-10c5c16a871d",
+			"ff50a0f8-96e9-41c1-b729-4f3a6a82d8d8",
+			"3d234a23-abd8-4633-8f43-654f8788413b",
+			"08dc456e-cafc-4199-b074-5f04cd4904f2",
+		},
+		ids,
+	)
+}
+
+func (s *MemFileStateContainerChangesetsSuite) Test_retrieves_empty_list_of_changesets_for_instance_without_changesets() {
+	changesets := s.container.Changesets()
+	instanceChangesets, err := changesets.GetAllByInstanceID(
+		context.Background(),
+		"fc7a2d6b-6a5f-4d2c-8a3e-8c6d0f3c2e1a",
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(instanceChangesets)
+}
+
 func (s *MemFileStateContainerChangesetsSuite) Test_saves_new_changeset() {
 	fixture := s.saveChangesetFixtures[1]
 
@@ -361,6 +361,106 @@ func (s *MemFileStateContainerInstancesTestSuite) Test_lists_instances_handles_o
 	s.Assert().Empty(result.Instances)
 }
 
+func (s *MemFileStateContainerInstancesTestSuite) Test_saves_and_lists_deployment_runs() {
+	instances := s.container.Instances()
+	runs := []state.DeploymentRun{
+		testDeploymentRun("run-1", state.DeploymentRunOperationDeploy, 1735787040),
+		testDeploymentRun("run-2", state.DeploymentRunOperationDeploy, 1735787050),
+		testDeploymentRun("run-3", state.DeploymentRunOperationDestroy, 1735787060),
+	}
+	for _, run := range runs {
+		err := instances.SaveRun(context.Background(), run)
+		s.Require().NoError(err)
+	}
+	// Runs are immutable, saving a run with an existing ID is a no-op.
+	err := instances.SaveRun(
+		context.Background(),
+		testDeploymentRun("run-1", state.DeploymentRunOperationDestroy, 1735787070),
+	)
+	s.Require().NoError(err)
+
+	result, err := instances.ListRuns(
+		context.Background(),
+		existingBlueprintInstanceID,
+		state.ListRunsParams{},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(3, result.TotalCount)
+	s.Assert().Equal([]string{"run-3", "run-2", "run-1"}, deploymentRunIDs(result.Runs))
+
+	// Runs must be persisted and retained after the instance is removed.
+	_, err = instances.Remove(context.Background(), existingBlueprintInstanceID)
+	s.Require().NoError(err)
+	freshContainer, err := LoadStateContainer(s.stateDir, s.fs, core.NewNopLogger())
+	s.Require().NoError(err)
+
+	result, err = freshContainer.Instances().ListRuns(
+		context.Background(),
+		existingBlueprintInstanceID,
+		state.ListRunsParams{
+			Operation: state.DeploymentRunOperationDeploy,
+			Limit:     1,
+		},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(2, result.TotalCount)
+	s.Require().Len(result.Runs, 1)
+	s.Assert().Equal(runs[1], result.Runs[0])
+}
+
+func (s *MemFileStateContainerInstancesTestSuite) Test_lists_no_deployment_runs_for_instance_without_runs() {
+	result, err := s.container.Instances().ListRuns(
+		context.Background(),
+		nonExistentInstanceID,
+		state.ListRunsParams{},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(0, result.TotalCount)
+	s.Assert().Empty(result.Runs)
+}
+
+func testDeploymentRun(
+	runID string,
+	operation state.DeploymentRunOperation,
+	startedAt int64,
+) state.DeploymentRun {
+	durationMilliseconds := 10000.0
+	return state.DeploymentRun{
+		ID:           runID,
+		InstanceID:   existingBlueprintInstanceID,
+		InstanceName: existingBlueprintInstanceName,
+		Operation:    operation,
+		Metadata: &state.DeploymentRunMetadata{
+			InitiatedBy:         "ops-user",
+			ClientVersion:       "0.4.0",
+			EngineVersion:       "0.6.0",
+			BlueprintSourceHash: "5d41402abc4b2a76b9719d911017c592",
+		},
+		Status:               "DEPLOYED",
+		Succeeded:            true,
+		StartedAt:            startedAt,
+		FinishedAt:           startedAt + 10,
+		DurationMilliseconds: &durationMilliseconds,
+		Elements: []*state.DeploymentRunElement{
+			{
+				Type:       state.DeploymentRunElementResource,
+				Name:       "ordersTable",
+				ID:         "resource-1",
+				InstanceID: existingBlueprintInstanceID,
+				Status:     "CREATED",
+			},
+		},
+	}
+}
+
+func deploymentRunIDs(runs []state.DeploymentRun) []string {
+	ids := make([]string, 0, len(runs))
+	for _, run := range runs {
+		ids = append(ids, run.ID)
+	}
+	return ids
+}
+
 func (s *MemFileStateContainerInstancesTestSuite) saveTestInstances() {
 	instances := s.container.Instances()
 
@@ -0,0 +1,225 @@
+package memfile
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	retentionTestInstanceID        = "5e3b7e0c-5a0f-4b6a-a6d5-6a1f4b0b5c01"
+	retentionTestResourceID        = "5e3b7e0c-5a0f-4b6a-a6d5-6a1f4b0b5c02"
+	retentionTestResourceName      = "ordersTable"
+	retentionTestOtherResourceID   = "5e3b7e0c-5a0f-4b6a-a6d5-6a1f4b0b5c03"
+	retentionTestOtherResourceName = "ordersQueue"
+	retentionTestNow               = int64(1750000000)
+	retentionTestMaxRevisions      = 2
+	retentionTestMaxDriftAgeDay    = 24 * time.Hour
+)
+
+type MemFileStateContainerRetentionTestSuite struct {
+	container *StateContainer
+	stateDir  string
+	fs        afero.Fs
+	suite.Suite
+}
+
+func (s *MemFileStateContainerRetentionTestSuite) SetupTest() {
+	stateDir := path.Join("__testdata", "initial-state")
+	memoryFS := afero.NewMemMapFs()
+	loadMemoryFS(stateDir, memoryFS, &s.Suite)
+	s.fs = memoryFS
+	s.stateDir = stateDir
+
+	container, err := LoadStateContainer(
+		stateDir,
+		memoryFS,
+		core.NewNopLogger(),
+		WithClock(&internal.MockClock{Timestamp: retentionTestNow}),
+		WithRetentionPolicy(manage.RetentionPolicy{
+			MaxRevisionsPerInstance: retentionTestMaxRevisions,
+			MaxDriftHistoryAge:      retentionTestMaxDriftAgeDay,
+		}),
+	)
+	s.Require().NoError(err)
+	s.container = container
+
+	err = s.container.Instances().Save(
+		context.Background(),
+		state.InstanceState{
+			InstanceID:   retentionTestInstanceID,
+			InstanceName: "RetentionTestInstance",
+			Status:       core.InstanceStatusDeployed,
+		},
+	)
+	s.Require().NoError(err)
+
+	s.saveResource(retentionTestResourceID, retentionTestResourceName)
+	s.saveResource(retentionTestOtherResourceID, retentionTestOtherResourceName)
+}
+
+func (s *MemFileStateContainerRetentionTestSuite) Test_removes_oldest_changesets_exceeding_max_revisions() {
+	changesetIDs := []string{}
+	for i := range 4 {
+		changesetID := fmt.Sprintf("5e3b7e0c-5a0f-4b6a-a6d5-6a1f4b0b5d0%d", i)
+		changesetIDs = append(changesetIDs, changesetID)
+		err := s.container.Changesets().Save(
+			context.Background(),
+			&manage.Changeset{
+				ID:                changesetID,
+				InstanceID:        retentionTestInstanceID,
+				Status:            manage.ChangesetStatusChangesStaged,
+				BlueprintLocation: "project.blueprint.yml",
+				Created:           retentionTestNow - int64(100-i),
+			},
+		)
+		s.Require().NoError(err)
+	}
+
+	s.assertChangesetsRetained(s.container, changesetIDs)
+
+	// Make sure the removal of change sets was persisted.
+	container, err := LoadStateContainer(s.stateDir, s.fs, core.NewNopLogger())
+	s.Require().NoError(err)
+	s.assertChangesetsRetained(container, changesetIDs)
+}
+
+func (s *MemFileStateContainerRetentionTestSuite) Test_keeps_expired_drift_entry_for_resource_that_is_still_drifted() {
+	expiredTimestamp := int(retentionTestNow - int64((48 * time.Hour).Seconds()))
+	s.saveDrift(retentionTestResourceID, retentionTestResourceName, expiredTimestamp)
+
+	driftState, err := s.container.Resources().GetDrift(
+		context.Background(),
+		retentionTestResourceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(retentionTestResourceID, driftState.ResourceID)
+
+	resource, err := s.container.Resources().Get(
+		context.Background(),
+		retentionTestResourceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().True(resource.Drifted)
+	s.Assert().Equal(expiredTimestamp, *resource.LastDriftDetectedTimestamp)
+}
+
+func (s *MemFileStateContainerRetentionTestSuite) Test_removes_expired_drift_entry_for_resource_that_is_no_longer_drifted() {
+	expiredTimestamp := int(retentionTestNow - int64((48 * time.Hour).Seconds()))
+	s.saveDrift(retentionTestResourceID, retentionTestResourceName, expiredTimestamp)
+	// Saving the resource state without the drifted flag leaves a stale
+	// drift entry behind, which is removed the next time drift is saved.
+	s.saveResource(retentionTestResourceID, retentionTestResourceName)
+
+	recentTimestamp := int(retentionTestNow - 60)
+	s.saveDrift(retentionTestOtherResourceID, retentionTestOtherResourceName, recentTimestamp)
+
+	driftState, err := s.container.Resources().GetDrift(
+		context.Background(),
+		retentionTestResourceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(driftState.ResourceID)
+
+	otherDriftState, err := s.container.Resources().GetDrift(
+		context.Background(),
+		retentionTestOtherResourceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(retentionTestOtherResourceID, otherDriftState.ResourceID)
+
+	// Make sure the removal of the stale drift entry was persisted.
+	container, err := LoadStateContainer(s.stateDir, s.fs, core.NewNopLogger())
+	s.Require().NoError(err)
+	persistedDriftState, err := container.Resources().GetDrift(
+		context.Background(),
+		retentionTestResourceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(persistedDriftState.ResourceID)
+}
+
+func (s *MemFileStateContainerRetentionTestSuite) Test_keeps_drift_entries_within_max_age() {
+	recentTimestamp := int(retentionTestNow - 60)
+	s.saveDrift(retentionTestResourceID, retentionTestResourceName, recentTimestamp)
+
+	driftState, err := s.container.Resources().GetDrift(
+		context.Background(),
+		retentionTestResourceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(retentionTestResourceID, driftState.ResourceID)
+
+	resource, err := s.container.Resources().Get(
+		context.Background(),
+		retentionTestResourceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().True(resource.Drifted)
+}
+
+func (s *MemFileStateContainerRetentionTestSuite) saveResource(
+	resourceID string,
+	resourceName string,
+) {
+	err := s.container.Resources().Save(
+		context.Background(),
+		state.ResourceState{
+			ResourceID: resourceID,
+			Name:       resourceName,
+			Type:       "aws/dynamodb/table",
+			InstanceID: retentionTestInstanceID,
+			SpecData:   &core.MappingNode{Fields: map[string]*core.MappingNode{}},
+		},
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MemFileStateContainerRetentionTestSuite) saveDrift(
+	resourceID string,
+	resourceName string,
+	timestamp int,
+) {
+	err := s.container.Resources().SaveDrift(
+		context.Background(),
+		state.ResourceDriftState{
+			ResourceID:   resourceID,
+			ResourceName: resourceName,
+			SpecData:     &core.MappingNode{Fields: map[string]*core.MappingNode{}},
+			Timestamp:    &timestamp,
+		},
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MemFileStateContainerRetentionTestSuite) assertChangesetsRetained(
+	container *StateContainer,
+	changesetIDs []string,
+) {
+	// The two oldest change sets should have been removed.
+	for _, changesetID := range changesetIDs[:2] {
+		_, err := container.Changesets().Get(context.Background(), changesetID)
+		s.Require().Error(err)
+		_, isNotFoundErr := err.(*manage.ChangesetNotFound)
+		s.Assert().True(isNotFoundErr)
+	}
+
+	for _, changesetID := range changesetIDs[2:] {
+		changeset, err := container.Changesets().Get(context.Background(), changesetID)
+		s.Require().NoError(err)
+		s.Assert().Equal(changesetID, changeset.ID)
+	}
+}
+
+func TestMemFileStateContainerRetentionTestSuite(t *testing.T) {
+	suite.Run(t, new(MemFileStateContainerRetentionTestSuite))
+}
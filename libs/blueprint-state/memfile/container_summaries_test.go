@@ -0,0 +1,141 @@
+package memfile
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/suite"
+)
+
+type MemFileStateContainerSummariesTestSuite struct {
+	container state.Container
+	suite.Suite
+}
+
+func (s *MemFileStateContainerSummariesTestSuite) SetupTest() {
+	stateDir := path.Join("__testdata", "initial-state")
+	memoryFS := afero.NewMemMapFs()
+	loadMemoryFS(stateDir, memoryFS, &s.Suite)
+	container, err := LoadStateContainer(stateDir, memoryFS, core.NewNopLogger())
+	s.Require().NoError(err)
+	s.container = container
+
+	err = s.container.Instances().Save(context.Background(), summaryTestInstance())
+	s.Require().NoError(err)
+}
+
+func (s *MemFileStateContainerSummariesTestSuite) Test_retrieves_instance_status_summary() {
+	summary, err := s.container.Summaries().Get(context.Background(), "summary-instance-1")
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		state.InstanceStatusSummary{
+			InstanceID:                 "summary-instance-1",
+			InstanceName:               "summary-app",
+			Status:                     core.InstanceStatusDeployFailed,
+			LastDeployedTimestamp:      1704067200,
+			LastDeployAttemptTimestamp: 1704070800,
+			ResourceCount:              3,
+			ResourceStatusCounts: map[core.ResourceStatus]int{
+				core.ResourceStatusCreated:      2,
+				core.ResourceStatusCreateFailed: 1,
+			},
+			DriftedResourceCount: 1,
+			LinkCount:            2,
+			DriftedLinkCount:     1,
+		},
+		summary,
+	)
+}
+
+func (s *MemFileStateContainerSummariesTestSuite) Test_reports_instance_not_found_for_summary() {
+	_, err := s.container.Summaries().Get(context.Background(), nonExistentInstanceID)
+	s.Require().Error(err)
+	s.Assert().True(state.IsInstanceNotFound(err))
+}
+
+func (s *MemFileStateContainerSummariesTestSuite) Test_lists_instance_status_summaries() {
+	result, err := s.container.Summaries().List(
+		context.Background(),
+		state.ListInstancesParams{
+			Search: "summary",
+		},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(1, result.TotalCount)
+	s.Require().Len(result.Summaries, 1)
+	s.Assert().Equal("summary-instance-1", result.Summaries[0].InstanceID)
+	s.Assert().Equal(3, result.Summaries[0].ResourceCount)
+
+	instancesResult, err := s.container.Instances().List(
+		context.Background(),
+		state.ListInstancesParams{},
+	)
+	s.Require().NoError(err)
+
+	result, err = s.container.Summaries().List(
+		context.Background(),
+		state.ListInstancesParams{},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(instancesResult.TotalCount, result.TotalCount)
+	for i, instance := range instancesResult.Instances {
+		s.Assert().Equal(instance.InstanceID, result.Summaries[i].InstanceID)
+	}
+}
+
+func summaryTestInstance() state.InstanceState {
+	return state.InstanceState{
+		InstanceID:                 "summary-instance-1",
+		InstanceName:               "summary-app",
+		Status:                     core.InstanceStatusDeployFailed,
+		LastDeployedTimestamp:      1704067200,
+		LastDeployAttemptTimestamp: 1704070800,
+		ResourceIDs: map[string]string{
+			"ordersTable":    "summary-resource-1",
+			"ordersFunction": "summary-resource-2",
+			"ordersQueue":    "summary-resource-3",
+		},
+		Resources: map[string]*state.ResourceState{
+			"summary-resource-1": {
+				ResourceID: "summary-resource-1",
+				Name:       "ordersTable",
+				InstanceID: "summary-instance-1",
+				Status:     core.ResourceStatusCreated,
+				Drifted:    true,
+			},
+			"summary-resource-2": {
+				ResourceID: "summary-resource-2",
+				Name:       "ordersFunction",
+				InstanceID: "summary-instance-1",
+				Status:     core.ResourceStatusCreated,
+			},
+			"summary-resource-3": {
+				ResourceID: "summary-resource-3",
+				Name:       "ordersQueue",
+				InstanceID: "summary-instance-1",
+				Status:     core.ResourceStatusCreateFailed,
+			},
+		},
+		Links: map[string]*state.LinkState{
+			"ordersTable::ordersFunction": {
+				LinkID:     "summary-link-1",
+				Name:       "ordersTable::ordersFunction",
+				InstanceID: "summary-instance-1",
+				Drifted:    true,
+			},
+			"ordersQueue::ordersFunction": {
+				LinkID:     "summary-link-2",
+				Name:       "ordersQueue::ordersFunction",
+				InstanceID: "summary-instance-1",
+			},
+		},
+	}
+}
+
+func TestMemFileStateContainerSummariesTestSuite(t *testing.T) {
+	suite.Run(t, new(MemFileStateContainerSummariesTestSuite))
+}
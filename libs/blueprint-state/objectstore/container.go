@@ -25,6 +25,7 @@ type StateContainer struct {
 	childrenContainer              *statestore.ChildrenContainer
 	metadataContainer              *statestore.MetadataContainer
 	exportContainer                *statestore.ExportsContainer
+	summariesContainer             *statestore.SummariesContainer
 	eventsContainer                *statestore.EventsContainer
 	changesetsContainer            *statestore.ChangesetsContainer
 	validationContainer            *statestore.ValidationsContainer
@@ -62,10 +63,20 @@ func WithRecentlyQueuedEventsThreshold(thresholdSeconds int64) Option {
 	}
 }
 
-// WithClock sets the clock used by the events container.
+// WithClock sets the clock used by the events container
+// and for retention policy enforcement.
 func WithClock(clock commoncore.Clock) Option {
 	return func(c *StateContainer) {
 		statestore.WithEventsClock(clock)(c.eventsContainer)
+		statestore.WithRetentionClock(clock)(c.storePersister)
+	}
+}
+
+// WithRetentionPolicy sets the retention policy that is enforced when
+// change sets and drift state are saved. See statestore.WithRetentionPolicy.
+func WithRetentionPolicy(policy manage.RetentionPolicy) Option {
+	return func(c *StateContainer) {
+		statestore.WithRetentionPolicy(policy)(c.storePersister)
 	}
 }
 
@@ -111,6 +122,7 @@ func LoadStateContainer(
 		childrenContainer:              statestore.NewChildrenContainer(storeState, storePersister, logger),
 		metadataContainer:              statestore.NewMetadataContainer(storeState, storePersister, logger),
 		exportContainer:                statestore.NewExportsContainer(storeState, storePersister, logger),
+		summariesContainer:             statestore.NewSummariesContainer(storeState, logger),
 		eventsContainer:                statestore.NewEventsContainer(storeState, storePersister, logger),
 		changesetsContainer:            statestore.NewChangesetsContainer(storeState, storePersister, logger),
 		validationContainer:            statestore.NewValidationsContainer(storeState, storePersister, logger),
@@ -130,6 +142,7 @@ func (c *StateContainer) Links() state.LinksContainer         { return c.linksCo
 func (c *StateContainer) Children() state.ChildrenContainer   { return c.childrenContainer }
 func (c *StateContainer) Metadata() state.MetadataContainer   { return c.metadataContainer }
 func (c *StateContainer) Exports() state.ExportsContainer     { return c.exportContainer }
+func (c *StateContainer) Summaries() state.SummariesContainer { return c.summariesContainer }
 
 func (c *StateContainer) Events() manage.Events         { return c.eventsContainer }
 func (c *StateContainer) Changesets() manage.Changesets { return c.changesetsContainer }
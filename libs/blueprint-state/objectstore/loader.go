@@ -144,6 +144,18 @@ func (l *ServiceLoader) LoadCleanupOperation(
 	return &op, true, nil
 }
 
+func (l *ServiceLoader) LoadDeploymentRuns(
+	ctx context.Context,
+	instanceID string,
+) ([]*state.DeploymentRun, bool, error) {
+	var runs []*state.DeploymentRun
+	found, err := readEntity(ctx, l.svc, l.keys.DeploymentRuns(instanceID), &runs)
+	if err != nil || !found {
+		return nil, found, err
+	}
+	return runs, true, nil
+}
+
 func readEntity(ctx context.Context, svc Service, key string, dst any) (bool, error) {
 	data, _, err := svc.Get(ctx, key)
 	if err != nil {
@@ -10,12 +10,31 @@ func changesetQuery() string {
 			'status', c.status,
 			'blueprintLocation', c.blueprint_location,
 			'changes', c.changes,
-			'created', EXTRACT(EPOCH FROM c.created)::bigint
+			'created', EXTRACT(EPOCH FROM c.created)::bigint,
+			'deployed', EXTRACT(EPOCH FROM c.deployed)::bigint
 		) As changeset_json
 	FROM changesets c
 	WHERE id = @id`
 }
 
+func changesetsByInstanceQuery() string {
+	return `
+	SELECT
+		json_build_object(
+			'id', c.id,
+			'instanceId', c.instance_id,
+			'destroy', c.destroy,
+			'status', c.status,
+			'blueprintLocation', c.blueprint_location,
+			'changes', c.changes,
+			'created', EXTRACT(EPOCH FROM c.created)::bigint,
+			'deployed', EXTRACT(EPOCH FROM c.deployed)::bigint
+		) As changeset_json
+	FROM changesets c
+	WHERE instance_id = @instanceId
+	ORDER BY created DESC, id DESC`
+}
+
 func saveChangesetQuery() string {
 	return `
 		INSERT INTO changesets (
@@ -25,7 +44,8 @@ func saveChangesetQuery() string {
 			"status",
 			blueprint_location,
 			"changes",
-			created
+			created,
+			deployed
 		) VALUES (
 			@id,
 			@instanceId,
@@ -33,11 +53,14 @@ func saveChangesetQuery() string {
 			@status,
 			@blueprintLocation,
 			@changes,
-			@created
+			@created,
+			@deployed
 		)
 		ON CONFLICT (id) DO UPDATE SET
+			instance_id = excluded.instance_id,
 			status = excluded.status,
-			changes = excluded.changes
+			changes = excluded.changes,
+			deployed = excluded.deployed
 	`
 }
 
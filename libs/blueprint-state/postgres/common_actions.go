@@ -42,6 +42,8 @@ func upsertResources(
 			),
 			"durations":     resource.Durations,
 			"removalPolicy": toNullableText(resource.RemovalPolicy),
+			"ignoreChanges": resource.IgnoreChanges,
+			"tainted":       resource.Tainted,
 		}
 		batch.Queue(
 			query,
@@ -96,6 +98,7 @@ func upsertLinks(
 			"resourceDataMappings":       link.ResourceDataMappings,
 			"failureReasons":             sliceOrEmpty(link.FailureReasons),
 			"durations":                  link.Durations,
+			"config":                     link.Config,
 		}
 		batch.Queue(
 			query,
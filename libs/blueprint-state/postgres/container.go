@@ -23,11 +23,13 @@ type StateContainer struct {
 	childrenContainer               *childrenContainerImpl
 	metadataContainer               *metadataContainerImpl
 	exportContainer                 *exportContainerImpl
+	summariesContainer              *summariesContainerImpl
 	validationContainer             *validationContainerImpl
 	changesetsContainer             *changesetsContainerImpl
 	eventsContainer                 *eventsContainerImpl
 	reconciliationResultsContainer  *reconciliationResultsContainerImpl
 	cleanupOperationsContainer      *cleanupOperationsContainerImpl
+	retention                       *retentionEnforcer
 }
 
 // Option is a type for options that can be passed to LoadStateContainer
@@ -36,12 +38,25 @@ type Option func(*StateContainer)
 
 // WithClock sets the clock to use for the state container.
 // This is used in tasks like determining the current time when checking for
-// recently queued events.
+// recently queued events and the age of drift entries for retention policies.
 //
 // When not set, the default value is the system clock.
 func WithClock(clock commoncore.Clock) func(*StateContainer) {
 	return func(c *StateContainer) {
 		c.eventsContainer.clock = clock
+		c.retention.clock = clock
+	}
+}
+
+// WithRetentionPolicy sets the retention policy that is enforced
+// when change sets and drift state are saved.
+// This allows the number of change sets kept for each blueprint instance
+// and the age of drift entries to be bounded without scheduled cleanup jobs.
+//
+// When not set, no retention limits are applied.
+func WithRetentionPolicy(policy manage.RetentionPolicy) func(*StateContainer) {
+	return func(c *StateContainer) {
+		c.retention.policy = policy
 	}
 }
 
@@ -72,14 +87,19 @@ func LoadStateContainer(
 	instancesContainer := &instancesContainerImpl{
 		connPool: connPool,
 	}
+	retention := &retentionEnforcer{
+		clock: &commoncore.SystemClock{},
+	}
 
 	container := &StateContainer{
 		instancesContainer: instancesContainer,
 		resourcesContainer: &resourcesContainerImpl{
-			connPool: connPool,
+			connPool:  connPool,
+			retention: retention,
 		},
 		linksContainer: &linksContainerImpl{
-			connPool: connPool,
+			connPool:  connPool,
+			retention: retention,
 		},
 		childrenContainer: &childrenContainerImpl{
 			connPool:  connPool,
@@ -91,6 +111,10 @@ func LoadStateContainer(
 		exportContainer: &exportContainerImpl{
 			connPool: connPool,
 		},
+		summariesContainer: &summariesContainerImpl{
+			connPool:  connPool,
+			instances: instancesContainer,
+		},
 		eventsContainer: &eventsContainerImpl{
 			connPool:                      connPool,
 			logger:                        logger,
@@ -98,8 +122,9 @@ func LoadStateContainer(
 			recentlyQueuedEventsThreshold: manage.DefaultRecentlyQueuedEventsThreshold,
 		},
 		changesetsContainer: &changesetsContainerImpl{
-			connPool: connPool,
-			logger:   logger,
+			connPool:  connPool,
+			logger:    logger,
+			retention: retention,
 		},
 		validationContainer: &validationContainerImpl{
 			connPool: connPool,
@@ -113,6 +138,7 @@ func LoadStateContainer(
 			connPool: connPool,
 			logger:   logger,
 		},
+		retention: retention,
 	}
 
 	for _, opt := range opts {
@@ -146,6 +172,10 @@ func (c *StateContainer) Exports() state.ExportsContainer {
 	return c.exportContainer
 }
 
+func (c *StateContainer) Summaries() state.SummariesContainer {
+	return c.summariesContainer
+}
+
 func (c *StateContainer) Validation() manage.Validation {
 	return c.validationContainer
 }
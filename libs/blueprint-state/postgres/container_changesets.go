@@ -13,8 +13,9 @@ import (
 )
 
 type changesetsContainerImpl struct {
-	connPool *pgxpool.Pool
-	logger   core.Logger
+	connPool  *pgxpool.Pool
+	logger    core.Logger
+	retention *retentionEnforcer
 }
 
 func (c *changesetsContainerImpl) Get(
@@ -46,6 +47,39 @@ func (c *changesetsContainerImpl) Get(
 	return &changeset, nil
 }
 
+func (c *changesetsContainerImpl) GetAllByInstanceID(
+	ctx context.Context,
+	instanceID string,
+) ([]*manage.Changeset, error) {
+	rows, err := c.connPool.Query(
+		ctx,
+		changesetsByInstanceQuery(),
+		&pgx.NamedArgs{
+			"instanceId": instanceID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changesets := []*manage.Changeset{}
+	for rows.Next() {
+		var changeset manage.Changeset
+		err := rows.Scan(&changeset)
+		if err != nil {
+			return nil, err
+		}
+		changesets = append(changesets, &changeset)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return changesets, nil
+}
+
 func (c *changesetsContainerImpl) Save(
 	ctx context.Context,
 	changeset *manage.Changeset,
@@ -56,7 +90,11 @@ func (c *changesetsContainerImpl) Save(
 		qInfo.sql,
 		qInfo.params,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	return c.retention.enforceRevisionLimit(ctx, c.connPool, changeset.InstanceID)
 }
 
 func (c *changesetsContainerImpl) Cleanup(
@@ -97,5 +135,6 @@ func buildChangesetArgs(changeset *manage.Changeset) *pgx.NamedArgs {
 		"blueprintLocation": changeset.BlueprintLocation,
 		"changes":           changeset.Changes,
 		"created":           toUnixTimestamp(int(changeset.Created)),
+		"deployed":          toNullableTimestamp(int(changeset.Deployed)),
 	}
 }
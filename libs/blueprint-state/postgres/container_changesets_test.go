@@ -69,6 +69,24 @@ func (s *PostgresChangesetsTestSuite) Test_fails_to_retrieve_non_existent_change
 	)
 }
 
+func (s *PostgresChangesetsTestSuite) Test_retrieves_all_changesets_for_instance() {
+	ctx := context.Background()
+	instanceID := "46324ee7-b515-4988-98b0-d5445746a997"
+	changesets, err := s.container.Changesets().GetAllByInstanceID(ctx, instanceID)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(changesets)
+
+	ids := make([]string, len(changesets))
+	for i, changeset := range changesets {
+		s.Assert().Equal(instanceID, changeset.InstanceID)
+		if i > 0 {
+			s.Assert().GreaterOrEqual(changesets[i-1].Created, changeset.Created)
+		}
+		ids[i] = changeset.ID
+	}
+	s.Assert().Contains(ids, existingChangesetID)
+}
+
 func (s *PostgresChangesetsTestSuite) Test_saves_changeset() {
 	fixture := s.saveChangesetFixtures[1]
 
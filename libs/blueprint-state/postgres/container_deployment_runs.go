@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (c *instancesContainerImpl) SaveRun(
+	ctx context.Context,
+	run state.DeploymentRun,
+) error {
+	_, err := c.connPool.Exec(
+		ctx,
+		saveDeploymentRunQuery(),
+		&pgx.NamedArgs{
+			"id":         run.ID,
+			"instanceId": run.InstanceID,
+			"operation":  string(run.Operation),
+			"startedAt":  toUnixTimestamp(int(run.StartedAt)),
+			"run":        &run,
+		},
+	)
+	return err
+}
+
+func (c *instancesContainerImpl) ListRuns(
+	ctx context.Context,
+	instanceID string,
+	params state.ListRunsParams,
+) (state.ListRunsResult, error) {
+	args := &pgx.NamedArgs{
+		"instanceId": instanceID,
+		"operation":  string(params.Operation),
+	}
+
+	var totalCount int
+	err := c.connPool.QueryRow(
+		ctx,
+		listDeploymentRunsCountQuery(string(params.Operation)),
+		args,
+	).Scan(&totalCount)
+	if err != nil {
+		return state.ListRunsResult{}, err
+	}
+
+	rows, err := c.connPool.Query(
+		ctx,
+		listDeploymentRunsQuery(string(params.Operation), params.Limit, params.Offset),
+		args,
+	)
+	if err != nil {
+		return state.ListRunsResult{}, err
+	}
+	defer rows.Close()
+
+	runs := []state.DeploymentRun{}
+	for rows.Next() {
+		var run state.DeploymentRun
+		err := rows.Scan(&run)
+		if err != nil {
+			return state.ListRunsResult{}, err
+		}
+		runs = append(runs, run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return state.ListRunsResult{}, err
+	}
+
+	return state.ListRunsResult{
+		Runs:       runs,
+		TotalCount: totalCount,
+	}, nil
+}
@@ -130,8 +130,9 @@ func scanInstanceSummary(rows pgx.Rows) (state.InstanceSummary, error) {
 	var inst state.InstanceSummary
 	var status core.InstanceStatus
 	var lastDeployedTs *time.Time
+	var expiresAt *time.Time
 
-	err := rows.Scan(&inst.InstanceID, &inst.InstanceName, &status, &lastDeployedTs)
+	err := rows.Scan(&inst.InstanceID, &inst.InstanceName, &status, &lastDeployedTs, &expiresAt)
 	if err != nil {
 		return state.InstanceSummary{}, err
 	}
@@ -140,6 +141,9 @@ func scanInstanceSummary(rows pgx.Rows) (state.InstanceSummary, error) {
 	if lastDeployedTs != nil {
 		inst.LastDeployedTimestamp = lastDeployedTs.Unix()
 	}
+	if expiresAt != nil {
+		inst.ExpiresAt = expiresAt.Unix()
+	}
 
 	return inst, nil
 }
@@ -1022,6 +1026,7 @@ func buildInstanceArgs(instanceState *state.InstanceState) *pgx.NamedArgs {
 		"exports":                    instanceState.Exports,
 		"childDependencies":          instanceState.ChildDependencies,
 		"durations":                  instanceState.Durations,
+		"expiresAt":                  toNullableTimestamp(instanceState.ExpiresAt),
 	}
 }
 
@@ -1070,5 +1075,9 @@ func buildUpdateInstanceStatusArgs(
 		namedArgs["durations"] = statusInfo.Durations
 	}
 
+	if statusInfo.ExpiresAt != nil {
+		namedArgs["expiresAt"] = toNullableTimestamp(*statusInfo.ExpiresAt)
+	}
+
 	return &namedArgs
 }
@@ -650,6 +650,87 @@ func (s *PostgresStateContainerInstancesTestSuite) Test_initialise_and_claim_con
 	s.Assert().Equal(int64(1), savedState.Version)
 }
 
+func (s *PostgresStateContainerInstancesTestSuite) Test_saves_and_lists_deployment_runs() {
+	instances := s.container.Instances()
+	instanceID := uuid.NewString()
+	runIDs := []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+	runs := []state.DeploymentRun{
+		testDeploymentRun(runIDs[0], instanceID, state.DeploymentRunOperationDeploy, 1735787040),
+		testDeploymentRun(runIDs[1], instanceID, state.DeploymentRunOperationDeploy, 1735787050),
+		testDeploymentRun(runIDs[2], instanceID, state.DeploymentRunOperationDestroy, 1735787060),
+	}
+	for _, run := range runs {
+		err := instances.SaveRun(context.Background(), run)
+		s.Require().NoError(err)
+	}
+	// Runs are immutable, saving a run with an existing ID is a no-op.
+	err := instances.SaveRun(
+		context.Background(),
+		testDeploymentRun(runIDs[0], instanceID, state.DeploymentRunOperationDestroy, 1735787070),
+	)
+	s.Require().NoError(err)
+
+	result, err := instances.ListRuns(
+		context.Background(),
+		instanceID,
+		state.ListRunsParams{},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(3, result.TotalCount)
+	s.Require().Len(result.Runs, 3)
+	s.Assert().Equal(runs[2], result.Runs[0])
+	s.Assert().Equal(runs[1], result.Runs[1])
+	s.Assert().Equal(runs[0], result.Runs[2])
+
+	result, err = instances.ListRuns(
+		context.Background(),
+		instanceID,
+		state.ListRunsParams{
+			Operation: state.DeploymentRunOperationDeploy,
+			Limit:     1,
+		},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(2, result.TotalCount)
+	s.Require().Len(result.Runs, 1)
+	s.Assert().Equal(runs[1], result.Runs[0])
+}
+
+func testDeploymentRun(
+	runID string,
+	instanceID string,
+	operation state.DeploymentRunOperation,
+	startedAt int64,
+) state.DeploymentRun {
+	durationMilliseconds := 10000.0
+	return state.DeploymentRun{
+		ID:           runID,
+		InstanceID:   instanceID,
+		InstanceName: "AuditedBlueprintInstance",
+		Operation:    operation,
+		Metadata: &state.DeploymentRunMetadata{
+			InitiatedBy:         "ops-user",
+			ClientVersion:       "0.4.0",
+			EngineVersion:       "0.6.0",
+			BlueprintSourceHash: "5d41402abc4b2a76b9719d911017c592",
+		},
+		Status:               "DEPLOYED",
+		Succeeded:            true,
+		StartedAt:            startedAt,
+		FinishedAt:           startedAt + 10,
+		DurationMilliseconds: &durationMilliseconds,
+		Elements: []*state.DeploymentRunElement{
+			{
+				Type:       state.DeploymentRunElementResource,
+				Name:       "ordersTable",
+				ID:         "resource-1",
+				InstanceID: instanceID,
+				Status:     "CREATED",
+			},
+		},
+	}
+}
+
 func TestPostgresStateContainerInstancesTestSuite(t *testing.T) {
 	suite.Run(t, new(PostgresStateContainerInstancesTestSuite))
 }
@@ -14,7 +14,8 @@ import (
 )
 
 type linksContainerImpl struct {
-	connPool *pgxpool.Pool
+	connPool  *pgxpool.Pool
+	retention *retentionEnforcer
 }
 
 func (c *linksContainerImpl) Get(
@@ -335,6 +336,11 @@ func (c *linksContainerImpl) SaveDrift(
 		return err
 	}
 
+	err = c.retention.pruneExpiredDrift(ctx, tx)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit(ctx)
 }
 
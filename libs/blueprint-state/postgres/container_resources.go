@@ -12,7 +12,8 @@ import (
 )
 
 type resourcesContainerImpl struct {
-	connPool *pgxpool.Pool
+	connPool  *pgxpool.Pool
+	retention *retentionEnforcer
 }
 
 func (c *resourcesContainerImpl) Get(
@@ -222,6 +223,11 @@ func (c *resourcesContainerImpl) SaveDrift(
 		return err
 	}
 
+	err = c.retention.pruneExpiredDrift(ctx, tx)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit(ctx)
 }
 
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	retentionTestInstanceID        = "7c0d4a52-2f3e-4a51-9d0e-3b8f1c6e2a01"
+	retentionTestResourceID        = "7c0d4a52-2f3e-4a51-9d0e-3b8f1c6e2a02"
+	retentionTestResourceName      = "ordersTable"
+	retentionTestOtherResourceID   = "7c0d4a52-2f3e-4a51-9d0e-3b8f1c6e2a03"
+	retentionTestOtherResourceName = "ordersQueue"
+	retentionTestNow               = int64(1750000000)
+	retentionTestMaxDriftAgeDay    = 24 * time.Hour
+)
+
+type PostgresStateContainerRetentionTestSuite struct {
+	container state.Container
+	connPool  *pgxpool.Pool
+	suite.Suite
+}
+
+func (s *PostgresStateContainerRetentionTestSuite) SetupTest() {
+	ctx := context.Background()
+	connPool, err := pgxpool.New(ctx, buildTestDatabaseURL())
+	s.connPool = connPool
+	s.Require().NoError(err)
+	container, err := LoadStateContainer(
+		ctx,
+		connPool,
+		core.NewNopLogger(),
+		WithClock(&internal.MockClock{Timestamp: retentionTestNow}),
+		WithRetentionPolicy(manage.RetentionPolicy{
+			MaxDriftHistoryAge: retentionTestMaxDriftAgeDay,
+		}),
+	)
+	s.Require().NoError(err)
+	s.container = container
+
+	err = s.container.Instances().Save(
+		ctx,
+		state.InstanceState{
+			InstanceID:   retentionTestInstanceID,
+			InstanceName: "RetentionTestInstance",
+			Status:       core.InstanceStatusDeployed,
+		},
+	)
+	s.Require().NoError(err)
+
+	s.saveResource(retentionTestResourceID, retentionTestResourceName)
+	s.saveResource(retentionTestOtherResourceID, retentionTestOtherResourceName)
+}
+
+func (s *PostgresStateContainerRetentionTestSuite) TearDownTest() {
+	ctx := context.Background()
+	_, _ = s.container.Resources().Remove(ctx, retentionTestResourceID)
+	_, _ = s.container.Resources().Remove(ctx, retentionTestOtherResourceID)
+	_, _ = s.container.Instances().Remove(ctx, retentionTestInstanceID)
+	s.connPool.Close()
+}
+
+func (s *PostgresStateContainerRetentionTestSuite) Test_keeps_expired_drift_entry_for_resource_that_is_still_drifted() {
+	expiredTimestamp := int(retentionTestNow - int64((48 * time.Hour).Seconds()))
+	s.saveDrift(retentionTestResourceID, retentionTestResourceName, expiredTimestamp)
+
+	driftState, err := s.container.Resources().GetDrift(
+		context.Background(),
+		retentionTestResourceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(retentionTestResourceID, driftState.ResourceID)
+
+	resource, err := s.container.Resources().Get(
+		context.Background(),
+		retentionTestResourceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().True(resource.Drifted)
+	s.Assert().Equal(expiredTimestamp, *resource.LastDriftDetectedTimestamp)
+}
+
+func (s *PostgresStateContainerRetentionTestSuite) Test_removes_expired_drift_entry_for_resource_that_is_no_longer_drifted() {
+	expiredTimestamp := int(retentionTestNow - int64((48 * time.Hour).Seconds()))
+	s.saveDrift(retentionTestResourceID, retentionTestResourceName, expiredTimestamp)
+	// Saving the resource state without the drifted flag leaves a stale
+	// drift entry behind, which is removed the next time drift is saved.
+	s.saveResource(retentionTestResourceID, retentionTestResourceName)
+
+	recentTimestamp := int(retentionTestNow - 60)
+	s.saveDrift(retentionTestOtherResourceID, retentionTestOtherResourceName, recentTimestamp)
+
+	driftState, err := s.container.Resources().GetDrift(
+		context.Background(),
+		retentionTestResourceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(driftState.ResourceID)
+
+	otherDriftState, err := s.container.Resources().GetDrift(
+		context.Background(),
+		retentionTestOtherResourceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(retentionTestOtherResourceID, otherDriftState.ResourceID)
+}
+
+func (s *PostgresStateContainerRetentionTestSuite) saveResource(
+	resourceID string,
+	resourceName string,
+) {
+	err := s.container.Resources().Save(
+		context.Background(),
+		state.ResourceState{
+			ResourceID: resourceID,
+			Name:       resourceName,
+			Type:       "aws/dynamodb/table",
+			InstanceID: retentionTestInstanceID,
+			SpecData:   &core.MappingNode{Fields: map[string]*core.MappingNode{}},
+		},
+	)
+	s.Require().NoError(err)
+}
+
+func (s *PostgresStateContainerRetentionTestSuite) saveDrift(
+	resourceID string,
+	resourceName string,
+	timestamp int,
+) {
+	err := s.container.Resources().SaveDrift(
+		context.Background(),
+		state.ResourceDriftState{
+			ResourceID:   resourceID,
+			ResourceName: resourceName,
+			SpecData:     &core.MappingNode{Fields: map[string]*core.MappingNode{}},
+			Timestamp:    &timestamp,
+		},
+	)
+	s.Require().NoError(err)
+}
+
+func TestPostgresStateContainerRetentionTestSuite(t *testing.T) {
+	suite.Run(t, new(PostgresStateContainerRetentionTestSuite))
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+type summariesContainerImpl struct {
+	connPool  *pgxpool.Pool
+	instances *instancesContainerImpl
+}
+
+func (c *summariesContainerImpl) Get(
+	ctx context.Context,
+	instanceID string,
+) (state.InstanceStatusSummary, error) {
+	rows, err := c.connPool.Query(
+		ctx,
+		instanceSummaryQuery(),
+		&pgx.NamedArgs{
+			"instanceId": instanceID,
+		},
+	)
+	if err != nil {
+		return state.InstanceStatusSummary{}, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		err = rows.Err()
+		var pgErr *pgconn.PgError
+		if err == nil || (errors.As(err, &pgErr) && isAltNotFoundPostgresErrorCode(pgErr.Code)) {
+			return state.InstanceStatusSummary{}, state.InstanceNotFoundError(instanceID)
+		}
+		return state.InstanceStatusSummary{}, err
+	}
+
+	return scanInstanceStatusSummary(rows)
+}
+
+func (c *summariesContainerImpl) List(
+	ctx context.Context,
+	params state.ListInstancesParams,
+) (state.ListInstanceStatusSummariesResult, error) {
+	totalCount, err := c.instances.getInstanceCount(ctx, params.Search)
+	if err != nil {
+		return state.ListInstanceStatusSummariesResult{}, err
+	}
+
+	rows, err := c.connPool.Query(
+		ctx,
+		listInstanceSummariesQuery(params.Search, params.Limit, params.Offset),
+		buildListQueryArgs(params.Search),
+	)
+	if err != nil {
+		return state.ListInstanceStatusSummariesResult{}, err
+	}
+	defer rows.Close()
+
+	summaries := []state.InstanceStatusSummary{}
+	for rows.Next() {
+		summary, err := scanInstanceStatusSummary(rows)
+		if err != nil {
+			return state.ListInstanceStatusSummariesResult{}, err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return state.ListInstanceStatusSummariesResult{}, err
+	}
+
+	return state.ListInstanceStatusSummariesResult{
+		Summaries:  summaries,
+		TotalCount: totalCount,
+	}, nil
+}
+
+func scanInstanceStatusSummary(rows pgx.Rows) (state.InstanceStatusSummary, error) {
+	var summary state.InstanceStatusSummary
+	var lastStatusUpdateTs, lastDeployedTs, lastDeployAttemptTs *time.Time
+	var statusCounts map[string]int
+
+	err := rows.Scan(
+		&summary.InstanceID,
+		&summary.InstanceName,
+		&summary.Status,
+		&lastStatusUpdateTs,
+		&lastDeployedTs,
+		&lastDeployAttemptTs,
+		&statusCounts,
+		&summary.DriftedResourceCount,
+		&summary.LinkCount,
+		&summary.DriftedLinkCount,
+	)
+	if err != nil {
+		return state.InstanceStatusSummary{}, err
+	}
+
+	summary.LastStatusUpdateTimestamp = unixOrZero(lastStatusUpdateTs)
+	summary.LastDeployedTimestamp = unixOrZero(lastDeployedTs)
+	summary.LastDeployAttemptTimestamp = unixOrZero(lastDeployAttemptTs)
+
+	summary.ResourceStatusCounts = make(map[core.ResourceStatus]int, len(statusCounts))
+	for status, count := range statusCounts {
+		statusValue, err := strconv.Atoi(status)
+		if err != nil {
+			return state.InstanceStatusSummary{}, err
+		}
+		summary.ResourceStatusCounts[core.ResourceStatus(statusValue)] = count
+		summary.ResourceCount += count
+	}
+
+	return summary, nil
+}
+
+func unixOrZero(timestamp *time.Time) int64 {
+	if timestamp == nil {
+		return 0
+	}
+	return timestamp.Unix()
+}
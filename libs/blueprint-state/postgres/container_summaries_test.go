@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type PostgresStateContainerSummariesTestSuite struct {
+	container state.Container
+	connPool  *pgxpool.Pool
+	suite.Suite
+}
+
+func (s *PostgresStateContainerSummariesTestSuite) SetupTest() {
+	ctx := context.Background()
+	connPool, err := pgxpool.New(ctx, buildTestDatabaseURL())
+	s.connPool = connPool
+	s.Require().NoError(err)
+	container, err := LoadStateContainer(ctx, connPool, core.NewNopLogger())
+	s.Require().NoError(err)
+	s.container = container
+}
+
+func (s *PostgresStateContainerSummariesTestSuite) TearDownTest() {
+	s.connPool.Close()
+}
+
+func (s *PostgresStateContainerSummariesTestSuite) Test_retrieves_instance_status_summary() {
+	instance, err := s.container.Instances().Get(context.Background(), getTestRootInstanceID)
+	s.Require().NoError(err)
+
+	summary, err := s.container.Summaries().Get(context.Background(), getTestRootInstanceID)
+	s.Require().NoError(err)
+	s.Assert().Equal(state.SummariseInstance(&instance), summary)
+}
+
+func (s *PostgresStateContainerSummariesTestSuite) Test_reports_instance_not_found_for_summary() {
+	_, err := s.container.Summaries().Get(context.Background(), nonExistentInstanceID)
+	s.Require().Error(err)
+	s.Assert().True(state.IsInstanceNotFound(err))
+}
+
+func (s *PostgresStateContainerSummariesTestSuite) Test_lists_instance_status_summaries() {
+	instancesResult, err := s.container.Instances().List(
+		context.Background(),
+		state.ListInstancesParams{
+			Search: "Instance1",
+			Limit:  5,
+		},
+	)
+	s.Require().NoError(err)
+
+	result, err := s.container.Summaries().List(
+		context.Background(),
+		state.ListInstancesParams{
+			Search: "Instance1",
+			Limit:  5,
+		},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(instancesResult.TotalCount, result.TotalCount)
+	s.Require().Len(result.Summaries, len(instancesResult.Instances))
+	for i, instance := range instancesResult.Instances {
+		s.Assert().Equal(instance.InstanceID, result.Summaries[i].InstanceID)
+		s.Assert().Equal(instance.Status, result.Summaries[i].Status)
+	}
+}
+
+func TestPostgresStateContainerSummariesTestSuite(t *testing.T) {
+	suite.Run(t, new(PostgresStateContainerSummariesTestSuite))
+}
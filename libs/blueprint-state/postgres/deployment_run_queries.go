@@ -0,0 +1,56 @@
+package postgres
+
+import "fmt"
+
+func saveDeploymentRunQuery() string {
+	return `
+	INSERT INTO deployment_runs (
+		id,
+		instance_id,
+		operation,
+		started_at,
+		run
+	) VALUES (
+		@id,
+		@instanceId,
+		@operation,
+		@startedAt,
+		@run
+	)
+	ON CONFLICT (instance_id, id) DO NOTHING`
+}
+
+func listDeploymentRunsCountQuery(operation string) string {
+	query := `
+	SELECT COUNT(*)
+	FROM deployment_runs
+	WHERE instance_id = @instanceId`
+
+	if operation != "" {
+		query += ` AND operation = @operation`
+	}
+
+	return query
+}
+
+func listDeploymentRunsQuery(operation string, limit, offset int) string {
+	query := `
+	SELECT run
+	FROM deployment_runs
+	WHERE instance_id = @instanceId`
+
+	if operation != "" {
+		query += ` AND operation = @operation`
+	}
+
+	query += ` ORDER BY started_at DESC, id DESC`
+
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", offset)
+	}
+
+	return query
+}
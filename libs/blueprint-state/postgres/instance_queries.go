@@ -18,7 +18,8 @@ func upsertInstanceQuery() string {
 		metadata,
 		exports,
 		child_dependencies,
-		durations
+		durations,
+		expires_at
 	) VALUES (
 		@id,
 		@name,
@@ -29,7 +30,8 @@ func upsertInstanceQuery() string {
 		@metadata,
 		@exports,
 		@childDependencies,
-		@durations
+		@durations,
+		@expiresAt
 	) ON CONFLICT (id) DO UPDATE SET
 		"name" = excluded.name,
 	 	status = excluded.status,
@@ -39,7 +41,8 @@ func upsertInstanceQuery() string {
 		metadata = excluded.metadata,
 		exports = excluded.exports,
 		child_dependencies = excluded.child_dependencies,
-		durations = excluded.durations
+		durations = excluded.durations,
+		expires_at = excluded.expires_at
 	`
 }
 
@@ -105,6 +108,7 @@ func blueprintInstanceQuery() string {
 			'exports', bi.exports,
 			'childDependencies', bi.child_dependencies,
 			'durations', bi.durations,
+			'expiresAt', EXTRACT(EPOCH FROM bi.expires_at)::bigint,
 			'version', bi.version
 		) As instance_json
 	FROM
@@ -161,6 +165,7 @@ func blueprintInstanceDescendantsQuery() string {
 			'exports', bi.exports,
 			'childDependencies', bi.child_dependencies,
 			'durations', bi.durations,
+			'expiresAt', EXTRACT(EPOCH FROM bi.expires_at)::bigint,
 			'version', bi.version
 		) AS instance_json
 	FROM descendants d
@@ -188,6 +193,7 @@ func blueprintInstanceChildQuery() string {
 			'exports', bi.exports,
 			'childDependencies', bi.child_dependencies,
 			'durations', bi.durations,
+			'expiresAt', EXTRACT(EPOCH FROM bi.expires_at)::bigint,
 			'version', bi.version
 		) As instance_json
 	FROM
@@ -332,6 +338,11 @@ func updateInstanceStatusQuery(statusInfo *state.InstanceStatusInfo) string {
 		durations = @durations`
 	}
 
+	if statusInfo.ExpiresAt != nil {
+		query += `,
+		expires_at = @expiresAt`
+	}
+
 	query += `
 	WHERE id = @instanceId`
 
@@ -355,7 +366,7 @@ func listInstancesCountQuery(search string) string {
 
 func listInstancesQuery(search string, limit, offset int) string {
 	query := `
-	SELECT id, "name", "status", last_deployed_timestamp
+	SELECT id, "name", "status", last_deployed_timestamp, expires_at
 	FROM blueprint_instances`
 
 	if search != "" {
@@ -391,6 +402,7 @@ func blueprintInstanceBatchQuery() string {
 			'exports', bi.exports,
 			'childDependencies', bi.child_dependencies,
 			'durations', bi.durations,
+			'expiresAt', EXTRACT(EPOCH FROM bi.expires_at)::bigint,
 			'version', bi.version
 		) As instance_json
 	FROM
@@ -480,6 +492,7 @@ func blueprintInstanceBatchDescendantsQuery() string {
 			'exports', bi.exports,
 			'childDependencies', bi.child_dependencies,
 			'durations', bi.durations,
+			'expiresAt', EXTRACT(EPOCH FROM bi.expires_at)::bigint,
 			'version', bi.version
 		) AS instance_json
 	FROM descendants d
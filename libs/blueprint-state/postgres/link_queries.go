@@ -31,7 +31,8 @@ func upsertLinksQuery() string {
 		data,
 		resource_data_mappings,
 		failure_reasons,
-		durations
+		durations,
+		config
 	) VALUES (
 	 	@id,
 		@status,
@@ -43,7 +44,8 @@ func upsertLinksQuery() string {
 		@data,
 		@resourceDataMappings,
 		@failureReasons,
-		@durations
+		@durations,
+		@config
 	) ON CONFLICT (id) DO UPDATE SET
 		status = excluded.status,
 		precise_status = excluded.precise_status,
@@ -54,7 +56,8 @@ func upsertLinksQuery() string {
 		data = excluded.data,
 		resource_data_mappings = excluded.resource_data_mappings,
 		failure_reasons = excluded.failure_reasons,
-		durations = excluded.durations
+		durations = excluded.durations,
+		config = excluded.config
 	`
 }
 
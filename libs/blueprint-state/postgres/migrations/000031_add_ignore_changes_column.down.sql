@@ -0,0 +1 @@
+ALTER TABLE resources DROP COLUMN IF EXISTS ignore_changes;
@@ -0,0 +1 @@
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS ignore_changes jsonb;
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS links
+    DROP COLUMN IF EXISTS config;
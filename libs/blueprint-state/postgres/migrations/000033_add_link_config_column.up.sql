@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS links
+  ADD COLUMN IF NOT EXISTS config jsonb;
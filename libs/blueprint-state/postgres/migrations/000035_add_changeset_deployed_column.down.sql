@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS changesets
+    DROP COLUMN IF EXISTS deployed;
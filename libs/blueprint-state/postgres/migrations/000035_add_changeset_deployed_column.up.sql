@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS changesets
+  ADD COLUMN IF NOT EXISTS deployed timestamptz;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_deployment_runs_instance_started;
+DROP TABLE IF EXISTS deployment_runs;
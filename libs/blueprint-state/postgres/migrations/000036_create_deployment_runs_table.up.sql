@@ -0,0 +1,15 @@
+-- Deployment runs are an audit trail of deploy, destroy and reconcile operations
+-- and are retained after a blueprint instance has been removed,
+-- so there is no foreign key to the blueprint_instances table.
+CREATE TABLE IF NOT EXISTS deployment_runs (
+    id varchar(255) NOT NULL,
+    instance_id uuid NOT NULL,
+    operation varchar(32) NOT NULL,
+    started_at timestamptz NOT NULL,
+    run jsonb NOT NULL,
+    PRIMARY KEY (instance_id, id)
+);
+
+-- Composite index for listing runs for an instance (ordered by started_at desc)
+CREATE INDEX IF NOT EXISTS idx_deployment_runs_instance_started
+    ON deployment_runs (instance_id, started_at DESC);
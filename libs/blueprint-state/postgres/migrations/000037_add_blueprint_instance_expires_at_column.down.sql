@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_blueprint_instances_expires_at;
+
+ALTER TABLE IF EXISTS blueprint_instances
+    DROP COLUMN IF EXISTS expires_at;
@@ -0,0 +1,7 @@
+ALTER TABLE IF EXISTS blueprint_instances
+  ADD COLUMN IF NOT EXISTS expires_at timestamptz;
+
+-- blueprint_instances.expires_at - for finding expired ephemeral instances
+CREATE INDEX IF NOT EXISTS idx_blueprint_instances_expires_at
+    ON blueprint_instances (expires_at ASC)
+    WHERE expires_at IS NOT NULL;
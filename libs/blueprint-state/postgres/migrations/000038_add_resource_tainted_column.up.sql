@@ -0,0 +1 @@
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS tainted boolean NOT NULL DEFAULT false;
@@ -34,7 +34,9 @@ func upsertResourcesQuery() string {
 		drifted,
 		last_drift_detected_timestamp,
 		durations,
-		removal_policy
+		removal_policy,
+		ignore_changes,
+		tainted
 	) VALUES (
 	 	@id,
 		@type,
@@ -55,7 +57,9 @@ func upsertResourcesQuery() string {
 		@drifted,
 		@lastDriftDetectedTimestamp,
 		@durations,
-		@removalPolicy
+		@removalPolicy,
+		@ignoreChanges,
+		@tainted
 	) ON CONFLICT (id) DO UPDATE SET
 		type = excluded.type,
 		template_name = excluded.template_name,
@@ -75,7 +79,9 @@ func upsertResourcesQuery() string {
 		drifted = excluded.drifted,
 		last_drift_detected_timestamp = excluded.last_drift_detected_timestamp,
 		durations = excluded.durations,
-		removal_policy = excluded.removal_policy
+		removal_policy = excluded.removal_policy,
+		ignore_changes = excluded.ignore_changes,
+		tainted = excluded.tainted
 	`
 }
 
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	commoncore "github.com/newstack-cloud/bluelink/libs/common/core"
+)
+
+// retentionEnforcer applies the retention policy configured for the
+// state container, it is shared between the containers that save
+// change sets and drift state.
+type retentionEnforcer struct {
+	policy manage.RetentionPolicy
+	clock  commoncore.Clock
+}
+
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+// enforceRevisionLimit removes the oldest change sets for the given
+// instance that exceed the maximum number of revisions in the retention policy.
+func (r *retentionEnforcer) enforceRevisionLimit(
+	ctx context.Context,
+	conn execer,
+	instanceID string,
+) error {
+	if !r.policy.LimitsRevisions() || instanceID == "" {
+		return nil
+	}
+
+	_, err := conn.Exec(
+		ctx,
+		pruneInstanceChangesetsQuery(),
+		pgx.NamedArgs{
+			"instanceId":   instanceID,
+			"maxRevisions": r.policy.MaxRevisionsPerInstance,
+		},
+	)
+	return err
+}
+
+// pruneExpiredDrift removes resource and link drift entries that are older
+// than the maximum drift history age in the retention policy.
+// Drift entries for resources and links that are still marked as drifted
+// are the current drift state and are never removed.
+func (r *retentionEnforcer) pruneExpiredDrift(
+	ctx context.Context,
+	conn execer,
+) error {
+	if !r.policy.LimitsDriftHistory() {
+		return nil
+	}
+
+	args := pgx.NamedArgs{
+		"expiresBefore": r.policy.DriftExpiryThreshold(r.clock.Now()),
+	}
+	_, err := conn.Exec(ctx, pruneExpiredResourceDriftQuery(), args)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, pruneExpiredLinkDriftQuery(), args)
+	return err
+}
@@ -0,0 +1,38 @@
+package postgres
+
+func pruneInstanceChangesetsQuery() string {
+	return `
+	DELETE FROM changesets
+	WHERE instance_id = @instanceId
+		AND id NOT IN (
+			SELECT id FROM changesets
+			WHERE instance_id = @instanceId
+			ORDER BY created DESC, id DESC
+			LIMIT @maxRevisions
+		)
+	`
+}
+
+func pruneExpiredResourceDriftQuery() string {
+	return `
+	DELETE FROM resource_drift
+	WHERE "timestamp" < @expiresBefore
+		AND NOT EXISTS (
+			SELECT 1 FROM resources
+			WHERE resources.id = resource_drift.resource_id
+				AND resources.drifted
+		)
+	`
+}
+
+func pruneExpiredLinkDriftQuery() string {
+	return `
+	DELETE FROM link_drift
+	WHERE "timestamp" < @expiresBefore
+		AND NOT EXISTS (
+			SELECT 1 FROM links
+			WHERE links.id = link_drift.link_id
+				AND links.drifted
+		)
+	`
+}
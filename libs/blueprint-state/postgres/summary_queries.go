@@ -0,0 +1,71 @@
+package postgres
+
+import "fmt"
+
+// instanceSummarySelectQuery selects status summaries for blueprint instances
+// with resource and link counts aggregated in the database so that
+// the full state of resources and links does not need to be loaded.
+func instanceSummarySelectQuery() string {
+	return `
+	SELECT
+		bi.id,
+		bi."name",
+		bi."status",
+		bi.last_status_update_timestamp,
+		bi.last_deployed_timestamp,
+		bi.last_deploy_attempt_timestamp,
+		COALESCE(rc.resource_status_counts, '{}'::jsonb),
+		COALESCE(rc.drifted_resource_count, 0),
+		COALESCE(lc.link_count, 0),
+		COALESCE(lc.drifted_link_count, 0)
+	FROM blueprint_instances bi
+	LEFT JOIN LATERAL (
+		SELECT
+			jsonb_object_agg(sc."status", sc.resource_count) AS resource_status_counts,
+			SUM(sc.drifted_count)::int AS drifted_resource_count
+		FROM (
+			SELECT
+				r."status",
+				COUNT(*) AS resource_count,
+				COUNT(*) FILTER (WHERE r.drifted) AS drifted_count
+			FROM blueprint_instance_resources bir
+			JOIN resources r ON r.id = bir.resource_id
+			WHERE bir.instance_id = bi.id
+			GROUP BY r."status"
+		) sc
+	) rc ON true
+	LEFT JOIN LATERAL (
+		SELECT
+			COUNT(*)::int AS link_count,
+			(COUNT(*) FILTER (WHERE l.drifted))::int AS drifted_link_count
+		FROM blueprint_instance_links bil
+		JOIN links l ON l.id = bil.link_id
+		WHERE bil.instance_id = bi.id
+	) lc ON true`
+}
+
+func instanceSummaryQuery() string {
+	return instanceSummarySelectQuery() + `
+	WHERE bi.id = @instanceId`
+}
+
+func listInstanceSummariesQuery(search string, limit, offset int) string {
+	query := instanceSummarySelectQuery()
+
+	if search != "" {
+		query += `
+	WHERE bi."name" ILIKE @searchPattern`
+	}
+
+	query += `
+	ORDER BY bi."name" ASC`
+
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", offset)
+	}
+
+	return query
+}
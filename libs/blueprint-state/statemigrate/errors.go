@@ -0,0 +1,27 @@
+package statemigrate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VerificationError is an error type that indicates the state
+// in the target container does not match the state in the source container
+// after a migration has completed.
+// An error can be checked against this type using:
+//
+//	var verificationErr *statemigrate.VerificationError
+//	if errors.As(err, &verificationErr) {
+//		// Handle the error
+//	}
+type VerificationError struct {
+	Mismatches []string
+}
+
+func (e *VerificationError) Error() string {
+	return fmt.Sprintf(
+		"state migration verification failed with %d mismatch(es):\n  - %s",
+		len(e.Mismatches),
+		strings.Join(e.Mismatches, "\n  - "),
+	)
+}
@@ -0,0 +1,338 @@
+package statemigrate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+const (
+	// DefaultBatchSize is the default number of top-level blueprint instances
+	// that are loaded from the source and saved to the target in a single batch.
+	DefaultBatchSize = 50
+)
+
+// Migrator copies blueprint instance state from one `state.Container`
+// implementation to another.
+// This is useful for moving between storage backends,
+// for example, moving from the memfile (in-memory with file persistence)
+// backend to the postgres backend as a team outgrows a single file system.
+type Migrator struct {
+	source    state.Container
+	target    state.Container
+	batchSize int
+	verify    bool
+	logger    core.Logger
+}
+
+// Option is a type for options that can be passed to NewMigrator.
+type Option func(*Migrator)
+
+// WithBatchSize sets the number of top-level blueprint instances to load
+// from the source and save to the target at a time.
+//
+// When not set, the default value is 50.
+func WithBatchSize(batchSize int) Option {
+	return func(m *Migrator) {
+		if batchSize > 0 {
+			m.batchSize = batchSize
+		}
+	}
+}
+
+// WithVerification determines whether the migrated state should be read back
+// from the target and compared with the source once all instances have been copied.
+//
+// When not set, verification is enabled.
+func WithVerification(verify bool) Option {
+	return func(m *Migrator) {
+		m.verify = verify
+	}
+}
+
+// WithLogger sets the logger to use for the migrator.
+//
+// When not set, a no-op logger is used.
+func WithLogger(logger core.Logger) Option {
+	return func(m *Migrator) {
+		m.logger = logger
+	}
+}
+
+// NewMigrator creates a new migrator that copies state from the source container
+// to the target container.
+func NewMigrator(source state.Container, target state.Container, opts ...Option) *Migrator {
+	migrator := &Migrator{
+		source:    source,
+		target:    target,
+		batchSize: DefaultBatchSize,
+		verify:    true,
+		logger:    core.NewNopLogger(),
+	}
+
+	for _, opt := range opts {
+		opt(migrator)
+	}
+
+	return migrator
+}
+
+// Result holds a summary of a completed state migration.
+type Result struct {
+	// Instances is the number of blueprint instances that were copied,
+	// this includes child blueprint instances.
+	Instances int `json:"instances"`
+	// Resources is the number of resources that were copied.
+	Resources int `json:"resources"`
+	// Links is the number of links that were copied.
+	Links int `json:"links"`
+	// ResourceDrift is the number of resource drift entries that were copied.
+	ResourceDrift int `json:"resourceDrift"`
+	// LinkDrift is the number of link drift entries that were copied.
+	LinkDrift int `json:"linkDrift"`
+	// Changesets is the number of change sets that were copied
+	// as a part of the revision history of blueprint instances.
+	Changesets int `json:"changesets"`
+	// Verified is true when the target state was read back
+	// and compared with the source state.
+	Verified bool `json:"verified"`
+}
+
+// Migrate copies all blueprint instances along with their resources, links,
+// child blueprints, metadata, exports and drift state from the source
+// container to the target container.
+// Instance versions are carried over as they are in the source so that
+// optimistic concurrency checks continue to work as expected against the target.
+//
+// When both containers implement RevisionHistoryContainer, the change sets
+// for each instance are also copied, these make up the revision history
+// that resource history is derived from.
+// Events and blueprint validations are not migrated, these are short-lived records
+// for streaming progress that are only retained for a limited period
+// and can not be listed through the manage.Events interface.
+// Change sets that are not associated with an instance are also not migrated.
+// The target applies its own revision limit as change sets are saved,
+// verification will report missing change sets if the target keeps fewer
+// revisions per instance than the source.
+//
+// When verification is enabled, a *VerificationError is returned if the target
+// state does not match the source state once the copy has completed.
+func (m *Migrator) Migrate(ctx context.Context) (*Result, error) {
+	instanceIDs, err := m.listSourceInstanceIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	copied := map[string]bool{}
+	for start := 0; start < len(instanceIDs); start += m.batchSize {
+		end := min(start+m.batchSize, len(instanceIDs))
+		err := m.migrateBatch(ctx, instanceIDs[start:end], copied, result)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	copiedIDs := sortedKeys(copied)
+	err = m.migrateRevisionHistory(ctx, copiedIDs, result)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.verify {
+		return result, nil
+	}
+
+	mismatches, err := verify(ctx, m.source, m.target, instanceIDs)
+	if err != nil {
+		return nil, err
+	}
+	historyMismatches, err := m.verifyRevisionHistory(ctx, copiedIDs)
+	if err != nil {
+		return nil, err
+	}
+	mismatches = slices.Concat(mismatches, historyMismatches)
+	if len(mismatches) > 0 {
+		return nil, &VerificationError{Mismatches: mismatches}
+	}
+	result.Verified = true
+
+	return result, nil
+}
+
+func (m *Migrator) listSourceInstanceIDs(ctx context.Context) ([]string, error) {
+	listResult, err := m.source.Instances().List(ctx, state.ListInstancesParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances in source state: %w", err)
+	}
+
+	instanceIDs := make([]string, 0, len(listResult.Instances))
+	for _, summary := range listResult.Instances {
+		instanceIDs = append(instanceIDs, summary.InstanceID)
+	}
+
+	return instanceIDs, nil
+}
+
+func (m *Migrator) migrateBatch(
+	ctx context.Context,
+	instanceIDs []string,
+	copied map[string]bool,
+	result *Result,
+) error {
+	instances, err := m.source.Instances().GetBatch(ctx, instanceIDs)
+	if err != nil {
+		return fmt.Errorf("failed to load instances from source state: %w", err)
+	}
+
+	err = m.target.Instances().SaveBatch(ctx, instances)
+	if err != nil {
+		return fmt.Errorf("failed to save instances to target state: %w", err)
+	}
+
+	for i := range instances {
+		err := m.migrateDrift(ctx, &instances[i], copied, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.logger.Debug(
+		"migrated batch of blueprint instances",
+		core.IntegerLogField("batchSize", int64(len(instances))),
+	)
+
+	return nil
+}
+
+// migrateDrift copies drift state for the resources and links of the given
+// instance and its descendants, keeping track of instances that have already
+// been visited as child blueprint instances are also listed
+// as top-level instances in some backends.
+func (m *Migrator) migrateDrift(
+	ctx context.Context,
+	instance *state.InstanceState,
+	copied map[string]bool,
+	result *Result,
+) error {
+	if instance == nil || copied[instance.InstanceID] {
+		return nil
+	}
+	copied[instance.InstanceID] = true
+	result.Instances += 1
+	result.Resources += len(instance.Resources)
+	result.Links += len(instance.Links)
+
+	for _, resource := range instance.Resources {
+		// Not all backends index the resources of an instance when the instance
+		// is saved, so resources are saved individually to make sure drift
+		// can be attached to them in the target state.
+		err := m.target.Resources().Save(ctx, *resource)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to save resource %q to target state: %w",
+				resource.ResourceID,
+				err,
+			)
+		}
+
+		copiedDrift, err := m.migrateResourceDrift(ctx, resource.ResourceID)
+		if err != nil {
+			return err
+		}
+		if copiedDrift {
+			result.ResourceDrift += 1
+		}
+	}
+
+	for _, link := range instance.Links {
+		err := m.target.Links().Save(ctx, *link)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to save link %q to target state: %w",
+				link.LinkID,
+				err,
+			)
+		}
+
+		copiedDrift, err := m.migrateLinkDrift(ctx, link.LinkID)
+		if err != nil {
+			return err
+		}
+		if copiedDrift {
+			result.LinkDrift += 1
+		}
+	}
+
+	for _, child := range instance.ChildBlueprints {
+		err := m.migrateDrift(ctx, child, copied, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Migrator) migrateResourceDrift(ctx context.Context, resourceID string) (bool, error) {
+	drift, err := m.source.Resources().GetDrift(ctx, resourceID)
+	if err != nil {
+		if state.IsResourceNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf(
+			"failed to load drift for resource %q from source state: %w",
+			resourceID,
+			err,
+		)
+	}
+
+	// An empty drift state is returned for resources that have not drifted.
+	if drift.ResourceID == "" {
+		return false, nil
+	}
+
+	err = m.target.Resources().SaveDrift(ctx, drift)
+	if err != nil {
+		return false, fmt.Errorf(
+			"failed to save drift for resource %q to target state: %w",
+			resourceID,
+			err,
+		)
+	}
+
+	return true, nil
+}
+
+func (m *Migrator) migrateLinkDrift(ctx context.Context, linkID string) (bool, error) {
+	drift, err := m.source.Links().GetDrift(ctx, linkID)
+	if err != nil {
+		if state.IsLinkNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf(
+			"failed to load drift for link %q from source state: %w",
+			linkID,
+			err,
+		)
+	}
+
+	// An empty drift state is returned for links that have not drifted.
+	if drift.LinkID == "" {
+		return false, nil
+	}
+
+	err = m.target.Links().SaveDrift(ctx, drift)
+	if err != nil {
+		return false, fmt.Errorf(
+			"failed to save drift for link %q to target state: %w",
+			linkID,
+			err,
+		)
+	}
+
+	return true, nil
+}
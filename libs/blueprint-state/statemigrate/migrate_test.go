@@ -0,0 +1,161 @@
+package statemigrate
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/memfile"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testRevisionHistoryInstanceID = "blueprint-instance-1"
+)
+
+type MigratorTestSuite struct {
+	source state.Container
+	target state.Container
+	suite.Suite
+}
+
+func (s *MigratorTestSuite) SetupTest() {
+	// Reuse the memfile seed state as the source, writes are never made
+	// to the source but a copy-on-write layer makes sure the seed state
+	// on disk can not be modified by the migrator.
+	sourceDir := path.Join("..", "memfile", "__testdata", "initial-state")
+	sourceFS := afero.NewCopyOnWriteFs(
+		afero.NewReadOnlyFs(afero.NewOsFs()),
+		afero.NewMemMapFs(),
+	)
+	source, err := memfile.LoadStateContainer(sourceDir, sourceFS, core.NewNopLogger())
+	s.Require().NoError(err)
+	s.source = source
+	s.saveSourceRevisionHistory()
+
+	targetDir := "target-state"
+	targetFS := afero.NewMemMapFs()
+	s.Require().NoError(targetFS.MkdirAll(targetDir, 0755))
+	target, err := memfile.LoadStateContainer(targetDir, targetFS, core.NewNopLogger())
+	s.Require().NoError(err)
+	s.target = target
+}
+
+func (s *MigratorTestSuite) Test_migrates_all_instances_with_drift_and_verifies() {
+	ctx := context.Background()
+	migrator := NewMigrator(s.source, s.target, WithBatchSize(1))
+
+	result, err := migrator.Migrate(ctx)
+	s.Require().NoError(err)
+	s.Assert().True(result.Verified)
+
+	sourceInstances, err := s.source.Instances().List(ctx, state.ListInstancesParams{})
+	s.Require().NoError(err)
+	targetInstances, err := s.target.Instances().List(ctx, state.ListInstancesParams{})
+	s.Require().NoError(err)
+	s.Assert().Equal(sourceInstances.TotalCount, targetInstances.TotalCount)
+	s.Assert().Greater(result.ResourceDrift, 0)
+	s.Assert().Greater(result.LinkDrift, 0)
+	s.Assert().Equal(2, result.Changesets)
+}
+
+func (s *MigratorTestSuite) Test_migrates_revision_history_for_instances() {
+	ctx := context.Background()
+	_, err := NewMigrator(s.source, s.target).Migrate(ctx)
+	s.Require().NoError(err)
+
+	sourceChangesets, err := s.source.(RevisionHistoryContainer).Changesets().
+		GetAllByInstanceID(ctx, testRevisionHistoryInstanceID)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(sourceChangesets)
+
+	targetChangesets, err := s.target.(RevisionHistoryContainer).Changesets().
+		GetAllByInstanceID(ctx, testRevisionHistoryInstanceID)
+	s.Require().NoError(err)
+	s.Assert().Equal(sourceChangesets, targetChangesets)
+}
+
+func (s *MigratorTestSuite) Test_reports_verification_error_for_missing_change_sets() {
+	ctx := context.Background()
+	migrator := NewMigrator(s.source, s.target, WithVerification(false))
+	_, err := migrator.Migrate(ctx)
+	s.Require().NoError(err)
+
+	_, err = s.target.(RevisionHistoryContainer).Changesets().Cleanup(
+		ctx,
+		time.Now().Add(time.Hour),
+	)
+	s.Require().NoError(err)
+
+	mismatches, err := migrator.verifyRevisionHistory(
+		ctx,
+		[]string{testRevisionHistoryInstanceID},
+	)
+	s.Require().NoError(err)
+	s.Assert().Len(mismatches, 2)
+	s.Assert().Contains(
+		mismatches[0],
+		"instance \""+testRevisionHistoryInstanceID+"\" change set",
+	)
+}
+
+func (s *MigratorTestSuite) Test_reports_verification_error_for_diverging_target() {
+	ctx := context.Background()
+	_, err := NewMigrator(s.source, s.target, WithVerification(false)).Migrate(ctx)
+	s.Require().NoError(err)
+
+	sourceInstances, err := s.source.Instances().List(ctx, state.ListInstancesParams{})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(sourceInstances.Instances)
+
+	removedID := sourceInstances.Instances[0].InstanceID
+	_, err = s.target.Instances().Remove(ctx, removedID)
+	s.Require().NoError(err)
+
+	mismatches, err := verify(ctx, s.source, s.target, []string{removedID})
+	s.Require().NoError(err)
+	s.Assert().Contains(mismatches, "instance \""+removedID+"\" is missing from the target state")
+}
+
+// The change sets in the memfile seed state are not associated with
+// any of the seeded instances, so revision history is saved to the
+// copy-on-write layer of the source for the tests.
+func (s *MigratorTestSuite) saveSourceRevisionHistory() {
+	changesets := []*manage.Changeset{
+		{
+			ID:                "revision-1",
+			InstanceID:        testRevisionHistoryInstanceID,
+			Status:            manage.ChangesetStatusChangesStaged,
+			BlueprintLocation: "project.blueprint.yml",
+			Changes:           &changes.BlueprintChanges{},
+			Created:           1743411600,
+			Deployed:          1743411700,
+		},
+		{
+			ID:                "revision-2",
+			InstanceID:        testRevisionHistoryInstanceID,
+			Status:            manage.ChangesetStatusChangesStaged,
+			BlueprintLocation: "project.blueprint.yml",
+			Changes:           &changes.BlueprintChanges{},
+			Created:           1743498000,
+		},
+	}
+
+	for _, changeset := range changesets {
+		err := s.source.(RevisionHistoryContainer).Changesets().Save(
+			context.Background(),
+			changeset,
+		)
+		s.Require().NoError(err)
+	}
+}
+
+func TestMigratorTestSuite(t *testing.T) {
+	suite.Run(t, new(MigratorTestSuite))
+}
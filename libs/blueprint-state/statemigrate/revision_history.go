@@ -0,0 +1,148 @@
+package statemigrate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+)
+
+// RevisionHistoryContainer is implemented by state containers that also
+// manage the revision history of blueprint instances.
+// All of the state backends in this module implement this interface,
+// the revision history is only migrated when both the source and target
+// containers implement it.
+type RevisionHistoryContainer interface {
+	// Changesets returns the service that manages change sets,
+	// deployed change sets make up the revision history of a blueprint instance
+	// and are used to reconstruct the history of resources.
+	Changesets() manage.Changesets
+}
+
+func (m *Migrator) revisionHistoryContainers() (RevisionHistoryContainer, RevisionHistoryContainer, bool) {
+	sourceHistory, sourceHasHistory := m.source.(RevisionHistoryContainer)
+	targetHistory, targetHasHistory := m.target.(RevisionHistoryContainer)
+	return sourceHistory, targetHistory, sourceHasHistory && targetHasHistory
+}
+
+// migrateRevisionHistory copies the change sets for each of the provided
+// instances from the source to the target in the order they were created
+// so the target ends up with the same revision history as the source.
+func (m *Migrator) migrateRevisionHistory(
+	ctx context.Context,
+	instanceIDs []string,
+	result *Result,
+) error {
+	sourceHistory, targetHistory, hasHistory := m.revisionHistoryContainers()
+	if !hasHistory {
+		m.logger.Debug(
+			"skipping revision history migration as the source or target state " +
+				"does not manage revision history",
+		)
+		return nil
+	}
+
+	for _, instanceID := range instanceIDs {
+		changesets, err := sourceHistory.Changesets().GetAllByInstanceID(ctx, instanceID)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to load change sets for instance %q from source state: %w",
+				instanceID,
+				err,
+			)
+		}
+
+		// Change sets are returned newest first, they are saved oldest first
+		// so that revision limits in the target drop the oldest revisions.
+		for _, changeset := range slices.Backward(changesets) {
+			err := targetHistory.Changesets().Save(ctx, changeset)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to save change set %q to target state: %w",
+					changeset.ID,
+					err,
+				)
+			}
+			result.Changesets += 1
+		}
+	}
+
+	return nil
+}
+
+// verifyRevisionHistory compares the change sets for each of the provided
+// instances in the target with those in the source.
+func (m *Migrator) verifyRevisionHistory(
+	ctx context.Context,
+	instanceIDs []string,
+) ([]string, error) {
+	sourceHistory, targetHistory, hasHistory := m.revisionHistoryContainers()
+	if !hasHistory {
+		return nil, nil
+	}
+
+	mismatches := []string{}
+	for _, instanceID := range instanceIDs {
+		sourceChangesets, err := sourceHistory.Changesets().GetAllByInstanceID(ctx, instanceID)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to load change sets for instance %q from source state for verification: %w",
+				instanceID,
+				err,
+			)
+		}
+
+		targetChangesets, err := targetHistory.Changesets().GetAllByInstanceID(ctx, instanceID)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to load change sets for instance %q from target state for verification: %w",
+				instanceID,
+				err,
+			)
+		}
+
+		mismatches = append(
+			mismatches,
+			compareChangesets(instanceID, sourceChangesets, targetChangesets)...,
+		)
+	}
+
+	return mismatches, nil
+}
+
+func compareChangesets(
+	instanceID string,
+	sourceChangesets []*manage.Changeset,
+	targetChangesets []*manage.Changeset,
+) []string {
+	targetLookup := make(map[string]*manage.Changeset, len(targetChangesets))
+	for _, changeset := range targetChangesets {
+		targetLookup[changeset.ID] = changeset
+	}
+
+	mismatches := []string{}
+	for _, sourceChangeset := range sourceChangesets {
+		targetChangeset, ok := targetLookup[sourceChangeset.ID]
+		if !ok {
+			mismatches = append(mismatches, fmt.Sprintf(
+				"instance %q change set %q is missing from the target state",
+				instanceID,
+				sourceChangeset.ID,
+			))
+			continue
+		}
+
+		if sourceChangeset.Status != targetChangeset.Status ||
+			sourceChangeset.Created != targetChangeset.Created ||
+			sourceChangeset.Deployed != targetChangeset.Deployed {
+			mismatches = append(mismatches, fmt.Sprintf(
+				"instance %q change set %q does not match the source",
+				instanceID,
+				sourceChangeset.ID,
+			))
+		}
+	}
+
+	return mismatches
+}
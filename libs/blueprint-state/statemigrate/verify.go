@@ -0,0 +1,270 @@
+package statemigrate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// verify reads back each of the provided instances from the target
+// and compares them with the source, returning a list of human-readable
+// descriptions of any differences found.
+func verify(
+	ctx context.Context,
+	source state.Container,
+	target state.Container,
+	instanceIDs []string,
+) ([]string, error) {
+	mismatches := []string{}
+	visited := map[string]bool{}
+	for _, instanceID := range instanceIDs {
+		sourceInstance, err := source.Instances().Get(ctx, instanceID)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to load instance %q from source state for verification: %w",
+				instanceID,
+				err,
+			)
+		}
+
+		targetInstance, err := target.Instances().Get(ctx, instanceID)
+		if err != nil {
+			if state.IsInstanceNotFound(err) {
+				mismatches = append(
+					mismatches,
+					fmt.Sprintf("instance %q is missing from the target state", instanceID),
+				)
+				continue
+			}
+			return nil, fmt.Errorf(
+				"failed to load instance %q from target state for verification: %w",
+				instanceID,
+				err,
+			)
+		}
+
+		instanceMismatches, err := compareInstances(
+			ctx,
+			source,
+			target,
+			&sourceInstance,
+			&targetInstance,
+			visited,
+		)
+		if err != nil {
+			return nil, err
+		}
+		mismatches = append(mismatches, instanceMismatches...)
+	}
+
+	return mismatches, nil
+}
+
+func compareInstances(
+	ctx context.Context,
+	source state.Container,
+	target state.Container,
+	sourceInstance *state.InstanceState,
+	targetInstance *state.InstanceState,
+	visited map[string]bool,
+) ([]string, error) {
+	if visited[sourceInstance.InstanceID] {
+		return nil, nil
+	}
+	visited[sourceInstance.InstanceID] = true
+
+	instanceID := sourceInstance.InstanceID
+	mismatches := []string{}
+	if sourceInstance.InstanceName != targetInstance.InstanceName {
+		mismatches = append(mismatches, fmt.Sprintf(
+			"instance %q name mismatch: expected %q, found %q",
+			instanceID,
+			sourceInstance.InstanceName,
+			targetInstance.InstanceName,
+		))
+	}
+	if sourceInstance.Status != targetInstance.Status {
+		mismatches = append(mismatches, fmt.Sprintf(
+			"instance %q status mismatch: expected %s, found %s",
+			instanceID,
+			sourceInstance.Status.String(),
+			targetInstance.Status.String(),
+		))
+	}
+
+	mismatches = append(
+		mismatches,
+		compareKeys(instanceID, "resource", sourceInstance.Resources, targetInstance.Resources)...,
+	)
+	mismatches = append(
+		mismatches,
+		compareKeys(instanceID, "link", sourceInstance.Links, targetInstance.Links)...,
+	)
+	mismatches = append(
+		mismatches,
+		compareKeys(instanceID, "export", sourceInstance.Exports, targetInstance.Exports)...,
+	)
+	mismatches = append(
+		mismatches,
+		compareKeys(instanceID, "child blueprint", sourceInstance.ChildBlueprints, targetInstance.ChildBlueprints)...,
+	)
+
+	for resourceName, sourceResource := range sourceInstance.Resources {
+		targetResource, ok := targetInstance.Resources[resourceName]
+		if !ok || targetResource == nil || sourceResource == nil {
+			continue
+		}
+		if !core.MappingNodeEqual(sourceResource.SpecData, targetResource.SpecData) {
+			mismatches = append(mismatches, fmt.Sprintf(
+				"instance %q resource %q spec data does not match the source",
+				instanceID,
+				resourceName,
+			))
+		}
+		driftMismatch, err := compareResourceDrift(ctx, source, target, sourceResource.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+		if driftMismatch != "" {
+			mismatches = append(mismatches, driftMismatch)
+		}
+	}
+
+	for linkName, sourceLink := range sourceInstance.Links {
+		if _, ok := targetInstance.Links[linkName]; !ok || sourceLink == nil {
+			continue
+		}
+		driftMismatch, err := compareLinkDrift(ctx, source, target, sourceLink.LinkID)
+		if err != nil {
+			return nil, err
+		}
+		if driftMismatch != "" {
+			mismatches = append(mismatches, driftMismatch)
+		}
+	}
+
+	for childName, sourceChild := range sourceInstance.ChildBlueprints {
+		targetChild, ok := targetInstance.ChildBlueprints[childName]
+		if !ok || targetChild == nil || sourceChild == nil {
+			continue
+		}
+		childMismatches, err := compareInstances(
+			ctx,
+			source,
+			target,
+			sourceChild,
+			targetChild,
+			visited,
+		)
+		if err != nil {
+			return nil, err
+		}
+		mismatches = append(mismatches, childMismatches...)
+	}
+
+	return mismatches, nil
+}
+
+func compareResourceDrift(
+	ctx context.Context,
+	source state.Container,
+	target state.Container,
+	resourceID string,
+) (string, error) {
+	sourceDrift, err := source.Resources().GetDrift(ctx, resourceID)
+	if err != nil && !state.IsResourceNotFound(err) {
+		return "", err
+	}
+	targetDrift, err := target.Resources().GetDrift(ctx, resourceID)
+	if err != nil && !state.IsResourceNotFound(err) {
+		return "", err
+	}
+
+	if sourceDrift.ResourceID != targetDrift.ResourceID ||
+		!driftSpecDataEqual(sourceDrift.SpecData, targetDrift.SpecData) {
+		return fmt.Sprintf(
+			"drift state for resource %q does not match the source",
+			resourceID,
+		), nil
+	}
+
+	return "", nil
+}
+
+func driftSpecDataEqual(sourceSpecData, targetSpecData *core.MappingNode) bool {
+	if sourceSpecData == nil || targetSpecData == nil {
+		// Resources without drift have no spec data in either state.
+		return sourceSpecData == targetSpecData
+	}
+
+	return core.MappingNodeEqual(sourceSpecData, targetSpecData)
+}
+
+func compareLinkDrift(
+	ctx context.Context,
+	source state.Container,
+	target state.Container,
+	linkID string,
+) (string, error) {
+	sourceDrift, err := source.Links().GetDrift(ctx, linkID)
+	if err != nil && !state.IsLinkNotFound(err) {
+		return "", err
+	}
+	targetDrift, err := target.Links().GetDrift(ctx, linkID)
+	if err != nil && !state.IsLinkNotFound(err) {
+		return "", err
+	}
+
+	if sourceDrift.LinkID != targetDrift.LinkID {
+		return fmt.Sprintf(
+			"drift state for link %q does not match the source",
+			linkID,
+		), nil
+	}
+
+	return "", nil
+}
+
+func compareKeys[Value any](
+	instanceID string,
+	kind string,
+	source map[string]Value,
+	target map[string]Value,
+) []string {
+	mismatches := []string{}
+	for _, key := range sortedKeys(source) {
+		if _, ok := target[key]; !ok {
+			mismatches = append(mismatches, fmt.Sprintf(
+				"instance %q %s %q is missing from the target state",
+				instanceID,
+				kind,
+				key,
+			))
+		}
+	}
+
+	for _, key := range sortedKeys(target) {
+		if _, ok := source[key]; !ok {
+			mismatches = append(mismatches, fmt.Sprintf(
+				"instance %q %s %q in the target state does not exist in the source",
+				instanceID,
+				kind,
+				key,
+			))
+		}
+	}
+
+	return mismatches
+}
+
+func sortedKeys[Value any](values map[string]Value) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -1,8 +1,10 @@
 package statestore
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
+	"slices"
 	"time"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
@@ -48,6 +50,39 @@ func (c *ChangesetsContainer) Get(
 	return &copied, nil
 }
 
+// GetAllByInstanceID returns the change sets for the given instance,
+// ordered by created desc. Under ModeLazy, only change sets that have
+// been loaded into memory are considered.
+func (c *ChangesetsContainer) GetAllByInstanceID(
+	ctx context.Context,
+	instanceID string,
+) ([]*manage.Changeset, error) {
+	c.state.RLock()
+	defer c.state.RUnlock()
+
+	instanceChangesets := []*manage.Changeset{}
+	for _, changeset := range c.state.changesets {
+		if changeset.InstanceID != instanceID {
+			continue
+		}
+
+		copied, err := copyChangeset(changeset)
+		if err != nil {
+			return nil, err
+		}
+		instanceChangesets = append(instanceChangesets, &copied)
+	}
+
+	slices.SortFunc(instanceChangesets, func(a, b *manage.Changeset) int {
+		return cmp.Or(
+			cmp.Compare(b.Created, a.Created),
+			cmp.Compare(b.ID, a.ID),
+		)
+	})
+
+	return instanceChangesets, nil
+}
+
 func (c *ChangesetsContainer) Save(
 	ctx context.Context,
 	changeset *manage.Changeset,
@@ -64,7 +99,10 @@ func (c *ChangesetsContainer) Save(
 		return c.persister.UpdateChangeset(ctx, changeset)
 	}
 	logger.Debug("persisting new change set")
-	return c.persister.CreateChangeset(ctx, changeset)
+	if err := c.persister.CreateChangeset(ctx, changeset); err != nil {
+		return err
+	}
+	return enforceRevisionLimit(ctx, c.state, c.persister, changeset.InstanceID, logger)
 }
 
 // Cleanup removes changesets older than thresholdDate and returns the count
@@ -99,6 +137,7 @@ func copyChangeset(changeset *manage.Changeset) (manage.Changeset, error) {
 		BlueprintLocation: changeset.BlueprintLocation,
 		Changes:           changesCopy,
 		Created:           changeset.Created,
+		Deployed:          changeset.Deployed,
 	}, nil
 }
 
@@ -176,8 +176,9 @@ func copyExport(export *state.ExportState) *state.ExportState {
 		return nil
 	}
 	return &state.ExportState{
-		Value: export.Value,
-		Type:  export.Type,
-		Field: export.Field,
+		Value:     export.Value,
+		Type:      export.Type,
+		Field:     export.Field,
+		Sensitive: export.Sensitive,
 	}
 }
@@ -3,6 +3,7 @@ package statestore
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -262,6 +263,9 @@ func (c *InstancesContainer) UpdateStatus(
 	if statusInfo.Durations != nil {
 		inst.Durations = statusInfo.Durations
 	}
+	if statusInfo.ExpiresAt != nil {
+		inst.ExpiresAt = *statusInfo.ExpiresAt
+	}
 	c.logger.Debug(
 		"persisting instance status update",
 		core.StringLogField("instanceId", instanceID),
@@ -318,6 +322,51 @@ func (c *InstancesContainer) Remove(
 	return *inst, c.persister.RemoveInstance(ctx, inst)
 }
 
+func (c *InstancesContainer) SaveRun(
+	ctx context.Context,
+	run state.DeploymentRun,
+) error {
+	existingRuns, _, err := c.state.LookupDeploymentRuns(ctx, run.InstanceID)
+	if err != nil {
+		return err
+	}
+
+	c.state.Lock()
+	defer c.state.Unlock()
+
+	// Deployment runs are immutable — idempotent create.
+	for _, existing := range existingRuns {
+		if existing.ID == run.ID {
+			return nil
+		}
+	}
+
+	runs := append(slices.Clone(existingRuns), &run)
+	c.state.deploymentRuns[run.InstanceID] = runs
+
+	c.logger.Debug(
+		"persisting new deployment run",
+		core.StringLogField("instanceId", run.InstanceID),
+		core.StringLogField("runId", run.ID),
+	)
+	return c.persister.SaveDeploymentRuns(ctx, run.InstanceID, runs)
+}
+
+func (c *InstancesContainer) ListRuns(
+	ctx context.Context,
+	instanceID string,
+	params state.ListRunsParams,
+) (state.ListRunsResult, error) {
+	runs, _, err := c.state.LookupDeploymentRuns(ctx, instanceID)
+	if err != nil {
+		return state.ListRunsResult{}, err
+	}
+
+	c.state.RLock()
+	defer c.state.RUnlock()
+	return state.FilterAndPaginateRuns(runs, params), nil
+}
+
 func (c *InstancesContainer) cleanupResourceDrift(resourceIDs map[string]string) {
 	for _, resourceID := range resourceIDs {
 		delete(c.state.resourceDrift, resourceID)
@@ -427,6 +476,7 @@ func filterInstances(
 			InstanceName:          inst.InstanceName,
 			Status:                inst.Status,
 			LastDeployedTimestamp: int64(inst.LastDeployedTimestamp),
+			ExpiresAt:             int64(inst.ExpiresAt),
 		})
 	}
 	sort.Slice(filtered, func(i, j int) bool {
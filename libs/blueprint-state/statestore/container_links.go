@@ -287,7 +287,10 @@ func (c *LinksContainer) SaveDrift(
 		"persisting link changes for latest drift state",
 		core.StringLogField("linkId", driftState.LinkID),
 	)
-	return c.persister.UpdateInstance(ctx, inst)
+	if err := c.persister.UpdateInstance(ctx, inst); err != nil {
+		return err
+	}
+	return pruneExpiredDrift(ctx, c.state, c.persister, c.logger)
 }
 
 func (c *LinksContainer) persistLinkDrift(
@@ -226,7 +226,10 @@ func (c *ResourcesContainer) SaveDrift(
 		"persisting resource changes for latest drift state",
 		core.StringLogField("resourceId", driftState.ResourceID),
 	)
-	return c.persister.UpdateInstance(ctx, inst)
+	if err := c.persister.UpdateInstance(ctx, inst); err != nil {
+		return err
+	}
+	return pruneExpiredDrift(ctx, c.state, c.persister, c.logger)
 }
 
 func (c *ResourcesContainer) persistResourceDrift(
@@ -0,0 +1,83 @@
+package statestore
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// SummariesContainer implements state.SummariesContainer against a shared
+// statestore.State. Backend-agnostic; every backend consumes it unchanged.
+type SummariesContainer struct {
+	state  *State
+	logger core.Logger
+}
+
+func NewSummariesContainer(st *State, logger core.Logger) *SummariesContainer {
+	if logger == nil {
+		logger = core.NewNopLogger()
+	}
+	return &SummariesContainer{state: st, logger: logger}
+}
+
+func (c *SummariesContainer) Get(
+	ctx context.Context,
+	instanceID string,
+) (state.InstanceStatusSummary, error) {
+	inst, ok, err := c.state.LookupInstance(ctx, instanceID)
+	if err != nil {
+		return state.InstanceStatusSummary{}, err
+	}
+	if !ok {
+		return state.InstanceStatusSummary{}, state.InstanceNotFoundError(instanceID)
+	}
+
+	c.state.RLock()
+	defer c.state.RUnlock()
+	return state.SummariseInstance(inst), nil
+}
+
+func (c *SummariesContainer) List(
+	ctx context.Context,
+	params state.ListInstancesParams,
+) (state.ListInstanceStatusSummariesResult, error) {
+	c.state.RLock()
+	defer c.state.RUnlock()
+
+	summaries := []state.InstanceStatusSummary{}
+	searchLower := strings.ToLower(params.Search)
+	for _, inst := range c.state.instances {
+		if params.Search != "" && !strings.Contains(strings.ToLower(inst.InstanceName), searchLower) {
+			continue
+		}
+		summaries = append(summaries, state.SummariseInstance(inst))
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].InstanceName < summaries[j].InstanceName
+	})
+
+	totalCount := len(summaries)
+	return state.ListInstanceStatusSummariesResult{
+		Summaries:  applySummaryPagination(summaries, params.Offset, params.Limit),
+		TotalCount: totalCount,
+	}, nil
+}
+
+func applySummaryPagination(
+	items []state.InstanceStatusSummary,
+	offset, limit int,
+) []state.InstanceStatusSummary {
+	if offset > 0 {
+		if offset >= len(items) {
+			return []state.InstanceStatusSummary{}
+		}
+		items = items[offset:]
+	}
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	return items
+}
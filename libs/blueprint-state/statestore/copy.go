@@ -128,6 +128,8 @@ func copyResource(resourceState *state.ResourceState) state.ResourceState {
 		LastDriftDetectedTimestamp: resourceState.LastDriftDetectedTimestamp,
 		Durations:                  resourceState.Durations,
 		RemovalPolicy:              resourceState.RemovalPolicy,
+		IgnoreChanges:              slices.Clone(resourceState.IgnoreChanges),
+		Tainted:                    resourceState.Tainted,
 	}
 }
 
@@ -181,6 +183,7 @@ func copyLink(linkState *state.LinkState) state.LinkState {
 		IntermediaryResourceStates: copyIntermediaryResources(linkState.IntermediaryResourceStates),
 		Data:                       linkState.Data,
 		ResourceDataMappings:       linkState.ResourceDataMappings,
+		Config:                     linkState.Config,
 		FailureReasons:             linkState.FailureReasons,
 		Drifted:                    linkState.Drifted,
 		LastDriftDetectedTimestamp: linkState.LastDriftDetectedTimestamp,
@@ -234,6 +237,7 @@ func copyResourceDriftFieldChanges(
 			FieldPath:    v.FieldPath,
 			StateValue:   v.StateValue,
 			DriftedValue: v.DriftedValue,
+			Sensitive:    v.Sensitive,
 		}
 	}
 	return out
@@ -142,6 +142,12 @@ func (k KeyBuilder) EventIndex() string {
 	return k.join("event_index.json")
 }
 
+// DeploymentRuns returns the storage key for the file holding all the
+// deployment runs for a blueprint instance.
+func (k KeyBuilder) DeploymentRuns(instanceID string) string {
+	return k.join("deployment_runs", instanceID+".json")
+}
+
 // CleanupOperation returns the per-entity storage key for a cleanup operation.
 func (k KeyBuilder) CleanupOperation(operationID string) string {
 	return k.join("cleanup_operations", operationID+".json")
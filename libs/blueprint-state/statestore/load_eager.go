@@ -113,6 +113,8 @@ func loadKey(
 		return loadIndex(ctx, storage, key, &st.reconciliationIndex)
 	case strings.HasPrefix(filename, "cleanup_operations/") && strings.HasSuffix(filename, ".json"):
 		return loadCleanupOperation(ctx, st, storage, key)
+	case strings.HasPrefix(filename, "deployment_runs/") && strings.HasSuffix(filename, ".json"):
+		return loadDeploymentRuns(ctx, st, storage, key, filename)
 	case strings.HasPrefix(filename, "instances/") && strings.HasSuffix(filename, ".json"):
 		return loadInstancePerEntity(ctx, st, storage, key, parentChildMapping)
 	case strings.HasPrefix(filename, "instances_by_name/"):
@@ -310,6 +312,21 @@ func loadIndex(ctx context.Context, storage Storage, key string, dst *map[string
 
 // Reads the object at key and unmarshals into dst.
 // Missing objects are treated as empty (dst left untouched); other read errors surface.
+func loadDeploymentRuns(
+	ctx context.Context,
+	st *State,
+	storage Storage,
+	key, filename string,
+) error {
+	var runs []*state.DeploymentRun
+	if err := readJSON(ctx, storage, key, &runs); err != nil {
+		return err
+	}
+	instanceID := strings.TrimSuffix(strings.TrimPrefix(filename, "deployment_runs/"), ".json")
+	st.deploymentRuns[instanceID] = runs
+	return nil
+}
+
 func readJSON(ctx context.Context, storage Storage, key string, dst any) error {
 	data, err := storage.Read(ctx, key)
 	if err != nil {
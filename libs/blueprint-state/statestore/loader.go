@@ -63,6 +63,7 @@ type EntityLoader interface {
 	LoadValidation(ctx context.Context, id string) (*manage.BlueprintValidation, bool, error)
 	LoadReconciliation(ctx context.Context, id string) (*manage.ReconciliationResult, bool, error)
 	LoadCleanupOperation(ctx context.Context, id string) (*manage.CleanupOperation, bool, error)
+	LoadDeploymentRuns(ctx context.Context, instanceID string) ([]*state.DeploymentRun, bool, error)
 }
 
 // noopLoader is the loader used under ModeEager — it never materialises
@@ -112,3 +113,7 @@ func (noopLoader) LoadReconciliation(context.Context, string) (*manage.Reconcili
 func (noopLoader) LoadCleanupOperation(context.Context, string) (*manage.CleanupOperation, bool, error) {
 	return nil, false, nil
 }
+
+func (noopLoader) LoadDeploymentRuns(context.Context, string) ([]*state.DeploymentRun, bool, error) {
+	return nil, false, nil
+}
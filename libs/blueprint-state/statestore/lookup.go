@@ -223,3 +223,22 @@ func (s *State) LookupCleanupOperation(ctx context.Context, id string) (*manage.
 	s.mu.Unlock()
 	return op, true, nil
 }
+
+// LookupDeploymentRuns returns the deployment runs for a blueprint instance.
+func (s *State) LookupDeploymentRuns(ctx context.Context, instanceID string) ([]*state.DeploymentRun, bool, error) {
+	s.mu.RLock()
+	if runs, ok := s.deploymentRuns[instanceID]; ok {
+		s.mu.RUnlock()
+		return runs, true, nil
+	}
+	s.mu.RUnlock()
+
+	runs, ok, err := s.loader.LoadDeploymentRuns(ctx, instanceID)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+	s.mu.Lock()
+	s.deploymentRuns[instanceID] = runs
+	s.mu.Unlock()
+	return runs, true, nil
+}
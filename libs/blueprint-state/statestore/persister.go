@@ -10,6 +10,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	commoncore "github.com/newstack-cloud/bluelink/libs/common/core"
 )
 
 // Persister manages durable persistence of state data via the Storage seam.
@@ -33,6 +34,9 @@ type Persister struct {
 
 	nameRecordReserver NameRecordReserver
 
+	retention manage.RetentionPolicy
+	clock     commoncore.Clock
+
 	mu sync.Mutex
 }
 
@@ -71,6 +75,23 @@ func WithMaxEventPartitionSize(maxEventPartitionSize int64) PersisterOption {
 	}
 }
 
+// WithRetentionPolicy sets the retention policy that containers sharing
+// the persister enforce when change sets and drift state are saved.
+// Default: no retention limits.
+func WithRetentionPolicy(policy manage.RetentionPolicy) PersisterOption {
+	return func(p *Persister) {
+		p.retention = policy
+	}
+}
+
+// WithRetentionClock sets the clock used to determine the age of drift
+// entries when enforcing the retention policy. Default: the system clock.
+func WithRetentionClock(clock commoncore.Clock) PersisterOption {
+	return func(p *Persister) {
+		p.clock = clock
+	}
+}
+
 // WithLogger sets the persister's logger. Defaults to a no-op logger.
 func WithLogger(logger core.Logger) PersisterOption {
 	return func(p *Persister) {
@@ -94,6 +115,7 @@ func NewPersister(
 		config:                  conf,
 		keys:                    NewKeyBuilder(prefix),
 		logger:                  core.NewNopLogger(),
+		clock:                   &commoncore.SystemClock{},
 		maxGuideFileSize:        DefaultMaxGuideFileSize,
 		maxEventPartitionSize:   DefaultMaxEventPartitionSize,
 		lastInstanceChunk:       maxChunkNumber(state.instanceIndex),
@@ -754,6 +776,20 @@ func (p *Persister) RemoveCleanupOperation(ctx context.Context, operationID stri
 	return deleteIgnoreNotFound(ctx, p.storage, p.keys.CleanupOperation(operationID))
 }
 
+// SaveDeploymentRuns persists the full set of deployment runs for a
+// blueprint instance. Runs for an instance are stored together in a single
+// object as they are always listed together and are low-volume.
+func (p *Persister) SaveDeploymentRuns(
+	ctx context.Context,
+	instanceID string,
+	runs []*state.DeploymentRun,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return writeChunk(ctx, p.storage, p.keys.DeploymentRuns(instanceID), runs)
+}
+
 func deleteIgnoreNotFound(ctx context.Context, storage Storage, key string) error {
 	if err := storage.Delete(ctx, key); err != nil && !errors.Is(err, ErrNotFound) {
 		return err
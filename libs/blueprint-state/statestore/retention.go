@@ -0,0 +1,165 @@
+package statestore
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+// enforceRevisionLimit removes the oldest change sets for the given instance
+// that exceed the maximum number of revisions in the persister's retention policy.
+// Under ModeLazy, only change sets that have been loaded into memory
+// are considered.
+// The caller must hold the State write lock.
+func enforceRevisionLimit(
+	ctx context.Context,
+	st *State,
+	persister *Persister,
+	instanceID string,
+	logger core.Logger,
+) error {
+	if !persister.retention.LimitsRevisions() || instanceID == "" {
+		return nil
+	}
+
+	instanceChangesets := []*manage.Changeset{}
+	for _, changeset := range st.changesets {
+		if changeset.InstanceID == instanceID {
+			instanceChangesets = append(instanceChangesets, changeset)
+		}
+	}
+
+	maxRevisions := persister.retention.MaxRevisionsPerInstance
+	if len(instanceChangesets) <= maxRevisions {
+		return nil
+	}
+
+	// Newest first, the ID is used as a tie-breaker to make sure
+	// the same change sets are removed for change sets
+	// created in the same second.
+	slices.SortFunc(instanceChangesets, func(a, b *manage.Changeset) int {
+		return cmp.Or(
+			cmp.Compare(b.Created, a.Created),
+			cmp.Compare(b.ID, a.ID),
+		)
+	})
+
+	for _, changeset := range instanceChangesets[maxRevisions:] {
+		logger.Debug(
+			"removing change set that exceeds the maximum number of revisions for the instance",
+			core.StringLogField("changesetId", changeset.ID),
+			core.StringLogField("instanceId", instanceID),
+		)
+		delete(st.changesets, changeset.ID)
+		if err := persister.RemoveChangeset(ctx, changeset.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneExpiredDrift removes resource and link drift entries that are older
+// than the maximum drift history age in the persister's retention policy.
+// Drift entries for resources and links that are still marked as drifted
+// are the current drift state and are never removed, only stale entries
+// for resources and links that are no longer drifted or no longer exist
+// are removed.
+// Under ModeLazy, only drift entries that have been loaded into memory
+// are considered.
+// The caller must hold the State write lock.
+func pruneExpiredDrift(
+	ctx context.Context,
+	st *State,
+	persister *Persister,
+	logger core.Logger,
+) error {
+	if !persister.retention.LimitsDriftHistory() {
+		return nil
+	}
+
+	threshold := persister.retention.DriftExpiryThreshold(persister.clock.Now()).Unix()
+
+	for resourceID, drift := range st.resourceDrift {
+		if !driftTimestampExpired(drift.Timestamp, threshold) {
+			continue
+		}
+
+		drifted, err := resourceStillDrifted(ctx, st, resourceID)
+		if err != nil {
+			return err
+		}
+		if drifted {
+			continue
+		}
+
+		logger.Debug(
+			"removing resource drift entry that exceeds the maximum drift history age",
+			core.StringLogField("resourceId", resourceID),
+		)
+		delete(st.resourceDrift, resourceID)
+		if err := persister.RemoveResourceDrift(ctx, drift); err != nil {
+			return err
+		}
+	}
+
+	for linkID, drift := range st.linkDrift {
+		if !driftTimestampExpired(drift.Timestamp, threshold) {
+			continue
+		}
+
+		drifted, err := linkStillDrifted(ctx, st, linkID)
+		if err != nil {
+			return err
+		}
+		if drifted {
+			continue
+		}
+
+		logger.Debug(
+			"removing link drift entry that exceeds the maximum drift history age",
+			core.StringLogField("linkId", linkID),
+		)
+		delete(st.linkDrift, linkID)
+		if err := persister.RemoveLinkDrift(ctx, drift); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func driftTimestampExpired(timestamp *int, threshold int64) bool {
+	return timestamp != nil && int64(*timestamp) < threshold
+}
+
+// The loader is used directly instead of State.LookupResource
+// as the caller already holds the State write lock.
+func resourceStillDrifted(ctx context.Context, st *State, resourceID string) (bool, error) {
+	if resource, ok := st.resources[resourceID]; ok {
+		return resource.Drifted, nil
+	}
+
+	resource, ok, err := st.loader.LoadResource(ctx, resourceID)
+	if err != nil || !ok {
+		return false, err
+	}
+	st.resources[resourceID] = resource
+	return resource.Drifted, nil
+}
+
+func linkStillDrifted(ctx context.Context, st *State, linkID string) (bool, error) {
+	if link, ok := st.links[linkID]; ok {
+		return link.Drifted, nil
+	}
+
+	link, ok, err := st.loader.LoadLink(ctx, linkID)
+	if err != nil || !ok {
+		return false, err
+	}
+	st.links[linkID] = link
+	return link.Drifted, nil
+}
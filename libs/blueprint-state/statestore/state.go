@@ -31,6 +31,9 @@ type State struct {
 	validations     map[string]*manage.BlueprintValidation
 	reconciliations map[string]*manage.ReconciliationResult
 	cleanupOps      map[string]*manage.CleanupOperation
+	// deploymentRuns holds the deployment runs for each blueprint instance
+	// keyed by instance ID.
+	deploymentRuns map[string][]*state.DeploymentRun
 
 	instanceIndex       map[string]*IndexLocation
 	resourceChunkIndex  map[string]*IndexLocation
@@ -245,6 +248,11 @@ func WithSharedCleanupOps(ops map[string]*manage.CleanupOperation) StateOption {
 	return func(s *State) { s.cleanupOps = ops }
 }
 
+// WithSharedDeploymentRuns replaces State's deployment runs map.
+func WithSharedDeploymentRuns(runs map[string][]*state.DeploymentRun) StateOption {
+	return func(s *State) { s.deploymentRuns = runs }
+}
+
 // NewState returns a new, empty State instance. Options let callers share
 // specific maps or the mutex with an external owner (memfile's legacy state
 // during the incremental migration).
@@ -264,6 +272,7 @@ func NewState(opts ...StateOption) *State {
 		validations:         map[string]*manage.BlueprintValidation{},
 		reconciliations:     map[string]*manage.ReconciliationResult{},
 		cleanupOps:          map[string]*manage.CleanupOperation{},
+		deploymentRuns:      map[string][]*state.DeploymentRun{},
 		instanceIndex:       map[string]*IndexLocation{},
 		resourceChunkIndex:  map[string]*IndexLocation{},
 		linkChunkIndex:      map[string]*IndexLocation{},
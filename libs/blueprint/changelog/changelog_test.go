@@ -0,0 +1,151 @@
+package changelog
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChangelogTestSuite struct {
+	suite.Suite
+}
+
+func (s *ChangelogTestSuite) Test_renders_markdown_entry_for_deployment() {
+	markdown := RenderMarkdown(testSummary())
+
+	s.Assert().Equal(
+		"## 2025-01-02T03:04:05Z - deploy orders\n\n"+
+			"- **Status:** DEPLOY_FAILED\n"+
+			"- **Instance ID:** `instance-1`\n"+
+			"- **Run ID:** `run-1`\n"+
+			"- **Duration:** 1.5s\n"+
+			"- **Changes:** 1 new resource, 2 updated resources\n"+
+			"\n### Failure reasons\n\n"+
+			"- failed to create ordersQueue\n"+
+			"\n### Resources\n\n"+
+			"- `ordersQueue`: CREATE_FAILED - quota exceeded\n"+
+			"- `ordersTable`: UPDATED\n"+
+			"- `cacheCluster`: CREATED (instance `instance-1-child`)\n",
+		markdown,
+	)
+}
+
+func (s *ChangelogTestSuite) Test_writes_markdown_entry_to_directory() {
+	dir := filepath.Join(s.T().TempDir(), "changelog")
+	writer := NewDirectoryWriter(dir)
+
+	summary := testSummary()
+	err := writer.OnDeploymentSummary(context.Background(), summary)
+	s.Require().NoError(err)
+
+	entries, err := os.ReadDir(dir)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Assert().Equal("20250102T030405Z-deploy-orders-run-1.md", entries[0].Name())
+
+	contents, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	s.Require().NoError(err)
+	s.Assert().Equal(RenderMarkdown(summary), string(contents))
+}
+
+func (s *ChangelogTestSuite) Test_publishes_summary_to_webhook() {
+	var receivedHeaders http.Header
+	var receivedPayload WebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedHeaders = r.Header.Clone()
+		body, err := io.ReadAll(r.Body)
+		s.Require().NoError(err)
+		s.Require().NoError(json.Unmarshal(body, &receivedPayload))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	publisher := NewWebhookPublisher(
+		server.URL,
+		WithWebhookHeaders(map[string]string{
+			"Authorization": "Bearer test-token",
+		}),
+	)
+	summary := testSummary()
+	err := publisher.OnDeploymentSummary(context.Background(), summary)
+	s.Require().NoError(err)
+
+	s.Assert().Equal("Bearer test-token", receivedHeaders.Get("Authorization"))
+	s.Assert().Equal("application/json", receivedHeaders.Get("Content-Type"))
+	s.Assert().Equal(RenderMarkdown(summary), receivedPayload.Markdown)
+	s.Require().NotNil(receivedPayload.Summary)
+	s.Assert().Equal(container.DeploymentSummarySchemaVersion, receivedPayload.Summary.SchemaVersion)
+	s.Assert().Equal("instance-1", receivedPayload.Summary.InstanceID)
+	s.Assert().Len(receivedPayload.Summary.Resources, 3)
+}
+
+func (s *ChangelogTestSuite) Test_reports_error_for_unsuccessful_webhook_response() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	publisher := NewWebhookPublisher(server.URL)
+	err := publisher.OnDeploymentSummary(context.Background(), testSummary())
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "unexpected status code 500")
+}
+
+func testSummary() *container.DeploymentSummary {
+	durationMilliseconds := 1500.0
+	return &container.DeploymentSummary{
+		SchemaVersion:        container.DeploymentSummarySchemaVersion,
+		Operation:            container.DeploymentOperationDeploy,
+		InstanceID:           "instance-1",
+		InstanceName:         "orders",
+		RunID:                "run-1",
+		Status:               "DEPLOY_FAILED",
+		FailureReasons:       []string{"failed to create ordersQueue"},
+		StartedAt:            1735787044,
+		FinishedAt:           1735787045,
+		DurationMilliseconds: &durationMilliseconds,
+		Changes: &changes.BlueprintChanges{
+			NewResources: map[string]provider.Changes{
+				"ordersQueue": {},
+			},
+			ResourceChanges: map[string]provider.Changes{
+				"ordersTable":  {},
+				"ordersBucket": {},
+			},
+		},
+		Resources: []*container.DeploymentSummaryElement{
+			{
+				Name:           "ordersQueue",
+				InstanceID:     "instance-1",
+				Status:         "CREATE_FAILED",
+				FailureReasons: []string{"quota exceeded"},
+			},
+			{
+				Name:       "ordersTable",
+				ID:         "resource-2",
+				InstanceID: "instance-1",
+				Status:     "UPDATED",
+			},
+			{
+				Name:       "cacheCluster",
+				ID:         "resource-3",
+				InstanceID: "instance-1-child",
+				Status:     "CREATED",
+			},
+		},
+	}
+}
+
+func TestChangelogTestSuite(t *testing.T) {
+	suite.Run(t, new(ChangelogTestSuite))
+}
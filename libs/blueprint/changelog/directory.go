@@ -0,0 +1,68 @@
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+)
+
+// DirectoryWriter is a deployment summary hook that writes a CHANGELOG-style
+// Markdown entry for each deploy or destroy operation to a file in a directory,
+// this allows teams to track infrastructure changes alongside code
+// (e.g. by committing the directory to a repository).
+type DirectoryWriter struct {
+	dir string
+}
+
+// NewDirectoryWriter creates a new deployment summary hook that writes
+// a Markdown entry for each operation to a new file in the provided directory.
+// The directory will be created if it does not exist.
+func NewDirectoryWriter(dir string) *DirectoryWriter {
+	return &DirectoryWriter{
+		dir: dir,
+	}
+}
+
+func (w *DirectoryWriter) OnDeploymentSummary(
+	ctx context.Context,
+	summary *container.DeploymentSummary,
+) error {
+	err := os.MkdirAll(w.dir, 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to create changelog directory: %w", err)
+	}
+
+	entryPath := filepath.Join(w.dir, EntryFileName(summary))
+	err = os.WriteFile(entryPath, []byte(RenderMarkdown(summary)), 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to write changelog entry: %w", err)
+	}
+
+	return nil
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// EntryFileName produces the name of the Markdown file for the changelog
+// entry of a deploy or destroy operation.
+// File names start with the time the operation finished so that entries
+// are listed in the order they were written.
+func EntryFileName(summary *container.DeploymentSummary) string {
+	finishedAt := time.Unix(summary.FinishedAt, 0).UTC().Format("20060102T150405Z")
+	name := fmt.Sprintf(
+		"%s-%s-%s",
+		finishedAt,
+		summary.Operation,
+		instanceLabel(summary),
+	)
+	if summary.RunID != "" {
+		name = fmt.Sprintf("%s-%s", name, summary.RunID)
+	}
+
+	return unsafeFileNameChars.ReplaceAllString(name, "_") + ".md"
+}
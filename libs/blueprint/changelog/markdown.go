@@ -0,0 +1,131 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+)
+
+// RenderMarkdown renders a CHANGELOG-style Markdown entry for the summary
+// of a deploy or destroy operation.
+// Each entry starts with a level 2 heading so entries can be concatenated
+// into a single changelog document.
+func RenderMarkdown(summary *container.DeploymentSummary) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(
+		&sb,
+		"## %s - %s %s\n\n",
+		time.Unix(summary.FinishedAt, 0).UTC().Format(time.RFC3339),
+		summary.Operation,
+		instanceLabel(summary),
+	)
+
+	fmt.Fprintf(&sb, "- **Status:** %s\n", statusLabel(summary))
+	if summary.InstanceID != "" {
+		fmt.Fprintf(&sb, "- **Instance ID:** `%s`\n", summary.InstanceID)
+	}
+	if summary.RunID != "" {
+		fmt.Fprintf(&sb, "- **Run ID:** `%s`\n", summary.RunID)
+	}
+	if summary.Rollback {
+		sb.WriteString("- **Rollback:** yes\n")
+	}
+	if summary.DurationMilliseconds != nil {
+		duration := time.Duration(*summary.DurationMilliseconds * float64(time.Millisecond))
+		fmt.Fprintf(&sb, "- **Duration:** %s\n", duration.Round(time.Millisecond))
+	}
+	if changeCounts := renderChangeCounts(summary.Changes); changeCounts != "" {
+		fmt.Fprintf(&sb, "- **Changes:** %s\n", changeCounts)
+	}
+
+	writeList(&sb, "Failure reasons", summary.FailureReasons)
+	writeList(&sb, "Errors", summary.Errors)
+	writeElements(&sb, "Resources", summary.Resources, summary.InstanceID)
+	writeElements(&sb, "Child blueprints", summary.Children, summary.InstanceID)
+	writeElements(&sb, "Links", summary.Links, summary.InstanceID)
+
+	return sb.String()
+}
+
+func instanceLabel(summary *container.DeploymentSummary) string {
+	if summary.InstanceName != "" {
+		return summary.InstanceName
+	}
+
+	return summary.InstanceID
+}
+
+func statusLabel(summary *container.DeploymentSummary) string {
+	if summary.Status == "" {
+		return "ERROR"
+	}
+
+	return summary.Status
+}
+
+func renderChangeCounts(blueprintChanges *changes.BlueprintChanges) string {
+	if blueprintChanges == nil {
+		return ""
+	}
+
+	counts := []string{}
+	counts = appendCount(counts, len(blueprintChanges.NewResources), "new resource", "new resources")
+	counts = appendCount(counts, len(blueprintChanges.ResourceChanges), "updated resource", "updated resources")
+	counts = appendCount(counts, len(blueprintChanges.RemovedResources), "removed resource", "removed resources")
+	counts = appendCount(counts, len(blueprintChanges.NewChildren), "new child blueprint", "new child blueprints")
+	counts = appendCount(counts, len(blueprintChanges.ChildChanges), "updated child blueprint", "updated child blueprints")
+	counts = appendCount(counts, len(blueprintChanges.RemovedChildren), "removed child blueprint", "removed child blueprints")
+	counts = appendCount(counts, len(blueprintChanges.RemovedLinks), "removed link", "removed links")
+
+	return strings.Join(counts, ", ")
+}
+
+func appendCount(counts []string, count int, singular string, plural string) []string {
+	if count == 0 {
+		return counts
+	}
+
+	if count == 1 {
+		return append(counts, fmt.Sprintf("1 %s", singular))
+	}
+
+	return append(counts, fmt.Sprintf("%d %s", count, plural))
+}
+
+func writeList(sb *strings.Builder, heading string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+
+	fmt.Fprintf(sb, "\n### %s\n\n", heading)
+	for _, item := range items {
+		fmt.Fprintf(sb, "- %s\n", item)
+	}
+}
+
+func writeElements(
+	sb *strings.Builder,
+	heading string,
+	elements []*container.DeploymentSummaryElement,
+	rootInstanceID string,
+) {
+	if len(elements) == 0 {
+		return
+	}
+
+	fmt.Fprintf(sb, "\n### %s\n\n", heading)
+	for _, element := range elements {
+		fmt.Fprintf(sb, "- `%s`: %s", element.Name, element.Status)
+		if element.InstanceID != "" && element.InstanceID != rootInstanceID {
+			fmt.Fprintf(sb, " (instance `%s`)", element.InstanceID)
+		}
+		if len(element.FailureReasons) > 0 {
+			fmt.Fprintf(sb, " - %s", strings.Join(element.FailureReasons, "; "))
+		}
+		sb.WriteString("\n")
+	}
+}
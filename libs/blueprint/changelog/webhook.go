@@ -0,0 +1,116 @@
+package changelog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+)
+
+const (
+	// DefaultWebhookTimeout is the default timeout for requests made
+	// to publish deployment summaries to a webhook.
+	DefaultWebhookTimeout = 30 * time.Second
+)
+
+// WebhookPayload is the JSON body sent to a webhook for
+// each deploy or destroy operation.
+type WebhookPayload struct {
+	// Summary holds the summary of the deploy or destroy operation.
+	Summary *container.DeploymentSummary `json:"summary"`
+	// Markdown holds the CHANGELOG-style Markdown entry for the operation.
+	Markdown string `json:"markdown"`
+}
+
+// WebhookPublisher is a deployment summary hook that publishes
+// the summary and a CHANGELOG-style Markdown entry for each deploy or destroy
+// operation to a webhook, this can be used to sync infrastructure changes
+// with external systems such as a configuration management database (CMDB).
+type WebhookPublisher struct {
+	url        string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+// WebhookPublisherOption is a function that can be used to configure
+// a webhook publisher.
+type WebhookPublisherOption func(*WebhookPublisher)
+
+// WithWebhookHeaders sets additional headers to include in requests
+// made to the webhook (e.g. an authorization header).
+func WithWebhookHeaders(headers map[string]string) WebhookPublisherOption {
+	return func(p *WebhookPublisher) {
+		for key, value := range headers {
+			p.headers[key] = value
+		}
+	}
+}
+
+// WithWebhookHTTPClient sets the HTTP client used to make requests
+// to the webhook.
+// When not set, a client with a timeout of DefaultWebhookTimeout is used.
+func WithWebhookHTTPClient(httpClient *http.Client) WebhookPublisherOption {
+	return func(p *WebhookPublisher) {
+		p.httpClient = httpClient
+	}
+}
+
+// NewWebhookPublisher creates a new deployment summary hook that sends
+// a POST request with a JSON WebhookPayload body to the provided URL
+// for each deploy or destroy operation.
+func NewWebhookPublisher(url string, opts ...WebhookPublisherOption) *WebhookPublisher {
+	publisher := &WebhookPublisher{
+		url:     url,
+		headers: map[string]string{},
+		httpClient: &http.Client{
+			Timeout: DefaultWebhookTimeout,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(publisher)
+	}
+
+	return publisher
+}
+
+func (p *WebhookPublisher) OnDeploymentSummary(
+	ctx context.Context,
+	summary *container.DeploymentSummary,
+) error {
+	body, err := json.Marshal(&WebhookPayload{
+		Summary:  summary,
+		Markdown: RenderMarkdown(summary),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range p.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf(
+			"changelog webhook responded with unexpected status code %d",
+			resp.StatusCode,
+		)
+	}
+
+	return nil
+}
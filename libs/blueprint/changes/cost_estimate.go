@@ -0,0 +1,108 @@
+package changes
+
+import (
+	"slices"
+)
+
+// CostEstimate holds the estimated change in monthly cost that will be
+// made by deploying a change set.
+// Costs are expressed in US dollars and are only estimated for resources
+// with resource types that implement provider.CostEstimator,
+// resources that do not support cost estimation are not included.
+type CostEstimate struct {
+	// CreateMonthlyCost is the estimated monthly cost of the resources
+	// that will be created.
+	CreateMonthlyCost float64 `json:"createMonthlyCost"`
+	// UpdateMonthlyCostChange is the estimated change in monthly cost
+	// for the resources that will be updated or recreated.
+	UpdateMonthlyCostChange float64 `json:"updateMonthlyCostChange"`
+	// DestroyMonthlyCost is the estimated monthly cost of the resources
+	// that will be destroyed.
+	DestroyMonthlyCost float64 `json:"destroyMonthlyCost"`
+	// MonthlyCostChange is the estimated overall change in monthly cost
+	// for the change set.
+	MonthlyCostChange float64 `json:"monthlyCostChange"`
+	// Resources holds the cost estimates for the resources in the blueprint
+	// and its descendants, ordered by child path and resource name.
+	Resources []*ResourceCostEstimate `json:"resources"`
+}
+
+// ResourceCostEstimate holds the estimated monthly cost of a resource
+// before and after deploying a change set.
+type ResourceCostEstimate struct {
+	// ChildPath is the path of the child blueprint the resource belongs to,
+	// (e.g. "networking.subnets"), this is empty for the root blueprint.
+	ChildPath    string     `json:"childPath,omitempty"`
+	ResourceName string     `json:"resourceName"`
+	ResourceType string     `json:"resourceType"`
+	Action       PlanAction `json:"action"`
+	// PrevMonthlyCost is the estimated monthly cost of the resource
+	// before the change set is deployed, this is 0 for new resources.
+	PrevMonthlyCost float64 `json:"prevMonthlyCost"`
+	// NewMonthlyCost is the estimated monthly cost of the resource
+	// after the change set is deployed, this is 0 for destroyed resources.
+	NewMonthlyCost float64 `json:"newMonthlyCost"`
+	// Error holds the reason the cost of the resource could not be estimated,
+	// when set, the resource does not contribute to the estimated totals.
+	Error string `json:"error,omitempty"`
+}
+
+// MonthlyCostChange returns the estimated change in monthly cost
+// for the resource.
+func (e *ResourceCostEstimate) MonthlyCostChange() float64 {
+	return e.NewMonthlyCost - e.PrevMonthlyCost
+}
+
+// NewCostEstimate creates a cost estimate from the provided resource
+// cost estimates, calculating the totals for each type of change.
+// This returns nil when there are no resource cost estimates.
+func NewCostEstimate(resources []*ResourceCostEstimate) *CostEstimate {
+	if len(resources) == 0 {
+		return nil
+	}
+
+	estimate := &CostEstimate{
+		Resources: slices.Clone(resources),
+	}
+	slices.SortStableFunc(estimate.Resources, func(a, b *ResourceCostEstimate) int {
+		return comparePlanElements(a.ChildPath, a.ResourceName, b.ChildPath, b.ResourceName)
+	})
+
+	for _, resource := range estimate.Resources {
+		if resource.Error != "" {
+			continue
+		}
+
+		switch resource.Action {
+		case PlanActionCreate:
+			estimate.CreateMonthlyCost += resource.NewMonthlyCost
+		case PlanActionUpdate, PlanActionRecreate:
+			estimate.UpdateMonthlyCostChange += resource.MonthlyCostChange()
+		case PlanActionDelete:
+			estimate.DestroyMonthlyCost += resource.PrevMonthlyCost
+		}
+		estimate.MonthlyCostChange += resource.MonthlyCostChange()
+	}
+
+	return estimate
+}
+
+// ChildResourceCostEstimates produces copies of the resource cost estimates
+// of a child blueprint where the child path is relative to the parent blueprint.
+func ChildResourceCostEstimates(childName string, estimate *CostEstimate) []*ResourceCostEstimate {
+	if estimate == nil {
+		return []*ResourceCostEstimate{}
+	}
+
+	resources := make([]*ResourceCostEstimate, 0, len(estimate.Resources))
+	for _, resource := range estimate.Resources {
+		resourceCopy := *resource
+		resourceCopy.ChildPath = childName
+		if resource.ChildPath != "" {
+			resourceCopy.ChildPath = buildChildPath(childName, resource.ChildPath)
+		}
+		resources = append(resources, &resourceCopy)
+	}
+
+	return resources
+}
@@ -0,0 +1,62 @@
+package changes
+
+import (
+	"slices"
+	"strings"
+)
+
+// Deprecation holds information about a deprecated resource type
+// or resource spec field that is used by a resource that will be created
+// or updated when deploying a change set.
+// Deprecations are reported so that users can plan migrations away from
+// deprecated resource types and fields before they are removed by providers.
+type Deprecation struct {
+	// ChildPath is the path of the child blueprint the resource belongs to,
+	// (e.g. "networking.subnets"), this is empty for the root blueprint.
+	ChildPath    string `json:"childPath,omitempty"`
+	ResourceName string `json:"resourceName"`
+	ResourceType string `json:"resourceType"`
+	// FieldPath is the path of the deprecated field in the resource
+	// (e.g. "spec.runtime"), this is empty when the resource type is deprecated.
+	FieldPath string `json:"fieldPath,omitempty"`
+	// Message holds the guidance provided by the provider for migrating away
+	// from the deprecated resource type or field.
+	Message string `json:"message,omitempty"`
+}
+
+// SortDeprecations produces a copy of the provided deprecations ordered by
+// child path, resource name and field path, where a deprecated resource type
+// is ordered before the deprecated fields of the same resource.
+// This returns nil when there are no deprecations.
+func SortDeprecations(deprecations []*Deprecation) []*Deprecation {
+	if len(deprecations) == 0 {
+		return nil
+	}
+
+	sorted := slices.Clone(deprecations)
+	slices.SortStableFunc(sorted, func(a, b *Deprecation) int {
+		elementComparison := comparePlanElements(a.ChildPath, a.ResourceName, b.ChildPath, b.ResourceName)
+		if elementComparison != 0 {
+			return elementComparison
+		}
+		return strings.Compare(a.FieldPath, b.FieldPath)
+	})
+
+	return sorted
+}
+
+// ChildDeprecations produces copies of the deprecations of a child blueprint
+// where the child path is relative to the parent blueprint.
+func ChildDeprecations(childName string, deprecations []*Deprecation) []*Deprecation {
+	childDeprecations := make([]*Deprecation, 0, len(deprecations))
+	for _, deprecation := range deprecations {
+		deprecationCopy := *deprecation
+		deprecationCopy.ChildPath = childName
+		if deprecation.ChildPath != "" {
+			deprecationCopy.ChildPath = buildChildPath(childName, deprecation.ChildPath)
+		}
+		childDeprecations = append(childDeprecations, &deprecationCopy)
+	}
+
+	return childDeprecations
+}
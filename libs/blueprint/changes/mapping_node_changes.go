@@ -2,12 +2,42 @@ package changes
 
 import (
 	"slices"
+	"strings"
 
 	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
 )
 
+// CompareMappingNodes produces the field changes between the current value
+// and the new value of a mapping node, such as two versions of a resource spec.
+// The root path is used as the prefix for the paths of the field changes (e.g. "spec").
+// Field changes are ordered by field path.
+func CompareMappingNodes(
+	newValue *bpcore.MappingNode,
+	currentValue *bpcore.MappingNode,
+	rootPath string,
+) *provider.Changes {
+	changes := &provider.Changes{}
+	collectMappingNodeChanges(
+		changes,
+		newValue,
+		currentValue,
+		&fieldChangeContext{
+			currentPath: rootPath,
+		},
+	)
+
+	compareFieldPaths := func(a, b provider.FieldChange) int {
+		return strings.Compare(a.FieldPath, b.FieldPath)
+	}
+	slices.SortFunc(changes.ModifiedFields, compareFieldPaths)
+	slices.SortFunc(changes.NewFields, compareFieldPaths)
+	slices.Sort(changes.RemovedFields)
+
+	return changes
+}
+
 func collectMappingNodeChanges(
 	changes *provider.Changes,
 	newValue *bpcore.MappingNode,
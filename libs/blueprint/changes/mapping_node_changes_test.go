@@ -0,0 +1,70 @@
+package changes
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/stretchr/testify/suite"
+)
+
+type MappingNodeChangesTestSuite struct {
+	suite.Suite
+}
+
+func (s *MappingNodeChangesTestSuite) Test_compares_two_versions_of_a_resource_spec() {
+	previousSpec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"tableName":   core.MappingNodeFromString("orders"),
+			"billingMode": core.MappingNodeFromString("PROVISIONED"),
+			"tags": {
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("team:orders"),
+				},
+			},
+		},
+	}
+	currentSpec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"tableName": core.MappingNodeFromString("orders-v2"),
+			"tags": {
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("team:orders"),
+				},
+			},
+			"streamEnabled": core.MappingNodeFromBool(true),
+		},
+	}
+
+	fieldChanges := CompareMappingNodes(currentSpec, previousSpec, "spec")
+
+	// Removed scalar values are represented as both a modification to a nil value
+	// and a removed field.
+	s.Require().Len(fieldChanges.ModifiedFields, 2)
+	s.Equal("spec.billingMode", fieldChanges.ModifiedFields[0].FieldPath)
+	s.Equal("PROVISIONED", core.StringValue(fieldChanges.ModifiedFields[0].PrevValue))
+	s.Nil(fieldChanges.ModifiedFields[0].NewValue)
+	s.Equal("spec.tableName", fieldChanges.ModifiedFields[1].FieldPath)
+	s.Equal("orders", core.StringValue(fieldChanges.ModifiedFields[1].PrevValue))
+	s.Equal("orders-v2", core.StringValue(fieldChanges.ModifiedFields[1].NewValue))
+	s.Require().Len(fieldChanges.NewFields, 1)
+	s.Equal("spec.streamEnabled", fieldChanges.NewFields[0].FieldPath)
+	s.Equal([]string{"spec.billingMode"}, fieldChanges.RemovedFields)
+}
+
+func (s *MappingNodeChangesTestSuite) Test_produces_no_changes_for_equal_values() {
+	spec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"tableName": core.MappingNodeFromString("orders"),
+		},
+	}
+
+	fieldChanges := CompareMappingNodes(spec, core.CopyMappingNode(spec), "spec")
+
+	s.Empty(fieldChanges.ModifiedFields)
+	s.Empty(fieldChanges.NewFields)
+	s.Empty(fieldChanges.RemovedFields)
+}
+
+func TestMappingNodeChangesTestSuite(t *testing.T) {
+	suite.Run(t, new(MappingNodeChangesTestSuite))
+}
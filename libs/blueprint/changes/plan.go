@@ -0,0 +1,412 @@
+package changes
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// PlanFormatVersion is the version of the plan document format
+// produced by BuildPlan.
+// This must be incremented for any change to the plan document
+// that is not backwards compatible so that tools that consume plans
+// (e.g. CI policy checks) can detect the format they are working with.
+const PlanFormatVersion = "1"
+
+// PlanAction is the action that will be taken for an element
+// in a plan document.
+type PlanAction string
+
+const (
+	// PlanActionCreate is used for elements that will be created.
+	PlanActionCreate PlanAction = "create"
+	// PlanActionUpdate is used for elements that will be updated in place.
+	PlanActionUpdate PlanAction = "update"
+	// PlanActionRecreate is used for elements that will be destroyed
+	// and created again.
+	PlanActionRecreate PlanAction = "recreate"
+	// PlanActionDelete is used for elements that will be removed.
+	PlanActionDelete PlanAction = "delete"
+	// PlanActionRetain is used for resources that will be removed
+	// from the blueprint instance but kept in the upstream provider.
+	PlanActionRetain PlanAction = "retain"
+)
+
+// Plan is a stable, machine-readable representation of a change set
+// that is intended for tools that review or apply policies to changes
+// before they are deployed.
+// Unlike BlueprintChanges, a plan is a flat list of changes for all elements
+// in the blueprint and its descendants, where each element is identified
+// by the path of the child blueprint it belongs to and its name.
+// Values of sensitive fields are redacted.
+type Plan struct {
+	// FormatVersion is the version of the plan document format.
+	FormatVersion string `json:"formatVersion"`
+	// Summary holds the number of resources that will be changed,
+	// including resources in child blueprints.
+	Summary ChangesSummary `json:"summary"`
+	// Resources holds the changes for resources, ordered by child path and name.
+	Resources []*PlanResourceChange `json:"resources"`
+	// Links holds the changes for links between resources,
+	// ordered by child path and logical link name.
+	Links []*PlanLinkChange `json:"links"`
+	// Children holds the changes for child blueprints, ordered by child path.
+	Children []*PlanChildChange `json:"children"`
+	// Exports holds the changes for exports, ordered by child path and name.
+	Exports []*PlanExportChange `json:"exports"`
+	// CostEstimate holds the estimated change in monthly cost for the change set,
+	// this is omitted when none of the changed resources support cost estimation.
+	CostEstimate *CostEstimate `json:"costEstimate,omitempty"`
+	// Deprecations holds the deprecated resource types and resource spec fields
+	// used by resources in the change set, ordered by child path, resource name
+	// and field path.
+	Deprecations []*Deprecation `json:"deprecations,omitempty"`
+}
+
+// PlanResourceChange holds the planned changes for a resource.
+type PlanResourceChange struct {
+	// ChildPath is the path of the child blueprint the resource belongs to,
+	// (e.g. "networking.subnets"), this is empty for the root blueprint.
+	ChildPath    string     `json:"childPath,omitempty"`
+	ResourceName string     `json:"resourceName"`
+	ResourceType string     `json:"resourceType,omitempty"`
+	Action       PlanAction `json:"action"`
+	// Fields holds the field-level changes for the resource,
+	// ordered by field path.
+	Fields []*PlanFieldChange `json:"fields,omitempty"`
+	// FieldsKnownOnDeploy holds the paths of fields for which changes
+	// can only be determined when the blueprint is deployed.
+	FieldsKnownOnDeploy []string `json:"fieldsKnownOnDeploy,omitempty"`
+	// ConditionKnownOnDeploy is true when whether the resource will be
+	// deployed can only be determined when the blueprint is deployed.
+	ConditionKnownOnDeploy bool `json:"conditionKnownOnDeploy,omitempty"`
+}
+
+// PlanFieldChange holds the planned change for a field of a resource or link.
+type PlanFieldChange struct {
+	Path   string     `json:"path"`
+	Action PlanAction `json:"action"`
+	// Before is the current value of the field, this is omitted for new fields
+	// and for sensitive fields.
+	Before *core.MappingNode `json:"before,omitempty"`
+	// After is the planned value of the field, this is omitted for removed fields
+	// and for sensitive fields.
+	After *core.MappingNode `json:"after,omitempty"`
+	// ForcesRecreate is true when the change to the field requires
+	// the resource or link to be recreated.
+	ForcesRecreate bool `json:"forcesRecreate,omitempty"`
+	Sensitive      bool `json:"sensitive,omitempty"`
+}
+
+// PlanLinkChange holds the planned changes for a link between two resources.
+type PlanLinkChange struct {
+	ChildPath string     `json:"childPath,omitempty"`
+	ResourceA string     `json:"resourceA"`
+	ResourceB string     `json:"resourceB"`
+	Action    PlanAction `json:"action"`
+	// Fields holds the field-level changes for the link data,
+	// ordered by field path.
+	Fields []*PlanFieldChange `json:"fields,omitempty"`
+}
+
+// PlanChildChange holds the planned action for a child blueprint.
+type PlanChildChange struct {
+	// ChildPath is the full path of the child blueprint,
+	// (e.g. "networking.subnets").
+	ChildPath string     `json:"childPath"`
+	Action    PlanAction `json:"action"`
+}
+
+// PlanExportChange holds the planned change for a blueprint export.
+type PlanExportChange struct {
+	ChildPath  string            `json:"childPath,omitempty"`
+	ExportName string            `json:"exportName"`
+	Action     PlanAction        `json:"action"`
+	Before     *core.MappingNode `json:"before,omitempty"`
+	After      *core.MappingNode `json:"after,omitempty"`
+}
+
+// BuildPlan produces a plan document from the provided change set.
+func BuildPlan(blueprintChanges *BlueprintChanges) *Plan {
+	plan := &Plan{
+		FormatVersion: PlanFormatVersion,
+		Resources:     []*PlanResourceChange{},
+		Links:         []*PlanLinkChange{},
+		Children:      []*PlanChildChange{},
+		Exports:       []*PlanExportChange{},
+	}
+	if blueprintChanges == nil {
+		return plan
+	}
+
+	groups := GroupChangesByChild(blueprintChanges)
+	plan.Summary = groups[0].TotalSummary
+	plan.CostEstimate = blueprintChanges.CostEstimate
+	plan.Deprecations = blueprintChanges.Deprecations
+	addBlueprintChangesToPlan(blueprintChanges, "", 0, plan)
+
+	slices.SortStableFunc(plan.Resources, func(a, b *PlanResourceChange) int {
+		return comparePlanElements(a.ChildPath, a.ResourceName, b.ChildPath, b.ResourceName)
+	})
+	slices.SortStableFunc(plan.Links, func(a, b *PlanLinkChange) int {
+		return comparePlanElements(
+			a.ChildPath,
+			core.LogicalLinkName(a.ResourceA, a.ResourceB),
+			b.ChildPath,
+			core.LogicalLinkName(b.ResourceA, b.ResourceB),
+		)
+	})
+	slices.SortStableFunc(plan.Children, func(a, b *PlanChildChange) int {
+		return strings.Compare(a.ChildPath, b.ChildPath)
+	})
+	slices.SortStableFunc(plan.Exports, func(a, b *PlanExportChange) int {
+		return comparePlanElements(a.ChildPath, a.ExportName, b.ChildPath, b.ExportName)
+	})
+
+	return plan
+}
+
+func addBlueprintChangesToPlan(
+	blueprintChanges *BlueprintChanges,
+	childPath string,
+	depth int,
+	plan *Plan,
+) {
+	for resourceName, resourceChanges := range blueprintChanges.NewResources {
+		addResourceChangesToPlan(childPath, resourceName, PlanActionCreate, &resourceChanges, plan)
+	}
+
+	for resourceName, resourceChanges := range blueprintChanges.ResourceChanges {
+		action := PlanActionUpdate
+		if resourceChanges.MustRecreate {
+			action = PlanActionRecreate
+		} else if !resourceHasChanges(&resourceChanges) {
+			continue
+		}
+		addResourceChangesToPlan(childPath, resourceName, action, &resourceChanges, plan)
+	}
+
+	for _, resourceName := range blueprintChanges.RemovedResources {
+		plan.Resources = append(plan.Resources, &PlanResourceChange{
+			ChildPath:    childPath,
+			ResourceName: resourceName,
+			Action:       PlanActionDelete,
+		})
+	}
+
+	for _, resourceName := range blueprintChanges.RetainedResources {
+		plan.Resources = append(plan.Resources, &PlanResourceChange{
+			ChildPath:    childPath,
+			ResourceName: resourceName,
+			Action:       PlanActionRetain,
+		})
+	}
+
+	for _, linkName := range blueprintChanges.RemovedLinks {
+		resourceA, resourceB, _ := strings.Cut(linkName, "::")
+		plan.Links = append(plan.Links, &PlanLinkChange{
+			ChildPath: childPath,
+			ResourceA: resourceA,
+			ResourceB: resourceB,
+			Action:    PlanActionDelete,
+		})
+	}
+
+	addExportChangesToPlan(blueprintChanges, childPath, plan)
+
+	if depth >= maxGroupChildDepth {
+		return
+	}
+
+	for childName, newChild := range blueprintChanges.NewChildren {
+		path := buildChildPath(childPath, childName)
+		plan.Children = append(plan.Children, &PlanChildChange{
+			ChildPath: path,
+			Action:    PlanActionCreate,
+		})
+		addBlueprintChangesToPlan(newBlueprintDefinitionToChanges(&newChild), path, depth+1, plan)
+	}
+
+	for childName, childChanges := range blueprintChanges.ChildChanges {
+		action := PlanActionUpdate
+		if slices.Contains(blueprintChanges.RecreateChildren, childName) {
+			action = PlanActionRecreate
+		}
+		path := buildChildPath(childPath, childName)
+		plan.Children = append(plan.Children, &PlanChildChange{
+			ChildPath: path,
+			Action:    action,
+		})
+		addBlueprintChangesToPlan(&childChanges, path, depth+1, plan)
+	}
+
+	for _, childName := range blueprintChanges.RecreateChildren {
+		if _, hasChanges := blueprintChanges.ChildChanges[childName]; !hasChanges {
+			plan.Children = append(plan.Children, &PlanChildChange{
+				ChildPath: buildChildPath(childPath, childName),
+				Action:    PlanActionRecreate,
+			})
+		}
+	}
+
+	for _, childName := range blueprintChanges.RemovedChildren {
+		plan.Children = append(plan.Children, &PlanChildChange{
+			ChildPath: buildChildPath(childPath, childName),
+			Action:    PlanActionDelete,
+		})
+	}
+}
+
+func addResourceChangesToPlan(
+	childPath string,
+	resourceName string,
+	action PlanAction,
+	resourceChanges *provider.Changes,
+	plan *Plan,
+) {
+	plan.Resources = append(plan.Resources, &PlanResourceChange{
+		ChildPath:    childPath,
+		ResourceName: resourceName,
+		ResourceType: GetResourceTypeFromResolved(
+			resourceChanges.AppliedResourceInfo.ResourceWithResolvedSubs,
+		),
+		Action: action,
+		Fields: planFieldChanges(
+			toFieldChangePtrs(resourceChanges.NewFields),
+			toFieldChangePtrs(resourceChanges.ModifiedFields),
+			resourceChanges.RemovedFields,
+		),
+		FieldsKnownOnDeploy:    sortedCopy(resourceChanges.FieldChangesKnownOnDeploy),
+		ConditionKnownOnDeploy: resourceChanges.ConditionKnownOnDeploy,
+	})
+
+	for linkedTo, linkChanges := range resourceChanges.NewOutboundLinks {
+		addLinkChangesToPlan(childPath, resourceName, linkedTo, PlanActionCreate, &linkChanges, plan)
+	}
+
+	for linkedTo, linkChanges := range resourceChanges.OutboundLinkChanges {
+		addLinkChangesToPlan(childPath, resourceName, linkedTo, PlanActionUpdate, &linkChanges, plan)
+	}
+
+	for _, linkedTo := range resourceChanges.RemovedOutboundLinks {
+		plan.Links = append(plan.Links, &PlanLinkChange{
+			ChildPath: childPath,
+			ResourceA: resourceName,
+			ResourceB: linkedTo,
+			Action:    PlanActionDelete,
+		})
+	}
+}
+
+func addLinkChangesToPlan(
+	childPath string,
+	resourceA string,
+	resourceB string,
+	action PlanAction,
+	linkChanges *provider.LinkChanges,
+	plan *Plan,
+) {
+	plan.Links = append(plan.Links, &PlanLinkChange{
+		ChildPath: childPath,
+		ResourceA: resourceA,
+		ResourceB: resourceB,
+		Action:    action,
+		Fields: planFieldChanges(
+			linkChanges.NewFields,
+			linkChanges.ModifiedFields,
+			linkChanges.RemovedFields,
+		),
+	})
+}
+
+func addExportChangesToPlan(
+	blueprintChanges *BlueprintChanges,
+	childPath string,
+	plan *Plan,
+) {
+	for exportName, exportChange := range blueprintChanges.NewExports {
+		plan.Exports = append(plan.Exports, &PlanExportChange{
+			ChildPath:  childPath,
+			ExportName: exportName,
+			Action:     PlanActionCreate,
+			After:      exportChange.NewValue,
+		})
+	}
+
+	for exportName, exportChange := range blueprintChanges.ExportChanges {
+		plan.Exports = append(plan.Exports, &PlanExportChange{
+			ChildPath:  childPath,
+			ExportName: exportName,
+			Action:     PlanActionUpdate,
+			Before:     exportChange.PrevValue,
+			After:      exportChange.NewValue,
+		})
+	}
+
+	for _, exportName := range blueprintChanges.RemovedExports {
+		plan.Exports = append(plan.Exports, &PlanExportChange{
+			ChildPath:  childPath,
+			ExportName: exportName,
+			Action:     PlanActionDelete,
+		})
+	}
+}
+
+func planFieldChanges(
+	newFields []*provider.FieldChange,
+	modifiedFields []*provider.FieldChange,
+	removedFields []string,
+) []*PlanFieldChange {
+	fields := []*PlanFieldChange{}
+	for _, field := range newFields {
+		fields = append(fields, planFieldChange(field, PlanActionCreate))
+	}
+
+	for _, field := range modifiedFields {
+		fields = append(fields, planFieldChange(field, PlanActionUpdate))
+	}
+
+	for _, fieldPath := range removedFields {
+		fields = append(fields, &PlanFieldChange{
+			Path:   fieldPath,
+			Action: PlanActionDelete,
+		})
+	}
+
+	slices.SortStableFunc(fields, func(a, b *PlanFieldChange) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return fields
+}
+
+func planFieldChange(field *provider.FieldChange, action PlanAction) *PlanFieldChange {
+	fieldChange := &PlanFieldChange{
+		Path:           field.FieldPath,
+		Action:         action,
+		ForcesRecreate: field.MustRecreate,
+		Sensitive:      field.Sensitive,
+	}
+	if !field.Sensitive {
+		fieldChange.Before = field.PrevValue
+		fieldChange.After = field.NewValue
+	}
+
+	return fieldChange
+}
+
+func toFieldChangePtrs(fieldChanges []provider.FieldChange) []*provider.FieldChange {
+	ptrs := make([]*provider.FieldChange, 0, len(fieldChanges))
+	for i := range fieldChanges {
+		ptrs = append(ptrs, &fieldChanges[i])
+	}
+	return ptrs
+}
+
+func comparePlanElements(childPathA, nameA, childPathB, nameB string) int {
+	if childPathA != childPathB {
+		return strings.Compare(childPathA, childPathB)
+	}
+	return strings.Compare(nameA, nameB)
+}
@@ -0,0 +1,207 @@
+package changes
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/stretchr/testify/suite"
+)
+
+type PlanTestSuite struct {
+	suite.Suite
+}
+
+func TestPlanTestSuite(t *testing.T) {
+	suite.Run(t, new(PlanTestSuite))
+}
+
+func (s *PlanTestSuite) Test_builds_empty_plan_for_nil_changes() {
+	plan := BuildPlan(nil)
+
+	s.Equal(PlanFormatVersion, plan.FormatVersion)
+	s.Empty(plan.Resources)
+	s.Empty(plan.Links)
+	s.Empty(plan.Children)
+	s.Empty(plan.Exports)
+}
+
+func (s *PlanTestSuite) Test_builds_plan_for_changes_across_child_blueprints() {
+	plan := BuildPlan(createPlanTestChanges())
+
+	s.Equal(ChangesSummary{Create: 2, Update: 1, Recreate: 1, Delete: 1}, plan.Summary)
+
+	s.Require().Len(plan.Resources, 5)
+	s.Equal("legacyQueue", plan.Resources[0].ResourceName)
+	s.Equal(PlanActionDelete, plan.Resources[0].Action)
+	s.Equal(
+		&PlanResourceChange{
+			ResourceName: "ordersFunction",
+			ResourceType: "aws/lambda/function",
+			Action:       PlanActionUpdate,
+			Fields: []*PlanFieldChange{
+				{
+					Path:   "spec.environment.variables.API_KEY",
+					Action: PlanActionUpdate,
+					// Values for sensitive fields must be redacted.
+					Sensitive: true,
+				},
+				{
+					Path:   "spec.memorySize",
+					Action: PlanActionUpdate,
+					Before: core.MappingNodeFromInt(128),
+					After:  core.MappingNodeFromInt(256),
+				},
+				{
+					Path:   "spec.tracingConfig",
+					Action: PlanActionDelete,
+				},
+			},
+			FieldsKnownOnDeploy: []string{"spec.layers"},
+		},
+		plan.Resources[1],
+	)
+	s.Equal("ordersTable", plan.Resources[2].ResourceName)
+	s.Equal(PlanActionCreate, plan.Resources[2].Action)
+	s.Equal("ordersTopic", plan.Resources[3].ResourceName)
+	s.Equal(PlanActionRecreate, plan.Resources[3].Action)
+	s.True(plan.Resources[3].Fields[0].ForcesRecreate)
+	s.Equal("networking", plan.Resources[4].ChildPath)
+	s.Equal("vpc", plan.Resources[4].ResourceName)
+	s.Equal(PlanActionCreate, plan.Resources[4].Action)
+
+	s.Equal(
+		[]*PlanLinkChange{
+			{
+				ResourceA: "ordersFunction",
+				ResourceB: "legacyQueue",
+				Action:    PlanActionDelete,
+			},
+			{
+				ResourceA: "ordersFunction",
+				ResourceB: "ordersTable",
+				Action:    PlanActionCreate,
+				Fields: []*PlanFieldChange{
+					{
+						Path:   "ordersFunction.spec.environment.variables.TABLE_NAME",
+						Action: PlanActionCreate,
+						After:  core.MappingNodeFromString("orders"),
+					},
+				},
+			},
+		},
+		plan.Links,
+	)
+
+	s.Equal(
+		[]*PlanChildChange{
+			{ChildPath: "legacy", Action: PlanActionDelete},
+			{ChildPath: "networking", Action: PlanActionCreate},
+		},
+		plan.Children,
+	)
+
+	s.Equal(
+		[]*PlanExportChange{
+			{
+				ExportName: "tableName",
+				Action:     PlanActionCreate,
+				After:      core.MappingNodeFromString("orders"),
+			},
+		},
+		plan.Exports,
+	)
+}
+
+func (s *PlanTestSuite) Test_plan_document_uses_stable_json_field_names() {
+	plan := BuildPlan(&BlueprintChanges{
+		RemovedResources: []string{"legacyQueue"},
+	})
+
+	serialised, err := json.Marshal(plan)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{
+			"formatVersion": "1",
+			"summary": {"create": 0, "update": 0, "recreate": 0, "delete": 1, "retain": 0},
+			"resources": [{"resourceName": "legacyQueue", "action": "delete"}],
+			"links": [],
+			"children": [],
+			"exports": []
+		}`,
+		string(serialised),
+	)
+}
+
+func createPlanTestChanges() *BlueprintChanges {
+	return &BlueprintChanges{
+		NewResources: map[string]provider.Changes{
+			"ordersTable": {},
+		},
+		ResourceChanges: map[string]provider.Changes{
+			"ordersFunction": {
+				AppliedResourceInfo: provider.ResourceInfo{
+					ResourceWithResolvedSubs: &provider.ResolvedResource{
+						Type: &schema.ResourceTypeWrapper{Value: "aws/lambda/function"},
+					},
+				},
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.memorySize",
+						PrevValue: core.MappingNodeFromInt(128),
+						NewValue:  core.MappingNodeFromInt(256),
+					},
+					{
+						FieldPath: "spec.environment.variables.API_KEY",
+						PrevValue: core.MappingNodeFromString("old-secret"),
+						NewValue:  core.MappingNodeFromString("new-secret"),
+						Sensitive: true,
+					},
+				},
+				RemovedFields:             []string{"spec.tracingConfig"},
+				FieldChangesKnownOnDeploy: []string{"spec.layers"},
+				NewOutboundLinks: map[string]provider.LinkChanges{
+					"ordersTable": {
+						NewFields: []*provider.FieldChange{
+							{
+								FieldPath: "ordersFunction.spec.environment.variables.TABLE_NAME",
+								NewValue:  core.MappingNodeFromString("orders"),
+							},
+						},
+					},
+				},
+				RemovedOutboundLinks: []string{"legacyQueue"},
+			},
+			"ordersTopic": {
+				MustRecreate: true,
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath:    "spec.fifo",
+						PrevValue:    core.MappingNodeFromBool(false),
+						NewValue:     core.MappingNodeFromBool(true),
+						MustRecreate: true,
+					},
+				},
+			},
+			// Resources without changes should not be included in the plan.
+			"ordersBucket": {},
+		},
+		RemovedResources: []string{"legacyQueue"},
+		NewChildren: map[string]NewBlueprintDefinition{
+			"networking": {
+				NewResources: map[string]provider.Changes{
+					"vpc": {},
+				},
+			},
+		},
+		RemovedChildren: []string{"legacy"},
+		NewExports: map[string]provider.FieldChange{
+			"tableName": {
+				FieldPath: "resources.ordersTable.spec.tableName",
+				NewValue:  core.MappingNodeFromString("orders"),
+			},
+		},
+	}
+}
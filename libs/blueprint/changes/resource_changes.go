@@ -70,6 +70,7 @@ func (s *defaultResourceChangeGenerator) GenerateChanges(
 		return &provider.Changes{
 			AppliedResourceInfo: *resourceInfo,
 			MustRecreate:        true,
+			RecreateTriggers:    []string{"type"},
 		}, nil
 	}
 
@@ -96,6 +97,9 @@ func (s *defaultResourceChangeGenerator) GenerateChanges(
 	)
 
 	changes.MustRecreate = mustRecreateResource(changes.ModifiedFields, resourceInfo)
+	if changes.MustRecreate {
+		changes.RecreateTriggers = provider.RecreateTriggerFields(changes.ModifiedFields)
+	}
 	currentResourceMetadata := getResourceMetadataFromState(resourceInfo.CurrentResourceState)
 	collectMetadataFieldChanges(
 		changes,
@@ -203,6 +203,8 @@ func reverseResourceChanges(original provider.Changes) provider.Changes {
 	reversed := provider.Changes{
 		AppliedResourceInfo:       original.AppliedResourceInfo,
 		MustRecreate:              original.MustRecreate,
+		ReplaceStrategy:           original.ReplaceStrategy,
+		RecreateTriggers:          original.RecreateTriggers,
 		ModifiedFields:            make([]provider.FieldChange, len(original.ModifiedFields)),
 		NewFields:                 make([]provider.FieldChange, 0),
 		RemovedFields:             make([]string, 0),
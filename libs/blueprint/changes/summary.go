@@ -0,0 +1,286 @@
+package changes
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// The maximum depth of child blueprints to group changes for,
+// this protects against cycles in nested blueprint structures.
+const maxGroupChildDepth = 10
+
+// ChildChangeAction is the action that will be taken for a child blueprint
+// when deploying a change set.
+type ChildChangeAction string
+
+const (
+	// ChildChangeActionCreate is used for a new child blueprint.
+	ChildChangeActionCreate ChildChangeAction = "create"
+	// ChildChangeActionUpdate is used for an existing child blueprint
+	// that will be updated.
+	ChildChangeActionUpdate ChildChangeAction = "update"
+	// ChildChangeActionRecreate is used for an existing child blueprint
+	// that will be destroyed and created again.
+	ChildChangeActionRecreate ChildChangeAction = "recreate"
+	// ChildChangeActionDelete is used for a child blueprint that will be removed.
+	ChildChangeActionDelete ChildChangeAction = "delete"
+)
+
+// ChangesSummary holds the number of resources that will be
+// created, updated, recreated, deleted or retained when deploying a change set.
+type ChangesSummary struct {
+	Create   int `json:"create"`
+	Update   int `json:"update"`
+	Recreate int `json:"recreate"`
+	Delete   int `json:"delete"`
+	Retain   int `json:"retain"`
+}
+
+// Total returns the total number of resources that will be changed.
+func (s ChangesSummary) Total() int {
+	return s.Create + s.Update + s.Recreate + s.Delete + s.Retain
+}
+
+// Add returns a new summary that combines the counts
+// of the current summary and the provided summary.
+func (s ChangesSummary) Add(other ChangesSummary) ChangesSummary {
+	return ChangesSummary{
+		Create:   s.Create + other.Create,
+		Update:   s.Update + other.Update,
+		Recreate: s.Recreate + other.Recreate,
+		Delete:   s.Delete + other.Delete,
+		Retain:   s.Retain + other.Retain,
+	}
+}
+
+// String renders a human-readable summary,
+// for example, "2 to create, 1 to update, 0 to delete".
+// Recreate and retain counts are only included when they are non-zero.
+func (s ChangesSummary) String() string {
+	parts := []string{
+		fmt.Sprintf("%d to create", s.Create),
+		fmt.Sprintf("%d to update", s.Update),
+	}
+	if s.Recreate > 0 {
+		parts = append(parts, fmt.Sprintf("%d to recreate", s.Recreate))
+	}
+	parts = append(parts, fmt.Sprintf("%d to delete", s.Delete))
+	if s.Retain > 0 {
+		parts = append(parts, fmt.Sprintf("%d to retain", s.Retain))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// ChildChangesGroup holds the resource changes for a single blueprint
+// in a change set, this is either the root blueprint or a child blueprint
+// included in the root blueprint or one of its descendants.
+type ChildChangesGroup struct {
+	// ChildPath is the path of child blueprint names from the root blueprint
+	// to the blueprint that the changes belong to, separated by ".".
+	// (e.g. "networking.subnets").
+	// This is empty for the root blueprint.
+	ChildPath string `json:"childPath"`
+	// Depth is the number of child blueprints between the root blueprint
+	// and the blueprint that the changes belong to, the root blueprint
+	// has a depth of 0.
+	Depth int `json:"depth"`
+	// Action is the action that will be taken for the child blueprint,
+	// this is empty for the root blueprint.
+	Action ChildChangeAction `json:"action,omitempty"`
+	// Summary holds the number of resource changes for the blueprint,
+	// excluding changes in descendant child blueprints.
+	Summary ChangesSummary `json:"summary"`
+	// TotalSummary holds the number of resource changes for the blueprint,
+	// including changes in descendant child blueprints.
+	// This is useful for rendering collapsed views of a child blueprint.
+	TotalSummary       ChangesSummary `json:"totalSummary"`
+	NewResources       []string       `json:"newResources,omitempty"`
+	UpdatedResources   []string       `json:"updatedResources,omitempty"`
+	RecreatedResources []string       `json:"recreatedResources,omitempty"`
+	RemovedResources   []string       `json:"removedResources,omitempty"`
+	RetainedResources  []string       `json:"retainedResources,omitempty"`
+	// Replacements holds details about the resources that will be recreated,
+	// in the same order as RecreatedResources.
+	Replacements []*ResourceReplacement `json:"replacements,omitempty"`
+}
+
+// ResourceReplacement describes a resource that will be destroyed and
+// created again when deploying a change set.
+type ResourceReplacement struct {
+	ResourceName string `json:"resourceName"`
+	// Strategy is the replace strategy set for the resource in the blueprint,
+	// this is empty when the default strategy for the resource type will be used.
+	Strategy schema.ReplaceStrategy `json:"strategy,omitempty"`
+	// TriggerFields holds the paths of the fields with changes that force
+	// the resource to be recreated.
+	// This is empty when the resource must be recreated due to the removal
+	// of a resource that it depended on.
+	TriggerFields []string `json:"triggerFields,omitempty"`
+}
+
+// GroupChangesByChild groups the resource changes in the provided change set
+// by the blueprint they belong to, producing a summary of changes for each
+// blueprint.
+// This is useful for presenting changes for blueprints with many includes,
+// where a flat list of resources is hard to follow.
+//
+// The root blueprint group is always the first group followed by groups
+// for child blueprints in depth-first order, with children at the same level
+// sorted by name.
+func GroupChangesByChild(blueprintChanges *BlueprintChanges) []*ChildChangesGroup {
+	if blueprintChanges == nil {
+		return []*ChildChangesGroup{}
+	}
+
+	groups := []*ChildChangesGroup{}
+	groupBlueprintChanges(blueprintChanges, "", "", 0, &groups)
+	return groups
+}
+
+func groupBlueprintChanges(
+	blueprintChanges *BlueprintChanges,
+	childPath string,
+	action ChildChangeAction,
+	depth int,
+	groups *[]*ChildChangesGroup,
+) ChangesSummary {
+	group := &ChildChangesGroup{
+		ChildPath: childPath,
+		Depth:     depth,
+		Action:    action,
+	}
+	*groups = append(*groups, group)
+
+	group.NewResources = sortedKeys(blueprintChanges.NewResources)
+	for _, resourceName := range sortedKeys(blueprintChanges.ResourceChanges) {
+		resourceChanges := blueprintChanges.ResourceChanges[resourceName]
+		if resourceChanges.MustRecreate {
+			group.RecreatedResources = append(group.RecreatedResources, resourceName)
+			group.Replacements = append(group.Replacements, &ResourceReplacement{
+				ResourceName:  resourceName,
+				Strategy:      resourceChanges.ReplaceStrategy,
+				TriggerFields: resourceChanges.RecreateTriggers,
+			})
+		} else if resourceHasChanges(&resourceChanges) {
+			group.UpdatedResources = append(group.UpdatedResources, resourceName)
+		}
+	}
+	group.RemovedResources = sortedCopy(blueprintChanges.RemovedResources)
+	group.RetainedResources = sortedCopy(blueprintChanges.RetainedResources)
+	group.Summary = ChangesSummary{
+		Create:   len(group.NewResources),
+		Update:   len(group.UpdatedResources),
+		Recreate: len(group.RecreatedResources),
+		Delete:   len(group.RemovedResources),
+		Retain:   len(group.RetainedResources),
+	}
+
+	total := group.Summary
+	if depth < maxGroupChildDepth {
+		total = total.Add(groupChildChanges(blueprintChanges, childPath, depth, groups))
+	}
+	group.TotalSummary = total
+
+	return total
+}
+
+func groupChildChanges(
+	blueprintChanges *BlueprintChanges,
+	childPath string,
+	depth int,
+	groups *[]*ChildChangesGroup,
+) ChangesSummary {
+	children := map[string]ChildChangeAction{}
+	for childName := range blueprintChanges.NewChildren {
+		children[childName] = ChildChangeActionCreate
+	}
+	for childName := range blueprintChanges.ChildChanges {
+		children[childName] = ChildChangeActionUpdate
+	}
+	for _, childName := range blueprintChanges.RecreateChildren {
+		children[childName] = ChildChangeActionRecreate
+	}
+	for _, childName := range blueprintChanges.RemovedChildren {
+		children[childName] = ChildChangeActionDelete
+	}
+
+	total := ChangesSummary{}
+	for _, childName := range sortedKeys(children) {
+		action := children[childName]
+		path := buildChildPath(childPath, childName)
+		switch action {
+		case ChildChangeActionCreate:
+			newChild := blueprintChanges.NewChildren[childName]
+			total = total.Add(groupBlueprintChanges(
+				newBlueprintDefinitionToChanges(&newChild),
+				path,
+				action,
+				depth+1,
+				groups,
+			))
+		case ChildChangeActionUpdate, ChildChangeActionRecreate:
+			childChanges, hasChanges := blueprintChanges.ChildChanges[childName]
+			if !hasChanges {
+				childChanges = BlueprintChanges{}
+			}
+			total = total.Add(groupBlueprintChanges(
+				&childChanges,
+				path,
+				action,
+				depth+1,
+				groups,
+			))
+		default:
+			// The resources in a removed child blueprint are not a part of the
+			// change set, so only the action for the child blueprint is recorded.
+			*groups = append(*groups, &ChildChangesGroup{
+				ChildPath: path,
+				Depth:     depth + 1,
+				Action:    action,
+			})
+		}
+	}
+
+	return total
+}
+
+func newBlueprintDefinitionToChanges(definition *NewBlueprintDefinition) *BlueprintChanges {
+	newChildren := definition.NewChildren
+	if newChildren == nil {
+		newChildren = map[string]NewBlueprintDefinition{}
+	}
+
+	return &BlueprintChanges{
+		NewResources: definition.NewResources,
+		NewChildren:  newChildren,
+	}
+}
+
+func resourceHasChanges(resourceChanges *provider.Changes) bool {
+	return len(resourceChanges.ModifiedFields) > 0 ||
+		len(resourceChanges.NewFields) > 0 ||
+		len(resourceChanges.RemovedFields) > 0 ||
+		len(resourceChanges.NewOutboundLinks) > 0 ||
+		len(resourceChanges.OutboundLinkChanges) > 0 ||
+		len(resourceChanges.RemovedOutboundLinks) > 0
+}
+
+func sortedKeys[Value any](items map[string]Value) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func sortedCopy(items []string) []string {
+	copied := slices.Clone(items)
+	slices.Sort(copied)
+	return copied
+}
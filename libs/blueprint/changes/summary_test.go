@@ -0,0 +1,154 @@
+package changes
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/stretchr/testify/suite"
+)
+
+type SummaryTestSuite struct {
+	suite.Suite
+}
+
+func TestSummaryTestSuite(t *testing.T) {
+	suite.Run(t, new(SummaryTestSuite))
+}
+
+func (s *SummaryTestSuite) Test_returns_empty_groups_for_nil_changes() {
+	groups := GroupChangesByChild(nil)
+	s.Empty(groups)
+}
+
+func (s *SummaryTestSuite) Test_groups_changes_by_child_blueprint() {
+	groups := GroupChangesByChild(createSummaryTestChanges())
+
+	s.Require().Len(groups, 6)
+
+	root := groups[0]
+	s.Equal("", root.ChildPath)
+	s.Equal(0, root.Depth)
+	s.Equal(ChildChangeAction(""), root.Action)
+	s.Equal([]string{"ordersQueue"}, root.NewResources)
+	s.Equal([]string{"ordersTable"}, root.UpdatedResources)
+	s.Equal([]string{"ordersFunction"}, root.RecreatedResources)
+	s.Equal([]string{"legacyTable"}, root.RemovedResources)
+	s.Equal(
+		[]*ResourceReplacement{
+			{
+				ResourceName:  "ordersFunction",
+				Strategy:      schema.ReplaceStrategyDestroyBeforeCreate,
+				TriggerFields: []string{"spec.functionName"},
+			},
+		},
+		root.Replacements,
+	)
+	s.Equal(
+		ChangesSummary{Create: 1, Update: 1, Recreate: 1, Delete: 1},
+		root.Summary,
+	)
+	s.Equal(
+		ChangesSummary{Create: 4, Update: 2, Recreate: 1, Delete: 2},
+		root.TotalSummary,
+	)
+
+	cache := groups[1]
+	s.Equal("cache", cache.ChildPath)
+	s.Equal(ChildChangeActionDelete, cache.Action)
+	s.Equal(ChangesSummary{}, cache.TotalSummary)
+
+	monitoring := groups[2]
+	s.Equal("monitoring", monitoring.ChildPath)
+	s.Equal(ChildChangeActionCreate, monitoring.Action)
+	s.Equal([]string{"alarm", "dashboard"}, monitoring.NewResources)
+	s.Equal(ChangesSummary{Create: 2}, monitoring.TotalSummary)
+
+	networking := groups[3]
+	s.Equal("networking", networking.ChildPath)
+	s.Equal(1, networking.Depth)
+	s.Equal(ChildChangeActionUpdate, networking.Action)
+	s.Equal(ChangesSummary{Update: 1, Delete: 1}, networking.Summary)
+	s.Equal(ChangesSummary{Create: 1, Update: 1, Delete: 1}, networking.TotalSummary)
+
+	subnets := groups[4]
+	s.Equal("networking.subnets", subnets.ChildPath)
+	s.Equal(2, subnets.Depth)
+	s.Equal(ChildChangeActionCreate, subnets.Action)
+	s.Equal([]string{"privateSubnet"}, subnets.NewResources)
+
+	search := groups[5]
+	s.Equal("search", search.ChildPath)
+	s.Equal(ChildChangeActionRecreate, search.Action)
+}
+
+func (s *SummaryTestSuite) Test_renders_summary_string() {
+	s.Equal(
+		"2 to create, 1 to update, 0 to delete",
+		ChangesSummary{Create: 2, Update: 1}.String(),
+	)
+	s.Equal(
+		"0 to create, 0 to update, 1 to recreate, 3 to delete, 1 to retain",
+		ChangesSummary{Recreate: 1, Delete: 3, Retain: 1}.String(),
+	)
+}
+
+func createSummaryTestChanges() *BlueprintChanges {
+	return &BlueprintChanges{
+		NewResources: map[string]provider.Changes{
+			"ordersQueue": {},
+		},
+		ResourceChanges: map[string]provider.Changes{
+			"ordersTable": {
+				ModifiedFields: []provider.FieldChange{
+					{
+						FieldPath: "spec.billingMode",
+						PrevValue: core.MappingNodeFromString("PROVISIONED"),
+						NewValue:  core.MappingNodeFromString("PAY_PER_REQUEST"),
+					},
+				},
+			},
+			"ordersFunction": {
+				MustRecreate:     true,
+				ReplaceStrategy:  schema.ReplaceStrategyDestroyBeforeCreate,
+				RecreateTriggers: []string{"spec.functionName"},
+			},
+			// Resources without any changes should not be counted as updates.
+			"ordersBucket": {},
+		},
+		RemovedResources: []string{"legacyTable"},
+		NewChildren: map[string]NewBlueprintDefinition{
+			"monitoring": {
+				NewResources: map[string]provider.Changes{
+					"dashboard": {},
+					"alarm":     {},
+				},
+			},
+		},
+		ChildChanges: map[string]BlueprintChanges{
+			"networking": {
+				ResourceChanges: map[string]provider.Changes{
+					"vpc": {
+						NewFields: []provider.FieldChange{
+							{
+								FieldPath: "spec.tags",
+								NewValue:  core.MappingNodeFromString("networking"),
+							},
+						},
+					},
+				},
+				RemovedResources: []string{"natGateway"},
+				NewChildren: map[string]NewBlueprintDefinition{
+					"subnets": {
+						NewResources: map[string]provider.Changes{
+							"privateSubnet": {},
+						},
+					},
+				},
+			},
+		},
+		RecreateChildren: []string{"search"},
+		RemovedChildren:  []string{"cache"},
+	}
+}
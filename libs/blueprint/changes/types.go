@@ -80,6 +80,22 @@ type BlueprintChanges struct {
 	// This includes properties in resources, data sources, blueprint-wide metadata
 	// and exported fields.
 	ResolveOnDeploy []string `json:"resolveOnDeploy"`
+	// CostEstimate holds the estimated change in monthly cost for the resources
+	// that will be created, updated and destroyed when deploying the changes,
+	// including resources in child blueprints.
+	// This is nil when none of the changed resources support cost estimation.
+	CostEstimate *CostEstimate `json:"costEstimate,omitempty"`
+	// Deprecations holds the deprecated resource types and resource spec fields
+	// used by the resources that will be created or updated when deploying the changes,
+	// including resources in child blueprints.
+	// Deprecations are ordered by child path, resource name and field path.
+	Deprecations []*Deprecation `json:"deprecations,omitempty"`
+	// StagingTimestamp holds the unix timestamp in seconds for the time
+	// at which change staging started.
+	// Functions that depend on the current time (e.g. "now") use this
+	// when the changes are deployed so the deployed values are the same as the
+	// values that were resolved when the changes were staged.
+	StagingTimestamp int64 `json:"stagingTimestamp,omitempty"`
 }
 
 // IntermediaryBlueprintChanges holds changes to a blueprint that are not yet finalised
@@ -110,4 +126,6 @@ type NewBlueprintDefinition struct {
 	NewChildren     map[string]NewBlueprintDefinition `json:"newChildren"`
 	NewExports      map[string]provider.FieldChange   `json:"newExports"`
 	ResolveOnDeploy []string                          `json:"resolveOnDeploy,omitempty"`
+	CostEstimate    *CostEstimate                     `json:"costEstimate,omitempty"`
+	Deprecations    []*Deprecation                    `json:"deprecations,omitempty"`
 }
@@ -0,0 +1,79 @@
+package container
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+)
+
+// BlastRadiusLimits provides thresholds for the number of changes
+// that can be applied in a single deployment.
+// These limits protect against accidental wide-scope changes,
+// for example, when a small edit to a blueprint causes
+// a large number of resources to be replaced.
+type BlastRadiusLimits struct {
+	// MaxChanges is the maximum number of resources and child blueprints
+	// that can be created, updated, recreated or removed in a single deployment.
+	// When set to 0 or less, the total number of changes is not limited.
+	MaxChanges int
+	// MaxDeletes is the maximum number of resources and child blueprints
+	// that can be removed or recreated in a single deployment.
+	// Recreating a resource or child blueprint counts as a delete as the existing
+	// resource or child blueprint will be destroyed.
+	// When set to 0 or less, the number of deletes is not limited.
+	MaxDeletes int
+}
+
+// BlastRadius holds the number of changes and deletes that
+// a change set will apply when deployed.
+type BlastRadius struct {
+	// Changes is the total number of resources and child blueprints
+	// that will be created, updated, recreated or removed.
+	Changes int
+	// Deletes is the total number of resources and child blueprints
+	// that will be removed or recreated.
+	Deletes int
+}
+
+// CalculateBlastRadius determines the number of changes and deletes
+// that will be applied when deploying the provided change set,
+// including changes in child blueprints.
+func CalculateBlastRadius(blueprintChanges *changes.BlueprintChanges) BlastRadius {
+	blastRadius := BlastRadius{}
+	for _, group := range changes.GroupChangesByChild(blueprintChanges) {
+		summary := group.Summary
+		blastRadius.Changes += summary.Create + summary.Update +
+			summary.Recreate + summary.Delete
+		blastRadius.Deletes += summary.Recreate + summary.Delete
+
+		if group.Action == changes.ChildChangeActionDelete ||
+			group.Action == changes.ChildChangeActionRecreate {
+			blastRadius.Changes++
+			blastRadius.Deletes++
+		}
+	}
+
+	return blastRadius
+}
+
+// CheckBlastRadius checks the provided change set against the provided
+// blast radius limits, returning an error if the change set exceeds
+// any of the limits.
+// A nil set of limits will never be exceeded.
+func CheckBlastRadius(
+	blueprintChanges *changes.BlueprintChanges,
+	limits *BlastRadiusLimits,
+) error {
+	if limits == nil || blueprintChanges == nil {
+		return nil
+	}
+
+	blastRadius := CalculateBlastRadius(blueprintChanges)
+	if limits.MaxChanges > 0 && blastRadius.Changes > limits.MaxChanges {
+		return errBlastRadiusExceeded("changes", blastRadius.Changes, limits.MaxChanges)
+	}
+
+	if limits.MaxDeletes > 0 && blastRadius.Deletes > limits.MaxDeletes {
+		return errBlastRadiusExceeded("deletes", blastRadius.Deletes, limits.MaxDeletes)
+	}
+
+	return nil
+}
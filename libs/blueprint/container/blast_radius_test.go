@@ -0,0 +1,96 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/stretchr/testify/suite"
+)
+
+type BlastRadiusTestSuite struct {
+	suite.Suite
+}
+
+func (s *BlastRadiusTestSuite) Test_calculates_blast_radius_including_child_blueprints() {
+	blastRadius := CalculateBlastRadius(createBlastRadiusTestChanges())
+
+	s.Assert().Equal(
+		BlastRadius{
+			// 1 new, 1 recreated and 1 removed resource in the root blueprint,
+			// 1 removed resource in a child blueprint and 1 removed child blueprint.
+			Changes: 5,
+			Deletes: 4,
+		},
+		blastRadius,
+	)
+}
+
+func (s *BlastRadiusTestSuite) Test_allows_changes_within_limits() {
+	err := CheckBlastRadius(
+		createBlastRadiusTestChanges(),
+		&BlastRadiusLimits{
+			MaxChanges: 5,
+			MaxDeletes: 4,
+		},
+	)
+	s.Assert().NoError(err)
+}
+
+func (s *BlastRadiusTestSuite) Test_allows_changes_when_no_limits_are_provided() {
+	err := CheckBlastRadius(createBlastRadiusTestChanges(), nil)
+	s.Assert().NoError(err)
+}
+
+func (s *BlastRadiusTestSuite) Test_reports_error_when_max_changes_exceeded() {
+	err := CheckBlastRadius(
+		createBlastRadiusTestChanges(),
+		&BlastRadiusLimits{
+			MaxChanges: 4,
+		},
+	)
+	s.assertBlastRadiusExceeded(err)
+}
+
+func (s *BlastRadiusTestSuite) Test_reports_error_when_max_deletes_exceeded() {
+	err := CheckBlastRadius(
+		createBlastRadiusTestChanges(),
+		&BlastRadiusLimits{
+			MaxChanges: 10,
+			MaxDeletes: 2,
+		},
+	)
+	s.assertBlastRadiusExceeded(err)
+}
+
+func (s *BlastRadiusTestSuite) assertBlastRadiusExceeded(err error) {
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Assert().Equal(ErrorReasonCodeBlastRadiusExceeded, runErr.ReasonCode)
+}
+
+func createBlastRadiusTestChanges() *changes.BlueprintChanges {
+	return &changes.BlueprintChanges{
+		NewResources: map[string]provider.Changes{
+			"ordersQueue": {},
+		},
+		ResourceChanges: map[string]provider.Changes{
+			"ordersFunction": {
+				MustRecreate: true,
+			},
+		},
+		RemovedResources: []string{"legacyTable"},
+		ChildChanges: map[string]changes.BlueprintChanges{
+			"networking": {
+				RemovedResources: []string{"natGateway"},
+			},
+		},
+		RemovedChildren: []string{"cache"},
+	}
+}
+
+func TestBlastRadiusTestSuite(t *testing.T) {
+	suite.Run(t, new(BlastRadiusTestSuite))
+}
@@ -336,6 +336,8 @@ func (c *defaultChangeStagingState) ApplyChildChanges(changesMsg ChildChangesMes
 			NewChildren:     changesMsg.Changes.NewChildren,
 			NewExports:      combinedNewExports,
 			ResolveOnDeploy: changesMsg.Changes.ResolveOnDeploy,
+			CostEstimate:    changesMsg.Changes.CostEstimate,
+			Deprecations:    changesMsg.Changes.Deprecations,
 		}
 	} else if changesMsg.Removed {
 		c.outputChanges.RemovedChildren = append(
@@ -436,7 +438,9 @@ func (c *defaultChangeStagingState) ExtractBlueprintChanges() changes.BlueprintC
 func filterResourceChangesWithAnyChanges(input map[string]*provider.Changes) map[string]provider.Changes {
 	output := map[string]provider.Changes{}
 	for key, value := range input {
-		if provider.HasAnyChanges(value) {
+		// Resources that must be recreated are kept even when there are no
+		// field or link changes, as is the case for tainted resources.
+		if provider.HasAnyChanges(value) || value.MustRecreate {
 			output[key] = *value
 		}
 	}
@@ -0,0 +1,116 @@
+package container
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// ConcurrencyConfig holds the limits on how many resources can be deployed
+// or destroyed at the same time.
+// Limits apply to the provider calls that create, update and destroy resources,
+// waiting for resources to stabilise does not count towards a limit.
+// Limits are useful for deployments that target rate-limited APIs where
+// deploying every independent resource in parallel would exceed API quotas.
+type ConcurrencyConfig struct {
+	// MaxInFlightResources is the maximum number of resources that can be
+	// deployed or destroyed at the same time across all providers.
+	// When zero or less, there is no global limit.
+	MaxInFlightResources int
+	// ProviderLimits holds the maximum number of resources that can be
+	// deployed or destroyed at the same time for each provider namespace
+	// (e.g. "aws" for all "aws/*" resource types).
+	// Provider namespaces without an entry, or with a limit of zero or less,
+	// are only bound by MaxInFlightResources.
+	ProviderLimits map[string]int
+}
+
+// ConcurrencyLimiter bounds the number of resources that are deployed or
+// destroyed at the same time based on a ConcurrencyConfig.
+// A single limiter is shared by all the blueprint containers created by a loader,
+// including the containers for child blueprints, so limits apply across
+// all the deployments carried out with the loader.
+//
+// A nil limiter does not apply any limits.
+type ConcurrencyLimiter struct {
+	global    chan struct{}
+	providers map[string]chan struct{}
+}
+
+// NewConcurrencyLimiter creates a limiter that enforces the provided limits,
+// a nil limiter is returned when the config does not define any limits.
+func NewConcurrencyLimiter(config *ConcurrencyConfig) *ConcurrencyLimiter {
+	if config == nil {
+		return nil
+	}
+
+	limiter := &ConcurrencyLimiter{
+		providers: map[string]chan struct{}{},
+	}
+	if config.MaxInFlightResources > 0 {
+		limiter.global = make(chan struct{}, config.MaxInFlightResources)
+	}
+
+	for namespace, limit := range config.ProviderLimits {
+		if limit > 0 {
+			limiter.providers[namespace] = make(chan struct{}, limit)
+		}
+	}
+
+	if limiter.global == nil && len(limiter.providers) == 0 {
+		return nil
+	}
+
+	return limiter
+}
+
+// Acquire waits for a slot to deploy or destroy a resource of the given type,
+// the returned function must be called to release the slot once the provider
+// has finished creating, updating or destroying the resource.
+// An error is returned if the context is cancelled before a slot is available.
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context, resourceType string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	// The provider slot is always acquired before the global slot so resources
+	// waiting on a saturated provider do not hold on to global slots that could
+	// be used by resources of other providers.
+	providerSlots := l.providers[provider.ExtractProviderFromItemType(resourceType)]
+	err := acquireSlot(ctx, providerSlots)
+	if err != nil {
+		return nil, err
+	}
+
+	err = acquireSlot(ctx, l.global)
+	if err != nil {
+		releaseSlot(providerSlots)
+		return nil, err
+	}
+
+	return func() {
+		releaseSlot(l.global)
+		releaseSlot(providerSlots)
+	}, nil
+}
+
+func acquireSlot(ctx context.Context, slots chan struct{}) error {
+	if slots == nil {
+		return nil
+	}
+
+	select {
+	case slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func releaseSlot(slots chan struct{}) {
+	if slots == nil {
+		return
+	}
+
+	<-slots
+}
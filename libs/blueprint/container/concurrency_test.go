@@ -0,0 +1,139 @@
+package container
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConcurrencyLimiterTestSuite struct {
+	suite.Suite
+}
+
+func (s *ConcurrencyLimiterTestSuite) Test_returns_nil_limiter_when_no_limits_are_configured() {
+	s.Nil(NewConcurrencyLimiter(nil))
+	s.Nil(NewConcurrencyLimiter(&ConcurrencyConfig{
+		MaxInFlightResources: 0,
+		ProviderLimits:       map[string]int{"aws": 0},
+	}))
+
+	var limiter *ConcurrencyLimiter
+	release, err := limiter.Acquire(context.Background(), "aws/lambda/function")
+	s.Require().NoError(err)
+	release()
+}
+
+func (s *ConcurrencyLimiterTestSuite) Test_enforces_global_limit() {
+	limiter := NewConcurrencyLimiter(&ConcurrencyConfig{
+		MaxInFlightResources: 3,
+	})
+
+	maxInFlight := s.runConcurrently(limiter, []string{
+		"aws/lambda/function",
+		"aws/sqs/queue",
+		"gcloud/storage/bucket",
+		"gcloud/compute/instance",
+		"azure/storage/account",
+		"aws/dynamodb/table",
+		"aws/s3/bucket",
+		"gcloud/pubsub/topic",
+	})
+	s.Equal(3, maxInFlight[""])
+}
+
+func (s *ConcurrencyLimiterTestSuite) Test_enforces_provider_limits() {
+	limiter := NewConcurrencyLimiter(&ConcurrencyConfig{
+		ProviderLimits: map[string]int{
+			"aws":    2,
+			"gcloud": 1,
+		},
+	})
+
+	maxInFlight := s.runConcurrently(limiter, []string{
+		"aws/lambda/function",
+		"aws/sqs/queue",
+		"aws/dynamodb/table",
+		"aws/s3/bucket",
+		"gcloud/storage/bucket",
+		"gcloud/compute/instance",
+		"azure/storage/account",
+		"azure/storage/container",
+	})
+	s.Equal(2, maxInFlight["aws"])
+	s.Equal(1, maxInFlight["gcloud"])
+	// Provider namespaces without a limit are not restricted.
+	s.Equal(2, maxInFlight["azure"])
+}
+
+func (s *ConcurrencyLimiterTestSuite) Test_fails_to_acquire_when_context_is_cancelled() {
+	limiter := NewConcurrencyLimiter(&ConcurrencyConfig{
+		MaxInFlightResources: 2,
+		ProviderLimits:       map[string]int{"aws": 1},
+	})
+
+	release, err := limiter.Acquire(context.Background(), "aws/lambda/function")
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = limiter.Acquire(ctx, "aws/sqs/queue")
+	s.ErrorIs(err, context.DeadlineExceeded)
+
+	release()
+
+	// The global slot must still be available for other providers
+	// after failing to acquire a provider slot.
+	releaseFirst, err := limiter.Acquire(context.Background(), "aws/sqs/queue")
+	s.Require().NoError(err)
+	releaseSecond, err := limiter.Acquire(context.Background(), "gcloud/storage/bucket")
+	s.Require().NoError(err)
+	releaseFirst()
+	releaseSecond()
+}
+
+// Acquires slots for all the provided resource types at the same time,
+// holding on to each slot for a short period and returns the maximum number
+// of slots held at once for each provider namespace and overall, keyed by an
+// empty string.
+func (s *ConcurrencyLimiterTestSuite) runConcurrently(
+	limiter *ConcurrencyLimiter,
+	resourceTypes []string,
+) map[string]int {
+	mu := sync.Mutex{}
+	inFlight := map[string]int{}
+	maxInFlight := map[string]int{}
+	track := func(namespace string, delta int) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, key := range []string{"", namespace} {
+			inFlight[key] += delta
+			maxInFlight[key] = max(maxInFlight[key], inFlight[key])
+		}
+	}
+
+	wg := sync.WaitGroup{}
+	for _, resourceType := range resourceTypes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := limiter.Acquire(context.Background(), resourceType)
+			s.NoError(err)
+			namespace := provider.ExtractProviderFromItemType(resourceType)
+			track(namespace, 1)
+			time.Sleep(20 * time.Millisecond)
+			track(namespace, -1)
+			release()
+		}()
+	}
+	wg.Wait()
+
+	return maxInFlight
+}
+
+func TestConcurrencyLimiterTestSuite(t *testing.T) {
+	suite.Run(t, new(ConcurrencyLimiterTestSuite))
+}
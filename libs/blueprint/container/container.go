@@ -8,6 +8,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/drift"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/policy"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
@@ -38,6 +39,22 @@ type BlueprintContainer interface {
 		channels *ChangeStagingChannels,
 		paramOverrides core.BlueprintParams,
 	) error
+	// VerifyChanges deals with verifying a set of staged changes against the
+	// resource providers by carrying out dry run deployments for resources that
+	// will be created or updated.
+	// This allows for catching errors that can not be determined by comparing
+	// a blueprint with the current state of an instance, such as insufficient
+	// permissions or quota limits.
+	// Only resource types that report support for dry runs in their spec definition
+	// will be verified, other resources are reported as unsupported.
+	// Errors reported by providers for individual resources are captured in the result,
+	// the returned error is reserved for failures that prevent verification from being
+	// carried out.
+	VerifyChanges(
+		ctx context.Context,
+		input *VerifyChangesInput,
+		paramOverrides core.BlueprintParams,
+	) (*VerifyChangesResult, error)
 	// Deploy deals with deploying the blueprint for the given instance ID.
 	// When an instance ID is omitted, the container will treat the deployment
 	// as a new instance of the blueprint where the provided change set only includes
@@ -59,6 +76,63 @@ type BlueprintContainer interface {
 		channels *DeployChannels,
 		paramOverrides core.BlueprintParams,
 	) error
+	// ResumeDeployment deals with resuming a deployment for a blueprint instance
+	// that was interrupted, for example, when the process carrying out the deployment
+	// was terminated or in-flight operations were cancelled after a terminal failure.
+	// This reconciles the statuses of interrupted resources and links with the
+	// state of the resources in the upstream provider, stages the changes for the elements
+	// that have not yet been deployed and continues the deployment for those elements.
+	// The loaded blueprint is expected to be the version of the blueprint that was
+	// being deployed when the deployment was interrupted.
+	//
+	// Interrupted elements that can not be automatically reconciled (e.g. resources
+	// that could not be found in the upstream provider) will cause a synchronous error
+	// as they require manual cleanup before the deployment can be resumed.
+	// Once the deployment has started, updates are streamed to the provided channels
+	// in the same way as Deploy.
+	ResumeDeployment(
+		ctx context.Context,
+		input *ResumeDeploymentInput,
+		channels *DeployChannels,
+		paramOverrides core.BlueprintParams,
+	) error
+	// SavePlan saves a set of staged changes along with a fingerprint of the current
+	// state of the blueprint instance the changes were staged against.
+	// This should be called as soon as change staging has completed so the saved plan
+	// can be reviewed and later deployed with DeployFromPlan.
+	SavePlan(
+		ctx context.Context,
+		input *SavePlanInput,
+	) (*SavedPlan, error)
+	// DeployFromPlan deploys exactly the changes held in a saved plan.
+	// This will return a synchronous error without deploying any changes if the state
+	// of the blueprint instance has diverged since the plan was saved, for example,
+	// when another deployment has been carried out or drift has been reconciled.
+	// Once the deployment has started, updates are streamed to the provided channels
+	// in the same way as Deploy.
+	DeployFromPlan(
+		ctx context.Context,
+		input *DeployFromPlanInput,
+		channels *DeployChannels,
+		paramOverrides core.BlueprintParams,
+	) error
+	// CloneInstance deploys the loaded blueprint as a new blueprint instance
+	// with fresh state, using the provided parameter overrides in place of
+	// those used for the source instance.
+	// This is useful for spinning up review and staging environments from an
+	// existing blueprint instance.
+	// The container must be loaded from the same blueprint that the source instance
+	// was deployed from.
+	// This will return a synchronous error if the source instance does not exist or
+	// an instance already exists with the new instance name.
+	// Once the deployment has started, updates are streamed to the provided channels
+	// in the same way as Deploy.
+	CloneInstance(
+		ctx context.Context,
+		input *CloneInstanceInput,
+		channels *DeployChannels,
+		paramOverrides core.BlueprintParams,
+	) error
 	// Destroy deals with destroying all the resources, child blueprints and links
 	// for a blueprint instance.
 	// Like Deploy, Destroy requires changes to be staged and passed in to ensure that
@@ -96,6 +170,46 @@ type BlueprintContainer interface {
 	// This allows retention of information about the original resource template
 	// that a resource was derived from in a source blueprint document.
 	ResourceTemplates() map[string]string
+	// Hooks returns the registry of deployment hooks for the container
+	// that can be used to register callbacks that are run before and after
+	// lifecycle phases when deploying and destroying blueprint instances.
+	// Hooks are not run for the elements of child blueprints.
+	Hooks() *DeploymentHooks
+	// ExportGraph renders the dependency graph of resources, child blueprints
+	// and links that is resolved when preparing the loaded blueprint for change staging.
+	// The graph can be rendered in DOT, Mermaid or JSON format and captures
+	// the groups of elements that are deployed concurrently along with the
+	// dependencies that determine the order in which groups are deployed.
+	// Parameter overrides can be provided to resolve resource templates
+	// and conditions.
+	ExportGraph(
+		ctx context.Context,
+		format GraphFormat,
+		paramOverrides core.BlueprintParams,
+	) ([]byte, error)
+	// Render produces a fully resolved version of the loaded blueprint
+	// with resource templates expanded, resource spec defaults populated,
+	// conditions applied and substitutions resolved where possible.
+	// Values that can only be known after deployment are marked with
+	// the KnownOnDeployPlaceholder and the paths to them are collected
+	// in the rendered blueprint.
+	// Parameter overrides can be provided to resolve resource templates
+	// and conditions.
+	Render(
+		ctx context.Context,
+		paramOverrides core.BlueprintParams,
+	) (*RenderedBlueprint, error)
+	// EvaluateExpression resolves a single substitution expression
+	// (e.g. "resources.ordersTable.spec.arn" or "cidrsubnet(variables.vpcCidr, 8, 2)")
+	// against the loaded blueprint where resources are resolved in the same way as
+	// they are for Render.
+	// An existing blueprint instance can be provided to resolve computed fields
+	// of resources from the instance state.
+	EvaluateExpression(
+		ctx context.Context,
+		input *EvaluateExpressionInput,
+		paramOverrides core.BlueprintParams,
+	) (*EvaluatedExpression, error)
 	// Diagnostics returns warning and informational diagnostics for the loaded blueprint
 	// that point out potential issues that may occur when executing
 	// a blueprint.
@@ -136,6 +250,103 @@ type BlueprintContainer interface {
 		input *ApplyReconciliationInput,
 		paramOverrides core.BlueprintParams,
 	) (*ApplyReconciliationResult, error)
+	// PlanReconciliation produces a preview of the state writes that would be made
+	// when applying the specified reconciliation actions, this does not modify any state.
+	// This includes updates to the data of links that map fields of reconciled
+	// resources to link data through resource data mappings.
+	// Use this to present a confirmation diff before calling ApplyReconciliation.
+	//
+	// Actions that would fail when applied are reported as errors in the plan.
+	PlanReconciliation(
+		ctx context.Context,
+		input *ApplyReconciliationInput,
+		paramOverrides core.BlueprintParams,
+	) (*ReconciliationPlan, error)
+	// RefreshState brings the persisted state of a blueprint instance up to date
+	// with the external state of its resources and links without making any changes
+	// to infrastructure.
+	// External state is fetched for every resource and link, the spec data of drifted
+	// resources and the data of drifted links are then replaced with the external state.
+	// Use this before planning changes to make sure the plan is based on the
+	// current state of the deployed infrastructure.
+	//
+	// Elements in an interrupted state are not refreshed, the result indicates
+	// when interrupted elements were found so they can be reconciled with
+	// CheckReconciliation and ApplyReconciliation.
+	RefreshState(
+		ctx context.Context,
+		input *RefreshStateInput,
+		paramOverrides core.BlueprintParams,
+	) (*RefreshStateResult, error)
+	// ListImportCandidates finds the resources in the loaded blueprint that are
+	// not tracked in the state of a blueprint instance and lists existing resources
+	// in the upstream provider that each unmatched resource can be mapped to.
+	// Candidates are only listed for resource types that implement provider.ResourceLister,
+	// this is a read-only operation that does not modify any state.
+	ListImportCandidates(
+		ctx context.Context,
+		input *ListImportCandidatesInput,
+		paramOverrides core.BlueprintParams,
+	) (*ImportCandidatesResult, error)
+	// ImportResources adopts existing resources in the upstream provider into the state
+	// of a blueprint instance using provider-specific import IDs.
+	// The external state of each resource is fetched from the provider and persisted
+	// as the resource spec state, a new instance is created when importing
+	// into an instance name that does not exist yet.
+	//
+	// Resources that fail to import are reported in the result and do not
+	// prevent other resources from being imported.
+	ImportResources(
+		ctx context.Context,
+		input *ImportResourcesInput,
+		paramOverrides core.BlueprintParams,
+	) (*ImportResourcesResult, error)
+	// TaintResource flags a resource in the state of a blueprint instance so that
+	// the next time changes are staged for the instance, a replacement is planned
+	// for the resource even if there are no changes to its spec.
+	// This is useful when a resource is known to have been corrupted externally.
+	// The flag is cleared once a replacement for the resource has been deployed.
+	TaintResource(
+		ctx context.Context,
+		instanceID string,
+		resourceName string,
+	) error
+	// UntaintResource removes the flag set by TaintResource so that a replacement
+	// is no longer planned for the resource.
+	UntaintResource(
+		ctx context.Context,
+		instanceID string,
+		resourceName string,
+	) error
+	// ReapplyLinks re-evaluates and re-applies the link implementations for the links
+	// of a blueprint instance, including the intermediary resources of each link,
+	// using the persisted state of the linked resources.
+	// The linked resources themselves are not re-deployed, only the configuration
+	// applied to the linked resources by the link implementations is updated.
+	// This is useful after a provider upgrade changes the behaviour of a link
+	// or to revert changes made to link-managed configuration outside
+	// of the deployment process.
+	//
+	// Links that fail to be re-applied are reported in the result and do not
+	// prevent other links from being re-applied.
+	ReapplyLinks(
+		ctx context.Context,
+		input *ReapplyLinksInput,
+		paramOverrides core.BlueprintParams,
+	) (*ReapplyLinksResult, error)
+	// GetOutputs resolves the exports of a deployed blueprint instance
+	// against the current state of the instance, including the exports
+	// of child blueprints.
+	// This allows tools to consume the deployed values of a blueprint instance
+	// without having to re-parse the state tree.
+	//
+	// Exports of resource fields and child blueprint exports are resolved
+	// from the current state, other exports fall back to the value captured
+	// when the instance was last deployed.
+	GetOutputs(
+		ctx context.Context,
+		instanceID string,
+	) (*BlueprintOutputs, error)
 }
 
 // StageChangesInput contains the primary input needed to stage changes
@@ -189,6 +400,47 @@ type DeployInput struct {
 	// Resources in CONFIG_COMPLETE (stabilization polling) benefit from
 	// longer drain times to reach finalized states.
 	DrainTimeout time.Duration
+	// BlastRadiusLimits holds thresholds for the number of changes and deletes
+	// that can be applied in the deployment.
+	// When the provided changes exceed any of the limits, the deployment
+	// will not proceed unless OverrideBlastRadius is set.
+	// If nil, the number of changes is not limited.
+	BlastRadiusLimits *BlastRadiusLimits
+	// OverrideBlastRadius explicitly allows a deployment to proceed
+	// when the provided changes exceed the configured blast radius limits.
+	OverrideBlastRadius bool
+	// Targets holds the logical names of resources to restrict the deployment to.
+	// When provided, only the changes for the targeted resources and their transitive
+	// dependencies (resources and child blueprints) will be deployed,
+	// removals are left in place until a full deployment is carried out.
+	// This is useful for iterating on a subset of a large blueprint during development.
+	// If empty, all the provided changes will be deployed.
+	Targets []string
+	// RunID identifies the deployment in the events recorded in the
+	// deployment event store configured for the container.
+	// When not provided, a run ID will be generated for the deployment.
+	// This is ignored when a deployment event store has not been configured.
+	RunID string
+	// RunMetadata holds information about who initiated the deployment and the
+	// versions of the tools and blueprint used, this is included in the summary
+	// of the deployment passed into deployment summary hooks
+	// so it can be recorded in the audit trail for the blueprint instance.
+	RunMetadata *state.DeploymentRunMetadata
+	// EstimatedDuration is the expected duration of the deployment,
+	// this is used to check whether the credentials for providers will expire
+	// before the deployment is expected to complete so they can be refreshed
+	// before the deployment starts.
+	// If zero, the duration of the latest deployment of the blueprint instance
+	// will be used, when there is no previous deployment, credentials will only
+	// be refreshed when they are close to expiry during the deployment.
+	EstimatedDuration time.Duration
+	// TTL marks the blueprint instance as ephemeral (e.g. a preview environment
+	// for a pull request), once the deployment succeeds, the instance will be
+	// set to expire after the TTL has elapsed so it can be destroyed by an
+	// ExpiredInstanceReaper.
+	// Each successful deployment with a TTL extends the expiry of the instance.
+	// If zero, the current expiry of the instance is left unchanged.
+	TTL time.Duration
 }
 
 // DestroyInput contains the primary input needed to destroy a blueprint instance.
@@ -227,6 +479,25 @@ type DestroyInput struct {
 	// Resources in CONFIG_COMPLETE (stabilization polling) benefit from
 	// longer drain times to reach finalized states.
 	DrainTimeout time.Duration
+	// RunID identifies the destroy operation in the events recorded in the
+	// deployment event store configured for the container.
+	// When not provided, a run ID will be generated for the destroy operation.
+	// This is ignored when a deployment event store has not been configured.
+	RunID string
+	// RunMetadata holds information about who initiated the destroy operation and
+	// the versions of the tools used, this is included in the summary of the operation
+	// passed into deployment summary hooks so it can be recorded in the audit trail
+	// for the blueprint instance.
+	RunMetadata *state.DeploymentRunMetadata
+	// Targets holds the logical names of resources and the paths of child blueprints
+	// (e.g. "children.networking") to restrict the destroy operation to.
+	// When provided, only the targeted elements and the links between targeted resources
+	// and other resources will be destroyed, the blueprint instance and all other
+	// elements will be left in place.
+	// Elements that are not targeted must not depend on the targeted elements,
+	// the destroy operation will fail before any elements are destroyed when they do.
+	// If empty, the entire blueprint instance will be destroyed.
+	Targets []string
 }
 
 const (
@@ -259,6 +530,7 @@ type defaultBlueprintContainer struct {
 	providers                map[string]provider.Provider
 	resourceRegistry         resourcehelpers.Registry
 	linkRegistry             provider.LinkRegistry
+	dataSourceRegistry       provider.DataSourceRegistry
 	spec                     speccore.BlueprintSpec
 	linkInfo                 links.SpecLinkInfo
 	resourceTemplates        map[string]string
@@ -280,7 +552,12 @@ type defaultBlueprintContainer struct {
 	driftChecker             drift.Checker
 	resourceDeployer         ResourceDeployer
 	childDeployer            ChildBlueprintDeployer
+	blueprintRenderer        BlueprintRenderer
 	defaultRetryPolicy       *provider.RetryPolicy
+	hooks                    *DeploymentHooks
+	concurrencyLimiter       *ConcurrencyLimiter
+	deploymentEventStore     DeploymentEventStore
+	policyEngine             policy.Engine
 	logger                   core.Logger
 }
 
@@ -308,6 +585,7 @@ type BlueprintContainerDependencies struct {
 	Providers                 map[string]provider.Provider
 	ResourceRegistry          resourcehelpers.Registry
 	LinkRegistry              provider.LinkRegistry
+	DataSourceRegistry        provider.DataSourceRegistry
 	LinkInfo                  links.SpecLinkInfo
 	ResourceTemplates         map[string]string
 	RefChainCollector         refgraph.RefChainCollector
@@ -327,8 +605,28 @@ type BlueprintContainerDependencies struct {
 	DriftChecker              drift.Checker
 	ResourceDeployer          ResourceDeployer
 	ChildBlueprintDeployer    ChildBlueprintDeployer
-	DefaultRetryPolicy        *provider.RetryPolicy
-	Logger                    core.Logger
+	// BlueprintRenderer renders fully resolved versions of the blueprint.
+	// As the renderer uses the resource cache and substitution resolver,
+	// a renderer must be created for each blueprint container.
+	BlueprintRenderer  BlueprintRenderer
+	DefaultRetryPolicy *provider.RetryPolicy
+	// DeploymentHooks holds the hooks that are run around lifecycle phases
+	// when deploying and destroying blueprint instances.
+	// When not provided, an empty registry of hooks is used.
+	DeploymentHooks *DeploymentHooks
+	// ConcurrencyLimiter bounds the number of resources that can be
+	// deployed or destroyed at the same time.
+	// When not provided, there are no limits.
+	ConcurrencyLimiter *ConcurrencyLimiter
+	// DeploymentEventStore persists the status updates emitted during
+	// deploy and destroy operations so they can be replayed.
+	// When not provided, status updates are only sent to the deploy channels.
+	DeploymentEventStore DeploymentEventStore
+	// PolicyEngine evaluates organisational policies against the changes
+	// for a deployment before the deployment proceeds.
+	// When not provided, policies are not evaluated.
+	PolicyEngine policy.Engine
+	Logger       core.Logger
 }
 
 // NewDefaultBlueprintContainer creates a new instance of the default
@@ -340,11 +638,17 @@ func NewDefaultBlueprintContainer(
 	deps *BlueprintContainerDependencies,
 	diagnostics []*core.Diagnostic,
 ) BlueprintContainer {
+	hooks := deps.DeploymentHooks
+	if hooks == nil {
+		hooks = NewDeploymentHooks()
+	}
+
 	return &defaultBlueprintContainer{
 		deps.StateContainer,
 		deps.Providers,
 		deps.ResourceRegistry,
 		deps.LinkRegistry,
+		deps.DataSourceRegistry,
 		spec,
 		deps.LinkInfo,
 		deps.ResourceTemplates,
@@ -366,7 +670,12 @@ func NewDefaultBlueprintContainer(
 		deps.DriftChecker,
 		deps.ResourceDeployer,
 		deps.ChildBlueprintDeployer,
+		deps.BlueprintRenderer,
 		deps.DefaultRetryPolicy,
+		hooks,
+		deps.ConcurrencyLimiter,
+		deps.DeploymentEventStore,
+		deps.PolicyEngine,
 		deps.Logger,
 	}
 }
@@ -391,11 +700,31 @@ func (c *defaultBlueprintContainer) ResourceTemplates() map[string]string {
 	return c.resourceTemplates
 }
 
+func (c *defaultBlueprintContainer) Hooks() *DeploymentHooks {
+	return c.hooks
+}
+
 func (c *defaultBlueprintContainer) resolveExport(
 	ctx context.Context,
 	exportName string,
 	export *schema.Export,
 	resolveFor subengine.ResolveForStage,
+) (*subengine.ResolveResult, error) {
+	return resolveExportField(
+		ctx,
+		c.substitutionResolver,
+		exportName,
+		export,
+		resolveFor,
+	)
+}
+
+func resolveExportField(
+	ctx context.Context,
+	substitutionResolver subengine.SubstitutionResolver,
+	exportName string,
+	export *schema.Export,
+	resolveFor subengine.ResolveForStage,
 ) (*subengine.ResolveResult, error) {
 	if export.Field != nil && export.Field.StringValue != nil {
 		exportFieldAsSub, err := substitutions.ParseSubstitution(
@@ -409,7 +738,7 @@ func (c *defaultBlueprintContainer) resolveExport(
 			return nil, err
 		}
 
-		return c.substitutionResolver.ResolveSubstitution(
+		return substitutionResolver.ResolveSubstitution(
 			ctx,
 			&substitutions.StringOrSubstitution{
 				SubstitutionValue: exportFieldAsSub,
@@ -27,7 +27,10 @@ func (c *defaultBlueprintContainer) StageChanges(
 		return err
 	}
 
-	ctxWithInstanceID := context.WithValue(ctx, core.BlueprintInstanceIDKey, resolvedInstanceID)
+	ctxWithInstanceID := withStagingTimestamp(
+		context.WithValue(ctx, core.BlueprintInstanceIDKey, resolvedInstanceID),
+		c.clock,
+	)
 	changeStagingLogger := c.logger.Named("stageChanges").WithFields(
 		core.StringLogField("instanceID", resolvedInstanceID),
 		core.StringLogField("instanceName", input.InstanceName),
@@ -53,7 +56,7 @@ func (c *defaultBlueprintContainer) StageChanges(
 			return state.InstanceNotFoundError(identifier)
 		}
 		changeStagingLogger.Info("staging changes for destroying blueprint instance")
-		go c.stageInstanceRemoval(ctxWithInstanceID, resolvedInstanceID, channels)
+		go c.stageInstanceRemoval(ctxWithInstanceID, resolvedInstanceID, channels, paramOverrides)
 		return nil
 	}
 
@@ -161,7 +164,21 @@ func (c *defaultBlueprintContainer) stageChanges(
 		return
 	}
 
-	channels.CompleteChan <- state.ExtractBlueprintChanges()
+	blueprintChanges := state.ExtractBlueprintChanges()
+	blueprintChanges.StagingTimestamp = stagingTimestampUnix(ctx)
+	blueprintChanges.CostEstimate = c.stagedChangesCostEstimate(
+		ctx,
+		instanceID,
+		&blueprintChanges,
+		paramOverrides,
+	)
+	blueprintChanges.Deprecations = c.stagedChangesDeprecations(
+		ctx,
+		&blueprintChanges,
+		paramOverrides,
+	)
+
+	channels.CompleteChan <- blueprintChanges
 }
 
 func (c *defaultBlueprintContainer) listenToAndProcessGroupChanges(
@@ -309,7 +326,10 @@ func (c *defaultBlueprintContainer) stageRemovals(
 
 	flattenedNodes := core.Flatten(deploymentNodes)
 
-	c.stageResourceRemovals(&instanceState, stagingState, flattenedNodes, channels)
+	err = c.stageResourceRemovals(&instanceState, stagingState, flattenedNodes, channels)
+	if err != nil {
+		return err
+	}
 	c.stageLinkRemovals(&instanceState, stagingState, flattenedNodes, channels)
 	c.stageChildRemovals(&instanceState, stagingState, flattenedNodes, channels)
 
@@ -321,30 +341,50 @@ func (c *defaultBlueprintContainer) stageResourceRemovals(
 	stagingState ChangeStagingState,
 	flattenedNodes []*DeploymentNode,
 	channels *ChangeStagingChannels,
-) {
+) error {
+	removedResources := []*state.ResourceState{}
+	protectedResources := []string{}
 	for _, resourceState := range instanceState.Resources {
 		inDeployNodes := slices.ContainsFunc(flattenedNodes, func(node *DeploymentNode) bool {
 			return node.ChainLinkNode != nil &&
 				node.ChainLinkNode.ResourceName == resourceState.Name
 		})
 		if !inDeployNodes {
-			dependents := findDependents(
-				resourceState,
-				flattenedNodes,
-				instanceState,
-			)
-			stagingState.AddElementsThatMustBeRecreated(
-				dependents,
-			)
-			changes := ResourceChangesMessage{
-				ResourceName:  resourceState.Name,
-				Removed:       true,
-				RemovalPolicy: effectiveRemovalPolicy(resourceState.RemovalPolicy),
+			removedResources = append(removedResources, resourceState)
+			if isResourceProtected(resourceState) &&
+				resourceRemovalPolicy(resourceState) != string(schema.RemovalPolicyRetain) {
+				protectedResources = append(protectedResources, resourceState.Name)
 			}
-			stagingState.ApplyResourceChanges(changes)
-			channels.ResourceChangesChan <- changes
 		}
 	}
+
+	// Protected resources are checked before any removals are staged
+	// so that no removal messages are dispatched for a set of changes
+	// that can not be deployed.
+	if len(protectedResources) > 0 {
+		slices.Sort(protectedResources)
+		return errProtectedResourceRemoval(protectedResources)
+	}
+
+	for _, resourceState := range removedResources {
+		dependents := findDependents(
+			resourceState,
+			flattenedNodes,
+			instanceState,
+		)
+		stagingState.AddElementsThatMustBeRecreated(
+			dependents,
+		)
+		changes := ResourceChangesMessage{
+			ResourceName:  resourceState.Name,
+			Removed:       true,
+			RemovalPolicy: resourceRemovalPolicy(resourceState),
+		}
+		stagingState.ApplyResourceChanges(changes)
+		channels.ResourceChangesChan <- changes
+	}
+
+	return nil
 }
 
 // The removal policy to apply when a resource
@@ -543,6 +583,7 @@ func (c *defaultBlueprintContainer) stageInstanceRemoval(
 	ctx context.Context,
 	instanceID string,
 	channels *ChangeStagingChannels,
+	paramOverrides core.BlueprintParams,
 ) {
 
 	instances := c.stateContainer.Instances()
@@ -554,6 +595,14 @@ func (c *defaultBlueprintContainer) stageInstanceRemoval(
 
 	changes := getInstanceRemovalChanges(&instanceState)
 
+	protectedResources := collectProtectedRemovals(&instanceState, &changes, "")
+	if len(protectedResources) > 0 {
+		slices.Sort(protectedResources)
+		channels.ErrChan <- errProtectedResourceRemoval(protectedResources)
+		return
+	}
+	changes.CostEstimate = c.estimateChangesCost(ctx, &instanceState, &changes, paramOverrides)
+
 	// For staging changes for destroying an instance, we don't need to individually
 	// dispatch resource, link, and child changes. We can just send the complete
 	// set of changes to the complete channel.
@@ -627,3 +676,44 @@ type changesWrapper struct {
 	resourceChanges *provider.Changes
 	childChanges    *changes.BlueprintChanges
 }
+
+// stageChangesAndWait stages the changes required to bring the instance
+// in line with the loaded blueprint, waiting for all changes to be staged.
+// This is used for operations that stage and deploy changes in a single step
+// without a review of the staged changes, such as resuming a deployment.
+func (c *defaultBlueprintContainer) stageChangesAndWait(
+	ctx context.Context,
+	input *StageChangesInput,
+	paramOverrides core.BlueprintParams,
+) (*changes.BlueprintChanges, error) {
+	stagingChannels := &ChangeStagingChannels{
+		ResourceChangesChan: make(chan ResourceChangesMessage),
+		ChildChangesChan:    make(chan ChildChangesMessage),
+		LinkChangesChan:     make(chan LinkChangesMessage),
+		CompleteChan:        make(chan changes.BlueprintChanges),
+		ErrChan:             make(chan error),
+	}
+	err := c.StageChanges(
+		ctx,
+		input,
+		stagingChannels,
+		paramOverrides,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-stagingChannels.ResourceChangesChan:
+		case <-stagingChannels.LinkChangesChan:
+		case <-stagingChannels.ChildChangesChan:
+		case remainingChanges := <-stagingChannels.CompleteChan:
+			return &remainingChanges, nil
+		case err := <-stagingChannels.ErrChan:
+			return nil, err
+		}
+	}
+}
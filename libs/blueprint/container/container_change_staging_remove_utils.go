@@ -30,7 +30,7 @@ func getResourceNamesFromInstanceState(
 	removed = make([]string, 0)
 	retained = make([]string, 0)
 	for _, resource := range instance.Resources {
-		if resource.RemovalPolicy == string(schema.RemovalPolicyRetain) {
+		if resourceRemovalPolicy(resource) == string(schema.RemovalPolicyRetain) {
 			retained = append(retained, resource.Name)
 		} else {
 			removed = append(removed, resource.Name)
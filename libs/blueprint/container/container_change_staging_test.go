@@ -192,6 +192,9 @@ func (s *ContainerChangeStagingTestSuite) Test_stage_changes_to_existing_bluepri
 		}
 	}
 	s.Require().NoError(err)
+	// The staging timestamp depends on the system clock so it is checked
+	// separately from the snapshot of the normalised changes.
+	s.Assert().NotZero(fullChangeSet.StagingTimestamp)
 
 	err = testhelpers.Snapshot(normaliseBlueprintChanges(fullChangeSet))
 	s.Require().NoError(err)
@@ -0,0 +1,112 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// CloneInstanceInput contains the input needed to clone an existing
+// blueprint instance into a new blueprint instance.
+type CloneInstanceInput struct {
+	// SourceInstanceID is the ID of the blueprint instance to clone.
+	// The blueprint container must be loaded from the same blueprint
+	// that the source instance was deployed from.
+	SourceInstanceID string
+	// NewInstanceName is the user-defined name for the new blueprint instance.
+	// This must not be the name of an existing blueprint instance.
+	NewInstanceName string
+	// TaggingConfig holds the configuration for Bluelink resource tagging.
+	// If nil, tagging will not be applied to resources.
+	TaggingConfig *provider.TaggingConfig
+	// ProviderMetadataLookup returns provider plugin metadata for a provider namespace.
+	ProviderMetadataLookup func(providerNamespace string) (pluginID, pluginVersion string)
+	// DrainTimeout is the maximum time to wait for in-flight operations
+	// to complete after a terminal failure before marking them as interrupted.
+	// If zero, defaults to DefaultDrainTimeout (2 minutes).
+	DrainTimeout time.Duration
+	// RunID identifies the deployment of the new instance in the events
+	// recorded in the deployment event store configured for the container.
+	// When not provided, a run ID will be generated for the deployment.
+	RunID string
+	// RunMetadata holds information about who initiated the clone and the
+	// versions of the tools used for the audit trail of the new blueprint instance.
+	RunMetadata *state.DeploymentRunMetadata
+}
+
+func (c *defaultBlueprintContainer) CloneInstance(
+	ctx context.Context,
+	input *CloneInstanceInput,
+	channels *DeployChannels,
+	paramOverrides core.BlueprintParams,
+) error {
+	if input == nil {
+		return errors.New("clone instance input is required")
+	}
+
+	if input.SourceInstanceID == "" {
+		return errors.New("source instance ID is required to clone an instance")
+	}
+
+	if input.NewInstanceName == "" {
+		return errMissingNameForNewInstance()
+	}
+
+	cloneLogger := c.logger.Named("cloneInstance").WithFields(
+		core.StringLogField("sourceInstanceId", input.SourceInstanceID),
+		core.StringLogField("newInstanceName", input.NewInstanceName),
+	)
+
+	sourceInstance, err := c.stateContainer.Instances().Get(ctx, input.SourceInstanceID)
+	if err != nil {
+		return err
+	}
+
+	if sourceInstance.Status == core.InstanceStatusDestroyed {
+		return errCloneSourceInstanceDestroyed(input.SourceInstanceID)
+	}
+
+	existingInstanceID, err := c.getInstanceID(ctx, "", input.NewInstanceName)
+	if err != nil {
+		return err
+	}
+
+	if existingInstanceID != "" {
+		return errCloneTargetInstanceExists(input.NewInstanceName)
+	}
+
+	// The new instance starts with fresh state, so the staged changes
+	// will contain every element of the loaded blueprint resolved
+	// with the overridden parameters for the new environment.
+	cloneLogger.Info("staging changes for the new blueprint instance")
+	cloneChanges, err := c.stageChangesAndWait(
+		ctx,
+		&StageChangesInput{
+			InstanceName: input.NewInstanceName,
+		},
+		paramOverrides,
+	)
+	if err != nil {
+		return err
+	}
+
+	cloneLogger.Info("deploying the new blueprint instance")
+	return c.Deploy(
+		ctx,
+		&DeployInput{
+			InstanceName:           input.NewInstanceName,
+			Changes:                cloneChanges,
+			TaggingConfig:          input.TaggingConfig,
+			ProviderMetadataLookup: input.ProviderMetadataLookup,
+			DrainTimeout:           input.DrainTimeout,
+			RunID:                  input.RunID,
+			RunMetadata:            input.RunMetadata,
+		},
+		channels,
+		paramOverrides,
+	)
+}
@@ -0,0 +1,108 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (s *ContainerDeployTestSuite) Test_clones_blueprint_instance_into_new_instance() {
+	channels := CreateDeployChannels()
+	err := s.blueprint1Fixture.blueprintContainer.CloneInstance(
+		context.Background(),
+		&CloneInstanceInput{
+			SourceInstanceID: "blueprint-instance-1",
+			NewInstanceName:  "BlueprintInstance1Review",
+		},
+		channels,
+		s.fixture1Params,
+	)
+	s.Require().NoError(err)
+
+	finishedMessage := (*DeploymentFinishedMessage)(nil)
+	for err == nil &&
+		finishedMessage == nil {
+		select {
+		case <-channels.ResourceUpdateChan:
+		case <-channels.ChildUpdateChan:
+		case <-channels.LinkUpdateChan:
+		case <-channels.DeploymentUpdateChan:
+		case msg := <-channels.FinishChan:
+			finishedMessage = &msg
+		case err = <-channels.ErrChan:
+		case <-time.After(defaultDrainTimeout):
+			err = errors.New(timeoutMessage)
+		}
+	}
+	s.Require().NoError(err)
+	s.Equal(core.InstanceStatusDeployed, finishedMessage.Status)
+	s.NotEqual("blueprint-instance-1", finishedMessage.InstanceID)
+
+	clonedInstanceID, err := s.stateContainer.Instances().LookupIDByName(
+		context.Background(),
+		"BlueprintInstance1Review",
+	)
+	s.Require().NoError(err)
+	s.Equal(finishedMessage.InstanceID, clonedInstanceID)
+
+	clonedInstance, err := s.stateContainer.Instances().Get(context.Background(), clonedInstanceID)
+	s.Require().NoError(err)
+	s.NotEmpty(clonedInstance.ResourceIDs)
+
+	// The resources in the cloned instance must be created from scratch
+	// instead of being shared with the source instance.
+	sourceInstance, err := s.stateContainer.Instances().Get(context.Background(), "blueprint-instance-1")
+	s.Require().NoError(err)
+	for resourceName, resourceID := range clonedInstance.ResourceIDs {
+		s.NotEqual(sourceInstance.ResourceIDs[resourceName], resourceID)
+	}
+}
+
+func (s *ContainerDeployTestSuite) Test_fails_to_clone_instance_when_new_instance_name_is_taken() {
+	err := s.blueprint1Fixture.blueprintContainer.CloneInstance(
+		context.Background(),
+		&CloneInstanceInput{
+			SourceInstanceID: "blueprint-instance-1",
+			NewInstanceName:  "BlueprintInstance1",
+		},
+		CreateDeployChannels(),
+		s.fixture1Params,
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*bperrors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeCloneTargetInstanceExists, runErr.ReasonCode)
+}
+
+func (s *ContainerDeployTestSuite) Test_fails_to_clone_instance_that_does_not_exist() {
+	err := s.blueprint1Fixture.blueprintContainer.CloneInstance(
+		context.Background(),
+		&CloneInstanceInput{
+			SourceInstanceID: "missing-instance",
+			NewInstanceName:  "BlueprintInstance1Review",
+		},
+		CreateDeployChannels(),
+		s.fixture1Params,
+	)
+	s.Require().Error(err)
+	s.True(state.IsInstanceNotFound(err))
+}
+
+func (s *ContainerDeployTestSuite) Test_fails_to_clone_instance_without_new_instance_name() {
+	err := s.blueprint1Fixture.blueprintContainer.CloneInstance(
+		context.Background(),
+		&CloneInstanceInput{
+			SourceInstanceID: "blueprint-instance-1",
+		},
+		CreateDeployChannels(),
+		s.fixture1Params,
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*bperrors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeMissingNameForNewInstance, runErr.ReasonCode)
+}
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
@@ -38,7 +40,10 @@ func (c *defaultBlueprintContainer) Deploy(
 		return err
 	}
 
-	ctxWithInstanceID := context.WithValue(ctx, core.BlueprintInstanceIDKey, instanceID)
+	ctxWithInstanceID := withDeployStagingTimestamp(
+		context.WithValue(ctx, core.BlueprintInstanceIDKey, instanceID),
+		input.Changes,
+	)
 	deployLogger := c.logger.Named("deploy").WithFields(
 		core.StringLogField("instanceId", input.InstanceID),
 		core.StringLogField("instanceName", input.InstanceName),
@@ -58,6 +63,29 @@ func (c *defaultBlueprintContainer) Deploy(
 		return errMissingNameForNewInstance()
 	}
 
+	// Rollbacks are not subject to blast radius limits as they revert
+	// changes that have already been applied.
+	if !input.Rollback && !input.OverrideBlastRadius {
+		err = CheckBlastRadius(input.Changes, input.BlastRadiusLimits)
+		if err != nil {
+			deployLogger.Error(
+				"changes exceed the blast radius limits for the deployment",
+				core.ErrorLogField("error", err),
+			)
+			return err
+		}
+	}
+
+	// Rollbacks are not subject to policies as they revert
+	// changes that have already been applied.
+	if !input.Rollback {
+		err = c.evaluatePolicies(ctx, instanceID, input, paramOverrides, deployLogger)
+		if err != nil {
+			return err
+		}
+	}
+
+	runID := c.deploymentRunID(input.RunID)
 	initialised, err := c.saveNewInstance(
 		ctx,
 		instanceID,
@@ -69,6 +97,36 @@ func (c *defaultBlueprintContainer) Deploy(
 		return err
 	}
 
+	// Events are recorded between the interceptor for top-level instance
+	// events and the caller-provided channels so that recorded events
+	// are in the same order as they are received by the caller.
+	channels, stopRecording := c.recordDeploymentEvents(
+		ctxWithInstanceID,
+		instanceID,
+		runID,
+		channels,
+	)
+
+	summaryInstanceID, summaryInstanceName := c.summaryInstance(
+		ctx,
+		instanceID,
+		input.InstanceName,
+	)
+	channels, stopSummarising := c.summariseDeployment(
+		ctxWithInstanceID,
+		&DeploymentSummary{
+			Operation:    DeploymentOperationDeploy,
+			InstanceID:   summaryInstanceID,
+			InstanceName: summaryInstanceName,
+			RunID:        runID,
+			RunMetadata:  input.RunMetadata,
+			Rollback:     input.Rollback,
+			Changes:      input.Changes,
+		},
+		channels,
+		paramOverrides,
+	)
+
 	interceptDeploymentUpdateChan := make(chan DeploymentUpdateMessage)
 	interceptDeploymentFinishChan := make(chan DeploymentFinishedMessage)
 	rewiredChannels := &DeployChannels{
@@ -99,6 +157,8 @@ func (c *defaultBlueprintContainer) Deploy(
 				TaggingConfig:          input.TaggingConfig,
 				ProviderMetadataLookup: input.ProviderMetadataLookup,
 				DrainTimeout:           input.DrainTimeout,
+				Targets:                input.Targets,
+				EstimatedDuration:      input.EstimatedDuration,
 			},
 			rewiredChannels,
 			state,
@@ -129,16 +189,21 @@ func (c *defaultBlueprintContainer) Deploy(
 	// As this is a single point where we can intercept when the instance deployment
 	// has finished either successfully or with a failure,
 	// it is also used to ensure that some clean up tasks are performed.
-	go c.saveInstanceDeploymentStateAndCleanup(
-		ctxWithInstanceID,
-		instanceID,
-		isNewInstance,
-		input.Rollback,
-		rewiredChannels,
-		channels,
-		resourceRegistry,
-		deployDone,
-	)
+	go func() {
+		defer stopRecording()
+		defer stopSummarising()
+		c.saveInstanceDeploymentStateAndCleanup(
+			ctxWithInstanceID,
+			instanceID,
+			isNewInstance,
+			input.Rollback,
+			input.TTL,
+			rewiredChannels,
+			channels,
+			resourceRegistry,
+			deployDone,
+		)
+	}()
 
 	return nil
 }
@@ -255,6 +320,31 @@ func (c *defaultBlueprintContainer) deploy(
 		SkipPersist: true,
 	}
 
+	instanceName := input.InstanceName
+	if instanceName == "" {
+		instanceName = currentInstanceState.InstanceName
+	}
+	err = c.hooks.Run(ctx, &HookInfo{
+		Event:        schema.HookEventBeforeDeploy,
+		InstanceID:   input.InstanceID,
+		InstanceName: instanceName,
+		Rollback:     input.Rollback,
+	})
+	if err != nil {
+		deployLogger.Debug(
+			"before deploy hook failed, exiting deployment early",
+			core.ErrorLogField("error", err),
+		)
+		channels.FinishChan <- c.createDeploymentFinishedMessage(
+			input.InstanceID,
+			determineInstanceDeployFailedStatus(input.Rollback, isNewInstance),
+			[]string{hookFailedMessage(schema.HookEventBeforeDeploy, err)},
+			c.clock.Since(startTime),
+			/* prepareElapsedTime */ nil,
+		)
+		return
+	}
+
 	deployLogger.Info(
 		"preparing blueprint (expanding templates, applying resource conditions etc.) for deployment",
 	)
@@ -307,8 +397,21 @@ func (c *defaultBlueprintContainer) deploy(
 		TaggingConfig:          input.TaggingConfig,
 		ProviderMetadataLookup: input.ProviderMetadataLookup,
 		DrainTimeout:           drainTimeout,
+		Credentials: newCredentialsTracker(
+			c.providers,
+			deployDeps.paramOverrides,
+			c.clock,
+			deployLogger,
+		),
 	}
 
+	deployLogger.Info("checking provider credentials will remain valid for the deployment")
+	deployCtx.Credentials.Preflight(
+		ctx,
+		deploymentProviderNamespaces(c.providers, deployCtx.ResourceProviders),
+		estimatedDeploymentDuration(input, &currentInstanceState),
+	)
+
 	flattenedNodes := core.Flatten(prepareResult.ParallelGroups)
 	// Ensure all direct dependencies are populated between nodes
 	// in the deployment groups, this provides the information needed
@@ -325,6 +428,25 @@ func (c *defaultBlueprintContainer) deploy(
 		return
 	}
 
+	if len(input.Targets) > 0 {
+		deployLogger.Info(
+			"restricting deployment to targeted resources and their dependencies",
+			core.StringLogField("targets", strings.Join(input.Targets, ", ")),
+		)
+		targetedChanges, err := selectTargetedChanges(
+			input.Changes,
+			input.Targets,
+			flattenedNodes,
+			deployCtx.ResourceTemplates,
+		)
+		if err != nil {
+			channels.ErrChan <- wrapErrorForChildContext(err, deployDeps.paramOverrides)
+			return
+		}
+		input.Changes = targetedChanges
+		deployCtx.InputChanges = targetedChanges
+	}
+
 	sentFinishedMessage, err := c.removeElements(
 		ctx,
 		input,
@@ -366,6 +488,21 @@ func (c *defaultBlueprintContainer) deploy(
 		return
 	}
 
+	// The changes have already been applied, so a failure in an after deploy hook
+	// (e.g. a failing smoke test) is logged instead of failing the deployment.
+	err = c.hooks.Run(ctx, &HookInfo{
+		Event:        schema.HookEventAfterDeploy,
+		InstanceID:   input.InstanceID,
+		InstanceName: instanceName,
+		Rollback:     input.Rollback,
+	})
+	if err != nil {
+		deployLogger.Warn(
+			"after deploy hook failed",
+			core.ErrorLogField("error", err),
+		)
+	}
+
 	channels.FinishChan <- c.createDeploymentFinishedMessage(
 		input.InstanceID,
 		determineInstanceDeployedStatus(input.Rollback, isNewInstance),
@@ -386,6 +523,7 @@ func (c *defaultBlueprintContainer) saveExportsAndMetadata(
 			ctx,
 			input.InstanceID,
 			blueprint,
+			deployCtx,
 		)
 		if err != nil {
 			return err
@@ -410,6 +548,7 @@ func (c *defaultBlueprintContainer) saveExports(
 	ctx context.Context,
 	instanceID string,
 	blueprint *schema.Blueprint,
+	deployCtx *DeployContext,
 ) error {
 	exports := map[string]*state.ExportState{}
 	for exportName, export := range blueprint.Exports.Values {
@@ -437,11 +576,23 @@ func (c *defaultBlueprintContainer) saveExports(
 			return err
 		}
 
+		sensitive, err := isSensitiveExport(
+			ctx,
+			blueprint,
+			field,
+			deployCtx.ResourceRegistry,
+			deployCtx.ParamOverrides,
+		)
+		if err != nil {
+			return err
+		}
+
 		exports[exportName] = &state.ExportState{
 			Type:        resolveResult.ResolvedExport.Type.Value,
 			Value:       resolveValueResult.Resolved,
 			Description: core.StringValue(resolveResult.ResolvedExport.Description),
 			Field:       field,
+			Sensitive:   sensitive,
 		}
 	}
 
@@ -498,6 +649,7 @@ func (c *defaultBlueprintContainer) saveInstanceDeploymentStateAndCleanup(
 	instanceID string,
 	isNewInstance bool,
 	rollingBack bool,
+	ttl time.Duration,
 	listenToChannels *DeployChannels,
 	forwardToChannels *DeployChannels,
 	resourceRegistry resourcehelpers.Registry,
@@ -555,7 +707,12 @@ func (c *defaultBlueprintContainer) saveInstanceDeploymentStateAndCleanup(
 
 		case msg := <-listenToChannels.FinishChan:
 			if !msg.SkipPersist {
-				statusInfo := createDeployFinishedInstanceStatusInfo(&msg, rollingBack, isNewInstance)
+				statusInfo := createDeployFinishedInstanceStatusInfo(
+					&msg,
+					rollingBack,
+					isNewInstance,
+					ttl,
+				)
 				err := c.stateContainer.Instances().UpdateStatus(
 					ctx,
 					instanceID,
@@ -781,7 +938,7 @@ func (c *defaultBlueprintContainer) deployNode(
 		// states between initiating the deployment and the listener receiving
 		// the in-progress message.
 		deployCtx.State.SetElementInProgress(resourceElem)
-		go c.resourceDeployer.Deploy(
+		go c.runHooksAndDeployResource(
 			ctx,
 			instanceID,
 			node.ChainLinkNode,
@@ -817,6 +974,48 @@ func (c *defaultBlueprintContainer) deployNode(
 	}
 }
 
+func (c *defaultBlueprintContainer) runHooksAndDeployResource(
+	ctx context.Context,
+	instanceID string,
+	chainLinkNode *links.ChainLinkNode,
+	changes *changes.BlueprintChanges,
+	deployCtx *DeployContext,
+) {
+	err := c.hooks.Run(ctx, &HookInfo{
+		Event:        schema.HookEventBeforeResourceDeploy,
+		InstanceID:   instanceID,
+		InstanceName: deployCtx.InstanceStateSnapshot.InstanceName,
+		ResourceName: chainLinkNode.ResourceName,
+		Rollback:     deployCtx.Rollback,
+	})
+	if err != nil {
+		deployCtx.Channels.ErrChan <- errDeploymentHookFailed(
+			schema.HookEventBeforeResourceDeploy,
+			chainLinkNode.ResourceName,
+			err,
+		)
+		return
+	}
+
+	// The concurrency slot is held until the provider has finished creating
+	// or updating the resource, waiting for the resource to stabilise
+	// happens in the background and does not hold on to a slot.
+	release, err := c.concurrencyLimiter.Acquire(ctx, chainLinkNode.Resource.Type.Value)
+	if err != nil {
+		deployCtx.Channels.ErrChan <- err
+		return
+	}
+	defer release()
+
+	c.resourceDeployer.Deploy(
+		ctx,
+		instanceID,
+		chainLinkNode,
+		changes,
+		deployCtx,
+	)
+}
+
 // deploymentEventLoopState tracks the state of the deployment event loop,
 // including any error that occurred and the drain deadline for graceful shutdown.
 type deploymentEventLoopState struct {
@@ -1479,6 +1678,7 @@ func (c *defaultBlueprintContainer) buildResourceState(
 		LastStatusUpdateTimestamp:  int(msg.UpdateTimestamp),
 		LastDeployAttemptTimestamp: int(c.clock.Now().Unix()),
 		RemovalPolicy:              schema.GetResourceRemovalPolicy(blueprintResource),
+		IgnoreChanges:              schema.GetResourceIgnoreChanges(blueprintResource),
 	}
 
 	if resourceData != nil {
@@ -2059,6 +2259,25 @@ func (c *defaultBlueprintContainer) handleSuccessfulResourceDeployment(
 		node.ChainLinkNode,
 	)
 
+	// After resource deploy hooks are run before the deployment of dependent
+	// elements is initiated so hooks can carry out tasks such as cache invalidation
+	// before dependents make use of the resource.
+	err = c.hooks.Run(ctx, &HookInfo{
+		Event:        schema.HookEventAfterResourceDeploy,
+		InstanceID:   msg.InstanceID,
+		InstanceName: deployCtx.InstanceStateSnapshot.InstanceName,
+		ResourceName: msg.ResourceName,
+		ResourceID:   msg.ResourceID,
+		Rollback:     deployCtx.Rollback,
+	})
+	if err != nil {
+		deployCtx.Logger.Warn(
+			"after resource deploy hook failed",
+			core.StringLogField("resourceName", msg.ResourceName),
+			core.ErrorLogField("error", err),
+		)
+	}
+
 	go c.prepareAndDeployLinks(
 		ctx,
 		msg.InstanceID,
@@ -2551,6 +2770,7 @@ func (c *defaultBlueprintContainer) buildLinkState(
 			if linkDeployResult.ResourceDataMappings != nil {
 				linkState.ResourceDataMappings = linkDeployResult.ResourceDataMappings
 			}
+			linkState.Config = linkDeployResult.Config
 			linkState.IntermediaryResourceStates = linkDeployResult.IntermediaryResourceStates
 		}
 
@@ -2868,6 +3088,12 @@ type resourceDeployInfo struct {
 	resourceImpl provider.Resource
 	changes      *provider.Changes
 	isNew        bool
+	// The resource spec as defined in the source blueprint,
+	// used to resolve the source positions of fields that caused a failure.
+	sourceSpec *core.MappingNode
+	// The maximum amount of time to wait for the provider to create
+	// or update the resource, zero means there is no timeout.
+	timeout time.Duration
 }
 
 // DeployChannels contains all the channels required to stream
@@ -0,0 +1,294 @@
+package container
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// SavedPlanFormatVersion is the version of the format for saved plans.
+// Plans saved with a different format version can not be deployed.
+const SavedPlanFormatVersion = "1"
+
+// SavedPlan holds a set of staged changes along with a fingerprint
+// of the state of the blueprint instance that the changes were staged against.
+// A saved plan can be persisted (e.g. as a JSON file in a CI pipeline)
+// and deployed at a later point with DeployFromPlan, which guarantees that
+// the changes that were reviewed are exactly the changes that will be applied.
+type SavedPlan struct {
+	// FormatVersion is the version of the saved plan format.
+	FormatVersion string `json:"formatVersion"`
+	// InstanceID is the ID of the blueprint instance the changes were staged for.
+	// This will be empty when the plan is for a new blueprint instance.
+	InstanceID string `json:"instanceId,omitempty"`
+	// InstanceName is the user-defined name of the blueprint instance
+	// the changes were staged for.
+	InstanceName string `json:"instanceName,omitempty"`
+	// StateFingerprint is a digest of the blueprint instance state
+	// that the changes were staged against.
+	// This will be empty when the plan is for a new blueprint instance.
+	StateFingerprint string `json:"stateFingerprint,omitempty"`
+	// Changes holds the staged changes that will be deployed.
+	Changes *changes.BlueprintChanges `json:"changes"`
+	// Created is the unix timestamp in seconds when the plan was saved.
+	Created int64 `json:"created"`
+}
+
+// SavePlanInput contains the input needed to save a plan
+// for a set of staged changes.
+type SavePlanInput struct {
+	// InstanceID is the ID of the blueprint instance that the changes were staged for.
+	// If this is set, `InstanceName` must be empty.
+	InstanceID string
+	// InstanceName is the user-defined name of the blueprint instance that
+	// the changes were staged for.
+	// If this is set, `InstanceID` must be empty.
+	InstanceName string
+	// Changes holds the staged changes to save in the plan.
+	Changes *changes.BlueprintChanges
+}
+
+// DeployFromPlanInput contains the input needed to deploy
+// the changes in a saved plan.
+type DeployFromPlanInput struct {
+	// Plan is the saved plan to deploy.
+	Plan *SavedPlan
+	// Force bypasses state validation checks that prevent deployment when the instance
+	// is already in an active state (e.g., Deploying, Updating).
+	// This does not bypass the check that the instance state has not diverged
+	// since the plan was saved.
+	Force bool
+	// TaggingConfig holds the configuration for Bluelink resource tagging.
+	// If nil, tagging will not be applied to resources.
+	TaggingConfig *provider.TaggingConfig
+	// ProviderMetadataLookup returns provider plugin metadata for a provider namespace.
+	ProviderMetadataLookup func(providerNamespace string) (pluginID, pluginVersion string)
+	// DrainTimeout is the maximum time to wait for in-flight operations
+	// to complete after a terminal failure before marking them as interrupted.
+	// If zero, defaults to DefaultDrainTimeout (2 minutes).
+	DrainTimeout time.Duration
+	// BlastRadiusLimits holds thresholds for the number of changes and deletes
+	// that can be applied in the deployment.
+	BlastRadiusLimits *BlastRadiusLimits
+	// OverrideBlastRadius explicitly allows a deployment to proceed
+	// when the changes in the plan exceed the configured blast radius limits.
+	OverrideBlastRadius bool
+	// RunID identifies the deployment in the events recorded in the
+	// deployment event store configured for the container.
+	// When not provided, a run ID will be generated for the deployment.
+	RunID string
+	// RunMetadata holds information about who initiated the deployment and the
+	// versions of the tools and blueprint used for the audit trail of the
+	// blueprint instance.
+	RunMetadata *state.DeploymentRunMetadata
+	// TTL marks the blueprint instance as ephemeral, once the deployment succeeds,
+	// the instance will be set to expire after the TTL has elapsed.
+	// If zero, the current expiry of the instance is left unchanged.
+	TTL time.Duration
+}
+
+func (c *defaultBlueprintContainer) SavePlan(
+	ctx context.Context,
+	input *SavePlanInput,
+) (*SavedPlan, error) {
+	if input == nil || input.Changes == nil {
+		return nil, errors.New("changes are required to save a plan")
+	}
+
+	instanceID, err := c.getInstanceID(ctx, input.InstanceID, input.InstanceName)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint, err := c.getInstanceStateFingerprint(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SavedPlan{
+		FormatVersion:    SavedPlanFormatVersion,
+		InstanceID:       instanceID,
+		InstanceName:     input.InstanceName,
+		StateFingerprint: fingerprint,
+		Changes:          input.Changes,
+		Created:          c.clock.Now().Unix(),
+	}, nil
+}
+
+func (c *defaultBlueprintContainer) DeployFromPlan(
+	ctx context.Context,
+	input *DeployFromPlanInput,
+	channels *DeployChannels,
+	paramOverrides core.BlueprintParams,
+) error {
+	if input == nil || input.Plan == nil || input.Plan.Changes == nil {
+		return errors.New("a saved plan with changes is required to deploy from a plan")
+	}
+
+	plan := input.Plan
+	if plan.FormatVersion != SavedPlanFormatVersion {
+		return errUnsupportedSavedPlanFormat(plan.FormatVersion)
+	}
+
+	instanceID := plan.InstanceID
+	if instanceID == "" && plan.InstanceName != "" {
+		// A plan for a new instance can only be deployed if the instance
+		// has not been created since the plan was saved.
+		resolvedInstanceID, err := c.getInstanceID(ctx, "", plan.InstanceName)
+		if err != nil {
+			return err
+		}
+		instanceID = resolvedInstanceID
+	}
+
+	currentFingerprint, err := c.getInstanceStateFingerprint(ctx, instanceID)
+	if err != nil {
+		if state.IsInstanceNotFound(err) {
+			return errSavedPlanStateDiverged(plan)
+		}
+		return err
+	}
+
+	if currentFingerprint != plan.StateFingerprint {
+		return errSavedPlanStateDiverged(plan)
+	}
+
+	deployInput := &DeployInput{
+		InstanceID:             plan.InstanceID,
+		Changes:                plan.Changes,
+		Force:                  input.Force,
+		TaggingConfig:          input.TaggingConfig,
+		ProviderMetadataLookup: input.ProviderMetadataLookup,
+		DrainTimeout:           input.DrainTimeout,
+		BlastRadiusLimits:      input.BlastRadiusLimits,
+		OverrideBlastRadius:    input.OverrideBlastRadius,
+		RunID:                  input.RunID,
+		RunMetadata:            input.RunMetadata,
+		TTL:                    input.TTL,
+	}
+	if plan.InstanceID == "" {
+		deployInput.InstanceName = plan.InstanceName
+	}
+
+	return c.Deploy(ctx, deployInput, channels, paramOverrides)
+}
+
+// getInstanceStateFingerprint produces a digest of the parts of the state
+// of a blueprint instance that changes are staged against.
+// An empty fingerprint is returned for new instances that do not have an ID yet.
+func (c *defaultBlueprintContainer) getInstanceStateFingerprint(
+	ctx context.Context,
+	instanceID string,
+) (string, error) {
+	if instanceID == "" {
+		return "", nil
+	}
+
+	instanceState, err := c.stateContainer.Instances().Get(ctx, instanceID)
+	if err != nil {
+		return "", err
+	}
+
+	serialised, err := json.Marshal(toFingerprintInstance(&instanceState, 0))
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(serialised)
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// fingerprintInstance holds the subset of instance state that is used to detect
+// whether the state of an instance has diverged since changes were staged.
+// Timestamps for status updates are omitted as they are not relevant
+// to the changes that will be applied, the last deployment attempt timestamp
+// is included to catch deployments that have been attempted since.
+type fingerprintInstance struct {
+	Status                     core.InstanceStatus             `json:"status"`
+	LastDeployedTimestamp      int                             `json:"lastDeployedTimestamp"`
+	LastDeployAttemptTimestamp int                             `json:"lastDeployAttemptTimestamp"`
+	Resources                  []*fingerprintResource          `json:"resources"`
+	Links                      []*fingerprintLink              `json:"links"`
+	Exports                    map[string]*core.MappingNode    `json:"exports"`
+	Children                   map[string]*fingerprintInstance `json:"children"`
+}
+
+type fingerprintResource struct {
+	ResourceID    string                     `json:"id"`
+	Name          string                     `json:"name"`
+	Type          string                     `json:"type"`
+	PreciseStatus core.PreciseResourceStatus `json:"preciseStatus"`
+	SpecData      *core.MappingNode          `json:"specData"`
+	Drifted       bool                       `json:"drifted"`
+}
+
+type fingerprintLink struct {
+	LinkID        string                       `json:"id"`
+	Name          string                       `json:"name"`
+	PreciseStatus core.PreciseLinkStatus       `json:"preciseStatus"`
+	Data          map[string]*core.MappingNode `json:"data"`
+}
+
+func toFingerprintInstance(instanceState *state.InstanceState, depth int) *fingerprintInstance {
+	fingerprint := &fingerprintInstance{
+		Status:                     instanceState.Status,
+		LastDeployedTimestamp:      instanceState.LastDeployedTimestamp,
+		LastDeployAttemptTimestamp: instanceState.LastDeployAttemptTimestamp,
+		Resources:                  make([]*fingerprintResource, 0, len(instanceState.Resources)),
+		Links:                      make([]*fingerprintLink, 0, len(instanceState.Links)),
+		Exports:                    map[string]*core.MappingNode{},
+		Children:                   map[string]*fingerprintInstance{},
+	}
+
+	for _, resource := range instanceState.Resources {
+		fingerprint.Resources = append(fingerprint.Resources, &fingerprintResource{
+			ResourceID:    resource.ResourceID,
+			Name:          resource.Name,
+			Type:          resource.Type,
+			PreciseStatus: resource.PreciseStatus,
+			SpecData:      resource.SpecData,
+			Drifted:       resource.Drifted,
+		})
+	}
+	slices.SortFunc(fingerprint.Resources, func(a, b *fingerprintResource) int {
+		return strings.Compare(a.ResourceID, b.ResourceID)
+	})
+
+	for _, link := range instanceState.Links {
+		fingerprint.Links = append(fingerprint.Links, &fingerprintLink{
+			LinkID:        link.LinkID,
+			Name:          link.Name,
+			PreciseStatus: link.PreciseStatus,
+			Data:          link.Data,
+		})
+	}
+	slices.SortFunc(fingerprint.Links, func(a, b *fingerprintLink) int {
+		return strings.Compare(a.LinkID, b.LinkID)
+	})
+
+	for exportName, export := range instanceState.Exports {
+		if export != nil {
+			fingerprint.Exports[exportName] = export.Value
+		}
+	}
+
+	if depth < MaxBlueprintDepth {
+		for childName, childState := range instanceState.ChildBlueprints {
+			if childState != nil {
+				fingerprint.Children[childName] = toFingerprintInstance(childState, depth+1)
+			}
+		}
+	}
+
+	return fingerprint
+}
@@ -0,0 +1,172 @@
+package container
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (s *ContainerReconciliationTestSuite) Test_save_plan_returns_error_when_changes_are_missing() {
+	_, err := s.container.SavePlan(
+		context.Background(),
+		&SavePlanInput{
+			InstanceID: testReconciliationInstanceID,
+		},
+	)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "changes are required")
+}
+
+func (s *ContainerReconciliationTestSuite) Test_save_plan_for_new_instance_has_empty_fingerprint() {
+	plan, err := s.container.SavePlan(
+		context.Background(),
+		&SavePlanInput{
+			InstanceName: "NewInstance",
+			Changes:      &changes.BlueprintChanges{},
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(SavedPlanFormatVersion, plan.FormatVersion)
+	s.Equal("", plan.InstanceID)
+	s.Equal("NewInstance", plan.InstanceName)
+	s.Equal("", plan.StateFingerprint)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_save_plan_fingerprint_is_stable_for_unchanged_state() {
+	err := s.populateTestState(createSavedPlanTestResources(), nil)
+	s.Require().NoError(err)
+
+	firstPlan, err := s.container.SavePlan(
+		context.Background(),
+		&SavePlanInput{
+			InstanceName: testReconciliationInstanceName,
+			Changes:      &changes.BlueprintChanges{},
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(testReconciliationInstanceID, firstPlan.InstanceID)
+	s.NotEmpty(firstPlan.StateFingerprint)
+
+	secondPlan, err := s.container.SavePlan(
+		context.Background(),
+		&SavePlanInput{
+			InstanceID: testReconciliationInstanceID,
+			Changes:    &changes.BlueprintChanges{},
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(firstPlan.StateFingerprint, secondPlan.StateFingerprint)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_deploy_from_plan_fails_when_state_has_diverged() {
+	resources := createSavedPlanTestResources()
+	err := s.populateTestState(resources, nil)
+	s.Require().NoError(err)
+
+	plan, err := s.container.SavePlan(
+		context.Background(),
+		&SavePlanInput{
+			InstanceID: testReconciliationInstanceID,
+			Changes: &changes.BlueprintChanges{
+				RemovedResources: []string{"ordersTable"},
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	// Round trip the plan to simulate a plan that was persisted
+	// and loaded in a later step of a CI pipeline.
+	serialised, err := json.Marshal(plan)
+	s.Require().NoError(err)
+	loadedPlan := &SavedPlan{}
+	err = json.Unmarshal(serialised, loadedPlan)
+	s.Require().NoError(err)
+
+	// Simulate a change to the deployed resource after the plan was saved.
+	updatedResource := *resources["resource-1"]
+	updatedResource.SpecData = &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"tableName": core.MappingNodeFromString("orders-v2"),
+		},
+	}
+	err = s.stateContainer.Resources().Save(context.Background(), updatedResource)
+	s.Require().NoError(err)
+
+	err = s.container.DeployFromPlan(
+		context.Background(),
+		&DeployFromPlanInput{
+			Plan: loadedPlan,
+		},
+		&DeployChannels{},
+		nil,
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeSavedPlanStateDiverged, runErr.ReasonCode)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_deploy_from_plan_fails_when_new_instance_has_since_been_created() {
+	plan := &SavedPlan{
+		FormatVersion: SavedPlanFormatVersion,
+		InstanceName:  testReconciliationInstanceName,
+		Changes:       &changes.BlueprintChanges{},
+	}
+
+	err := s.populateTestState(createSavedPlanTestResources(), nil)
+	s.Require().NoError(err)
+
+	err = s.container.DeployFromPlan(
+		context.Background(),
+		&DeployFromPlanInput{
+			Plan: plan,
+		},
+		&DeployChannels{},
+		nil,
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeSavedPlanStateDiverged, runErr.ReasonCode)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_deploy_from_plan_fails_for_unsupported_format_version() {
+	err := s.container.DeployFromPlan(
+		context.Background(),
+		&DeployFromPlanInput{
+			Plan: &SavedPlan{
+				FormatVersion: "0",
+				InstanceID:    testReconciliationInstanceID,
+				Changes:       &changes.BlueprintChanges{},
+			},
+		},
+		&DeployChannels{},
+		nil,
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeUnsupportedSavedPlanFormat, runErr.ReasonCode)
+}
+
+func createSavedPlanTestResources() map[string]*state.ResourceState {
+	return map[string]*state.ResourceState{
+		"resource-1": {
+			ResourceID:    "resource-1",
+			Name:          "ordersTable",
+			Type:          "aws/dynamodb/table",
+			InstanceID:    testReconciliationInstanceID,
+			Status:        core.ResourceStatusCreated,
+			PreciseStatus: core.PreciseResourceStatusCreated,
+			SpecData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"tableName": core.MappingNodeFromString("orders"),
+				},
+			},
+		},
+	}
+}
@@ -984,6 +984,7 @@ func (s *stubBlueprintContainerLoader) ValidateFromSchema(
 
 type stubBlueprintContainer struct {
 	deployEventSequence []*DeployEvent
+	spec                speccore.BlueprintSpec
 }
 
 func (c *stubBlueprintContainer) StageChanges(
@@ -1042,7 +1043,7 @@ func (c *stubBlueprintContainer) SpecLinkInfo() links.SpecLinkInfo {
 }
 
 func (c *stubBlueprintContainer) BlueprintSpec() speccore.BlueprintSpec {
-	return nil
+	return c.spec
 }
 
 func (c *stubBlueprintContainer) RefChainCollector() refgraph.RefChainCollector {
@@ -1053,6 +1054,33 @@ func (c *stubBlueprintContainer) ResourceTemplates() map[string]string {
 	return map[string]string{}
 }
 
+func (c *stubBlueprintContainer) Hooks() *DeploymentHooks {
+	return NewDeploymentHooks()
+}
+
+func (c *stubBlueprintContainer) ExportGraph(
+	ctx context.Context,
+	format GraphFormat,
+	paramOverrides core.BlueprintParams,
+) ([]byte, error) {
+	return nil, nil
+}
+
+func (c *stubBlueprintContainer) Render(
+	ctx context.Context,
+	paramOverrides core.BlueprintParams,
+) (*RenderedBlueprint, error) {
+	return nil, nil
+}
+
+func (c *stubBlueprintContainer) EvaluateExpression(
+	ctx context.Context,
+	input *EvaluateExpressionInput,
+	paramOverrides core.BlueprintParams,
+) (*EvaluatedExpression, error) {
+	return nil, nil
+}
+
 func (c *stubBlueprintContainer) Diagnostics() []*core.Diagnostic {
 	return []*core.Diagnostic{}
 }
@@ -1072,3 +1100,108 @@ func (c *stubBlueprintContainer) ApplyReconciliation(
 ) (*ApplyReconciliationResult, error) {
 	return nil, nil
 }
+
+func (c *stubBlueprintContainer) ListImportCandidates(
+	ctx context.Context,
+	input *ListImportCandidatesInput,
+	paramOverrides core.BlueprintParams,
+) (*ImportCandidatesResult, error) {
+	return nil, nil
+}
+
+func (c *stubBlueprintContainer) ImportResources(
+	ctx context.Context,
+	input *ImportResourcesInput,
+	paramOverrides core.BlueprintParams,
+) (*ImportResourcesResult, error) {
+	return nil, nil
+}
+
+func (c *stubBlueprintContainer) TaintResource(
+	ctx context.Context,
+	instanceID string,
+	resourceName string,
+) error {
+	return nil
+}
+
+func (c *stubBlueprintContainer) UntaintResource(
+	ctx context.Context,
+	instanceID string,
+	resourceName string,
+) error {
+	return nil
+}
+
+func (c *stubBlueprintContainer) ReapplyLinks(
+	ctx context.Context,
+	input *ReapplyLinksInput,
+	paramOverrides core.BlueprintParams,
+) (*ReapplyLinksResult, error) {
+	return &ReapplyLinksResult{}, nil
+}
+
+func (c *stubBlueprintContainer) GetOutputs(
+	ctx context.Context,
+	instanceID string,
+) (*BlueprintOutputs, error) {
+	return &BlueprintOutputs{}, nil
+}
+
+func (c *stubBlueprintContainer) ResumeDeployment(
+	ctx context.Context,
+	input *ResumeDeploymentInput,
+	channels *DeployChannels,
+	paramOverrides core.BlueprintParams,
+) error {
+	return nil
+}
+
+func (c *stubBlueprintContainer) SavePlan(
+	ctx context.Context,
+	input *SavePlanInput,
+) (*SavedPlan, error) {
+	return nil, nil
+}
+
+func (c *stubBlueprintContainer) DeployFromPlan(
+	ctx context.Context,
+	input *DeployFromPlanInput,
+	channels *DeployChannels,
+	paramOverrides core.BlueprintParams,
+) error {
+	return nil
+}
+
+func (c *stubBlueprintContainer) CloneInstance(
+	ctx context.Context,
+	input *CloneInstanceInput,
+	channels *DeployChannels,
+	paramOverrides core.BlueprintParams,
+) error {
+	return nil
+}
+
+func (c *stubBlueprintContainer) VerifyChanges(
+	ctx context.Context,
+	input *VerifyChangesInput,
+	paramOverrides core.BlueprintParams,
+) (*VerifyChangesResult, error) {
+	return nil, nil
+}
+
+func (c *stubBlueprintContainer) PlanReconciliation(
+	ctx context.Context,
+	input *ApplyReconciliationInput,
+	paramOverrides core.BlueprintParams,
+) (*ReconciliationPlan, error) {
+	return nil, nil
+}
+
+func (c *stubBlueprintContainer) RefreshState(
+	ctx context.Context,
+	input *RefreshStateInput,
+	paramOverrides core.BlueprintParams,
+) (*RefreshStateResult, error) {
+	return nil, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
 )
 
@@ -49,6 +50,34 @@ func (c *defaultBlueprintContainer) Destroy(
 	ctxWithInstanceID := context.WithValue(ctx, core.BlueprintInstanceIDKey, input.InstanceID)
 	state := c.createDeploymentState()
 
+	runID := c.deploymentRunID(input.RunID)
+	channels, stopRecording := c.recordDeploymentEvents(
+		ctxWithInstanceID,
+		c.destroyEventsInstanceID(ctx, input),
+		runID,
+		channels,
+	)
+
+	summaryInstanceID, summaryInstanceName := c.summaryInstance(
+		ctx,
+		input.InstanceID,
+		input.InstanceName,
+	)
+	channels, stopSummarising := c.summariseDeployment(
+		ctxWithInstanceID,
+		&DeploymentSummary{
+			Operation:    DeploymentOperationDestroy,
+			InstanceID:   summaryInstanceID,
+			InstanceName: summaryInstanceName,
+			RunID:        runID,
+			RunMetadata:  input.RunMetadata,
+			Rollback:     input.Rollback,
+			Changes:      input.Changes,
+		},
+		channels,
+		paramOverrides,
+	)
+
 	// Top-level destroy events are intercepted in the same way as deployment
 	// events so failed destroy statuses are persisted before reaching the
 	// caller. Without this, a failed destroy would leave the instance with
@@ -78,13 +107,37 @@ func (c *defaultBlueprintContainer) Destroy(
 		)
 	}()
 
-	go c.saveInstanceDestroyStateAndCleanup(
-		ctxWithInstanceID,
-		input,
-		rewiredChannels,
-		channels,
-		destroyDone,
-	)
+	go func() {
+		defer stopRecording()
+		defer stopSummarising()
+		c.saveInstanceDestroyStateAndCleanup(
+			ctxWithInstanceID,
+			input,
+			rewiredChannels,
+			channels,
+			destroyDone,
+		)
+	}()
+}
+
+// The ID of the instance that events for a destroy operation are recorded for,
+// the instance ID must be resolved from the name when only a name is provided.
+func (c *defaultBlueprintContainer) destroyEventsInstanceID(
+	ctx context.Context,
+	input *DestroyInput,
+) string {
+	if c.deploymentEventStore == nil || input.InstanceID != "" {
+		return input.InstanceID
+	}
+
+	instanceID, err := c.getInstanceID(ctx, input.InstanceID, input.InstanceName)
+	if err != nil {
+		// Destroy operations for instances that can not be resolved will fail,
+		// the events for the failure are recorded under the instance name.
+		return input.InstanceName
+	}
+
+	return instanceID
 }
 
 func (c *defaultBlueprintContainer) saveInstanceDestroyStateAndCleanup(
@@ -267,6 +320,35 @@ func (c *defaultBlueprintContainer) destroy(
 		core.StringLogField("instanceName", input.InstanceName),
 	)
 
+	destroyChanges := input.Changes
+	partialDestroy := len(input.Targets) > 0
+	if partialDestroy {
+		destroyLogger.Info(
+			"restricting destroy operation to targeted elements",
+			core.StringLogField("targets", strings.Join(input.Targets, ", ")),
+		)
+		targetedChanges, err := selectTargetedRemovals(
+			input.Changes,
+			input.Targets,
+			&currentInstanceState,
+		)
+		if err != nil {
+			rejectionMsg := c.createDeploymentFinishedMessage(
+				resolvedInstanceID,
+				determineInstanceDestroyFailedStatus(input.Rollback),
+				[]string{err.Error()},
+				c.clock.Since(startTime),
+				/* prepareElapsedTime */ nil,
+			)
+			// No elements have been touched when the targets are rejected,
+			// the status of the instance must be left as it is.
+			rejectionMsg.SkipPersist = true
+			channels.FinishChan <- rejectionMsg
+			return
+		}
+		destroyChanges = targetedChanges
+	}
+
 	destroyingStatus := determineInstanceDestroyingStatus(input.Rollback)
 
 	// An atomic operation to claim the destroy action for the current instance ID,
@@ -319,7 +401,7 @@ func (c *defaultBlueprintContainer) destroy(
 		ParamOverrides:         paramOverrides,
 		InstanceStateSnapshot:  &currentInstanceState,
 		ResourceProviders:      resourceProviderMap,
-		InputChanges:           input.Changes,
+		InputChanges:           destroyChanges,
 		ResourceTemplates:      map[string]string{},
 		ResourceRegistry:       c.resourceRegistry.WithParams(paramOverrides),
 		TaggingConfig:          input.TaggingConfig,
@@ -327,6 +409,37 @@ func (c *defaultBlueprintContainer) destroy(
 		DrainTimeout:           drainTimeout,
 		Logger:                 destroyLogger,
 	}
+
+	// The same container is used to destroy child blueprints, hooks are only
+	// run for the instance that destroy was called for by the user.
+	// Destroy hooks are not run for a partial destroy as the instance
+	// itself is not being destroyed.
+	hooks := c.hooks
+	if getIncludeTreePath(paramOverrides, "") != "" || partialDestroy {
+		hooks = nil
+	}
+
+	err = hooks.Run(ctx, &HookInfo{
+		Event:        schema.HookEventBeforeDestroy,
+		InstanceID:   resolvedInstanceID,
+		InstanceName: currentInstanceState.InstanceName,
+		Rollback:     input.Rollback,
+	})
+	if err != nil {
+		destroyLogger.Debug(
+			"before destroy hook failed, exiting destroy early",
+			core.ErrorLogField("error", err),
+		)
+		channels.FinishChan <- c.createDeploymentFinishedMessage(
+			resolvedInstanceID,
+			determineInstanceDestroyFailedStatus(input.Rollback),
+			[]string{hookFailedMessage(schema.HookEventBeforeDestroy, err)},
+			c.clock.Since(startTime),
+			/* prepareElapsedTime */ nil,
+		)
+		return
+	}
+
 	// removeElements returns errors only for preparation phase issues (collecting,
 	// ordering elements). Runtime errors during element removal are handled internally
 	// via the drain mechanism.
@@ -337,7 +450,7 @@ func (c *defaultBlueprintContainer) destroy(
 			// We must use the current state instance name as
 			// the instance name supplied in the input can be empty.
 			InstanceName: currentInstanceState.InstanceName,
-			Changes:      input.Changes,
+			Changes:      destroyChanges,
 			Rollback:     input.Rollback,
 			Force:        input.Force,
 		},
@@ -372,6 +485,23 @@ func (c *defaultBlueprintContainer) destroy(
 	// in which case we skip sending any further finish messages.
 	skipFinalMessage := input.Force && sentFinishedMessage
 
+	if partialDestroy {
+		// The instance is kept in place when only the targeted elements
+		// are destroyed, the targeted elements have already been removed
+		// from the instance state as they were destroyed.
+		if !skipFinalMessage {
+			channels.FinishChan <- c.createDeploymentFinishedMessage(
+				resolvedInstanceID,
+				core.InstanceStatusUpdated,
+				[]string{},
+				c.clock.Since(startTime),
+				/* prepareElapsedTime */
+				deployCtx.State.GetPrepareDuration(),
+			)
+		}
+		return
+	}
+
 	sentFinishedMessage = c.removeBlueprintInstanceFromState(
 		ctx,
 		&DestroyInput{
@@ -391,6 +521,19 @@ func (c *defaultBlueprintContainer) destroy(
 		return
 	}
 
+	err = hooks.Run(ctx, &HookInfo{
+		Event:        schema.HookEventAfterDestroy,
+		InstanceID:   resolvedInstanceID,
+		InstanceName: currentInstanceState.InstanceName,
+		Rollback:     input.Rollback,
+	})
+	if err != nil {
+		destroyLogger.Warn(
+			"after destroy hook failed",
+			core.ErrorLogField("error", err),
+		)
+	}
+
 	channels.FinishChan <- c.createDeploymentFinishedMessage(
 		resolvedInstanceID,
 		determineInstanceDestroyedStatus(input.Rollback),
@@ -930,6 +1073,28 @@ func (c *defaultBlueprintContainer) handleLinkDestroyEvent(
 	return nil
 }
 
+func (c *defaultBlueprintContainer) destroyResource(
+	ctx context.Context,
+	element state.Element,
+	instanceID string,
+	deployCtx *DeployContext,
+) {
+	resourceType := ""
+	resourceState := getResourceStateByName(deployCtx.InstanceStateSnapshot, element.LogicalName())
+	if resourceState != nil {
+		resourceType = resourceState.Type
+	}
+
+	release, err := c.concurrencyLimiter.Acquire(ctx, resourceType)
+	if err != nil {
+		deployCtx.Channels.ErrChan <- err
+		return
+	}
+	defer release()
+
+	c.resourceDestroyer.Destroy(ctx, element, instanceID, deployCtx)
+}
+
 func (c *defaultBlueprintContainer) removeGroupElements(
 	ctx context.Context,
 	instanceID string,
@@ -954,7 +1119,7 @@ func (c *defaultBlueprintContainer) removeGroupElements(
 			} else {
 				resourceLogger.Info("destroying resource")
 				trackRemoval(removalGoroutines, func() {
-					c.resourceDestroyer.Destroy(ctx, element, instanceID, elementDeployCtx)
+					c.destroyResource(ctx, element, instanceID, elementDeployCtx)
 				})
 			}
 		} else if element.Kind() == state.ChildElement {
@@ -1125,6 +1290,12 @@ func (c *defaultBlueprintContainer) buildResourceToRemoveInfo(
 		return nil, errResourceToBeRemovedHasDependents(resourceName, elements)
 	}
 
+	// Protection is checked again at deploy time as changes can be staged
+	// before the resource was marked as protected.
+	if !retained && isResourceProtected(toBeRemovedResourceState) {
+		return nil, errProtectedResourceRemoval([]string{resourceName})
+	}
+
 	return &ResourceIDInfo{
 		ResourceID:   toBeRemovedResourceState.ResourceID,
 		ResourceName: toBeRemovedResourceState.Name,
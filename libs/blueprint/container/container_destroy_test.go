@@ -674,6 +674,72 @@ func (s *ContainerDestroyTestSuite) Test_destroys_blueprint_instance_with_elemen
 	s.Assert().Error(err)
 }
 
+func (s *ContainerDestroyTestSuite) Test_destroys_targeted_resources_of_blueprint_instance() {
+	// Resource IDs are shared between the destroy fixtures, the state for
+	// blueprint instance 1 must be saved last so the resource records
+	// point to blueprint instance 1.
+	err := populateBlueprintCurrentState(s.stateContainer, "blueprint-instance-1", 1, "destroy")
+	s.Require().NoError(err)
+
+	channels := CreateDeployChannels()
+	s.blueprint1Fixture.blueprintContainer.Destroy(
+		context.Background(),
+		&DestroyInput{
+			InstanceID: "blueprint-instance-1",
+			Changes:    blueprint1RemovalChanges(),
+			// Targets all the resources expanded from the ordersTable template.
+			Targets: []string{"ordersTable"},
+		},
+		channels,
+		blueprintDestroyParams(),
+	)
+
+	finishedMessage, err := collectHookTestFinishedMessage(channels)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusUpdated, finishedMessage.Status)
+	s.Assert().Empty(finishedMessage.FailureReasons)
+
+	instance, err := s.stateContainer.Instances().Get(context.Background(), "blueprint-instance-1")
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusUpdated, instance.Status)
+	remainingResources := []string{}
+	for _, resource := range instance.Resources {
+		remainingResources = append(remainingResources, resource.Name)
+	}
+	slices.Sort(remainingResources)
+	s.Assert().Equal([]string{"invoicesTable", "saveOrderFunction"}, remainingResources)
+	s.Assert().Empty(instance.Links)
+	s.Assert().Contains(instance.ChildBlueprints, "coreInfra")
+}
+
+func (s *ContainerDestroyTestSuite) Test_fails_to_destroy_targets_that_do_not_exist_in_blueprint_instance() {
+	channels := CreateDeployChannels()
+	s.blueprint1Fixture.blueprintContainer.Destroy(
+		context.Background(),
+		&DestroyInput{
+			InstanceID: "blueprint-instance-1",
+			Changes:    blueprint1RemovalChanges(),
+			Targets:    []string{"saveOrderFunction", "children.missingChild"},
+		},
+		channels,
+		blueprintDestroyParams(),
+	)
+
+	finishedMessage, err := collectHookTestFinishedMessage(channels)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusDestroyFailed, finishedMessage.Status)
+	s.Assert().Equal(
+		[]string{errUnknownDestroyTargets([]string{"children.missingChild"}).Error()},
+		finishedMessage.FailureReasons,
+	)
+
+	// The instance and all of its elements are left untouched.
+	instance, err := s.stateContainer.Instances().Get(context.Background(), "blueprint-instance-1")
+	s.Require().NoError(err)
+	s.Assert().Len(instance.Resources, 4)
+	s.Assert().Len(instance.Links, 2)
+}
+
 func blueprint1RemovalChanges() *changes.BlueprintChanges {
 	return &changes.BlueprintChanges{
 		RemovedResources: []string{
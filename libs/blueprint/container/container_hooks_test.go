@@ -0,0 +1,226 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// hookRecorder records the hooks that are run for a container
+// in a way that is safe for concurrent use as resource hooks
+// are run from multiple goroutines.
+type hookRecorder struct {
+	mu    sync.Mutex
+	calls []*HookInfo
+}
+
+func (r *hookRecorder) record(ctx context.Context, info *HookInfo) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, info)
+	return nil
+}
+
+func (r *hookRecorder) recorded() []*HookInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.calls)
+}
+
+func (r *hookRecorder) register(hooks *DeploymentHooks) {
+	hooks.BeforeDeploy(r.record)
+	hooks.AfterDeploy(r.record)
+	hooks.BeforeResourceDeploy("", r.record)
+	hooks.AfterResourceDeploy("", r.record)
+	hooks.BeforeDestroy(r.record)
+	hooks.AfterDestroy(r.record)
+}
+
+func (s *ContainerDeployTestSuite) Test_runs_deployment_hooks_when_deploying_new_blueprint_instance() {
+	recorder := &hookRecorder{}
+	blueprintContainer := s.blueprint2Fixture.blueprintContainer
+	recorder.register(blueprintContainer.Hooks())
+
+	changes, changeStagingErr := s.stageChanges(
+		context.Background(),
+		/* instanceID */ "",
+		blueprintContainer,
+		s.fixture2Params,
+	)
+	s.Require().NoError(changeStagingErr)
+
+	channels := CreateDeployChannels()
+	err := blueprintContainer.Deploy(
+		context.Background(),
+		&DeployInput{
+			InstanceName: "BlueprintInstance2",
+			Changes:      changes,
+		},
+		channels,
+		s.fixture2Params,
+	)
+	s.Require().NoError(err)
+
+	finishedMessage, err := collectHookTestFinishedMessage(channels)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusDeployed, finishedMessage.Status)
+
+	calls := recorder.recorded()
+	s.Require().NotEmpty(calls)
+	s.Assert().Equal(schema.HookEventBeforeDeploy, calls[0].Event)
+	s.Assert().Equal("BlueprintInstance2", calls[0].InstanceName)
+	s.Assert().Equal(finishedMessage.InstanceID, calls[0].InstanceID)
+	s.Assert().Equal(schema.HookEventAfterDeploy, calls[len(calls)-1].Event)
+
+	beforeResources := hookResourceNames(calls, schema.HookEventBeforeResourceDeploy)
+	afterResources := hookResourceNames(calls, schema.HookEventAfterResourceDeploy)
+	s.Assert().NotEmpty(beforeResources)
+	s.Assert().Equal(beforeResources, afterResources)
+	s.Assert().Equal(len(changes.NewResources), len(afterResources))
+	for _, call := range calls {
+		if call.Event == schema.HookEventAfterResourceDeploy {
+			s.Assert().NotEmpty(call.ResourceID)
+		}
+	}
+}
+
+func (s *ContainerDeployTestSuite) Test_fails_deployment_when_before_deploy_hook_fails() {
+	blueprintContainer := s.blueprint2Fixture.blueprintContainer
+	blueprintContainer.Hooks().BeforeDeploy(
+		func(ctx context.Context, info *HookInfo) error {
+			return errors.New("cache warm up failed")
+		},
+	)
+	afterDeployCalled := false
+	blueprintContainer.Hooks().AfterDeploy(
+		func(ctx context.Context, info *HookInfo) error {
+			afterDeployCalled = true
+			return nil
+		},
+	)
+
+	changes, changeStagingErr := s.stageChanges(
+		context.Background(),
+		/* instanceID */ "",
+		blueprintContainer,
+		s.fixture2Params,
+	)
+	s.Require().NoError(changeStagingErr)
+
+	channels := CreateDeployChannels()
+	err := blueprintContainer.Deploy(
+		context.Background(),
+		&DeployInput{
+			InstanceName: "BlueprintInstance2",
+			Changes:      changes,
+		},
+		channels,
+		s.fixture2Params,
+	)
+	s.Require().NoError(err)
+
+	finishedMessage, err := collectHookTestFinishedMessage(channels)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusDeployFailed, finishedMessage.Status)
+	s.Assert().Equal(
+		[]string{"beforeDeploy hook failed: cache warm up failed"},
+		finishedMessage.FailureReasons,
+	)
+	s.Assert().False(afterDeployCalled)
+}
+
+func (s *ContainerDestroyTestSuite) Test_runs_destroy_hooks_when_destroying_blueprint_instance() {
+	recorder := &hookRecorder{}
+	recorder.register(s.blueprint1Fixture.blueprintContainer.Hooks())
+
+	channels := CreateDeployChannels()
+	s.blueprint1Fixture.blueprintContainer.Destroy(
+		context.Background(),
+		&DestroyInput{
+			InstanceID: "blueprint-instance-1",
+			Changes:    blueprint1RemovalChanges(),
+		},
+		channels,
+		blueprintDestroyParams(),
+	)
+
+	finishedMessage, err := collectHookTestFinishedMessage(channels)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusDestroyed, finishedMessage.Status)
+
+	calls := recorder.recorded()
+	s.Require().Len(calls, 2)
+	s.Assert().Equal(schema.HookEventBeforeDestroy, calls[0].Event)
+	s.Assert().Equal("blueprint-instance-1", calls[0].InstanceID)
+	s.Assert().Equal("BlueprintInstance1", calls[0].InstanceName)
+	s.Assert().Equal(schema.HookEventAfterDestroy, calls[1].Event)
+}
+
+func (s *ContainerDestroyTestSuite) Test_fails_destroy_when_before_destroy_hook_fails() {
+	s.blueprint1Fixture.blueprintContainer.Hooks().BeforeDestroy(
+		func(ctx context.Context, info *HookInfo) error {
+			return errors.New("traffic has not been drained")
+		},
+	)
+
+	channels := CreateDeployChannels()
+	s.blueprint1Fixture.blueprintContainer.Destroy(
+		context.Background(),
+		&DestroyInput{
+			InstanceID: "blueprint-instance-1",
+			Changes:    blueprint1RemovalChanges(),
+		},
+		channels,
+		blueprintDestroyParams(),
+	)
+
+	finishedMessage, err := collectHookTestFinishedMessage(channels)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusDestroyFailed, finishedMessage.Status)
+	s.Assert().Equal(
+		[]string{"beforeDestroy hook failed: traffic has not been drained"},
+		finishedMessage.FailureReasons,
+	)
+
+	// The instance must not have been removed as the destroy
+	// operation was aborted before any elements were removed.
+	instanceState, err := s.stateContainer.Instances().Get(
+		context.Background(),
+		"blueprint-instance-1",
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusDestroyFailed, instanceState.Status)
+}
+
+func collectHookTestFinishedMessage(channels *DeployChannels) (*DeploymentFinishedMessage, error) {
+	for {
+		select {
+		case <-channels.ResourceUpdateChan:
+		case <-channels.ChildUpdateChan:
+		case <-channels.LinkUpdateChan:
+		case <-channels.DeploymentUpdateChan:
+		case msg := <-channels.FinishChan:
+			return &msg, nil
+		case err := <-channels.ErrChan:
+			return nil, err
+		case <-time.After(defaultDrainTimeout):
+			return nil, errors.New(timeoutMessage)
+		}
+	}
+}
+
+func hookResourceNames(calls []*HookInfo, event schema.HookEvent) []string {
+	resourceNames := []string{}
+	for _, call := range calls {
+		if call.Event == event {
+			resourceNames = append(resourceNames, call.ResourceName)
+		}
+	}
+	slices.Sort(resourceNames)
+	return resourceNames
+}
@@ -0,0 +1,450 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (c *defaultBlueprintContainer) ListImportCandidates(
+	ctx context.Context,
+	input *ListImportCandidatesInput,
+	paramOverrides core.BlueprintParams,
+) (*ImportCandidatesResult, error) {
+	if input == nil {
+		return nil, fmt.Errorf("list import candidates input is required")
+	}
+
+	instanceState, err := c.findImportInstance(ctx, input.InstanceID, input.InstanceName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportCandidatesResult{
+		Resources: []*UnmatchedResource{},
+	}
+	if instanceState != nil {
+		result.InstanceID = instanceState.InstanceID
+	}
+
+	for _, resourceName := range c.unmatchedResourceNames(instanceState, input.ResourceNames) {
+		unmatched := c.listCandidatesForResource(
+			ctx,
+			resourceName,
+			input,
+			instanceState,
+			paramOverrides,
+		)
+		result.Resources = append(result.Resources, unmatched)
+	}
+
+	return result, nil
+}
+
+func (c *defaultBlueprintContainer) ImportResources(
+	ctx context.Context,
+	input *ImportResourcesInput,
+	paramOverrides core.BlueprintParams,
+) (*ImportResourcesResult, error) {
+	if input == nil {
+		return nil, fmt.Errorf("import resources input is required")
+	}
+
+	if input.InstanceID == "" && input.InstanceName == "" {
+		return nil, fmt.Errorf("instance ID or instance name is required to import resources")
+	}
+
+	instanceState, err := c.findImportInstance(ctx, input.InstanceID, input.InstanceName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportResourcesResult{
+		Imported: []*ImportedResource{},
+		Errors:   []*ImportError{},
+	}
+	if instanceState == nil {
+		if input.InstanceID != "" {
+			return nil, state.InstanceNotFoundError(input.InstanceID)
+		}
+
+		instanceState, err = c.createImportInstance(ctx, input.InstanceName)
+		if err != nil {
+			return nil, err
+		}
+		result.InstanceCreated = true
+	}
+	result.InstanceID = instanceState.InstanceID
+
+	for _, mapping := range input.Mappings {
+		resourceID, err := c.importResource(ctx, mapping, instanceState, paramOverrides)
+		if err != nil {
+			result.Errors = append(result.Errors, &ImportError{
+				ResourceName: mapping.ResourceName,
+				ImportID:     mapping.ImportID,
+				Error:        err.Error(),
+			})
+			continue
+		}
+
+		instanceState.ResourceIDs[mapping.ResourceName] = resourceID
+		result.Imported = append(result.Imported, &ImportedResource{
+			ResourceName: mapping.ResourceName,
+			ResourceID:   resourceID,
+			ImportID:     mapping.ImportID,
+		})
+	}
+
+	return result, nil
+}
+
+// Returns nil without an error when the instance does not exist yet.
+func (c *defaultBlueprintContainer) findImportInstance(
+	ctx context.Context,
+	instanceID string,
+	instanceName string,
+) (*state.InstanceState, error) {
+	instances := c.stateContainer.Instances()
+	if instanceID == "" && instanceName == "" {
+		return nil, nil
+	}
+
+	if instanceID == "" {
+		var err error
+		instanceID, err = instances.LookupIDByName(ctx, instanceName)
+		if err != nil {
+			if state.IsInstanceNotFound(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to look up instance: %w", err)
+		}
+	}
+
+	instanceState, err := instances.Get(ctx, instanceID)
+	if err != nil {
+		if state.IsInstanceNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get instance state: %w", err)
+	}
+
+	if instanceState.ResourceIDs == nil {
+		instanceState.ResourceIDs = map[string]string{}
+	}
+
+	return &instanceState, nil
+}
+
+func (c *defaultBlueprintContainer) createImportInstance(
+	ctx context.Context,
+	instanceName string,
+) (*state.InstanceState, error) {
+	instanceID, err := c.idGenerator.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+
+	instanceState := state.InstanceState{
+		InstanceID:                instanceID,
+		InstanceName:              instanceName,
+		Status:                    core.InstanceStatusNotDeployed,
+		LastStatusUpdateTimestamp: int(c.clock.Now().Unix()),
+		ResourceIDs:               map[string]string{},
+		Resources:                 map[string]*state.ResourceState{},
+		Links:                     map[string]*state.LinkState{},
+		ChildBlueprints:           map[string]*state.InstanceState{},
+	}
+	err = c.stateContainer.Instances().Save(ctx, instanceState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create instance to import resources into: %w", err)
+	}
+
+	return &instanceState, nil
+}
+
+func (c *defaultBlueprintContainer) unmatchedResourceNames(
+	instanceState *state.InstanceState,
+	resourceNames []string,
+) []string {
+	blueprintResources := c.blueprintResources()
+	unmatched := []string{}
+	for resourceName := range blueprintResources {
+		if len(resourceNames) > 0 && !slices.Contains(resourceNames, resourceName) {
+			continue
+		}
+
+		if instanceState != nil {
+			if _, tracked := instanceState.ResourceIDs[resourceName]; tracked {
+				continue
+			}
+		}
+
+		unmatched = append(unmatched, resourceName)
+	}
+
+	slices.Sort(unmatched)
+	return unmatched
+}
+
+func (c *defaultBlueprintContainer) listCandidatesForResource(
+	ctx context.Context,
+	resourceName string,
+	input *ListImportCandidatesInput,
+	instanceState *state.InstanceState,
+	paramOverrides core.BlueprintParams,
+) *UnmatchedResource {
+	resourceType := c.blueprintResourceType(resourceName)
+	unmatched := &UnmatchedResource{
+		ResourceName: resourceName,
+		ResourceType: resourceType,
+		Candidates:   []*provider.ExternalResource{},
+	}
+
+	resourceImpl, providerNamespace, err := c.importResourceImplementation(ctx, resourceType)
+	if err != nil {
+		unmatched.Error = err.Error()
+		return unmatched
+	}
+
+	lister, isLister := resourceImpl.(provider.ResourceLister)
+	if !isLister {
+		return unmatched
+	}
+	unmatched.ListingSupported = true
+
+	providerCtx := provider.NewProviderContextFromParams(providerNamespace, paramOverrides)
+	instanceName := input.InstanceName
+	if instanceState != nil {
+		instanceName = instanceState.InstanceName
+	}
+	output, err := lister.ListResources(ctx, &provider.ResourceListInput{
+		ResourceType:    resourceType,
+		InstanceName:    instanceName,
+		ResourceName:    resourceName,
+		MaxResults:      input.MaxCandidates,
+		ProviderContext: providerCtx,
+	})
+	if errors.Is(err, provider.ErrResourceListingNotSupported) {
+		unmatched.ListingSupported = false
+		return unmatched
+	}
+	if err != nil {
+		c.logger.Debug(
+			"failed to list import candidates for resource",
+			core.StringLogField("resourceName", resourceName),
+			core.StringLogField("resourceType", resourceType),
+			core.ErrorLogField("error", err),
+		)
+		unmatched.Error = err.Error()
+		return unmatched
+	}
+
+	if output == nil {
+		return unmatched
+	}
+
+	trackedIDs := c.trackedImportIDs(ctx, resourceImpl, resourceType, instanceState, providerCtx)
+	for _, candidate := range output.Resources {
+		if candidate == nil || trackedIDs[candidate.ImportID] {
+			continue
+		}
+		unmatched.Candidates = append(unmatched.Candidates, candidate)
+		if input.MaxCandidates > 0 && len(unmatched.Candidates) == input.MaxCandidates {
+			break
+		}
+	}
+
+	return unmatched
+}
+
+// Collects the import IDs of resources of the given type that are already
+// tracked in the instance state so they are not presented as candidates.
+func (c *defaultBlueprintContainer) trackedImportIDs(
+	ctx context.Context,
+	resourceImpl provider.Resource,
+	resourceType string,
+	instanceState *state.InstanceState,
+	providerCtx provider.Context,
+) map[string]bool {
+	trackedIDs := map[string]bool{}
+	if instanceState == nil {
+		return trackedIDs
+	}
+
+	idField, err := c.importIDField(ctx, resourceImpl, resourceType, providerCtx)
+	if err != nil {
+		return trackedIDs
+	}
+
+	for _, resource := range instanceState.Resources {
+		if resource.Type != resourceType || resource.SpecData == nil {
+			continue
+		}
+
+		idValue := resource.SpecData.Fields[idField]
+		if idValue != nil && idValue.Scalar != nil {
+			trackedIDs[idValue.Scalar.ToString()] = true
+		}
+	}
+
+	return trackedIDs
+}
+
+func (c *defaultBlueprintContainer) importResource(
+	ctx context.Context,
+	mapping *ImportResourceMapping,
+	instanceState *state.InstanceState,
+	paramOverrides core.BlueprintParams,
+) (string, error) {
+	if mapping.ImportID == "" {
+		return "", fmt.Errorf("an import ID is required")
+	}
+
+	resourceType := c.blueprintResourceType(mapping.ResourceName)
+	if resourceType == "" {
+		return "", fmt.Errorf("resource %q is not defined in the blueprint", mapping.ResourceName)
+	}
+
+	if _, tracked := instanceState.ResourceIDs[mapping.ResourceName]; tracked {
+		return "", fmt.Errorf(
+			"resource %q is already tracked in the state of the blueprint instance",
+			mapping.ResourceName,
+		)
+	}
+
+	resourceImpl, providerNamespace, err := c.importResourceImplementation(ctx, resourceType)
+	if err != nil {
+		return "", err
+	}
+
+	providerCtx := provider.NewProviderContextFromParams(providerNamespace, paramOverrides)
+	idField, err := c.importIDField(ctx, resourceImpl, resourceType, providerCtx)
+	if err != nil {
+		return "", err
+	}
+
+	resourceID, err := c.idGenerator.GenerateID()
+	if err != nil {
+		return "", err
+	}
+
+	output, err := resourceImpl.GetExternalState(ctx, &provider.ResourceGetExternalStateInput{
+		InstanceID:   instanceState.InstanceID,
+		InstanceName: instanceState.InstanceName,
+		ResourceID:   resourceID,
+		ResourceName: mapping.ResourceName,
+		CurrentResourceSpec: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				idField: core.MappingNodeFromString(mapping.ImportID),
+			},
+		},
+		ProviderContext: providerCtx,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve external state: %w", err)
+	}
+
+	if output == nil || output.ResourceSpecState == nil {
+		return "", fmt.Errorf(
+			"resource with the import ID %q was not found in the provider",
+			mapping.ImportID,
+		)
+	}
+
+	currentTime := int(c.clock.Now().Unix())
+	err = c.stateContainer.Resources().Save(ctx, state.ResourceState{
+		ResourceID:                 resourceID,
+		Name:                       mapping.ResourceName,
+		Type:                       resourceType,
+		InstanceID:                 instanceState.InstanceID,
+		Status:                     core.ResourceStatusCreated,
+		PreciseStatus:              core.PreciseResourceStatusCreated,
+		LastStatusUpdateTimestamp:  currentTime,
+		LastDeployedTimestamp:      currentTime,
+		LastDeployAttemptTimestamp: currentTime,
+		SpecData:                   output.ResourceSpecState,
+		FailureReasons:             []string{},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to save imported resource state: %w", err)
+	}
+
+	return resourceID, nil
+}
+
+func (c *defaultBlueprintContainer) importResourceImplementation(
+	ctx context.Context,
+	resourceType string,
+) (provider.Resource, string, error) {
+	providerNamespace := provider.ExtractProviderFromItemType(resourceType)
+	resourceProvider, hasProvider := c.providers[providerNamespace]
+	if !hasProvider {
+		return nil, "", fmt.Errorf(
+			"no provider found for resource type %q, only resources of provider resource types can be imported",
+			resourceType,
+		)
+	}
+
+	resourceImpl, err := resourceProvider.Resource(ctx, resourceType)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if resourceImpl == nil {
+		return nil, "", fmt.Errorf("resource type %q is not supported by the provider", resourceType)
+	}
+
+	return resourceImpl, providerNamespace, nil
+}
+
+func (c *defaultBlueprintContainer) importIDField(
+	ctx context.Context,
+	resourceImpl provider.Resource,
+	resourceType string,
+	providerCtx provider.Context,
+) (string, error) {
+	specDefOutput, err := resourceImpl.GetSpecDefinition(
+		ctx,
+		&provider.ResourceGetSpecDefinitionInput{
+			ProviderContext: providerCtx,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if specDefOutput == nil ||
+		specDefOutput.SpecDefinition == nil ||
+		specDefOutput.SpecDefinition.IDField == "" {
+		return "", fmt.Errorf(
+			"resource type %q does not define an ID field so can not be imported",
+			resourceType,
+		)
+	}
+
+	return specDefOutput.SpecDefinition.IDField, nil
+}
+
+func (c *defaultBlueprintContainer) blueprintResources() map[string]*schema.Resource {
+	blueprint := c.spec.Schema()
+	if blueprint == nil || blueprint.Resources == nil {
+		return map[string]*schema.Resource{}
+	}
+
+	return blueprint.Resources.Values
+}
+
+func (c *defaultBlueprintContainer) blueprintResourceType(resourceName string) string {
+	resource, hasResource := c.blueprintResources()[resourceName]
+	if !hasResource || resource == nil || resource.Type == nil {
+		return ""
+	}
+
+	return resource.Type.Value
+}
@@ -0,0 +1,330 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/memstate"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testImportInstanceID   = "import-instance-1"
+	testImportInstanceName = "import-instance"
+)
+
+type ContainerImportTestSuite struct {
+	suite.Suite
+	stateContainer state.Container
+	queueResource  *importableResource
+	container      *defaultBlueprintContainer
+}
+
+func (s *ContainerImportTestSuite) SetupTest() {
+	s.stateContainer = memstate.NewMemoryStateContainer()
+	s.queueResource = &importableResource{
+		externalResources: map[string]*core.MappingNode{
+			"queue-arn-1": importTestQueueSpec("queue-arn-1", "orders"),
+			"queue-arn-2": importTestQueueSpec("queue-arn-2", "payments"),
+		},
+		listed: []*provider.ExternalResource{
+			{ImportID: "queue-arn-1", Name: "orders"},
+			{ImportID: "queue-arn-2", Name: "payments"},
+		},
+	}
+
+	s.container = &defaultBlueprintContainer{
+		stateContainer: s.stateContainer,
+		providers: map[string]provider.Provider{
+			"test": &internal.ProviderMock{
+				NamespaceValue: "test",
+				Resources: map[string]provider.Resource{
+					"test/queue":    s.queueResource,
+					"test/function": &importableFunctionResource{},
+				},
+			},
+		},
+		spec: internal.NewBlueprintSpecMock(&schema.Blueprint{
+			Resources: &schema.ResourceMap{
+				Values: map[string]*schema.Resource{
+					"ordersQueue":    {Type: &schema.ResourceTypeWrapper{Value: "test/queue"}},
+					"paymentsQueue":  {Type: &schema.ResourceTypeWrapper{Value: "test/queue"}},
+					"handler":        {Type: &schema.ResourceTypeWrapper{Value: "test/function"}},
+					"abstractWidget": {Type: &schema.ResourceTypeWrapper{Value: "other/widget"}},
+				},
+			},
+		}),
+		clock:       core.SystemClock{},
+		idGenerator: core.NewUUIDGenerator(),
+		logger:      core.NewNopLogger(),
+	}
+}
+
+func (s *ContainerImportTestSuite) Test_lists_candidates_for_all_resources_when_instance_does_not_exist() {
+	result, err := s.container.ListImportCandidates(
+		context.Background(),
+		&ListImportCandidatesInput{
+			InstanceName: testImportInstanceName,
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Empty(result.InstanceID)
+	s.Require().Len(result.Resources, 4)
+
+	s.Equal("abstractWidget", result.Resources[0].ResourceName)
+	s.False(result.Resources[0].ListingSupported)
+	s.Contains(result.Resources[0].Error, "no provider found for resource type \"other/widget\"")
+
+	s.Equal("handler", result.Resources[1].ResourceName)
+	s.False(result.Resources[1].ListingSupported)
+	s.Empty(result.Resources[1].Error)
+	s.Empty(result.Resources[1].Candidates)
+
+	s.Equal("ordersQueue", result.Resources[2].ResourceName)
+	s.Equal("test/queue", result.Resources[2].ResourceType)
+	s.True(result.Resources[2].ListingSupported)
+	s.Equal(s.queueResource.listed, result.Resources[2].Candidates)
+	s.Equal(testImportInstanceName, s.queueResource.lastListInput.InstanceName)
+}
+
+func (s *ContainerImportTestSuite) Test_excludes_tracked_resources_and_their_import_ids() {
+	s.Require().NoError(s.populateImportTestState(&state.ResourceState{
+		ResourceID: "orders-queue-id",
+		Name:       "ordersQueue",
+		Type:       "test/queue",
+		InstanceID: testImportInstanceID,
+		SpecData:   importTestQueueSpec("queue-arn-1", "orders"),
+	}))
+
+	result, err := s.container.ListImportCandidates(
+		context.Background(),
+		&ListImportCandidatesInput{
+			InstanceID:    testImportInstanceID,
+			ResourceNames: []string{"ordersQueue", "paymentsQueue"},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Equal(testImportInstanceID, result.InstanceID)
+	s.Require().Len(result.Resources, 1)
+	s.Equal("paymentsQueue", result.Resources[0].ResourceName)
+	s.Equal(
+		[]*provider.ExternalResource{{ImportID: "queue-arn-2", Name: "payments"}},
+		result.Resources[0].Candidates,
+	)
+}
+
+func (s *ContainerImportTestSuite) Test_records_listing_errors_for_each_resource() {
+	s.queueResource.listErr = errors.New("access denied")
+
+	result, err := s.container.ListImportCandidates(
+		context.Background(),
+		&ListImportCandidatesInput{
+			ResourceNames: []string{"ordersQueue"},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Require().Len(result.Resources, 1)
+	s.True(result.Resources[0].ListingSupported)
+	s.Equal("access denied", result.Resources[0].Error)
+	s.Empty(result.Resources[0].Candidates)
+}
+
+func (s *ContainerImportTestSuite) Test_reports_listing_as_not_supported_when_lister_reports_no_support() {
+	s.queueResource.listErr = provider.ErrResourceListingNotSupported
+
+	result, err := s.container.ListImportCandidates(
+		context.Background(),
+		&ListImportCandidatesInput{
+			ResourceNames: []string{"ordersQueue"},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Require().Len(result.Resources, 1)
+	s.False(result.Resources[0].ListingSupported)
+	s.Empty(result.Resources[0].Error)
+	s.Empty(result.Resources[0].Candidates)
+}
+
+func (s *ContainerImportTestSuite) Test_imports_resources_into_new_instance() {
+	result, err := s.container.ImportResources(
+		context.Background(),
+		&ImportResourcesInput{
+			InstanceName: testImportInstanceName,
+			Mappings: []*ImportResourceMapping{
+				{ResourceName: "ordersQueue", ImportID: "queue-arn-1"},
+				{ResourceName: "paymentsQueue", ImportID: "queue-arn-missing"},
+				{ResourceName: "handler", ImportID: "function-arn"},
+				{ResourceName: "missing", ImportID: "queue-arn-2"},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.True(result.InstanceCreated)
+	s.Require().Len(result.Imported, 1)
+	s.Equal("ordersQueue", result.Imported[0].ResourceName)
+	s.Equal("queue-arn-1", result.Imported[0].ImportID)
+
+	s.Require().Len(result.Errors, 3)
+	s.Contains(result.Errors[0].Error, "was not found in the provider")
+	s.Contains(result.Errors[1].Error, "does not define an ID field")
+	s.Contains(result.Errors[2].Error, "is not defined in the blueprint")
+
+	instanceID, err := s.stateContainer.Instances().LookupIDByName(
+		context.Background(),
+		testImportInstanceName,
+	)
+	s.Require().NoError(err)
+	s.Equal(result.InstanceID, instanceID)
+
+	instanceState, err := s.stateContainer.Instances().Get(context.Background(), instanceID)
+	s.Require().NoError(err)
+	s.Equal(core.InstanceStatusNotDeployed, instanceState.Status)
+	s.Equal(result.Imported[0].ResourceID, instanceState.ResourceIDs["ordersQueue"])
+
+	resourceState, err := s.stateContainer.Resources().Get(
+		context.Background(),
+		result.Imported[0].ResourceID,
+	)
+	s.Require().NoError(err)
+	s.Equal("test/queue", resourceState.Type)
+	s.Equal(core.PreciseResourceStatusCreated, resourceState.PreciseStatus)
+	s.Equal(importTestQueueSpec("queue-arn-1", "orders"), resourceState.SpecData)
+}
+
+func (s *ContainerImportTestSuite) Test_fails_to_import_resource_already_tracked_in_instance() {
+	s.Require().NoError(s.populateImportTestState(&state.ResourceState{
+		ResourceID: "orders-queue-id",
+		Name:       "ordersQueue",
+		Type:       "test/queue",
+		InstanceID: testImportInstanceID,
+		SpecData:   importTestQueueSpec("queue-arn-1", "orders"),
+	}))
+
+	result, err := s.container.ImportResources(
+		context.Background(),
+		&ImportResourcesInput{
+			InstanceID: testImportInstanceID,
+			Mappings: []*ImportResourceMapping{
+				{ResourceName: "ordersQueue", ImportID: "queue-arn-2"},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.False(result.InstanceCreated)
+	s.Empty(result.Imported)
+	s.Require().Len(result.Errors, 1)
+	s.Contains(result.Errors[0].Error, "already tracked")
+}
+
+func (s *ContainerImportTestSuite) Test_fails_to_import_into_missing_instance_id() {
+	_, err := s.container.ImportResources(
+		context.Background(),
+		&ImportResourcesInput{
+			InstanceID: "missing-instance",
+		},
+		nil,
+	)
+	s.Require().Error(err)
+	s.True(state.IsInstanceNotFound(err))
+}
+
+func (s *ContainerImportTestSuite) populateImportTestState(resource *state.ResourceState) error {
+	err := s.stateContainer.Instances().Save(
+		context.Background(),
+		state.InstanceState{
+			InstanceID:   testImportInstanceID,
+			InstanceName: testImportInstanceName,
+			Status:       core.InstanceStatusDeployed,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.stateContainer.Resources().Save(context.Background(), *resource)
+}
+
+func importTestQueueSpec(arn string, name string) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"arn":  core.MappingNodeFromString(arn),
+			"name": core.MappingNodeFromString(name),
+		},
+	}
+}
+
+// importableResource is a resource implementation that supports
+// listing and fetching the state of existing resources for import.
+type importableResource struct {
+	provider.Resource
+	externalResources map[string]*core.MappingNode
+	listed            []*provider.ExternalResource
+	listErr           error
+	lastListInput     *provider.ResourceListInput
+}
+
+func (r *importableResource) GetSpecDefinition(
+	ctx context.Context,
+	input *provider.ResourceGetSpecDefinitionInput,
+) (*provider.ResourceGetSpecDefinitionOutput, error) {
+	return &provider.ResourceGetSpecDefinitionOutput{
+		SpecDefinition: &provider.ResourceSpecDefinition{
+			IDField: "arn",
+		},
+	}, nil
+}
+
+func (r *importableResource) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	arn := core.StringValue(input.CurrentResourceSpec.Fields["arn"])
+	return &provider.ResourceGetExternalStateOutput{
+		ResourceSpecState: r.externalResources[arn],
+	}, nil
+}
+
+func (r *importableResource) ListResources(
+	ctx context.Context,
+	input *provider.ResourceListInput,
+) (*provider.ResourceListOutput, error) {
+	r.lastListInput = input
+	if r.listErr != nil {
+		return nil, r.listErr
+	}
+
+	return &provider.ResourceListOutput{
+		Resources: r.listed,
+	}, nil
+}
+
+// importableFunctionResource is a resource implementation that does not
+// support listing resources and does not define an ID field.
+type importableFunctionResource struct {
+	provider.Resource
+}
+
+func (r *importableFunctionResource) GetSpecDefinition(
+	ctx context.Context,
+	input *provider.ResourceGetSpecDefinitionInput,
+) (*provider.ResourceGetSpecDefinitionOutput, error) {
+	return &provider.ResourceGetSpecDefinitionOutput{
+		SpecDefinition: &provider.ResourceSpecDefinition{},
+	}, nil
+}
+
+func TestContainerImportTestSuite(t *testing.T) {
+	suite.Run(t, new(ContainerImportTestSuite))
+}
@@ -0,0 +1,335 @@
+package container
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+// BlueprintOutputs holds the exports of a deployed blueprint instance
+// resolved against the current state of the instance,
+// along with the resolved exports of its child blueprints.
+type BlueprintOutputs struct {
+	InstanceID   string `json:"instanceId"`
+	InstanceName string `json:"instanceName"`
+	// Outputs holds the resolved exports of the blueprint instance,
+	// keyed by export name.
+	Outputs map[string]*Output `json:"outputs"`
+	// Children holds the resolved exports of child blueprints,
+	// keyed by the name of the child blueprint in the parent blueprint.
+	Children map[string]*BlueprintOutputs `json:"children,omitempty"`
+}
+
+// Output holds the value of a single blueprint export.
+type Output struct {
+	Name        string            `json:"name"`
+	Type        schema.ExportType `json:"type"`
+	Description string            `json:"description,omitempty"`
+	// Field holds the path of the element field that is exported
+	// (e.g. "resources.ordersTable.spec.id").
+	Field string            `json:"field"`
+	Value *core.MappingNode `json:"value"`
+	// ResolvedFromState is true when the value was resolved from the current
+	// state of the resource or child blueprint that the export field refers to.
+	// This is false when the value captured when the instance was last deployed
+	// is used, this is the case for exports of variables, values and data sources
+	// along with fields that are no longer present in the current state.
+	ResolvedFromState bool `json:"resolvedFromState"`
+}
+
+// TypedValue returns the value of the output as a Go value that matches
+// the export type.
+// Strings are returned as string, integers as int, floats as float64,
+// booleans as bool, arrays as []any and objects as map[string]any.
+// An error is returned if the value does not match the export type.
+func (o *Output) TypedValue() (any, error) {
+	if core.IsNilMappingNode(o.Value) {
+		return nil, nil
+	}
+
+	switch o.Type {
+	case schema.ExportTypeString:
+		if o.Value.Scalar == nil || o.Value.Scalar.StringValue == nil {
+			return nil, errOutputTypeMismatch(o)
+		}
+		return *o.Value.Scalar.StringValue, nil
+	case schema.ExportTypeInteger:
+		if o.Value.Scalar == nil || o.Value.Scalar.IntValue == nil {
+			return nil, errOutputTypeMismatch(o)
+		}
+		return *o.Value.Scalar.IntValue, nil
+	case schema.ExportTypeFloat:
+		if o.Value.Scalar != nil && o.Value.Scalar.IntValue != nil {
+			return float64(*o.Value.Scalar.IntValue), nil
+		}
+		if o.Value.Scalar == nil || o.Value.Scalar.FloatValue == nil {
+			return nil, errOutputTypeMismatch(o)
+		}
+		return *o.Value.Scalar.FloatValue, nil
+	case schema.ExportTypeBoolean:
+		if o.Value.Scalar == nil || o.Value.Scalar.BoolValue == nil {
+			return nil, errOutputTypeMismatch(o)
+		}
+		return *o.Value.Scalar.BoolValue, nil
+	case schema.ExportTypeArray:
+		if !core.IsArrayMappingNode(o.Value) {
+			return nil, errOutputTypeMismatch(o)
+		}
+	case schema.ExportTypeObject:
+		if !core.IsObjectMappingNode(o.Value) {
+			return nil, errOutputTypeMismatch(o)
+		}
+	}
+
+	return mappingNodeToGoValue(o.Value), nil
+}
+
+func errOutputTypeMismatch(output *Output) error {
+	return fmt.Errorf(
+		"the value of output %q does not match the expected %s export type",
+		output.Name,
+		output.Type,
+	)
+}
+
+func mappingNodeToGoValue(node *core.MappingNode) any {
+	if core.IsNilMappingNode(node) {
+		return nil
+	}
+
+	if node.Scalar != nil {
+		return scalarToGoValue(node.Scalar)
+	}
+
+	if node.Items != nil {
+		items := make([]any, len(node.Items))
+		for i, item := range node.Items {
+			items[i] = mappingNodeToGoValue(item)
+		}
+		return items
+	}
+
+	if node.Fields != nil {
+		fields := make(map[string]any, len(node.Fields))
+		for key, value := range node.Fields {
+			fields[key] = mappingNodeToGoValue(value)
+		}
+		return fields
+	}
+
+	return nil
+}
+
+func scalarToGoValue(scalar *core.ScalarValue) any {
+	switch {
+	case scalar.StringValue != nil:
+		return *scalar.StringValue
+	case scalar.IntValue != nil:
+		return *scalar.IntValue
+	case scalar.FloatValue != nil:
+		return *scalar.FloatValue
+	case scalar.BoolValue != nil:
+		return *scalar.BoolValue
+	default:
+		return nil
+	}
+}
+
+func (c *defaultBlueprintContainer) GetOutputs(
+	ctx context.Context,
+	instanceID string,
+) (*BlueprintOutputs, error) {
+	if instanceID == "" {
+		return nil, fmt.Errorf("instance ID is required to get outputs")
+	}
+
+	instanceState, err := c.stateContainer.Instances().Get(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveInstanceOutputs(&instanceState)
+}
+
+func resolveInstanceOutputs(instanceState *state.InstanceState) (*BlueprintOutputs, error) {
+	outputs := &BlueprintOutputs{
+		InstanceID:   instanceState.InstanceID,
+		InstanceName: instanceState.InstanceName,
+		Outputs:      map[string]*Output{},
+		Children:     map[string]*BlueprintOutputs{},
+	}
+
+	// Child blueprint outputs are resolved first as the exports
+	// of the parent blueprint can refer to the exports of a child blueprint.
+	for childName, childState := range instanceState.ChildBlueprints {
+		if childState == nil {
+			continue
+		}
+
+		childOutputs, err := resolveInstanceOutputs(childState)
+		if err != nil {
+			return nil, err
+		}
+		outputs.Children[childName] = childOutputs
+	}
+
+	for exportName, exportState := range instanceState.Exports {
+		if exportState == nil {
+			continue
+		}
+
+		value, resolvedFromState, err := resolveExportFromState(
+			exportState,
+			instanceState,
+			outputs.Children,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve output %q: %w", exportName, err)
+		}
+
+		outputs.Outputs[exportName] = &Output{
+			Name:              exportName,
+			Type:              exportState.Type,
+			Description:       exportState.Description,
+			Field:             exportState.Field,
+			Value:             value,
+			ResolvedFromState: resolvedFromState,
+		}
+	}
+
+	return outputs, nil
+}
+
+// resolveExportFromState resolves the value of an export from the current state
+// of the resource or child blueprint that the export field refers to,
+// falling back to the value captured when the instance was last deployed.
+func resolveExportFromState(
+	exportState *state.ExportState,
+	instanceState *state.InstanceState,
+	childOutputs map[string]*BlueprintOutputs,
+) (*core.MappingNode, bool, error) {
+	if exportState.Field == "" {
+		return exportState.Value, false, nil
+	}
+
+	fieldAsSub, err := substitutions.ParseSubstitution(
+		"exports",
+		exportState.Field,
+		/* parentSourceStart */ &source.Meta{Position: source.Position{}},
+		/* outputLineInfo */ false,
+		/* ignoreParentColumn */ true,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var value *core.MappingNode
+	resolvedFromState := false
+	if fieldAsSub.ResourceProperty != nil {
+		value = getResourcePropertyFromState(fieldAsSub.ResourceProperty, instanceState)
+		resolvedFromState = value != nil
+	} else if fieldAsSub.Child != nil {
+		value, resolvedFromState = getChildExportFromOutputs(fieldAsSub.Child, childOutputs)
+	}
+
+	if value == nil {
+		return exportState.Value, false, nil
+	}
+
+	return value, resolvedFromState, nil
+}
+
+func getResourcePropertyFromState(
+	prop *substitutions.SubstitutionResourceProperty,
+	instanceState *state.InstanceState,
+) *core.MappingNode {
+	resourceName := prop.ResourceName
+	if prop.ResourceEachTemplateIndex != nil {
+		resourceName = core.ExpandedResourceName(
+			prop.ResourceName,
+			int(*prop.ResourceEachTemplateIndex),
+		)
+	}
+
+	resourceState := findResourceByName(instanceState.Resources, resourceName)
+	if resourceState == nil {
+		return nil
+	}
+
+	if len(prop.Path) == 0 {
+		return resourceState.SpecData
+	}
+
+	switch prop.Path[0].FieldName {
+	case "spec":
+		return getValueAtSubstitutionPath(resourceState.SpecData, prop.Path[1:])
+	case "metadata":
+		return getValueAtSubstitutionPath(
+			resourceMetadataStateToMappingNode(resourceState.Metadata),
+			prop.Path[1:],
+		)
+	default:
+		return nil
+	}
+}
+
+func getChildExportFromOutputs(
+	child *substitutions.SubstitutionChild,
+	childOutputs map[string]*BlueprintOutputs,
+) (*core.MappingNode, bool) {
+	outputs, hasChild := childOutputs[child.ChildName]
+	if !hasChild || len(child.Path) == 0 {
+		return nil, false
+	}
+
+	output, hasOutput := outputs.Outputs[child.Path[0].FieldName]
+	if !hasOutput {
+		return nil, false
+	}
+
+	return getValueAtSubstitutionPath(output.Value, child.Path[1:]), output.ResolvedFromState
+}
+
+func resourceMetadataStateToMappingNode(metadata *state.ResourceMetadataState) *core.MappingNode {
+	if metadata == nil {
+		return nil
+	}
+
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"displayName": core.MappingNodeFromString(metadata.DisplayName),
+			"annotations": {Fields: metadata.Annotations},
+			"labels":      core.MappingNodeFromStringMap(metadata.Labels),
+			"custom":      metadata.Custom,
+		},
+	}
+}
+
+func getValueAtSubstitutionPath(
+	node *core.MappingNode,
+	path []*substitutions.SubstitutionPathItem,
+) *core.MappingNode {
+	current := node
+	for _, pathItem := range path {
+		if current == nil {
+			return nil
+		}
+
+		if pathItem.ArrayIndex != nil {
+			index := int(*pathItem.ArrayIndex)
+			if index < 0 || index >= len(current.Items) {
+				return nil
+			}
+			current = current.Items[index]
+		} else {
+			current = current.Fields[pathItem.FieldName]
+		}
+	}
+
+	return current
+}
@@ -0,0 +1,253 @@
+package container
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/memstate"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testOutputsInstanceID   = "test-outputs-instance"
+	testOutputsInstanceName = "TestOutputsInstance"
+)
+
+type ContainerOutputsTestSuite struct {
+	suite.Suite
+	stateContainer state.Container
+	container      *defaultBlueprintContainer
+}
+
+func (s *ContainerOutputsTestSuite) SetupTest() {
+	s.stateContainer = memstate.NewMemoryStateContainer()
+	s.container = &defaultBlueprintContainer{
+		stateContainer: s.stateContainer,
+		clock:          core.SystemClock{},
+		logger:         core.NewNopLogger(),
+	}
+
+	err := s.stateContainer.Instances().Save(
+		context.Background(),
+		createOutputsTestInstanceState(),
+	)
+	s.Require().NoError(err)
+}
+
+func (s *ContainerOutputsTestSuite) Test_get_outputs_resolves_resource_exports_from_current_state() {
+	outputs, err := s.container.GetOutputs(context.Background(), testOutputsInstanceID)
+	s.Require().NoError(err)
+	s.Equal(testOutputsInstanceID, outputs.InstanceID)
+	s.Equal(testOutputsInstanceName, outputs.InstanceName)
+
+	tableArn := outputs.Outputs["tableArn"]
+	s.Require().NotNil(tableArn)
+	s.True(tableArn.ResolvedFromState)
+	s.Equal(
+		core.MappingNodeFromString("arn:aws:dynamodb:us-east-1:123456789012:table/orders-v2"),
+		tableArn.Value,
+	)
+
+	firstRegion := outputs.Outputs["firstRegion"]
+	s.Require().NotNil(firstRegion)
+	s.True(firstRegion.ResolvedFromState)
+	s.Equal(core.MappingNodeFromString("us-east-1"), firstRegion.Value)
+
+	tableDisplayName := outputs.Outputs["tableDisplayName"]
+	s.Require().NotNil(tableDisplayName)
+	s.True(tableDisplayName.ResolvedFromState)
+	s.Equal(core.MappingNodeFromString("Orders Table"), tableDisplayName.Value)
+}
+
+func (s *ContainerOutputsTestSuite) Test_get_outputs_resolves_child_blueprint_exports() {
+	outputs, err := s.container.GetOutputs(context.Background(), testOutputsInstanceID)
+	s.Require().NoError(err)
+
+	childOutputs := outputs.Children["coreInfra"]
+	s.Require().NotNil(childOutputs)
+	s.Equal("child-instance", childOutputs.InstanceID)
+
+	queueURL := childOutputs.Outputs["queueUrl"]
+	s.Require().NotNil(queueURL)
+	s.True(queueURL.ResolvedFromState)
+	s.Equal(
+		core.MappingNodeFromString("https://sqs.us-east-1.amazonaws.com/123456789012/orders"),
+		queueURL.Value,
+	)
+
+	parentQueueURL := outputs.Outputs["queueUrl"]
+	s.Require().NotNil(parentQueueURL)
+	s.True(parentQueueURL.ResolvedFromState)
+	s.Equal(queueURL.Value, parentQueueURL.Value)
+}
+
+func (s *ContainerOutputsTestSuite) Test_get_outputs_falls_back_to_persisted_export_values() {
+	outputs, err := s.container.GetOutputs(context.Background(), testOutputsInstanceID)
+	s.Require().NoError(err)
+
+	environment := outputs.Outputs["environment"]
+	s.Require().NotNil(environment)
+	s.False(environment.ResolvedFromState)
+	s.Equal(core.MappingNodeFromString("production"), environment.Value)
+
+	removedField := outputs.Outputs["tableStreamArn"]
+	s.Require().NotNil(removedField)
+	s.False(removedField.ResolvedFromState)
+	s.Equal(core.MappingNodeFromString("arn:aws:dynamodb:stream/previous"), removedField.Value)
+}
+
+func (s *ContainerOutputsTestSuite) Test_get_outputs_returns_typed_values() {
+	outputs, err := s.container.GetOutputs(context.Background(), testOutputsInstanceID)
+	s.Require().NoError(err)
+
+	tableArn, err := outputs.Outputs["tableArn"].TypedValue()
+	s.Require().NoError(err)
+	s.Equal("arn:aws:dynamodb:us-east-1:123456789012:table/orders-v2", tableArn)
+
+	readCapacity, err := outputs.Outputs["readCapacity"].TypedValue()
+	s.Require().NoError(err)
+	s.Equal(10, readCapacity)
+
+	regions, err := outputs.Outputs["regions"].TypedValue()
+	s.Require().NoError(err)
+	s.Equal([]any{"us-east-1", "eu-west-2"}, regions)
+}
+
+func (s *ContainerOutputsTestSuite) Test_typed_value_fails_for_mismatched_export_type() {
+	output := &Output{
+		Name:  "readCapacity",
+		Type:  schema.ExportTypeInteger,
+		Value: core.MappingNodeFromString("ten"),
+	}
+
+	_, err := output.TypedValue()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "does not match the expected integer export type")
+}
+
+func (s *ContainerOutputsTestSuite) Test_get_outputs_fails_for_missing_instance() {
+	_, err := s.container.GetOutputs(context.Background(), "missing-instance")
+	s.Require().Error(err)
+	stateErr, isStateErr := err.(*state.Error)
+	s.Require().True(isStateErr)
+	s.Equal(state.ErrInstanceNotFound, stateErr.Code)
+}
+
+func createOutputsTestInstanceState() state.InstanceState {
+	readCapacity := 10
+	return state.InstanceState{
+		InstanceID:   testOutputsInstanceID,
+		InstanceName: testOutputsInstanceName,
+		Status:       core.InstanceStatusDeployed,
+		Resources: map[string]*state.ResourceState{
+			"resource-table": {
+				ResourceID: "resource-table",
+				Name:       "ordersTable",
+				Type:       "aws/dynamodb/table",
+				InstanceID: testOutputsInstanceID,
+				Status:     core.ResourceStatusCreated,
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn": core.MappingNodeFromString(
+							"arn:aws:dynamodb:us-east-1:123456789012:table/orders-v2",
+						),
+						"readCapacity": core.MappingNodeFromInt(readCapacity),
+						"regions": {
+							Items: []*core.MappingNode{
+								core.MappingNodeFromString("us-east-1"),
+								core.MappingNodeFromString("eu-west-2"),
+							},
+						},
+					},
+				},
+				Metadata: &state.ResourceMetadataState{
+					DisplayName: "Orders Table",
+				},
+			},
+		},
+		ResourceIDs: map[string]string{
+			"ordersTable": "resource-table",
+		},
+		Exports: map[string]*state.ExportState{
+			"tableArn": {
+				Type:  schema.ExportTypeString,
+				Field: "resources.ordersTable.spec.arn",
+				// Persisted value from the last deployment,
+				// the current state of the resource should take precedence.
+				Value: core.MappingNodeFromString(
+					"arn:aws:dynamodb:us-east-1:123456789012:table/orders",
+				),
+			},
+			"readCapacity": {
+				Type:  schema.ExportTypeInteger,
+				Field: "resources.ordersTable.spec.readCapacity",
+				Value: core.MappingNodeFromInt(5),
+			},
+			"regions": {
+				Type:  schema.ExportTypeArray,
+				Field: "resources.ordersTable.spec.regions",
+			},
+			"firstRegion": {
+				Type:  schema.ExportTypeString,
+				Field: "resources.ordersTable.spec.regions[0]",
+			},
+			"tableDisplayName": {
+				Type:  schema.ExportTypeString,
+				Field: "resources.ordersTable.metadata.displayName",
+			},
+			"tableStreamArn": {
+				Type:  schema.ExportTypeString,
+				Field: "resources.ordersTable.spec.streamArn",
+				Value: core.MappingNodeFromString("arn:aws:dynamodb:stream/previous"),
+			},
+			"environment": {
+				Type:  schema.ExportTypeString,
+				Field: "variables.environment",
+				Value: core.MappingNodeFromString("production"),
+			},
+			"queueUrl": {
+				Type:  schema.ExportTypeString,
+				Field: "children.coreInfra.queueUrl",
+			},
+		},
+		ChildBlueprints: map[string]*state.InstanceState{
+			"coreInfra": {
+				InstanceID:   "child-instance",
+				InstanceName: "coreInfra",
+				Status:       core.InstanceStatusDeployed,
+				Resources: map[string]*state.ResourceState{
+					"resource-queue": {
+						ResourceID: "resource-queue",
+						Name:       "ordersQueue",
+						Type:       "aws/sqs/queue",
+						InstanceID: "child-instance",
+						Status:     core.ResourceStatusCreated,
+						SpecData: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"url": core.MappingNodeFromString(
+									"https://sqs.us-east-1.amazonaws.com/123456789012/orders",
+								),
+							},
+						},
+					},
+				},
+				ResourceIDs: map[string]string{
+					"ordersQueue": "resource-queue",
+				},
+				Exports: map[string]*state.ExportState{
+					"queueUrl": {
+						Type:  schema.ExportTypeString,
+						Field: "resources.ordersQueue.spec.url",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestContainerOutputsTestSuite(t *testing.T) {
+	suite.Run(t, new(ContainerOutputsTestSuite))
+}
@@ -0,0 +1,143 @@
+package container
+
+import (
+	"context"
+	"errors"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/policy"
+)
+
+// staticPolicyEngine is a policy engine that records the input it was
+// called with and produces a fixed result.
+type staticPolicyEngine struct {
+	result *policy.EvaluationResult
+	err    error
+	input  *policy.EvaluationInput
+}
+
+func (e *staticPolicyEngine) Evaluate(
+	ctx context.Context,
+	input *policy.EvaluationInput,
+) (*policy.EvaluationResult, error) {
+	e.input = input
+	return e.result, e.err
+}
+
+func (s *ContainerDeployTestSuite) Test_rejects_deployment_that_violates_deny_policies() {
+	engine := &staticPolicyEngine{
+		result: &policy.EvaluationResult{
+			Violations: []*policy.Violation{
+				{
+					Level:        policy.ViolationLevelDeny,
+					Message:      "storage buckets must not be public",
+					Policy:       "no-public-buckets",
+					ResourceName: "assetsBucket",
+				},
+				{
+					Level:   policy.ViolationLevelWarn,
+					Message: "functions should have a memory limit",
+				},
+			},
+		},
+	}
+	blueprintContainer := s.blueprint2Fixture.blueprintContainer
+	blueprintContainer.(*defaultBlueprintContainer).policyEngine = engine
+
+	changes, changeStagingErr := s.stageChanges(
+		context.Background(),
+		/* instanceID */ "",
+		blueprintContainer,
+		s.fixture2Params,
+	)
+	s.Require().NoError(changeStagingErr)
+
+	err := blueprintContainer.Deploy(
+		context.Background(),
+		&DeployInput{
+			InstanceName: "BlueprintInstance2",
+			Changes:      changes,
+		},
+		CreateDeployChannels(),
+		s.fixture2Params,
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*bperrors.RunError)
+	s.Require().True(isRunErr)
+	s.Assert().Equal(ErrorReasonCodePolicyViolation, runErr.ReasonCode)
+	s.Assert().Equal(
+		[]error{errors.New("[no-public-buckets] resource \"assetsBucket\": storage buckets must not be public")},
+		runErr.ChildErrors,
+	)
+
+	s.Require().NotNil(engine.input)
+	s.Assert().Equal("BlueprintInstance2", engine.input.InstanceName)
+	s.Assert().Same(changes, engine.input.Changes)
+	s.Assert().NotNil(engine.input.Blueprint)
+}
+
+func (s *ContainerDeployTestSuite) Test_deploys_changes_with_policy_warnings() {
+	engine := &staticPolicyEngine{
+		result: &policy.EvaluationResult{
+			Violations: []*policy.Violation{
+				{
+					Level:   policy.ViolationLevelWarn,
+					Message: "functions should have a memory limit",
+				},
+			},
+		},
+	}
+	blueprintContainer := s.blueprint2Fixture.blueprintContainer
+	blueprintContainer.(*defaultBlueprintContainer).policyEngine = engine
+
+	changes, changeStagingErr := s.stageChanges(
+		context.Background(),
+		/* instanceID */ "",
+		blueprintContainer,
+		s.fixture2Params,
+	)
+	s.Require().NoError(changeStagingErr)
+
+	channels := CreateDeployChannels()
+	err := blueprintContainer.Deploy(
+		context.Background(),
+		&DeployInput{
+			InstanceName: "BlueprintInstance2",
+			Changes:      changes,
+		},
+		channels,
+		s.fixture2Params,
+	)
+	s.Require().NoError(err)
+
+	finishedMessage, err := collectHookTestFinishedMessage(channels)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusDeployed, finishedMessage.Status)
+}
+
+func (s *ContainerDeployTestSuite) Test_fails_deployment_when_policies_cannot_be_evaluated() {
+	blueprintContainer := s.blueprint2Fixture.blueprintContainer
+	blueprintContainer.(*defaultBlueprintContainer).policyEngine = &staticPolicyEngine{
+		err: errors.New("policy server unavailable"),
+	}
+
+	err := blueprintContainer.Deploy(
+		context.Background(),
+		&DeployInput{
+			InstanceName: "BlueprintInstance2",
+			Changes:      &changes.BlueprintChanges{},
+		},
+		CreateDeployChannels(),
+		s.fixture2Params,
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*bperrors.RunError)
+	s.Require().True(isRunErr)
+	s.Assert().Equal(ErrorReasonCodePolicyEvaluationFailed, runErr.ReasonCode)
+	s.Assert().Equal(
+		"run error: failed to evaluate policies for the deployment: policy server unavailable",
+		runErr.Error(),
+	)
+}
@@ -0,0 +1,180 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// ReapplyLinksInput contains the input for re-applying the link implementations
+// for the links of a blueprint instance.
+type ReapplyLinksInput struct {
+	// InstanceID is the ID of the blueprint instance to re-apply links for.
+	InstanceID string `json:"instanceId"`
+	// ChildPath targets the links of a child blueprint of the instance.
+	// Empty string for the links of the instance itself.
+	// Format: "childA" for first level, "childA.childB" for nested.
+	ChildPath string `json:"childPath,omitempty"`
+	// LinkNames is an optional list of logical link names
+	// (format: "{resourceA}::{resourceB}") to re-apply.
+	// When empty, all links in the targeted instance are re-applied.
+	LinkNames []string `json:"linkNames,omitempty"`
+}
+
+// ReapplyLinksResult contains the result of re-applying the link implementations
+// for the links of a blueprint instance.
+type ReapplyLinksResult struct {
+	// InstanceID is the ID of the blueprint instance that links were re-applied for.
+	InstanceID string `json:"instanceId"`
+	// Links contains the links that were successfully re-applied.
+	Links []ReappliedLink `json:"links"`
+	// Errors contains the links that failed to be re-applied.
+	Errors []ReconciliationError `json:"errors"`
+}
+
+// ReappliedLink holds information about a link that has been re-applied.
+type ReappliedLink struct {
+	// LinkID is the unique identifier for the link.
+	LinkID string `json:"linkId"`
+	// LinkName is the logical name of the link (format: "{resourceA}::{resourceB}").
+	LinkName string `json:"linkName"`
+	// ChildPath indicates the path to the child blueprint containing the link.
+	// Empty string for links in the parent blueprint.
+	ChildPath string `json:"childPath,omitempty"`
+}
+
+func (c *defaultBlueprintContainer) ReapplyLinks(
+	ctx context.Context,
+	input *ReapplyLinksInput,
+	paramOverrides core.BlueprintParams,
+) (*ReapplyLinksResult, error) {
+	if input == nil {
+		return nil, fmt.Errorf("reapply links input is required")
+	}
+
+	if input.InstanceID == "" {
+		return nil, fmt.Errorf("instance ID is required to reapply links")
+	}
+
+	instanceState, err := c.stateContainer.Instances().Get(ctx, input.InstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance state: %w", err)
+	}
+
+	if isInstanceInProgress(
+		&instanceState,
+		/* rollingBack */ false,
+	) {
+		return nil, fmt.Errorf(
+			"links can not be reapplied for instance %s while a deployment is in progress",
+			input.InstanceID,
+		)
+	}
+
+	targetInstance := getInstanceStateByChildPath(&instanceState, input.ChildPath)
+	if targetInstance == nil {
+		return nil, fmt.Errorf(
+			"child blueprint %q could not be found in instance %s",
+			input.ChildPath,
+			input.InstanceID,
+		)
+	}
+
+	linkStates, err := selectLinksToReapply(targetInstance, input.LinkNames)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReapplyLinksResult{
+		InstanceID: input.InstanceID,
+		Links:      []ReappliedLink{},
+		Errors:     []ReconciliationError{},
+	}
+
+	for _, linkState := range linkStates {
+		err := c.reapplyLink(ctx, *linkState, targetInstance, input.ChildPath, paramOverrides)
+		if err != nil {
+			result.Errors = append(result.Errors, ReconciliationError{
+				ElementID:   linkState.LinkID,
+				ElementName: linkState.Name,
+				ElementType: "link",
+				Error:       err.Error(),
+			})
+			continue
+		}
+
+		result.Links = append(result.Links, ReappliedLink{
+			LinkID:    linkState.LinkID,
+			LinkName:  linkState.Name,
+			ChildPath: input.ChildPath,
+		})
+	}
+
+	return result, nil
+}
+
+// reapplyLink re-applies the link implementation for a single link,
+// persisting the updated link data and intermediary resource states.
+// The linked resources are not re-deployed, only the link-managed
+// configuration of the resources is re-applied by the link implementation.
+func (c *defaultBlueprintContainer) reapplyLink(
+	ctx context.Context,
+	linkState state.LinkState,
+	instanceState *state.InstanceState,
+	childPath string,
+	paramOverrides core.BlueprintParams,
+) error {
+	err := c.applyLinkToPersistedResources(ctx, &linkState, instanceState, paramOverrides)
+	if err != nil {
+		return err
+	}
+
+	currentTime := int(c.clock.Now().Unix())
+	linkState.Status = core.LinkStatusUpdated
+	linkState.PreciseStatus = core.PreciseLinkStatusIntermediaryResourcesUpdated
+	linkState.LastStatusUpdateTimestamp = currentTime
+	linkState.LastDeployedTimestamp = currentTime
+	linkState.LastDeployAttemptTimestamp = currentTime
+	linkState.FailureReasons = nil
+	linkState.Drifted = false
+	linkState.LastDriftDetectedTimestamp = nil
+
+	// Re-applying the link brings link-managed configuration back in line
+	// with the link implementation, so any detected drift no longer applies.
+	c.removeLinkDriftAfterReapply(ctx, linkState.LinkID, childPath)
+
+	return c.stateContainer.Links().Save(ctx, linkState)
+}
+
+// selectLinksToReapply selects the links in the provided instance state to re-apply,
+// sorted by name so links are re-applied in a deterministic order.
+func selectLinksToReapply(
+	instanceState *state.InstanceState,
+	linkNames []string,
+) ([]*state.LinkState, error) {
+	for _, linkName := range linkNames {
+		if _, exists := instanceState.Links[linkName]; !exists {
+			return nil, fmt.Errorf(
+				"link %q could not be found in the instance state",
+				linkName,
+			)
+		}
+	}
+
+	selected := []*state.LinkState{}
+	for linkName, linkState := range instanceState.Links {
+		if len(linkNames) == 0 || slices.Contains(linkNames, linkName) {
+			selected = append(selected, linkState)
+		}
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Name < selected[j].Name
+	})
+
+	return selected, nil
+}
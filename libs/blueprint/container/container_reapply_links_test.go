@@ -0,0 +1,251 @@
+package container
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (s *ContainerReconciliationTestSuite) Test_reapply_links_reapplies_link_implementations_without_deploying_resources() {
+	driftTimestamp := 1234567890
+	err := s.populateTestState(
+		createReapplyLinksTestResources(),
+		map[string]*state.LinkState{
+			"ordersTable::ordersStream": {
+				LinkID:                     "link-1",
+				Name:                       "ordersTable::ordersStream",
+				InstanceID:                 testReconciliationInstanceID,
+				Status:                     core.LinkStatusCreated,
+				PreciseStatus:              core.PreciseLinkStatusIntermediaryResourcesUpdated,
+				Drifted:                    true,
+				LastDriftDetectedTimestamp: &driftTimestamp,
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	err = s.stateContainer.Links().SaveDrift(context.Background(), state.LinkDriftState{
+		LinkID:    "link-1",
+		LinkName:  "ordersTable::ordersStream",
+		Timestamp: &driftTimestamp,
+	})
+	s.Require().NoError(err)
+
+	link, tableResource := s.setupReapplyLinksTest()
+
+	result, err := s.container.ReapplyLinks(
+		context.Background(),
+		&ReapplyLinksInput{
+			InstanceID: testReconciliationInstanceID,
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Empty(result.Errors)
+	s.Equal(
+		[]ReappliedLink{
+			{
+				LinkID:   "link-1",
+				LinkName: "ordersTable::ordersStream",
+			},
+		},
+		result.Links,
+	)
+
+	s.Require().NotNil(link.intermediaryInput)
+	s.Equal("link-1", link.intermediaryInput.LinkID)
+	s.Equal(provider.LinkUpdateTypeUpdate, link.intermediaryInput.LinkUpdateType)
+	s.Equal("ordersTable", link.intermediaryInput.ResourceAInfo.ResourceName)
+	s.Equal("ordersStream", link.intermediaryInput.ResourceBInfo.ResourceName)
+	s.Empty(tableResource.deployInputs, "linked resources should not be re-deployed")
+
+	linkState, err := s.stateContainer.Links().Get(context.Background(), "link-1")
+	s.Require().NoError(err)
+	s.Equal(core.LinkStatusUpdated, linkState.Status)
+	s.Equal(core.PreciseLinkStatusIntermediaryResourcesUpdated, linkState.PreciseStatus)
+	s.False(linkState.Drifted)
+	s.Equal(link.intermediaryResourceStates, linkState.IntermediaryResourceStates)
+
+	driftState, err := s.stateContainer.Links().GetDrift(context.Background(), "link-1")
+	s.Require().NoError(err)
+	s.Empty(driftState.LinkID, "link drift state should be removed after reapplying")
+}
+
+func (s *ContainerReconciliationTestSuite) Test_reapply_links_reports_links_that_fail_to_be_reapplied() {
+	err := s.populateTestState(
+		createReapplyLinksTestResources(),
+		map[string]*state.LinkState{
+			"ordersTable::ordersStream": {
+				LinkID:     "link-1",
+				Name:       "ordersTable::ordersStream",
+				InstanceID: testReconciliationInstanceID,
+				Status:     core.LinkStatusCreated,
+			},
+			"ordersTable::missingStream": {
+				LinkID:     "link-2",
+				Name:       "ordersTable::missingStream",
+				InstanceID: testReconciliationInstanceID,
+				Status:     core.LinkStatusCreated,
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.setupReapplyLinksTest()
+
+	result, err := s.container.ReapplyLinks(
+		context.Background(),
+		&ReapplyLinksInput{
+			InstanceID: testReconciliationInstanceID,
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Require().Len(result.Links, 1)
+	s.Equal("ordersTable::ordersStream", result.Links[0].LinkName)
+	s.Require().Len(result.Errors, 1)
+	s.Equal("link-2", result.Errors[0].ElementID)
+	s.Equal("link", result.Errors[0].ElementType)
+	s.Contains(result.Errors[0].Error, "could not be found in the instance state")
+
+	linkState, err := s.stateContainer.Links().Get(context.Background(), "link-2")
+	s.Require().NoError(err)
+	s.Equal(core.LinkStatusCreated, linkState.Status)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_reapply_links_only_reapplies_selected_links() {
+	err := s.populateTestState(
+		createReapplyLinksTestResources(),
+		map[string]*state.LinkState{
+			"ordersTable::ordersStream": {
+				LinkID:     "link-1",
+				Name:       "ordersTable::ordersStream",
+				InstanceID: testReconciliationInstanceID,
+				Status:     core.LinkStatusCreated,
+			},
+			"ordersTable::missingStream": {
+				LinkID:     "link-2",
+				Name:       "ordersTable::missingStream",
+				InstanceID: testReconciliationInstanceID,
+				Status:     core.LinkStatusCreated,
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.setupReapplyLinksTest()
+
+	result, err := s.container.ReapplyLinks(
+		context.Background(),
+		&ReapplyLinksInput{
+			InstanceID: testReconciliationInstanceID,
+			LinkNames:  []string{"ordersTable::ordersStream"},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Empty(result.Errors)
+	s.Require().Len(result.Links, 1)
+	s.Equal("link-1", result.Links[0].LinkID)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_reapply_links_fails_for_unknown_link_name() {
+	err := s.populateTestState(
+		createReapplyLinksTestResources(),
+		map[string]*state.LinkState{},
+	)
+	s.Require().NoError(err)
+
+	_, err = s.container.ReapplyLinks(
+		context.Background(),
+		&ReapplyLinksInput{
+			InstanceID: testReconciliationInstanceID,
+			LinkNames:  []string{"ordersTable::ordersStream"},
+		},
+		nil,
+	)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "link \"ordersTable::ordersStream\" could not be found")
+}
+
+func (s *ContainerReconciliationTestSuite) Test_reapply_links_fails_for_instance_with_deployment_in_progress() {
+	err := s.stateContainer.Instances().Save(
+		context.Background(),
+		state.InstanceState{
+			InstanceID:   testReconciliationInstanceID,
+			InstanceName: testReconciliationInstanceName,
+			Status:       core.InstanceStatusUpdating,
+		},
+	)
+	s.Require().NoError(err)
+
+	_, err = s.container.ReapplyLinks(
+		context.Background(),
+		&ReapplyLinksInput{
+			InstanceID: testReconciliationInstanceID,
+		},
+		nil,
+	)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "while a deployment is in progress")
+}
+
+func (s *ContainerReconciliationTestSuite) setupReapplyLinksTest() (
+	*reapplyRecordingLink,
+	*reapplyRecordingResource,
+) {
+	link := &reapplyRecordingLink{
+		testDynamoDBTableStreamLink: &testDynamoDBTableStreamLink{},
+		intermediaryResourceStates: []*state.LinkIntermediaryResourceState{
+			{
+				ResourceID: "policy-1",
+				Status:     core.ResourceStatusCreated,
+				ResourceSpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"policyName": core.MappingNodeFromString("orders-stream-policy-v2"),
+					},
+				},
+			},
+		},
+	}
+	tableResource := &reapplyRecordingResource{
+		DynamoDBTableResource: &internal.DynamoDBTableResource{},
+	}
+	s.container.resourceRegistry = s.createReapplyResourceRegistry(tableResource)
+	s.container.linkRegistry = provider.NewLinkRegistry(
+		map[string]provider.Provider{
+			"aws": &internal.ProviderMock{
+				NamespaceValue: "aws",
+				Links: map[string]provider.Link{
+					"aws/dynamodb/table::aws/dynamodb/stream": link,
+				},
+			},
+		},
+	)
+
+	return link, tableResource
+}
+
+func createReapplyLinksTestResources() map[string]*state.ResourceState {
+	return map[string]*state.ResourceState{
+		"resource-table": {
+			ResourceID:    "resource-table",
+			Name:          "ordersTable",
+			Type:          "aws/dynamodb/table",
+			InstanceID:    testReconciliationInstanceID,
+			Status:        core.ResourceStatusCreated,
+			PreciseStatus: core.PreciseResourceStatusCreated,
+		},
+		"resource-stream": {
+			ResourceID:    "resource-stream",
+			Name:          "ordersStream",
+			Type:          "aws/dynamodb/stream",
+			InstanceID:    testReconciliationInstanceID,
+			Status:        core.ResourceStatusCreated,
+			PreciseStatus: core.PreciseResourceStatusCreated,
+		},
+	}
+}
@@ -33,6 +33,7 @@ func (c *defaultBlueprintContainer) CheckReconciliation(
 	if err != nil {
 		return nil, fmt.Errorf("failed to get instance state: %w", err)
 	}
+	input = expandReconciliationScopeWithDependents(input, &instanceState)
 
 	result := &ReconciliationCheckResult{
 		InstanceID: input.InstanceID,
@@ -52,6 +53,17 @@ func (c *defaultBlueprintContainer) CheckReconciliation(
 	}
 	result.Links = linkResults
 
+	if input.CheckDataSources {
+		dataSourceResults, err := c.checkDataSourceReconciliation(ctx, &instanceState, paramOverrides)
+		if err != nil {
+			return nil, err
+		}
+		result.DataSources = dataSourceResults
+		if len(dataSourceResults) > 0 {
+			result.HasDrift = true
+		}
+	}
+
 	for _, r := range result.Resources {
 		if r.Type == ReconciliationTypeInterrupted {
 			result.HasInterrupted = true
@@ -1015,7 +1027,7 @@ func (c *defaultBlueprintContainer) ApplyReconciliation(
 	}
 
 	for _, action := range input.ResourceActions {
-		err := c.applyResourceReconciliation(ctx, action)
+		err := c.applyResourceReconciliation(ctx, action, paramOverrides)
 		if err != nil {
 			elementName := c.getResourceName(ctx, action.ResourceID)
 			if action.ChildPath != "" {
@@ -1033,7 +1045,7 @@ func (c *defaultBlueprintContainer) ApplyReconciliation(
 	}
 
 	for _, action := range input.LinkActions {
-		err := c.applyLinkReconciliation(ctx, action)
+		err := c.applyLinkReconciliation(ctx, action, paramOverrides)
 		if err != nil {
 			elementName := c.getLinkName(ctx, action.LinkID)
 			if action.ChildPath != "" {
@@ -1056,6 +1068,7 @@ func (c *defaultBlueprintContainer) ApplyReconciliation(
 func (c *defaultBlueprintContainer) applyResourceReconciliation(
 	ctx context.Context,
 	action ResourceReconcileAction,
+	paramOverrides core.BlueprintParams,
 ) error {
 	resources := c.stateContainer.Resources()
 	currentTime := int(c.clock.Now().Unix())
@@ -1075,16 +1088,36 @@ func (c *defaultBlueprintContainer) applyResourceReconciliation(
 			return fmt.Errorf("failed to get current resource state: %w", err)
 		}
 
+		acceptedState := action.ExternalState
+		if len(action.FieldActions) > 0 {
+			acceptedState, err = mergeAcceptedFields(
+				currentState.SpecData,
+				action.ExternalState,
+				action.FieldActions,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
 		currentState.Status = reconcilePreciseToResourceStatus(action.NewStatus)
 		currentState.PreciseStatus = action.NewStatus
 		currentState.LastStatusUpdateTimestamp = currentTime
-		currentState.SpecData = action.ExternalState
+		currentState.SpecData = acceptedState
 
 		// Update any link.Data that references this resource via ResourceDataMappings
-		if err := c.updateAffectedLinkData(ctx, currentState, action.ExternalState); err != nil {
+		if err := c.updateAffectedLinkData(ctx, currentState, acceptedState); err != nil {
 			return fmt.Errorf("failed to update affected link data: %w", err)
 		}
 		currentState.FailureReasons = nil
+
+		if keepsPersistedFields(action.FieldActions) {
+			// The external values for fields that the persisted values were kept for
+			// still differ from the persisted state, so the resource remains drifted
+			// until the next deployment or drift check.
+			return resources.Save(ctx, currentState)
+		}
+
 		currentState.Drifted = false
 		currentState.LastDriftDetectedTimestamp = nil
 
@@ -1107,6 +1140,9 @@ func (c *defaultBlueprintContainer) applyResourceReconciliation(
 
 		return resources.Save(ctx, currentState)
 
+	case ReconciliationActionReapplyBlueprint:
+		return c.reapplyResourceFromPersistedState(ctx, action, paramOverrides)
+
 	case ReconciliationActionUpdateStatus:
 		return resources.UpdateStatus(ctx, action.ResourceID, state.ResourceStatusInfo{
 			Status:                    reconcilePreciseToResourceStatus(action.NewStatus),
@@ -1133,7 +1169,12 @@ func (c *defaultBlueprintContainer) applyResourceReconciliation(
 func (c *defaultBlueprintContainer) applyLinkReconciliation(
 	ctx context.Context,
 	action LinkReconcileAction,
+	paramOverrides core.BlueprintParams,
 ) error {
+	if action.Action == ReconciliationActionReapplyBlueprint {
+		return c.reapplyLinkFromPersistedState(ctx, action, paramOverrides)
+	}
+
 	links := c.stateContainer.Links()
 	currentTime := int(c.clock.Now().Unix())
 
@@ -1207,6 +1248,116 @@ func (c *defaultBlueprintContainer) applyLinkReconciliation(
 	return links.Save(ctx, linkState)
 }
 
+// mergeAcceptedFields produces the spec data to persist for a resource
+// when accepting external state for a subset of its fields.
+// Values for accepted fields are taken from the external state,
+// all other fields keep their persisted values.
+// Accepted fields that are not present in the external state are removed.
+func mergeAcceptedFields(
+	persisted *core.MappingNode,
+	external *core.MappingNode,
+	fieldActions []FieldReconcileAction,
+) (*core.MappingNode, error) {
+	merged := core.CopyMappingNode(persisted)
+	if merged == nil || merged.Fields == nil {
+		merged = &core.MappingNode{Fields: map[string]*core.MappingNode{}}
+	}
+
+	for _, fieldAction := range fieldActions {
+		switch fieldAction.Action {
+		case FieldReconciliationActionKeepPersisted:
+			continue
+		case FieldReconciliationActionAcceptExternal:
+			err := acceptExternalField(merged, external, fieldAction.FieldPath)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf(
+				"unknown field reconciliation action %q for field %s",
+				fieldAction.Action,
+				fieldAction.FieldPath,
+			)
+		}
+	}
+
+	return merged, nil
+}
+
+func acceptExternalField(
+	merged *core.MappingNode,
+	external *core.MappingNode,
+	fieldPath string,
+) error {
+	path := core.ReplaceSpecWithRoot(fieldPath)
+	externalValue, err := core.GetPathValue(path, external, core.MappingNodeMaxTraverseDepth)
+	if err != nil {
+		return fmt.Errorf("invalid field path %s: %w", fieldPath, err)
+	}
+
+	if externalValue == nil {
+		removeFieldAtPath(merged, path)
+		return nil
+	}
+
+	err = core.InjectPathValueReplaceFields(
+		path,
+		core.CopyMappingNode(externalValue),
+		merged,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to accept external value for field %s: %w", fieldPath, err)
+	}
+
+	return nil
+}
+
+// removeFieldAtPath removes the object field at the end of the given path
+// from the provided node.
+// Paths that end with an array accessor are not supported
+// and will leave the node unchanged.
+func removeFieldAtPath(node *core.MappingNode, path string) {
+	parentPath, fieldName, ok := splitLastPathField(path)
+	if !ok {
+		return
+	}
+
+	parent, _ := core.GetPathValue(parentPath, node, core.MappingNodeMaxTraverseDepth)
+	if parent != nil && parent.Fields != nil {
+		delete(parent.Fields, fieldName)
+	}
+}
+
+func splitLastPathField(path string) (string, string, bool) {
+	if strings.HasSuffix(path, "\"]") {
+		idx := strings.LastIndex(path, "[\"")
+		if idx < 0 {
+			return "", "", false
+		}
+		return path[:idx], path[idx+2 : len(path)-2], true
+	}
+
+	if strings.HasSuffix(path, "]") {
+		return "", "", false
+	}
+
+	idx := strings.LastIndex(path, ".")
+	if idx < 0 {
+		return "", "", false
+	}
+	return path[:idx], path[idx+1:], true
+}
+
+func keepsPersistedFields(fieldActions []FieldReconcileAction) bool {
+	for _, fieldAction := range fieldActions {
+		if fieldAction.Action == FieldReconciliationActionKeepPersisted {
+			return true
+		}
+	}
+	return false
+}
+
 func applyLinkDataUpdates(linkState *state.LinkState, updates map[string]*core.MappingNode) {
 	if linkState.Data == nil {
 		linkState.Data = make(map[string]*core.MappingNode)
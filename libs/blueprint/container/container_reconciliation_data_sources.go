@@ -0,0 +1,224 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+// capturedDataSourceField holds a data source field referenced by a blueprint
+// export along with the value captured in state when the export was last saved.
+type capturedDataSourceField struct {
+	exportName    string
+	fieldPath     string
+	property      *substitutions.SubstitutionDataSourceProperty
+	capturedValue *core.MappingNode
+}
+
+func (c *defaultBlueprintContainer) checkDataSourceReconciliation(
+	ctx context.Context,
+	instanceState *state.InstanceState,
+	params core.BlueprintParams,
+) ([]DataSourceReconcileResult, error) {
+	results := []DataSourceReconcileResult{}
+	blueprint := c.spec.Schema()
+	if blueprint.DataSources == nil || c.dataSourceRegistry == nil {
+		return results, nil
+	}
+
+	capturedFields, err := collectCapturedDataSourceFields(blueprint, instanceState)
+	if err != nil {
+		return nil, err
+	}
+
+	dataSourceNames := sortedKeys(capturedFields)
+	for _, dataSourceName := range dataSourceNames {
+		dataSource, hasDataSource := blueprint.DataSources.Values[dataSourceName]
+		if !hasDataSource {
+			continue
+		}
+
+		result, err := c.checkDataSourceDrift(
+			ctx,
+			dataSourceName,
+			dataSource,
+			capturedFields[dataSourceName],
+			params,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			results = append(results, *result)
+		}
+	}
+
+	return results, nil
+}
+
+func (c *defaultBlueprintContainer) checkDataSourceDrift(
+	ctx context.Context,
+	dataSourceName string,
+	dataSource *schema.DataSource,
+	capturedFields []*capturedDataSourceField,
+	params core.BlueprintParams,
+) (*DataSourceReconcileResult, error) {
+	// Filters are re-evaluated so that data sources with filters that depend on
+	// values that have changed since the last deployment are fetched with the same
+	// filters that would be used in a new deployment.
+	resolveResult, err := c.substitutionResolver.ResolveInDataSource(
+		ctx,
+		dataSourceName,
+		dataSource,
+		&subengine.ResolveDataSourceTargetInfo{
+			ResolveFor: subengine.ResolveForDeployment,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to resolve data source %q for drift check: %w",
+			dataSourceName,
+			err,
+		)
+	}
+
+	resolvedDataSource := resolveResult.ResolvedDataSource
+	dataSourceType := resolvedDataSource.Type.Value
+	fetchOutput, err := c.dataSourceRegistry.Fetch(
+		ctx,
+		dataSourceType,
+		&provider.DataSourceFetchInput{
+			DataSourceWithResolvedSubs: resolvedDataSource,
+			ProviderContext: provider.NewProviderContextFromParams(
+				provider.ExtractProviderFromItemType(dataSourceType),
+				params,
+			),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to fetch data source %q for drift check: %w",
+			dataSourceName,
+			err,
+		)
+	}
+
+	fieldChanges := []provider.FieldChange{}
+	affectedExports := []string{}
+	for _, field := range capturedFields {
+		currentValue, err := subengine.ExtractDataSourceProperty(
+			core.ExportElementID(field.exportName),
+			resolvedDataSource,
+			fetchOutput.Data,
+			field.property,
+		)
+		if err != nil {
+			// A field that is no longer present in the data source output
+			// or an out of bounds array index is reported as drift with no value
+			// as the export would fail to resolve in a new deployment.
+			c.logger.Debug(
+				"data source field could not be extracted during drift check",
+				core.StringLogField("dataSourceName", dataSourceName),
+				core.StringLogField("fieldPath", field.fieldPath),
+				core.ErrorLogField("error", err),
+			)
+			currentValue = nil
+		}
+
+		if core.MappingNodeEqual(field.capturedValue, currentValue) {
+			continue
+		}
+
+		if !slices.ContainsFunc(fieldChanges, func(change provider.FieldChange) bool {
+			return change.FieldPath == field.fieldPath
+		}) {
+			fieldChanges = append(fieldChanges, provider.FieldChange{
+				FieldPath: field.fieldPath,
+				PrevValue: field.capturedValue,
+				NewValue:  currentValue,
+			})
+		}
+		affectedExports = append(affectedExports, field.exportName)
+	}
+
+	if len(fieldChanges) == 0 {
+		return nil, nil
+	}
+
+	return &DataSourceReconcileResult{
+		DataSourceName:  dataSourceName,
+		DataSourceType:  dataSourceType,
+		Type:            ReconciliationTypeDrift,
+		Changes:         fieldChanges,
+		AffectedExports: affectedExports,
+	}, nil
+}
+
+// Collects the data source fields referenced by blueprint exports that have
+// values captured in the instance state, grouped by data source name.
+// Fields for each data source are ordered by export name to produce
+// deterministic drift results.
+func collectCapturedDataSourceFields(
+	blueprint *schema.Blueprint,
+	instanceState *state.InstanceState,
+) (map[string][]*capturedDataSourceField, error) {
+	capturedFields := map[string][]*capturedDataSourceField{}
+	if blueprint.Exports == nil || len(instanceState.Exports) == 0 {
+		return capturedFields, nil
+	}
+
+	exportNames := sortedKeys(blueprint.Exports.Values)
+	for _, exportName := range exportNames {
+		export := blueprint.Exports.Values[exportName]
+		exportState, hasExportState := instanceState.Exports[exportName]
+		if !hasExportState || export.Field == nil || export.Field.StringValue == nil {
+			continue
+		}
+
+		fieldAsSub, err := substitutions.ParseSubstitution(
+			"exports",
+			*export.Field.StringValue,
+			/* parentSourceStart */ &source.Meta{Position: source.Position{}},
+			/* outputLineInfo */ false,
+			/* ignoreParentColumn */ true,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if fieldAsSub.DataSourceProperty == nil {
+			continue
+		}
+
+		dataSourceName := fieldAsSub.DataSourceProperty.DataSourceName
+		capturedFields[dataSourceName] = append(
+			capturedFields[dataSourceName],
+			&capturedDataSourceField{
+				exportName:    exportName,
+				fieldPath:     *export.Field.StringValue,
+				property:      fieldAsSub.DataSourceProperty,
+				capturedValue: exportState.Value,
+			},
+		)
+	}
+
+	return capturedFields, nil
+}
+
+func sortedKeys[Value any](values map[string]Value) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
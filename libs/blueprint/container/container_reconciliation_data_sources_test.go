@@ -0,0 +1,248 @@
+package container
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/drift"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/memstate"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+	"github.com/stretchr/testify/suite"
+)
+
+type ContainerDataSourceReconciliationTestSuite struct {
+	suite.Suite
+	stateContainer     state.Container
+	dataSourceRegistry *stubDataSourceRegistry
+	container          *defaultBlueprintContainer
+}
+
+func (s *ContainerDataSourceReconciliationTestSuite) SetupTest() {
+	s.stateContainer = memstate.NewMemoryStateContainer()
+	s.dataSourceRegistry = &stubDataSourceRegistry{
+		data: map[string]*core.MappingNode{
+			"vpcId": core.MappingNodeFromString("vpc-12345678"),
+			"subnetIds": {
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("subnet-12345678"),
+					core.MappingNodeFromString("subnet-87654321"),
+				},
+			},
+		},
+	}
+
+	s.container = &defaultBlueprintContainer{
+		stateContainer: s.stateContainer,
+		driftChecker: &mockDriftChecker{
+			checkInterruptedResults: []drift.ReconcileResult{},
+			checkDriftResults:       map[string]*state.ResourceDriftState{},
+		},
+		spec:                 internal.NewBlueprintSpecMock(dataSourceReconciliationBlueprint()),
+		substitutionResolver: &stubDataSourceSubstitutionResolver{},
+		dataSourceRegistry:   s.dataSourceRegistry,
+		clock:                core.SystemClock{},
+		logger:               core.NewNopLogger(),
+	}
+}
+
+func (s *ContainerDataSourceReconciliationTestSuite) Test_check_reconciliation_reports_drifted_data_source_fields() {
+	s.populateExportState(map[string]*core.MappingNode{
+		"vpcId":         core.MappingNodeFromString("vpc-00000000"),
+		"primarySubnet": core.MappingNodeFromString("subnet-12345678"),
+		"networkId":     core.MappingNodeFromString("vpc-00000000"),
+	})
+
+	result, err := s.container.CheckReconciliation(
+		context.Background(),
+		&CheckReconciliationInput{
+			InstanceID:       testReconciliationInstanceID,
+			Scope:            ReconciliationScopeAll,
+			CheckDataSources: true,
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.True(result.HasDrift)
+	s.Require().Len(result.DataSources, 1)
+
+	dataSourceResult := result.DataSources[0]
+	s.Equal("network", dataSourceResult.DataSourceName)
+	s.Equal("aws/vpc", dataSourceResult.DataSourceType)
+	s.Equal(ReconciliationTypeDrift, dataSourceResult.Type)
+	s.Equal([]string{"networkId", "vpcId"}, dataSourceResult.AffectedExports)
+	s.Equal(
+		[]provider.FieldChange{
+			{
+				FieldPath: "datasources.network.vpcId",
+				PrevValue: core.MappingNodeFromString("vpc-00000000"),
+				NewValue:  core.MappingNodeFromString("vpc-12345678"),
+			},
+		},
+		dataSourceResult.Changes,
+	)
+}
+
+func (s *ContainerDataSourceReconciliationTestSuite) Test_check_reconciliation_reports_missing_data_source_field_as_drift() {
+	s.populateExportState(map[string]*core.MappingNode{
+		"vpcId":         core.MappingNodeFromString("vpc-12345678"),
+		"primarySubnet": core.MappingNodeFromString("subnet-12345678"),
+		"networkId":     core.MappingNodeFromString("vpc-12345678"),
+	})
+	s.dataSourceRegistry.data = map[string]*core.MappingNode{
+		"vpcId":     core.MappingNodeFromString("vpc-12345678"),
+		"subnetIds": {Items: []*core.MappingNode{}},
+	}
+
+	result, err := s.container.CheckReconciliation(
+		context.Background(),
+		&CheckReconciliationInput{
+			InstanceID:       testReconciliationInstanceID,
+			Scope:            ReconciliationScopeAll,
+			CheckDataSources: true,
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.True(result.HasDrift)
+	s.Require().Len(result.DataSources, 1)
+	s.Equal([]string{"primarySubnet"}, result.DataSources[0].AffectedExports)
+	s.Require().Len(result.DataSources[0].Changes, 1)
+	s.Equal("datasources.network.subnetIds[0]", result.DataSources[0].Changes[0].FieldPath)
+	s.Nil(result.DataSources[0].Changes[0].NewValue)
+}
+
+func (s *ContainerDataSourceReconciliationTestSuite) Test_check_reconciliation_reports_no_data_source_drift_for_matching_values() {
+	s.populateExportState(map[string]*core.MappingNode{
+		"vpcId":         core.MappingNodeFromString("vpc-12345678"),
+		"primarySubnet": core.MappingNodeFromString("subnet-12345678"),
+		"networkId":     core.MappingNodeFromString("vpc-12345678"),
+	})
+
+	result, err := s.container.CheckReconciliation(
+		context.Background(),
+		&CheckReconciliationInput{
+			InstanceID:       testReconciliationInstanceID,
+			Scope:            ReconciliationScopeAll,
+			CheckDataSources: true,
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.False(result.HasDrift)
+	s.Empty(result.DataSources)
+}
+
+func (s *ContainerDataSourceReconciliationTestSuite) Test_check_reconciliation_skips_data_sources_when_not_requested() {
+	s.populateExportState(map[string]*core.MappingNode{
+		"vpcId":         core.MappingNodeFromString("vpc-00000000"),
+		"primarySubnet": core.MappingNodeFromString("subnet-12345678"),
+		"networkId":     core.MappingNodeFromString("vpc-00000000"),
+	})
+
+	result, err := s.container.CheckReconciliation(
+		context.Background(),
+		&CheckReconciliationInput{
+			InstanceID: testReconciliationInstanceID,
+			Scope:      ReconciliationScopeAll,
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.False(result.HasDrift)
+	s.Empty(result.DataSources)
+	s.Equal(0, s.dataSourceRegistry.fetchCalls)
+}
+
+func (s *ContainerDataSourceReconciliationTestSuite) populateExportState(
+	exportValues map[string]*core.MappingNode,
+) {
+	exports := map[string]*state.ExportState{}
+	for name, value := range exportValues {
+		exports[name] = &state.ExportState{
+			Value: value,
+			Type:  schema.ExportTypeString,
+		}
+	}
+
+	err := s.stateContainer.Instances().Save(
+		context.Background(),
+		state.InstanceState{
+			InstanceID:   testReconciliationInstanceID,
+			InstanceName: testReconciliationInstanceName,
+			Status:       core.InstanceStatusUpdated,
+			Resources:    map[string]*state.ResourceState{},
+			Links:        map[string]*state.LinkState{},
+			Exports:      exports,
+		},
+	)
+	s.Require().NoError(err)
+}
+
+func dataSourceReconciliationBlueprint() *schema.Blueprint {
+	return &schema.Blueprint{
+		DataSources: &schema.DataSourceMap{
+			Values: map[string]*schema.DataSource{
+				"network": {
+					Type: &schema.DataSourceTypeWrapper{Value: "aws/vpc"},
+				},
+			},
+		},
+		Exports: &schema.ExportMap{
+			Values: map[string]*schema.Export{
+				"vpcId":         dataSourceReconciliationExport("datasources.network.vpcId"),
+				"networkId":     dataSourceReconciliationExport("datasources.network.vpcId"),
+				"primarySubnet": dataSourceReconciliationExport("datasources.network.subnetIds[0]"),
+			},
+		},
+	}
+}
+
+func dataSourceReconciliationExport(field string) *schema.Export {
+	return &schema.Export{
+		Type:  &schema.ExportTypeWrapper{Value: schema.ExportTypeString},
+		Field: core.ScalarFromString(field),
+	}
+}
+
+type stubDataSourceSubstitutionResolver struct {
+	subengine.SubstitutionResolver
+}
+
+func (r *stubDataSourceSubstitutionResolver) ResolveInDataSource(
+	ctx context.Context,
+	dataSourceName string,
+	dataSource *schema.DataSource,
+	resolveTargetInfo *subengine.ResolveDataSourceTargetInfo,
+) (*subengine.ResolveInDataSourceResult, error) {
+	return &subengine.ResolveInDataSourceResult{
+		ResolvedDataSource: &provider.ResolvedDataSource{
+			Type: dataSource.Type,
+		},
+	}, nil
+}
+
+type stubDataSourceRegistry struct {
+	provider.DataSourceRegistry
+	data       map[string]*core.MappingNode
+	fetchCalls int
+}
+
+func (r *stubDataSourceRegistry) Fetch(
+	ctx context.Context,
+	dataSourceType string,
+	input *provider.DataSourceFetchInput,
+) (*provider.DataSourceFetchOutput, error) {
+	r.fetchCalls += 1
+	return &provider.DataSourceFetchOutput{
+		Data: r.data,
+	}, nil
+}
+
+func TestContainerDataSourceReconciliationTestSuite(t *testing.T) {
+	suite.Run(t, new(ContainerDataSourceReconciliationTestSuite))
+}
@@ -0,0 +1,133 @@
+package container
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// expandReconciliationScopeWithDependents produces a copy of the check reconciliation
+// input for the specific scope where the named resources are expanded to include
+// the elements that depend on them.
+//
+// The expanded scope contains:
+//   - Resources that depend on the named resources, directly or transitively,
+//     through references or explicit dependencies in the reference graph.
+//   - Links where one of the named resources or their dependents is one end of the link
+//     along with the resource at the other end of the link.
+//   - Resources that have their data updated by those links through
+//     resource data mappings (e.g. intermediary resources and mapped fields).
+//
+// Dependents are resolved within the instance that contains the named resources,
+// this is the instance at the configured child path or the root instance
+// when a child path is not provided.
+func expandReconciliationScopeWithDependents(
+	input *CheckReconciliationInput,
+	instanceState *state.InstanceState,
+) *CheckReconciliationInput {
+	if input.Scope != ReconciliationScopeSpecific ||
+		!input.IncludeDependents ||
+		len(input.ResourceNames) == 0 {
+		return input
+	}
+
+	targetInstance := getInstanceStateByChildPath(instanceState, input.ChildPath)
+	if targetInstance == nil {
+		return input
+	}
+
+	dependentResources := collectDependentResources(input.ResourceNames, targetInstance)
+	// Linked resources are not expanded any further so that a chain of links
+	// does not pull in every resource in the blueprint instance.
+	resourceNames := slices.Clone(dependentResources)
+	linkNames := slices.Clone(input.LinkNames)
+	for _, linkName := range sortedLinkNames(targetInstance) {
+		link := targetInstance.Links[linkName]
+		resourceAName, resourceBName, _ := strings.Cut(linkName, "::")
+		if !slices.Contains(dependentResources, resourceAName) &&
+			!slices.Contains(dependentResources, resourceBName) {
+			continue
+		}
+
+		linkNames = appendIfMissing(linkNames, linkName)
+		resourceNames = appendIfMissing(resourceNames, resourceAName)
+		resourceNames = appendIfMissing(resourceNames, resourceBName)
+		for _, mappedResourceName := range linkMappedResourceNames(link) {
+			resourceNames = appendIfMissing(resourceNames, mappedResourceName)
+		}
+	}
+
+	expanded := *input
+	expanded.ResourceNames = resourceNames
+	expanded.LinkNames = linkNames
+	return &expanded
+}
+
+// collectDependentResources walks the reference graph captured in the
+// instance state to collect the named resources along with all the resources
+// that depend on them, directly or transitively.
+func collectDependentResources(
+	resourceNames []string,
+	instanceState *state.InstanceState,
+) []string {
+	collected := slices.Clone(resourceNames)
+	dependents := map[string][]string{}
+	for _, resource := range instanceState.Resources {
+		for _, dependency := range resource.DependsOnResources {
+			dependents[dependency] = append(dependents[dependency], resource.Name)
+		}
+	}
+
+	queue := slices.Clone(resourceNames)
+	for len(queue) > 0 {
+		resourceName := queue[0]
+		queue = queue[1:]
+
+		resourceDependents := dependents[resourceName]
+		slices.Sort(resourceDependents)
+		for _, dependent := range resourceDependents {
+			if slices.Contains(collected, dependent) {
+				continue
+			}
+			collected = append(collected, dependent)
+			queue = append(queue, dependent)
+		}
+	}
+
+	return collected
+}
+
+// Resource data mappings are keyed by "{resourceName}::{fieldPath}".
+func linkMappedResourceNames(link *state.LinkState) []string {
+	if link == nil {
+		return []string{}
+	}
+
+	resourceNames := []string{}
+	for mappingKey := range link.ResourceDataMappings {
+		resourceName, _, hasSeparator := strings.Cut(mappingKey, "::")
+		if hasSeparator {
+			resourceNames = appendIfMissing(resourceNames, resourceName)
+		}
+	}
+	slices.Sort(resourceNames)
+
+	return resourceNames
+}
+
+func sortedLinkNames(instanceState *state.InstanceState) []string {
+	linkNames := make([]string, 0, len(instanceState.Links))
+	for linkName := range instanceState.Links {
+		linkNames = append(linkNames, linkName)
+	}
+	slices.Sort(linkNames)
+	return linkNames
+}
+
+func appendIfMissing(values []string, value string) []string {
+	if value == "" || slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
@@ -0,0 +1,123 @@
+package container
+
+import (
+	"context"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/drift"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (s *ContainerReconciliationTestSuite) Test_check_reconciliation_for_specific_resources_includes_dependents() {
+	s.populateDependentsTestState()
+
+	result, err := s.container.CheckReconciliation(
+		context.Background(),
+		&CheckReconciliationInput{
+			InstanceID:        testReconciliationInstanceID,
+			Scope:             ReconciliationScopeSpecific,
+			ResourceNames:     []string{"ordersTable"},
+			IncludeDependents: true,
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+
+	s.Equal(
+		[]string{"ordersApi", "ordersHandler", "ordersQueue", "ordersRole", "ordersTable"},
+		reconciledResourceNames(result),
+	)
+	s.Require().Len(result.Links, 1)
+	s.Equal("ordersHandler::ordersQueue", result.Links[0].LinkName)
+	s.True(result.HasInterrupted)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_check_reconciliation_for_specific_resources_excludes_dependents_by_default() {
+	s.populateDependentsTestState()
+
+	result, err := s.container.CheckReconciliation(
+		context.Background(),
+		&CheckReconciliationInput{
+			InstanceID:    testReconciliationInstanceID,
+			Scope:         ReconciliationScopeSpecific,
+			ResourceNames: []string{"ordersTable"},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+
+	s.Equal([]string{"ordersTable"}, reconciledResourceNames(result))
+	s.Empty(result.Links)
+}
+
+func (s *ContainerReconciliationTestSuite) populateDependentsTestState() {
+	resourceNames := []string{
+		"ordersTable",
+		"ordersHandler",
+		"ordersApi",
+		"ordersQueue",
+		"ordersRole",
+		"unrelatedBucket",
+	}
+	dependencies := map[string][]string{
+		"ordersHandler": {"ordersTable"},
+		"ordersApi":     {"ordersHandler"},
+	}
+
+	resources := map[string]*state.ResourceState{}
+	for _, resourceName := range resourceNames {
+		resourceID := resourceName + "-id"
+		resources[resourceID] = &state.ResourceState{
+			ResourceID:         resourceID,
+			Name:               resourceName,
+			Type:               "test/resource",
+			InstanceID:         testReconciliationInstanceID,
+			Status:             core.ResourceStatusCreating,
+			PreciseStatus:      core.PreciseResourceStatusCreateInterrupted,
+			DependsOnResources: dependencies[resourceName],
+		}
+		s.driftChecker.checkInterruptedResults = append(
+			s.driftChecker.checkInterruptedResults,
+			drift.ReconcileResult{
+				ResourceID:   resourceID,
+				ResourceName: resourceName,
+				ResourceType: "test/resource",
+				OldStatus:    core.PreciseResourceStatusCreateInterrupted,
+				NewStatus:    core.PreciseResourceStatusCreated,
+			},
+		)
+	}
+
+	links := map[string]*state.LinkState{
+		"ordersHandler::ordersQueue": {
+			LinkID:        "orders-handler-queue-link",
+			Name:          "ordersHandler::ordersQueue",
+			InstanceID:    testReconciliationInstanceID,
+			Status:        core.LinkStatusCreating,
+			PreciseStatus: core.PreciseLinkStatusResourceAUpdateInterrupted,
+			ResourceDataMappings: map[string]string{
+				"ordersRole::spec.policies": "ordersHandler.rolePolicies",
+			},
+		},
+		"unrelatedBucket::ordersRole": {
+			LinkID:        "unrelated-bucket-role-link",
+			Name:          "unrelatedBucket::ordersRole",
+			InstanceID:    testReconciliationInstanceID,
+			Status:        core.LinkStatusCreating,
+			PreciseStatus: core.PreciseLinkStatusResourceAUpdateInterrupted,
+		},
+	}
+
+	err := s.populateTestState(resources, links)
+	s.Require().NoError(err)
+}
+
+func reconciledResourceNames(result *ReconciliationCheckResult) []string {
+	resourceNames := []string{}
+	for _, resource := range result.Resources {
+		resourceNames = append(resourceNames, resource.ResourceName)
+	}
+	slices.Sort(resourceNames)
+	return resourceNames
+}
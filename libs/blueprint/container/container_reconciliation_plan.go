@@ -0,0 +1,355 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (c *defaultBlueprintContainer) PlanReconciliation(
+	ctx context.Context,
+	input *ApplyReconciliationInput,
+	paramOverrides core.BlueprintParams,
+) (*ReconciliationPlan, error) {
+	if input == nil {
+		return nil, fmt.Errorf("apply reconciliation input is required")
+	}
+
+	if input.InstanceID == "" {
+		return nil, fmt.Errorf("instance ID is required for reconciliation")
+	}
+
+	plan := &ReconciliationPlan{
+		InstanceID: input.InstanceID,
+		Resources:  []ResourceReconcilePlan{},
+		Links:      []LinkReconcilePlan{},
+		Errors:     []ReconciliationError{},
+	}
+
+	for _, action := range input.ResourceActions {
+		resourcePlan, err := c.planResourceReconciliation(ctx, action)
+		if err != nil {
+			elementName := c.getResourceName(ctx, action.ResourceID)
+			if action.ChildPath != "" {
+				elementName = fmt.Sprintf("%s.%s", action.ChildPath, elementName)
+			}
+			plan.Errors = append(plan.Errors, ReconciliationError{
+				ElementID:   action.ResourceID,
+				ElementName: elementName,
+				ElementType: "resource",
+				Error:       err.Error(),
+			})
+		} else {
+			plan.Resources = append(plan.Resources, *resourcePlan)
+		}
+	}
+
+	for _, action := range input.LinkActions {
+		linkPlan, err := c.planLinkReconciliation(ctx, action)
+		if err != nil {
+			elementName := c.getLinkName(ctx, action.LinkID)
+			if action.ChildPath != "" {
+				elementName = fmt.Sprintf("%s.%s", action.ChildPath, elementName)
+			}
+			plan.Errors = append(plan.Errors, ReconciliationError{
+				ElementID:   action.LinkID,
+				ElementName: elementName,
+				ElementType: "link",
+				Error:       err.Error(),
+			})
+		} else {
+			plan.Links = append(plan.Links, *linkPlan)
+		}
+	}
+
+	return plan, nil
+}
+
+// planResourceReconciliation mirrors applyResourceReconciliation
+// without writing to state or calling providers.
+func (c *defaultBlueprintContainer) planResourceReconciliation(
+	ctx context.Context,
+	action ResourceReconcileAction,
+) (*ResourceReconcilePlan, error) {
+	resources := c.stateContainer.Resources()
+	currentState, err := resources.Get(ctx, action.ResourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current resource state: %w", err)
+	}
+
+	resourcePlan := &ResourceReconcilePlan{
+		ResourceID:   action.ResourceID,
+		ResourceName: currentState.Name,
+		ChildPath:    action.ChildPath,
+		Action:       action.Action,
+		PrevStatus:   currentState.PreciseStatus,
+		NewStatus:    action.NewStatus,
+	}
+
+	switch action.Action {
+	case ReconciliationActionAcceptExternal:
+		if action.ExternalState == nil {
+			return nil, fmt.Errorf(
+				"external state is required for action %s on resource %s",
+				ReconciliationActionAcceptExternal,
+				action.ResourceID,
+			)
+		}
+
+		acceptedState := action.ExternalState
+		if len(action.FieldActions) > 0 {
+			acceptedState, err = mergeAcceptedFields(
+				currentState.SpecData,
+				action.ExternalState,
+				action.FieldActions,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resourcePlan.SpecChange = &provider.FieldChange{
+			FieldPath: "spec",
+			PrevValue: currentState.SpecData,
+			NewValue:  acceptedState,
+		}
+		resourcePlan.ClearsDrift = !keepsPersistedFields(action.FieldActions)
+		resourcePlan.LinkDataUpdates, err = c.planAffectedLinkData(ctx, currentState, acceptedState)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan affected link data updates: %w", err)
+		}
+
+	case ReconciliationActionReapplyBlueprint:
+		driftState, err := resources.GetDrift(ctx, action.ResourceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get resource drift state: %w", err)
+		}
+
+		if driftState.ResourceID == "" {
+			return nil, fmt.Errorf(
+				"drift state is required for action %s on resource %s",
+				ReconciliationActionReapplyBlueprint,
+				action.ResourceID,
+			)
+		}
+
+		// The persisted spec is the desired state for the re-deployed resource,
+		// the spec data that is saved will only differ in computed fields
+		// that are returned by the provider.
+		resourcePlan.SpecChange = &provider.FieldChange{
+			FieldPath: "spec",
+			PrevValue: currentState.SpecData,
+			NewValue:  currentState.SpecData,
+		}
+		resourcePlan.ClearsDrift = true
+		resourcePlan.RequiresDeployment = true
+		resourcePlan.LinkDataUpdates, err = c.planAffectedLinkData(
+			ctx,
+			currentState,
+			currentState.SpecData,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan affected link data updates: %w", err)
+		}
+
+	case ReconciliationActionUpdateStatus:
+		// Failure reasons are cleared when only the status is updated.
+
+	case ReconciliationActionManualCleanupRequired:
+		resourcePlan.FailureReasons = []string{"marked as failed during reconciliation"}
+
+	default:
+		return nil, fmt.Errorf("unknown reconciliation action: %s", action.Action)
+	}
+
+	return resourcePlan, nil
+}
+
+// planAffectedLinkData mirrors updateAffectedLinkData without saving
+// the updated links.
+func (c *defaultBlueprintContainer) planAffectedLinkData(
+	ctx context.Context,
+	resourceState state.ResourceState,
+	newSpecData *core.MappingNode,
+) ([]LinkDataUpdatePlan, error) {
+	affectedLinks, err := c.stateContainer.Links().ListWithResourceDataMappings(
+		ctx,
+		resourceState.InstanceID,
+		resourceState.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list links with resource data mappings: %w", err)
+	}
+
+	updatePlans := []LinkDataUpdatePlan{}
+	for _, linkState := range affectedLinks {
+		linkDataUpdates := extractLinkDataUpdatesFromExternalState(
+			linkState.ResourceDataMappings,
+			resourceState.Name,
+			newSpecData,
+		)
+
+		if len(linkDataUpdates) == 0 {
+			continue
+		}
+
+		updatePlans = append(updatePlans, LinkDataUpdatePlan{
+			LinkID:   linkState.LinkID,
+			LinkName: linkState.Name,
+			Changes:  planLinkDataChanges(linkState.Data, linkDataUpdates),
+		})
+	}
+
+	return sortedLinkDataUpdatePlans(updatePlans), nil
+}
+
+// planLinkReconciliation mirrors applyLinkReconciliation
+// without writing to state or calling providers.
+func (c *defaultBlueprintContainer) planLinkReconciliation(
+	ctx context.Context,
+	action LinkReconcileAction,
+) (*LinkReconcilePlan, error) {
+	linkState, err := c.stateContainer.Links().Get(ctx, action.LinkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get link state: %w", err)
+	}
+
+	linkPlan := &LinkReconcilePlan{
+		LinkID:     action.LinkID,
+		LinkName:   linkState.Name,
+		ChildPath:  action.ChildPath,
+		Action:     action.Action,
+		PrevStatus: linkState.PreciseStatus,
+		NewStatus:  action.NewStatus,
+	}
+
+	if action.Action == ReconciliationActionReapplyBlueprint {
+		// Link data and intermediary resource state are derived from the output
+		// of the link implementation, so they can not be determined up front.
+		linkPlan.ClearsDrift = true
+		linkPlan.RequiresDeployment = true
+		return linkPlan, nil
+	}
+
+	needsFullSave := len(action.IntermediaryActions) > 0 ||
+		len(action.LinkDataUpdates) > 0 ||
+		action.Action == ReconciliationActionAcceptExternal
+
+	if !needsFullSave {
+		// Failure reasons are cleared when only the status is updated.
+		return linkPlan, nil
+	}
+
+	if len(action.LinkDataUpdates) > 0 {
+		linkPlan.LinkDataChanges = planLinkDataChanges(linkState.Data, action.LinkDataUpdates)
+	}
+
+	intermediaryIDs := sortedKeys(action.IntermediaryActions)
+	for _, intermediaryID := range intermediaryIDs {
+		intermediaryPlan, err := planIntermediaryReconciliation(
+			&linkState,
+			intermediaryID,
+			action.IntermediaryActions[intermediaryID],
+		)
+		if err != nil {
+			return nil, err
+		}
+		linkPlan.Intermediaries = append(linkPlan.Intermediaries, *intermediaryPlan)
+	}
+
+	switch action.Action {
+	case ReconciliationActionAcceptExternal:
+		linkPlan.ClearsDrift = true
+	case ReconciliationActionManualCleanupRequired:
+		linkPlan.FailureReasons = []string{"manual cleanup required during reconciliation"}
+	default:
+		linkPlan.FailureReasons = linkState.FailureReasons
+	}
+
+	return linkPlan, nil
+}
+
+func planIntermediaryReconciliation(
+	linkState *state.LinkState,
+	intermediaryID string,
+	action *IntermediaryReconcileAction,
+) (*IntermediaryReconcilePlan, error) {
+	idx := findIntermediaryIndex(linkState.IntermediaryResourceStates, intermediaryID)
+	if idx == -1 {
+		return nil, fmt.Errorf("intermediary resource %s not found in link state", intermediaryID)
+	}
+
+	current := linkState.IntermediaryResourceStates[idx]
+	// The action is applied to a copy so the plan reflects exactly
+	// what applyIntermediaryAction would write.
+	planned := *current
+	err := applyIntermediaryAction(&planned, action, current.LastDeployedTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	intermediaryPlan := &IntermediaryReconcilePlan{
+		IntermediaryID: intermediaryID,
+		PrevStatus:     current.PreciseStatus,
+		NewStatus:      planned.PreciseStatus,
+		FailureReasons: planned.FailureReasons,
+	}
+	if planned.ResourceSpecData != current.ResourceSpecData {
+		intermediaryPlan.SpecChange = &provider.FieldChange{
+			FieldPath: "spec",
+			PrevValue: current.ResourceSpecData,
+			NewValue:  planned.ResourceSpecData,
+		}
+	}
+
+	return intermediaryPlan, nil
+}
+
+// planLinkDataChanges produces a field change for each link data path
+// that would be written, ordered by link data path.
+func planLinkDataChanges(
+	currentData map[string]*core.MappingNode,
+	updates map[string]*core.MappingNode,
+) []provider.FieldChange {
+	linkDataPaths := sortedKeys(updates)
+	changes := make([]provider.FieldChange, 0, len(linkDataPaths))
+	for _, linkDataPath := range linkDataPaths {
+		changes = append(changes, provider.FieldChange{
+			FieldPath: linkDataPath,
+			PrevValue: getValueAtLinkDataPath(currentData, linkDataPath),
+			NewValue:  updates[linkDataPath],
+		})
+	}
+
+	return changes
+}
+
+// getValueAtLinkDataPath is the read counterpart to setValueAtLinkDataPath.
+func getValueAtLinkDataPath(data map[string]*core.MappingNode, path string) *core.MappingNode {
+	parts := strings.Split(path, ".")
+	current, hasRoot := data[parts[0]]
+	if !hasRoot {
+		return nil
+	}
+
+	for _, part := range parts[1:] {
+		if current == nil || current.Fields == nil {
+			return nil
+		}
+		current = current.Fields[part]
+	}
+
+	return current
+}
+
+func sortedLinkDataUpdatePlans(plans []LinkDataUpdatePlan) []LinkDataUpdatePlan {
+	slices.SortFunc(plans, func(a, b LinkDataUpdatePlan) int {
+		return strings.Compare(a.LinkName, b.LinkName)
+	})
+	return plans
+}
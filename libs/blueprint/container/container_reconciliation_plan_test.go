@@ -0,0 +1,274 @@
+package container
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (s *ContainerReconciliationTestSuite) Test_plan_reconciliation_returns_error_when_input_is_nil() {
+	_, err := s.container.PlanReconciliation(
+		context.Background(),
+		nil,
+		nil,
+	)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "apply reconciliation input is required")
+}
+
+func (s *ContainerReconciliationTestSuite) Test_plan_reconciliation_includes_affected_link_data_without_writing_state() {
+	oldHandler := core.MappingNodeFromString("old-handler-arn")
+	newHandler := core.MappingNodeFromString("new-external-handler-arn")
+
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-a": {
+				ResourceID:    "resource-a",
+				Name:          "resourceA",
+				Type:          "test/resourceA",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreated,
+				PreciseStatus: core.PreciseResourceStatusCreated,
+				Drifted:       true,
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"handler": oldHandler,
+					},
+				},
+			},
+			"resource-b": {
+				ResourceID:    "resource-b",
+				Name:          "resourceB",
+				Type:          "test/resourceB",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreated,
+				PreciseStatus: core.PreciseResourceStatusCreated,
+			},
+		},
+		map[string]*state.LinkState{
+			"resourceA::resourceB": {
+				LinkID:        "link-1",
+				Name:          "resourceA::resourceB",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.LinkStatusCreated,
+				PreciseStatus: core.PreciseLinkStatusIntermediaryResourcesUpdated,
+				Data: map[string]*core.MappingNode{
+					"resourceA": {
+						Fields: map[string]*core.MappingNode{
+							"handler": oldHandler,
+						},
+					},
+				},
+				ResourceDataMappings: map[string]string{
+					"resourceA::handler": "resourceA.handler",
+				},
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	externalState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"handler": newHandler,
+		},
+	}
+
+	plan, err := s.container.PlanReconciliation(
+		context.Background(),
+		&ApplyReconciliationInput{
+			InstanceID: testReconciliationInstanceID,
+			ResourceActions: []ResourceReconcileAction{
+				{
+					ResourceID:    "resource-a",
+					Action:        ReconciliationActionAcceptExternal,
+					NewStatus:     core.PreciseResourceStatusUpdated,
+					ExternalState: externalState,
+				},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Empty(plan.Errors)
+	s.Require().Len(plan.Resources, 1)
+
+	resourcePlan := plan.Resources[0]
+	s.Equal("resourceA", resourcePlan.ResourceName)
+	s.Equal(core.PreciseResourceStatusCreated, resourcePlan.PrevStatus)
+	s.Equal(core.PreciseResourceStatusUpdated, resourcePlan.NewStatus)
+	s.True(resourcePlan.ClearsDrift)
+	s.False(resourcePlan.RequiresDeployment)
+	s.Require().NotNil(resourcePlan.SpecChange)
+	s.Equal(oldHandler, resourcePlan.SpecChange.PrevValue.Fields["handler"])
+	s.Equal(externalState, resourcePlan.SpecChange.NewValue)
+	s.Equal(
+		[]LinkDataUpdatePlan{
+			{
+				LinkID:   "link-1",
+				LinkName: "resourceA::resourceB",
+				Changes: []provider.FieldChange{
+					{
+						FieldPath: "resourceA.handler",
+						PrevValue: oldHandler,
+						NewValue:  newHandler,
+					},
+				},
+			},
+		},
+		resourcePlan.LinkDataUpdates,
+	)
+
+	// Planning must not modify persisted state.
+	resourceState, err := s.stateContainer.Resources().Get(context.Background(), "resource-a")
+	s.Require().NoError(err)
+	s.Equal(core.PreciseResourceStatusCreated, resourceState.PreciseStatus)
+	s.True(resourceState.Drifted)
+	s.Equal(oldHandler, resourceState.SpecData.Fields["handler"])
+
+	linkState, err := s.stateContainer.Links().Get(context.Background(), "link-1")
+	s.Require().NoError(err)
+	s.Equal(oldHandler, linkState.Data["resourceA"].Fields["handler"])
+}
+
+func (s *ContainerReconciliationTestSuite) Test_plan_reconciliation_includes_link_data_and_intermediary_changes() {
+	oldValue := core.MappingNodeFromString("old-value")
+	newValue := core.MappingNodeFromString("new-external-value")
+
+	err := s.populateTestState(
+		map[string]*state.ResourceState{},
+		map[string]*state.LinkState{
+			"resourceA::resourceB": {
+				LinkID:        "link-1",
+				Name:          "resourceA::resourceB",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.LinkStatusCreated,
+				PreciseStatus: core.PreciseLinkStatusIntermediaryResourcesUpdated,
+				Data: map[string]*core.MappingNode{
+					"resourceA": {
+						Fields: map[string]*core.MappingNode{
+							"handler": oldValue,
+						},
+					},
+				},
+				IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{
+					{
+						ResourceID:       "intermediary-1",
+						ResourceType:     "test/intermediary",
+						InstanceID:       testReconciliationInstanceID,
+						Status:           core.ResourceStatusCreated,
+						PreciseStatus:    core.PreciseResourceStatusCreated,
+						ResourceSpecData: oldValue,
+					},
+				},
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	plan, err := s.container.PlanReconciliation(
+		context.Background(),
+		&ApplyReconciliationInput{
+			InstanceID: testReconciliationInstanceID,
+			LinkActions: []LinkReconcileAction{
+				{
+					LinkID:    "link-1",
+					Action:    ReconciliationActionAcceptExternal,
+					NewStatus: core.PreciseLinkStatusIntermediaryResourcesUpdated,
+					LinkDataUpdates: map[string]*core.MappingNode{
+						"resourceA.handler": newValue,
+						"resourceB.queue":   newValue,
+					},
+					IntermediaryActions: map[string]*IntermediaryReconcileAction{
+						"intermediary-1": {
+							IntermediaryID: "intermediary-1",
+							Action:         ReconciliationActionAcceptExternal,
+							ExternalState:  newValue,
+							NewStatus:      core.PreciseResourceStatusUpdated,
+						},
+					},
+				},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Empty(plan.Errors)
+	s.Require().Len(plan.Links, 1)
+
+	linkPlan := plan.Links[0]
+	s.True(linkPlan.ClearsDrift)
+	s.Equal(
+		[]provider.FieldChange{
+			{
+				FieldPath: "resourceA.handler",
+				PrevValue: oldValue,
+				NewValue:  newValue,
+			},
+			{
+				FieldPath: "resourceB.queue",
+				NewValue:  newValue,
+			},
+		},
+		linkPlan.LinkDataChanges,
+	)
+	s.Equal(
+		[]IntermediaryReconcilePlan{
+			{
+				IntermediaryID: "intermediary-1",
+				PrevStatus:     core.PreciseResourceStatusCreated,
+				NewStatus:      core.PreciseResourceStatusUpdated,
+				SpecChange: &provider.FieldChange{
+					FieldPath: "spec",
+					PrevValue: oldValue,
+					NewValue:  newValue,
+				},
+			},
+		},
+		linkPlan.Intermediaries,
+	)
+
+	linkState, err := s.stateContainer.Links().Get(context.Background(), "link-1")
+	s.Require().NoError(err)
+	s.Equal(oldValue, linkState.IntermediaryResourceStates[0].ResourceSpecData)
+	s.NotContains(linkState.Data, "resourceB")
+}
+
+func (s *ContainerReconciliationTestSuite) Test_plan_reconciliation_reports_actions_that_would_fail() {
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-1": {
+				ResourceID:    "resource-1",
+				Name:          "testResource",
+				Type:          "test/resource",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreated,
+				PreciseStatus: core.PreciseResourceStatusCreated,
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+
+	plan, err := s.container.PlanReconciliation(
+		context.Background(),
+		&ApplyReconciliationInput{
+			InstanceID: testReconciliationInstanceID,
+			ResourceActions: []ResourceReconcileAction{
+				{
+					ResourceID: "resource-1",
+					Action:     ReconciliationActionAcceptExternal,
+					NewStatus:  core.PreciseResourceStatusCreated,
+				},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Empty(plan.Resources)
+	s.Require().Len(plan.Errors, 1)
+	s.Equal("resource-1", plan.Errors[0].ElementID)
+	s.Equal("testResource", plan.Errors[0].ElementName)
+	s.Contains(plan.Errors[0].Error, "external state is required")
+}
@@ -0,0 +1,478 @@
+package container
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/specmerge"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// reapplyResourceFromPersistedState re-deploys a drifted resource using the
+// persisted spec as the desired state, reverting the changes that were made
+// to the resource outside of the deployment process.
+// The persisted drift state for the resource is used to determine the changes
+// that need to be applied to bring the resource back in line with the persisted spec.
+// Stabilisation is not awaited, the caller is expected to provide the status
+// that the resource should be set to once the deployment has been initiated.
+func (c *defaultBlueprintContainer) reapplyResourceFromPersistedState(
+	ctx context.Context,
+	action ResourceReconcileAction,
+	paramOverrides core.BlueprintParams,
+) error {
+	resources := c.stateContainer.Resources()
+
+	currentState, err := resources.Get(ctx, action.ResourceID)
+	if err != nil {
+		return fmt.Errorf("failed to get current resource state: %w", err)
+	}
+
+	driftState, err := resources.GetDrift(ctx, action.ResourceID)
+	if err != nil {
+		return fmt.Errorf("failed to get resource drift state: %w", err)
+	}
+
+	if driftState.ResourceID == "" {
+		return fmt.Errorf(
+			"drift state is required for action %s on resource %s",
+			ReconciliationActionReapplyBlueprint,
+			action.ResourceID,
+		)
+	}
+
+	externalState := driftState.SpecData
+	if action.ExternalState != nil {
+		externalState = action.ExternalState
+	}
+
+	instanceName, err := c.getInstanceName(ctx, currentState.InstanceID)
+	if err != nil {
+		return err
+	}
+
+	resourceRegistry := c.resourceRegistry.WithParams(paramOverrides)
+	providerNamespace := provider.ExtractProviderFromItemType(currentState.Type)
+	providerCtx := provider.NewProviderContextFromParams(providerNamespace, paramOverrides)
+	changes := createReapplyResourceChanges(&currentState, externalState, driftState.Difference)
+
+	output, err := resourceRegistry.Deploy(
+		ctx,
+		currentState.Type,
+		&provider.ResourceDeployServiceInput{
+			DeployInput: &provider.ResourceDeployInput{
+				InstanceID:      currentState.InstanceID,
+				InstanceName:    instanceName,
+				ResourceID:      currentState.ResourceID,
+				Changes:         changes,
+				ProviderContext: providerCtx,
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to reapply persisted state for resource %s: %w",
+			currentState.Name,
+			err,
+		)
+	}
+
+	reappliedSpec, err := c.mergeReappliedComputedFields(
+		ctx,
+		resourceRegistry,
+		&currentState,
+		output,
+		providerCtx,
+	)
+	if err != nil {
+		return err
+	}
+
+	currentTime := int(c.clock.Now().Unix())
+	currentState.Status = reconcilePreciseToResourceStatus(action.NewStatus)
+	currentState.PreciseStatus = action.NewStatus
+	currentState.LastStatusUpdateTimestamp = currentTime
+	currentState.LastDeployedTimestamp = currentTime
+	currentState.LastDeployAttemptTimestamp = currentTime
+	currentState.SpecData = reappliedSpec
+	currentState.FailureReasons = nil
+	currentState.Drifted = false
+	currentState.LastDriftDetectedTimestamp = nil
+
+	// Links that map resource fields to link data may have been synced with
+	// the drifted values, so they need to be brought back in line with the
+	// persisted spec that has been re-deployed.
+	if err := c.updateAffectedLinkData(ctx, currentState, reappliedSpec); err != nil {
+		return fmt.Errorf("failed to update affected link data: %w", err)
+	}
+
+	if _, err := resources.RemoveDrift(ctx, action.ResourceID); err != nil {
+		// Log but don't fail - drift state removal is not critical.
+		// User can force redeploy or skip drift check if state becomes inconsistent.
+		logFields := []core.LogField{
+			core.StringLogField("resourceId", action.ResourceID),
+			core.ErrorLogField("error", err),
+		}
+		if action.ChildPath != "" {
+			logFields = append(logFields, core.StringLogField("childPath", action.ChildPath))
+		}
+		c.logger.Warn(
+			"failed to remove resource drift state after reapplying persisted state",
+			logFields...,
+		)
+	}
+
+	return resources.Save(ctx, currentState)
+}
+
+// mergeReappliedComputedFields merges the computed field values returned
+// by the provider after reapplying a resource into the persisted spec.
+// The persisted spec already holds computed values from the last deployment,
+// these are replaced by the computed values returned from the provider.
+func (c *defaultBlueprintContainer) mergeReappliedComputedFields(
+	ctx context.Context,
+	resourceRegistry resourcehelpers.Registry,
+	currentState *state.ResourceState,
+	output *provider.ResourceDeployOutput,
+	providerCtx provider.Context,
+) (*core.MappingNode, error) {
+	if output == nil || len(output.ComputedFieldValues) == 0 {
+		return currentState.SpecData, nil
+	}
+
+	specDefOutput, err := resourceRegistry.GetSpecDefinition(
+		ctx,
+		currentState.Type,
+		&provider.ResourceGetSpecDefinitionInput{
+			ProviderContext: providerCtx,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spec definition for resource %s: %w", currentState.Name, err)
+	}
+
+	expectedComputedFields := []string{}
+	if specDefOutput != nil && specDefOutput.SpecDefinition != nil {
+		expectedComputedFields = specmerge.CollectComputedFields(
+			specDefOutput.SpecDefinition.Schema,
+			"spec",
+		)
+	}
+
+	merged := core.CopyMappingNode(currentState.SpecData)
+	if merged == nil {
+		merged = &core.MappingNode{Fields: map[string]*core.MappingNode{}}
+	}
+
+	for computedFieldPath, computedFieldValue := range output.ComputedFieldValues {
+		if !specmerge.IsComputedFieldInList(expectedComputedFields, computedFieldPath) {
+			return nil, fmt.Errorf(
+				"computed field %q returned when reapplying resource %s is not "+
+					"a computed field in the resource spec definition",
+				computedFieldPath,
+				currentState.Name,
+			)
+		}
+
+		err := core.InjectPathValueReplaceFields(
+			core.ReplaceSpecWithRoot(computedFieldPath),
+			computedFieldValue,
+			merged,
+			core.MappingNodeMaxTraverseDepth,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return merged, nil
+}
+
+// createReapplyResourceChanges produces the changes to bring a resource
+// from its external (drifted) state back to the persisted spec.
+// This is the inverse of the drift changes that represent the changes
+// from the persisted state to the external state.
+func createReapplyResourceChanges(
+	resource *state.ResourceState,
+	externalState *core.MappingNode,
+	driftChanges *state.ResourceDriftChanges,
+) *provider.Changes {
+	externalResourceState := *resource
+	externalResourceState.SpecData = externalState
+
+	changes := &provider.Changes{
+		AppliedResourceInfo: provider.ResourceInfo{
+			ResourceID:               resource.ResourceID,
+			ResourceName:             resource.Name,
+			InstanceID:               resource.InstanceID,
+			CurrentResourceState:     &externalResourceState,
+			ResourceWithResolvedSubs: createPersistedResolvedResource(resource),
+		},
+		ModifiedFields: []provider.FieldChange{},
+		NewFields:      []provider.FieldChange{},
+		RemovedFields:  []string{},
+	}
+
+	if driftChanges == nil {
+		return changes
+	}
+
+	for _, change := range driftChanges.ModifiedFields {
+		changes.ModifiedFields = append(changes.ModifiedFields, provider.FieldChange{
+			FieldPath: change.FieldPath,
+			PrevValue: change.DriftedValue,
+			NewValue:  change.StateValue,
+		})
+	}
+
+	// Fields that are only present in the external state
+	// need to be removed to match the persisted spec.
+	for _, change := range driftChanges.NewFields {
+		changes.RemovedFields = append(changes.RemovedFields, change.FieldPath)
+	}
+
+	// Fields that were removed outside of the deployment process
+	// need to be added back with their persisted values.
+	for _, fieldPath := range driftChanges.RemovedFields {
+		persistedValue, _ := core.GetPathValue(
+			core.ReplaceSpecWithRoot(fieldPath),
+			resource.SpecData,
+			core.MappingNodeMaxTraverseDepth,
+		)
+		changes.NewFields = append(changes.NewFields, provider.FieldChange{
+			FieldPath: fieldPath,
+			NewValue:  persistedValue,
+		})
+	}
+
+	return changes
+}
+
+func createPersistedResolvedResource(resource *state.ResourceState) *provider.ResolvedResource {
+	return &provider.ResolvedResource{
+		Type: &schema.ResourceTypeWrapper{
+			Value: resource.Type,
+		},
+		Description: core.MappingNodeFromString(resource.Description),
+		Metadata:    createPersistedResolvedResourceMetadata(resource),
+		Spec:        core.CopyMappingNode(resource.SpecData),
+	}
+}
+
+func createPersistedResolvedResourceMetadata(
+	resource *state.ResourceState,
+) *provider.ResolvedResourceMetadata {
+	if resource.Metadata == nil {
+		return nil
+	}
+
+	return &provider.ResolvedResourceMetadata{
+		DisplayName: core.MappingNodeFromString(
+			resource.Metadata.DisplayName,
+		),
+		Annotations: &core.MappingNode{
+			Fields: resource.Metadata.Annotations,
+		},
+		Labels: &schema.StringMap{
+			Values: resource.Metadata.Labels,
+		},
+		Custom: resource.Metadata.Custom,
+	}
+}
+
+// reapplyLinkFromPersistedState re-applies a link to both of its resources
+// and its intermediary resources using the persisted state of the linked resources,
+// reverting changes made to link-managed fields and intermediary resources
+// outside of the deployment process.
+// When reapplying resources and links in the same reconciliation, resources are
+// reapplied first so the link is applied to the reapplied resource state.
+func (c *defaultBlueprintContainer) reapplyLinkFromPersistedState(
+	ctx context.Context,
+	action LinkReconcileAction,
+	paramOverrides core.BlueprintParams,
+) error {
+	links := c.stateContainer.Links()
+
+	linkState, err := links.Get(ctx, action.LinkID)
+	if err != nil {
+		return fmt.Errorf("failed to get link state: %w", err)
+	}
+
+	instanceState, err := c.stateContainer.Instances().Get(ctx, linkState.InstanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get instance state for link: %w", err)
+	}
+
+	err = c.applyLinkToPersistedResources(ctx, &linkState, &instanceState, paramOverrides)
+	if err != nil {
+		return err
+	}
+
+	currentTime := int(c.clock.Now().Unix())
+	linkState.Status = reconcilePreciseLinkToLinkStatus(action.NewStatus)
+	linkState.PreciseStatus = action.NewStatus
+	linkState.LastStatusUpdateTimestamp = currentTime
+	linkState.LastDeployedTimestamp = currentTime
+	linkState.LastDeployAttemptTimestamp = currentTime
+	linkState.FailureReasons = nil
+	linkState.Drifted = false
+	linkState.LastDriftDetectedTimestamp = nil
+
+	c.removeLinkDriftAfterReapply(ctx, action.LinkID, action.ChildPath)
+
+	return links.Save(ctx, linkState)
+}
+
+// applyLinkToPersistedResources re-applies a link implementation to both of the
+// linked resources and the intermediary resources of the link using the persisted
+// state of the linked resources.
+// The link data, resource data mappings and intermediary resource states
+// of the provided link state are updated with the output of the link implementation,
+// the caller is responsible for updating the status of the link and persisting it.
+func (c *defaultBlueprintContainer) applyLinkToPersistedResources(
+	ctx context.Context,
+	linkState *state.LinkState,
+	instanceState *state.InstanceState,
+	paramOverrides core.BlueprintParams,
+) error {
+	resourceAName, resourceBName := parseLinkName(linkState.Name)
+	resourceA := findResourceByName(instanceState.Resources, resourceAName)
+	resourceB := findResourceByName(instanceState.Resources, resourceBName)
+	if resourceA == nil || resourceB == nil {
+		return fmt.Errorf(
+			"resources for link %s could not be found in the instance state",
+			linkState.Name,
+		)
+	}
+
+	linkImplementation, err := c.linkRegistry.Link(ctx, resourceA.Type, resourceB.Type)
+	if err != nil {
+		return fmt.Errorf("failed to get link implementation for %s: %w", linkState.Name, err)
+	}
+
+	resourceAInfo := createPersistedResourceInfo(resourceA)
+	resourceBInfo := createPersistedResourceInfo(resourceB)
+	linkCtx := provider.NewLinkContextFromParams(paramOverrides)
+
+	resourceAOutput, err := linkImplementation.UpdateResourceA(
+		ctx,
+		&provider.LinkUpdateResourceInput{
+			ResourceInfo:      resourceAInfo,
+			OtherResourceInfo: resourceBInfo,
+			InstanceName:      instanceState.InstanceName,
+			LinkUpdateType:    provider.LinkUpdateTypeUpdate,
+			CurrentLinkState:  linkState,
+			LinkContext:       linkCtx,
+			LinkConfig:        linkState.Config,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to reapply link %s to resource A: %w", linkState.Name, err)
+	}
+
+	resourceBOutput, err := linkImplementation.UpdateResourceB(
+		ctx,
+		&provider.LinkUpdateResourceInput{
+			ResourceInfo:      resourceBInfo,
+			OtherResourceInfo: resourceAInfo,
+			InstanceName:      instanceState.InstanceName,
+			LinkUpdateType:    provider.LinkUpdateTypeUpdate,
+			CurrentLinkState:  linkState,
+			LinkContext:       linkCtx,
+			LinkConfig:        linkState.Config,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to reapply link %s to resource B: %w", linkState.Name, err)
+	}
+
+	resourceRegistry := c.resourceRegistry.WithParams(paramOverrides)
+	intermediaryResourcesOutput, err := linkImplementation.UpdateIntermediaryResources(
+		ctx,
+		&provider.LinkUpdateIntermediaryResourcesInput{
+			ResourceAInfo:    resourceAInfo,
+			ResourceBInfo:    resourceBInfo,
+			LinkID:           linkState.LinkID,
+			InstanceName:     instanceState.InstanceName,
+			LinkUpdateType:   provider.LinkUpdateTypeUpdate,
+			CurrentLinkState: linkState,
+			LinkContext:      linkCtx,
+			LinkConfig:       linkState.Config,
+			ResourceService:  resourceRegistry,
+		},
+	)
+	// Locks acquired by the link implementation when updating intermediary resources
+	// must be released regardless of whether or not the update was successful.
+	resourceRegistry.ReleaseResourceLocksAcquiredBy(ctx, linkState.InstanceID, linkState.LinkID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to reapply intermediary resources for link %s: %w",
+			linkState.Name,
+			err,
+		)
+	}
+
+	linkDeployResult := createLinkDeployResult(
+		resourceAOutput,
+		resourceBOutput,
+		intermediaryResourcesOutput,
+	)
+	if linkDeployResult.LinkData != nil {
+		linkState.Data = linkDeployResult.LinkData.Fields
+	}
+	if linkDeployResult.ResourceDataMappings != nil {
+		linkState.ResourceDataMappings = linkDeployResult.ResourceDataMappings
+	}
+	if linkDeployResult.IntermediaryResourceStates != nil {
+		linkState.IntermediaryResourceStates = linkDeployResult.IntermediaryResourceStates
+	}
+
+	return nil
+}
+
+func (c *defaultBlueprintContainer) removeLinkDriftAfterReapply(
+	ctx context.Context,
+	linkID string,
+	childPath string,
+) {
+	if _, err := c.stateContainer.Links().RemoveDrift(ctx, linkID); err != nil {
+		// Log but don't fail - drift state removal is not critical.
+		// User can force redeploy or skip drift check if state becomes inconsistent.
+		logFields := []core.LogField{
+			core.StringLogField("linkId", linkID),
+			core.ErrorLogField("error", err),
+		}
+		if childPath != "" {
+			logFields = append(logFields, core.StringLogField("childPath", childPath))
+		}
+		c.logger.Warn(
+			"failed to remove link drift state after reapplying persisted state",
+			logFields...,
+		)
+	}
+}
+
+func createPersistedResourceInfo(resource *state.ResourceState) *provider.ResourceInfo {
+	return &provider.ResourceInfo{
+		ResourceID:               resource.ResourceID,
+		ResourceName:             resource.Name,
+		InstanceID:               resource.InstanceID,
+		CurrentResourceState:     resource,
+		ResourceWithResolvedSubs: createPersistedResolvedResource(resource),
+	}
+}
+
+func (c *defaultBlueprintContainer) getInstanceName(
+	ctx context.Context,
+	instanceID string,
+) (string, error) {
+	instanceState, err := c.stateContainer.Instances().Get(ctx, instanceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get instance state: %w", err)
+	}
+
+	return instanceState.InstanceName, nil
+}
@@ -3,12 +3,16 @@ package container
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/drift"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/memstate"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/transform"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -1821,6 +1825,233 @@ func (s *ContainerReconciliationTestSuite) Test_apply_reconciliation_populates_e
 	s.Contains(reconcileError.Error, "non-existent-intermediary")
 }
 
+func (s *ContainerReconciliationTestSuite) Test_apply_reconciliation_accepts_external_state_for_selected_fields() {
+	persistedHandler := "persisted-handler"
+	externalHandler := "external-handler"
+	persistedTimeout := 30
+	externalTimeout := 60
+	driftTimestamp := 1234567890
+
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-a": {
+				ResourceID:                 "resource-a",
+				Name:                       "resourceA",
+				Type:                       "test/resourceA",
+				InstanceID:                 testReconciliationInstanceID,
+				Status:                     core.ResourceStatusCreated,
+				PreciseStatus:              core.PreciseResourceStatusCreated,
+				Drifted:                    true,
+				LastDriftDetectedTimestamp: &driftTimestamp,
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"handler": core.MappingNodeFromString(persistedHandler),
+						"timeout": core.MappingNodeFromInt(persistedTimeout),
+					},
+				},
+			},
+			"resource-b": {
+				ResourceID:    "resource-b",
+				Name:          "resourceB",
+				Type:          "test/resourceB",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreated,
+				PreciseStatus: core.PreciseResourceStatusCreated,
+			},
+		},
+		map[string]*state.LinkState{
+			"resourceA::resourceB": {
+				LinkID:        "link-1",
+				Name:          "resourceA::resourceB",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.LinkStatusCreated,
+				PreciseStatus: core.PreciseLinkStatusIntermediaryResourcesUpdated,
+				Data: map[string]*core.MappingNode{
+					"resourceA": {
+						Fields: map[string]*core.MappingNode{
+							"handler": core.MappingNodeFromString(persistedHandler),
+							"timeout": core.MappingNodeFromInt(persistedTimeout),
+						},
+					},
+				},
+				ResourceDataMappings: map[string]string{
+					"resourceA::handler": "resourceA.handler",
+					"resourceA::timeout": "resourceA.timeout",
+				},
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	result, err := s.container.ApplyReconciliation(
+		context.Background(),
+		&ApplyReconciliationInput{
+			InstanceID: testReconciliationInstanceID,
+			ResourceActions: []ResourceReconcileAction{
+				{
+					ResourceID: "resource-a",
+					Action:     ReconciliationActionAcceptExternal,
+					NewStatus:  core.PreciseResourceStatusCreated,
+					ExternalState: &core.MappingNode{
+						Fields: map[string]*core.MappingNode{
+							"handler": core.MappingNodeFromString(externalHandler),
+							"timeout": core.MappingNodeFromInt(externalTimeout),
+						},
+					},
+					FieldActions: []FieldReconcileAction{
+						{
+							FieldPath: "spec.timeout",
+							Action:    FieldReconciliationActionAcceptExternal,
+						},
+						{
+							FieldPath: "spec.handler",
+							Action:    FieldReconciliationActionKeepPersisted,
+						},
+					},
+				},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+	s.Equal(1, result.ResourcesUpdated)
+	s.Empty(result.Errors)
+
+	resourceState, err := s.stateContainer.Resources().Get(context.Background(), "resource-a")
+	s.Require().NoError(err)
+	s.Equal(persistedHandler, core.StringValue(resourceState.SpecData.Fields["handler"]))
+	s.Equal(externalTimeout, core.IntValue(resourceState.SpecData.Fields["timeout"]))
+	// The external handler value still differs from the persisted value,
+	// so the resource should remain drifted.
+	s.True(resourceState.Drifted)
+
+	linkState, err := s.stateContainer.Links().Get(context.Background(), "link-1")
+	s.Require().NoError(err)
+	s.Require().NotNil(linkState.Data["resourceA"])
+	s.Equal(persistedHandler, core.StringValue(linkState.Data["resourceA"].Fields["handler"]))
+	s.Equal(externalTimeout, core.IntValue(linkState.Data["resourceA"].Fields["timeout"]))
+}
+
+func (s *ContainerReconciliationTestSuite) Test_apply_reconciliation_removes_accepted_fields_missing_from_external_state() {
+	handler := "handler"
+	driftTimestamp := 1234567890
+
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-1": {
+				ResourceID:                 "resource-1",
+				Name:                       "testResource1",
+				Type:                       "test/resource",
+				InstanceID:                 testReconciliationInstanceID,
+				Status:                     core.ResourceStatusCreated,
+				PreciseStatus:              core.PreciseResourceStatusCreated,
+				Drifted:                    true,
+				LastDriftDetectedTimestamp: &driftTimestamp,
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"handler": core.MappingNodeFromString(handler),
+						"tags": {
+							Fields: map[string]*core.MappingNode{
+								"owner.team": core.MappingNodeFromString("platform"),
+							},
+						},
+					},
+				},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+
+	result, err := s.container.ApplyReconciliation(
+		context.Background(),
+		&ApplyReconciliationInput{
+			InstanceID: testReconciliationInstanceID,
+			ResourceActions: []ResourceReconcileAction{
+				{
+					ResourceID: "resource-1",
+					Action:     ReconciliationActionAcceptExternal,
+					NewStatus:  core.PreciseResourceStatusCreated,
+					ExternalState: &core.MappingNode{
+						Fields: map[string]*core.MappingNode{
+							"handler": core.MappingNodeFromString(handler),
+							"tags": {
+								Fields: map[string]*core.MappingNode{},
+							},
+						},
+					},
+					FieldActions: []FieldReconcileAction{
+						{
+							FieldPath: "spec.tags[\"owner.team\"]",
+							Action:    FieldReconciliationActionAcceptExternal,
+						},
+					},
+				},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+	s.Equal(1, result.ResourcesUpdated)
+	s.Empty(result.Errors)
+
+	resourceState, err := s.stateContainer.Resources().Get(context.Background(), "resource-1")
+	s.Require().NoError(err)
+	s.Equal(handler, core.StringValue(resourceState.SpecData.Fields["handler"]))
+	s.Require().NotNil(resourceState.SpecData.Fields["tags"])
+	s.Empty(resourceState.SpecData.Fields["tags"].Fields)
+	s.False(resourceState.Drifted)
+	s.Nil(resourceState.LastDriftDetectedTimestamp)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_apply_reconciliation_reports_error_for_unknown_field_action() {
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-1": {
+				ResourceID:    "resource-1",
+				Name:          "testResource1",
+				Type:          "test/resource",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreated,
+				PreciseStatus: core.PreciseResourceStatusCreated,
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+
+	result, err := s.container.ApplyReconciliation(
+		context.Background(),
+		&ApplyReconciliationInput{
+			InstanceID: testReconciliationInstanceID,
+			ResourceActions: []ResourceReconcileAction{
+				{
+					ResourceID: "resource-1",
+					Action:     ReconciliationActionAcceptExternal,
+					NewStatus:  core.PreciseResourceStatusCreated,
+					ExternalState: &core.MappingNode{
+						Fields: map[string]*core.MappingNode{},
+					},
+					FieldActions: []FieldReconcileAction{
+						{
+							FieldPath: "spec.handler",
+							Action:    "unknown",
+						},
+					},
+				},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+	s.Equal(0, result.ResourcesUpdated)
+	s.Require().Len(result.Errors, 1)
+	s.Contains(result.Errors[0].Error, "unknown field reconciliation action")
+}
+
 func (s *ContainerReconciliationTestSuite) Test_check_reconciliation_populates_link_data_updates_for_drift() {
 	oldValue := "old-handler"
 	newValue := "new-external-handler"
@@ -1918,10 +2149,390 @@ func (s *ContainerReconciliationTestSuite) Test_check_reconciliation_populates_l
 		"LinkDataUpdates should contain the external value for reconciliation")
 }
 
+func (s *ContainerReconciliationTestSuite) Test_apply_reconciliation_reapplies_persisted_state_for_drifted_resource() {
+	tableID := "table-arn-1"
+	persistedRegion := "us-east-1"
+	driftedRegion := "eu-west-2"
+	driftedBillingMode := "PAY_PER_REQUEST"
+	driftTimestamp := 1234567890
+
+	persistedSpec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"id":     core.MappingNodeFromString(tableID),
+			"region": core.MappingNodeFromString(persistedRegion),
+		},
+	}
+	driftedSpec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"id":          core.MappingNodeFromString(tableID),
+			"region":      core.MappingNodeFromString(driftedRegion),
+			"billingMode": core.MappingNodeFromString(driftedBillingMode),
+		},
+	}
+
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-table": {
+				ResourceID:                 "resource-table",
+				Name:                       "ordersTable",
+				Type:                       "aws/dynamodb/table",
+				InstanceID:                 testReconciliationInstanceID,
+				Status:                     core.ResourceStatusCreated,
+				PreciseStatus:              core.PreciseResourceStatusCreated,
+				Drifted:                    true,
+				LastDriftDetectedTimestamp: &driftTimestamp,
+				SpecData:                   persistedSpec,
+			},
+			"resource-stream": {
+				ResourceID:    "resource-stream",
+				Name:          "ordersStream",
+				Type:          "aws/dynamodb/stream",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreated,
+				PreciseStatus: core.PreciseResourceStatusCreated,
+			},
+		},
+		map[string]*state.LinkState{
+			"ordersTable::ordersStream": {
+				LinkID:        "link-1",
+				Name:          "ordersTable::ordersStream",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.LinkStatusCreated,
+				PreciseStatus: core.PreciseLinkStatusIntermediaryResourcesUpdated,
+				// Link data was previously synced with the drifted value.
+				Data: map[string]*core.MappingNode{
+					"ordersTable": {
+						Fields: map[string]*core.MappingNode{
+							"region": core.MappingNodeFromString(driftedRegion),
+						},
+					},
+				},
+				ResourceDataMappings: map[string]string{
+					"ordersTable::region": "ordersTable.region",
+				},
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	err = s.stateContainer.Resources().SaveDrift(context.Background(), state.ResourceDriftState{
+		ResourceID:   "resource-table",
+		ResourceName: "ordersTable",
+		SpecData:     driftedSpec,
+		Difference: &state.ResourceDriftChanges{
+			ModifiedFields: []*state.ResourceDriftFieldChange{
+				{
+					FieldPath:    "spec.region",
+					StateValue:   core.MappingNodeFromString(persistedRegion),
+					DriftedValue: core.MappingNodeFromString(driftedRegion),
+				},
+			},
+			NewFields: []*state.ResourceDriftFieldChange{
+				{
+					FieldPath:    "spec.billingMode",
+					DriftedValue: core.MappingNodeFromString(driftedBillingMode),
+				},
+			},
+		},
+		Timestamp: &driftTimestamp,
+	})
+	s.Require().NoError(err)
+
+	tableResource := &reapplyRecordingResource{
+		DynamoDBTableResource: &internal.DynamoDBTableResource{},
+		computedFieldValues: map[string]*core.MappingNode{
+			"spec.id": core.MappingNodeFromString("table-arn-2"),
+		},
+	}
+	s.container.resourceRegistry = s.createReapplyResourceRegistry(tableResource)
+
+	result, err := s.container.ApplyReconciliation(
+		context.Background(),
+		&ApplyReconciliationInput{
+			InstanceID: testReconciliationInstanceID,
+			ResourceActions: []ResourceReconcileAction{
+				{
+					ResourceID: "resource-table",
+					Action:     ReconciliationActionReapplyBlueprint,
+					NewStatus:  core.PreciseResourceStatusUpdated,
+				},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Empty(result.Errors)
+	s.Equal(1, result.ResourcesUpdated)
+
+	// Verify the resource was re-deployed with changes from the external state
+	// back to the persisted spec.
+	s.Require().Len(tableResource.deployInputs, 1)
+	deployInput := tableResource.deployInputs[0]
+	s.Equal(testReconciliationInstanceName, deployInput.InstanceName)
+	s.Equal(driftedSpec, deployInput.Changes.AppliedResourceInfo.CurrentResourceState.SpecData)
+	s.Equal(persistedSpec, deployInput.Changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec)
+	s.Equal(
+		[]provider.FieldChange{
+			{
+				FieldPath: "spec.region",
+				PrevValue: core.MappingNodeFromString(driftedRegion),
+				NewValue:  core.MappingNodeFromString(persistedRegion),
+			},
+		},
+		deployInput.Changes.ModifiedFields,
+	)
+	s.Equal([]string{"spec.billingMode"}, deployInput.Changes.RemovedFields)
+
+	resourceState, err := s.stateContainer.Resources().Get(context.Background(), "resource-table")
+	s.Require().NoError(err)
+	s.False(resourceState.Drifted)
+	s.Nil(resourceState.LastDriftDetectedTimestamp)
+	s.Equal(core.PreciseResourceStatusUpdated, resourceState.PreciseStatus)
+	s.Equal("table-arn-2", core.StringValue(resourceState.SpecData.Fields["id"]))
+	s.Equal(persistedRegion, core.StringValue(resourceState.SpecData.Fields["region"]))
+	s.Nil(resourceState.SpecData.Fields["billingMode"])
+
+	driftState, err := s.stateContainer.Resources().GetDrift(context.Background(), "resource-table")
+	s.Require().NoError(err)
+	s.Empty(driftState.ResourceID, "drift state should be removed after reapplying")
+
+	// Verify link data was synced back to the persisted value.
+	linkState, err := s.stateContainer.Links().Get(context.Background(), "link-1")
+	s.Require().NoError(err)
+	s.Equal(
+		persistedRegion,
+		core.StringValue(linkState.Data["ordersTable"].Fields["region"]),
+	)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_apply_reconciliation_requires_drift_state_to_reapply_resource() {
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-table": {
+				ResourceID:    "resource-table",
+				Name:          "ordersTable",
+				Type:          "aws/dynamodb/table",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreated,
+				PreciseStatus: core.PreciseResourceStatusCreated,
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+
+	tableResource := &reapplyRecordingResource{
+		DynamoDBTableResource: &internal.DynamoDBTableResource{},
+	}
+	s.container.resourceRegistry = s.createReapplyResourceRegistry(tableResource)
+
+	result, err := s.container.ApplyReconciliation(
+		context.Background(),
+		&ApplyReconciliationInput{
+			InstanceID: testReconciliationInstanceID,
+			ResourceActions: []ResourceReconcileAction{
+				{
+					ResourceID: "resource-table",
+					Action:     ReconciliationActionReapplyBlueprint,
+					NewStatus:  core.PreciseResourceStatusUpdated,
+				},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Equal(0, result.ResourcesUpdated)
+	s.Require().Len(result.Errors, 1)
+	s.Contains(result.Errors[0].Error, "drift state is required")
+	s.Empty(tableResource.deployInputs)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_apply_reconciliation_reapplies_link_intermediary_resources() {
+	driftTimestamp := 1234567890
+	persistedPolicy := "orders-stream-policy"
+
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-table": {
+				ResourceID:    "resource-table",
+				Name:          "ordersTable",
+				Type:          "aws/dynamodb/table",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreated,
+				PreciseStatus: core.PreciseResourceStatusCreated,
+			},
+			"resource-stream": {
+				ResourceID:    "resource-stream",
+				Name:          "ordersStream",
+				Type:          "aws/dynamodb/stream",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreated,
+				PreciseStatus: core.PreciseResourceStatusCreated,
+			},
+		},
+		map[string]*state.LinkState{
+			"ordersTable::ordersStream": {
+				LinkID:                     "link-1",
+				Name:                       "ordersTable::ordersStream",
+				InstanceID:                 testReconciliationInstanceID,
+				Status:                     core.LinkStatusCreated,
+				PreciseStatus:              core.PreciseLinkStatusIntermediaryResourcesUpdated,
+				Drifted:                    true,
+				LastDriftDetectedTimestamp: &driftTimestamp,
+				IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{
+					{
+						ResourceID: "policy-1",
+						Status:     core.ResourceStatusCreated,
+						ResourceSpecData: &core.MappingNode{
+							Fields: map[string]*core.MappingNode{
+								"policyName": core.MappingNodeFromString(persistedPolicy),
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	err = s.stateContainer.Links().SaveDrift(context.Background(), state.LinkDriftState{
+		LinkID:   "link-1",
+		LinkName: "ordersTable::ordersStream",
+		IntermediaryDrift: map[string]*state.IntermediaryDriftState{
+			"policy-1": {
+				ResourceID:   "policy-1",
+				ResourceType: "aws/iam/policy",
+				Exists:       false,
+			},
+		},
+		Timestamp: &driftTimestamp,
+	})
+	s.Require().NoError(err)
+
+	link := &reapplyRecordingLink{
+		testDynamoDBTableStreamLink: &testDynamoDBTableStreamLink{},
+		intermediaryResourceStates: []*state.LinkIntermediaryResourceState{
+			{
+				ResourceID: "policy-1",
+				Status:     core.ResourceStatusCreated,
+				ResourceSpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"policyName": core.MappingNodeFromString(persistedPolicy),
+						"arn":        core.MappingNodeFromString("policy-arn-2"),
+					},
+				},
+			},
+		},
+	}
+	s.container.resourceRegistry = s.createReapplyResourceRegistry(
+		&internal.DynamoDBTableResource{},
+	)
+	s.container.linkRegistry = provider.NewLinkRegistry(
+		map[string]provider.Provider{
+			"aws": &internal.ProviderMock{
+				NamespaceValue: "aws",
+				Links: map[string]provider.Link{
+					"aws/dynamodb/table::aws/dynamodb/stream": link,
+				},
+			},
+		},
+	)
+
+	result, err := s.container.ApplyReconciliation(
+		context.Background(),
+		&ApplyReconciliationInput{
+			InstanceID: testReconciliationInstanceID,
+			LinkActions: []LinkReconcileAction{
+				{
+					LinkID:    "link-1",
+					Action:    ReconciliationActionReapplyBlueprint,
+					NewStatus: core.PreciseLinkStatusIntermediaryResourcesUpdated,
+				},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Empty(result.Errors)
+	s.Equal(1, result.LinksUpdated)
+
+	s.Require().NotNil(link.intermediaryInput)
+	s.Equal("link-1", link.intermediaryInput.LinkID)
+	s.Equal(testReconciliationInstanceName, link.intermediaryInput.InstanceName)
+	s.Equal(provider.LinkUpdateTypeUpdate, link.intermediaryInput.LinkUpdateType)
+	s.Equal("ordersTable", link.intermediaryInput.ResourceAInfo.ResourceName)
+	s.Equal("ordersStream", link.intermediaryInput.ResourceBInfo.ResourceName)
+
+	linkState, err := s.stateContainer.Links().Get(context.Background(), "link-1")
+	s.Require().NoError(err)
+	s.False(linkState.Drifted)
+	s.Nil(linkState.LastDriftDetectedTimestamp)
+	s.Equal(link.intermediaryResourceStates, linkState.IntermediaryResourceStates)
+
+	driftState, err := s.stateContainer.Links().GetDrift(context.Background(), "link-1")
+	s.Require().NoError(err)
+	s.Empty(driftState.LinkID, "link drift state should be removed after reapplying")
+}
+
+func (s *ContainerReconciliationTestSuite) createReapplyResourceRegistry(
+	tableResource provider.Resource,
+) resourcehelpers.Registry {
+	return resourcehelpers.NewRegistry(
+		map[string]provider.Provider{
+			"aws": &internal.ProviderMock{
+				NamespaceValue: "aws",
+				Resources: map[string]provider.Resource{
+					"aws/dynamodb/table": tableResource,
+				},
+			},
+		},
+		map[string]transform.SpecTransformer{},
+		10*time.Millisecond,
+		s.stateContainer,
+		/* params */ nil,
+	)
+}
+
 func TestContainerReconciliationTestSuite(t *testing.T) {
 	suite.Run(t, new(ContainerReconciliationTestSuite))
 }
 
+// reapplyRecordingResource records the inputs for resource deployments
+// to verify the changes derived when reapplying persisted state.
+type reapplyRecordingResource struct {
+	*internal.DynamoDBTableResource
+	computedFieldValues map[string]*core.MappingNode
+	deployInputs        []*provider.ResourceDeployInput
+}
+
+func (r *reapplyRecordingResource) Deploy(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	r.deployInputs = append(r.deployInputs, input)
+	return &provider.ResourceDeployOutput{
+		ComputedFieldValues: r.computedFieldValues,
+	}, nil
+}
+
+// reapplyRecordingLink records the input for intermediary resource updates
+// to verify links are re-applied from persisted state.
+type reapplyRecordingLink struct {
+	*testDynamoDBTableStreamLink
+	intermediaryResourceStates []*state.LinkIntermediaryResourceState
+	intermediaryInput          *provider.LinkUpdateIntermediaryResourcesInput
+}
+
+func (l *reapplyRecordingLink) UpdateIntermediaryResources(
+	ctx context.Context,
+	input *provider.LinkUpdateIntermediaryResourcesInput,
+) (*provider.LinkUpdateIntermediaryResourcesOutput, error) {
+	l.intermediaryInput = input
+	return &provider.LinkUpdateIntermediaryResourcesOutput{
+		IntermediaryResourceStates: l.intermediaryResourceStates,
+	}, nil
+}
+
 // mockDriftChecker is a test mock for the drift.Checker interface
 type mockDriftChecker struct {
 	checkDriftResults        map[string]*state.ResourceDriftState
@@ -0,0 +1,149 @@
+package container
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (c *defaultBlueprintContainer) RefreshState(
+	ctx context.Context,
+	input *RefreshStateInput,
+	paramOverrides core.BlueprintParams,
+) (*RefreshStateResult, error) {
+	if input == nil {
+		return nil, fmt.Errorf("refresh state input is required")
+	}
+
+	if input.InstanceID == "" {
+		return nil, fmt.Errorf("instance ID is required for state refresh")
+	}
+
+	checkResult, err := c.CheckReconciliation(
+		ctx,
+		&CheckReconciliationInput{
+			InstanceID:      input.InstanceID,
+			Scope:           ReconciliationScopeAll,
+			IncludeChildren: input.IncludeChildren,
+			TaggingConfig:   input.TaggingConfig,
+		},
+		paramOverrides,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceState, err := c.stateContainer.Instances().Get(ctx, input.InstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance state: %w", err)
+	}
+
+	result := &RefreshStateResult{
+		InstanceID:     input.InstanceID,
+		Resources:      []ResourceReconcileResult{},
+		Links:          []LinkReconcileResult{},
+		HasInterrupted: checkResult.HasInterrupted,
+		Errors:         []ReconciliationError{},
+	}
+	applyInput := &ApplyReconciliationInput{
+		InstanceID:      input.InstanceID,
+		ResourceActions: []ResourceReconcileAction{},
+		LinkActions:     []LinkReconcileAction{},
+	}
+
+	for _, resourceResult := range checkResult.Resources {
+		// Interrupted resources are left as they are, the status of the resource
+		// can not be determined from the external state alone.
+		if resourceResult.Type != ReconciliationTypeDrift || resourceResult.ExternalState == nil {
+			continue
+		}
+
+		applyInput.ResourceActions = append(
+			applyInput.ResourceActions,
+			ResourceReconcileAction{
+				ResourceID:    resourceResult.ResourceID,
+				ChildPath:     resourceResult.ChildPath,
+				Action:        ReconciliationActionAcceptExternal,
+				ExternalState: resourceResult.ExternalState,
+				NewStatus:     resourceResult.NewStatus,
+			},
+		)
+		resourceResult.Type = ReconciliationTypeStateRefresh
+		result.Resources = append(result.Resources, resourceResult)
+	}
+
+	for _, linkResult := range checkResult.Links {
+		if linkResult.Type != ReconciliationTypeDrift {
+			continue
+		}
+
+		linkState := findLinkStateByChildPath(&instanceState, linkResult.ChildPath, linkResult.LinkName)
+		applyInput.LinkActions = append(
+			applyInput.LinkActions,
+			LinkReconcileAction{
+				LinkID:              linkResult.LinkID,
+				ChildPath:           linkResult.ChildPath,
+				Action:              ReconciliationActionAcceptExternal,
+				NewStatus:           linkResult.NewStatus,
+				LinkDataUpdates:     linkResult.LinkDataUpdates,
+				IntermediaryActions: createRefreshIntermediaryActions(linkResult, linkState),
+			},
+		)
+		linkResult.Type = ReconciliationTypeStateRefresh
+		result.Links = append(result.Links, linkResult)
+	}
+
+	applyResult, err := c.ApplyReconciliation(ctx, applyInput, paramOverrides)
+	if err != nil {
+		return nil, err
+	}
+	result.ResourcesUpdated = applyResult.ResourcesUpdated
+	result.LinksUpdated = applyResult.LinksUpdated
+	result.Errors = applyResult.Errors
+
+	return result, nil
+}
+
+// createRefreshIntermediaryActions creates the actions to accept the external state
+// for intermediary resources of a link that still exist in the upstream provider.
+// The current status of each intermediary resource is preserved.
+func createRefreshIntermediaryActions(
+	linkResult LinkReconcileResult,
+	linkState *state.LinkState,
+) map[string]*IntermediaryReconcileAction {
+	if len(linkResult.IntermediaryChanges) == 0 || linkState == nil {
+		return nil
+	}
+
+	actions := map[string]*IntermediaryReconcileAction{}
+	for intermediaryID, intermediaryResult := range linkResult.IntermediaryChanges {
+		idx := findIntermediaryIndex(linkState.IntermediaryResourceStates, intermediaryID)
+		if idx == -1 || !intermediaryResult.Exists || intermediaryResult.ExternalState == nil {
+			continue
+		}
+
+		actions[intermediaryID] = &IntermediaryReconcileAction{
+			IntermediaryID: intermediaryID,
+			Action:         ReconciliationActionAcceptExternal,
+			ExternalState:  intermediaryResult.ExternalState,
+			NewStatus:      linkState.IntermediaryResourceStates[idx].PreciseStatus,
+		}
+	}
+
+	return actions
+}
+
+func findLinkStateByChildPath(
+	instanceState *state.InstanceState,
+	childPath string,
+	linkName string,
+) *state.LinkState {
+	targetInstance := getInstanceStateByChildPath(instanceState, childPath)
+	if targetInstance == nil {
+		return nil
+	}
+
+	return targetInstance.Links[linkName]
+}
@@ -0,0 +1,189 @@
+package container
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/drift"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (s *ContainerReconciliationTestSuite) Test_refresh_state_updates_drifted_resources_and_links() {
+	driftTimestamp := 1234567890
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-a": {
+				ResourceID:                 "resource-a",
+				Name:                       "resourceA",
+				Type:                       "test/resourceA",
+				InstanceID:                 testReconciliationInstanceID,
+				Status:                     core.ResourceStatusCreated,
+				PreciseStatus:              core.PreciseResourceStatusCreated,
+				SpecData:                   refreshTestSpec("persisted-handler"),
+				Drifted:                    true,
+				LastDriftDetectedTimestamp: &driftTimestamp,
+			},
+			"resource-b": {
+				ResourceID:    "resource-b",
+				Name:          "resourceB",
+				Type:          "test/resourceB",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreated,
+				PreciseStatus: core.PreciseResourceStatusCreated,
+			},
+		},
+		map[string]*state.LinkState{
+			"resourceA::resourceB": {
+				LinkID:        "link-1",
+				Name:          "resourceA::resourceB",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.LinkStatusCreated,
+				PreciseStatus: core.PreciseLinkStatusResourceBUpdated,
+				IntermediaryResourceStates: []*state.LinkIntermediaryResourceState{
+					{
+						ResourceID:       "intermediary-1",
+						ResourceType:     "test/intermediary",
+						Status:           core.ResourceStatusCreated,
+						PreciseStatus:    core.PreciseResourceStatusCreated,
+						ResourceSpecData: refreshTestSpec("persisted-policy"),
+					},
+				},
+				Drifted:                    true,
+				LastDriftDetectedTimestamp: &driftTimestamp,
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.driftChecker.checkDriftResults = map[string]*state.ResourceDriftState{
+		"resource-a": {
+			ResourceID:   "resource-a",
+			ResourceName: "resourceA",
+			SpecData:     refreshTestSpec("external-handler"),
+		},
+	}
+	s.driftChecker.checkLinkDriftState = &state.LinkDriftState{
+		LinkID:   "link-1",
+		LinkName: "resourceA::resourceB",
+		IntermediaryDrift: map[string]*state.IntermediaryDriftState{
+			"intermediary-1": {
+				ResourceID:     "intermediary-1",
+				ResourceType:   "test/intermediary",
+				PersistedState: refreshTestSpec("persisted-policy"),
+				ExternalState:  refreshTestSpec("external-policy"),
+				Exists:         true,
+			},
+		},
+	}
+
+	result, err := s.container.RefreshState(
+		context.Background(),
+		&RefreshStateInput{
+			InstanceID: testReconciliationInstanceID,
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+
+	s.Equal(testReconciliationInstanceID, result.InstanceID)
+	s.Equal(1, result.ResourcesUpdated)
+	s.Equal(1, result.LinksUpdated)
+	s.False(result.HasInterrupted)
+	s.Empty(result.Errors)
+	s.Require().Len(result.Resources, 1)
+	s.Equal("resourceA", result.Resources[0].ResourceName)
+	s.Equal(ReconciliationTypeStateRefresh, result.Resources[0].Type)
+	s.Require().Len(result.Links, 1)
+	s.Equal("resourceA::resourceB", result.Links[0].LinkName)
+	s.Equal(ReconciliationTypeStateRefresh, result.Links[0].Type)
+
+	resourceState, err := s.stateContainer.Resources().Get(context.Background(), "resource-a")
+	s.Require().NoError(err)
+	s.Equal(
+		"external-handler",
+		core.StringValue(resourceState.SpecData.Fields["handler"]),
+	)
+	s.Equal(core.PreciseResourceStatusCreated, resourceState.PreciseStatus)
+	s.False(resourceState.Drifted)
+	s.Nil(resourceState.LastDriftDetectedTimestamp)
+
+	linkState, err := s.stateContainer.Links().Get(context.Background(), "link-1")
+	s.Require().NoError(err)
+	s.Equal(core.PreciseLinkStatusResourceBUpdated, linkState.PreciseStatus)
+	s.False(linkState.Drifted)
+	s.Require().Len(linkState.IntermediaryResourceStates, 1)
+	intermediary := linkState.IntermediaryResourceStates[0]
+	s.Equal(core.PreciseResourceStatusCreated, intermediary.PreciseStatus)
+	s.Equal(
+		"external-policy",
+		core.StringValue(intermediary.ResourceSpecData.Fields["handler"]),
+	)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_refresh_state_leaves_interrupted_resources_unchanged() {
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-a": {
+				ResourceID:    "resource-a",
+				Name:          "resourceA",
+				Type:          "test/resourceA",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreating,
+				PreciseStatus: core.PreciseResourceStatusCreateInterrupted,
+				SpecData:      refreshTestSpec("persisted-handler"),
+			},
+		},
+		map[string]*state.LinkState{},
+	)
+	s.Require().NoError(err)
+
+	s.driftChecker.checkInterruptedResults = []drift.ReconcileResult{
+		{
+			ResourceID:    "resource-a",
+			ResourceName:  "resourceA",
+			ResourceType:  "test/resourceA",
+			OldStatus:     core.PreciseResourceStatusCreateInterrupted,
+			NewStatus:     core.PreciseResourceStatusCreated,
+			ExternalState: refreshTestSpec("external-handler"),
+		},
+	}
+
+	result, err := s.container.RefreshState(
+		context.Background(),
+		&RefreshStateInput{
+			InstanceID: testReconciliationInstanceID,
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+
+	s.True(result.HasInterrupted)
+	s.Empty(result.Resources)
+	s.Equal(0, result.ResourcesUpdated)
+
+	resourceState, err := s.stateContainer.Resources().Get(context.Background(), "resource-a")
+	s.Require().NoError(err)
+	s.Equal(core.PreciseResourceStatusCreateInterrupted, resourceState.PreciseStatus)
+	s.Equal(
+		"persisted-handler",
+		core.StringValue(resourceState.SpecData.Fields["handler"]),
+	)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_refresh_state_fails_for_missing_instance_id() {
+	_, err := s.container.RefreshState(
+		context.Background(),
+		&RefreshStateInput{},
+		nil,
+	)
+	s.Require().Error(err)
+	s.Equal("instance ID is required for state refresh", err.Error())
+}
+
+func refreshTestSpec(handler string) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"handler": core.MappingNodeFromString(handler),
+		},
+	}
+}
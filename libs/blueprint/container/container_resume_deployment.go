@@ -0,0 +1,209 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// ResumeDeploymentInput contains the input needed to resume
+// an interrupted deployment for a blueprint instance.
+type ResumeDeploymentInput struct {
+	// InstanceID is the ID of the blueprint instance to resume the deployment for.
+	InstanceID string
+	// Force bypasses the check that prevents a deployment from starting when the
+	// instance is in an active state (e.g. Deploying, Updating).
+	// This is needed to resume deployments that were interrupted by a crash or
+	// unexpected termination of the process that was carrying out the deployment,
+	// as the instance will be left in an active state.
+	Force bool
+	// TaggingConfig holds the configuration for Bluelink resource tagging.
+	// This is used for tag-based lookups of interrupted resources and
+	// for tagging resources that are deployed when resuming the deployment.
+	// If nil, tagging will not be applied to resources.
+	TaggingConfig *provider.TaggingConfig
+	// ProviderMetadataLookup returns provider plugin metadata for a provider namespace.
+	// This is used to populate ProviderPluginID and ProviderPluginVersion
+	// in the TaggingConfig for each resource.
+	ProviderMetadataLookup func(providerNamespace string) (pluginID, pluginVersion string)
+	// DrainTimeout is the maximum time to wait for in-flight operations
+	// to complete after a terminal failure before marking them as interrupted.
+	// If zero, defaults to DefaultDrainTimeout (2 minutes).
+	DrainTimeout time.Duration
+	// RunID identifies the resumed deployment in the events recorded in the
+	// deployment event store configured for the container.
+	// When not provided, a run ID will be generated for the deployment.
+	RunID string
+}
+
+func (c *defaultBlueprintContainer) ResumeDeployment(
+	ctx context.Context,
+	input *ResumeDeploymentInput,
+	channels *DeployChannels,
+	paramOverrides core.BlueprintParams,
+) error {
+	if input == nil {
+		return errors.New("resume deployment input is required")
+	}
+
+	if input.InstanceID == "" {
+		return errors.New("instance ID is required to resume a deployment")
+	}
+
+	resumeLogger := c.logger.Named("resumeDeployment").WithFields(
+		core.StringLogField("instanceId", input.InstanceID),
+	)
+
+	resumeLogger.Info("checking for interrupted resources and links")
+	checkResult, err := c.CheckReconciliation(
+		ctx,
+		&CheckReconciliationInput{
+			InstanceID:    input.InstanceID,
+			Scope:         ReconciliationScopeInterrupted,
+			TaggingConfig: input.TaggingConfig,
+		},
+		paramOverrides,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = c.reconcileInterruptedElements(ctx, checkResult, paramOverrides, resumeLogger)
+	if err != nil {
+		return err
+	}
+
+	resumeLogger.Info("staging changes for the remaining elements of the deployment")
+	// Elements that were deployed before the deployment was interrupted will be
+	// reflected in the instance state, so only the remaining elements will be
+	// included in the staged changes.
+	remainingChanges, err := c.stageChangesAndWait(
+		ctx,
+		&StageChangesInput{
+			InstanceID: input.InstanceID,
+		},
+		paramOverrides,
+	)
+	if err != nil {
+		return err
+	}
+
+	resumeLogger.Info("continuing deployment for the remaining elements")
+	return c.Deploy(
+		ctx,
+		&DeployInput{
+			InstanceID:             input.InstanceID,
+			Changes:                remainingChanges,
+			Force:                  input.Force,
+			TaggingConfig:          input.TaggingConfig,
+			ProviderMetadataLookup: input.ProviderMetadataLookup,
+			DrainTimeout:           input.DrainTimeout,
+			RunID:                  input.RunID,
+		},
+		channels,
+		paramOverrides,
+	)
+}
+
+// reconcileInterruptedElements applies the recommended reconciliation actions
+// for interrupted resources and links so that the persisted state reflects
+// what was deployed before the deployment was interrupted.
+func (c *defaultBlueprintContainer) reconcileInterruptedElements(
+	ctx context.Context,
+	checkResult *ReconciliationCheckResult,
+	paramOverrides core.BlueprintParams,
+	resumeLogger core.Logger,
+) error {
+	if !checkResult.HasInterrupted {
+		resumeLogger.Debug("no interrupted resources or links found")
+		return nil
+	}
+
+	requiresManualCleanup := []string{}
+	applyInput := &ApplyReconciliationInput{
+		InstanceID:      checkResult.InstanceID,
+		ResourceActions: []ResourceReconcileAction{},
+		LinkActions:     []LinkReconcileAction{},
+	}
+
+	for _, result := range checkResult.Resources {
+		if result.RecommendedAction == ReconciliationActionManualCleanupRequired {
+			requiresManualCleanup = append(
+				requiresManualCleanup,
+				buildChildPath(result.ChildPath, result.ResourceName),
+			)
+			continue
+		}
+
+		applyInput.ResourceActions = append(applyInput.ResourceActions, ResourceReconcileAction{
+			ResourceID:    result.ResourceID,
+			ChildPath:     result.ChildPath,
+			Action:        result.RecommendedAction,
+			ExternalState: result.ExternalState,
+			NewStatus:     result.NewStatus,
+		})
+	}
+
+	for _, result := range checkResult.Links {
+		if result.RecommendedAction == ReconciliationActionManualCleanupRequired {
+			requiresManualCleanup = append(
+				requiresManualCleanup,
+				buildChildPath(result.ChildPath, result.LinkName),
+			)
+			continue
+		}
+
+		applyInput.LinkActions = append(applyInput.LinkActions, LinkReconcileAction{
+			LinkID:          result.LinkID,
+			ChildPath:       result.ChildPath,
+			Action:          result.RecommendedAction,
+			NewStatus:       result.NewStatus,
+			LinkDataUpdates: result.LinkDataUpdates,
+		})
+	}
+
+	if len(requiresManualCleanup) > 0 {
+		return errResumeRequiresManualCleanup(checkResult.InstanceID, requiresManualCleanup)
+	}
+
+	resumeLogger.Info(
+		"reconciling interrupted resources and links",
+		core.IntegerLogField("resources", int64(len(applyInput.ResourceActions))),
+		core.IntegerLogField("links", int64(len(applyInput.LinkActions))),
+	)
+	applyResult, err := c.ApplyReconciliation(ctx, applyInput, paramOverrides)
+	if err != nil {
+		return err
+	}
+
+	if len(applyResult.Errors) > 0 {
+		return fmt.Errorf(
+			"failed to reconcile interrupted elements before resuming deployment: %s",
+			reconciliationErrorsSummary(applyResult.Errors),
+		)
+	}
+
+	return nil
+}
+
+func reconciliationErrorsSummary(reconciliationErrors []ReconciliationError) string {
+	summaries := make([]string, 0, len(reconciliationErrors))
+	for _, reconciliationErr := range reconciliationErrors {
+		summaries = append(
+			summaries,
+			fmt.Sprintf(
+				"%s %q: %s",
+				reconciliationErr.ElementType,
+				reconciliationErr.ElementName,
+				reconciliationErr.Error,
+			),
+		)
+	}
+
+	return strings.Join(summaries, "; ")
+}
@@ -0,0 +1,126 @@
+package container
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/drift"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (s *ContainerReconciliationTestSuite) Test_resume_deployment_returns_error_when_instance_id_is_empty() {
+	err := s.container.ResumeDeployment(
+		context.Background(),
+		&ResumeDeploymentInput{},
+		&DeployChannels{},
+		nil,
+	)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "instance ID is required")
+}
+
+func (s *ContainerReconciliationTestSuite) Test_resume_deployment_returns_error_when_interrupted_resources_require_manual_cleanup() {
+	s.driftChecker.checkInterruptedResults = []drift.ReconcileResult{
+		{
+			ResourceID:   "resource-1",
+			ResourceName: "ordersTable",
+			ResourceType: "aws/dynamodb/table",
+			OldStatus:    core.PreciseResourceStatusCreateInterrupted,
+			NewStatus:    core.PreciseResourceStatusCreateFailed,
+			// No external state indicates the resource could not be found
+			// in the upstream provider.
+		},
+	}
+
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-1": {
+				ResourceID:    "resource-1",
+				Name:          "ordersTable",
+				Type:          "aws/dynamodb/table",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreating,
+				PreciseStatus: core.PreciseResourceStatusCreateInterrupted,
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+
+	err = s.container.ResumeDeployment(
+		context.Background(),
+		&ResumeDeploymentInput{
+			InstanceID: testReconciliationInstanceID,
+		},
+		&DeployChannels{},
+		nil,
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeResumeRequiresManualCleanup, runErr.ReasonCode)
+	s.Contains(runErr.Error(), "ordersTable")
+
+	// State must not be modified when the deployment can not be resumed.
+	resourceState, err := s.stateContainer.Resources().Get(context.Background(), "resource-1")
+	s.Require().NoError(err)
+	s.Equal(core.PreciseResourceStatusCreateInterrupted, resourceState.PreciseStatus)
+}
+
+func (s *ContainerReconciliationTestSuite) Test_resume_deployment_reconciles_interrupted_elements() {
+	externalState := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"tableName": core.MappingNodeFromString("orders"),
+		},
+	}
+	s.driftChecker.checkInterruptedResults = []drift.ReconcileResult{
+		{
+			ResourceID:    "resource-1",
+			ResourceName:  "ordersTable",
+			ResourceType:  "aws/dynamodb/table",
+			OldStatus:     core.PreciseResourceStatusCreateInterrupted,
+			NewStatus:     core.PreciseResourceStatusCreated,
+			ExternalState: externalState,
+		},
+	}
+
+	err := s.populateTestState(
+		map[string]*state.ResourceState{
+			"resource-1": {
+				ResourceID:    "resource-1",
+				Name:          "ordersTable",
+				Type:          "aws/dynamodb/table",
+				InstanceID:    testReconciliationInstanceID,
+				Status:        core.ResourceStatusCreating,
+				PreciseStatus: core.PreciseResourceStatusCreateInterrupted,
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+
+	checkResult, err := s.container.CheckReconciliation(
+		context.Background(),
+		&CheckReconciliationInput{
+			InstanceID: testReconciliationInstanceID,
+			Scope:      ReconciliationScopeInterrupted,
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+
+	err = s.container.reconcileInterruptedElements(
+		context.Background(),
+		checkResult,
+		nil,
+		core.NewNopLogger(),
+	)
+	s.Require().NoError(err)
+
+	resourceState, err := s.stateContainer.Resources().Get(context.Background(), "resource-1")
+	s.Require().NoError(err)
+	s.Equal(core.PreciseResourceStatusCreated, resourceState.PreciseStatus)
+	s.Equal(core.ResourceStatusCreated, resourceState.Status)
+	s.Equal(externalState, resourceState.SpecData)
+}
@@ -0,0 +1,224 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// summaryRecorder is a deployment summary hook that passes summaries on to
+// a channel as summary hooks are run after the finished message
+// has been sent to the caller.
+type summaryRecorder struct {
+	summaries chan *DeploymentSummary
+}
+
+func newSummaryRecorder() *summaryRecorder {
+	return &summaryRecorder{
+		summaries: make(chan *DeploymentSummary, 1),
+	}
+}
+
+func (r *summaryRecorder) OnDeploymentSummary(
+	ctx context.Context,
+	summary *DeploymentSummary,
+) error {
+	r.summaries <- summary
+	return nil
+}
+
+func (r *summaryRecorder) next() (*DeploymentSummary, error) {
+	select {
+	case summary := <-r.summaries:
+		return summary, nil
+	case <-time.After(defaultDrainTimeout):
+		return nil, errors.New(timeoutMessage)
+	}
+}
+
+type failingSummaryHook struct{}
+
+func (h *failingSummaryHook) OnDeploymentSummary(
+	ctx context.Context,
+	summary *DeploymentSummary,
+) error {
+	return errors.New("changelog directory is not writable")
+}
+
+func (s *ContainerDeployTestSuite) Test_runs_summary_hooks_after_deploying_new_blueprint_instance() {
+	recorder := newSummaryRecorder()
+	blueprintContainer := s.blueprint2Fixture.blueprintContainer
+	// A failing summary hook must not prevent other summary hooks from being run.
+	blueprintContainer.Hooks().AfterRun(&failingSummaryHook{})
+	blueprintContainer.Hooks().AfterRun(recorder)
+
+	changes, changeStagingErr := s.stageChanges(
+		context.Background(),
+		/* instanceID */ "",
+		blueprintContainer,
+		s.fixture2Params,
+	)
+	s.Require().NoError(changeStagingErr)
+
+	channels := CreateDeployChannels()
+	err := blueprintContainer.Deploy(
+		context.Background(),
+		&DeployInput{
+			InstanceName: "BlueprintInstance2",
+			Changes:      changes,
+		},
+		channels,
+		s.fixture2Params,
+	)
+	s.Require().NoError(err)
+
+	finishedMessage, err := collectHookTestFinishedMessage(channels)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusDeployed, finishedMessage.Status)
+
+	summary, err := recorder.next()
+	s.Require().NoError(err)
+	s.Assert().Equal(DeploymentSummarySchemaVersion, summary.SchemaVersion)
+	s.Assert().Equal(DeploymentOperationDeploy, summary.Operation)
+	s.Assert().Equal(finishedMessage.InstanceID, summary.InstanceID)
+	s.Assert().Equal("BlueprintInstance2", summary.InstanceName)
+	s.Assert().Equal("DEPLOYED", summary.Status)
+	s.Assert().True(summary.Succeeded)
+	s.Assert().Empty(summary.Errors)
+	s.Assert().Same(changes, summary.Changes)
+	s.Assert().Equal(finishedMessage.FinishTimestamp, summary.FinishedAt)
+
+	// Resources of child blueprints are included in the summary
+	// with the ID of the child blueprint instance.
+	rootResources := summaryElementsForInstance(summary.Resources, summary.InstanceID)
+	s.Require().Len(rootResources, len(changes.NewResources))
+	for _, resource := range rootResources {
+		s.Assert().Contains(changes.NewResources, resource.Name)
+		s.Assert().Equal("CREATED", resource.Status)
+		s.Assert().NotEmpty(resource.ID)
+	}
+	s.Assert().Len(summary.Resources, len(rootResources)+1)
+}
+
+func (s *ContainerDestroyTestSuite) Test_runs_summary_hooks_after_destroying_blueprint_instance() {
+	recorder := newSummaryRecorder()
+	s.blueprint1Fixture.blueprintContainer.Hooks().AfterRun(recorder)
+
+	changes := blueprint1RemovalChanges()
+	channels := CreateDeployChannels()
+	s.blueprint1Fixture.blueprintContainer.Destroy(
+		context.Background(),
+		&DestroyInput{
+			InstanceID: "blueprint-instance-1",
+			Changes:    changes,
+		},
+		channels,
+		blueprintDestroyParams(),
+	)
+
+	finishedMessage, err := collectHookTestFinishedMessage(channels)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusDestroyed, finishedMessage.Status)
+
+	summary, err := recorder.next()
+	s.Require().NoError(err)
+	s.Assert().Equal(DeploymentOperationDestroy, summary.Operation)
+	s.Assert().Equal("blueprint-instance-1", summary.InstanceID)
+	s.Assert().Equal("BlueprintInstance1", summary.InstanceName)
+	s.Assert().Equal("DESTROYED", summary.Status)
+	s.Assert().True(summary.Succeeded)
+	s.Assert().Same(changes, summary.Changes)
+
+	rootResources := summaryElementsForInstance(summary.Resources, "blueprint-instance-1")
+	s.Require().Len(rootResources, len(changes.RemovedResources))
+	for _, resource := range rootResources {
+		s.Assert().Contains(changes.RemovedResources, resource.Name)
+		s.Assert().Equal("DESTROYED", resource.Status)
+	}
+
+	childResources := summaryElementsForInstance(
+		summary.Resources,
+		"blueprint-instance-1-child-core-infra",
+	)
+	s.Require().Len(childResources, 1)
+	s.Assert().Equal("complexResource", childResources[0].Name)
+	s.Assert().Equal("DESTROYED", childResources[0].Status)
+}
+
+func (s *ContainerDestroyTestSuite) Test_records_deployment_run_after_destroying_blueprint_instance() {
+	recorder := newSummaryRecorder()
+	blueprintContainer := s.blueprint1Fixture.blueprintContainer
+	// Summary hooks are run in the order they are registered so the run
+	// will have been saved by the time the summary is received by the recorder.
+	blueprintContainer.Hooks().AfterRun(
+		NewDeploymentRunRecorder(s.stateContainer.Instances(), core.NewUUIDGenerator()),
+	)
+	blueprintContainer.Hooks().AfterRun(recorder)
+
+	runMetadata := &state.DeploymentRunMetadata{
+		InitiatedBy:   "ops-user",
+		ClientVersion: "0.4.0",
+		EngineVersion: "0.6.0",
+	}
+	channels := CreateDeployChannels()
+	blueprintContainer.Destroy(
+		context.Background(),
+		&DestroyInput{
+			InstanceID:  "blueprint-instance-1",
+			Changes:     blueprint1RemovalChanges(),
+			RunMetadata: runMetadata,
+		},
+		channels,
+		blueprintDestroyParams(),
+	)
+
+	finishedMessage, err := collectHookTestFinishedMessage(channels)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusDestroyed, finishedMessage.Status)
+
+	summary, err := recorder.next()
+	s.Require().NoError(err)
+
+	result, err := s.stateContainer.Instances().ListRuns(
+		context.Background(),
+		"blueprint-instance-1",
+		state.ListRunsParams{},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(1, result.TotalCount)
+	run := result.Runs[0]
+	s.Assert().NotEmpty(run.ID)
+	s.Assert().Equal("blueprint-instance-1", run.InstanceID)
+	s.Assert().Equal("BlueprintInstance1", run.InstanceName)
+	s.Assert().Equal(state.DeploymentRunOperationDestroy, run.Operation)
+	s.Assert().Equal(runMetadata, run.Metadata)
+	s.Assert().Equal("DESTROYED", run.Status)
+	s.Assert().True(run.Succeeded)
+	s.Assert().Equal(summary.StartedAt, run.StartedAt)
+	s.Assert().Equal(summary.FinishedAt, run.FinishedAt)
+	s.Assert().Len(
+		run.Elements,
+		len(summary.Resources)+len(summary.Children)+len(summary.Links),
+	)
+	for _, element := range run.Elements {
+		if element.Type == state.DeploymentRunElementResource {
+			s.Assert().Equal("DESTROYED", element.Status)
+		}
+	}
+}
+
+func summaryElementsForInstance(
+	elements []*DeploymentSummaryElement,
+	instanceID string,
+) []*DeploymentSummaryElement {
+	instanceElements := []*DeploymentSummaryElement{}
+	for _, element := range elements {
+		if element.InstanceID == instanceID {
+			instanceElements = append(instanceElements, element)
+		}
+	}
+	return instanceElements
+}
@@ -0,0 +1,55 @@
+package container
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (c *defaultBlueprintContainer) TaintResource(
+	ctx context.Context,
+	instanceID string,
+	resourceName string,
+) error {
+	return c.setResourceTainted(ctx, instanceID, resourceName, true)
+}
+
+func (c *defaultBlueprintContainer) UntaintResource(
+	ctx context.Context,
+	instanceID string,
+	resourceName string,
+) error {
+	return c.setResourceTainted(ctx, instanceID, resourceName, false)
+}
+
+func (c *defaultBlueprintContainer) setResourceTainted(
+	ctx context.Context,
+	instanceID string,
+	resourceName string,
+	tainted bool,
+) error {
+	resources := c.stateContainer.Resources()
+	resourceState, err := resources.GetByName(ctx, instanceID, resourceName)
+	if err != nil {
+		return err
+	}
+
+	if resourceState.Tainted == tainted {
+		return nil
+	}
+
+	c.logger.Named("taintResource").Info(
+		"updating tainted flag for resource",
+		core.StringLogField("instanceId", instanceID),
+		core.StringLogField("resourceName", resourceName),
+		core.BoolLogField("tainted", tainted),
+	)
+
+	resourceState.Tainted = tainted
+	return resources.Save(ctx, resourceState)
+}
+
+func isResourceTainted(resourceState *state.ResourceState) bool {
+	return resourceState != nil && resourceState.Tainted
+}
@@ -0,0 +1,79 @@
+package container
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (s *ContainerDeployTestSuite) Test_stages_replacement_for_tainted_resource() {
+	blueprintContainer := s.blueprint1Fixture.blueprintContainer
+	err := blueprintContainer.TaintResource(
+		context.Background(),
+		"blueprint-instance-1",
+		"saveOrderFunction",
+	)
+	s.Require().NoError(err)
+
+	resourceState, err := s.stateContainer.Resources().GetByName(
+		context.Background(),
+		"blueprint-instance-1",
+		"saveOrderFunction",
+	)
+	s.Require().NoError(err)
+	s.Assert().True(resourceState.Tainted)
+
+	changes, err := s.stageChanges(
+		context.Background(),
+		"blueprint-instance-1",
+		blueprintContainer,
+		s.fixture1Params,
+	)
+	s.Require().NoError(err)
+
+	resourceChanges, hasResourceChanges := changes.ResourceChanges["saveOrderFunction"]
+	s.Require().True(hasResourceChanges)
+	s.Assert().True(resourceChanges.MustRecreate)
+	s.Assert().Contains(resourceChanges.RecreateTriggers, provider.RecreateTriggerTainted)
+}
+
+func (s *ContainerDeployTestSuite) Test_does_not_stage_replacement_for_untainted_resource() {
+	blueprintContainer := s.blueprint1Fixture.blueprintContainer
+	err := blueprintContainer.TaintResource(
+		context.Background(),
+		"blueprint-instance-1",
+		"saveOrderFunction",
+	)
+	s.Require().NoError(err)
+
+	err = blueprintContainer.UntaintResource(
+		context.Background(),
+		"blueprint-instance-1",
+		"saveOrderFunction",
+	)
+	s.Require().NoError(err)
+
+	changes, err := s.stageChanges(
+		context.Background(),
+		"blueprint-instance-1",
+		blueprintContainer,
+		s.fixture1Params,
+	)
+	s.Require().NoError(err)
+
+	resourceChanges := changes.ResourceChanges["saveOrderFunction"]
+	s.Assert().NotContains(resourceChanges.RecreateTriggers, provider.RecreateTriggerTainted)
+}
+
+func (s *ContainerDeployTestSuite) Test_fails_to_taint_resource_that_does_not_exist() {
+	err := s.blueprint1Fixture.blueprintContainer.TaintResource(
+		context.Background(),
+		"blueprint-instance-1",
+		"missingResource",
+	)
+	s.Require().Error(err)
+	stateErr, isStateErr := err.(*state.Error)
+	s.Require().True(isStateErr)
+	s.Assert().Equal(state.ErrResourceNotFound, stateErr.Code)
+}
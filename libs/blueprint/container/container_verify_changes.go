@@ -0,0 +1,263 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
+)
+
+// VerifyChangesInput contains the input needed to verify a set of staged changes
+// against the resource providers.
+type VerifyChangesInput struct {
+	// InstanceID is the ID of the blueprint instance that the changes will be applied to.
+	// If this is set, `InstanceName` must be empty.
+	InstanceID string
+	// InstanceName is the user-defined name of the blueprint instance
+	// that the changes will be applied to.
+	// If this is set, `InstanceID` must be empty.
+	InstanceName string
+	// Changes contains the staged changes to verify.
+	Changes *changes.BlueprintChanges
+}
+
+// VerifyChangesResult contains the results of verifying a set of staged changes.
+type VerifyChangesResult struct {
+	// Resources contains the verification results for each resource
+	// that will be created or updated, including resources in child blueprints.
+	Resources []ResourceVerifyResult `json:"resources"`
+}
+
+// HasFailures returns true if the provider reported an error
+// for at least one of the verified resources.
+func (r *VerifyChangesResult) HasFailures() bool {
+	return slices.ContainsFunc(r.Resources, func(result ResourceVerifyResult) bool {
+		return result.Status == ResourceVerifyStatusFailed
+	})
+}
+
+// ResourceVerifyResult contains the result of verifying the changes
+// for a single resource.
+type ResourceVerifyResult struct {
+	// ResourceName is the name of the resource in the blueprint.
+	ResourceName string `json:"resourceName"`
+	// ResourceType is the type of the resource.
+	ResourceType string `json:"resourceType"`
+	// ChildPath is the path to the child blueprint that the resource belongs to,
+	// (e.g. "childA.childB"), this will be empty for resources in the root blueprint.
+	ChildPath string `json:"childPath,omitempty"`
+	// Status is the outcome of verifying the changes for the resource.
+	Status ResourceVerifyStatus `json:"status"`
+	// Error holds the error reported by the provider when the status is "failed".
+	Error string `json:"error,omitempty"`
+}
+
+// ResourceVerifyStatus is the outcome of verifying the changes
+// for a resource.
+type ResourceVerifyStatus string
+
+const (
+	// ResourceVerifyStatusVerified indicates that the provider
+	// validated the changes for the resource without any errors.
+	ResourceVerifyStatusVerified ResourceVerifyStatus = "verified"
+	// ResourceVerifyStatusFailed indicates that the provider
+	// reported an error when validating the changes for the resource.
+	ResourceVerifyStatusFailed ResourceVerifyStatus = "failed"
+	// ResourceVerifyStatusUnsupported indicates that the resource type
+	// does not support dry run deployments so the changes could not be verified.
+	ResourceVerifyStatusUnsupported ResourceVerifyStatus = "unsupported"
+)
+
+func (c *defaultBlueprintContainer) VerifyChanges(
+	ctx context.Context,
+	input *VerifyChangesInput,
+	paramOverrides core.BlueprintParams,
+) (*VerifyChangesResult, error) {
+	if input == nil || input.Changes == nil {
+		return nil, errors.New("changes are required to verify staged changes")
+	}
+
+	instanceID, err := c.getInstanceID(ctx, input.InstanceID, input.InstanceName)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceRegistry := c.resourceRegistry.WithParams(paramOverrides)
+	resourcesToVerify := collectResourcesToVerify(input.Changes)
+	result := &VerifyChangesResult{
+		Resources: make([]ResourceVerifyResult, 0, len(resourcesToVerify)),
+	}
+	for _, resource := range resourcesToVerify {
+		verifyResult, err := c.verifyResourceChanges(
+			ctx,
+			resourceRegistry,
+			instanceID,
+			input.InstanceName,
+			resource,
+			paramOverrides,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result.Resources = append(result.Resources, verifyResult)
+	}
+
+	return result, nil
+}
+
+func (c *defaultBlueprintContainer) verifyResourceChanges(
+	ctx context.Context,
+	resourceRegistry resourcehelpers.Registry,
+	instanceID string,
+	instanceName string,
+	resource *resourceToVerify,
+	paramOverrides core.BlueprintParams,
+) (ResourceVerifyResult, error) {
+	result := ResourceVerifyResult{
+		ResourceName: resource.name,
+		ResourceType: resource.resourceType,
+		ChildPath:    resource.childPath,
+	}
+
+	providerNamespace := provider.ExtractProviderFromItemType(resource.resourceType)
+	providerCtx := provider.NewProviderContextFromParams(providerNamespace, paramOverrides)
+	specDefOutput, err := resourceRegistry.GetSpecDefinition(
+		ctx,
+		resource.resourceType,
+		&provider.ResourceGetSpecDefinitionInput{
+			ProviderContext: providerCtx,
+		},
+	)
+	if err != nil {
+		return result, err
+	}
+
+	if specDefOutput == nil ||
+		specDefOutput.SpecDefinition == nil ||
+		!specDefOutput.SpecDefinition.SupportsDryRun {
+		result.Status = ResourceVerifyStatusUnsupported
+		return result, nil
+	}
+
+	_, err = resourceRegistry.Deploy(
+		ctx,
+		resource.resourceType,
+		&provider.ResourceDeployServiceInput{
+			DeployInput: &provider.ResourceDeployInput{
+				InstanceID:      instanceID,
+				InstanceName:    instanceName,
+				ResourceID:      resource.changes.AppliedResourceInfo.ResourceID,
+				Changes:         resource.changes,
+				ProviderContext: providerCtx,
+				DryRun:          true,
+			},
+		},
+	)
+	if err != nil {
+		c.logger.Debug(
+			"provider reported an error for dry run deployment of resource",
+			core.StringLogField("resourceName", resource.name),
+			core.StringLogField("childPath", resource.childPath),
+			core.ErrorLogField("error", err),
+		)
+		result.Status = ResourceVerifyStatusFailed
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	result.Status = ResourceVerifyStatusVerified
+	return result, nil
+}
+
+type resourceToVerify struct {
+	name         string
+	resourceType string
+	childPath    string
+	changes      *provider.Changes
+}
+
+// collectResourcesToVerify collects the resources that will be created
+// or updated for the provided changes, including resources in new and
+// existing child blueprints.
+// Resources are ordered by child path and then by name to produce
+// consistent verification results.
+func collectResourcesToVerify(blueprintChanges *changes.BlueprintChanges) []*resourceToVerify {
+	resources := []*resourceToVerify{}
+	collectChangedResourcesToVerify(blueprintChanges, "", &resources)
+
+	slices.SortFunc(resources, func(a, b *resourceToVerify) int {
+		if a.childPath != b.childPath {
+			return strings.Compare(a.childPath, b.childPath)
+		}
+		return strings.Compare(a.name, b.name)
+	})
+	return resources
+}
+
+func collectChangedResourcesToVerify(
+	blueprintChanges *changes.BlueprintChanges,
+	childPath string,
+	collected *[]*resourceToVerify,
+) {
+	addResourcesToVerify(blueprintChanges.NewResources, childPath, collected)
+	addResourcesToVerify(blueprintChanges.ResourceChanges, childPath, collected)
+
+	for childName, newChild := range blueprintChanges.NewChildren {
+		collectNewResourcesToVerify(&newChild, buildChildPath(childPath, childName), collected)
+	}
+
+	for childName, childChanges := range blueprintChanges.ChildChanges {
+		collectChangedResourcesToVerify(&childChanges, buildChildPath(childPath, childName), collected)
+	}
+}
+
+func collectNewResourcesToVerify(
+	newChild *changes.NewBlueprintDefinition,
+	childPath string,
+	collected *[]*resourceToVerify,
+) {
+	addResourcesToVerify(newChild.NewResources, childPath, collected)
+
+	for childName, grandchild := range newChild.NewChildren {
+		collectNewResourcesToVerify(&grandchild, buildChildPath(childPath, childName), collected)
+	}
+}
+
+func addResourcesToVerify(
+	resourceChanges map[string]provider.Changes,
+	childPath string,
+	collected *[]*resourceToVerify,
+) {
+	for resourceName, changes := range resourceChanges {
+		resourceType := getResourceTypeFromChanges(&changes)
+		if resourceType == "" {
+			continue
+		}
+
+		*collected = append(*collected, &resourceToVerify{
+			name:         resourceName,
+			resourceType: resourceType,
+			childPath:    childPath,
+			changes:      &changes,
+		})
+	}
+}
+
+func getResourceTypeFromChanges(changes *provider.Changes) string {
+	resolvedResource := changes.AppliedResourceInfo.ResourceWithResolvedSubs
+	if resolvedResource != nil && resolvedResource.Type != nil {
+		return resolvedResource.Type.Value
+	}
+
+	currentState := changes.AppliedResourceInfo.CurrentResourceState
+	if currentState != nil {
+		return currentState.Type
+	}
+
+	return ""
+}
@@ -0,0 +1,189 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/memstate"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/transform"
+	"github.com/stretchr/testify/suite"
+)
+
+type ContainerVerifyChangesTestSuite struct {
+	suite.Suite
+	stateContainer state.Container
+	tableResource  *dryRunRecordingResource
+	container      *defaultBlueprintContainer
+}
+
+func (s *ContainerVerifyChangesTestSuite) SetupTest() {
+	s.stateContainer = memstate.NewMemoryStateContainer()
+	s.tableResource = &dryRunRecordingResource{
+		DynamoDBTableResource: &internal.DynamoDBTableResource{},
+		deployErrors:          map[string]error{},
+	}
+	s.container = &defaultBlueprintContainer{
+		stateContainer: s.stateContainer,
+		resourceRegistry: resourcehelpers.NewRegistry(
+			map[string]provider.Provider{
+				"aws": &internal.ProviderMock{
+					NamespaceValue: "aws",
+					Resources: map[string]provider.Resource{
+						"aws/dynamodb/table":  s.tableResource,
+						"aws/lambda/function": &internal.LambdaFunctionResource{},
+					},
+				},
+			},
+			map[string]transform.SpecTransformer{},
+			10*time.Millisecond,
+			s.stateContainer,
+			/* params */ nil,
+		),
+		clock:  core.SystemClock{},
+		logger: core.NewNopLogger(),
+	}
+}
+
+func (s *ContainerVerifyChangesTestSuite) Test_returns_error_when_changes_are_not_provided() {
+	_, err := s.container.VerifyChanges(
+		context.Background(),
+		&VerifyChangesInput{},
+		nil,
+	)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "changes are required")
+}
+
+func (s *ContainerVerifyChangesTestSuite) Test_verifies_resources_that_support_dry_runs() {
+	s.tableResource.deployErrors["failingTable"] = errors.New("quota exceeded for tables")
+
+	result, err := s.container.VerifyChanges(
+		context.Background(),
+		&VerifyChangesInput{
+			InstanceID: "instance-1",
+			Changes: &changes.BlueprintChanges{
+				NewResources: map[string]provider.Changes{
+					"ordersTable":   verifyTestResourceChanges("ordersTable", "aws/dynamodb/table"),
+					"failingTable":  verifyTestResourceChanges("failingTable", "aws/dynamodb/table"),
+					"ordersHandler": verifyTestResourceChanges("ordersHandler", "aws/lambda/function"),
+				},
+				ChildChanges: map[string]changes.BlueprintChanges{
+					"coreInfra": {
+						ResourceChanges: map[string]provider.Changes{
+							"usersTable": verifyTestResourceChanges("usersTable", "aws/dynamodb/table"),
+						},
+						NewChildren: map[string]changes.NewBlueprintDefinition{
+							"networking": {
+								NewResources: map[string]provider.Changes{
+									"routesTable": verifyTestResourceChanges("routesTable", "aws/dynamodb/table"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		nil,
+	)
+	s.Require().NoError(err)
+	s.True(result.HasFailures())
+	s.Equal(
+		[]ResourceVerifyResult{
+			{
+				ResourceName: "failingTable",
+				ResourceType: "aws/dynamodb/table",
+				Status:       ResourceVerifyStatusFailed,
+				Error:        "quota exceeded for tables",
+			},
+			{
+				ResourceName: "ordersHandler",
+				ResourceType: "aws/lambda/function",
+				Status:       ResourceVerifyStatusUnsupported,
+			},
+			{
+				ResourceName: "ordersTable",
+				ResourceType: "aws/dynamodb/table",
+				Status:       ResourceVerifyStatusVerified,
+			},
+			{
+				ResourceName: "usersTable",
+				ResourceType: "aws/dynamodb/table",
+				ChildPath:    "coreInfra",
+				Status:       ResourceVerifyStatusVerified,
+			},
+			{
+				ResourceName: "routesTable",
+				ResourceType: "aws/dynamodb/table",
+				ChildPath:    "coreInfra.networking",
+				Status:       ResourceVerifyStatusVerified,
+			},
+		},
+		result.Resources,
+	)
+
+	s.Require().Len(s.tableResource.deployInputs, 4)
+	for _, input := range s.tableResource.deployInputs {
+		s.True(input.DryRun)
+		s.Equal("instance-1", input.InstanceID)
+	}
+}
+
+func verifyTestResourceChanges(resourceName string, resourceType string) provider.Changes {
+	return provider.Changes{
+		AppliedResourceInfo: provider.ResourceInfo{
+			ResourceName: resourceName,
+			ResourceWithResolvedSubs: &provider.ResolvedResource{
+				Type: &schema.ResourceTypeWrapper{
+					Value: resourceType,
+				},
+			},
+		},
+	}
+}
+
+func TestContainerVerifyChangesTestSuite(t *testing.T) {
+	suite.Run(t, new(ContainerVerifyChangesTestSuite))
+}
+
+// dryRunRecordingResource is a resource that supports dry run deployments
+// and records the inputs for deployments to verify dry runs are requested.
+type dryRunRecordingResource struct {
+	*internal.DynamoDBTableResource
+	deployErrors map[string]error
+	deployInputs []*provider.ResourceDeployInput
+}
+
+func (r *dryRunRecordingResource) GetSpecDefinition(
+	ctx context.Context,
+	input *provider.ResourceGetSpecDefinitionInput,
+) (*provider.ResourceGetSpecDefinitionOutput, error) {
+	output, err := r.DynamoDBTableResource.GetSpecDefinition(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	output.SpecDefinition.SupportsDryRun = true
+	return output, nil
+}
+
+func (r *dryRunRecordingResource) Deploy(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	r.deployInputs = append(r.deployInputs, input)
+	deployErr, hasErr := r.deployErrors[input.Changes.AppliedResourceInfo.ResourceName]
+	if hasErr {
+		return nil, deployErr
+	}
+
+	return &provider.ResourceDeployOutput{}, nil
+}
@@ -0,0 +1,331 @@
+package container
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// costEstimationContext holds the cost estimators that have been looked up
+// for resource types while estimating the cost of a change set,
+// a nil value indicates the resource type does not support cost estimation.
+type costEstimationContext struct {
+	estimators     map[string]provider.CostEstimator
+	paramOverrides core.BlueprintParams
+}
+
+// stagedChangesCostEstimate produces an estimate of the change in monthly cost
+// for staged changes to a new or existing blueprint instance.
+func (c *defaultBlueprintContainer) stagedChangesCostEstimate(
+	ctx context.Context,
+	instanceID string,
+	blueprintChanges *changes.BlueprintChanges,
+	paramOverrides core.BlueprintParams,
+) *changes.CostEstimate {
+	if instanceID == "" {
+		return c.estimateChangesCost(ctx, nil, blueprintChanges, paramOverrides)
+	}
+
+	instanceState, err := c.stateContainer.Instances().Get(ctx, instanceID)
+	if err != nil {
+		return c.estimateChangesCost(ctx, nil, blueprintChanges, paramOverrides)
+	}
+
+	return c.estimateChangesCost(ctx, &instanceState, blueprintChanges, paramOverrides)
+}
+
+// estimateChangesCost produces an estimate of the change in monthly cost for
+// the resources that will be created, updated and destroyed when deploying
+// the provided changes.
+// Estimates for child blueprints are taken from the cost estimates produced
+// when staging changes for the child blueprints, except for removed child blueprints
+// where the cost of resources is estimated from the current instance state.
+func (c *defaultBlueprintContainer) estimateChangesCost(
+	ctx context.Context,
+	instanceState *state.InstanceState,
+	blueprintChanges *changes.BlueprintChanges,
+	paramOverrides core.BlueprintParams,
+) *changes.CostEstimate {
+	estimationCtx := &costEstimationContext{
+		estimators:     map[string]provider.CostEstimator{},
+		paramOverrides: paramOverrides,
+	}
+	resources := []*changes.ResourceCostEstimate{}
+
+	for resourceName, resourceChanges := range blueprintChanges.NewResources {
+		resolvedResource := resourceChanges.AppliedResourceInfo.ResourceWithResolvedSubs
+		if resolvedResource == nil || resolvedResource.Type == nil {
+			continue
+		}
+		estimate := c.estimateResourceCost(
+			ctx,
+			estimationCtx,
+			resourceName,
+			resolvedResource.Type.Value,
+			changes.PlanActionCreate,
+			/* prevSpec */ nil,
+			resolvedResource.Spec,
+		)
+		resources = appendCostEstimate(resources, estimate)
+	}
+
+	for resourceName, resourceChanges := range blueprintChanges.ResourceChanges {
+		resourceType, prevSpec := currentResourceTypeAndSpec(&resourceChanges)
+		var newSpec *core.MappingNode
+		if resolvedResource := resourceChanges.AppliedResourceInfo.ResourceWithResolvedSubs; resolvedResource != nil {
+			newSpec = resolvedResource.Spec
+		}
+		action := changes.PlanActionUpdate
+		if resourceChanges.MustRecreate {
+			action = changes.PlanActionRecreate
+		}
+		estimate := c.estimateResourceCost(
+			ctx,
+			estimationCtx,
+			resourceName,
+			resourceType,
+			action,
+			prevSpec,
+			newSpec,
+		)
+		resources = appendCostEstimate(resources, estimate)
+	}
+
+	if instanceState != nil {
+		for _, resourceName := range blueprintChanges.RemovedResources {
+			resourceState := findResourceByName(instanceState.Resources, resourceName)
+			if resourceState == nil {
+				continue
+			}
+			resources = appendCostEstimate(
+				resources,
+				c.estimateRemovedResourceCost(ctx, estimationCtx, resourceState),
+			)
+		}
+
+		for _, childName := range blueprintChanges.RemovedChildren {
+			childState, hasChild := instanceState.ChildBlueprints[childName]
+			if !hasChild {
+				continue
+			}
+			resources = append(
+				resources,
+				changes.ChildResourceCostEstimates(
+					childName,
+					changes.NewCostEstimate(
+						c.estimateRemovedInstanceResourcesCost(ctx, estimationCtx, childState, 0),
+					),
+				)...,
+			)
+		}
+	}
+
+	for childName, newChild := range blueprintChanges.NewChildren {
+		resources = append(
+			resources,
+			changes.ChildResourceCostEstimates(childName, newChild.CostEstimate)...,
+		)
+	}
+
+	for childName, childChanges := range blueprintChanges.ChildChanges {
+		resources = append(
+			resources,
+			changes.ChildResourceCostEstimates(childName, childChanges.CostEstimate)...,
+		)
+	}
+
+	return changes.NewCostEstimate(resources)
+}
+
+// estimateRemovedInstanceResourcesCost estimates the cost of all the resources
+// in a blueprint instance that is being removed, including resources in descendant
+// child blueprints.
+func (c *defaultBlueprintContainer) estimateRemovedInstanceResourcesCost(
+	ctx context.Context,
+	estimationCtx *costEstimationContext,
+	instanceState *state.InstanceState,
+	depth int,
+) []*changes.ResourceCostEstimate {
+	resources := []*changes.ResourceCostEstimate{}
+	for _, resourceState := range instanceState.Resources {
+		if resourceRemovalPolicy(resourceState) == string(schema.RemovalPolicyRetain) {
+			continue
+		}
+		resources = appendCostEstimate(
+			resources,
+			c.estimateRemovedResourceCost(ctx, estimationCtx, resourceState),
+		)
+	}
+
+	if depth >= MaxBlueprintDepth {
+		return resources
+	}
+
+	for childName, childState := range instanceState.ChildBlueprints {
+		resources = append(
+			resources,
+			changes.ChildResourceCostEstimates(
+				childName,
+				changes.NewCostEstimate(
+					c.estimateRemovedInstanceResourcesCost(ctx, estimationCtx, childState, depth+1),
+				),
+			)...,
+		)
+	}
+
+	return resources
+}
+
+func (c *defaultBlueprintContainer) estimateRemovedResourceCost(
+	ctx context.Context,
+	estimationCtx *costEstimationContext,
+	resourceState *state.ResourceState,
+) *changes.ResourceCostEstimate {
+	return c.estimateResourceCost(
+		ctx,
+		estimationCtx,
+		resourceState.Name,
+		resourceState.Type,
+		changes.PlanActionDelete,
+		resourceState.SpecData,
+		/* newSpec */ nil,
+	)
+}
+
+// estimateResourceCost estimates the cost of a resource before and after
+// deploying a change set, this returns nil when the resource type
+// does not support cost estimation.
+func (c *defaultBlueprintContainer) estimateResourceCost(
+	ctx context.Context,
+	estimationCtx *costEstimationContext,
+	resourceName string,
+	resourceType string,
+	action changes.PlanAction,
+	prevSpec *core.MappingNode,
+	newSpec *core.MappingNode,
+) *changes.ResourceCostEstimate {
+	estimator := c.costEstimator(ctx, estimationCtx, resourceType)
+	if estimator == nil {
+		return nil
+	}
+
+	estimate := &changes.ResourceCostEstimate{
+		ResourceName: resourceName,
+		ResourceType: resourceType,
+		Action:       action,
+	}
+	providerCtx := provider.NewProviderContextFromParams(
+		provider.ExtractProviderFromItemType(resourceType),
+		estimationCtx.paramOverrides,
+	)
+
+	if action != changes.PlanActionCreate {
+		prevCost, err := estimateMonthlyCost(ctx, estimator, resourceName, resourceType, prevSpec, providerCtx)
+		if err != nil {
+			c.logCostEstimationError(resourceName, resourceType, err)
+			estimate.Error = err.Error()
+			return estimate
+		}
+		estimate.PrevMonthlyCost = prevCost
+	}
+
+	if action != changes.PlanActionDelete {
+		newCost, err := estimateMonthlyCost(ctx, estimator, resourceName, resourceType, newSpec, providerCtx)
+		if err != nil {
+			c.logCostEstimationError(resourceName, resourceType, err)
+			estimate.Error = err.Error()
+			return estimate
+		}
+		estimate.NewMonthlyCost = newCost
+	}
+
+	return estimate
+}
+
+func (c *defaultBlueprintContainer) costEstimator(
+	ctx context.Context,
+	estimationCtx *costEstimationContext,
+	resourceType string,
+) provider.CostEstimator {
+	if estimator, checked := estimationCtx.estimators[resourceType]; checked {
+		return estimator
+	}
+
+	var estimator provider.CostEstimator
+	resourceProvider, hasProvider := c.providers[provider.ExtractProviderFromItemType(resourceType)]
+	if hasProvider {
+		resourceImpl, err := resourceProvider.Resource(ctx, resourceType)
+		if err == nil && resourceImpl != nil {
+			estimator, _ = resourceImpl.(provider.CostEstimator)
+		}
+	}
+
+	estimationCtx.estimators[resourceType] = estimator
+	return estimator
+}
+
+func (c *defaultBlueprintContainer) logCostEstimationError(
+	resourceName string,
+	resourceType string,
+	err error,
+) {
+	c.logger.Debug(
+		"failed to estimate cost for resource",
+		core.StringLogField("resourceName", resourceName),
+		core.StringLogField("resourceType", resourceType),
+		core.ErrorLogField("error", err),
+	)
+}
+
+func estimateMonthlyCost(
+	ctx context.Context,
+	estimator provider.CostEstimator,
+	resourceName string,
+	resourceType string,
+	specData *core.MappingNode,
+	providerCtx provider.Context,
+) (float64, error) {
+	output, err := estimator.EstimateMonthlyCost(ctx, &provider.ResourceCostEstimateInput{
+		ResourceType:    resourceType,
+		ResourceName:    resourceName,
+		SpecData:        specData,
+		ProviderContext: providerCtx,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if output == nil {
+		return 0, nil
+	}
+
+	return output.MonthlyCost, nil
+}
+
+func currentResourceTypeAndSpec(resourceChanges *provider.Changes) (string, *core.MappingNode) {
+	currentState := resourceChanges.AppliedResourceInfo.CurrentResourceState
+	if currentState != nil {
+		return currentState.Type, currentState.SpecData
+	}
+
+	resolvedResource := resourceChanges.AppliedResourceInfo.ResourceWithResolvedSubs
+	if resolvedResource != nil && resolvedResource.Type != nil {
+		return resolvedResource.Type.Value, nil
+	}
+
+	return "", nil
+}
+
+func appendCostEstimate(
+	resources []*changes.ResourceCostEstimate,
+	estimate *changes.ResourceCostEstimate,
+) []*changes.ResourceCostEstimate {
+	if estimate == nil {
+		return resources
+	}
+	return append(resources, estimate)
+}
@@ -0,0 +1,210 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type CostEstimationTestSuite struct {
+	suite.Suite
+	container *defaultBlueprintContainer
+}
+
+func (s *CostEstimationTestSuite) SetupTest() {
+	s.container = &defaultBlueprintContainer{
+		providers: map[string]provider.Provider{
+			"test": &internal.ProviderMock{
+				NamespaceValue: "test",
+				Resources: map[string]provider.Resource{
+					"test/database": &costEstimatingResource{},
+					"test/function": &importableFunctionResource{},
+				},
+			},
+		},
+		logger: core.NewNopLogger(),
+	}
+}
+
+func (s *CostEstimationTestSuite) Test_estimates_cost_for_created_updated_and_destroyed_resources() {
+	currentState := &state.InstanceState{
+		InstanceID: "instance-1",
+		Resources: map[string]*state.ResourceState{
+			"orders-db-id": costTestResourceState("ordersDatabase", "test/database", 2),
+			"legacy-db-id": costTestResourceState("legacyDatabase", "test/database", 4),
+			"handler-id":   costTestResourceState("handler", "test/function", 1),
+		},
+	}
+
+	estimate := s.container.estimateChangesCost(
+		context.Background(),
+		currentState,
+		&changes.BlueprintChanges{
+			NewResources: map[string]provider.Changes{
+				"analyticsDatabase": costTestNewResourceChanges("test/database", 3),
+				"newHandler":        costTestNewResourceChanges("test/function", 1),
+			},
+			ResourceChanges: map[string]provider.Changes{
+				"ordersDatabase": {
+					AppliedResourceInfo: provider.ResourceInfo{
+						CurrentResourceState:     currentState.Resources["orders-db-id"],
+						ResourceWithResolvedSubs: costTestResolvedResource("test/database", 5),
+					},
+				},
+			},
+			RemovedResources: []string{"legacyDatabase", "handler"},
+			ChildChanges: map[string]changes.BlueprintChanges{
+				"networking": {
+					CostEstimate: changes.NewCostEstimate([]*changes.ResourceCostEstimate{
+						{
+							ResourceName:   "natGateway",
+							ResourceType:   "test/natGateway",
+							Action:         changes.PlanActionCreate,
+							NewMonthlyCost: 32,
+						},
+					}),
+				},
+			},
+		},
+		nil,
+	)
+
+	s.Require().NotNil(estimate)
+	s.Equal(62.0, estimate.CreateMonthlyCost)
+	s.Equal(30.0, estimate.UpdateMonthlyCostChange)
+	s.Equal(40.0, estimate.DestroyMonthlyCost)
+	s.Equal(52.0, estimate.MonthlyCostChange)
+	s.Equal(
+		[]*changes.ResourceCostEstimate{
+			{
+				ResourceName:   "analyticsDatabase",
+				ResourceType:   "test/database",
+				Action:         changes.PlanActionCreate,
+				NewMonthlyCost: 30,
+			},
+			{
+				ResourceName:    "legacyDatabase",
+				ResourceType:    "test/database",
+				Action:          changes.PlanActionDelete,
+				PrevMonthlyCost: 40,
+			},
+			{
+				ResourceName:    "ordersDatabase",
+				ResourceType:    "test/database",
+				Action:          changes.PlanActionUpdate,
+				PrevMonthlyCost: 20,
+				NewMonthlyCost:  50,
+			},
+			{
+				ChildPath:      "networking",
+				ResourceName:   "natGateway",
+				ResourceType:   "test/natGateway",
+				Action:         changes.PlanActionCreate,
+				NewMonthlyCost: 32,
+			},
+		},
+		estimate.Resources,
+	)
+}
+
+func (s *CostEstimationTestSuite) Test_reports_resources_that_failed_cost_estimation() {
+	estimate := s.container.estimateChangesCost(
+		context.Background(),
+		nil,
+		&changes.BlueprintChanges{
+			NewResources: map[string]provider.Changes{
+				"ordersDatabase": costTestNewResourceChanges("test/database", 0),
+			},
+		},
+		nil,
+	)
+
+	s.Require().NotNil(estimate)
+	s.Equal(0.0, estimate.MonthlyCostChange)
+	s.Require().Len(estimate.Resources, 1)
+	s.Equal("instance size is required to estimate cost", estimate.Resources[0].Error)
+}
+
+func (s *CostEstimationTestSuite) Test_produces_no_estimate_when_resource_types_do_not_support_estimation() {
+	estimate := s.container.estimateChangesCost(
+		context.Background(),
+		nil,
+		&changes.BlueprintChanges{
+			NewResources: map[string]provider.Changes{
+				"handler": costTestNewResourceChanges("test/function", 1),
+				"widget":  costTestNewResourceChanges("other/widget", 1),
+			},
+		},
+		nil,
+	)
+
+	s.Nil(estimate)
+}
+
+func costTestSpec(instanceSize int) *core.MappingNode {
+	return &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"instanceSize": core.MappingNodeFromInt(instanceSize),
+		},
+	}
+}
+
+func costTestResolvedResource(resourceType string, instanceSize int) *provider.ResolvedResource {
+	return &provider.ResolvedResource{
+		Type: &schema.ResourceTypeWrapper{Value: resourceType},
+		Spec: costTestSpec(instanceSize),
+	}
+}
+
+func costTestNewResourceChanges(resourceType string, instanceSize int) provider.Changes {
+	return provider.Changes{
+		AppliedResourceInfo: provider.ResourceInfo{
+			ResourceWithResolvedSubs: costTestResolvedResource(resourceType, instanceSize),
+		},
+	}
+}
+
+func costTestResourceState(
+	resourceName string,
+	resourceType string,
+	instanceSize int,
+) *state.ResourceState {
+	return &state.ResourceState{
+		ResourceID: resourceName + "-id",
+		Name:       resourceName,
+		Type:       resourceType,
+		SpecData:   costTestSpec(instanceSize),
+	}
+}
+
+// costEstimatingResource is a resource implementation that estimates
+// a monthly cost of 10 for each unit of instance size.
+type costEstimatingResource struct {
+	provider.Resource
+}
+
+func (r *costEstimatingResource) EstimateMonthlyCost(
+	ctx context.Context,
+	input *provider.ResourceCostEstimateInput,
+) (*provider.ResourceCostEstimateOutput, error) {
+	instanceSize := core.IntValue(input.SpecData.Fields["instanceSize"])
+	if instanceSize == 0 {
+		return nil, errors.New("instance size is required to estimate cost")
+	}
+
+	return &provider.ResourceCostEstimateOutput{
+		MonthlyCost: float64(instanceSize * 10),
+	}, nil
+}
+
+func TestCostEstimationTestSuite(t *testing.T) {
+	suite.Run(t, new(CostEstimationTestSuite))
+}
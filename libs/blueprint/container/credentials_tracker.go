@@ -0,0 +1,229 @@
+package container
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+const (
+	// CredentialsRefreshThreshold is the remaining lifetime of provider credentials
+	// at which credentials will be refreshed before carrying out an operation
+	// in the middle of a deployment.
+	// This is also used as a safety margin on top of the estimated duration
+	// of a deployment when checking credentials before a deployment starts.
+	CredentialsRefreshThreshold = 5 * time.Minute
+)
+
+// CredentialsTracker keeps track of the expiry of credentials for providers
+// that implement provider.CredentialsRefresher for the duration of a deployment,
+// refreshing credentials that are close to expiry.
+// Providers that do not implement provider.CredentialsRefresher are ignored.
+type CredentialsTracker struct {
+	providers      map[string]provider.Provider
+	paramOverrides core.BlueprintParams
+	clock          core.Clock
+	logger         core.Logger
+	// A mapping of provider namespaces to the expiry time of the credentials
+	// for the provider, a nil value indicates the credentials do not expire.
+	expiries map[string]*time.Time
+	// A mapping of provider namespaces to the credentials refresher for the provider,
+	// a nil value indicates the provider does not support refreshing credentials.
+	refreshers map[string]provider.CredentialsRefresher
+	mu         sync.Mutex
+}
+
+func newCredentialsTracker(
+	providers map[string]provider.Provider,
+	paramOverrides core.BlueprintParams,
+	clock core.Clock,
+	logger core.Logger,
+) *CredentialsTracker {
+	return &CredentialsTracker{
+		providers:      providers,
+		paramOverrides: paramOverrides,
+		clock:          clock,
+		logger:         logger,
+		expiries:       map[string]*time.Time{},
+		refreshers:     map[string]provider.CredentialsRefresher{},
+	}
+}
+
+// Preflight checks that the credentials for the provided provider namespaces
+// will remain valid for the estimated duration of a deployment,
+// refreshing credentials that will expire before the deployment is expected
+// to complete.
+// A warning is logged for each provider where the credentials could not be refreshed
+// or where the refreshed credentials will still expire before the deployment
+// is expected to complete.
+func (t *CredentialsTracker) Preflight(
+	ctx context.Context,
+	providerNamespaces []string,
+	estimatedDuration time.Duration,
+) {
+	if t == nil {
+		return
+	}
+
+	for _, providerNamespace := range providerNamespaces {
+		expiry, err := t.ensureValidFor(
+			ctx,
+			providerNamespace,
+			estimatedDuration+CredentialsRefreshThreshold,
+		)
+		if err != nil {
+			t.logger.Warn(
+				"failed to refresh provider credentials that expire before the "+
+					"estimated completion of the deployment",
+				core.StringLogField("provider", providerNamespace),
+				core.StringLogField("estimatedDuration", estimatedDuration.String()),
+				core.ErrorLogField("error", err),
+			)
+			continue
+		}
+
+		if expiry != nil && expiry.Before(t.clock.Now().Add(estimatedDuration)) {
+			t.logger.Warn(
+				"provider credentials expire before the estimated completion of the deployment",
+				core.StringLogField("provider", providerNamespace),
+				core.StringLogField("estimatedDuration", estimatedDuration.String()),
+				core.StringLogField("expiresAt", expiry.Format(time.RFC3339)),
+			)
+		}
+	}
+}
+
+// RefreshIfExpiring refreshes the credentials for the provider with the given
+// namespace when they will expire within the CredentialsRefreshThreshold.
+// This is to be called before carrying out operations with a provider
+// in the middle of a deployment.
+// Failures to refresh credentials are logged as warnings and do not prevent
+// the operation from being attempted, if the credentials have expired,
+// the provider operation will fail and be reported as such.
+func (t *CredentialsTracker) RefreshIfExpiring(
+	ctx context.Context,
+	providerNamespace string,
+) {
+	if t == nil {
+		return
+	}
+
+	_, err := t.ensureValidFor(ctx, providerNamespace, CredentialsRefreshThreshold)
+	if err != nil {
+		t.logger.Warn(
+			"failed to refresh provider credentials that are close to expiry",
+			core.StringLogField("provider", providerNamespace),
+			core.ErrorLogField("error", err),
+		)
+	}
+}
+
+// ensureValidFor makes sure the credentials for the provider with the given namespace
+// remain valid for at least the provided duration, refreshing them when they do not.
+// This returns the expiry time of the credentials after any refresh has taken place.
+func (t *CredentialsTracker) ensureValidFor(
+	ctx context.Context,
+	providerNamespace string,
+	validFor time.Duration,
+) (*time.Time, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	refresher := t.refresher(providerNamespace)
+	if refresher == nil {
+		return nil, nil
+	}
+
+	providerCtx := provider.NewProviderContextFromParams(
+		providerNamespace,
+		t.paramOverrides,
+	)
+	expiry, checked := t.expiries[providerNamespace]
+	if !checked {
+		currentExpiry, err := refresher.CredentialsExpiry(ctx, providerCtx)
+		if err != nil {
+			return nil, err
+		}
+		expiry = currentExpiry
+		t.expiries[providerNamespace] = expiry
+	}
+
+	if expiry == nil || expiry.After(t.clock.Now().Add(validFor)) {
+		return expiry, nil
+	}
+
+	t.logger.Info(
+		"refreshing provider credentials that are close to expiry",
+		core.StringLogField("provider", providerNamespace),
+		core.StringLogField("expiresAt", expiry.Format(time.RFC3339)),
+	)
+	newExpiry, err := refresher.RefreshCredentials(ctx, providerCtx)
+	if err != nil {
+		return expiry, err
+	}
+	t.expiries[providerNamespace] = newExpiry
+
+	return newExpiry, nil
+}
+
+func (t *CredentialsTracker) refresher(providerNamespace string) provider.CredentialsRefresher {
+	if refresher, checked := t.refreshers[providerNamespace]; checked {
+		return refresher
+	}
+
+	var refresher provider.CredentialsRefresher
+	if providerImpl, hasProvider := t.providers[providerNamespace]; hasProvider {
+		refresher, _ = providerImpl.(provider.CredentialsRefresher)
+	}
+
+	t.refreshers[providerNamespace] = refresher
+	return refresher
+}
+
+// deploymentProviderNamespaces returns the sorted namespaces of the providers
+// for the resources that are a part of a deployment.
+func deploymentProviderNamespaces(
+	providers map[string]provider.Provider,
+	resourceProviders map[string]provider.Provider,
+) []string {
+	namespaces := []string{}
+	for namespace, providerImpl := range providers {
+		for _, resourceProvider := range resourceProviders {
+			if resourceProvider == providerImpl {
+				namespaces = append(namespaces, namespace)
+				break
+			}
+		}
+	}
+	slices.Sort(namespaces)
+
+	return namespaces
+}
+
+// estimatedDeploymentDuration determines the expected duration of a deployment
+// for the purpose of checking the lifetime of provider credentials.
+// The estimate provided in the deploy input is used when set, otherwise,
+// the duration of the latest deployment of the blueprint instance is used.
+func estimatedDeploymentDuration(
+	input *DeployInput,
+	currentInstanceState *state.InstanceState,
+) time.Duration {
+	if input.EstimatedDuration > 0 {
+		return input.EstimatedDuration
+	}
+
+	if currentInstanceState == nil ||
+		currentInstanceState.Durations == nil ||
+		currentInstanceState.Durations.TotalDuration == nil {
+		return 0
+	}
+
+	return time.Duration(
+		*currentInstanceState.Durations.TotalDuration * float64(time.Millisecond),
+	)
+}
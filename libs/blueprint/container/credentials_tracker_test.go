@@ -0,0 +1,153 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/mockclock"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type CredentialsTrackerTestSuite struct {
+	suite.Suite
+	clock    *mockclock.AdvanceableClock
+	provider *credentialsRefreshingProvider
+	tracker  *CredentialsTracker
+}
+
+func (s *CredentialsTrackerTestSuite) SetupTest() {
+	s.clock = mockclock.NewAdvanceableClock(time.Unix(mockclock.CurrentTimeUnixMock, 0))
+	s.provider = &credentialsRefreshingProvider{
+		Provider: &internal.ProviderMock{NamespaceValue: "aws"},
+		clock:    s.clock,
+		lifetime: time.Hour,
+		expiry:   s.clock.Now().Add(time.Hour),
+	}
+	s.tracker = newCredentialsTracker(
+		map[string]provider.Provider{
+			"aws":   s.provider,
+			"azure": &internal.ProviderMock{NamespaceValue: "azure"},
+		},
+		core.BlueprintParams(nil),
+		s.clock,
+		core.NewNopLogger(),
+	)
+}
+
+func (s *CredentialsTrackerTestSuite) Test_preflight_refreshes_credentials_that_expire_before_deployment_completes() {
+	s.tracker.Preflight(context.Background(), []string{"aws", "azure"}, 2*time.Hour)
+
+	s.Equal(1, s.provider.refreshCount)
+	s.Equal(s.clock.Now().Add(time.Hour), s.provider.expiry)
+}
+
+func (s *CredentialsTrackerTestSuite) Test_preflight_does_not_refresh_long_lived_credentials() {
+	s.tracker.Preflight(context.Background(), []string{"aws"}, 30*time.Minute)
+
+	s.Equal(0, s.provider.refreshCount)
+}
+
+func (s *CredentialsTrackerTestSuite) Test_refreshes_credentials_close_to_expiry_during_deployment() {
+	ctx := context.Background()
+	s.tracker.Preflight(ctx, []string{"aws"}, 30*time.Minute)
+	s.tracker.RefreshIfExpiring(ctx, "aws")
+	s.Equal(0, s.provider.refreshCount)
+
+	// The initial credentials expire within the refresh threshold
+	// 56 minutes into the deployment.
+	s.clock.Advance(56 * time.Minute)
+	s.tracker.RefreshIfExpiring(ctx, "aws")
+	s.Equal(1, s.provider.refreshCount)
+
+	s.clock.Advance(time.Hour)
+	s.tracker.RefreshIfExpiring(ctx, "aws")
+	s.Equal(2, s.provider.refreshCount)
+}
+
+func (s *CredentialsTrackerTestSuite) Test_continues_when_credentials_fail_to_refresh() {
+	s.provider.refreshErr = errors.New("session token could not be renewed")
+	s.clock.Advance(58 * time.Minute)
+
+	s.tracker.RefreshIfExpiring(context.Background(), "aws")
+
+	s.Equal(1, s.provider.refreshCount)
+	s.Equal(time.Unix(mockclock.CurrentTimeUnixMock, 0).Add(time.Hour), s.provider.expiry)
+}
+
+func (s *CredentialsTrackerTestSuite) Test_nil_tracker_is_a_no_op() {
+	var tracker *CredentialsTracker
+	tracker.Preflight(context.Background(), []string{"aws"}, time.Hour)
+	tracker.RefreshIfExpiring(context.Background(), "aws")
+}
+
+func (s *CredentialsTrackerTestSuite) Test_estimates_deployment_duration() {
+	totalDuration := float64(90 * time.Minute / time.Millisecond)
+	instanceState := &state.InstanceState{
+		Durations: &state.InstanceCompletionDuration{
+			TotalDuration: &totalDuration,
+		},
+	}
+
+	s.Equal(
+		2*time.Hour,
+		estimatedDeploymentDuration(&DeployInput{EstimatedDuration: 2 * time.Hour}, instanceState),
+	)
+	s.Equal(90*time.Minute, estimatedDeploymentDuration(&DeployInput{}, instanceState))
+	s.Equal(time.Duration(0), estimatedDeploymentDuration(&DeployInput{}, &state.InstanceState{}))
+}
+
+func (s *CredentialsTrackerTestSuite) Test_selects_provider_namespaces_for_deployment() {
+	s.Equal(
+		[]string{"aws"},
+		deploymentProviderNamespaces(
+			s.tracker.providers,
+			map[string]provider.Provider{
+				"ordersTable":   s.provider,
+				"ordersHandler": s.provider,
+			},
+		),
+	)
+}
+
+// credentialsRefreshingProvider is a provider implementation with credentials
+// that are valid for a fixed lifetime from the time they are refreshed.
+type credentialsRefreshingProvider struct {
+	provider.Provider
+	clock        core.Clock
+	lifetime     time.Duration
+	expiry       time.Time
+	refreshCount int
+	refreshErr   error
+}
+
+func (p *credentialsRefreshingProvider) CredentialsExpiry(
+	ctx context.Context,
+	providerCtx provider.Context,
+) (*time.Time, error) {
+	expiry := p.expiry
+	return &expiry, nil
+}
+
+func (p *credentialsRefreshingProvider) RefreshCredentials(
+	ctx context.Context,
+	providerCtx provider.Context,
+) (*time.Time, error) {
+	p.refreshCount += 1
+	if p.refreshErr != nil {
+		return nil, p.refreshErr
+	}
+
+	p.expiry = p.clock.Now().Add(p.lifetime)
+	expiry := p.expiry
+	return &expiry, nil
+}
+
+func TestCredentialsTrackerTestSuite(t *testing.T) {
+	suite.Run(t, new(CredentialsTrackerTestSuite))
+}
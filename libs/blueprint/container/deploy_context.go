@@ -51,6 +51,11 @@ type DeployContext struct {
 	// after a terminal failure. This should be set from configuration when creating
 	// the context, and defaults to DefaultDrainTimeout if not set.
 	DrainTimeout time.Duration
+	// Credentials keeps track of the expiry of provider credentials
+	// so they can be refreshed when they are close to expiry
+	// in the middle of a deployment.
+	// This can be nil, in which case credentials will not be refreshed.
+	Credentials *CredentialsTracker
 }
 
 func DeployContextWithChannels(
@@ -77,6 +82,7 @@ func DeployContextWithChannels(
 		TaggingConfig:          deployCtx.TaggingConfig,
 		ProviderMetadataLookup: deployCtx.ProviderMetadataLookup,
 		DrainTimeout:           deployCtx.DrainTimeout,
+		Credentials:            deployCtx.Credentials,
 	}
 }
 
@@ -104,6 +110,7 @@ func DeployContextWithGroup(
 		TaggingConfig:          deployCtx.TaggingConfig,
 		ProviderMetadataLookup: deployCtx.ProviderMetadataLookup,
 		DrainTimeout:           deployCtx.DrainTimeout,
+		Credentials:            deployCtx.Credentials,
 	}
 }
 
@@ -131,6 +138,7 @@ func DeployContextWithInstanceSnapshot(
 		TaggingConfig:          deployCtx.TaggingConfig,
 		ProviderMetadataLookup: deployCtx.ProviderMetadataLookup,
 		DrainTimeout:           deployCtx.DrainTimeout,
+		Credentials:            deployCtx.Credentials,
 	}
 }
 
@@ -158,5 +166,6 @@ func DeployContextWithLogger(
 		TaggingConfig:          deployCtx.TaggingConfig,
 		ProviderMetadataLookup: deployCtx.ProviderMetadataLookup,
 		DrainTimeout:           deployCtx.DrainTimeout,
+		Credentials:            deployCtx.Credentials,
 	}
 }
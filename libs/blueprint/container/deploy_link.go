@@ -37,6 +37,9 @@ type LinkDeployResult struct {
 	IntermediaryResourceStates []*state.LinkIntermediaryResourceState
 	LinkData                   *core.MappingNode
 	ResourceDataMappings       map[string]string
+	// Config holds the configuration for the link from the `links`
+	// section of the blueprint that was used to deploy the link.
+	Config *core.MappingNode
 }
 
 // NewDefaultLinkDeployer creates a new instance of the default implementation
@@ -113,6 +116,12 @@ func (d *defaultLinkDeployer) Deploy(
 		instanceID: instanceID,
 	}
 	linkCtx := provider.NewLinkContextFromParams(deployCtx.ParamOverrides)
+	linkConfig := getLinkConfigForDeployment(
+		linkElement.LogicalName(),
+		linkUpdateType,
+		currentLinkState,
+		deployCtx,
+	)
 	resourceAOutput, stop, err := d.updateLinkResourceA(
 		ctx,
 		linkImplementation,
@@ -123,6 +132,7 @@ func (d *defaultLinkDeployer) Deploy(
 			LinkUpdateType:    linkUpdateType,
 			CurrentLinkState:  currentLinkState,
 			LinkContext:       linkCtx,
+			LinkConfig:        linkConfig,
 		},
 		linkInfo,
 		provider.CreateRetryContext(retryPolicy),
@@ -145,6 +155,7 @@ func (d *defaultLinkDeployer) Deploy(
 			LinkUpdateType:    linkUpdateType,
 			CurrentLinkState:  currentLinkState,
 			LinkContext:       linkCtx,
+			LinkConfig:        linkConfig,
 		},
 		linkInfo,
 		provider.CreateRetryContext(retryPolicy),
@@ -168,6 +179,7 @@ func (d *defaultLinkDeployer) Deploy(
 			LinkUpdateType:   linkUpdateType,
 			CurrentLinkState: currentLinkState,
 			LinkContext:      linkCtx,
+			LinkConfig:       linkConfig,
 			ResourceService:  deployCtx.ResourceRegistry,
 		},
 		linkInfo,
@@ -859,6 +871,7 @@ func (d *defaultLinkDeployer) updateLinkIntermediaryResources(
 		resourceOutputs.resourceBOutput,
 		intermediaryResourcesOutput,
 	)
+	result.Config = input.LinkConfig
 	deployCtx.State.SetLinkDeployResult(linkInfo.element.LogicalName(), result)
 
 	deployCtx.Channels.LinkUpdateChan <- d.createLinkIntermediariesUpdatedMessage(
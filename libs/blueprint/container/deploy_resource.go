@@ -2,6 +2,8 @@ package container
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
@@ -151,6 +153,21 @@ func (d *defaultResourceDeployer) Deploy(
 		return
 	}
 
+	timeout, err := resolveResourceOperationTimeout(
+		ctx,
+		resourceDeployTimeoutOperation(resourceChangeInfo.isNew),
+		chainLinkNode.Resource,
+		resourceImplementation,
+		provider.NewProviderContextFromParams(
+			provider.ExtractProviderFromItemType(resolvedResource.Type.Value),
+			deployCtx.ParamOverrides,
+		),
+	)
+	if err != nil {
+		deployCtx.Channels.ErrChan <- err
+		return
+	}
+
 	// The resource state is made available in a change set at the time
 	// changes were staged, this is primarily to provide a convenient way
 	// to surface the current state to users during the "planning" phase.
@@ -177,7 +194,9 @@ func (d *defaultResourceDeployer) Deploy(
 				resourceID,
 				instanceID,
 			),
-			isNew: resourceChangeInfo.isNew,
+			isNew:      resourceChangeInfo.isNew,
+			sourceSpec: getResourceSourceSpec(chainLinkNode),
+			timeout:    timeout,
 		},
 		resolvedResource.Type.Value,
 		deployCtx,
@@ -228,9 +247,20 @@ func (d *defaultResourceDeployer) deployResource(
 		core.IntegerLogField("attempt", int64(resourceRetryInfo.Attempt)),
 	)
 
+	// The provider call is made with a context that is cancelled
+	// once the configured timeout for the operation has been exceeded,
+	// providers are expected to stop in-flight requests when the context
+	// is cancelled.
+	operationCtx, cancelOperation := contextWithResourceOperationTimeout(
+		ctx,
+		resourceInfo.timeout,
+	)
+	defer cancelOperation()
+
 	providerNamespace := provider.ExtractProviderFromItemType(resourceType)
+	deployCtx.Credentials.RefreshIfExpiring(ctx, providerNamespace)
 	output, err := resourceInfo.resourceImpl.Deploy(
-		ctx,
+		operationCtx,
 		&provider.ResourceDeployInput{
 			InstanceID:   resourceInfo.instanceID,
 			InstanceName: resourceInfo.instanceName,
@@ -249,6 +279,22 @@ func (d *defaultResourceDeployer) deployResource(
 			),
 		},
 	)
+	if err != nil && resourceOperationTimedOut(operationCtx) {
+		deployCtx.Logger.Debug(
+			"resource deployment timed out",
+			core.IntegerLogField("attempt", int64(resourceRetryInfo.Attempt)),
+			core.ErrorLogField("error", err),
+		)
+		return d.handleDeployResourceTimeout(
+			resourceInfo,
+			provider.RetryContextWithStartTime(
+				resourceRetryInfo,
+				resourceDeploymentStartTime,
+			),
+			deployCtx,
+		)
+	}
+
 	if err != nil {
 		var retryErr *provider.RetryableError
 		if provider.AsRetryableError(err, &retryErr) {
@@ -283,6 +329,10 @@ func (d *defaultResourceDeployer) deployResource(
 					resourceDeploymentStartTime,
 				),
 				resourceDeployErr.FailureReasons,
+				createFieldFailureDiagnostics(
+					resourceDeployErr.FieldFailures,
+					resourceInfo.sourceSpec,
+				),
 				deployCtx,
 			)
 		}
@@ -695,6 +745,7 @@ func (d *defaultResourceDeployer) handleDeployResourceTerminalFailure(
 	resourceInfo *resourceDeployInfo,
 	resourceRetryInfo *provider.RetryContext,
 	failureReasons []string,
+	failureDiagnostics []*core.Diagnostic,
 	deployCtx *DeployContext,
 ) error {
 	currentAttemptDuration := d.clock.Since(resourceRetryInfo.AttemptStartTime)
@@ -711,13 +762,57 @@ func (d *defaultResourceDeployer) handleDeployResourceTerminalFailure(
 			deployCtx.Rollback,
 			resourceInfo.isNew,
 		),
-		FailureReasons:  failureReasons,
+		FailureReasons: append(
+			slices.Clone(failureReasons),
+			fieldFailureReasons(failureDiagnostics)...,
+		),
+		FailureDiagnostics: failureDiagnostics,
+		Attempt:            resourceRetryInfo.Attempt,
+		CanRetry:           false,
+		UpdateTimestamp:    d.clock.Now().Unix(),
+		Durations: determineResourceDeployFinishedDurations(
+			resourceRetryInfo,
+			currentAttemptDuration,
+			/* configCompleteDuration */ nil,
+		),
+	}
+
+	return nil
+}
+
+// Marks a resource as interrupted when the provider did not finish
+// creating or updating the resource within the configured timeout.
+// The state of the resource in the upstream provider is unknown at this point,
+// so it is left for reconciliation instead of being marked as failed.
+func (d *defaultResourceDeployer) handleDeployResourceTimeout(
+	resourceInfo *resourceDeployInfo,
+	resourceRetryInfo *provider.RetryContext,
+	deployCtx *DeployContext,
+) error {
+	status, preciseStatus := determineResourceInterruptedStatus(
+		/* destroying */ false,
+		deployCtx.Rollback,
+		&resourceInfo.isNew,
+	)
+	deployCtx.Channels.ResourceUpdateChan <- ResourceDeployUpdateMessage{
+		InstanceID:    resourceInfo.instanceID,
+		ResourceID:    resourceInfo.resourceID,
+		ResourceName:  resourceInfo.resourceName,
+		Group:         deployCtx.CurrentGroupIndex,
+		Status:        status,
+		PreciseStatus: preciseStatus,
+		FailureReasons: []string{
+			resourceOperationTimeoutFailureMessage(
+				resourceDeployTimeoutOperation(resourceInfo.isNew),
+				resourceInfo.timeout,
+			),
+		},
 		Attempt:         resourceRetryInfo.Attempt,
 		CanRetry:        false,
 		UpdateTimestamp: d.clock.Now().Unix(),
 		Durations: determineResourceDeployFinishedDurations(
 			resourceRetryInfo,
-			currentAttemptDuration,
+			d.clock.Since(resourceRetryInfo.AttemptStartTime),
 			/* configCompleteDuration */ nil,
 		),
 	}
@@ -820,3 +915,55 @@ func lookupProviderMetadata(
 	}
 	return metadataLookup(providerNamespace)
 }
+
+func getResourceSourceSpec(chainLinkNode *links.ChainLinkNode) *core.MappingNode {
+	if chainLinkNode.Resource == nil {
+		return nil
+	}
+
+	return chainLinkNode.Resource.Spec
+}
+
+// Creates diagnostics for failures caused by specific fields in a resource spec,
+// resolving the location of each field in the source blueprint.
+func createFieldFailureDiagnostics(
+	fieldFailures []*provider.SpecFieldFailure,
+	sourceSpec *core.MappingNode,
+) []*core.Diagnostic {
+	if len(fieldFailures) == 0 {
+		return nil
+	}
+
+	diagnostics := make([]*core.Diagnostic, 0, len(fieldFailures))
+	for _, fieldFailure := range fieldFailures {
+		diagnostics = append(diagnostics, &core.Diagnostic{
+			Level:    core.DiagnosticLevelError,
+			Message:  fieldFailure.Reason,
+			SpecPath: fieldFailure.SpecPath,
+			Range:    core.DiagnosticRangeFromSpecPath(fieldFailure.SpecPath, sourceSpec),
+		})
+	}
+
+	return diagnostics
+}
+
+// Produces failure reasons for field failure diagnostics that include the
+// spec path and the line in the source blueprint for tools that only
+// display failure reasons.
+func fieldFailureReasons(diagnostics []*core.Diagnostic) []string {
+	reasons := make([]string, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		location := diagnostic.SpecPath
+		if diagnostic.Range != nil && diagnostic.Range.Start != nil {
+			location = fmt.Sprintf(
+				"%s (line %d, column %d)",
+				diagnostic.SpecPath,
+				diagnostic.Range.Start.Line,
+				diagnostic.Range.Start.Column,
+			)
+		}
+		reasons = append(reasons, fmt.Sprintf("%s: %s", location, diagnostic.Message))
+	}
+
+	return reasons
+}
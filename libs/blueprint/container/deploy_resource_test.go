@@ -17,6 +17,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
 	"github.com/stretchr/testify/suite"
 )
@@ -131,6 +132,59 @@ func (s *ResourceDeployerTestSuite) Test_handles_stabilise_timeout_error() {
 	)
 }
 
+func (s *ResourceDeployerTestSuite) Test_resolves_source_locations_for_field_failures() {
+	handler := "src/orders.save"
+	sourceSpec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"handler": {
+				Scalar: &core.ScalarValue{StringValue: &handler},
+				SourceMeta: &source.Meta{
+					Position: source.Position{Line: 12, Column: 16},
+				},
+			},
+		},
+		SourceMeta: &source.Meta{
+			Position: source.Position{Line: 11, Column: 7},
+		},
+	}
+
+	diagnostics := createFieldFailureDiagnostics(
+		[]*provider.SpecFieldFailure{
+			{SpecPath: "$.handler", Reason: "handler does not exist in the deployment package"},
+			{SpecPath: "$.memorySize", Reason: "memory size must be at least 128MB"},
+		},
+		sourceSpec,
+	)
+	s.Require().Len(diagnostics, 2)
+	s.Assert().Equal("$.handler", diagnostics[0].SpecPath)
+	s.Assert().Equal(core.DiagnosticLevelError, diagnostics[0].Level)
+	s.Assert().Equal(12, diagnostics[0].Range.Start.Line)
+	s.Assert().Equal(16, diagnostics[0].Range.Start.Column)
+	// Fields that are not defined in the source blueprint are reported
+	// at the location of the closest ancestor.
+	s.Assert().Equal(11, diagnostics[1].Range.Start.Line)
+
+	s.Assert().Equal(
+		[]string{
+			"$.handler (line 12, column 16): handler does not exist in the deployment package",
+			"$.memorySize (line 11, column 7): memory size must be at least 128MB",
+		},
+		fieldFailureReasons(diagnostics),
+	)
+
+	withoutSource := createFieldFailureDiagnostics(
+		[]*provider.SpecFieldFailure{
+			{SpecPath: "$.handler", Reason: "handler does not exist in the deployment package"},
+		},
+		/* sourceSpec */ nil,
+	)
+	s.Assert().Nil(withoutSource[0].Range)
+	s.Assert().Equal(
+		[]string{"$.handler: handler does not exist in the deployment package"},
+		fieldFailureReasons(withoutSource),
+	)
+}
+
 func (s *ResourceDeployerTestSuite) runDeployTest(
 	fixture *resourceDeployerFixture,
 	rollingBack bool,
@@ -0,0 +1,288 @@
+package container
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+// DeploymentEventType is the type of status update
+// that a persisted deployment event holds.
+type DeploymentEventType string
+
+const (
+	// DeploymentEventTypeResourceUpdate is an event that holds
+	// a status update for a resource.
+	DeploymentEventTypeResourceUpdate DeploymentEventType = "resourceUpdate"
+	// DeploymentEventTypeLinkUpdate is an event that holds
+	// a status update for a link.
+	DeploymentEventTypeLinkUpdate DeploymentEventType = "linkUpdate"
+	// DeploymentEventTypeChildUpdate is an event that holds
+	// a status update for a child blueprint.
+	DeploymentEventTypeChildUpdate DeploymentEventType = "childUpdate"
+	// DeploymentEventTypeDeploymentUpdate is an event that holds
+	// a status update for the blueprint instance.
+	DeploymentEventTypeDeploymentUpdate DeploymentEventType = "deploymentUpdate"
+	// DeploymentEventTypeFinish is an event that holds the final status
+	// of a deployment or destroy operation for a blueprint instance.
+	DeploymentEventTypeFinish DeploymentEventType = "finish"
+	// DeploymentEventTypeError is an event for an unexpected error
+	// that caused a deployment or destroy operation to stop.
+	DeploymentEventTypeError DeploymentEventType = "error"
+)
+
+// DeploymentEvent is a persisted version of a message sent to the
+// deploy channels of a blueprint container during a deployment
+// or destroy operation.
+// Only the field for the type of event will be set.
+type DeploymentEvent struct {
+	// Sequence is the position of the event in the events of the
+	// blueprint instance, this is assigned by the event store when the
+	// event is persisted and increases for each event saved for an instance.
+	Sequence int64 `json:"sequence"`
+	// InstanceID is the ID of the blueprint instance that the deploy or destroy
+	// operation was carried out for.
+	// Updates for elements of child blueprints are recorded under the
+	// ID of the instance that the operation was carried out for,
+	// the instance ID in the message holds the ID of the child blueprint instance.
+	InstanceID string `json:"instanceId"`
+	// RunID identifies the deploy or destroy operation that emitted
+	// the event.
+	RunID string `json:"runId"`
+	// Type is the type of status update that the event holds.
+	Type DeploymentEventType `json:"type"`
+	// Timestamp is the unix timestamp in seconds
+	// when the event was recorded.
+	Timestamp int64 `json:"timestamp"`
+
+	ResourceUpdate   *ResourceDeployUpdateMessage `json:"resourceUpdate,omitempty"`
+	LinkUpdate       *LinkDeployUpdateMessage     `json:"linkUpdate,omitempty"`
+	ChildUpdate      *ChildDeployUpdateMessage    `json:"childUpdate,omitempty"`
+	DeploymentUpdate *DeploymentUpdateMessage     `json:"deploymentUpdate,omitempty"`
+	Finish           *DeploymentFinishedMessage   `json:"finish,omitempty"`
+	// Error holds the message of an unexpected error for error events.
+	Error string `json:"error,omitempty"`
+}
+
+// DeploymentEventFilter determines the persisted deployment events
+// to retrieve when replaying events.
+type DeploymentEventFilter struct {
+	// InstanceID is the ID of the blueprint instance to replay events for.
+	InstanceID string
+	// RunID limits the replayed events to a single deploy or destroy
+	// operation, when empty, events for all operations for the instance
+	// are replayed.
+	RunID string
+	// AfterSequence is the sequence number of the last event that the caller
+	// has already received, only events with a greater sequence number are
+	// replayed.
+	// When zero, all retained events are replayed.
+	AfterSequence int64
+}
+
+// DeploymentEventStore provides an interface for a store that persists
+// the status updates emitted by blueprint containers during deployment
+// and destroy operations so they can be replayed for callers that were
+// not connected when the events were emitted.
+type DeploymentEventStore interface {
+	// Append persists a deployment event, assigning the next sequence number
+	// for the blueprint instance to the event.
+	Append(ctx context.Context, event *DeploymentEvent) error
+	// Replay retrieves the persisted events that match the provided filter
+	// in ascending sequence order.
+	Replay(ctx context.Context, filter *DeploymentEventFilter) ([]*DeploymentEvent, error)
+}
+
+type memoryDeploymentEventStore struct {
+	mu        sync.Mutex
+	events    map[string][]*DeploymentEvent
+	sequences map[string]int64
+}
+
+// NewMemoryDeploymentEventStore creates a new in-memory implementation
+// of a deployment event store.
+// Events are only retained for the lifetime of the process,
+// a persistent implementation should be used for events to be replayed
+// across restarts.
+func NewMemoryDeploymentEventStore() DeploymentEventStore {
+	return &memoryDeploymentEventStore{
+		events:    map[string][]*DeploymentEvent{},
+		sequences: map[string]int64{},
+	}
+}
+
+func (s *memoryDeploymentEventStore) Append(
+	ctx context.Context,
+	event *DeploymentEvent,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sequences[event.InstanceID] += 1
+	event.Sequence = s.sequences[event.InstanceID]
+	s.events[event.InstanceID] = append(s.events[event.InstanceID], event)
+
+	return nil
+}
+
+func (s *memoryDeploymentEventStore) Replay(
+	ctx context.Context,
+	filter *DeploymentEventFilter,
+) ([]*DeploymentEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := []*DeploymentEvent{}
+	for _, event := range s.events[filter.InstanceID] {
+		if event.Sequence > filter.AfterSequence &&
+			(filter.RunID == "" || event.RunID == filter.RunID) {
+			events = append(events, event)
+		}
+	}
+
+	slices.SortFunc(events, func(a, b *DeploymentEvent) int {
+		return cmp.Compare(a.Sequence, b.Sequence)
+	})
+
+	return events, nil
+}
+
+// recordDeploymentEvents re-wires the provided deploy channels so every message
+// sent during a deployment or destroy operation is persisted in the deployment
+// event store before it is passed on to the caller-provided channels.
+// Recording stops once a finished message has been passed on,
+// the returned function must be called to stop recording once no further
+// messages will be sent to the re-wired channels for operations that
+// do not send a finished message (e.g. when exiting due to an unexpected error).
+//
+// When no deployment event store has been configured for the container,
+// the provided channels are returned as they are.
+func (c *defaultBlueprintContainer) recordDeploymentEvents(
+	ctx context.Context,
+	instanceID string,
+	runID string,
+	channels *DeployChannels,
+) (*DeployChannels, func()) {
+	if c.deploymentEventStore == nil {
+		return channels, func() {}
+	}
+
+	recordChannels := CreateDeployChannels()
+	stop := make(chan struct{})
+	recorder := &deploymentEventRecorder{
+		store:      c.deploymentEventStore,
+		clock:      c.clock,
+		logger:     c.logger.Named("deploymentEvents"),
+		instanceID: instanceID,
+		runID:      runID,
+	}
+	go recorder.record(ctx, recordChannels, channels, stop)
+
+	var once sync.Once
+	return recordChannels, func() {
+		once.Do(func() { close(stop) })
+	}
+}
+
+type deploymentEventRecorder struct {
+	store      DeploymentEventStore
+	clock      core.Clock
+	logger     core.Logger
+	instanceID string
+	runID      string
+}
+
+func (r *deploymentEventRecorder) record(
+	ctx context.Context,
+	listenToChannels *DeployChannels,
+	forwardToChannels *DeployChannels,
+	stop <-chan struct{},
+) {
+	for {
+		select {
+		case <-stop:
+			return
+		case msg := <-listenToChannels.ResourceUpdateChan:
+			r.save(ctx, &DeploymentEvent{
+				Type:           DeploymentEventTypeResourceUpdate,
+				ResourceUpdate: &msg,
+			})
+			forwardToChannels.ResourceUpdateChan <- msg
+		case msg := <-listenToChannels.LinkUpdateChan:
+			r.save(ctx, &DeploymentEvent{
+				Type:       DeploymentEventTypeLinkUpdate,
+				LinkUpdate: &msg,
+			})
+			forwardToChannels.LinkUpdateChan <- msg
+		case msg := <-listenToChannels.ChildUpdateChan:
+			r.save(ctx, &DeploymentEvent{
+				Type:        DeploymentEventTypeChildUpdate,
+				ChildUpdate: &msg,
+			})
+			forwardToChannels.ChildUpdateChan <- msg
+		case msg := <-listenToChannels.DeploymentUpdateChan:
+			r.save(ctx, &DeploymentEvent{
+				Type:             DeploymentEventTypeDeploymentUpdate,
+				DeploymentUpdate: &msg,
+			})
+			forwardToChannels.DeploymentUpdateChan <- msg
+		case msg := <-listenToChannels.FinishChan:
+			r.save(ctx, &DeploymentEvent{
+				Type:   DeploymentEventTypeFinish,
+				Finish: &msg,
+			})
+			forwardToChannels.FinishChan <- msg
+			return
+		case err := <-listenToChannels.ErrChan:
+			r.save(ctx, &DeploymentEvent{
+				Type:  DeploymentEventTypeError,
+				Error: err.Error(),
+			})
+			forwardToChannels.ErrChan <- err
+		}
+	}
+}
+
+// Failing to persist an event must not prevent the event from reaching
+// the caller or stop the deployment, so failures are logged instead of
+// being reported through the error channel.
+func (r *deploymentEventRecorder) save(
+	ctx context.Context,
+	event *DeploymentEvent,
+) {
+	event.InstanceID = r.instanceID
+	event.RunID = r.runID
+	event.Timestamp = r.clock.Now().Unix()
+
+	// Events emitted after the deployment context has been cancelled
+	// (e.g. interrupted resources) must still be persisted.
+	err := r.store.Append(context.WithoutCancel(ctx), event)
+	if err != nil {
+		r.logger.Warn(
+			"failed to persist deployment event",
+			core.StringLogField("eventType", string(event.Type)),
+			core.ErrorLogField("error", err),
+		)
+	}
+}
+
+// The run ID used to group the events recorded for a deploy or destroy operation.
+func (c *defaultBlueprintContainer) deploymentRunID(requestedRunID string) string {
+	if c.deploymentEventStore == nil || requestedRunID != "" {
+		return requestedRunID
+	}
+
+	runID, err := c.idGenerator.GenerateID()
+	if err != nil {
+		c.logger.Warn(
+			"failed to generate run ID for deployment events",
+			core.ErrorLogField("error", err),
+		)
+		return ""
+	}
+
+	return runID
+}
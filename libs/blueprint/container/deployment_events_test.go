@@ -0,0 +1,142 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/mockclock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeploymentEventsTestSuite struct {
+	suite.Suite
+}
+
+func (s *DeploymentEventsTestSuite) Test_memory_store_assigns_sequence_numbers_per_instance() {
+	store := NewMemoryDeploymentEventStore()
+	ctx := context.Background()
+
+	for _, instanceID := range []string{"instance-1", "instance-2", "instance-1"} {
+		err := store.Append(ctx, &DeploymentEvent{
+			InstanceID: instanceID,
+			RunID:      "run-1",
+			Type:       DeploymentEventTypeDeploymentUpdate,
+		})
+		s.Require().NoError(err)
+	}
+
+	events, err := store.Replay(ctx, &DeploymentEventFilter{InstanceID: "instance-1"})
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Equal(int64(1), events[0].Sequence)
+	s.Equal(int64(2), events[1].Sequence)
+
+	events, err = store.Replay(ctx, &DeploymentEventFilter{InstanceID: "instance-2"})
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+	s.Equal(int64(1), events[0].Sequence)
+}
+
+func (s *DeploymentEventsTestSuite) Test_memory_store_replays_events_for_run_after_sequence() {
+	store := NewMemoryDeploymentEventStore()
+	ctx := context.Background()
+
+	for _, runID := range []string{"run-1", "run-2", "run-2", "run-2"} {
+		err := store.Append(ctx, &DeploymentEvent{
+			InstanceID: "instance-1",
+			RunID:      runID,
+			Type:       DeploymentEventTypeResourceUpdate,
+		})
+		s.Require().NoError(err)
+	}
+
+	events, err := store.Replay(ctx, &DeploymentEventFilter{
+		InstanceID:    "instance-1",
+		RunID:         "run-2",
+		AfterSequence: 2,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Equal(int64(3), events[0].Sequence)
+	s.Equal(int64(4), events[1].Sequence)
+}
+
+func (s *DeploymentEventsTestSuite) Test_records_and_forwards_deployment_events_until_finished() {
+	store := NewMemoryDeploymentEventStore()
+	container := &defaultBlueprintContainer{
+		deploymentEventStore: store,
+		clock:                &mockclock.StaticClock{},
+		logger:               core.NewNopLogger(),
+	}
+	channels := CreateDeployChannels()
+
+	recordChannels, stopRecording := container.recordDeploymentEvents(
+		context.Background(),
+		"instance-1",
+		"run-1",
+		channels,
+	)
+	defer stopRecording()
+
+	go func() {
+		recordChannels.ResourceUpdateChan <- ResourceDeployUpdateMessage{
+			InstanceID:   "child-instance-1",
+			ResourceName: "ordersTable",
+		}
+		recordChannels.ErrChan <- errors.New("unexpected error")
+		recordChannels.FinishChan <- DeploymentFinishedMessage{
+			InstanceID: "instance-1",
+		}
+	}()
+
+	s.Equal("ordersTable", (<-channels.ResourceUpdateChan).ResourceName)
+	s.EqualError(<-channels.ErrChan, "unexpected error")
+	s.Equal("instance-1", (<-channels.FinishChan).InstanceID)
+
+	events, err := store.Replay(context.Background(), &DeploymentEventFilter{
+		InstanceID: "instance-1",
+		RunID:      "run-1",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(events, 3)
+
+	s.Equal(DeploymentEventTypeResourceUpdate, events[0].Type)
+	s.Equal("child-instance-1", events[0].ResourceUpdate.InstanceID)
+	s.Equal("instance-1", events[0].InstanceID)
+	s.Equal(mockclock.CurrentTimeUnixMock, events[0].Timestamp)
+	s.Equal(DeploymentEventTypeError, events[1].Type)
+	s.Equal("unexpected error", events[1].Error)
+	s.Equal(DeploymentEventTypeFinish, events[2].Type)
+	s.Equal(int64(3), events[2].Sequence)
+
+	// Recording stops after the finished message has been passed on,
+	// so further messages must not be consumed by the recorder.
+	select {
+	case recordChannels.DeploymentUpdateChan <- DeploymentUpdateMessage{}:
+		s.Fail("expected recorder to stop after finished message")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func (s *DeploymentEventsTestSuite) Test_returns_provided_channels_when_no_store_is_configured() {
+	container := &defaultBlueprintContainer{}
+	channels := CreateDeployChannels()
+
+	recordChannels, stopRecording := container.recordDeploymentEvents(
+		context.Background(),
+		"instance-1",
+		"run-1",
+		channels,
+	)
+	stopRecording()
+
+	s.Same(channels, recordChannels)
+	s.Equal("", container.deploymentRunID(""))
+}
+
+func TestDeploymentEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(DeploymentEventsTestSuite))
+}
@@ -35,6 +35,10 @@ type ResourceDeployUpdateMessage struct {
 	// FailureReasons holds a list of reasons why the resource failed to deploy
 	// if the status update is for a failure.
 	FailureReasons []string `json:"failureReasons,omitempty"`
+	// FailureDiagnostics holds diagnostics for failures that were caused by
+	// specific fields in the resource spec, with ranges that point to the fields
+	// in the source blueprint when source positions are available.
+	FailureDiagnostics []*core.Diagnostic `json:"failureDiagnostics,omitempty"`
 	// Attempt is the current attempt number for deploying or destroying the resource.
 	Attempt int `json:"attempt"`
 	// CanRetry indicates if the operation for the resource can be retried
@@ -67,6 +71,11 @@ type ResourceDeployUpdateMessage struct {
 	// persisted state. It may have already been removed or was never
 	// created (e.g. a previous deploy failed before reaching it).
 	MissingFromState bool `json:"missingFromState,omitempty"`
+	// AlreadyDestroyed indicates that the resource was reported as destroyed
+	// because the provider found that it no longer exists in the upstream
+	// provider, this is the case when a resource has been deleted
+	// outside of Bluelink.
+	AlreadyDestroyed bool `json:"alreadyDestroyed,omitempty"`
 }
 
 // ResourceChangesMessage provides a message containing status updates
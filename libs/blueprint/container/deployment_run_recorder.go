@@ -0,0 +1,116 @@
+package container
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// DeploymentRunRecorder is a deployment summary hook that records
+// a deployment run in the state container for each deploy or destroy
+// operation, this provides an audit trail of who carried out each operation
+// for a blueprint instance and the outcome for each element.
+// Runs can be retrieved with `Instances().ListRuns` on the state container.
+type DeploymentRunRecorder struct {
+	instances   state.InstancesContainer
+	idGenerator core.IDGenerator
+}
+
+// NewDeploymentRunRecorder creates a new deployment summary hook that saves
+// a deployment run to the provided instances state container for each
+// deploy or destroy operation.
+// The ID generator is used to generate IDs for runs when a run ID
+// was not assigned to the operation.
+func NewDeploymentRunRecorder(
+	instances state.InstancesContainer,
+	idGenerator core.IDGenerator,
+) *DeploymentRunRecorder {
+	return &DeploymentRunRecorder{
+		instances:   instances,
+		idGenerator: idGenerator,
+	}
+}
+
+func (r *DeploymentRunRecorder) OnDeploymentSummary(
+	ctx context.Context,
+	summary *DeploymentSummary,
+) error {
+	if summary.InstanceID == "" {
+		// An operation that failed before the instance was resolved
+		// can not be associated with a blueprint instance.
+		return nil
+	}
+
+	runID := summary.RunID
+	if runID == "" {
+		generatedID, err := r.idGenerator.GenerateID()
+		if err != nil {
+			return err
+		}
+		runID = generatedID
+	}
+
+	return r.instances.SaveRun(ctx, DeploymentRunFromSummary(runID, summary))
+}
+
+// DeploymentRunFromSummary creates a deployment run with the provided ID
+// from the summary of a deploy or destroy operation.
+func DeploymentRunFromSummary(runID string, summary *DeploymentSummary) state.DeploymentRun {
+	elements := make(
+		[]*state.DeploymentRunElement,
+		0,
+		len(summary.Resources)+len(summary.Children)+len(summary.Links),
+	)
+	elements = appendDeploymentRunElements(
+		elements,
+		state.DeploymentRunElementResource,
+		summary.Resources,
+	)
+	elements = appendDeploymentRunElements(
+		elements,
+		state.DeploymentRunElementChild,
+		summary.Children,
+	)
+	elements = appendDeploymentRunElements(
+		elements,
+		state.DeploymentRunElementLink,
+		summary.Links,
+	)
+
+	return state.DeploymentRun{
+		ID:                   runID,
+		InstanceID:           summary.InstanceID,
+		InstanceName:         summary.InstanceName,
+		Operation:            state.DeploymentRunOperation(summary.Operation),
+		Rollback:             summary.Rollback,
+		Metadata:             summary.RunMetadata,
+		Status:               summary.Status,
+		Succeeded:            summary.Succeeded,
+		FailureReasons:       summary.FailureReasons,
+		Errors:               summary.Errors,
+		StartedAt:            summary.StartedAt,
+		FinishedAt:           summary.FinishedAt,
+		DurationMilliseconds: summary.DurationMilliseconds,
+		Elements:             elements,
+	}
+}
+
+func appendDeploymentRunElements(
+	elements []*state.DeploymentRunElement,
+	elementType state.DeploymentRunElementType,
+	summaryElements []*DeploymentSummaryElement,
+) []*state.DeploymentRunElement {
+	for _, summaryElement := range summaryElements {
+		elements = append(elements, &state.DeploymentRunElement{
+			Type:           elementType,
+			Name:           summaryElement.Name,
+			ID:             summaryElement.ID,
+			InstanceID:     summaryElement.InstanceID,
+			Status:         summaryElement.Status,
+			FailureReasons: summaryElement.FailureReasons,
+		})
+	}
+
+	return elements
+}
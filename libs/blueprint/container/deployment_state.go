@@ -521,6 +521,7 @@ func copyLinkDeployResult(result *LinkDeployResult) *LinkDeployResult {
 		LinkData:                   core.CopyMappingNode(result.LinkData),
 		ResourceDataMappings:       resourceDataMappings,
 		IntermediaryResourceStates: copyLinkIntermediaryResourceStates(result.IntermediaryResourceStates),
+		Config:                     core.CopyMappingNode(result.Config),
 	}
 }
 
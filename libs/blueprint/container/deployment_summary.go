@@ -0,0 +1,327 @@
+package container
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// DeploymentSummarySchemaVersion is the version of the structure of
+// deployment summaries passed into summary hooks.
+// This will only change when a breaking change is made to the structure
+// of a summary, so external systems that store or process summaries
+// can rely on the JSON representation of a summary.
+const DeploymentSummarySchemaVersion = "1"
+
+// DeploymentOperation is the type of operation that
+// a deployment summary is for.
+type DeploymentOperation string
+
+const (
+	// DeploymentOperationDeploy is used for summaries of operations
+	// that deploy changes to a new or existing blueprint instance.
+	DeploymentOperationDeploy DeploymentOperation = "deploy"
+	// DeploymentOperationDestroy is used for summaries of operations
+	// that destroy a blueprint instance.
+	DeploymentOperationDestroy DeploymentOperation = "destroy"
+)
+
+// DeploymentSummaryHook is an interface for a hook that receives a summary
+// of each deploy or destroy operation for a blueprint instance once the operation
+// has finished.
+// This is useful for syncing infrastructure changes with external systems
+// such as changelogs or a configuration management database (CMDB).
+type DeploymentSummaryHook interface {
+	// OnDeploymentSummary is called with the summary of a deploy or destroy
+	// operation once the final status has been sent to the caller.
+	// Errors returned by the hook are logged as the changes
+	// have already been applied.
+	OnDeploymentSummary(ctx context.Context, summary *DeploymentSummary) error
+}
+
+// DeploymentSummary holds the final change set and results of a deploy
+// or destroy operation for a blueprint instance.
+// Statuses are provided in their string form (e.g. "DEPLOYED") so that
+// summaries can be consumed by external systems without knowledge of
+// the blueprint framework's status enums.
+type DeploymentSummary struct {
+	// SchemaVersion is the version of the structure of the summary,
+	// this will be set to DeploymentSummarySchemaVersion.
+	SchemaVersion string `json:"schemaVersion"`
+	// Operation is the type of operation that was carried out.
+	Operation DeploymentOperation `json:"operation"`
+	// InstanceID is the ID of the blueprint instance that was deployed or destroyed.
+	InstanceID string `json:"instanceId"`
+	// InstanceName is the user-defined name of the blueprint instance
+	// that was deployed or destroyed.
+	InstanceName string `json:"instanceName"`
+	// RunID identifies the deploy or destroy operation in the events
+	// recorded in the deployment event store configured for the container.
+	RunID string `json:"runId,omitempty"`
+	// RunMetadata holds information about who initiated the operation and the
+	// versions of the tools and blueprint used, as provided in the input
+	// for the operation.
+	RunMetadata *state.DeploymentRunMetadata `json:"runMetadata,omitempty"`
+	// Rollback is true when the operation was carried out to roll back
+	// changes for a previous deployment.
+	Rollback bool `json:"rollback"`
+	// Status is the final status of the blueprint instance
+	// (e.g. "DEPLOYED" or "UPDATE FAILED").
+	// This is empty when the operation stopped due to an unexpected error,
+	// in which case, the errors are provided in Errors.
+	Status string `json:"status,omitempty"`
+	// Succeeded is true when the operation reached a successful final status.
+	Succeeded bool `json:"succeeded"`
+	// FailureReasons holds the reasons the operation failed
+	// when the final status is a failure status.
+	FailureReasons []string `json:"failureReasons,omitempty"`
+	// Errors holds the unexpected errors that occurred during the operation.
+	Errors []string `json:"errors,omitempty"`
+	// StartedAt is the unix timestamp in seconds when the operation started.
+	StartedAt int64 `json:"startedAt"`
+	// FinishedAt is the unix timestamp in seconds when the operation finished.
+	FinishedAt int64 `json:"finishedAt"`
+	// DurationMilliseconds is the duration of the operation in milliseconds,
+	// this is only set when the duration was reported in the final status update.
+	DurationMilliseconds *float64 `json:"durationMilliseconds,omitempty"`
+	// Changes holds the final change set that was applied for the operation.
+	Changes *changes.BlueprintChanges `json:"changes,omitempty"`
+	// Resources holds the final status of each resource that was deployed
+	// or destroyed, sorted by instance ID and resource name.
+	Resources []*DeploymentSummaryElement `json:"resources"`
+	// Children holds the final status of each child blueprint that was deployed
+	// or destroyed, sorted by parent instance ID and child name.
+	Children []*DeploymentSummaryElement `json:"children"`
+	// Links holds the final status of each link that was deployed
+	// or destroyed, sorted by instance ID and link name.
+	Links []*DeploymentSummaryElement `json:"links"`
+}
+
+// DeploymentSummaryElement holds the final status of an element
+// in a deployment summary.
+type DeploymentSummaryElement struct {
+	// Name is the name of the resource, child blueprint or link
+	// in the blueprint.
+	Name string `json:"name"`
+	// ID is the ID of the resource, child blueprint instance or link.
+	ID string `json:"id,omitempty"`
+	// InstanceID is the ID of the blueprint instance that the element belongs to,
+	// this will be the ID of a child blueprint instance for elements of child blueprints.
+	InstanceID string `json:"instanceId"`
+	// Status is the final status of the element (e.g. "CREATED").
+	Status string `json:"status"`
+	// FailureReasons holds the reasons the element failed to be deployed
+	// or destroyed.
+	FailureReasons []string `json:"failureReasons,omitempty"`
+}
+
+// summariseDeployment re-wires the provided deploy channels to collect the final
+// status of each element for a summary of a deploy or destroy operation,
+// messages are passed on to the caller-provided channels as they are received.
+// The summary is passed into the summary hooks registered for the container once
+// the finished message has been passed on.
+// The returned function must be called once no further messages will be sent
+// to the re-wired channels, so a summary can be produced for operations that
+// stop due to an unexpected error without sending a finished message.
+//
+// Summaries are only produced for root blueprint instances as the updates
+// for child blueprints are included in the summary of the parent.
+// When no summary hooks have been registered, the provided channels
+// are returned as they are.
+func (c *defaultBlueprintContainer) summariseDeployment(
+	ctx context.Context,
+	summary *DeploymentSummary,
+	channels *DeployChannels,
+	paramOverrides core.BlueprintParams,
+) (*DeployChannels, func()) {
+	if !c.hooks.HasSummaryHooks() || isChildDeployment(paramOverrides) {
+		return channels, func() {}
+	}
+
+	summary.SchemaVersion = DeploymentSummarySchemaVersion
+	summary.StartedAt = c.clock.Now().Unix()
+
+	collectChannels := CreateDeployChannels()
+	stop := make(chan struct{})
+	collector := &deploymentSummaryCollector{
+		hooks:     c.hooks,
+		clock:     c.clock,
+		logger:    c.logger.Named("deploymentSummary"),
+		summary:   summary,
+		resources: map[string]*DeploymentSummaryElement{},
+		children:  map[string]*DeploymentSummaryElement{},
+		links:     map[string]*DeploymentSummaryElement{},
+	}
+	go collector.collect(ctx, collectChannels, channels, stop)
+
+	var once sync.Once
+	return collectChannels, func() {
+		once.Do(func() { close(stop) })
+	}
+}
+
+type deploymentSummaryCollector struct {
+	hooks     *DeploymentHooks
+	clock     core.Clock
+	logger    core.Logger
+	summary   *DeploymentSummary
+	resources map[string]*DeploymentSummaryElement
+	children  map[string]*DeploymentSummaryElement
+	links     map[string]*DeploymentSummaryElement
+}
+
+func (s *deploymentSummaryCollector) collect(
+	ctx context.Context,
+	listenToChannels *DeployChannels,
+	forwardToChannels *DeployChannels,
+	stop <-chan struct{},
+) {
+	for {
+		select {
+		case <-stop:
+			// Operations that stop without a finished message will only
+			// have made changes when an unexpected error occurred.
+			if len(s.summary.Errors) > 0 {
+				s.summary.FinishedAt = s.clock.Now().Unix()
+				s.runHooks(ctx)
+			}
+			return
+		case msg := <-listenToChannels.ResourceUpdateChan:
+			s.resources[summaryElementKey(msg.InstanceID, msg.ResourceName)] = &DeploymentSummaryElement{
+				Name:           msg.ResourceName,
+				ID:             msg.ResourceID,
+				InstanceID:     msg.InstanceID,
+				Status:         msg.Status.String(),
+				FailureReasons: msg.FailureReasons,
+			}
+			forwardToChannels.ResourceUpdateChan <- msg
+		case msg := <-listenToChannels.LinkUpdateChan:
+			s.links[summaryElementKey(msg.InstanceID, msg.LinkName)] = &DeploymentSummaryElement{
+				Name:           msg.LinkName,
+				ID:             msg.LinkID,
+				InstanceID:     msg.InstanceID,
+				Status:         msg.Status.String(),
+				FailureReasons: msg.FailureReasons,
+			}
+			forwardToChannels.LinkUpdateChan <- msg
+		case msg := <-listenToChannels.ChildUpdateChan:
+			s.children[summaryElementKey(msg.ParentInstanceID, msg.ChildName)] = &DeploymentSummaryElement{
+				Name:           msg.ChildName,
+				ID:             msg.ChildInstanceID,
+				InstanceID:     msg.ParentInstanceID,
+				Status:         msg.Status.String(),
+				FailureReasons: msg.FailureReasons,
+			}
+			forwardToChannels.ChildUpdateChan <- msg
+		case msg := <-listenToChannels.DeploymentUpdateChan:
+			forwardToChannels.DeploymentUpdateChan <- msg
+		case msg := <-listenToChannels.FinishChan:
+			forwardToChannels.FinishChan <- msg
+			s.finish(msg)
+			s.runHooks(ctx)
+			return
+		case err := <-listenToChannels.ErrChan:
+			s.summary.Errors = append(s.summary.Errors, err.Error())
+			forwardToChannels.ErrChan <- err
+		}
+	}
+}
+
+func (s *deploymentSummaryCollector) finish(msg DeploymentFinishedMessage) {
+	s.summary.Status = msg.Status.String()
+	s.summary.Succeeded = len(s.summary.Errors) == 0 && isSuccessfulRunStatus(msg.Status)
+	s.summary.FailureReasons = msg.FailureReasons
+	s.summary.FinishedAt = msg.FinishTimestamp
+	if s.summary.FinishedAt == 0 {
+		s.summary.FinishedAt = s.clock.Now().Unix()
+	}
+	if msg.Durations != nil {
+		s.summary.DurationMilliseconds = msg.Durations.TotalDuration
+	}
+}
+
+func (s *deploymentSummaryCollector) runHooks(ctx context.Context) {
+	s.summary.Resources = sortedSummaryElements(s.resources)
+	s.summary.Children = sortedSummaryElements(s.children)
+	s.summary.Links = sortedSummaryElements(s.links)
+
+	// Summary hooks must still be run when the context of the operation
+	// has been cancelled after the final status has been sent to the caller.
+	errs := s.hooks.RunSummaryHooks(context.WithoutCancel(ctx), s.summary)
+	for _, err := range errs {
+		s.logger.Warn(
+			"deployment summary hook failed",
+			core.StringLogField("instanceId", s.summary.InstanceID),
+			core.ErrorLogField("error", err),
+		)
+	}
+}
+
+func summaryElementKey(instanceID string, elementName string) string {
+	return instanceID + "::" + elementName
+}
+
+func sortedSummaryElements(
+	elements map[string]*DeploymentSummaryElement,
+) []*DeploymentSummaryElement {
+	sorted := make([]*DeploymentSummaryElement, 0, len(elements))
+	for _, element := range elements {
+		sorted = append(sorted, element)
+	}
+
+	slices.SortFunc(sorted, func(a, b *DeploymentSummaryElement) int {
+		return cmp.Or(
+			cmp.Compare(a.InstanceID, b.InstanceID),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	return sorted
+}
+
+func isSuccessfulRunStatus(status core.InstanceStatus) bool {
+	return status == core.InstanceStatusDeployed ||
+		status == core.InstanceStatusUpdated ||
+		status == core.InstanceStatusDestroyed ||
+		status == core.InstanceStatusDeployRollbackComplete ||
+		status == core.InstanceStatusUpdateRollbackComplete ||
+		status == core.InstanceStatusDestroyRollbackComplete
+}
+
+// The ID and name of the instance for a deployment summary, the ID or name
+// must be resolved from the persisted state when only one of them
+// is provided for an operation.
+func (c *defaultBlueprintContainer) summaryInstance(
+	ctx context.Context,
+	instanceID string,
+	instanceName string,
+) (string, string) {
+	if !c.hooks.HasSummaryHooks() {
+		return instanceID, instanceName
+	}
+
+	if instanceID == "" && instanceName != "" {
+		resolvedInstanceID, err := c.stateContainer.
+			Instances().
+			LookupIDByName(ctx, instanceName)
+		if err != nil {
+			return instanceID, instanceName
+		}
+		return resolvedInstanceID, instanceName
+	}
+
+	if instanceName == "" && instanceID != "" {
+		instanceState, err := c.stateContainer.Instances().Get(ctx, instanceID)
+		if err != nil {
+			return instanceID, instanceName
+		}
+		return instanceID, instanceState.InstanceName
+	}
+
+	return instanceID, instanceName
+}
@@ -1907,6 +1907,7 @@ func createDeployFinishedInstanceStatusInfo(
 	msg *DeploymentFinishedMessage,
 	rollingBack bool,
 	isNew bool,
+	ttl time.Duration,
 ) state.InstanceStatusInfo {
 	updateTimestamp := int(msg.UpdateTimestamp)
 	instanceStatusInfo := state.InstanceStatusInfo{
@@ -1920,6 +1921,11 @@ func createDeployFinishedInstanceStatusInfo(
 	if wasDeploymentSuccessful(msg, rollingBack, isNew) {
 		finishTimestamp := int(msg.FinishTimestamp)
 		instanceStatusInfo.LastDeployedTimestamp = &finishTimestamp
+
+		if ttl > 0 {
+			expiresAt := finishTimestamp + int(ttl.Seconds())
+			instanceStatusInfo.ExpiresAt = &expiresAt
+		}
 	}
 
 	return instanceStatusInfo
@@ -2057,6 +2063,47 @@ func getRetryPolicy(
 	return retryPolicy, nil
 }
 
+// Determines whether an error returned by a resource implementation
+// indicates that the resource no longer exists in the upstream provider.
+// Errors wrapped in a provider.ResourceNotFoundError are always treated as
+// not found errors, otherwise the provider of the resource is given the
+// opportunity to classify the error when it implements
+// provider.ResourceErrorClassifier.
+func isResourceNotFoundError(
+	ctx context.Context,
+	err error,
+	resourceName string,
+	resourceType string,
+	resourceProviders map[string]provider.Provider,
+) (bool, error) {
+	if provider.IsResourceNotFoundError(err) {
+		return true, nil
+	}
+
+	resourceProvider, ok := resourceProviders[resourceName]
+	if !ok {
+		return false, nil
+	}
+
+	classifier, isClassifier := resourceProvider.(provider.ResourceErrorClassifier)
+	if !isClassifier {
+		return false, nil
+	}
+
+	errorClass, classifyErr := classifier.ClassifyResourceError(
+		ctx,
+		&provider.ResourceErrorClassifyInput{
+			ResourceType: resourceType,
+			Error:        err,
+		},
+	)
+	if classifyErr != nil {
+		return false, classifyErr
+	}
+
+	return errorClass == provider.ResourceErrorClassNotFound, nil
+}
+
 func createElementFromDeploymentNode(
 	node *DeploymentNode,
 ) state.Element {
@@ -2321,6 +2368,13 @@ func isTerminalResourceFailure(msg ResourceDeployUpdateMessage, rollback bool) b
 		return false
 	}
 
+	// Resource deployers only report an interrupted status when a provider
+	// operation has exceeded its configured timeout, at this point the state
+	// of the resource is unknown so no further elements should be deployed.
+	if isInterruptedPreciseResourceStatus(msg.PreciseStatus) {
+		return true
+	}
+
 	if rollback {
 		return msg.PreciseStatus == core.PreciseResourceStatusDestroyRollbackFailed ||
 			msg.PreciseStatus == core.PreciseResourceStatusUpdateRollbackFailed ||
@@ -0,0 +1,214 @@
+package container
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+// stagedChangesDeprecations collects the deprecated resource types and
+// resource spec fields used by the resources that will be created or updated
+// when deploying the provided changes.
+// Deprecations for child blueprints are taken from the deprecations collected
+// when staging changes for the child blueprints.
+func (c *defaultBlueprintContainer) stagedChangesDeprecations(
+	ctx context.Context,
+	blueprintChanges *changes.BlueprintChanges,
+	paramOverrides core.BlueprintParams,
+) []*changes.Deprecation {
+	// Spec definitions are cached by resource type as a blueprint will often
+	// contain multiple resources of the same type.
+	specDefinitions := map[string]*provider.ResourceSpecDefinition{}
+	deprecations := []*changes.Deprecation{}
+
+	for resourceName, resourceChanges := range blueprintChanges.NewResources {
+		deprecations = append(
+			deprecations,
+			c.resourceDeprecations(
+				ctx,
+				resourceName,
+				resourceChanges.AppliedResourceInfo.ResourceWithResolvedSubs,
+				specDefinitions,
+				paramOverrides,
+			)...,
+		)
+	}
+
+	for resourceName, resourceChanges := range blueprintChanges.ResourceChanges {
+		deprecations = append(
+			deprecations,
+			c.resourceDeprecations(
+				ctx,
+				resourceName,
+				resourceChanges.AppliedResourceInfo.ResourceWithResolvedSubs,
+				specDefinitions,
+				paramOverrides,
+			)...,
+		)
+	}
+
+	for childName, newChild := range blueprintChanges.NewChildren {
+		deprecations = append(
+			deprecations,
+			changes.ChildDeprecations(childName, newChild.Deprecations)...,
+		)
+	}
+
+	for childName, childChanges := range blueprintChanges.ChildChanges {
+		deprecations = append(
+			deprecations,
+			changes.ChildDeprecations(childName, childChanges.Deprecations)...,
+		)
+	}
+
+	return changes.SortDeprecations(deprecations)
+}
+
+func (c *defaultBlueprintContainer) resourceDeprecations(
+	ctx context.Context,
+	resourceName string,
+	resolvedResource *provider.ResolvedResource,
+	specDefinitions map[string]*provider.ResourceSpecDefinition,
+	paramOverrides core.BlueprintParams,
+) []*changes.Deprecation {
+	if resolvedResource == nil || resolvedResource.Type == nil {
+		return nil
+	}
+
+	resourceType := resolvedResource.Type.Value
+	specDefinition := c.deprecationsSpecDefinition(
+		ctx,
+		resourceType,
+		specDefinitions,
+		paramOverrides,
+	)
+	if specDefinition == nil {
+		return nil
+	}
+
+	deprecations := []*changes.Deprecation{}
+	if specDefinition.Deprecated {
+		deprecations = append(deprecations, &changes.Deprecation{
+			ResourceName: resourceName,
+			ResourceType: resourceType,
+			Message:      specDefinition.DeprecationMessage,
+		})
+	}
+
+	collectDeprecatedFields(
+		&deprecatedFieldsContext{
+			resourceName: resourceName,
+			resourceType: resourceType,
+			deprecations: &deprecations,
+		},
+		resolvedResource.Spec,
+		specDefinition.Schema,
+		"spec",
+		/* depth */ 0,
+	)
+
+	return deprecations
+}
+
+// Deprecations are informational, failing to load the spec definition
+// for a resource type should not prevent changes from being staged,
+// so errors are logged and the resource type is skipped.
+func (c *defaultBlueprintContainer) deprecationsSpecDefinition(
+	ctx context.Context,
+	resourceType string,
+	specDefinitions map[string]*provider.ResourceSpecDefinition,
+	paramOverrides core.BlueprintParams,
+) *provider.ResourceSpecDefinition {
+	if specDefinition, loaded := specDefinitions[resourceType]; loaded {
+		return specDefinition
+	}
+
+	specDefOutput, err := c.resourceRegistry.GetSpecDefinition(
+		ctx,
+		resourceType,
+		&provider.ResourceGetSpecDefinitionInput{
+			ProviderContext: provider.NewProviderContextFromParams(
+				provider.ExtractProviderFromItemType(resourceType),
+				paramOverrides,
+			),
+		},
+	)
+	if err != nil {
+		c.logger.Debug(
+			"failed to load spec definition to check for deprecations",
+			core.StringLogField("resourceType", resourceType),
+			core.ErrorLogField("error", err),
+		)
+	}
+
+	var specDefinition *provider.ResourceSpecDefinition
+	if err == nil && specDefOutput != nil {
+		specDefinition = specDefOutput.SpecDefinition
+	}
+	specDefinitions[resourceType] = specDefinition
+	return specDefinition
+}
+
+type deprecatedFieldsContext struct {
+	resourceName string
+	resourceType string
+	deprecations *[]*changes.Deprecation
+}
+
+func collectDeprecatedFields(
+	fieldsCtx *deprecatedFieldsContext,
+	node *core.MappingNode,
+	fieldSchema *provider.ResourceDefinitionsSchema,
+	path string,
+	depth int,
+) {
+	if node == nil || fieldSchema == nil || depth >= core.MappingNodeMaxTraverseDepth {
+		return
+	}
+
+	if fieldSchema.Deprecated {
+		*fieldsCtx.deprecations = append(*fieldsCtx.deprecations, &changes.Deprecation{
+			ResourceName: fieldsCtx.resourceName,
+			ResourceType: fieldsCtx.resourceType,
+			FieldPath:    path,
+			Message:      fieldSchema.DeprecationMessage,
+		})
+	}
+
+	switch fieldSchema.Type {
+	case provider.ResourceDefinitionsSchemaTypeObject:
+		for fieldName, attrSchema := range fieldSchema.Attributes {
+			collectDeprecatedFields(
+				fieldsCtx,
+				node.Fields[fieldName],
+				attrSchema,
+				substitutions.RenderFieldPath(path, fieldName),
+				depth+1,
+			)
+		}
+	case provider.ResourceDefinitionsSchemaTypeMap:
+		for key, value := range node.Fields {
+			collectDeprecatedFields(
+				fieldsCtx,
+				value,
+				fieldSchema.MapValues,
+				substitutions.RenderFieldPath(path, key),
+				depth+1,
+			)
+		}
+	case provider.ResourceDefinitionsSchemaTypeArray:
+		for index, item := range node.Items {
+			collectDeprecatedFields(
+				fieldsCtx,
+				item,
+				fieldSchema.Items,
+				fmt.Sprintf("%s[%d]", path, index),
+				depth+1,
+			)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package container
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeprecationsTestSuite struct {
+	suite.Suite
+	container *defaultBlueprintContainer
+}
+
+func (s *DeprecationsTestSuite) SetupTest() {
+	s.container = &defaultBlueprintContainer{
+		resourceRegistry: internal.NewResourceRegistryMock(
+			map[string]provider.Resource{
+				"test/legacyQueue": &deprecationsTestResource{
+					specDefinition: &provider.ResourceSpecDefinition{
+						Schema:             deprecationsTestSchema(),
+						Deprecated:         true,
+						DeprecationMessage: "use test/queue instead",
+					},
+				},
+				"test/function": &deprecationsTestResource{
+					specDefinition: &provider.ResourceSpecDefinition{
+						Schema: deprecationsTestSchema(),
+					},
+				},
+			},
+		),
+		logger: core.NewNopLogger(),
+	}
+}
+
+func (s *DeprecationsTestSuite) Test_collects_deprecated_resource_types_and_fields() {
+	deprecations := s.container.stagedChangesDeprecations(
+		context.Background(),
+		&changes.BlueprintChanges{
+			NewResources: map[string]provider.Changes{
+				"ordersQueue": deprecationsTestResourceChanges(
+					"test/legacyQueue",
+					&core.MappingNode{Fields: map[string]*core.MappingNode{}},
+				),
+				"newHandler": deprecationsTestResourceChanges(
+					"test/function",
+					&core.MappingNode{
+						Fields: map[string]*core.MappingNode{
+							"handler": core.MappingNodeFromString("index.handler"),
+						},
+					},
+				),
+			},
+			ResourceChanges: map[string]provider.Changes{
+				"ordersHandler": deprecationsTestResourceChanges(
+					"test/function",
+					&core.MappingNode{
+						Fields: map[string]*core.MappingNode{
+							"runtime": core.MappingNodeFromString("nodejs16.x"),
+							"layers": {
+								Items: []*core.MappingNode{
+									{
+										Fields: map[string]*core.MappingNode{
+											"version": core.MappingNodeFromInt(3),
+										},
+									},
+								},
+							},
+						},
+					},
+				),
+			},
+			ChildChanges: map[string]changes.BlueprintChanges{
+				"networking": {
+					Deprecations: []*changes.Deprecation{
+						{
+							ResourceName: "vpc",
+							ResourceType: "test/vpc",
+							FieldPath:    "spec.classicLink",
+						},
+					},
+				},
+			},
+		},
+		nil,
+	)
+
+	s.Equal(
+		[]*changes.Deprecation{
+			{
+				ResourceName: "ordersHandler",
+				ResourceType: "test/function",
+				FieldPath:    "spec.layers[0].version",
+				Message:      "use spec.layers[].arn instead",
+			},
+			{
+				ResourceName: "ordersHandler",
+				ResourceType: "test/function",
+				FieldPath:    "spec.runtime",
+				Message:      "use spec.runtimeVersion instead",
+			},
+			{
+				ResourceName: "ordersQueue",
+				ResourceType: "test/legacyQueue",
+				Message:      "use test/queue instead",
+			},
+			{
+				ChildPath:    "networking",
+				ResourceName: "vpc",
+				ResourceType: "test/vpc",
+				FieldPath:    "spec.classicLink",
+			},
+		},
+		deprecations,
+	)
+}
+
+func (s *DeprecationsTestSuite) Test_produces_no_deprecations_when_deprecated_elements_are_not_used() {
+	deprecations := s.container.stagedChangesDeprecations(
+		context.Background(),
+		&changes.BlueprintChanges{
+			NewResources: map[string]provider.Changes{
+				"newHandler": deprecationsTestResourceChanges(
+					"test/function",
+					&core.MappingNode{
+						Fields: map[string]*core.MappingNode{
+							"handler": core.MappingNodeFromString("index.handler"),
+						},
+					},
+				),
+				// Resource types that can not be loaded are skipped.
+				"widget": deprecationsTestResourceChanges(
+					"other/widget",
+					&core.MappingNode{Fields: map[string]*core.MappingNode{}},
+				),
+			},
+		},
+		nil,
+	)
+
+	s.Nil(deprecations)
+}
+
+func deprecationsTestSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type: provider.ResourceDefinitionsSchemaTypeObject,
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"handler": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+			},
+			"runtime": {
+				Type:               provider.ResourceDefinitionsSchemaTypeString,
+				Deprecated:         true,
+				DeprecationMessage: "use spec.runtimeVersion instead",
+			},
+			"layers": {
+				Type: provider.ResourceDefinitionsSchemaTypeArray,
+				Items: &provider.ResourceDefinitionsSchema{
+					Type: provider.ResourceDefinitionsSchemaTypeObject,
+					Attributes: map[string]*provider.ResourceDefinitionsSchema{
+						"arn": {
+							Type: provider.ResourceDefinitionsSchemaTypeString,
+						},
+						"version": {
+							Type:               provider.ResourceDefinitionsSchemaTypeInteger,
+							Deprecated:         true,
+							DeprecationMessage: "use spec.layers[].arn instead",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func deprecationsTestResourceChanges(
+	resourceType string,
+	spec *core.MappingNode,
+) provider.Changes {
+	return provider.Changes{
+		AppliedResourceInfo: provider.ResourceInfo{
+			ResourceWithResolvedSubs: &provider.ResolvedResource{
+				Type: &schema.ResourceTypeWrapper{Value: resourceType},
+				Spec: spec,
+			},
+		},
+	}
+}
+
+type deprecationsTestResource struct {
+	provider.Resource
+	specDefinition *provider.ResourceSpecDefinition
+}
+
+func (r *deprecationsTestResource) GetSpecDefinition(
+	ctx context.Context,
+	input *provider.ResourceGetSpecDefinitionInput,
+) (*provider.ResourceGetSpecDefinitionOutput, error) {
+	return &provider.ResourceGetSpecDefinitionOutput{
+		SpecDefinition: r.specDefinition,
+	}, nil
+}
+
+func TestDeprecationsTestSuite(t *testing.T) {
+	suite.Run(t, new(DeprecationsTestSuite))
+}
@@ -103,6 +103,21 @@ func (d *defaultResourceDestroyer) Destroy(
 		return
 	}
 
+	timeout, err := resolveResourceOperationTimeout(
+		ctx,
+		resourceTimeoutOperationDestroy,
+		getBlueprintResourceForDestroy(deployCtx, resourceElement.LogicalName()),
+		resourceImplementation,
+		provider.NewProviderContextFromParams(
+			provider.ExtractProviderFromItemType(resourceState.Type),
+			deployCtx.ParamOverrides,
+		),
+	)
+	if err != nil {
+		deployCtx.Channels.ErrChan <- err
+		return
+	}
+
 	err = d.destroyResource(
 		ctx,
 		&deploymentElementInfo{
@@ -111,6 +126,7 @@ func (d *defaultResourceDestroyer) Destroy(
 		},
 		deployCtx.InstanceStateSnapshot.InstanceName,
 		resourceImplementation,
+		timeout,
 		deployCtx,
 		provider.CreateRetryContext(policy),
 	)
@@ -159,6 +175,7 @@ func (d *defaultResourceDestroyer) destroyResource(
 	resourceInfo *deploymentElementInfo,
 	instanceName string,
 	resourceImplementation provider.Resource,
+	timeout time.Duration,
 	deployCtx *DeployContext,
 	resourceRetryInfo *provider.RetryContext,
 ) error {
@@ -183,8 +200,11 @@ func (d *defaultResourceDestroyer) destroyResource(
 		deployCtx.InstanceStateSnapshot,
 		resourceInfo.element.LogicalName(),
 	)
+	operationCtx, cancelOperation := contextWithResourceOperationTimeout(ctx, timeout)
+	defer cancelOperation()
+
 	providerNamespace := provider.ExtractProviderFromItemType(resourceState.Type)
-	err := resourceImplementation.Destroy(ctx, &provider.ResourceDestroyInput{
+	err := resourceImplementation.Destroy(operationCtx, &provider.ResourceDestroyInput{
 		InstanceID:    resourceInfo.instanceID,
 		InstanceName:  instanceName,
 		ResourceID:    resourceInfo.element.ID(),
@@ -201,7 +221,46 @@ func (d *defaultResourceDestroyer) destroyResource(
 			},
 		),
 	})
+	if err != nil && resourceOperationTimedOut(operationCtx) {
+		deployCtx.Logger.Debug(
+			"resource destruction timed out",
+			core.IntegerLogField("attempt", int64(resourceRetryInfo.Attempt)),
+			core.ErrorLogField("error", err),
+		)
+		return d.handleDestroyResourceTimeout(
+			resourceInfo,
+			provider.RetryContextWithStartTime(resourceRetryInfo, resourceRemovalStartTime),
+			timeout,
+			deployCtx,
+		)
+	}
+
 	if err != nil {
+		isNotFound, classifyErr := isResourceNotFoundError(
+			ctx,
+			err,
+			resourceInfo.element.LogicalName(),
+			resourceState.Type,
+			deployCtx.ResourceProviders,
+		)
+		if classifyErr != nil {
+			return classifyErr
+		}
+
+		if isNotFound {
+			deployCtx.Logger.Info(
+				"resource no longer exists in the upstream provider, "+
+					"treating it as destroyed",
+				core.IntegerLogField("attempt", int64(resourceRetryInfo.Attempt)),
+				core.ErrorLogField("error", err),
+			)
+			return d.handleDestroyResourceAlreadyRemoved(
+				resourceInfo,
+				provider.RetryContextWithStartTime(resourceRetryInfo, resourceRemovalStartTime),
+				deployCtx,
+			)
+		}
+
 		var retryErr *provider.RetryableError
 		if provider.AsRetryableError(err, &retryErr) {
 			deployCtx.Logger.Debug(
@@ -214,6 +273,7 @@ func (d *defaultResourceDestroyer) destroyResource(
 				resourceInfo,
 				instanceName,
 				resourceImplementation,
+				timeout,
 				provider.RetryContextWithStartTime(resourceRetryInfo, resourceRemovalStartTime),
 				[]string{retryErr.ChildError.Error()},
 				deployCtx,
@@ -272,6 +332,7 @@ func (d *defaultResourceDestroyer) handleDestroyResourceRetry(
 	resourceInfo *deploymentElementInfo,
 	instanceName string,
 	resourceImplementation provider.Resource,
+	timeout time.Duration,
 	resourceRetryInfo *provider.RetryContext,
 	failureReasons []string,
 	deployCtx *DeployContext,
@@ -307,6 +368,7 @@ func (d *defaultResourceDestroyer) handleDestroyResourceRetry(
 			resourceInfo,
 			instanceName,
 			resourceImplementation,
+			timeout,
 			deployCtx,
 			nextRetryInfo,
 		)
@@ -350,3 +412,71 @@ func (d *defaultResourceDestroyer) handleDestroyResourceTerminalFailure(
 
 	return nil
 }
+
+// Marks a resource as destroyed when the provider reports that
+// the resource no longer exists, this allows the removal of resources
+// that have been deleted outside of Bluelink to run to completion.
+func (d *defaultResourceDestroyer) handleDestroyResourceAlreadyRemoved(
+	resourceInfo *deploymentElementInfo,
+	resourceRetryInfo *provider.RetryContext,
+	deployCtx *DeployContext,
+) error {
+	deployCtx.Channels.ResourceUpdateChan <- ResourceDeployUpdateMessage{
+		InstanceID:      resourceInfo.instanceID,
+		ResourceID:      resourceInfo.element.ID(),
+		ResourceName:    resourceInfo.element.LogicalName(),
+		Group:           deployCtx.CurrentGroupIndex,
+		Status:          determineResourceDestroyedStatus(deployCtx.Rollback),
+		PreciseStatus:   determinePreciseResourceDestroyedStatus(deployCtx.Rollback),
+		UpdateTimestamp: d.clock.Now().Unix(),
+		Attempt:         resourceRetryInfo.Attempt,
+		Durations: determineResourceDeployFinishedDurations(
+			resourceRetryInfo,
+			d.clock.Since(resourceRetryInfo.AttemptStartTime),
+			/* configCompleteDuration */ nil,
+		),
+		AlreadyDestroyed: true,
+	}
+
+	return nil
+}
+
+// Marks a resource as interrupted when the provider did not finish
+// destroying the resource within the configured timeout.
+// The resource is kept in state so that it can be reconciled.
+func (d *defaultResourceDestroyer) handleDestroyResourceTimeout(
+	resourceInfo *deploymentElementInfo,
+	resourceRetryInfo *provider.RetryContext,
+	timeout time.Duration,
+	deployCtx *DeployContext,
+) error {
+	status, preciseStatus := determineResourceInterruptedStatus(
+		/* destroying */ true,
+		deployCtx.Rollback,
+		/* isNew */ nil,
+	)
+	deployCtx.Channels.ResourceUpdateChan <- ResourceDeployUpdateMessage{
+		InstanceID:    resourceInfo.instanceID,
+		ResourceID:    resourceInfo.element.ID(),
+		ResourceName:  resourceInfo.element.LogicalName(),
+		Group:         deployCtx.CurrentGroupIndex,
+		Status:        status,
+		PreciseStatus: preciseStatus,
+		FailureReasons: []string{
+			resourceOperationTimeoutFailureMessage(
+				resourceTimeoutOperationDestroy,
+				timeout,
+			),
+		},
+		Attempt:         resourceRetryInfo.Attempt,
+		CanRetry:        false,
+		UpdateTimestamp: d.clock.Now().Unix(),
+		Durations: determineResourceDeployFinishedDurations(
+			resourceRetryInfo,
+			d.clock.Since(resourceRetryInfo.AttemptStartTime),
+			/* configCompleteDuration */ nil,
+		),
+	}
+
+	return nil
+}
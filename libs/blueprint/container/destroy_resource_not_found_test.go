@@ -0,0 +1,202 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/mockclock"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type DestroyResourceNotFoundTestSuite struct {
+	suite.Suite
+}
+
+func (s *DestroyResourceNotFoundTestSuite) Test_reports_destroyed_when_provider_returns_not_found_error() {
+	channels, deployCtx := s.createDeployContext(
+		map[string]provider.Provider{},
+	)
+
+	go s.destroy(
+		deployCtx,
+		&missingResource{
+			DynamoDBTableResource: &internal.DynamoDBTableResource{},
+			err: &provider.ResourceNotFoundError{
+				ChildError: errors.New("table ordersTable does not exist"),
+			},
+		},
+	)
+
+	msg := s.waitForTerminalMessage(channels)
+	s.Assert().Equal(core.ResourceStatusDestroyed, msg.Status)
+	s.Assert().Equal(core.PreciseResourceStatusDestroyed, msg.PreciseStatus)
+	s.Assert().True(msg.AlreadyDestroyed)
+	s.Assert().Empty(msg.FailureReasons)
+}
+
+func (s *DestroyResourceNotFoundTestSuite) Test_reports_destroyed_when_provider_classifies_error_as_not_found() {
+	channels, deployCtx := s.createDeployContext(
+		map[string]provider.Provider{
+			"ordersTable": &classifyingProvider{
+				ProviderMock: &internal.ProviderMock{NamespaceValue: "aws"},
+			},
+		},
+	)
+
+	go s.destroy(
+		deployCtx,
+		&missingResource{
+			DynamoDBTableResource: &internal.DynamoDBTableResource{},
+			err: &provider.ResourceDestroyError{
+				FailureReasons: []string{"ResourceNotFoundException"},
+			},
+		},
+	)
+
+	msg := s.waitForTerminalMessage(channels)
+	s.Assert().Equal(core.ResourceStatusDestroyed, msg.Status)
+	s.Assert().Equal(core.PreciseResourceStatusDestroyed, msg.PreciseStatus)
+	s.Assert().True(msg.AlreadyDestroyed)
+}
+
+func (s *DestroyResourceNotFoundTestSuite) Test_reports_failure_for_errors_not_classified_as_not_found() {
+	channels, deployCtx := s.createDeployContext(
+		map[string]provider.Provider{
+			"ordersTable": &classifyingProvider{
+				ProviderMock: &internal.ProviderMock{NamespaceValue: "aws"},
+			},
+		},
+	)
+
+	go s.destroy(
+		deployCtx,
+		&missingResource{
+			DynamoDBTableResource: &internal.DynamoDBTableResource{},
+			err: &provider.ResourceDestroyError{
+				FailureReasons: []string{"AccessDeniedException"},
+			},
+		},
+	)
+
+	msg := s.waitForTerminalMessage(channels)
+	s.Assert().Equal(core.ResourceStatusDestroyFailed, msg.Status)
+	s.Assert().Equal(core.PreciseResourceStatusDestroyFailed, msg.PreciseStatus)
+	s.Assert().False(msg.AlreadyDestroyed)
+	s.Assert().Equal([]string{"AccessDeniedException"}, msg.FailureReasons)
+}
+
+func (s *DestroyResourceNotFoundTestSuite) createDeployContext(
+	resourceProviders map[string]provider.Provider,
+) (*DeployChannels, *DeployContext) {
+	channels := CreateDeployChannels()
+	return channels, &DeployContext{
+		Channels:          channels,
+		State:             NewDefaultDeploymentState(),
+		Logger:            core.NewNopLogger(),
+		ParamOverrides:    createParams(),
+		ResourceProviders: resourceProviders,
+		InstanceStateSnapshot: &state.InstanceState{
+			InstanceID: "instance-1",
+			ResourceIDs: map[string]string{
+				"ordersTable": "resource-1",
+			},
+			Resources: map[string]*state.ResourceState{
+				"resource-1": {
+					ResourceID: "resource-1",
+					Name:       "ordersTable",
+					Type:       "aws/dynamodb/table",
+					InstanceID: "instance-1",
+				},
+			},
+		},
+	}
+}
+
+func (s *DestroyResourceNotFoundTestSuite) destroy(
+	deployCtx *DeployContext,
+	resourceImpl provider.Resource,
+) {
+	destroyer := &defaultResourceDestroyer{
+		clock:              &mockclock.StaticClock{},
+		defaultRetryPolicy: provider.DefaultRetryPolicy,
+	}
+	err := destroyer.destroyResource(
+		context.Background(),
+		&deploymentElementInfo{
+			element: &ResourceIDInfo{
+				ResourceID:   "resource-1",
+				ResourceName: "ordersTable",
+			},
+			instanceID: "instance-1",
+		},
+		"instance-name-1",
+		resourceImpl,
+		/* timeout */ 0,
+		deployCtx,
+		provider.CreateRetryContext(provider.DefaultRetryPolicy),
+	)
+	if err != nil {
+		deployCtx.Channels.ErrChan <- err
+	}
+}
+
+func (s *DestroyResourceNotFoundTestSuite) waitForTerminalMessage(
+	channels *DeployChannels,
+) ResourceDeployUpdateMessage {
+	for {
+		select {
+		case msg := <-channels.ResourceUpdateChan:
+			if msg.PreciseStatus != core.PreciseResourceStatusDestroying {
+				return msg
+			}
+		case err := <-channels.ErrChan:
+			s.Require().NoError(err)
+		case <-time.After(5 * time.Second):
+			s.Require().Fail("timed out waiting for resource destroy status update")
+		}
+	}
+}
+
+// missingResource is a resource where the destroy operation
+// fails with a pre-configured error.
+type missingResource struct {
+	*internal.DynamoDBTableResource
+	err error
+}
+
+func (r *missingResource) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	return r.err
+}
+
+// classifyingProvider is a provider that classifies destroy errors
+// with a "ResourceNotFoundException" failure reason as not found errors.
+type classifyingProvider struct {
+	*internal.ProviderMock
+}
+
+func (p *classifyingProvider) ClassifyResourceError(
+	ctx context.Context,
+	input *provider.ResourceErrorClassifyInput,
+) (provider.ResourceErrorClass, error) {
+	var destroyErr *provider.ResourceDestroyError
+	if provider.AsResourceDestroyError(input.Error, &destroyErr) &&
+		len(destroyErr.FailureReasons) == 1 &&
+		destroyErr.FailureReasons[0] == "ResourceNotFoundException" {
+		return provider.ResourceErrorClassNotFound, nil
+	}
+
+	return provider.ResourceErrorClassUnknown, nil
+}
+
+func TestDestroyResourceNotFoundTestSuite(t *testing.T) {
+	suite.Run(t, new(DestroyResourceNotFoundTestSuite))
+}
@@ -0,0 +1,144 @@
+package container
+
+import (
+	"context"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+const (
+	// EvaluatedExpressionElementName is the element name used to resolve
+	// an expression evaluated against a blueprint, this will be the element name
+	// in errors and the field path in the trace for the expression.
+	EvaluatedExpressionElementName = "expression"
+)
+
+// EvaluateExpressionInput provides the input for evaluating
+// a substitution expression against a loaded blueprint.
+type EvaluateExpressionInput struct {
+	// Expression is the contents of a substitution without the
+	// surrounding "${" and "}" (e.g. "resources.ordersTable.spec.arn").
+	Expression string
+	// InstanceID is the ID of an existing blueprint instance to resolve
+	// computed fields of resources from.
+	// This should be left empty if InstanceName is provided.
+	InstanceID string
+	// InstanceName is the user-defined name of an existing blueprint instance
+	// to resolve computed fields of resources from.
+	// This should be left empty if InstanceID is provided.
+	InstanceName string
+	// Trace determines whether to record the reference lookups and
+	// function calls carried out to evaluate the expression.
+	Trace bool
+}
+
+// EvaluatedExpression holds the result of evaluating
+// a substitution expression against a loaded blueprint.
+type EvaluatedExpression struct {
+	// Value is the resolved value of the expression, this will be
+	// RedactedSecretPlaceholder when the expression depends on a secret
+	// variable or value.
+	Value *core.MappingNode `json:"value,omitempty"`
+	// KnownOnDeploy is true when the expression depends on values that can
+	// only be known after deployment and no blueprint instance was provided
+	// to resolve them from.
+	KnownOnDeploy bool `json:"knownOnDeploy"`
+	// Trace holds the steps carried out to evaluate the expression keyed by
+	// the full path to the field being resolved, the steps for the expression
+	// itself are keyed by EvaluatedExpressionElementName.
+	// This is only populated when tracing is requested.
+	Trace map[string][]*subengine.SubstitutionTraceEntry `json:"trace,omitempty"`
+}
+
+func (c *defaultBlueprintContainer) EvaluateExpression(
+	ctx context.Context,
+	input *EvaluateExpressionInput,
+	paramOverrides core.BlueprintParams,
+) (*EvaluatedExpression, error) {
+	expression, err := substitutions.ParseSubstitution(
+		EvaluatedExpressionElementName,
+		strings.TrimSpace(input.Expression),
+		/* parentSourceStart */ &source.Meta{Position: source.Position{}},
+		/* outputLineInfo */ false,
+		/* ignoreParentColumn */ true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceID, err := c.getInstanceID(ctx, input.InstanceID, input.InstanceName)
+	if err != nil {
+		return nil, err
+	}
+	if instanceID == "" && input.InstanceName != "" {
+		return nil, state.InstanceNotFoundError(input.InstanceName)
+	}
+
+	// Resources must be resolved before the expression so that
+	// references to resources can be resolved from the resource cache.
+	_, err = c.Render(ctx, paramOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	// Computed fields of resources can be resolved from the state
+	// of an existing instance, otherwise they are only known on deploy.
+	resolveFor := subengine.ResolveForChangeStaging
+	evalCtx := ctx
+	if instanceID != "" {
+		resolveFor = subengine.ResolveForDeployment
+		evalCtx = context.WithValue(evalCtx, core.BlueprintInstanceIDKey, instanceID)
+	}
+
+	// A trace is always recorded to determine whether the expression
+	// depends on a secret, even when tracing has not been requested.
+	trace := subengine.NewSubstitutionTrace()
+	resolveResult, err := c.substitutionResolver.ResolveSubstitution(
+		subengine.WithSubstitutionTrace(evalCtx, trace),
+		&substitutions.StringOrSubstitution{
+			SubstitutionValue: expression,
+		},
+		EvaluatedExpressionElementName,
+		/* inElementProperty */ "",
+		&subengine.ResolveTargetInfo{
+			ResolveFor: resolveFor,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluated := &EvaluatedExpression{
+		Value:         resolveResult.Resolved,
+		KnownOnDeploy: len(resolveResult.ResolveOnDeploy) > 0,
+	}
+	if evaluated.KnownOnDeploy {
+		evaluated.Value = core.MappingNodeFromString(KnownOnDeployPlaceholder)
+	}
+
+	fields := trace.Fields()
+	if dependsOnSecret(fields[EvaluatedExpressionElementName]) {
+		evaluated.Value = core.MappingNodeFromString(RedactedSecretPlaceholder)
+	}
+
+	if input.Trace {
+		evaluated.Trace = redactTrace(fields, c.spec.Schema())
+	}
+
+	return evaluated, nil
+}
+
+func dependsOnSecret(entries []*subengine.SubstitutionTraceEntry) bool {
+	for _, entry := range entries {
+		if entry.Depth == 0 && entry.Secret {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,221 @@
+package container
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/memstate"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/providerhelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/transform"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testEvaluateInstanceID   = "evaluate-instance-id"
+	testEvaluateInstanceName = "evaluate-instance"
+)
+
+type EvaluateExpressionTestSuite struct {
+	blueprintContainer BlueprintContainer
+	suite.Suite
+}
+
+func (s *EvaluateExpressionTestSuite) SetupTest() {
+	stateContainer := memstate.NewMemoryStateContainer()
+	err := stateContainer.Instances().Save(
+		context.Background(),
+		createEvaluateTestInstanceState(),
+	)
+	s.Require().NoError(err)
+
+	providers := map[string]provider.Provider{
+		"aws": newTestAWSProvider(
+			/* alwaysStabilise */ false,
+			/* skipRetryFailuresForLinkNames */ []string{},
+			stateContainer,
+		),
+		"core": providerhelpers.NewCoreProvider(
+			stateContainer.Links(),
+			core.BlueprintInstanceIDFromContext,
+			os.Getwd,
+			provider.NewFileSourceRegistry(),
+			core.SystemClock{},
+		),
+	}
+	loader := NewDefaultLoader(
+		providers,
+		map[string]transform.SpecTransformer{},
+		stateContainer,
+		newFSChildResolver(),
+		WithLoaderTransformSpec(false),
+		WithLoaderRefChainCollectorFactory(refgraph.NewRefChainCollector),
+	)
+
+	blueprintContainer, err := loader.Load(
+		context.Background(),
+		"__testdata/container/render/blueprint1.yml",
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+	s.blueprintContainer = blueprintContainer
+}
+
+func (s *EvaluateExpressionTestSuite) Test_evaluates_reference_to_resolved_resource() {
+	evaluated, err := s.blueprintContainer.EvaluateExpression(
+		context.Background(),
+		&EvaluateExpressionInput{
+			Expression: "resources.ordersTable.spec.tableName",
+		},
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+	s.False(evaluated.KnownOnDeploy)
+	s.Equal("production-orders", core.StringValue(evaluated.Value))
+	s.Nil(evaluated.Trace)
+}
+
+func (s *EvaluateExpressionTestSuite) Test_evaluates_function_call() {
+	evaluated, err := s.blueprintContainer.EvaluateExpression(
+		context.Background(),
+		&EvaluateExpressionInput{
+			Expression: "to_upper(values.tablePrefix)",
+		},
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+	s.Equal("PRODUCTION-ORDERS", core.StringValue(evaluated.Value))
+}
+
+func (s *EvaluateExpressionTestSuite) Test_marks_computed_field_as_known_on_deploy_without_instance() {
+	evaluated, err := s.blueprintContainer.EvaluateExpression(
+		context.Background(),
+		&EvaluateExpressionInput{
+			Expression: "resources.ordersTable.spec.id",
+		},
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+	s.True(evaluated.KnownOnDeploy)
+	s.Equal(KnownOnDeployPlaceholder, core.StringValue(evaluated.Value))
+}
+
+func (s *EvaluateExpressionTestSuite) Test_resolves_computed_field_from_instance_state() {
+	evaluated, err := s.blueprintContainer.EvaluateExpression(
+		context.Background(),
+		&EvaluateExpressionInput{
+			Expression:   "resources.ordersTable.spec.id",
+			InstanceName: testEvaluateInstanceName,
+		},
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+	s.False(evaluated.KnownOnDeploy)
+	s.Equal(
+		"arn:aws:dynamodb:us-east-1:123456789012:table/production-orders",
+		core.StringValue(evaluated.Value),
+	)
+}
+
+func (s *EvaluateExpressionTestSuite) Test_fails_for_missing_instance() {
+	_, err := s.blueprintContainer.EvaluateExpression(
+		context.Background(),
+		&EvaluateExpressionInput{
+			Expression:   "resources.ordersTable.spec.id",
+			InstanceName: "missing-instance",
+		},
+		createRenderBlueprintParams(),
+	)
+	s.Require().Error(err)
+	stateErr, isStateErr := err.(*state.Error)
+	s.Require().True(isStateErr)
+	s.Equal(state.ErrInstanceNotFound, stateErr.Code)
+}
+
+func (s *EvaluateExpressionTestSuite) Test_redacts_expression_that_depends_on_secret() {
+	evaluated, err := s.blueprintContainer.EvaluateExpression(
+		context.Background(),
+		&EvaluateExpressionInput{
+			Expression: "to_upper(variables.apiKey)",
+			Trace:      true,
+		},
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+	s.Equal(RedactedSecretPlaceholder, core.StringValue(evaluated.Value))
+
+	expressionTrace := evaluated.Trace[EvaluatedExpressionElementName]
+	s.Require().Len(expressionTrace, 2)
+	for _, entry := range expressionTrace {
+		s.True(entry.Secret)
+		s.Equal(RedactedSecretPlaceholder, core.StringValue(entry.Output))
+	}
+}
+
+func (s *EvaluateExpressionTestSuite) Test_records_trace_when_requested() {
+	evaluated, err := s.blueprintContainer.EvaluateExpression(
+		context.Background(),
+		&EvaluateExpressionInput{
+			Expression: "to_upper(variables.environment)",
+			Trace:      true,
+		},
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+
+	expressionTrace := evaluated.Trace[EvaluatedExpressionElementName]
+	s.Require().Len(expressionTrace, 2)
+	s.Equal(subengine.SubstitutionTraceEntryTypeFunctionCall, expressionTrace[0].Type)
+	s.Equal("to_upper(variables.environment)", expressionTrace[0].Expression)
+	s.Equal("PRODUCTION", core.StringValue(expressionTrace[0].Output))
+	s.Equal("variables.environment", expressionTrace[1].Expression)
+	s.Equal(1, expressionTrace[1].Depth)
+}
+
+func (s *EvaluateExpressionTestSuite) Test_fails_for_invalid_expression() {
+	_, err := s.blueprintContainer.EvaluateExpression(
+		context.Background(),
+		&EvaluateExpressionInput{
+			Expression: "variables.",
+		},
+		createRenderBlueprintParams(),
+	)
+	s.Require().Error(err)
+}
+
+func createEvaluateTestInstanceState() state.InstanceState {
+	return state.InstanceState{
+		InstanceID:   testEvaluateInstanceID,
+		InstanceName: testEvaluateInstanceName,
+		Status:       core.InstanceStatusDeployed,
+		Resources: map[string]*state.ResourceState{
+			"resource-orders-table": {
+				ResourceID: "resource-orders-table",
+				Name:       "ordersTable",
+				Type:       "aws/dynamodb/table",
+				InstanceID: testEvaluateInstanceID,
+				Status:     core.ResourceStatusCreated,
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"tableName": core.MappingNodeFromString("production-orders"),
+						"id": core.MappingNodeFromString(
+							"arn:aws:dynamodb:us-east-1:123456789012:table/production-orders",
+						),
+					},
+				},
+			},
+		},
+		ResourceIDs: map[string]string{
+			"ordersTable": "resource-orders-table",
+		},
+	}
+}
+
+func TestEvaluateExpressionTestSuite(t *testing.T) {
+	suite.Run(t, new(EvaluateExpressionTestSuite))
+}
@@ -0,0 +1,297 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+const (
+	// ExpiredInstanceReaperInitiator is used to identify the expired instance reaper
+	// as the initiator of destroy operations in the audit trail
+	// for a blueprint instance.
+	ExpiredInstanceReaperInitiator = "expired-instance-reaper"
+	// The number of instances to retrieve per page
+	// when searching for expired instances.
+	defaultReaperPageSize = 100
+)
+
+// ExpiredInstanceReaper destroys ephemeral blueprint instances
+// (e.g. preview environments for pull requests) once their expiry time
+// has passed.
+// Instances are marked as ephemeral by providing a TTL when deploying them,
+// see DeployInput.TTL.
+// The reaper can be run in the background by a long-running process
+// such as the deploy engine or used to carry out a single pass
+// with ReapExpired.
+type ExpiredInstanceReaper struct {
+	instances state.InstancesContainer
+	destroyer BlueprintDestroyer
+	clock     core.Clock
+	logger    core.Logger
+	params    core.BlueprintParams
+	pageSize  int
+}
+
+// ExpiredInstanceReaperOption is a function that can be used to configure
+// an expired instance reaper.
+type ExpiredInstanceReaperOption func(reaper *ExpiredInstanceReaper)
+
+// WithReaperClock sets the clock used to determine whether
+// a blueprint instance has expired.
+func WithReaperClock(clock core.Clock) ExpiredInstanceReaperOption {
+	return func(reaper *ExpiredInstanceReaper) {
+		reaper.clock = clock
+	}
+}
+
+// WithReaperLogger sets the logger used by the reaper
+// to report the outcome of each pass.
+func WithReaperLogger(logger core.Logger) ExpiredInstanceReaperOption {
+	return func(reaper *ExpiredInstanceReaper) {
+		reaper.logger = logger
+	}
+}
+
+// WithReaperParams sets the parameters used to destroy expired instances,
+// this should include the configuration for the providers used
+// by the resources in the expired instances.
+func WithReaperParams(params core.BlueprintParams) ExpiredInstanceReaperOption {
+	return func(reaper *ExpiredInstanceReaper) {
+		reaper.params = params
+	}
+}
+
+// WithReaperPageSize sets the number of instances to retrieve per page
+// when searching for expired instances.
+func WithReaperPageSize(pageSize int) ExpiredInstanceReaperOption {
+	return func(reaper *ExpiredInstanceReaper) {
+		reaper.pageSize = pageSize
+	}
+}
+
+// NewExpiredInstanceReaper creates a new reaper that finds expired blueprint
+// instances in the provided instances state container and destroys them
+// with the provided destroyer.
+// The destroyer is expected to rely purely on the removal changes
+// and the persisted state of an instance, so any loaded blueprint container
+// can be used as the destroyer.
+func NewExpiredInstanceReaper(
+	instances state.InstancesContainer,
+	destroyer BlueprintDestroyer,
+	opts ...ExpiredInstanceReaperOption,
+) *ExpiredInstanceReaper {
+	reaper := &ExpiredInstanceReaper{
+		instances: instances,
+		destroyer: destroyer,
+		clock:     &core.SystemClock{},
+		logger:    core.NewNopLogger(),
+		params: core.NewDefaultParams(
+			map[string]map[string]*core.ScalarValue{},
+			map[string]map[string]*core.ScalarValue{},
+			map[string]*core.ScalarValue{},
+			map[string]*core.ScalarValue{},
+		),
+		pageSize: defaultReaperPageSize,
+	}
+
+	for _, opt := range opts {
+		opt(reaper)
+	}
+
+	return reaper
+}
+
+// ReapResult holds the outcome of a single pass of the expired instance reaper.
+type ReapResult struct {
+	// Destroyed holds the IDs of the expired instances that were destroyed.
+	Destroyed []string
+	// Failures holds the expired instances that could not be destroyed,
+	// these will be retried in the next pass.
+	Failures []*ReapFailure
+}
+
+// ReapFailure holds information about an expired instance
+// that could not be destroyed.
+type ReapFailure struct {
+	InstanceID   string
+	InstanceName string
+	Err          error
+}
+
+// Run carries out a pass of the reaper every interval until the provided
+// context is cancelled, this blocks so is expected to be called in a goroutine.
+func (r *ExpiredInstanceReaper) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := r.ReapExpired(ctx)
+			if err != nil {
+				r.logger.Error(
+					"failed to search for expired blueprint instances",
+					core.ErrorLogField("error", err),
+				)
+				continue
+			}
+
+			r.logResult(result)
+		}
+	}
+}
+
+// ReapExpired carries out a single pass of the reaper, destroying all the
+// blueprint instances that have expired.
+// Instances are destroyed one at a time, an error is only returned when
+// expired instances could not be retrieved, failures to destroy individual
+// instances are reported in the result.
+func (r *ExpiredInstanceReaper) ReapExpired(ctx context.Context) (*ReapResult, error) {
+	expired, err := r.findExpiredInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReapResult{
+		Destroyed: []string{},
+		Failures:  []*ReapFailure{},
+	}
+	for _, summary := range expired {
+		err := r.destroyInstance(ctx, summary.InstanceID)
+		if err != nil {
+			result.Failures = append(result.Failures, &ReapFailure{
+				InstanceID:   summary.InstanceID,
+				InstanceName: summary.InstanceName,
+				Err:          err,
+			})
+			continue
+		}
+
+		result.Destroyed = append(result.Destroyed, summary.InstanceID)
+	}
+
+	return result, nil
+}
+
+func (r *ExpiredInstanceReaper) findExpiredInstances(
+	ctx context.Context,
+) ([]state.InstanceSummary, error) {
+	now := r.clock.Now().Unix()
+	expired := []state.InstanceSummary{}
+	// Instances saved or removed while paging through the list
+	// can cause an instance to appear in more than one page.
+	seen := map[string]bool{}
+	offset := 0
+	for {
+		page, err := r.instances.List(ctx, state.ListInstancesParams{
+			Offset: offset,
+			Limit:  r.pageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range page.Instances {
+			if !seen[summary.InstanceID] && isReapableInstance(summary, now) {
+				expired = append(expired, summary)
+			}
+			seen[summary.InstanceID] = true
+		}
+
+		offset += len(page.Instances)
+		if len(page.Instances) == 0 || offset >= page.TotalCount {
+			return expired, nil
+		}
+	}
+}
+
+func isReapableInstance(summary state.InstanceSummary, now int64) bool {
+	if summary.ExpiresAt == 0 || summary.ExpiresAt > now {
+		return false
+	}
+
+	// Instances that are already being deployed or destroyed are left
+	// for a later pass.
+	return !isInstanceInProgress(
+		&state.InstanceState{Status: summary.Status},
+		/* rollingBack */ false,
+	) && summary.Status != core.InstanceStatusDestroyed
+}
+
+func (r *ExpiredInstanceReaper) destroyInstance(
+	ctx context.Context,
+	instanceID string,
+) error {
+	instance, err := r.instances.Get(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	removalChanges := getInstanceRemovalChanges(&instance)
+	channels := CreateDeployChannels()
+	r.destroyer.Destroy(
+		ctx,
+		&DestroyInput{
+			InstanceID: instanceID,
+			Changes:    &removalChanges,
+			RunMetadata: &state.DeploymentRunMetadata{
+				InitiatedBy: ExpiredInstanceReaperInitiator,
+			},
+		},
+		channels,
+		r.params,
+	)
+
+	return waitForReapedInstanceDestroy(ctx, channels)
+}
+
+// Waits for the destroy operation for an expired instance to finish,
+// element updates are discarded as the reaper only needs
+// to know the final status of the instance.
+func waitForReapedInstanceDestroy(ctx context.Context, channels *DeployChannels) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-channels.ResourceUpdateChan:
+		case <-channels.ChildUpdateChan:
+		case <-channels.LinkUpdateChan:
+		case <-channels.DeploymentUpdateChan:
+		case msg := <-channels.FinishChan:
+			if msg.Status != core.InstanceStatusDestroyed {
+				return fmt.Errorf(
+					"destroying expired instance finished with status %q: %v",
+					msg.Status.String(),
+					msg.FailureReasons,
+				)
+			}
+			return nil
+		case err := <-channels.ErrChan:
+			return err
+		}
+	}
+}
+
+func (r *ExpiredInstanceReaper) logResult(result *ReapResult) {
+	for _, instanceID := range result.Destroyed {
+		r.logger.Info(
+			"destroyed expired blueprint instance",
+			core.StringLogField("instanceId", instanceID),
+		)
+	}
+
+	for _, failure := range result.Failures {
+		r.logger.Warn(
+			"failed to destroy expired blueprint instance",
+			core.StringLogField("instanceId", failure.InstanceID),
+			core.StringLogField("instanceName", failure.InstanceName),
+			core.ErrorLogField("error", failure.Err),
+		)
+	}
+}
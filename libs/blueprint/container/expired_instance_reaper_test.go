@@ -0,0 +1,110 @@
+package container
+
+import (
+	"context"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/mockclock"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+func (s *ContainerDeployTestSuite) Test_sets_expiry_for_ephemeral_blueprint_instance_deployed_with_ttl() {
+	blueprintContainer := s.blueprint2Fixture.blueprintContainer
+
+	changes, changeStagingErr := s.stageChanges(
+		context.Background(),
+		/* instanceID */ "",
+		blueprintContainer,
+		s.fixture2Params,
+	)
+	s.Require().NoError(changeStagingErr)
+
+	channels := CreateDeployChannels()
+	err := blueprintContainer.Deploy(
+		context.Background(),
+		&DeployInput{
+			InstanceName: "BlueprintInstance2",
+			Changes:      changes,
+			TTL:          2 * time.Hour,
+		},
+		channels,
+		s.fixture2Params,
+	)
+	s.Require().NoError(err)
+
+	finishedMessage, err := collectHookTestFinishedMessage(channels)
+	s.Require().NoError(err)
+	s.Assert().Equal(core.InstanceStatusDeployed, finishedMessage.Status)
+
+	instance, err := s.stateContainer.Instances().Get(
+		context.Background(),
+		finishedMessage.InstanceID,
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		int(finishedMessage.FinishTimestamp)+int((2*time.Hour).Seconds()),
+		instance.ExpiresAt,
+	)
+}
+
+func (s *ContainerDestroyTestSuite) Test_reaper_destroys_expired_blueprint_instances() {
+	instances := s.stateContainer.Instances()
+	expiredAt := int(mockclock.CurrentTimeUnixMock) - 60
+	err := instances.UpdateStatus(
+		context.Background(),
+		"blueprint-instance-1",
+		state.InstanceStatusInfo{
+			Status:    core.InstanceStatusDeployed,
+			ExpiresAt: &expiredAt,
+		},
+	)
+	s.Require().NoError(err)
+
+	// Instances that have not expired yet must be left in place.
+	expiresAt := int(mockclock.CurrentTimeUnixMock) + 3600
+	err = instances.UpdateStatus(
+		context.Background(),
+		"blueprint-instance-2",
+		state.InstanceStatusInfo{
+			Status:    core.InstanceStatusDeployed,
+			ExpiresAt: &expiresAt,
+		},
+	)
+	s.Require().NoError(err)
+
+	recorder := newSummaryRecorder()
+	s.blueprint1Fixture.blueprintContainer.Hooks().AfterRun(recorder)
+
+	reaper := NewExpiredInstanceReaper(
+		instances,
+		s.blueprint1Fixture.blueprintContainer,
+		WithReaperClock(&mockclock.StaticClock{}),
+		WithReaperParams(blueprintDestroyParams()),
+		// A small page size ensures that instances are searched
+		// across multiple pages.
+		WithReaperPageSize(2),
+	)
+
+	result, err := reaper.ReapExpired(context.Background())
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"blueprint-instance-1"}, result.Destroyed)
+	s.Assert().Empty(result.Failures)
+
+	_, err = instances.Get(context.Background(), "blueprint-instance-1")
+	s.Require().Error(err)
+	stateErr, isStateErr := err.(*state.Error)
+	s.Require().True(isStateErr)
+	s.Assert().Equal(state.ErrInstanceNotFound, stateErr.Code)
+
+	notExpired, err := instances.Get(context.Background(), "blueprint-instance-2")
+	s.Require().NoError(err)
+	s.Assert().Equal(expiresAt, notExpired.ExpiresAt)
+
+	summary, err := recorder.next()
+	s.Require().NoError(err)
+	s.Assert().Equal(DeploymentOperationDestroy, summary.Operation)
+	s.Assert().Equal("blueprint-instance-1", summary.InstanceID)
+	s.Require().NotNil(summary.RunMetadata)
+	s.Assert().Equal(ExpiredInstanceReaperInitiator, summary.RunMetadata.InitiatedBy)
+}
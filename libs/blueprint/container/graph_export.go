@@ -0,0 +1,324 @@
+package container
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+)
+
+// GraphFormat is the format that a dependency graph
+// for a blueprint can be exported in.
+type GraphFormat string
+
+const (
+	// GraphFormatDOT renders a dependency graph in the Graphviz DOT language.
+	GraphFormatDOT GraphFormat = "dot"
+	// GraphFormatMermaid renders a dependency graph as a Mermaid flowchart.
+	GraphFormatMermaid GraphFormat = "mermaid"
+	// GraphFormatJSON renders a dependency graph as a JSON document
+	// with a list of nodes and a list of edges.
+	GraphFormatJSON GraphFormat = "json"
+)
+
+// SupportedGraphFormats holds the formats that a dependency graph
+// can be exported in.
+var SupportedGraphFormats = []GraphFormat{
+	GraphFormatDOT,
+	GraphFormatMermaid,
+	GraphFormatJSON,
+}
+
+// DependencyGraphEdgeType is the type of relationship
+// that an edge in a dependency graph represents.
+type DependencyGraphEdgeType string
+
+const (
+	// DependencyGraphEdgeTypeDependsOn is an edge where the source element
+	// must be deployed after the target element.
+	DependencyGraphEdgeTypeDependsOn DependencyGraphEdgeType = "dependsOn"
+	// DependencyGraphEdgeTypeLink is an edge where the source resource
+	// links to the target resource.
+	// A link does not determine deployment order on its own,
+	// a "dependsOn" edge will also be present when the priority resource
+	// of a link must be deployed first.
+	DependencyGraphEdgeTypeLink DependencyGraphEdgeType = "link"
+)
+
+// DependencyGraph holds the resolved resources, child blueprints
+// and the relationships between them that determine the order in which
+// the elements of a blueprint are staged and deployed.
+type DependencyGraph struct {
+	Nodes []*DependencyGraphNode `json:"nodes"`
+	Edges []*DependencyGraphEdge `json:"edges"`
+}
+
+// DependencyGraphNode is a resource or child blueprint in a dependency graph.
+type DependencyGraphNode struct {
+	// ID is the element ID of the resource or child blueprint
+	// (e.g. "resources.ordersTable" or "children.coreInfra").
+	ID string `json:"id"`
+	// Name is the logical name of the resource or child blueprint.
+	Name string `json:"name"`
+	// Type is the type of element that the node represents.
+	Type DeploymentNodeType `json:"type"`
+	// ResourceType is the type of the resource (e.g. "aws/dynamodb/table"),
+	// this is empty for child blueprints.
+	ResourceType string `json:"resourceType,omitempty"`
+	// Group is the index of the group of elements that
+	// can be staged or deployed concurrently,
+	// groups are processed in ascending order.
+	Group int `json:"group"`
+}
+
+// DependencyGraphEdge is a relationship between two elements
+// in a dependency graph.
+type DependencyGraphEdge struct {
+	// From is the element ID of the dependent element or the resource that
+	// is the source of a link.
+	From string `json:"from"`
+	// To is the element ID of the dependency or the resource that
+	// is the target of a link.
+	To string `json:"to"`
+	// Type is the type of relationship between the two elements.
+	Type DependencyGraphEdgeType `json:"type"`
+}
+
+func (c *defaultBlueprintContainer) ExportGraph(
+	ctx context.Context,
+	format GraphFormat,
+	paramOverrides core.BlueprintParams,
+) ([]byte, error) {
+	if !slices.Contains(SupportedGraphFormats, format) {
+		return nil, errUnsupportedGraphFormat(format)
+	}
+
+	prepareResult, err := c.blueprintPreparer.Prepare(
+		ctx,
+		c.spec.Schema(),
+		subengine.ResolveForChangeStaging,
+		/* changes */ nil,
+		c.linkInfo,
+		paramOverrides,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The ref chain collector from the prepared blueprint must be used
+	// so relationships of resources expanded from templates are included.
+	err = PopulateDirectDependencies(
+		ctx,
+		core.Flatten(prepareResult.ParallelGroups),
+		prepareResult.BlueprintContainer.RefChainCollector(),
+		paramOverrides,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return RenderDependencyGraph(
+		BuildDependencyGraph(prepareResult.ParallelGroups),
+		format,
+	)
+}
+
+// BuildDependencyGraph creates a dependency graph from the ordered groups
+// of deployment nodes produced when preparing a blueprint for change staging
+// or deployment.
+// Direct dependencies are expected to have been populated for the provided nodes
+// with PopulateDirectDependencies.
+func BuildDependencyGraph(parallelGroups [][]*DeploymentNode) *DependencyGraph {
+	graph := &DependencyGraph{
+		Nodes: []*DependencyGraphNode{},
+		Edges: []*DependencyGraphEdge{},
+	}
+	inGraph := map[string]bool{}
+	for groupIndex, group := range parallelGroups {
+		for _, node := range sortedDeploymentNodes(group) {
+			graph.Nodes = append(graph.Nodes, toDependencyGraphNode(node, groupIndex))
+			inGraph[node.Name()] = true
+		}
+	}
+
+	for _, group := range parallelGroups {
+		for _, node := range sortedDeploymentNodes(group) {
+			graph.Edges = append(graph.Edges, dependencyGraphEdges(node, inGraph)...)
+		}
+	}
+
+	return graph
+}
+
+// RenderDependencyGraph renders the provided dependency graph
+// in the given format.
+func RenderDependencyGraph(graph *DependencyGraph, format GraphFormat) ([]byte, error) {
+	switch format {
+	case GraphFormatDOT:
+		return []byte(renderDOTGraph(graph)), nil
+	case GraphFormatMermaid:
+		return []byte(renderMermaidGraph(graph)), nil
+	case GraphFormatJSON:
+		return json.MarshalIndent(graph, "", "  ")
+	default:
+		return nil, errUnsupportedGraphFormat(format)
+	}
+}
+
+func toDependencyGraphNode(node *DeploymentNode, group int) *DependencyGraphNode {
+	graphNode := &DependencyGraphNode{
+		ID:    node.Name(),
+		Type:  node.Type(),
+		Group: group,
+	}
+
+	if node.Type() == DeploymentNodeTypeResource {
+		graphNode.Name = node.ChainLinkNode.ResourceName
+		resource := node.ChainLinkNode.Resource
+		if resource != nil && resource.Type != nil {
+			graphNode.ResourceType = resource.Type.Value
+		}
+	} else {
+		graphNode.Name = core.ToLogicalChildName(node.Name())
+	}
+
+	return graphNode
+}
+
+func dependencyGraphEdges(
+	node *DeploymentNode,
+	inGraph map[string]bool,
+) []*DependencyGraphEdge {
+	edges := []*DependencyGraphEdge{}
+	for _, dependency := range sortedDeploymentNodes(node.DirectDependencies) {
+		edges = append(edges, &DependencyGraphEdge{
+			From: node.Name(),
+			To:   dependency.Name(),
+			Type: DependencyGraphEdgeTypeDependsOn,
+		})
+	}
+
+	if node.Type() != DeploymentNodeTypeResource {
+		return edges
+	}
+
+	linkedResourceIDs := []string{}
+	for _, linksTo := range node.ChainLinkNode.LinksTo {
+		linkedResourceID := core.ResourceElementID(linksTo.ResourceName)
+		// Resources that have been excluded when preparing the blueprint
+		// (e.g. by a condition) are not a part of the graph.
+		if inGraph[linkedResourceID] {
+			linkedResourceIDs = append(linkedResourceIDs, linkedResourceID)
+		}
+	}
+	slices.Sort(linkedResourceIDs)
+
+	for _, linkedResourceID := range linkedResourceIDs {
+		edges = append(edges, &DependencyGraphEdge{
+			From: node.Name(),
+			To:   linkedResourceID,
+			Type: DependencyGraphEdgeTypeLink,
+		})
+	}
+
+	return edges
+}
+
+func sortedDeploymentNodes(nodes []*DeploymentNode) []*DeploymentNode {
+	sorted := slices.Clone(nodes)
+	slices.SortFunc(sorted, func(a, b *DeploymentNode) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return sorted
+}
+
+func renderDOTGraph(graph *DependencyGraph) string {
+	var sb strings.Builder
+	sb.WriteString("digraph blueprint {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	for _, node := range graph.Nodes {
+		shape := "box"
+		label := node.Name
+		if node.Type == DeploymentNodeTypeChild {
+			shape = "folder"
+		}
+		if node.ResourceType != "" {
+			label = fmt.Sprintf("%s\\n%s", node.Name, node.ResourceType)
+		}
+		sb.WriteString(fmt.Sprintf(
+			"  %s [label=%s, shape=%s];\n",
+			quoteDOTString(node.ID),
+			quoteDOTString(label),
+			shape,
+		))
+	}
+
+	for _, edge := range graph.Edges {
+		attributes := ""
+		if edge.Type == DependencyGraphEdgeTypeLink {
+			attributes = " [style=dashed, label=\"link\"]"
+		}
+		sb.WriteString(fmt.Sprintf(
+			"  %s -> %s%s;\n",
+			quoteDOTString(edge.From),
+			quoteDOTString(edge.To),
+			attributes,
+		))
+	}
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+func quoteDOTString(value string) string {
+	// Backslashes are left as they are so escape sequences
+	// such as "\n" in labels are interpreted by Graphviz.
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(value, "\"", "\\\""))
+}
+
+func renderMermaidGraph(graph *DependencyGraph) string {
+	// Element IDs can contain characters that are not valid in Mermaid node IDs,
+	// so nodes are assigned sequential IDs that are used in edges.
+	mermaidIDs := map[string]string{}
+
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	for i, node := range graph.Nodes {
+		mermaidID := fmt.Sprintf("n%d", i)
+		mermaidIDs[node.ID] = mermaidID
+
+		label := escapeMermaidLabel(node.Name)
+		if node.ResourceType != "" {
+			label = fmt.Sprintf("%s<br/>%s", label, escapeMermaidLabel(node.ResourceType))
+		}
+
+		if node.Type == DeploymentNodeTypeChild {
+			sb.WriteString(fmt.Sprintf("  %s[[\"%s\"]]\n", mermaidID, label))
+		} else {
+			sb.WriteString(fmt.Sprintf("  %s[\"%s\"]\n", mermaidID, label))
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		arrow := "-->"
+		if edge.Type == DependencyGraphEdgeTypeLink {
+			arrow = "-. link .->"
+		}
+		sb.WriteString(fmt.Sprintf(
+			"  %s %s %s\n",
+			mermaidIDs[edge.From],
+			arrow,
+			mermaidIDs[edge.To],
+		))
+	}
+
+	return sb.String()
+}
+
+func escapeMermaidLabel(value string) string {
+	return strings.ReplaceAll(value, "\"", "#quot;")
+}
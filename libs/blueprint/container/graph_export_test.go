@@ -0,0 +1,179 @@
+package container
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/stretchr/testify/suite"
+)
+
+type GraphExportTestSuite struct {
+	suite.Suite
+	parallelGroups [][]*DeploymentNode
+}
+
+func (s *GraphExportTestSuite) SetupTest() {
+	ordersTable := &links.ChainLinkNode{
+		ResourceName: "ordersTable",
+		Resource: &schema.Resource{
+			Type: &schema.ResourceTypeWrapper{Value: "aws/dynamodb/table"},
+		},
+	}
+	ordersFunction := &links.ChainLinkNode{
+		ResourceName: "ordersFunction",
+		Resource: &schema.Resource{
+			Type: &schema.ResourceTypeWrapper{Value: "aws/lambda/function"},
+		},
+		LinksTo: []*links.ChainLinkNode{ordersTable},
+	}
+	ordersTable.LinkedFrom = []*links.ChainLinkNode{ordersFunction}
+
+	ordersTableNode := &DeploymentNode{ChainLinkNode: ordersTable}
+	coreInfraNode := &DeploymentNode{
+		ChildNode: &refgraph.ReferenceChainNode{ElementName: "children.coreInfra"},
+	}
+	ordersFunctionNode := &DeploymentNode{
+		ChainLinkNode:      ordersFunction,
+		DirectDependencies: []*DeploymentNode{ordersTableNode, coreInfraNode},
+	}
+
+	s.parallelGroups = [][]*DeploymentNode{
+		{ordersTableNode, coreInfraNode},
+		{ordersFunctionNode},
+	}
+}
+
+func (s *GraphExportTestSuite) Test_builds_dependency_graph_from_deployment_groups() {
+	graph := BuildDependencyGraph(s.parallelGroups)
+
+	s.Equal(
+		[]*DependencyGraphNode{
+			{
+				ID:    "children.coreInfra",
+				Name:  "coreInfra",
+				Type:  DeploymentNodeTypeChild,
+				Group: 0,
+			},
+			{
+				ID:           "resources.ordersTable",
+				Name:         "ordersTable",
+				Type:         DeploymentNodeTypeResource,
+				ResourceType: "aws/dynamodb/table",
+				Group:        0,
+			},
+			{
+				ID:           "resources.ordersFunction",
+				Name:         "ordersFunction",
+				Type:         DeploymentNodeTypeResource,
+				ResourceType: "aws/lambda/function",
+				Group:        1,
+			},
+		},
+		graph.Nodes,
+	)
+	s.Equal(
+		[]*DependencyGraphEdge{
+			{
+				From: "resources.ordersFunction",
+				To:   "children.coreInfra",
+				Type: DependencyGraphEdgeTypeDependsOn,
+			},
+			{
+				From: "resources.ordersFunction",
+				To:   "resources.ordersTable",
+				Type: DependencyGraphEdgeTypeDependsOn,
+			},
+			{
+				From: "resources.ordersFunction",
+				To:   "resources.ordersTable",
+				Type: DependencyGraphEdgeTypeLink,
+			},
+		},
+		graph.Edges,
+	)
+}
+
+func (s *GraphExportTestSuite) Test_renders_dependency_graph_in_dot_format() {
+	output, err := RenderDependencyGraph(BuildDependencyGraph(s.parallelGroups), GraphFormatDOT)
+	s.Require().NoError(err)
+	s.Equal(
+		`digraph blueprint {
+  rankdir=LR;
+  "children.coreInfra" [label="coreInfra", shape=folder];
+  "resources.ordersTable" [label="ordersTable\naws/dynamodb/table", shape=box];
+  "resources.ordersFunction" [label="ordersFunction\naws/lambda/function", shape=box];
+  "resources.ordersFunction" -> "children.coreInfra";
+  "resources.ordersFunction" -> "resources.ordersTable";
+  "resources.ordersFunction" -> "resources.ordersTable" [style=dashed, label="link"];
+}
+`,
+		string(output),
+	)
+}
+
+func (s *GraphExportTestSuite) Test_renders_dependency_graph_in_mermaid_format() {
+	output, err := RenderDependencyGraph(BuildDependencyGraph(s.parallelGroups), GraphFormatMermaid)
+	s.Require().NoError(err)
+	s.Equal(
+		`flowchart LR
+  n0[["coreInfra"]]
+  n1["ordersTable<br/>aws/dynamodb/table"]
+  n2["ordersFunction<br/>aws/lambda/function"]
+  n2 --> n0
+  n2 --> n1
+  n2 -. link .-> n1
+`,
+		string(output),
+	)
+}
+
+func (s *GraphExportTestSuite) Test_renders_dependency_graph_in_json_format() {
+	output, err := RenderDependencyGraph(BuildDependencyGraph(s.parallelGroups), GraphFormatJSON)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{
+			"nodes": [
+				{"id": "children.coreInfra", "name": "coreInfra", "type": "child", "group": 0},
+				{
+					"id": "resources.ordersTable",
+					"name": "ordersTable",
+					"type": "resource",
+					"resourceType": "aws/dynamodb/table",
+					"group": 0
+				},
+				{
+					"id": "resources.ordersFunction",
+					"name": "ordersFunction",
+					"type": "resource",
+					"resourceType": "aws/lambda/function",
+					"group": 1
+				}
+			],
+			"edges": [
+				{"from": "resources.ordersFunction", "to": "children.coreInfra", "type": "dependsOn"},
+				{"from": "resources.ordersFunction", "to": "resources.ordersTable", "type": "dependsOn"},
+				{"from": "resources.ordersFunction", "to": "resources.ordersTable", "type": "link"}
+			]
+		}`,
+		string(output),
+	)
+}
+
+func (s *GraphExportTestSuite) Test_fails_to_export_graph_in_unsupported_format() {
+	container := &defaultBlueprintContainer{}
+	_, err := container.ExportGraph(context.Background(), GraphFormat("svg"), nil)
+	s.Require().Error(err)
+
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeUnsupportedGraphFormat, runErr.ReasonCode)
+	s.Contains(runErr.Error(), "unsupported graph format \"svg\"")
+}
+
+func TestGraphExportTestSuite(t *testing.T) {
+	suite.Run(t, new(GraphExportTestSuite))
+}
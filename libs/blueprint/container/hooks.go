@@ -0,0 +1,208 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// HookInfo provides information about the lifecycle phase
+// that a deployment hook is being run for.
+type HookInfo struct {
+	// Event is the lifecycle phase that the hook is being run for.
+	Event schema.HookEvent
+	// InstanceID is the ID of the blueprint instance being deployed or destroyed.
+	InstanceID string
+	// InstanceName is the user-defined name of the blueprint instance
+	// being deployed or destroyed.
+	InstanceName string
+	// ResourceName is the name of the resource being deployed,
+	// this is only set for resource events.
+	ResourceName string
+	// ResourceID is the ID of the resource that has been deployed,
+	// this is only set for the afterResourceDeploy event.
+	ResourceID string
+	// Rollback is true when the hook is being run as a part
+	// of a rollback operation.
+	Rollback bool
+}
+
+// Hook is a callback that is run around a lifecycle phase of
+// deploying or destroying a blueprint instance.
+// Hooks that return an error for a "before" event will cause the operation
+// to fail, errors returned by hooks for "after" events are logged
+// as the changes have already been applied.
+type Hook func(ctx context.Context, info *HookInfo) error
+
+// DeploymentHooks holds the hooks registered for a blueprint container
+// that are run around lifecycle phases for tasks such as cache invalidation
+// and smoke tests.
+// Hooks for the same event are run in the order they are registered.
+// Resource hooks block the deployment of elements that depend on the resource
+// so they should return promptly.
+type DeploymentHooks struct {
+	mu           sync.RWMutex
+	hooks        map[schema.HookEvent][]*registeredHook
+	summaryHooks []DeploymentSummaryHook
+}
+
+type registeredHook struct {
+	// An empty resource name means the hook is run for all resources.
+	resourceName string
+	hook         Hook
+}
+
+// NewDeploymentHooks creates a new empty registry of deployment hooks.
+func NewDeploymentHooks() *DeploymentHooks {
+	return &DeploymentHooks{
+		hooks: map[schema.HookEvent][]*registeredHook{},
+	}
+}
+
+// BeforeDeploy registers a hook that is run before the elements
+// of a blueprint instance are deployed.
+func (h *DeploymentHooks) BeforeDeploy(hook Hook) {
+	h.register(schema.HookEventBeforeDeploy, "", hook)
+}
+
+// AfterDeploy registers a hook that is run after a blueprint instance
+// has been deployed successfully.
+func (h *DeploymentHooks) AfterDeploy(hook Hook) {
+	h.register(schema.HookEventAfterDeploy, "", hook)
+}
+
+// BeforeResourceDeploy registers a hook that is run before a resource is created
+// or updated.
+// When resourceName is empty, the hook is run for every resource.
+func (h *DeploymentHooks) BeforeResourceDeploy(resourceName string, hook Hook) {
+	h.register(schema.HookEventBeforeResourceDeploy, resourceName, hook)
+}
+
+// AfterResourceDeploy registers a hook that is run after a resource has been
+// created or updated successfully.
+// When resourceName is empty, the hook is run for every resource.
+func (h *DeploymentHooks) AfterResourceDeploy(resourceName string, hook Hook) {
+	h.register(schema.HookEventAfterResourceDeploy, resourceName, hook)
+}
+
+// BeforeDestroy registers a hook that is run before the elements
+// of a blueprint instance are destroyed.
+func (h *DeploymentHooks) BeforeDestroy(hook Hook) {
+	h.register(schema.HookEventBeforeDestroy, "", hook)
+}
+
+// AfterDestroy registers a hook that is run after a blueprint instance
+// has been destroyed successfully.
+func (h *DeploymentHooks) AfterDestroy(hook Hook) {
+	h.register(schema.HookEventAfterDestroy, "", hook)
+}
+
+// AfterRun registers a hook that receives a summary of the changes and results
+// of every deploy or destroy operation for a root blueprint instance
+// once the operation has finished, regardless of whether the operation
+// was successful.
+// This is useful for syncing infrastructure changes with external systems
+// such as changelogs or a configuration management database (CMDB).
+func (h *DeploymentHooks) AfterRun(hook DeploymentSummaryHook) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.summaryHooks = append(h.summaryHooks, hook)
+}
+
+// Has returns true if at least one hook is registered for the given event.
+func (h *DeploymentHooks) Has(event schema.HookEvent) bool {
+	if h == nil {
+		return false
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.hooks[event]) > 0
+}
+
+// Run runs the hooks registered for the event in the provided info,
+// stopping at the first hook that returns an error.
+func (h *DeploymentHooks) Run(ctx context.Context, info *HookInfo) error {
+	if h == nil {
+		return nil
+	}
+
+	h.mu.RLock()
+	hooks := make([]*registeredHook, len(h.hooks[info.Event]))
+	copy(hooks, h.hooks[info.Event])
+	h.mu.RUnlock()
+
+	for _, registered := range hooks {
+		if registered.resourceName != "" &&
+			registered.resourceName != info.ResourceName {
+			continue
+		}
+
+		err := registered.hook(ctx, info)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HasSummaryHooks returns true if at least one hook has been registered
+// to receive summaries of deploy and destroy operations.
+func (h *DeploymentHooks) HasSummaryHooks() bool {
+	if h == nil {
+		return false
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.summaryHooks) > 0
+}
+
+// RunSummaryHooks runs all the registered summary hooks with the provided
+// summary of a deploy or destroy operation.
+// Unlike lifecycle hooks, a failure in one summary hook does not prevent
+// the remaining hooks from being run, the errors of all failed hooks
+// are returned.
+func (h *DeploymentHooks) RunSummaryHooks(
+	ctx context.Context,
+	summary *DeploymentSummary,
+) []error {
+	if h == nil {
+		return nil
+	}
+
+	h.mu.RLock()
+	hooks := make([]DeploymentSummaryHook, len(h.summaryHooks))
+	copy(hooks, h.summaryHooks)
+	h.mu.RUnlock()
+
+	errs := []error{}
+	for _, hook := range hooks {
+		err := hook.OnDeploymentSummary(ctx, summary)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func (h *DeploymentHooks) register(
+	event schema.HookEvent,
+	resourceName string,
+	hook Hook,
+) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.hooks[event] = append(h.hooks[event], &registeredHook{
+		resourceName: resourceName,
+		hook:         hook,
+	})
+}
+
+func hookFailedMessage(event schema.HookEvent, err error) string {
+	return fmt.Sprintf("%s hook failed: %s", event, err.Error())
+}
@@ -0,0 +1,201 @@
+package container
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// applyIgnoreChanges removes changes to fields in the spec of an existing resource
+// that match one of the paths in the "ignoreChanges" list for the resource.
+// The current values of ignored fields are kept in the resolved resource
+// that is used to deploy the resource so that the ignored changes
+// are not applied to the resource in the provider.
+func applyIgnoreChanges(changes *provider.Changes, ignoreChanges []string) error {
+	resolvedResource := changes.AppliedResourceInfo.ResourceWithResolvedSubs
+	if len(ignoreChanges) == 0 || resolvedResource == nil {
+		return nil
+	}
+
+	patterns := make([]string, len(ignoreChanges))
+	for i, path := range ignoreChanges {
+		patterns[i] = core.ReplaceSpecWithRoot(path)
+	}
+
+	modifiedFields := []provider.FieldChange{}
+	ignoredModifiedField := false
+	for _, fieldChange := range changes.ModifiedFields {
+		if !isIgnoredField(fieldChange.FieldPath, patterns) {
+			modifiedFields = append(modifiedFields, fieldChange)
+			continue
+		}
+
+		err := keepCurrentFieldValue(resolvedResource, fieldChange.FieldPath, fieldChange.PrevValue)
+		if err != nil {
+			return err
+		}
+		changes.IgnoredFields = append(changes.IgnoredFields, fieldChange.FieldPath)
+		changes.UnchangedFields = append(changes.UnchangedFields, fieldChange.FieldPath)
+		ignoredModifiedField = true
+	}
+
+	newFields := []provider.FieldChange{}
+	ignoredNewFields := []string{}
+	for _, fieldChange := range changes.NewFields {
+		if !isIgnoredField(fieldChange.FieldPath, patterns) {
+			newFields = append(newFields, fieldChange)
+			continue
+		}
+		ignoredNewFields = append(ignoredNewFields, fieldChange.FieldPath)
+	}
+
+	// Remove new fields in reverse order so that removing an item from an array
+	// does not shift the indices of other new items in the same array.
+	for i := len(ignoredNewFields) - 1; i >= 0; i -= 1 {
+		err := removeSpecFieldValue(resolvedResource.Spec, ignoredNewFields[i])
+		if err != nil {
+			return err
+		}
+	}
+	changes.IgnoredFields = append(changes.IgnoredFields, ignoredNewFields...)
+
+	removedFields := []string{}
+	currentSpec := getResourceSpecFromCurrentState(changes.AppliedResourceInfo)
+	for _, fieldPath := range changes.RemovedFields {
+		if !isIgnoredField(fieldPath, patterns) {
+			removedFields = append(removedFields, fieldPath)
+			continue
+		}
+
+		currentValue, err := core.GetPathValue(
+			core.ReplaceSpecWithRoot(fieldPath),
+			currentSpec,
+			core.MappingNodeMaxTraverseDepth,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = keepCurrentFieldValue(resolvedResource, fieldPath, currentValue)
+		if err != nil {
+			return err
+		}
+		changes.IgnoredFields = append(changes.IgnoredFields, fieldPath)
+		changes.UnchangedFields = append(changes.UnchangedFields, fieldPath)
+	}
+
+	changes.ModifiedFields = modifiedFields
+	changes.NewFields = newFields
+	changes.RemovedFields = removedFields
+	if ignoredModifiedField {
+		changes.RecreateTriggers = provider.RecreateTriggerFields(modifiedFields)
+		changes.MustRecreate = len(changes.RecreateTriggers) > 0
+	}
+
+	return nil
+}
+
+func isIgnoredField(fieldPath string, patterns []string) bool {
+	if !strings.HasPrefix(fieldPath, "spec.") && !strings.HasPrefix(fieldPath, "spec[") {
+		return false
+	}
+
+	searchPath := core.ReplaceSpecWithRoot(fieldPath)
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		// Invalid patterns are reported during validation so they can be
+		// safely treated as not matching here.
+		within, _ := core.PathWithinPattern(searchPath, pattern)
+		return within
+	})
+}
+
+func keepCurrentFieldValue(
+	resolvedResource *provider.ResolvedResource,
+	fieldPath string,
+	currentValue *core.MappingNode,
+) error {
+	if currentValue == nil {
+		return removeSpecFieldValue(resolvedResource.Spec, fieldPath)
+	}
+
+	if resolvedResource.Spec == nil {
+		resolvedResource.Spec = &core.MappingNode{
+			Fields: map[string]*core.MappingNode{},
+		}
+	}
+
+	return core.InjectPathValueReplaceFields(
+		core.ReplaceSpecWithRoot(fieldPath),
+		currentValue,
+		resolvedResource.Spec,
+		core.MappingNodeMaxTraverseDepth,
+	)
+}
+
+// removeSpecFieldValue removes the value at the provided field path
+// (e.g. "spec.tags[0].value") from a resolved resource spec.
+func removeSpecFieldValue(spec *core.MappingNode, fieldPath string) error {
+	segments, err := core.ParsePathPattern(core.ReplaceSpecWithRoot(fieldPath))
+	if err != nil {
+		return err
+	}
+
+	if len(segments) == 0 || len(segments) > core.MappingNodeMaxTraverseDepth {
+		return fmt.Errorf("the value at path %q can not be removed from the resource spec", fieldPath)
+	}
+
+	parent := spec
+	for _, segment := range segments[:len(segments)-1] {
+		parent = childMappingNode(parent, segment)
+		if parent == nil {
+			// There is nothing to remove if the parent does not exist.
+			return nil
+		}
+	}
+
+	last := segments[len(segments)-1]
+	if last.ArrayIndex != nil {
+		index := *last.ArrayIndex
+		if parent != nil && index >= 0 && index < len(parent.Items) {
+			parent.Items = slices.Delete(parent.Items, index, index+1)
+		}
+		return nil
+	}
+
+	if parent != nil && parent.Fields != nil {
+		delete(parent.Fields, last.FieldName)
+	}
+
+	return nil
+}
+
+func childMappingNode(node *core.MappingNode, segment *core.PathPatternSegment) *core.MappingNode {
+	if node == nil {
+		return nil
+	}
+
+	if segment.ArrayIndex != nil {
+		index := *segment.ArrayIndex
+		if index < 0 || index >= len(node.Items) {
+			return nil
+		}
+		return node.Items[index]
+	}
+
+	if node.Fields == nil {
+		return nil
+	}
+
+	return node.Fields[segment.FieldName]
+}
+
+func getResourceSpecFromCurrentState(resourceInfo provider.ResourceInfo) *core.MappingNode {
+	if resourceInfo.CurrentResourceState == nil {
+		return nil
+	}
+
+	return resourceInfo.CurrentResourceState.SpecData
+}
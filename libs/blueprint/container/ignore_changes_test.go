@@ -0,0 +1,134 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type IgnoreChangesTestSuite struct {
+	suite.Suite
+}
+
+func (s *IgnoreChangesTestSuite) Test_keeps_current_values_for_ignored_modified_fields() {
+	changes := createIgnoreChangesTestChanges()
+
+	err := applyIgnoreChanges(changes, []string{"spec.id"})
+	s.Require().NoError(err)
+
+	s.Assert().Equal([]string{"spec.id"}, changes.IgnoredFields)
+	s.Assert().Equal([]string{"spec.id"}, changes.UnchangedFields)
+	s.Assert().Len(changes.ModifiedFields, 1)
+	s.Assert().Equal("spec.name", changes.ModifiedFields[0].FieldPath)
+	s.Assert().False(changes.MustRecreate)
+	s.Assert().Equal(
+		"current-id",
+		core.StringValue(
+			changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec.Fields["id"],
+		),
+	)
+}
+
+func (s *IgnoreChangesTestSuite) Test_ignores_new_and_removed_fields_under_ignored_path() {
+	changes := createIgnoreChangesTestChanges()
+
+	err := applyIgnoreChanges(changes, []string{"spec.tags"})
+	s.Require().NoError(err)
+
+	s.Assert().ElementsMatch(
+		[]string{"spec.tags.team", "spec.tags.env"},
+		changes.IgnoredFields,
+	)
+	s.Assert().Empty(changes.NewFields)
+	s.Assert().Empty(changes.RemovedFields)
+	s.Assert().Len(changes.ModifiedFields, 2)
+	s.Assert().True(changes.MustRecreate)
+
+	tags := changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec.Fields["tags"]
+	s.Assert().NotContains(tags.Fields, "team")
+	s.Assert().Equal("production", core.StringValue(tags.Fields["env"]))
+}
+
+func (s *IgnoreChangesTestSuite) Test_does_not_change_anything_when_no_paths_are_ignored() {
+	changes := createIgnoreChangesTestChanges()
+
+	err := applyIgnoreChanges(changes, []string{})
+	s.Require().NoError(err)
+
+	s.Assert().Empty(changes.IgnoredFields)
+	s.Assert().Len(changes.ModifiedFields, 2)
+	s.Assert().Len(changes.NewFields, 1)
+	s.Assert().Len(changes.RemovedFields, 1)
+	s.Assert().True(changes.MustRecreate)
+}
+
+func createIgnoreChangesTestChanges() *provider.Changes {
+	resolvedResource := &provider.ResolvedResource{
+		Type: &schema.ResourceTypeWrapper{Value: "example/resource"},
+		Spec: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"id":   core.MappingNodeFromString("new-id"),
+				"name": core.MappingNodeFromString("new-name"),
+				"tags": {
+					Fields: map[string]*core.MappingNode{
+						"team": core.MappingNodeFromString("platform"),
+					},
+				},
+			},
+		},
+	}
+
+	currentState := &state.ResourceState{
+		ResourceID: "resource-1",
+		Name:       "resource",
+		SpecData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"id":   core.MappingNodeFromString("current-id"),
+				"name": core.MappingNodeFromString("current-name"),
+				"tags": {
+					Fields: map[string]*core.MappingNode{
+						"env": core.MappingNodeFromString("production"),
+					},
+				},
+			},
+		},
+	}
+
+	return &provider.Changes{
+		AppliedResourceInfo: provider.ResourceInfo{
+			ResourceID:               "resource-1",
+			ResourceName:             "resource",
+			CurrentResourceState:     currentState,
+			ResourceWithResolvedSubs: resolvedResource,
+		},
+		ModifiedFields: []provider.FieldChange{
+			{
+				FieldPath:    "spec.id",
+				PrevValue:    core.MappingNodeFromString("current-id"),
+				NewValue:     core.MappingNodeFromString("new-id"),
+				MustRecreate: true,
+			},
+			{
+				FieldPath: "spec.name",
+				PrevValue: core.MappingNodeFromString("current-name"),
+				NewValue:  core.MappingNodeFromString("new-name"),
+			},
+		},
+		NewFields: []provider.FieldChange{
+			{
+				FieldPath: "spec.tags.team",
+				NewValue:  core.MappingNodeFromString("platform"),
+			},
+		},
+		RemovedFields: []string{"spec.tags.env"},
+		MustRecreate:  true,
+	}
+}
+
+func TestIgnoreChangesTestSuite(t *testing.T) {
+	suite.Run(t, new(IgnoreChangesTestSuite))
+}
@@ -0,0 +1,115 @@
+package container
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// ListImportCandidatesInput specifies the blueprint instance to find
+// unmatched resources for and how many candidates to list for each resource.
+type ListImportCandidatesInput struct {
+	// InstanceID is the ID of the blueprint instance to find unmatched resources for.
+	// When both InstanceID and InstanceName are empty or the instance does not exist yet,
+	// every resource in the blueprint is considered to be unmatched.
+	InstanceID string
+	// InstanceName is the user-defined name of the blueprint instance,
+	// this is used to look up the instance when InstanceID is not set
+	// and is passed to providers to help narrow down candidates.
+	InstanceName string
+	// ResourceNames restricts the unmatched resources to the resources
+	// with the given names, when empty, all unmatched resources are included.
+	ResourceNames []string
+	// MaxCandidates is the maximum number of candidates to list for each resource,
+	// when zero or less, the provider decides how many candidates to return.
+	MaxCandidates int
+}
+
+// ImportCandidatesResult holds the resources in a blueprint that are not
+// tracked in the state of a blueprint instance along with the candidate
+// external resources that each unmatched resource can be mapped to.
+type ImportCandidatesResult struct {
+	// InstanceID is the ID of the blueprint instance,
+	// this will be empty if the instance does not exist yet.
+	InstanceID string `json:"instanceId"`
+	// Resources holds the unmatched resources ordered by resource name.
+	Resources []*UnmatchedResource `json:"resources"`
+}
+
+// UnmatchedResource is a resource defined in a blueprint that is not tracked
+// in the state of a blueprint instance.
+type UnmatchedResource struct {
+	// ResourceName is the logical name of the resource in the blueprint.
+	ResourceName string `json:"resourceName"`
+	// ResourceType is the type of the resource.
+	ResourceType string `json:"resourceType"`
+	// ListingSupported indicates whether the resource type implementation
+	// supports listing existing resources in the provider.
+	// When this is false, an import ID must be provided by the user.
+	ListingSupported bool `json:"listingSupported"`
+	// Candidates holds existing resources in the provider that the
+	// blueprint resource can be mapped to.
+	// Resources that are already tracked in the instance state are excluded.
+	Candidates []*provider.ExternalResource `json:"candidates"`
+	// Error holds the reason that candidates could not be listed
+	// for the resource, if listing failed.
+	Error string `json:"error,omitempty"`
+}
+
+// ImportResourcesInput specifies the blueprint instance to import resources into
+// and the external resources that blueprint resources should be mapped to.
+type ImportResourcesInput struct {
+	// InstanceID is the ID of the blueprint instance to import resources into.
+	InstanceID string
+	// InstanceName is the user-defined name of the blueprint instance,
+	// this is used to look up the instance when InstanceID is not set.
+	// A new instance with this name will be created if an instance
+	// with the name does not exist yet.
+	InstanceName string
+	// Mappings holds the external resources that each blueprint
+	// resource should be mapped to.
+	Mappings []*ImportResourceMapping
+}
+
+// ImportResourceMapping maps a resource in a blueprint to an existing
+// resource in the upstream provider.
+type ImportResourceMapping struct {
+	// ResourceName is the logical name of the resource in the blueprint.
+	ResourceName string `json:"resourceName"`
+	// ImportID is the provider-specific identifier of the external resource,
+	// this is the value of the `IDField` in the spec definition of the resource type.
+	ImportID string `json:"importId"`
+}
+
+// ImportResourcesResult holds the outcome of importing resources
+// into a blueprint instance.
+type ImportResourcesResult struct {
+	// InstanceID is the ID of the blueprint instance that resources were imported into.
+	InstanceID string `json:"instanceId"`
+	// InstanceCreated indicates whether a new blueprint instance was created
+	// to import resources into.
+	InstanceCreated bool `json:"instanceCreated"`
+	// Imported holds the resources that were imported successfully.
+	Imported []*ImportedResource `json:"imported"`
+	// Errors holds the resources that could not be imported.
+	Errors []*ImportError `json:"errors"`
+}
+
+// ImportedResource describes a resource that was imported
+// into the state of a blueprint instance.
+type ImportedResource struct {
+	// ResourceName is the logical name of the resource in the blueprint.
+	ResourceName string `json:"resourceName"`
+	// ResourceID is the ID assigned to the resource in the blueprint instance state.
+	ResourceID string `json:"resourceId"`
+	// ImportID is the provider-specific identifier of the external resource.
+	ImportID string `json:"importId"`
+}
+
+// ImportError captures the reason a resource could not be imported.
+type ImportError struct {
+	// ResourceName is the logical name of the resource in the blueprint.
+	ResourceName string `json:"resourceName"`
+	// ImportID is the provider-specific identifier of the external resource.
+	ImportID string `json:"importId"`
+	// Error is the error message.
+	Error string `json:"error"`
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/speccore"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
 )
@@ -31,11 +32,13 @@ func NewDefaultLinkChangeStager(
 	stateContainer state.Container,
 	substitutionResolver subengine.SubstitutionResolver,
 	resourceCache *core.Cache[*provider.ResolvedResource],
+	spec speccore.BlueprintSpec,
 ) LinkChangeStager {
 	return &defaultLinkChangeStager{
 		stateContainer:       stateContainer,
 		substitutionResolver: substitutionResolver,
 		resourceCache:        resourceCache,
+		spec:                 spec,
 	}
 }
 
@@ -43,6 +46,7 @@ type defaultLinkChangeStager struct {
 	stateContainer       state.Container
 	substitutionResolver subengine.SubstitutionResolver
 	resourceCache        *core.Cache[*provider.ResolvedResource]
+	spec                 speccore.BlueprintSpec
 }
 
 func (d *defaultLinkChangeStager) StageChanges(
@@ -80,10 +84,11 @@ func (d *defaultLinkChangeStager) StageChanges(
 	)
 	var currentLinkStatePtr *state.LinkState
 	links := d.stateContainer.Links()
+	linkName := core.LogicalLinkName(resourceAInfo.ResourceName, resourceBInfo.ResourceName)
 	currentLinkState, err := links.GetByName(
 		ctx,
 		resourceAInfo.InstanceID,
-		core.LogicalLinkName(resourceAInfo.ResourceName, resourceBInfo.ResourceName),
+		linkName,
 	)
 	if err != nil {
 		if !state.IsLinkNotFound(err) {
@@ -107,6 +112,7 @@ func (d *defaultLinkChangeStager) StageChanges(
 		ResourceBChanges: resourceBChanges,
 		CurrentLinkState: currentLinkStatePtr,
 		LinkContext:      linkCtx,
+		LinkConfig:       getLinkConfig(d.spec, linkName),
 	})
 	if err != nil {
 		logger.Debug(
@@ -0,0 +1,44 @@
+package container
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/speccore"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// Retrieves the configuration for a link from the `links` section
+// of a blueprint, nil is returned when no configuration has been
+// provided for the link.
+func getLinkConfig(spec speccore.BlueprintSpec, linkName string) *core.MappingNode {
+	if spec == nil {
+		return nil
+	}
+
+	bpSchema := spec.Schema()
+	if bpSchema == nil || bpSchema.Links == nil {
+		return nil
+	}
+
+	return bpSchema.Links.Values[linkName]
+}
+
+// Determines the configuration to pass into a link implementation
+// when deploying a link.
+// Links that are being destroyed are no longer defined in the blueprint,
+// so the configuration used in the last deployment of the link is used instead.
+func getLinkConfigForDeployment(
+	linkName string,
+	linkUpdateType provider.LinkUpdateType,
+	currentLinkState *state.LinkState,
+	deployCtx *DeployContext,
+) *core.MappingNode {
+	if linkUpdateType == provider.LinkUpdateTypeDestroy || deployCtx.PreparedContainer == nil {
+		if currentLinkState == nil {
+			return nil
+		}
+		return currentLinkState.Config
+	}
+
+	return getLinkConfig(deployCtx.PreparedContainer.BlueprintSpec(), linkName)
+}
@@ -0,0 +1,90 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/speccore"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type LinkConfigTestSuite struct {
+	suite.Suite
+	specConfig  *core.MappingNode
+	stateConfig *core.MappingNode
+	deployCtx   *DeployContext
+}
+
+func (s *LinkConfigTestSuite) SetupTest() {
+	s.specConfig = &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"permissionsScope": core.MappingNodeFromString("readWrite"),
+		},
+	}
+	s.stateConfig = &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"permissionsScope": core.MappingNodeFromString("read"),
+		},
+	}
+	s.deployCtx = &DeployContext{
+		PreparedContainer: &stubBlueprintContainer{
+			spec: speccore.BlueprintSpecFromSchema(&schema.Blueprint{
+				Links: &schema.LinkConfigMap{
+					Values: map[string]*core.MappingNode{
+						"ordersFunction::ordersTable": s.specConfig,
+					},
+				},
+			}),
+		},
+	}
+}
+
+func (s *LinkConfigTestSuite) Test_uses_config_from_blueprint_when_creating_or_updating_links() {
+	currentState := &state.LinkState{Config: s.stateConfig}
+	for _, updateType := range []provider.LinkUpdateType{
+		provider.LinkUpdateTypeCreate,
+		provider.LinkUpdateTypeUpdate,
+	} {
+		s.Equal(
+			s.specConfig,
+			getLinkConfigForDeployment("ordersFunction::ordersTable", updateType, currentState, s.deployCtx),
+		)
+	}
+
+	s.Nil(
+		getLinkConfigForDeployment(
+			"ordersFunction::ordersQueue",
+			provider.LinkUpdateTypeUpdate,
+			currentState,
+			s.deployCtx,
+		),
+	)
+}
+
+func (s *LinkConfigTestSuite) Test_uses_config_from_link_state_when_destroying_links() {
+	s.Equal(
+		s.stateConfig,
+		getLinkConfigForDeployment(
+			"ordersFunction::ordersTable",
+			provider.LinkUpdateTypeDestroy,
+			&state.LinkState{Config: s.stateConfig},
+			s.deployCtx,
+		),
+	)
+
+	s.Nil(
+		getLinkConfigForDeployment(
+			"ordersFunction::ordersTable",
+			provider.LinkUpdateTypeDestroy,
+			nil,
+			s.deployCtx,
+		),
+	)
+}
+
+func TestLinkConfigTestSuite(t *testing.T) {
+	suite.Run(t, new(LinkConfigTestSuite))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/includes"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/linktypes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/policy"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/providerhelpers"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
@@ -127,6 +128,9 @@ type ValidationResult struct {
 	LinkInfo links.SpecLinkInfo
 	// The parsed blueprint schema that was validated.
 	Schema *schema.Blueprint
+	// Diagnostics that were suppressed by directives
+	// in the blueprint document.
+	SuppressedDiagnostics []*validation.SuppressedDiagnostic
 }
 
 type loadBlueprintInfo struct {
@@ -138,6 +142,19 @@ type loadSpecResult struct {
 	spec              speccore.BlueprintSpec
 	declaredLinkGraph linktypes.DeclaredLinkGraph
 	diagnostics       []*bpcore.Diagnostic
+	// The blueprint schema before transformers have been applied,
+	// this holds the suppression directives from the source document.
+	sourceSchema          *schema.Blueprint
+	suppressedDiagnostics []*validation.SuppressedDiagnostic
+}
+
+// Records diagnostics produced after the core validation of a blueprint,
+// separating out diagnostics that are suppressed by directives in the
+// blueprint document.
+func (r *loadSpecResult) appendDiagnostics(diagnostics []*bpcore.Diagnostic) {
+	remaining, _, suppressed := validation.ApplySuppressions(r.sourceSchema, diagnostics, nil)
+	r.diagnostics = append(r.diagnostics, remaining...)
+	r.suppressedDiagnostics = append(r.suppressedDiagnostics, suppressed...)
 }
 
 // DependenciesOverrider is a function that customises the dependencies
@@ -147,13 +164,16 @@ type DependenciesOverrider func(
 ) *BlueprintContainerDependencies
 
 type defaultLoader struct {
-	providers              map[string]provider.Provider
-	specTransformers       map[string]transform.SpecTransformer
-	stateContainer         state.Container
-	childResolver          includes.ChildResolver
-	validateRuntimeValues  bool
-	validateAfterTransform bool
-	transformSpec          bool
+	providers                    map[string]provider.Provider
+	specTransformers             map[string]transform.SpecTransformer
+	stateContainer               state.Container
+	childResolver                includes.ChildResolver
+	validateRuntimeValues        bool
+	resolveIncludesForValidation bool
+	validateProviderConfig       bool
+	validateAfterTransform       bool
+	treatWarningsAsErrors        bool
+	transformSpec                bool
 	// A list of resource names derived from resource templates.
 	// "elem" and "i" references should be allowed in resources
 	// derived from templates where the `each` property is not set.
@@ -162,7 +182,13 @@ type defaultLoader struct {
 	funcRegistry                   provider.FunctionRegistry
 	resourceRegistry               resourcehelpers.Registry
 	dataSourceRegistry             provider.DataSourceRegistry
+	allowedValuesLookup            validation.AllowedValuesLookup
+	ruleRegistry                   validation.RuleRegistry
+	validationCache                ValidationCache
+	validationCacheSchemaVersions  map[string]string
+	complexityLimits               *validation.ComplexityLimits
 	fileSourceRegistry             provider.FileSourceRegistry
+	allowedFileRoots               []string
 	linkRegistry                   provider.LinkRegistry
 	clock                          bpcore.Clock
 	resolveWorkingDir              corefunctions.WorkingDirResolver
@@ -176,6 +202,10 @@ type defaultLoader struct {
 	linkDestroyer                  LinkDestroyer
 	linkDeployer                   LinkDeployer
 	driftChecker                   drift.Checker
+	deploymentHooks                *DeploymentHooks
+	concurrencyLimiter             *ConcurrencyLimiter
+	deploymentEventStore           DeploymentEventStore
+	policyEngine                   policy.Engine
 	// Allows for customisation of the blueprint container dependencies
 	// used for instantiating the blueprint container.
 	// This allows users to override the default implementations of services
@@ -219,6 +249,18 @@ func WithLoaderValidateAfterTransform(validateAfterTransform bool) LoaderOption
 	}
 }
 
+// WithLoaderTreatWarningsAsErrors sets the flag to determine whether
+// warnings produced when validating a blueprint should be treated as errors.
+// When enabled, a blueprint with validation warnings (e.g. unused variables)
+// will fail to load, this is useful for enforcing stricter validation in CI pipelines.
+//
+// When this option is not provided, the default value is false.
+func WithLoaderTreatWarningsAsErrors(treatWarningsAsErrors bool) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.treatWarningsAsErrors = treatWarningsAsErrors
+	}
+}
+
 // WithLoaderTransformSpec sets the flag to determine whether transformers should be applied
 // to the blueprint spec when loading blueprints.
 // This is useful when you want to validate a blueprint spec without
@@ -251,6 +293,53 @@ func WithLoaderResolveWorkingDir(resolveWorkingDir corefunctions.WorkingDirResol
 	}
 }
 
+// WithLoaderResolveIncludesForValidation sets the flag to determine whether
+// child blueprints that can not be loaded from the local file system
+// (e.g. includes that reference remote sources) should be resolved
+// with the loader's child resolver during validation.
+// Resolved child blueprints are used to check that the variables provided
+// to an include match the child blueprint's variable definitions and that
+// child exports referenced in the parent blueprint exist.
+// Only includes with a path and metadata that do not contain substitutions
+// (other than the cwd() function) can be resolved at the validation stage.
+//
+// When this option is not provided, the default value is false.
+func WithLoaderResolveIncludesForValidation(resolveIncludes bool) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.resolveIncludesForValidation = resolveIncludes
+	}
+}
+
+// WithLoaderValidateProviderConfig sets the flag to determine whether
+// the provider configuration supplied in blueprint params should be validated
+// against the config definition of each provider used in a blueprint
+// when loading or validating blueprints.
+// This allows invalid provider configuration to be reported as a validation error
+// for a blueprint instead of failing when a provider is first used in a deployment.
+// This should only be enabled when the provider configuration that will be used
+// for a deployment is available at the validation stage.
+//
+// When this option is not provided, the default value is false.
+func WithLoaderValidateProviderConfig(validateProviderConfig bool) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.validateProviderConfig = validateProviderConfig
+	}
+}
+
+// WithLoaderComplexityLimits sets the maximum complexity allowed for blueprints
+// loaded by the loader, this includes the number of resources, the include depth,
+// the nesting depth of substitutions and the number of references between elements.
+// Blueprints that exceed any of the limits will fail validation.
+// This is useful for host applications that load blueprints from untrusted sources
+// to defend against pathological blueprints.
+//
+// When this option is not provided, no complexity limits are applied.
+func WithLoaderComplexityLimits(limits *validation.ComplexityLimits) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.complexityLimits = limits
+	}
+}
+
 // WithLoaderFileSourceRegistry sets the file source registry to be used by the loader.
 // This allows host applications to register custom file sources for different URI schemes
 // (e.g., s3://, gs://, https://) to extend the file() function.
@@ -262,6 +351,20 @@ func WithLoaderFileSourceRegistry(fileSourceRegistry provider.FileSourceRegistry
 	}
 }
 
+// WithLoaderAllowedFileRoots sets the root directories that local files can be read from
+// by core functions such as file() and templatefile().
+// Relative paths passed into these functions are resolved against the directory
+// of the current blueprint, any local file that resolves to a location outside of
+// the allowed root directories will be rejected.
+// Files from sources registered for other URI schemes (e.g. s3://) are not restricted.
+//
+// When this option is not provided, local files can be read from any location.
+func WithLoaderAllowedFileRoots(allowedFileRoots []string) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.allowedFileRoots = allowedFileRoots
+	}
+}
+
 // WithLoaderDerivedFromTemplates sets the list of resource names that are derived
 // from resource templates.
 // This is useful when you want to allow references to "elem" and "i" in resources
@@ -320,6 +423,51 @@ func WithLoaderDataSourceRegistry(dataSourceRegistry provider.DataSourceRegistry
 	}
 }
 
+// WithLoaderRuleRegistry sets the registry of custom validation rules
+// that are applied to blueprints in addition to the core validators.
+// Custom rules are only applied to blueprints that pass the core validation checks.
+//
+// When this option is not provided, the default value is nil
+// and no custom validation rules are applied.
+func WithLoaderRuleRegistry(ruleRegistry validation.RuleRegistry) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.ruleRegistry = ruleRegistry
+	}
+}
+
+// WithLoaderValidationCache sets a cache for the results of validating
+// blueprint documents with the Validate and ValidateString methods.
+// The schema versions are a mapping of provider and transformer namespaces
+// to the versions of the plugins that provide them (e.g. {"aws": "1.2.0"}),
+// these are included in cache keys so that results produced with different
+// versions of a provider or transformer schema are never served.
+// The cache should be cleared when the providers, transformers or
+// validation rules change without a change in schema versions.
+//
+// When this option is not provided, validation results are not cached.
+func WithLoaderValidationCache(
+	validationCache ValidationCache,
+	schemaVersions map[string]string,
+) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.validationCache = validationCache
+		loader.validationCacheSchemaVersions = schemaVersions
+	}
+}
+
+// WithLoaderAllowedValuesLookup sets the lookup used to fetch allowed values
+// for variables and resource spec fields that source their allowed values
+// from data sources.
+//
+// When this option is not provided, a lookup that fetches data sources through
+// the loader's data source registry is used, caching results for
+// validation.DefaultAllowedValuesCacheTTL.
+func WithLoaderAllowedValuesLookup(allowedValuesLookup validation.AllowedValuesLookup) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.allowedValuesLookup = allowedValuesLookup
+	}
+}
+
 // WithLoaderLinkRegistry sets the link registry to be used by the loader.
 //
 // When this option is not provided, the default link registry that is
@@ -444,6 +592,60 @@ func WithLoaderResourceStabilityPollingConfig(config *ResourceStabilityPollingCo
 	}
 }
 
+// WithLoaderDeploymentHooks sets the registry of deployment hooks that will be
+// shared by all blueprint containers created by the loader.
+// Hooks can also be registered on a loaded container through its Hooks method.
+//
+// When this option is not provided, a new, empty registry of hooks is created
+// for the loader.
+func WithLoaderDeploymentHooks(hooks *DeploymentHooks) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.deploymentHooks = hooks
+	}
+}
+
+// WithLoaderConcurrencyLimiter sets the limiter that bounds the number of resources
+// that can be deployed or destroyed at the same time by blueprint containers
+// created by the loader.
+// The same limiter is used for child blueprints so limits apply across an entire
+// deployment, a limiter can also be shared between multiple loaders.
+//
+// When this option is not provided, there are no limits on the number of resources
+// that can be deployed or destroyed at the same time.
+func WithLoaderConcurrencyLimiter(limiter *ConcurrencyLimiter) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.concurrencyLimiter = limiter
+	}
+}
+
+// WithLoaderDeploymentEventStore sets the store that status updates emitted
+// during deploy and destroy operations are persisted to by blueprint containers
+// created by the loader, so they can be replayed for callers that were not
+// connected when the updates were emitted.
+// Updates for child blueprints are recorded as a part of the events
+// for the instance of the parent blueprint.
+//
+// When this option is not provided, status updates are only sent
+// to the deploy channels provided by the caller.
+func WithLoaderDeploymentEventStore(store DeploymentEventStore) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.deploymentEventStore = store
+	}
+}
+
+// WithLoaderPolicyEngine sets the policy engine used to evaluate organisational
+// policies against the changes for a deployment before the deployment proceeds
+// for blueprint containers created by the loader.
+// Violations of policies with the "deny" level will prevent the deployment
+// from proceeding, violations with the "warn" level are logged as warnings.
+//
+// When this option is not provided, policies are not evaluated for deployments.
+func WithLoaderPolicyEngine(policyEngine policy.Engine) LoaderOption {
+	return func(loader *defaultLoader) {
+		loader.policyEngine = policyEngine
+	}
+}
+
 // WithLoaderLogger sets the logger to be used by the loader.
 //
 // When this option is not provided, a default, no-op logger is used.
@@ -540,6 +742,10 @@ func NewDefaultLoader(
 		)
 	}
 
+	if loader.deploymentHooks == nil {
+		loader.deploymentHooks = NewDeploymentHooks()
+	}
+
 	if loader.dataSourceRegistry == nil {
 		loader.dataSourceRegistry = provider.NewDataSourceRegistry(
 			providers,
@@ -548,12 +754,27 @@ func NewDefaultLoader(
 		)
 	}
 
+	if loader.allowedValuesLookup == nil {
+		loader.allowedValuesLookup = validation.NewAllowedValuesLookup(
+			loader.dataSourceRegistry,
+			validation.WithAllowedValuesLookupClock(loader.clock),
+		)
+	}
+
 	if _, hasCore := internalProviders["core"]; !hasCore {
+		fileSourceRegistry := loader.fileSourceRegistry
+		if len(loader.allowedFileRoots) > 0 {
+			fileSourceRegistry = provider.NewRootRestrictedFileSourceRegistry(
+				fileSourceRegistry,
+				loader.allowedFileRoots,
+			)
+		}
+
 		internalProviders["core"] = providerhelpers.NewCoreProvider(
 			getStateContainerLinks(stateContainer),
 			bpcore.BlueprintInstanceIDFromContext,
 			loader.resolveWorkingDir,
-			loader.fileSourceRegistry,
+			fileSourceRegistry,
 			loader.clock,
 		)
 	}
@@ -572,15 +793,24 @@ func (l *defaultLoader) forChildBlueprint(
 		l.childResolver,
 		WithLoaderValidateRuntimeValues(l.validateRuntimeValues),
 		WithLoaderValidateAfterTransform(l.validateAfterTransform),
+		WithLoaderTreatWarningsAsErrors(l.treatWarningsAsErrors),
 		WithLoaderTransformSpec(l.transformSpec),
 		WithLoaderClock(l.clock),
 		WithLoaderResolveWorkingDir(l.resolveWorkingDir),
+		WithLoaderFileSourceRegistry(l.fileSourceRegistry),
+		WithLoaderAllowedFileRoots(l.allowedFileRoots),
+		WithLoaderResolveIncludesForValidation(l.resolveIncludesForValidation),
+		WithLoaderValidateProviderConfig(l.validateProviderConfig),
+		WithLoaderComplexityLimits(l.complexityLimits),
 		WithLoaderDerivedFromTemplates(derivedFromTemplate),
 		WithLoaderIDGenerator(l.idGenerator),
 		WithLoaderDefaultRetryPolicy(l.defaultRetryPolicy),
 		WithLoaderResourceRegistry(l.resourceRegistry),
 		WithLoaderFunctionRegistry(l.funcRegistry),
 		WithLoaderDataSourceRegistry(l.dataSourceRegistry),
+		WithLoaderAllowedValuesLookup(l.allowedValuesLookup),
+		WithLoaderRuleRegistry(l.ruleRegistry),
+		WithLoaderValidationCache(l.validationCache, l.validationCacheSchemaVersions),
 		WithLoaderLinkRegistry(l.linkRegistry),
 		WithLoaderDeploymentStateFactory(l.deploymentStateFactory),
 		WithLoaderChangeStagingStateFactory(l.changeStagingStateFactory),
@@ -593,6 +823,7 @@ func (l *defaultLoader) forChildBlueprint(
 		WithLoaderDriftChecker(l.driftChecker),
 		WithLoaderDependenciesOverrider(l.overrideContainerDependencies),
 		WithLoaderResourceStabilityPollingConfig(l.resourceStabilityPollingConfig),
+		WithLoaderConcurrencyLimiter(l.concurrencyLimiter),
 		WithLoaderLogger(l.logger),
 	)
 }
@@ -601,7 +832,7 @@ func (l *defaultLoader) Load(ctx context.Context, blueprintSpecFile string, para
 	loadInfo := &loadBlueprintInfo{
 		specOrFilePath: blueprintSpecFile,
 	}
-	container, diagnostics, err := l.loadSpecAndLinkInfo(ctx, loadInfo, params, loadSpecFile, deriveSpecFormat)
+	container, diagnostics, _, err := l.loadSpecAndLinkInfo(ctx, loadInfo, params, loadSpecFile, deriveSpecFormat)
 	if err != nil {
 		return container, err
 	}
@@ -615,21 +846,46 @@ func (l *defaultLoader) Validate(
 	ctx context.Context,
 	blueprintSpecFile string,
 	params bpcore.BlueprintParams,
+) (*ValidationResult, error) {
+	document, err := os.ReadFile(blueprintSpecFile)
+	if err != nil {
+		// Let the loader report the error for a file that can not be read.
+		return l.validateFile(ctx, blueprintSpecFile, params)
+	}
+
+	return l.cachedValidation(
+		ctx,
+		blueprintSpecFile,
+		document,
+		/* inputFormat */ "",
+		params,
+		func() (*ValidationResult, error) {
+			return l.validateFile(ctx, blueprintSpecFile, params)
+		},
+	)
+}
+
+func (l *defaultLoader) validateFile(
+	ctx context.Context,
+	blueprintSpecFile string,
+	params bpcore.BlueprintParams,
 ) (*ValidationResult, error) {
 	loadInfo := &loadBlueprintInfo{
 		specOrFilePath: blueprintSpecFile,
 	}
-	container, diagnostics, err := l.loadSpecAndLinkInfo(ctx, loadInfo, params, loadSpecFile, deriveSpecFormat)
+	container, diagnostics, suppressed, err := l.loadSpecAndLinkInfo(ctx, loadInfo, params, loadSpecFile, deriveSpecFormat)
 	if err != nil {
 		return &ValidationResult{
-			Diagnostics: diagnostics,
-			Schema:      getSchemaFromContainer(container),
+			Diagnostics:           diagnostics,
+			SuppressedDiagnostics: suppressed,
+			Schema:                getSchemaFromContainer(container),
 		}, err
 	}
 	return &ValidationResult{
-		Diagnostics: diagnostics,
-		Schema:      getSchemaFromContainer(container),
-		LinkInfo:    container.SpecLinkInfo(),
+		Diagnostics:           diagnostics,
+		SuppressedDiagnostics: suppressed,
+		Schema:                getSchemaFromContainer(container),
+		LinkInfo:              container.SpecLinkInfo(),
 	}, nil
 }
 
@@ -639,7 +895,7 @@ func (l *defaultLoader) loadSpecAndLinkInfo(
 	params bpcore.BlueprintParams,
 	schemaLoader schema.Loader,
 	formatLoader func(string) (schema.SpecFormat, error),
-) (BlueprintContainer, []*bpcore.Diagnostic, error) {
+) (BlueprintContainer, []*bpcore.Diagnostic, []*validation.SuppressedDiagnostic, error) {
 	refChainCollector := l.refChainCollectorFactory()
 	loadSpecRes, err := l.loadSpec(ctx, loadInfo, params, schemaLoader, formatLoader, refChainCollector)
 	if err != nil {
@@ -649,7 +905,7 @@ func (l *defaultLoader) loadSpecAndLinkInfo(
 			loadSpecRes.spec,
 			l.buildPartialBlueprintContainerDependencies(refChainCollector),
 			loadSpecRes.diagnostics,
-		), loadSpecRes.diagnostics, err
+		), loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, err
 	}
 
 	resourceTypeProviderMap := createResourceTypeProviderMap(
@@ -672,7 +928,7 @@ func (l *defaultLoader) loadSpecAndLinkInfo(
 			loadSpecRes.spec,
 			l.buildPartialBlueprintContainerDependencies(refChainCollector),
 			loadSpecRes.diagnostics,
-		), loadSpecRes.diagnostics, err
+		), loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, err
 	}
 
 	container := NewDefaultBlueprintContainer(
@@ -692,17 +948,17 @@ func (l *defaultLoader) loadSpecAndLinkInfo(
 	// a link and the other resource references a property of the first resource.
 	err = l.collectLinksAsReferences(ctx, linkInfo, refChainCollector, params)
 	if err != nil {
-		return container, loadSpecRes.diagnostics, err
+		return container, loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, err
 	}
 
 	refCycleRoots := refChainCollector.FindCircularReferences()
 	if len(refCycleRoots) > 0 {
-		return container, loadSpecRes.diagnostics, validation.ErrReferenceCycles(refCycleRoots)
+		return container, loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, validation.ErrReferenceCycles(refCycleRoots)
 	}
 
 	linkChains, err := linkInfo.Links(ctx)
 	if err != nil {
-		return container, loadSpecRes.diagnostics, err
+		return container, loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, err
 	}
 
 	linkConstraintDiags, err := validation.ValidateLinkConstraints(
@@ -713,9 +969,9 @@ func (l *defaultLoader) loadSpecAndLinkInfo(
 		params,
 	)
 	if err != nil {
-		return container, loadSpecRes.diagnostics, err
+		return container, loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, err
 	}
-	loadSpecRes.diagnostics = append(loadSpecRes.diagnostics, linkConstraintDiags...)
+	loadSpecRes.appendDiagnostics(linkConstraintDiags)
 
 	l.logger.Info("Validating link annotations")
 	annotationDiagnostics, err := validation.ValidateLinkAnnotations(
@@ -724,7 +980,7 @@ func (l *defaultLoader) loadSpecAndLinkInfo(
 		params,
 	)
 	if err != nil {
-		return container, loadSpecRes.diagnostics, err
+		return container, loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, err
 	}
 
 	// ValidateLinkAnnotations returns validation warnings and errors
@@ -735,9 +991,21 @@ func (l *defaultLoader) loadSpecAndLinkInfo(
 		annotationDiagnostics,
 		validation.ErrorReasonCodeInvalidResource,
 	)
-	loadSpecRes.diagnostics = append(loadSpecRes.diagnostics, finalAnnotationDiags...)
+	loadSpecRes.appendDiagnostics(finalAnnotationDiags)
 	if annotationsErr != nil {
-		return container, loadSpecRes.diagnostics, annotationsErr
+		return container, loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, annotationsErr
+	}
+
+	l.logger.Info("Validating link configuration")
+	linkConfigDiagnostics, err := validation.ValidateLinkConfig(
+		ctx,
+		linkChains,
+		loadSpecRes.spec.Schema(),
+		params,
+	)
+	loadSpecRes.appendDiagnostics(linkConfigDiagnostics)
+	if err != nil {
+		return container, loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, err
 	}
 
 	eachDepsErr := validation.ValidateResourceEachDependencies(
@@ -745,10 +1013,18 @@ func (l *defaultLoader) loadSpecAndLinkInfo(
 		refChainCollector,
 	)
 	if eachDepsErr != nil {
-		return container, loadSpecRes.diagnostics, eachDepsErr
+		return container, loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, eachDepsErr
 	}
 
-	return container, loadSpecRes.diagnostics, nil
+	conditionDepsErr := validation.ValidateResourceConditionDependencies(
+		loadSpecRes.spec.Schema(),
+		refChainCollector,
+	)
+	if conditionDepsErr != nil {
+		return container, loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, conditionDepsErr
+	}
+
+	return container, loadSpecRes.diagnostics, loadSpecRes.suppressedDiagnostics, nil
 }
 
 func (l *defaultLoader) buildPartialBlueprintContainerDependencies(
@@ -806,6 +1082,7 @@ func (l *defaultLoader) buildFullBlueprintContainerDependencies(
 		l.stateContainer,
 		substitutionResolver,
 		resourceCache,
+		blueprintSpec,
 	)
 	// As the child change stager uses the child export field cache and
 	// substitution resolver, it must be created for each blueprint container that is loaded.
@@ -843,6 +1120,14 @@ func (l *defaultLoader) buildFullBlueprintContainerDependencies(
 		l.stateContainer,
 		changes.NewDefaultResourceChangeGenerator(),
 		linkChangeStager,
+		l.funcRegistry,
+	)
+
+	// As the blueprint renderer uses the resource cache and substitution resolver,
+	// it must be created for each blueprint container that is loaded.
+	blueprintRenderer := NewDefaultBlueprintRenderer(
+		substitutionResolver,
+		resourceCache,
 	)
 
 	initialDependencies := &BlueprintContainerDependencies{
@@ -850,6 +1135,7 @@ func (l *defaultLoader) buildFullBlueprintContainerDependencies(
 		Providers:                 l.providers,
 		ResourceRegistry:          resourceRegistry,
 		LinkRegistry:              l.linkRegistry,
+		DataSourceRegistry:        l.dataSourceRegistry,
 		LinkInfo:                  linkInfo,
 		ResourceTemplates:         l.resourceTemplates,
 		RefChainCollector:         refChainCollector,
@@ -869,7 +1155,12 @@ func (l *defaultLoader) buildFullBlueprintContainerDependencies(
 		DriftChecker:              l.driftChecker,
 		ResourceDeployer:          resourceDeployer,
 		ChildBlueprintDeployer:    childBlueprintDeployer,
+		BlueprintRenderer:         blueprintRenderer,
 		DefaultRetryPolicy:        l.defaultRetryPolicy,
+		DeploymentHooks:           l.deploymentHooks,
+		ConcurrencyLimiter:        l.concurrencyLimiter,
+		DeploymentEventStore:      l.deploymentEventStore,
+		PolicyEngine:              l.policyEngine,
 		Logger:                    l.logger.Named("container"),
 	}
 
@@ -920,6 +1211,36 @@ func (l *defaultLoader) loadSpec(
 		}, err
 	}
 
+	l.logger.Info("Checking blueprint complexity limits")
+	err = validation.ValidateComplexityLimits(
+		blueprintSchema,
+		getIncludeDepth(params),
+		l.complexityLimits,
+	)
+	if err != nil {
+		// Blueprints that exceed the complexity limits are not validated any further
+		// to avoid spending resources on pathological blueprints.
+		return &loadSpecResult{
+			spec:        speccore.BlueprintSpecFromSchema(blueprintSchema),
+			diagnostics: diagnostics,
+		}, err
+	}
+
+	l.logger.Info("Validating and expanding user-defined functions")
+	err = validation.ValidateFunctions(ctx, blueprintSchema, l.funcRegistry)
+	if err == nil {
+		err = validation.ExpandFunctionCalls(blueprintSchema)
+	}
+	if err != nil {
+		// Calls to user-defined functions can not be validated in the same way as
+		// calls to registered functions so the blueprint is not validated any further
+		// when user-defined functions or calls to them are invalid.
+		return &loadSpecResult{
+			spec:        speccore.BlueprintSpecFromSchema(blueprintSchema),
+			diagnostics: diagnostics,
+		}, err
+	}
+
 	l.logger.Info("Validating blueprint top-level properties")
 	var bpValidationDiagnostics []*bpcore.Diagnostic
 	validationErrors := []error{}
@@ -929,13 +1250,22 @@ func (l *defaultLoader) loadSpec(
 		validationErrors = append(validationErrors, err)
 	}
 
+	l.logger.Info("Checking for duplicate element definitions")
+	err = validation.ValidateDuplicateDefinitions(blueprintSchema)
+	if err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
 	valCtx := &validation.ValidationContext{
-		BpSchema:           blueprintSchema,
-		Params:             params,
-		FuncRegistry:       l.funcRegistry,
-		RefChainCollector:  refChainCollector,
-		ResourceRegistry:   l.resourceRegistry.WithParams(params),
-		DataSourceRegistry: l.dataSourceRegistry,
+		BpSchema:             blueprintSchema,
+		Params:               params,
+		FuncRegistry:         l.funcRegistry,
+		RefChainCollector:    refChainCollector,
+		ResourceRegistry:     l.resourceRegistry.WithParams(params),
+		DataSourceRegistry:   l.dataSourceRegistry,
+		AllowedValuesLookup:  l.allowedValuesLookup,
+		InstanceExportLookup: l.createInstanceExportLookup(),
+		ElementUsage:         validation.NewElementUsage(),
 	}
 
 	l.logger.Info("Validating blueprint variables")
@@ -955,17 +1285,17 @@ func (l *defaultLoader) loadSpec(
 	}
 
 	l.logger.Info("Resolving child blueprint schemas for validation")
-	childSchemas := l.resolveChildBlueprintSchemas(blueprintSchema, params)
+	childBlueprints := l.resolveChildBlueprintSchemas(ctx, blueprintSchema, params)
 
 	l.logger.Info("Validating blueprint includes")
 	var includeDiagnostics []*bpcore.Diagnostic
-	includeDiagnostics, err = l.validateIncludes(ctx, valCtx, childSchemas)
+	includeDiagnostics, err = l.validateIncludes(ctx, valCtx, childBlueprints)
 	diagnostics = append(diagnostics, includeDiagnostics...)
 	if err != nil {
 		validationErrors = append(validationErrors, err)
 	}
 
-	childExportLookup := l.createChildExportLookup(childSchemas)
+	childExportLookup := l.createChildExportLookup(childBlueprints)
 	valCtx.ChildExportLookup = childExportLookup
 
 	l.logger.Info("Validating blueprint exports")
@@ -976,6 +1306,12 @@ func (l *defaultLoader) loadSpec(
 		validationErrors = append(validationErrors, err)
 	}
 
+	l.logger.Info("Validating blueprint hooks")
+	err = validation.ValidateHooks(valCtx.BpSchema.Hooks, valCtx.BpSchema.Resources)
+	if err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
 	l.logger.Info("Validating blueprint top-level metadata")
 	var metadataDiagnostics []*bpcore.Diagnostic
 	metadataDiagnostics, err = l.validateMetadata(ctx, valCtx)
@@ -1000,6 +1336,68 @@ func (l *defaultLoader) loadSpec(
 		validationErrors = append(validationErrors, err)
 	}
 
+	if l.validateProviderConfig {
+		l.logger.Info("Validating provider configuration")
+		var providerConfigDiagnostics []*bpcore.Diagnostic
+		providerConfigDiagnostics, err = validation.ValidateProviderConfig(
+			ctx,
+			blueprintSchema,
+			params,
+			l.providers,
+		)
+		diagnostics = append(diagnostics, providerConfigDiagnostics...)
+		if err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
+	l.logger.Info("Validating references to other blueprint instances")
+	var instanceRefDiagnostics []*bpcore.Diagnostic
+	instanceRefDiagnostics, err = validation.ValidateInstanceReferences(ctx, valCtx)
+	diagnostics = append(diagnostics, instanceRefDiagnostics...)
+	if err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
+	l.logger.Info("Checking blueprint reference graph size")
+	err = validation.ValidateReferenceGraphSize(refChainCollector, l.complexityLimits)
+	if err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
+	coreValidationPassed := len(validationErrors) == 0
+	if coreValidationPassed {
+		// Unused elements are only reported for blueprints that are otherwise valid,
+		// as references in invalid parts of a blueprint may not have been tracked.
+		l.logger.Info("Checking for unused blueprint variables, values and data sources")
+		err = validation.ValidateUnusedElements(blueprintSchema, valCtx.ElementUsage)
+		if err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
+	if coreValidationPassed && l.ruleRegistry != nil {
+		// Custom rules are only applied to blueprints that pass the core validation
+		// checks so rules can rely on the blueprint being structurally valid.
+		l.logger.Info("Applying custom validation rules")
+		var ruleDiagnostics []*bpcore.Diagnostic
+		ruleDiagnostics, err = validation.ValidateRules(
+			ctx,
+			l.ruleRegistry,
+			&validation.RuleInput{
+				BpSchema:           blueprintSchema,
+				Params:             params,
+				FuncRegistry:       l.funcRegistry,
+				ResourceRegistry:   valCtx.ResourceRegistry,
+				DataSourceRegistry: l.dataSourceRegistry,
+			},
+		)
+		diagnostics = append(diagnostics, ruleDiagnostics...)
+		if err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
 	l.logger.Info("Collecting declared links for blueprint into a graph")
 	declaredLinkGraph, err := links.EnumerateDeclaredLinks(
 		ctx,
@@ -1049,21 +1447,62 @@ func (l *defaultLoader) loadSpec(
 		}
 	}
 
+	// Suppressions are applied before the warning severity so that suppressed
+	// warnings do not fail validation when warnings are treated as errors.
+	diagnostics, validationErrors, suppressedDiagnostics := validation.ApplySuppressions(
+		blueprintSchema,
+		diagnostics,
+		validationErrors,
+	)
+	diagnostics, validationErrors = l.applyWarningSeverity(diagnostics, validationErrors)
 	if len(validationErrors) > 0 {
 		return &loadSpecResult{
-			spec:              speccore.BlueprintSpecFromSchema(transformedSchema),
-			diagnostics:       diagnostics,
-			declaredLinkGraph: declaredLinkGraph,
+			spec:                  speccore.BlueprintSpecFromSchema(transformedSchema),
+			diagnostics:           diagnostics,
+			declaredLinkGraph:     declaredLinkGraph,
+			sourceSchema:          blueprintSchema,
+			suppressedDiagnostics: suppressedDiagnostics,
 		}, validation.ErrMultipleValidationErrors(validationErrors)
 	}
 
 	return &loadSpecResult{
-		spec:              speccore.BlueprintSpecFromSchema(transformedSchema),
-		diagnostics:       diagnostics,
-		declaredLinkGraph: declaredLinkGraph,
+		spec:                  speccore.BlueprintSpecFromSchema(transformedSchema),
+		diagnostics:           diagnostics,
+		declaredLinkGraph:     declaredLinkGraph,
+		sourceSchema:          blueprintSchema,
+		suppressedDiagnostics: suppressedDiagnostics,
 	}, nil
 }
 
+// applyWarningSeverity separates validation errors with the warning severity
+// into diagnostics, unless warnings are treated as errors,
+// in which case warning diagnostics are converted into validation errors.
+func (l *defaultLoader) applyWarningSeverity(
+	diagnostics []*bpcore.Diagnostic,
+	validationErrors []error,
+) ([]*bpcore.Diagnostic, []error) {
+	if l.treatWarningsAsErrors {
+		return diagnostics, append(
+			validationErrors,
+			validation.WarningDiagnosticsAsErrors(
+				diagnostics,
+				validation.ErrorReasonCodeValidationWarning,
+			)...,
+		)
+	}
+
+	remainingErrors := []error{}
+	for _, err := range validationErrors {
+		warningDiagnostics, remainingErr := validation.SplitWarnings(err)
+		diagnostics = append(diagnostics, warningDiagnostics...)
+		if remainingErr != nil {
+			remainingErrors = append(remainingErrors, remainingErr)
+		}
+	}
+
+	return diagnostics, remainingErrors
+}
+
 func (l *defaultLoader) validateAndApplyTransforms(
 	ctx context.Context,
 	blueprintSchema *schema.Blueprint,
@@ -1280,6 +1719,20 @@ func (l *defaultLoader) validateVariable(
 		*diagnostics = append(*diagnostics, customVarDiagnostics...)
 	}
 
+	allowedValuesFromDiagnostics, err := validation.ValidateVariableAllowedValuesFrom(
+		ctx,
+		name,
+		varSchema,
+		valCtx.BpSchema.Variables,
+		valCtx.Params,
+		valCtx.AllowedValuesLookup,
+		l.validateRuntimeValues,
+	)
+	if err != nil {
+		currentVarErrs = append(currentVarErrs, err)
+	}
+	*diagnostics = append(*diagnostics, allowedValuesFromDiagnostics...)
+
 	valCtx.RefChainCollector.Collect(bpcore.VariableElementID(name), varSchema, "", []string{})
 	return currentVarErrs
 }
@@ -1339,7 +1792,7 @@ func (l *defaultLoader) validateValue(
 func (l *defaultLoader) validateIncludes(
 	ctx context.Context,
 	valCtx *validation.ValidationContext,
-	childSchemas map[string]*schema.Blueprint,
+	childBlueprints map[string]*resolvedChildBlueprint,
 ) ([]*bpcore.Diagnostic, error) {
 	diagnostics := []*bpcore.Diagnostic{}
 	if valCtx.BpSchema.Include == nil {
@@ -1372,13 +1825,14 @@ func (l *defaultLoader) validateIncludes(
 			includeErrors[name] = pathErr
 		}
 
-		if childBp, ok := childSchemas[name]; ok {
+		if childBp, ok := childBlueprints[name]; ok {
 			varDiagnostics, varErr := validation.ValidateIncludeVariables(
 				ctx,
 				name,
 				includeSchema,
 				valCtx.BpSchema.Include,
-				childBp,
+				childBp.schema,
+				childBp.location,
 				valCtx,
 			)
 			diagnostics = append(diagnostics, varDiagnostics...)
@@ -1599,6 +2053,11 @@ func (l *defaultLoader) validateResources(
 		}
 	}
 
+	uniquenessErrors := validation.ValidateResourceUniqueness(ctx, valCtx)
+	for name, errs := range uniquenessErrors {
+		resourceErrors[name] = append(resourceErrors[name], errs...)
+	}
+
 	if len(resourceErrors) > 0 {
 		return diagnostics, errResourceValidationError(resourceErrors)
 	}
@@ -1654,7 +2113,7 @@ func (l *defaultLoader) LoadString(
 	loadInfo := &loadBlueprintInfo{
 		specOrFilePath: blueprintSpec,
 	}
-	container, diagnostics, err := l.loadSpecAndLinkInfo(ctx, loadInfo, params, loadSpecString, predefinedFormatFactory(inputFormat))
+	container, diagnostics, _, err := l.loadSpecAndLinkInfo(ctx, loadInfo, params, loadSpecString, predefinedFormatFactory(inputFormat))
 	if err != nil {
 		return container, err
 	}
@@ -1669,22 +2128,42 @@ func (l *defaultLoader) ValidateString(
 	blueprintSpec string,
 	inputFormat schema.SpecFormat,
 	params bpcore.BlueprintParams,
+) (*ValidationResult, error) {
+	return l.cachedValidation(
+		ctx,
+		/* source */ "",
+		[]byte(blueprintSpec),
+		inputFormat,
+		params,
+		func() (*ValidationResult, error) {
+			return l.validateString(ctx, blueprintSpec, inputFormat, params)
+		},
+	)
+}
+
+func (l *defaultLoader) validateString(
+	ctx context.Context,
+	blueprintSpec string,
+	inputFormat schema.SpecFormat,
+	params bpcore.BlueprintParams,
 ) (*ValidationResult, error) {
 	loadInfo := &loadBlueprintInfo{
 		specOrFilePath: blueprintSpec,
 	}
-	container, diagnostics, err := l.loadSpecAndLinkInfo(ctx, loadInfo, params, loadSpecString, predefinedFormatFactory(inputFormat))
+	container, diagnostics, suppressed, err := l.loadSpecAndLinkInfo(ctx, loadInfo, params, loadSpecString, predefinedFormatFactory(inputFormat))
 	if err != nil {
 		return &ValidationResult{
-			Diagnostics: diagnostics,
-			Schema:      getSchemaFromContainer(container),
+			Diagnostics:           diagnostics,
+			SuppressedDiagnostics: suppressed,
+			Schema:                getSchemaFromContainer(container),
 		}, err
 	}
 
 	return &ValidationResult{
-		Diagnostics: diagnostics,
-		Schema:      getSchemaFromContainer(container),
-		LinkInfo:    container.SpecLinkInfo(),
+		Diagnostics:           diagnostics,
+		SuppressedDiagnostics: suppressed,
+		Schema:                getSchemaFromContainer(container),
+		LinkInfo:              container.SpecLinkInfo(),
 	}, nil
 }
 
@@ -1696,7 +2175,7 @@ func (l *defaultLoader) LoadFromSchema(
 	loadInfo := &loadBlueprintInfo{
 		preloadedSchema: blueprintSchema,
 	}
-	container, diagnostics, err := l.loadSpecAndLinkInfo(
+	container, diagnostics, _, err := l.loadSpecAndLinkInfo(
 		ctx,
 		loadInfo,
 		params,
@@ -1720,7 +2199,7 @@ func (l *defaultLoader) ValidateFromSchema(
 	loadInfo := &loadBlueprintInfo{
 		preloadedSchema: blueprintSchema,
 	}
-	container, diagnostics, err := l.loadSpecAndLinkInfo(
+	container, diagnostics, suppressed, err := l.loadSpecAndLinkInfo(
 		ctx,
 		loadInfo,
 		params,
@@ -1729,14 +2208,16 @@ func (l *defaultLoader) ValidateFromSchema(
 	)
 	if err != nil {
 		return &ValidationResult{
-			Diagnostics: diagnostics,
-			Schema:      blueprintSchema,
+			Diagnostics:           diagnostics,
+			SuppressedDiagnostics: suppressed,
+			Schema:                blueprintSchema,
 		}, err
 	}
 	return &ValidationResult{
-		Diagnostics: diagnostics,
-		Schema:      container.BlueprintSpec().Schema(),
-		LinkInfo:    container.SpecLinkInfo(),
+		Diagnostics:           diagnostics,
+		SuppressedDiagnostics: suppressed,
+		Schema:                container.BlueprintSpec().Schema(),
+		LinkInfo:              container.SpecLinkInfo(),
 	}, nil
 }
 
@@ -1798,95 +2279,235 @@ func getStateContainerLinks(
 // and returns a ChildExportTypeLookup that can be used during validation
 // to resolve child export types.
 func (l *defaultLoader) createChildExportLookup(
-	childSchemas map[string]*schema.Blueprint,
+	childBlueprints map[string]*resolvedChildBlueprint,
 ) validation.ChildExportTypeLookup {
-	if len(childSchemas) == 0 {
+	if len(childBlueprints) == 0 {
 		return nil
 	}
 
 	return func(childName string, exportName string, location *source.Meta) (*schema.Export, error) {
-		childBp, ok := childSchemas[childName]
+		childBp, ok := childBlueprints[childName]
 		if !ok {
-			// Child blueprint couldn't be resolved from the filesystem,
+			// Child blueprint couldn't be resolved,
 			// return nil to indicate "unknown" rather than "not found".
 			return nil, nil
 		}
 
-		if childBp.Exports == nil {
-			return nil, validation.ErrChildExportNotFound(childName, exportName, location)
+		if childBp.schema.Exports == nil {
+			return nil, validation.ErrChildExportNotFound(
+				childName, exportName, childBp.location, location,
+			)
 		}
 
-		exportSchema, ok := childBp.Exports.Values[exportName]
+		exportSchema, ok := childBp.schema.Exports.Values[exportName]
 		if !ok {
-			return nil, validation.ErrChildExportNotFound(childName, exportName, location)
+			return nil, validation.ErrChildExportNotFound(
+				childName, exportName, childBp.location, location,
+			)
 		}
 
 		return exportSchema, nil
 	}
 }
 
+// createInstanceExportLookup creates a lookup used to check that other deployed
+// blueprint instances referenced in the blueprint and their exports exist.
+// When no state container is configured, only the format of instance references
+// will be validated.
+func (l *defaultLoader) createInstanceExportLookup() validation.InstanceExportLookup {
+	if l.stateContainer == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, instanceName string, exportName string) (bool, bool, error) {
+		instances := l.stateContainer.Instances()
+		instanceID, err := instances.LookupIDByName(ctx, instanceName)
+		if err != nil {
+			if state.IsInstanceNotFound(err) {
+				return false, false, nil
+			}
+			return false, false, err
+		}
+
+		instanceState, err := instances.Get(ctx, instanceID)
+		if err != nil {
+			if state.IsInstanceNotFound(err) {
+				return false, false, nil
+			}
+			return false, false, err
+		}
+
+		exportState, hasExport := instanceState.Exports[exportName]
+		return true, hasExport && exportState != nil, nil
+	}
+}
+
+// resolvedChildBlueprint holds a child blueprint that has been loaded
+// for validation along with the location it was loaded from.
+type resolvedChildBlueprint struct {
+	schema *schema.Blueprint
+	// The absolute path or include path of the child blueprint,
+	// used to point users to the child blueprint in error messages.
+	location string
+}
+
 // resolveChildBlueprintSchemas attempts to load child blueprints from local
 // filesystem paths for use during validation.
+// When enabled, child blueprints that can not be loaded from the local file system
+// are resolved with the loader's child resolver.
 // Returns a map of include names to their parsed blueprint schemas.
 // Failures are logged and skipped.
 func (l *defaultLoader) resolveChildBlueprintSchemas(
+	ctx context.Context,
 	bpSchema *schema.Blueprint,
 	params bpcore.BlueprintParams,
-) map[string]*schema.Blueprint {
+) map[string]*resolvedChildBlueprint {
 	if bpSchema.Include == nil {
 		return nil
 	}
 
-	childSchemas := map[string]*schema.Blueprint{}
+	childBlueprints := map[string]*resolvedChildBlueprint{}
 	for name, includeSchema := range bpSchema.Include.Values {
 		if includeSchema.Path == nil {
 			continue
 		}
 
-		if validation.IsRemoteInclude(includeSchema) {
-			continue
+		childBp := l.loadLocalChildBlueprint(name, includeSchema, params)
+		if childBp == nil && l.resolveIncludesForValidation && l.childResolver != nil {
+			childBp = l.loadChildBlueprintWithResolver(ctx, name, includeSchema, params)
 		}
 
-		resolvedPath, ok := validation.TryResolveIncludePath(
-			includeSchema.Path,
-			l.resolveWorkingDir,
-		)
-		if !ok {
-			continue
+		if childBp != nil {
+			childBlueprints[name] = childBp
 		}
+	}
 
-		if !filepath.IsAbs(resolvedPath) {
-			baseDir := resolveBaseDirForLoader(params, l.resolveWorkingDir)
-			if baseDir == "" {
-				continue
-			}
-			resolvedPath = filepath.Join(baseDir, resolvedPath)
-		}
+	return childBlueprints
+}
 
-		format, err := deriveSpecFormat(resolvedPath)
-		if err != nil {
-			l.logger.Debug(
-				"Could not determine format for child blueprint",
-				bpcore.StringLogField("include", name),
-				bpcore.StringLogField("path", resolvedPath),
-			)
-			continue
-		}
+func (l *defaultLoader) loadLocalChildBlueprint(
+	name string,
+	includeSchema *schema.Include,
+	params bpcore.BlueprintParams,
+) *resolvedChildBlueprint {
+	if validation.IsRemoteInclude(includeSchema) {
+		return nil
+	}
 
-		childBp, err := loadSpecFile(resolvedPath, format)
-		if err != nil {
-			l.logger.Debug(
-				"Could not load child blueprint for validation",
-				bpcore.StringLogField("include", name),
-				bpcore.StringLogField("path", resolvedPath),
-			)
-			continue
+	resolvedPath, ok := validation.TryResolveIncludePath(
+		includeSchema.Path,
+		l.resolveWorkingDir,
+	)
+	if !ok {
+		return nil
+	}
+
+	if !filepath.IsAbs(resolvedPath) {
+		baseDir := resolveBaseDirForLoader(params, l.resolveWorkingDir)
+		if baseDir == "" {
+			return nil
 		}
+		resolvedPath = filepath.Join(baseDir, resolvedPath)
+	}
+
+	return l.loadChildBlueprintFile(name, resolvedPath)
+}
+
+func (l *defaultLoader) loadChildBlueprintFile(
+	name string,
+	path string,
+) *resolvedChildBlueprint {
+	format, err := deriveSpecFormat(path)
+	if err != nil {
+		l.logger.Debug(
+			"Could not determine format for child blueprint",
+			bpcore.StringLogField("include", name),
+			bpcore.StringLogField("path", path),
+		)
+		return nil
+	}
 
-		childSchemas[name] = childBp
+	childBp, err := loadSpecFile(path, format)
+	if err != nil {
+		l.logger.Debug(
+			"Could not load child blueprint for validation",
+			bpcore.StringLogField("include", name),
+			bpcore.StringLogField("path", path),
+		)
+		return nil
+	}
+
+	return &resolvedChildBlueprint{
+		schema:   childBp,
+		location: path,
+	}
+}
+
+// loadChildBlueprintWithResolver resolves a child blueprint with the loader's
+// child resolver, substitutions are not resolved at the validation stage so
+// only includes with a path and metadata that are known before deployment
+// can be resolved.
+func (l *defaultLoader) loadChildBlueprintWithResolver(
+	ctx context.Context,
+	name string,
+	includeSchema *schema.Include,
+	params bpcore.BlueprintParams,
+) *resolvedChildBlueprint {
+	includePath, ok := validation.TryResolveIncludePath(
+		includeSchema.Path,
+		l.resolveWorkingDir,
+	)
+	if !ok || containsSubstitutions(includeSchema.Metadata) {
+		return nil
 	}
 
-	return childSchemas
+	resolvedInclude := &subengine.ResolvedInclude{
+		Path:     bpcore.MappingNodeFromString(includePath),
+		Metadata: includeSchema.Metadata,
+	}
+	childBlueprintInfo, err := l.childResolver.Resolve(ctx, name, resolvedInclude, params)
+	if err != nil {
+		l.logger.Debug(
+			"Could not resolve child blueprint for validation",
+			bpcore.StringLogField("include", name),
+			bpcore.StringLogField("path", includePath),
+			bpcore.ErrorLogField("error", err),
+		)
+		return nil
+	}
+
+	if childBlueprintInfo.AbsolutePath != nil {
+		return l.loadChildBlueprintFile(name, *childBlueprintInfo.AbsolutePath)
+	}
+
+	if childBlueprintInfo.BlueprintSource == nil {
+		return nil
+	}
+
+	format, err := extractChildBlueprintFormat(name, resolvedInclude)
+	if err != nil {
+		l.logger.Debug(
+			"Could not determine format for child blueprint",
+			bpcore.StringLogField("include", name),
+			bpcore.StringLogField("path", includePath),
+		)
+		return nil
+	}
+
+	childBp, err := loadSpecString(*childBlueprintInfo.BlueprintSource, format)
+	if err != nil {
+		l.logger.Debug(
+			"Could not load child blueprint for validation",
+			bpcore.StringLogField("include", name),
+			bpcore.StringLogField("path", includePath),
+		)
+		return nil
+	}
+
+	return &resolvedChildBlueprint{
+		schema:   childBp,
+		location: includePath,
+	}
 }
 
 func resolveBaseDirForLoader(
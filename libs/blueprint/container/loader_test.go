@@ -2,11 +2,13 @@ package container
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/memstate"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
@@ -31,6 +33,8 @@ type LoaderTestSuite struct {
 	loaderGoErrorLinks           Loader
 	loaderErrorEmit              Loader
 	loaderWarningEmit            Loader
+	loaderWarningsAsErrors       Loader
+	loaderWithRules              Loader
 	providersWithoutCore         map[string]provider.Provider
 	specTransformers             map[string]transform.SpecTransformer
 	logger                       core.Logger
@@ -200,6 +204,30 @@ func (s *LoaderTestSuite) SetupSuite() {
 		WithLoaderRefChainCollectorFactory(refgraph.NewRefChainCollector),
 		WithLoaderLogger(logger),
 	)
+	s.loaderWarningsAsErrors = NewDefaultLoader(
+		providers,
+		specTransformers,
+		stateContainer,
+		newFSChildResolver(),
+		WithLoaderTransformSpec(true),
+		WithLoaderTreatWarningsAsErrors(true),
+		WithLoaderRefChainCollectorFactory(refgraph.NewRefChainCollector),
+		WithLoaderLogger(logger),
+	)
+	ruleRegistry, err := validation.NewRuleRegistry(
+		&forbiddenResourceTypeRule{resourceType: "aws/dynamodb/table"},
+	)
+	s.Require().NoError(err)
+	s.loaderWithRules = NewDefaultLoader(
+		providers,
+		specTransformers,
+		stateContainer,
+		newFSChildResolver(),
+		WithLoaderTransformSpec(true),
+		WithLoaderRuleRegistry(ruleRegistry),
+		WithLoaderRefChainCollectorFactory(refgraph.NewRefChainCollector),
+		WithLoaderLogger(logger),
+	)
 }
 
 func (s *LoaderTestSuite) Test_loads_container_from_input_spec_file_without_any_issues() {
@@ -686,6 +714,309 @@ func (s *LoaderTestSuite) Test_Load_does_not_halt_for_warning_emit_diagnostic()
 	s.Assert().NotNil(container)
 }
 
+func (s *LoaderTestSuite) Test_reports_unused_variables_and_values_as_warnings() {
+	result, err := s.loader.Validate(
+		context.TODO(),
+		s.specFixtureFiles["valid"],
+		createParams(),
+	)
+	s.Require().NoError(err)
+	s.Assert().True(hasDiagnosticWithMessage(
+		result.Diagnostics,
+		core.DiagnosticLevelWarning,
+		"variable \"instanceType\" is defined but is never referenced in the blueprint",
+	))
+	s.Assert().True(hasDiagnosticWithMessage(
+		result.Diagnostics,
+		core.DiagnosticLevelWarning,
+		"value \"tableName\" is defined but is never referenced in the blueprint",
+	))
+	s.Assert().False(hasDiagnosticWithMessage(
+		result.Diagnostics,
+		core.DiagnosticLevelWarning,
+		"variable \"environment\"",
+	))
+}
+
+func (s *LoaderTestSuite) Test_fails_to_load_blueprint_with_warnings_when_treating_warnings_as_errors() {
+	_, err := s.loaderWarningsAsErrors.Load(
+		context.TODO(),
+		s.specFixtureFiles["valid"],
+		createParams(),
+	)
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+
+	reasonCodes := []errors.ErrorReasonCode{}
+	for _, childErr := range collectLoadErrorLeaves(loadErr) {
+		s.Assert().Equal(errors.ErrorSeverityWarning, childErr.Severity)
+		reasonCodes = append(reasonCodes, childErr.ReasonCode)
+	}
+	s.Assert().Contains(reasonCodes, validation.ErrorReasonCodeUnusedVariable)
+	s.Assert().Contains(reasonCodes, validation.ErrorReasonCodeUnusedValue)
+}
+
+func (s *LoaderTestSuite) Test_records_warnings_suppressed_by_directives_in_blueprint() {
+	blueprintWithSuppressions := strings.Replace(
+		s.specFixtures["valid"],
+		"\n  instanceType:",
+		"\n  # bluelink:disable unused_variable -- reserved for compute resources\n  instanceType:",
+		1,
+	)
+	result, err := s.loader.ValidateString(
+		context.TODO(),
+		blueprintWithSuppressions,
+		schema.YAMLSpecFormat,
+		createParams(),
+	)
+	s.Require().NoError(err)
+	s.Assert().False(hasDiagnosticWithMessage(
+		result.Diagnostics,
+		core.DiagnosticLevelWarning,
+		"variable \"instanceType\"",
+	))
+	s.Assert().True(hasDiagnosticWithMessage(
+		result.Diagnostics,
+		core.DiagnosticLevelWarning,
+		"value \"tableName\" is defined but is never referenced in the blueprint",
+	))
+
+	s.Require().Len(result.SuppressedDiagnostics, 1)
+	suppressed := result.SuppressedDiagnostics[0]
+	s.Assert().Equal("variables.instanceType", suppressed.ElementID)
+	s.Assert().Equal("reserved for compute resources", suppressed.Reason)
+	s.Assert().Equal(
+		validation.ErrorReasonCodeUnusedVariable,
+		suppressed.Diagnostic.Context.ReasonCode,
+	)
+}
+
+func (s *LoaderTestSuite) Test_does_not_fail_for_suppressed_warnings_when_treating_warnings_as_errors() {
+	blueprintWithSuppressions := strings.Replace(
+		s.specFixtures["valid"],
+		"\n  instanceType:",
+		"\n  instanceType: # bluelink:disable unused_variable",
+		1,
+	)
+	blueprintWithSuppressions = strings.Replace(
+		blueprintWithSuppressions,
+		"\n  tableName:",
+		"\n  tableName: # bluelink:disable unused_value",
+		1,
+	)
+	blueprintWithSuppressions = strings.Replace(
+		blueprintWithSuppressions,
+		"\n  network:",
+		"\n  network: # bluelink:disable unused_data_source",
+		1,
+	)
+	result, err := s.loaderWarningsAsErrors.ValidateString(
+		context.TODO(),
+		blueprintWithSuppressions,
+		schema.YAMLSpecFormat,
+		createParams(),
+	)
+	s.Require().NoError(err)
+	s.Assert().Len(result.SuppressedDiagnostics, 3)
+}
+
+func (s *LoaderTestSuite) Test_fails_to_load_blueprint_that_violates_custom_validation_rule() {
+	result, err := s.loaderWithRules.Validate(
+		context.TODO(),
+		s.specFixtureFiles["valid"],
+		createParams(),
+	)
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+
+	leaves := collectLoadErrorLeaves(loadErr)
+	s.Require().Len(leaves, 1)
+	s.Assert().Equal(validation.ErrorReasonCodeValidationRuleViolation, leaves[0].ReasonCode)
+	s.Assert().Contains(
+		leaves[0].Error(),
+		"resource type \"aws/dynamodb/table\" is not allowed",
+	)
+	// Warnings from the core validators are still reported alongside rule violations.
+	s.Assert().True(hasDiagnosticWithMessage(
+		result.Diagnostics,
+		core.DiagnosticLevelWarning,
+		"variable \"instanceType\" is defined but is never referenced in the blueprint",
+	))
+}
+
+func (s *LoaderTestSuite) Test_serves_repeated_validation_of_unchanged_blueprint_from_cache() {
+	rule := &countingRule{}
+	validationCache := NewInMemoryValidationCache(10)
+	loader := s.createLoaderWithValidationCache(
+		rule,
+		validationCache,
+		map[string]string{"aws": "1.0.0"},
+	)
+
+	firstResult, err := loader.ValidateString(
+		context.TODO(),
+		s.specFixtures["valid"],
+		schema.YAMLSpecFormat,
+		createParams(),
+	)
+	s.Require().NoError(err)
+	secondResult, err := loader.ValidateString(
+		context.TODO(),
+		s.specFixtures["valid"],
+		schema.YAMLSpecFormat,
+		createParams(),
+	)
+	s.Require().NoError(err)
+	s.Assert().Same(firstResult, secondResult)
+	s.Assert().Equal(1, rule.runs)
+
+	_, err = loader.ValidateString(
+		context.TODO(),
+		s.specFixtures["valid"]+"\n# A change to the document.\n",
+		schema.YAMLSpecFormat,
+		createParams(),
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(2, rule.runs)
+
+	_, err = loader.Validate(context.TODO(), s.specFixtureFiles["valid"], createParams())
+	s.Require().NoError(err)
+	_, err = loader.Validate(context.TODO(), s.specFixtureFiles["valid"], createParams())
+	s.Require().NoError(err)
+	s.Assert().Equal(3, rule.runs)
+
+	validationCache.Clear()
+	_, err = loader.ValidateString(
+		context.TODO(),
+		s.specFixtures["valid"],
+		schema.YAMLSpecFormat,
+		createParams(),
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(4, rule.runs)
+}
+
+func (s *LoaderTestSuite) Test_does_not_serve_cached_validation_for_different_provider_schema_versions() {
+	rule := &countingRule{}
+	validationCache := NewInMemoryValidationCache(10)
+	loader := s.createLoaderWithValidationCache(
+		rule,
+		validationCache,
+		map[string]string{"aws": "1.0.0"},
+	)
+	upgradedLoader := s.createLoaderWithValidationCache(
+		rule,
+		validationCache,
+		map[string]string{"aws": "1.1.0"},
+	)
+
+	_, err := loader.ValidateString(
+		context.TODO(),
+		s.specFixtures["valid"],
+		schema.YAMLSpecFormat,
+		createParams(),
+	)
+	s.Require().NoError(err)
+	_, err = upgradedLoader.ValidateString(
+		context.TODO(),
+		s.specFixtures["valid"],
+		schema.YAMLSpecFormat,
+		createParams(),
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(2, rule.runs)
+}
+
+func (s *LoaderTestSuite) createLoaderWithValidationCache(
+	rule validation.Rule,
+	validationCache ValidationCache,
+	schemaVersions map[string]string,
+) Loader {
+	ruleRegistry, err := validation.NewRuleRegistry(rule)
+	s.Require().NoError(err)
+
+	return NewDefaultLoader(
+		s.providersWithoutCore,
+		s.specTransformers,
+		memstate.NewMemoryStateContainer(),
+		newFSChildResolver(),
+		WithLoaderTransformSpec(true),
+		WithLoaderRuleRegistry(ruleRegistry),
+		WithLoaderValidationCache(validationCache, schemaVersions),
+		WithLoaderRefChainCollectorFactory(refgraph.NewRefChainCollector),
+		WithLoaderLogger(s.logger),
+	)
+}
+
+// countingRule is a validation rule that keeps track of the number of
+// times it has been applied, this is used to determine whether a blueprint
+// was validated or the result was served from a cache.
+type countingRule struct {
+	runs int
+}
+
+func (r *countingRule) Name() string {
+	return "test/counting"
+}
+
+func (r *countingRule) Validate(
+	ctx context.Context,
+	input *validation.RuleInput,
+) ([]*core.Diagnostic, error) {
+	r.runs += 1
+	return []*core.Diagnostic{}, nil
+}
+
+type forbiddenResourceTypeRule struct {
+	resourceType string
+}
+
+func (r *forbiddenResourceTypeRule) Name() string {
+	return "test/forbidden-resource-type"
+}
+
+func (r *forbiddenResourceTypeRule) Validate(
+	ctx context.Context,
+	input *validation.RuleInput,
+) ([]*core.Diagnostic, error) {
+	diagnostics := []*core.Diagnostic{}
+	if input.BpSchema.Resources == nil {
+		return diagnostics, nil
+	}
+
+	for name, resource := range input.BpSchema.Resources.Values {
+		if resource.Type != nil && resource.Type.Value == r.resourceType {
+			diagnostics = append(diagnostics, &core.Diagnostic{
+				Level: core.DiagnosticLevelError,
+				Message: fmt.Sprintf(
+					"resource type %q is not allowed, used by resource %q",
+					r.resourceType,
+					name,
+				),
+				Range: core.DiagnosticRangeFromSourceMeta(resource.SourceMeta, nil),
+			})
+		}
+	}
+
+	return diagnostics, nil
+}
+
+func collectLoadErrorLeaves(loadErr *errors.LoadError) []*errors.LoadError {
+	if len(loadErr.ChildErrors) == 0 {
+		return []*errors.LoadError{loadErr}
+	}
+
+	leaves := []*errors.LoadError{}
+	for _, childErr := range loadErr.ChildErrors {
+		if childLoadErr, isLoadErr := childErr.(*errors.LoadError); isLoadErr {
+			leaves = append(leaves, collectLoadErrorLeaves(childLoadErr)...)
+		}
+	}
+	return leaves
+}
+
 func hasDiagnosticWithMessage(
 	diagnostics []*core.Diagnostic,
 	level core.DiagnosticLevel,
@@ -122,6 +122,53 @@ func (s *OrderingForDeploymentTestSuite) Test_order_items_based_on_references()
 	}
 }
 
+func (s *OrderingForDeploymentTestSuite) Test_order_children_after_their_dependencies() {
+	ordersTable := &links.ChainLinkNode{
+		ResourceName: "ordersTable",
+		Resource: &schema.Resource{
+			Type: &schema.ResourceTypeWrapper{Value: "aws/dynamodb/table"},
+		},
+		Paths:               []string{},
+		LinkImplementations: map[string]provider.Link{},
+		LinkedFrom:          []*links.ChainLinkNode{},
+		LinksTo:             []*links.ChainLinkNode{},
+	}
+
+	// The "coreInfra" child blueprint has a `dependsOn` declaration
+	// for the "networking" child blueprint and the "ordersTable" resource.
+	collector := refgraph.NewRefChainCollector()
+	dependencyTag := validation.CreateDependencyRefTag("children.coreInfra")
+	err := collector.Collect(
+		"children.networking",
+		&schema.Include{},
+		"children.coreInfra",
+		[]string{dependencyTag},
+	)
+	s.Require().NoError(err)
+	err = collector.Collect(
+		"resources.ordersTable",
+		ordersTable.Resource,
+		"children.coreInfra",
+		[]string{dependencyTag},
+	)
+	s.Require().NoError(err)
+
+	orderedItems, err := OrderItemsForDeployment(
+		context.TODO(),
+		[]*links.ChainLinkNode{ordersTable},
+		[]*refgraph.ReferenceChainNode{
+			collector.Chain("children.coreInfra"),
+			collector.Chain("children.networking"),
+		},
+		collector,
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Assert().Len(orderedItems, 3)
+	s.assertOrderedExpected(orderedItems, []string{"children.networking", "children.coreInfra"})
+	s.assertOrderedExpected(orderedItems, []string{"resources.ordersTable", "children.coreInfra"})
+}
+
 func (s *OrderingForDeploymentTestSuite) assertOrderedExpected(actual []*DeploymentNode, orderedExpected []string) {
 	expectedItemsInOrder := core.Filter(actual, inExpected(orderedExpected))
 	inOrder := true
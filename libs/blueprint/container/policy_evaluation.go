@@ -0,0 +1,73 @@
+package container
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/policy"
+)
+
+// evaluatePolicies evaluates the policies of the configured policy engine
+// against the changes to be deployed, returning an error when any policies
+// with the "deny" level are violated.
+// Violations of policies with the "warn" level are logged as warnings.
+//
+// Policies are only evaluated for the root blueprint of a deployment
+// as the changes that are evaluated include the changes for child blueprints.
+func (c *defaultBlueprintContainer) evaluatePolicies(
+	ctx context.Context,
+	instanceID string,
+	input *DeployInput,
+	paramOverrides core.BlueprintParams,
+	logger core.Logger,
+) error {
+	if c.policyEngine == nil || input.Changes == nil || isChildDeployment(paramOverrides) {
+		return nil
+	}
+
+	logger.Info("evaluating policies for the changes to be deployed")
+	result, err := c.policyEngine.Evaluate(ctx, &policy.EvaluationInput{
+		InstanceID:   instanceID,
+		InstanceName: input.InstanceName,
+		Blueprint:    c.spec.Schema(),
+		Changes:      input.Changes,
+	})
+	if err != nil {
+		logger.Error(
+			"failed to evaluate policies for the deployment",
+			core.ErrorLogField("error", err),
+		)
+		return errPolicyEvaluationFailed(err)
+	}
+
+	for _, warning := range result.Warnings() {
+		logger.Warn(
+			"changes to be deployed violate policy",
+			core.StringLogField("violation", warning.String()),
+		)
+	}
+
+	denials := result.Denials()
+	if len(denials) > 0 {
+		logger.Error(
+			"changes to be deployed violate policies that prevent the deployment from proceeding",
+			core.IntegerLogField("violations", int64(len(denials))),
+		)
+		return errPolicyViolation(denials)
+	}
+
+	return nil
+}
+
+// isChildDeployment determines whether the provided parameters
+// are for the deployment of a child blueprint, child blueprints
+// are deployed with the path of the parent instance in the tree of
+// blueprint instances.
+func isChildDeployment(params core.BlueprintParams) bool {
+	if params == nil {
+		return false
+	}
+
+	instanceTreePath := params.ContextVariable("instanceTreePath")
+	return instanceTreePath != nil && instanceTreePath.StringValue != nil
+}
@@ -248,6 +248,7 @@ func (p *defaultBlueprintPreparer) applyResourceConditions(
 		},
 		DataSources: blueprint.DataSources,
 		Exports:     blueprint.Exports,
+		Links:       blueprint.Links,
 		Metadata:    blueprint.Metadata,
 	}, nil
 }
@@ -48,6 +48,27 @@ const (
 	// This is used when external state cannot be retrieved for an interrupted resource
 	// (e.g., tag-based lookup is not supported for the resource type).
 	ReconciliationActionManualCleanupRequired ReconciliationAction = "manual_cleanup_required"
+	// ReconciliationActionReapplyBlueprint re-deploys drifted elements using the persisted
+	// state as the source of truth, reverting changes made outside of the deployment
+	// process instead of accepting them.
+	// For resources, only the drifted resource is re-deployed and any link data that
+	// references the resource is synced with the persisted spec.
+	// For links, the link is re-applied to both resources and its intermediary resources.
+	// The persisted drift state is required to reapply a resource.
+	ReconciliationActionReapplyBlueprint ReconciliationAction = "reapply_blueprint"
+)
+
+// FieldReconciliationAction indicates what action to take for an individual
+// field of a resource's spec when accepting external state.
+type FieldReconciliationAction string
+
+const (
+	// FieldReconciliationActionAcceptExternal accepts the external cloud value
+	// for a field and updates the persisted spec data to match.
+	FieldReconciliationActionAcceptExternal FieldReconciliationAction = "accept_external"
+	// FieldReconciliationActionKeepPersisted keeps the persisted value for a field,
+	// the external value will be overwritten by the next deployment.
+	FieldReconciliationActionKeepPersisted FieldReconciliationAction = "keep_persisted"
 )
 
 // CheckReconciliationInput specifies what to check for reconciliation.
@@ -62,6 +83,12 @@ type CheckReconciliationInput struct {
 	// LinkNames specifies which links to check when Scope is ReconciliationScopeSpecific.
 	// Ignored for other scopes.
 	LinkNames []string
+	// IncludeDependents expands the resources to check when Scope is ReconciliationScopeSpecific
+	// to include the resources that depend on the named resources in the reference graph
+	// along with the links connected to them and the resources that those links update
+	// through resource data mappings.
+	// Ignored for other scopes.
+	IncludeDependents bool
 	// IncludeChildren controls whether to recursively check child blueprints.
 	// If nil, defaults to true.
 	IncludeChildren *bool
@@ -73,6 +100,13 @@ type CheckReconciliationInput struct {
 	// When set, enables providers to perform tag-based resource lookups during
 	// drift checking and reconciliation. If nil, tagging-based lookups will not be available.
 	TaggingConfig *provider.TaggingConfig
+	// CheckDataSources enables drift checking for data sources.
+	// When set, data sources in the blueprint are re-fetched with their filters
+	// re-evaluated and the exported data source fields are compared against the values
+	// captured in the instance state when the blueprint was last deployed.
+	// Only data source fields referenced by blueprint exports have values captured in state.
+	// Data sources in child blueprints are not checked.
+	CheckDataSources bool
 }
 
 // ReconciliationCheckResult contains all elements needing reconciliation.
@@ -83,6 +117,10 @@ type ReconciliationCheckResult struct {
 	Resources []ResourceReconcileResult `json:"resources"`
 	// Links contains reconciliation details for each link that needs attention.
 	Links []LinkReconcileResult `json:"links"`
+	// DataSources contains drift details for each data source that returns
+	// different values to those captured in state.
+	// This is only populated when data source checks are enabled.
+	DataSources []DataSourceReconcileResult `json:"dataSources,omitempty"`
 	// HasInterrupted is true if any elements are in an interrupted state.
 	HasInterrupted bool `json:"hasInterrupted"`
 	// HasDrift is true if any elements have drifted from expected state.
@@ -134,6 +172,28 @@ func (r *ResourceReconcileResult) HasStateChanges() bool {
 		len(r.Changes.RemovedFields) > 0
 }
 
+// DataSourceReconcileResult contains drift details for a single data source.
+// Data source drift can not be resolved by updating state, the blueprint instance
+// must be re-deployed so that resources and exports that consume the data source
+// are updated with the latest values.
+type DataSourceReconcileResult struct {
+	// DataSourceName is the logical name of the data source in the blueprint.
+	DataSourceName string `json:"dataSourceName"`
+	// DataSourceType is the provider data source type (e.g., "aws/vpc").
+	DataSourceType string `json:"dataSourceType"`
+	// Type indicates why this data source needs reconciliation,
+	// this will always be ReconciliationTypeDrift.
+	Type ReconciliationType `json:"type"`
+	// Changes contains a change for each exported data source field where the
+	// value captured in state differs from the value returned by the data source.
+	// The field path is the reference to the data source field used in the blueprint
+	// (e.g., "datasources.network.vpcId").
+	Changes []provider.FieldChange `json:"changes"`
+	// AffectedExports holds the names of the blueprint exports that reference
+	// the drifted data source fields.
+	AffectedExports []string `json:"affectedExports"`
+}
+
 // LinkReconcileResult contains reconciliation details for a single link.
 // Link reconciliation is derived from connected resource states and
 // ResourceDataMappings rather than direct external state fetching.
@@ -212,6 +272,22 @@ type ResourceReconcileAction struct {
 	ExternalState *core.MappingNode
 	// NewStatus is the status to set for the resource.
 	NewStatus core.PreciseResourceStatus
+	// FieldActions allows accepting external state for a subset of the resource's
+	// spec fields when Action is ReconciliationActionAcceptExternal.
+	// When set, the persisted spec data is kept and only the values for fields
+	// with FieldReconciliationActionAcceptExternal are taken from ExternalState.
+	// When empty, the entire external state is accepted.
+	FieldActions []FieldReconcileAction
+}
+
+// FieldReconcileAction specifies the action to take for a single field
+// of a resource's spec.
+type FieldReconcileAction struct {
+	// FieldPath is the path to the field in the resource,
+	// in the same format as field paths in drift changes (e.g. "spec.timeout").
+	FieldPath string
+	// Action is the field reconciliation action to apply.
+	Action FieldReconciliationAction
 }
 
 // LinkReconcileAction specifies the action to take for a link.
@@ -271,3 +347,147 @@ type ReconciliationError struct {
 	// Error is the error message.
 	Error string
 }
+
+// ReconciliationPlan describes the state writes that would be made when
+// applying a set of reconciliation actions, without modifying any state.
+type ReconciliationPlan struct {
+	// InstanceID is the ID of the blueprint instance the plan is for.
+	InstanceID string `json:"instanceId"`
+	// Resources contains the planned state writes for each resource action.
+	Resources []ResourceReconcilePlan `json:"resources"`
+	// Links contains the planned state writes for each link action.
+	Links []LinkReconcilePlan `json:"links"`
+	// Errors contains errors for actions that would fail when applied.
+	// Actions with errors are not included in Resources or Links.
+	Errors []ReconciliationError `json:"errors"`
+}
+
+// ResourceReconcilePlan describes the state writes that would be made
+// for a resource when applying a reconciliation action.
+type ResourceReconcilePlan struct {
+	// ResourceID is the unique identifier for the resource.
+	ResourceID string `json:"resourceId"`
+	// ResourceName is the logical name of the resource in the blueprint.
+	ResourceName string `json:"resourceName"`
+	// ChildPath is the path to the child blueprint containing this resource.
+	ChildPath string `json:"childPath,omitempty"`
+	// Action is the reconciliation action that would be applied.
+	Action ReconciliationAction `json:"action"`
+	// PrevStatus is the current precise status of the resource.
+	PrevStatus core.PreciseResourceStatus `json:"prevStatus"`
+	// NewStatus is the precise status that would be set for the resource.
+	NewStatus core.PreciseResourceStatus `json:"newStatus"`
+	// SpecChange holds the current and new spec data for the resource
+	// when the spec data would be replaced, nil when the spec data is left unchanged.
+	// For ReconciliationActionReapplyBlueprint, the new value is the persisted spec
+	// that would be re-deployed, computed fields may be updated by the provider.
+	SpecChange *provider.FieldChange `json:"specChange,omitempty"`
+	// ClearsDrift indicates whether the resource would no longer be marked as drifted
+	// and its persisted drift state would be removed.
+	ClearsDrift bool `json:"clearsDrift"`
+	// FailureReasons holds the failure reasons that would be set for the resource,
+	// nil when the current failure reasons would be cleared.
+	FailureReasons []string `json:"failureReasons,omitempty"`
+	// RequiresDeployment indicates whether applying the action would call the provider
+	// to deploy the resource in addition to writing to state.
+	RequiresDeployment bool `json:"requiresDeployment"`
+	// LinkDataUpdates contains the updates that would be made to the data of links
+	// that map fields of the resource to link data through ResourceDataMappings.
+	LinkDataUpdates []LinkDataUpdatePlan `json:"linkDataUpdates,omitempty"`
+}
+
+// LinkDataUpdatePlan describes the updates that would be made to the data
+// of a link that references a reconciled resource.
+type LinkDataUpdatePlan struct {
+	// LinkID is the unique identifier for the link.
+	LinkID string `json:"linkId"`
+	// LinkName is the logical name of the link.
+	LinkName string `json:"linkName"`
+	// Changes contains the link data path and current and new values
+	// for each link data value that would be written.
+	Changes []provider.FieldChange `json:"changes"`
+}
+
+// LinkReconcilePlan describes the state writes that would be made
+// for a link when applying a reconciliation action.
+type LinkReconcilePlan struct {
+	// LinkID is the unique identifier for the link.
+	LinkID string `json:"linkId"`
+	// LinkName is the logical name of the link.
+	LinkName string `json:"linkName"`
+	// ChildPath is the path to the child blueprint containing this link.
+	ChildPath string `json:"childPath,omitempty"`
+	// Action is the reconciliation action that would be applied.
+	Action ReconciliationAction `json:"action"`
+	// PrevStatus is the current precise status of the link.
+	PrevStatus core.PreciseLinkStatus `json:"prevStatus"`
+	// NewStatus is the precise status that would be set for the link.
+	NewStatus core.PreciseLinkStatus `json:"newStatus"`
+	// LinkDataChanges contains the link data path and current and new values
+	// for each link data value that would be written.
+	LinkDataChanges []provider.FieldChange `json:"linkDataChanges,omitempty"`
+	// Intermediaries contains the planned state writes for intermediary resources.
+	Intermediaries []IntermediaryReconcilePlan `json:"intermediaries,omitempty"`
+	// ClearsDrift indicates whether the link would no longer be marked as drifted
+	// and its persisted drift state would be removed.
+	ClearsDrift bool `json:"clearsDrift"`
+	// FailureReasons holds the failure reasons that would be set for the link.
+	FailureReasons []string `json:"failureReasons,omitempty"`
+	// RequiresDeployment indicates whether applying the action would call the provider
+	// to re-apply the link in addition to writing to state.
+	RequiresDeployment bool `json:"requiresDeployment"`
+}
+
+// IntermediaryReconcilePlan describes the state writes that would be made
+// for an intermediary resource of a link.
+type IntermediaryReconcilePlan struct {
+	// IntermediaryID is the unique identifier for the intermediary resource.
+	IntermediaryID string `json:"intermediaryId"`
+	// PrevStatus is the current precise status of the intermediary resource.
+	PrevStatus core.PreciseResourceStatus `json:"prevStatus"`
+	// NewStatus is the precise status that would be set for the intermediary resource.
+	NewStatus core.PreciseResourceStatus `json:"newStatus"`
+	// SpecChange holds the current and new spec data for the intermediary resource
+	// when the spec data would be replaced.
+	SpecChange *provider.FieldChange `json:"specChange,omitempty"`
+	// FailureReasons holds the failure reasons that would be set for the intermediary resource.
+	FailureReasons []string `json:"failureReasons,omitempty"`
+}
+
+// RefreshStateInput contains the input for refreshing the persisted state
+// of a blueprint instance from the external state of its resources and links.
+type RefreshStateInput struct {
+	// InstanceID is the ID of the blueprint instance to refresh the state of.
+	InstanceID string `json:"instanceId"`
+	// IncludeChildren controls whether the state of resources and links in
+	// child blueprints is also refreshed.
+	// When nil, defaults to true.
+	IncludeChildren *bool `json:"includeChildren,omitempty"`
+	// TaggingConfig is the tagging configuration used to determine which tags
+	// are managed by Bluelink when comparing external state to persisted state.
+	TaggingConfig *provider.TaggingConfig `json:"taggingConfig,omitempty"`
+}
+
+// RefreshStateResult contains the result of refreshing the persisted state
+// of a blueprint instance.
+type RefreshStateResult struct {
+	// InstanceID is the ID of the blueprint instance that was refreshed.
+	InstanceID string `json:"instanceId"`
+	// Resources contains the resources that had their persisted state
+	// updated to match the external state.
+	Resources []ResourceReconcileResult `json:"resources"`
+	// Links contains the links that had their persisted data
+	// updated to match the external state.
+	Links []LinkReconcileResult `json:"links"`
+	// ResourcesUpdated is the number of resources that were successfully updated.
+	ResourcesUpdated int `json:"resourcesUpdated"`
+	// LinksUpdated is the number of links that were successfully updated.
+	LinksUpdated int `json:"linksUpdated"`
+	// HasInterrupted indicates whether any elements were found in an interrupted state.
+	// Interrupted elements are not refreshed, they must be reconciled
+	// with CheckReconciliation and ApplyReconciliation.
+	HasInterrupted bool `json:"hasInterrupted"`
+	// Errors contains any errors that occurred when updating the persisted state
+	// of individual elements.
+	Errors []ReconciliationError `json:"errors"`
+}
@@ -0,0 +1,462 @@
+package container
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+)
+
+const (
+	// KnownOnDeployPlaceholder is the value used in a rendered blueprint
+	// in place of values that can only be resolved during deployment,
+	// such as computed fields of resources that have not been deployed yet.
+	KnownOnDeployPlaceholder = "(known on deploy)"
+	// RedactedSecretPlaceholder is the value used in a rendered blueprint
+	// in place of the values of variables and values that are marked as secret.
+	RedactedSecretPlaceholder = "(secret)"
+)
+
+// RenderedBlueprint holds a fully resolved version of a blueprint
+// where resource templates have been expanded, resource spec defaults
+// have been populated, conditions have been applied and substitutions
+// have been resolved where possible.
+//
+// Values that can only be known after deployment are replaced with
+// KnownOnDeployPlaceholder and the full paths to them
+// (e.g. "resources.ordersTable.spec.arn") are collected in ResolveOnDeploy.
+// Secret variables and values are replaced with RedactedSecretPlaceholder.
+type RenderedBlueprint struct {
+	Version   string                                `json:"version"`
+	Variables map[string]*core.ScalarValue          `json:"variables,omitempty"`
+	Values    map[string]*subengine.ResolvedValue   `json:"values,omitempty"`
+	Include   map[string]*subengine.ResolvedInclude `json:"include,omitempty"`
+	Resources map[string]*provider.ResolvedResource `json:"resources"`
+	Exports   map[string]*RenderedExport            `json:"exports,omitempty"`
+	// ResolveOnDeploy holds the full paths to all the values in the
+	// blueprint that can only be resolved during deployment.
+	ResolveOnDeploy []string `json:"resolveOnDeploy"`
+	// Trace holds the reference lookups and function calls carried out
+	// to resolve each field in the blueprint keyed by the full path to the field.
+	// This is only populated when a substitution trace is attached to the context
+	// passed into Render with subengine.WithSubstitutionTrace.
+	// The inputs and outputs of steps that depend on secret variables or values
+	// are replaced with RedactedSecretPlaceholder.
+	Trace map[string][]*subengine.SubstitutionTraceEntry `json:"trace,omitempty"`
+}
+
+// RenderedExport holds a resolved export of a rendered blueprint.
+type RenderedExport struct {
+	Type *schema.ExportTypeWrapper `json:"type"`
+	// Field is the reference to the exported field as defined in the blueprint
+	// (e.g. "resources.ordersTable.spec.tableName").
+	Field *core.ScalarValue `json:"field"`
+	// Value is the resolved value of the exported field.
+	Value       *core.MappingNode `json:"value,omitempty"`
+	Description *core.MappingNode `json:"description,omitempty"`
+}
+
+// BlueprintRenderer provides an interface for a service that produces
+// fully resolved versions of blueprints that have been prepared
+// for change staging.
+type BlueprintRenderer interface {
+	// Render resolves the provided prepared blueprint where the resources
+	// are resolved in the order of the provided groups of deployment nodes
+	// so references between resources can be resolved.
+	Render(
+		ctx context.Context,
+		blueprint *schema.Blueprint,
+		parallelGroups [][]*DeploymentNode,
+		params core.BlueprintParams,
+	) (*RenderedBlueprint, error)
+}
+
+type defaultBlueprintRenderer struct {
+	substitutionResolver subengine.SubstitutionResolver
+	resourceCache        *core.Cache[*provider.ResolvedResource]
+}
+
+// NewDefaultBlueprintRenderer creates a new instance of the default
+// implementation of the service that renders fully resolved blueprints.
+func NewDefaultBlueprintRenderer(
+	substitutionResolver subengine.SubstitutionResolver,
+	resourceCache *core.Cache[*provider.ResolvedResource],
+) BlueprintRenderer {
+	return &defaultBlueprintRenderer{
+		substitutionResolver: substitutionResolver,
+		resourceCache:        resourceCache,
+	}
+}
+
+func (c *defaultBlueprintContainer) Render(
+	ctx context.Context,
+	paramOverrides core.BlueprintParams,
+) (*RenderedBlueprint, error) {
+	prepareResult, err := c.blueprintPreparer.Prepare(
+		ctx,
+		c.spec.Schema(),
+		subengine.ResolveForChangeStaging,
+		/* changes */ nil,
+		c.linkInfo,
+		paramOverrides,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	preparedBlueprint := prepareResult.BlueprintContainer.BlueprintSpec().Schema()
+	rendered, err := c.blueprintRenderer.Render(
+		ctx,
+		preparedBlueprint,
+		prepareResult.ParallelGroups,
+		paramOverrides,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	trace := subengine.SubstitutionTraceFromContext(ctx)
+	if trace != nil {
+		rendered.Trace = redactTrace(trace.Fields(), preparedBlueprint)
+	}
+
+	return rendered, nil
+}
+
+func (r *defaultBlueprintRenderer) Render(
+	ctx context.Context,
+	blueprint *schema.Blueprint,
+	parallelGroups [][]*DeploymentNode,
+	params core.BlueprintParams,
+) (*RenderedBlueprint, error) {
+	rendered := &RenderedBlueprint{
+		Version:         core.StringValueFromScalar(blueprint.Version),
+		Variables:       renderVariables(blueprint, params),
+		Resources:       map[string]*provider.ResolvedResource{},
+		ResolveOnDeploy: []string{},
+	}
+
+	err := r.renderResources(ctx, parallelGroups, rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.renderValues(ctx, blueprint, rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.renderIncludes(ctx, blueprint, rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.renderExports(ctx, blueprint, rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(rendered.ResolveOnDeploy)
+	rendered.ResolveOnDeploy = slices.Compact(rendered.ResolveOnDeploy)
+
+	return rendered, nil
+}
+
+func (r *defaultBlueprintRenderer) renderResources(
+	ctx context.Context,
+	parallelGroups [][]*DeploymentNode,
+	rendered *RenderedBlueprint,
+) error {
+	// Resources must be resolved in deployment order so that references
+	// to other resources can be resolved from the resource cache.
+	for _, node := range core.Flatten(parallelGroups) {
+		if node.Type() != DeploymentNodeTypeResource {
+			continue
+		}
+
+		resourceName := node.ChainLinkNode.ResourceName
+		resolveResourceResult, err := r.substitutionResolver.ResolveInResource(
+			ctx,
+			resourceName,
+			node.ChainLinkNode.Resource,
+			&subengine.ResolveResourceTargetInfo{
+				ResolveFor: subengine.ResolveForChangeStaging,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		r.resourceCache.Set(resourceName, resolveResourceResult.ResolvedResource)
+
+		// A copy of the spec is marked with placeholders to avoid
+		// modifying the resolved resource that is shared through the cache.
+		renderedResource := *resolveResourceResult.ResolvedResource
+		renderedResource.Spec = markKnownOnDeploy(
+			core.CopyMappingNode(renderedResource.Spec),
+			core.ElementPropertyPath(core.ResourceElementID(resourceName), "spec"),
+			resolveResourceResult.ResolveOnDeploy,
+		)
+		rendered.Resources[resourceName] = &renderedResource
+		rendered.ResolveOnDeploy = append(
+			rendered.ResolveOnDeploy,
+			resolveResourceResult.ResolveOnDeploy...,
+		)
+	}
+
+	return nil
+}
+
+func (r *defaultBlueprintRenderer) renderValues(
+	ctx context.Context,
+	blueprint *schema.Blueprint,
+	rendered *RenderedBlueprint,
+) error {
+	if blueprint.Values == nil || len(blueprint.Values.Values) == 0 {
+		return nil
+	}
+
+	rendered.Values = map[string]*subengine.ResolvedValue{}
+	for valueName, value := range blueprint.Values.Values {
+		resolveValueResult, err := r.substitutionResolver.ResolveInValue(
+			ctx,
+			valueName,
+			value,
+			&subengine.ResolveValueTargetInfo{
+				ResolveFor: subengine.ResolveForChangeStaging,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		renderedValue := *resolveValueResult.ResolvedValue
+		if core.BoolValueFromScalar(renderedValue.Secret) {
+			renderedValue.Value = core.MappingNodeFromString(RedactedSecretPlaceholder)
+		} else {
+			renderedValue.Value = markKnownOnDeploy(
+				core.CopyMappingNode(renderedValue.Value),
+				core.ElementPropertyPath(core.ValueElementID(valueName), "value"),
+				resolveValueResult.ResolveOnDeploy,
+			)
+		}
+		rendered.Values[valueName] = &renderedValue
+		rendered.ResolveOnDeploy = append(
+			rendered.ResolveOnDeploy,
+			resolveValueResult.ResolveOnDeploy...,
+		)
+	}
+
+	return nil
+}
+
+func (r *defaultBlueprintRenderer) renderIncludes(
+	ctx context.Context,
+	blueprint *schema.Blueprint,
+	rendered *RenderedBlueprint,
+) error {
+	if blueprint.Include == nil || len(blueprint.Include.Values) == 0 {
+		return nil
+	}
+
+	rendered.Include = map[string]*subengine.ResolvedInclude{}
+	for includeName, include := range blueprint.Include.Values {
+		resolveIncludeResult, err := r.substitutionResolver.ResolveInInclude(
+			ctx,
+			includeName,
+			include,
+			&subengine.ResolveIncludeTargetInfo{
+				ResolveFor: subengine.ResolveForChangeStaging,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		renderedInclude := *resolveIncludeResult.ResolvedInclude
+		renderedInclude.Variables = markKnownOnDeploy(
+			core.CopyMappingNode(renderedInclude.Variables),
+			core.ElementPropertyPath(core.ChildElementID(includeName), "variables"),
+			resolveIncludeResult.ResolveOnDeploy,
+		)
+		rendered.Include[includeName] = &renderedInclude
+		rendered.ResolveOnDeploy = append(
+			rendered.ResolveOnDeploy,
+			resolveIncludeResult.ResolveOnDeploy...,
+		)
+	}
+
+	return nil
+}
+
+func (r *defaultBlueprintRenderer) renderExports(
+	ctx context.Context,
+	blueprint *schema.Blueprint,
+	rendered *RenderedBlueprint,
+) error {
+	if blueprint.Exports == nil || len(blueprint.Exports.Values) == 0 {
+		return nil
+	}
+
+	rendered.Exports = map[string]*RenderedExport{}
+	for exportName, export := range blueprint.Exports.Values {
+		resolveExportResult, err := r.substitutionResolver.ResolveInExport(
+			ctx,
+			exportName,
+			export,
+			&subengine.ResolveExportTargetInfo{
+				ResolveFor: subengine.ResolveForChangeStaging,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		renderedExport := &RenderedExport{
+			Type:        resolveExportResult.ResolvedExport.Type,
+			Field:       resolveExportResult.ResolvedExport.Field,
+			Description: resolveExportResult.ResolvedExport.Description,
+		}
+
+		resolveFieldResult, err := resolveExportField(
+			ctx,
+			r.substitutionResolver,
+			exportName,
+			export,
+			subengine.ResolveForChangeStaging,
+		)
+		if err != nil {
+			return err
+		}
+
+		if resolveFieldResult != nil {
+			renderedExport.Value = resolveFieldResult.Resolved
+			if len(resolveFieldResult.ResolveOnDeploy) > 0 {
+				renderedExport.Value = core.MappingNodeFromString(KnownOnDeployPlaceholder)
+				rendered.ResolveOnDeploy = append(
+					rendered.ResolveOnDeploy,
+					resolveFieldResult.ResolveOnDeploy...,
+				)
+			}
+		}
+
+		rendered.Exports[exportName] = renderedExport
+	}
+
+	return nil
+}
+
+func renderVariables(
+	blueprint *schema.Blueprint,
+	params core.BlueprintParams,
+) map[string]*core.ScalarValue {
+	if blueprint.Variables == nil || len(blueprint.Variables.Values) == 0 {
+		return nil
+	}
+
+	variables := map[string]*core.ScalarValue{}
+	for variableName, variable := range blueprint.Variables.Values {
+		if core.BoolValueFromScalar(variable.Secret) {
+			variables[variableName] = core.ScalarFromString(RedactedSecretPlaceholder)
+			continue
+		}
+
+		value := params.BlueprintVariable(variableName)
+		if value == nil {
+			value = variable.Default
+		}
+		variables[variableName] = value
+	}
+
+	return variables
+}
+
+// redactTrace replaces the inputs and outputs of steps in a substitution trace
+// that depend on secret variables or values along with all the steps
+// for fields of values that are marked as secret.
+func redactTrace(
+	fields map[string][]*subengine.SubstitutionTraceEntry,
+	blueprint *schema.Blueprint,
+) map[string][]*subengine.SubstitutionTraceEntry {
+	redacted := make(map[string][]*subengine.SubstitutionTraceEntry, len(fields))
+	for fieldPath, entries := range fields {
+		isSecretField := isSecretValueField(fieldPath, blueprint)
+		redactedEntries := make([]*subengine.SubstitutionTraceEntry, len(entries))
+		for i, entry := range entries {
+			if !entry.Secret && !isSecretField {
+				redactedEntries[i] = entry
+				continue
+			}
+
+			redactedEntry := *entry
+			redactedEntry.Secret = true
+			redactedEntry.Output = core.MappingNodeFromString(RedactedSecretPlaceholder)
+			if len(entry.Inputs) > 0 {
+				redactedEntry.Inputs = make([]*core.MappingNode, len(entry.Inputs))
+				for j := range entry.Inputs {
+					redactedEntry.Inputs[j] = core.MappingNodeFromString(RedactedSecretPlaceholder)
+				}
+			}
+			redactedEntries[i] = &redactedEntry
+		}
+		redacted[fieldPath] = redactedEntries
+	}
+
+	return redacted
+}
+
+func isSecretValueField(fieldPath string, blueprint *schema.Blueprint) bool {
+	if blueprint.Values == nil {
+		return false
+	}
+
+	for valueName, value := range blueprint.Values.Values {
+		valuePath := core.ValueElementID(valueName)
+		if (fieldPath == valuePath || strings.HasPrefix(fieldPath, valuePath+".")) &&
+			core.BoolValueFromScalar(value.Secret) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// markKnownOnDeploy replaces the values in the provided mapping node
+// that can only be resolved during deployment with KnownOnDeployPlaceholder.
+// The element property path is the path that the provided mapping node
+// is located at in the blueprint (e.g. "resources.ordersTable.spec"),
+// paths that must be resolved on deploy that are not under the element property
+// path are ignored.
+func markKnownOnDeploy(
+	node *core.MappingNode,
+	elementPropertyPath string,
+	resolveOnDeploy []string,
+) *core.MappingNode {
+	for _, path := range resolveOnDeploy {
+		pathInNode, isInNode := strings.CutPrefix(path, elementPropertyPath)
+		if !isInNode ||
+			(pathInNode != "" && !strings.HasPrefix(pathInNode, ".") &&
+				!strings.HasPrefix(pathInNode, "[")) {
+			continue
+		}
+
+		if pathInNode == "" || node == nil {
+			return core.MappingNodeFromString(KnownOnDeployPlaceholder)
+		}
+
+		// Paths that can not be injected into the mapping node are
+		// still collected in the list of paths to resolve on deploy
+		// for the rendered blueprint.
+		_ = core.InjectPathValueReplaceFields(
+			"$"+pathInNode,
+			core.MappingNodeFromString(KnownOnDeployPlaceholder),
+			node,
+			core.MappingNodeMaxTraverseDepth,
+		)
+	}
+
+	return node
+}
@@ -0,0 +1,209 @@
+package container
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/memstate"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/providerhelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/transform"
+	"github.com/stretchr/testify/suite"
+)
+
+type RenderTestSuite struct {
+	blueprintContainer BlueprintContainer
+	suite.Suite
+}
+
+func (s *RenderTestSuite) SetupTest() {
+	stateContainer := memstate.NewMemoryStateContainer()
+	providers := map[string]provider.Provider{
+		"aws": newTestAWSProvider(
+			/* alwaysStabilise */ false,
+			/* skipRetryFailuresForLinkNames */ []string{},
+			stateContainer,
+		),
+		"core": providerhelpers.NewCoreProvider(
+			stateContainer.Links(),
+			core.BlueprintInstanceIDFromContext,
+			os.Getwd,
+			provider.NewFileSourceRegistry(),
+			core.SystemClock{},
+		),
+	}
+	loader := NewDefaultLoader(
+		providers,
+		map[string]transform.SpecTransformer{},
+		stateContainer,
+		newFSChildResolver(),
+		WithLoaderTransformSpec(false),
+		WithLoaderRefChainCollectorFactory(refgraph.NewRefChainCollector),
+	)
+
+	blueprintContainer, err := loader.Load(
+		context.Background(),
+		"__testdata/container/render/blueprint1.yml",
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+	s.blueprintContainer = blueprintContainer
+}
+
+func (s *RenderTestSuite) Test_renders_resolved_resources_with_defaults_and_conditions_applied() {
+	rendered, err := s.blueprintContainer.Render(
+		context.Background(),
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+
+	s.Equal("2025-11-02", rendered.Version)
+	s.Len(rendered.Resources, 2)
+	s.NotContains(rendered.Resources, "invoicesTable")
+
+	ordersTable := rendered.Resources["ordersTable"]
+	s.Require().NotNil(ordersTable)
+	s.Equal("production-orders", core.StringValue(ordersTable.Spec.Fields["tableName"]))
+	s.Equal("us-east-1", core.StringValue(ordersTable.Spec.Fields["region"]))
+	s.False(core.BoolValue(ordersTable.Spec.Fields["global"]))
+	s.Equal(
+		"production Orders Table",
+		core.StringValue(ordersTable.Metadata.DisplayName),
+	)
+
+	archiveTable := rendered.Resources["archiveTable"]
+	s.Require().NotNil(archiveTable)
+	s.Equal(
+		"production-orders-archive",
+		core.StringValue(archiveTable.Spec.Fields["tableName"]),
+	)
+}
+
+func (s *RenderTestSuite) Test_marks_values_that_are_known_on_deploy() {
+	rendered, err := s.blueprintContainer.Render(
+		context.Background(),
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+
+	s.Equal(
+		KnownOnDeployPlaceholder,
+		core.StringValue(rendered.Resources["archiveTable"].Spec.Fields["region"]),
+	)
+	s.Equal(
+		KnownOnDeployPlaceholder,
+		core.StringValue(rendered.Exports["ordersTableId"].Value),
+	)
+	s.Equal(
+		"production-orders",
+		core.StringValue(rendered.Exports["ordersTableName"].Value),
+	)
+	s.Equal(
+		[]string{
+			"exports.ordersTableId.field",
+			"resources.archiveTable.spec.region",
+		},
+		rendered.ResolveOnDeploy,
+	)
+}
+
+func (s *RenderTestSuite) Test_redacts_secret_variables_and_values() {
+	rendered, err := s.blueprintContainer.Render(
+		context.Background(),
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+
+	s.Equal(
+		RedactedSecretPlaceholder,
+		core.StringValueFromScalar(rendered.Variables["apiKey"]),
+	)
+	s.Equal(
+		"production",
+		core.StringValueFromScalar(rendered.Variables["environment"]),
+	)
+	s.Equal(
+		"us-east-1",
+		core.StringValueFromScalar(rendered.Variables["region"]),
+	)
+	s.Equal(
+		RedactedSecretPlaceholder,
+		core.StringValue(rendered.Values["apiKeyValue"].Value),
+	)
+	s.Equal(
+		"production-orders",
+		core.StringValue(rendered.Values["tablePrefix"].Value),
+	)
+}
+
+func (s *RenderTestSuite) Test_records_substitution_trace_when_enabled() {
+	rendered, err := s.blueprintContainer.Render(
+		subengine.WithSubstitutionTrace(context.Background(), subengine.NewSubstitutionTrace()),
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+	s.Require().NotNil(rendered.Trace)
+
+	tableNameTrace := rendered.Trace["resources.ordersTable.spec.tableName"]
+	s.Require().NotEmpty(tableNameTrace)
+	s.Equal(subengine.SubstitutionTraceEntryTypeReference, tableNameTrace[0].Type)
+	s.Equal("values.tablePrefix", tableNameTrace[0].Expression)
+	s.Equal(0, tableNameTrace[0].Depth)
+	s.Equal("production-orders", core.StringValue(tableNameTrace[0].Output))
+
+	conditionTrace := rendered.Trace["resources.invoicesTable.condition"]
+	s.Require().Len(conditionTrace, 2)
+	s.Equal(subengine.SubstitutionTraceEntryTypeFunctionCall, conditionTrace[0].Type)
+	s.Equal("eq(variables.environment,\"staging\")", conditionTrace[0].Expression)
+	s.Equal(0, conditionTrace[0].Depth)
+	s.Require().Len(conditionTrace[0].Inputs, 2)
+	s.Equal("production", core.StringValue(conditionTrace[0].Inputs[0]))
+	s.Equal("staging", core.StringValue(conditionTrace[0].Inputs[1]))
+	s.False(core.BoolValue(conditionTrace[0].Output))
+	s.Equal("variables.environment", conditionTrace[1].Expression)
+	s.Equal(1, conditionTrace[1].Depth)
+}
+
+func (s *RenderTestSuite) Test_redacts_secrets_in_substitution_trace() {
+	rendered, err := s.blueprintContainer.Render(
+		subengine.WithSubstitutionTrace(context.Background(), subengine.NewSubstitutionTrace()),
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+
+	apiKeyTrace := rendered.Trace["values.apiKeyValue.value"]
+	s.Require().NotEmpty(apiKeyTrace)
+	for _, entry := range apiKeyTrace {
+		s.True(entry.Secret)
+		s.Equal(RedactedSecretPlaceholder, core.StringValue(entry.Output))
+	}
+}
+
+func (s *RenderTestSuite) Test_does_not_record_substitution_trace_by_default() {
+	rendered, err := s.blueprintContainer.Render(
+		context.Background(),
+		createRenderBlueprintParams(),
+	)
+	s.Require().NoError(err)
+	s.Nil(rendered.Trace)
+}
+
+func createRenderBlueprintParams() core.BlueprintParams {
+	return core.NewDefaultParams(
+		map[string]map[string]*core.ScalarValue{},
+		map[string]map[string]*core.ScalarValue{},
+		map[string]*core.ScalarValue{},
+		map[string]*core.ScalarValue{
+			"environment": core.ScalarFromString("production"),
+			"apiKey":      core.ScalarFromString("test-api-key"),
+		},
+	)
+}
+
+func TestRenderTestSuite(t *testing.T) {
+	suite.Run(t, new(RenderTestSuite))
+}
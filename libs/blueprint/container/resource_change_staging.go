@@ -7,6 +7,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/subengine"
 )
@@ -34,6 +35,9 @@ func NewDefaultResourceChangeStager(
 	stateContainer state.Container,
 	changeGenerator changes.ResourceChangeGenerator,
 	linkChangeStager LinkChangeStager,
+	// The function registry is used to look up the volatility of functions
+	// used in resource specs to detect perpetual changes.
+	funcRegistry provider.FunctionRegistry,
 ) ResourceChangeStager {
 	return &defaultResourceChangeStager{
 		substitutionResolver: substitutionResolver,
@@ -41,6 +45,7 @@ func NewDefaultResourceChangeStager(
 		stateContainer:       stateContainer,
 		changeGenerator:      changeGenerator,
 		linkChangeStager:     linkChangeStager,
+		funcRegistry:         funcRegistry,
 	}
 }
 
@@ -50,6 +55,7 @@ type defaultResourceChangeStager struct {
 	stateContainer       state.Container
 	changeGenerator      changes.ResourceChangeGenerator
 	linkChangeStager     LinkChangeStager
+	funcRegistry         provider.FunctionRegistry
 }
 
 func (s *defaultResourceChangeStager) StageChanges(
@@ -151,6 +157,46 @@ func (s *defaultResourceChangeStager) stageChanges(
 		return err
 	}
 
+	err = s.checkVolatileFields(
+		ctx,
+		stageResourceInfo.node,
+		resourceInfo,
+		changes,
+		params,
+		resourceIDLogger,
+	)
+	if err != nil {
+		resourceIDLogger.Debug(
+			"failed to check for changes caused by volatile functions",
+			core.ErrorLogField("error", err),
+		)
+		return err
+	}
+
+	err = s.checkIgnoredFields(
+		stageResourceInfo.node,
+		resourceInfo,
+		changes,
+		resourceIDLogger,
+	)
+	if err != nil {
+		resourceIDLogger.Debug(
+			"failed to remove ignored changes from the change set for resource",
+			core.ErrorLogField("error", err),
+		)
+		return err
+	}
+
+	// Tainted resources must be replaced even if there are no changes
+	// to the resource in the blueprint.
+	if isResourceTainted(resourceInfo.CurrentResourceState) {
+		changes.MustRecreate = true
+		changes.RecreateTriggers = append(
+			changes.RecreateTriggers,
+			provider.RecreateTriggerTainted,
+		)
+	}
+
 	// The resource must be recreated if an element that it previously depended on
 	// has been removed.
 	if !changes.MustRecreate {
@@ -159,6 +205,23 @@ func (s *defaultResourceChangeStager) stageChanges(
 		)
 	}
 
+	if changes.MustRecreate &&
+		(isResourceProtected(resourceInfo.CurrentResourceState) ||
+			isResolvedResourceProtected(resourceInfo.ResourceWithResolvedSubs)) {
+		return errProtectedResourceReplacement(
+			resourceInfo.ResourceName,
+			changes.RecreateTriggers,
+		)
+	}
+
+	changes.ReplaceStrategy = schema.GetResourceReplaceStrategy(stageResourceInfo.node.Resource)
+	if changes.MustRecreate && len(changes.RecreateTriggers) > 0 {
+		resourceIDLogger.Info(
+			"resource must be recreated due to changes to fields that can not be updated in place",
+			core.StringsLogField("recreateTriggers", changes.RecreateTriggers),
+		)
+	}
+
 	changesMsg := ResourceChangesMessage{
 		ResourceName:    stageResourceInfo.node.ResourceName,
 		Changes:         *changes,
@@ -202,6 +265,71 @@ func (s *defaultResourceChangeStager) stageChanges(
 	return nil
 }
 
+// checkVolatileFields detects changes to an existing resource that are caused
+// by volatile functions such as "uuid" or "datetime", these would otherwise
+// cause changes to be reported every time changes are staged for the resource.
+func (s *defaultResourceChangeStager) checkVolatileFields(
+	ctx context.Context,
+	node *links.ChainLinkNode,
+	resourceInfo *provider.ResourceInfo,
+	changes *provider.Changes,
+	params core.BlueprintParams,
+	logger core.Logger,
+) error {
+	if s.funcRegistry == nil ||
+		node.Resource == nil ||
+		isResourceNewForStaging(resourceInfo.CurrentResourceState) {
+		return nil
+	}
+
+	checker := newVolatileFunctionChecker(s.funcRegistry, params)
+	volatilePaths, err := checker.collectVolatileFieldPaths(ctx, node.Resource.Spec)
+	if err != nil {
+		return err
+	}
+
+	applyVolatileFieldChecks(changes, volatilePaths)
+	if len(changes.VolatileFields) > 0 {
+		logger.Warn(
+			"changes to resource fields are caused by volatile functions "+
+				"and will be reported every time changes are staged, "+
+				"set the \""+KeepersAnnotation+"\" annotation to keep current values",
+			core.StringsLogField("volatileFields", changes.VolatileFields),
+		)
+	}
+
+	return nil
+}
+
+// checkIgnoredFields removes changes to fields of an existing resource that
+// are listed in the "ignoreChanges" setting for the resource, the current values
+// of these fields are kept in the resolved resource.
+func (s *defaultResourceChangeStager) checkIgnoredFields(
+	node *links.ChainLinkNode,
+	resourceInfo *provider.ResourceInfo,
+	changes *provider.Changes,
+	logger core.Logger,
+) error {
+	if node.Resource == nil ||
+		isResourceNewForStaging(resourceInfo.CurrentResourceState) {
+		return nil
+	}
+
+	err := applyIgnoreChanges(changes, schema.GetResourceIgnoreChanges(node.Resource))
+	if err != nil {
+		return err
+	}
+
+	if len(changes.IgnoredFields) > 0 {
+		logger.Info(
+			"changes to resource fields are ignored based on the ignoreChanges setting",
+			core.StringsLogField("ignoredFields", changes.IgnoredFields),
+		)
+	}
+
+	return nil
+}
+
 // isResourceNewForStaging determines if a resource should be treated as "new"
 // (requiring creation) during change staging. A resource is considered new if:
 // - No persisted state exists, OR
@@ -0,0 +1,143 @@
+package container
+
+import (
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// ProtectAnnotation is the resource annotation that can be used to protect
+// a resource from being destroyed.
+// When this annotation is set to "true" for a resource, change staging and
+// deployments will fail for any set of changes that would destroy the resource,
+// this includes removing the resource from the blueprint, destroying the blueprint
+// instance and replacing the resource due to changes that can not be applied in place.
+//
+// To destroy a protected resource, the annotation must first be removed
+// (or set to "false") and the blueprint deployed before the resource can be destroyed.
+//
+// For example:
+//
+//	metadata:
+//	  annotations:
+//	    bluelink.protect: true
+const ProtectAnnotation = "bluelink.protect"
+
+// RetainOnDestroyAnnotation is the resource annotation that can be used to keep
+// a resource in the upstream provider when it is removed from a blueprint instance.
+// When this annotation is set to "true" for a resource, the resource will be removed
+// from the state of the blueprint instance without calling the provider to destroy it,
+// this is equivalent to setting the removal policy of the resource to "retain".
+//
+// For example:
+//
+//	metadata:
+//	  annotations:
+//	    bluelink.retainOnDestroy: true
+const RetainOnDestroyAnnotation = "bluelink.retainOnDestroy"
+
+// isResourceProtected determines whether the deployed version of a resource
+// has been marked as protected from being destroyed.
+func isResourceProtected(resourceState *state.ResourceState) bool {
+	if resourceState == nil || resourceState.Metadata == nil {
+		return false
+	}
+
+	return isAnnotationEnabled(resourceState.Metadata.Annotations[ProtectAnnotation])
+}
+
+// isResolvedResourceProtected determines whether the version of a resource
+// that is being staged for deployment has been marked as protected
+// from being destroyed.
+func isResolvedResourceProtected(resolvedResource *provider.ResolvedResource) bool {
+	if resolvedResource == nil ||
+		resolvedResource.Metadata == nil ||
+		resolvedResource.Metadata.Annotations == nil {
+		return false
+	}
+
+	return isAnnotationEnabled(
+		resolvedResource.Metadata.Annotations.Fields[ProtectAnnotation],
+	)
+}
+
+// isResourceRetainedOnDestroy determines whether the deployed version of a resource
+// has been marked to be kept in the upstream provider when it is removed.
+func isResourceRetainedOnDestroy(resourceState *state.ResourceState) bool {
+	if resourceState == nil || resourceState.Metadata == nil {
+		return false
+	}
+
+	return isAnnotationEnabled(resourceState.Metadata.Annotations[RetainOnDestroyAnnotation])
+}
+
+// The removal policy to apply when a resource is being removed from management,
+// taking the retain on destroy annotation into account
+// along with the removal policy stored for the resource.
+func resourceRemovalPolicy(resourceState *state.ResourceState) string {
+	if isResourceRetainedOnDestroy(resourceState) {
+		return string(schema.RemovalPolicyRetain)
+	}
+
+	return effectiveRemovalPolicy(resourceState.RemovalPolicy)
+}
+
+// Annotation values can be provided as booleans or as strings,
+// as annotations are often populated with substitutions that resolve
+// to strings.
+func isAnnotationEnabled(value *core.MappingNode) bool {
+	if value == nil || value.Scalar == nil {
+		return false
+	}
+
+	if value.Scalar.BoolValue != nil {
+		return *value.Scalar.BoolValue
+	}
+
+	return strings.EqualFold(core.StringValue(value), "true")
+}
+
+// collectProtectedRemovals collects the names of all protected resources
+// that would be destroyed by the provided changes, including resources in
+// child blueprints that are being removed.
+// Names of resources in child blueprints are prefixed with the path
+// to the child blueprint, for example, "children.coreInfra::ordersTable".
+func collectProtectedRemovals(
+	instanceState *state.InstanceState,
+	blueprintChanges *changes.BlueprintChanges,
+	pathPrefix string,
+) []string {
+	if instanceState == nil || blueprintChanges == nil {
+		return []string{}
+	}
+
+	protected := []string{}
+	for _, resourceName := range blueprintChanges.RemovedResources {
+		resourceState := getResourceStateByName(instanceState, resourceName)
+		if isResourceProtected(resourceState) {
+			protected = append(protected, pathPrefix+resourceName)
+		}
+	}
+
+	for childName, childChanges := range blueprintChanges.ChildChanges {
+		childState, hasChildState := instanceState.ChildBlueprints[childName]
+		if !hasChildState {
+			continue
+		}
+
+		protected = append(
+			protected,
+			collectProtectedRemovals(
+				childState,
+				&childChanges,
+				pathPrefix+core.ChildElementID(childName)+"::",
+			)...,
+		)
+	}
+
+	return protected
+}
@@ -0,0 +1,152 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResourceProtectionTestSuite struct {
+	suite.Suite
+}
+
+func (s *ResourceProtectionTestSuite) Test_retain_on_destroy_annotation_retains_resources_on_instance_removal() {
+	instance := &state.InstanceState{
+		Resources: map[string]*state.ResourceState{
+			"deleteMe": {Name: "deleteMe"},
+			"retainMe": {
+				Name: "retainMe",
+				Metadata: &state.ResourceMetadataState{
+					Annotations: map[string]*core.MappingNode{
+						RetainOnDestroyAnnotation: core.MappingNodeFromString("true"),
+					},
+				},
+			},
+			"retainToo": {
+				Name: "retainToo",
+				Metadata: &state.ResourceMetadataState{
+					Annotations: map[string]*core.MappingNode{
+						RetainOnDestroyAnnotation: core.MappingNodeFromBool(true),
+					},
+				},
+			},
+			"deleteToo": {
+				Name: "deleteToo",
+				Metadata: &state.ResourceMetadataState{
+					Annotations: map[string]*core.MappingNode{
+						RetainOnDestroyAnnotation: core.MappingNodeFromString("false"),
+					},
+				},
+			},
+		},
+	}
+
+	bpChanges := getInstanceRemovalChanges(instance)
+
+	s.ElementsMatch(bpChanges.RemovedResources, []string{"deleteMe", "deleteToo"})
+	s.ElementsMatch(bpChanges.RetainedResources, []string{"retainMe", "retainToo"})
+}
+
+func (s *ResourceProtectionTestSuite) Test_collects_protected_resources_for_instance_removal() {
+	instance := &state.InstanceState{
+		ResourceIDs: map[string]string{
+			"ordersTable":    "resource-1",
+			"ordersFunction": "resource-2",
+		},
+		Resources: map[string]*state.ResourceState{
+			"resource-1": protectedResourceState("resource-1", "ordersTable"),
+			"resource-2": {ResourceID: "resource-2", Name: "ordersFunction"},
+		},
+		ChildBlueprints: map[string]*state.InstanceState{
+			"coreInfra": {
+				ResourceIDs: map[string]string{
+					"networkBucket": "resource-3",
+				},
+				Resources: map[string]*state.ResourceState{
+					"resource-3": protectedResourceState("resource-3", "networkBucket"),
+				},
+			},
+		},
+	}
+
+	bpChanges := getInstanceRemovalChanges(instance)
+	protected := collectProtectedRemovals(instance, &bpChanges, "")
+
+	s.ElementsMatch(
+		[]string{"ordersTable", "children.coreInfra::networkBucket"},
+		protected,
+	)
+}
+
+func (s *ResourceProtectionTestSuite) Test_protected_resources_that_are_retained_can_be_removed() {
+	resourceState := protectedResourceState("resource-1", "ordersTable")
+	resourceState.RemovalPolicy = string(schema.RemovalPolicyRetain)
+	instance := &state.InstanceState{
+		ResourceIDs: map[string]string{
+			"ordersTable": "resource-1",
+		},
+		Resources: map[string]*state.ResourceState{
+			"resource-1": resourceState,
+		},
+	}
+
+	bpChanges := getInstanceRemovalChanges(instance)
+	protected := collectProtectedRemovals(instance, &bpChanges, "")
+
+	s.Empty(protected)
+	s.Equal([]string{"ordersTable"}, bpChanges.RetainedResources)
+}
+
+func (s *ResourceProtectionTestSuite) Test_fails_to_collect_protected_resource_for_removal_on_deploy() {
+	container := &defaultBlueprintContainer{}
+	instance := &state.InstanceState{
+		ResourceIDs: map[string]string{
+			"ordersTable": "resource-1",
+		},
+		Resources: map[string]*state.ResourceState{
+			"resource-1": protectedResourceState("resource-1", "ordersTable"),
+		},
+	}
+
+	_, err := container.collectResourcesToRemove(
+		instance,
+		&changes.BlueprintChanges{
+			RemovedResources: []string{"ordersTable"},
+		},
+		[]*DeploymentNode{},
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeProtectedResourceRemoval, runErr.ReasonCode)
+	s.Contains(runErr.Error(), "annotation: ordersTable")
+}
+
+func (s *ResourceProtectionTestSuite) Test_protected_resource_replacement_error_includes_recreate_triggers() {
+	err := errProtectedResourceReplacement("ordersTable", []string{"spec.tableName"})
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeProtectedResourceReplacement, runErr.ReasonCode)
+	s.Contains(runErr.Error(), "caused by changes to: spec.tableName")
+}
+
+func protectedResourceState(resourceID string, resourceName string) *state.ResourceState {
+	return &state.ResourceState{
+		ResourceID: resourceID,
+		Name:       resourceName,
+		Metadata: &state.ResourceMetadataState{
+			Annotations: map[string]*core.MappingNode{
+				ProtectAnnotation: core.MappingNodeFromBool(true),
+			},
+		},
+	}
+}
+
+func TestResourceProtectionTestSuite(t *testing.T) {
+	suite.Run(t, new(ResourceProtectionTestSuite))
+}
@@ -52,15 +52,17 @@ func PopulateResourceSpecDefaults(
 					/* depth */ 0,
 				)
 				newResourceMap.Values[resourceName] = &schema.Resource{
-					Type:         resource.Type,
-					Description:  resource.Description,
-					Metadata:     resource.Metadata,
-					DependsOn:    resource.DependsOn,
-					Condition:    resource.Condition,
-					Each:         resource.Each,
-					LinkSelector: resource.LinkSelector,
-					Spec:         newSpec,
-					SourceMeta:   resource.SourceMeta,
+					Type:            resource.Type,
+					Description:     resource.Description,
+					Metadata:        resource.Metadata,
+					DependsOn:       resource.DependsOn,
+					Condition:       resource.Condition,
+					Each:            resource.Each,
+					LinkSelector:    resource.LinkSelector,
+					ReplaceStrategy: resource.ReplaceStrategy,
+					Timeouts:        resource.Timeouts,
+					Spec:            newSpec,
+					SourceMeta:      resource.SourceMeta,
 				}
 			}
 		}
@@ -75,6 +77,7 @@ func PopulateResourceSpecDefaults(
 		Resources:   newResourceMap,
 		DataSources: blueprint.DataSources,
 		Exports:     blueprint.Exports,
+		Links:       blueprint.Links,
 		Metadata:    blueprint.Metadata,
 	}, nil
 }
@@ -0,0 +1,170 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// The operation carried out by a provider for a resource
+// that a timeout can be configured for.
+type resourceTimeoutOperation string
+
+const (
+	resourceTimeoutOperationCreate  resourceTimeoutOperation = "create"
+	resourceTimeoutOperationUpdate  resourceTimeoutOperation = "update"
+	resourceTimeoutOperationDestroy resourceTimeoutOperation = "destroy"
+)
+
+// errResourceOperationTimedOut is used as the cause of the cancellation
+// of the context passed into a provider call when the configured timeout
+// for the operation has been exceeded.
+// This is used to distinguish a resource timeout from the cancellation
+// of the deployment as a whole.
+var errResourceOperationTimedOut = errors.New("resource operation timed out")
+
+func resourceDeployTimeoutOperation(isNew bool) resourceTimeoutOperation {
+	if isNew {
+		return resourceTimeoutOperationCreate
+	}
+
+	return resourceTimeoutOperationUpdate
+}
+
+// Resolves the timeout for a provider operation on a resource.
+// Timeouts set for the resource in the blueprint take precedence over
+// the defaults in the spec definition for the resource type.
+// A zero duration is returned when there is no timeout for the operation.
+func resolveResourceOperationTimeout(
+	ctx context.Context,
+	operation resourceTimeoutOperation,
+	blueprintResource *schema.Resource,
+	resourceImpl provider.Resource,
+	providerContext provider.Context,
+) (time.Duration, error) {
+	if blueprintResource != nil {
+		timeout := blueprintResourceOperationTimeout(operation, blueprintResource.Timeouts)
+		if timeout > 0 {
+			return timeout, nil
+		}
+	}
+
+	specDefOutput, err := resourceImpl.GetSpecDefinition(
+		ctx,
+		&provider.ResourceGetSpecDefinitionInput{
+			ProviderContext: providerContext,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if specDefOutput == nil ||
+		specDefOutput.SpecDefinition == nil ||
+		specDefOutput.SpecDefinition.Timeouts == nil {
+		return 0, nil
+	}
+
+	return providerResourceOperationTimeout(
+		operation,
+		specDefOutput.SpecDefinition.Timeouts,
+	), nil
+}
+
+func blueprintResourceOperationTimeout(
+	operation resourceTimeoutOperation,
+	timeouts *schema.ResourceTimeouts,
+) time.Duration {
+	if timeouts == nil {
+		return 0
+	}
+
+	var value *core.ScalarValue
+	switch operation {
+	case resourceTimeoutOperationCreate:
+		value = timeouts.Create
+	case resourceTimeoutOperationUpdate:
+		value = timeouts.Update
+	case resourceTimeoutOperationDestroy:
+		value = timeouts.Destroy
+	}
+
+	if value == nil || value.StringValue == nil {
+		return 0
+	}
+
+	// Timeouts are checked during validation, an invalid value
+	// that makes it this far will be treated as if there is no timeout
+	// set in the blueprint.
+	timeout, err := time.ParseDuration(*value.StringValue)
+	if err != nil {
+		return 0
+	}
+
+	return timeout
+}
+
+func providerResourceOperationTimeout(
+	operation resourceTimeoutOperation,
+	timeouts *provider.ResourceTimeouts,
+) time.Duration {
+	switch operation {
+	case resourceTimeoutOperationCreate:
+		return timeouts.Create
+	case resourceTimeoutOperationUpdate:
+		return timeouts.Update
+	case resourceTimeoutOperationDestroy:
+		return timeouts.Destroy
+	default:
+		return 0
+	}
+}
+
+// Derives a context for a provider call that will be cancelled
+// once the provided timeout has been exceeded.
+// When there is no timeout for the operation, the derived context
+// will only be cancelled along with the parent context.
+func contextWithResourceOperationTimeout(
+	ctx context.Context,
+	timeout time.Duration,
+) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeoutCause(ctx, timeout, errResourceOperationTimedOut)
+}
+
+// Determines whether the provider call made with the given context
+// was interrupted due to the resource operation timeout being exceeded.
+func resourceOperationTimedOut(operationCtx context.Context) bool {
+	return errors.Is(context.Cause(operationCtx), errResourceOperationTimedOut)
+}
+
+func resourceOperationTimeoutFailureMessage(
+	operation resourceTimeoutOperation,
+	timeout time.Duration,
+) string {
+	return fmt.Sprintf(
+		"Resource %s operation was interrupted as it did not complete within the configured timeout of %s, "+
+			"the resource may be in an inconsistent state and should be reconciled",
+		operation,
+		timeout,
+	)
+}
+
+func getBlueprintResourceForDestroy(
+	deployCtx *DeployContext,
+	resourceName string,
+) *schema.Resource {
+	if deployCtx.PreparedContainer == nil {
+		return nil
+	}
+
+	return deployCtx.PreparedContainer.BlueprintSpec().ResourceSchema(resourceName)
+}
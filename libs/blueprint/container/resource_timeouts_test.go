@@ -0,0 +1,245 @@
+package container
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/mockclock"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResourceTimeoutsTestSuite struct {
+	suite.Suite
+}
+
+func (s *ResourceTimeoutsTestSuite) Test_blueprint_timeout_takes_precedence_over_spec_definition_default() {
+	resource := &blockingResource{
+		DynamoDBTableResource: &internal.DynamoDBTableResource{},
+		timeouts: &provider.ResourceTimeouts{
+			Create: 30 * time.Minute,
+			Update: 20 * time.Minute,
+		},
+	}
+	blueprintResource := &schema.Resource{
+		Timeouts: &schema.ResourceTimeouts{
+			Create: core.ScalarFromString("45m"),
+		},
+	}
+
+	createTimeout, err := resolveResourceOperationTimeout(
+		context.Background(),
+		resourceTimeoutOperationCreate,
+		blueprintResource,
+		resource,
+		provider.NewProviderContextFromParams("aws", createParams()),
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(45*time.Minute, createTimeout)
+
+	// The update timeout is not set in the blueprint so the default
+	// from the spec definition is used.
+	updateTimeout, err := resolveResourceOperationTimeout(
+		context.Background(),
+		resourceTimeoutOperationUpdate,
+		blueprintResource,
+		resource,
+		provider.NewProviderContextFromParams("aws", createParams()),
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(20*time.Minute, updateTimeout)
+
+	destroyTimeout, err := resolveResourceOperationTimeout(
+		context.Background(),
+		resourceTimeoutOperationDestroy,
+		/* blueprintResource */ nil,
+		resource,
+		provider.NewProviderContextFromParams("aws", createParams()),
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(time.Duration(0), destroyTimeout)
+}
+
+func (s *ResourceTimeoutsTestSuite) Test_marks_resource_as_interrupted_when_deploy_timeout_is_exceeded() {
+	deployer := &defaultResourceDeployer{
+		clock:              &mockclock.StaticClock{},
+		defaultRetryPolicy: provider.DefaultRetryPolicy,
+	}
+	channels := CreateDeployChannels()
+	deployCtx := &DeployContext{
+		Channels:              channels,
+		State:                 NewDefaultDeploymentState(),
+		Logger:                core.NewNopLogger(),
+		InstanceStateSnapshot: &state.InstanceState{InstanceID: "instance-1"},
+		ParamOverrides:        createParams(),
+	}
+
+	go func() {
+		err := deployer.deployResource(
+			context.Background(),
+			&resourceDeployInfo{
+				instanceID:   "instance-1",
+				resourceID:   "resource-1",
+				resourceName: "ordersTable",
+				resourceImpl: &blockingResource{
+					DynamoDBTableResource: &internal.DynamoDBTableResource{},
+				},
+				changes: &provider.Changes{},
+				isNew:   true,
+				timeout: 10 * time.Millisecond,
+			},
+			"aws/dynamodb/table",
+			deployCtx,
+			provider.CreateRetryContext(provider.DefaultRetryPolicy),
+		)
+		if err != nil {
+			channels.ErrChan <- err
+		}
+	}()
+
+	msg := s.waitForInterruptedMessage(channels)
+	s.Assert().Equal(core.ResourceStatusCreateInterrupted, msg.Status)
+	s.Assert().Equal(core.PreciseResourceStatusCreateInterrupted, msg.PreciseStatus)
+	s.Assert().False(msg.CanRetry)
+	s.Assert().Equal(
+		[]string{
+			resourceOperationTimeoutFailureMessage(
+				resourceTimeoutOperationCreate,
+				10*time.Millisecond,
+			),
+		},
+		msg.FailureReasons,
+	)
+	s.Assert().True(
+		isTerminalResourceFailure(
+			msg,
+			/* rollback */ false,
+		),
+	)
+}
+
+func (s *ResourceTimeoutsTestSuite) Test_marks_resource_as_interrupted_when_destroy_timeout_is_exceeded() {
+	destroyer := &defaultResourceDestroyer{
+		clock:              &mockclock.StaticClock{},
+		defaultRetryPolicy: provider.DefaultRetryPolicy,
+	}
+	channels := CreateDeployChannels()
+	deployCtx := &DeployContext{
+		Channels:       channels,
+		State:          NewDefaultDeploymentState(),
+		Logger:         core.NewNopLogger(),
+		ParamOverrides: createParams(),
+		InstanceStateSnapshot: &state.InstanceState{
+			InstanceID: "instance-1",
+			ResourceIDs: map[string]string{
+				"ordersTable": "resource-1",
+			},
+			Resources: map[string]*state.ResourceState{
+				"resource-1": {
+					ResourceID: "resource-1",
+					Name:       "ordersTable",
+					Type:       "aws/dynamodb/table",
+					InstanceID: "instance-1",
+				},
+			},
+		},
+	}
+
+	go func() {
+		err := destroyer.destroyResource(
+			context.Background(),
+			&deploymentElementInfo{
+				element: &ResourceIDInfo{
+					ResourceID:   "resource-1",
+					ResourceName: "ordersTable",
+				},
+				instanceID: "instance-1",
+			},
+			"instance-name-1",
+			&blockingResource{
+				DynamoDBTableResource: &internal.DynamoDBTableResource{},
+			},
+			10*time.Millisecond,
+			deployCtx,
+			provider.CreateRetryContext(provider.DefaultRetryPolicy),
+		)
+		if err != nil {
+			channels.ErrChan <- err
+		}
+	}()
+
+	msg := s.waitForInterruptedMessage(channels)
+	s.Assert().Equal(core.ResourceStatusDestroyInterrupted, msg.Status)
+	s.Assert().Equal(core.PreciseResourceStatusDestroyInterrupted, msg.PreciseStatus)
+	s.Assert().Equal(
+		[]string{
+			resourceOperationTimeoutFailureMessage(
+				resourceTimeoutOperationDestroy,
+				10*time.Millisecond,
+			),
+		},
+		msg.FailureReasons,
+	)
+}
+
+func (s *ResourceTimeoutsTestSuite) waitForInterruptedMessage(
+	channels *DeployChannels,
+) ResourceDeployUpdateMessage {
+	for {
+		select {
+		case msg := <-channels.ResourceUpdateChan:
+			if isInterruptedPreciseResourceStatus(msg.PreciseStatus) {
+				return msg
+			}
+		case err := <-channels.ErrChan:
+			s.Require().NoError(err)
+		case <-time.After(5 * time.Second):
+			s.Require().Fail("timed out waiting for interrupted resource status update")
+		}
+	}
+}
+
+// blockingResource is a resource where deploy and destroy operations
+// only return once the context for the provider call has been cancelled.
+type blockingResource struct {
+	*internal.DynamoDBTableResource
+	timeouts *provider.ResourceTimeouts
+}
+
+func (r *blockingResource) GetSpecDefinition(
+	ctx context.Context,
+	input *provider.ResourceGetSpecDefinitionInput,
+) (*provider.ResourceGetSpecDefinitionOutput, error) {
+	output, err := r.DynamoDBTableResource.GetSpecDefinition(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	output.SpecDefinition.Timeouts = r.timeouts
+	return output, nil
+}
+
+func (r *blockingResource) Deploy(
+	ctx context.Context,
+	input *provider.ResourceDeployInput,
+) (*provider.ResourceDeployOutput, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (r *blockingResource) Destroy(
+	ctx context.Context,
+	input *provider.ResourceDestroyInput,
+) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestResourceTimeoutsTestSuite(t *testing.T) {
+	suite.Run(t, new(ResourceTimeoutsTestSuite))
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/policy"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 )
 
 const (
@@ -109,6 +111,91 @@ const (
 	// This is used to wrap errors that occur in child blueprints
 	// that are not run errors.
 	ErrorReasonCodeChildBlueprintError errors.ErrorReasonCode = "child_blueprint_error"
+	// ErrorReasonCodeBlastRadiusExceeded
+	// is provided when the reason for an error
+	// during deployment is due to the changes to be deployed
+	// exceeding the configured blast radius limits.
+	ErrorReasonCodeBlastRadiusExceeded errors.ErrorReasonCode = "blast_radius_exceeded"
+	// ErrorReasonCodeResumeRequiresManualCleanup
+	// is provided when the reason for an error
+	// when resuming an interrupted deployment is due to
+	// elements that can not be automatically reconciled
+	// and require manual cleanup before the deployment can continue.
+	ErrorReasonCodeResumeRequiresManualCleanup errors.ErrorReasonCode = "resume_requires_manual_cleanup"
+	// ErrorReasonCodeUnknownDeployTargets
+	// is provided when the reason for an error
+	// during a targeted deployment is due to one or more
+	// of the targets not matching a resource in the blueprint.
+	ErrorReasonCodeUnknownDeployTargets errors.ErrorReasonCode = "unknown_deploy_targets"
+	// ErrorReasonCodeDeployTargetsRequireExcluded
+	// is provided when the reason for an error
+	// during a targeted deployment is due to the targeted resources
+	// requiring resources that are excluded from the deployment
+	// and have not been deployed yet.
+	ErrorReasonCodeDeployTargetsRequireExcluded errors.ErrorReasonCode = "deploy_targets_require_excluded"
+	// ErrorReasonCodeUnknownDestroyTargets
+	// is provided when the reason for an error
+	// during a partial destroy is due to one or more
+	// of the targets not matching a resource or child blueprint
+	// in the blueprint instance.
+	ErrorReasonCodeUnknownDestroyTargets errors.ErrorReasonCode = "unknown_destroy_targets"
+	// ErrorReasonCodeDestroyTargetsHaveDependents
+	// is provided when the reason for an error
+	// during a partial destroy is due to elements that are not targeted
+	// depending on the targeted elements.
+	ErrorReasonCodeDestroyTargetsHaveDependents errors.ErrorReasonCode = "destroy_targets_have_dependents"
+	// ErrorReasonCodeUnsupportedSavedPlanFormat
+	// is provided when the reason for an error
+	// when deploying from a saved plan is due to the plan
+	// having been saved with an unsupported format version.
+	ErrorReasonCodeUnsupportedSavedPlanFormat errors.ErrorReasonCode = "unsupported_saved_plan_format"
+	// ErrorReasonCodeSavedPlanStateDiverged
+	// is provided when the reason for an error
+	// when deploying from a saved plan is due to the state
+	// of the blueprint instance having changed since the plan was saved.
+	ErrorReasonCodeSavedPlanStateDiverged errors.ErrorReasonCode = "saved_plan_state_diverged"
+	// ErrorReasonCodeDeploymentHookFailed
+	// is provided when the reason for an error
+	// during deployment is due to a registered deployment hook
+	// returning an error.
+	ErrorReasonCodeDeploymentHookFailed errors.ErrorReasonCode = "deployment_hook_failed"
+	// ErrorReasonCodeUnsupportedGraphFormat
+	// is provided when the reason for an error
+	// when exporting the dependency graph for a blueprint
+	// is due to an unsupported format being requested.
+	ErrorReasonCodeUnsupportedGraphFormat errors.ErrorReasonCode = "unsupported_graph_format"
+	// ErrorReasonCodeProtectedResourceRemoval
+	// is provided when the reason for an error
+	// during deployment, destruction or change staging is due to
+	// changes that would destroy resources that are protected
+	// with the "bluelink.protect" annotation.
+	ErrorReasonCodeProtectedResourceRemoval errors.ErrorReasonCode = "protected_resource_removal"
+	// ErrorReasonCodeProtectedResourceReplacement
+	// is provided when the reason for an error
+	// during change staging is due to changes that would require
+	// a resource that is protected with the "bluelink.protect" annotation
+	// to be replaced.
+	ErrorReasonCodeProtectedResourceReplacement errors.ErrorReasonCode = "protected_resource_replacement"
+	// ErrorReasonCodeCloneSourceInstanceDestroyed
+	// is provided when the reason for an error
+	// when cloning a blueprint instance is due to the source
+	// instance having been destroyed.
+	ErrorReasonCodeCloneSourceInstanceDestroyed errors.ErrorReasonCode = "clone_source_instance_destroyed"
+	// ErrorReasonCodeCloneTargetInstanceExists
+	// is provided when the reason for an error
+	// when cloning a blueprint instance is due to a blueprint
+	// instance already existing with the name chosen for the clone.
+	ErrorReasonCodeCloneTargetInstanceExists errors.ErrorReasonCode = "clone_target_instance_exists"
+	// ErrorReasonCodePolicyViolation
+	// is provided when the reason for an error
+	// during deployment is due to the changes to be deployed
+	// violating one or more policies with the "deny" level.
+	ErrorReasonCodePolicyViolation errors.ErrorReasonCode = "policy_violation"
+	// ErrorReasonCodePolicyEvaluationFailed
+	// is provided when the reason for an error
+	// during deployment is due to the configured policy engine
+	// failing to evaluate policies for the changes to be deployed.
+	ErrorReasonCodePolicyEvaluationFailed errors.ErrorReasonCode = "policy_evaluation_failed"
 )
 
 func errMissingChildBlueprintPath(includeName string) error {
@@ -188,6 +275,44 @@ func errResourceToBeRemovedHasDependents(
 	}
 }
 
+func errProtectedResourceRemoval(resourceNames []string) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeProtectedResourceRemoval,
+		Err: fmt.Errorf(
+			"the following resources cannot be destroyed because they are protected "+
+				"with the %q annotation: %s, the annotation must be removed and the blueprint "+
+				"deployed before these resources can be destroyed",
+			ProtectAnnotation,
+			strings.Join(resourceNames, ", "),
+		),
+	}
+}
+
+func errProtectedResourceReplacement(
+	resourceName string,
+	recreateTriggers []string,
+) error {
+	triggersInfo := ""
+	if len(recreateTriggers) > 0 {
+		triggersInfo = fmt.Sprintf(
+			" (caused by changes to: %s)",
+			strings.Join(recreateTriggers, ", "),
+		)
+	}
+
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeProtectedResourceReplacement,
+		Err: fmt.Errorf(
+			"resource %q cannot be replaced because it is protected with the %q annotation%s, "+
+				"the annotation must be removed and the blueprint deployed before changes "+
+				"that require the resource to be replaced can be applied",
+			resourceName,
+			ProtectAnnotation,
+			triggersInfo,
+		),
+	}
+}
+
 func errChildToBeRemovedHasDependents(
 	childName string,
 	dependents *CollectedElements,
@@ -259,6 +384,157 @@ func errMissingNameForNewInstance() error {
 	}
 }
 
+func errBlastRadiusExceeded(limitName string, actual int, limit int) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeBlastRadiusExceeded,
+		Err: fmt.Errorf(
+			"the changes to be deployed exceed the blast radius limit for %s, "+
+				"%d %s will be applied but the maximum allowed is %d, "+
+				"the deployment must be explicitly overridden to proceed",
+			limitName,
+			actual,
+			limitName,
+			limit,
+		),
+	}
+}
+
+func errResumeRequiresManualCleanup(instanceID string, elementNames []string) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeResumeRequiresManualCleanup,
+		Err: fmt.Errorf(
+			"the deployment for instance %q can not be resumed as the following "+
+				"interrupted elements require manual cleanup: %s",
+			instanceID,
+			strings.Join(elementNames, ", "),
+		),
+	}
+}
+
+func errUnknownDeployTargets(targets []string) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeUnknownDeployTargets,
+		Err: fmt.Errorf(
+			"the following deployment targets do not match any resources in the blueprint: %s",
+			strings.Join(targets, ", "),
+		),
+	}
+}
+
+func errDeployTargetsRequireExcludedResources(resourceNames []string) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeDeployTargetsRequireExcluded,
+		Err: fmt.Errorf(
+			"the targeted resources are linked to the following resources that have not been "+
+				"deployed yet and are excluded from the deployment: %s, "+
+				"add them to the deployment targets to proceed",
+			strings.Join(resourceNames, ", "),
+		),
+	}
+}
+
+func errUnknownDestroyTargets(targets []string) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeUnknownDestroyTargets,
+		Err: fmt.Errorf(
+			"the following destroy targets do not match any resources or child blueprints "+
+				"in the blueprint instance: %s",
+			strings.Join(targets, ", "),
+		),
+	}
+}
+
+func errDestroyTargetsHaveDependents(dependents []string) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeDestroyTargetsHaveDependents,
+		Err: fmt.Errorf(
+			"the targeted elements can not be destroyed as elements that are not targeted "+
+				"depend on them: %s, add the dependent elements to the destroy targets to proceed",
+			strings.Join(dependents, ", "),
+		),
+	}
+}
+
+func errUnsupportedSavedPlanFormat(formatVersion string) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeUnsupportedSavedPlanFormat,
+		Err: fmt.Errorf(
+			"the saved plan has an unsupported format version %q, expected %q",
+			formatVersion,
+			SavedPlanFormatVersion,
+		),
+	}
+}
+
+func errSavedPlanStateDiverged(plan *SavedPlan) error {
+	instance := plan.InstanceName
+	if instance == "" {
+		instance = plan.InstanceID
+	}
+
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeSavedPlanStateDiverged,
+		Err: fmt.Errorf(
+			"the state of blueprint instance %q has changed since the plan was saved, "+
+				"changes must be staged again before they can be deployed",
+			instance,
+		),
+	}
+}
+
+func errCloneSourceInstanceDestroyed(instanceID string) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeCloneSourceInstanceDestroyed,
+		Err: fmt.Errorf(
+			"blueprint instance %q can not be cloned as it has been destroyed",
+			instanceID,
+		),
+	}
+}
+
+func errCloneTargetInstanceExists(instanceName string) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeCloneTargetInstanceExists,
+		Err: fmt.Errorf(
+			"a blueprint instance with the name %q already exists, "+
+				"a new name must be chosen for the cloned instance",
+			instanceName,
+		),
+	}
+}
+
+func errDeploymentHookFailed(
+	event schema.HookEvent,
+	resourceName string,
+	hookErr error,
+) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeDeploymentHookFailed,
+		Err: fmt.Errorf(
+			"%s hook failed for resource %q: %w",
+			event,
+			resourceName,
+			hookErr,
+		),
+	}
+}
+
+func errUnsupportedGraphFormat(format GraphFormat) error {
+	supported := make([]string, len(SupportedGraphFormats))
+	for i, supportedFormat := range SupportedGraphFormats {
+		supported[i] = string(supportedFormat)
+	}
+
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeUnsupportedGraphFormat,
+		Err: fmt.Errorf(
+			"unsupported graph format %q, expected one of: %s",
+			format,
+			strings.Join(supported, ", "),
+		),
+	}
+}
+
 func errMissingResourceChanges(resourceName string) error {
 	return &errors.RunError{
 		ReasonCode: ErrorReasonCodeDeployMissingResourceChanges,
@@ -339,3 +615,27 @@ func getDeploymentErrorSpecificMessage(err error, fallbackMessage string) string
 
 	return message
 }
+
+func errPolicyViolation(denials []*policy.Violation) error {
+	childErrors := make([]error, 0, len(denials))
+	for _, denial := range denials {
+		childErrors = append(childErrors, fmt.Errorf("%s", denial.String()))
+	}
+
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodePolicyViolation,
+		Err: fmt.Errorf(
+			"the changes to be deployed violate %d policies, "+
+				"the changes must comply with all policies with the deny level to proceed",
+			len(denials),
+		),
+		ChildErrors: childErrors,
+	}
+}
+
+func errPolicyEvaluationFailed(err error) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodePolicyEvaluationFailed,
+		Err:        fmt.Errorf("failed to evaluate policies for the deployment: %w", err),
+	}
+}
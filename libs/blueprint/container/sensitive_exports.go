@@ -0,0 +1,113 @@
+package container
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+// Determines whether the value of an export is sensitive based on
+// the element that the field of the export references.
+// An export is sensitive when it references a secret variable or value
+// or a field of a resource spec that is marked as sensitive.
+func isSensitiveExport(
+	ctx context.Context,
+	blueprint *schema.Blueprint,
+	exportField string,
+	resourceRegistry resourcehelpers.Registry,
+	params core.BlueprintParams,
+) (bool, error) {
+	if exportField == "" {
+		return false, nil
+	}
+
+	exportFieldAsSub, err := substitutions.ParseSubstitution(
+		"exports",
+		exportField,
+		/* parentSourceStart */ &source.Meta{Position: source.Position{}},
+		/* outputLineInfo */ false,
+		/* ignoreParentColumn */ true,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if exportFieldAsSub.Variable != nil {
+		return isSecretVariable(blueprint, exportFieldAsSub.Variable.VariableName), nil
+	}
+
+	if exportFieldAsSub.ValueReference != nil {
+		return isSecretValue(blueprint, exportFieldAsSub.ValueReference.ValueName), nil
+	}
+
+	if exportFieldAsSub.ResourceProperty != nil {
+		return isSensitiveResourceProperty(
+			ctx,
+			blueprint,
+			exportFieldAsSub.ResourceProperty,
+			resourceRegistry,
+			params,
+		)
+	}
+
+	return false, nil
+}
+
+func isSecretVariable(blueprint *schema.Blueprint, variableName string) bool {
+	if blueprint.Variables == nil {
+		return false
+	}
+
+	variable, hasVariable := blueprint.Variables.Values[variableName]
+	return hasVariable && variable != nil && core.BoolValueFromScalar(variable.Secret)
+}
+
+func isSecretValue(blueprint *schema.Blueprint, valueName string) bool {
+	if blueprint.Values == nil {
+		return false
+	}
+
+	value, hasValue := blueprint.Values.Values[valueName]
+	return hasValue && value != nil && core.BoolValueFromScalar(value.Secret)
+}
+
+func isSensitiveResourceProperty(
+	ctx context.Context,
+	blueprint *schema.Blueprint,
+	resourceProperty *substitutions.SubstitutionResourceProperty,
+	resourceRegistry resourcehelpers.Registry,
+	params core.BlueprintParams,
+) (bool, error) {
+	path := resourceProperty.Path
+	if len(path) == 0 || path[0].FieldName != "spec" || blueprint.Resources == nil {
+		return false, nil
+	}
+
+	resource, hasResource := blueprint.Resources.Values[resourceProperty.ResourceName]
+	if !hasResource || resource == nil || resource.Type == nil {
+		return false, nil
+	}
+
+	providerNamespace := provider.ExtractProviderFromItemType(resource.Type.Value)
+	specDefOutput, err := resourceRegistry.GetSpecDefinition(
+		ctx,
+		resource.Type.Value,
+		&provider.ResourceGetSpecDefinitionInput{
+			ProviderContext: provider.NewProviderContextFromParams(providerNamespace, params),
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if specDefOutput == nil || specDefOutput.SpecDefinition == nil {
+		return false, nil
+	}
+
+	return provider.IsSensitivePath(specDefOutput.SpecDefinition.Schema, path[1:]), nil
+}
@@ -0,0 +1,80 @@
+package container
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/stretchr/testify/suite"
+)
+
+const sensitiveExportsTestBlueprint = `
+version: 2025-11-02
+variables:
+  apiKey:
+    type: string
+    secret: true
+  region:
+    type: string
+values:
+  connectionString:
+    type: string
+    value: "${variables.apiKey}"
+    secret: true
+resources:
+  complexResource:
+    type: example/complex
+    spec:
+      itemConfig:
+        endpoints: ["https://example.com/1"]
+        primaryPort: 8080
+`
+
+type SensitiveExportsTestSuite struct {
+	resourceRegistry resourcehelpers.Registry
+	blueprint        *schema.Blueprint
+	suite.Suite
+}
+
+func (s *SensitiveExportsTestSuite) SetupSuite() {
+	s.resourceRegistry = internal.NewResourceRegistryMock(
+		map[string]provider.Resource{
+			"example/complex": &internal.ExampleComplexResource{},
+		},
+	)
+
+	blueprint, err := schema.LoadString(sensitiveExportsTestBlueprint, schema.YAMLSpecFormat)
+	s.Require().NoError(err)
+	s.blueprint = blueprint
+}
+
+func (s *SensitiveExportsTestSuite) Test_reports_secret_variables_and_values_as_sensitive() {
+	s.assertSensitive("variables.apiKey", true)
+	s.assertSensitive("values.connectionString", true)
+	s.assertSensitive("variables.region", false)
+}
+
+func (s *SensitiveExportsTestSuite) Test_reports_sensitive_resource_spec_fields_as_sensitive() {
+	s.assertSensitive("resources.complexResource.spec.itemConfig.primaryPort", true)
+	s.assertSensitive("resources.complexResource.spec.itemConfig.endpoints[0]", false)
+	s.assertSensitive("resources.complexResource.spec.id", false)
+}
+
+func (s *SensitiveExportsTestSuite) assertSensitive(field string, expected bool) {
+	sensitive, err := isSensitiveExport(
+		context.Background(),
+		s.blueprint,
+		field,
+		s.resourceRegistry,
+		/* params */ nil,
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(expected, sensitive, field)
+}
+
+func TestSensitiveExportsTestSuite(t *testing.T) {
+	suite.Run(t, new(SensitiveExportsTestSuite))
+}
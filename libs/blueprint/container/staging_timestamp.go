@@ -0,0 +1,54 @@
+package container
+
+import (
+	"context"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+// withStagingTimestamp attaches the time at which change staging started
+// to the context so functions that depend on the current time (e.g. "now")
+// produce the same value for every element in the blueprint.
+// When staging changes for a child blueprint, the timestamp of the parent
+// blueprint is kept so the whole tree of blueprints shares the same value.
+func withStagingTimestamp(ctx context.Context, clock core.Clock) context.Context {
+	if _, hasTimestamp := core.StagingTimestampFromContext(ctx); hasTimestamp {
+		return ctx
+	}
+
+	// The timestamp is recorded in the staged changes with a precision
+	// of seconds, truncating here ensures values resolved when staging
+	// changes match those resolved when the changes are deployed.
+	return core.WithStagingTimestamp(ctx, time.Unix(clock.Now().Unix(), 0))
+}
+
+// withDeployStagingTimestamp attaches the time at which the changes being
+// deployed were staged to the context so that substitutions resolved during
+// deployment produce the same values for functions that depend on the current
+// time as were presented in the staged changes.
+// The context is returned unchanged for changes that were not produced
+// by change staging, such as the changes derived for a rollback.
+func withDeployStagingTimestamp(
+	ctx context.Context,
+	blueprintChanges *changes.BlueprintChanges,
+) context.Context {
+	if blueprintChanges == nil || blueprintChanges.StagingTimestamp == 0 {
+		return ctx
+	}
+
+	return core.WithStagingTimestamp(
+		ctx,
+		time.Unix(blueprintChanges.StagingTimestamp, 0),
+	)
+}
+
+func stagingTimestampUnix(ctx context.Context) int64 {
+	timestamp, hasTimestamp := core.StagingTimestampFromContext(ctx)
+	if !hasTimestamp {
+		return 0
+	}
+
+	return timestamp.Unix()
+}
@@ -0,0 +1,68 @@
+package container
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/mockclock"
+	"github.com/stretchr/testify/suite"
+)
+
+type StagingTimestampTestSuite struct {
+	suite.Suite
+}
+
+func (s *StagingTimestampTestSuite) Test_attaches_clock_time_truncated_to_seconds() {
+	clock := mockclock.NewAdvanceableClock(
+		time.Unix(mockclock.CurrentTimeUnixMock, int64(750*time.Millisecond)),
+	)
+
+	ctx := withStagingTimestamp(context.Background(), clock)
+
+	timestamp, hasTimestamp := core.StagingTimestampFromContext(ctx)
+	s.Require().True(hasTimestamp)
+	s.Equal(time.Unix(mockclock.CurrentTimeUnixMock, 0), timestamp)
+	s.Equal(mockclock.CurrentTimeUnixMock, stagingTimestampUnix(ctx))
+}
+
+func (s *StagingTimestampTestSuite) Test_keeps_timestamp_of_parent_blueprint() {
+	parentTimestamp := time.Unix(mockclock.CurrentTimeUnixMock-3600, 0)
+	parentCtx := core.WithStagingTimestamp(context.Background(), parentTimestamp)
+
+	ctx := withStagingTimestamp(parentCtx, &mockclock.StaticClock{})
+
+	timestamp, hasTimestamp := core.StagingTimestampFromContext(ctx)
+	s.Require().True(hasTimestamp)
+	s.Equal(parentTimestamp, timestamp)
+}
+
+func (s *StagingTimestampTestSuite) Test_attaches_timestamp_recorded_in_changes_for_deployment() {
+	ctx := withDeployStagingTimestamp(
+		context.Background(),
+		&changes.BlueprintChanges{
+			StagingTimestamp: mockclock.CurrentTimeUnixMock,
+		},
+	)
+
+	timestamp, hasTimestamp := core.StagingTimestampFromContext(ctx)
+	s.Require().True(hasTimestamp)
+	s.Equal(mockclock.CurrentTimeUnixMock, timestamp.Unix())
+}
+
+func (s *StagingTimestampTestSuite) Test_does_not_attach_timestamp_for_changes_without_one() {
+	ctx := withDeployStagingTimestamp(
+		context.Background(),
+		&changes.BlueprintChanges{},
+	)
+
+	_, hasTimestamp := core.StagingTimestampFromContext(ctx)
+	s.False(hasTimestamp)
+	s.Equal(int64(0), stagingTimestampUnix(ctx))
+}
+
+func TestStagingTimestampTestSuite(t *testing.T) {
+	suite.Run(t, new(StagingTimestampTestSuite))
+}
@@ -0,0 +1,159 @@
+package container
+
+import (
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// selectTargetedChanges restricts the provided changes to the targeted
+// resources and their transitive dependencies (resources and child blueprints),
+// based on the direct dependencies populated for the provided deployment nodes.
+// Targets are logical resource names, a target that is the name of a resource
+// template will select all the resources expanded from the template.
+//
+// Removals are never included in a targeted deployment, removed resources,
+// links and child blueprints are left in place until a full deployment is carried out.
+//
+// An error is returned when a target does not match any resource in the blueprint
+// or when a selected resource requires an excluded resource that has not been deployed yet.
+func selectTargetedChanges(
+	blueprintChanges *changes.BlueprintChanges,
+	targets []string,
+	nodes []*DeploymentNode,
+	resourceTemplates map[string]string,
+) (*changes.BlueprintChanges, error) {
+	selected := map[string]bool{}
+	unknownTargets := []string{}
+	for _, target := range targets {
+		matched := false
+		for _, node := range nodes {
+			if isTargetedNode(node, target, resourceTemplates) {
+				matched = true
+				collectNodeWithDependencies(node, selected)
+			}
+		}
+
+		if !matched {
+			unknownTargets = append(unknownTargets, target)
+		}
+	}
+
+	if len(unknownTargets) > 0 {
+		return nil, errUnknownDeployTargets(unknownTargets)
+	}
+
+	targetedChanges := &changes.BlueprintChanges{
+		NewResources:     selectResourceChanges(blueprintChanges.NewResources, selected),
+		ResourceChanges:  selectResourceChanges(blueprintChanges.ResourceChanges, selected),
+		NewChildren:      map[string]changes.NewBlueprintDefinition{},
+		ChildChanges:     map[string]changes.BlueprintChanges{},
+		RecreateChildren: []string{},
+		NewExports:       blueprintChanges.NewExports,
+		ExportChanges:    blueprintChanges.ExportChanges,
+		UnchangedExports: blueprintChanges.UnchangedExports,
+		RemovedExports:   blueprintChanges.RemovedExports,
+		MetadataChanges:  blueprintChanges.MetadataChanges,
+		ResolveOnDeploy:  blueprintChanges.ResolveOnDeploy,
+	}
+
+	for childName, newChild := range blueprintChanges.NewChildren {
+		if selected[core.ChildElementID(childName)] {
+			targetedChanges.NewChildren[childName] = newChild
+		}
+	}
+
+	for childName, childChanges := range blueprintChanges.ChildChanges {
+		if selected[core.ChildElementID(childName)] {
+			targetedChanges.ChildChanges[childName] = childChanges
+		}
+	}
+
+	for _, childName := range blueprintChanges.RecreateChildren {
+		if selected[core.ChildElementID(childName)] {
+			targetedChanges.RecreateChildren = append(targetedChanges.RecreateChildren, childName)
+		}
+	}
+
+	requiredExcluded := collectRequiredExcludedResources(
+		blueprintChanges,
+		targetedChanges,
+		selected,
+	)
+	if len(requiredExcluded) > 0 {
+		return nil, errDeployTargetsRequireExcludedResources(requiredExcluded)
+	}
+
+	return targetedChanges, nil
+}
+
+func isTargetedNode(
+	node *DeploymentNode,
+	target string,
+	resourceTemplates map[string]string,
+) bool {
+	if node.Type() != DeploymentNodeTypeResource {
+		return false
+	}
+
+	resourceName := node.ChainLinkNode.ResourceName
+	return resourceName == target || resourceTemplates[resourceName] == target
+}
+
+func collectNodeWithDependencies(node *DeploymentNode, selected map[string]bool) {
+	if selected[node.Name()] {
+		return
+	}
+
+	selected[node.Name()] = true
+	for _, dependency := range node.DirectDependencies {
+		collectNodeWithDependencies(dependency, selected)
+	}
+}
+
+func selectResourceChanges(
+	resourceChanges map[string]provider.Changes,
+	selected map[string]bool,
+) map[string]provider.Changes {
+	selectedChanges := map[string]provider.Changes{}
+	for resourceName, changes := range resourceChanges {
+		if selected[core.ResourceElementID(resourceName)] {
+			selectedChanges[resourceName] = changes
+		}
+	}
+	return selectedChanges
+}
+
+// collectRequiredExcludedResources collects the names of resources that are
+// excluded from a targeted deployment that have not been deployed yet but
+// are required by links from the selected resources.
+// Links do not always produce a dependency between the two resources
+// in the deployment order, so the resource on the other side of a link
+// may not have been selected as a dependency.
+func collectRequiredExcludedResources(
+	blueprintChanges *changes.BlueprintChanges,
+	targetedChanges *changes.BlueprintChanges,
+	selected map[string]bool,
+) []string {
+	requiredExcluded := []string{}
+	for _, resourceChanges := range []map[string]provider.Changes{
+		targetedChanges.NewResources,
+		targetedChanges.ResourceChanges,
+	} {
+		for _, changes := range resourceChanges {
+			for linkedToResource := range changes.NewOutboundLinks {
+				_, pendingCreation := blueprintChanges.NewResources[linkedToResource]
+				if pendingCreation &&
+					!selected[core.ResourceElementID(linkedToResource)] &&
+					!slices.Contains(requiredExcluded, linkedToResource) {
+					requiredExcluded = append(requiredExcluded, linkedToResource)
+				}
+			}
+		}
+	}
+
+	slices.Sort(requiredExcluded)
+	return requiredExcluded
+}
@@ -0,0 +1,169 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
+	"github.com/stretchr/testify/suite"
+)
+
+type TargetedDeploymentTestSuite struct {
+	suite.Suite
+	nodes []*DeploymentNode
+}
+
+func (s *TargetedDeploymentTestSuite) SetupTest() {
+	networkingNode := &DeploymentNode{
+		ChildNode: &refgraph.ReferenceChainNode{
+			ElementName: "children.networking",
+		},
+	}
+	ordersTableNode := &DeploymentNode{
+		ChainLinkNode: &links.ChainLinkNode{ResourceName: "ordersTable"},
+	}
+	ordersQueueNode := &DeploymentNode{
+		ChainLinkNode: &links.ChainLinkNode{ResourceName: "ordersQueue"},
+	}
+	saveOrderFunctionNode := &DeploymentNode{
+		ChainLinkNode: &links.ChainLinkNode{ResourceName: "saveOrderFunction"},
+		DirectDependencies: []*DeploymentNode{
+			ordersTableNode,
+			networkingNode,
+		},
+	}
+	workerNodes := []*DeploymentNode{
+		{
+			ChainLinkNode:      &links.ChainLinkNode{ResourceName: "worker_0"},
+			DirectDependencies: []*DeploymentNode{ordersQueueNode},
+		},
+		{
+			ChainLinkNode:      &links.ChainLinkNode{ResourceName: "worker_1"},
+			DirectDependencies: []*DeploymentNode{ordersQueueNode},
+		},
+	}
+	s.nodes = append(
+		[]*DeploymentNode{
+			networkingNode,
+			ordersTableNode,
+			ordersQueueNode,
+			saveOrderFunctionNode,
+		},
+		workerNodes...,
+	)
+}
+
+func (s *TargetedDeploymentTestSuite) Test_selects_targets_and_transitive_dependencies() {
+	blueprintChanges := &changes.BlueprintChanges{
+		NewResources: map[string]provider.Changes{
+			"ordersTable":       {},
+			"saveOrderFunction": {},
+			"ordersQueue":       {},
+			"worker_0":          {},
+			"worker_1":          {},
+		},
+		NewChildren: map[string]changes.NewBlueprintDefinition{
+			"networking": {},
+		},
+		RemovedResources: []string{"legacyTable"},
+		RemovedLinks:     []string{"legacyFunction::legacyTable"},
+		RemovedChildren:  []string{"legacyChild"},
+	}
+
+	targetedChanges, err := selectTargetedChanges(
+		blueprintChanges,
+		[]string{"saveOrderFunction"},
+		s.nodes,
+		map[string]string{"worker_0": "worker", "worker_1": "worker"},
+	)
+	s.Require().NoError(err)
+	s.Equal(
+		map[string]provider.Changes{
+			"ordersTable":       {},
+			"saveOrderFunction": {},
+		},
+		targetedChanges.NewResources,
+	)
+	s.Equal(
+		map[string]changes.NewBlueprintDefinition{
+			"networking": {},
+		},
+		targetedChanges.NewChildren,
+	)
+	s.Empty(targetedChanges.RemovedResources)
+	s.Empty(targetedChanges.RemovedLinks)
+	s.Empty(targetedChanges.RemovedChildren)
+}
+
+func (s *TargetedDeploymentTestSuite) Test_selects_all_resources_expanded_from_a_template() {
+	blueprintChanges := &changes.BlueprintChanges{
+		ResourceChanges: map[string]provider.Changes{
+			"ordersTable": {},
+			"ordersQueue": {},
+			"worker_0":    {},
+			"worker_1":    {},
+		},
+	}
+
+	targetedChanges, err := selectTargetedChanges(
+		blueprintChanges,
+		[]string{"worker"},
+		s.nodes,
+		map[string]string{"worker_0": "worker", "worker_1": "worker"},
+	)
+	s.Require().NoError(err)
+	s.Equal(
+		map[string]provider.Changes{
+			"ordersQueue": {},
+			"worker_0":    {},
+			"worker_1":    {},
+		},
+		targetedChanges.ResourceChanges,
+	)
+}
+
+func (s *TargetedDeploymentTestSuite) Test_reports_error_for_unknown_targets() {
+	_, err := selectTargetedChanges(
+		&changes.BlueprintChanges{},
+		[]string{"saveOrderFunction", "missingFunction"},
+		s.nodes,
+		map[string]string{},
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeUnknownDeployTargets, runErr.ReasonCode)
+	s.Contains(runErr.Error(), "missingFunction")
+}
+
+func (s *TargetedDeploymentTestSuite) Test_reports_error_when_targets_link_to_excluded_new_resources() {
+	blueprintChanges := &changes.BlueprintChanges{
+		NewResources: map[string]provider.Changes{
+			"ordersQueue": {},
+			"ordersTable": {
+				NewOutboundLinks: map[string]provider.LinkChanges{
+					"ordersQueue": {},
+				},
+			},
+		},
+	}
+
+	_, err := selectTargetedChanges(
+		blueprintChanges,
+		[]string{"ordersTable"},
+		s.nodes,
+		map[string]string{},
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeDeployTargetsRequireExcluded, runErr.ReasonCode)
+	s.Contains(runErr.Error(), "ordersQueue")
+}
+
+func TestTargetedDeploymentTestSuite(t *testing.T) {
+	suite.Run(t, new(TargetedDeploymentTestSuite))
+}
@@ -0,0 +1,190 @@
+package container
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// selectTargetedRemovals restricts the provided removal changes to the targeted
+// resources and child blueprints of a blueprint instance along with the links
+// that connect the targeted resources to other resources.
+// Targets are logical resource names or child blueprint paths
+// (e.g. "children.networking"), a target that is the name of a resource
+// template will select all the resources expanded from the template.
+//
+// An error is returned when a target does not match any resource or child blueprint
+// in the current state of the instance or when elements that are not targeted
+// depend on the targeted elements, the elements that remain after a partial destroy
+// must not be left with dependencies that no longer exist.
+func selectTargetedRemovals(
+	blueprintChanges *changes.BlueprintChanges,
+	targets []string,
+	instanceState *state.InstanceState,
+) (*changes.BlueprintChanges, error) {
+	selectedResources := []string{}
+	selectedChildren := []string{}
+	unknownTargets := []string{}
+	for _, target := range targets {
+		if strings.HasPrefix(target, "children.") {
+			childName := core.ToLogicalChildName(target)
+			if getChildStateByName(instanceState, childName) == nil {
+				unknownTargets = append(unknownTargets, target)
+			} else {
+				selectedChildren = appendUnique(selectedChildren, childName)
+			}
+			continue
+		}
+
+		matched := false
+		for _, resource := range instanceState.Resources {
+			if resource.Name == target || resource.TemplateName == target {
+				matched = true
+				selectedResources = appendUnique(selectedResources, resource.Name)
+			}
+		}
+
+		if !matched {
+			unknownTargets = append(unknownTargets, target)
+		}
+	}
+
+	if len(unknownTargets) > 0 {
+		return nil, errUnknownDestroyTargets(unknownTargets)
+	}
+
+	remainingDependents := collectRemainingDependents(
+		instanceState,
+		selectedResources,
+		selectedChildren,
+	)
+	if len(remainingDependents) > 0 {
+		return nil, errDestroyTargetsHaveDependents(remainingDependents)
+	}
+
+	slices.Sort(selectedResources)
+	slices.Sort(selectedChildren)
+	targetedChanges := &changes.BlueprintChanges{
+		RemovedResources:  []string{},
+		RetainedResources: []string{},
+		RemovedChildren:   selectedChildren,
+		RemovedLinks:      []string{},
+	}
+	for _, resourceName := range selectedResources {
+		if slices.Contains(blueprintChanges.RetainedResources, resourceName) {
+			targetedChanges.RetainedResources = append(
+				targetedChanges.RetainedResources,
+				resourceName,
+			)
+		} else {
+			targetedChanges.RemovedResources = append(
+				targetedChanges.RemovedResources,
+				resourceName,
+			)
+		}
+	}
+
+	for linkName := range instanceState.Links {
+		resourceAName, resourceBName, _ := strings.Cut(linkName, "::")
+		if slices.Contains(selectedResources, resourceAName) ||
+			slices.Contains(selectedResources, resourceBName) {
+			targetedChanges.RemovedLinks = append(targetedChanges.RemovedLinks, linkName)
+		}
+	}
+	slices.Sort(targetedChanges.RemovedLinks)
+
+	return targetedChanges, nil
+}
+
+// collectRemainingDependents collects descriptions of the dependencies that
+// resources and child blueprints that are not targeted have on the
+// targeted elements, in the format "{dependent} depends on {dependency}".
+func collectRemainingDependents(
+	instanceState *state.InstanceState,
+	selectedResources []string,
+	selectedChildren []string,
+) []string {
+	remainingDependents := []string{}
+	for _, resource := range instanceState.Resources {
+		if slices.Contains(selectedResources, resource.Name) {
+			continue
+		}
+
+		remainingDependents = append(
+			remainingDependents,
+			describeTargetedDependencies(
+				core.ResourceElementID(resource.Name),
+				&state.DependencyInfo{
+					DependsOnResources: resource.DependsOnResources,
+					DependsOnChildren:  resource.DependsOnChildren,
+				},
+				selectedResources,
+				selectedChildren,
+			)...,
+		)
+	}
+
+	for childName, dependencies := range instanceState.ChildDependencies {
+		if slices.Contains(selectedChildren, childName) ||
+			getChildStateByName(instanceState, childName) == nil {
+			continue
+		}
+
+		remainingDependents = append(
+			remainingDependents,
+			describeTargetedDependencies(
+				core.ChildElementID(childName),
+				dependencies,
+				selectedResources,
+				selectedChildren,
+			)...,
+		)
+	}
+
+	slices.Sort(remainingDependents)
+	return remainingDependents
+}
+
+func describeTargetedDependencies(
+	dependentID string,
+	dependencies *state.DependencyInfo,
+	selectedResources []string,
+	selectedChildren []string,
+) []string {
+	descriptions := []string{}
+	if dependencies == nil {
+		return descriptions
+	}
+
+	for _, resourceName := range dependencies.DependsOnResources {
+		if slices.Contains(selectedResources, resourceName) {
+			descriptions = append(
+				descriptions,
+				fmt.Sprintf("%s depends on %s", dependentID, core.ResourceElementID(resourceName)),
+			)
+		}
+	}
+
+	for _, childName := range dependencies.DependsOnChildren {
+		if slices.Contains(selectedChildren, childName) {
+			descriptions = append(
+				descriptions,
+				fmt.Sprintf("%s depends on %s", dependentID, core.ChildElementID(childName)),
+			)
+		}
+	}
+
+	return descriptions
+}
+
+func appendUnique(values []string, value string) []string {
+	if slices.Contains(values, value) {
+		return values
+	}
+
+	return append(values, value)
+}
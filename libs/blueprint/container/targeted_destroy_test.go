@@ -0,0 +1,144 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type TargetedDestroyTestSuite struct {
+	suite.Suite
+	instanceState *state.InstanceState
+}
+
+func (s *TargetedDestroyTestSuite) SetupTest() {
+	s.instanceState = &state.InstanceState{
+		InstanceID: "instance-1",
+		ResourceIDs: map[string]string{
+			"ordersTable":       "resource-1",
+			"saveOrderFunction": "resource-2",
+			"worker_0":          "resource-3",
+			"worker_1":          "resource-4",
+			"ordersQueue":       "resource-5",
+		},
+		Resources: map[string]*state.ResourceState{
+			"resource-1": {
+				ResourceID: "resource-1",
+				Name:       "ordersTable",
+			},
+			"resource-2": {
+				ResourceID:         "resource-2",
+				Name:               "saveOrderFunction",
+				DependsOnResources: []string{"ordersTable"},
+				DependsOnChildren:  []string{"networking"},
+			},
+			"resource-3": {
+				ResourceID:         "resource-3",
+				Name:               "worker_0",
+				TemplateName:       "worker",
+				DependsOnResources: []string{"ordersQueue"},
+			},
+			"resource-4": {
+				ResourceID:         "resource-4",
+				Name:               "worker_1",
+				TemplateName:       "worker",
+				DependsOnResources: []string{"ordersQueue"},
+			},
+			"resource-5": {
+				ResourceID: "resource-5",
+				Name:       "ordersQueue",
+			},
+		},
+		Links: map[string]*state.LinkState{
+			"saveOrderFunction::ordersTable": {
+				LinkID: "link-1",
+				Name:   "saveOrderFunction::ordersTable",
+			},
+			"worker_0::ordersQueue": {
+				LinkID: "link-2",
+				Name:   "worker_0::ordersQueue",
+			},
+		},
+		ChildBlueprints: map[string]*state.InstanceState{
+			"networking": {
+				InstanceID: "child-instance-1",
+			},
+			"monitoring": {
+				InstanceID: "child-instance-2",
+			},
+		},
+		ChildDependencies: map[string]*state.DependencyInfo{
+			"monitoring": {
+				DependsOnResources: []string{"ordersQueue"},
+			},
+		},
+	}
+}
+
+func (s *TargetedDestroyTestSuite) Test_selects_targeted_resources_children_and_links() {
+	targetedChanges, err := selectTargetedRemovals(
+		&changes.BlueprintChanges{
+			RetainedResources: []string{"ordersTable"},
+		},
+		[]string{"saveOrderFunction", "ordersTable", "children.networking"},
+		s.instanceState,
+	)
+	s.Require().NoError(err)
+	s.Equal([]string{"saveOrderFunction"}, targetedChanges.RemovedResources)
+	s.Equal([]string{"ordersTable"}, targetedChanges.RetainedResources)
+	s.Equal([]string{"networking"}, targetedChanges.RemovedChildren)
+	s.Equal([]string{"saveOrderFunction::ordersTable"}, targetedChanges.RemovedLinks)
+	s.Empty(targetedChanges.NewResources)
+	s.Empty(targetedChanges.ResourceChanges)
+}
+
+func (s *TargetedDestroyTestSuite) Test_selects_all_resources_expanded_from_a_template() {
+	targetedChanges, err := selectTargetedRemovals(
+		&changes.BlueprintChanges{},
+		[]string{"worker"},
+		s.instanceState,
+	)
+	s.Require().NoError(err)
+	s.Equal([]string{"worker_0", "worker_1"}, targetedChanges.RemovedResources)
+	s.Equal([]string{"worker_0::ordersQueue"}, targetedChanges.RemovedLinks)
+	s.Empty(targetedChanges.RemovedChildren)
+}
+
+func (s *TargetedDestroyTestSuite) Test_reports_error_for_unknown_targets() {
+	_, err := selectTargetedRemovals(
+		&changes.BlueprintChanges{},
+		[]string{"ordersTable", "missingFunction", "children.missingChild"},
+		s.instanceState,
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeUnknownDestroyTargets, runErr.ReasonCode)
+	s.Contains(runErr.Error(), "missingFunction, children.missingChild")
+}
+
+func (s *TargetedDestroyTestSuite) Test_reports_error_when_remaining_elements_depend_on_targets() {
+	_, err := selectTargetedRemovals(
+		&changes.BlueprintChanges{},
+		[]string{"ordersQueue", "children.networking"},
+		s.instanceState,
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Equal(ErrorReasonCodeDestroyTargetsHaveDependents, runErr.ReasonCode)
+	s.Contains(
+		runErr.Error(),
+		"children.monitoring depends on resources.ordersQueue, "+
+			"resources.saveOrderFunction depends on children.networking, "+
+			"resources.worker_0 depends on resources.ordersQueue, "+
+			"resources.worker_1 depends on resources.ordersQueue",
+	)
+}
+
+func TestTargetedDestroyTestSuite(t *testing.T) {
+	suite.Run(t, new(TargetedDestroyTestSuite))
+}
@@ -81,6 +81,7 @@ func ExpandResourceTemplates(
 		},
 		DataSources: blueprint.DataSources,
 		Exports:     blueprint.Exports,
+		Links:       blueprint.Links,
 		Metadata:    blueprint.Metadata,
 	}
 
@@ -198,14 +199,16 @@ func expandResource(
 	metadata := createExpandedResourceMetadata(resourceTemplate.Metadata, labelInfo, index)
 	linkSelector := createExpandedResourceLinkSelector(resourceTemplate.LinkSelector, linkSelectorInfo, index)
 	return &schema.Resource{
-		Type:         resourceTemplate.Type,
-		Description:  resourceTemplate.Description,
-		Metadata:     metadata,
-		DependsOn:    resourceTemplate.DependsOn,
-		Condition:    resourceTemplate.Condition,
-		LinkSelector: linkSelector,
-		Spec:         resourceTemplate.Spec,
-		SourceMeta:   resourceTemplate.SourceMeta,
+		Type:            resourceTemplate.Type,
+		Description:     resourceTemplate.Description,
+		Metadata:        metadata,
+		DependsOn:       resourceTemplate.DependsOn,
+		Condition:       resourceTemplate.Condition,
+		LinkSelector:    linkSelector,
+		ReplaceStrategy: resourceTemplate.ReplaceStrategy,
+		Timeouts:        resourceTemplate.Timeouts,
+		Spec:            resourceTemplate.Spec,
+		SourceMeta:      resourceTemplate.SourceMeta,
 	}
 }
 
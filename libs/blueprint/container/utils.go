@@ -23,7 +23,7 @@ import (
 
 func deriveSpecFormat(specFilePath string) (schema.SpecFormat, error) {
 	// Bear in mind this is a somewhat naive check, however if the spec file data
-	// isn't valid YAML, JWCC or blueprint language it will be caught in a failure
+	// isn't valid YAML, JWCC, HCL or blueprint language it will be caught in a failure
 	// to unmarshal/parse the spec.
 	if strings.HasSuffix(specFilePath, ".yml") || strings.HasSuffix(specFilePath, ".yaml") {
 		return schema.YAMLSpecFormat, nil
@@ -33,6 +33,10 @@ func deriveSpecFormat(specFilePath string) (schema.SpecFormat, error) {
 		return schema.JWCCSpecFormat, nil
 	}
 
+	if strings.HasSuffix(specFilePath, ".bp.hcl") {
+		return schema.HCLSpecFormat, nil
+	}
+
 	if strings.HasSuffix(specFilePath, ".bp") || strings.HasSuffix(specFilePath, ".blueprint") {
 		return schema.BlueprintLangSpecFormat, nil
 	}
@@ -378,6 +382,23 @@ func getIncludeTreePath(
 	return addToIncludeTreePath(parentTreePath, includeName)
 }
 
+// getIncludeDepth determines the depth of the current blueprint
+// in a tree of nested child blueprints from the include tree path
+// context variable, the root blueprint has a depth of 0.
+func getIncludeDepth(params core.BlueprintParams) int {
+	if params == nil {
+		return 0
+	}
+
+	includeTreePath := params.ContextVariable("includeTreePath")
+	if includeTreePath == nil || includeTreePath.StringValue == nil ||
+		*includeTreePath.StringValue == "" {
+		return 0
+	}
+
+	return len(strings.Split(*includeTreePath.StringValue, "::"))
+}
+
 func addToIncludeTreePath(
 	parentIncludeTreePath string,
 	includeName string,
@@ -993,3 +1014,29 @@ func copyPointerMap[Item any](input map[string]*Item) map[string]Item {
 func exceedsMaxDepth(path string, maxDepth int) bool {
 	return len(strings.Split(path, "/")) > maxDepth
 }
+
+// containsSubstitutions determines whether a mapping node or any of its
+// descendants contain ${..} substitutions.
+func containsSubstitutions(node *core.MappingNode) bool {
+	if node == nil {
+		return false
+	}
+
+	if node.StringWithSubstitutions != nil {
+		return true
+	}
+
+	for _, field := range node.Fields {
+		if containsSubstitutions(field) {
+			return true
+		}
+	}
+
+	for _, item := range node.Items {
+		if containsSubstitutions(item) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,226 @@
+package container
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// DefaultValidationCacheMaxEntries is the default maximum number of validation
+// results that are held in an in-memory validation cache.
+const DefaultValidationCacheMaxEntries = 100
+
+// ValidationCache provides an interface for caching the results of validating
+// blueprint documents.
+// This is useful for tools that validate the same blueprint documents repeatedly
+// such as a language server or a CLI in watch mode, where running the full validation
+// process for a document that has not changed is wasteful.
+//
+// Cached results are keyed by a hash of the document content, the blueprint parameters,
+// the loader configuration and the versions of the provider and transformer schemas
+// used for validation.
+// Results are shared between callers and must not be modified.
+type ValidationCache interface {
+	// Get a cached validation result by key.
+	Get(key string) (*CachedValidation, bool)
+	// Set a validation result in the cache with the given key.
+	Set(key string, validation *CachedValidation)
+	// Clear removes all the cached validation results,
+	// this should be called when the providers, transformers or
+	// validation rules used by a loader change without a change
+	// in the schema versions provided to the loader.
+	Clear()
+}
+
+// CachedValidation holds the result of validating a blueprint document
+// that is stored in a validation cache.
+type CachedValidation struct {
+	Result *ValidationResult
+	// Err holds the error that validation failed with,
+	// this is nil when the blueprint document is valid.
+	Err error
+}
+
+type inMemoryValidationCache struct {
+	maxEntries int
+	entries    map[string]*list.Element
+	// Tracks the order in which entries were used
+	// so the least recently used entry can be evicted
+	// when the cache is full.
+	usage *list.List
+	mu    sync.Mutex
+}
+
+type inMemoryValidationCacheEntry struct {
+	key        string
+	validation *CachedValidation
+}
+
+// NewInMemoryValidationCache creates a thread-safe in-memory validation cache
+// that holds up to maxEntries validation results,
+// the least recently used result is evicted when the cache is full.
+// When maxEntries is less than 1, DefaultValidationCacheMaxEntries is used.
+func NewInMemoryValidationCache(maxEntries int) ValidationCache {
+	if maxEntries < 1 {
+		maxEntries = DefaultValidationCacheMaxEntries
+	}
+
+	return &inMemoryValidationCache{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		usage:      list.New(),
+	}
+}
+
+func (c *inMemoryValidationCache) Get(key string) (*CachedValidation, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.usage.MoveToFront(elem)
+	return elem.Value.(*inMemoryValidationCacheEntry).validation, true
+}
+
+func (c *inMemoryValidationCache) Set(key string, validation *CachedValidation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*inMemoryValidationCacheEntry).validation = validation
+		c.usage.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.usage.PushFront(&inMemoryValidationCacheEntry{
+		key:        key,
+		validation: validation,
+	})
+
+	if c.usage.Len() > c.maxEntries {
+		oldest := c.usage.Back()
+		c.usage.Remove(oldest)
+		delete(c.entries, oldest.Value.(*inMemoryValidationCacheEntry).key)
+	}
+}
+
+func (c *inMemoryValidationCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[string]*list.Element{}
+	c.usage.Init()
+}
+
+// validationCacheKeyParams holds everything other than the document content
+// that can change the outcome of validating a blueprint document.
+type validationCacheKeyParams struct {
+	Source                 string                                    `json:"source"`
+	InputFormat            schema.SpecFormat                         `json:"inputFormat"`
+	ProviderNamespaces     []string                                  `json:"providerNamespaces"`
+	TransformerNames       []string                                  `json:"transformerNames"`
+	SchemaVersions         map[string]string                         `json:"schemaVersions"`
+	ValidateRuntimeValues  bool                                      `json:"validateRuntimeValues"`
+	ValidateAfterTransform bool                                      `json:"validateAfterTransform"`
+	TreatWarningsAsErrors  bool                                      `json:"treatWarningsAsErrors"`
+	TransformSpec          bool                                      `json:"transformSpec"`
+	DerivedFromTemplates   []string                                  `json:"derivedFromTemplates"`
+	ProvidersConfig        map[string]map[string]*bpcore.ScalarValue `json:"providersConfig"`
+	TransformersConfig     map[string]map[string]*bpcore.ScalarValue `json:"transformersConfig"`
+	ContextVariables       map[string]*bpcore.ScalarValue            `json:"contextVariables"`
+	BlueprintVariables     map[string]*bpcore.ScalarValue            `json:"blueprintVariables"`
+}
+
+// Creates a key for a validation result from a hash of the document content
+// along with the parameters and loader configuration that validation depends on.
+// The source is the file path for documents loaded from the file system
+// and is empty for documents provided as strings, the file path is included
+// as relative paths in a blueprint are resolved from the directory of the file.
+func (l *defaultLoader) validationCacheKey(
+	source string,
+	document []byte,
+	inputFormat schema.SpecFormat,
+	params bpcore.BlueprintParams,
+) (string, error) {
+	keyParams := &validationCacheKeyParams{
+		Source:                 source,
+		InputFormat:            inputFormat,
+		ProviderNamespaces:     sortedKeys(l.providers),
+		TransformerNames:       sortedKeys(l.specTransformers),
+		SchemaVersions:         l.validationCacheSchemaVersions,
+		ValidateRuntimeValues:  l.validateRuntimeValues,
+		ValidateAfterTransform: l.validateAfterTransform,
+		TreatWarningsAsErrors:  l.treatWarningsAsErrors,
+		TransformSpec:          l.transformSpec,
+		DerivedFromTemplates:   l.derivedFromTemplates,
+	}
+	if params != nil {
+		keyParams.ProvidersConfig = params.AllProvidersConfig()
+		keyParams.TransformersConfig = params.AllTransformersConfig()
+		keyParams.ContextVariables = params.AllContextVariables()
+		keyParams.BlueprintVariables = params.AllBlueprintVariables()
+	}
+
+	// Maps are serialised with sorted keys so the serialised parameters
+	// are stable for the same set of parameters.
+	serialisedParams, err := json.Marshal(keyParams)
+	if err != nil {
+		return "", fmt.Errorf("failed to create validation cache key: %w", err)
+	}
+
+	hash := sha256.New()
+	hash.Write(document)
+	hash.Write([]byte{0})
+	hash.Write(serialisedParams)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Serves the result of validating a blueprint document from the validation cache
+// when one is configured, running validation and caching the result on a cache miss.
+func (l *defaultLoader) cachedValidation(
+	ctx context.Context,
+	source string,
+	document []byte,
+	inputFormat schema.SpecFormat,
+	params bpcore.BlueprintParams,
+	validate func() (*ValidationResult, error),
+) (*ValidationResult, error) {
+	if l.validationCache == nil {
+		return validate()
+	}
+
+	key, err := l.validationCacheKey(source, document, inputFormat, params)
+	if err != nil {
+		l.logger.Debug(
+			"skipping validation cache",
+			bpcore.ErrorLogField("error", err),
+		)
+		return validate()
+	}
+
+	if cached, ok := l.validationCache.Get(key); ok {
+		l.logger.Debug("serving blueprint validation result from cache")
+		return cached.Result, cached.Err
+	}
+
+	result, err := validate()
+	if ctx.Err() == nil {
+		// Results for validation that was cancelled or timed out
+		// are incomplete and must not be served for future requests.
+		l.validationCache.Set(key, &CachedValidation{
+			Result: result,
+			Err:    err,
+		})
+	}
+	return result, err
+}
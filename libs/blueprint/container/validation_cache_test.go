@@ -0,0 +1,54 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ValidationCacheTestSuite struct {
+	suite.Suite
+}
+
+func (s *ValidationCacheTestSuite) Test_evicts_least_recently_used_result_when_full() {
+	validationCache := NewInMemoryValidationCache(2)
+	first := &CachedValidation{Result: &ValidationResult{}}
+	second := &CachedValidation{Result: &ValidationResult{}}
+	third := &CachedValidation{Result: &ValidationResult{}}
+
+	validationCache.Set("first", first)
+	validationCache.Set("second", second)
+	// Using the first result makes the second result
+	// the least recently used.
+	cached, ok := validationCache.Get("first")
+	s.Require().True(ok)
+	s.Assert().Same(first, cached)
+
+	validationCache.Set("third", third)
+
+	_, ok = validationCache.Get("second")
+	s.Assert().False(ok)
+	cached, ok = validationCache.Get("first")
+	s.Require().True(ok)
+	s.Assert().Same(first, cached)
+	cached, ok = validationCache.Get("third")
+	s.Require().True(ok)
+	s.Assert().Same(third, cached)
+}
+
+func (s *ValidationCacheTestSuite) Test_clears_all_results() {
+	validationCache := NewInMemoryValidationCache(0)
+	validationCache.Set("first", &CachedValidation{Result: &ValidationResult{}})
+	validationCache.Set("second", &CachedValidation{Result: &ValidationResult{}})
+
+	validationCache.Clear()
+
+	_, ok := validationCache.Get("first")
+	s.Assert().False(ok)
+	_, ok = validationCache.Get("second")
+	s.Assert().False(ok)
+}
+
+func TestValidationCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(ValidationCacheTestSuite))
+}
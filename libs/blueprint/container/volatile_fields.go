@@ -0,0 +1,269 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subwalk"
+)
+
+// KeepersAnnotation is the resource annotation that can be used to keep
+// values produced by volatile functions (e.g. "uuid" or "datetime") stable
+// between deployments.
+// When this annotation is set for a resource, values in the resource spec
+// that are derived from volatile functions will only be re-evaluated when the
+// resolved value of the annotation changes.
+//
+// For example:
+//
+//	metadata:
+//	  annotations:
+//	    bluelink.keepers: "${variables.releaseVersion}"
+const KeepersAnnotation = "bluelink.keepers"
+
+// volatileFunctionChecker determines whether substitutions in a resource spec
+// contain calls to volatile functions, caching function definitions as multiple
+// resources will often use the same functions.
+type volatileFunctionChecker struct {
+	funcRegistry provider.FunctionRegistry
+	params       core.BlueprintParams
+	cache        map[string]bool
+}
+
+func newVolatileFunctionChecker(
+	funcRegistry provider.FunctionRegistry,
+	params core.BlueprintParams,
+) *volatileFunctionChecker {
+	return &volatileFunctionChecker{
+		funcRegistry: funcRegistry,
+		params:       params,
+		cache:        map[string]bool{},
+	}
+}
+
+// collectVolatileFieldPaths collects the paths of all the fields in the provided
+// resource spec that contain a call to a volatile function.
+// Paths are rendered in the same format as field changes for a resource,
+// for example, "spec.tags[0].value".
+func (c *volatileFunctionChecker) collectVolatileFieldPaths(
+	ctx context.Context,
+	spec *core.MappingNode,
+) ([]string, error) {
+	paths := []string{}
+	err := c.collectFromMappingNode(ctx, spec, "spec", 0, &paths)
+	if err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+func (c *volatileFunctionChecker) collectFromMappingNode(
+	ctx context.Context,
+	node *core.MappingNode,
+	path string,
+	depth int,
+	paths *[]string,
+) error {
+	if node == nil || depth > core.MappingNodeMaxTraverseDepth {
+		return nil
+	}
+
+	if node.StringWithSubstitutions != nil {
+		isVolatile, err := c.containsVolatileCall(ctx, node.StringWithSubstitutions)
+		if err != nil {
+			return err
+		}
+		if isVolatile {
+			*paths = append(*paths, path)
+		}
+		return nil
+	}
+
+	for fieldName, fieldValue := range node.Fields {
+		err := c.collectFromMappingNode(
+			ctx,
+			fieldValue,
+			substitutions.RenderFieldPath(path, fieldName),
+			depth+1,
+			paths,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, item := range node.Items {
+		err := c.collectFromMappingNode(
+			ctx,
+			item,
+			fmt.Sprintf("%s[%d]", path, i),
+			depth+1,
+			paths,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *volatileFunctionChecker) containsVolatileCall(
+	ctx context.Context,
+	stringOrSubs *substitutions.StringOrSubstitutions,
+) (bool, error) {
+	functionNames := []string{}
+	subwalk.WalkStringOrSubstitutions(
+		stringOrSubs,
+		func(sub *substitutions.Substitution) *substitutions.Substitution {
+			if sub.Function != nil {
+				functionNames = append(functionNames, string(sub.Function.FunctionName))
+			}
+			return nil
+		},
+	)
+
+	for _, functionName := range functionNames {
+		isVolatile, err := c.isVolatile(ctx, functionName)
+		if err != nil {
+			return false, err
+		}
+		if isVolatile {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (c *volatileFunctionChecker) isVolatile(ctx context.Context, functionName string) (bool, error) {
+	if isVolatile, cached := c.cache[functionName]; cached {
+		return isVolatile, nil
+	}
+
+	hasFunction, err := c.funcRegistry.HasFunction(ctx, functionName)
+	if err != nil {
+		return false, err
+	}
+	if !hasFunction {
+		// Missing functions are reported during validation and substitution
+		// resolution, a missing function can not be volatile.
+		c.cache[functionName] = false
+		return false, nil
+	}
+
+	output, err := c.funcRegistry.GetDefinition(
+		ctx,
+		functionName,
+		&provider.FunctionGetDefinitionInput{
+			Params: c.params,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	isVolatile := output != nil && output.Definition.IsVolatile()
+	c.cache[functionName] = isVolatile
+	return isVolatile, nil
+}
+
+// applyVolatileFieldChecks marks modified fields of an existing resource that are
+// derived from volatile functions so they can be reported as perpetual changes.
+// When the keepers annotation is set for the resource and its value has not changed
+// since the last deployment, the current values for these fields are kept
+// and the fields are no longer treated as modified.
+func applyVolatileFieldChecks(
+	changes *provider.Changes,
+	volatilePaths []string,
+) {
+	if len(volatilePaths) == 0 || len(changes.ModifiedFields) == 0 {
+		return
+	}
+
+	volatileChanges := []provider.FieldChange{}
+	otherChanges := []provider.FieldChange{}
+	for _, fieldChange := range changes.ModifiedFields {
+		if isUnderAnyPath(fieldChange.FieldPath, volatilePaths) {
+			volatileChanges = append(volatileChanges, fieldChange)
+		} else {
+			otherChanges = append(otherChanges, fieldChange)
+		}
+	}
+
+	if len(volatileChanges) == 0 {
+		return
+	}
+
+	resolvedResource := changes.AppliedResourceInfo.ResourceWithResolvedSubs
+	if !keepersUnchanged(resolvedResource, changes.AppliedResourceInfo.CurrentResourceState) {
+		for _, fieldChange := range volatileChanges {
+			changes.VolatileFields = append(changes.VolatileFields, fieldChange.FieldPath)
+		}
+		return
+	}
+
+	for _, fieldChange := range volatileChanges {
+		err := core.InjectPathValueReplaceFields(
+			specFieldPathToMappingNodePath(fieldChange.FieldPath),
+			fieldChange.PrevValue,
+			resolvedResource.Spec,
+			core.MappingNodeMaxTraverseDepth,
+		)
+		if err != nil {
+			// Report the field as volatile if the current value can not be kept
+			// instead of failing the change staging process.
+			changes.VolatileFields = append(changes.VolatileFields, fieldChange.FieldPath)
+			otherChanges = append(otherChanges, fieldChange)
+			continue
+		}
+		changes.UnchangedFields = append(changes.UnchangedFields, fieldChange.FieldPath)
+	}
+
+	changes.ModifiedFields = otherChanges
+	changes.RecreateTriggers = provider.RecreateTriggerFields(otherChanges)
+	changes.MustRecreate = len(changes.RecreateTriggers) > 0
+}
+
+func keepersUnchanged(
+	resolvedResource *provider.ResolvedResource,
+	currentState *state.ResourceState,
+) bool {
+	if resolvedResource == nil ||
+		resolvedResource.Metadata == nil ||
+		resolvedResource.Metadata.Annotations == nil ||
+		currentState == nil ||
+		currentState.Metadata == nil {
+		return false
+	}
+
+	newKeepers, hasNewKeepers := resolvedResource.Metadata.Annotations.Fields[KeepersAnnotation]
+	currentKeepers, hasCurrentKeepers := currentState.Metadata.Annotations[KeepersAnnotation]
+	if !hasNewKeepers || !hasCurrentKeepers {
+		return false
+	}
+
+	return core.MappingNodeEqual(newKeepers, currentKeepers)
+}
+
+func isUnderAnyPath(fieldPath string, paths []string) bool {
+	return slices.ContainsFunc(paths, func(path string) bool {
+		return fieldPath == path ||
+			strings.HasPrefix(fieldPath, path+".") ||
+			strings.HasPrefix(fieldPath, path+"[")
+	})
+}
+
+// specFieldPathToMappingNodePath converts a field change path such as
+// "spec.tags[0].value" to a path relative to the resource spec that can be used
+// with core.InjectPathValue, such as "$.tags[0].value".
+func specFieldPathToMappingNodePath(fieldPath string) string {
+	return "$" + strings.TrimPrefix(fieldPath, "spec")
+}
@@ -0,0 +1,141 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type VolatileFieldsTestSuite struct {
+	suite.Suite
+}
+
+func (s *VolatileFieldsTestSuite) Test_reports_volatile_fields_when_keepers_are_not_set() {
+	changes := createVolatileTestChanges(nil, nil)
+
+	applyVolatileFieldChecks(changes, []string{"spec.id"})
+
+	s.Assert().Equal([]string{"spec.id"}, changes.VolatileFields)
+	s.Assert().Len(changes.ModifiedFields, 2)
+	s.Assert().True(changes.MustRecreate)
+}
+
+func (s *VolatileFieldsTestSuite) Test_keeps_current_values_when_keepers_are_unchanged() {
+	changes := createVolatileTestChanges(
+		core.MappingNodeFromString("v1"),
+		core.MappingNodeFromString("v1"),
+	)
+
+	applyVolatileFieldChecks(changes, []string{"spec.id"})
+
+	s.Assert().Empty(changes.VolatileFields)
+	s.Assert().Equal([]string{"spec.id"}, changes.UnchangedFields)
+	s.Assert().Len(changes.ModifiedFields, 1)
+	s.Assert().Equal("spec.name", changes.ModifiedFields[0].FieldPath)
+	s.Assert().False(changes.MustRecreate)
+	s.Assert().Equal(
+		"current-id",
+		core.StringValue(
+			changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec.Fields["id"],
+		),
+	)
+}
+
+func (s *VolatileFieldsTestSuite) Test_reports_volatile_fields_when_keepers_have_changed() {
+	changes := createVolatileTestChanges(
+		core.MappingNodeFromString("v2"),
+		core.MappingNodeFromString("v1"),
+	)
+
+	applyVolatileFieldChecks(changes, []string{"spec.id"})
+
+	s.Assert().Equal([]string{"spec.id"}, changes.VolatileFields)
+	s.Assert().Len(changes.ModifiedFields, 2)
+	s.Assert().Equal(
+		"new-id",
+		core.StringValue(
+			changes.AppliedResourceInfo.ResourceWithResolvedSubs.Spec.Fields["id"],
+		),
+	)
+}
+
+func (s *VolatileFieldsTestSuite) Test_matches_nested_field_paths() {
+	paths := []string{"spec.tags", "spec.id"}
+	s.Assert().True(isUnderAnyPath("spec.tags[0].value", paths))
+	s.Assert().True(isUnderAnyPath("spec.tags.env", paths))
+	s.Assert().True(isUnderAnyPath("spec.id", paths))
+	s.Assert().False(isUnderAnyPath("spec.identifier", paths))
+}
+
+func createVolatileTestChanges(
+	newKeepers *core.MappingNode,
+	currentKeepers *core.MappingNode,
+) *provider.Changes {
+	resolvedResource := &provider.ResolvedResource{
+		Type: &schema.ResourceTypeWrapper{Value: "example/resource"},
+		Spec: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"id":   core.MappingNodeFromString("new-id"),
+				"name": core.MappingNodeFromString("new-name"),
+			},
+		},
+	}
+	if newKeepers != nil {
+		resolvedResource.Metadata = &provider.ResolvedResourceMetadata{
+			Annotations: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					KeepersAnnotation: newKeepers,
+				},
+			},
+		}
+	}
+
+	currentState := &state.ResourceState{
+		ResourceID: "resource-1",
+		Name:       "resource",
+		SpecData: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"id":   core.MappingNodeFromString("current-id"),
+				"name": core.MappingNodeFromString("current-name"),
+			},
+		},
+	}
+	if currentKeepers != nil {
+		currentState.Metadata = &state.ResourceMetadataState{
+			Annotations: map[string]*core.MappingNode{
+				KeepersAnnotation: currentKeepers,
+			},
+		}
+	}
+
+	return &provider.Changes{
+		AppliedResourceInfo: provider.ResourceInfo{
+			ResourceID:               "resource-1",
+			ResourceName:             "resource",
+			CurrentResourceState:     currentState,
+			ResourceWithResolvedSubs: resolvedResource,
+		},
+		ModifiedFields: []provider.FieldChange{
+			{
+				FieldPath:    "spec.id",
+				PrevValue:    core.MappingNodeFromString("current-id"),
+				NewValue:     core.MappingNodeFromString("new-id"),
+				MustRecreate: true,
+			},
+			{
+				FieldPath: "spec.name",
+				PrevValue: core.MappingNodeFromString("current-name"),
+				NewValue:  core.MappingNodeFromString("new-name"),
+			},
+		},
+		MustRecreate: true,
+	}
+}
+
+func TestVolatileFieldsTestSuite(t *testing.T) {
+	suite.Run(t, new(VolatileFieldsTestSuite))
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 // ContextKey provides a unique key type for blueprint execution
@@ -17,6 +18,10 @@ const (
 	// BlueprintInstanceIDKey is the key used to store the blueprint instance ID
 	// in the context for a blueprint execution.
 	BlueprintInstanceIDKey = ContextKey("blueprintInstanceID")
+	// StagingTimestampKey is the key used to store the time at which
+	// changes were staged for a blueprint instance in the context
+	// for a blueprint execution.
+	StagingTimestampKey = ContextKey("stagingTimestamp")
 )
 
 // BlueprintInstanceIDFromContext retrieves the current blueprint instance ID from the context
@@ -29,3 +34,20 @@ func BlueprintInstanceIDFromContext(ctx context.Context) (string, error) {
 
 	return instanceID, nil
 }
+
+// WithStagingTimestamp returns a copy of the provided context that holds the
+// time at which changes were staged for a blueprint instance.
+// Functions that depend on the current time use this in place of the host
+// system's clock so the same value is produced when changes are staged
+// and when the staged changes are deployed.
+func WithStagingTimestamp(ctx context.Context, timestamp time.Time) context.Context {
+	return context.WithValue(ctx, StagingTimestampKey, timestamp)
+}
+
+// StagingTimestampFromContext retrieves the time at which changes were staged
+// for a blueprint instance from the context, the second return value is false
+// when the context does not hold a staging timestamp.
+func StagingTimestampFromContext(ctx context.Context) (time.Time, bool) {
+	timestamp, ok := ctx.Value(StagingTimestampKey).(time.Time)
+	return timestamp, ok
+}
@@ -20,6 +20,11 @@ type Diagnostic struct {
 	Range *DiagnosticRange `json:"range,omitempty"`
 	// Structured context for enhanced error handling
 	Context *errors.ErrorContext `json:"context,omitempty"`
+	// An optional path to the field in a resource spec that the diagnostic
+	// applies to (e.g. "$.environment.variables"), see GetPathValue for the path syntax.
+	// Providers can set this instead of a range when validating a resource,
+	// the blueprint framework resolves the path to a range in the source blueprint.
+	SpecPath string `json:"specPath,omitempty"`
 }
 
 // DiagnosticLevel provides the level of a diagnostic.
@@ -41,6 +46,32 @@ const (
 	DiagnosticLevelInfo DiagnosticLevel = 3
 )
 
+// DiagnosticLevelFromSeverity derives the diagnostic level for the provided
+// load error severity, an empty severity is treated as an error.
+func DiagnosticLevelFromSeverity(severity errors.ErrorSeverity) DiagnosticLevel {
+	switch severity {
+	case errors.ErrorSeverityWarning:
+		return DiagnosticLevelWarning
+	case errors.ErrorSeverityInfo:
+		return DiagnosticLevelInfo
+	default:
+		return DiagnosticLevelError
+	}
+}
+
+// SeverityFromDiagnosticLevel derives the load error severity
+// for the provided diagnostic level.
+func SeverityFromDiagnosticLevel(level DiagnosticLevel) errors.ErrorSeverity {
+	switch level {
+	case DiagnosticLevelWarning:
+		return errors.ErrorSeverityWarning
+	case DiagnosticLevelInfo:
+		return errors.ErrorSeverityInfo
+	default:
+		return errors.ErrorSeverityError
+	}
+}
+
 // DiagnosticRange provides a range in the source blueprint that a diagnostic applies to.
 // This will only be used for source formats that allow position tracking of parsed nodes
 // (i.e. YAML source documents).
@@ -85,6 +116,21 @@ func DiagnosticRangeFromSourceMeta(
 	}
 }
 
+// DiagnosticRangeFromSpecPath creates a diagnostic range for the value at the
+// given path in a resource spec.
+// When the value at the path does not exist or does not have source metadata,
+// the range of the closest ancestor with source metadata is used.
+// This returns nil if the path is invalid or there is no source metadata
+// available for the spec.
+func DiagnosticRangeFromSpecPath(path string, spec *MappingNode) *DiagnosticRange {
+	sourceMeta, err := GetPathSourceMeta(path, spec, MappingNodeMaxTraverseDepth)
+	if err != nil || sourceMeta == nil {
+		return nil
+	}
+
+	return DiagnosticRangeFromSourceMeta(sourceMeta, nil)
+}
+
 func determineEndSourceMeta(
 	start *source.Meta,
 	nextLocation *source.Meta,
@@ -9,6 +9,8 @@ import (
 	"strings"
 	"unicode"
 	"unicode/utf8"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
 )
 
 // GetPathValue retrieves a value from a MappingNode using a path.
@@ -78,6 +80,67 @@ func GetPathValue(path string, node *MappingNode, maxTraverseDepth int) (*Mappin
 	return current, nil
 }
 
+// GetPathSourceMeta retrieves the position in the source blueprint of the value
+// at the given path in a MappingNode.
+// When the exact value at the path does not have source metadata or does not exist,
+// the position of the closest ancestor with source metadata is returned.
+// This will return an error if the provided path is invalid and will
+// return nil if no source metadata is available for the path.
+//
+// See GetPathValue for the supported path syntax.
+//
+// Example:
+//
+//	core.GetPathSourceMeta("$.environment.variables[\"API_KEY\"]", resource.Spec, 10)
+func GetPathSourceMeta(path string, node *MappingNode, maxTraverseDepth int) (*source.Meta, error) {
+	parsedPath, err := parsePath(
+		path,
+		/* allowPatterns */ false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if node == nil {
+		return nil, nil
+	}
+
+	closest := node.SourceMeta
+	current := node
+	maxDepth := min(maxTraverseDepth, len(parsedPath))
+	for i := 0; i < maxDepth && current != nil; i += 1 {
+		pathItem := parsedPath[i]
+		parent := current
+		current = nil
+		if pathItem.fieldName != "" && parent.Fields != nil {
+			current = parent.Fields[pathItem.fieldName]
+			if parent.FieldsSourceMeta[pathItem.fieldName] != nil {
+				closest = parent.FieldsSourceMeta[pathItem.fieldName]
+			}
+		} else if pathItem.arrayIndex != nil &&
+			*pathItem.arrayIndex >= 0 &&
+			*pathItem.arrayIndex < len(parent.Items) {
+			current = parent.Items[*pathItem.arrayIndex]
+		} else if pathItem.arrayItemSelector != nil {
+			targetItemIndex := slices.IndexFunc(
+				parent.Items,
+				objectHasPropertyWithValue(
+					pathItem.arrayItemSelector,
+				),
+			)
+			if targetItemIndex >= 0 {
+				current = parent.Items[targetItemIndex]
+			}
+		}
+
+		if current != nil && current.SourceMeta != nil {
+			closest = current.SourceMeta
+		}
+	}
+
+	return closest, nil
+}
+
 // InjectPathValue injects a value into a MappingNode using a path.
 // This will return an error if the provided path is invalid
 // or if the path is not reachable in the given node.
@@ -404,6 +467,48 @@ func PathMatchesPattern(path, pattern string) (bool, error) {
 		return false, nil
 	}
 
+	return pathItemsMatchPattern(parsedPath, parsedPatternPath), nil
+}
+
+// PathWithinPattern determines if a given path matches the provided pattern
+// or is a descendant of a path that matches the provided pattern.
+// For example, the path "$.cluster.config.endpoints[0].host" is within
+// the pattern "$.cluster.config.endpoints[*]".
+//
+// See PathMatchesPattern for more information about the path
+// and pattern syntax.
+func PathWithinPattern(path, pattern string) (bool, error) {
+	if path == pattern {
+		return true, nil
+	}
+
+	parsedPatternPath, err := parsePath(
+		pattern,
+		/* allowPatterns */ true,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	parsedPath, err := parsePath(
+		path,
+		/* allowPatterns */ false,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if len(parsedPath) < len(parsedPatternPath) {
+		return false, nil
+	}
+
+	return pathItemsMatchPattern(
+		parsedPath[:len(parsedPatternPath)],
+		parsedPatternPath,
+	), nil
+}
+
+func pathItemsMatchPattern(parsedPath []*pathItem, parsedPatternPath []*pathItem) bool {
 	for i := range parsedPath {
 		patternItem := parsedPatternPath[i]
 		pathItem := parsedPath[i]
@@ -417,11 +522,66 @@ func PathMatchesPattern(path, pattern string) (bool, error) {
 		)
 
 		if !matchesFieldName || !matchesArrayIndex {
-			return false, nil
+			return false
+		}
+	}
+
+	return true
+}
+
+// PathPatternSegment represents a single segment of a parsed path pattern.
+type PathPatternSegment struct {
+	// FieldName is the name of the field or map key accessed by the segment,
+	// this will be empty for array index accessors.
+	FieldName string
+	// ArrayIndex is the index of an array item accessed by the segment,
+	// this will be nil for field accessors and array item selectors.
+	ArrayIndex *int
+	// AnyFieldName indicates that the segment matches any field name or map key
+	// (".*" in a pattern).
+	AnyFieldName bool
+	// AnyIndex indicates that the segment matches any array index
+	// ("[*]" in a pattern).
+	AnyIndex bool
+	// ArrayItemSelector indicates that the segment selects an item in an
+	// array by its value or the value of one or more of its attributes,
+	// for example, "[@.id = \"x\"]".
+	ArrayItemSelector bool
+}
+
+// IsArrayAccessor determines whether the segment accesses
+// an item in an array.
+func (s *PathPatternSegment) IsArrayAccessor() bool {
+	return s.ArrayIndex != nil || s.AnyIndex || s.ArrayItemSelector
+}
+
+// ParsePathPattern parses a path or pattern into a list of segments,
+// an empty list of segments is returned for the root path "$".
+// This is useful for validating paths or patterns against a schema.
+//
+// See PathMatchesPattern for more information about the path
+// and pattern syntax.
+func ParsePathPattern(pattern string) ([]*PathPatternSegment, error) {
+	parsed, err := parsePath(
+		pattern,
+		/* allowPatterns */ true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]*PathPatternSegment, len(parsed))
+	for i, item := range parsed {
+		segments[i] = &PathPatternSegment{
+			FieldName:         item.fieldName,
+			ArrayIndex:        item.arrayIndex,
+			AnyFieldName:      item.anyFieldName,
+			AnyIndex:          item.anyIndex,
+			ArrayItemSelector: item.arrayItemSelector != nil,
 		}
 	}
 
-	return true, nil
+	return segments, nil
 }
 
 func checkArrayIndexMatch(patternItem, pathItem *pathItem) bool {
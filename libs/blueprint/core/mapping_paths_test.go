@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -82,6 +83,34 @@ func (s *MappingPathsTestSuite) Test_fails_to_get_value_by_path_for_invalid_path
 	s.Assert().Equal(path, mappingPathErr.Path)
 }
 
+func (s *MappingPathsTestSuite) Test_get_source_meta_by_path() {
+	node := fixtureMappingNodeWithSourceMeta()
+	sourceMeta, err := GetPathSourceMeta("$.environment.variables[\"API_KEY\"]", node, 10)
+	s.Require().NoError(err)
+	s.Assert().Equal(&source.Meta{Position: source.Position{Line: 5, Column: 16}}, sourceMeta)
+
+	sourceMeta, err = GetPathSourceMeta("$.layers[1]", node, 10)
+	s.Require().NoError(err)
+	s.Assert().Equal(&source.Meta{Position: source.Position{Line: 8, Column: 7}}, sourceMeta)
+}
+
+func (s *MappingPathsTestSuite) Test_get_source_meta_for_closest_ancestor_of_missing_path() {
+	node := fixtureMappingNodeWithSourceMeta()
+	sourceMeta, err := GetPathSourceMeta("$.environment.variables.MISSING", node, 10)
+	s.Require().NoError(err)
+	s.Assert().Equal(&source.Meta{Position: source.Position{Line: 4, Column: 5}}, sourceMeta)
+
+	sourceMeta, err = GetPathSourceMeta("$.layers[5]", node, 10)
+	s.Require().NoError(err)
+	s.Assert().Equal(&source.Meta{Position: source.Position{Line: 6, Column: 3}}, sourceMeta)
+}
+
+func (s *MappingPathsTestSuite) Test_fails_to_get_source_meta_for_invalid_path() {
+	node := fixtureMappingNodeWithSourceMeta()
+	_, err := GetPathSourceMeta("environment", node, 10)
+	s.Assert().Error(err)
+}
+
 func (s *MappingPathsTestSuite) Test_inject_value_for_map_field() {
 	path := "$[\"cluster.v1\"].config.endpoint"
 	node := fixtureInjectMappingNode1()
@@ -250,6 +279,38 @@ func (s *MappingPathsTestSuite) Test_path_matches_pattern_with_wildcards_2() {
 	s.Assert().True(matches)
 }
 
+func (s *MappingPathsTestSuite) Test_path_within_pattern_for_descendant_path() {
+	path := "$.cluster.config[5].environments[2].hosts[0].endpoint"
+	pattern := "$.cluster.config[*].environments"
+	within, err := PathWithinPattern(path, pattern)
+	s.Require().NoError(err)
+	s.Assert().True(within)
+}
+
+func (s *MappingPathsTestSuite) Test_path_not_within_pattern_for_sibling_path() {
+	path := "$.cluster.config[5].environmentNames[0]"
+	pattern := "$.cluster.config[*].environments"
+	within, err := PathWithinPattern(path, pattern)
+	s.Require().NoError(err)
+	s.Assert().False(within)
+}
+
+func (s *MappingPathsTestSuite) Test_parses_path_pattern_into_segments() {
+	segments, err := ParsePathPattern("$[\"cluster.v1\"].config.*[*].hosts[@.id = \"a\"]")
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		[]*PathPatternSegment{
+			{FieldName: "cluster.v1"},
+			{FieldName: "config"},
+			{AnyFieldName: true},
+			{AnyIndex: true},
+			{FieldName: "hosts"},
+			{ArrayItemSelector: true},
+		},
+		segments,
+	)
+}
+
 func fixtureMappingNode1() (*MappingNode, string) {
 	endpoint := "https://sfg94832-api.example.com"
 	return &MappingNode{
@@ -510,3 +571,53 @@ func fixtureCompoundSelectorNode() *MappingNode {
 func TestMappingPathsTestSuite(t *testing.T) {
 	suite.Run(t, new(MappingPathsTestSuite))
 }
+
+func fixtureMappingNodeWithSourceMeta() *MappingNode {
+	apiKey := "secret"
+	firstLayer := "layer-1"
+	secondLayer := "layer-2"
+	return &MappingNode{
+		Fields: map[string]*MappingNode{
+			"environment": {
+				Fields: map[string]*MappingNode{
+					"variables": {
+						Fields: map[string]*MappingNode{
+							"API_KEY": {
+								Scalar: &ScalarValue{StringValue: &apiKey},
+								SourceMeta: &source.Meta{
+									Position: source.Position{Line: 5, Column: 16},
+								},
+							},
+						},
+						FieldsSourceMeta: map[string]*source.Meta{
+							"API_KEY": {Position: source.Position{Line: 5, Column: 7}},
+						},
+					},
+				},
+				FieldsSourceMeta: map[string]*source.Meta{
+					"variables": {Position: source.Position{Line: 4, Column: 5}},
+				},
+			},
+			"layers": {
+				Items: []*MappingNode{
+					{
+						Scalar: &ScalarValue{StringValue: &firstLayer},
+						SourceMeta: &source.Meta{
+							Position: source.Position{Line: 7, Column: 7},
+						},
+					},
+					{
+						Scalar: &ScalarValue{StringValue: &secondLayer},
+						SourceMeta: &source.Meta{
+							Position: source.Position{Line: 8, Column: 7},
+						},
+					},
+				},
+			},
+		},
+		FieldsSourceMeta: map[string]*source.Meta{
+			"environment": {Position: source.Position{Line: 3, Column: 3}},
+			"layers":      {Position: source.Position{Line: 6, Column: 3}},
+		},
+	}
+}
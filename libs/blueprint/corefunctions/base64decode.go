@@ -2,8 +2,6 @@ package corefunctions
 
 import (
 	"context"
-	"encoding/base64"
-	"fmt"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
@@ -80,10 +78,10 @@ func (f *Base64DecodeFunction) Call(
 		return nil, err
 	}
 
-	valueBytes, err := base64.StdEncoding.DecodeString(value)
+	valueBytes, err := DecodeBase64String(value)
 	if err != nil {
 		return nil, function.NewFuncCallError(
-			fmt.Sprintf("unable to decode base64 string: %s", err.Error()),
+			"unable to decode base64 string: "+err.Error(),
 			function.FuncCallErrorCodeInvalidInput,
 			input.CallContext.CallStackSnapshot(),
 		)
@@ -0,0 +1,122 @@
+package corefunctions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// CIDRHostFunction provides the implementation of
+// a function that calculates a host IP address within a given CIDR block.
+type CIDRHostFunction struct {
+	definition *function.Definition
+}
+
+// NewCIDRHostFunction creates a new instance of the CIDRHostFunction with
+// a complete function definition.
+func NewCIDRHostFunction() provider.Function {
+	return &CIDRHostFunction{
+		definition: &function.Definition{
+			Description: "A function that calculates a host IP address within a given CIDR block.",
+			FormattedDescription: "A function that calculates a host IP address within a given CIDR block.\n\n" +
+				"**Examples:**\n\n" +
+				"Calculating host addresses:\n" +
+				"```\n${cidrhost(\"10.12.112.0/20\", 16)}   # Returns \"10.12.112.16\"\n" +
+				"${cidrhost(\"10.12.112.0/20\", 268)}  # Returns \"10.12.113.12\"\n" +
+				"${cidrhost(\"10.12.112.0/20\", -1)}   # Returns \"10.12.127.255\"\n```\n\n" +
+				"Host address within a calculated subnet:\n" +
+				"```\n${cidrhost(cidrsubnet(variables.vpcCidr, 8, 2), 5)}\n```",
+			Parameters: []function.Parameter{
+				&function.ScalarParameter{
+					Label: "prefix",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The CIDR block to calculate the host address within (e.g., \"10.12.112.0/20\").",
+				},
+				&function.ScalarParameter{
+					Label: "hostnum",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "integer",
+						Type:  function.ValueTypeInt64,
+					},
+					Description: "The host number within the CIDR block (0-based index), " +
+						"negative numbers count back from the end of the block where -1 is the last address.",
+				},
+			},
+			Return: &function.ScalarReturn{
+				Type: &function.ValueTypeDefinitionScalar{
+					Label: "string",
+					Type:  function.ValueTypeString,
+				},
+				Description: "The host IP address without a prefix length (e.g., \"10.12.112.16\").",
+			},
+		},
+	}
+}
+
+func (f *CIDRHostFunction) GetDefinition(
+	ctx context.Context,
+	input *provider.FunctionGetDefinitionInput,
+) (*provider.FunctionGetDefinitionOutput, error) {
+	return &provider.FunctionGetDefinitionOutput{
+		Definition: f.definition,
+	}, nil
+}
+
+func (f *CIDRHostFunction) Call(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	var prefix string
+	if err := input.Arguments.GetVar(ctx, 0, &prefix); err != nil {
+		return nil, err
+	}
+
+	var hostnum int
+	if err := input.Arguments.GetVar(ctx, 1, &hostnum); err != nil {
+		return nil, err
+	}
+
+	ipNet, err := parseCIDRPrefix(input, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixLen, maxBits := ipNet.Mask.Size()
+	// The number of addresses is calculated as a big integer as there can be
+	// up to 2^128 addresses in an IPv6 block.
+	numAddresses := new(big.Int).Lsh(big.NewInt(1), uint(maxBits-prefixLen))
+	hostnumInt := big.NewInt(int64(hostnum))
+	offset := hostnumInt
+	if hostnum < 0 {
+		offset = new(big.Int).Add(numAddresses, hostnumInt)
+	}
+
+	if offset.Sign() < 0 || offset.Cmp(numAddresses) >= 0 {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf(
+				"hostnum %d out of range for the prefix %q (must be between -%s and %s)",
+				hostnum,
+				prefix,
+				numAddresses.String(),
+				new(big.Int).Sub(numAddresses, big.NewInt(1)).String(),
+			),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	hostIP := cidrIntToIP(
+		new(big.Int).Add(cidrNetworkToInt(ipNet), offset),
+		ipNet,
+	)
+
+	return &provider.FunctionCallOutput{
+		ResponseData: hostIP.String(),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	. "gopkg.in/check.v1"
+)
+
+type CIDRHostFunctionTestSuite struct {
+	callStack   function.Stack
+	callContext *functionCallContextMock
+}
+
+var _ = Suite(&CIDRHostFunctionTestSuite{})
+
+func (s *CIDRHostFunctionTestSuite) SetUpTest(c *C) {
+	s.callStack = function.NewStack()
+	s.callContext = &functionCallContextMock{
+		params: &core.ParamsImpl{},
+		registry: &internal.FunctionRegistryMock{
+			Functions: map[string]provider.Function{},
+		},
+		callStack: s.callStack,
+	}
+}
+
+func (s *CIDRHostFunctionTestSuite) Test_calculates_ipv4_host(c *C) {
+	output, err := s.callCIDRHost("10.12.112.0/20", 268)
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "10.12.113.12")
+}
+
+func (s *CIDRHostFunctionTestSuite) Test_calculates_ipv4_host_from_end_of_block(c *C) {
+	output, err := s.callCIDRHost("10.12.112.0/20", -1)
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "10.12.127.255")
+}
+
+func (s *CIDRHostFunctionTestSuite) Test_calculates_host_ignoring_host_bits_in_prefix(c *C) {
+	output, err := s.callCIDRHost("10.0.0.5/24", 10)
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "10.0.0.10")
+}
+
+func (s *CIDRHostFunctionTestSuite) Test_calculates_ipv6_host(c *C) {
+	output, err := s.callCIDRHost("fd00:fd12:3456:7890::/56", 34)
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "fd00:fd12:3456:7800::22")
+}
+
+func (s *CIDRHostFunctionTestSuite) Test_returns_error_for_invalid_prefix(c *C) {
+	_, err := s.callCIDRHost("10.0.0.0/33", 1)
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"invalid CIDR prefix \"10.0.0.0/33\", expected an IPv4 or IPv6 address "+
+			"followed by a prefix length (e.g. \"10.0.0.0/16\")",
+	)
+	c.Assert(funcErr.CallStack, DeepEquals, []*function.Call{
+		{
+			FunctionName: "cidrhost",
+		},
+	})
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *CIDRHostFunctionTestSuite) Test_returns_error_for_hostnum_out_of_range(c *C) {
+	_, err := s.callCIDRHost("192.168.1.0/24", 256)
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"hostnum 256 out of range for the prefix \"192.168.1.0/24\" (must be between -256 and 255)",
+	)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *CIDRHostFunctionTestSuite) Test_returns_error_for_negative_hostnum_out_of_range(c *C) {
+	_, err := s.callCIDRHost("192.168.1.0/24", -257)
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *CIDRHostFunctionTestSuite) callCIDRHost(prefix string, hostnum int) (*provider.FunctionCallOutput, error) {
+	cidrhostFunc := NewCIDRHostFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "cidrhost",
+	})
+	return cidrhostFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args: []any{
+				prefix,
+				hostnum,
+			},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+}
@@ -0,0 +1,86 @@
+package corefunctions
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// CIDRNetmaskFunction provides the implementation of
+// a function that converts an IPv4 CIDR block to a subnet mask.
+type CIDRNetmaskFunction struct {
+	definition *function.Definition
+}
+
+// NewCIDRNetmaskFunction creates a new instance of the CIDRNetmaskFunction with
+// a complete function definition.
+func NewCIDRNetmaskFunction() provider.Function {
+	return &CIDRNetmaskFunction{
+		definition: &function.Definition{
+			Description: "A function that converts an IPv4 CIDR block to a subnet mask in dotted decimal notation.",
+			FormattedDescription: "A function that converts an IPv4 CIDR block to a subnet mask in dotted decimal notation.\n\n" +
+				"IPv6 prefixes are not supported as subnet masks are not used with IPv6.\n\n" +
+				"**Examples:**\n\n" +
+				"```\n${cidrnetmask(\"172.16.0.0/12\")}  # Returns \"255.240.0.0\"\n```",
+			Parameters: []function.Parameter{
+				&function.ScalarParameter{
+					Label: "prefix",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The IPv4 CIDR block to convert to a subnet mask (e.g., \"172.16.0.0/12\").",
+				},
+			},
+			Return: &function.ScalarReturn{
+				Type: &function.ValueTypeDefinitionScalar{
+					Label: "string",
+					Type:  function.ValueTypeString,
+				},
+				Description: "The subnet mask in dotted decimal notation (e.g., \"255.240.0.0\").",
+			},
+		},
+	}
+}
+
+func (f *CIDRNetmaskFunction) GetDefinition(
+	ctx context.Context,
+	input *provider.FunctionGetDefinitionInput,
+) (*provider.FunctionGetDefinitionOutput, error) {
+	return &provider.FunctionGetDefinitionOutput{
+		Definition: f.definition,
+	}, nil
+}
+
+func (f *CIDRNetmaskFunction) Call(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	var prefix string
+	if err := input.Arguments.GetVar(ctx, 0, &prefix); err != nil {
+		return nil, err
+	}
+
+	ipNet, err := parseCIDRPrefix(input, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isIPv4Network(ipNet) {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf(
+				"cidrnetmask only supports IPv4 prefixes, %q is an IPv6 prefix",
+				prefix,
+			),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	return &provider.FunctionCallOutput{
+		ResponseData: net.IP(ipNet.Mask).String(),
+	}, nil
+}
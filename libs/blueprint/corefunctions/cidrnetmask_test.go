@@ -0,0 +1,84 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	. "gopkg.in/check.v1"
+)
+
+type CIDRNetmaskFunctionTestSuite struct {
+	callStack   function.Stack
+	callContext *functionCallContextMock
+}
+
+var _ = Suite(&CIDRNetmaskFunctionTestSuite{})
+
+func (s *CIDRNetmaskFunctionTestSuite) SetUpTest(c *C) {
+	s.callStack = function.NewStack()
+	s.callContext = &functionCallContextMock{
+		params: &core.ParamsImpl{},
+		registry: &internal.FunctionRegistryMock{
+			Functions: map[string]provider.Function{},
+		},
+		callStack: s.callStack,
+	}
+}
+
+func (s *CIDRNetmaskFunctionTestSuite) Test_converts_ipv4_prefix_to_netmask(c *C) {
+	output, err := s.callCIDRNetmask("172.16.0.0/12")
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "255.240.0.0")
+}
+
+func (s *CIDRNetmaskFunctionTestSuite) Test_converts_host_prefix_to_netmask(c *C) {
+	output, err := s.callCIDRNetmask("10.1.2.3/32")
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "255.255.255.255")
+}
+
+func (s *CIDRNetmaskFunctionTestSuite) Test_returns_error_for_ipv6_prefix(c *C) {
+	_, err := s.callCIDRNetmask("fd00::/64")
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"cidrnetmask only supports IPv4 prefixes, \"fd00::/64\" is an IPv6 prefix",
+	)
+	c.Assert(funcErr.CallStack, DeepEquals, []*function.Call{
+		{
+			FunctionName: "cidrnetmask",
+		},
+	})
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *CIDRNetmaskFunctionTestSuite) Test_returns_error_for_invalid_prefix(c *C) {
+	_, err := s.callCIDRNetmask("172.16.0.0")
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(funcErr.Message, Matches, "invalid CIDR prefix \"172.16.0.0\".*")
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *CIDRNetmaskFunctionTestSuite) callCIDRNetmask(prefix string) (*provider.FunctionCallOutput, error) {
+	cidrnetmaskFunc := NewCIDRNetmaskFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "cidrnetmask",
+	})
+	return cidrnetmaskFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args: []any{
+				prefix,
+			},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"net"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
@@ -90,81 +89,62 @@ func (f *CIDRSubnetFunction) Call(
 		return nil, err
 	}
 
-	// Parse the CIDR block
-	_, ipNet, err := net.ParseCIDR(prefix)
+	ipNet, err := parseCIDRPrefix(input, prefix)
 	if err != nil {
-		return nil, fmt.Errorf("invalid CIDR block %q: %w", prefix, err)
+		return nil, err
 	}
 
-	// Get the current prefix length
-	prefixLen, _ := ipNet.Mask.Size()
-	newPrefixLen := prefixLen + newbits
-
-	// Validate newbits
 	if newbits < 0 {
-		return nil, fmt.Errorf("newbits must be non-negative, got %d", newbits)
-	}
-
-	// Determine if this is an IPv4 or IPv6 address
-	ipv4 := ipNet.IP.To4() != nil
-	maxBits := 32
-	if !ipv4 {
-		maxBits = 128
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf("newbits must be non-negative, got %d", newbits),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
 	}
 
+	prefixLen, maxBits := ipNet.Mask.Size()
+	newPrefixLen := prefixLen + newbits
 	if newPrefixLen > maxBits {
-		return nil, fmt.Errorf("new prefix length %d exceeds maximum of %d for %s", newPrefixLen, maxBits, map[bool]string{true: "IPv4", false: "IPv6"}[ipv4])
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf(
+				"new prefix length %d exceeds maximum of %d for %s, "+
+					"newbits can be at most %d for the prefix %q",
+				newPrefixLen,
+				maxBits,
+				cidrIPVersionLabel(ipNet),
+				maxBits-prefixLen,
+				prefix,
+			),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
 	}
 
-	// Calculate the maximum number of subnets
-	maxSubnets := 1 << newbits
-	if netnum < 0 || netnum >= maxSubnets {
-		return nil, fmt.Errorf("netnum %d out of range (must be 0-%d)", netnum, maxSubnets-1)
+	// The number of subnets is calculated as a big integer as newbits
+	// can be up to 128 for IPv6 prefixes.
+	maxSubnets := new(big.Int).Lsh(big.NewInt(1), uint(newbits))
+	netnumInt := big.NewInt(int64(netnum))
+	if netnum < 0 || netnumInt.Cmp(maxSubnets) >= 0 {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf(
+				"netnum %d out of range (must be 0-%s)",
+				netnum,
+				new(big.Int).Sub(maxSubnets, big.NewInt(1)).String(),
+			),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
 	}
 
-	// Convert IP to big.Int for manipulation
-	ip := ipNet.IP
-	if ipv4 {
-		ip = ip.To4()
-	} else {
-		ip = ip.To16()
-	}
-
-	ipInt := new(big.Int).SetBytes(ip)
-
-	// Calculate the subnet increment
-	// The increment is the number of addresses per subnet (2^(maxBits-newPrefixLen))
-	// We need to add netnum * increment to the base network address
-	bitsToShift := maxBits - newPrefixLen
-	increment := new(big.Int).Lsh(big.NewInt(1), uint(bitsToShift))
-	offset := new(big.Int).Mul(big.NewInt(int64(netnum)), increment)
-
-	// Add the offset to get the new subnet address
-	newIPInt := new(big.Int).Add(ipInt, offset)
-
-	// Convert back to IP
-	var newIP net.IP
-	ipBytes := newIPInt.Bytes()
-
-	if ipv4 {
-		// For IPv4, ensure we have exactly 4 bytes
-		newIP = make(net.IP, 4)
-		if len(ipBytes) <= 4 {
-			copy(newIP[4-len(ipBytes):], ipBytes)
-		} else {
-			return nil, fmt.Errorf("calculated IP address exceeds IPv4 range")
-		}
-	} else {
-		// For IPv6, ensure we have exactly 16 bytes
-		newIP = make(net.IP, 16)
-		if len(ipBytes) <= 16 {
-			copy(newIP[16-len(ipBytes):], ipBytes)
-		} else {
-			return nil, fmt.Errorf("calculated IP address exceeds IPv6 range")
-		}
-	}
+	// The increment is the number of addresses per subnet (2^(maxBits-newPrefixLen)),
+	// the subnet address is the base network address plus netnum * increment.
+	increment := new(big.Int).Lsh(big.NewInt(1), uint(maxBits-newPrefixLen))
+	offset := new(big.Int).Mul(netnumInt, increment)
+	newIP := cidrIntToIP(
+		new(big.Int).Add(cidrNetworkToInt(ipNet), offset),
+		ipNet,
+	)
 
-	// Create the new CIDR notation
 	result := fmt.Sprintf("%s/%d", newIP.String(), newPrefixLen)
 
 	return &provider.FunctionCallOutput{
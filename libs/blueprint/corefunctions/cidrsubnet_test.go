@@ -108,6 +108,26 @@ func (s *CIDRSubnetFunctionTestSuite) Test_calculates_ipv6_subnet(c *C) {
 	c.Assert(output.ResponseData, Equals, "fd00::100:0:0:0/72")
 }
 
+func (s *CIDRSubnetFunctionTestSuite) Test_calculates_ipv6_subnet_with_large_newbits(c *C) {
+	cidrsubnetFunc := NewCIDRSubnetFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "cidrsubnet",
+	})
+	output, err := cidrsubnetFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args: []any{
+				"fd00::/16",
+				80,
+				5,
+			},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "fd00::5:0:0/96")
+}
+
 func (s *CIDRSubnetFunctionTestSuite) Test_returns_error_for_invalid_cidr(c *C) {
 	cidrsubnetFunc := NewCIDRSubnetFunction()
 	s.callStack.Push(&function.Call{
@@ -123,7 +143,10 @@ func (s *CIDRSubnetFunctionTestSuite) Test_returns_error_for_invalid_cidr(c *C)
 		},
 		CallContext: s.callContext,
 	})
-	c.Assert(err, ErrorMatches, "invalid CIDR block.*")
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(funcErr.Message, Matches, "invalid CIDR prefix \"invalid-cidr\".*")
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
 }
 
 func (s *CIDRSubnetFunctionTestSuite) Test_returns_error_for_negative_newbits(c *C) {
@@ -141,7 +164,10 @@ func (s *CIDRSubnetFunctionTestSuite) Test_returns_error_for_negative_newbits(c
 		},
 		CallContext: s.callContext,
 	})
-	c.Assert(err, ErrorMatches, "newbits must be non-negative.*")
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(funcErr.Message, Matches, "newbits must be non-negative.*")
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
 }
 
 func (s *CIDRSubnetFunctionTestSuite) Test_returns_error_for_netnum_out_of_range(c *C) {
@@ -159,7 +185,10 @@ func (s *CIDRSubnetFunctionTestSuite) Test_returns_error_for_netnum_out_of_range
 		},
 		CallContext: s.callContext,
 	})
-	c.Assert(err, ErrorMatches, "netnum .* out of range.*")
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(funcErr.Message, Matches, "netnum .* out of range.*")
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
 }
 
 func (s *CIDRSubnetFunctionTestSuite) Test_returns_error_for_prefix_overflow(c *C) {
@@ -177,5 +206,8 @@ func (s *CIDRSubnetFunctionTestSuite) Test_returns_error_for_prefix_overflow(c *
 		},
 		CallContext: s.callContext,
 	})
-	c.Assert(err, ErrorMatches, "new prefix length .* exceeds maximum.*")
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(funcErr.Message, Matches, "new prefix length .* exceeds maximum.*")
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
 }
@@ -39,6 +39,7 @@ func NewCWDFunction(resolveWorkingDir WorkingDirResolver) provider.Function {
 				},
 				Description: "The current working directory of the user.",
 			},
+			Volatility: function.VolatilityStable,
 		},
 	}
 }
@@ -72,6 +72,7 @@ func NewDateTimeFunction(clock core.Clock) provider.Function {
 				},
 				Description: "A string representing the current time in the requested format.",
 			},
+			Volatility: function.VolatilityVolatile,
 		},
 	}
 }
@@ -2,7 +2,6 @@ package corefunctions
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
@@ -29,6 +28,7 @@ func NewFileFunction(fileSourceRegistry provider.FileSourceRegistry) provider.Fu
 				"```\n${file(\"dist/function.zip\")}\n```\n\n" +
 				"Reading remote files:\n" +
 				"```\n${file(\"s3://my-bucket/certificates/server.pem\")}\n```\n\n" +
+				"Relative local paths are resolved against the directory of the current blueprint.\n\n" +
 				"**Note:** When the output of `file()` is used as the value of a blueprint element field, " +
 				"it will be automatically UTF-8 encoded by default.",
 			Parameters: []function.Parameter{
@@ -48,6 +48,7 @@ func NewFileFunction(fileSourceRegistry provider.FileSourceRegistry) provider.Fu
 				},
 				Description: "The raw binary data from the file.",
 			},
+			Volatility: function.VolatilityStable,
 		},
 	}
 }
@@ -73,13 +74,9 @@ func (f *FileFunction) Call(
 	// Use the file source registry to read the file
 	// This allows host applications to register custom handlers
 	// for different URI schemes (s3://, gs://, etc.)
-	data, err := f.fileSourceRegistry.ReadFile(ctx, path)
+	data, err := readFunctionFile(ctx, input, f.fileSourceRegistry, path)
 	if err != nil {
-		return nil, function.NewFuncCallError(
-			fmt.Sprintf("unable to read file at path %q: %s", path, err.Error()),
-			function.FuncCallErrorCodeFunctionCall,
-			input.CallContext.CallStackSnapshot(),
-		)
+		return nil, err
 	}
 
 	return &provider.FunctionCallOutput{
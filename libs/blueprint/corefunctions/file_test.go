@@ -111,6 +111,75 @@ func (s *FileFunctionTestSuite) Test_reads_empty_file(c *C) {
 	c.Assert(output.ResponseData, DeepEquals, []byte{})
 }
 
+func (s *FileFunctionTestSuite) Test_reads_file_relative_to_blueprint_directory(c *C) {
+	scriptsDir := filepath.Join(s.tempDir, "scripts")
+	err := os.Mkdir(scriptsDir, 0755)
+	c.Assert(err, IsNil)
+	content := []byte("#!/bin/bash\necho \"starting\"")
+	err = os.WriteFile(filepath.Join(scriptsDir, "user-data.sh"), content, 0644)
+	c.Assert(err, IsNil)
+
+	s.callContext.params = &core.ParamsImpl{
+		ContextVariables: map[string]*core.ScalarValue{
+			"__blueprintDir": core.ScalarFromString(s.tempDir),
+		},
+	}
+	fileFunc := NewFileFunction(provider.NewFileSourceRegistry())
+	s.callStack.Push(&function.Call{
+		FunctionName: "file",
+	})
+	output, err := fileFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args:    []any{"scripts/user-data.sh"},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, DeepEquals, content)
+}
+
+func (s *FileFunctionTestSuite) Test_returns_error_for_file_outside_allowed_roots(c *C) {
+	allowedDir := filepath.Join(s.tempDir, "blueprint")
+	err := os.Mkdir(allowedDir, 0755)
+	c.Assert(err, IsNil)
+	err = os.WriteFile(filepath.Join(s.tempDir, "secret.txt"), []byte("secret"), 0644)
+	c.Assert(err, IsNil)
+
+	s.callContext.params = &core.ParamsImpl{
+		ContextVariables: map[string]*core.ScalarValue{
+			"__blueprintDir": core.ScalarFromString(allowedDir),
+		},
+	}
+	fileFunc := NewFileFunction(
+		provider.NewRootRestrictedFileSourceRegistry(
+			provider.NewFileSourceRegistry(),
+			[]string{allowedDir},
+		),
+	)
+	s.callStack.Push(&function.Call{
+		FunctionName: "file",
+	})
+	_, err = fileFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args:    []any{"../secret.txt"},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Matches,
+		`unable to read file at path "../secret.txt": access denied, the file is not located in one of the allowed root directories.*`,
+	)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeFunctionCall)
+}
+
 func (s *FileFunctionTestSuite) Test_returns_error_for_nonexistent_file(c *C) {
 	fileFunc := NewFileFunction(provider.NewFileSourceRegistry())
 	s.callStack.Push(&function.Call{
@@ -21,8 +21,11 @@ func NewFilterFunction() provider.Function {
 		definition: &function.Definition{
 			Description: "Filters a list of values based on a predicate function.",
 			FormattedDescription: "Filters a list of values based on a predicate function.\n\n" +
+				"An element expression that resolves to a boolean can be passed in place of a function, " +
+				"where `elem` and `i` refer to the current item and index of the list.\n\n" +
 				"**Examples:**\n\n" +
-				"```\n${filter(\n  datasources.network.subnets,\n  has_prefix_g(\"subnet-402948-\")\n)}\n```",
+				"```\n${filter(\n  datasources.network.subnets,\n  has_prefix_g(\"subnet-402948-\")\n)}\n```\n\n" +
+				"```\n${filter(datasources.network.subnets, has_prefix(elem.id, \"subnet-402948-\"))}\n```",
 			Parameters: []function.Parameter{
 				&function.ListParameter{
 					Label: "items",
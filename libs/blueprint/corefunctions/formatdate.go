@@ -0,0 +1,295 @@
+package corefunctions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// FormatDateFunction provides the implementation of
+// a function that formats a timestamp using a format specification.
+type FormatDateFunction struct {
+	definition *function.Definition
+}
+
+// NewFormatDateFunction creates a new instance of the FormatDateFunction with
+// a complete function definition.
+func NewFormatDateFunction() provider.Function {
+	return &FormatDateFunction{
+		definition: &function.Definition{
+			Description: "A function that formats a timestamp in RFC3339 format " +
+				"using a format specification.\n\n" +
+				"The format specification is made up of the following sequences of letters " +
+				"that are replaced with parts of the timestamp:\n\n" +
+				"- \"YYYY\" - Four digit year. (e.g. 2023)\n" +
+				"- \"YY\" - Two digit year. (e.g. 23)\n" +
+				"- \"MMMM\" - Full name of the month. (e.g. September)\n" +
+				"- \"MMM\" - Abbreviated name of the month. (e.g. Sep)\n" +
+				"- \"MM\" - Two digit month. (e.g. 09)\n" +
+				"- \"M\" - Month without padding. (e.g. 9)\n" +
+				"- \"DD\" - Two digit day of the month. (e.g. 07)\n" +
+				"- \"D\" - Day of the month without padding. (e.g. 7)\n" +
+				"- \"EEEE\" - Full name of the day of the week. (e.g. Thursday)\n" +
+				"- \"EEE\" - Abbreviated name of the day of the week. (e.g. Thu)\n" +
+				"- \"hh\" - Two digit hour on a 24 hour clock. (e.g. 14)\n" +
+				"- \"h\" - Hour on a 24 hour clock without padding. (e.g. 14)\n" +
+				"- \"HH\" - Two digit hour on a 12 hour clock. (e.g. 02)\n" +
+				"- \"H\" - Hour on a 12 hour clock without padding. (e.g. 2)\n" +
+				"- \"AA\" - \"AM\" or \"PM\".\n" +
+				"- \"aa\" - \"am\" or \"pm\".\n" +
+				"- \"mm\" - Two digit minute. (e.g. 05)\n" +
+				"- \"m\" - Minute without padding. (e.g. 5)\n" +
+				"- \"ss\" - Two digit second. (e.g. 04)\n" +
+				"- \"s\" - Second without padding. (e.g. 4)\n" +
+				"- \"ZZZZZ\" - Timezone offset with a colon. (e.g. +00:00)\n" +
+				"- \"ZZZZ\" - Timezone offset without a colon. (e.g. +0000)\n" +
+				"- \"ZZZ\" - Timezone abbreviation. (e.g. UTC)\n" +
+				"- \"Z\" - Timezone offset in RFC3339 format, \"Z\" for UTC. (e.g. Z)\n\n" +
+				"Any other letters must be enclosed in single quotes to be included as literal text, " +
+				"two single quotes produce a literal single quote. " +
+				"Characters that are not letters are included as they are.",
+			FormattedDescription: "A function that formats a timestamp in RFC3339 format " +
+				"using a format specification.\n\n" +
+				"The format specification is made up of the following sequences of letters " +
+				"that are replaced with parts of the timestamp:\n\n" +
+				"- `YYYY` - Four digit year. (e.g. 2023)\n" +
+				"- `YY` - Two digit year. (e.g. 23)\n" +
+				"- `MMMM` - Full name of the month. (e.g. September)\n" +
+				"- `MMM` - Abbreviated name of the month. (e.g. Sep)\n" +
+				"- `MM` - Two digit month. (e.g. 09)\n" +
+				"- `M` - Month without padding. (e.g. 9)\n" +
+				"- `DD` - Two digit day of the month. (e.g. 07)\n" +
+				"- `D` - Day of the month without padding. (e.g. 7)\n" +
+				"- `EEEE` - Full name of the day of the week. (e.g. Thursday)\n" +
+				"- `EEE` - Abbreviated name of the day of the week. (e.g. Thu)\n" +
+				"- `hh` - Two digit hour on a 24 hour clock. (e.g. 14)\n" +
+				"- `h` - Hour on a 24 hour clock without padding. (e.g. 14)\n" +
+				"- `HH` - Two digit hour on a 12 hour clock. (e.g. 02)\n" +
+				"- `H` - Hour on a 12 hour clock without padding. (e.g. 2)\n" +
+				"- `AA` - `AM` or `PM`.\n" +
+				"- `aa` - `am` or `pm`.\n" +
+				"- `mm` - Two digit minute. (e.g. 05)\n" +
+				"- `m` - Minute without padding. (e.g. 5)\n" +
+				"- `ss` - Two digit second. (e.g. 04)\n" +
+				"- `s` - Second without padding. (e.g. 4)\n" +
+				"- `ZZZZZ` - Timezone offset with a colon. (e.g. +00:00)\n" +
+				"- `ZZZZ` - Timezone offset without a colon. (e.g. +0000)\n" +
+				"- `ZZZ` - Timezone abbreviation. (e.g. UTC)\n" +
+				"- `Z` - Timezone offset in RFC3339 format, `Z` for UTC. (e.g. Z)\n\n" +
+				"Any other letters must be enclosed in single quotes to be included as literal text, " +
+				"two single quotes produce a literal single quote. " +
+				"Characters that are not letters are included as they are.\n\n" +
+				"**Examples:**\n\n" +
+				"```\n${formatdate(\"YYYY-MM-DD\", \"2023-09-07T14:43:44Z\")}   # Returns \"2023-09-07\"\n" +
+				"${formatdate(\"EEE, DD MMM YYYY hh:mm:ss ZZZ\", \"2023-09-07T14:43:44Z\")}" +
+				"   # Returns \"Thu, 07 Sep 2023 14:43:44 UTC\"\n" +
+				"${formatdate(\"H:mmaa 'on' D MMMM\", \"2023-09-07T14:43:44Z\")}" +
+				"   # Returns \"2:43pm on 7 September\"\n```\n\n" +
+				"Tagging a resource with the deployment date:\n" +
+				"```\n${formatdate(\"YYYYMMDD\", now())}\n```",
+			Parameters: []function.Parameter{
+				&function.ScalarParameter{
+					Label: "format",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The format specification to format the timestamp with (e.g. \"YYYY-MM-DD\").",
+				},
+				&function.ScalarParameter{
+					Label: "timestamp",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The timestamp in RFC3339 format to format.",
+				},
+			},
+			Return: &function.ScalarReturn{
+				Type: &function.ValueTypeDefinitionScalar{
+					Label: "string",
+					Type:  function.ValueTypeString,
+				},
+				Description: "The timestamp formatted with the format specification, " +
+					"the timestamp is formatted in its own timezone offset.",
+			},
+		},
+	}
+}
+
+func (f *FormatDateFunction) GetDefinition(
+	ctx context.Context,
+	input *provider.FunctionGetDefinitionInput,
+) (*provider.FunctionGetDefinitionOutput, error) {
+	return &provider.FunctionGetDefinitionOutput{
+		Definition: f.definition,
+	}, nil
+}
+
+func (f *FormatDateFunction) Call(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	var format string
+	if err := input.Arguments.GetVar(ctx, 0, &format); err != nil {
+		return nil, err
+	}
+
+	var timestamp string
+	if err := input.Arguments.GetVar(ctx, 1, &timestamp); err != nil {
+		return nil, err
+	}
+
+	parsedTime, err := parseRFC3339Timestamp(input, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := formatDate(format, parsedTime)
+	if err != nil {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf("invalid format specification %q: %s", format, err.Error()),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	return &provider.FunctionCallOutput{
+		ResponseData: output,
+	}, nil
+}
+
+func formatDate(format string, timestamp time.Time) (string, error) {
+	chars := []rune(format)
+	var builder strings.Builder
+	i := 0
+	for i < len(chars) {
+		char := chars[i]
+		switch {
+		case char == '\'':
+			literal, next, err := readQuotedLiteral(chars, i)
+			if err != nil {
+				return "", err
+			}
+			builder.WriteString(literal)
+			i = next
+		case unicode.IsLetter(char):
+			end := i
+			for end < len(chars) && chars[end] == char {
+				end += 1
+			}
+			sequence := string(chars[i:end])
+			formatted, err := formatDateSequence(sequence, timestamp)
+			if err != nil {
+				return "", err
+			}
+			builder.WriteString(formatted)
+			i = end
+		default:
+			builder.WriteRune(char)
+			i += 1
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// Reads literal text enclosed in single quotes starting at the provided
+// position of an opening quote, returning the literal text and the position
+// after the closing quote.
+// Two single quotes in a row produce a literal single quote, both inside
+// and outside of quoted text.
+func readQuotedLiteral(chars []rune, start int) (string, int, error) {
+	if start+1 < len(chars) && chars[start+1] == '\'' {
+		return "'", start + 2, nil
+	}
+
+	var builder strings.Builder
+	i := start + 1
+	for i < len(chars) {
+		if chars[i] == '\'' {
+			if i+1 < len(chars) && chars[i+1] == '\'' {
+				builder.WriteRune('\'')
+				i += 2
+				continue
+			}
+			return builder.String(), i + 1, nil
+		}
+		builder.WriteRune(chars[i])
+		i += 1
+	}
+
+	return "", 0, fmt.Errorf("unterminated quoted text starting at offset %d", start)
+}
+
+func formatDateSequence(sequence string, timestamp time.Time) (string, error) {
+	switch sequence {
+	case "YYYY":
+		return fmt.Sprintf("%04d", timestamp.Year()), nil
+	case "YY":
+		return fmt.Sprintf("%02d", timestamp.Year()%100), nil
+	case "MMMM":
+		return timestamp.Month().String(), nil
+	case "MMM":
+		return timestamp.Month().String()[:3], nil
+	case "MM":
+		return fmt.Sprintf("%02d", int(timestamp.Month())), nil
+	case "M":
+		return fmt.Sprintf("%d", int(timestamp.Month())), nil
+	case "DD":
+		return fmt.Sprintf("%02d", timestamp.Day()), nil
+	case "D":
+		return fmt.Sprintf("%d", timestamp.Day()), nil
+	case "EEEE":
+		return timestamp.Weekday().String(), nil
+	case "EEE":
+		return timestamp.Weekday().String()[:3], nil
+	case "hh":
+		return fmt.Sprintf("%02d", timestamp.Hour()), nil
+	case "h":
+		return fmt.Sprintf("%d", timestamp.Hour()), nil
+	case "HH":
+		return fmt.Sprintf("%02d", twelveHourClockHour(timestamp)), nil
+	case "H":
+		return fmt.Sprintf("%d", twelveHourClockHour(timestamp)), nil
+	case "AA":
+		return timestamp.Format("PM"), nil
+	case "aa":
+		return timestamp.Format("pm"), nil
+	case "mm":
+		return fmt.Sprintf("%02d", timestamp.Minute()), nil
+	case "m":
+		return fmt.Sprintf("%d", timestamp.Minute()), nil
+	case "ss":
+		return fmt.Sprintf("%02d", timestamp.Second()), nil
+	case "s":
+		return fmt.Sprintf("%d", timestamp.Second()), nil
+	case "ZZZZZ":
+		return timestamp.Format("-07:00"), nil
+	case "ZZZZ":
+		return timestamp.Format("-0700"), nil
+	case "ZZZ":
+		return timestamp.Format("MST"), nil
+	case "Z":
+		return timestamp.Format("Z07:00"), nil
+	}
+
+	return "", fmt.Errorf(
+		"unsupported sequence %q, literal text must be enclosed in single quotes",
+		sequence,
+	)
+}
+
+func twelveHourClockHour(timestamp time.Time) int {
+	hour := timestamp.Hour() % 12
+	if hour == 0 {
+		return 12
+	}
+
+	return hour
+}
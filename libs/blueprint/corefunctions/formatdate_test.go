@@ -0,0 +1,121 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	. "gopkg.in/check.v1"
+)
+
+type FormatDateFunctionTestSuite struct {
+	callStack   function.Stack
+	callContext *functionCallContextMock
+}
+
+var _ = Suite(&FormatDateFunctionTestSuite{})
+
+func (s *FormatDateFunctionTestSuite) SetUpTest(c *C) {
+	s.callStack = function.NewStack()
+	s.callContext = &functionCallContextMock{
+		params: &core.ParamsImpl{},
+		registry: &internal.FunctionRegistryMock{
+			Functions: map[string]provider.Function{},
+		},
+		callStack: s.callStack,
+	}
+}
+
+func (s *FormatDateFunctionTestSuite) Test_formats_date(c *C) {
+	output, err := s.callFormatDate("YYYY-MM-DD", "2023-09-07T14:43:44Z")
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "2023-09-07")
+}
+
+func (s *FormatDateFunctionTestSuite) Test_formats_date_with_names_and_timezone(c *C) {
+	output, err := s.callFormatDate("EEE, DD MMM YYYY hh:mm:ss ZZZ", "2023-09-07T14:43:44Z")
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "Thu, 07 Sep 2023 14:43:44 UTC")
+}
+
+func (s *FormatDateFunctionTestSuite) Test_formats_date_with_twelve_hour_clock_and_literals(c *C) {
+	output, err := s.callFormatDate("H:mmaa 'on' EEEE D MMMM YY", "2023-09-07T14:03:44Z")
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "2:03pm on Thursday 7 September 23")
+}
+
+func (s *FormatDateFunctionTestSuite) Test_formats_midnight_on_twelve_hour_clock(c *C) {
+	output, err := s.callFormatDate("HH AA", "2023-09-07T00:15:00Z")
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "12 AM")
+}
+
+func (s *FormatDateFunctionTestSuite) Test_formats_timezone_offsets(c *C) {
+	output, err := s.callFormatDate("Z ZZZZ ZZZZZ", "2023-09-07T14:43:44+05:30")
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "+05:30 +0530 +05:30")
+}
+
+func (s *FormatDateFunctionTestSuite) Test_formats_escaped_single_quotes(c *C) {
+	output, err := s.callFormatDate("'o''clock' h''", "2023-09-07T09:00:00Z")
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "o'clock 9'")
+}
+
+func (s *FormatDateFunctionTestSuite) Test_returns_error_for_unsupported_sequence(c *C) {
+	_, err := s.callFormatDate("YYYY-MM-DD at hh:mm", "2023-09-07T14:43:44Z")
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"invalid format specification \"YYYY-MM-DD at hh:mm\": unsupported sequence \"a\", "+
+			"literal text must be enclosed in single quotes",
+	)
+	c.Assert(funcErr.CallStack, DeepEquals, []*function.Call{
+		{
+			FunctionName: "formatdate",
+		},
+	})
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *FormatDateFunctionTestSuite) Test_returns_error_for_unterminated_quote(c *C) {
+	_, err := s.callFormatDate("YYYY 'year", "2023-09-07T14:43:44Z")
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"invalid format specification \"YYYY 'year\": unterminated quoted text starting at offset 5",
+	)
+}
+
+func (s *FormatDateFunctionTestSuite) Test_returns_error_for_invalid_timestamp(c *C) {
+	_, err := s.callFormatDate("YYYY", "2023-09-07")
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *FormatDateFunctionTestSuite) callFormatDate(format string, timestamp string) (*provider.FunctionCallOutput, error) {
+	formatDateFunc := NewFormatDateFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "formatdate",
+	})
+	return formatDateFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args: []any{
+				format,
+				timestamp,
+			},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+}
@@ -45,6 +45,7 @@ func NewHTTPResourceFunction() provider.Function {
 			Return: &function.ValueTypeDefinitionScalar{
 				Type: function.ValueTypeBytes,
 			},
+			Volatility: function.VolatilityStable,
 		},
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
@@ -1,9 +1,11 @@
 package corefunctions
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
@@ -60,8 +62,8 @@ func (f *JSONDecodeFunction) Call(
 		return nil, err
 	}
 
-	var output interface{}
-	err := json.Unmarshal([]byte(jsonStr), &output)
+	var rawJSON json.RawMessage
+	err := json.Unmarshal([]byte(jsonStr), &rawJSON)
 	if err != nil {
 		return nil, function.NewFuncCallError(
 			fmt.Sprintf("unable to decode json string: %s", err.Error()),
@@ -70,7 +72,41 @@ func (f *JSONDecodeFunction) Call(
 		)
 	}
 
+	// Numbers are decoded as json.Number to be able to preserve integers
+	// instead of treating all numbers as floats, this is important
+	// for decoded values to be used where integers are expected.
+	decoder := json.NewDecoder(bytes.NewReader(rawJSON))
+	decoder.UseNumber()
+	var output any
+	// The raw json has already been validated so decoding can not fail.
+	_ = decoder.Decode(&output)
+
 	return &provider.FunctionCallOutput{
-		ResponseData: output,
+		ResponseData: fromDecodedJSONNumbers(output),
 	}, nil
 }
+
+// Converts json numbers in a decoded value to integers when
+// they can be represented as integers, otherwise to floats.
+func fromDecodedJSONNumbers(value any) any {
+	switch typedValue := value.(type) {
+	case json.Number:
+		if intValue, err := strconv.Atoi(typedValue.String()); err == nil {
+			return intValue
+		}
+		floatValue, _ := typedValue.Float64()
+		return floatValue
+	case []any:
+		for i, item := range typedValue {
+			typedValue[i] = fromDecodedJSONNumbers(item)
+		}
+		return typedValue
+	case map[string]any:
+		for key, field := range typedValue {
+			typedValue[key] = fromDecodedJSONNumbers(field)
+		}
+		return typedValue
+	}
+
+	return value
+}
@@ -101,6 +101,29 @@ func (s *JSONDecodeFunctionTestSuite) Test_decodes_json_array(c *C) {
 	})
 }
 
+func (s *JSONDecodeFunctionTestSuite) Test_decodes_json_numbers_preserving_integers(c *C) {
+	jsonDecodeFunc := NewJSONDecodeFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "jsondecode",
+	})
+	output, err := jsonDecodeFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args: []any{
+				`{"port": 8080, "ratio": 0.75, "limits": [10, 1.5e3]}`,
+			},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, DeepEquals, map[string]any{
+		"port":   8080,
+		"ratio":  0.75,
+		"limits": []any{10, 1500.0},
+	})
+}
+
 func (s *JSONDecodeFunctionTestSuite) Test_returns_func_error_for_invalid_input(c *C) {
 	jsonDecodeFunc := NewJSONDecodeFunction()
 	s.callStack.Push(&function.Call{
@@ -0,0 +1,221 @@
+package corefunctions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// JSONEncodeFunction provides the implementation of
+// a function that encodes a value as a JSON string.
+type JSONEncodeFunction struct {
+	definition *function.Definition
+}
+
+// NewJSONEncodeFunction creates a new instance of the JSONEncodeFunction with
+// a complete function definition.
+func NewJSONEncodeFunction() provider.Function {
+	return &JSONEncodeFunction{
+		definition: &function.Definition{
+			Description: "Encodes a primitive value, array or mapping as a serialised json string.",
+			FormattedDescription: "Encodes a primitive value, array or mapping as a serialised json string. " +
+				"Keys of mappings are sorted in the encoded string so the output is the same for equivalent values.\n\n" +
+				"**Examples:**\n\n" +
+				"```\n${jsonencode(values.bucketPolicy)}\n```\n" +
+				"```\n${jsonencode(object(Version=\"2012-10-17\", Statement=values.policyStatements))}\n```",
+			Parameters: []function.Parameter{
+				&function.AnyParameter{
+					Label: "value",
+					UnionTypes: []function.ValueTypeDefinition{
+						&function.ValueTypeDefinitionScalar{
+							Label: "string",
+							Type:  function.ValueTypeString,
+						},
+						&function.ValueTypeDefinitionScalar{
+							Label: "integer",
+							Type:  function.ValueTypeInt64,
+						},
+						&function.ValueTypeDefinitionScalar{
+							Label: "float",
+							Type:  function.ValueTypeFloat64,
+						},
+						&function.ValueTypeDefinitionScalar{
+							Label: "boolean",
+							Type:  function.ValueTypeBool,
+						},
+						&function.ValueTypeDefinitionList{
+							Label: "array",
+							ElementType: &function.ValueTypeDefinitionAny{
+								Label: "any",
+								Type:  function.ValueTypeAny,
+							},
+						},
+						&function.ValueTypeDefinitionMap{
+							Label: "mapping",
+							ElementType: &function.ValueTypeDefinitionAny{
+								Label: "any",
+								Type:  function.ValueTypeAny,
+							},
+						},
+					},
+					Description: "A valid literal, reference or function call yielding the value to encode.",
+				},
+			},
+			Return: &function.ScalarReturn{
+				Type: &function.ValueTypeDefinitionScalar{
+					Label: "string",
+					Type:  function.ValueTypeString,
+				},
+				Description: "The value encoded as a json string.",
+			},
+		},
+	}
+}
+
+func (f *JSONEncodeFunction) GetDefinition(
+	ctx context.Context,
+	input *provider.FunctionGetDefinitionInput,
+) (*provider.FunctionGetDefinitionOutput, error) {
+	return &provider.FunctionGetDefinitionOutput{
+		Definition: f.definition,
+	}, nil
+}
+
+func (f *JSONEncodeFunction) Call(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	var value any
+	if err := input.Arguments.GetVar(ctx, 0, &value); err != nil {
+		return nil, err
+	}
+
+	// If input is none, propagate none
+	if core.IsNoneMarker(value) {
+		return &provider.FunctionCallOutput{
+			ResponseData: core.GetNoneMarker(),
+		}, nil
+	}
+
+	encodableValue, err := toJSONEncodableValue(value, "")
+	if err != nil {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf("input argument at index 0 can not be encoded as json, %s", err.Error()),
+			function.FuncCallErrorCodeInvalidArgumentType,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	// HTML characters are common in documents such as IAM policies
+	// and must be kept as they are.
+	encoder.SetEscapeHTML(false)
+	err = encoder.Encode(encodableValue)
+	if err != nil {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf("unable to encode value as json: %s", err.Error()),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	return &provider.FunctionCallOutput{
+		// The encoder adds a trailing new line after the encoded value.
+		ResponseData: string(bytes.TrimSuffix(buffer.Bytes(), []byte("\n"))),
+	}, nil
+}
+
+// Checks that a value passed into the jsonencode function only contains
+// primitives, arrays and mappings that can be represented in json,
+// converting none values nested in arrays and mappings to json nulls.
+func toJSONEncodableValue(value any, path string) (any, error) {
+	if value == nil || core.IsNoneMarker(value) {
+		return nil, nil
+	}
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Pointer:
+		if reflected.IsNil() {
+			return nil, nil
+		}
+		return toJSONEncodableValue(reflected.Elem().Interface(), path)
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Float32, reflect.Float64:
+		return value, nil
+	case reflect.Slice, reflect.Array:
+		if reflected.Type().Elem().Kind() == reflect.Uint8 {
+			return nil, errJSONEncodeUnsupportedValue(
+				"byte arrays are not supported, use base64encode to encode binary data",
+				path,
+			)
+		}
+		return toJSONEncodableItems(reflected, path)
+	case reflect.Map:
+		if reflected.Type().Key().Kind() != reflect.String {
+			return nil, errJSONEncodeUnsupportedValue(
+				"mappings must have string keys",
+				path,
+			)
+		}
+		return toJSONEncodableFields(reflected, path)
+	}
+
+	return nil, errJSONEncodeUnsupportedValue(
+		fmt.Sprintf("values of type %T are not supported", value),
+		path,
+	)
+}
+
+func toJSONEncodableItems(reflected reflect.Value, path string) (any, error) {
+	items := make([]any, reflected.Len())
+	for i := range reflected.Len() {
+		item, err := toJSONEncodableValue(
+			reflected.Index(i).Interface(),
+			fmt.Sprintf("%s[%d]", path, i),
+		)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = item
+	}
+
+	return items, nil
+}
+
+func toJSONEncodableFields(reflected reflect.Value, path string) (any, error) {
+	fields := map[string]any{}
+	iter := reflected.MapRange()
+	for iter.Next() {
+		key := iter.Key().String()
+		fieldPath := key
+		if path != "" {
+			fieldPath = fmt.Sprintf("%s.%s", path, key)
+		}
+
+		field, err := toJSONEncodableValue(iter.Value().Interface(), fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = field
+	}
+
+	return fields, nil
+}
+
+func errJSONEncodeUnsupportedValue(reason string, path string) error {
+	if path == "" {
+		return errors.New(reason)
+	}
+
+	return fmt.Errorf("%s (found at %q)", reason, path)
+}
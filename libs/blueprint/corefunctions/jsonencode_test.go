@@ -0,0 +1,144 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	. "gopkg.in/check.v1"
+)
+
+type JSONEncodeFunctionTestSuite struct {
+	callStack   function.Stack
+	callContext *functionCallContextMock
+}
+
+var _ = Suite(&JSONEncodeFunctionTestSuite{})
+
+func (s *JSONEncodeFunctionTestSuite) SetUpTest(c *C) {
+	s.callStack = function.NewStack()
+	s.callContext = &functionCallContextMock{
+		params: &core.ParamsImpl{},
+		registry: &internal.FunctionRegistryMock{
+			Functions: map[string]provider.Function{},
+			CallStack: s.callStack,
+		},
+		callStack: s.callStack,
+	}
+}
+
+func (s *JSONEncodeFunctionTestSuite) Test_encodes_mapping(c *C) {
+	output, err := s.callJSONEncode(map[string]any{
+		"Version": "2012-10-17",
+		"Statement": []any{
+			map[string]any{
+				"Effect":    "Allow",
+				"Action":    []any{"s3:GetObject"},
+				"Condition": map[string]any{"NumericLessThan": map[string]any{"s3:max-keys": int64(10)}},
+				"Resource":  "arn:aws:s3:::orders/*",
+			},
+		},
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, `{"Statement":[{"Action":["s3:GetObject"],`+
+		`"Condition":{"NumericLessThan":{"s3:max-keys":10}},"Effect":"Allow",`+
+		`"Resource":"arn:aws:s3:::orders/*"}],"Version":"2012-10-17"}`)
+}
+
+func (s *JSONEncodeFunctionTestSuite) Test_encodes_primitive_without_escaping_html_characters(c *C) {
+	output, err := s.callJSONEncode("<orders> & <payments>")
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, `"<orders> & <payments>"`)
+}
+
+func (s *JSONEncodeFunctionTestSuite) Test_encodes_nested_none_values_as_null(c *C) {
+	output, err := s.callJSONEncode([]any{1.5, core.GetNoneMarker(), true})
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, `[1.5,null,true]`)
+}
+
+func (s *JSONEncodeFunctionTestSuite) Test_propagates_none_value(c *C) {
+	output, err := s.callJSONEncode(core.GetNoneMarker())
+
+	c.Assert(err, IsNil)
+	c.Assert(core.IsNoneMarker(output.ResponseData), Equals, true)
+}
+
+func (s *JSONEncodeFunctionTestSuite) Test_round_trips_value_decoded_by_jsondecode(c *C) {
+	jsonStr := `{"ports":[8080,8443],"ratio":0.75,"tags":{"env":"prod"}}`
+	jsonDecodeFunc := NewJSONDecodeFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "jsondecode",
+	})
+	decoded, err := jsonDecodeFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args:    []any{jsonStr},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+	c.Assert(err, IsNil)
+
+	output, err := s.callJSONEncode(decoded.ResponseData)
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, jsonStr)
+}
+
+func (s *JSONEncodeFunctionTestSuite) Test_returns_func_error_for_byte_array(c *C) {
+	_, err := s.callJSONEncode(map[string]any{
+		"certificates": []any{[]byte("certificate")},
+	})
+
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"input argument at index 0 can not be encoded as json, byte arrays are not supported, "+
+			"use base64encode to encode binary data (found at \"certificates[0]\")",
+	)
+	c.Assert(funcErr.CallStack, DeepEquals, []*function.Call{
+		{
+			FunctionName: "jsonencode",
+		},
+	})
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidArgumentType)
+}
+
+func (s *JSONEncodeFunctionTestSuite) Test_returns_func_error_for_function_value(c *C) {
+	_, err := s.callJSONEncode(provider.FunctionRuntimeInfo{
+		FunctionName: "trim",
+	})
+
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"input argument at index 0 can not be encoded as json, "+
+			"values of type provider.FunctionRuntimeInfo are not supported",
+	)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidArgumentType)
+}
+
+func (s *JSONEncodeFunctionTestSuite) callJSONEncode(value any) (*provider.FunctionCallOutput, error) {
+	jsonEncodeFunc := NewJSONEncodeFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "jsonencode",
+	})
+	return jsonEncodeFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args:    []any{value},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+}
@@ -0,0 +1,81 @@
+package corefunctions
+
+import (
+	"context"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// NowFunction provides the implementation of
+// a function that gets the current date/time in RFC3339 format.
+// When changes are being staged or deployed for a blueprint instance,
+// the time at which the changes were staged is used instead of the
+// host system's clock so the value is the same in the staged changes
+// and the deployed resources.
+type NowFunction struct {
+	definition *function.Definition
+	clock      core.Clock
+}
+
+// NewNowFunction creates a new instance of the NowFunction with
+// a complete function definition.
+func NewNowFunction(clock core.Clock) provider.Function {
+	return &NowFunction{
+		clock: clock,
+		definition: &function.Definition{
+			Description: "A function that returns the current date/time in RFC3339 format normalised to UTC.\n\n" +
+				"When changes are staged for a blueprint instance, the time at which change staging started " +
+				"is used for every call to \"now\" and is recorded in the staged changes, the same value is used " +
+				"when the changes are deployed so a deployment never produces a different value to the one " +
+				"presented in the staged changes.\n\n" +
+				"This function is useful for tagging resources with deployment timestamps, " +
+				"the \"formatdate\" and \"timeadd\" functions can be used to format and offset the value.",
+			FormattedDescription: "A function that returns the current date/time in RFC3339 format normalised to UTC.\n\n" +
+				"When changes are staged for a blueprint instance, the time at which change staging started " +
+				"is used for every call to `now` and is recorded in the staged changes, the same value is used " +
+				"when the changes are deployed so a deployment never produces a different value to the one " +
+				"presented in the staged changes.\n\n" +
+				"This function is useful for tagging resources with deployment timestamps, " +
+				"the `formatdate` and `timeadd` functions can be used to format and offset the value.\n\n" +
+				"**Examples:**\n\n" +
+				"```\n${now()}\n```\n\n" +
+				"Tagging a resource with the deployment date:\n" +
+				"```\n${formatdate(\"YYYY-MM-DD\", now())}\n```",
+			Parameters: []function.Parameter{},
+			Return: &function.ScalarReturn{
+				Type: &function.ValueTypeDefinitionScalar{
+					Label: "string",
+					Type:  function.ValueTypeString,
+				},
+				Description: "The current date/time in RFC3339 format. (e.g. 2023-09-07T14:43:44Z)",
+			},
+			Volatility: function.VolatilityVolatile,
+		},
+	}
+}
+
+func (f *NowFunction) GetDefinition(
+	ctx context.Context,
+	input *provider.FunctionGetDefinitionInput,
+) (*provider.FunctionGetDefinitionOutput, error) {
+	return &provider.FunctionGetDefinitionOutput{
+		Definition: f.definition,
+	}, nil
+}
+
+func (f *NowFunction) Call(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	now, isStaging := core.StagingTimestampFromContext(ctx)
+	if !isStaging {
+		now = f.clock.Now()
+	}
+
+	return &provider.FunctionCallOutput{
+		ResponseData: now.UTC().Format(time.RFC3339),
+	}, nil
+}
@@ -0,0 +1,68 @@
+package corefunctions
+
+import (
+	"context"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/mockclock"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	. "gopkg.in/check.v1"
+)
+
+type NowFunctionTestSuite struct {
+	callStack   function.Stack
+	callContext *functionCallContextMock
+}
+
+var _ = Suite(&NowFunctionTestSuite{})
+
+func (s *NowFunctionTestSuite) SetUpTest(c *C) {
+	s.callStack = function.NewStack()
+	s.callContext = &functionCallContextMock{
+		params: &core.ParamsImpl{},
+		registry: &internal.FunctionRegistryMock{
+			Functions: map[string]provider.Function{},
+		},
+		callStack: s.callStack,
+	}
+}
+
+func (s *NowFunctionTestSuite) Test_gets_current_time_from_clock(c *C) {
+	output, err := s.callNow(context.TODO())
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "2023-09-07T14:43:44Z")
+}
+
+func (s *NowFunctionTestSuite) Test_uses_staging_timestamp_from_context(c *C) {
+	ctx := core.WithStagingTimestamp(
+		context.TODO(),
+		time.Date(2024, time.March, 1, 9, 30, 0, 0, time.FixedZone("CET", 3600)),
+	)
+	output, err := s.callNow(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "2024-03-01T08:30:00Z")
+}
+
+func (s *NowFunctionTestSuite) Test_is_volatile(c *C) {
+	nowFunc := NewNowFunction(&mockclock.StaticClock{})
+	output, err := nowFunc.GetDefinition(context.TODO(), &provider.FunctionGetDefinitionInput{})
+	c.Assert(err, IsNil)
+	c.Assert(output.Definition.IsVolatile(), Equals, true)
+}
+
+func (s *NowFunctionTestSuite) callNow(ctx context.Context) (*provider.FunctionCallOutput, error) {
+	nowFunc := NewNowFunction(&mockclock.StaticClock{})
+	s.callStack.Push(&function.Call{
+		FunctionName: "now",
+	})
+	return nowFunc.Call(ctx, &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args:    []any{},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+}
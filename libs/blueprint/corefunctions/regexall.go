@@ -0,0 +1,99 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// RegexAllFunction provides the implementation of
+// a function that extracts all matches of a regular expression from a string.
+type RegexAllFunction struct {
+	definition *function.Definition
+}
+
+// NewRegexAllFunction creates a new instance of the RegexAllFunction with
+// a complete function definition.
+func NewRegexAllFunction() provider.Function {
+	return &RegexAllFunction{
+		definition: &function.Definition{
+			Description: "Extracts all non-overlapping matches of a regular expression from a string.",
+			FormattedDescription: "Extracts all non-overlapping matches of a regular expression from a string. " +
+				"Patterns use [RE2 syntax](https://github.com/google/re2/wiki/Syntax).\n\n" +
+				"**Examples:**\n\n" +
+				"```\n${regexall(values.connectionString, \"[a-z]+=[^;]+\")}\n```",
+			Parameters: []function.Parameter{
+				&function.ScalarParameter{
+					Label: "input",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "A valid string literal, reference or function call yielding a return value " +
+						"representing the string to extract matches from.",
+				},
+				&function.ScalarParameter{
+					Label: "pattern",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The regular expression pattern to extract matches of.",
+				},
+			},
+			Return: &function.ListReturn{
+				ElementType: &function.ValueTypeDefinitionScalar{
+					Label: "string",
+					Type:  function.ValueTypeString,
+				},
+				Description: "An array of all the matches of the pattern in the input string in order, " +
+					"an empty array if there are no matches.",
+			},
+		},
+	}
+}
+
+func (f *RegexAllFunction) GetDefinition(
+	ctx context.Context,
+	input *provider.FunctionGetDefinitionInput,
+) (*provider.FunctionGetDefinitionOutput, error) {
+	return &provider.FunctionGetDefinitionOutput{
+		Definition: f.definition,
+	}, nil
+}
+
+func (f *RegexAllFunction) Call(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	// Get first argument as any to check for none marker
+	inputAny, err := input.Arguments.Get(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// If input is none, there is nothing to extract matches from
+	if core.IsNoneMarker(inputAny) {
+		return &provider.FunctionCallOutput{
+			ResponseData: []any{},
+		}, nil
+	}
+
+	var inputStr string
+	var pattern string
+	if err := input.Arguments.GetMultipleVars(ctx, &inputStr, &pattern); err != nil {
+		return nil, err
+	}
+
+	compiled, err := compileRegexPatternArg(input, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := compiled.FindAllString(inputStr, -1)
+	return &provider.FunctionCallOutput{
+		ResponseData: intoInterfaceSlice(matches),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	. "gopkg.in/check.v1"
+)
+
+type RegexAllFunctionTestSuite struct {
+	callStack   function.Stack
+	callContext *functionCallContextMock
+}
+
+var _ = Suite(&RegexAllFunctionTestSuite{})
+
+func (s *RegexAllFunctionTestSuite) SetUpTest(c *C) {
+	s.callStack = function.NewStack()
+	s.callContext = &functionCallContextMock{
+		params: &core.ParamsImpl{},
+		registry: &internal.FunctionRegistryMock{
+			Functions: map[string]provider.Function{},
+			CallStack: s.callStack,
+		},
+		callStack: s.callStack,
+	}
+}
+
+func (s *RegexAllFunctionTestSuite) Test_extracts_all_matches_of_pattern(c *C) {
+	output, err := s.callRegexAll("host=db.local;port=5432;user=orders", "[a-z]+=[^;]+")
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, DeepEquals, []any{
+		"host=db.local",
+		"port=5432",
+		"user=orders",
+	})
+}
+
+func (s *RegexAllFunctionTestSuite) Test_returns_empty_list_when_there_are_no_matches(c *C) {
+	output, err := s.callRegexAll("orders", "[0-9]+")
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, DeepEquals, []any{})
+}
+
+func (s *RegexAllFunctionTestSuite) Test_returns_func_error_for_invalid_pattern(c *C) {
+	_, err := s.callRegexAll("orders", "[a-z]+*")
+
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"invalid regular expression \"[a-z]+*\": invalid nested repetition operator at offset 5 (\"+*\")",
+	)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *RegexAllFunctionTestSuite) callRegexAll(
+	inputStr any,
+	pattern string,
+) (*provider.FunctionCallOutput, error) {
+	regexAllFunc := NewRegexAllFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "regexall",
+	})
+	return regexAllFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args:    []any{inputStr, pattern},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+}
@@ -0,0 +1,98 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// RegexMatchFunction provides the implementation of
+// a function that checks if a string matches a regular expression.
+type RegexMatchFunction struct {
+	definition *function.Definition
+}
+
+// NewRegexMatchFunction creates a new instance of the RegexMatchFunction with
+// a complete function definition.
+func NewRegexMatchFunction() provider.Function {
+	return &RegexMatchFunction{
+		definition: &function.Definition{
+			Description: "Checks if a string contains a match for a regular expression.",
+			FormattedDescription: "Checks if a string contains a match for a regular expression. " +
+				"Patterns use [RE2 syntax](https://github.com/google/re2/wiki/Syntax), " +
+				"use `^` and `$` to match the entire string.\n\n" +
+				"**Examples:**\n\n" +
+				"```\n${regexmatch(variables.environment, \"^(staging|production)$\")}\n```",
+			Parameters: []function.Parameter{
+				&function.ScalarParameter{
+					Label: "input",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "A valid string literal, reference or function call yielding a return value " +
+						"representing the string to search for a match in.",
+				},
+				&function.ScalarParameter{
+					Label: "pattern",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The regular expression pattern to match.",
+				},
+			},
+			Return: &function.ScalarReturn{
+				Type: &function.ValueTypeDefinitionScalar{
+					Label: "boolean",
+					Type:  function.ValueTypeBool,
+				},
+				Description: "True, if the input string contains a match for the pattern, false otherwise.",
+			},
+		},
+	}
+}
+
+func (f *RegexMatchFunction) GetDefinition(
+	ctx context.Context,
+	input *provider.FunctionGetDefinitionInput,
+) (*provider.FunctionGetDefinitionOutput, error) {
+	return &provider.FunctionGetDefinitionOutput{
+		Definition: f.definition,
+	}, nil
+}
+
+func (f *RegexMatchFunction) Call(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	// Get first argument as any to check for none marker
+	inputAny, err := input.Arguments.Get(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// If input is none, there is nothing to match against
+	if core.IsNoneMarker(inputAny) {
+		return &provider.FunctionCallOutput{
+			ResponseData: false,
+		}, nil
+	}
+
+	var inputStr string
+	var pattern string
+	if err := input.Arguments.GetMultipleVars(ctx, &inputStr, &pattern); err != nil {
+		return nil, err
+	}
+
+	compiled, err := compileRegexPatternArg(input, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.FunctionCallOutput{
+		ResponseData: compiled.MatchString(inputStr),
+	}, nil
+}
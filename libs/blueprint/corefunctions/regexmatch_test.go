@@ -0,0 +1,88 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	. "gopkg.in/check.v1"
+)
+
+type RegexMatchFunctionTestSuite struct {
+	callStack   function.Stack
+	callContext *functionCallContextMock
+}
+
+var _ = Suite(&RegexMatchFunctionTestSuite{})
+
+func (s *RegexMatchFunctionTestSuite) SetUpTest(c *C) {
+	s.callStack = function.NewStack()
+	s.callContext = &functionCallContextMock{
+		params: &core.ParamsImpl{},
+		registry: &internal.FunctionRegistryMock{
+			Functions: map[string]provider.Function{},
+			CallStack: s.callStack,
+		},
+		callStack: s.callStack,
+	}
+}
+
+func (s *RegexMatchFunctionTestSuite) Test_returns_true_for_matching_string(c *C) {
+	output, err := s.callRegexMatch("production", "^(staging|production)$")
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, true)
+}
+
+func (s *RegexMatchFunctionTestSuite) Test_returns_false_for_string_without_match(c *C) {
+	output, err := s.callRegexMatch("development", "^(staging|production)$")
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, false)
+}
+
+func (s *RegexMatchFunctionTestSuite) Test_returns_false_for_none_input(c *C) {
+	output, err := s.callRegexMatch(core.GetNoneMarker(), "^.*$")
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, false)
+}
+
+func (s *RegexMatchFunctionTestSuite) Test_returns_func_error_for_invalid_pattern(c *C) {
+	_, err := s.callRegexMatch("production", "^(staging|production$")
+
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"invalid regular expression \"^(staging|production$\": missing closing ) "+
+			"at offset 0 (\"^(staging|production$\")",
+	)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+	c.Assert(funcErr.CallStack, DeepEquals, []*function.Call{
+		{
+			FunctionName: "regexmatch",
+		},
+	})
+}
+
+func (s *RegexMatchFunctionTestSuite) callRegexMatch(
+	inputStr any,
+	pattern string,
+) (*provider.FunctionCallOutput, error) {
+	regexMatchFunc := NewRegexMatchFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "regexmatch",
+	})
+	return regexMatchFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args:    []any{inputStr, pattern},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+}
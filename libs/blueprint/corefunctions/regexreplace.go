@@ -0,0 +1,109 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// RegexReplaceFunction provides the implementation of
+// a function that replaces all matches of a regular expression in a string.
+type RegexReplaceFunction struct {
+	definition *function.Definition
+}
+
+// NewRegexReplaceFunction creates a new instance of the RegexReplaceFunction with
+// a complete function definition.
+func NewRegexReplaceFunction() provider.Function {
+	return &RegexReplaceFunction{
+		definition: &function.Definition{
+			Description: "Replaces all matches of a regular expression in a string with a replacement string.",
+			FormattedDescription: "Replaces all matches of a regular expression in a string with a replacement string. " +
+				"Patterns use [RE2 syntax](https://github.com/google/re2/wiki/Syntax), " +
+				"the replacement string can refer to capture groups with `$1` or `${name}` for named groups.\n\n" +
+				"**Examples:**\n\n" +
+				"```\n${regexreplace(values.serviceName, \"[^a-z0-9-]\", \"-\")}\n```\n" +
+				"```\n${regexreplace(variables.version, \"^v([0-9]+)[.]([0-9]+).*$\", \"$1-$2\")}\n```",
+			Parameters: []function.Parameter{
+				&function.ScalarParameter{
+					Label: "input",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "A valid string literal, reference or function call yielding a return value " +
+						"representing an input string that contains matches that need replacing.",
+				},
+				&function.ScalarParameter{
+					Label: "pattern",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The regular expression pattern to replace matches of.",
+				},
+				&function.ScalarParameter{
+					Label: "replaceWith",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The string to replace matches with, " +
+						"capture groups can be referenced with $1 or ${name}.",
+				},
+			},
+			Return: &function.ScalarReturn{
+				Type: &function.ValueTypeDefinitionScalar{
+					Label: "string",
+					Type:  function.ValueTypeString,
+				},
+				Description: "The input string with all matches of the pattern replaced.",
+			},
+		},
+	}
+}
+
+func (f *RegexReplaceFunction) GetDefinition(
+	ctx context.Context,
+	input *provider.FunctionGetDefinitionInput,
+) (*provider.FunctionGetDefinitionOutput, error) {
+	return &provider.FunctionGetDefinitionOutput{
+		Definition: f.definition,
+	}, nil
+}
+
+func (f *RegexReplaceFunction) Call(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	// Get first argument as any to check for none marker
+	inputAny, err := input.Arguments.Get(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// If input is none, propagate none
+	if core.IsNoneMarker(inputAny) {
+		return &provider.FunctionCallOutput{
+			ResponseData: core.GetNoneMarker(),
+		}, nil
+	}
+
+	var inputStr string
+	var pattern string
+	var replaceWith string
+	if err := input.Arguments.GetMultipleVars(ctx, &inputStr, &pattern, &replaceWith); err != nil {
+		return nil, err
+	}
+
+	compiled, err := compileRegexPatternArg(input, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.FunctionCallOutput{
+		ResponseData: compiled.ReplaceAllString(inputStr, replaceWith),
+	}, nil
+}
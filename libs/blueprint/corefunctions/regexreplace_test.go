@@ -0,0 +1,87 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	. "gopkg.in/check.v1"
+)
+
+type RegexReplaceFunctionTestSuite struct {
+	callStack   function.Stack
+	callContext *functionCallContextMock
+}
+
+var _ = Suite(&RegexReplaceFunctionTestSuite{})
+
+func (s *RegexReplaceFunctionTestSuite) SetUpTest(c *C) {
+	s.callStack = function.NewStack()
+	s.callContext = &functionCallContextMock{
+		params: &core.ParamsImpl{},
+		registry: &internal.FunctionRegistryMock{
+			Functions: map[string]provider.Function{},
+			CallStack: s.callStack,
+		},
+		callStack: s.callStack,
+	}
+}
+
+func (s *RegexReplaceFunctionTestSuite) Test_replaces_all_matches_of_pattern(c *C) {
+	output, err := s.callRegexReplace("Orders Service_v2", "[^a-z0-9-]", "-")
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "-rders--ervice-v2")
+}
+
+func (s *RegexReplaceFunctionTestSuite) Test_replaces_matches_with_capture_groups(c *C) {
+	output, err := s.callRegexReplace(
+		"v1.24.3",
+		"^v(?P<major>[0-9]+)[.]([0-9]+).*$",
+		"${major}-$2",
+	)
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "1-24")
+}
+
+func (s *RegexReplaceFunctionTestSuite) Test_propagates_none_input(c *C) {
+	output, err := s.callRegexReplace(core.GetNoneMarker(), "[a-z]", "-")
+
+	c.Assert(err, IsNil)
+	c.Assert(core.IsNoneMarker(output.ResponseData), Equals, true)
+}
+
+func (s *RegexReplaceFunctionTestSuite) Test_returns_func_error_for_invalid_pattern(c *C) {
+	_, err := s.callRegexReplace("orders", "orders-[a-z", "-")
+
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"invalid regular expression \"orders-[a-z\": missing closing ] at offset 7 (\"[a-z\")",
+	)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *RegexReplaceFunctionTestSuite) callRegexReplace(
+	inputStr any,
+	pattern string,
+	replaceWith string,
+) (*provider.FunctionCallOutput, error) {
+	regexReplaceFunc := NewRegexReplaceFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "regexreplace",
+	})
+	return regexReplaceFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args:    []any{inputStr, pattern, replaceWith},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+}
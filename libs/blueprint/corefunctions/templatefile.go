@@ -0,0 +1,183 @@
+package corefunctions
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+var (
+	// Matches escaped placeholders ("$${") and placeholders ("${name}")
+	// in the content of a template file.
+	templatePlaceholderPattern = regexp.MustCompile(`\$\$\{|\$\{([^}]*)\}`)
+	templateVarNamePattern     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+)
+
+// TemplateFileFunction provides the implementation of
+// a function that reads a text file and renders it as a template
+// with a set of variables.
+type TemplateFileFunction struct {
+	definition         *function.Definition
+	fileSourceRegistry provider.FileSourceRegistry
+}
+
+// NewTemplateFileFunction creates a new instance of the TemplateFileFunction with
+// a complete function definition and file source registry.
+func NewTemplateFileFunction(fileSourceRegistry provider.FileSourceRegistry) provider.Function {
+	return &TemplateFileFunction{
+		fileSourceRegistry: fileSourceRegistry,
+		definition: &function.Definition{
+			Description: "A function that reads a text file from a file path (local or remote) and renders it as a template, " +
+				"replacing placeholders with the provided variables.",
+			FormattedDescription: "A function that reads a text file from a file path (local or remote) and renders it as a template, " +
+				"replacing placeholders with the provided variables.\n\n" +
+				"Placeholders take the form `${name}` where `name` is a key in the variables mapping, " +
+				"`$${` can be used to include a literal `${` in the rendered output. " +
+				"Relative local paths are resolved against the directory of the current blueprint.\n\n" +
+				"**Examples:**\n\n" +
+				"```\n${templatefile(\"scripts/user-data.sh\", object(environment=variables.environment))}\n```\n" +
+				"```\n${templatefile(\"policies/bucket-policy.json\", object(bucketArn=resources.bucket.spec.arn))}\n```",
+			Parameters: []function.Parameter{
+				&function.ScalarParameter{
+					Label: "path",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The path to the template file to read (local path or URI).",
+				},
+				&function.MapParameter{
+					Label: "vars",
+					ElementType: &function.ValueTypeDefinitionAny{
+						Label: "any",
+						Type:  function.ValueTypeAny,
+					},
+					Description: "A mapping of variable names to the string, number or boolean values " +
+						"that should replace the placeholders in the template.",
+				},
+			},
+			Return: &function.ScalarReturn{
+				Type: &function.ValueTypeDefinitionScalar{
+					Label: "string",
+					Type:  function.ValueTypeString,
+				},
+				Description: "The rendered content of the template file.",
+			},
+			Volatility: function.VolatilityStable,
+		},
+	}
+}
+
+func (f *TemplateFileFunction) GetDefinition(
+	ctx context.Context,
+	input *provider.FunctionGetDefinitionInput,
+) (*provider.FunctionGetDefinitionOutput, error) {
+	return &provider.FunctionGetDefinitionOutput{
+		Definition: f.definition,
+	}, nil
+}
+
+func (f *TemplateFileFunction) Call(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	var path string
+	if err := input.Arguments.GetVar(ctx, 0, &path); err != nil {
+		return nil, err
+	}
+
+	var vars map[string]any
+	if err := input.Arguments.GetVar(ctx, 1, &vars); err != nil {
+		return nil, err
+	}
+
+	data, err := readFunctionFile(ctx, input, f.fileSourceRegistry, path)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := renderTemplate(string(data), vars)
+	if err != nil {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf("unable to render template file at path %q: %s", path, err.Error()),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	return &provider.FunctionCallOutput{
+		ResponseData: rendered,
+	}, nil
+}
+
+func renderTemplate(template string, vars map[string]any) (string, error) {
+	var renderErr error
+	rendered := templatePlaceholderPattern.ReplaceAllStringFunc(
+		template,
+		func(match string) string {
+			if renderErr != nil {
+				return match
+			}
+
+			if match == "$${" {
+				return "${"
+			}
+
+			varName := strings.TrimSpace(match[2 : len(match)-1])
+			if !templateVarNamePattern.MatchString(varName) {
+				renderErr = fmt.Errorf(
+					"invalid placeholder %q, placeholders must contain a single variable name",
+					match,
+				)
+				return match
+			}
+
+			value, hasVar := vars[varName]
+			if !hasVar {
+				renderErr = fmt.Errorf(
+					"variable %q used in the template is not defined in the provided variables",
+					varName,
+				)
+				return match
+			}
+
+			renderedValue, err := renderTemplateValue(varName, value)
+			if err != nil {
+				renderErr = err
+				return match
+			}
+
+			return renderedValue
+		},
+	)
+	if renderErr != nil {
+		return "", renderErr
+	}
+
+	return rendered, nil
+}
+
+func renderTemplateValue(varName string, value any) (string, error) {
+	if value == nil || core.IsNoneMarker(value) {
+		return "", fmt.Errorf("variable %q has no value", varName)
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", value), nil
+	}
+
+	return "", fmt.Errorf(
+		"variable %q must be a string, number or boolean, "+
+			"use jsonencode to render arrays and mappings",
+		varName,
+	)
+}
@@ -0,0 +1,161 @@
+package corefunctions
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	. "gopkg.in/check.v1"
+)
+
+type TemplateFileFunctionTestSuite struct {
+	callStack   function.Stack
+	callContext *functionCallContextMock
+	tempDir     string
+}
+
+var _ = Suite(&TemplateFileFunctionTestSuite{})
+
+func (s *TemplateFileFunctionTestSuite) SetUpTest(c *C) {
+	tempDir, err := os.MkdirTemp("", "templatefile-function-test-*")
+	c.Assert(err, IsNil)
+	s.tempDir = tempDir
+
+	s.callStack = function.NewStack()
+	s.callContext = &functionCallContextMock{
+		params: &core.ParamsImpl{
+			ContextVariables: map[string]*core.ScalarValue{
+				"__blueprintDir": core.ScalarFromString(tempDir),
+			},
+		},
+		registry: &internal.FunctionRegistryMock{
+			Functions: map[string]provider.Function{},
+			CallStack: s.callStack,
+		},
+		callStack: s.callStack,
+	}
+}
+
+func (s *TemplateFileFunctionTestSuite) TearDownTest(c *C) {
+	if s.tempDir != "" {
+		os.RemoveAll(s.tempDir)
+	}
+}
+
+func (s *TemplateFileFunctionTestSuite) Test_renders_template_file_relative_to_blueprint_directory(c *C) {
+	s.writeTemplate(
+		c,
+		"user-data.sh",
+		"#!/bin/bash\n"+
+			"export ENVIRONMENT=${environment}\n"+
+			"export WORKERS=${ workers }\n"+
+			"export DEBUG=${debug}\n"+
+			"echo \"$${HOME}\"\n",
+	)
+
+	output, err := s.callTemplateFile("user-data.sh", map[string]any{
+		"environment": "production",
+		"workers":     int64(4),
+		"debug":       false,
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(
+		output.ResponseData,
+		Equals,
+		"#!/bin/bash\n"+
+			"export ENVIRONMENT=production\n"+
+			"export WORKERS=4\n"+
+			"export DEBUG=false\n"+
+			"echo \"${HOME}\"\n",
+	)
+}
+
+func (s *TemplateFileFunctionTestSuite) Test_returns_error_for_undefined_variable(c *C) {
+	s.writeTemplate(c, "policy.json", `{"Resource": "${bucketArn}"}`)
+
+	_, err := s.callTemplateFile("policy.json", map[string]any{})
+
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"unable to render template file at path \"policy.json\": variable \"bucketArn\" "+
+			"used in the template is not defined in the provided variables",
+	)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *TemplateFileFunctionTestSuite) Test_returns_error_for_invalid_placeholder(c *C) {
+	s.writeTemplate(c, "policy.json", `{"Resource": "${bucket arn}"}`)
+
+	_, err := s.callTemplateFile("policy.json", map[string]any{})
+
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"unable to render template file at path \"policy.json\": invalid placeholder \"${bucket arn}\", "+
+			"placeholders must contain a single variable name",
+	)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *TemplateFileFunctionTestSuite) Test_returns_error_for_non_scalar_variable(c *C) {
+	s.writeTemplate(c, "policy.json", `{"Statement": ${statements}}`)
+
+	_, err := s.callTemplateFile("policy.json", map[string]any{
+		"statements": []any{"a", "b"},
+	})
+
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"unable to render template file at path \"policy.json\": variable \"statements\" "+
+			"must be a string, number or boolean, use jsonencode to render arrays and mappings",
+	)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *TemplateFileFunctionTestSuite) Test_returns_error_for_nonexistent_file(c *C) {
+	_, err := s.callTemplateFile("missing.tpl", map[string]any{})
+
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(funcErr.Message, Matches, "unable to read file at path \"missing.tpl\".*")
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeFunctionCall)
+}
+
+func (s *TemplateFileFunctionTestSuite) writeTemplate(c *C, name string, content string) {
+	err := os.WriteFile(filepath.Join(s.tempDir, name), []byte(content), 0644)
+	c.Assert(err, IsNil)
+}
+
+func (s *TemplateFileFunctionTestSuite) callTemplateFile(
+	path string,
+	vars map[string]any,
+) (*provider.FunctionCallOutput, error) {
+	templateFileFunc := NewTemplateFileFunction(provider.NewFileSourceRegistry())
+	s.callStack.Push(&function.Call{
+		FunctionName: "templatefile",
+	})
+	return templateFileFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args:    []any{path, vars},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+}
@@ -0,0 +1,111 @@
+package corefunctions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// TimeAddFunction provides the implementation of
+// a function that adds a duration to a timestamp.
+type TimeAddFunction struct {
+	definition *function.Definition
+}
+
+// NewTimeAddFunction creates a new instance of the TimeAddFunction with
+// a complete function definition.
+func NewTimeAddFunction() provider.Function {
+	return &TimeAddFunction{
+		definition: &function.Definition{
+			Description: "A function that adds a duration to a timestamp in RFC3339 format " +
+				"and returns the result in RFC3339 format.\n\n" +
+				"The duration is a sequence of numbers with unit suffixes, such as \"1h30m\" or \"-24h\", " +
+				"valid units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\" and \"h\". " +
+				"A negative duration subtracts from the timestamp.",
+			FormattedDescription: "A function that adds a duration to a timestamp in RFC3339 format " +
+				"and returns the result in RFC3339 format.\n\n" +
+				"The duration is a sequence of numbers with unit suffixes, such as `1h30m` or `-24h`, " +
+				"valid units are `ns`, `us` (or `µs`), `ms`, `s`, `m` and `h`. " +
+				"A negative duration subtracts from the timestamp.\n\n" +
+				"**Examples:**\n\n" +
+				"```\n${timeadd(\"2023-09-07T14:43:44Z\", \"1h30m\")}   # Returns \"2023-09-07T16:13:44Z\"\n```\n\n" +
+				"Expiring a resource 30 days after deployment:\n" +
+				"```\n${timeadd(now(), \"720h\")}\n```",
+			Parameters: []function.Parameter{
+				&function.ScalarParameter{
+					Label: "timestamp",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The timestamp in RFC3339 format to add the duration to.",
+				},
+				&function.ScalarParameter{
+					Label: "duration",
+					Type: &function.ValueTypeDefinitionScalar{
+						Label: "string",
+						Type:  function.ValueTypeString,
+					},
+					Description: "The duration to add to the timestamp (e.g. \"1h30m\" or \"-24h\").",
+				},
+			},
+			Return: &function.ScalarReturn{
+				Type: &function.ValueTypeDefinitionScalar{
+					Label: "string",
+					Type:  function.ValueTypeString,
+				},
+				Description: "The timestamp with the duration added in RFC3339 format, " +
+					"the offset of the provided timestamp is preserved.",
+			},
+		},
+	}
+}
+
+func (f *TimeAddFunction) GetDefinition(
+	ctx context.Context,
+	input *provider.FunctionGetDefinitionInput,
+) (*provider.FunctionGetDefinitionOutput, error) {
+	return &provider.FunctionGetDefinitionOutput{
+		Definition: f.definition,
+	}, nil
+}
+
+func (f *TimeAddFunction) Call(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	var timestamp string
+	if err := input.Arguments.GetVar(ctx, 0, &timestamp); err != nil {
+		return nil, err
+	}
+
+	var durationStr string
+	if err := input.Arguments.GetVar(ctx, 1, &durationStr); err != nil {
+		return nil, err
+	}
+
+	parsedTime, err := parseRFC3339Timestamp(input, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf(
+				"invalid duration %q, expected a sequence of numbers with unit suffixes "+
+					"such as \"1h30m\" or \"-24h\"",
+				durationStr,
+			),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	return &provider.FunctionCallOutput{
+		ResponseData: parsedTime.Add(duration).Format(time.RFC3339),
+	}, nil
+}
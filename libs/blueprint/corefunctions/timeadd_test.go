@@ -0,0 +1,97 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	. "gopkg.in/check.v1"
+)
+
+type TimeAddFunctionTestSuite struct {
+	callStack   function.Stack
+	callContext *functionCallContextMock
+}
+
+var _ = Suite(&TimeAddFunctionTestSuite{})
+
+func (s *TimeAddFunctionTestSuite) SetUpTest(c *C) {
+	s.callStack = function.NewStack()
+	s.callContext = &functionCallContextMock{
+		params: &core.ParamsImpl{},
+		registry: &internal.FunctionRegistryMock{
+			Functions: map[string]provider.Function{},
+		},
+		callStack: s.callStack,
+	}
+}
+
+func (s *TimeAddFunctionTestSuite) Test_adds_duration_to_timestamp(c *C) {
+	output, err := s.callTimeAdd("2023-09-07T14:43:44Z", "1h30m")
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "2023-09-07T16:13:44Z")
+}
+
+func (s *TimeAddFunctionTestSuite) Test_subtracts_negative_duration_from_timestamp(c *C) {
+	output, err := s.callTimeAdd("2023-09-07T14:43:44Z", "-24h")
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "2023-09-06T14:43:44Z")
+}
+
+func (s *TimeAddFunctionTestSuite) Test_preserves_timestamp_offset(c *C) {
+	output, err := s.callTimeAdd("2023-12-31T23:00:00+01:00", "2h")
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "2024-01-01T01:00:00+01:00")
+}
+
+func (s *TimeAddFunctionTestSuite) Test_returns_error_for_invalid_timestamp(c *C) {
+	_, err := s.callTimeAdd("07/09/2023", "1h")
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"invalid timestamp \"07/09/2023\", expected a date/time in RFC3339 format "+
+			"(e.g. \"2023-09-07T14:43:44Z\")",
+	)
+	c.Assert(funcErr.CallStack, DeepEquals, []*function.Call{
+		{
+			FunctionName: "timeadd",
+		},
+	})
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *TimeAddFunctionTestSuite) Test_returns_error_for_invalid_duration(c *C) {
+	_, err := s.callTimeAdd("2023-09-07T14:43:44Z", "1 day")
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(
+		funcErr.Message,
+		Equals,
+		"invalid duration \"1 day\", expected a sequence of numbers with unit suffixes "+
+			"such as \"1h30m\" or \"-24h\"",
+	)
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidInput)
+}
+
+func (s *TimeAddFunctionTestSuite) callTimeAdd(timestamp string, duration string) (*provider.FunctionCallOutput, error) {
+	timeAddFunc := NewTimeAddFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "timeadd",
+	})
+	return timeAddFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args: []any{
+				timestamp,
+				duration,
+			},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+}
@@ -0,0 +1,85 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// TryFunction provides the implementation of
+// a function that returns the first argument that can be resolved
+// without an error.
+type TryFunction struct {
+	definition *function.Definition
+}
+
+// NewTryFunction creates a new instance of the TryFunction with
+// a complete function definition.
+//
+// Arguments of the try function are resolved lazily by the substitution
+// resolver, in order, until one of them resolves without an error.
+// Only the successfully resolved argument is passed into the function
+// when it is called during substitution resolution.
+func NewTryFunction() provider.Function {
+	return &TryFunction{
+		definition: &function.Definition{
+			Description: "A function that returns the first argument that resolves without an error. " +
+				"This can be used to provide fallbacks for references or function calls that may fail at resolve time.",
+			FormattedDescription: "A function that returns the first argument that resolves without an error. " +
+				"This can be used to provide fallbacks for references or function calls that may fail at resolve time.\n\n" +
+				"Arguments are resolved in order and arguments after the first one that resolves successfully are not resolved. " +
+				"Use `coalesce` to provide fallbacks for values that resolve to `none`.\n\n" +
+				"**Examples:**\n\n" +
+				"```\n${try(jsondecode(variables.config).region, \"us-east-1\")}\n```\n\n" +
+				"```\n${try(datasources.network.subnetIds[2], datasources.network.subnetIds[0])}\n```",
+			Parameters: []function.Parameter{
+				&function.VariadicParameter{
+					Label: "values",
+					Type: &function.ValueTypeDefinitionAny{
+						Type:  function.ValueTypeAny,
+						Label: "any",
+					},
+					Description: "N arguments of the same type to try in order, at least one argument is expected.",
+				},
+			},
+			Return: &function.AnyReturn{
+				Type:        function.ValueTypeAny,
+				Description: "The value of the first argument that resolves without an error.",
+			},
+		},
+	}
+}
+
+func (f *TryFunction) GetDefinition(
+	ctx context.Context,
+	input *provider.FunctionGetDefinitionInput,
+) (*provider.FunctionGetDefinitionOutput, error) {
+	return &provider.FunctionGetDefinitionOutput{
+		Definition: f.definition,
+	}, nil
+}
+
+func (f *TryFunction) Call(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+) (*provider.FunctionCallOutput, error) {
+	var params []any
+	if err := input.Arguments.GetVar(ctx, 0, &params); err != nil {
+		return nil, err
+	}
+
+	if len(params) == 0 {
+		return nil, function.NewFuncCallError(
+			"no arguments passed to the `try` function, at least one argument is expected",
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	// By the time the function is called, the arguments have been resolved
+	// successfully so the first argument is the result.
+	return &provider.FunctionCallOutput{
+		ResponseData: params[0],
+	}, nil
+}
@@ -0,0 +1,134 @@
+package corefunctions
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	. "gopkg.in/check.v1"
+)
+
+type URLEncodeFunctionTestSuite struct {
+	callStack   function.Stack
+	callContext *functionCallContextMock
+}
+
+var _ = Suite(&URLEncodeFunctionTestSuite{})
+
+func (s *URLEncodeFunctionTestSuite) SetUpTest(c *C) {
+	s.callStack = function.NewStack()
+	s.callContext = &functionCallContextMock{
+		params: &core.ParamsImpl{},
+		registry: &internal.FunctionRegistryMock{
+			Functions: map[string]provider.Function{},
+			CallStack: s.callStack,
+		},
+		callStack: s.callStack,
+	}
+}
+
+func (s *URLEncodeFunctionTestSuite) Test_percent_encodes_reserved_characters_and_spaces(c *C) {
+	urlEncodeFunc := NewURLEncodeFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "urlencode",
+	})
+	output, err := urlEncodeFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args: []any{
+				"reports/2023 Q3.csv?version=1&sig=a+b",
+			},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "reports%2F2023%20Q3.csv%3Fversion%3D1%26sig%3Da%2Bb")
+}
+
+func (s *URLEncodeFunctionTestSuite) Test_leaves_unreserved_characters_unencoded(c *C) {
+	urlEncodeFunc := NewURLEncodeFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "urlencode",
+	})
+	output, err := urlEncodeFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args: []any{
+				"Orders-Table_v2.0~prod",
+			},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "Orders-Table_v2.0~prod")
+}
+
+func (s *URLEncodeFunctionTestSuite) Test_percent_encodes_multi_byte_characters_as_utf8_bytes(c *C) {
+	urlEncodeFunc := NewURLEncodeFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "urlencode",
+	})
+	output, err := urlEncodeFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args: []any{
+				"café ☕",
+			},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(output.ResponseData, Equals, "caf%C3%A9%20%E2%98%95")
+}
+
+func (s *URLEncodeFunctionTestSuite) Test_returns_func_error_for_invalid_input_type(c *C) {
+	urlEncodeFunc := NewURLEncodeFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "urlencode",
+	})
+	_, err := urlEncodeFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args: []any{
+				// Only a string is allowed.
+				12345,
+			},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+
+	c.Assert(err, NotNil)
+	funcErr, isFuncErr := err.(*function.FuncCallError)
+	c.Assert(isFuncErr, Equals, true)
+	c.Assert(funcErr.Message, Equals, "argument at index 0 is of type int, but target is of type string")
+	c.Assert(funcErr.CallStack, DeepEquals, []*function.Call{
+		{
+			FunctionName: "urlencode",
+		},
+	})
+	c.Assert(funcErr.Code, Equals, function.FuncCallErrorCodeInvalidArgumentType)
+}
+
+func (s *URLEncodeFunctionTestSuite) Test_propagates_none_value(c *C) {
+	urlEncodeFunc := NewURLEncodeFunction()
+	s.callStack.Push(&function.Call{
+		FunctionName: "urlencode",
+	})
+	output, err := urlEncodeFunc.Call(context.TODO(), &provider.FunctionCallInput{
+		Arguments: &functionCallArgsMock{
+			args: []any{
+				core.GetNoneMarker(),
+			},
+			callCtx: s.callContext,
+		},
+		CallContext: s.callContext,
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(core.IsNoneMarker(output.ResponseData), Equals, true)
+}
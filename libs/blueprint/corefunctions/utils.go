@@ -1,9 +1,19 @@
 package corefunctions
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
+	"math/big"
+	"net"
+	"path/filepath"
 	"reflect"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"time"
 
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 )
@@ -88,3 +98,175 @@ func checkNumberTypes(input *provider.FunctionCallInput, lhs interface{}, rhs in
 		},
 	}, nil
 }
+
+// Parses an IP address prefix in CIDR notation for the CIDR math functions,
+// returning the network that the prefix represents.
+// Host bits set in the prefix are ignored, "10.0.0.5/16" represents
+// the "10.0.0.0/16" network.
+func parseCIDRPrefix(input *provider.FunctionCallInput, prefix string) (*net.IPNet, error) {
+	_, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf(
+				"invalid CIDR prefix %q, expected an IPv4 or IPv6 address "+
+					"followed by a prefix length (e.g. \"10.0.0.0/16\")",
+				prefix,
+			),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	return ipNet, nil
+}
+
+// Converts the network address of an IP network to an integer
+// to be able to carry out address arithmetic.
+func cidrNetworkToInt(ipNet *net.IPNet) *big.Int {
+	ip := ipNet.IP.To16()
+	if isIPv4Network(ipNet) {
+		ip = ipNet.IP.To4()
+	}
+	return new(big.Int).SetBytes(ip)
+}
+
+// Converts an integer back to an IP address with the same number of bytes as
+// the addresses of the given network, the integer must be within the address
+// space of the network.
+func cidrIntToIP(value *big.Int, ipNet *net.IPNet) net.IP {
+	ip := make(net.IP, len(ipNet.Mask))
+	return value.FillBytes(ip)
+}
+
+// The mask length is used to determine the IP version of a network
+// as IPv4-mapped IPv6 prefixes (e.g. "::ffff:10.0.0.0/104")
+// have IPv4 addresses with IPv6 masks.
+func isIPv4Network(ipNet *net.IPNet) bool {
+	return len(ipNet.Mask) == net.IPv4len
+}
+
+func cidrIPVersionLabel(ipNet *net.IPNet) string {
+	if isIPv4Network(ipNet) {
+		return "IPv4"
+	}
+	return "IPv6"
+}
+
+// Parses a timestamp in RFC3339 format for functions that operate on
+// date/time values, as produced by the "now" function.
+func parseRFC3339Timestamp(input *provider.FunctionCallInput, timestamp string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return time.Time{}, function.NewFuncCallError(
+			fmt.Sprintf(
+				"invalid timestamp %q, expected a date/time in RFC3339 format "+
+					"(e.g. \"2023-09-07T14:43:44Z\")",
+				timestamp,
+			),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	return parsed, nil
+}
+
+// blueprintDirectoryContextVar is the name of the context variable
+// that holds the directory of the current blueprint being processed,
+// this must be kept in sync with container.BlueprintDirectoryContextVar.
+const blueprintDirectoryContextVar = "__blueprintDir"
+
+// Reads a file through the file source registry for functions that load
+// content from files, relative local paths are resolved against the directory
+// of the current blueprint when it is known.
+func readFunctionFile(
+	ctx context.Context,
+	input *provider.FunctionCallInput,
+	fileSourceRegistry provider.FileSourceRegistry,
+	path string,
+) ([]byte, error) {
+	resolvedPath := resolveFunctionFilePath(path, input.CallContext.Params())
+	data, err := fileSourceRegistry.ReadFile(ctx, resolvedPath)
+	if err != nil {
+		return nil, function.NewFuncCallError(
+			fmt.Sprintf("unable to read file at path %q: %s", path, err.Error()),
+			function.FuncCallErrorCodeFunctionCall,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	return data, nil
+}
+
+func resolveFunctionFilePath(path string, params core.BlueprintParams) string {
+	if strings.Contains(path, "://") || filepath.IsAbs(path) || params == nil {
+		return path
+	}
+
+	blueprintDir := params.ContextVariable(blueprintDirectoryContextVar)
+	if blueprintDir == nil || blueprintDir.StringValue == nil ||
+		*blueprintDir.StringValue == "" {
+		return path
+	}
+
+	return filepath.Join(*blueprintDir.StringValue, path)
+}
+
+// DecodeBase64String decodes a string with the standard RFC 4648 Base64 encoding
+// (including padding) used by the base64decode function.
+// This is exported so that Base64-encoded string literals can be checked
+// when validating a blueprint.
+func DecodeBase64String(value string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(value)
+}
+
+// CompileRegexPattern compiles a regular expression pattern used in
+// the regexmatch, regexreplace and regexall functions with RE2 syntax.
+// For invalid patterns, the returned error describes the problem along with
+// the offset in the pattern where the problem was found.
+// This is exported so that pattern literals can be checked when
+// validating a blueprint.
+func CompileRegexPattern(pattern string) (*regexp.Regexp, error) {
+	compiled, err := regexp.Compile(pattern)
+	if err == nil {
+		return compiled, nil
+	}
+
+	syntaxErr, isSyntaxErr := err.(*syntax.Error)
+	if !isSyntaxErr {
+		return nil, fmt.Errorf("invalid regular expression %q: %w", pattern, err)
+	}
+
+	offset := strings.Index(pattern, syntaxErr.Expr)
+	if offset == -1 || syntaxErr.Expr == "" {
+		return nil, fmt.Errorf(
+			"invalid regular expression %q: %s",
+			pattern,
+			syntaxErr.Code.String(),
+		)
+	}
+
+	return nil, fmt.Errorf(
+		"invalid regular expression %q: %s at offset %d (%q)",
+		pattern,
+		syntaxErr.Code.String(),
+		offset,
+		syntaxErr.Expr,
+	)
+}
+
+func compileRegexPatternArg(
+	input *provider.FunctionCallInput,
+	pattern string,
+) (*regexp.Regexp, error) {
+	compiled, err := CompileRegexPattern(pattern)
+	if err != nil {
+		return nil, function.NewFuncCallError(
+			err.Error(),
+			function.FuncCallErrorCodeInvalidInput,
+			input.CallContext.CallStackSnapshot(),
+		)
+	}
+
+	return compiled, nil
+}
@@ -34,6 +34,7 @@ func NewUUIDFunction() provider.Function {
 				},
 				Description: "A version 4 UUID string.",
 			},
+			Volatility: function.VolatilityVolatile,
 		},
 	}
 }
@@ -1,6 +1,10 @@
 package drift
 
 import (
+	"context"
+	"sync/atomic"
+	"time"
+
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
@@ -68,3 +72,30 @@ func newTestExampleProvider(
 		},
 	}
 }
+
+// concurrencyTrackingResource wraps a resource implementation to track
+// the maximum number of concurrent calls to retrieve external state.
+type concurrencyTrackingResource struct {
+	provider.Resource
+	delay     time.Duration
+	active    atomic.Int32
+	maxActive atomic.Int32
+}
+
+func (r *concurrencyTrackingResource) GetExternalState(
+	ctx context.Context,
+	input *provider.ResourceGetExternalStateInput,
+) (*provider.ResourceGetExternalStateOutput, error) {
+	active := r.active.Add(1)
+	defer r.active.Add(-1)
+
+	for {
+		currentMax := r.maxActive.Load()
+		if active <= currentMax || r.maxActive.CompareAndSwap(currentMax, active) {
+			break
+		}
+	}
+
+	time.Sleep(r.delay)
+	return r.Resource.GetExternalState(ctx, input)
+}
@@ -3,7 +3,10 @@ package drift
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
@@ -37,6 +40,9 @@ type Checker interface {
 	// drift check with the configured state container.
 	// This returns a map of resource IDs to their drift state ONLY
 	// if the resource has drifted from the last known state.
+	// Drift may be checked for multiple resources concurrently,
+	// if checking drift fails for multiple resources, the error for the
+	// first resource ordered by resource ID is returned.
 	CheckDrift(
 		ctx context.Context,
 		instanceID string,
@@ -128,30 +134,121 @@ type Checker interface {
 	) (map[string]*state.LinkDriftState, error)
 }
 
+const (
+	// DefaultMaxConcurrency is the default maximum number of resources
+	// that drift will be checked for concurrently when checking drift
+	// for all resources in a blueprint instance.
+	DefaultMaxConcurrency = 10
+)
+
 type defaultChecker struct {
-	stateContainer  state.Container
-	providers       map[string]provider.Provider
-	changeGenerator changes.ResourceChangeGenerator
-	clock           core.Clock
-	logger          core.Logger
+	stateContainer      state.Container
+	providers           map[string]provider.Provider
+	changeGenerator     changes.ResourceChangeGenerator
+	clock               core.Clock
+	logger              core.Logger
+	ignoreRules         IgnoreRules
+	instanceIgnoreRules map[string]IgnoreRules
+	maxConcurrency      int
+	providerLimits      map[string]int
+	// Semaphores that bound the number of concurrent drift checks
+	// for resources of a given provider, shared between all
+	// drift checks carried out by the checker.
+	providerSemaphores map[string]chan struct{}
+	eventBus           EventBus
+}
+
+// CheckerOption is a function that can be used to configure
+// the default drift checker implementation.
+type CheckerOption func(*defaultChecker)
+
+// WithIgnoreRules sets drift ignore rules that apply to resources
+// in all blueprint instances.
+func WithIgnoreRules(rules IgnoreRules) CheckerOption {
+	return func(c *defaultChecker) {
+		c.ignoreRules = c.ignoreRules.Merge(rules)
+	}
+}
+
+// WithInstanceIgnoreRules sets drift ignore rules that only apply
+// to resources in the blueprint instance with the given ID.
+// This can be used multiple times to set rules for different instances.
+func WithInstanceIgnoreRules(instanceID string, rules IgnoreRules) CheckerOption {
+	return func(c *defaultChecker) {
+		c.instanceIgnoreRules[instanceID] = c.instanceIgnoreRules[instanceID].Merge(rules)
+	}
+}
+
+// WithMaxConcurrency sets the maximum number of resources that drift
+// will be checked for concurrently when checking drift for all resources
+// in a blueprint instance.
+// Values less than 1 are treated as 1, meaning resources will be checked
+// one at a time.
+// When not set, DefaultMaxConcurrency is used.
+func WithMaxConcurrency(maxConcurrency int) CheckerOption {
+	return func(c *defaultChecker) {
+		c.maxConcurrency = max(maxConcurrency, 1)
+	}
+}
+
+// WithProviderConcurrencyLimit sets the maximum number of resources
+// of the provider with the given namespace that drift will be checked
+// for at the same time.
+// This limit applies across all drift checks carried out by the checker
+// and can be used to stay within the rate limits of the upstream
+// provider APIs (e.g. the AWS API).
+// This can be used multiple times to set limits for different providers.
+func WithProviderConcurrencyLimit(providerNamespace string, limit int) CheckerOption {
+	return func(c *defaultChecker) {
+		c.providerLimits[providerNamespace] = max(limit, 1)
+	}
+}
+
+// WithEventBus sets the event bus that drift events will be published to
+// when a drift check finds that a resource or link has drifted,
+// or that previously detected drift has been resolved.
+// When not set, no drift events are published.
+func WithEventBus(eventBus EventBus) CheckerOption {
+	return func(c *defaultChecker) {
+		c.eventBus = eventBus
+	}
 }
 
 // NewDefaultChecker creates a new instance
 // of the default drift checker implementation.
+//
+// In addition to rules provided as options, drift ignore rules can be defined
+// in blueprint metadata under the IgnoreRulesMetadataKey.
 func NewDefaultChecker(
 	stateContainer state.Container,
 	providers map[string]provider.Provider,
 	changeGenerator changes.ResourceChangeGenerator,
 	clock core.Clock,
 	logger core.Logger,
+	opts ...CheckerOption,
 ) Checker {
-	return &defaultChecker{
-		stateContainer:  stateContainer,
-		providers:       providers,
-		changeGenerator: changeGenerator,
-		clock:           clock,
-		logger:          logger,
+	checker := &defaultChecker{
+		stateContainer:      stateContainer,
+		providers:           providers,
+		changeGenerator:     changeGenerator,
+		clock:               clock,
+		logger:              logger,
+		ignoreRules:         IgnoreRules{},
+		instanceIgnoreRules: map[string]IgnoreRules{},
+		maxConcurrency:      DefaultMaxConcurrency,
+		providerLimits:      map[string]int{},
+		providerSemaphores:  map[string]chan struct{}{},
 	}
+
+	for _, opt := range opts {
+		opt(checker)
+	}
+
+	for providerNamespace, limit := range checker.providerLimits {
+		checker.providerSemaphores[providerNamespace] = make(chan struct{}, limit)
+	}
+
+	return checker
 }
 
 func (c *defaultChecker) CheckDrift(
@@ -198,39 +295,114 @@ func (c *defaultChecker) checkDriftWithState(
 	taggingConfig *provider.TaggingConfig,
 	instanceLogger core.Logger,
 ) (map[string]*state.ResourceDriftState, error) {
+	ignoreRules := c.resolveIgnoreRules(instanceState.InstanceID, instanceState.Metadata)
+	// Resources are checked in a consistent order so that the results
+	// (including which error is reported when multiple checks fail)
+	// do not depend on the order in which concurrent checks complete.
+	resources := sortedResources(instanceState.Resources)
+	driftStates := make([]*state.ResourceDriftState, len(resources))
+	errs := make([]error, len(resources))
+
+	// Once a check has failed, no more checks are started
+	// but checks that are already in progress are allowed to finish.
+	// As checks are started in order, this guarantees that the first
+	// resource that fails will always have been checked.
+	failed := &atomic.Bool{}
+	jobs := make(chan int)
+	workers := &sync.WaitGroup{}
+	for range min(c.maxConcurrency, len(resources)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for i := range jobs {
+				driftStates[i], errs[i] = c.checkDriftForInstanceResource(
+					ctx,
+					resources[i],
+					instanceState.InstanceName,
+					ignoreRules,
+					params,
+					taggingConfig,
+					instanceLogger,
+				)
+				if errs[i] != nil {
+					failed.Store(true)
+				}
+			}
+		}()
+	}
+
+	for i := range resources {
+		if failed.Load() || ctx.Err() != nil {
+			break
+		}
+		jobs <- i
+	}
+	close(jobs)
+	workers.Wait()
+
 	driftResults := map[string]*state.ResourceDriftState{}
-	for _, resource := range instanceState.Resources {
-		resourceLogger := instanceLogger.WithFields(
-			core.StringLogField("resourceId", resource.ResourceID),
-		)
-		resourceLogger.Debug(
-			fmt.Sprintf("Checking drift for resource %s", resource.ResourceID),
-		)
-		resourceDrift, err := c.checkResourceDrift(
-			ctx,
-			resource,
-			instanceState.InstanceName,
-			params,
-			taggingConfig,
-			resourceLogger,
-		)
-		if err != nil {
+	for i, resource := range resources {
+		if errs[i] != nil {
 			instanceLogger.Debug(
 				fmt.Sprintf("Failed to check drift for resource %s", resource.ResourceID),
 				core.StringLogField("resourceId", resource.ResourceID),
-				core.ErrorLogField("error", err),
+				core.ErrorLogField("error", errs[i]),
 			)
-			return nil, err
+			return nil, errs[i]
 		}
 
-		if resourceDrift != nil {
-			driftResults[resource.ResourceID] = resourceDrift
+		if driftStates[i] != nil {
+			driftResults[resource.ResourceID] = driftStates[i]
 		}
 	}
 
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
 	return driftResults, nil
 }
 
+func (c *defaultChecker) checkDriftForInstanceResource(
+	ctx context.Context,
+	resource *state.ResourceState,
+	instanceName string,
+	ignoreRules IgnoreRules,
+	params core.BlueprintParams,
+	taggingConfig *provider.TaggingConfig,
+	instanceLogger core.Logger,
+) (*state.ResourceDriftState, error) {
+	resourceLogger := instanceLogger.WithFields(
+		core.StringLogField("resourceId", resource.ResourceID),
+	)
+	resourceLogger.Debug(
+		fmt.Sprintf("Checking drift for resource %s", resource.ResourceID),
+	)
+	return c.checkResourceDrift(
+		ctx,
+		resource,
+		instanceName,
+		ignoreRules,
+		params,
+		taggingConfig,
+		resourceLogger,
+	)
+}
+
+func sortedResources(resources map[string]*state.ResourceState) []*state.ResourceState {
+	sorted := make([]*state.ResourceState, 0, len(resources))
+	for _, resource := range resources {
+		if resource != nil {
+			sorted = append(sorted, resource)
+		}
+	}
+
+	slices.SortFunc(sorted, func(a, b *state.ResourceState) int {
+		return strings.Compare(a.ResourceID, b.ResourceID)
+	})
+	return sorted
+}
+
 func (c *defaultChecker) CheckResourceDrift(
 	ctx context.Context,
 	instanceID string,
@@ -282,13 +454,44 @@ func (c *defaultChecker) CheckResourceDrift(
 		return nil, err
 	}
 
-	return c.checkResourceDrift(ctx, &finalResourceState, instanceName, params, taggingConfig, resourceLogger)
+	instanceMetadata, err := c.stateContainer.Metadata().Get(ctx, instanceID)
+	if err != nil {
+		resourceLogger.Debug(
+			fmt.Sprintf("Failed to fetch instance metadata for drift ignore rules for resource %s", resourceID),
+			core.ErrorLogField("error", err),
+		)
+		return nil, err
+	}
+	ignoreRules := c.resolveIgnoreRules(instanceID, instanceMetadata)
+
+	return c.checkResourceDrift(
+		ctx,
+		&finalResourceState,
+		instanceName,
+		ignoreRules,
+		params,
+		taggingConfig,
+		resourceLogger,
+	)
+}
+
+// resolveIgnoreRules combines the drift ignore rules configured for all instances,
+// the rules configured for the given instance and the rules defined in the
+// blueprint metadata persisted for the instance.
+func (c *defaultChecker) resolveIgnoreRules(
+	instanceID string,
+	instanceMetadata map[string]*core.MappingNode,
+) IgnoreRules {
+	return c.ignoreRules.
+		Merge(c.instanceIgnoreRules[instanceID]).
+		Merge(IgnoreRulesFromMetadata(instanceMetadata))
 }
 
 func (c *defaultChecker) checkResourceDrift(
 	ctx context.Context,
 	resource *state.ResourceState,
 	instanceName string,
+	ignoreRules IgnoreRules,
 	params core.BlueprintParams,
 	taggingConfig *provider.TaggingConfig,
 	resourceLogger core.Logger,
@@ -298,6 +501,12 @@ func (c *defaultChecker) checkResourceDrift(
 		core.StringLogField("resourceType", resource.Type),
 	)
 	providerNamespace := provider.ExtractProviderFromItemType(resource.Type)
+	release, err := c.acquireProviderSlot(ctx, providerNamespace)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	resourceImpl, resourceProvider, err := c.getResourceImplementation(ctx, providerNamespace, resource.Type)
 	if err != nil {
 		resourceLogger.Debug(
@@ -397,12 +606,30 @@ func (c *defaultChecker) checkResourceDrift(
 		resourceLogger,
 	)
 
+	// Changes to fields listed in the "ignoreChanges" setting for the resource
+	// or in drift ignore rules for the resource type are not considered drift,
+	// the values from the external state are recorded in the resource state instead.
+	ignoredFields := append(
+		append([]string{}, resource.IgnoreChanges...),
+		ignoreRules.FieldsForResourceType(resource.Type)...,
+	)
+	finalResourceChanges, ignoredChanges := withoutIgnoredChanges(
+		finalResourceChanges,
+		ignoredFields,
+	)
+	if hasChanges(ignoredChanges) {
+		err = c.recordIgnoredFieldValues(ctx, resource, ignoredChanges, resourceLogger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if !hasChanges(finalResourceChanges) {
 		resourceLogger.Debug(
 			"No changes detected indicating that the resource has not drifted" +
 				", updating resource state as not drifted",
 		)
-		_, err = c.stateContainer.Resources().RemoveDrift(
+		removedDrift, err := c.stateContainer.Resources().RemoveDrift(
 			ctx,
 			resource.ResourceID,
 		)
@@ -410,6 +637,16 @@ func (c *defaultChecker) checkResourceDrift(
 			return nil, err
 		}
 
+		if removedDrift.ResourceID != "" {
+			c.publishEvent(ctx, &Event{
+				Type:        EventTypeDriftResolved,
+				ElementKind: ElementKindResource,
+				InstanceID:  resource.InstanceID,
+				ElementID:   resource.ResourceID,
+				ElementName: resource.Name,
+			})
+		}
+
 		return nil, nil
 	}
 
@@ -435,9 +672,33 @@ func (c *defaultChecker) checkResourceDrift(
 		return nil, err
 	}
 
+	// Events are delivered to external systems so values of sensitive fields
+	// are redacted, the persisted drift state retains the original values
+	// as they are needed to reconcile the drifted resource.
+	c.publishEvent(ctx, &Event{
+		Type:        EventTypeResourceDrifted,
+		ElementKind: ElementKindResource,
+		InstanceID:  resource.InstanceID,
+		ElementID:   resource.ResourceID,
+		ElementName: resource.Name,
+		ResourceDrift: redactResourceDriftState(
+			&driftState,
+			specDefinitionOutput.SpecDefinition,
+		),
+	})
+
 	return &driftState, nil
 }
 
+func (c *defaultChecker) publishEvent(ctx context.Context, event *Event) {
+	if c.eventBus == nil {
+		return
+	}
+
+	event.Timestamp = c.clock.Now().Unix()
+	c.eventBus.Publish(ctx, event)
+}
+
 func (c *defaultChecker) getResourceExternalState(
 	ctx context.Context,
 	resource provider.Resource,
@@ -486,7 +747,10 @@ func (c *defaultChecker) handleGetResourceExternalStateRetry(
 
 	if !nextRetryCtx.ExceededMaxRetries {
 		waitTimeMs := provider.CalculateRetryWaitTimeMS(nextRetryCtx.Policy, nextRetryCtx.Attempt)
-		time.Sleep(time.Duration(waitTimeMs) * time.Millisecond)
+		err := waitForRetry(ctx, time.Duration(waitTimeMs)*time.Millisecond)
+		if err != nil {
+			return nil, err
+		}
 		return c.getResourceExternalState(
 			ctx,
 			resource,
@@ -505,6 +769,38 @@ func (c *defaultChecker) handleGetResourceExternalStateRetry(
 	return nil, nil
 }
 
+// acquireProviderSlot waits for a slot to check drift for a resource
+// of the given provider when a concurrency limit has been set for the provider.
+// The returned function must be called to release the slot.
+func (c *defaultChecker) acquireProviderSlot(
+	ctx context.Context,
+	providerNamespace string,
+) (func(), error) {
+	semaphore, hasLimit := c.providerSemaphores[providerNamespace]
+	if !hasLimit {
+		return func() {}, nil
+	}
+
+	select {
+	case semaphore <- struct{}{}:
+		return func() { <-semaphore }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func waitForRetry(ctx context.Context, waitTime time.Duration) error {
+	timer := time.NewTimer(waitTime)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (c *defaultChecker) getResourceImplementation(
 	ctx context.Context,
 	providerNamespace string,
@@ -808,6 +1104,133 @@ func filterRemovedFields(
 	return filteredRemovedFields
 }
 
+// withoutIgnoredChanges splits the provided changes into changes that should
+// be considered drift and changes to fields that match one of the paths
+// in the "ignoreChanges" setting for a resource or the drift ignore rules
+// for the resource type.
+func withoutIgnoredChanges(
+	changes *provider.Changes,
+	ignoreChanges []string,
+) (*provider.Changes, *provider.Changes) {
+	ignoredChanges := &provider.Changes{}
+	if len(ignoreChanges) == 0 {
+		return changes, ignoredChanges
+	}
+
+	patterns := make([]string, len(ignoreChanges))
+	for i, path := range ignoreChanges {
+		patterns[i] = core.ReplaceSpecWithRoot(path)
+	}
+
+	filteredChanges := *changes
+	filteredChanges.ModifiedFields = []provider.FieldChange{}
+	filteredChanges.NewFields = []provider.FieldChange{}
+	filteredChanges.RemovedFields = []string{}
+
+	for _, fieldChange := range changes.ModifiedFields {
+		if isIgnoredField(fieldChange.FieldPath, patterns) {
+			ignoredChanges.ModifiedFields = append(ignoredChanges.ModifiedFields, fieldChange)
+		} else {
+			filteredChanges.ModifiedFields = append(filteredChanges.ModifiedFields, fieldChange)
+		}
+	}
+
+	for _, fieldChange := range changes.NewFields {
+		if isIgnoredField(fieldChange.FieldPath, patterns) {
+			ignoredChanges.NewFields = append(ignoredChanges.NewFields, fieldChange)
+		} else {
+			filteredChanges.NewFields = append(filteredChanges.NewFields, fieldChange)
+		}
+	}
+
+	for _, removedField := range changes.RemovedFields {
+		if isIgnoredField(removedField, patterns) {
+			ignoredChanges.RemovedFields = append(ignoredChanges.RemovedFields, removedField)
+		} else {
+			filteredChanges.RemovedFields = append(filteredChanges.RemovedFields, removedField)
+		}
+	}
+
+	return &filteredChanges, ignoredChanges
+}
+
+func isIgnoredField(fieldPath string, patterns []string) bool {
+	if !isFieldInSpec(fieldPath) {
+		return false
+	}
+
+	searchPath := core.ReplaceSpecWithRoot(fieldPath)
+	for _, pattern := range patterns {
+		// Invalid patterns are reported during validation so they can be
+		// safely treated as not matching here.
+		within, _ := core.PathWithinPattern(searchPath, pattern)
+		if within {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recordIgnoredFieldValues updates the spec data in the persisted state
+// of a resource with the external values of fields that are ignored
+// for drift checking based on the "ignoreChanges" setting for the resource
+// or the drift ignore rules for the resource type.
+func (c *defaultChecker) recordIgnoredFieldValues(
+	ctx context.Context,
+	resource *state.ResourceState,
+	ignoredChanges *provider.Changes,
+	resourceLogger core.Logger,
+) error {
+	specData := core.CopyMappingNode(resource.SpecData)
+	if specData == nil {
+		specData = &core.MappingNode{
+			Fields: map[string]*core.MappingNode{},
+		}
+	}
+
+	fieldChanges := append(
+		append([]provider.FieldChange{}, ignoredChanges.ModifiedFields...),
+		ignoredChanges.NewFields...,
+	)
+	recordedFields := []string{}
+	for _, fieldChange := range fieldChanges {
+		err := core.InjectPathValueReplaceFields(
+			core.ReplaceSpecWithRoot(fieldChange.FieldPath),
+			fieldChange.NewValue,
+			specData,
+			core.MappingNodeMaxTraverseDepth,
+		)
+		if err != nil {
+			resourceLogger.Debug(
+				"Failed to record external value for ignored field in resource state",
+				core.StringLogField("fieldPath", fieldChange.FieldPath),
+				core.ErrorLogField("error", err),
+			)
+			continue
+		}
+		recordedFields = append(recordedFields, fieldChange.FieldPath)
+	}
+
+	if len(recordedFields) == 0 {
+		return nil
+	}
+
+	resourceLogger.Debug(
+		"Recording external values for fields ignored for drift checking in resource state",
+		core.StringsLogField("fields", recordedFields),
+	)
+	updatedResource := *resource
+	updatedResource.SpecData = specData
+	err := c.stateContainer.Resources().Save(ctx, updatedResource)
+	if err != nil {
+		return err
+	}
+	resource.SpecData = specData
+
+	return nil
+}
+
 func isFieldInSpec(fieldPath string) bool {
 	return strings.HasPrefix(fieldPath, "spec.") ||
 		strings.HasPrefix(fieldPath, "spec[")
@@ -840,11 +1263,64 @@ func toResourceDriftFieldChanges(
 				FieldPath:    fieldChange.FieldPath,
 				StateValue:   fieldChange.PrevValue,
 				DriftedValue: fieldChange.NewValue,
+				Sensitive:    fieldChange.Sensitive,
+			}
+		},
+	)
+}
+
+func redactResourceDriftState(
+	driftState *state.ResourceDriftState,
+	specDefinition *provider.ResourceSpecDefinition,
+) *state.ResourceDriftState {
+	redacted := *driftState
+	if specDefinition != nil {
+		redacted.SpecData = provider.RedactSensitiveValues(
+			driftState.SpecData,
+			specDefinition.Schema,
+		)
+	}
+
+	if driftState.Difference != nil {
+		redacted.Difference = &state.ResourceDriftChanges{
+			ModifiedFields:  redactResourceDriftFieldChanges(driftState.Difference.ModifiedFields),
+			NewFields:       redactResourceDriftFieldChanges(driftState.Difference.NewFields),
+			RemovedFields:   driftState.Difference.RemovedFields,
+			UnchangedFields: driftState.Difference.UnchangedFields,
+		}
+	}
+
+	return &redacted
+}
+
+func redactResourceDriftFieldChanges(
+	fieldChanges []*state.ResourceDriftFieldChange,
+) []*state.ResourceDriftFieldChange {
+	return commoncore.Map(
+		fieldChanges,
+		func(fieldChange *state.ResourceDriftFieldChange, _ int) *state.ResourceDriftFieldChange {
+			if !fieldChange.Sensitive {
+				return fieldChange
+			}
+
+			return &state.ResourceDriftFieldChange{
+				FieldPath:    fieldChange.FieldPath,
+				StateValue:   redactedDriftValue(fieldChange.StateValue),
+				DriftedValue: redactedDriftValue(fieldChange.DriftedValue),
+				Sensitive:    true,
 			}
 		},
 	)
 }
 
+func redactedDriftValue(value *core.MappingNode) *core.MappingNode {
+	if value == nil {
+		return nil
+	}
+
+	return core.MappingNodeFromString(provider.RedactedSensitiveValue)
+}
+
 func applyLinksToResourceState(
 	resourceState *state.ResourceState,
 	linksWithResourceDataMappings []state.LinkState,
@@ -1368,10 +1844,19 @@ func (c *defaultChecker) checkLinkDrift(
 	// If no drift detected, remove any existing drift state and return nil
 	if resourceADrift == nil && resourceBDrift == nil && len(intermediaryDrift) == 0 {
 		linkLogger.Debug("no link drift detected, removing any existing drift state")
-		_, err = c.stateContainer.Links().RemoveDrift(ctx, link.LinkID)
+		removedDrift, err := c.stateContainer.Links().RemoveDrift(ctx, link.LinkID)
 		if err != nil {
 			return nil, err
 		}
+		if removedDrift.LinkID != "" {
+			c.publishEvent(ctx, &Event{
+				Type:        EventTypeDriftResolved,
+				ElementKind: ElementKindLink,
+				InstanceID:  link.InstanceID,
+				ElementID:   link.LinkID,
+				ElementName: link.Name,
+			})
+		}
 		return nil, nil
 	}
 
@@ -1392,6 +1877,15 @@ func (c *defaultChecker) checkLinkDrift(
 		return nil, err
 	}
 
+	c.publishEvent(ctx, &Event{
+		Type:        EventTypeLinkDrifted,
+		ElementKind: ElementKindLink,
+		InstanceID:  link.InstanceID,
+		ElementID:   link.LinkID,
+		ElementName: link.Name,
+		LinkDrift:   &driftState,
+	})
+
 	return &driftState, nil
 }
 
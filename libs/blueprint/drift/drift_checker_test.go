@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
@@ -24,6 +25,7 @@ type DriftCheckerTestSuite struct {
 
 const (
 	instance1ID           = "blueprint-instance-1"
+	instance2ID           = "blueprint-instance-2"
 	ordersTableID         = "orders-table"
 	saveOrderFunctionID   = "save-order-function"
 	ordersTableName       = "ordersTable"
@@ -164,6 +166,365 @@ func (s *DriftCheckerTestSuite) Test_checks_drift_for_a_single_resource_where_li
 	s.Assert().Nil(persistedDriftState.Timestamp)
 }
 
+func (s *DriftCheckerTestSuite) Test_ignores_drift_for_fields_in_instance_ignore_rules() {
+	driftChecker := NewDefaultChecker(
+		s.stateContainer,
+		map[string]provider.Provider{
+			"aws": newTestAWSProvider(
+				s.dynamoDBTableExternalState(),
+				s.lambdaFunctionExternalState(),
+			),
+		},
+		changes.NewDefaultResourceChangeGenerator(),
+		core.SystemClock{},
+		core.NewNopLogger(),
+		WithInstanceIgnoreRules(instance1ID, IgnoreRules{
+			"aws/lambda/function": {"spec.handler"},
+		}),
+	)
+
+	driftState, err := driftChecker.CheckResourceDrift(
+		context.Background(),
+		instance1ID,
+		instance1ID,
+		saveOrderFunctionID,
+		createParams(),
+		nil, // taggingConfig
+	)
+	s.Require().NoError(err)
+	s.Assert().Nil(driftState)
+
+	stateAfterCheck, err := s.stateContainer.Resources().Get(
+		context.Background(),
+		saveOrderFunctionID,
+	)
+	s.Require().NoError(err)
+	s.Assert().False(stateAfterCheck.Drifted)
+	// The external value of the ignored field should be recorded in the resource state.
+	s.Assert().Equal(
+		core.StringValue(s.lambdaFunctionExternalState().Fields["handler"]),
+		core.StringValue(stateAfterCheck.SpecData.Fields["handler"]),
+	)
+}
+
+func (s *DriftCheckerTestSuite) Test_ignores_drift_for_fields_in_blueprint_metadata_ignore_rules() {
+	err := s.stateContainer.Metadata().Save(
+		context.Background(),
+		instance1ID,
+		map[string]*core.MappingNode{
+			IgnoreRulesMetadataKey: {
+				Fields: map[string]*core.MappingNode{
+					AnyResourceType: {
+						Items: []*core.MappingNode{
+							core.MappingNodeFromString("spec.handler"),
+						},
+					},
+				},
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	driftStateMap, err := s.driftChecker.CheckDrift(
+		context.Background(),
+		instance1ID,
+		createParams(),
+		nil, // taggingConfig
+	)
+	s.Require().NoError(err)
+	s.Assert().NotContains(driftStateMap, saveOrderFunctionID)
+	s.Assert().Contains(driftStateMap, ordersTableID)
+}
+
+func (s *DriftCheckerTestSuite) Test_publishes_event_when_resource_has_drifted() {
+	events := make(chan *Event, 10)
+	eventBus := NewEventBus(core.NewNopLogger())
+	eventBus.Subscribe(NewChannelSubscriber(events))
+
+	driftChecker := NewDefaultChecker(
+		s.stateContainer,
+		map[string]provider.Provider{
+			"aws": newTestAWSProvider(
+				s.dynamoDBTableExternalState(),
+				s.lambdaFunctionExternalState(),
+			),
+		},
+		changes.NewDefaultResourceChangeGenerator(),
+		core.SystemClock{},
+		core.NewNopLogger(),
+		WithEventBus(eventBus),
+	)
+
+	driftState, err := driftChecker.CheckResourceDrift(
+		context.Background(),
+		instance1ID,
+		instance1ID,
+		saveOrderFunctionID,
+		createParams(),
+		nil, // taggingConfig
+	)
+	s.Require().NoError(err)
+	s.Require().NotNil(driftState)
+
+	s.Require().Len(events, 1)
+	event := <-events
+	s.Assert().Equal(EventTypeResourceDrifted, event.Type)
+	s.Assert().Equal(ElementKindResource, event.ElementKind)
+	s.Assert().Equal(instance1ID, event.InstanceID)
+	s.Assert().Equal(saveOrderFunctionID, event.ElementID)
+	s.Assert().Equal(saveOrderFunctionName, event.ElementName)
+	s.Assert().Equal(driftState, event.ResourceDrift)
+	s.Assert().Greater(event.Timestamp, int64(0))
+}
+
+func (s *DriftCheckerTestSuite) Test_redacts_sensitive_values_in_published_drift_events() {
+	events := make(chan *Event, 10)
+	eventBus := NewEventBus(core.NewNopLogger())
+	eventBus.Subscribe(NewChannelSubscriber(events))
+
+	driftChecker := NewDefaultChecker(
+		s.stateContainer,
+		map[string]provider.Provider{
+			"example": newTestExampleProvider(
+				s.exampleComplexResourceExternalState(),
+			),
+		},
+		changes.NewDefaultResourceChangeGenerator(),
+		core.SystemClock{},
+		core.NewNopLogger(),
+		WithEventBus(eventBus),
+	)
+
+	driftState, err := driftChecker.CheckResourceDrift(
+		context.Background(),
+		instance1ID,
+		instance1ID,
+		complexResourceID,
+		createParams(),
+		nil, // taggingConfig
+	)
+	s.Require().NoError(err)
+	s.Require().NotNil(driftState)
+
+	s.Require().Len(events, 1)
+	event := <-events
+	s.Require().NotNil(event.ResourceDrift)
+
+	sensitivePath := "spec.itemConfig.primaryPort"
+	persistedChange := findResourceDriftFieldChange(driftState.Difference.ModifiedFields, sensitivePath)
+	s.Require().NotNil(persistedChange)
+	s.Assert().True(persistedChange.Sensitive)
+	// The drift state returned to the caller and persisted retains the
+	// original values so that the resource can be reconciled.
+	s.Assert().NotEqual(provider.RedactedSensitiveValue, core.StringValue(persistedChange.DriftedValue))
+
+	eventChange := findResourceDriftFieldChange(event.ResourceDrift.Difference.ModifiedFields, sensitivePath)
+	s.Require().NotNil(eventChange)
+	s.Assert().Equal(provider.RedactedSensitiveValue, core.StringValue(eventChange.StateValue))
+	s.Assert().Equal(provider.RedactedSensitiveValue, core.StringValue(eventChange.DriftedValue))
+
+	eventPort, err := core.GetPathValue(
+		"$.itemConfig.primaryPort",
+		event.ResourceDrift.SpecData,
+		core.MappingNodeMaxTraverseDepth,
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(provider.RedactedSensitiveValue, core.StringValue(eventPort))
+}
+
+func findResourceDriftFieldChange(
+	fieldChanges []*state.ResourceDriftFieldChange,
+	fieldPath string,
+) *state.ResourceDriftFieldChange {
+	for _, fieldChange := range fieldChanges {
+		if fieldChange.FieldPath == fieldPath {
+			return fieldChange
+		}
+	}
+	return nil
+}
+
+func (s *DriftCheckerTestSuite) Test_publishes_event_when_resource_drift_is_resolved() {
+	timestamp := int(time.Now().Unix())
+	err := s.stateContainer.Resources().SaveDrift(
+		context.Background(),
+		state.ResourceDriftState{
+			ResourceID:   saveOrderFunctionID,
+			ResourceName: saveOrderFunctionName,
+			Timestamp:    &timestamp,
+		},
+	)
+	s.Require().NoError(err)
+
+	events := make(chan *Event, 10)
+	eventBus := NewEventBus(core.NewNopLogger())
+	eventBus.Subscribe(NewChannelSubscriber(events))
+
+	driftChecker := NewDefaultChecker(
+		s.stateContainer,
+		map[string]provider.Provider{
+			"aws": newTestAWSProvider(
+				s.dynamoDBTableExternalState(),
+				s.lambdaFunctionExternalState(),
+			),
+		},
+		changes.NewDefaultResourceChangeGenerator(),
+		core.SystemClock{},
+		core.NewNopLogger(),
+		// Ignoring the only field that differs from the external state
+		// means the resource is no longer considered to have drifted.
+		WithInstanceIgnoreRules(instance1ID, IgnoreRules{
+			"aws/lambda/function": {"spec.handler"},
+		}),
+		WithEventBus(eventBus),
+	)
+
+	for range 2 {
+		driftState, err := driftChecker.CheckResourceDrift(
+			context.Background(),
+			instance1ID,
+			instance1ID,
+			saveOrderFunctionID,
+			createParams(),
+			nil, // taggingConfig
+		)
+		s.Require().NoError(err)
+		s.Assert().Nil(driftState)
+	}
+
+	// Only the first check resolves drift, the second check
+	// finds no drift to resolve.
+	s.Require().Len(events, 1)
+	event := <-events
+	s.Assert().Equal(EventTypeDriftResolved, event.Type)
+	s.Assert().Equal(ElementKindResource, event.ElementKind)
+	s.Assert().Equal(saveOrderFunctionID, event.ElementID)
+	s.Assert().Nil(event.ResourceDrift)
+}
+
+func (s *DriftCheckerTestSuite) Test_bounds_concurrent_drift_checks_for_resources() {
+	tableResource := &concurrencyTrackingResource{
+		Resource: &internal.DynamoDBTableResource{
+			ExternalState: s.dynamoDBTableExternalState(),
+		},
+		delay: 20 * time.Millisecond,
+	}
+	awsProvider := newTestAWSProvider(nil, nil).(*internal.ProviderMock)
+	awsProvider.Resources["aws/dynamodb/table"] = tableResource
+	err := s.populateTablesState(instance2ID, 8)
+	s.Require().NoError(err)
+
+	driftChecker := NewDefaultChecker(
+		s.stateContainer,
+		map[string]provider.Provider{
+			"aws": awsProvider,
+		},
+		changes.NewDefaultResourceChangeGenerator(),
+		core.SystemClock{},
+		core.NewNopLogger(),
+		WithMaxConcurrency(3),
+	)
+
+	driftStateMap, err := driftChecker.CheckDrift(
+		context.Background(),
+		instance2ID,
+		createParams(),
+		nil, // taggingConfig
+	)
+	s.Require().NoError(err)
+	s.Assert().Len(driftStateMap, 8)
+	s.Assert().Equal(int32(3), tableResource.maxActive.Load())
+}
+
+func (s *DriftCheckerTestSuite) Test_bounds_concurrent_drift_checks_for_a_provider() {
+	tableResource := &concurrencyTrackingResource{
+		Resource: &internal.DynamoDBTableResource{
+			ExternalState: s.dynamoDBTableExternalState(),
+		},
+		delay: 20 * time.Millisecond,
+	}
+	awsProvider := newTestAWSProvider(nil, nil).(*internal.ProviderMock)
+	awsProvider.Resources["aws/dynamodb/table"] = tableResource
+	err := s.populateTablesState(instance2ID, 6)
+	s.Require().NoError(err)
+
+	driftChecker := NewDefaultChecker(
+		s.stateContainer,
+		map[string]provider.Provider{
+			"aws": awsProvider,
+		},
+		changes.NewDefaultResourceChangeGenerator(),
+		core.SystemClock{},
+		core.NewNopLogger(),
+		WithMaxConcurrency(6),
+		WithProviderConcurrencyLimit("aws", 2),
+	)
+
+	driftStateMap, err := driftChecker.CheckDrift(
+		context.Background(),
+		instance2ID,
+		createParams(),
+		nil, // taggingConfig
+	)
+	s.Require().NoError(err)
+	s.Assert().Len(driftStateMap, 6)
+	s.Assert().Equal(int32(2), tableResource.maxActive.Load())
+}
+
+func (s *DriftCheckerTestSuite) Test_reports_error_for_first_failed_resource_in_id_order() {
+	err := s.populateTablesState(instance2ID, 4)
+	s.Require().NoError(err)
+	// Drift checks for resources of a type that is not implemented
+	// by any of the configured providers will fail.
+	for _, resourceID := range []string{"table-1", "table-3"} {
+		resource, err := s.stateContainer.Resources().Get(context.Background(), resourceID)
+		s.Require().NoError(err)
+		resource.Type = fmt.Sprintf("missing%s/dynamodb/table", resourceID)
+		err = s.stateContainer.Resources().Save(context.Background(), resource)
+		s.Require().NoError(err)
+	}
+
+	_, err = s.driftChecker.CheckDrift(
+		context.Background(),
+		instance2ID,
+		createParams(),
+		nil, // taggingConfig
+	)
+	s.Require().Error(err)
+	s.Assert().Equal("provider missingtable-1 not found", err.Error())
+}
+
+func (s *DriftCheckerTestSuite) populateTablesState(instanceID string, tableCount int) error {
+	instanceState := state.InstanceState{
+		InstanceID:  instanceID,
+		Status:      core.InstanceStatusDeployed,
+		ResourceIDs: map[string]string{},
+		Resources:   map[string]*state.ResourceState{},
+	}
+
+	for i := range tableCount {
+		resourceID := fmt.Sprintf("table-%d", i)
+		resourceName := fmt.Sprintf("table%d", i)
+		instanceState.ResourceIDs[resourceName] = resourceID
+		instanceState.Resources[resourceID] = &state.ResourceState{
+			ResourceID:    resourceID,
+			Name:          resourceName,
+			Type:          "aws/dynamodb/table",
+			InstanceID:    instanceID,
+			Status:        core.ResourceStatusCreated,
+			PreciseStatus: core.PreciseResourceStatusCreated,
+			SpecData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"tableName": core.MappingNodeFromString(resourceName),
+					"region":    core.MappingNodeFromString("us-east-1"),
+				},
+			},
+		}
+	}
+
+	return s.stateContainer.Instances().Save(context.Background(), instanceState)
+}
+
 func (s *DriftCheckerTestSuite) populateCurrentState(includeLinkData bool) error {
 	instanceState := state.InstanceState{
 		InstanceID: instance1ID,
@@ -480,6 +841,47 @@ func (s *DriftCheckerTestSuite) Test_checks_link_drift_via_resource_data_mapping
 	s.Assert().Equal(linkDriftState.LinkName, persistedDriftState.LinkName)
 }
 
+func (s *DriftCheckerTestSuite) Test_publishes_event_when_link_has_drifted() {
+	err := s.populateLinkDriftState()
+	s.Require().NoError(err)
+
+	events := make(chan *Event, 10)
+	eventBus := NewEventBus(core.NewNopLogger())
+	eventBus.Subscribe(NewChannelSubscriber(events))
+
+	driftChecker := NewDefaultChecker(
+		s.stateContainer,
+		map[string]provider.Provider{
+			"aws": newTestAWSProvider(
+				s.dynamoDBTableExternalState(),
+				s.lambdaFunctionExternalState(),
+			),
+		},
+		changes.NewDefaultResourceChangeGenerator(),
+		core.SystemClock{},
+		core.NewNopLogger(),
+		WithEventBus(eventBus),
+	)
+
+	linkDriftState, err := driftChecker.CheckLinkDrift(
+		context.Background(),
+		instance1ID,
+		"test-link-drift-1",
+		createParams(),
+		nil, // taggingConfig
+	)
+	s.Require().NoError(err)
+	s.Require().NotNil(linkDriftState)
+
+	s.Require().Len(events, 1)
+	event := <-events
+	s.Assert().Equal(EventTypeLinkDrifted, event.Type)
+	s.Assert().Equal(ElementKindLink, event.ElementKind)
+	s.Assert().Equal(instance1ID, event.InstanceID)
+	s.Assert().Equal("test-link-drift-1", event.ElementID)
+	s.Assert().Equal(linkDriftState, event.LinkDrift)
+}
+
 func (s *DriftCheckerTestSuite) Test_checks_all_link_drift_in_instance() {
 	// Set up state with multiple links
 	err := s.populateLinkDriftState()
@@ -0,0 +1,264 @@
+package drift
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// EventType is the type of a drift event published
+// to subscribers of an event bus.
+type EventType string
+
+const (
+	// EventTypeResourceDrifted is published when a drift check
+	// finds that a resource has drifted from the persisted state.
+	EventTypeResourceDrifted EventType = "resourceDrifted"
+	// EventTypeLinkDrifted is published when a drift check
+	// finds that a link has drifted from the persisted state.
+	EventTypeLinkDrifted EventType = "linkDrifted"
+	// EventTypeDriftResolved is published when a drift check
+	// finds that a resource or link that was previously marked as drifted
+	// is now in sync with the persisted state.
+	EventTypeDriftResolved EventType = "driftResolved"
+)
+
+// ElementKind is the kind of blueprint element
+// that a drift event is for.
+type ElementKind string
+
+const (
+	// ElementKindResource indicates a drift event is for a resource.
+	ElementKindResource ElementKind = "resource"
+	// ElementKindLink indicates a drift event is for a link.
+	ElementKindLink ElementKind = "link"
+)
+
+// Event holds information about a change in the drift status
+// of a resource or link in a blueprint instance.
+type Event struct {
+	Type        EventType   `json:"type"`
+	ElementKind ElementKind `json:"elementKind"`
+	InstanceID  string      `json:"instanceId"`
+	ElementID   string      `json:"elementId"`
+	ElementName string      `json:"elementName"`
+	// ResourceDrift holds the drift state for a resource
+	// for EventTypeResourceDrifted events.
+	ResourceDrift *state.ResourceDriftState `json:"resourceDrift,omitempty"`
+	// LinkDrift holds the drift state for a link
+	// for EventTypeLinkDrifted events.
+	LinkDrift *state.LinkDriftState `json:"linkDrift,omitempty"`
+	// Timestamp is the unix timestamp in seconds
+	// for when the event was produced.
+	Timestamp int64 `json:"timestamp"`
+}
+
+// EventSubscriber is an interface for a receiver of drift events.
+// Subscribers can be used to integrate drift detection with external
+// systems such as chat notifiers or ticketing systems.
+type EventSubscriber interface {
+	// Receive handles a single drift event.
+	// This may be called concurrently for events produced by drift checks
+	// that are carried out in parallel.
+	Receive(ctx context.Context, event *Event) error
+}
+
+// EventBus is an interface for a service that delivers drift events
+// to registered subscribers.
+type EventBus interface {
+	// Subscribe registers a subscriber that will receive all
+	// events published to the bus.
+	// The returned function removes the subscriber from the bus.
+	Subscribe(subscriber EventSubscriber) func()
+	// Publish delivers the given event to all registered subscribers.
+	// Failures to deliver events to subscribers must not prevent
+	// other subscribers from receiving the event.
+	Publish(ctx context.Context, event *Event)
+}
+
+type defaultEventBus struct {
+	mu          sync.RWMutex
+	subscribers map[int]EventSubscriber
+	nextID      int
+	logger      core.Logger
+}
+
+// NewEventBus creates a new instance of the default drift event bus
+// implementation that delivers events to subscribers in the order
+// they were registered.
+// Events are delivered synchronously, subscribers that carry out
+// slow work should hand events off to be processed in the background.
+// Errors returned by subscribers are logged and do not prevent
+// delivery to other subscribers.
+func NewEventBus(logger core.Logger) EventBus {
+	return &defaultEventBus{
+		subscribers: map[int]EventSubscriber{},
+		logger:      logger,
+	}
+}
+
+func (b *defaultEventBus) Subscribe(subscriber EventSubscriber) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = subscriber
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers, id)
+	}
+}
+
+func (b *defaultEventBus) Publish(ctx context.Context, event *Event) {
+	for _, subscriber := range b.currentSubscribers() {
+		err := subscriber.Receive(ctx, event)
+		if err != nil {
+			b.logger.Warn(
+				"failed to deliver drift event to subscriber",
+				core.StringLogField("eventType", string(event.Type)),
+				core.StringLogField("elementId", event.ElementID),
+				core.ErrorLogField("error", err),
+			)
+		}
+	}
+}
+
+func (b *defaultEventBus) currentSubscribers() []EventSubscriber {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	ids := make([]int, 0, len(b.subscribers))
+	for id := range b.subscribers {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	subscribers := make([]EventSubscriber, 0, len(ids))
+	for _, id := range ids {
+		subscribers = append(subscribers, b.subscribers[id])
+	}
+	return subscribers
+}
+
+// ChannelSubscriber is a drift event subscriber that sends
+// events to a Go channel.
+type ChannelSubscriber struct {
+	events chan<- *Event
+}
+
+// NewChannelSubscriber creates a new drift event subscriber
+// that sends events to the provided channel.
+// Sending an event blocks until the channel has capacity or the
+// context for the drift check is cancelled, a buffered channel should be
+// used to avoid slowing down drift checks.
+func NewChannelSubscriber(events chan<- *Event) *ChannelSubscriber {
+	return &ChannelSubscriber{
+		events: events,
+	}
+}
+
+func (s *ChannelSubscriber) Receive(ctx context.Context, event *Event) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case s.events <- event:
+		return nil
+	}
+}
+
+const (
+	// DefaultWebhookTimeout is the default timeout for requests
+	// made to deliver drift events to a webhook endpoint.
+	DefaultWebhookTimeout = 10 * time.Second
+)
+
+// WebhookSubscriber is a drift event subscriber that
+// delivers events as JSON to an HTTP endpoint with POST requests.
+type WebhookSubscriber struct {
+	url        string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+// WebhookSubscriberOption is a function that can be used to configure
+// a webhook drift event subscriber.
+type WebhookSubscriberOption func(*WebhookSubscriber)
+
+// WithWebhookHeaders sets additional headers to include in requests
+// made to the webhook endpoint (e.g. an authorization header).
+func WithWebhookHeaders(headers map[string]string) WebhookSubscriberOption {
+	return func(s *WebhookSubscriber) {
+		for key, value := range headers {
+			s.headers[key] = value
+		}
+	}
+}
+
+// WithWebhookHTTPClient sets the HTTP client used to make requests
+// to the webhook endpoint.
+// When not set, a client with a timeout of DefaultWebhookTimeout is used.
+func WithWebhookHTTPClient(httpClient *http.Client) WebhookSubscriberOption {
+	return func(s *WebhookSubscriber) {
+		s.httpClient = httpClient
+	}
+}
+
+// NewWebhookSubscriber creates a new drift event subscriber that
+// delivers events to the given URL.
+func NewWebhookSubscriber(url string, opts ...WebhookSubscriberOption) *WebhookSubscriber {
+	subscriber := &WebhookSubscriber{
+		url:     url,
+		headers: map[string]string{},
+		httpClient: &http.Client{
+			Timeout: DefaultWebhookTimeout,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(subscriber)
+	}
+
+	return subscriber
+}
+
+func (s *WebhookSubscriber) Receive(ctx context.Context, event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range s.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf(
+			"webhook endpoint responded with unexpected status code %d",
+			resp.StatusCode,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventBusTestSuite struct {
+	suite.Suite
+}
+
+func (s *EventBusTestSuite) Test_delivers_events_to_subscribers_when_a_subscriber_fails() {
+	eventBus := NewEventBus(core.NewNopLogger())
+	failing := &failingSubscriber{}
+	events := make(chan *Event, 10)
+	eventBus.Subscribe(failing)
+	eventBus.Subscribe(NewChannelSubscriber(events))
+
+	event := &Event{
+		Type:        EventTypeDriftResolved,
+		ElementKind: ElementKindResource,
+		InstanceID:  instance1ID,
+		ElementID:   ordersTableID,
+		ElementName: ordersTableName,
+	}
+	eventBus.Publish(context.Background(), event)
+
+	s.Assert().Equal(1, failing.calls)
+	s.Require().Len(events, 1)
+	s.Assert().Equal(event, <-events)
+}
+
+func (s *EventBusTestSuite) Test_stops_delivering_events_to_unsubscribed_subscribers() {
+	eventBus := NewEventBus(core.NewNopLogger())
+	events := make(chan *Event, 10)
+	unsubscribe := eventBus.Subscribe(NewChannelSubscriber(events))
+
+	eventBus.Publish(context.Background(), &Event{Type: EventTypeResourceDrifted})
+	unsubscribe()
+	eventBus.Publish(context.Background(), &Event{Type: EventTypeLinkDrifted})
+
+	s.Require().Len(events, 1)
+	s.Assert().Equal(EventTypeResourceDrifted, (<-events).Type)
+}
+
+func (s *EventBusTestSuite) Test_webhook_subscriber_posts_events_as_json() {
+	var receivedEvent *Event
+	var receivedHeaders http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedHeaders = r.Header.Clone()
+		body, err := io.ReadAll(r.Body)
+		s.Require().NoError(err)
+		receivedEvent = &Event{}
+		s.Require().NoError(json.Unmarshal(body, receivedEvent))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	subscriber := NewWebhookSubscriber(
+		server.URL,
+		WithWebhookHeaders(map[string]string{
+			"Authorization": "Bearer test-token",
+		}),
+	)
+
+	timestamp := 1750000000
+	event := &Event{
+		Type:        EventTypeResourceDrifted,
+		ElementKind: ElementKindResource,
+		InstanceID:  instance1ID,
+		ElementID:   ordersTableID,
+		ElementName: ordersTableName,
+		ResourceDrift: &state.ResourceDriftState{
+			ResourceID:   ordersTableID,
+			ResourceName: ordersTableName,
+			Timestamp:    &timestamp,
+		},
+		Timestamp: 1750000000,
+	}
+	err := subscriber.Receive(context.Background(), event)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(event, receivedEvent)
+	s.Assert().Equal("application/json", receivedHeaders.Get("Content-Type"))
+	s.Assert().Equal("Bearer test-token", receivedHeaders.Get("Authorization"))
+}
+
+func (s *EventBusTestSuite) Test_webhook_subscriber_reports_error_for_unsuccessful_response() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	subscriber := NewWebhookSubscriber(server.URL)
+	err := subscriber.Receive(context.Background(), &Event{Type: EventTypeLinkDrifted})
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "500")
+}
+
+type failingSubscriber struct {
+	calls int
+}
+
+func (s *failingSubscriber) Receive(ctx context.Context, event *Event) error {
+	s.calls++
+	return errors.New("subscriber failure")
+}
+
+func TestEventBusTestSuite(t *testing.T) {
+	suite.Run(t, new(EventBusTestSuite))
+}
@@ -0,0 +1,90 @@
+package drift
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+const (
+	// IgnoreRulesMetadataKey is the key in blueprint metadata that holds
+	// drift ignore rules that apply to all resources in the blueprint.
+	// Blueprint metadata is persisted with the blueprint instance on deployment,
+	// so rules defined in a blueprint will be applied to drift checks for
+	// instances of the blueprint.
+	//
+	// For example:
+	//
+	//	metadata:
+	//	  driftIgnore:
+	//	    aws/lambda/function:
+	//	      - spec.lastModified
+	//	    "*":
+	//	      - spec.tags
+	IgnoreRulesMetadataKey = "driftIgnore"
+	// AnyResourceType can be used as the resource type in drift ignore rules
+	// to ignore the provided field paths for all resource types.
+	AnyResourceType = "*"
+)
+
+// IgnoreRules holds a mapping of resource types to field paths
+// that should not be considered when checking resources of that type for drift.
+// This is useful for known-noisy fields such as timestamps managed by the provider
+// or tags injected by external systems.
+//
+// Field paths are in the same format as the "ignoreChanges" resource setting
+// (e.g. "spec.lastModified" or "spec.tags[\"owner\"]").
+// Changes to ignored fields are not reported as drift, the external values are
+// recorded in the resource state instead.
+type IgnoreRules map[string][]string
+
+// FieldsForResourceType returns the field paths that should be ignored
+// for resources of the given type, including field paths that
+// are ignored for all resource types.
+func (r IgnoreRules) FieldsForResourceType(resourceType string) []string {
+	fields := []string{}
+	fields = append(fields, r[AnyResourceType]...)
+	if resourceType != AnyResourceType {
+		fields = append(fields, r[resourceType]...)
+	}
+	return fields
+}
+
+// Merge returns a new set of ignore rules that combines the current
+// rules with the provided rules.
+func (r IgnoreRules) Merge(other IgnoreRules) IgnoreRules {
+	merged := IgnoreRules{}
+	for resourceType, fields := range r {
+		merged[resourceType] = append(merged[resourceType], fields...)
+	}
+	for resourceType, fields := range other {
+		merged[resourceType] = append(merged[resourceType], fields...)
+	}
+	return merged
+}
+
+// IgnoreRulesFromMetadata extracts drift ignore rules from the provided
+// blueprint instance metadata.
+// Rules are expected to be under the IgnoreRulesMetadataKey as a mapping
+// of resource types to lists of field paths, values that do not match
+// this structure are ignored.
+func IgnoreRulesFromMetadata(metadata map[string]*core.MappingNode) IgnoreRules {
+	rules := IgnoreRules{}
+	rulesNode, hasRules := metadata[IgnoreRulesMetadataKey]
+	if !hasRules || !core.IsObjectMappingNode(rulesNode) {
+		return rules
+	}
+
+	for resourceType, fieldsNode := range rulesNode.Fields {
+		if !core.IsArrayMappingNode(fieldsNode) {
+			continue
+		}
+
+		for _, fieldNode := range fieldsNode.Items {
+			fieldPath := core.StringValue(fieldNode)
+			if fieldPath != "" {
+				rules[resourceType] = append(rules[resourceType], fieldPath)
+			}
+		}
+	}
+
+	return rules
+}
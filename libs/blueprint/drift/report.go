@@ -0,0 +1,600 @@
+package drift
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+// ReportFormat is the output format for a drift report.
+type ReportFormat string
+
+const (
+	// ReportFormatJSON renders a drift report as indented JSON.
+	ReportFormatJSON ReportFormat = "json"
+	// ReportFormatMarkdown renders a drift report as a Markdown document.
+	ReportFormatMarkdown ReportFormat = "markdown"
+	// ReportFormatHTML renders a drift report as a standalone HTML document.
+	ReportFormatHTML ReportFormat = "html"
+)
+
+// FieldChangeType is the type of change for a field
+// in a drift report.
+type FieldChangeType string
+
+const (
+	// FieldChangeTypeModified indicates a field has a different value
+	// in the upstream provider to the value persisted in the blueprint state.
+	FieldChangeTypeModified FieldChangeType = "modified"
+	// FieldChangeTypeNew indicates a field has been set in the upstream
+	// provider that is not present in the blueprint state.
+	FieldChangeTypeNew FieldChangeType = "new"
+	// FieldChangeTypeRemoved indicates a field that is present in the
+	// blueprint state is no longer set in the upstream provider.
+	FieldChangeTypeRemoved FieldChangeType = "removed"
+)
+
+// ReportGenerator is an interface for a service that produces
+// drift reports for audits from the drift state of a blueprint instance.
+type ReportGenerator interface {
+	// Generate produces a structured drift report from the
+	// provided resource and link drift states.
+	Generate(input *ReportInput) *Report
+	// Render writes the provided report to the given writer
+	// in the requested format.
+	Render(report *Report, format ReportFormat, w io.Writer) error
+}
+
+// ReportInput holds the drift state that a drift report is generated from.
+type ReportInput struct {
+	InstanceID   string
+	InstanceName string
+	// ResourceDrift is a mapping of resource IDs to their drift state.
+	ResourceDrift map[string]*state.ResourceDriftState
+	// LinkDrift is a mapping of link IDs to their drift state.
+	LinkDrift map[string]*state.LinkDriftState
+	// ResourceTypes is a mapping of resource IDs to resource types
+	// that is used to group drifted resources by type in the report summary.
+	// Resources without an entry are reported with an unknown type.
+	ResourceTypes map[string]string
+}
+
+// Report holds a structured drift report for a blueprint instance.
+type Report struct {
+	InstanceID   string `json:"instanceId"`
+	InstanceName string `json:"instanceName"`
+	// GeneratedAt is the unix timestamp in seconds
+	// for when the report was generated.
+	GeneratedAt int64                  `json:"generatedAt"`
+	Summary     *ReportSummary         `json:"summary"`
+	Resources   []*ResourceDriftReport `json:"resources"`
+	Links       []*LinkDriftReport     `json:"links"`
+}
+
+// ReportSummary holds aggregate drift information for a report.
+type ReportSummary struct {
+	DriftedResourceCount int                    `json:"driftedResourceCount"`
+	DriftedLinkCount     int                    `json:"driftedLinkCount"`
+	ResourceTypes        []*ResourceTypeSummary `json:"resourceTypes"`
+}
+
+// ResourceTypeSummary holds aggregate drift information
+// for drifted resources of a single resource type.
+type ResourceTypeSummary struct {
+	ResourceType         string `json:"resourceType"`
+	DriftedResourceCount int    `json:"driftedResourceCount"`
+	ModifiedFieldCount   int    `json:"modifiedFieldCount"`
+	NewFieldCount        int    `json:"newFieldCount"`
+	RemovedFieldCount    int    `json:"removedFieldCount"`
+}
+
+// ResourceDriftReport holds the drift details for a single resource.
+type ResourceDriftReport struct {
+	ResourceID   string `json:"resourceId"`
+	ResourceName string `json:"resourceName"`
+	ResourceType string `json:"resourceType"`
+	// DetectedAt is the unix timestamp in seconds for when drift
+	// was detected for the resource.
+	DetectedAt   *int         `json:"detectedAt,omitempty"`
+	FieldChanges []*FieldDiff `json:"fieldChanges"`
+}
+
+// LinkDriftReport holds the drift details for a single link.
+type LinkDriftReport struct {
+	LinkID   string `json:"linkId"`
+	LinkName string `json:"linkName"`
+	// DetectedAt is the unix timestamp in seconds for when drift
+	// was detected for the link.
+	DetectedAt   *int         `json:"detectedAt,omitempty"`
+	FieldChanges []*FieldDiff `json:"fieldChanges"`
+}
+
+// FieldDiff holds a field-level difference between the blueprint
+// state and the state of a resource in the upstream provider.
+type FieldDiff struct {
+	// Target is the name of the linked resource or the ID of the
+	// intermediary resource that a link field change applies to.
+	// This is empty for resource field changes.
+	Target     string          `json:"target,omitempty"`
+	FieldPath  string          `json:"fieldPath"`
+	ChangeType FieldChangeType `json:"changeType"`
+	// StateValue is the JSON representation of the value persisted
+	// in the blueprint state.
+	StateValue string `json:"stateValue,omitempty"`
+	// DriftedValue is the JSON representation of the value found
+	// in the upstream provider.
+	DriftedValue string `json:"driftedValue,omitempty"`
+	// Sensitive indicates whether the field is marked as sensitive,
+	// when true, the state and drifted values are redacted.
+	Sensitive bool `json:"sensitive,omitempty"`
+}
+
+const unknownResourceType = "unknown"
+
+// ResourceTypesFromInstance derives a mapping of resource IDs to resource
+// types from the state of a blueprint instance to be used as the
+// ResourceTypes for a drift report.
+func ResourceTypesFromInstance(instanceState *state.InstanceState) map[string]string {
+	resourceTypes := map[string]string{}
+	if instanceState == nil {
+		return resourceTypes
+	}
+
+	for resourceID, resource := range instanceState.Resources {
+		if resource != nil {
+			resourceTypes[resourceID] = resource.Type
+		}
+	}
+
+	return resourceTypes
+}
+
+type defaultReportGenerator struct {
+	clock core.Clock
+}
+
+// NewReportGenerator creates a new instance of the default
+// drift report generator.
+func NewReportGenerator(clock core.Clock) ReportGenerator {
+	return &defaultReportGenerator{
+		clock: clock,
+	}
+}
+
+func (g *defaultReportGenerator) Generate(input *ReportInput) *Report {
+	report := &Report{
+		InstanceID:   input.InstanceID,
+		InstanceName: input.InstanceName,
+		GeneratedAt:  g.clock.Now().Unix(),
+		Resources:    []*ResourceDriftReport{},
+		Links:        []*LinkDriftReport{},
+	}
+
+	typeSummaries := map[string]*ResourceTypeSummary{}
+	for resourceID, driftState := range input.ResourceDrift {
+		if driftState == nil {
+			continue
+		}
+
+		resourceType, hasType := input.ResourceTypes[resourceID]
+		if !hasType {
+			resourceType = unknownResourceType
+		}
+		resourceReport := &ResourceDriftReport{
+			ResourceID:   resourceID,
+			ResourceName: driftState.ResourceName,
+			ResourceType: resourceType,
+			DetectedAt:   driftState.Timestamp,
+			FieldChanges: resourceFieldDiffs(driftState.Difference),
+		}
+		report.Resources = append(report.Resources, resourceReport)
+		addToTypeSummary(typeSummaries, resourceReport)
+	}
+	slices.SortFunc(report.Resources, func(a, b *ResourceDriftReport) int {
+		return cmp.Or(
+			strings.Compare(a.ResourceName, b.ResourceName),
+			strings.Compare(a.ResourceID, b.ResourceID),
+		)
+	})
+
+	for linkID, driftState := range input.LinkDrift {
+		if driftState == nil {
+			continue
+		}
+
+		report.Links = append(report.Links, &LinkDriftReport{
+			LinkID:       linkID,
+			LinkName:     driftState.LinkName,
+			DetectedAt:   driftState.Timestamp,
+			FieldChanges: linkFieldDiffs(driftState),
+		})
+	}
+	slices.SortFunc(report.Links, func(a, b *LinkDriftReport) int {
+		return cmp.Or(
+			strings.Compare(a.LinkName, b.LinkName),
+			strings.Compare(a.LinkID, b.LinkID),
+		)
+	})
+
+	typeSummaryList := make([]*ResourceTypeSummary, 0, len(typeSummaries))
+	for _, typeSummary := range typeSummaries {
+		typeSummaryList = append(typeSummaryList, typeSummary)
+	}
+	slices.SortFunc(typeSummaryList, func(a, b *ResourceTypeSummary) int {
+		return strings.Compare(a.ResourceType, b.ResourceType)
+	})
+
+	report.Summary = &ReportSummary{
+		DriftedResourceCount: len(report.Resources),
+		DriftedLinkCount:     len(report.Links),
+		ResourceTypes:        typeSummaryList,
+	}
+
+	return report
+}
+
+func addToTypeSummary(
+	typeSummaries map[string]*ResourceTypeSummary,
+	resourceReport *ResourceDriftReport,
+) {
+	typeSummary, exists := typeSummaries[resourceReport.ResourceType]
+	if !exists {
+		typeSummary = &ResourceTypeSummary{
+			ResourceType: resourceReport.ResourceType,
+		}
+		typeSummaries[resourceReport.ResourceType] = typeSummary
+	}
+
+	typeSummary.DriftedResourceCount++
+	for _, fieldChange := range resourceReport.FieldChanges {
+		switch fieldChange.ChangeType {
+		case FieldChangeTypeModified:
+			typeSummary.ModifiedFieldCount++
+		case FieldChangeTypeNew:
+			typeSummary.NewFieldCount++
+		case FieldChangeTypeRemoved:
+			typeSummary.RemovedFieldCount++
+		}
+	}
+}
+
+func resourceFieldDiffs(changes *state.ResourceDriftChanges) []*FieldDiff {
+	diffs := []*FieldDiff{}
+	if changes == nil {
+		return diffs
+	}
+
+	for _, fieldChange := range changes.ModifiedFields {
+		diffs = append(diffs, &FieldDiff{
+			FieldPath:    fieldChange.FieldPath,
+			ChangeType:   FieldChangeTypeModified,
+			StateValue:   reportFieldValue(fieldChange.StateValue, fieldChange.Sensitive),
+			DriftedValue: reportFieldValue(fieldChange.DriftedValue, fieldChange.Sensitive),
+			Sensitive:    fieldChange.Sensitive,
+		})
+	}
+
+	for _, fieldChange := range changes.NewFields {
+		diffs = append(diffs, &FieldDiff{
+			FieldPath:    fieldChange.FieldPath,
+			ChangeType:   FieldChangeTypeNew,
+			DriftedValue: reportFieldValue(fieldChange.DriftedValue, fieldChange.Sensitive),
+			Sensitive:    fieldChange.Sensitive,
+		})
+	}
+
+	for _, fieldPath := range changes.RemovedFields {
+		diffs = append(diffs, &FieldDiff{
+			FieldPath:  fieldPath,
+			ChangeType: FieldChangeTypeRemoved,
+		})
+	}
+
+	return diffs
+}
+
+func linkFieldDiffs(driftState *state.LinkDriftState) []*FieldDiff {
+	diffs := []*FieldDiff{}
+	for _, resourceDrift := range []*state.LinkResourceDrift{
+		driftState.ResourceADrift,
+		driftState.ResourceBDrift,
+	} {
+		if resourceDrift == nil {
+			continue
+		}
+
+		for _, fieldChange := range resourceDrift.MappedFieldChanges {
+			diffs = append(diffs, &FieldDiff{
+				Target:       resourceDrift.ResourceName,
+				FieldPath:    fieldChange.ResourceFieldPath,
+				ChangeType:   FieldChangeTypeModified,
+				StateValue:   reportValue(fieldChange.LinkDataValue),
+				DriftedValue: reportValue(fieldChange.ExternalValue),
+			})
+		}
+	}
+
+	intermediaryIDs := make([]string, 0, len(driftState.IntermediaryDrift))
+	for intermediaryID := range driftState.IntermediaryDrift {
+		intermediaryIDs = append(intermediaryIDs, intermediaryID)
+	}
+	slices.Sort(intermediaryIDs)
+
+	for _, intermediaryID := range intermediaryIDs {
+		diffs = append(
+			diffs,
+			intermediaryFieldDiffs(
+				intermediaryID,
+				driftState.IntermediaryDrift[intermediaryID],
+			)...,
+		)
+	}
+
+	return diffs
+}
+
+func intermediaryFieldDiffs(
+	intermediaryID string,
+	intermediaryDrift *state.IntermediaryDriftState,
+) []*FieldDiff {
+	if intermediaryDrift == nil || intermediaryDrift.Changes == nil {
+		return []*FieldDiff{}
+	}
+
+	diffs := []*FieldDiff{}
+	changeGroups := []struct {
+		changeType FieldChangeType
+		changes    []state.IntermediaryFieldChange
+	}{
+		{FieldChangeTypeModified, intermediaryDrift.Changes.ModifiedFields},
+		{FieldChangeTypeNew, intermediaryDrift.Changes.NewFields},
+		{FieldChangeTypeRemoved, intermediaryDrift.Changes.RemovedFields},
+	}
+	for _, group := range changeGroups {
+		for _, fieldChange := range group.changes {
+			diffs = append(diffs, &FieldDiff{
+				Target:       intermediaryID,
+				FieldPath:    fieldChange.FieldPath,
+				ChangeType:   group.changeType,
+				StateValue:   reportValue(fieldChange.PrevValue),
+				DriftedValue: reportValue(fieldChange.NewValue),
+			})
+		}
+	}
+
+	return diffs
+}
+
+func reportFieldValue(value *core.MappingNode, sensitive bool) string {
+	if sensitive && value != nil {
+		return provider.RedactedSensitiveValue
+	}
+
+	return reportValue(value)
+}
+
+func reportValue(value *core.MappingNode) string {
+	if value == nil {
+		return ""
+	}
+
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("<unrenderable value: %s>", err)
+	}
+
+	return string(valueBytes)
+}
+
+func (g *defaultReportGenerator) Render(
+	report *Report,
+	format ReportFormat,
+	w io.Writer,
+) error {
+	switch format {
+	case ReportFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	case ReportFormatMarkdown:
+		_, err := io.WriteString(w, renderMarkdownReport(report))
+		return err
+	case ReportFormatHTML:
+		return htmlReportTemplate.Execute(w, report)
+	default:
+		return fmt.Errorf("unsupported drift report format %q", format)
+	}
+}
+
+func renderMarkdownReport(report *Report) string {
+	sb := &strings.Builder{}
+	sb.WriteString("# Drift Report\n\n")
+	fmt.Fprintf(sb, "- **Instance:** %s (`%s`)\n", report.InstanceName, report.InstanceID)
+	fmt.Fprintf(sb, "- **Generated at:** %s\n", formatReportTimestamp(report.GeneratedAt))
+	fmt.Fprintf(sb, "- **Drifted resources:** %d\n", report.Summary.DriftedResourceCount)
+	fmt.Fprintf(sb, "- **Drifted links:** %d\n", report.Summary.DriftedLinkCount)
+
+	if len(report.Summary.ResourceTypes) > 0 {
+		sb.WriteString("\n## Summary by resource type\n\n")
+		sb.WriteString("| Resource type | Drifted resources | Modified fields | New fields | Removed fields |\n")
+		sb.WriteString("| --- | --- | --- | --- | --- |\n")
+		for _, typeSummary := range report.Summary.ResourceTypes {
+			fmt.Fprintf(
+				sb,
+				"| %s | %d | %d | %d | %d |\n",
+				markdownCell(typeSummary.ResourceType),
+				typeSummary.DriftedResourceCount,
+				typeSummary.ModifiedFieldCount,
+				typeSummary.NewFieldCount,
+				typeSummary.RemovedFieldCount,
+			)
+		}
+	}
+
+	if len(report.Resources) > 0 {
+		sb.WriteString("\n## Resources\n")
+		for _, resourceReport := range report.Resources {
+			fmt.Fprintf(sb, "\n### %s (`%s`)\n\n", resourceReport.ResourceName, resourceReport.ResourceID)
+			fmt.Fprintf(sb, "- **Type:** %s\n", resourceReport.ResourceType)
+			writeMarkdownDetectedAt(sb, resourceReport.DetectedAt)
+			writeMarkdownFieldChanges(sb, resourceReport.FieldChanges, false)
+		}
+	}
+
+	if len(report.Links) > 0 {
+		sb.WriteString("\n## Links\n")
+		for _, linkReport := range report.Links {
+			fmt.Fprintf(sb, "\n### %s (`%s`)\n\n", linkReport.LinkName, linkReport.LinkID)
+			writeMarkdownDetectedAt(sb, linkReport.DetectedAt)
+			writeMarkdownFieldChanges(sb, linkReport.FieldChanges, true)
+		}
+	}
+
+	return sb.String()
+}
+
+func writeMarkdownDetectedAt(sb *strings.Builder, detectedAt *int) {
+	if detectedAt == nil {
+		return
+	}
+
+	fmt.Fprintf(sb, "- **Detected at:** %s\n", formatReportTimestamp(int64(*detectedAt)))
+}
+
+func writeMarkdownFieldChanges(sb *strings.Builder, fieldChanges []*FieldDiff, includeTarget bool) {
+	if len(fieldChanges) == 0 {
+		return
+	}
+
+	sb.WriteString("\n")
+	if includeTarget {
+		sb.WriteString("| Target | Field | Change | State value | Drifted value |\n")
+		sb.WriteString("| --- | --- | --- | --- | --- |\n")
+	} else {
+		sb.WriteString("| Field | Change | State value | Drifted value |\n")
+		sb.WriteString("| --- | --- | --- | --- |\n")
+	}
+
+	for _, fieldChange := range fieldChanges {
+		if includeTarget {
+			fmt.Fprintf(sb, "| %s ", markdownCell(fieldChange.Target))
+		}
+		fmt.Fprintf(
+			sb,
+			"| `%s` | %s | %s | %s |\n",
+			fieldChange.FieldPath,
+			fieldChange.ChangeType,
+			markdownCodeCell(fieldChange.StateValue),
+			markdownCodeCell(fieldChange.DriftedValue),
+		)
+	}
+}
+
+func markdownCell(value string) string {
+	return strings.ReplaceAll(value, "|", "\\|")
+}
+
+func markdownCodeCell(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("`%s`", markdownCell(value))
+}
+
+func formatReportTimestamp(timestamp int64) string {
+	return time.Unix(timestamp, 0).UTC().Format(time.RFC3339)
+}
+
+var htmlReportTemplate = template.Must(
+	template.New("driftReport").
+		Funcs(template.FuncMap{
+			"formatTimestamp": formatReportTimestamp,
+			"derefTimestamp": func(timestamp *int) int64 {
+				return int64(*timestamp)
+			},
+		}).
+		Parse(htmlReportTemplateSource),
+)
+
+const htmlReportTemplateSource = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Drift Report - {{ .InstanceName }}</title>
+<style>
+body { font-family: sans-serif; margin: 2rem; color: #1f2328; }
+table { border-collapse: collapse; margin: 1rem 0; }
+th, td { border: 1px solid #d0d7de; padding: 0.4rem 0.8rem; text-align: left; vertical-align: top; }
+th { background: #f6f8fa; }
+code { font-family: monospace; white-space: pre-wrap; word-break: break-all; }
+</style>
+</head>
+<body>
+<h1>Drift Report</h1>
+<ul>
+<li><strong>Instance:</strong> {{ .InstanceName }} (<code>{{ .InstanceID }}</code>)</li>
+<li><strong>Generated at:</strong> {{ formatTimestamp .GeneratedAt }}</li>
+<li><strong>Drifted resources:</strong> {{ .Summary.DriftedResourceCount }}</li>
+<li><strong>Drifted links:</strong> {{ .Summary.DriftedLinkCount }}</li>
+</ul>
+{{- if .Summary.ResourceTypes }}
+<h2>Summary by resource type</h2>
+<table>
+<tr><th>Resource type</th><th>Drifted resources</th><th>Modified fields</th><th>New fields</th><th>Removed fields</th></tr>
+{{- range .Summary.ResourceTypes }}
+<tr><td>{{ .ResourceType }}</td><td>{{ .DriftedResourceCount }}</td><td>{{ .ModifiedFieldCount }}</td><td>{{ .NewFieldCount }}</td><td>{{ .RemovedFieldCount }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- if .Resources }}
+<h2>Resources</h2>
+{{- range .Resources }}
+<h3>{{ .ResourceName }} (<code>{{ .ResourceID }}</code>)</h3>
+<ul>
+<li><strong>Type:</strong> {{ .ResourceType }}</li>
+{{- if .DetectedAt }}
+<li><strong>Detected at:</strong> {{ formatTimestamp (derefTimestamp .DetectedAt) }}</li>
+{{- end }}
+</ul>
+{{- if .FieldChanges }}
+<table>
+<tr><th>Field</th><th>Change</th><th>State value</th><th>Drifted value</th></tr>
+{{- range .FieldChanges }}
+<tr><td><code>{{ .FieldPath }}</code></td><td>{{ .ChangeType }}</td><td><code>{{ .StateValue }}</code></td><td><code>{{ .DriftedValue }}</code></td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .Links }}
+<h2>Links</h2>
+{{- range .Links }}
+<h3>{{ .LinkName }} (<code>{{ .LinkID }}</code>)</h3>
+{{- if .DetectedAt }}
+<ul>
+<li><strong>Detected at:</strong> {{ formatTimestamp (derefTimestamp .DetectedAt) }}</li>
+</ul>
+{{- end }}
+{{- if .FieldChanges }}
+<table>
+<tr><th>Target</th><th>Field</th><th>Change</th><th>State value</th><th>Drifted value</th></tr>
+{{- range .FieldChanges }}
+<tr><td>{{ .Target }}</td><td><code>{{ .FieldPath }}</code></td><td>{{ .ChangeType }}</td><td><code>{{ .StateValue }}</code></td><td><code>{{ .DriftedValue }}</code></td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- end }}
+{{- end }}
+</body>
+</html>
+`
@@ -0,0 +1,251 @@
+package drift
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/mockclock"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReportGeneratorTestSuite struct {
+	generator ReportGenerator
+	suite.Suite
+}
+
+func (s *ReportGeneratorTestSuite) SetupTest() {
+	s.generator = NewReportGenerator(&mockclock.StaticClock{})
+}
+
+func (s *ReportGeneratorTestSuite) Test_generates_report_with_field_diffs_and_type_summaries() {
+	report := s.generator.Generate(createReportInput())
+
+	s.Assert().Equal(instance1ID, report.InstanceID)
+	s.Assert().Equal(mockclock.CurrentTimeUnixMock, report.GeneratedAt)
+	s.Assert().Equal(
+		&ReportSummary{
+			DriftedResourceCount: 2,
+			DriftedLinkCount:     1,
+			ResourceTypes: []*ResourceTypeSummary{
+				{
+					ResourceType:         "aws/dynamodb/table",
+					DriftedResourceCount: 1,
+					NewFieldCount:        1,
+					RemovedFieldCount:    1,
+				},
+				{
+					ResourceType:         "aws/lambda/function",
+					DriftedResourceCount: 1,
+					ModifiedFieldCount:   1,
+				},
+			},
+		},
+		report.Summary,
+	)
+
+	s.Require().Len(report.Resources, 2)
+	s.Assert().Equal(ordersTableName, report.Resources[0].ResourceName)
+	s.Assert().Equal(
+		[]*FieldDiff{
+			{
+				FieldPath:    "spec.billingMode",
+				ChangeType:   FieldChangeTypeNew,
+				DriftedValue: `"PAY_PER_REQUEST"`,
+			},
+			{
+				FieldPath:  "spec.streamArn",
+				ChangeType: FieldChangeTypeRemoved,
+			},
+		},
+		report.Resources[0].FieldChanges,
+	)
+	s.Assert().Equal(
+		[]*FieldDiff{
+			{
+				FieldPath:    "spec.handler",
+				ChangeType:   FieldChangeTypeModified,
+				StateValue:   `"orders.saveOrder"`,
+				DriftedValue: `"orders.saveOrderV2"`,
+			},
+		},
+		report.Resources[1].FieldChanges,
+	)
+
+	s.Require().Len(report.Links, 1)
+	s.Assert().Equal(
+		[]*FieldDiff{
+			{
+				Target:       saveOrderFunctionName,
+				FieldPath:    "spec.environment.variables.TABLE_NAME",
+				ChangeType:   FieldChangeTypeModified,
+				StateValue:   `"orders"`,
+				DriftedValue: `"orders-v2"`,
+			},
+		},
+		report.Links[0].FieldChanges,
+	)
+}
+
+func (s *ReportGeneratorTestSuite) Test_redacts_values_of_sensitive_fields() {
+	input := createReportInput()
+	input.ResourceDrift[saveOrderFunctionID].Difference.ModifiedFields = append(
+		input.ResourceDrift[saveOrderFunctionID].Difference.ModifiedFields,
+		&state.ResourceDriftFieldChange{
+			FieldPath:    "spec.environment.variables.API_KEY",
+			StateValue:   core.MappingNodeFromString("original-api-key"),
+			DriftedValue: core.MappingNodeFromString("rotated-api-key"),
+			Sensitive:    true,
+		},
+	)
+
+	report := s.generator.Generate(input)
+
+	s.Require().Len(report.Resources, 2)
+	s.Assert().Equal(
+		&FieldDiff{
+			FieldPath:    "spec.environment.variables.API_KEY",
+			ChangeType:   FieldChangeTypeModified,
+			StateValue:   provider.RedactedSensitiveValue,
+			DriftedValue: provider.RedactedSensitiveValue,
+			Sensitive:    true,
+		},
+		report.Resources[1].FieldChanges[1],
+	)
+
+	buf := &bytes.Buffer{}
+	s.Require().NoError(s.generator.Render(report, ReportFormatMarkdown, buf))
+	s.Assert().NotContains(buf.String(), "api-key")
+}
+
+func (s *ReportGeneratorTestSuite) Test_renders_report_as_json() {
+	report := s.generator.Generate(createReportInput())
+
+	buf := &bytes.Buffer{}
+	err := s.generator.Render(report, ReportFormatJSON, buf)
+	s.Require().NoError(err)
+
+	decoded := &Report{}
+	err = json.Unmarshal(buf.Bytes(), decoded)
+	s.Require().NoError(err)
+	s.Assert().Equal(report, decoded)
+}
+
+func (s *ReportGeneratorTestSuite) Test_renders_report_as_markdown() {
+	report := s.generator.Generate(createReportInput())
+
+	buf := &bytes.Buffer{}
+	err := s.generator.Render(report, ReportFormatMarkdown, buf)
+	s.Require().NoError(err)
+
+	output := buf.String()
+	s.Assert().Contains(output, "# Drift Report")
+	s.Assert().Contains(output, "- **Generated at:** 2023-09-07T14:43:44Z")
+	s.Assert().Contains(output, "| aws/lambda/function | 1 | 1 | 0 | 0 |")
+	s.Assert().Contains(
+		output,
+		"| `spec.handler` | modified | `\"orders.saveOrder\"` | `\"orders.saveOrderV2\"` |",
+	)
+	s.Assert().Contains(output, "### saveOrderFunction::ordersTable (`test-link-1`)")
+}
+
+func (s *ReportGeneratorTestSuite) Test_renders_report_as_html_with_escaped_values() {
+	input := createReportInput()
+	input.InstanceName = "<script>alert(1)</script>"
+	report := s.generator.Generate(input)
+
+	buf := &bytes.Buffer{}
+	err := s.generator.Render(report, ReportFormatHTML, buf)
+	s.Require().NoError(err)
+
+	output := buf.String()
+	s.Assert().Contains(output, "<h1>Drift Report</h1>")
+	s.Assert().Contains(output, "<td>aws/dynamodb/table</td>")
+	s.Assert().Contains(output, "<code>spec.handler</code>")
+	s.Assert().NotContains(output, "<script>")
+	s.Assert().Contains(output, "&lt;script&gt;")
+}
+
+func (s *ReportGeneratorTestSuite) Test_fails_to_render_report_in_unsupported_format() {
+	report := s.generator.Generate(createReportInput())
+
+	err := s.generator.Render(report, ReportFormat("pdf"), &bytes.Buffer{})
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "unsupported drift report format")
+}
+
+func createReportInput() *ReportInput {
+	timestamp := 1694000000
+	return &ReportInput{
+		InstanceID:   instance1ID,
+		InstanceName: "orders-service",
+		ResourceDrift: map[string]*state.ResourceDriftState{
+			saveOrderFunctionID: {
+				ResourceID:   saveOrderFunctionID,
+				ResourceName: saveOrderFunctionName,
+				Difference: &state.ResourceDriftChanges{
+					ModifiedFields: []*state.ResourceDriftFieldChange{
+						{
+							FieldPath:    "spec.handler",
+							StateValue:   core.MappingNodeFromString("orders.saveOrder"),
+							DriftedValue: core.MappingNodeFromString("orders.saveOrderV2"),
+						},
+					},
+				},
+				Timestamp: &timestamp,
+			},
+			ordersTableID: {
+				ResourceID:   ordersTableID,
+				ResourceName: ordersTableName,
+				Difference: &state.ResourceDriftChanges{
+					NewFields: []*state.ResourceDriftFieldChange{
+						{
+							FieldPath:    "spec.billingMode",
+							DriftedValue: core.MappingNodeFromString("PAY_PER_REQUEST"),
+						},
+					},
+					RemovedFields: []string{"spec.streamArn"},
+				},
+				Timestamp: &timestamp,
+			},
+		},
+		LinkDrift: map[string]*state.LinkDriftState{
+			"test-link-1": {
+				LinkID:   "test-link-1",
+				LinkName: "saveOrderFunction::ordersTable",
+				ResourceADrift: &state.LinkResourceDrift{
+					ResourceID:   saveOrderFunctionID,
+					ResourceName: saveOrderFunctionName,
+					MappedFieldChanges: []*state.LinkDriftFieldChange{
+						{
+							ResourceFieldPath: "spec.environment.variables.TABLE_NAME",
+							LinkDataPath:      "saveOrderFunction.environmentVariables.TABLE_NAME",
+							LinkDataValue:     core.MappingNodeFromString("orders"),
+							ExternalValue:     core.MappingNodeFromString("orders-v2"),
+						},
+					},
+				},
+				Timestamp: &timestamp,
+			},
+		},
+		ResourceTypes: ResourceTypesFromInstance(&state.InstanceState{
+			Resources: map[string]*state.ResourceState{
+				saveOrderFunctionID: {
+					ResourceID: saveOrderFunctionID,
+					Type:       "aws/lambda/function",
+				},
+				ordersTableID: {
+					ResourceID: ordersTableID,
+					Type:       "aws/dynamodb/table",
+				},
+			},
+		}),
+	}
+}
+
+func TestReportGeneratorTestSuite(t *testing.T) {
+	suite.Run(t, new(ReportGeneratorTestSuite))
+}
@@ -0,0 +1,321 @@
+package drift
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+)
+
+const (
+	// DefaultSchedulerInterval is the default interval between
+	// scheduled drift checks.
+	DefaultSchedulerInterval = 1 * time.Hour
+)
+
+// ScheduledInstance holds the information needed to run
+// scheduled drift checks for a blueprint instance.
+type ScheduledInstance struct {
+	// InstanceID is the ID of the blueprint instance to check for drift.
+	InstanceID string
+	// Params holds the blueprint parameters used to check drift for the instance,
+	// such as provider configuration.
+	Params core.BlueprintParams
+	// TaggingConfig holds the configuration for Bluelink resource tagging
+	// to be used when checking drift for the instance.
+	// If nil, tagging will not be taken into account when checking drift.
+	TaggingConfig *provider.TaggingConfig
+}
+
+// ScheduledCheckResult holds the result of a scheduled
+// drift check for a single blueprint instance.
+type ScheduledCheckResult struct {
+	// InstanceID is the ID of the blueprint instance that was checked.
+	InstanceID string
+	// ResourceDrift holds the drift state for resources that have drifted,
+	// mapped by resource ID.
+	ResourceDrift map[string]*state.ResourceDriftState
+	// LinkDrift holds the drift state for links that have drifted,
+	// mapped by link ID.
+	LinkDrift map[string]*state.LinkDriftState
+	// Err is the error that occurred when checking drift for the instance,
+	// this is nil if the drift check was successful.
+	Err error
+	// CheckedAt is the time that the drift check completed.
+	CheckedAt time.Time
+}
+
+// HasDrift determines whether any resources or links
+// were found to have drifted in the scheduled check.
+func (r *ScheduledCheckResult) HasDrift() bool {
+	return len(r.ResourceDrift) > 0 || len(r.LinkDrift) > 0
+}
+
+// Subscriber is an interface for behaviour that receives the results
+// of scheduled drift checks.
+// This can be used to notify users or external systems about drift
+// that occurs between deployments.
+type Subscriber interface {
+	// OnDriftCheckComplete is called after a scheduled drift check
+	// has been run for a blueprint instance, this is called for successful
+	// and failed drift checks.
+	OnDriftCheckComplete(ctx context.Context, result *ScheduledCheckResult)
+}
+
+// SubscriberFunc is a function adapter that allows ordinary functions
+// to be used as drift check result subscribers.
+type SubscriberFunc func(ctx context.Context, result *ScheduledCheckResult)
+
+// OnDriftCheckComplete calls the underlying function.
+func (f SubscriberFunc) OnDriftCheckComplete(ctx context.Context, result *ScheduledCheckResult) {
+	f(ctx, result)
+}
+
+// Scheduler periodically runs drift checks for resources and links
+// in a configurable set of blueprint instances.
+// The results of drift checks are persisted by the drift checker and
+// emitted to subscribers.
+type Scheduler struct {
+	checker     Checker
+	interval    time.Duration
+	clock       core.Clock
+	logger      core.Logger
+	mu          sync.Mutex
+	instances   []ScheduledInstance
+	subscribers []Subscriber
+	stop        chan struct{}
+	running     bool
+}
+
+// SchedulerOption is a function that can be used to configure
+// a drift check scheduler.
+type SchedulerOption func(*Scheduler)
+
+// WithSchedulerInterval sets the interval between scheduled drift checks.
+// When not set, or set to 0 or less, DefaultSchedulerInterval is used.
+func WithSchedulerInterval(interval time.Duration) SchedulerOption {
+	return func(s *Scheduler) {
+		if interval > 0 {
+			s.interval = interval
+		}
+	}
+}
+
+// WithSchedulerInstances sets the initial set of blueprint instances
+// to check for drift on each run of the scheduler.
+func WithSchedulerInstances(instances []ScheduledInstance) SchedulerOption {
+	return func(s *Scheduler) {
+		s.instances = instances
+	}
+}
+
+// WithSchedulerSubscribers sets the subscribers that will receive
+// the results of scheduled drift checks.
+func WithSchedulerSubscribers(subscribers ...Subscriber) SchedulerOption {
+	return func(s *Scheduler) {
+		s.subscribers = append(s.subscribers, subscribers...)
+	}
+}
+
+// WithSchedulerClock sets the clock used to record the time of
+// scheduled drift checks.
+// When not set, the system clock is used.
+func WithSchedulerClock(clock core.Clock) SchedulerOption {
+	return func(s *Scheduler) {
+		s.clock = clock
+	}
+}
+
+// WithSchedulerLogger sets the logger for the scheduler.
+// When not set, a no-op logger is used.
+func WithSchedulerLogger(logger core.Logger) SchedulerOption {
+	return func(s *Scheduler) {
+		s.logger = logger
+	}
+}
+
+// NewScheduler creates a new drift check scheduler that uses
+// the provided drift checker to check and persist drift.
+func NewScheduler(checker Checker, opts ...SchedulerOption) *Scheduler {
+	scheduler := &Scheduler{
+		checker:     checker,
+		interval:    DefaultSchedulerInterval,
+		clock:       &core.SystemClock{},
+		logger:      core.NewNopLogger(),
+		instances:   []ScheduledInstance{},
+		subscribers: []Subscriber{},
+	}
+
+	for _, opt := range opts {
+		opt(scheduler)
+	}
+
+	return scheduler
+}
+
+// SetInstances replaces the set of blueprint instances that will
+// be checked for drift on the next run of the scheduler.
+func (s *Scheduler) SetInstances(instances []ScheduledInstance) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.instances = instances
+}
+
+// Subscribe adds a subscriber that will receive the results
+// of scheduled drift checks.
+func (s *Scheduler) Subscribe(subscriber Subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscribers = append(s.subscribers, subscriber)
+}
+
+// Start runs drift checks for the configured instances
+// at the configured interval in the background, the first run
+// takes place after the first interval has elapsed.
+// Scheduled checks will continue until Stop is called or
+// the provided context is cancelled.
+// Calling Start on a scheduler that is already running has no effect.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return
+	}
+	s.running = true
+	s.stop = make(chan struct{})
+
+	go s.run(ctx, s.stop)
+}
+
+// Stop stops scheduled drift checks, a drift check run that is
+// in progress will run to completion.
+// Calling Stop on a scheduler that is not running has no effect.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+	s.running = false
+	close(s.stop)
+}
+
+func (s *Scheduler) run(ctx context.Context, stop chan struct{}) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.markStopped(stop)
+			return
+		case <-stop:
+			return
+		case <-ticker.C:
+			s.RunOnce(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) markStopped(stop chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Make sure a scheduler that has been restarted since the context
+	// was cancelled is not marked as stopped.
+	if s.running && s.stop == stop {
+		s.running = false
+	}
+}
+
+// RunOnce runs drift checks for all the configured instances immediately,
+// emitting the result for each instance to subscribers.
+// Drift checks are run for instances sequentially to avoid overwhelming
+// upstream providers with requests.
+// A failed drift check for one instance does not prevent drift checks
+// from running for the remaining instances.
+func (s *Scheduler) RunOnce(ctx context.Context) []*ScheduledCheckResult {
+	s.mu.Lock()
+	instances := append([]ScheduledInstance{}, s.instances...)
+	subscribers := append([]Subscriber{}, s.subscribers...)
+	s.mu.Unlock()
+
+	results := make([]*ScheduledCheckResult, 0, len(instances))
+	for _, instance := range instances {
+		if ctx.Err() != nil {
+			break
+		}
+
+		result := s.checkInstance(ctx, instance)
+		results = append(results, result)
+		for _, subscriber := range subscribers {
+			subscriber.OnDriftCheckComplete(ctx, result)
+		}
+	}
+
+	return results
+}
+
+func (s *Scheduler) checkInstance(
+	ctx context.Context,
+	instance ScheduledInstance,
+) *ScheduledCheckResult {
+	instanceLogger := s.logger.WithFields(
+		core.StringLogField("instanceId", instance.InstanceID),
+	)
+	result := &ScheduledCheckResult{
+		InstanceID: instance.InstanceID,
+	}
+
+	instanceLogger.Debug("running scheduled resource drift check")
+	resourceDrift, err := s.checker.CheckDrift(
+		ctx,
+		instance.InstanceID,
+		instance.Params,
+		instance.TaggingConfig,
+	)
+	if err != nil {
+		instanceLogger.Error(
+			"scheduled resource drift check failed",
+			core.ErrorLogField("error", err),
+		)
+		result.Err = err
+		result.CheckedAt = s.clock.Now()
+		return result
+	}
+	result.ResourceDrift = resourceDrift
+
+	instanceLogger.Debug("running scheduled link drift check")
+	linkDrift, err := s.checker.CheckAllLinkDrift(
+		ctx,
+		instance.InstanceID,
+		instance.Params,
+		instance.TaggingConfig,
+	)
+	if err != nil {
+		instanceLogger.Error(
+			"scheduled link drift check failed",
+			core.ErrorLogField("error", err),
+		)
+		result.Err = err
+	}
+	result.LinkDrift = linkDrift
+	result.CheckedAt = s.clock.Now()
+
+	if result.HasDrift() {
+		instanceLogger.Info(
+			"scheduled drift check detected drift",
+			core.IntegerLogField("driftedResources", int64(len(result.ResourceDrift))),
+			core.IntegerLogField("driftedLinks", int64(len(result.LinkDrift))),
+		)
+	}
+
+	return result
+}
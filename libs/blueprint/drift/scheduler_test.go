@@ -0,0 +1,195 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	schedulerTestInstanceID1 = "scheduler-test-instance-1"
+	schedulerTestInstanceID2 = "scheduler-test-instance-2"
+	schedulerTestFailingID   = "scheduler-test-failing-instance"
+)
+
+type SchedulerTestSuite struct {
+	suite.Suite
+	checker *stubScheduledChecker
+}
+
+func (s *SchedulerTestSuite) SetupTest() {
+	s.checker = &stubScheduledChecker{
+		resourceDrift: map[string]map[string]*state.ResourceDriftState{
+			schedulerTestInstanceID1: {
+				"resource-1": {
+					ResourceID:   "resource-1",
+					ResourceName: "ordersTable",
+				},
+			},
+		},
+		linkDrift: map[string]map[string]*state.LinkDriftState{
+			schedulerTestInstanceID2: {
+				"link-1": {
+					LinkID:   "link-1",
+					LinkName: "ordersTable::ordersFunction",
+				},
+			},
+		},
+		failInstanceIDs: []string{schedulerTestFailingID},
+	}
+}
+
+func (s *SchedulerTestSuite) Test_runs_drift_checks_and_emits_results_to_subscribers() {
+	collector := &schedulerResultCollector{}
+	scheduler := NewScheduler(
+		s.checker,
+		WithSchedulerInstances([]ScheduledInstance{
+			{InstanceID: schedulerTestInstanceID1},
+			{InstanceID: schedulerTestFailingID},
+			{InstanceID: schedulerTestInstanceID2},
+		}),
+		WithSchedulerSubscribers(collector),
+		WithSchedulerClock(&core.SystemClock{}),
+	)
+
+	results := scheduler.RunOnce(context.Background())
+	s.Require().Len(results, 3)
+	s.Assert().Equal(results, collector.getResults())
+
+	s.Assert().Equal(schedulerTestInstanceID1, results[0].InstanceID)
+	s.Assert().True(results[0].HasDrift())
+	s.Assert().Contains(results[0].ResourceDrift, "resource-1")
+	s.Assert().NoError(results[0].Err)
+
+	// A failed drift check for one instance should not prevent
+	// checks for the remaining instances.
+	s.Assert().Equal(schedulerTestFailingID, results[1].InstanceID)
+	s.Assert().Error(results[1].Err)
+	s.Assert().False(results[1].HasDrift())
+
+	s.Assert().Equal(schedulerTestInstanceID2, results[2].InstanceID)
+	s.Assert().True(results[2].HasDrift())
+	s.Assert().Contains(results[2].LinkDrift, "link-1")
+}
+
+func (s *SchedulerTestSuite) Test_runs_drift_checks_periodically_until_stopped() {
+	resultsChan := make(chan *ScheduledCheckResult, 10)
+	scheduler := NewScheduler(
+		s.checker,
+		WithSchedulerInterval(5*time.Millisecond),
+		WithSchedulerInstances([]ScheduledInstance{
+			{InstanceID: schedulerTestInstanceID1},
+		}),
+	)
+	scheduler.Subscribe(SubscriberFunc(
+		func(ctx context.Context, result *ScheduledCheckResult) {
+			select {
+			case resultsChan <- result:
+			default:
+			}
+		},
+	))
+
+	scheduler.Start(context.Background())
+	defer scheduler.Stop()
+
+	for range 2 {
+		select {
+		case result := <-resultsChan:
+			s.Assert().Equal(schedulerTestInstanceID1, result.InstanceID)
+		case <-time.After(time.Second):
+			s.Fail("timed out waiting for scheduled drift check")
+		}
+	}
+}
+
+func (s *SchedulerTestSuite) Test_uses_updated_set_of_instances() {
+	scheduler := NewScheduler(
+		s.checker,
+		WithSchedulerInstances([]ScheduledInstance{
+			{InstanceID: schedulerTestInstanceID1},
+		}),
+	)
+	scheduler.SetInstances([]ScheduledInstance{
+		{InstanceID: schedulerTestInstanceID2},
+	})
+
+	results := scheduler.RunOnce(context.Background())
+	s.Require().Len(results, 1)
+	s.Assert().Equal(schedulerTestInstanceID2, results[0].InstanceID)
+}
+
+type schedulerResultCollector struct {
+	mu      sync.Mutex
+	results []*ScheduledCheckResult
+}
+
+func (c *schedulerResultCollector) OnDriftCheckComplete(
+	ctx context.Context,
+	result *ScheduledCheckResult,
+) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.results = append(c.results, result)
+}
+
+func (c *schedulerResultCollector) getResults() []*ScheduledCheckResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.results
+}
+
+// stubScheduledChecker provides the drift check methods
+// used by the scheduler, calling any other methods will panic.
+type stubScheduledChecker struct {
+	Checker
+	resourceDrift   map[string]map[string]*state.ResourceDriftState
+	linkDrift       map[string]map[string]*state.LinkDriftState
+	failInstanceIDs []string
+}
+
+func (c *stubScheduledChecker) CheckDrift(
+	ctx context.Context,
+	instanceID string,
+	params core.BlueprintParams,
+	taggingConfig *provider.TaggingConfig,
+) (map[string]*state.ResourceDriftState, error) {
+	if c.shouldFail(instanceID) {
+		return nil, errors.New("failed to check drift")
+	}
+
+	return c.resourceDrift[instanceID], nil
+}
+
+func (c *stubScheduledChecker) CheckAllLinkDrift(
+	ctx context.Context,
+	instanceID string,
+	params core.BlueprintParams,
+	taggingConfig *provider.TaggingConfig,
+) (map[string]*state.LinkDriftState, error) {
+	if c.shouldFail(instanceID) {
+		return nil, errors.New("failed to check link drift")
+	}
+
+	return c.linkDrift[instanceID], nil
+}
+
+func (c *stubScheduledChecker) shouldFail(instanceID string) bool {
+	for _, failInstanceID := range c.failInstanceIDs {
+		if failInstanceID == instanceID {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSchedulerTestSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerTestSuite))
+}
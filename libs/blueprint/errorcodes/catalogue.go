@@ -0,0 +1,68 @@
+// Package errorcodes provides a catalogue of the error reason codes
+// used for blueprint validation and container errors along with
+// information that helps users to resolve errors.
+//
+// The catalogue is generated from the source of the container and validation
+// packages so it stays in sync with the errors that are produced.
+// Run "go generate" in this package after adding or updating error reason codes.
+package errorcodes
+
+//go:generate go run ./internal/gen
+
+import (
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+)
+
+// Entry holds information about an error reason code
+// in the catalogue.
+type Entry struct {
+	// Code is the error reason code that is attached to errors
+	// and diagnostics.
+	Code errors.ErrorReasonCode `json:"code"`
+	// Package is the blueprint framework package
+	// that defines the error reason code.
+	Package string `json:"package"`
+	// ConstantName is the name of the Go constant
+	// for the error reason code.
+	ConstantName string `json:"constantName"`
+	// Category is the category of the error context provided
+	// with errors that use the reason code, this is empty when errors
+	// for the reason code are not provided with a category.
+	Category errors.ErrorCategory `json:"category,omitempty"`
+	// Description of the issue that the error reason code represents.
+	Description string `json:"description"`
+	// Example of a message for an error or diagnostic with the reason code,
+	// values that are derived from a blueprint are shown as "<value>" placeholders.
+	Example string `json:"example,omitempty"`
+	// SuggestedActions holds the actions that are suggested to resolve
+	// errors with the reason code, descriptions may contain "<value>"
+	// placeholders for values derived from a blueprint.
+	SuggestedActions []errors.SuggestedAction `json:"suggestedActions,omitempty"`
+}
+
+var catalogueByCode = createCatalogueByCode()
+
+func createCatalogueByCode() map[errors.ErrorReasonCode]*Entry {
+	byCode := make(map[errors.ErrorReasonCode]*Entry, len(catalogue))
+	for _, entry := range catalogue {
+		byCode[entry.Code] = entry
+	}
+	return byCode
+}
+
+// All returns every entry in the error code catalogue
+// ordered by error reason code.
+// Entries are shared and must not be modified.
+func All() []*Entry {
+	return slices.Clone(catalogue)
+}
+
+// Lookup finds the entry for the provided error reason code
+// in the error code catalogue.
+// Entries are shared and must not be modified.
+func Lookup(code errors.ErrorReasonCode) (*Entry, bool) {
+	entry, ok := catalogueByCode[code]
+	return entry, ok
+}
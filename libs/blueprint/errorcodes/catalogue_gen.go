@@ -0,0 +1,1669 @@
+// Code generated by go generate; DO NOT EDIT.
+package errorcodes
+
+import "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+
+var catalogue = []*Entry{
+	{
+		Code:         "blast_radius_exceeded",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeBlastRadiusExceeded",
+		Description:  "Provided when the reason for an error during deployment is due to the changes to be deployed exceeding the configured blast radius limits.",
+		Example:      "the changes to be deployed exceed the blast radius limit for <value>, <value> <value> will be applied but the maximum allowed is <value>, the deployment must be explicitly overridden to proceed",
+	},
+	{
+		Code:         "blueprint_cycle_detected",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeBlueprintCycleDetected",
+		Description:  "Provided when the reason for an error during deployment or change staging is due to a cyclic blueprint inclusion detected.",
+		Example:      "[include.<value>]: cyclic blueprint inclusion detected, instance \"<value>\" is an ancestor of the current blueprint as shown in the instance tree path: \"<value>\"",
+	},
+	{
+		Code:         "child_blueprint_error",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeChildBlueprintError",
+		Description:  "Provided when the reason for an error during deployment or change staging is due to an error in a child blueprint. This is used to wrap errors that occur in child blueprints that are not run errors.",
+	},
+	{
+		Code:         "child_export_not_found",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeChildExportNotFound",
+		Description:  "Provided when a substitution or export field references a child blueprint export that does not exist in the resolved child blueprint.",
+		Example:      "validation failed due to export \"<value>\" not being found in child blueprint \"<value>\"<value>",
+	},
+	{
+		Code:         "child_export_scalar_navigation",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeChildExportScalarNavigation",
+		Description:  "Provided when a substitution or export field attempts to navigate into a child blueprint export that has a scalar type.",
+		Example:      "validation failed due to an attempt to access a nested property of export \"<value>\" in child blueprint \"<value>\" which has scalar type \"<value>\"",
+	},
+	{
+		Code:         "child_not_found_in_state",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeChildNotFoundInState",
+		Description:  "Provided when the reason for an error during deployment or change staging is due to a child not being found in the state of a blueprint instance.",
+	},
+	{
+		Code:         "clone_source_instance_destroyed",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeCloneSourceInstanceDestroyed",
+		Description:  "Provided when the reason for an error when cloning a blueprint instance is due to the source instance having been destroyed.",
+		Example:      "blueprint instance \"<value>\" can not be cloned as it has been destroyed",
+	},
+	{
+		Code:         "clone_target_instance_exists",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeCloneTargetInstanceExists",
+		Description:  "Provided when the reason for an error when cloning a blueprint instance is due to a blueprint instance already existing with the name chosen for the clone.",
+		Example:      "a blueprint instance with the name \"<value>\" already exists, a new name must be chosen for the cloned instance",
+	},
+	{
+		Code:         "computed_field_in_blueprint",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeComputedFieldInBlueprint",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a computed field being used in a blueprint. Computed fields are not allowed to be defined in blueprints, they are computed by providers when a resource has been created.",
+		Example:      "validation failed due to \"<value>\" being a computed field defined in the blueprint for resource \"<value>\", this field is computed by the provider after the resource has been created",
+	},
+	{
+		Code:         "condition_value_deployed_state_dependency",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeConditionValueDeployedStateDependency",
+		Description:  "Provided when the reason for a blueprint spec load error is due to the \"condition\" property of a resource referencing a value that depends on the deployed state of a resource or child blueprint. Values that depend on deployed state can only be resolved once the resources or child blueprints they depend on have been deployed, whereas conditions must be resolved before the deployment can be planned.",
+		Example:      "validation failed due to a resource \"<value>\" referencing the value \"<value>\" in the condition property that has a direct or transitive dependency on \"<value>\", the condition property can not reference values that depend on the deployed state of resources or child blueprints",
+	},
+	{
+		Code:         "custom_variable_allowed_values_not_in_options",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeCustomVarAllowedValuesNotInOptions",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to allowed values not being in the available options for a custom variable type.",
+		Example:      "validation failed due to invalid allowed values being provided for variable \"<value>\" of custom type \"<value>\". See custom type documentation for possible values. Invalid values provided: <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckCustomVariableOptions),
+				Title:       "Check Custom Variable Options",
+				Description: "Check the options for the custom variable type.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "custom_variable_default_value_not_in_options",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeCustomVarDefaultValueNotInOptions",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a default value not being in the available options for a custom variable type.",
+		Example:      "validation failed due to an invalid default value for variable \"<value>\" of custom type \"<value>\". See custom type documentation for possible values. Invalid default value provided: <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckCustomVariableOptions),
+				Title:       "Check Custom Variable Options",
+				Description: "Check the options for the custom variable type.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "custom_variable_value_not_in_options",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeCustomVarValueNotInOptions",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a custom variable value not being in the available options.",
+		Example:      "validation failed due to an invalid <value> \"<value>\" being provided for variable \"<value>\", which is not a valid <value> option, see the custom type documentation for more details",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckCustomVariableOptions),
+				Title:       "Check Custom Variable Options",
+				Description: "Check the options for the custom variable type.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "data_source_empty_filter",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceEmptyFilter",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an empty data source filter.",
+		Example:      "validation failed due to an empty filter in data source \"<value>\", filters cannot be null or empty objects",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddDataSourceFilter),
+				Title:       "Provide Valid Filter",
+				Description: "Provide a valid filter for the data source.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "data_source_filter_conflict",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceFilterConflict",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a conflict between two filter fields in a data source, where both fields can not be used to filter the same data source.",
+		Example:      "validation failed due to a conflict between the filter fields \"<value>\" and \"<value>\" in data source \"<value>\", you must use one or the other in the filter section of the data source",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddDataSourceFilter),
+				Title:       "Fix Filter Field Conflict",
+				Description: "Fix the conflict between the filter fields.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "data_source_filter_field_conflict",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceFilterFieldConflict",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a data source filter field conflict.",
+	},
+	{
+		Code:         "data_source_filter_field_not_supported",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceFilterFieldNotSupported",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a data source having a field set as a filter that can't be used for filtering.",
+		Example:      "validation failed due to the field \"<value>\" in the filter for data source \"<value>\" not being supported",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckDataSourceFilterFields),
+				Title:       "Choose a different field for filtering",
+				Description: "Choose a different field for filtering from the list of supported fields.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "data_source_filter_operator_not_supported",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceFilterOperatorNotSupported",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an unsupported data source filter operator.",
+	},
+	{
+		Code:         "data_source_missing_exports",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceMissingExports",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to missing data source exports.",
+		Example:      "validation failed due to missing exports for data source \"<value>\", at least one field must be exported for a data source",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddDataSourceExport),
+				Title:       "Add Export",
+				Description: "Add an exported field for the data source.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "data_source_missing_filter",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceMissingFilter",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a missing data source filter.",
+		Example:      "validation failed due to a missing filter in data source \"<value>\", every data source must have a filter",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddDataSourceFilter),
+				Title:       "Add Filter",
+				Description: "Add a filter to the data source.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "data_source_missing_filter_field",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceMissingFilterField",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a missing data source filter field.",
+		Example:      "validation failed due to a missing field in filter for data source \"<value>\", field must be set for a data source filter",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddDataSourceFilter),
+				Title:       "Add Filter Field",
+				Description: "Add a filter field to the data source.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "data_source_missing_filter_operator",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceMissingFilterOperator",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a missing data source filter operator.",
+	},
+	{
+		Code:         "data_source_missing_filter_search",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceMissingFilterSearch",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a missing data source filter search.",
+		Example:      "validation failed due to a missing search in filter for data source \"<value>\", at least one search value must be provided for a filter",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddDataSourceFilter),
+				Title:       "Add Filter Search Value",
+				Description: "Add at least one search value to the data source.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "data_source_missing_type",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceMissingType",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a missing type for a data source.",
+		Example:      "validation failed due to a missing type for data source \"<value>\"",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddDataSourceType),
+				Title:       "Add Data Source Type",
+				Description: "Add a type for the data source.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "data_source_spec_def_missing",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDataSourceSpecDefMissing",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a missing spec definition for a data source.",
+		Example:      "validation failed due to a missing spec definition for data source \"<value>\" of type \"<value>\"<value>: <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeContactDataSourceTypeDeveloper),
+				Title:       "Contact Data Source Type Developer",
+				Description: "Contact the developer of the data source type <value> to fix the issue.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "data_source_validation_errors",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeDataSourceValidationErrors",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a collection of errors for one or more data sources in the spec. This should be used for a wrapper error that holds more specific errors which can be used for reporting useful information about issues with the spec.",
+		Example:      "validation failed due to issues with <value> data sources in the spec",
+	},
+	{
+		Code:         "deploy_missing_instance_id",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeDeployMissingInstanceID",
+		Description:  "Provided when the reason for an error during deployment is due to a missing instance ID when deploying changes that modify existing resources or child blueprints.",
+		Example:      "an instance ID is required for deployments where the provided change set contains modifications to existing resources or child blueprints",
+	},
+	{
+		Code:         "deploy_missing_partially_resolved_resource",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeDeployMissingPartiallyResolvedResource",
+		Description:  "Provided when the reason for an error during deployment is due to a missing partially resolved resource for a resource that is being deployed.",
+		Example:      "resource \"<value>\" is missing from the partially resolved resources, a partially resolved resource must be provided for each resource in the given set of changes",
+	},
+	{
+		Code:         "deploy_missing_resource_changes",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeDeployMissingResourceChanges",
+		Description:  "Provided when the reason for an error during deployment is due to missing changes for a resource that is being deployed.",
+		Example:      "no changes provided for resource \"<value>\", at least one change is required in the provided set of changes",
+	},
+	{
+		Code:         "deploy_targets_require_excluded",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeDeployTargetsRequireExcluded",
+		Description:  "Provided when the reason for an error during a targeted deployment is due to the targeted resources requiring resources that are excluded from the deployment and have not been deployed yet.",
+		Example:      "the targeted resources are linked to the following resources that have not been deployed yet and are excluded from the deployment: <value>, add them to the deployment targets to proceed",
+	},
+	{
+		Code:         "deployment_hook_failed",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeDeploymentHookFailed",
+		Description:  "Provided when the reason for an error during deployment is due to a registered deployment hook returning an error.",
+		Example:      "<value> hook failed for resource \"<value>\": <value>",
+	},
+	{
+		Code:         "deprecated_resource_field",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDeprecatedResourceField",
+		Description:  "Provided for warnings about a field set in a resource spec that has been deprecated by the provider of the resource type.",
+	},
+	{
+		Code:         "deprecated_resource_type",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDeprecatedResourceType",
+		Description:  "Provided for warnings about a resource that uses a resource type that has been deprecated by its provider.",
+	},
+	{
+		Code:         "destroy_targets_have_dependents",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeDestroyTargetsHaveDependents",
+		Description:  "Provided when the reason for an error during a partial destroy is due to elements that are not targeted depending on the targeted elements.",
+		Example:      "the targeted elements can not be destroyed as elements that are not targeted depend on them: <value>, add the dependent elements to the destroy targets to proceed",
+	},
+	{
+		Code:         "drift_detected",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeDriftDetected",
+		Description:  "Provided when the reason for an error during deployment or change staging is due to drift being detected in resources.",
+	},
+	{
+		Code:         "duplicate_definition",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeDuplicateDefinition",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an element being defined more than once with the same name in the same section of a blueprint document.",
+		Example:      "validation failed due to the <value> \"<value>\" being defined more than once, first defined at line <value>, column <value> and defined again at line <value>, column <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeRenameElement),
+				Title:       "Rename Duplicate Definition",
+				Description: "Rename one of the definitions of the <value> \"<value>\" or remove the duplicate definition, only the last definition is used.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "each_child_dependency",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeEachChildDependency",
+		Description:  "Provided when the reason for a blueprint spec load error is due to the \"each\" property of a resource having a dependency on a child blueprint.",
+		Example:      "validation failed due to a resource \"<value>\" having a direct or transitive dependency on a child blueprint \"<value>\" in the each property, the each property can not depend on child blueprints",
+	},
+	{
+		Code:         "each_resource_dependency",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeEachResourceDependency",
+		Description:  "Provided when the reason for a blueprint spec load error is due to the \"each\" property of a resource having a dependency on another resource.",
+		Example:      "validation failed due to a resource \"<value>\" having a direct or transitive dependency \"<value>\" in the each property, the each property can not depend on resources",
+	},
+	{
+		Code:         "empty_child_blueprint_path",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeEmptyChildBlueprintPath",
+		Description:  "Provided when the reason for an error during deployment or change staging is due to an empty path to a child blueprint in an include.",
+		Example:      "[include.<value>]: child blueprint path is empty for include",
+	},
+	{
+		Code:         "empty_export_field",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeEmptyExportField",
+		Category:     errors.ErrorCategoryExport,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an empty export field.",
+		Example:      "validation failed due to an empty field string being provided for export \"<value>\"",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Provide Export Field",
+				Description: "Provide a non-empty field value for the export.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "export_validation_errors",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeExportValidationErrors",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a collection of errors for one or more variables in the spec. This should be used for a wrapper error that holds more specific errors which can be used for reporting useful information about issues with the spec.",
+		Example:      "validation failed due to issues with <value> exports in the spec",
+	},
+	{
+		Code:         "function_cycle",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeFunctionCycle",
+		Description:  "Provided when the reason for a blueprint spec load error is due to user-defined functions calling each other in a cycle.",
+		Example:      "validation failed due to a cycle between user-defined functions: <value>, functions can not call themselves directly or indirectly",
+	},
+	{
+		Code:         "include_empty_path",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeIncludeEmptyPath",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an empty include path.",
+	},
+	{
+		Code:         "include_missing_required_variable",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeIncludeMissingRequiredVar",
+		Description:  "Provided when a required variable is not provided to a child blueprint include.",
+		Example:      "validation failed due to required variable \"<value>\" not being provided to include \"<value>\"<value>",
+	},
+	{
+		Code:         "include_path_not_found",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeIncludePathNotFound",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a child blueprint include path pointing to a file that does not exist on the local filesystem.",
+		Example:      "validation failed due to the include path for \"<value>\" resolving to \"<value>\" which does not exist on the local filesystem",
+	},
+	{
+		Code:         "include_validation_errors",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeIncludeValidationErrors",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a collection of errors for one or more includes in the spec. This should be used for a wrapper error that holds more specific errors which can be used for reporting useful information about issues with the spec.",
+		Example:      "validation failed due to issues with <value> includes in the spec",
+	},
+	{
+		Code:         "include_variable_type_mismatch",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeIncludeVarTypeMismatch",
+		Description:  "Provided when a variable provided to a child blueprint include has a different type than expected.",
+		Example:      "validation failed due to variable \"<value>\" provided to include \"<value>\" having type \"<value>\", but the child blueprint expects type \"<value>\"<value>",
+	},
+	{
+		Code:         "instance_id_and_name_provided",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeInstanceIDAndNameProvided",
+		Description:  "Provided when the reason for an error when destroying an instance or change staging is due to both an instance ID and a name being provided. This does not apply to deployments, for which an instance ID and name can be provided and will both be used in the process of saving new blueprint instances.",
+		Example:      "an instance ID and name cannot be provided at the same time for this purpose, only one of them can be used for staging changes or destroying a blueprint instance",
+	},
+	{
+		Code:         "invalid_data_source",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidDataSource",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to one or more data sources being invalid.",
+		Example:      "validation failed due to the exported field \"<value>\" in data source \"<value>\" not being supported, the exported field \"<value>\" is not present for data source type \"<value>\"",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeInstallProvider),
+				Title:       "Install Provider",
+				Description: "Install the <value> provider to support <value> data sources",
+				Priority:    1,
+			},
+			{
+				Type:        string(errors.ActionTypeCheckDataSourceType),
+				Title:       "Check Data Source Type",
+				Description: "Verify the data source type name is correct",
+				Priority:    2,
+			},
+		},
+	},
+	{
+		Code:         "invalid_data_source_field_type",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidDataSourceFieldType",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid data source field type.",
+		Example:      "unsupported field type \"<value>\" has been provided for export \"<value>\" in data source \"<value>\", you can choose from: string, integer, float, boolean and array",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddDataSourceExport),
+				Title:       "Choose Valid Field Export Type",
+				Description: "Choose a valid field export type for the data source.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "invalid_data_source_filter_operator",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidDataSourceFilterOperator",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid data source filter operator being provided.",
+		Example:      "invalid filter operator \"<value>\" has been provided in data source \"<value>\", you can choose from <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddDataSourceFilter),
+				Title:       "Choose Valid Filter Operator",
+				Description: "Choose a valid filter operator for the data source.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "invalid_export",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidExport",
+		Description:  "Provided when the reason for a blueprint spec load error is due to one or more exports being invalid.",
+		Example:      "validation failed due to a type mismatch in export \"<value>\", the expected export type <value> does not match the resolved type <value> for field \"<value>\"",
+	},
+	{
+		Code:         "invalid_export_type",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidExportType",
+		Category:     errors.ErrorCategoryExport,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid export type.",
+		Example:      "validation failed due to an invalid export type of \"<value>\" being provided for export \"<value>\". The following export types are supported: <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Check Supported Export Types",
+				Description: "Use a valid export type from the supported list.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "invalid_function",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidFunction",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid user-defined function in the \"functions\" section of a blueprint or an invalid use of a parameter of a user-defined function.",
+		Example:      "validation failed due to the function name \"<value>\" not being valid, function names must be valid substitution identifiers and can not be a substitution keyword such as \"variables\" or \"elem\"",
+	},
+	{
+		Code:         "invalid_hook",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidHook",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid hook definition in the hooks section of a blueprint.",
+		Example:      "validation failed due to an unsupported event \"<value>\" being provided for hook <value>, the following hook events are supported: <value>",
+	},
+	{
+		Code:         "invalid_include",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidInclude",
+		Description:  "Provided when the reason for a blueprint spec load error is due to one or more includes being invalid.",
+		Example:      "validation failed due to a missing or empty path for include \"<value>\"",
+	},
+	{
+		Code:         "invalid_instance_reference",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidInstanceReference",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid reference to an export of another deployed blueprint instance or a reference to an instance or export that does not exist.",
+		Example:      "validation failed due to an invalid instance reference \"<value>\", instance references must be in the form \"bluelink://instance/{instanceName}/exports/{exportName}\"",
+	},
+	{
+		Code:         "invalid_link_config",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidLinkConfig",
+		Description:  "Provided when the reason for a blueprint spec load error is due to invalid configuration for a link in the links section of a blueprint.",
+		Example:      "validation failed due to configuration being provided for link \"<value>\" that does not exist in the blueprint, link names must be in the format \"{resourceA}::{resourceB}\" where resourceA selects resourceB by label",
+	},
+	{
+		Code:         "invalid_logical_link_name",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeInvalidLogicalLinkName",
+		Description:  "Provided when the reason for an error during deployment or change staging is due to an invalid logical link name being provided when preparing to deploy or destroy a link between resources.",
+		Example:      "invalid logical link name \"<value>\" has been provided in blueprint instance \"<value>\", logical link names must be of the form `{resourceA}::{resourceB}`",
+	},
+	{
+		Code:         "invalid_map_key",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidMapKey",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid map key.",
+		Example:      "${..} substitutions can not be used in map keys, found \"<value>\" in child mapping key of <value> \"<value>\"",
+	},
+	{
+		Code:         "invalid_mapping_node",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidMappingNode",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid mapping node.",
+	},
+	{
+		Code:         "invalid_provider_config",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidProviderConfig",
+		Category:     errors.ErrorCategoryProvider,
+		Description:  "Provided when the reason for a blueprint spec load error is due to the configuration supplied for a provider used in the blueprint not matching the config definition of the provider.",
+		Example:      "validation failed due to invalid provider configuration: <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckConfiguration),
+				Title:       "Check Provider Configuration",
+				Description: "Update the configuration supplied for the \"<value>\" provider to match the provider's config definition.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "invalid_reference",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidReference",
+		Description:  "Provided when the reason for a blueprint spec load error is due to one or more references being invalid.",
+		Example:      "validation failed due to a reference to a <value> (\"<value>\") being made from \"<value>\", which can not access values from a <value>",
+	},
+	{
+		Code:         "invalid_reference_pattern",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidReferencePattern",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid reference pattern.",
+	},
+	{
+		Code:         "invalid_resource",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidResource",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to one or more resources being invalid.",
+		Example:      "validation failed due to errors in the pre-validation of the resource spec for resource \"<value>\"",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddResourceType),
+				Title:       "Add Resource Type",
+				Description: "Add a type field to the resource <value>",
+				Priority:    1,
+			},
+			{
+				Type:        string(errors.ActionTypeCheckResourceType),
+				Title:       "Check Available Resource Types",
+				Description: "See available resource types from installed providers",
+				Priority:    2,
+			},
+			{
+				Type:        string(errors.ActionTypeInstallProvider),
+				Title:       "Install Plugin",
+				Description: "Install a provider or transformer plugin with namespace \"<value>\" that supports the <value> resource type",
+				Priority:    1,
+			},
+			{
+				Type:        string(errors.ActionTypeCheckResourceType),
+				Title:       "Check Resource Type",
+				Description: "Verify the resource type name is correct",
+				Priority:    2,
+			},
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "invalid_resource_type",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeInvalidResourceType",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid resource type provided in one of the resources in the spec.",
+	},
+	{
+		Code:         "invalid_spec_ext",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeInvalidSpecExtension",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid specification file extension.",
+		Example:      "unsupported spec file extension in <value>, only json and yaml are supported",
+	},
+	{
+		Code:         "invalid_substitution",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidSubstitution",
+		Category:     errors.ErrorCategoryFunction,
+		Description:  "Provided when the reason for a blueprint spec load error is due to one or more substitutions being invalid.",
+		Example:      "validation failed due to an invalid number of arguments being provided for substitution function \"<value>\", expected <value> but got <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckFunctionName),
+				Title:       "Check Function Name",
+				Description: "Verify the function name is correct (case-sensitive)",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "invalid_transform_links",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidTransformLinks",
+		Description:  "Provided when the reason for a blueprint spec load error is due to invalid transform links.",
+	},
+	{
+		Code:         "invalid_value",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidValue",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid value being provided.",
+		Example:      "validation failed as an empty value was found in \"<value>\", values must be populated with a value that resolves to the defined value type",
+	},
+	{
+		Code:         "invalid_value_type",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidValueType",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid value type.",
+		Example:      "validation failed as an unsupported type \"<value>\" was provided for value \"<value>\", you can choose from: string, integer, float, boolean, object and array",
+	},
+	{
+		Code:         "invalid_variable",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidVariable",
+		Description:  "Provided when the reason for a blueprint spec load error is due to one or more variables being invalid. This could be due to a mismatch between the type and the value, a missing required variable (one without a default value), an invalid default value, invalid allowed values or an incorrect variable type.",
+		Example:      "validation failed due to an error when loading options for variable \"<value>\" of custom type \"<value>\"",
+	},
+	{
+		Code:         "invalid_version",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeInvalidVersion",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid version of the spec being provided.",
+		Example:      "validation failed due to an unsupported version \"<value>\" being provided. supported versions include: <value>",
+	},
+	{
+		Code:         "link_not_found_in_state",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeLinkNotFoundInState",
+		Description:  "Provided when the reason for an error during deployment or change staging is due to a link not being found in the state of a blueprint instance.",
+	},
+	{
+		Code:         "mapping_node_key_contains_substitution",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMappingNodeKeyContainsSubstitution",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a mapping node key containing substitution.",
+	},
+	{
+		Code:         "max_blueprint_depth_exceeded",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeMaxBlueprintDepthExceeded",
+		Description:  "Provided when the reason for an error during deployment or change staging is due to the maximum blueprint depth being exceeded.",
+		Example:      "max blueprint depth exceeded, instance tree path: \"<value>\", only <value> levels of blueprint includes are allowed",
+	},
+	{
+		Code:         "max_include_depth_exceeded",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMaxIncludeDepthExceeded",
+		Description:  "Provided when the reason for a blueprint spec load error is due to child blueprints being nested deeper than the configured limit.",
+		Example:      "validation failed due to child blueprints being included at a depth of <value>, the maximum include depth allowed is <value>",
+	},
+	{
+		Code:         "max_reference_graph_size_exceeded",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMaxReferenceGraphSizeExceeded",
+		Description:  "Provided when the reason for a blueprint spec load error is due to the blueprint containing more references between elements than the configured limit.",
+		Example:      "validation failed due to the blueprint containing <value> references between elements, the maximum number of references allowed is <value>",
+	},
+	{
+		Code:         "max_resources_exceeded",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMaxResourcesExceeded",
+		Description:  "Provided when the reason for a blueprint spec load error is due to the blueprint defining more resources than the configured limit.",
+		Example:      "validation failed due to the blueprint defining <value> resources, the maximum number of resources allowed in a blueprint is <value>",
+	},
+	{
+		Code:         "max_substitution_depth_exceeded",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMaxSubstitutionDepthExceeded",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a substitution being nested deeper than the configured limit.",
+		Example:      "validation failed due to a substitution with a nesting depth of <value>, the maximum substitution depth allowed is <value>",
+	},
+	{
+		Code:         "missing_child_blueprint_path",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeMissingChildBlueprintPath",
+		Description:  "Provided when the reason for an error during deployment or change staging is due to a missing path to a child blueprint in an include.",
+		Example:      "[include.<value>]: child blueprint path is missing for include",
+	},
+	{
+		Code:         "missing_export_type",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMissingExportType",
+		Category:     errors.ErrorCategoryExport,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a missing export type.",
+		Example:      "validation failed due to a missing export type for export \"<value>\"",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Add Export Type",
+				Description: "Add a valid export type to the export definition.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "missing_include_dependency",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMissingIncludeDependency",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an include dependency in the \"dependsOn\" property not being a valid include or resource.",
+		Example:      "validation failed due to a missing dependency \"<value>\" for include \"<value>\", the dependency must be an include or resource in the same blueprint",
+	},
+	{
+		Code:         "missing_name_for_new_instance",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeMissingNameForNewInstance",
+		Description:  "Provided when the reason for an error during deployment is due to a missing name for a new instance that is being created. All blueprint instances require a user-defined name (or one generated by the caller system based on its context).",
+		Example:      "an instance name is required for new blueprint instances, the name must be provided in the deploy input",
+	},
+	{
+		Code:         "missing_resource",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeMissingResource",
+		Description:  "Provided when the reason for a blueprint spec load error is due to the resource provider missing an implementation for the resource type for one of the resources in the spec.",
+	},
+	{
+		Code:         "missing_resource_dependency",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMissingResourceDependency",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a resource dependency in the \"dependsOn\" property not being a valid resource.",
+		Example:      "validation failed due to a missing dependency \"<value>\" for resource \"<value>\"",
+	},
+	{
+		Code:         "missing_resources",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMissingResourcesOrIncludes",
+		Description:  "Provided when the reason for a blueprint spec load error is due no resources or includes being defined in the blueprint. An empty map or omitted property will result in this error.",
+		Example:      "validation failed as no resources or includes have been defined, at least one resource must be defined in a blueprint if there are no includes and at least one include must be defined in a blueprint if there are no resources",
+	},
+	{
+		Code:         "missing_transformers",
+		Package:      "container",
+		ConstantName: "ErrorReasonMissingTransformers",
+		Category:     errors.ErrorCategoryTransformer,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a spec referencing transformers that aren't supported by the blueprint loader used to parse the schema.",
+		Example:      "the following transformers are missing in the blueprint loader: <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeInstallTransformers),
+				Title:       "Install Transformers",
+				Description: "Install the missing transformers",
+				Priority:    1,
+			},
+			{
+				Type:        string(errors.ActionTypeCheckTransformers),
+				Title:       "Check Transformers",
+				Description: "Explore the available transformers",
+				Priority:    2,
+			},
+			{
+				Type:        string(errors.ActionTypeInstallTransformer),
+				Title:       "Install Transformer",
+				Description: "Install the missing transformer",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "missing_version",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMissingVersion",
+		Description:  "Provided when the reason for a blueprint spec load error is due to the version property not being provided for a blueprint.",
+		Example:      "validation failed due to a version not being provided, version is a required property",
+	},
+	{
+		Code:         "mixed_variable_types",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMixedVariableTypes",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to mixed variable types used in the options for a custom variable type.",
+		Example:      "validation failed due to mixed types provided as options for variable type \"<value>\" used in variable \"<value>\", all options must be of the same scalar type",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeContactVariableTypeDeveloper),
+				Title:       "Contact Variable Type Developer",
+				Description: "Contact the developer of the variable type to fix the issue.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "multiple_validation_errors",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeMultipleValidationErrors",
+		Description:  "Provided when the reason for a blueprint spec load error is due to multiple validation errors.",
+		Example:      "validation failed due to multiple errors",
+	},
+	{
+		Code:         "policy_evaluation_failed",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodePolicyEvaluationFailed",
+		Description:  "Provided when the reason for an error during deployment is due to the configured policy engine failing to evaluate policies for the changes to be deployed.",
+		Example:      "failed to evaluate policies for the deployment: <value>",
+	},
+	{
+		Code:         "policy_violation",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodePolicyViolation",
+		Description:  "Provided when the reason for an error during deployment is due to the changes to be deployed violating one or more policies with the \"deny\" level.",
+		Example:      "the changes to be deployed violate <value> policies, the changes must comply with all policies with the deny level to proceed",
+	},
+	{
+		Code:         "protected_resource_removal",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeProtectedResourceRemoval",
+		Description:  "Provided when the reason for an error during deployment, destruction or change staging is due to changes that would destroy resources that are protected with the \"bluelink.protect\" annotation.",
+		Example:      "the following resources cannot be destroyed because they are protected with the \"<value>\" annotation: <value>, the annotation must be removed and the blueprint deployed before these resources can be destroyed",
+	},
+	{
+		Code:         "protected_resource_replacement",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeProtectedResourceReplacement",
+		Description:  "Provided when the reason for an error during change staging is due to changes that would require a resource that is protected with the \"bluelink.protect\" annotation to be replaced.",
+		Example:      "resource \"<value>\" cannot be replaced because it is protected with the \"<value>\" annotation<value>, the annotation must be removed and the blueprint deployed before changes that require the resource to be replaced can be applied",
+	},
+	{
+		Code:         "reference_context_access",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeReferenceContextAccess",
+		Description:  "Provided when the reason for a blueprint spec load error is due to invalid reference context access.",
+	},
+	{
+		Code:         "reference_cycle",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeReferenceCycle",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a reference cycle being detected. This error code is used to collect and surface reference cycle errors for pure substitution reference cycles and link <-> substitution reference cycles.",
+		Example:      "validation failed due to a reference cycle in the blueprint, the cycle started with element: \"<value>\", this could be due to explicit references between elements or an implicit link conflicting with an explicit item reference",
+	},
+	{
+		Code:         "removed_child_has_dependents",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeRemovedChildHasDependents",
+		Description:  "Provided when the reason for an error during deployment is due to a child blueprint that is to be removed having dependents that will not be removed or recreated.",
+		Example:      "child blueprint \"<value>\" cannot be removed because it has dependents that will not be removed or recreated: <value>",
+	},
+	{
+		Code:         "removed_resource_has_dependents",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeRemovedResourceHasDependents",
+		Description:  "Provided when the reason for an error during deployment is due to a resource that is to be removed having dependents that will not be removed or recreated.",
+		Example:      "resource \"<value>\" cannot be removed because it has dependents that will not be removed or recreated: <value>",
+	},
+	{
+		Code:         "required_variable_missing",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeRequiredVariableMissing",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a required variable being missing.",
+		Example:      "required variable \"<value>\" has no value",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeProvideValue),
+				Title:       "Provide Value",
+				Description: "Provide a value for the required variable <value>",
+				Priority:    1,
+			},
+			{
+				Type:        string(errors.ActionTypeAddDefaultValue),
+				Title:       "Add Default Value",
+				Description: "Add a default value to the variable definition for <value>",
+				Priority:    2,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_complex_max_length_constraint_failure",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefComplexMaxLengthConstraintFailure",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a maximum length constraint failure for complex types.",
+		Example:      "validation failed due to <value> that has more items than the maximum length constraint at path \"<value>\", <value> provided when there must be at most <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_complex_min_length_constraint_failure",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefComplexMinLengthConstraintFailure",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a minimum length constraint failure for complex types.",
+		Example:      "validation failed due to <value> that has less items than the minimum length constraint at path \"<value>\", <value> provided when there must be at least <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_conflicting_fields",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefConflictingFields",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to fields being set together in the same object when they conflict with each other.",
+		Example:      "validation failed due to conflicting fields at path \"<value>\", \"<value>\" can not be set when \"<value>\" is set",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_exactly_one_of_constraint_failure",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefExactlyOneOfConstraintFailure",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to none or more than one of a set of fields in the same object being set when exactly one of the fields must be set.",
+		Example:      "validation failed at path \"<value>\" as exactly one of the fields <value> must be set, <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_invalid_type",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefInvalidType",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid type in a resource definition.",
+		Example:      "validation failed due to an invalid resource item at path \"<value>\" where the <value> type was expected, but <value> was found",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_item_empty",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefItemEmpty",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an empty resource definition item.",
+		Example:      "validation failed due to an empty resource item at path \"<value>\" where the <value> type was expected",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_max_constraint_failure",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefMaxConstraintFailure",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a maximum value constraint failure.",
+		Example:      "validation failed due to a value that is greater than the maximum constraint at path \"<value>\", <value> provided but the value must be less than or equal to <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_min_constraint_failure",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefMinConstraintFailure",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a minimum value constraint failure.",
+		Example:      "validation failed due to a value that is less than the minimum constraint at path \"<value>\", <value> provided but the value must be greater than or equal to <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_missing_conditionally_required_field",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefMissingConditionallyRequiredField",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a missing field that is required based on the value of another field in the same object.",
+		Example:      "validation failed due to a missing field \"<value>\" at path \"<value>\" that is required when <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_missing_required_field",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefMissingRequiredField",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a missing required field in a resource definition.",
+		Example:      "validation failed due to a missing required field \"<value>\" of type <value> at path \"<value>\"",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_not_allowed_value",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefNotAllowedValue",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a value not being in the allowed values list.",
+		Example:      "validation failed due to a value that is not allowed being provided at path \"<value>\", the value must be one of: <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_pattern_constraint_failure",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefPatternConstraintFailure",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a pattern constraint failure.",
+		Example:      "validation failed due to a value that does not match the pattern constraint at path \"<value>\", the value must match the pattern: <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_string_max_length_constraint_failure",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefStringMaxLengthConstraintFailure",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a maximum length constraint failure for strings.",
+		Example:      "validation failed due to a string value that is longer than the maximum length constraint at path \"<value>\", <value> provided when there must be at most <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_string_min_length_constraint_failure",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefStringMinLengthConstraintFailure",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a minimum length constraint failure for strings.",
+		Example:      "validation failed due to a string value that is shorter than the minimum length constraint at path \"<value>\", <value> provided when there must be at least <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_union_invalid_type",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefUnionInvalidType",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid type in a union item.",
+		Example:      "validation failed due to an invalid resource item found at path \"<value>\" where one of the types <value> was expected",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_union_item_empty",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefUnionItemEmpty",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an empty union item in a resource definition.",
+		Example:      "validation failed due to an empty resource item at path <value> where one of the types <value> was expected",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_unique_constraint_failure",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefUniqueConstraintFailure",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to multiple resources using the same value for fields that must have unique values across all resources in a blueprint.",
+		Example:      "validation failed due to a value at path \"<value>\" that must be unique but is also used at path \"<value>\", the value \"<value>\" can only be used once for fields in the \"<value>\" unique group",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_def_unknown_field",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceDefUnknownField",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an unknown field in a resource definition.",
+		Example:      "validation failed due to an unknown field \"<value>\" at path \"<value>\", only fields that match the resource definition schema are allowed",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeCheckResourceTypeSchema),
+				Title:       "Check Resource Type Schema",
+				Description: "Check the schema for the resource type for the expected fields and their types.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_not_found_in_state",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeResourceNotFoundInState",
+		Description:  "Provided when the reason for an error during deployment or change staging is due to a resource not being found in the state of a blueprint instance.",
+		Example:      "resource \"<value>\" not found in state for blueprint instance \"<value>\"",
+	},
+	{
+		Code:         "resource_spec_pre_validation_failed",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceSpecPreValidationFailed",
+		Description:  "Provided when the reason for a blueprint spec load error is due to resource spec pre-validation failure.",
+	},
+	{
+		Code:         "resource_template_link_length_mismatch",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeResourceTemplateLinkLengthMismatch",
+		Description:  "Provided when the reason for an error during deployment or change staging is due to a mismatch in the length of the resolved items for linked resource templates.",
+		Example:      "resource template <value> has a link to resource template <value> with a different input length, links between resource templates can only be made when the resolved items list from the `each` property of both templates is of the same length",
+	},
+	{
+		Code:         "resource_type_spec_def_missing",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceTypeSpecDefMissing",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a missing spec definition for a resource.",
+		Example:      "validation failed due to a missing spec definition for resource \"<value>\" of type \"<value>\"<value>: <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeContactResourceTypeDeveloper),
+				Title:       "Contact Resource Type Developer",
+				Description: "Contact the developer of the resource type <value> to fix the issue.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_type_spec_def_missing_schema",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeResourceTypeSpecDefMissingSchema",
+		Category:     errors.ErrorCategoryResourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a missing spec definition schema for a resource.",
+		Example:      "validation failed due to a missing spec definition schema for resource \"<value>\" of type \"<value>\"<value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeContactResourceTypeDeveloper),
+				Title:       "Contact Resource Type Developer",
+				Description: "Contact the developer of the resource type <value> to fix the issue.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "resource_validation_errors",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeResourceValidationErrors",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a collection of errors for one or more resources in the spec. This should be used for a wrapper error that holds more specific errors which can be used for reporting useful information about issues with the spec.",
+		Example:      "validation failed due to issues with <value> resources in the spec",
+	},
+	{
+		Code:         "resume_requires_manual_cleanup",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeResumeRequiresManualCleanup",
+		Description:  "Provided when the reason for an error when resuming an interrupted deployment is due to elements that can not be automatically reconciled and require manual cleanup before the deployment can continue.",
+		Example:      "the deployment for instance \"<value>\" can not be resumed as the following interrupted elements require manual cleanup: <value>",
+	},
+	{
+		Code:         "saved_plan_state_diverged",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeSavedPlanStateDiverged",
+		Description:  "Provided when the reason for an error when deploying from a saved plan is due to the state of the blueprint instance having changed since the plan was saved.",
+		Example:      "the state of blueprint instance \"<value>\" has changed since the plan was saved, changes must be staged again before they can be deployed",
+	},
+	{
+		Code:         "sub_func_inconsistent_arg_types",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeSubFuncInconsistentArgTypes",
+		Description:  "Provided when the reason for a blueprint spec load error is due to the arguments of a fallback substitution function such as \"try\" or \"coalesce\" resolving to different types.",
+		Example:      "validation failed due to inconsistent argument types for substitution function \"<value>\", all arguments must resolve to the same type, the argument at position <value> resolves to <value> but the argument at position <value> resolves to <value>",
+	},
+	{
+		Code:         "sub_func_invalid_base64_string",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeSubFuncInvalidBase64String",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a string literal that is not valid Base64 being passed into the \"base64decode\" substitution function.",
+		Example:      "validation failed due to an invalid Base64-encoded string being passed into the \"<value>\" function call argument at position <value> in \"<value>\", <value>",
+	},
+	{
+		Code:         "sub_func_invalid_elem_expression",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeSubFuncInvalidElemExpression",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an element expression passed into a higher-order substitution function such as \"map\" or \"filter\" resolving to an unexpected type.",
+		Example:      "validation failed due to the element expression passed into substitution function \"<value>\" resolving to type \"<value>\", the element expression is expected to resolve to <value> for each item",
+	},
+	{
+		Code:         "sub_func_invalid_regex_pattern",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeSubFuncInvalidRegexPattern",
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid regular expression pattern being passed into one of the regular expression substitution functions.",
+		Example:      "validation failed due to an invalid pattern being passed into the \"<value>\" function call argument at position <value> in \"<value>\", <value>",
+	},
+	{
+		Code:         "sub_func_link_arg_resource_not_found",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeSubFuncLinkArgResourceNotFound",
+		Description:  "Provided when the reason for a blueprint spec load error is due to a resource not being found in an argument to the \"link\" substitution function.",
+		Example:      "validation failed due to a missing resource \"<value>\" being referenced in the link function call argument at position <value> in \"<value>\"",
+	},
+	{
+		Code:         "sub_func_path_field_on_non_object",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeSubFuncPathFieldOnNonObject",
+		Description:  "Provided when a substitution applies a field accessor to a function that returns a scalar type.",
+		Example:      "validation failed due to a field accessor \"<value>\" being applied to the result of function \"<value>\" which returns type \"<value>\"; field access requires the return type to be an object",
+	},
+	{
+		Code:         "sub_func_path_index_on_non_array",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeSubFuncPathIndexOnNonArray",
+		Description:  "Provided when a substitution applies an array index to a function that returns a scalar type.",
+		Example:      "validation failed due to an array index [<value>] being applied to the result of function \"<value>\" which returns type \"<value>\"; array index access requires the return type to be an array or object",
+	},
+	{
+		Code:         "transform_validation_errors",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeTransformValidationErrors",
+		Description:  "Provided when one or more transformers produced error-level diagnostics during the transform/emit phase. Promoting these to load errors prevents the deploy engine from acting on a partially expanded blueprint when a transformer signals a fatal user-input problem (for example, an unsupported runtime) via diagnostics rather than a Go error.",
+	},
+	{
+		Code:         "unknown_deploy_targets",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeUnknownDeployTargets",
+		Description:  "Provided when the reason for an error during a targeted deployment is due to one or more of the targets not matching a resource in the blueprint.",
+		Example:      "the following deployment targets do not match any resources in the blueprint: <value>",
+	},
+	{
+		Code:         "unknown_destroy_targets",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeUnknownDestroyTargets",
+		Description:  "Provided when the reason for an error during a partial destroy is due to one or more of the targets not matching a resource or child blueprint in the blueprint instance.",
+		Example:      "the following destroy targets do not match any resources or child blueprints in the blueprint instance: <value>",
+	},
+	{
+		Code:         "unsupported_data_source_filter_operator",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeUnsupportedDataSourceFilterOperator",
+		Category:     errors.ErrorCategoryDataSourceType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an unsupported data source filter operator being provided.",
+		Example:      "data source \"<value>\" does not support the filter operator \"<value>\" for field \"<value>\", supported operators are: <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddDataSourceFilter),
+				Title:       "Choose Valid Filter Operator",
+				Description: "Choose a valid filter operator for the data source.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "unsupported_graph_format",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeUnsupportedGraphFormat",
+		Description:  "Provided when the reason for an error when exporting the dependency graph for a blueprint is due to an unsupported format being requested.",
+		Example:      "unsupported graph format \"<value>\", expected one of: <value>",
+	},
+	{
+		Code:         "unsupported_saved_plan_format",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeUnsupportedSavedPlanFormat",
+		Description:  "Provided when the reason for an error when deploying from a saved plan is due to the plan having been saved with an unsupported format version.",
+		Example:      "the saved plan has an unsupported format version \"<value>\", expected \"<value>\"",
+	},
+	{
+		Code:         "unused_data_source",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeUnusedDataSource",
+		Description:  "Provided for warnings about a data source that is defined in a blueprint but is never referenced.",
+		Example:      "data source \"<value>\" is defined but is never referenced in the blueprint",
+	},
+	{
+		Code:         "unused_value",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeUnusedValue",
+		Description:  "Provided for warnings about a value that is defined in a blueprint but is never referenced.",
+		Example:      "value \"<value>\" is defined but is never referenced in the blueprint",
+	},
+	{
+		Code:         "unused_variable",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeUnusedVariable",
+		Description:  "Provided for warnings about a variable that is defined in a blueprint but is never referenced.",
+		Example:      "variable \"<value>\" is defined but is never referenced in the blueprint",
+	},
+	{
+		Code:         "validation_rule_failed",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeValidationRuleFailed",
+		Description:  "Provided when a custom validation rule fails to run.",
+		Example:      "validation failed due to the custom validation rule \"<value>\" failing to run: <value>",
+	},
+	{
+		Code:         "validation_rule_violation",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeValidationRuleViolation",
+		Description:  "Provided when a blueprint violates a custom validation rule.",
+	},
+	{
+		Code:         "validation_warning",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeValidationWarning",
+		Description:  "Provided for warning diagnostics that are converted to load errors when warnings are treated as errors and a more specific reason code is not available.",
+	},
+	{
+		Code:         "variable_empty_default_value",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeVariableEmptyDefaultValue",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an empty default value for a variable.",
+		Example:      "validation failed due to an empty default <value> value for variable \"<value>\", you must provide a value when declaring a default in a blueprint",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Fix Variable Default Value",
+				Description: "Provide a valid default value for the variable or remove the default declaration.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "variable_empty_value",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeVariableEmptyValue",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an empty variable value.",
+		Example:      "validation failed due to an empty value being provided for variable \"<value>\", please provide a valid <value> value that is not empty",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Provide Non-Empty Variable Value",
+				Description: "Provide a valid non-empty value for the variable.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "variable_invalid_allowed_value",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeVariableInvalidAllowedValue",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid allowed value for a variable.",
+		Example:      "an invalid allowed value was provided, <value> with the value \"<value>\" was provided when only <value>s are allowed",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Fix Allowed Value Type",
+				Description: "Provide an allowed value with the correct type for the variable.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "variable_invalid_allowed_values",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeVariableInvalidAllowedValues",
+		Description:  "Provided when the reason for a blueprint spec load error is due to invalid allowed values for a variable.",
+		Example:      "validation failed due to one or more invalid allowed values being provided for variable \"<value>\"",
+	},
+	{
+		Code:         "variable_invalid_allowed_values_from",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeVariableInvalidAllowedValuesFrom",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid data source lookup definition for the allowed values of a variable.",
+		Example:      "validation failed due to an invalid allowedValuesFrom definition for variable \"<value>\": <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Fix Allowed Values Data Source",
+				Description: "Provide a data source type and field with literal filter values for allowedValuesFrom.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "variable_invalid_allowed_values_not_supported",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeVariableInvalidAllowedValuesNotSupported",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to allowed values not being supported for a variable type.",
+		Example:      "validation failed due to an allowed values list being provided for <value> variable \"<value>\", <value> variables do not support allowed values enumeration",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Remove Allowed Values",
+				Description: "Remove the allowed values list as this variable type does not support enumeration.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "variable_invalid_default_value",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeVariableInvalidDefaultValue",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid default value for a variable.",
+		Example:      "variable \"<value>\": expected <value>, got <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Fix Variable Type",
+				Description: "Update the variable type or default value for <value>",
+				Priority:    1,
+			},
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Fix Variable Default Value",
+				Description: "Provide a valid default value for the variable.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "variable_invalid_or_missing",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeVariableInvalidOrMissing",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid or missing variable value.",
+		Example:      "validation failed to a missing value for variable \"<value>\", a value of type <value> must be provided",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Provide Variable Value",
+				Description: "Provide a valid value for the variable with the correct type.",
+				Priority:    1,
+			},
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Fix Variable Type",
+				Description: "Provide a value with the correct type for the variable.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "variable_invalid_secret_value",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeVariableInvalidSecretValue",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to an invalid secret field value for a variable.",
+		Example:      "validation failed due to an invalid secret field value for variable \"<value>\", expected a boolean but got <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Fix Secret Field Value",
+				Description: "The secret field must be a boolean value (true or false).",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "variable_null_allowed_value",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeVariableNullAllowedValue",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a null allowed value for a variable.",
+		Example:      "null was provided for an allowed value, a valid <value> must be provided",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Fix Allowed Value",
+				Description: "Provide a valid non-null allowed value for the variable.",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "variable_validation_errors",
+		Package:      "container",
+		ConstantName: "ErrorReasonCodeVariableValidationErrors",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a collection of errors for one or more variables in the spec. This should be used for a wrapper error that holds more specific errors which can be used for reporting useful information about issues with the spec.",
+		Example:      "validation failed due to issues with <value> variables in the spec",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeAddVariableType),
+				Title:       "Add Variable Type",
+				Description: "Add the missing variable type",
+				Priority:    1,
+			},
+			{
+				Type:        string(errors.ActionTypeCheckVariableType),
+				Title:       "Check Variable Type",
+				Description: "Explore the available variable types",
+				Priority:    2,
+			},
+			{
+				Type:        string(errors.ActionTypeInstallProvider),
+				Title:       "Install Provider for Variable Type",
+				Description: "Install the provider for the variable type",
+				Priority:    1,
+			},
+		},
+	},
+	{
+		Code:         "variable_value_not_allowed",
+		Package:      "validation",
+		ConstantName: "ErrorReasonCodeVariableValueNotAllowed",
+		Category:     errors.ErrorCategoryVariableType,
+		Description:  "Provided when the reason for a blueprint spec load error is due to a variable value not being in the allowed values.",
+		Example:      "validation failed due to an invalid <value> being provided for <value> variable \"<value>\", only the following values are supported: <value>",
+		SuggestedActions: []errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Use Allowed Value",
+				Description: "Provide a value from the allowed values list for the variable.",
+				Priority:    1,
+			},
+		},
+	},
+}
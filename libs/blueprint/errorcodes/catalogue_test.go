@@ -0,0 +1,84 @@
+package errorcodes
+
+import (
+	"os"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errorcodes/internal/generator"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/validation"
+	"github.com/stretchr/testify/suite"
+)
+
+type CatalogueTestSuite struct {
+	suite.Suite
+}
+
+func (s *CatalogueTestSuite) Test_generated_catalogue_is_up_to_date() {
+	expected, err := generator.Generate("..")
+	s.Require().NoError(err)
+
+	current, err := os.ReadFile("catalogue_gen.go")
+	s.Require().NoError(err)
+
+	s.Assert().Equal(
+		string(expected),
+		string(current),
+		"the error code catalogue is out of date, run \"go generate\" in the errorcodes package",
+	)
+}
+
+func (s *CatalogueTestSuite) Test_looks_up_validation_error_code() {
+	entry, ok := Lookup(validation.ErrorReasonCodeVariableInvalidDefaultValue)
+	s.Require().True(ok)
+	s.Assert().Equal("validation", entry.Package)
+	s.Assert().Equal("ErrorReasonCodeVariableInvalidDefaultValue", entry.ConstantName)
+	s.Assert().Equal(errors.ErrorCategoryVariableType, entry.Category)
+	s.Assert().NotEmpty(entry.Description)
+	s.Assert().Equal("variable \"<value>\": expected <value>, got <value>", entry.Example)
+	s.Assert().Equal(
+		[]errors.SuggestedAction{
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Fix Variable Type",
+				Description: "Update the variable type or default value for <value>",
+				Priority:    1,
+			},
+			{
+				Type:        string(errors.ActionTypeFixVariableType),
+				Title:       "Fix Variable Default Value",
+				Description: "Provide a valid default value for the variable.",
+				Priority:    1,
+			},
+		},
+		entry.SuggestedActions,
+	)
+}
+
+func (s *CatalogueTestSuite) Test_looks_up_container_error_code() {
+	entry, ok := Lookup(container.ErrorReasonCodeInvalidSpecExtension)
+	s.Require().True(ok)
+	s.Assert().Equal("container", entry.Package)
+	s.Assert().Equal(
+		"unsupported spec file extension in <value>, only json and yaml are supported",
+		entry.Example,
+	)
+}
+
+func (s *CatalogueTestSuite) Test_reports_unknown_error_code() {
+	_, ok := Lookup("not_a_reason_code")
+	s.Assert().False(ok)
+}
+
+func (s *CatalogueTestSuite) Test_lists_all_entries_ordered_by_code() {
+	entries := All()
+	s.Require().NotEmpty(entries)
+	for i := 1; i < len(entries); i += 1 {
+		s.Assert().Less(string(entries[i-1].Code), string(entries[i].Code))
+	}
+}
+
+func TestCatalogueTestSuite(t *testing.T) {
+	suite.Run(t, new(CatalogueTestSuite))
+}
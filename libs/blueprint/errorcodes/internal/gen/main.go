@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errorcodes/internal/generator"
+)
+
+// Generates the error code catalogue, this is expected to be run
+// from the errorcodes package directory via go:generate.
+func main() {
+	fmt.Println("Generating catalogue_gen.go ...")
+
+	catalogueCode, err := generator.Generate("..")
+	if err != nil {
+		log.Fatalf("error generating error code catalogue: %s", err)
+	}
+
+	err = os.WriteFile("catalogue_gen.go", catalogueCode, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
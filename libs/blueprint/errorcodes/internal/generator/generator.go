@@ -0,0 +1,584 @@
+// Package generator produces the error code catalogue from the source
+// of the blueprint framework packages that define error reason codes.
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	blueprintModulePath = "github.com/newstack-cloud/bluelink/libs/blueprint"
+	errorsPackagePath   = blueprintModulePath + "/errors"
+)
+
+// CataloguePackages holds the packages, relative to the root of
+// the blueprint framework module, that error reason codes are
+// collected from for the catalogue.
+var CataloguePackages = []string{
+	"container",
+	"validation",
+}
+
+type catalogueEntry struct {
+	Code             string
+	Package          string
+	ConstantName     string
+	Category         string
+	Description      string
+	Example          string
+	SuggestedActions []*suggestedAction
+}
+
+type suggestedAction struct {
+	// Type holds a Go expression for the action type
+	// as it will be rendered in the generated catalogue.
+	Type        string
+	Title       string
+	Description string
+	Priority    int
+}
+
+// Generate produces the Go source for the error code catalogue from
+// the packages in the blueprint framework module at the provided
+// root directory.
+// Descriptions are taken from the doc comments of error reason code constants,
+// examples are taken from the first error message in the source that uses
+// an error reason code and categories and suggested actions are taken from
+// the error context provided with errors that use an error reason code.
+func Generate(blueprintDir string) ([]byte, error) {
+	entries := []*catalogueEntry{}
+	for _, pkg := range CataloguePackages {
+		pkgEntries, err := collectPackageEntries(blueprintDir, pkg)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, pkgEntries...)
+	}
+
+	slices.SortFunc(entries, func(a, b *catalogueEntry) int {
+		return strings.Compare(a.Code, b.Code)
+	})
+
+	buf := &bytes.Buffer{}
+	err := catalogueTemplate.Execute(buf, entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render error code catalogue: %w", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format error code catalogue: %w", err)
+	}
+
+	return formatted, nil
+}
+
+type parsedFile struct {
+	file *ast.File
+	// Maps import paths to the names they are referenced
+	// by in the file.
+	importNames map[string]string
+}
+
+func collectPackageEntries(blueprintDir string, pkg string) ([]*catalogueEntry, error) {
+	files, err := parsePackageFiles(filepath.Join(blueprintDir, pkg))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[string]*catalogueEntry{}
+	errorContextFuncs := map[string]*errorContextFunc{}
+	for _, file := range files {
+		collectReasonCodeConstants(file, pkg, entries)
+		collectErrorContextFuncs(file, errorContextFuncs)
+	}
+
+	for _, file := range files {
+		ast.Inspect(file.file, func(node ast.Node) bool {
+			lit, isCompositeLit := node.(*ast.CompositeLit)
+			if isCompositeLit {
+				collectReasonCodeUsage(file, lit, entries, errorContextFuncs)
+			}
+			return true
+		})
+	}
+
+	pkgEntries := make([]*catalogueEntry, 0, len(entries))
+	for _, entry := range entries {
+		pkgEntries = append(pkgEntries, entry)
+	}
+	return pkgEntries, nil
+}
+
+func parsePackageFiles(dir string) ([]*parsedFile, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSet := token.NewFileSet()
+	files := []*parsedFile{}
+	// os.ReadDir returns entries sorted by file name so the first usage
+	// of an error reason code is stable between runs.
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() ||
+			!strings.HasSuffix(name, ".go") ||
+			strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(
+			fileSet,
+			filepath.Join(dir, name),
+			nil,
+			parser.ParseComments,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, &parsedFile{
+			file:        file,
+			importNames: collectImportNames(file),
+		})
+	}
+
+	return files, nil
+}
+
+func collectImportNames(file *ast.File) map[string]string {
+	importNames := map[string]string{}
+	for _, importSpec := range file.Imports {
+		importPath, err := strconv.Unquote(importSpec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		name := importPath[strings.LastIndex(importPath, "/")+1:]
+		if importSpec.Name != nil {
+			name = importSpec.Name.Name
+		}
+		importNames[importPath] = name
+	}
+
+	return importNames
+}
+
+func collectReasonCodeConstants(
+	file *parsedFile,
+	pkg string,
+	entries map[string]*catalogueEntry,
+) {
+	for _, decl := range file.file.Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl || genDecl.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			if !isErrorsSelector(file, valueSpec.Type, "ErrorReasonCode") ||
+				len(valueSpec.Names) != len(valueSpec.Values) {
+				continue
+			}
+
+			for i, name := range valueSpec.Names {
+				code, isString := stringLiteral(valueSpec.Values[i])
+				if !isString {
+					continue
+				}
+
+				entries[name.Name] = &catalogueEntry{
+					Code:         code,
+					Package:      pkg,
+					ConstantName: name.Name,
+					Description:  describeConstant(valueSpec.Doc),
+				}
+			}
+		}
+	}
+}
+
+// errorContextFunc holds an error context literal returned by a helper
+// function that creates the same error context for multiple error reason codes.
+type errorContextFunc struct {
+	file         *parsedFile
+	errorContext *ast.CompositeLit
+}
+
+func collectErrorContextFuncs(
+	file *parsedFile,
+	errorContextFuncs map[string]*errorContextFunc,
+) {
+	for _, decl := range file.file.Decls {
+		funcDecl, isFuncDecl := decl.(*ast.FuncDecl)
+		if !isFuncDecl || funcDecl.Recv != nil || funcDecl.Body == nil {
+			continue
+		}
+
+		for _, stmt := range funcDecl.Body.List {
+			returnStmt, isReturn := stmt.(*ast.ReturnStmt)
+			if !isReturn || len(returnStmt.Results) != 1 {
+				continue
+			}
+
+			errorContext := errorContextLit(returnStmt.Results[0], nil)
+			if errorContext != nil && isErrorsSelector(file, errorContext.Type, "ErrorContext") {
+				errorContextFuncs[funcDecl.Name.Name] = &errorContextFunc{
+					file:         file,
+					errorContext: errorContext,
+				}
+			}
+		}
+	}
+}
+
+func collectReasonCodeUsage(
+	file *parsedFile,
+	lit *ast.CompositeLit,
+	entries map[string]*catalogueEntry,
+	errorContextFuncs map[string]*errorContextFunc,
+) {
+	fields := compositeLitFields(lit)
+	reasonCode, hasReasonCode := fields["ReasonCode"]
+	if !hasReasonCode {
+		return
+	}
+
+	entry := resolveReasonCodeEntry(reasonCode, entries)
+	if entry == nil {
+		return
+	}
+
+	if entry.Example == "" {
+		// Load and run errors hold messages in the Err field,
+		// diagnostics hold messages in the Message field.
+		if message, hasMessage := fields["Err"]; hasMessage {
+			entry.Example = messageExample(message)
+		} else if message, hasMessage := fields["Message"]; hasMessage {
+			entry.Example = messageExample(message)
+		}
+	}
+
+	errorContext := lit
+	errorContextFile := file
+	if contextField, hasContext := fields["Context"]; hasContext {
+		errorContext = errorContextLit(contextField, errorContextFuncs)
+		if contextFunc := calledErrorContextFunc(contextField, errorContextFuncs); contextFunc != nil {
+			errorContextFile = contextFunc.file
+		}
+	}
+
+	if errorContext != nil && isErrorsSelector(errorContextFile, errorContext.Type, "ErrorContext") {
+		collectErrorContext(errorContextFile, errorContext, entry)
+	}
+}
+
+// Only error reason codes defined in the package being collected are resolved,
+// codes defined in other packages are attributed to the package that
+// defines them.
+func resolveReasonCodeEntry(
+	reasonCode ast.Expr,
+	entries map[string]*catalogueEntry,
+) *catalogueEntry {
+	ident, isIdent := reasonCode.(*ast.Ident)
+	if !isIdent {
+		return nil
+	}
+
+	return entries[ident.Name]
+}
+
+// Resolves an error context literal from an expression that is either
+// a literal or a call to a helper function that returns a literal.
+func errorContextLit(
+	expr ast.Expr,
+	errorContextFuncs map[string]*errorContextFunc,
+) *ast.CompositeLit {
+	if contextFunc := calledErrorContextFunc(expr, errorContextFuncs); contextFunc != nil {
+		return contextFunc.errorContext
+	}
+
+	unaryExpr, isUnary := expr.(*ast.UnaryExpr)
+	if isUnary && unaryExpr.Op == token.AND {
+		expr = unaryExpr.X
+	}
+
+	lit, isCompositeLit := expr.(*ast.CompositeLit)
+	if !isCompositeLit {
+		return nil
+	}
+
+	return lit
+}
+
+func calledErrorContextFunc(
+	expr ast.Expr,
+	errorContextFuncs map[string]*errorContextFunc,
+) *errorContextFunc {
+	callExpr, isCall := expr.(*ast.CallExpr)
+	if !isCall {
+		return nil
+	}
+
+	funcIdent, isIdent := callExpr.Fun.(*ast.Ident)
+	if !isIdent {
+		return nil
+	}
+
+	return errorContextFuncs[funcIdent.Name]
+}
+
+func collectErrorContext(
+	file *parsedFile,
+	errorContext *ast.CompositeLit,
+	entry *catalogueEntry,
+) {
+	fields := compositeLitFields(errorContext)
+	if category, hasCategory := fields["Category"]; hasCategory && entry.Category == "" {
+		entry.Category = errorsConstantName(file, category)
+	}
+
+	actions, hasActions := fields["SuggestedActions"].(*ast.CompositeLit)
+	if !hasActions {
+		return
+	}
+
+	for _, elt := range actions.Elts {
+		actionLit, isCompositeLit := elt.(*ast.CompositeLit)
+		if !isCompositeLit {
+			continue
+		}
+
+		action := suggestedActionFromLit(file, actionLit)
+		if action != nil && !hasSuggestedAction(entry, action) {
+			entry.SuggestedActions = append(entry.SuggestedActions, action)
+		}
+	}
+}
+
+func suggestedActionFromLit(file *parsedFile, actionLit *ast.CompositeLit) *suggestedAction {
+	fields := compositeLitFields(actionLit)
+	action := &suggestedAction{
+		Type:        actionTypeExpr(file, fields["Type"]),
+		Title:       messageExample(fields["Title"]),
+		Description: messageExample(fields["Description"]),
+	}
+	if action.Type == "" || action.Title == "" {
+		return nil
+	}
+
+	if priorityLit, isBasicLit := fields["Priority"].(*ast.BasicLit); isBasicLit &&
+		priorityLit.Kind == token.INT {
+		action.Priority, _ = strconv.Atoi(priorityLit.Value)
+	}
+
+	return action
+}
+
+func actionTypeExpr(file *parsedFile, expr ast.Expr) string {
+	if value, isString := stringLiteral(expr); isString {
+		return strconv.Quote(value)
+	}
+
+	// Action types are converted to strings when provided
+	// in a suggested action, for example: string(errors.ActionTypeInstallProvider).
+	callExpr, isCall := expr.(*ast.CallExpr)
+	if !isCall || len(callExpr.Args) != 1 {
+		return ""
+	}
+
+	constantName := errorsConstantName(file, callExpr.Args[0])
+	if constantName == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("string(errors.%s)", constantName)
+}
+
+func hasSuggestedAction(entry *catalogueEntry, action *suggestedAction) bool {
+	return slices.ContainsFunc(entry.SuggestedActions, func(existing *suggestedAction) bool {
+		return existing.Type == action.Type && existing.Title == action.Title
+	})
+}
+
+func compositeLitFields(lit *ast.CompositeLit) map[string]ast.Expr {
+	fields := map[string]ast.Expr{}
+	for _, elt := range lit.Elts {
+		keyValue, isKeyValue := elt.(*ast.KeyValueExpr)
+		if !isKeyValue {
+			continue
+		}
+
+		if key, isIdent := keyValue.Key.(*ast.Ident); isIdent {
+			fields[key.Name] = keyValue.Value
+		}
+	}
+
+	return fields
+}
+
+// Determines whether the provided expression is a selector for the provided
+// name in the blueprint framework errors package.
+func isErrorsSelector(file *parsedFile, expr ast.Expr, name string) bool {
+	selector, isSelector := expr.(*ast.SelectorExpr)
+	return isSelector && selector.Sel.Name == name && errorsConstantName(file, selector) != ""
+}
+
+// Resolves the name of a constant in the blueprint framework errors package
+// from a selector expression, an empty string is returned when the expression
+// does not refer to the errors package.
+func errorsConstantName(file *parsedFile, expr ast.Expr) string {
+	selector, isSelector := expr.(*ast.SelectorExpr)
+	if !isSelector {
+		return ""
+	}
+
+	pkgIdent, isIdent := selector.X.(*ast.Ident)
+	errorsImportName, importsErrors := file.importNames[errorsPackagePath]
+	if !isIdent || !importsErrors || pkgIdent.Name != errorsImportName {
+		return ""
+	}
+
+	return selector.Sel.Name
+}
+
+// Resolves the value of a string literal or a concatenation
+// of string literals.
+func stringLiteral(expr ast.Expr) (string, bool) {
+	binaryExpr, isBinary := expr.(*ast.BinaryExpr)
+	if isBinary && binaryExpr.Op == token.ADD {
+		left, isLeftString := stringLiteral(binaryExpr.X)
+		right, isRightString := stringLiteral(binaryExpr.Y)
+		return left + right, isLeftString && isRightString
+	}
+
+	lit, isBasicLit := expr.(*ast.BasicLit)
+	if !isBasicLit || lit.Kind != token.STRING {
+		return "", false
+	}
+
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+
+	return value, true
+}
+
+var formatVerbPattern = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+// Derives an example message from a string literal or a call to a
+// formatting function such as fmt.Errorf, fmt.Sprintf or errors.New.
+// Formatting verbs are replaced with placeholders for the values
+// that are derived from a blueprint when the message is created.
+func messageExample(expr ast.Expr) string {
+	if value, isString := stringLiteral(expr); isString {
+		return value
+	}
+
+	callExpr, isCall := expr.(*ast.CallExpr)
+	if !isCall || len(callExpr.Args) == 0 {
+		return ""
+	}
+
+	format, isString := stringLiteral(callExpr.Args[0])
+	if !isString {
+		return ""
+	}
+
+	return formatVerbPattern.ReplaceAllStringFunc(format, func(verb string) string {
+		switch verb {
+		case "%%":
+			return "%"
+		case "%q":
+			return "\"<value>\""
+		default:
+			return "<value>"
+		}
+	})
+}
+
+// Derives a description from the doc comment of an error reason code constant,
+// doc comments in the form "ErrorReasonCodeX is provided when ..." are
+// rewritten as "Provided when ...".
+func describeConstant(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+
+	description := strings.Join(strings.Fields(doc.Text()), " ")
+	words := strings.SplitN(description, " ", 3)
+	if len(words) == 3 && strings.HasPrefix(words[0], "ErrorReason") && words[1] == "is" {
+		description = capitalise(words[2])
+	}
+
+	return description
+}
+
+func capitalise(value string) string {
+	runes := []rune(value)
+	if len(runes) == 0 {
+		return value
+	}
+
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+var catalogueTemplate = template.Must(
+	template.New("catalogue").
+		Funcs(template.FuncMap{"quote": strconv.Quote}).
+		Parse(`// Code generated by go generate; DO NOT EDIT.
+package errorcodes
+
+import "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+
+var catalogue = []*Entry{
+{{- range . }}
+	{
+		Code: {{ quote .Code }},
+		Package: {{ quote .Package }},
+		ConstantName: {{ quote .ConstantName }},
+		{{- if .Category }}
+		Category: errors.{{ .Category }},
+		{{- end }}
+		Description: {{ quote .Description }},
+		{{- if .Example }}
+		Example: {{ quote .Example }},
+		{{- end }}
+		{{- if .SuggestedActions }}
+		SuggestedActions: []errors.SuggestedAction{
+		{{- range .SuggestedActions }}
+			{
+				Type: {{ .Type }},
+				Title: {{ quote .Title }},
+				{{- if .Description }}
+				Description: {{ quote .Description }},
+				{{- end }}
+				{{- if .Priority }}
+				Priority: {{ .Priority }},
+				{{- end }}
+			},
+		{{- end }}
+		},
+		{{- end }}
+	},
+{{- end }}
+}
+`),
+)
@@ -69,12 +69,28 @@ const (
 	ActionTypeContactDataSourceTypeDeveloper ActionType = "contact_data_source_type_developer"
 	ActionTypeCheckDataSourceFilterFields    ActionType = "check_data_source_filter_fields"
 	ActionTypeAddDataSourceType              ActionType = "add_data_source_type"
+	ActionTypeRenameElement                  ActionType = "rename_element"
 )
 
 // ErrorReasonCodeAnyTypeWarning is used to tag warning diagnostics
 // where a substitution resolves to the "any" type.
 const ErrorReasonCodeAnyTypeWarning ErrorReasonCode = "any_type_warning"
 
+// ErrorSeverity indicates how severe an issue reported
+// as a load error is.
+type ErrorSeverity string
+
+const (
+	// ErrorSeverityError is for issues that prevent a blueprint from being loaded.
+	ErrorSeverityError ErrorSeverity = "error"
+	// ErrorSeverityWarning is for potential issues that do not prevent a blueprint
+	// from being loaded unless warnings are treated as errors.
+	ErrorSeverityWarning ErrorSeverity = "warning"
+	// ErrorSeverityInfo is for informational messages that do not prevent
+	// a blueprint from being loaded.
+	ErrorSeverityInfo ErrorSeverity = "info"
+)
+
 type LoadError struct {
 	ReasonCode     ErrorReasonCode
 	Err            error
@@ -85,6 +101,15 @@ type LoadError struct {
 	EndColumn      *int
 	ColumnAccuracy *source.ColumnAccuracy
 	Context        *ErrorContext `json:"context,omitempty"`
+	// Severity of the issue reported by the load error,
+	// when not set, the load error is treated as an error.
+	Severity ErrorSeverity `json:"severity,omitempty"`
+}
+
+// IsError determines whether the load error has the error severity,
+// load errors without a severity are treated as errors.
+func (e *LoadError) IsError() bool {
+	return e.Severity == "" || e.Severity == ErrorSeverityError
 }
 
 func (e *LoadError) Error() string {
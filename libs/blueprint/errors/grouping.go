@@ -0,0 +1,343 @@
+package errors
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ErrorGroup holds the errors for a single blueprint element
+// and reason code, this is used to present large sets of errors
+// (e.g. the child errors of a multiple validation errors error)
+// in a more digestible form than a flat list.
+type ErrorGroup struct {
+	// Element is the blueprint element that the errors in the group are for,
+	// this will be nil for errors that could not be associated
+	// with a specific element.
+	Element *ErrorElement `json:"element,omitempty"`
+	// ReasonCode is the reason code shared by all the errors in the group.
+	ReasonCode ErrorReasonCode `json:"reasonCode,omitempty"`
+	// Errors holds the deduplicated errors in the group
+	// in the order they first appeared.
+	Errors []*GroupedError `json:"errors"`
+}
+
+// ErrorElement identifies the blueprint element that an error is for.
+type ErrorElement struct {
+	// Type is the type of the element,
+	// one of "resource", "dataSource", "variable", "value", "include" or "export".
+	Type string `json:"type"`
+	// Name is the name of the element in the blueprint,
+	// for expanded templated resources, this will be the name of the resource template.
+	Name string `json:"name"`
+}
+
+// GroupedError is a single deduplicated error message in an error group.
+type GroupedError struct {
+	// Message is the error message, for errors deduplicated across the resources
+	// expanded from a resource template, this is the message
+	// for the first resource in the template.
+	Message string `json:"message"`
+	// Count is the number of times the error occurred.
+	Count int `json:"count"`
+	// Elements holds the names of the resources expanded from a resource template
+	// that the error occurred for, this is only populated for errors that were
+	// deduplicated across templated resources.
+	Elements []string `json:"elements,omitempty"`
+	// Line is the line in the source blueprint where the error first occurred.
+	Line *int `json:"line,omitempty"`
+	// Column is the column in the source blueprint where the error first occurred.
+	Column *int `json:"column,omitempty"`
+}
+
+var (
+	// Matches the first reference to a blueprint element in an error message,
+	// (e.g. `resource "ordersTable"`).
+	errorElementPattern = regexp.MustCompile(
+		`(resource|data source|variable|value|child blueprint|include|export) "([^"]+)"`,
+	)
+	// Matches the name of a resource expanded from a resource template,
+	// see core.ExpandedResourceName.
+	expandedResourceNamePattern = regexp.MustCompile(`^(.+)_\d+$`)
+)
+
+var errorElementTypes = map[string]string{
+	"resource":        "resource",
+	"data source":     "dataSource",
+	"variable":        "variable",
+	"value":           "value",
+	"child blueprint": "include",
+	"include":         "include",
+	"export":          "export",
+}
+
+// GroupErrors groups the leaf errors in the tree of child errors
+// for load and run errors by the blueprint element they are for and reason code.
+// Identical messages within a group are deduplicated, errors that only differ
+// by the name of resources expanded from the same resource template are
+// deduplicated into a single error that lists the names of the expanded resources.
+// Groups are ordered by the first occurrence of an error in the group.
+func GroupErrors(err error) []*ErrorGroup {
+	if err == nil {
+		return []*ErrorGroup{}
+	}
+
+	leaves := []*leafError{}
+	collectLeafErrors(err, &errorAncestry{}, &leaves)
+	templateNames := findTemplateNames(leaves)
+
+	groups := []*ErrorGroup{}
+	groupLookup := map[string]*ErrorGroup{}
+	groupedErrorLookup := map[string]*GroupedError{}
+	for _, leaf := range leaves {
+		element := leaf.element
+		messageKey := leaf.message
+		expandedName := ""
+		if element != nil && element.Type == "resource" {
+			if templateName, isTemplated := templateNames[element.Name]; isTemplated {
+				expandedName = element.Name
+				messageKey = strings.ReplaceAll(
+					leaf.message,
+					fmt.Sprintf("%q", element.Name),
+					fmt.Sprintf("%q", templateName),
+				)
+				element = &ErrorElement{Type: element.Type, Name: templateName}
+			}
+		}
+
+		groupKey := fmt.Sprintf("%s|%s", errorElementKey(element), leaf.reasonCode)
+		group, hasGroup := groupLookup[groupKey]
+		if !hasGroup {
+			group = &ErrorGroup{
+				Element:    element,
+				ReasonCode: leaf.reasonCode,
+				Errors:     []*GroupedError{},
+			}
+			groupLookup[groupKey] = group
+			groups = append(groups, group)
+		}
+
+		groupedErrorKey := fmt.Sprintf("%s|%s", groupKey, messageKey)
+		groupedError, hasGroupedError := groupedErrorLookup[groupedErrorKey]
+		if !hasGroupedError {
+			groupedError = &GroupedError{
+				Message: leaf.message,
+				Line:    leaf.line,
+				Column:  leaf.column,
+			}
+			groupedErrorLookup[groupedErrorKey] = groupedError
+			group.Errors = append(group.Errors, groupedError)
+		}
+
+		groupedError.Count += 1
+		if expandedName != "" && !slices.Contains(groupedError.Elements, expandedName) {
+			groupedError.Elements = append(groupedError.Elements, expandedName)
+		}
+	}
+
+	return groups
+}
+
+// FormatErrorGroups renders error groups as plain text
+// with an indented list of errors for each group,
+// this is useful for presenting errors in a terminal.
+func FormatErrorGroups(groups []*ErrorGroup) string {
+	sb := strings.Builder{}
+	for i, group := range groups {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+
+		sb.WriteString(formatErrorGroupHeading(group))
+		sb.WriteString("\n")
+		for _, groupedError := range group.Errors {
+			sb.WriteString("  - ")
+			sb.WriteString(groupedError.Message)
+			if groupedError.Count > 1 {
+				sb.WriteString(fmt.Sprintf(" (x%d)", groupedError.Count))
+			}
+			if len(groupedError.Elements) > 0 {
+				sb.WriteString(
+					fmt.Sprintf(" [%s]", strings.Join(groupedError.Elements, ", ")),
+				)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func formatErrorGroupHeading(group *ErrorGroup) string {
+	heading := "blueprint"
+	if group.Element != nil {
+		heading = fmt.Sprintf("%s %q", group.Element.Type, group.Element.Name)
+	}
+
+	if group.ReasonCode != "" {
+		heading = fmt.Sprintf("%s (%s)", heading, group.ReasonCode)
+	}
+
+	return fmt.Sprintf("%s:", heading)
+}
+
+type leafError struct {
+	message    string
+	reasonCode ErrorReasonCode
+	element    *ErrorElement
+	line       *int
+	column     *int
+}
+
+// Holds the closest values provided by ancestors of an error
+// in the tree of child errors.
+type errorAncestry struct {
+	reasonCode ErrorReasonCode
+	element    *ErrorElement
+	line       *int
+	column     *int
+}
+
+func collectLeafErrors(err error, ancestry *errorAncestry, leaves *[]*leafError) {
+	switch typedErr := err.(type) {
+	case *LoadError:
+		message := typedErr.Err.Error()
+		current := deriveErrorAncestry(
+			ancestry,
+			typedErr.ReasonCode,
+			message,
+			typedErr.Line,
+			typedErr.Column,
+		)
+		if len(typedErr.ChildErrors) == 0 {
+			*leaves = append(*leaves, leafFromAncestry(message, current))
+			return
+		}
+
+		for _, childErr := range typedErr.ChildErrors {
+			collectLeafErrors(childErr, current, leaves)
+		}
+	case *RunError:
+		message := typedErr.Err.Error()
+		current := deriveErrorAncestry(
+			ancestry,
+			typedErr.ReasonCode,
+			message,
+			/* line */ nil,
+			/* column */ nil,
+		)
+		if len(typedErr.ChildErrors) == 0 {
+			*leaves = append(*leaves, leafFromAncestry(message, current))
+			return
+		}
+
+		for _, childErr := range typedErr.ChildErrors {
+			collectLeafErrors(childErr, current, leaves)
+		}
+	default:
+		message := err.Error()
+		current := deriveErrorAncestry(
+			ancestry,
+			/* reasonCode */ "",
+			message,
+			/* line */ nil,
+			/* column */ nil,
+		)
+		*leaves = append(*leaves, leafFromAncestry(message, current))
+	}
+}
+
+func deriveErrorAncestry(
+	parent *errorAncestry,
+	reasonCode ErrorReasonCode,
+	message string,
+	line *int,
+	column *int,
+) *errorAncestry {
+	current := *parent
+	if reasonCode != "" {
+		current.reasonCode = reasonCode
+	}
+
+	if element := errorElementFromMessage(message); element != nil {
+		current.element = element
+	}
+
+	if line != nil {
+		current.line = line
+		current.column = column
+	}
+
+	return &current
+}
+
+func leafFromAncestry(message string, ancestry *errorAncestry) *leafError {
+	return &leafError{
+		message:    message,
+		reasonCode: ancestry.reasonCode,
+		element:    ancestry.element,
+		line:       ancestry.line,
+		column:     ancestry.column,
+	}
+}
+
+func errorElementFromMessage(message string) *ErrorElement {
+	match := errorElementPattern.FindStringSubmatch(message)
+	if len(match) < 3 {
+		return nil
+	}
+
+	return &ErrorElement{
+		Type: errorElementTypes[match[1]],
+		Name: match[2],
+	}
+}
+
+// Finds the resources that have been expanded from a resource template
+// based on the names of resources in the leaf errors.
+// Only resource names that share the same template name with at least
+// one other resource are treated as expanded resources to avoid
+// treating resources that have an index-like suffix as templated resources.
+func findTemplateNames(leaves []*leafError) map[string]string {
+	expandedNames := map[string][]string{}
+	for _, leaf := range leaves {
+		if leaf.element == nil || leaf.element.Type != "resource" {
+			continue
+		}
+
+		match := expandedResourceNamePattern.FindStringSubmatch(leaf.element.Name)
+		if len(match) < 2 {
+			continue
+		}
+
+		templateName := match[1]
+		if !slices.Contains(expandedNames[templateName], leaf.element.Name) {
+			expandedNames[templateName] = append(
+				expandedNames[templateName],
+				leaf.element.Name,
+			)
+		}
+	}
+
+	templateNames := map[string]string{}
+	for templateName, names := range expandedNames {
+		if len(names) < 2 {
+			continue
+		}
+
+		for _, name := range names {
+			templateNames[name] = templateName
+		}
+	}
+
+	return templateNames
+}
+
+func errorElementKey(element *ErrorElement) string {
+	if element == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s.%s", element.Type, element.Name)
+}
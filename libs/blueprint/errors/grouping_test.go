@@ -0,0 +1,221 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GroupingTestSuite struct {
+	suite.Suite
+}
+
+func (s *GroupingTestSuite) Test_groups_child_errors_by_element_and_reason_code() {
+	err := &LoadError{
+		ReasonCode: "multiple_validation_errors",
+		Err:        errors.New("validation failed due to multiple errors"),
+		ChildErrors: []error{
+			&LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        errors.New("validation failed due to the resource \"ordersTable\" missing a type"),
+				Line:       intPtr(10),
+				Column:     intPtr(5),
+			},
+			&LoadError{
+				ReasonCode: "invalid_variable",
+				Err:        errors.New("validation failed due to an incorrect type used for variable \"region\""),
+				Line:       intPtr(3),
+				Column:     intPtr(3),
+			},
+			&LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        errors.New("validation failed due to the resource \"ordersTable\" having an empty spec"),
+				Line:       intPtr(12),
+				Column:     intPtr(5),
+			},
+			errors.New("an unexpected error occurred"),
+		},
+	}
+
+	groups := GroupErrors(err)
+
+	s.Assert().Equal(
+		[]*ErrorGroup{
+			{
+				Element:    &ErrorElement{Type: "resource", Name: "ordersTable"},
+				ReasonCode: "invalid_resource",
+				Errors: []*GroupedError{
+					{
+						Message: "validation failed due to the resource \"ordersTable\" missing a type",
+						Count:   1,
+						Line:    intPtr(10),
+						Column:  intPtr(5),
+					},
+					{
+						Message: "validation failed due to the resource \"ordersTable\" having an empty spec",
+						Count:   1,
+						Line:    intPtr(12),
+						Column:  intPtr(5),
+					},
+				},
+			},
+			{
+				Element:    &ErrorElement{Type: "variable", Name: "region"},
+				ReasonCode: "invalid_variable",
+				Errors: []*GroupedError{
+					{
+						Message: "validation failed due to an incorrect type used for variable \"region\"",
+						Count:   1,
+						Line:    intPtr(3),
+						Column:  intPtr(3),
+					},
+				},
+			},
+			{
+				ReasonCode: "multiple_validation_errors",
+				Errors: []*GroupedError{
+					{
+						Message: "an unexpected error occurred",
+						Count:   1,
+					},
+				},
+			},
+		},
+		groups,
+	)
+}
+
+func (s *GroupingTestSuite) Test_deduplicates_identical_messages_across_templated_resources() {
+	err := &LoadError{
+		ReasonCode: "multiple_validation_errors",
+		Err:        errors.New("validation failed due to multiple errors"),
+		ChildErrors: []error{
+			&LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        errors.New("validation failed due to the resource \"orderQueue_0\" having an invalid spec"),
+			},
+			&LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        errors.New("validation failed due to the resource \"orderQueue_1\" having an invalid spec"),
+			},
+			&LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        errors.New("validation failed due to the resource \"orderQueue_2\" having an invalid spec"),
+			},
+			// Resources with an index-like suffix that do not share a template name
+			// with any other resource should not be treated as templated resources.
+			&LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        errors.New("validation failed due to the resource \"database_1\" having an invalid spec"),
+			},
+			&LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        errors.New("validation failed due to the resource \"database_1\" having an invalid spec"),
+			},
+		},
+	}
+
+	groups := GroupErrors(err)
+
+	s.Assert().Equal(
+		[]*ErrorGroup{
+			{
+				Element:    &ErrorElement{Type: "resource", Name: "orderQueue"},
+				ReasonCode: "invalid_resource",
+				Errors: []*GroupedError{
+					{
+						Message:  "validation failed due to the resource \"orderQueue_0\" having an invalid spec",
+						Count:    3,
+						Elements: []string{"orderQueue_0", "orderQueue_1", "orderQueue_2"},
+					},
+				},
+			},
+			{
+				Element:    &ErrorElement{Type: "resource", Name: "database_1"},
+				ReasonCode: "invalid_resource",
+				Errors: []*GroupedError{
+					{
+						Message: "validation failed due to the resource \"database_1\" having an invalid spec",
+						Count:   2,
+					},
+				},
+			},
+		},
+		groups,
+	)
+}
+
+func (s *GroupingTestSuite) Test_uses_element_from_parent_error_for_child_errors() {
+	err := &LoadError{
+		ReasonCode: "invalid_resource",
+		Err:        errors.New("validation failed due to errors in the resource spec for resource \"ordersFunction\""),
+		Line:       intPtr(20),
+		Column:     intPtr(3),
+		ChildErrors: []error{
+			errors.New("missing required field \"handler\""),
+		},
+	}
+
+	groups := GroupErrors(err)
+
+	s.Assert().Equal(
+		[]*ErrorGroup{
+			{
+				Element:    &ErrorElement{Type: "resource", Name: "ordersFunction"},
+				ReasonCode: "invalid_resource",
+				Errors: []*GroupedError{
+					{
+						Message: "missing required field \"handler\"",
+						Count:   1,
+						Line:    intPtr(20),
+						Column:  intPtr(3),
+					},
+				},
+			},
+		},
+		groups,
+	)
+}
+
+func (s *GroupingTestSuite) Test_formats_error_groups_as_plain_text() {
+	formatted := FormatErrorGroups([]*ErrorGroup{
+		{
+			Element:    &ErrorElement{Type: "resource", Name: "orderQueue"},
+			ReasonCode: "invalid_resource",
+			Errors: []*GroupedError{
+				{
+					Message:  "validation failed due to the resource \"orderQueue_0\" having an invalid spec",
+					Count:    2,
+					Elements: []string{"orderQueue_0", "orderQueue_1"},
+				},
+			},
+		},
+		{
+			Errors: []*GroupedError{
+				{
+					Message: "an unexpected error occurred",
+					Count:   1,
+				},
+			},
+		},
+	})
+
+	s.Assert().Equal(
+		"resource \"orderQueue\" (invalid_resource):\n"+
+			"  - validation failed due to the resource \"orderQueue_0\" having an invalid spec"+
+			" (x2) [orderQueue_0, orderQueue_1]\n"+
+			"\n"+
+			"blueprint:\n"+
+			"  - an unexpected error occurred\n",
+		formatted,
+	)
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func TestGroupingTestSuite(t *testing.T) {
+	suite.Run(t, new(GroupingTestSuite))
+}
@@ -38,8 +38,41 @@ type Definition struct {
 	// function composition, piping and a small collection of other higher-order
 	// functions.
 	Internal bool
+	// Volatility describes whether the function produces the same output
+	// for the same input arguments.
+	// When not set, the function is expected to be deterministic.
+	Volatility Volatility
 }
 
+// IsVolatile determines whether the function produces a different
+// value every time it is called, regardless of the input arguments.
+func (d *Definition) IsVolatile() bool {
+	return d != nil && d.Volatility == VolatilityVolatile
+}
+
+// Volatility describes how the output of a function can change
+// between calls with the same input arguments.
+// This is used to detect functions that will cause changes to be reported
+// for resources every time changes are staged.
+type Volatility string
+
+const (
+	// VolatilityDeterministic is used for pure functions that always produce
+	// the same output for the same input arguments.
+	// This is the default for functions that do not specify a volatility.
+	VolatilityDeterministic Volatility = ""
+	// VolatilityStable is used for functions that produce the same output
+	// for the same input arguments within a single run of the blueprint framework
+	// but can produce a different output in subsequent runs.
+	// For example, a function that reads the contents of a file.
+	VolatilityStable Volatility = "stable"
+	// VolatilityVolatile is used for functions that produce a different output
+	// every time they are called.
+	// For example, a function that generates a random identifier
+	// or retrieves the current time.
+	VolatilityVolatile Volatility = "volatile"
+)
+
 // Parameter is a parameter type definition for arguments
 // passed into a function.
 type Parameter interface {
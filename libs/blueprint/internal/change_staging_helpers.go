@@ -23,12 +23,22 @@ func NormaliseResourceChanges(changes *provider.Changes, excludeResourceInfo boo
 		UnchangedFields:           OrderStringSlice(changes.UnchangedFields),
 		FieldChangesKnownOnDeploy: OrderStringSlice(changes.FieldChangesKnownOnDeploy),
 		ComputedFields:            OrderStringSlice(changes.ComputedFields),
+		ReplaceStrategy:           changes.ReplaceStrategy,
+		RecreateTriggers:          orderOptionalStringSlice(changes.RecreateTriggers),
 		NewOutboundLinks:          changes.NewOutboundLinks,
 		OutboundLinkChanges:       changes.OutboundLinkChanges,
 		RemovedOutboundLinks:      changes.RemovedOutboundLinks,
 	}
 }
 
+func orderOptionalStringSlice(fields []string) []string {
+	if fields == nil {
+		return nil
+	}
+
+	return OrderStringSlice(fields)
+}
+
 func OrderFieldChanges(fieldChanges []provider.FieldChange) []provider.FieldChange {
 	orderedFieldChanges := make([]provider.FieldChange, len(fieldChanges))
 	copy(orderedFieldChanges, fieldChanges)
@@ -15,6 +15,7 @@ type ProviderMock struct {
 	Links               map[string]provider.Link
 	CustomVariableTypes map[string]provider.CustomVariableType
 	ProviderRetryPolicy *provider.RetryPolicy
+	ProviderConfigDef   *core.ConfigDefinition
 }
 
 func (p *ProviderMock) Namespace(ctx context.Context) (string, error) {
@@ -22,7 +23,7 @@ func (p *ProviderMock) Namespace(ctx context.Context) (string, error) {
 }
 
 func (p *ProviderMock) ConfigDefinition(ctx context.Context) (*core.ConfigDefinition, error) {
-	return nil, nil
+	return p.ProviderConfigDef, nil
 }
 
 func (p *ProviderMock) Resource(ctx context.Context, resourceType string) (provider.Resource, error) {
@@ -0,0 +1,510 @@
+package jsonschema
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/validation"
+)
+
+const (
+	// SchemaID is the identifier used for generated blueprint JSON Schema documents.
+	SchemaID = "https://bluelink.dev/schemas/blueprint.json"
+
+	substitutionDefinition = "substitution"
+	stringListDefinition   = "stringList"
+	scalarDefinition       = "scalar"
+	variableDefinition     = "variable"
+	valueDefinition        = "value"
+	includeDefinition      = "include"
+	resourceDefinition     = "resource"
+	dataSourceDefinition   = "dataSource"
+	dataSourceFilterDef    = "dataSourceFilter"
+	exportDefinition       = "export"
+	hookDefinition         = "hook"
+	conditionDefinition    = "condition"
+	resourceSpecDefPrefix  = "resourceSpec:"
+)
+
+// GenerateParams holds the registries and parameters used to generate
+// a JSON Schema for blueprint documents.
+type GenerateParams struct {
+	// ResourceRegistry is an optional registry used to include the
+	// spec schemas for all the resource types provided by loaded plugins.
+	// When not set, resource specs are allowed to contain any value.
+	ResourceRegistry resourcehelpers.Registry
+	// DataSourceRegistry is an optional registry used to restrict
+	// data source types to those provided by loaded plugins.
+	DataSourceRegistry provider.DataSourceRegistry
+	// Params holds the blueprint parameters passed into providers
+	// when retrieving resource spec definitions.
+	Params core.BlueprintParams
+}
+
+// Generate produces a JSON Schema (draft 2020-12) that describes
+// the blueprint document format.
+// The generated schema can be used by editors and external validators
+// to validate blueprint documents in YAML or JSON format without the
+// language server.
+//
+// Validation carried out with the generated schema is a subset
+// of the validation carried out when loading a blueprint, references
+// between elements and the results of substitutions are not checked.
+func Generate(ctx context.Context, params *GenerateParams) (*Schema, error) {
+	if params == nil {
+		params = &GenerateParams{}
+	}
+
+	defs := coreDefinitions()
+
+	resourceSpecs, err := resourceSpecDefinitions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for resourceType, specSchema := range resourceSpecs {
+		defs[resourceSpecDefPrefix+resourceType] = specSchema
+	}
+	defs[resourceDefinition] = resourceElementSchema(sortedKeys(resourceSpecs))
+
+	dataSourceTypes, err := dataSourceTypes(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	defs[dataSourceDefinition] = dataSourceElementSchema(dataSourceTypes)
+
+	return &Schema{
+		Schema:      Draft202012,
+		ID:          SchemaID,
+		Title:       "Bluelink Blueprint",
+		Description: "A blueprint document that describes a set of infrastructure resources.",
+		Type:        []string{"object"},
+		Properties: map[string]*Schema{
+			"version": {
+				Description: "The version of the blueprint specification.",
+				Type:        []string{"string"},
+				Enum:        toAnySlice(validation.SupportedVersions),
+			},
+			"transform": {
+				Description: "The transformers to apply to the blueprint before it is deployed.",
+				Ref:         Ref(stringListDefinition).Ref,
+			},
+			"variables":   elementMap(variableDefinition),
+			"values":      elementMap(valueDefinition),
+			"include":     elementMap(includeDefinition),
+			"resources":   elementMap(resourceDefinition),
+			"datasources": elementMap(dataSourceDefinition),
+			"exports":     elementMap(exportDefinition),
+			"hooks": {
+				Type:  []string{"array"},
+				Items: Ref(hookDefinition),
+			},
+			"links": {
+				Type:                 []string{"object"},
+				AdditionalProperties: True(),
+			},
+			"metadata": {
+				Type:                 []string{"object"},
+				AdditionalProperties: True(),
+			},
+		},
+		Required: []string{"version"},
+		// A blueprint must contain at least one resource or include.
+		AnyOf: []*Schema{
+			{Required: []string{"resources"}},
+			{Required: []string{"include"}},
+		},
+		AdditionalProperties: False(),
+		Defs:                 defs,
+	}, nil
+}
+
+func resourceSpecDefinitions(
+	ctx context.Context,
+	params *GenerateParams,
+) (map[string]*Schema, error) {
+	specs := map[string]*Schema{}
+	if params.ResourceRegistry == nil {
+		return specs, nil
+	}
+
+	resourceTypes, err := params.ResourceRegistry.ListResourceTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resourceType := range resourceTypes {
+		providerNamespace := provider.ExtractProviderFromItemType(resourceType)
+		specDefOutput, err := params.ResourceRegistry.GetSpecDefinition(
+			ctx,
+			resourceType,
+			&provider.ResourceGetSpecDefinitionInput{
+				ProviderContext: provider.NewProviderContextFromParams(
+					providerNamespace,
+					params.Params,
+				),
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to get spec definition for resource type %q: %w",
+				resourceType,
+				err,
+			)
+		}
+
+		if specDefOutput == nil || specDefOutput.SpecDefinition == nil {
+			specs[resourceType] = True()
+			continue
+		}
+
+		specSchema, err := FromResourceDefinitionsSchema(specDefOutput.SpecDefinition.Schema)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to convert spec schema for resource type %q: %w",
+				resourceType,
+				err,
+			)
+		}
+		specs[resourceType] = specSchema
+	}
+
+	return specs, nil
+}
+
+func dataSourceTypes(ctx context.Context, params *GenerateParams) ([]string, error) {
+	if params.DataSourceRegistry == nil {
+		return []string{}, nil
+	}
+
+	types, err := params.DataSourceRegistry.ListDataSourceTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Sorted(slices.Values(types)), nil
+}
+
+func resourceElementSchema(resourceTypes []string) *Schema {
+	resourceSchema := &Schema{
+		Type: []string{"object"},
+		Properties: map[string]*Schema{
+			"type": typeSchema(resourceTypes, "The type of the resource."),
+			"description": {
+				Type: []string{"string"},
+			},
+			"metadata": {
+				Type: []string{"object"},
+				Properties: map[string]*Schema{
+					"displayName": {Type: []string{"string"}},
+					"annotations": {
+						Type:                 []string{"object"},
+						AdditionalProperties: True(),
+					},
+					"labels": {
+						Type:                 []string{"object"},
+						AdditionalProperties: &Schema{Type: []string{"string"}},
+					},
+					"custom": True(),
+				},
+				AdditionalProperties: False(),
+			},
+			"dependsOn": Ref(stringListDefinition),
+			"condition": Ref(conditionDefinition),
+			"each":      {Type: []string{"string"}},
+			"linkSelector": {
+				Type: []string{"object"},
+				Properties: map[string]*Schema{
+					"byLabel": {
+						Type:                 []string{"object"},
+						AdditionalProperties: &Schema{Type: []string{"string"}},
+					},
+					"exclude": Ref(stringListDefinition),
+				},
+				AdditionalProperties: False(),
+			},
+			"removalPolicy":   enumSchema(schema.ValidRemovalPolicies),
+			"replaceStrategy": enumSchema(schema.ValidReplaceStrategies),
+			"ignoreChanges":   Ref(stringListDefinition),
+			"timeouts": {
+				Type: []string{"object"},
+				Properties: map[string]*Schema{
+					"create":  {Type: []string{"string"}},
+					"update":  {Type: []string{"string"}},
+					"destroy": {Type: []string{"string"}},
+				},
+				AdditionalProperties: False(),
+			},
+			"spec": True(),
+		},
+		Required:             []string{"type"},
+		AdditionalProperties: False(),
+	}
+
+	// The spec schema is selected based on the resource type,
+	// a conditional is used for each type so that errors are reported
+	// against the spec of the matching type only.
+	for _, resourceType := range resourceTypes {
+		resourceSchema.AllOf = append(resourceSchema.AllOf, &Schema{
+			If: &Schema{
+				Properties: map[string]*Schema{
+					"type": {Const: resourceType},
+				},
+				Required: []string{"type"},
+			},
+			Then: &Schema{
+				Properties: map[string]*Schema{
+					"spec": Ref(resourceSpecDefPrefix + resourceType),
+				},
+			},
+		})
+	}
+
+	return resourceSchema
+}
+
+func dataSourceElementSchema(dataSourceTypes []string) *Schema {
+	return &Schema{
+		Type: []string{"object"},
+		Properties: map[string]*Schema{
+			"type": typeSchema(dataSourceTypes, "The type of the data source."),
+			"metadata": {
+				Type: []string{"object"},
+				Properties: map[string]*Schema{
+					"displayName": {Type: []string{"string"}},
+					"annotations": {
+						Type:                 []string{"object"},
+						AdditionalProperties: True(),
+					},
+					"custom": True(),
+				},
+				AdditionalProperties: False(),
+			},
+			"filter": {
+				AnyOf: []*Schema{
+					Ref(dataSourceFilterDef),
+					{
+						Type:     []string{"array"},
+						Items:    Ref(dataSourceFilterDef),
+						MinItems: positiveIntOrNil(1),
+					},
+				},
+			},
+			"exports": {
+				AnyOf: []*Schema{
+					{
+						Type: []string{"object"},
+						AdditionalProperties: &Schema{
+							Type: []string{"object"},
+							Properties: map[string]*Schema{
+								"type":        enumSchema(schema.DataSourceFieldTypes),
+								"aliasFor":    {Type: []string{"string"}},
+								"description": {Type: []string{"string"}},
+							},
+							Required:             []string{"type"},
+							AdditionalProperties: False(),
+						},
+					},
+					{
+						Description: "Exports all fields of the data source.",
+						Const:       "*",
+					},
+				},
+			},
+			"description": {Type: []string{"string"}},
+		},
+		Required:             []string{"type", "filter", "exports"},
+		AdditionalProperties: False(),
+	}
+}
+
+func coreDefinitions() map[string]*Schema {
+	return map[string]*Schema{
+		substitutionDefinition: {
+			Description: "A string that contains one or more substitutions " +
+				"that are resolved when the blueprint is deployed.",
+			Type:    []string{"string"},
+			Pattern: `\$\{`,
+		},
+		stringListDefinition: {
+			AnyOf: []*Schema{
+				{Type: []string{"string"}},
+				{
+					Type:  []string{"array"},
+					Items: &Schema{Type: []string{"string"}},
+				},
+			},
+		},
+		scalarDefinition: {
+			Type: []string{"string", "integer", "number", "boolean"},
+		},
+		variableDefinition: {
+			Type: []string{"object"},
+			Properties: map[string]*Schema{
+				"type": {
+					Description: "The type of the variable, this can be one of the core " +
+						"types or a custom variable type provided by a plugin.",
+					Type: []string{"string"},
+				},
+				"description": {Type: []string{"string"}},
+				"secret":      {Type: []string{"boolean"}},
+				"default":     Ref(scalarDefinition),
+				"allowedValues": {
+					Type:  []string{"array"},
+					Items: Ref(scalarDefinition),
+				},
+				"allowedValuesFrom": {
+					Type: []string{"object"},
+					Properties: map[string]*Schema{
+						"dataSourceType": {Type: []string{"string"}},
+						"field":          {Type: []string{"string"}},
+						"filter": {
+							AnyOf: []*Schema{
+								Ref(dataSourceFilterDef),
+								{
+									Type:  []string{"array"},
+									Items: Ref(dataSourceFilterDef),
+								},
+							},
+						},
+					},
+					Required:             []string{"dataSourceType", "field"},
+					AdditionalProperties: False(),
+				},
+			},
+			Required:             []string{"type"},
+			AdditionalProperties: False(),
+		},
+		valueDefinition: {
+			Type: []string{"object"},
+			Properties: map[string]*Schema{
+				"type":        enumSchema(schema.ValueTypes),
+				"value":       True(),
+				"description": {Type: []string{"string"}},
+				"secret":      {Type: []string{"boolean"}},
+			},
+			Required:             []string{"type", "value"},
+			AdditionalProperties: False(),
+		},
+		includeDefinition: {
+			Type: []string{"object"},
+			Properties: map[string]*Schema{
+				"path": {Type: []string{"string"}},
+				"variables": {
+					Type:                 []string{"object"},
+					AdditionalProperties: True(),
+				},
+				"metadata": {
+					Type:                 []string{"object"},
+					AdditionalProperties: True(),
+				},
+				"description": {Type: []string{"string"}},
+				"dependsOn":   Ref(stringListDefinition),
+			},
+			Required:             []string{"path"},
+			AdditionalProperties: False(),
+		},
+		dataSourceFilterDef: {
+			Type: []string{"object"},
+			Properties: map[string]*Schema{
+				"field":    {Type: []string{"string"}},
+				"operator": enumSchema(schema.DataSourceFilterOperators),
+				"search": {
+					AnyOf: []*Schema{
+						Ref(scalarDefinition),
+						{
+							Type:  []string{"array"},
+							Items: Ref(scalarDefinition),
+						},
+					},
+				},
+			},
+			Required:             []string{"field", "operator", "search"},
+			AdditionalProperties: False(),
+		},
+		exportDefinition: {
+			Type: []string{"object"},
+			Properties: map[string]*Schema{
+				"type":        enumSchema(schema.ExportTypes),
+				"field":       {Type: []string{"string"}},
+				"description": {Type: []string{"string"}},
+			},
+			Required:             []string{"type", "field"},
+			AdditionalProperties: False(),
+		},
+		hookDefinition: {
+			Type: []string{"object"},
+			Properties: map[string]*Schema{
+				"event":    enumSchema(schema.HookEvents),
+				"resource": {Type: []string{"string"}},
+				"run":      {Type: []string{"string"}},
+			},
+			Required:             []string{"event", "run"},
+			AdditionalProperties: False(),
+		},
+		conditionDefinition: {
+			AnyOf: []*Schema{
+				{Type: []string{"string"}},
+				{
+					Type: []string{"object"},
+					Properties: map[string]*Schema{
+						"and": {
+							Type:  []string{"array"},
+							Items: Ref(conditionDefinition),
+						},
+						"or": {
+							Type:  []string{"array"},
+							Items: Ref(conditionDefinition),
+						},
+						"not": Ref(conditionDefinition),
+					},
+					MinProperties:        positiveIntOrNil(1),
+					MaxProperties:        positiveIntOrNil(1),
+					AdditionalProperties: False(),
+				},
+			},
+		},
+	}
+}
+
+func elementMap(definitionName string) *Schema {
+	return &Schema{
+		Type:                 []string{"object"},
+		AdditionalProperties: Ref(definitionName),
+	}
+}
+
+func typeSchema(knownTypes []string, description string) *Schema {
+	typeSchema := &Schema{
+		Description: description,
+		Type:        []string{"string"},
+	}
+	if len(knownTypes) > 0 {
+		typeSchema.Enum = toAnySlice(knownTypes)
+	}
+	return typeSchema
+}
+
+func enumSchema[Value ~string](values []Value) *Schema {
+	return &Schema{
+		Type: []string{"string"},
+		Enum: toAnySlice(values),
+	}
+}
+
+func toAnySlice[Value any](values []Value) []any {
+	anyValues := make([]any, 0, len(values))
+	for _, value := range values {
+		anyValues = append(anyValues, value)
+	}
+	return anyValues
+}
+
+func sortedKeys[Value any](values map[string]Value) []string {
+	return slices.Sorted(maps.Keys(values))
+}
@@ -0,0 +1,159 @@
+package jsonschema
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/stretchr/testify/suite"
+)
+
+type GenerateTestSuite struct {
+	suite.Suite
+}
+
+func (s *GenerateTestSuite) Test_generates_schema_for_core_blueprint_format() {
+	generated, err := Generate(context.Background(), nil)
+	s.Require().NoError(err)
+
+	rendered := s.render(generated)
+	s.Assert().Equal(Draft202012, rendered["$schema"])
+	s.Assert().Equal("object", rendered["type"])
+	s.Assert().Equal(false, rendered["additionalProperties"])
+	s.Assert().Equal([]any{"version"}, rendered["required"])
+
+	properties := rendered["properties"].(map[string]any)
+	s.Assert().Equal(
+		map[string]any{"$ref": "#/$defs/resource"},
+		properties["resources"].(map[string]any)["additionalProperties"],
+	)
+	s.Assert().Equal(
+		map[string]any{"$ref": "#/$defs/export"},
+		properties["exports"].(map[string]any)["additionalProperties"],
+	)
+
+	defs := rendered["$defs"].(map[string]any)
+	resource := defs["resource"].(map[string]any)
+	resourceType := resource["properties"].(map[string]any)["type"].(map[string]any)
+	s.Assert().Equal("string", resourceType["type"])
+	s.Assert().NotContains(resourceType, "enum")
+	s.Assert().Equal(true, resource["properties"].(map[string]any)["spec"])
+	s.Assert().NotContains(resource, "allOf")
+
+	export := defs["export"].(map[string]any)
+	s.Assert().Equal(
+		[]any{"string", "object", "integer", "float", "array", "boolean"},
+		export["properties"].(map[string]any)["type"].(map[string]any)["enum"],
+	)
+}
+
+func (s *GenerateTestSuite) Test_generates_schema_with_resource_specs_from_registry() {
+	generated, err := Generate(context.Background(), &GenerateParams{
+		ResourceRegistry: internal.NewResourceRegistryMock(
+			map[string]provider.Resource{
+				"example/complex": &internal.ExampleComplexResource{},
+			},
+		),
+	})
+	s.Require().NoError(err)
+
+	rendered := s.render(generated)
+	defs := rendered["$defs"].(map[string]any)
+	resource := defs["resource"].(map[string]any)
+	resourceType := resource["properties"].(map[string]any)["type"].(map[string]any)
+	s.Assert().Equal([]any{"example/complex"}, resourceType["enum"])
+
+	s.Assert().Equal(
+		[]any{
+			map[string]any{
+				"if": map[string]any{
+					"properties": map[string]any{
+						"type": map[string]any{"const": "example/complex"},
+					},
+					"required": []any{"type"},
+				},
+				"then": map[string]any{
+					"properties": map[string]any{
+						"spec": map[string]any{"$ref": "#/$defs/resourceSpec:example~1complex"},
+					},
+				},
+			},
+		},
+		resource["allOf"],
+	)
+
+	spec := defs["resourceSpec:example/complex"].(map[string]any)
+	specProperties := spec["anyOf"].([]any)[0].(map[string]any)["properties"].(map[string]any)
+	// Computed fields can not be set in a blueprint.
+	s.Assert().NotContains(specProperties, "id")
+	s.Assert().Contains(specProperties, "itemConfig")
+}
+
+func (s *GenerateTestSuite) Test_converts_resource_definitions_schema() {
+	converted, err := FromResourceDefinitionsSchema(&provider.ResourceDefinitionsSchema{
+		Type: provider.ResourceDefinitionsSchemaTypeObject,
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"name": {
+				Type:        provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The name of the resource.",
+			},
+			"port": {
+				Type:     provider.ResourceDefinitionsSchemaTypeInteger,
+				Nullable: true,
+			},
+			"arn": {
+				Type:     provider.ResourceDefinitionsSchemaTypeString,
+				Computed: true,
+			},
+		},
+		Required: []string{"port", "name"},
+	})
+	s.Require().NoError(err)
+
+	s.Assert().Equal(
+		map[string]any{
+			"anyOf": []any{
+				map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"name": map[string]any{
+							"type":        "string",
+							"description": "The name of the resource.",
+						},
+						"port": map[string]any{
+							"anyOf": []any{
+								map[string]any{
+									"anyOf": []any{
+										map[string]any{"type": "integer"},
+										map[string]any{"$ref": "#/$defs/substitution"},
+									},
+								},
+								map[string]any{"type": "null"},
+							},
+						},
+					},
+					"required":             []any{"name", "port"},
+					"additionalProperties": false,
+				},
+				map[string]any{"$ref": "#/$defs/substitution"},
+			},
+		},
+		s.render(converted),
+	)
+}
+
+func (s *GenerateTestSuite) render(schema *Schema) map[string]any {
+	serialised, err := json.Marshal(schema)
+	s.Require().NoError(err)
+
+	rendered := map[string]any{}
+	err = json.Unmarshal(serialised, &rendered)
+	s.Require().NoError(err)
+	return rendered
+}
+
+func TestGenerateTestSuite(t *testing.T) {
+	suite.Run(t, new(GenerateTestSuite))
+}
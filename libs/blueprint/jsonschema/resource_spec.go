@@ -0,0 +1,270 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// FromResourceDefinitionsSchema converts a resource spec definition schema
+// provided by a provider plugin to a JSON Schema.
+//
+// As any value in a resource spec can be a substitution that is resolved
+// at deploy time, every value that is not a plain string can also be
+// a string containing a substitution.
+// Computed fields are not included as they can not be set in a blueprint,
+// so documents that set them will fail validation as they would when loaded
+// by the blueprint framework.
+func FromResourceDefinitionsSchema(
+	schema *provider.ResourceDefinitionsSchema,
+) (*Schema, error) {
+	if schema == nil {
+		return True(), nil
+	}
+
+	converted, err := convertResourceDefinitionsSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	if schema.Nullable {
+		return &Schema{
+			AnyOf: []*Schema{converted, {Type: []string{"null"}}},
+		}, nil
+	}
+
+	return converted, nil
+}
+
+func convertResourceDefinitionsSchema(
+	schema *provider.ResourceDefinitionsSchema,
+) (*Schema, error) {
+	var converted *Schema
+	var err error
+	switch schema.Type {
+	case provider.ResourceDefinitionsSchemaTypeObject:
+		converted, err = convertObjectSchema(schema)
+	case provider.ResourceDefinitionsSchemaTypeMap:
+		converted, err = convertMapSchema(schema)
+	case provider.ResourceDefinitionsSchemaTypeArray:
+		converted, err = convertArraySchema(schema)
+	case provider.ResourceDefinitionsSchemaTypeUnion:
+		converted, err = convertUnionSchema(schema)
+	default:
+		converted, err = convertScalarSchema(schema)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = addAnnotations(converted, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return withSubstitution(converted, schema.Type), nil
+}
+
+func convertObjectSchema(schema *provider.ResourceDefinitionsSchema) (*Schema, error) {
+	properties := map[string]*Schema{}
+	for attrName, attrSchema := range schema.Attributes {
+		if attrSchema == nil || attrSchema.Computed {
+			continue
+		}
+
+		converted, err := FromResourceDefinitionsSchema(attrSchema)
+		if err != nil {
+			return nil, err
+		}
+		properties[attrName] = converted
+	}
+
+	required := []string{}
+	for _, fieldName := range schema.Required {
+		if _, isProperty := properties[fieldName]; isProperty {
+			required = append(required, fieldName)
+		}
+	}
+	slices.Sort(required)
+
+	return &Schema{
+		Type:                 []string{"object"},
+		Properties:           properties,
+		Required:             required,
+		AdditionalProperties: False(),
+	}, nil
+}
+
+func convertMapSchema(schema *provider.ResourceDefinitionsSchema) (*Schema, error) {
+	mapValues, err := FromResourceDefinitionsSchema(schema.MapValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schema{
+		Type:                 []string{"object"},
+		AdditionalProperties: mapValues,
+		MinProperties:        positiveIntOrNil(schema.MinLength),
+		MaxProperties:        positiveIntOrNil(schema.MaxLength),
+	}, nil
+}
+
+func convertArraySchema(schema *provider.ResourceDefinitionsSchema) (*Schema, error) {
+	items, err := FromResourceDefinitionsSchema(schema.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schema{
+		Type:     []string{"array"},
+		Items:    items,
+		MinItems: positiveIntOrNil(schema.MinLength),
+		MaxItems: positiveIntOrNil(schema.MaxLength),
+	}, nil
+}
+
+func convertUnionSchema(schema *provider.ResourceDefinitionsSchema) (*Schema, error) {
+	// The schemas in a union can overlap (e.g. a string and a string with
+	// a specific set of allowed values) so a value only needs to match
+	// at least one of the schemas.
+	anyOf := make([]*Schema, 0, len(schema.OneOf))
+	for _, unionSchema := range schema.OneOf {
+		converted, err := FromResourceDefinitionsSchema(unionSchema)
+		if err != nil {
+			return nil, err
+		}
+		anyOf = append(anyOf, converted)
+	}
+
+	return &Schema{AnyOf: anyOf}, nil
+}
+
+func convertScalarSchema(schema *provider.ResourceDefinitionsSchema) (*Schema, error) {
+	converted := &Schema{
+		Type: []string{scalarJSONSchemaType(schema.Type)},
+	}
+
+	if schema.Type == provider.ResourceDefinitionsSchemaTypeString {
+		converted.Pattern = schema.Pattern
+		converted.MinLength = positiveIntOrNil(schema.MinLength)
+		converted.MaxLength = positiveIntOrNil(schema.MaxLength)
+	}
+
+	if schema.Minimum != nil {
+		minimum, err := jsonValue(schema.Minimum)
+		if err != nil {
+			return nil, err
+		}
+		converted.Minimum = minimum
+	}
+
+	if schema.Maximum != nil {
+		maximum, err := jsonValue(schema.Maximum)
+		if err != nil {
+			return nil, err
+		}
+		converted.Maximum = maximum
+	}
+
+	for _, allowedValue := range schema.AllowedValues {
+		value, err := jsonValue(allowedValue)
+		if err != nil {
+			return nil, err
+		}
+		converted.Enum = append(converted.Enum, value)
+	}
+
+	return converted, nil
+}
+
+func addAnnotations(converted *Schema, schema *provider.ResourceDefinitionsSchema) error {
+	converted.Title = schema.Label
+	converted.Description = schema.Description
+	converted.Deprecated = schema.Deprecated
+
+	if schema.Default != nil {
+		defaultValue, err := jsonValue(schema.Default)
+		if err != nil {
+			return err
+		}
+		converted.Default = defaultValue
+	}
+
+	return nil
+}
+
+// Allows a substitution in place of values that are not plain strings
+// or that have constraints that a substitution would not satisfy.
+func withSubstitution(
+	converted *Schema,
+	schemaType provider.ResourceDefinitionsSchemaType,
+) *Schema {
+	if schemaType == provider.ResourceDefinitionsSchemaTypeUnion {
+		// Each of the schemas in the union already allow substitutions.
+		return converted
+	}
+
+	if schemaType == provider.ResourceDefinitionsSchemaTypeString &&
+		converted.Pattern == "" &&
+		converted.MinLength == nil &&
+		converted.MaxLength == nil &&
+		len(converted.Enum) == 0 {
+		return converted
+	}
+
+	return &Schema{
+		Title:       converted.Title,
+		Description: converted.Description,
+		Deprecated:  converted.Deprecated,
+		Default:     converted.Default,
+		AnyOf:       []*Schema{withoutAnnotations(converted), Ref(substitutionDefinition)},
+	}
+}
+
+func withoutAnnotations(schema *Schema) *Schema {
+	withoutAnnotations := *schema
+	withoutAnnotations.Title = ""
+	withoutAnnotations.Description = ""
+	withoutAnnotations.Deprecated = false
+	withoutAnnotations.Default = nil
+	return &withoutAnnotations
+}
+
+func scalarJSONSchemaType(schemaType provider.ResourceDefinitionsSchemaType) string {
+	switch schemaType {
+	case provider.ResourceDefinitionsSchemaTypeInteger:
+		return "integer"
+	case provider.ResourceDefinitionsSchemaTypeFloat:
+		return "number"
+	case provider.ResourceDefinitionsSchemaTypeBoolean:
+		return "boolean"
+	default:
+		return "string"
+	}
+}
+
+func positiveIntOrNil(value int) *int {
+	if value <= 0 {
+		return nil
+	}
+	return &value
+}
+
+// Renders a mapping node or scalar value as raw JSON so that it
+// can be used for keywords such as "enum" and "default".
+func jsonValue(value any) (json.RawMessage, error) {
+	switch typedValue := value.(type) {
+	case *core.MappingNode:
+		if core.IsNilMappingNode(typedValue) {
+			return json.RawMessage("null"), nil
+		}
+	case *core.ScalarValue:
+		if typedValue == nil {
+			return json.RawMessage("null"), nil
+		}
+	}
+
+	return json.Marshal(value)
+}
@@ -0,0 +1,105 @@
+// Package jsonschema provides a generator for JSON Schema documents
+// that describe the blueprint document format, including the spec
+// schemas of resource types provided by loaded provider plugins.
+package jsonschema
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	// Draft202012 is the URI of the JSON Schema dialect
+	// that generated schemas conform to.
+	Draft202012 = "https://json-schema.org/draft/2020-12/schema"
+)
+
+// Schema represents a JSON Schema document or sub-schema.
+// Only the keywords that are needed to describe blueprint documents
+// are supported.
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	ID          string `json:"$id,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Type holds one or more JSON Schema types,
+	// a single type is rendered as a string and multiple
+	// types are rendered as an array.
+	Type                 []string           `json:"-"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Const                any                `json:"const,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	PatternProperties    map[string]*Schema `json:"patternProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	If                   *Schema            `json:"if,omitempty"`
+	Then                 *Schema            `json:"then,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+	Minimum              any                `json:"minimum,omitempty"`
+	Maximum              any                `json:"maximum,omitempty"`
+	Default              any                `json:"default,omitempty"`
+	Deprecated           bool               `json:"deprecated,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+	// A boolean schema, where true accepts any value
+	// and false rejects all values.
+	// When set, all other keywords are ignored.
+	boolValue *bool
+}
+
+// True creates a boolean schema that accepts any value.
+func True() *Schema {
+	value := true
+	return &Schema{boolValue: &value}
+}
+
+// False creates a boolean schema that rejects all values.
+func False() *Schema {
+	value := false
+	return &Schema{boolValue: &value}
+}
+
+// Ref creates a schema that references a definition
+// in the "$defs" section of the root schema.
+func Ref(definitionName string) *Schema {
+	return &Schema{Ref: "#/$defs/" + escapeJSONPointer(definitionName)}
+}
+
+// MarshalJSON implements the json.Marshaler interface
+// to render boolean schemas and the type keyword.
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	if s.boolValue != nil {
+		return json.Marshal(*s.boolValue)
+	}
+
+	type schemaAlias Schema
+	var schemaType any
+	if len(s.Type) == 1 {
+		schemaType = s.Type[0]
+	} else if len(s.Type) > 1 {
+		schemaType = s.Type
+	}
+
+	return json.Marshal(struct {
+		Type any `json:"type,omitempty"`
+		*schemaAlias
+	}{
+		Type:        schemaType,
+		schemaAlias: (*schemaAlias)(s),
+	})
+}
+
+func escapeJSONPointer(value string) string {
+	return jsonPointerEscaper.Replace(value)
+}
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
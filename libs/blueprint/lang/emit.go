@@ -88,6 +88,13 @@ func emitResourceBody(b *strings.Builder, resource *schema.Resource) error {
 		fmt.Fprintf(b, "%sremovalPolicy = %s\n", indentUnit, quote(string(resource.RemovalPolicy.Value)))
 	}
 
+	if resource.ReplaceStrategy != nil && resource.ReplaceStrategy.Value != "" {
+		fmt.Fprintf(b, "%sreplaceStrategy = %s\n", indentUnit, quote(string(resource.ReplaceStrategy.Value)))
+	}
+
+	emitIgnoreChanges(b, resource.IgnoreChanges)
+	emitTimeouts(b, resource.Timeouts)
+
 	if err := emitMetadata(b, resource.Metadata); err != nil {
 		return err
 	}
@@ -103,6 +103,7 @@ func emitIncludes(b *strings.Builder, bp *schema.Blueprint) error {
 			}
 			fmt.Fprintf(b, "%sdescription = %s\n", indentUnit, desc)
 		}
+		emitDependsOn(b, include.DependsOn)
 		if err := emitNamedBlock(b, "variables", include.Variables); err != nil {
 			return err
 		}
@@ -326,6 +327,50 @@ func emitDependsOn(b *strings.Builder, dependsOn *schema.DependsOnList) {
 	fmt.Fprintf(b, "%sdependsOn = [%s]\n", indentUnit, strings.Join(quoted, ", "))
 }
 
+func emitIgnoreChanges(b *strings.Builder, ignoreChanges *schema.IgnoreChangesList) {
+	if ignoreChanges == nil || len(ignoreChanges.Values) == 0 {
+		return
+	}
+
+	quoted := make([]string, len(ignoreChanges.Values))
+	for i, value := range ignoreChanges.Values {
+		quoted[i] = quote(value)
+	}
+
+	fmt.Fprintf(b, "%signoreChanges = [%s]\n", indentUnit, strings.Join(quoted, ", "))
+}
+
+func emitTimeouts(b *strings.Builder, timeouts *schema.ResourceTimeouts) {
+	if timeouts == nil {
+		return
+	}
+
+	operationTimeouts := []struct {
+		key   string
+		value *core.ScalarValue
+	}{
+		{key: "create", value: timeouts.Create},
+		{key: "update", value: timeouts.Update},
+		{key: "destroy", value: timeouts.Destroy},
+	}
+	entries := []string{}
+	for _, operationTimeout := range operationTimeouts {
+		if operationTimeout.value == nil || operationTimeout.value.StringValue == nil {
+			continue
+		}
+		entries = append(
+			entries,
+			fmt.Sprintf("%s = %s", operationTimeout.key, quote(*operationTimeout.value.StringValue)),
+		)
+	}
+
+	if len(entries) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "%stimeouts = { %s }\n", indentUnit, strings.Join(entries, ", "))
+}
+
 func emitForEach(b *strings.Builder, each *substitutions.StringOrSubstitutions) error {
 	if each == nil {
 		return nil
@@ -91,6 +91,32 @@ resource completeQueue: aws/sqs/queue {
 `)
 }
 
+func (s *EmitSuite) Test_emits_resource_timeouts() {
+	s.requireRoundTrip(`version "2025-11-02"
+
+resource ordersTable: aws/dynamodb/table {
+    timeouts = { create = "20m", destroy = "1h" }
+
+    spec {
+        tableName = "Orders"
+    }
+}
+`)
+}
+
+func (s *EmitSuite) Test_emits_resource_replace_strategy() {
+	s.requireRoundTrip(`version "2025-11-02"
+
+resource ordersTable: aws/dynamodb/table {
+    replaceStrategy = "destroyBeforeCreate"
+
+    spec {
+        tableName = "Orders"
+    }
+}
+`)
+}
+
 func TestEmitSuite(t *testing.T) {
 	suite.Run(t, new(EmitSuite))
 }
@@ -24,6 +24,7 @@ func (p *parser) parseVariableDecl(bp *schema.Blueprint) error {
 	}
 
 	variable := &schema.Variable{}
+	recordDuplicateDefinition(bp, "variables", name, bp.Variables.SourceMeta, meta)
 	bp.Variables.Values[name] = variable
 	bp.Variables.SourceMeta[name] = meta
 
@@ -101,6 +102,7 @@ func (p *parser) parseValueDecl(bp *schema.Blueprint) error {
 	}
 
 	value := &schema.Value{}
+	recordDuplicateDefinition(bp, "values", name, bp.Values.SourceMeta, meta)
 	bp.Values.Values[name] = value
 	bp.Values.SourceMeta[name] = meta
 
@@ -191,6 +193,7 @@ func (p *parser) parseDataDecl(bp *schema.Blueprint) error {
 		},
 		FieldsSourceMeta: map[string]*source.Meta{},
 	}
+	recordDuplicateDefinition(bp, "datasources", name, bp.DataSources.SourceMeta, meta)
 	bp.DataSources.Values[name] = ds
 	bp.DataSources.SourceMeta[name] = meta
 
@@ -231,6 +234,7 @@ func (p *parser) parseResourceDecl(bp *schema.Blueprint) error {
 		},
 		FieldsSourceMeta: map[string]*source.Meta{},
 	}
+	recordDuplicateDefinition(bp, "resources", name, bp.Resources.SourceMeta, meta)
 	bp.Resources.Values[name] = resource
 	bp.Resources.SourceMeta[name] = meta
 
@@ -275,6 +279,7 @@ func (p *parser) parseIncludeDecl(bp *schema.Blueprint) error {
 		Path:             path,
 		FieldsSourceMeta: map[string]*source.Meta{},
 	}
+	recordDuplicateDefinition(bp, "include", name, bp.Include.SourceMeta, meta)
 	bp.Include.Values[name] = include
 	bp.Include.SourceMeta[name] = meta
 
@@ -331,6 +336,28 @@ func (p *parser) parseIncludeField(inc *schema.Include) error {
 		}
 		inc.FieldsSourceMeta[field] = mergeEnd(fieldMeta, valueEnd)
 		return nil
+	case "dependsOn":
+		if _, err := p.expect(TokenAssign); err != nil {
+			return err
+		}
+
+		e, err := p.parseExpr()
+		if err != nil {
+			return err
+		}
+
+		list, err := exprToResourceNameList(e, "dependsOn")
+		if err != nil {
+			return err
+		}
+		inc.DependsOn = &schema.DependsOnList{StringList: *list}
+
+		var valueEnd *source.Position
+		if n := len(list.SourceMeta); n > 0 && list.SourceMeta[n-1] != nil {
+			valueEnd = list.SourceMeta[n-1].EndPosition
+		}
+		inc.FieldsSourceMeta[field] = mergeEnd(fieldMeta, valueEnd)
+		return nil
 	default:
 		return p.errf(
 			fieldMeta.Position,
@@ -356,6 +383,7 @@ func (p *parser) parseExportDecl(bp *schema.Blueprint) error {
 	}
 
 	export := &schema.Export{}
+	recordDuplicateDefinition(bp, "exports", name, bp.Exports.SourceMeta, meta)
 	bp.Exports.Values[name] = export
 	bp.Exports.SourceMeta[name] = meta
 
@@ -546,3 +574,34 @@ func isValidTypeSegment(s string) bool {
 	}
 	return s != ""
 }
+
+// Records a definition of an element with a name that has already been used
+// in the same section of the document.
+// Only the last definition of an element is kept in the blueprint,
+// so duplicates are recorded to allow validation to report them.
+func recordDuplicateDefinition(
+	bp *schema.Blueprint,
+	section string,
+	name string,
+	sectionSourceMeta map[string]*source.Meta,
+	location *source.Meta,
+) {
+	first, isDefined := sectionSourceMeta[name]
+	if !isDefined {
+		return
+	}
+
+	for _, duplicate := range bp.DuplicateDefinitions {
+		if duplicate.Section == section && duplicate.Name == name {
+			first = duplicate.First
+			break
+		}
+	}
+
+	bp.DuplicateDefinitions = append(bp.DuplicateDefinitions, &schema.DuplicateDefinition{
+		Section:   section,
+		Name:      name,
+		First:     first,
+		Duplicate: location,
+	})
+}
@@ -1,6 +1,8 @@
 package lang
 
 import (
+	"fmt"
+
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
 )
@@ -238,6 +240,30 @@ func (p *parser) parseResourceFieldAssignment(r *schema.Resource) error {
 				valueEnd = list.SourceMeta[n-1].EndPosition
 			}
 		}
+	case "ignoreChanges":
+		e, exprErr := p.parseExpr()
+		if exprErr != nil {
+			return exprErr
+		}
+
+		var list *schema.StringList
+		list, err = exprToStringLiteralList(e, "ignoreChanges")
+		if err == nil {
+			r.IgnoreChanges = &schema.IgnoreChangesList{StringList: *list}
+			if n := len(list.SourceMeta); n > 0 && list.SourceMeta[n-1] != nil {
+				valueEnd = list.SourceMeta[n-1].EndPosition
+			}
+		}
+	case "timeouts":
+		e, exprErr := p.parseExpr()
+		if exprErr != nil {
+			return exprErr
+		}
+
+		r.Timeouts, err = exprToResourceTimeouts(e)
+		if err == nil && e.meta() != nil {
+			valueEnd = e.meta().EndPosition
+		}
 	case "removalPolicy":
 		var value string
 		var valueMeta *source.Meta
@@ -251,6 +277,19 @@ func (p *parser) parseResourceFieldAssignment(r *schema.Resource) error {
 				valueEnd = valueMeta.EndPosition
 			}
 		}
+	case "replaceStrategy":
+		var value string
+		var valueMeta *source.Meta
+		value, valueMeta, err = p.parseReplaceStrategyValue()
+		if err == nil {
+			r.ReplaceStrategy = &schema.ReplaceStrategyWrapper{
+				Value:      schema.ReplaceStrategy(value),
+				SourceMeta: valueMeta,
+			}
+			if valueMeta != nil {
+				valueEnd = valueMeta.EndPosition
+			}
+		}
 	default:
 		return p.errf(fieldMeta.Position, "unknown field %q in resource declaration", field)
 	}
@@ -303,6 +342,33 @@ func (p *parser) parseRemovalPolicyValue() (string, *source.Meta, error) {
 	)
 }
 
+func (p *parser) parseReplaceStrategyValue() (string, *source.Meta, error) {
+	if p.peek().Type != TokenStringStart {
+		return "", nil, p.errf(
+			p.peek().Start,
+			"replaceStrategy must be a literal string, got %s",
+			p.peek().Type,
+		)
+	}
+
+	value, meta, err := p.collectStringLiteral(false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, valid := range schema.ValidReplaceStrategies {
+		if value == string(valid) {
+			return value, meta, nil
+		}
+	}
+
+	return "", nil, p.errf(
+		meta.Position,
+		"replaceStrategy must be one of \"createBeforeDestroy\" or \"destroyBeforeCreate\", got %q",
+		value,
+	)
+}
+
 // Lowers a single-resource-name or array-of-resource-names
 // expression to a *schema.StringList for use by `dependsOn` and `select.exclude`.
 // Each element must be either a string literal or a bare single-segment
@@ -338,6 +404,79 @@ func exprToResourceNameList(e expr, field string) (*schema.StringList, error) {
 	}, nil
 }
 
+// Lowers a single string literal or array of string literals expression
+// to a *schema.StringList for use by `ignoreChanges`.
+func exprToStringLiteralList(e expr, field string) (*schema.StringList, error) {
+	elems := []expr{e}
+	if arr, ok := e.(*arrayExpr); ok {
+		elems = arr.elems
+	}
+
+	values := make([]string, 0, len(elems))
+	sourceMetas := make([]*source.Meta, 0, len(elems))
+	for _, el := range elems {
+		scalar, ok := el.(*scalarExpr)
+		if !ok || scalar.value.StringValue == nil {
+			return nil, &ParseError{
+				Message:    "entries in " + field + " must be string literals",
+				SourceMeta: el.meta(),
+			}
+		}
+		values = append(values, *scalar.value.StringValue)
+		sourceMetas = append(sourceMetas, scalar.value.SourceMeta)
+	}
+
+	return &schema.StringList{
+		Values:     values,
+		SourceMeta: sourceMetas,
+	}, nil
+}
+
+func exprToResourceTimeouts(e expr) (*schema.ResourceTimeouts, error) {
+	obj, ok := e.(*objectExpr)
+	if !ok {
+		return nil, &ParseError{
+			Message:    "timeouts must be an object literal",
+			SourceMeta: e.meta(),
+		}
+	}
+
+	timeouts := &schema.ResourceTimeouts{
+		SourceMeta: obj.meta(),
+	}
+	for _, entry := range obj.entries {
+		scalar, ok := entry.value.(*scalarExpr)
+		if !ok || scalar.value.StringValue == nil {
+			return nil, &ParseError{
+				Message: fmt.Sprintf(
+					"the %s timeout must be a duration string literal such as \"10m\"",
+					entry.key,
+				),
+				SourceMeta: entry.value.meta(),
+			}
+		}
+
+		switch entry.key {
+		case "create":
+			timeouts.Create = scalar.value
+		case "update":
+			timeouts.Update = scalar.value
+		case "destroy":
+			timeouts.Destroy = scalar.value
+		default:
+			return nil, &ParseError{
+				Message: fmt.Sprintf(
+					"unknown field %q in resource timeouts, expected one of \"create\", \"update\" or \"destroy\"",
+					entry.key,
+				),
+				SourceMeta: entry.meta,
+			}
+		}
+	}
+
+	return timeouts, nil
+}
+
 func extractResourceName(e expr, field string) (string, *source.Meta, error) {
 	if scalar, ok := e.(*scalarExpr); ok && scalar.value.StringValue != nil {
 		return *scalar.value.StringValue, scalar.value.SourceMeta, nil
@@ -135,6 +135,7 @@ func (s *ParseSuite) Test_parses_resource_fixtures() {
 		"resource-removal-policy",
 		"resource-foreach",
 		"resource-spec-complex",
+		"resource-duplicate-names",
 	})
 }
 
@@ -0,0 +1,199 @@
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultOPATimeout is the default timeout for requests made
+	// to an OPA server to evaluate policies.
+	DefaultOPATimeout = 30 * time.Second
+)
+
+// OPAEngine is a policy engine that evaluates Rego policies
+// with an Open Policy Agent (OPA) server through the OPA data API.
+//
+// The evaluation input is provided as the "input" document and the
+// policy package at the configured path is expected to define "deny"
+// and "warn" rules that produce sets of violations, following the same conventions
+// as tools like conftest:
+//
+//	package bluelink.deploy
+//
+//	deny contains msg if {
+//		some name, resource in input.changes.newResources
+//		resource.appliedResourceInfo.resourceWithResolvedSubs.spec.publicAccess == true
+//		msg := sprintf("storage bucket %q must not be public", [name])
+//	}
+//
+// Violations can be strings or objects with "msg", "policy" and "resource" fields.
+type OPAEngine struct {
+	endpoint   string
+	policyPath string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+// OPAEngineOption is a function that can be used to configure
+// an OPA policy engine.
+type OPAEngineOption func(*OPAEngine)
+
+// WithOPAHeaders sets additional headers to include in requests
+// made to the OPA server (e.g. an authorization header).
+func WithOPAHeaders(headers map[string]string) OPAEngineOption {
+	return func(e *OPAEngine) {
+		for key, value := range headers {
+			e.headers[key] = value
+		}
+	}
+}
+
+// WithOPAHTTPClient sets the HTTP client used to make requests
+// to the OPA server.
+// When not set, a client with a timeout of DefaultOPATimeout is used.
+func WithOPAHTTPClient(httpClient *http.Client) OPAEngineOption {
+	return func(e *OPAEngine) {
+		e.httpClient = httpClient
+	}
+}
+
+// NewOPAEngine creates a new policy engine that evaluates the policy package
+// at the given path (e.g. "bluelink/deploy" or "bluelink.deploy")
+// with the OPA server at the provided endpoint (e.g. "http://localhost:8181").
+func NewOPAEngine(endpoint string, policyPath string, opts ...OPAEngineOption) *OPAEngine {
+	engine := &OPAEngine{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		policyPath: strings.Trim(strings.ReplaceAll(policyPath, ".", "/"), "/"),
+		headers:    map[string]string{},
+		httpClient: &http.Client{
+			Timeout: DefaultOPATimeout,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(engine)
+	}
+
+	return engine
+}
+
+type opaDataRequest struct {
+	Input *EvaluationInput `json:"input"`
+}
+
+type opaDataResponse struct {
+	Result *opaPolicyResult `json:"result"`
+}
+
+type opaPolicyResult struct {
+	Deny []json.RawMessage `json:"deny"`
+	Warn []json.RawMessage `json:"warn"`
+}
+
+type opaViolation struct {
+	Msg      string `json:"msg"`
+	Policy   string `json:"policy"`
+	Resource string `json:"resource"`
+}
+
+func (e *OPAEngine) Evaluate(
+	ctx context.Context,
+	input *EvaluationInput,
+) (*EvaluationResult, error) {
+	body, err := json.Marshal(&opaDataRequest{Input: input})
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/v1/data/%s", e.endpoint, e.policyPath)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range e.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf(
+			"OPA server responded with unexpected status code %d",
+			resp.StatusCode,
+		)
+	}
+
+	dataResp := &opaDataResponse{}
+	err = json.NewDecoder(resp.Body).Decode(dataResp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode OPA server response: %w", err)
+	}
+
+	if dataResp.Result == nil {
+		return nil, fmt.Errorf(
+			"policy %q is not defined in the OPA server",
+			e.policyPath,
+		)
+	}
+
+	return toEvaluationResult(dataResp.Result)
+}
+
+func toEvaluationResult(result *opaPolicyResult) (*EvaluationResult, error) {
+	violations := make([]*Violation, 0, len(result.Deny)+len(result.Warn))
+	for _, rawViolation := range result.Deny {
+		violation, err := toViolation(rawViolation, ViolationLevelDeny)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, violation)
+	}
+
+	for _, rawViolation := range result.Warn {
+		violation, err := toViolation(rawViolation, ViolationLevelWarn)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, violation)
+	}
+
+	return &EvaluationResult{Violations: violations}, nil
+}
+
+func toViolation(rawViolation json.RawMessage, level ViolationLevel) (*Violation, error) {
+	var msg string
+	if err := json.Unmarshal(rawViolation, &msg); err == nil {
+		return &Violation{
+			Level:   level,
+			Message: msg,
+		}, nil
+	}
+
+	violation := &opaViolation{}
+	if err := json.Unmarshal(rawViolation, violation); err != nil {
+		return nil, fmt.Errorf(
+			"expected %s policy violations to be strings or objects "+
+				"with a \"msg\" field, got %s",
+			level,
+			string(rawViolation),
+		)
+	}
+
+	return &Violation{
+		Level:        level,
+		Message:      violation.Msg,
+		Policy:       violation.Policy,
+		ResourceName: violation.Resource,
+	}, nil
+}
@@ -0,0 +1,135 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/stretchr/testify/suite"
+)
+
+type OPAEngineTestSuite struct {
+	suite.Suite
+}
+
+func (s *OPAEngineTestSuite) Test_evaluates_policies_with_opa_server() {
+	var receivedPath string
+	var receivedHeaders http.Header
+	var receivedInput map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		receivedHeaders = r.Header.Clone()
+		body, err := io.ReadAll(r.Body)
+		s.Require().NoError(err)
+		s.Require().NoError(json.Unmarshal(body, &receivedInput))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"result": {
+				"deny": [
+					"storage buckets must not be public",
+					{"msg": "tables must be encrypted", "policy": "encryption", "resource": "ordersTable"}
+				],
+				"warn": ["functions should have a memory limit"]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	engine := NewOPAEngine(
+		server.URL,
+		"bluelink.deploy",
+		WithOPAHeaders(map[string]string{
+			"Authorization": "Bearer test-token",
+		}),
+	)
+	result, err := engine.Evaluate(context.Background(), &EvaluationInput{
+		InstanceID:   "instance-1",
+		InstanceName: "orders",
+		Changes: &changes.BlueprintChanges{
+			NewResources: map[string]provider.Changes{
+				"ordersTable": {},
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	s.Equal("/v1/data/bluelink/deploy", receivedPath)
+	s.Equal("Bearer test-token", receivedHeaders.Get("Authorization"))
+	s.Require().Contains(receivedInput, "input")
+	input := receivedInput["input"].(map[string]any)
+	s.Equal("instance-1", input["instanceId"])
+	s.Contains(input["changes"].(map[string]any)["newResources"], "ordersTable")
+
+	s.Equal(
+		[]*Violation{
+			{
+				Level:   ViolationLevelDeny,
+				Message: "storage buckets must not be public",
+			},
+			{
+				Level:        ViolationLevelDeny,
+				Message:      "tables must be encrypted",
+				Policy:       "encryption",
+				ResourceName: "ordersTable",
+			},
+		},
+		result.Denials(),
+	)
+	s.Equal(
+		[]*Violation{
+			{
+				Level:   ViolationLevelWarn,
+				Message: "functions should have a memory limit",
+			},
+		},
+		result.Warnings(),
+	)
+}
+
+func (s *OPAEngineTestSuite) Test_fails_when_policy_is_not_defined() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	engine := NewOPAEngine(server.URL, "bluelink/deploy")
+	_, err := engine.Evaluate(context.Background(), &EvaluationInput{})
+	s.Require().Error(err)
+	s.Equal("policy \"bluelink/deploy\" is not defined in the OPA server", err.Error())
+}
+
+func (s *OPAEngineTestSuite) Test_fails_for_unexpected_status_code() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	engine := NewOPAEngine(server.URL, "bluelink/deploy")
+	_, err := engine.Evaluate(context.Background(), &EvaluationInput{})
+	s.Require().Error(err)
+	s.Equal("OPA server responded with unexpected status code 500", err.Error())
+}
+
+func (s *OPAEngineTestSuite) Test_fails_for_invalid_violations() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result": {"deny": [10]}}`))
+	}))
+	defer server.Close()
+
+	engine := NewOPAEngine(server.URL, "bluelink/deploy")
+	_, err := engine.Evaluate(context.Background(), &EvaluationInput{})
+	s.Require().Error(err)
+	s.Equal(
+		"expected deny policy violations to be strings or objects with a \"msg\" field, got 10",
+		err.Error(),
+	)
+}
+
+func TestOPAEngineTestSuite(t *testing.T) {
+	suite.Run(t, new(OPAEngineTestSuite))
+}
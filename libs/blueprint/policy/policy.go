@@ -0,0 +1,111 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// Engine is an interface for a policy engine that evaluates organisational
+// policies against the changes that are about to be deployed for a blueprint instance.
+// Policy engines can reject a deployment or produce warnings
+// for changes that do not follow organisational guardrails,
+// (e.g. "no public storage buckets") without the need to modify providers.
+type Engine interface {
+	// Evaluate evaluates policies against the staged changes and blueprint
+	// for a deployment, returning the violations of the policies.
+	// An error should only be returned when the policies could not be evaluated,
+	// violations of policies should be reported in the result.
+	Evaluate(ctx context.Context, input *EvaluationInput) (*EvaluationResult, error)
+}
+
+// EvaluationInput provides the input for evaluating policies
+// for a deployment.
+type EvaluationInput struct {
+	// InstanceID is the ID of the blueprint instance that is being deployed.
+	InstanceID string `json:"instanceId"`
+	// InstanceName is the user-defined name of the blueprint instance
+	// that is being deployed.
+	InstanceName string `json:"instanceName"`
+	// Blueprint is the blueprint that is being deployed.
+	Blueprint *schema.Blueprint `json:"blueprint"`
+	// Changes holds the staged changes that will be applied
+	// when deploying the blueprint instance, this includes
+	// resources with resolved substitutions.
+	Changes *changes.BlueprintChanges `json:"changes"`
+}
+
+// ViolationLevel determines the effect of a policy violation
+// on a deployment.
+type ViolationLevel string
+
+const (
+	// ViolationLevelDeny should be used for violations that must
+	// prevent a deployment from proceeding.
+	ViolationLevelDeny ViolationLevel = "deny"
+	// ViolationLevelWarn should be used for violations that should
+	// be reported without preventing a deployment from proceeding.
+	ViolationLevelWarn ViolationLevel = "warn"
+)
+
+// Violation holds information about a policy that has been violated
+// by the changes for a deployment.
+type Violation struct {
+	// Level determines whether the violation prevents the deployment
+	// from proceeding.
+	Level ViolationLevel `json:"level"`
+	// Message describes the violation of the policy.
+	Message string `json:"message"`
+	// Policy is an optional identifier for the policy that has been violated.
+	Policy string `json:"policy,omitempty"`
+	// ResourceName is the optional name of the resource that violates the policy.
+	ResourceName string `json:"resourceName,omitempty"`
+}
+
+func (v *Violation) String() string {
+	var sb strings.Builder
+	if v.Policy != "" {
+		sb.WriteString(fmt.Sprintf("[%s] ", v.Policy))
+	}
+	if v.ResourceName != "" {
+		sb.WriteString(fmt.Sprintf("resource %q: ", v.ResourceName))
+	}
+	sb.WriteString(v.Message)
+	return sb.String()
+}
+
+// EvaluationResult holds the result of evaluating policies
+// for a deployment.
+type EvaluationResult struct {
+	Violations []*Violation `json:"violations"`
+}
+
+// Denials returns the violations that must prevent
+// the deployment from proceeding.
+func (r *EvaluationResult) Denials() []*Violation {
+	return r.violationsWithLevel(ViolationLevelDeny)
+}
+
+// Warnings returns the violations that should be reported without
+// preventing the deployment from proceeding.
+func (r *EvaluationResult) Warnings() []*Violation {
+	return r.violationsWithLevel(ViolationLevelWarn)
+}
+
+func (r *EvaluationResult) violationsWithLevel(level ViolationLevel) []*Violation {
+	violations := []*Violation{}
+	if r == nil {
+		return violations
+	}
+
+	for _, violation := range r.Violations {
+		if violation.Level == level {
+			violations = append(violations, violation)
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,15 @@
+package provider
+
+// AllowedValuesDataSource describes a data source lookup that produces
+// a list of allowed values for a variable or a resource spec field.
+type AllowedValuesDataSource struct {
+	// DataSourceType is the type of data source to fetch,
+	// for example, "aws/ec2/instanceTypes".
+	DataSourceType string
+	// Field is the field in the data source output that holds the allowed values.
+	// The field can hold a single scalar value or an array of scalar values.
+	Field string
+	// Filter holds optional filters that are passed to the data source
+	// when fetching the allowed values.
+	Filter *ResolvedDataSourceFilters
+}
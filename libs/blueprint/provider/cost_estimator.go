@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+// CostEstimator is an optional interface that resource type implementations
+// can implement to estimate the monthly cost of a resource in the upstream provider.
+// When a resource type implements this interface, the blueprint container
+// aggregates cost estimates for resources that will be created, updated
+// and destroyed into the changes produced when staging changes for a blueprint instance.
+//
+// Resource types that do not implement this interface are left out of
+// cost estimates.
+type CostEstimator interface {
+	// EstimateMonthlyCost estimates the monthly cost of a resource
+	// with the provided spec in the upstream provider.
+	EstimateMonthlyCost(
+		ctx context.Context,
+		input *ResourceCostEstimateInput,
+	) (*ResourceCostEstimateOutput, error)
+}
+
+// ResourceCostEstimateInput provides the input data needed to estimate
+// the monthly cost of a resource.
+type ResourceCostEstimateInput struct {
+	// ResourceType is the type of resource to estimate the cost of.
+	ResourceType string
+	// ResourceName is the logical name of the resource in the blueprint.
+	ResourceName string
+	// SpecData holds the resolved spec of the resource to estimate the cost for,
+	// this is the new spec for resources that will be created or updated
+	// and the current spec for resources that will be destroyed.
+	SpecData *core.MappingNode
+	// ProviderContext provides access to provider configuration and context variables.
+	ProviderContext Context
+}
+
+// ResourceCostEstimateOutput provides the output data from estimating
+// the monthly cost of a resource.
+type ResourceCostEstimateOutput struct {
+	// MonthlyCost is the estimated monthly cost of the resource in US dollars.
+	MonthlyCost float64
+}
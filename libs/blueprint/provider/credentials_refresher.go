@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"context"
+	"time"
+)
+
+// CredentialsRefresher is an optional interface that provider implementations
+// can implement when they make use of credentials with a limited lifetime,
+// such as temporary credentials obtained by assuming a role or
+// short-lived access tokens.
+//
+// When a provider implements this interface, the blueprint container will check
+// the remaining lifetime of the credentials before starting a deployment
+// and proactively refresh credentials that will expire before the deployment is
+// expected to complete.
+// During a deployment, credentials are also refreshed when they are close to expiry
+// before resources are deployed so long-running deployments do not fail part way
+// through due to expired credentials.
+type CredentialsRefresher interface {
+	// CredentialsExpiry retrieves the time at which the credentials currently
+	// used by the provider expire.
+	// This should return nil when the credentials do not expire.
+	CredentialsExpiry(ctx context.Context, providerCtx Context) (*time.Time, error)
+	// RefreshCredentials obtains a new set of credentials for the provider
+	// to use for subsequent operations, returning the time at which the
+	// new credentials expire.
+	// This should return a nil expiry time when the new credentials do not expire.
+	RefreshCredentials(ctx context.Context, providerCtx Context) (*time.Time, error)
+}
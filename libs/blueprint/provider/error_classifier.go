@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+)
+
+// ResourceErrorClass is the class of an error returned by a provider
+// resource implementation that determines how the error is handled
+// by the host tool.
+type ResourceErrorClass string
+
+const (
+	// ResourceErrorClassUnknown is used for errors that the provider
+	// does not classify, these are handled based on the provider error
+	// type that the resource implementation returned.
+	ResourceErrorClassUnknown ResourceErrorClass = ""
+	// ResourceErrorClassNotFound is used for errors that indicate that
+	// the resource no longer exists in the upstream provider.
+	// When destroying a resource, errors of this class are treated as
+	// a successful removal of the resource.
+	ResourceErrorClassNotFound ResourceErrorClass = "notFound"
+)
+
+// ResourceErrorClassifier is an optional interface that providers
+// can implement to classify errors returned by resource implementations.
+// This allows providers to recognise errors from upstream APIs
+// (e.g. a 404 response or a "ResourceNotFoundException") that resource
+// implementations return without wrapping them in a `ResourceNotFoundError`.
+//
+// Errors that are wrapped in a `ResourceNotFoundError` are always treated
+// as not found errors, the classifier is only used for other errors.
+type ResourceErrorClassifier interface {
+	// ClassifyResourceError determines the class of an error
+	// returned by a resource implementation of the provider.
+	ClassifyResourceError(
+		ctx context.Context,
+		input *ResourceErrorClassifyInput,
+	) (ResourceErrorClass, error)
+}
+
+// ResourceErrorClassifyInput provides the input data needed
+// to classify an error returned by a resource implementation.
+type ResourceErrorClassifyInput struct {
+	// ResourceType is the type of the resource that the error
+	// was returned for.
+	ResourceType string
+	// Error is the error returned by the resource implementation.
+	Error error
+}
@@ -345,7 +345,11 @@ func IsRetryableError(err error) bool {
 // should be used for transient errors that can be retried.
 type ResourceDeployError struct {
 	FailureReasons []string
-	ChildError     error
+	// FieldFailures holds failures that are caused by specific fields
+	// in the resource spec, these are used to point users to the
+	// location of the field in the source blueprint.
+	FieldFailures []*SpecFieldFailure
+	ChildError    error
 }
 
 func (e *ResourceDeployError) Error() string {
@@ -360,6 +364,10 @@ func (e *ResourceDeployError) GetFailureReasons() []string {
 	return e.FailureReasons
 }
 
+func (e *ResourceDeployError) GetFieldFailures() []*SpecFieldFailure {
+	return e.FieldFailures
+}
+
 // AsResourceDeployError returns true if the error is a resource deploy error
 // and assigns the error to the target.
 func AsResourceDeployError(err error, target **ResourceDeployError) bool {
@@ -395,6 +403,36 @@ func AsResourceDestroyError(err error, target **ResourceDestroyError) bool {
 	return nativeerrors.As(err, target)
 }
 
+// ResourceNotFoundError is an error that indicates that a resource
+// no longer exists in the upstream provider.
+// This is a part of the API for provider resources that should be returned
+// when an attempt to remove a resource finds that it has already been deleted
+// outside of Bluelink, the resource will be treated as destroyed instead of
+// failing the operation.
+type ResourceNotFoundError struct {
+	ChildError error
+}
+
+func (e *ResourceNotFoundError) Error() string {
+	if e.ChildError == nil {
+		return "resource not found"
+	}
+
+	return fmt.Sprintf("resource not found: %s", e.ChildError.Error())
+}
+
+// AsResourceNotFoundError returns true if the error is a resource not found error
+// and assigns the error to the target.
+func AsResourceNotFoundError(err error, target **ResourceNotFoundError) bool {
+	return nativeerrors.As(err, target)
+}
+
+// IsResourceNotFoundError returns true if the error is a resource not found error.
+func IsResourceNotFoundError(err error) bool {
+	var notFoundErr *ResourceNotFoundError
+	return nativeerrors.As(err, &notFoundErr)
+}
+
 // LinkUpdateResourceAError is an error that indicates a failure to update
 // resource A in a link relationship.
 type LinkUpdateResourceAError struct {
@@ -484,6 +522,9 @@ type BadInputError struct {
 	// The underlying error for that describes the bad input.
 	ChildError     error
 	FailureReasons []string
+	// FieldFailures holds failures that are caused by specific fields
+	// in the resource spec.
+	FieldFailures []*SpecFieldFailure
 }
 
 func (e *BadInputError) Error() string {
@@ -498,6 +539,10 @@ func (e *BadInputError) GetFailureReasons() []string {
 	return e.FailureReasons
 }
 
+func (e *BadInputError) GetFieldFailures() []*SpecFieldFailure {
+	return e.FieldFailures
+}
+
 // AsBadInputError returns true if the error is a bad input error
 // and assigns the error to the target.
 func AsBadInputError(err error, target **BadInputError) bool {
@@ -509,3 +554,20 @@ func AsBadInputError(err error, target **BadInputError) bool {
 type ErrorFailureReasons interface {
 	GetFailureReasons() []string
 }
+
+// SpecFieldFailure describes a failure that is caused by
+// a specific field in a resource spec.
+type SpecFieldFailure struct {
+	// SpecPath is the path to the field in the resource spec
+	// that caused the failure (e.g. "$.environment.variables").
+	// See core.GetPathValue for the path syntax.
+	SpecPath string `json:"specPath"`
+	// Reason describes why the field caused the failure.
+	Reason string `json:"reason"`
+}
+
+// ErrorFieldFailures is an interface that should be implemented by errors
+// that provide failures for specific fields in a resource spec.
+type ErrorFieldFailures interface {
+	GetFieldFailures() []*SpecFieldFailure
+}
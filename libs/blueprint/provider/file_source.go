@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 )
 
@@ -116,3 +117,102 @@ func (s *LocalFileSource) ReadFile(ctx context.Context, path string) ([]byte, er
 
 	return data, nil
 }
+
+// NewRootRestrictedFileSourceRegistry creates a file source registry that wraps
+// the given registry and only allows local files that are located in one of the
+// provided root directories to be read.
+// Relative root directories are resolved against the current working directory
+// and symbolic links are followed before checking whether a file is
+// located in an allowed root.
+// Files from sources for other URI schemes (e.g. s3://) are not restricted.
+func NewRootRestrictedFileSourceRegistry(
+	registry FileSourceRegistry,
+	allowedRoots []string,
+) FileSourceRegistry {
+	return &rootRestrictedFileSourceRegistry{
+		registry:     registry,
+		allowedRoots: allowedRoots,
+	}
+}
+
+type rootRestrictedFileSourceRegistry struct {
+	registry     FileSourceRegistry
+	allowedRoots []string
+}
+
+func (r *rootRestrictedFileSourceRegistry) Register(source FileSource) error {
+	return r.registry.Register(source)
+}
+
+func (r *rootRestrictedFileSourceRegistry) Get(path string) (FileSource, error) {
+	return r.registry.Get(path)
+}
+
+func (r *rootRestrictedFileSourceRegistry) ReadFile(ctx context.Context, path string) ([]byte, error) {
+	scheme := extractScheme(path)
+	if scheme == "" || scheme == "file" {
+		allowed, err := isInAllowedRoot(strings.TrimPrefix(path, "file://"), r.allowedRoots)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			return nil, fmt.Errorf(
+				"access denied, the file is not located in one of the allowed root directories (%s)",
+				strings.Join(r.allowedRoots, ", "),
+			)
+		}
+	}
+
+	return r.registry.ReadFile(ctx, path)
+}
+
+func isInAllowedRoot(path string, allowedRoots []string) (bool, error) {
+	resolvedPath, err := resolveRealPath(path)
+	if err != nil {
+		return false, err
+	}
+
+	for _, root := range allowedRoots {
+		resolvedRoot, err := resolveRealPath(root)
+		if err != nil {
+			return false, err
+		}
+
+		relPath, err := filepath.Rel(resolvedRoot, resolvedPath)
+		if err == nil && relPath != ".." &&
+			!strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Resolves the absolute path with symbolic links evaluated so that
+// links can not be used to escape allowed root directories.
+// For paths that do not exist, symbolic links are evaluated for the closest
+// existing parent directory.
+func resolveRealPath(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	realPath, err := filepath.EvalSymlinks(absPath)
+	if err == nil {
+		return realPath, nil
+	}
+
+	parent := filepath.Dir(absPath)
+	if parent == absPath {
+		return absPath, nil
+	}
+
+	realParent, err := resolveRealPath(parent)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(realParent, filepath.Base(absPath)), nil
+}
@@ -145,6 +145,76 @@ func (s *FileSourceTestSuite) Test_extract_scheme_from_various_paths() {
 	}
 }
 
+func (s *FileSourceTestSuite) Test_root_restricted_registry_reads_file_in_allowed_root() {
+	allowedDir := filepath.Join(s.tempDir, "allowed")
+	s.Require().NoError(os.Mkdir(allowedDir, 0755))
+	testFile := filepath.Join(allowedDir, "policy.json")
+	s.Require().NoError(os.WriteFile(testFile, []byte("{}"), 0644))
+
+	registry := NewRootRestrictedFileSourceRegistry(
+		NewFileSourceRegistry(),
+		[]string{allowedDir},
+	)
+
+	data, err := registry.ReadFile(context.Background(), testFile)
+	s.Assert().NoError(err)
+	s.Assert().Equal([]byte("{}"), data)
+}
+
+func (s *FileSourceTestSuite) Test_root_restricted_registry_denies_file_outside_allowed_roots() {
+	allowedDir := filepath.Join(s.tempDir, "allowed")
+	s.Require().NoError(os.Mkdir(allowedDir, 0755))
+	testFile := filepath.Join(s.tempDir, "secret.txt")
+	s.Require().NoError(os.WriteFile(testFile, []byte("secret"), 0644))
+
+	registry := NewRootRestrictedFileSourceRegistry(
+		NewFileSourceRegistry(),
+		[]string{allowedDir},
+	)
+
+	_, err := registry.ReadFile(
+		context.Background(),
+		filepath.Join(allowedDir, "..", "secret.txt"),
+	)
+	s.Assert().Error(err)
+	s.Assert().Contains(err.Error(), "not located in one of the allowed root directories")
+}
+
+func (s *FileSourceTestSuite) Test_root_restricted_registry_denies_symlink_escaping_allowed_root() {
+	allowedDir := filepath.Join(s.tempDir, "allowed")
+	s.Require().NoError(os.Mkdir(allowedDir, 0755))
+	testFile := filepath.Join(s.tempDir, "secret.txt")
+	s.Require().NoError(os.WriteFile(testFile, []byte("secret"), 0644))
+	linkPath := filepath.Join(allowedDir, "link.txt")
+	s.Require().NoError(os.Symlink(testFile, linkPath))
+
+	registry := NewRootRestrictedFileSourceRegistry(
+		NewFileSourceRegistry(),
+		[]string{allowedDir},
+	)
+
+	_, err := registry.ReadFile(context.Background(), linkPath)
+	s.Assert().Error(err)
+	s.Assert().Contains(err.Error(), "not located in one of the allowed root directories")
+}
+
+func (s *FileSourceTestSuite) Test_root_restricted_registry_does_not_restrict_remote_sources() {
+	registry := NewRootRestrictedFileSourceRegistry(
+		NewFileSourceRegistry(),
+		[]string{s.tempDir},
+	)
+	err := registry.Register(&MockFileSource{
+		files: map[string][]byte{
+			"mock://bucket/file.txt": []byte("mock content"),
+		},
+	})
+	s.Require().NoError(err)
+
+	data, err := registry.ReadFile(context.Background(), "mock://bucket/file.txt")
+	s.Assert().NoError(err)
+	s.Assert().Equal([]byte("mock content"), data)
+}
+
 func TestFileSourceTestSuite(t *testing.T) {
 	suite.Run(t, new(FileSourceTestSuite))
 }
@@ -133,6 +133,10 @@ type LinkStageChangesInput struct {
 	ResourceBChanges *Changes
 	CurrentLinkState *state.LinkState
 	LinkContext      LinkContext
+	// LinkConfig holds the configuration for the link defined in the
+	// `links` section of the blueprint, this is only set for link
+	// implementations that implement LinkConfigDefinitionProvider.
+	LinkConfig *core.MappingNode
 }
 
 // LinkStageChangesOutput provides the output from staging changes
@@ -154,6 +158,10 @@ type LinkUpdateResourceInput struct {
 	LinkUpdateType   LinkUpdateType
 	CurrentLinkState *state.LinkState
 	LinkContext      LinkContext
+	// LinkConfig holds the configuration for the link defined in the
+	// `links` section of the blueprint, this is only set for link
+	// implementations that implement LinkConfigDefinitionProvider.
+	LinkConfig *core.MappingNode
 }
 
 // LinkUpdateType represents the type of update that is being carried out
@@ -202,6 +210,10 @@ type LinkUpdateIntermediaryResourcesInput struct {
 	LinkUpdateType   LinkUpdateType
 	CurrentLinkState *state.LinkState
 	LinkContext      LinkContext
+	// LinkConfig holds the configuration for the link defined in the
+	// `links` section of the blueprint, this is only set for link
+	// implementations that implement LinkConfigDefinitionProvider.
+	LinkConfig *core.MappingNode
 	// ResourceService allows a link implementation to hook into
 	// the framework's existing mechanism to manage resource deployments,
 	// look up resources and acquire locks when updating existing resources
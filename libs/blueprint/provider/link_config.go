@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+)
+
+// LinkConfigDefinitionProvider is an optional interface that link implementations
+// can implement to allow users to tune a specific link between two resources
+// from the `links` section of a blueprint.
+// For example, a link between a function and a table may allow users to narrow
+// the scope of permissions granted to the function or choose the naming convention
+// for environment variables that are populated with details about the table.
+//
+// Configuration for a link is validated against the schema provided by the
+// implementation in the same way resource specs are validated and is passed into
+// the link implementation when staging changes and deploying the link.
+// Links that do not implement this interface can not be configured
+// in the `links` section of a blueprint.
+type LinkConfigDefinitionProvider interface {
+	// GetConfigDefinition retrieves the schema that blueprint-level
+	// configuration for the link must conform to.
+	GetConfigDefinition(
+		ctx context.Context,
+		input *LinkGetConfigDefinitionInput,
+	) (*LinkGetConfigDefinitionOutput, error)
+}
+
+// LinkGetConfigDefinitionInput provides the input for retrieving the schema
+// for blueprint-level configuration of a link.
+type LinkGetConfigDefinitionInput struct {
+	LinkContext LinkContext
+}
+
+// LinkGetConfigDefinitionOutput provides the output for retrieving the schema
+// for blueprint-level configuration of a link.
+type LinkGetConfigDefinitionOutput struct {
+	// Schema is the schema for the link configuration, this must be an object
+	// schema where each attribute is a field that can be set in the configuration
+	// for a link in the `links` section of a blueprint.
+	Schema *ResourceDefinitionsSchema
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
@@ -265,6 +266,12 @@ type ResourceDeployInput struct {
 	ResourceID      string
 	Changes         *Changes
 	ProviderContext Context
+	// DryRun indicates that the deployment should only be validated
+	// against the upstream provider (e.g. via a validate-only API)
+	// without making any changes.
+	// This will only be set for resources that report support for dry runs
+	// in their spec definition.
+	DryRun bool
 }
 
 // ResourceGetTypeInput provides the input data needed for a resource to
@@ -420,6 +427,32 @@ type Changes struct {
 	// until deployment, whether the resource will be deployed or not
 	// cannot be known during the change staging phase.
 	ConditionKnownOnDeploy bool `json:"conditionKnownOnDeploy"`
+	// VolatileFields holds a list of field paths with changes that are caused
+	// by volatile functions (e.g. "uuid" or "datetime") that produce
+	// a new value every time they are evaluated.
+	// Changes to these fields will be reported every time changes are staged
+	// unless the "bluelink.keepers" annotation is set for the resource.
+	VolatileFields []string `json:"volatileFields,omitempty"`
+	// IgnoredFields holds a list of field paths with changes that have been
+	// ignored as they match one of the paths in the "ignoreChanges" list
+	// for the resource.
+	// The current values of these fields will be kept when the resource is deployed.
+	IgnoredFields []string `json:"ignoredFields,omitempty"`
+	// ReplaceStrategy holds the strategy set for the resource in the blueprint
+	// with the "replaceStrategy" field that determines whether a replacement
+	// should be created before or after the existing resource is destroyed
+	// when the resource must be recreated.
+	// When empty, the default for the resource type should be used,
+	// as per the DestroyBeforeCreate field of the resource spec definition.
+	ReplaceStrategy schema.ReplaceStrategy `json:"replaceStrategy,omitempty"`
+	// RecreateTriggers holds the paths of the fields with changes that
+	// force the resource to be recreated (e.g. "spec.tableName").
+	// When the resource type has changed, this will contain "type".
+	// When the resource has been tainted, this will contain "tainted",
+	// see RecreateTriggerTainted.
+	// This is empty when the resource does not need to be recreated or when
+	// it must be recreated due to the removal of a resource it depended on.
+	RecreateTriggers []string `json:"recreateTriggers,omitempty"`
 	// NewOutboundLinks holds a mapping of the linked to resource name
 	// to the link changes representing the new links that will be created.
 	NewOutboundLinks map[string]LinkChanges `json:"newOutboundLinks"`
@@ -449,6 +482,26 @@ func ChangesHasFieldChanges(changes *Changes) bool {
 		len(changes.RemovedFields) > 0
 }
 
+// RecreateTriggerTainted is the recreate trigger used for a resource
+// that must be replaced because it has been tainted in the state
+// of a blueprint instance.
+const RecreateTriggerTainted = "tainted"
+
+// RecreateTriggerFields returns the paths of the fields in the provided
+// field changes that force a resource to be recreated,
+// nil is returned when none of the field changes force the resource
+// to be recreated.
+func RecreateTriggerFields(fieldChanges []FieldChange) []string {
+	var triggers []string
+	for _, fieldChange := range fieldChanges {
+		if fieldChange.MustRecreate {
+			triggers = append(triggers, fieldChange.FieldPath)
+		}
+	}
+
+	return triggers
+}
+
 // HasAnyChanges returns true if the provided Changes has any changes at all,
 // including field-level changes (modified, new, or removed fields) or link changes
 // (new, modified, or removed outbound links).
@@ -489,9 +542,13 @@ type ResourceSpecDefinition struct {
 	// as some providers may not allow the use of the same name for a resource until a certain
 	// period of time has passed since the resource was destroyed.
 	//
-	// To keep things simple for the practitioner, this is not something that can be set
-	// in a blueprint spec, it is up to the developers of resource providers to set this
-	// based on the nature of the resource and how critical it will be deemed for most use cases.
+	// This is the default for the resource type that is set by the developers of
+	// resource providers based on the nature of the resource and how critical it will be
+	// deemed for most use cases, practitioners can override this for a resource
+	// in a blueprint with the "replaceStrategy" field.
+	// When this is true, the "createBeforeDestroy" replace strategy can not be used
+	// as the resource has a unique name that can not be shared by the existing
+	// resource and its replacement.
 	//
 	// As far as the core blueprint framework is concerned, this is for documentation purposes
 	// and is a hint for tools and SDKs built on top of the framework (such as the plugin framework SDK)
@@ -501,6 +558,38 @@ type ResourceSpecDefinition struct {
 	// This is used by the deploy engine to determine how to apply Bluelink tags
 	// to resources.
 	TaggingSupport TaggingSupport
+	// SupportsDryRun specifies whether the resource supports dry run deployments
+	// where changes are validated against the upstream provider without being applied.
+	// This allows for catching errors that can not be determined by comparing
+	// the blueprint with the current state such as insufficient permissions
+	// or quota limits.
+	SupportsDryRun bool
+	// Timeouts holds the default maximum amount of time to wait for
+	// the resource to be created, updated or destroyed.
+	// These can be overridden for a resource in a blueprint with the
+	// "timeouts" field.
+	// When not set, there is no timeout for the provider operation
+	// beyond the context of the deployment.
+	Timeouts *ResourceTimeouts
+	// Deprecated specifies whether the resource type is deprecated
+	// and should no longer be used in new blueprints.
+	// Blueprints that use a deprecated resource type are still valid,
+	// a warning is reported during validation and deprecated resource types
+	// are included in the deprecation summary of staged changes.
+	Deprecated bool
+	// DeprecationMessage provides guidance for users of a deprecated resource type,
+	// this should include the resource type that replaces the deprecated type
+	// where there is one (e.g. "Use aws/lambda/function instead.").
+	DeprecationMessage string
+}
+
+// ResourceTimeouts holds the maximum amount of time to wait for
+// a provider to create, update or destroy a resource.
+// A zero value for an operation means there is no timeout for the operation.
+type ResourceTimeouts struct {
+	Create  time.Duration
+	Update  time.Duration
+	Destroy time.Duration
 }
 
 // ResourceDefinitionsSchema provides a schema that can be used to validate
@@ -529,6 +618,21 @@ type ResourceDefinitionsSchema struct {
 	OneOf []*ResourceDefinitionsSchema
 	// Required holds a list of required attributes for a resource definition schema object.
 	Required []string
+	// RequiredIf holds a condition based on the value of another attribute
+	// in the same object that makes the field required when the condition is met.
+	// This is only used for schemas of attributes in a resource definition schema object.
+	RequiredIf *ResourceDefinitionsCondition
+	// ConflictsWith holds a list of other attributes in the same object
+	// that can not be set when the field is set.
+	// This is only used for schemas of attributes in a resource definition schema object.
+	ConflictsWith []string
+	// ExactlyOneOf holds a list of attributes in the same object,
+	// which should include the field itself, where exactly one of the attributes
+	// must be set.
+	// Attributes in the list can use the same list or leave it out as
+	// the constraint is only checked once for a set of attributes.
+	// This is only used for schemas of attributes in a resource definition schema object.
+	ExactlyOneOf []string
 	// Nullable specifies whether the resource definition schema can be null.
 	Nullable bool
 	// AllowedValues holds a list of allowed values for a resource definition schema.
@@ -542,6 +646,14 @@ type ResourceDefinitionsSchema struct {
 	// contains substitutions as there is no way to know the final value during the validation phase.
 	// Allowed values take precedence over other value constrants such as minimum, maximum and pattern.
 	AllowedValues []*core.MappingNode
+	// AllowedValuesFrom sources the allowed values for a resource definition schema
+	// from the results of a data source lookup, for example, the instance types
+	// that are available in a region.
+	// The lookup is carried out through the data source registry during validation,
+	// results are cached for the lifetime of the cache used by the blueprint loader.
+	// This is ignored when AllowedValues is set and can be used with the "string",
+	// "integer" and "float" types.
+	AllowedValuesFrom *AllowedValuesDataSource
 	// Minimum holds the minimum value that can be used for an element in a resource spec that uses
 	// this schema.
 	// This is only used for "integer" and "float" types.
@@ -682,6 +794,44 @@ type ResourceDefinitionsSchema struct {
 	// Example: For tags like [{key: "env", value: "prod"}, {key: "name", value: "foo"}],
 	// setting SortArrayByField to "key" ensures consistent comparison regardless of order.
 	SortArrayByField string
+	// UniqueGroup marks the value of the field as one that must be unique
+	// across all resources in a blueprint that have a field in the same group.
+	// This is useful for values that identify a resource in the namespace of
+	// the provider, such as a bucket name, where two resources in the same blueprint
+	// can not be deployed with the same value.
+	// Fields of different resource types can share a group when the values
+	// of the fields conflict with each other (e.g. queue and topic names that share
+	// a namespace), group names should be prefixed with the provider namespace
+	// to avoid clashes with other providers (e.g. "aws/s3/bucketName").
+	// This is only used for "string", "integer" and "float" types.
+	// This constraint can not be enforced when ${..} substitutions are used in a value
+	// as there is no way to know the final value during the validation phase.
+	UniqueGroup string
+	// Deprecated specifies whether the field is deprecated
+	// and should no longer be set in blueprints.
+	// Setting a deprecated field in a blueprint is still valid,
+	// a warning is reported during validation and deprecated fields
+	// are included in the deprecation summary of staged changes.
+	Deprecated bool
+	// DeprecationMessage provides guidance for users of a deprecated field,
+	// this should include the field that replaces the deprecated field
+	// where there is one (e.g. "Use memorySize instead.").
+	DeprecationMessage string
+}
+
+// ResourceDefinitionsCondition holds a condition based on the value
+// of an attribute in a resource definition schema object.
+type ResourceDefinitionsCondition struct {
+	// Field is the name of the attribute in the same object
+	// that the condition is based on.
+	Field string
+	// Values holds a list of scalar values where the condition is met when
+	// the attribute is set to one of the values.
+	// When empty, the condition is met when the attribute is set to any value.
+	// When values are provided, the condition is not met for values that contain
+	// ${..} substitutions as there is no way to know the final value during
+	// the validation phase.
+	Values []*core.MappingNode
 }
 
 // ResourceDefinitionsSchemaType holds the type of a resource schema.
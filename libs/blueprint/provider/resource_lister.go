@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrResourceListingNotSupported is returned from ListResources by implementations
+// that can only determine whether a resource type supports listing at runtime,
+// such as resource types provided by plugins.
+// This is treated in the same way as a resource type that does not implement
+// ResourceLister.
+var ErrResourceListingNotSupported = errors.New(
+	"listing existing resources is not supported for the resource type",
+)
+
+// ResourceLister is an optional interface that resource type implementations
+// can implement to list existing resources in the upstream provider.
+// Listed resources are presented as candidates to be adopted by a blueprint
+// instance when importing resources that were created outside of Bluelink,
+// this saves users from having to look up provider-specific IDs by hand.
+//
+// Resource types that do not implement this interface can still be imported
+// with an explicitly provided import ID.
+type ResourceLister interface {
+	// ListResources lists existing resources of the resource type
+	// in the upstream provider.
+	ListResources(ctx context.Context, input *ResourceListInput) (*ResourceListOutput, error)
+}
+
+// ResourceListInput provides the input data needed to list existing
+// resources of a given type in the upstream provider.
+type ResourceListInput struct {
+	// ResourceType is the type of resource to list.
+	ResourceType string
+	// InstanceName is the user-defined name of the blueprint instance
+	// that resources are being listed for, this can be used to narrow down
+	// candidates when resource names are derived from the instance name.
+	InstanceName string
+	// ResourceName is the logical name of the resource in the blueprint
+	// that resources are being listed for.
+	ResourceName string
+	// MaxResults is the maximum number of resources to return,
+	// when zero or less, the implementation can choose a sensible default.
+	MaxResults int
+	// ProviderContext provides access to provider configuration and context variables.
+	ProviderContext Context
+}
+
+// ResourceListOutput provides the output data from listing
+// existing resources in the upstream provider.
+type ResourceListOutput struct {
+	// Resources holds the existing resources that can be imported,
+	// implementations should order these by relevance.
+	Resources []*ExternalResource
+}
+
+// ExternalResource describes an existing resource in the upstream provider
+// that can be imported into a blueprint instance.
+type ExternalResource struct {
+	// ImportID is the provider-specific identifier of the resource,
+	// this must be the value of the field that is the `IDField` in the
+	// spec definition of the resource type (e.g. an ARN for AWS resources).
+	ImportID string `json:"importId"`
+	// Name is a human-friendly name for the resource
+	// that helps users identify it.
+	Name string `json:"name,omitempty"`
+	// Description provides additional information about the resource
+	// such as its region or creation time.
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+// RedactedSensitiveValue is the value that values of fields marked as sensitive
+// are replaced with when they are written to events, logs, reports
+// or any other output that is not the persisted state of a resource.
+const RedactedSensitiveValue = "(sensitive value)"
+
+// RedactSensitiveValues produces a copy of the provided resource spec value
+// where all values for fields marked as sensitive in the given schema
+// are replaced with RedactedSensitiveValue.
+// The provided value is not modified.
+func RedactSensitiveValues(
+	value *core.MappingNode,
+	schema *ResourceDefinitionsSchema,
+) *core.MappingNode {
+	if value == nil || schema == nil {
+		return value
+	}
+
+	if schema.Sensitive {
+		return core.MappingNodeFromString(RedactedSensitiveValue)
+	}
+
+	switch schema.Type {
+	case ResourceDefinitionsSchemaTypeObject:
+		return redactFields(value, func(fieldName string) *ResourceDefinitionsSchema {
+			return schema.Attributes[fieldName]
+		})
+	case ResourceDefinitionsSchemaTypeMap:
+		return redactFields(value, func(string) *ResourceDefinitionsSchema {
+			return schema.MapValues
+		})
+	case ResourceDefinitionsSchemaTypeArray:
+		return redactItems(value, schema.Items)
+	case ResourceDefinitionsSchemaTypeUnion:
+		// The schema a value matches in a union can not always be determined
+		// from the shape of the value, so redaction for every schema in the union
+		// is applied to err on the side of caution.
+		redacted := value
+		for _, unionSchema := range schema.OneOf {
+			redacted = RedactSensitiveValues(redacted, unionSchema)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// IsSensitivePath determines whether the value at the given path
+// relative to the root of the provided schema is sensitive.
+// A value is sensitive if the field at the path or any of its ancestors
+// is marked as sensitive.
+// For unions, the value is considered to be sensitive if the path
+// leads to a sensitive field in any of the schemas in the union.
+func IsSensitivePath(
+	schema *ResourceDefinitionsSchema,
+	path []*substitutions.SubstitutionPathItem,
+) bool {
+	if schema == nil {
+		return false
+	}
+
+	if schema.Sensitive {
+		return true
+	}
+
+	if len(path) == 0 {
+		return false
+	}
+
+	pathItem := path[0]
+	switch schema.Type {
+	case ResourceDefinitionsSchemaTypeObject:
+		if pathItem.FieldName == "" {
+			return false
+		}
+		return IsSensitivePath(schema.Attributes[pathItem.FieldName], path[1:])
+	case ResourceDefinitionsSchemaTypeMap:
+		if pathItem.FieldName == "" {
+			return false
+		}
+		return IsSensitivePath(schema.MapValues, path[1:])
+	case ResourceDefinitionsSchemaTypeArray:
+		if pathItem.ArrayIndex == nil {
+			return false
+		}
+		return IsSensitivePath(schema.Items, path[1:])
+	case ResourceDefinitionsSchemaTypeUnion:
+		for _, unionSchema := range schema.OneOf {
+			if IsSensitivePath(unionSchema, path) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+func redactFields(
+	value *core.MappingNode,
+	fieldSchema func(fieldName string) *ResourceDefinitionsSchema,
+) *core.MappingNode {
+	if value.Fields == nil {
+		return value
+	}
+
+	fields := make(map[string]*core.MappingNode, len(value.Fields))
+	for fieldName, fieldValue := range value.Fields {
+		fields[fieldName] = RedactSensitiveValues(fieldValue, fieldSchema(fieldName))
+	}
+
+	return &core.MappingNode{
+		Fields:           fields,
+		SourceMeta:       value.SourceMeta,
+		FieldsSourceMeta: value.FieldsSourceMeta,
+	}
+}
+
+func redactItems(
+	value *core.MappingNode,
+	itemSchema *ResourceDefinitionsSchema,
+) *core.MappingNode {
+	if value.Items == nil {
+		return value
+	}
+
+	items := make([]*core.MappingNode, 0, len(value.Items))
+	for _, item := range value.Items {
+		items = append(items, RedactSensitiveValues(item, itemSchema))
+	}
+
+	return &core.MappingNode{
+		Items:      items,
+		SourceMeta: value.SourceMeta,
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/stretchr/testify/suite"
+)
+
+type SensitiveValuesTestSuite struct {
+	suite.Suite
+}
+
+func (s *SensitiveValuesTestSuite) Test_redacts_values_of_sensitive_fields() {
+	value := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"name": core.MappingNodeFromString("orders-db"),
+			"credentials": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"username": core.MappingNodeFromString("admin"),
+							"password": core.MappingNodeFromString("hunter2"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	redacted := RedactSensitiveValues(value, sensitiveValuesTestSchema())
+
+	s.Assert().Equal(
+		&core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"name": core.MappingNodeFromString("orders-db"),
+				"credentials": {
+					Items: []*core.MappingNode{
+						{
+							Fields: map[string]*core.MappingNode{
+								"username": core.MappingNodeFromString("admin"),
+								"password": core.MappingNodeFromString(RedactedSensitiveValue),
+							},
+						},
+					},
+				},
+			},
+		},
+		redacted,
+	)
+	// The original value must not be modified.
+	s.Assert().Equal(
+		"hunter2",
+		core.StringValue(value.Fields["credentials"].Items[0].Fields["password"]),
+	)
+}
+
+func (s *SensitiveValuesTestSuite) Test_determines_whether_a_path_is_sensitive() {
+	schema := sensitiveValuesTestSchema()
+
+	s.Assert().True(IsSensitivePath(schema, parseSensitiveTestPath("spec.credentials[0].password")))
+	s.Assert().True(IsSensitivePath(schema, parseSensitiveTestPath("spec.connectionDetails.host")))
+	s.Assert().True(IsSensitivePath(schema, parseSensitiveTestPath("spec.tokens[\"primary\"]")))
+	s.Assert().False(IsSensitivePath(schema, parseSensitiveTestPath("spec.credentials[0].username")))
+	s.Assert().False(IsSensitivePath(schema, parseSensitiveTestPath("spec.name")))
+	s.Assert().False(IsSensitivePath(schema, parseSensitiveTestPath("spec.unknown")))
+}
+
+func parseSensitiveTestPath(path string) []*substitutions.SubstitutionPathItem {
+	sub, err := substitutions.ParseSubstitution(
+		"",
+		fmt.Sprintf("resources.test.%s", path),
+		nil,
+		/* outputLineInfo */ false,
+		/* ignoreParentColumn */ true,
+	)
+	if err != nil {
+		panic(err)
+	}
+	// Exclude the "spec" prefix as paths are relative to the root of the spec schema.
+	return sub.ResourceProperty.Path[1:]
+}
+
+func sensitiveValuesTestSchema() *ResourceDefinitionsSchema {
+	return &ResourceDefinitionsSchema{
+		Type: ResourceDefinitionsSchemaTypeObject,
+		Attributes: map[string]*ResourceDefinitionsSchema{
+			"name": {
+				Type: ResourceDefinitionsSchemaTypeString,
+			},
+			"credentials": {
+				Type: ResourceDefinitionsSchemaTypeArray,
+				Items: &ResourceDefinitionsSchema{
+					Type: ResourceDefinitionsSchemaTypeObject,
+					Attributes: map[string]*ResourceDefinitionsSchema{
+						"username": {
+							Type: ResourceDefinitionsSchemaTypeString,
+						},
+						"password": {
+							Type:      ResourceDefinitionsSchemaTypeString,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"connectionDetails": {
+				Type:      ResourceDefinitionsSchemaTypeObject,
+				Sensitive: true,
+				Attributes: map[string]*ResourceDefinitionsSchema{
+					"host": {
+						Type: ResourceDefinitionsSchemaTypeString,
+					},
+				},
+			},
+			"tokens": {
+				Type: ResourceDefinitionsSchemaTypeMap,
+				MapValues: &ResourceDefinitionsSchema{
+					Type:      ResourceDefinitionsSchemaTypeString,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+func TestSensitiveValuesTestSuite(t *testing.T) {
+	suite.Run(t, new(SensitiveValuesTestSuite))
+}
@@ -33,11 +33,15 @@ func NewCoreProvider(
 		"fromjson":      corefunctions.NewFromJSONFunction(),
 		"fromjson_g":    corefunctions.NewFromJSON_G_Function(),
 		"jsondecode":    corefunctions.NewJSONDecodeFunction(),
+		"jsonencode":    corefunctions.NewJSONEncodeFunction(),
 		"len":           corefunctions.NewLenFunction(),
 		"substr":        corefunctions.NewSubstrFunction(),
 		"substr_g":      corefunctions.NewSubstr_G_Function(),
 		"replace":       corefunctions.NewReplaceFunction(),
 		"replace_g":     corefunctions.NewReplace_G_Function(),
+		"regexmatch":    corefunctions.NewRegexMatchFunction(),
+		"regexreplace":  corefunctions.NewRegexReplaceFunction(),
+		"regexall":      corefunctions.NewRegexAllFunction(),
 		"trim":          corefunctions.NewTrimFunction(),
 		"trimprefix":    corefunctions.NewTrimPrefixFunction(),
 		"trimprefix_g":  corefunctions.NewTrimPrefix_G_Function(),
@@ -62,6 +66,7 @@ func NewCoreProvider(
 		"vals":          corefunctions.NewValsFunction(),
 		"first":         corefunctions.NewFirstFunction(),
 		"coalesce":      corefunctions.NewCoalesceFunction(),
+		"try":           corefunctions.NewTryFunction(),
 		"lookup":        corefunctions.NewLookupFunction(),
 		"if":            corefunctions.NewIfFunction(),
 		"map":           corefunctions.NewMapFunction(),
@@ -89,18 +94,25 @@ func NewCoreProvider(
 		"le":            corefunctions.NewLeFunction(),
 		"cwd":           corefunctions.NewCWDFunction(resolveWorkingDir),
 		"datetime":      corefunctions.NewDateTimeFunction(clock),
+		"now":           corefunctions.NewNowFunction(clock),
+		"formatdate":    corefunctions.NewFormatDateFunction(),
+		"timeadd":       corefunctions.NewTimeAddFunction(),
 		"base64encode":  corefunctions.NewBase64EncodeFunction(),
 		"base64decode":  corefunctions.NewBase64DecodeFunction(),
+		"urlencode":     corefunctions.NewURLEncodeFunction(),
 		"min":           corefunctions.NewMinFunction(),
 		"max":           corefunctions.NewMaxFunction(),
 		"abs":           corefunctions.NewAbsFunction(),
 		"file":          corefunctions.NewFileFunction(fileSourceRegistry),
+		"templatefile":  corefunctions.NewTemplateFileFunction(fileSourceRegistry),
 		"utf8":          corefunctions.NewUTF8Function(),
 		"sha256":        corefunctions.NewSHA256Function(),
 		"md5":           corefunctions.NewMD5Function(),
 		"sha1":          corefunctions.NewSHA1Function(),
 		"uuid":          corefunctions.NewUUIDFunction(),
 		"cidrsubnet":    corefunctions.NewCIDRSubnetFunction(),
+		"cidrhost":      corefunctions.NewCIDRHostFunction(),
+		"cidrnetmask":   corefunctions.NewCIDRNetmaskFunction(),
 		"http_resource": corefunctions.NewHTTPResourceFunction(),
 	}
 	return &coreProvider{
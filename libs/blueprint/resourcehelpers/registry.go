@@ -36,6 +36,14 @@ type Registry interface {
 		input *provider.ResourceGetTypeDescriptionInput,
 	) (*provider.ResourceGetTypeDescriptionOutput, error)
 
+	// GetExamples returns the examples of how to use a resource type
+	// in the registry.
+	GetExamples(
+		ctx context.Context,
+		resourceType string,
+		input *provider.ResourceGetExamplesInput,
+	) (*provider.ResourceGetExamplesOutput, error)
+
 	// HasResourceType checks if a resource type is available in the registry.
 	HasResourceType(ctx context.Context, resourceType string) (bool, error)
 
@@ -304,6 +312,41 @@ func (r *registryFromProviders) GetTypeDescription(
 	return resourceImpl.GetTypeDescription(ctx, input)
 }
 
+func (r *registryFromProviders) GetExamples(
+	ctx context.Context,
+	resourceType string,
+	input *provider.ResourceGetExamplesInput,
+) (*provider.ResourceGetExamplesOutput, error) {
+	resourceImpl, err := r.getResourceType(ctx, resourceType)
+	if err != nil {
+		abstractResourceImpl, abstractErr := r.getAbstractResourceType(ctx, resourceType)
+		if abstractErr != nil {
+			return nil, errMultipleRunErrors([]error{err, abstractErr})
+		}
+
+		transformerNamespace := transform.ExtractTransformerFromItemType(resourceType)
+		output, err := abstractResourceImpl.GetExamples(
+			ctx,
+			&transform.AbstractResourceGetExamplesInput{
+				TransformerContext: transform.NewTransformerContextFromParams(
+					transformerNamespace,
+					r.params,
+				),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &provider.ResourceGetExamplesOutput{
+			MarkdownExamples:  output.MarkdownExamples,
+			PlainTextExamples: output.PlainTextExamples,
+		}, nil
+	}
+
+	return resourceImpl.GetExamples(ctx, input)
+}
+
 func (r *registryFromProviders) ListResourceTypes(ctx context.Context) ([]string, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
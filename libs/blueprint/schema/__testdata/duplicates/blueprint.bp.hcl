@@ -0,0 +1,20 @@
+version = "2025-11-02"
+
+# The second definition of the "region" variable is a duplicate.
+variable "region" { type = "string" }
+variable "region" { type = "string" }
+
+resource "ordersQueue" {
+  type = "aws/sqs/queue"
+  spec {}
+}
+
+resource "ordersTable" {
+  type = "aws/dynamodb/table"
+  spec {}
+}
+
+resource "ordersQueue" {
+  type = "aws/sqs/queue"
+  spec {}
+}
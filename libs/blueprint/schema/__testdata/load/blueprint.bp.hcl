@@ -0,0 +1,180 @@
+version   = "2021-12-18"
+transform = "celerity-2022-01-22"
+
+variable "dynamoDBTable" {
+  type        = "string"
+  description = "The Orders DynamoDB Table"
+}
+
+variable "ordersTopicName" {
+  type        = "string"
+  description = "The Orders Event Topic"
+}
+
+variable "environment" {
+  type        = "string"
+  description = "The environment to deploy to"
+}
+
+value "alteredTable" {
+  type        = "string"
+  description = "An altered table name"
+  value       = "${variables.dynamoDBTable}-altered"
+}
+
+datasource "network" {
+  type = "aws/vpc"
+
+  metadata {
+    displayName = "Network source"
+  }
+
+  filter {
+    field    = "tags"
+    operator = "has key"
+    # We can use ${..} in search values for data source filtering.
+    search = "${variables.environment}"
+  }
+
+  exports "subnets" {
+    type = "array"
+  }
+
+  exports "securityGroups" {
+    type = "array"
+  }
+
+  exports "vpc" {
+    type = "string"
+  }
+}
+
+resource "orderApi" {
+  type = "celerity/api"
+
+  metadata {
+    displayName = "Order API"
+    labels = {
+      app = "orderApi"
+    }
+  }
+
+  linkSelector {
+    byLabel = {
+      app = "orderApi"
+    }
+  }
+
+  spec {
+    environment {
+      variables = {
+        DYNAMODB_TABLE = "${variables.dynamoDBTable}"
+      }
+    }
+    runtime        = "go1.x"
+    tracingEnabled = true
+  }
+}
+
+resource "authoriser" {
+  type = "celerity/handler"
+
+  metadata {
+    displayName = "Authoriser"
+    annotations = {
+      "authoriser"                               = "true"
+      "authoriser.identity.header"               = "MyCustomAuthHeader"
+      "authoriser.identity.validationExpression" = "Bearer .*"
+      "authoriser.identity.reauthoriseEvery"     = "20"
+    }
+    labels = {
+      app = "orderApi"
+    }
+  }
+
+  spec {
+    handler = "handlers.Authoriser"
+    timeout = 120
+  }
+}
+
+resource "getOrdersHandler" {
+  type = "celerity/handler"
+
+  metadata {
+    displayName = "Get Orders Handler"
+    labels = {
+      app = "orderApi"
+    }
+  }
+
+  spec {
+    handler = "handlers.GetOrders"
+    timeout = 120
+    endpoint {
+      method     = "get"
+      path       = "/orders"
+      authoriser = "${authoriser}"
+    }
+  }
+}
+
+resource "updateOrderFunction" {
+  type = "celerity/handler"
+
+  metadata {
+    displayName = "Update Order Function"
+    labels = {
+      workflow   = "orderPubSub"
+      pubsubType = "consumer"
+    }
+  }
+
+  spec {
+    handler = "handlers.UpdateOrder"
+    timeout = 120
+    events "orderEvent" {
+      type      = "pubsub"
+      batchSize = 10
+    }
+  }
+}
+
+resource "sharedPackagesLayer" {
+  type = "celerity/layerVersion"
+
+  metadata {
+    displayName = "Shared Packages Layer"
+    labels = {
+      workflow = "orderPubSub"
+      app      = "orderApi"
+    }
+  }
+
+  spec {
+    compatibleRuntimes = ["go1.x"]
+    content            = "build/shared-packages-layer"
+    layerName          = "sharedPackagesLayer"
+  }
+}
+
+resource "orderPubSub" {
+  type = "celerity/pubsub"
+
+  metadata {
+    displayName = "Order Pub/Sub"
+    labels = {
+      workflow = "orderPubSub"
+    }
+  }
+
+  linkSelector {
+    byLabel = {
+      workflow = "orderPubSub"
+    }
+  }
+
+  spec {
+    topicName = "${variables.ordersTopicName}"
+  }
+}
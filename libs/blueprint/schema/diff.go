@@ -0,0 +1,479 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+// DiffChangeType describes how an element or field
+// differs between two blueprint documents.
+type DiffChangeType string
+
+const (
+	// DiffChangeTypeAdded is used for elements or fields that are only
+	// present in the new blueprint document.
+	DiffChangeTypeAdded DiffChangeType = "added"
+	// DiffChangeTypeRemoved is used for elements or fields that are only
+	// present in the old blueprint document.
+	DiffChangeTypeRemoved DiffChangeType = "removed"
+	// DiffChangeTypeChanged is used for elements or fields that are present
+	// in both blueprint documents with different values.
+	DiffChangeTypeChanged DiffChangeType = "changed"
+)
+
+// BlueprintDiff holds the semantic differences between two blueprint documents.
+// Each list of element diffs is sorted by element name.
+type BlueprintDiff struct {
+	Variables   []*ElementDiff `json:"variables,omitempty"`
+	Values      []*ElementDiff `json:"values,omitempty"`
+	Include     []*ElementDiff `json:"include,omitempty"`
+	Resources   []*ElementDiff `json:"resources,omitempty"`
+	DataSources []*ElementDiff `json:"datasources,omitempty"`
+	Exports     []*ElementDiff `json:"exports,omitempty"`
+}
+
+// HasChanges determines whether there are any differences
+// between the two blueprint documents.
+func (d *BlueprintDiff) HasChanges() bool {
+	return len(d.Variables) > 0 ||
+		len(d.Values) > 0 ||
+		len(d.Include) > 0 ||
+		len(d.Resources) > 0 ||
+		len(d.DataSources) > 0 ||
+		len(d.Exports) > 0
+}
+
+// ElementDiff describes how a single element (e.g. a resource or a variable)
+// differs between two blueprint documents.
+type ElementDiff struct {
+	// Name is the name of the element in the blueprint document.
+	Name       string         `json:"name"`
+	ChangeType DiffChangeType `json:"changeType"`
+	// Fields holds the fields that differ for an element that is present
+	// in both blueprint documents.
+	// This is empty for added and removed elements.
+	Fields []*FieldDiff `json:"fields,omitempty"`
+}
+
+// FieldDiff describes how a field of an element differs
+// between two blueprint documents.
+type FieldDiff struct {
+	// Path is the path of the field relative to the element
+	// (e.g. "spec.tags[0].value" for a resource).
+	Path       string         `json:"path"`
+	ChangeType DiffChangeType `json:"changeType"`
+	// OldValue is the value of the field in the old blueprint document,
+	// this is nil for added fields.
+	OldValue any `json:"oldValue,omitempty"`
+	// NewValue is the value of the field in the new blueprint document,
+	// this is nil for removed fields.
+	NewValue any `json:"newValue,omitempty"`
+}
+
+// Diff produces a semantic diff between two blueprint documents.
+// Elements are compared by their parsed values without source locations
+// so the diff is independent of the format of the source documents (YAML or JSONC),
+// the order of fields, whitespace and comments.
+// Fields that are set to empty values are treated the same as fields that are not set.
+// Either document can be nil to represent an empty blueprint.
+func Diff(oldDoc *Blueprint, newDoc *Blueprint) (*BlueprintDiff, error) {
+	if oldDoc == nil {
+		oldDoc = &Blueprint{}
+	}
+	if newDoc == nil {
+		newDoc = &Blueprint{}
+	}
+
+	diff := &BlueprintDiff{}
+	var err error
+	diff.Variables, err = diffElements(variableValues(oldDoc.Variables), variableValues(newDoc.Variables))
+	if err != nil {
+		return nil, err
+	}
+
+	diff.Values, err = diffElements(valueValues(oldDoc.Values), valueValues(newDoc.Values))
+	if err != nil {
+		return nil, err
+	}
+
+	diff.Include, err = diffElements(includeValues(oldDoc.Include), includeValues(newDoc.Include))
+	if err != nil {
+		return nil, err
+	}
+
+	diff.Resources, err = diffElements(resourceValues(oldDoc.Resources), resourceValues(newDoc.Resources))
+	if err != nil {
+		return nil, err
+	}
+
+	diff.DataSources, err = diffElements(
+		dataSourceValues(oldDoc.DataSources),
+		dataSourceValues(newDoc.DataSources),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	diff.Exports, err = diffElements(exportValues(oldDoc.Exports), exportValues(newDoc.Exports))
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+func diffElements[Element any](
+	oldElements map[string]*Element,
+	newElements map[string]*Element,
+) ([]*ElementDiff, error) {
+	names := []string{}
+	for name := range oldElements {
+		names = append(names, name)
+	}
+	for name := range newElements {
+		if _, inOld := oldElements[name]; !inOld {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	diffs := []*ElementDiff{}
+	for _, name := range names {
+		oldElem, inOld := oldElements[name]
+		newElem, inNew := newElements[name]
+		if !inOld {
+			diffs = append(diffs, &ElementDiff{Name: name, ChangeType: DiffChangeTypeAdded})
+			continue
+		}
+		if !inNew {
+			diffs = append(diffs, &ElementDiff{Name: name, ChangeType: DiffChangeTypeRemoved})
+			continue
+		}
+
+		oldValue, err := toComparableValue(name, oldElem)
+		if err != nil {
+			return nil, err
+		}
+		newValue, err := toComparableValue(name, newElem)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldDiffs := []*FieldDiff{}
+		diffValues("", oldValue, newValue, &fieldDiffs)
+		if len(fieldDiffs) > 0 {
+			diffs = append(diffs, &ElementDiff{
+				Name:       name,
+				ChangeType: DiffChangeTypeChanged,
+				Fields:     fieldDiffs,
+			})
+		}
+	}
+
+	return diffs, nil
+}
+
+var (
+	mappingNodeType      = reflect.TypeOf(&core.MappingNode{})
+	scalarValueType      = reflect.TypeOf(&core.ScalarValue{})
+	sourceMetaType       = reflect.TypeOf(&source.Meta{})
+	fieldsSourceMetaType = reflect.TypeOf(map[string]*source.Meta{})
+	jsonMarshalerType    = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// Converts an element to a tree of generic maps, slices and scalar values
+// so elements can be compared without source location information.
+// Empty values are treated the same as values that are not set,
+// this is needed as documents in different formats produce different
+// representations of optional fields that are not set.
+func toComparableValue(name string, element any) (any, error) {
+	value, err := comparableValue(reflect.ValueOf(element))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare element %q for diff: %w", name, err)
+	}
+
+	return value, nil
+}
+
+func comparableValue(value reflect.Value) (any, error) {
+	if !value.IsValid() || isEmptyValue(value) {
+		return nil, nil
+	}
+
+	switch value.Type() {
+	case mappingNodeType:
+		return comparableMappingNode(value.Interface().(*core.MappingNode))
+	case scalarValueType:
+		return marshalledValue(value.Interface().(json.Marshaler))
+	}
+
+	if value.Type().Implements(jsonMarshalerType) {
+		return marshalledValue(value.Interface().(json.Marshaler))
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return comparableValue(value.Elem())
+	case reflect.Struct:
+		return comparableStruct(value)
+	case reflect.Slice, reflect.Array:
+		items := make([]any, value.Len())
+		for i := 0; i < value.Len(); i += 1 {
+			item, err := comparableValue(value.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return items, nil
+	case reflect.Map:
+		fields := map[string]any{}
+		iter := value.MapRange()
+		for iter.Next() {
+			field, err := comparableValue(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			fields[fmt.Sprintf("%v", iter.Key().Interface())] = field
+		}
+		return fields, nil
+	}
+
+	return value.Interface(), nil
+}
+
+func comparableStruct(value reflect.Value) (any, error) {
+	fields := map[string]any{}
+	for i := 0; i < value.NumField(); i += 1 {
+		fieldType := value.Type().Field(i)
+		fieldName, include := jsonFieldName(fieldType)
+		if !include {
+			continue
+		}
+
+		field, err := comparableValue(value.Field(i))
+		if err != nil {
+			return nil, err
+		}
+		if field != nil {
+			fields[fieldName] = field
+		}
+	}
+
+	return fields, nil
+}
+
+func comparableMappingNode(node *core.MappingNode) (any, error) {
+	if node.Scalar != nil {
+		return comparableValue(reflect.ValueOf(node.Scalar))
+	}
+
+	if node.StringWithSubstitutions != nil {
+		return comparableValue(reflect.ValueOf(node.StringWithSubstitutions))
+	}
+
+	if len(node.Fields) > 0 {
+		return comparableValue(reflect.ValueOf(node.Fields))
+	}
+
+	return comparableValue(reflect.ValueOf(node.Items))
+}
+
+func marshalledValue(marshaler json.Marshaler) (any, error) {
+	serialised, err := marshaler.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	err = json.Unmarshal(serialised, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() || isSourceMetaField(field) {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return "", false
+	}
+
+	if name == "" {
+		return field.Name, true
+	}
+
+	return name, true
+}
+
+func isSourceMetaField(field reflect.StructField) bool {
+	return field.Type == sourceMetaType || field.Type == fieldsSourceMetaType
+}
+
+func isEmptyValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return true
+		}
+		if value.Elem().Kind() == reflect.Struct {
+			return isEmptyValue(value.Elem())
+		}
+		return false
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i += 1 {
+			// Fields that are excluded from the JSON representation
+			// can hold parsed values (e.g. conditions that are substitutions)
+			// so only source location fields are ignored.
+			field := value.Type().Field(i)
+			if !field.IsExported() || isSourceMetaField(field) {
+				continue
+			}
+			if !isEmptyValue(value.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return value.IsZero()
+}
+
+func diffValues(path string, oldValue any, newValue any, fieldDiffs *[]*FieldDiff) {
+	if oldValue == nil && newValue == nil {
+		return
+	}
+
+	if oldValue == nil {
+		*fieldDiffs = append(*fieldDiffs, &FieldDiff{
+			Path:       path,
+			ChangeType: DiffChangeTypeAdded,
+			NewValue:   newValue,
+		})
+		return
+	}
+
+	if newValue == nil {
+		*fieldDiffs = append(*fieldDiffs, &FieldDiff{
+			Path:       path,
+			ChangeType: DiffChangeTypeRemoved,
+			OldValue:   oldValue,
+		})
+		return
+	}
+
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+	if oldIsMap && newIsMap {
+		diffMaps(path, oldMap, newMap, fieldDiffs)
+		return
+	}
+
+	oldList, oldIsList := oldValue.([]any)
+	newList, newIsList := newValue.([]any)
+	if oldIsList && newIsList {
+		diffLists(path, oldList, newList, fieldDiffs)
+		return
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) {
+		*fieldDiffs = append(*fieldDiffs, &FieldDiff{
+			Path:       path,
+			ChangeType: DiffChangeTypeChanged,
+			OldValue:   oldValue,
+			NewValue:   newValue,
+		})
+	}
+}
+
+func diffMaps(path string, oldMap map[string]any, newMap map[string]any, fieldDiffs *[]*FieldDiff) {
+	keys := []string{}
+	for key := range oldMap {
+		keys = append(keys, key)
+	}
+	for key := range newMap {
+		if _, inOld := oldMap[key]; !inOld {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		diffValues(
+			substitutions.RenderFieldPath(path, key),
+			oldMap[key],
+			newMap[key],
+			fieldDiffs,
+		)
+	}
+}
+
+func diffLists(path string, oldList []any, newList []any, fieldDiffs *[]*FieldDiff) {
+	for i := 0; i < max(len(oldList), len(newList)); i += 1 {
+		var oldItem any
+		if i < len(oldList) {
+			oldItem = oldList[i]
+		}
+		var newItem any
+		if i < len(newList) {
+			newItem = newList[i]
+		}
+		diffValues(fmt.Sprintf("%s[%d]", path, i), oldItem, newItem, fieldDiffs)
+	}
+}
+
+func variableValues(variables *VariableMap) map[string]*Variable {
+	if variables == nil {
+		return nil
+	}
+	return variables.Values
+}
+
+func valueValues(values *ValueMap) map[string]*Value {
+	if values == nil {
+		return nil
+	}
+	return values.Values
+}
+
+func includeValues(include *IncludeMap) map[string]*Include {
+	if include == nil {
+		return nil
+	}
+	return include.Values
+}
+
+func resourceValues(resources *ResourceMap) map[string]*Resource {
+	if resources == nil {
+		return nil
+	}
+	return resources.Values
+}
+
+func dataSourceValues(dataSources *DataSourceMap) map[string]*DataSource {
+	if dataSources == nil {
+		return nil
+	}
+	return dataSources.Values
+}
+
+func exportValues(exports *ExportMap) map[string]*Export {
+	if exports == nil {
+		return nil
+	}
+	return exports.Values
+}
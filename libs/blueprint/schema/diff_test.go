@@ -0,0 +1,95 @@
+package schema
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type DiffTestSuite struct{}
+
+var _ = Suite(&DiffTestSuite{})
+
+func (s *DiffTestSuite) Test_produces_semantic_diff_between_blueprints(c *C) {
+	oldDoc, err := Load("__testdata/diff/blueprint-old.yml", YAMLSpecFormat)
+	c.Assert(err, IsNil)
+	newDoc, err := Load("__testdata/diff/blueprint-new.yml", YAMLSpecFormat)
+	c.Assert(err, IsNil)
+
+	diff, err := Diff(oldDoc, newDoc)
+	c.Assert(err, IsNil)
+	c.Assert(diff.HasChanges(), Equals, true)
+
+	c.Assert(diff.Variables, DeepEquals, []*ElementDiff{
+		{Name: "instanceType", ChangeType: DiffChangeTypeAdded},
+		{Name: "region", ChangeType: DiffChangeTypeRemoved},
+	})
+
+	c.Assert(diff.Resources, DeepEquals, []*ElementDiff{
+		{Name: "ordersFunction", ChangeType: DiffChangeTypeAdded},
+		{Name: "ordersQueue", ChangeType: DiffChangeTypeRemoved},
+		{
+			Name:       "ordersTable",
+			ChangeType: DiffChangeTypeChanged,
+			Fields: []*FieldDiff{
+				{
+					Path:       "spec.billingMode",
+					ChangeType: DiffChangeTypeChanged,
+					OldValue:   "PAY_PER_REQUEST",
+					NewValue:   "PROVISIONED",
+				},
+				{
+					Path:       "spec.tags[1]",
+					ChangeType: DiffChangeTypeAdded,
+					NewValue: map[string]any{
+						"key":   "costCentre",
+						"value": "1234",
+					},
+				},
+			},
+		},
+	})
+
+	c.Assert(diff.Exports, DeepEquals, []*ElementDiff{
+		{
+			Name:       "ordersTableName",
+			ChangeType: DiffChangeTypeChanged,
+			Fields: []*FieldDiff{
+				{
+					Path:       "field",
+					ChangeType: DiffChangeTypeChanged,
+					OldValue:   "resources.ordersTable.spec.tableName",
+					NewValue:   "resources.ordersTable.spec.id",
+				},
+			},
+		},
+	})
+
+	c.Assert(diff.Values, HasLen, 0)
+	c.Assert(diff.Include, HasLen, 0)
+	c.Assert(diff.DataSources, HasLen, 0)
+}
+
+func (s *DiffTestSuite) Test_ignores_formatting_differences_between_documents(c *C) {
+	yamlDoc, err := Load("__testdata/diff/blueprint-old.yml", YAMLSpecFormat)
+	c.Assert(err, IsNil)
+	jsoncDoc, err := Load("__testdata/diff/blueprint-old.jsonc", JWCCSpecFormat)
+	c.Assert(err, IsNil)
+
+	diff, err := Diff(yamlDoc, jsoncDoc)
+	c.Assert(err, IsNil)
+	c.Assert(diff.HasChanges(), Equals, false)
+}
+
+func (s *DiffTestSuite) Test_treats_nil_document_as_empty_blueprint(c *C) {
+	newDoc, err := Load("__testdata/diff/blueprint-new.yml", YAMLSpecFormat)
+	c.Assert(err, IsNil)
+
+	diff, err := Diff(nil, newDoc)
+	c.Assert(err, IsNil)
+	c.Assert(diff.Resources, DeepEquals, []*ElementDiff{
+		{Name: "ordersFunction", ChangeType: DiffChangeTypeAdded},
+		{Name: "ordersTable", ChangeType: DiffChangeTypeAdded},
+	})
+	c.Assert(diff.Exports, DeepEquals, []*ElementDiff{
+		{Name: "ordersTableName", ChangeType: DiffChangeTypeAdded},
+	})
+}
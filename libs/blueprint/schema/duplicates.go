@@ -0,0 +1,167 @@
+package schema
+
+import (
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/tailscale/hujson"
+	"gopkg.in/yaml.v3"
+)
+
+// DuplicateDefinition holds the locations of an element that is defined
+// more than once in the same section of a blueprint document.
+// Only the last definition of an element is kept when a blueprint document
+// is unmarshalled, so duplicates are collected from the source document
+// to allow validation to report them.
+type DuplicateDefinition struct {
+	// Section is the top-level section of the blueprint document
+	// that the element is defined in (e.g. "resources").
+	Section string
+	// Name is the name of the element that is defined more than once.
+	Name string
+	// First holds the location of the first definition of the element.
+	First *source.Meta
+	// Duplicate holds the location of a subsequent definition of the element.
+	Duplicate *source.Meta
+}
+
+// Top-level sections of a blueprint document that contain named elements,
+// where names must be unique within a section.
+var namedElementSections = []string{
+	"variables",
+	"values",
+	"functions",
+	"include",
+	"resources",
+	"datasources",
+	"exports",
+}
+
+// ExtractDuplicateDefinitions collects the elements that are defined more than once
+// in a YAML, JSON with Commas and Comments or HCL blueprint document.
+// A definition is reported for every occurrence of a name after the first
+// within the same section.
+// Documents that can not be parsed produce a nil list, parse errors are reported
+// when the document is unmarshalled.
+func ExtractDuplicateDefinitions(document string, format SpecFormat) []*DuplicateDefinition {
+	if format == YAMLSpecFormat {
+		return extractYAMLDuplicateDefinitions(document)
+	}
+
+	if format == HCLSpecFormat {
+		return extractHCLDuplicateDefinitions(document)
+	}
+
+	return extractJWCCDuplicateDefinitions(document)
+}
+
+func extractYAMLDuplicateDefinitions(document string) []*DuplicateDefinition {
+	root := &yaml.Node{}
+	err := yaml.Unmarshal([]byte(document), root)
+	if err != nil || len(root.Content) == 0 ||
+		root.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var duplicates []*DuplicateDefinition
+	docNode := root.Content[0]
+	for i := 0; i+1 < len(docNode.Content); i += 2 {
+		sectionKey := docNode.Content[i]
+		sectionNode := docNode.Content[i+1]
+		if !slices.Contains(namedElementSections, sectionKey.Value) ||
+			sectionNode.Kind != yaml.MappingNode {
+			continue
+		}
+
+		firstDefinitions := map[string]*source.Meta{}
+		for j := 0; j+1 < len(sectionNode.Content); j += 2 {
+			key := sectionNode.Content[j]
+			location := &source.Meta{
+				Position: source.Position{
+					Line:   key.Line,
+					Column: key.Column,
+				},
+			}
+			duplicates = appendDuplicateDefinition(
+				duplicates,
+				firstDefinitions,
+				sectionKey.Value,
+				key.Value,
+				location,
+			)
+		}
+	}
+
+	return duplicates
+}
+
+func extractJWCCDuplicateDefinitions(document string) []*DuplicateDefinition {
+	root, err := hujson.Parse([]byte(document))
+	if err != nil {
+		return nil
+	}
+
+	docObject, isObject := root.Value.(*hujson.Object)
+	if !isObject {
+		return nil
+	}
+
+	linePositions := core.LinePositionsFromSource(document)
+	var duplicates []*DuplicateDefinition
+	for _, sectionMember := range docObject.Members {
+		sectionName := jwccMemberName(sectionMember)
+		sectionObject, isSectionObject := sectionMember.Value.Value.(*hujson.Object)
+		if !slices.Contains(namedElementSections, sectionName) || !isSectionObject {
+			continue
+		}
+
+		firstDefinitions := map[string]*source.Meta{}
+		for _, member := range sectionObject.Members {
+			// Element locations for JWCC documents are the positions of the
+			// element values to be consistent with the source locations
+			// captured when unmarshalling a document, hujson offsets
+			// are one character behind the offsets used when unmarshalling.
+			position := source.PositionFromOffset(member.Value.StartOffset+1, linePositions)
+			duplicates = appendDuplicateDefinition(
+				duplicates,
+				firstDefinitions,
+				sectionName,
+				jwccMemberName(member),
+				&source.Meta{Position: position},
+			)
+		}
+	}
+
+	return duplicates
+}
+
+func jwccMemberName(member hujson.ObjectMember) string {
+	name, isLiteral := member.Name.Value.(hujson.Literal)
+	if !isLiteral {
+		return ""
+	}
+
+	return name.String()
+}
+
+func appendDuplicateDefinition(
+	duplicates []*DuplicateDefinition,
+	firstDefinitions map[string]*source.Meta,
+	section string,
+	name string,
+	location *source.Meta,
+) []*DuplicateDefinition {
+	first, isDefined := firstDefinitions[name]
+	if !isDefined {
+		firstDefinitions[name] = location
+		return duplicates
+	}
+
+	return append(duplicates, &DuplicateDefinition{
+		Section:   section,
+		Name:      name,
+		First:     first,
+		Duplicate: location,
+	})
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	. "gopkg.in/check.v1"
+)
+
+type DuplicatesTestSuite struct{}
+
+var _ = Suite(&DuplicatesTestSuite{})
+
+func (s *DuplicatesTestSuite) Test_collects_duplicate_definitions_from_yaml_document(c *C) {
+	blueprint, err := Load("__testdata/duplicates/blueprint.yml", YAMLSpecFormat)
+	c.Assert(err, IsNil)
+
+	c.Assert(blueprint.DuplicateDefinitions, DeepEquals, []*DuplicateDefinition{
+		{
+			Section:   "variables",
+			Name:      "region",
+			First:     &source.Meta{Position: source.Position{Line: 3, Column: 3}},
+			Duplicate: &source.Meta{Position: source.Position{Line: 5, Column: 3}},
+		},
+		{
+			Section:   "resources",
+			Name:      "ordersQueue",
+			First:     &source.Meta{Position: source.Position{Line: 8, Column: 3}},
+			Duplicate: &source.Meta{Position: source.Position{Line: 12, Column: 3}},
+		},
+	})
+}
+
+func (s *DuplicatesTestSuite) Test_collects_duplicate_definitions_from_jwcc_document(c *C) {
+	blueprint, err := Load("__testdata/duplicates/blueprint.jsonc", JWCCSpecFormat)
+	c.Assert(err, IsNil)
+
+	c.Assert(blueprint.DuplicateDefinitions, DeepEquals, []*DuplicateDefinition{
+		{
+			Section:   "variables",
+			Name:      "region",
+			First:     &source.Meta{Position: source.Position{Line: 5, Column: 16}},
+			Duplicate: &source.Meta{Position: source.Position{Line: 6, Column: 16}},
+		},
+		{
+			Section:   "resources",
+			Name:      "ordersQueue",
+			First:     &source.Meta{Position: source.Position{Line: 9, Column: 21}},
+			Duplicate: &source.Meta{Position: source.Position{Line: 11, Column: 21}},
+		},
+	})
+}
+
+func (s *DuplicatesTestSuite) Test_collects_duplicate_definitions_from_hcl_document(c *C) {
+	blueprint, err := Load("__testdata/duplicates/blueprint.bp.hcl", HCLSpecFormat)
+	c.Assert(err, IsNil)
+
+	c.Assert(blueprint.DuplicateDefinitions, DeepEquals, []*DuplicateDefinition{
+		{
+			Section:   "variables",
+			Name:      "region",
+			First:     &source.Meta{Position: source.Position{Line: 4, Column: 20}},
+			Duplicate: &source.Meta{Position: source.Position{Line: 5, Column: 20}},
+		},
+		{
+			Section:   "resources",
+			Name:      "ordersQueue",
+			First:     &source.Meta{Position: source.Position{Line: 7, Column: 25}},
+			Duplicate: &source.Meta{Position: source.Position{Line: 17, Column: 25}},
+		},
+	})
+	c.Assert(blueprint.Variables.SourceMeta["region"], DeepEquals, &source.Meta{
+		Position:    source.Position{Line: 5, Column: 20},
+		EndPosition: &source.Position{Line: 5, Column: 38},
+	})
+}
+
+func (s *DuplicatesTestSuite) Test_produces_empty_list_for_document_without_duplicates(c *C) {
+	blueprint, err := Load("__testdata/diff/blueprint-old.yml", YAMLSpecFormat)
+	c.Assert(err, IsNil)
+	c.Assert(blueprint.DuplicateDefinitions, HasLen, 0)
+}
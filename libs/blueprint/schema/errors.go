@@ -2,7 +2,10 @@ package schema
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
@@ -47,6 +50,14 @@ const (
 	// when the reason for a blueprint schema load error is due
 	// to an invalid dependsOn field value being provided for a resource.
 	ErrorSchemaReasonCodeInvalidDependencyType ErrorSchemaReasonCode = "invalid_dependency_type"
+	// ErrorSchemaReasonCodeInvalidIgnoreChangesType is provided
+	// when the reason for a blueprint schema load error is due
+	// to an invalid ignoreChanges field value being provided for a resource.
+	ErrorSchemaReasonCodeInvalidIgnoreChangesType ErrorSchemaReasonCode = "invalid_ignore_changes_type"
+	// ErrorSchemaReasonCodeInvalidFunctionParameterType is provided
+	// when the reason for a blueprint schema load error is due
+	// to an invalid parameters field value being provided for a user-defined function.
+	ErrorSchemaReasonCodeInvalidFunctionParameterType ErrorSchemaReasonCode = "invalid_function_parameter_type"
 	// ErrorSchemaReasonCodeInvalidStringList is provided
 	// when the reason for a blueprint schema load error is due
 	// to an invalid string list value being provided (e.g., linkSelector.exclude).
@@ -71,6 +82,30 @@ const (
 	// for a blueprint schema load error is not specific,
 	// primarily used for errors wrapped with parent scope line information.
 	ErrorSchemaReasonCodeGeneral ErrorSchemaReasonCode = "general"
+	// ErrorSchemaReasonCodeMigrationUnsupportedFormat is provided when the reason
+	// for a blueprint migration error is due to the document being in a format
+	// that does not support migrations that preserve comments and formatting.
+	ErrorSchemaReasonCodeMigrationUnsupportedFormat ErrorSchemaReasonCode = "migration_unsupported_format"
+	// ErrorSchemaReasonCodeMigrationInvalidDocument is provided when the reason
+	// for a blueprint migration error is due to the document not being a valid
+	// YAML or JWCC document with a mapping at the root.
+	ErrorSchemaReasonCodeMigrationInvalidDocument ErrorSchemaReasonCode = "migration_invalid_document"
+	// ErrorSchemaReasonCodeMigrationMissingVersion is provided when the reason
+	// for a blueprint migration error is due to the document not having
+	// a version to migrate from.
+	ErrorSchemaReasonCodeMigrationMissingVersion ErrorSchemaReasonCode = "migration_missing_version"
+	// ErrorSchemaReasonCodeNoMigrationPath is provided when the reason
+	// for a blueprint migration error is due to there being no chain of
+	// migrations from the document version to the target version.
+	ErrorSchemaReasonCodeNoMigrationPath ErrorSchemaReasonCode = "no_migration_path"
+	// ErrorSchemaReasonCodeMigrationFailed is provided when the reason
+	// for a blueprint migration error is due to a migration failing
+	// to rewrite a document.
+	ErrorSchemaReasonCodeMigrationFailed ErrorSchemaReasonCode = "migration_failed"
+	// ErrorSchemaReasonCodeInvalidHCLDocument is provided when the reason
+	// for a blueprint schema load error is due to an HCL document that can not
+	// be parsed or that uses HCL syntax that can not be mapped to a blueprint.
+	ErrorSchemaReasonCodeInvalidHCLDocument ErrorSchemaReasonCode = "invalid_hcl_document"
 )
 
 func errInvalidTransformType(underlyingError error, line *int, column *int) error {
@@ -97,6 +132,30 @@ func errInvalidDependencyType(underlyingError error, line *int, column *int) err
 	}
 }
 
+func errInvalidIgnoreChangesType(underlyingError error, line *int, column *int) error {
+	return &Error{
+		ReasonCode: ErrorSchemaReasonCodeInvalidIgnoreChangesType,
+		Err: fmt.Errorf(
+			"unsupported type provided for resource ignoreChanges, must be string or a list of strings: %s",
+			underlyingError.Error(),
+		),
+		SourceLine:   line,
+		SourceColumn: column,
+	}
+}
+
+func errInvalidFunctionParameterType(underlyingError error, line *int, column *int) error {
+	return &Error{
+		ReasonCode: ErrorSchemaReasonCodeInvalidFunctionParameterType,
+		Err: fmt.Errorf(
+			"unsupported type provided for function parameters, must be string or a list of strings: %s",
+			underlyingError.Error(),
+		),
+		SourceLine:   line,
+		SourceColumn: column,
+	}
+}
+
 func errInvalidMap(posInfo source.PositionInfo, field string) error {
 	innerError := fmt.Errorf("an invalid value has been provided for %s, expected a mapping", field)
 	if posInfo == nil {
@@ -210,3 +269,189 @@ func wrapErrorWithLineInfo(underlyingError error, parent *yaml.Node) error {
 		SourceColumn: &parent.Column,
 	}
 }
+
+func errMigrationUnsupportedFormat(format SpecFormat) error {
+	return &Error{
+		ReasonCode: ErrorSchemaReasonCodeMigrationUnsupportedFormat,
+		Err: fmt.Errorf(
+			"blueprint migrations are not supported for the %q format, "+
+				"only YAML and JWCC documents can be migrated",
+			format,
+		),
+	}
+}
+
+func errMigrationInvalidDocument(underlyingError error) error {
+	return &Error{
+		ReasonCode: ErrorSchemaReasonCodeMigrationInvalidDocument,
+		Err: fmt.Errorf(
+			"failed to load blueprint document for migration: %s",
+			underlyingError.Error(),
+		),
+	}
+}
+
+func errMigrationMissingVersion() error {
+	return &Error{
+		ReasonCode: ErrorSchemaReasonCodeMigrationMissingVersion,
+		Err: fmt.Errorf(
+			"blueprint document can not be migrated as it does not have a version, " +
+				"the version must be set to the spec version that the document was written for",
+		),
+	}
+}
+
+func errNoMigrationPath(fromVersion string, toVersion string) error {
+	return &Error{
+		ReasonCode: ErrorSchemaReasonCodeNoMigrationPath,
+		Err: fmt.Errorf(
+			"no migration path found from blueprint spec version %q to %q",
+			fromVersion,
+			toVersion,
+		),
+	}
+}
+
+func errMigrationFailed(migration *Migration, underlyingError error) error {
+	return &Error{
+		ReasonCode: ErrorSchemaReasonCodeMigrationFailed,
+		Err: fmt.Errorf(
+			"failed to migrate blueprint document from version %q to %q: %s",
+			migration.FromVersion,
+			migration.ToVersion,
+			underlyingError.Error(),
+		),
+	}
+}
+
+func errMigrationEmptyPath() error {
+	return fmt.Errorf("a non-empty path must be provided to modify a field in a blueprint document")
+}
+
+func errMigrationFieldNotFound(path []string) error {
+	return fmt.Errorf("field %q not found in blueprint document", strings.Join(path, "."))
+}
+
+func errMigrationFieldExists(path []string, newName string) error {
+	return fmt.Errorf(
+		"field %q can not be renamed to %q as a field with that name already exists",
+		strings.Join(path, "."),
+		newName,
+	)
+}
+
+func errMigrationNotMapping(path []string) error {
+	return fmt.Errorf("field %q in blueprint document is not a mapping", strings.Join(path, "."))
+}
+
+func errInvalidHCLSyntax(diags hcl.Diagnostics) error {
+	for _, diag := range diags {
+		if diag.Severity != hcl.DiagError {
+			continue
+		}
+
+		innerError := fmt.Errorf("invalid HCL syntax: %s", diag.Summary)
+		if diag.Detail != "" {
+			innerError = fmt.Errorf("invalid HCL syntax: %s; %s", diag.Summary, diag.Detail)
+		}
+		if diag.Subject == nil {
+			return &Error{
+				ReasonCode: ErrorSchemaReasonCodeInvalidHCLDocument,
+				Err:        innerError,
+			}
+		}
+
+		return errHCLDocumentAtRange(*diag.Subject, innerError)
+	}
+
+	return &Error{
+		ReasonCode: ErrorSchemaReasonCodeInvalidHCLDocument,
+		Err:        diags,
+	}
+}
+
+func errHCLEmptyDocument() error {
+	return &Error{
+		ReasonCode: ErrorSchemaReasonCodeInvalidHCLDocument,
+		Err:        fmt.Errorf("blueprint HCL document is empty"),
+	}
+}
+
+func errHCLInvalidBlockLabels(block *hclsyntax.Block, expected string) error {
+	return errHCLDocumentAtRange(
+		block.TypeRange,
+		fmt.Errorf(
+			"the %q block has %d label(s), expected %s",
+			block.Type,
+			len(block.Labels),
+			expected,
+		),
+	)
+}
+
+func errHCLBlockConflict(block *hclsyntax.Block, field string) error {
+	return errHCLDocumentAtRange(
+		block.TypeRange,
+		fmt.Errorf(
+			"the %q block can not be used as %q is already defined as an attribute",
+			block.Type,
+			field,
+		),
+	)
+}
+
+func errHCLUnsupportedExpression(exprRange hcl.Range, src []byte) error {
+	snippet := hclRangeSnippet(exprRange, src)
+	return errHCLDocumentAtRange(
+		exprRange,
+		fmt.Errorf(
+			"the expression %q is not supported, only strings, numbers, booleans, null, "+
+				"lists and objects can be used as values, "+
+				"references and function calls must be wrapped in a string substitution "+
+				"such as \"${%s}\"",
+			snippet,
+			snippet,
+		),
+	)
+}
+
+func errHCLUnsupportedValue(exprRange hcl.Range, typeName string) error {
+	return errHCLDocumentAtRange(
+		exprRange,
+		fmt.Errorf("values of type %s are not supported", typeName),
+	)
+}
+
+func errHCLDuplicateObjectKey(keyRange hcl.Range, key string) error {
+	return errHCLDocumentAtRange(
+		keyRange,
+		fmt.Errorf("the key %q is defined more than once in the same object", key),
+	)
+}
+
+func errHCLUnsupportedObjectKey(keyRange hcl.Range) error {
+	return errHCLDocumentAtRange(
+		keyRange,
+		fmt.Errorf(
+			"object keys must be identifiers or strings without substitutions",
+		),
+	)
+}
+
+func errHCLUnsupportedTemplateSequence(exprRange hcl.Range, reason string) error {
+	return errHCLDocumentAtRange(
+		exprRange,
+		fmt.Errorf("unsupported string template, %s", reason),
+	)
+}
+
+func errHCLDocumentAtRange(errRange hcl.Range, innerError error) error {
+	line := errRange.Start.Line
+	col := errRange.Start.Column
+	return &Error{
+		ReasonCode:   ErrorSchemaReasonCodeInvalidHCLDocument,
+		Err:          innerError,
+		SourceLine:   &line,
+		SourceColumn: &col,
+	}
+}
@@ -0,0 +1,148 @@
+package schema
+
+import (
+	json "github.com/coreos/go-json"
+
+	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"gopkg.in/yaml.v3"
+)
+
+// Function provides the definition of a user-defined function
+// that can be called in substitutions throughout a blueprint.
+//
+// The value of a function is a single substitution that can
+// reference the function parameters by name, for example,
+// `${join(list(variables.environment, service), "-")}` for a function
+// with a "service" parameter.
+// Calls to user-defined functions are expanded in place with the
+// provided arguments before the rest of the blueprint is validated,
+// parameters shadow resources with the same name in the function value.
+type Function struct {
+	Description *bpcore.ScalarValue                  `yaml:"description,omitempty" json:"description,omitempty"`
+	Parameters  *FunctionParameterList               `yaml:"parameters,omitempty" json:"parameters,omitempty"`
+	Value       *substitutions.StringOrSubstitutions `yaml:"value" json:"value"`
+	SourceMeta  *source.Meta                         `yaml:"-" json:"-"`
+}
+
+func (f *Function) UnmarshalYAML(value *yaml.Node) error {
+	f.SourceMeta = &source.Meta{
+		Position: source.Position{
+			Line:   value.Line,
+			Column: value.Column,
+		},
+	}
+
+	type functionAlias Function
+	var alias functionAlias
+	if err := value.Decode(&alias); err != nil {
+		return wrapErrorWithLineInfo(err, value)
+	}
+
+	f.Description = alias.Description
+	f.Parameters = alias.Parameters
+	f.Value = alias.Value
+
+	return nil
+}
+
+func (f *Function) FromJSONNode(node *json.Node, linePositions []int, parentPath string) error {
+	nodeMap, ok := node.Value.(map[string]json.Node)
+	if !ok {
+		position := source.PositionFromJSONNode(node, linePositions)
+		return errInvalidMap(&position, parentPath)
+	}
+
+	f.Description = &bpcore.ScalarValue{}
+	err := bpcore.UnpackValueFromJSONMapNode(
+		nodeMap,
+		"description",
+		f.Description,
+		linePositions,
+		/* parentPath */ parentPath,
+		/* parentIsRoot */ false,
+		/* required */ false,
+	)
+	if err != nil {
+		return err
+	}
+
+	f.Parameters = &FunctionParameterList{}
+	err = bpcore.UnpackValueFromJSONMapNode(
+		nodeMap,
+		"parameters",
+		f.Parameters,
+		linePositions,
+		/* parentPath */ parentPath,
+		/* parentIsRoot */ false,
+		/* required */ false,
+	)
+	if err != nil {
+		return err
+	}
+
+	f.Value = &substitutions.StringOrSubstitutions{}
+	err = bpcore.UnpackValueFromJSONMapNode(
+		nodeMap,
+		"value",
+		f.Value,
+		linePositions,
+		/* parentPath */ parentPath,
+		/* parentIsRoot */ false,
+		/* required */ true,
+	)
+	if err != nil {
+		return err
+	}
+
+	f.SourceMeta = source.ExtractSourcePositionFromJSONNode(
+		node,
+		linePositions,
+	)
+
+	return nil
+}
+
+// ParameterNames returns the names of the parameters of the function
+// in the order they are defined.
+func (f *Function) ParameterNames() []string {
+	if f.Parameters == nil {
+		return []string{}
+	}
+
+	return f.Parameters.Values
+}
+
+// FunctionParameterList provides a list of parameter names
+// for a user-defined function.
+// This can include extra information about the locations of
+// parameters in the list in the original source,
+// depending on the source format.
+type FunctionParameterList struct {
+	StringList
+}
+
+func (t *FunctionParameterList) MarshalYAML() (any, error) {
+	return t.StringList.MarshalYAML()
+}
+
+func (t *FunctionParameterList) UnmarshalYAML(value *yaml.Node) error {
+	return t.StringList.unmarshalYAML(value, errInvalidFunctionParameterType, "function parameter")
+}
+
+func (t *FunctionParameterList) MarshalJSON() ([]byte, error) {
+	return t.StringList.MarshalJSON()
+}
+
+func (t *FunctionParameterList) UnmarshalJSON(data []byte) error {
+	return t.unmarshalJSON(data, errInvalidFunctionParameterType, "function parameter")
+}
+
+func (t *FunctionParameterList) FromJSONNode(
+	node *json.Node,
+	linePositions []int,
+	parentPath string,
+) error {
+	return t.StringList.FromJSONNode(node, linePositions, parentPath)
+}
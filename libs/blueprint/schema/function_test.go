@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	. "gopkg.in/check.v1"
+	"gopkg.in/yaml.v3"
+)
+
+type FunctionTestSuite struct{}
+
+var _ = Suite(&FunctionTestSuite{})
+
+func (s *FunctionTestSuite) Test_parses_valid_functions_yaml_input(c *C) {
+	blueprint, err := LoadString(`
+version: 2025-11-02
+resources: {}
+functions:
+  resourceName:
+    description: Derives a resource name for a service in the current environment.
+    parameters: [service, suffix]
+    value: "${join(list(variables.environment, service, suffix), \"-\")}"
+`, YAMLSpecFormat)
+	c.Assert(err, IsNil)
+	c.Assert(blueprint.Functions, NotNil)
+	c.Assert(blueprint.Functions.Values, HasLen, 1)
+	c.Assert(blueprint.Functions.SourceMeta["resourceName"].Line, Equals, 5)
+
+	function := blueprint.Functions.Values["resourceName"]
+	c.Assert(
+		core.StringValueFromScalar(function.Description),
+		Equals,
+		"Derives a resource name for a service in the current environment.",
+	)
+	c.Assert(function.ParameterNames(), DeepEquals, []string{"service", "suffix"})
+	c.Assert(function.Parameters.SourceMeta, HasLen, 2)
+	c.Assert(function.Value.Values, HasLen, 1)
+	c.Assert(function.Value.Values[0].SubstitutionValue.Function, NotNil)
+	c.Assert(function.SourceMeta.Line, Equals, 6)
+}
+
+func (s *FunctionTestSuite) Test_parses_valid_functions_jwcc_input(c *C) {
+	blueprint, err := LoadString(`{
+	"version": "2025-11-02",
+	"resources": {},
+	"functions": {
+		// Derives the name of a table for a service.
+		"tableName": {
+			"parameters": "service",
+			"value": "${join(list(service, \"table\"), \"-\")}"
+		}
+	}
+}`, JWCCSpecFormat)
+	c.Assert(err, IsNil)
+	c.Assert(blueprint.Functions, NotNil)
+	c.Assert(blueprint.Functions.Values, HasLen, 1)
+
+	function := blueprint.Functions.Values["tableName"]
+	c.Assert(function.ParameterNames(), DeepEquals, []string{"service"})
+	c.Assert(function.Value.Values, HasLen, 1)
+	c.Assert(function.Value.Values[0].SubstitutionValue.Function, NotNil)
+	c.Assert(function.SourceMeta, NotNil)
+}
+
+func (s *FunctionTestSuite) Test_returns_empty_parameter_names_for_function_without_parameters(c *C) {
+	function := &Function{}
+	c.Assert(function.ParameterNames(), DeepEquals, []string{})
+}
+
+func (s *FunctionTestSuite) Test_fails_to_parse_function_parameters_that_are_not_a_list(c *C) {
+	targetFunction := &Function{}
+	err := yaml.Unmarshal(
+		[]byte("parameters:\n  service: string\nvalue: \"${service}\"\n"),
+		targetFunction,
+	)
+	c.Assert(err, NotNil)
+	schemaErr, isSchemaErr := err.(*Error)
+	c.Assert(isSchemaErr, Equals, true)
+	c.Assert(schemaErr.ReasonCode, Equals, ErrorSchemaReasonCodeInvalidFunctionParameterType)
+}
@@ -0,0 +1,719 @@
+package schema
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	json "github.com/coreos/go-json"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// HCL documents are converted to the same tree of JSON nodes that is produced
+// when parsing a JWCC document, with offsets into the HCL source, so that the
+// blueprint is populated by the same unmarshalling code and the source
+// positions derived from the offsets point to the HCL source.
+//
+// A blueprint HCL document looks like the following:
+//
+//	version = "2025-11-02"
+//
+//	variable "environment" {
+//	  type = "string"
+//	}
+//
+//	resource "ordersTable" {
+//	  type = "aws/dynamodb/table"
+//
+//	  metadata {
+//	    displayName = "Orders Table"
+//	  }
+//
+//	  spec {
+//	    tableName = "orders-${variables.environment}"
+//	  }
+//	}
+//
+// Named elements are defined with blocks labelled with the element name,
+// any other block is a mapping, repeated blocks of the same type without
+// labels are a sequence of mappings and blocks with a single label are
+// a mapping of labels to the block contents.
+// Substitutions are only supported in strings as they are for YAML and JWCC
+// documents, the contents of "${..}" are passed through to the substitution
+// parser as written.
+
+// Maps the block types that define named elements in a blueprint HCL
+// document to the top-level sections of a blueprint.
+var hclElementBlockSections = map[string]string{
+	"variable":   "variables",
+	"value":      "values",
+	"function":   "functions",
+	"include":    "include",
+	"resource":   "resources",
+	"datasource": "datasources",
+	"export":     "exports",
+	"link":       "links",
+}
+
+const (
+	// The block type for hooks in a blueprint HCL document,
+	// every hook block is an item in the top-level hooks sequence.
+	hclHookBlockType = "hook"
+	hclHooksSection  = "hooks"
+)
+
+func loadHCLFromFile(specFilePath string) (*Blueprint, error) {
+	contents, err := os.ReadFile(specFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	blueprint := &Blueprint{}
+	err = unmarshalHCL(contents, specFilePath, blueprint)
+	if err != nil {
+		return nil, err
+	}
+	blueprint.Suppressions = ExtractSuppressions(string(contents))
+	blueprint.DuplicateDefinitions = ExtractDuplicateDefinitions(string(contents), HCLSpecFormat)
+
+	return blueprint, nil
+}
+
+func unmarshalHCL(contents []byte, fileName string, blueprint *Blueprint) error {
+	if len(strings.TrimSpace(string(contents))) == 0 {
+		return errHCLEmptyDocument()
+	}
+
+	body, err := parseHCLBody(contents, fileName)
+	if err != nil {
+		return err
+	}
+
+	rootNode, err := hclBlueprintBodyToJSONNode(body, contents)
+	if err != nil {
+		return err
+	}
+
+	return populateBlueprintFromJSONNode(string(contents), rootNode, blueprint)
+}
+
+func parseHCLBody(contents []byte, fileName string) (*hclsyntax.Body, error) {
+	file, diags := hclsyntax.ParseConfig(contents, fileName, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, errInvalidHCLSyntax(diags)
+	}
+
+	return file.Body.(*hclsyntax.Body), nil
+}
+
+func hclBlueprintBodyToJSONNode(body *hclsyntax.Body, src []byte) (*json.Node, error) {
+	rootMap, err := hclAttributesToJSONMap(body.Attributes, src)
+	if err != nil {
+		return nil, err
+	}
+
+	nestedBlocks := []*hclsyntax.Block{}
+	for _, block := range body.Blocks {
+		section, isElementBlock := hclElementBlockSections[block.Type]
+		if block.Type == hclHookBlockType {
+			section = hclHooksSection
+		} else if !isElementBlock {
+			nestedBlocks = append(nestedBlocks, block)
+			continue
+		}
+
+		if _, isAttribute := body.Attributes[section]; isAttribute {
+			return nil, errHCLBlockConflict(block, section)
+		}
+
+		if isElementBlock {
+			err = addHCLElementBlock(rootMap, section, block, src)
+		} else {
+			err = addHCLHookBlock(rootMap, block, src)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = addHCLNestedBlocks(rootMap, nestedBlocks, src)
+	if err != nil {
+		return nil, err
+	}
+
+	return &json.Node{
+		Start: 0,
+		End:   len(src) - 1,
+		Value: rootMap,
+	}, nil
+}
+
+func addHCLElementBlock(
+	rootMap map[string]json.Node,
+	section string,
+	block *hclsyntax.Block,
+	src []byte,
+) error {
+	if len(block.Labels) != 1 {
+		return errHCLInvalidBlockLabels(block, "exactly one label for the element name")
+	}
+
+	sectionNode, isDefined := rootMap[section]
+	if !isDefined {
+		sectionNode = json.Node{
+			Start:    block.TypeRange.Start.Byte,
+			KeyStart: block.TypeRange.Start.Byte,
+			KeyEnd:   block.TypeRange.End.Byte,
+			Value:    map[string]json.Node{},
+		}
+	}
+
+	elementNode, err := hclBlockToJSONNode(block, block.LabelRanges[0], src)
+	if err != nil {
+		return err
+	}
+	// When an element is defined more than once, the last definition is kept
+	// in the same way as duplicate keys in YAML and JWCC documents,
+	// duplicates are reported by validation.
+	sectionNode.Value.(map[string]json.Node)[block.Labels[0]] = elementNode
+	sectionNode.End = block.CloseBraceRange.Start.Byte
+	rootMap[section] = sectionNode
+
+	return nil
+}
+
+func addHCLHookBlock(
+	rootMap map[string]json.Node,
+	block *hclsyntax.Block,
+	src []byte,
+) error {
+	if len(block.Labels) != 0 {
+		return errHCLInvalidBlockLabels(block, "no labels")
+	}
+
+	hooksNode, isDefined := rootMap[hclHooksSection]
+	if !isDefined {
+		hooksNode = json.Node{
+			Start:    block.TypeRange.Start.Byte,
+			KeyStart: block.TypeRange.Start.Byte,
+			KeyEnd:   block.TypeRange.End.Byte,
+			Value:    []json.Node{},
+		}
+	}
+
+	hookNode, err := hclBlockToJSONNode(block, block.TypeRange, src)
+	if err != nil {
+		return err
+	}
+	hooksNode.Value = append(hooksNode.Value.([]json.Node), hookNode)
+	hooksNode.End = block.CloseBraceRange.Start.Byte
+	rootMap[hclHooksSection] = hooksNode
+
+	return nil
+}
+
+func hclBodyToJSONMap(body *hclsyntax.Body, src []byte) (map[string]json.Node, error) {
+	nodeMap, err := hclAttributesToJSONMap(body.Attributes, src)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addHCLNestedBlocks(nodeMap, body.Blocks, src)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeMap, nil
+}
+
+func hclAttributesToJSONMap(
+	attributes hclsyntax.Attributes,
+	src []byte,
+) (map[string]json.Node, error) {
+	// Attributes are converted in document order so that the first
+	// error in the document is reported.
+	orderedAttributes := make([]*hclsyntax.Attribute, 0, len(attributes))
+	for _, attribute := range attributes {
+		orderedAttributes = append(orderedAttributes, attribute)
+	}
+	slices.SortFunc(orderedAttributes, func(a, b *hclsyntax.Attribute) int {
+		return a.NameRange.Start.Byte - b.NameRange.Start.Byte
+	})
+
+	nodeMap := map[string]json.Node{}
+	for _, attribute := range orderedAttributes {
+		node, err := hclExprToJSONNode(attribute.Expr, src)
+		if err != nil {
+			return nil, err
+		}
+		node.KeyStart = attribute.NameRange.Start.Byte
+		node.KeyEnd = attribute.NameRange.End.Byte
+		nodeMap[attribute.Name] = node
+	}
+
+	return nodeMap, nil
+}
+
+// Adds nested blocks to a mapping, a single block without labels is a mapping,
+// repeated blocks without labels are a sequence of mappings and blocks with
+// a label are a mapping of labels to the block contents.
+func addHCLNestedBlocks(
+	nodeMap map[string]json.Node,
+	blocks []*hclsyntax.Block,
+	src []byte,
+) error {
+	blockGroups := map[string][]*hclsyntax.Block{}
+	blockTypes := []string{}
+	for _, block := range blocks {
+		if _, isAttribute := nodeMap[block.Type]; isAttribute {
+			return errHCLBlockConflict(block, block.Type)
+		}
+
+		if _, hasGroup := blockGroups[block.Type]; !hasGroup {
+			blockTypes = append(blockTypes, block.Type)
+		}
+		blockGroups[block.Type] = append(blockGroups[block.Type], block)
+	}
+
+	for _, blockType := range blockTypes {
+		node, err := hclBlockGroupToJSONNode(blockGroups[blockType], src)
+		if err != nil {
+			return err
+		}
+		nodeMap[blockType] = node
+	}
+
+	return nil
+}
+
+func hclBlockGroupToJSONNode(blocks []*hclsyntax.Block, src []byte) (json.Node, error) {
+	first := blocks[0]
+	last := blocks[len(blocks)-1]
+	if len(first.Labels) > 1 {
+		return json.Node{}, errHCLInvalidBlockLabels(first, "at most one label")
+	}
+
+	if len(blocks) == 1 && len(first.Labels) == 0 {
+		return hclBlockToJSONNode(first, first.TypeRange, src)
+	}
+
+	groupNode := json.Node{
+		Start:    first.TypeRange.Start.Byte,
+		End:      last.CloseBraceRange.Start.Byte,
+		KeyStart: first.TypeRange.Start.Byte,
+		KeyEnd:   first.TypeRange.End.Byte,
+	}
+	if len(first.Labels) == 0 {
+		items := make([]json.Node, 0, len(blocks))
+		for _, block := range blocks {
+			if len(block.Labels) != 0 {
+				return json.Node{}, errHCLInvalidBlockLabels(block, "no labels")
+			}
+			item, err := hclBlockToJSONNode(block, block.TypeRange, src)
+			if err != nil {
+				return json.Node{}, err
+			}
+			items = append(items, item)
+		}
+		groupNode.Value = items
+		return groupNode, nil
+	}
+
+	items := map[string]json.Node{}
+	for _, block := range blocks {
+		if len(block.Labels) != 1 {
+			return json.Node{}, errHCLInvalidBlockLabels(block, "exactly one label")
+		}
+		item, err := hclBlockToJSONNode(block, block.LabelRanges[0], src)
+		if err != nil {
+			return json.Node{}, err
+		}
+		items[block.Labels[0]] = item
+	}
+	groupNode.Value = items
+	return groupNode, nil
+}
+
+func hclBlockToJSONNode(
+	block *hclsyntax.Block,
+	keyRange hcl.Range,
+	src []byte,
+) (json.Node, error) {
+	nodeMap, err := hclBodyToJSONMap(block.Body, src)
+	if err != nil {
+		return json.Node{}, err
+	}
+
+	// Offsets for mappings are consistent with the JSON parser,
+	// which starts a mapping after the opening brace and ends it
+	// at the closing brace.
+	return json.Node{
+		Start:    block.OpenBraceRange.Start.Byte + 1,
+		End:      block.CloseBraceRange.Start.Byte,
+		KeyStart: keyRange.Start.Byte,
+		KeyEnd:   keyRange.End.Byte,
+		Value:    nodeMap,
+	}, nil
+}
+
+func hclExprToJSONNode(expr hclsyntax.Expression, src []byte) (json.Node, error) {
+	exprRange := expr.Range()
+	switch typedExpr := expr.(type) {
+	case *hclsyntax.TemplateExpr, *hclsyntax.TemplateWrapExpr:
+		return hclTemplateToJSONNode(exprRange, src)
+	case *hclsyntax.LiteralValueExpr:
+		return hclLiteralToJSONNode(typedExpr.Val, exprRange)
+	case *hclsyntax.UnaryOpExpr:
+		literal, isLiteral := typedExpr.Val.(*hclsyntax.LiteralValueExpr)
+		if typedExpr.Op == hclsyntax.OpNegate && isLiteral &&
+			literal.Val.Type() == cty.Number {
+			return hclLiteralToJSONNode(literal.Val.Negate(), exprRange)
+		}
+	case *hclsyntax.TupleConsExpr:
+		return hclTupleToJSONNode(typedExpr, src)
+	case *hclsyntax.ObjectConsExpr:
+		return hclObjectToJSONNode(typedExpr, src)
+	}
+
+	return json.Node{}, errHCLUnsupportedExpression(exprRange, src)
+}
+
+func hclLiteralToJSONNode(value cty.Value, exprRange hcl.Range) (json.Node, error) {
+	node := json.Node{
+		Start: exprRange.Start.Byte,
+		End:   exprRange.End.Byte - 1,
+	}
+	if value.IsNull() {
+		return node, nil
+	}
+
+	switch value.Type() {
+	case cty.String:
+		node.Value = value.AsString()
+	case cty.Bool:
+		node.Value = value.True()
+	case cty.Number:
+		// Numbers are represented as 64-bit floating point numbers
+		// to be consistent with the JSON parser.
+		node.Value, _ = value.AsBigFloat().Float64()
+	default:
+		return json.Node{}, errHCLUnsupportedValue(exprRange, value.Type().FriendlyName())
+	}
+
+	return node, nil
+}
+
+func hclTupleToJSONNode(expr *hclsyntax.TupleConsExpr, src []byte) (json.Node, error) {
+	items := make([]json.Node, 0, len(expr.Exprs))
+	for _, itemExpr := range expr.Exprs {
+		item, err := hclExprToJSONNode(itemExpr, src)
+		if err != nil {
+			return json.Node{}, err
+		}
+		items = append(items, item)
+	}
+
+	exprRange := expr.Range()
+	return json.Node{
+		Start: exprRange.Start.Byte + 1,
+		End:   exprRange.End.Byte - 1,
+		Value: items,
+	}, nil
+}
+
+func hclObjectToJSONNode(expr *hclsyntax.ObjectConsExpr, src []byte) (json.Node, error) {
+	nodeMap := map[string]json.Node{}
+	for _, item := range expr.Items {
+		key, err := hclObjectKey(item.KeyExpr, src)
+		if err != nil {
+			return json.Node{}, err
+		}
+
+		keyRange := item.KeyExpr.Range()
+		if _, isDefined := nodeMap[key]; isDefined {
+			return json.Node{}, errHCLDuplicateObjectKey(keyRange, key)
+		}
+
+		value, err := hclExprToJSONNode(item.ValueExpr, src)
+		if err != nil {
+			return json.Node{}, err
+		}
+		value.KeyStart = keyRange.Start.Byte
+		value.KeyEnd = keyRange.End.Byte
+		nodeMap[key] = value
+	}
+
+	exprRange := expr.Range()
+	return json.Node{
+		Start: exprRange.Start.Byte + 1,
+		End:   exprRange.End.Byte - 1,
+		Value: nodeMap,
+	}, nil
+}
+
+func hclObjectKey(keyExpr hclsyntax.Expression, src []byte) (string, error) {
+	wrapped := keyExpr
+	if consKeyExpr, isConsKey := keyExpr.(*hclsyntax.ObjectConsKeyExpr); isConsKey {
+		wrapped = consKeyExpr.Wrapped
+	}
+
+	// A bare identifier used as a key is the key itself
+	// and not a reference to be resolved.
+	if name := hcl.ExprAsKeyword(wrapped); name != "" {
+		return name, nil
+	}
+
+	keyRange := wrapped.Range()
+	if _, isTemplate := wrapped.(*hclsyntax.TemplateExpr); isTemplate {
+		key, err := hclTemplateValue(keyRange, src)
+		if err != nil {
+			return "", err
+		}
+		if !strings.Contains(key, "${") {
+			return key, nil
+		}
+	}
+
+	return "", errHCLUnsupportedObjectKey(keyRange)
+}
+
+func hclTemplateToJSONNode(exprRange hcl.Range, src []byte) (json.Node, error) {
+	value, err := hclTemplateValue(exprRange, src)
+	if err != nil {
+		return json.Node{}, err
+	}
+
+	raw := string(src[exprRange.Start.Byte:exprRange.End.Byte])
+	start := exprRange.Start.Byte
+	if isHCLHeredoc(raw) {
+		// The first character of the heredoc content is used as the start
+		// of the string so that positions of substitutions are on the correct
+		// lines, columns on the first line of the content are approximate
+		// as there is no opening quote to account for.
+		start += strings.Index(raw, "\n") + 1
+	}
+
+	return json.Node{
+		Start: start,
+		End:   exprRange.End.Byte - 1,
+		Value: value,
+	}, nil
+}
+
+// Derives a string value from the raw source of a quoted string or heredoc,
+// substitutions are taken from the raw source instead of the parsed template
+// so that they are evaluated by the blueprint substitution parser.
+func hclTemplateValue(exprRange hcl.Range, src []byte) (string, error) {
+	raw := string(src[exprRange.Start.Byte:exprRange.End.Byte])
+	if !isHCLHeredoc(raw) {
+		return decodeHCLTemplateContent(
+			raw[1:len(raw)-1],
+			/* processEscapes */ true,
+			exprRange,
+		)
+	}
+
+	contentStart := strings.Index(raw, "\n") + 1
+	contentEnd := strings.LastIndex(raw, "\n") + 1
+	content := ""
+	if contentEnd > contentStart {
+		content = raw[contentStart:contentEnd]
+	}
+	if strings.HasPrefix(raw, "<<-") {
+		content = stripHCLHeredocIndentation(content)
+	}
+
+	return decodeHCLTemplateContent(
+		content,
+		/* processEscapes */ false,
+		exprRange,
+	)
+}
+
+func isHCLHeredoc(raw string) bool {
+	return strings.HasPrefix(raw, "<<")
+}
+
+func decodeHCLTemplateContent(
+	content string,
+	processEscapes bool,
+	exprRange hcl.Range,
+) (string, error) {
+	var builder strings.Builder
+	i := 0
+	for i < len(content) {
+		remaining := content[i:]
+		switch {
+		case strings.HasPrefix(remaining, "$${"):
+			return "", errHCLUnsupportedTemplateSequence(
+				exprRange,
+				"literal \"${\" sequences can not be used in blueprint strings",
+			)
+		case strings.HasPrefix(remaining, "%%{"):
+			builder.WriteString("%{")
+			i += 3
+		case strings.HasPrefix(remaining, "%{"):
+			return "", errHCLUnsupportedTemplateSequence(
+				exprRange,
+				"template directives are not supported, "+
+					"use substitution functions such as \"if\" instead",
+			)
+		case strings.HasPrefix(remaining, "${"):
+			end := hclInterpolationEnd(content, i+2)
+			builder.WriteString(content[i:end])
+			i = end
+		case processEscapes && remaining[0] == '\\':
+			decoded, width := decodeHCLEscapeSequence(remaining)
+			builder.WriteString(decoded)
+			i += width
+		default:
+			builder.WriteByte(content[i])
+			i += 1
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// Finds the offset after the closing brace of an interpolation
+// starting at the provided offset, string literals in the
+// interpolation can contain braces.
+func hclInterpolationEnd(content string, start int) int {
+	depth := 1
+	inStringLiteral := false
+	i := start
+	for i < len(content) {
+		char := content[i]
+		switch {
+		case inStringLiteral && char == '\\':
+			i += 1
+		case char == '"':
+			inStringLiteral = !inStringLiteral
+		case !inStringLiteral && char == '{':
+			depth += 1
+		case !inStringLiteral && char == '}':
+			depth -= 1
+			if depth == 0 {
+				return i + 1
+			}
+		}
+		i += 1
+	}
+
+	return len(content)
+}
+
+// Decodes an escape sequence at the start of the provided string,
+// returning the decoded value and the number of bytes consumed.
+// The parser has already checked that escape sequences are valid.
+func decodeHCLEscapeSequence(value string) (string, int) {
+	if len(value) < 2 {
+		return value, len(value)
+	}
+
+	switch value[1] {
+	case 'n':
+		return "\n", 2
+	case 'r':
+		return "\r", 2
+	case 't':
+		return "\t", 2
+	case 'u', 'U':
+		digitCount := 4
+		if value[1] == 'U' {
+			digitCount = 8
+		}
+		if len(value) >= 2+digitCount {
+			codePoint, err := strconv.ParseUint(value[2:2+digitCount], 16, 32)
+			if err == nil {
+				return string(rune(codePoint)), 2 + digitCount
+			}
+		}
+	}
+
+	return value[1:2], 2
+}
+
+// Removes the shortest leading whitespace shared by all the non-empty lines
+// of a flush heredoc (<<-) as per the HCL specification.
+func stripHCLHeredocIndentation(content string) string {
+	lines := strings.SplitAfter(content, "\n")
+	minIndent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if minIndent == -1 || indent < minIndent {
+			minIndent = indent
+		}
+	}
+
+	if minIndent <= 0 {
+		return content
+	}
+
+	var builder strings.Builder
+	for _, line := range lines {
+		if len(line) >= minIndent && strings.TrimSpace(line[:minIndent]) == "" {
+			builder.WriteString(line[minIndent:])
+		} else {
+			builder.WriteString(strings.TrimLeft(line, " \t"))
+		}
+	}
+
+	return builder.String()
+}
+
+func extractHCLDuplicateDefinitions(document string) []*DuplicateDefinition {
+	body, err := parseHCLBody([]byte(document), "")
+	if err != nil || len(strings.TrimSpace(document)) == 0 {
+		return nil
+	}
+
+	linePositions := core.LinePositionsFromSource(document)
+	firstDefinitions := map[string]map[string]*source.Meta{}
+	var duplicates []*DuplicateDefinition
+	for _, block := range body.Blocks {
+		section, isElementBlock := hclElementBlockSections[block.Type]
+		if !isElementBlock || len(block.Labels) != 1 ||
+			!slices.Contains(namedElementSections, section) {
+			continue
+		}
+
+		if _, hasSection := firstDefinitions[section]; !hasSection {
+			firstDefinitions[section] = map[string]*source.Meta{}
+		}
+		// Element locations are consistent with the source locations
+		// captured when unmarshalling a document, which start after
+		// the opening brace of the element block.
+		position := source.PositionFromOffset(
+			block.OpenBraceRange.Start.Byte+1,
+			linePositions,
+		)
+		duplicates = appendDuplicateDefinition(
+			duplicates,
+			firstDefinitions[section],
+			section,
+			block.Labels[0],
+			&source.Meta{Position: position},
+		)
+	}
+
+	return duplicates
+}
+
+func hclRangeSnippet(exprRange hcl.Range, src []byte) string {
+	snippet := string(src[exprRange.Start.Byte:exprRange.End.Byte])
+	if firstLine, _, isMultiline := strings.Cut(snippet, "\n"); isMultiline {
+		return fmt.Sprintf("%s...", firstLine)
+	}
+
+	return snippet
+}
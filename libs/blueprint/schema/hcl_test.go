@@ -0,0 +1,203 @@
+package schema
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	. "gopkg.in/check.v1"
+)
+
+type HCLTestSuite struct{}
+
+var _ = Suite(&HCLTestSuite{})
+
+const hclBlueprintWithHooksAndLinks = `version = "2025-11-02"
+
+resource "ordersQueue" {
+  type = "aws/sqs/queue"
+  spec {
+    delaySeconds = -5
+    tags = [
+      { key = "team", value = "orders" },
+    ]
+    policy = <<EOT
+{"queue": "${variables.queueName}"}
+EOT
+  }
+}
+
+link "ordersQueue::ordersTable" {
+  accessType = "read"
+}
+
+hook {
+  event = "beforeDeploy"
+  run   = "./scripts/check.sh"
+}
+
+hook {
+  event = "afterDeploy"
+  run   = "./scripts/notify.sh"
+}
+`
+
+func (s *HCLTestSuite) Test_maps_hcl_blocks_and_expressions_to_blueprint(c *C) {
+	blueprint, err := LoadString(hclBlueprintWithHooksAndLinks, HCLSpecFormat)
+	c.Assert(err, IsNil)
+
+	c.Assert(blueprint.Hooks.Values, HasLen, 2)
+	c.Assert(core.StringValueFromScalar(blueprint.Hooks.Values[0].Event), Equals, "beforeDeploy")
+	c.Assert(core.StringValueFromScalar(blueprint.Hooks.Values[1].Run), Equals, "./scripts/notify.sh")
+
+	linkConfig := blueprint.Links.Values["ordersQueue::ordersTable"]
+	c.Assert(linkConfig, NotNil)
+	c.Assert(core.StringValue(linkConfig.Fields["accessType"]), Equals, "read")
+
+	spec := blueprint.Resources.Values["ordersQueue"].Spec
+	c.Assert(core.IntValue(spec.Fields["delaySeconds"]), Equals, -5)
+	c.Assert(spec.Fields["tags"].Items, HasLen, 1)
+	c.Assert(
+		core.StringValue(spec.Fields["tags"].Items[0].Fields["value"]),
+		Equals,
+		"orders",
+	)
+
+	policy := spec.Fields["policy"].StringWithSubstitutions
+	c.Assert(policy.Values, HasLen, 3)
+	c.Assert(*policy.Values[0].StringValue, Equals, "{\"queue\": \"")
+	c.Assert(policy.Values[1].SubstitutionValue.Variable.VariableName, Equals, "queueName")
+	c.Assert(policy.Values[1].SubstitutionValue.SourceMeta.Line, Equals, 11)
+	c.Assert(*policy.Values[2].StringValue, Equals, "\"}\n")
+}
+
+func (s *HCLTestSuite) Test_captures_source_positions_from_hcl_document(c *C) {
+	blueprint, err := LoadString(hclBlueprintWithHooksAndLinks, HCLSpecFormat)
+	c.Assert(err, IsNil)
+
+	c.Assert(blueprint.Version.SourceMeta, DeepEquals, &source.Meta{
+		Position:    source.Position{Line: 1, Column: 11},
+		EndPosition: &source.Position{Line: 1, Column: 23},
+	})
+	c.Assert(blueprint.Resources.SourceMeta["ordersQueue"], DeepEquals, &source.Meta{
+		Position:    source.Position{Line: 3, Column: 25},
+		EndPosition: &source.Position{Line: 14, Column: 2},
+	})
+	c.Assert(
+		blueprint.Resources.Values["ordersQueue"].Spec.Fields["delaySeconds"].SourceMeta.Position,
+		DeepEquals,
+		source.Position{Line: 6, Column: 20},
+	)
+}
+
+func (s *HCLTestSuite) Test_reports_error_for_reference_outside_of_string(c *C) {
+	_, err := LoadString(
+		"version = \"2025-11-02\"\n"+
+			"resource \"ordersTable\" {\n"+
+			"  type = \"aws/dynamodb/table\"\n"+
+			"  spec {\n"+
+			"    tableName = values.tableName\n"+
+			"  }\n"+
+			"}\n",
+		HCLSpecFormat,
+	)
+	assertHCLDocumentError(
+		c,
+		err,
+		5,
+		17,
+		"the expression \"values.tableName\" is not supported, only strings, numbers, booleans, "+
+			"null, lists and objects can be used as values, references and function calls must be "+
+			"wrapped in a string substitution such as \"${values.tableName}\"",
+	)
+}
+
+func (s *HCLTestSuite) Test_reports_error_for_invalid_hcl_syntax(c *C) {
+	_, err := LoadString(
+		"version = \"2025-11-02\"\n"+
+			"resource \"ordersTable\" {\n"+
+			"  type = \n"+
+			"}\n",
+		HCLSpecFormat,
+	)
+	schemaErr, isSchemaErr := err.(*Error)
+	c.Assert(isSchemaErr, Equals, true)
+	c.Assert(schemaErr.ReasonCode, Equals, ErrorSchemaReasonCodeInvalidHCLDocument)
+	c.Assert(*schemaErr.SourceLine, Equals, 3)
+	c.Assert(schemaErr.Error(), Matches, "invalid HCL syntax: .*")
+}
+
+func (s *HCLTestSuite) Test_reports_error_for_template_directive(c *C) {
+	_, err := LoadString(
+		"version = \"2025-11-02\"\n"+
+			"value \"tableName\" {\n"+
+			"  type  = \"string\"\n"+
+			"  value = \"%{ if true }orders%{ endif }\"\n"+
+			"}\n",
+		HCLSpecFormat,
+	)
+	assertHCLDocumentError(
+		c,
+		err,
+		4,
+		11,
+		"unsupported string template, template directives are not supported, "+
+			"use substitution functions such as \"if\" instead",
+	)
+}
+
+func (s *HCLTestSuite) Test_reports_error_for_element_block_without_name(c *C) {
+	_, err := LoadString(
+		"version = \"2025-11-02\"\n"+
+			"resource {\n"+
+			"  type = \"aws/dynamodb/table\"\n"+
+			"}\n",
+		HCLSpecFormat,
+	)
+	assertHCLDocumentError(
+		c,
+		err,
+		2,
+		1,
+		"the \"resource\" block has 0 label(s), expected exactly one label for the element name",
+	)
+}
+
+func (s *HCLTestSuite) Test_reports_error_for_section_defined_as_block_and_attribute(c *C) {
+	_, err := LoadString(
+		"version = \"2025-11-02\"\n"+
+			"resources = {}\n"+
+			"resource \"ordersTable\" {\n"+
+			"  type = \"aws/dynamodb/table\"\n"+
+			"}\n",
+		HCLSpecFormat,
+	)
+	assertHCLDocumentError(
+		c,
+		err,
+		3,
+		1,
+		"the \"resource\" block can not be used as \"resources\" is already defined as an attribute",
+	)
+}
+
+func (s *HCLTestSuite) Test_reports_error_for_empty_document(c *C) {
+	_, err := LoadString("\n", HCLSpecFormat)
+	schemaErr, isSchemaErr := err.(*Error)
+	c.Assert(isSchemaErr, Equals, true)
+	c.Assert(schemaErr.ReasonCode, Equals, ErrorSchemaReasonCodeInvalidHCLDocument)
+	c.Assert(schemaErr.Error(), Equals, "blueprint HCL document is empty")
+}
+
+func assertHCLDocumentError(
+	c *C,
+	err error,
+	expectedLine int,
+	expectedColumn int,
+	expectedMessage string,
+) {
+	schemaErr, isSchemaErr := err.(*Error)
+	c.Assert(isSchemaErr, Equals, true)
+	c.Assert(schemaErr.ReasonCode, Equals, ErrorSchemaReasonCodeInvalidHCLDocument)
+	c.Assert(*schemaErr.SourceLine, Equals, expectedLine)
+	c.Assert(*schemaErr.SourceColumn, Equals, expectedColumn)
+	c.Assert(schemaErr.Error(), Equals, expectedMessage)
+}
@@ -0,0 +1,247 @@
+package schema
+
+import (
+	"fmt"
+	"slices"
+
+	json "github.com/coreos/go-json"
+	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"gopkg.in/yaml.v3"
+)
+
+// HookEvent represents a lifecycle phase of a blueprint instance
+// deployment that a hook can be run for.
+type HookEvent string
+
+const (
+	// HookEventBeforeDeploy is for hooks that run before the resources,
+	// links and child blueprints of a blueprint instance are deployed.
+	HookEventBeforeDeploy HookEvent = "beforeDeploy"
+	// HookEventAfterDeploy is for hooks that run after a blueprint instance
+	// has been deployed successfully.
+	HookEventAfterDeploy HookEvent = "afterDeploy"
+	// HookEventBeforeResourceDeploy is for hooks that run before
+	// a resource is created or updated.
+	HookEventBeforeResourceDeploy HookEvent = "beforeResourceDeploy"
+	// HookEventAfterResourceDeploy is for hooks that run after
+	// a resource has been created or updated successfully.
+	HookEventAfterResourceDeploy HookEvent = "afterResourceDeploy"
+	// HookEventBeforeDestroy is for hooks that run before
+	// a blueprint instance is destroyed.
+	HookEventBeforeDestroy HookEvent = "beforeDestroy"
+	// HookEventAfterDestroy is for hooks that run after
+	// a blueprint instance has been destroyed successfully.
+	HookEventAfterDestroy HookEvent = "afterDestroy"
+)
+
+var (
+	// HookEvents provides a slice of all the supported
+	// hook events.
+	HookEvents = []HookEvent{
+		HookEventBeforeDeploy,
+		HookEventAfterDeploy,
+		HookEventBeforeResourceDeploy,
+		HookEventAfterResourceDeploy,
+		HookEventBeforeDestroy,
+		HookEventAfterDestroy,
+	}
+)
+
+// IsResourceEvent returns true if the hook event is for
+// the deployment of an individual resource.
+func (e HookEvent) IsResourceEvent() bool {
+	return e == HookEventBeforeResourceDeploy || e == HookEventAfterResourceDeploy
+}
+
+// IsValid returns true if the hook event is one of the supported
+// hook events.
+func (e HookEvent) IsValid() bool {
+	return slices.Contains(HookEvents, e)
+}
+
+// Hook represents a command defined in the hooks section of a blueprint
+// that is run around a lifecycle phase of a blueprint instance deployment.
+// Hook commands are run by tools that support them such as the CLI,
+// for example, to invalidate a cache after a resource has been updated
+// or to run smoke tests after a deployment.
+type Hook struct {
+	// Event is the lifecycle phase that the hook is run for.
+	Event *bpcore.ScalarValue `yaml:"event" json:"event"`
+	// Resource is the name of the resource that the hook is run for,
+	// this can only be set for resource events.
+	// When not set for a resource event, the hook will be run for
+	// every resource in the blueprint.
+	Resource *bpcore.ScalarValue `yaml:"resource,omitempty" json:"resource,omitempty"`
+	// Run is the shell command to run.
+	Run        *bpcore.ScalarValue `yaml:"run" json:"run"`
+	SourceMeta *source.Meta        `yaml:"-" json:"-"`
+}
+
+func (h *Hook) UnmarshalYAML(value *yaml.Node) error {
+	h.SourceMeta = &source.Meta{
+		Position: source.Position{
+			Line:   value.Line,
+			Column: value.Column,
+		},
+	}
+
+	type hookAlias Hook
+	var alias hookAlias
+	if err := value.Decode(&alias); err != nil {
+		return wrapErrorWithLineInfo(err, value)
+	}
+
+	h.Event = alias.Event
+	h.Resource = alias.Resource
+	h.Run = alias.Run
+
+	return nil
+}
+
+func (h *Hook) FromJSONNode(
+	node *json.Node,
+	linePositions []int,
+	parentPath string,
+) error {
+	nodeMap, ok := node.Value.(map[string]json.Node)
+	if !ok {
+		position := source.PositionFromJSONNode(node, linePositions)
+		return errInvalidMap(&position, parentPath)
+	}
+
+	h.Event = &bpcore.ScalarValue{}
+	err := bpcore.UnpackValueFromJSONMapNode(
+		nodeMap,
+		"event",
+		h.Event,
+		linePositions,
+		parentPath,
+		/* parentIsRoot */ false,
+		/* required */ true,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, hasResource := nodeMap["resource"]; hasResource {
+		h.Resource = &bpcore.ScalarValue{}
+		err = bpcore.UnpackValueFromJSONMapNode(
+			nodeMap,
+			"resource",
+			h.Resource,
+			linePositions,
+			parentPath,
+			/* parentIsRoot */ false,
+			/* required */ false,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	h.Run = &bpcore.ScalarValue{}
+	err = bpcore.UnpackValueFromJSONMapNode(
+		nodeMap,
+		"run",
+		h.Run,
+		linePositions,
+		parentPath,
+		/* parentIsRoot */ false,
+		/* required */ true,
+	)
+	if err != nil {
+		return err
+	}
+
+	h.SourceMeta = source.ExtractSourcePositionFromJSONNode(
+		node,
+		linePositions,
+	)
+
+	return nil
+}
+
+// HookList provides an ordered list of hooks defined in a blueprint.
+// Hooks for the same event are run in the order they are defined.
+type HookList struct {
+	Values     []*Hook
+	SourceMeta *source.Meta
+}
+
+func (l *HookList) MarshalYAML() (any, error) {
+	return l.Values, nil
+}
+
+func (l *HookList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.SequenceNode {
+		return errInvalidArray(bpcore.YAMLNodeToPosInfo(value), "hooks")
+	}
+
+	l.SourceMeta = &source.Meta{
+		Position: source.Position{
+			Line:   value.Line,
+			Column: value.Column,
+		},
+	}
+
+	l.Values = make([]*Hook, 0, len(value.Content))
+	for _, item := range value.Content {
+		hook := &Hook{}
+		err := item.Decode(hook)
+		if err != nil {
+			return err
+		}
+		l.Values = append(l.Values, hook)
+	}
+
+	return nil
+}
+
+func (l *HookList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.Values)
+}
+
+func (l *HookList) UnmarshalJSON(data []byte) error {
+	values := []*Hook{}
+	err := json.Unmarshal(data, &values)
+	if err != nil {
+		return err
+	}
+
+	l.Values = values
+	return nil
+}
+
+func (l *HookList) FromJSONNode(
+	node *json.Node,
+	linePositions []int,
+	parentPath string,
+) error {
+	nodeSlice, ok := node.Value.([]json.Node)
+	if !ok {
+		position := source.PositionFromJSONNode(node, linePositions)
+		return errInvalidArray(&position, parentPath)
+	}
+
+	l.SourceMeta = source.ExtractSourcePositionFromJSONNode(
+		node,
+		linePositions,
+	)
+	l.Values = make([]*Hook, 0, len(nodeSlice))
+	for i, hookNode := range nodeSlice {
+		hook := &Hook{}
+		hookPath := bpcore.CreateJSONNodePath(
+			fmt.Sprintf("%d", i),
+			parentPath,
+			/* parentIsRoot */ false,
+		)
+		err := hook.FromJSONNode(&hookNode, linePositions, hookPath)
+		if err != nil {
+			return err
+		}
+		l.Values = append(l.Values, hook)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	. "gopkg.in/check.v1"
+	"gopkg.in/yaml.v3"
+)
+
+type HookTestSuite struct{}
+
+var _ = Suite(&HookTestSuite{})
+
+func (s *HookTestSuite) Test_parses_valid_hooks_yaml_input(c *C) {
+	blueprint, err := LoadString(`
+version: 2025-11-02
+resources: {}
+hooks:
+  - event: afterResourceDeploy
+    resource: ordersTable
+    run: ./scripts/invalidate-cache.sh
+  - event: afterDeploy
+    run: make smoke-test
+`, YAMLSpecFormat)
+	c.Assert(err, IsNil)
+	c.Assert(blueprint.Hooks, NotNil)
+	c.Assert(blueprint.Hooks.Values, HasLen, 2)
+
+	first := blueprint.Hooks.Values[0]
+	c.Assert(core.StringValueFromScalar(first.Event), Equals, string(HookEventAfterResourceDeploy))
+	c.Assert(core.StringValueFromScalar(first.Resource), Equals, "ordersTable")
+	c.Assert(core.StringValueFromScalar(first.Run), Equals, "./scripts/invalidate-cache.sh")
+	c.Assert(first.SourceMeta.Line, Equals, 5)
+
+	second := blueprint.Hooks.Values[1]
+	c.Assert(core.StringValueFromScalar(second.Event), Equals, string(HookEventAfterDeploy))
+	c.Assert(second.Resource, IsNil)
+	c.Assert(core.StringValueFromScalar(second.Run), Equals, "make smoke-test")
+}
+
+func (s *HookTestSuite) Test_parses_valid_hooks_jwcc_input(c *C) {
+	blueprint, err := LoadString(`{
+	"version": "2025-11-02",
+	"resources": {},
+	"hooks": [
+		{
+			"event": "beforeDestroy",
+			"run": "./scripts/drain-traffic.sh"
+		}
+	]
+}`, JWCCSpecFormat)
+	c.Assert(err, IsNil)
+	c.Assert(blueprint.Hooks, NotNil)
+	c.Assert(blueprint.Hooks.Values, HasLen, 1)
+
+	hook := blueprint.Hooks.Values[0]
+	c.Assert(core.StringValueFromScalar(hook.Event), Equals, string(HookEventBeforeDestroy))
+	c.Assert(hook.Resource, IsNil)
+	c.Assert(core.StringValueFromScalar(hook.Run), Equals, "./scripts/drain-traffic.sh")
+	c.Assert(hook.SourceMeta, NotNil)
+}
+
+func (s *HookTestSuite) Test_leaves_hooks_unset_when_not_provided_in_jwcc_input(c *C) {
+	blueprint, err := LoadString(`{
+	"version": "2025-11-02",
+	"resources": {}
+}`, JWCCSpecFormat)
+	c.Assert(err, IsNil)
+	c.Assert(blueprint.Hooks, IsNil)
+}
+
+func (s *HookTestSuite) Test_fails_to_parse_hooks_that_are_not_a_list(c *C) {
+	targetHooks := &HookList{}
+	err := yaml.Unmarshal([]byte("event: afterDeploy\nrun: make smoke-test\n"), targetHooks)
+	c.Assert(err, NotNil)
+	schemaErr, isSchemaErr := err.(*Error)
+	c.Assert(isSchemaErr, Equals, true)
+	c.Assert(schemaErr.ReasonCode, Equals, ErrorSchemaReasonCodeInvalidArray)
+}
+
+func (s *HookTestSuite) Test_identifies_resource_hook_events(c *C) {
+	c.Assert(HookEventBeforeResourceDeploy.IsResourceEvent(), Equals, true)
+	c.Assert(HookEventAfterResourceDeploy.IsResourceEvent(), Equals, true)
+	c.Assert(HookEventAfterDeploy.IsResourceEvent(), Equals, false)
+	c.Assert(HookEvent("afterEverything").IsValid(), Equals, false)
+}
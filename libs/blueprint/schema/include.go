@@ -22,10 +22,15 @@ type Include struct {
 	// An example of this could be the use of fields that provide information
 	// about a remote location to download the child blueprint from such as
 	// an AWS S3 bucket.
-	Metadata         *core.MappingNode                    `yaml:"metadata" json:"metadata"`
-	Description      *substitutions.StringOrSubstitutions `yaml:"description" json:"description"`
-	SourceMeta       *source.Meta                         `yaml:"-" json:"-"`
-	FieldsSourceMeta map[string]*source.Meta              `yaml:"-" json:"-"`
+	Metadata    *core.MappingNode                    `yaml:"metadata" json:"metadata"`
+	Description *substitutions.StringOrSubstitutions `yaml:"description" json:"description"`
+	// DependsOn holds the names of sibling includes and resources
+	// in the parent blueprint that must be deployed before the child blueprint.
+	// This is useful for ordering child blueprints that depend on
+	// side effects of other elements without referencing them.
+	DependsOn        *DependsOnList          `yaml:"dependsOn,omitempty" json:"dependsOn,omitempty"`
+	SourceMeta       *source.Meta            `yaml:"-" json:"-"`
+	FieldsSourceMeta map[string]*source.Meta `yaml:"-" json:"-"`
 }
 
 func (i *Include) UnmarshalYAML(value *yaml.Node) error {
@@ -47,6 +52,7 @@ func (i *Include) UnmarshalYAML(value *yaml.Node) error {
 	i.Variables = alias.Variables
 	i.Metadata = alias.Metadata
 	i.Description = alias.Description
+	i.DependsOn = alias.DependsOn
 
 	return nil
 }
@@ -120,6 +126,22 @@ func (i *Include) FromJSONNode(
 		return err
 	}
 
+	if _, hasDependsOn := nodeMap["dependsOn"]; hasDependsOn {
+		i.DependsOn = &DependsOnList{}
+		err = core.UnpackValueFromJSONMapNode(
+			nodeMap,
+			"dependsOn",
+			i.DependsOn,
+			linePositions,
+			parentPath,
+			/* parentIsRoot */ false,
+			/* required */ false,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	i.SourceMeta = source.ExtractSourcePositionFromJSONNode(
 		node,
 		linePositions,
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	. "gopkg.in/check.v1"
+	"gopkg.in/yaml.v3"
+)
+
+type LinkConfigTestSuite struct{}
+
+var _ = Suite(&LinkConfigTestSuite{})
+
+func (s *LinkConfigTestSuite) Test_parses_valid_links_yaml_input(c *C) {
+	blueprint, err := LoadString(`
+version: 2025-11-02
+resources: {}
+links:
+  ordersFunction::ordersTable:
+    permissionsScope: read
+    envVarPrefix: ORDERS_
+`, YAMLSpecFormat)
+	c.Assert(err, IsNil)
+	c.Assert(blueprint.Links, NotNil)
+	c.Assert(blueprint.Links.Values, HasLen, 1)
+
+	linkConfig := blueprint.Links.Values["ordersFunction::ordersTable"]
+	c.Assert(linkConfig, NotNil)
+	c.Assert(
+		core.StringValue(linkConfig.Fields["permissionsScope"]),
+		Equals,
+		"read",
+	)
+	c.Assert(
+		core.StringValue(linkConfig.Fields["envVarPrefix"]),
+		Equals,
+		"ORDERS_",
+	)
+	c.Assert(blueprint.Links.SourceMeta["ordersFunction::ordersTable"].Line, Equals, 5)
+}
+
+func (s *LinkConfigTestSuite) Test_parses_valid_links_jwcc_input(c *C) {
+	blueprint, err := LoadString(`{
+	"version": "2025-11-02",
+	"resources": {},
+	"links": {
+		"ordersFunction::ordersQueue": {
+			"permissionsScope": "write"
+		}
+	}
+}`, JWCCSpecFormat)
+	c.Assert(err, IsNil)
+	c.Assert(blueprint.Links, NotNil)
+	c.Assert(blueprint.Links.Values, HasLen, 1)
+
+	linkConfig := blueprint.Links.Values["ordersFunction::ordersQueue"]
+	c.Assert(linkConfig, NotNil)
+	c.Assert(
+		core.StringValue(linkConfig.Fields["permissionsScope"]),
+		Equals,
+		"write",
+	)
+	c.Assert(blueprint.Links.SourceMeta["ordersFunction::ordersQueue"], NotNil)
+}
+
+func (s *LinkConfigTestSuite) Test_leaves_links_unset_when_not_provided_in_jwcc_input(c *C) {
+	blueprint, err := LoadString(`{
+	"version": "2025-11-02",
+	"resources": {}
+}`, JWCCSpecFormat)
+	c.Assert(err, IsNil)
+	c.Assert(blueprint.Links, IsNil)
+}
+
+func (s *LinkConfigTestSuite) Test_fails_to_parse_links_that_are_not_a_map(c *C) {
+	targetLinks := &LinkConfigMap{}
+	err := yaml.Unmarshal([]byte("- ordersFunction::ordersTable\n"), targetLinks)
+	c.Assert(err, NotNil)
+	schemaErr, isSchemaErr := err.(*Error)
+	c.Assert(isSchemaErr, Equals, true)
+	c.Assert(schemaErr.ReasonCode, Equals, ErrorSchemaReasonCodeInvalidMap)
+}
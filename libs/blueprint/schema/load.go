@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"bytes"
 	"os"
 
 	json "github.com/coreos/go-json"
@@ -17,7 +18,7 @@ import (
 type Loader func(string, SpecFormat) (*Blueprint, error)
 
 // Load deals with loading a blueprint specification
-// from a YAML or JSON file on disk.
+// from a YAML, JSON or HCL file on disk.
 // The blueprint language (`.bp`) format is not handled here — callers
 // that need it must dispatch to the `lang` package themselves, since
 // `lang` depends on `schema`. The dispatch lives in `container`.
@@ -26,21 +27,28 @@ func Load(specFilePath string, inputFormat SpecFormat) (*Blueprint, error) {
 		return loadYAMLFromFile(specFilePath)
 	}
 
+	if inputFormat == HCLSpecFormat {
+		return loadHCLFromFile(specFilePath)
+	}
+
 	return loadJWCCFromFile(specFilePath)
 }
 
 func loadYAMLFromFile(specFilePath string) (*Blueprint, error) {
 	blueprint := &Blueprint{}
-	f, err := os.Open(specFilePath)
+	// The entire file is read to extract suppression directives
+	// from comments in the document.
+	contents, err := os.ReadFile(specFilePath)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	err = yaml.NewDecoder(f).Decode(blueprint)
+	err = yaml.NewDecoder(bytes.NewReader(contents)).Decode(blueprint)
 	if err != nil {
 		return nil, err
 	}
+	blueprint.Suppressions = ExtractSuppressions(string(contents))
+	blueprint.DuplicateDefinitions = ExtractDuplicateDefinitions(string(contents), YAMLSpecFormat)
 
 	return blueprint, nil
 }
@@ -57,6 +65,8 @@ func loadJWCCFromFile(specFilePath string) (*Blueprint, error) {
 	if err != nil {
 		return nil, err
 	}
+	blueprint.Suppressions = ExtractSuppressions(string(contents))
+	blueprint.DuplicateDefinitions = ExtractDuplicateDefinitions(string(contents), JWCCSpecFormat)
 
 	return blueprint, nil
 }
@@ -92,10 +102,14 @@ const (
 	// or exported should be serialised or deserialised
 	// in the Blueprint Language format.
 	BlueprintLangSpecFormat SpecFormat = "bplang"
+	// HCLSpecFormat determines that a spec being loaded
+	// should be deserialised from the HashiCorp Configuration Language
+	// native syntax, used for `.bp.hcl` files.
+	HCLSpecFormat SpecFormat = "hcl"
 )
 
 // LoadString deals with loading a blueprint specification
-// from a given YAML or JSON with Commas and Comments string.
+// from a given YAML, JSON with Commas and Comments or HCL string.
 // The blueprint language (`.bp`) format is not handled here — callers
 // that need it must dispatch to the `lang` package themselves, since
 // `lang` depends on `schema`. The dispatch lives in `container`.
@@ -105,9 +119,15 @@ func LoadString(spec string, inputFormat SpecFormat) (*Blueprint, error) {
 	var err error
 	if inputFormat == YAMLSpecFormat {
 		err = yaml.Unmarshal([]byte(spec), blueprint)
+	} else if inputFormat == HCLSpecFormat {
+		err = unmarshalHCL([]byte(spec), "", blueprint)
 	} else {
 		err = unmarshalJWCC([]byte(spec), blueprint)
 	}
+	if err == nil {
+		blueprint.Suppressions = ExtractSuppressions(spec)
+		blueprint.DuplicateDefinitions = ExtractDuplicateDefinitions(spec, inputFormat)
+	}
 
 	return blueprint, err
 }
@@ -176,6 +196,20 @@ func populateBlueprintFromJSONNode(docSource string, node *json.Node, blueprint
 		return err
 	}
 
+	blueprint.Functions = &FunctionMap{}
+	err = core.UnpackValueFromJSONMapNode(
+		nodeMap,
+		"functions",
+		blueprint.Functions,
+		linePositions,
+		/* parentPath */ "blueprint",
+		/* parentIsRoot */ true,
+		/* required */ false,
+	)
+	if err != nil {
+		return err
+	}
+
 	blueprint.Include = &IncludeMap{}
 	err = core.UnpackValueFromJSONMapNode(
 		nodeMap,
@@ -232,6 +266,38 @@ func populateBlueprintFromJSONNode(docSource string, node *json.Node, blueprint
 		return err
 	}
 
+	if _, hasHooks := nodeMap["hooks"]; hasHooks {
+		blueprint.Hooks = &HookList{}
+		err = core.UnpackValueFromJSONMapNode(
+			nodeMap,
+			"hooks",
+			blueprint.Hooks,
+			linePositions,
+			/* parentPath */ "blueprint",
+			/* parentIsRoot */ true,
+			/* required */ false,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, hasLinks := nodeMap["links"]; hasLinks {
+		blueprint.Links = &LinkConfigMap{}
+		err = core.UnpackValueFromJSONMapNode(
+			nodeMap,
+			"links",
+			blueprint.Links,
+			linePositions,
+			/* parentPath */ "blueprint",
+			/* parentIsRoot */ true,
+			/* required */ false,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	blueprint.Metadata = &core.MappingNode{}
 	err = core.UnpackValueFromJSONMapNode(
 		nodeMap,
@@ -27,6 +27,7 @@ func (s *LoadTestSuite) SetupSuite() {
 		"jwccWithInclude":        "__testdata/load/blueprint-with-include.jsonc",
 		"yamlWithMultipleValues": "__testdata/load/blueprint-with-multiple-values.yml",
 		"jwccWithMultipleValues": "__testdata/load/blueprint-with-multiple-values.jsonc",
+		"hcl":                    "__testdata/load/blueprint.bp.hcl",
 	}
 
 	for name, filePath := range fixturesToLoad {
@@ -95,6 +96,20 @@ func (s *LoadTestSuite) Test_loads_blueprint_from_json_string() {
 	s.Require().NoError(err)
 }
 
+func (s *LoadTestSuite) Test_loads_blueprint_from_hcl_file() {
+	blueprint, err := Load(s.specFixtures["hcl"].filePath, HCLSpecFormat)
+	s.Require().NoError(err)
+	err = testhelpers.Snapshot(blueprint)
+	s.Require().NoError(err)
+}
+
+func (s *LoadTestSuite) Test_loads_blueprint_from_hcl_string() {
+	blueprint, err := LoadString(s.specFixtures["hcl"].stringVal, HCLSpecFormat)
+	s.Require().NoError(err)
+	err = testhelpers.Snapshot(blueprint)
+	s.Require().NoError(err)
+}
+
 func TestLoadTestSuite(t *testing.T) {
 	suite.Run(t, new(LoadTestSuite))
 }
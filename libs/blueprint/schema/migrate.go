@@ -0,0 +1,164 @@
+package schema
+
+// Migration rewrites a blueprint document written for one version
+// of the blueprint specification so that it conforms to the next version
+// of the specification.
+type Migration struct {
+	// FromVersion is the version of the blueprint specification
+	// that the migration rewrites documents from.
+	FromVersion string
+	// ToVersion is the version of the blueprint specification
+	// that the migration rewrites documents to.
+	ToVersion string
+	// Description is a short, human-readable summary of the changes
+	// made by the migration.
+	Description string
+	// Migrate rewrites the provided document in place.
+	// The version field of the document is updated by the migrator
+	// once the migration has been applied so migrations do not need to set it.
+	Migrate func(doc MigrationDocument) error
+}
+
+// Migrations holds the migrations between the versions of the blueprint
+// specification supported by the blueprint framework.
+// A migration must be registered here for each older version of the specification
+// when a new version of the specification is introduced.
+var Migrations = []*Migration{}
+
+// MigrationResult holds the result of migrating a blueprint document.
+type MigrationResult struct {
+	// FromVersion is the version of the blueprint specification
+	// that the source document was written for.
+	FromVersion string
+	// ToVersion is the version of the blueprint specification
+	// that the migrated document conforms to.
+	ToVersion string
+	// Applied holds the migrations that were applied to the document
+	// in the order they were applied.
+	Applied []*Migration
+	// Spec is the migrated document in the same format as the source document.
+	// This will be the unmodified source document when no migrations were applied.
+	Spec string
+}
+
+// Migrated determines whether any migrations were applied
+// to the source document.
+func (r *MigrationResult) Migrated() bool {
+	return len(r.Applied) > 0
+}
+
+// Migrator rewrites blueprint documents written for older versions
+// of the blueprint specification to a target version.
+// Documents are rewritten through their concrete syntax (YAML nodes or JWCC values)
+// so that comments and formatting are preserved wherever possible.
+type Migrator struct {
+	targetVersion string
+	migrations    map[string]*Migration
+}
+
+// MigratorOption is a function that configures a migrator.
+type MigratorOption func(*Migrator)
+
+// WithMigrations sets the migrations that are available to the migrator,
+// replacing the default migrations registered in Migrations.
+// Only one migration can be provided for each source version.
+func WithMigrations(migrations ...*Migration) MigratorOption {
+	return func(m *Migrator) {
+		m.migrations = migrationsByFromVersion(migrations)
+	}
+}
+
+// NewMigrator creates a new migrator that rewrites blueprint documents
+// to the given target version of the blueprint specification.
+// The target version will usually be the latest version of the specification
+// supported by the blueprint framework.
+func NewMigrator(targetVersion string, opts ...MigratorOption) *Migrator {
+	migrator := &Migrator{
+		targetVersion: targetVersion,
+		migrations:    migrationsByFromVersion(Migrations),
+	}
+
+	for _, opt := range opts {
+		opt(migrator)
+	}
+
+	return migrator
+}
+
+// MigrateString rewrites a blueprint document in the given format
+// to the target version of the migrator.
+// Only the YAML and JWCC formats are supported.
+func (m *Migrator) MigrateString(spec string, format SpecFormat) (*MigrationResult, error) {
+	doc, err := newMigrationDocument([]byte(spec), format)
+	if err != nil {
+		return nil, err
+	}
+
+	fromVersion, hasVersion := doc.Version()
+	if !hasVersion {
+		return nil, errMigrationMissingVersion()
+	}
+
+	path, err := m.MigrationPath(fromVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MigrationResult{
+		FromVersion: fromVersion,
+		ToVersion:   m.targetVersion,
+		Applied:     []*Migration{},
+		Spec:        spec,
+	}
+	if len(path) == 0 {
+		return result, nil
+	}
+
+	for _, migration := range path {
+		if err := migration.Migrate(doc); err != nil {
+			return nil, errMigrationFailed(migration, err)
+		}
+
+		if err := doc.SetVersion(migration.ToVersion); err != nil {
+			return nil, errMigrationFailed(migration, err)
+		}
+		result.Applied = append(result.Applied, migration)
+	}
+
+	migrated, err := doc.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	result.Spec = string(migrated)
+
+	return result, nil
+}
+
+// MigrationPath determines the ordered chain of migrations that must be applied
+// to a document written for the given version to reach the target version.
+// An empty path is returned when the provided version is the target version.
+func (m *Migrator) MigrationPath(fromVersion string) ([]*Migration, error) {
+	path := []*Migration{}
+	visited := map[string]bool{}
+	currentVersion := fromVersion
+	for currentVersion != m.targetVersion {
+		migration, hasMigration := m.migrations[currentVersion]
+		if !hasMigration || visited[currentVersion] {
+			return nil, errNoMigrationPath(fromVersion, m.targetVersion)
+		}
+
+		visited[currentVersion] = true
+		path = append(path, migration)
+		currentVersion = migration.ToVersion
+	}
+
+	return path, nil
+}
+
+func migrationsByFromVersion(migrations []*Migration) map[string]*Migration {
+	byFromVersion := make(map[string]*Migration, len(migrations))
+	for _, migration := range migrations {
+		byFromVersion[migration.FromVersion] = migration
+	}
+	return byFromVersion
+}
@@ -0,0 +1,621 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/tailscale/hujson"
+	"gopkg.in/yaml.v3"
+)
+
+// MigrationDocument provides a format-agnostic view over the concrete syntax
+// of a blueprint document that migrations use to rewrite documents.
+// Changes are made directly to the underlying YAML nodes or JWCC values
+// so comments and formatting for untouched parts of the document are preserved.
+//
+// Paths are made up of mapping keys from the root of the document,
+// for example, []string{"resources", "orderQueue", "spec"}.
+type MigrationDocument interface {
+	// Format returns the format of the underlying document.
+	Format() SpecFormat
+	// Version returns the version of the blueprint specification
+	// that is set in the document.
+	Version() (string, bool)
+	// SetVersion sets the version of the blueprint specification in the document.
+	SetVersion(version string) error
+	// Get retrieves the value at the given path decoded as a plain Go value
+	// (map[string]any, []any, string, bool, number or nil).
+	Get(path []string) (any, bool, error)
+	// Set sets the value at the given path, creating any missing parent mappings.
+	// Comments attached to an existing value are retained.
+	Set(path []string, value any) error
+	// Delete removes the field at the given path, reporting whether
+	// the field existed.
+	Delete(path []string) (bool, error)
+	// Rename renames the key of the field at the given path,
+	// the field keeps its position and comments.
+	Rename(path []string, newName string) error
+	// Keys returns the keys of the mapping at the given path in document order,
+	// an empty path returns the top-level keys of the document.
+	Keys(path []string) ([]string, error)
+	// Bytes serialises the document in its original format.
+	Bytes() ([]byte, error)
+}
+
+func newMigrationDocument(spec []byte, format SpecFormat) (MigrationDocument, error) {
+	switch format {
+	case YAMLSpecFormat:
+		return newYAMLMigrationDocument(spec)
+	case JWCCSpecFormat:
+		return newJWCCMigrationDocument(spec)
+	default:
+		return nil, errMigrationUnsupportedFormat(format)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////
+// YAML
+////////////////////////////////////////////////////////////////////////////////////
+
+type yamlMigrationDocument struct {
+	root *yaml.Node
+}
+
+func newYAMLMigrationDocument(spec []byte) (*yamlMigrationDocument, error) {
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal(spec, root); err != nil {
+		return nil, errMigrationInvalidDocument(err)
+	}
+
+	if root.Kind != yaml.DocumentNode ||
+		len(root.Content) == 0 ||
+		root.Content[0].Kind != yaml.MappingNode {
+		return nil, errMigrationInvalidDocument(
+			fmt.Errorf("expected a mapping at the root of the document"),
+		)
+	}
+
+	return &yamlMigrationDocument{root: root}, nil
+}
+
+func (d *yamlMigrationDocument) Format() SpecFormat {
+	return YAMLSpecFormat
+}
+
+func (d *yamlMigrationDocument) Version() (string, bool) {
+	value := lookupYAMLNode(d.root.Content[0], []string{"version"})
+	if value == nil || value.Kind != yaml.ScalarNode || strings.TrimSpace(value.Value) == "" {
+		return "", false
+	}
+
+	return value.Value, true
+}
+
+func (d *yamlMigrationDocument) SetVersion(version string) error {
+	value := lookupYAMLNode(d.root.Content[0], []string{"version"})
+	if value != nil && value.Kind == yaml.ScalarNode {
+		// Update the scalar in place to retain the original quoting style
+		// of the version.
+		value.Value = version
+		return nil
+	}
+
+	return d.Set([]string{"version"}, version)
+}
+
+func (d *yamlMigrationDocument) Get(path []string) (any, bool, error) {
+	node := lookupYAMLNode(d.root.Content[0], path)
+	if node == nil {
+		return nil, false, nil
+	}
+
+	var value any
+	if err := node.Decode(&value); err != nil {
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
+
+func (d *yamlMigrationDocument) Set(path []string, value any) error {
+	if len(path) == 0 {
+		return errMigrationEmptyPath()
+	}
+
+	parent, err := yamlParentMapping(
+		d.root.Content[0],
+		path,
+		/* create */ true,
+	)
+	if err != nil {
+		return err
+	}
+
+	newNode := &yaml.Node{}
+	if err := newNode.Encode(value); err != nil {
+		return err
+	}
+
+	key := path[len(path)-1]
+	keyIndex := yamlMappingKeyIndex(parent, key)
+	if keyIndex >= 0 {
+		existing := parent.Content[keyIndex+1]
+		newNode.HeadComment = existing.HeadComment
+		newNode.LineComment = existing.LineComment
+		newNode.FootComment = existing.FootComment
+		parent.Content[keyIndex+1] = newNode
+		return nil
+	}
+
+	parent.Content = append(
+		parent.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		newNode,
+	)
+	return nil
+}
+
+func (d *yamlMigrationDocument) Delete(path []string) (bool, error) {
+	if len(path) == 0 {
+		return false, errMigrationEmptyPath()
+	}
+
+	parent, err := yamlParentMapping(
+		d.root.Content[0],
+		path,
+		/* create */ false,
+	)
+	if err != nil || parent == nil {
+		return false, err
+	}
+
+	keyIndex := yamlMappingKeyIndex(parent, path[len(path)-1])
+	if keyIndex < 0 {
+		return false, nil
+	}
+
+	parent.Content = append(parent.Content[:keyIndex], parent.Content[keyIndex+2:]...)
+	return true, nil
+}
+
+func (d *yamlMigrationDocument) Rename(path []string, newName string) error {
+	if len(path) == 0 {
+		return errMigrationEmptyPath()
+	}
+
+	parent, err := yamlParentMapping(
+		d.root.Content[0],
+		path,
+		/* create */ false,
+	)
+	if err != nil {
+		return err
+	}
+
+	keyIndex := -1
+	if parent != nil {
+		keyIndex = yamlMappingKeyIndex(parent, path[len(path)-1])
+	}
+	if keyIndex < 0 {
+		return errMigrationFieldNotFound(path)
+	}
+
+	if yamlMappingKeyIndex(parent, newName) >= 0 {
+		return errMigrationFieldExists(path, newName)
+	}
+
+	parent.Content[keyIndex].Value = newName
+	return nil
+}
+
+func (d *yamlMigrationDocument) Keys(path []string) ([]string, error) {
+	node := lookupYAMLNode(d.root.Content[0], path)
+	if node == nil {
+		return nil, nil
+	}
+
+	if node.Kind != yaml.MappingNode {
+		return nil, errMigrationNotMapping(path)
+	}
+
+	keys := make([]string, 0, len(node.Content)/2)
+	for i := 0; i < len(node.Content); i += 2 {
+		keys = append(keys, node.Content[i].Value)
+	}
+	return keys, nil
+}
+
+func (d *yamlMigrationDocument) Bytes() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(d.root); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func lookupYAMLNode(mapping *yaml.Node, path []string) *yaml.Node {
+	current := mapping
+	for _, key := range path {
+		if current.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		keyIndex := yamlMappingKeyIndex(current, key)
+		if keyIndex < 0 {
+			return nil
+		}
+		current = current.Content[keyIndex+1]
+	}
+
+	return current
+}
+
+func yamlParentMapping(mapping *yaml.Node, path []string, create bool) (*yaml.Node, error) {
+	current := mapping
+	for i, key := range path[:len(path)-1] {
+		keyIndex := yamlMappingKeyIndex(current, key)
+		if keyIndex < 0 {
+			if !create {
+				return nil, nil
+			}
+
+			child := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			current.Content = append(
+				current.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+				child,
+			)
+			current = child
+			continue
+		}
+
+		child := current.Content[keyIndex+1]
+		if child.Kind != yaml.MappingNode {
+			return nil, errMigrationNotMapping(path[:i+1])
+		}
+		current = child
+	}
+
+	return current, nil
+}
+
+func yamlMappingKeyIndex(mapping *yaml.Node, key string) int {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+////////////////////////////////////////////////////////////////////////////////////
+// JSON with Commas and Comments (JWCC)
+////////////////////////////////////////////////////////////////////////////////////
+
+type jwccMigrationDocument struct {
+	root hujson.Value
+}
+
+func newJWCCMigrationDocument(spec []byte) (*jwccMigrationDocument, error) {
+	root, err := hujson.Parse(spec)
+	if err != nil {
+		return nil, errMigrationInvalidDocument(err)
+	}
+
+	if _, isObject := root.Value.(*hujson.Object); !isObject {
+		return nil, errMigrationInvalidDocument(
+			fmt.Errorf("expected an object at the root of the document"),
+		)
+	}
+
+	return &jwccMigrationDocument{root: root}, nil
+}
+
+func (d *jwccMigrationDocument) Format() SpecFormat {
+	return JWCCSpecFormat
+}
+
+func (d *jwccMigrationDocument) Version() (string, bool) {
+	value := lookupJWCCValue(&d.root, []string{"version"})
+	if value == nil {
+		return "", false
+	}
+
+	literal, isLiteral := value.Value.(hujson.Literal)
+	if !isLiteral {
+		return "", false
+	}
+
+	version, isString := jwccLiteralString(literal)
+	if !isString || strings.TrimSpace(version) == "" {
+		return "", false
+	}
+
+	return version, true
+}
+
+func (d *jwccMigrationDocument) SetVersion(version string) error {
+	return d.Set([]string{"version"}, version)
+}
+
+func (d *jwccMigrationDocument) Get(path []string) (any, bool, error) {
+	value := lookupJWCCValue(&d.root, path)
+	if value == nil {
+		return nil, false, nil
+	}
+
+	standardised, err := hujson.Standardize(value.Pack())
+	if err != nil {
+		return nil, false, err
+	}
+
+	var decoded any
+	if err := json.Unmarshal(standardised, &decoded); err != nil {
+		return nil, false, err
+	}
+
+	return decoded, true, nil
+}
+
+func (d *jwccMigrationDocument) Set(path []string, value any) error {
+	if len(path) == 0 {
+		return errMigrationEmptyPath()
+	}
+
+	newValue, err := jwccValueFrom(value)
+	if err != nil {
+		return err
+	}
+
+	parent, err := jwccParentObject(
+		&d.root,
+		path,
+		/* create */ true,
+	)
+	if err != nil {
+		return err
+	}
+
+	key := path[len(path)-1]
+	memberIndex := jwccObjectMemberIndex(parent, key)
+	if memberIndex >= 0 {
+		existing := parent.Members[memberIndex].Value
+		newValue.BeforeExtra = existing.BeforeExtra
+		newValue.AfterExtra = existing.AfterExtra
+		parent.Members[memberIndex].Value = newValue
+		return nil
+	}
+
+	return appendJWCCObjectMember(parent, key, newValue)
+}
+
+func (d *jwccMigrationDocument) Delete(path []string) (bool, error) {
+	if len(path) == 0 {
+		return false, errMigrationEmptyPath()
+	}
+
+	parent, err := jwccParentObject(
+		&d.root,
+		path,
+		/* create */ false,
+	)
+	if err != nil || parent == nil {
+		return false, err
+	}
+
+	memberIndex := jwccObjectMemberIndex(parent, path[len(path)-1])
+	if memberIndex < 0 {
+		return false, nil
+	}
+
+	removed := parent.Members[memberIndex]
+	parent.Members = append(parent.Members[:memberIndex], parent.Members[memberIndex+1:]...)
+
+	// A trailing comma is only emitted for the last member of an object
+	// when its value has trailing extra content, this is carried over
+	// to the new last member to retain the trailing comma style of the document.
+	isLastMember := memberIndex == len(parent.Members)
+	if isLastMember && len(parent.Members) > 0 {
+		newLast := &parent.Members[len(parent.Members)-1]
+		if newLast.Value.AfterExtra == nil {
+			newLast.Value.AfterExtra = removed.Value.AfterExtra
+		}
+	}
+
+	return true, nil
+}
+
+func (d *jwccMigrationDocument) Rename(path []string, newName string) error {
+	if len(path) == 0 {
+		return errMigrationEmptyPath()
+	}
+
+	parent, err := jwccParentObject(
+		&d.root,
+		path,
+		/* create */ false,
+	)
+	if err != nil {
+		return err
+	}
+
+	memberIndex := -1
+	if parent != nil {
+		memberIndex = jwccObjectMemberIndex(parent, path[len(path)-1])
+	}
+	if memberIndex < 0 {
+		return errMigrationFieldNotFound(path)
+	}
+
+	if jwccObjectMemberIndex(parent, newName) >= 0 {
+		return errMigrationFieldExists(path, newName)
+	}
+
+	name, err := jwccStringLiteral(newName)
+	if err != nil {
+		return err
+	}
+	parent.Members[memberIndex].Name.Value = name
+	return nil
+}
+
+func (d *jwccMigrationDocument) Keys(path []string) ([]string, error) {
+	value := lookupJWCCValue(&d.root, path)
+	if value == nil {
+		return nil, nil
+	}
+
+	obj, isObject := value.Value.(*hujson.Object)
+	if !isObject {
+		return nil, errMigrationNotMapping(path)
+	}
+
+	keys := make([]string, 0, len(obj.Members))
+	for _, member := range obj.Members {
+		if name, isLiteral := member.Name.Value.(hujson.Literal); isLiteral {
+			if key, isString := jwccLiteralString(name); isString {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys, nil
+}
+
+func (d *jwccMigrationDocument) Bytes() ([]byte, error) {
+	return d.root.Pack(), nil
+}
+
+func lookupJWCCValue(root *hujson.Value, path []string) *hujson.Value {
+	current := root
+	for _, key := range path {
+		obj, isObject := current.Value.(*hujson.Object)
+		if !isObject {
+			return nil
+		}
+
+		memberIndex := jwccObjectMemberIndex(obj, key)
+		if memberIndex < 0 {
+			return nil
+		}
+		current = &obj.Members[memberIndex].Value
+	}
+
+	return current
+}
+
+func jwccParentObject(root *hujson.Value, path []string, create bool) (*hujson.Object, error) {
+	current := root.Value.(*hujson.Object)
+	for i, key := range path[:len(path)-1] {
+		memberIndex := jwccObjectMemberIndex(current, key)
+		if memberIndex < 0 {
+			if !create {
+				return nil, nil
+			}
+
+			child, err := hujson.Parse([]byte("{}"))
+			if err != nil {
+				return nil, err
+			}
+			if err := appendJWCCObjectMember(current, key, child); err != nil {
+				return nil, err
+			}
+			current = current.Members[len(current.Members)-1].Value.Value.(*hujson.Object)
+			continue
+		}
+
+		child, isObject := current.Members[memberIndex].Value.Value.(*hujson.Object)
+		if !isObject {
+			return nil, errMigrationNotMapping(path[:i+1])
+		}
+		current = child
+	}
+
+	return current, nil
+}
+
+func appendJWCCObjectMember(obj *hujson.Object, key string, value hujson.Value) error {
+	name, err := jwccStringLiteral(key)
+	if err != nil {
+		return err
+	}
+
+	member := hujson.ObjectMember{
+		Name:  hujson.Value{Value: name},
+		Value: value,
+	}
+	member.Value.BeforeExtra = hujson.Extra(" ")
+
+	if len(obj.Members) > 0 {
+		// Follow the indentation of the previous member and move
+		// the trailing comma (if present) to the new last member.
+		last := &obj.Members[len(obj.Members)-1]
+		member.Name.BeforeExtra = jwccIndentation(last.Name.BeforeExtra)
+		member.Value.AfterExtra = last.Value.AfterExtra
+		last.Value.AfterExtra = nil
+	}
+
+	obj.Members = append(obj.Members, member)
+	return nil
+}
+
+func jwccIndentation(extra hujson.Extra) hujson.Extra {
+	lastNewline := bytes.LastIndexByte(extra, '\n')
+	if lastNewline < 0 {
+		return hujson.Extra(" ")
+	}
+
+	indentation := make(hujson.Extra, 0, len(extra)-lastNewline)
+	return append(indentation, extra[lastNewline:]...)
+}
+
+func jwccObjectMemberIndex(obj *hujson.Object, key string) int {
+	for i, member := range obj.Members {
+		name, isLiteral := member.Name.Value.(hujson.Literal)
+		if !isLiteral {
+			continue
+		}
+
+		if memberKey, isString := jwccLiteralString(name); isString && memberKey == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func jwccLiteralString(literal hujson.Literal) (string, bool) {
+	if literal.Kind() != '"' {
+		return "", false
+	}
+
+	var value string
+	if err := json.Unmarshal(literal, &value); err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+func jwccStringLiteral(value string) (hujson.Literal, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return hujson.Literal(encoded), nil
+}
+
+func jwccValueFrom(value any) (hujson.Value, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return hujson.Value{}, err
+	}
+
+	return hujson.Parse(encoded)
+}
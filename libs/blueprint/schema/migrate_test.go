@@ -0,0 +1,257 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testMigrationOldestVersion = "2024-06-01"
+	testMigrationOlderVersion  = "2025-03-01"
+	testMigrationLatestVersion = "2025-11-02"
+)
+
+type MigrateTestSuite struct {
+	migrator *Migrator
+	suite.Suite
+}
+
+func (s *MigrateTestSuite) SetupTest() {
+	s.migrator = NewMigrator(
+		testMigrationLatestVersion,
+		WithMigrations(
+			// Registered out of order to ensure the migration path
+			// is derived from the versions of the migrations.
+			&Migration{
+				FromVersion: testMigrationOlderVersion,
+				ToVersion:   testMigrationLatestVersion,
+				Description: "Moves the legacy resource name in the spec to the display name in resource metadata",
+				Migrate:     moveLegacyResourceName,
+			},
+			&Migration{
+				FromVersion: testMigrationOldestVersion,
+				ToVersion:   testMigrationOlderVersion,
+				Description: "Renames the resource dependencies field to dependsOn",
+				Migrate:     renameResourceDependencies,
+			},
+		),
+	)
+}
+
+func (s *MigrateTestSuite) Test_migrates_yaml_document_preserving_comments() {
+	spec := `# Blueprint for the orders service.
+version: 2024-06-01
+resources:
+  # The queue that receives new orders.
+  ordersQueue:
+    type: aws/sqs/queue
+    dependencies: ordersTable # Must be created after the table.
+    spec:
+      legacyName: Orders Queue
+      # Keep messages for 1 day.
+      messageRetentionPeriod: 86400
+  ordersTable:
+    type: aws/dynamodb/table
+`
+	result, err := s.migrator.MigrateString(spec, YAMLSpecFormat)
+	s.Require().NoError(err)
+	s.Assert().True(result.Migrated())
+	s.Assert().Equal(testMigrationOldestVersion, result.FromVersion)
+	s.Assert().Equal(testMigrationLatestVersion, result.ToVersion)
+	s.Require().Len(result.Applied, 2)
+	s.Assert().Equal(testMigrationOldestVersion, result.Applied[0].FromVersion)
+	s.Assert().Equal(testMigrationOlderVersion, result.Applied[1].FromVersion)
+
+	s.Assert().Contains(result.Spec, "# Blueprint for the orders service.")
+	s.Assert().Contains(result.Spec, "# The queue that receives new orders.")
+	s.Assert().Contains(result.Spec, "# Must be created after the table.")
+	s.Assert().Contains(result.Spec, "# Keep messages for 1 day.")
+	s.Assert().Contains(result.Spec, "version: 2025-11-02\n")
+
+	s.assertMigratedDocument(result.Spec, YAMLSpecFormat)
+}
+
+func (s *MigrateTestSuite) Test_migrates_jwcc_document_preserving_comments() {
+	spec := `// Blueprint for the orders service.
+{
+  "version": "2024-06-01",
+  "resources": {
+    // The queue that receives new orders.
+    "ordersQueue": {
+      "type": "aws/sqs/queue",
+      "dependencies": "ordersTable", // Must be created after the table.
+      "spec": {
+        "legacyName": "Orders Queue",
+        // Keep messages for 1 day.
+        "messageRetentionPeriod": 86400,
+      },
+    },
+    "ordersTable": {
+      "type": "aws/dynamodb/table",
+    },
+  },
+}
+`
+	result, err := s.migrator.MigrateString(spec, JWCCSpecFormat)
+	s.Require().NoError(err)
+	s.Assert().True(result.Migrated())
+	s.Require().Len(result.Applied, 2)
+
+	s.Assert().Contains(result.Spec, "// Blueprint for the orders service.")
+	s.Assert().Contains(result.Spec, "// The queue that receives new orders.")
+	s.Assert().Contains(result.Spec, "\"dependsOn\": \"ordersTable\", // Must be created after the table.")
+	s.Assert().Contains(result.Spec, "// Keep messages for 1 day.")
+	s.Assert().Contains(result.Spec, "\"version\": \"2025-11-02\",")
+
+	s.assertMigratedDocument(result.Spec, JWCCSpecFormat)
+}
+
+func (s *MigrateTestSuite) Test_returns_unmodified_document_for_latest_version() {
+	spec := `version: 2025-11-02 # Latest version.
+resources:
+  ordersQueue:
+    type: aws/sqs/queue
+`
+	result, err := s.migrator.MigrateString(spec, YAMLSpecFormat)
+	s.Require().NoError(err)
+	s.Assert().False(result.Migrated())
+	s.Assert().Equal(spec, result.Spec)
+	s.Assert().Equal(testMigrationLatestVersion, result.FromVersion)
+}
+
+func (s *MigrateTestSuite) Test_fails_when_there_is_no_migration_path() {
+	_, err := s.migrator.MigrateString("version: 2023-01-01\n", YAMLSpecFormat)
+	s.Require().Error(err)
+	schemaErr, isSchemaErr := err.(*Error)
+	s.Require().True(isSchemaErr)
+	s.Assert().Equal(ErrorSchemaReasonCodeNoMigrationPath, schemaErr.ReasonCode)
+}
+
+func (s *MigrateTestSuite) Test_fails_when_document_has_no_version() {
+	_, err := s.migrator.MigrateString("{\"resources\": {}}", JWCCSpecFormat)
+	s.Require().Error(err)
+	schemaErr, isSchemaErr := err.(*Error)
+	s.Require().True(isSchemaErr)
+	s.Assert().Equal(ErrorSchemaReasonCodeMigrationMissingVersion, schemaErr.ReasonCode)
+}
+
+func (s *MigrateTestSuite) Test_fails_for_blueprint_language_documents() {
+	_, err := s.migrator.MigrateString("version 2024-06-01", BlueprintLangSpecFormat)
+	s.Require().Error(err)
+	schemaErr, isSchemaErr := err.(*Error)
+	s.Require().True(isSchemaErr)
+	s.Assert().Equal(ErrorSchemaReasonCodeMigrationUnsupportedFormat, schemaErr.ReasonCode)
+}
+
+func (s *MigrateTestSuite) Test_fails_when_a_migration_fails() {
+	spec := `version: 2025-03-01
+resources:
+  ordersQueue:
+    type: aws/sqs/queue
+    spec: invalid
+`
+	_, err := s.migrator.MigrateString(spec, YAMLSpecFormat)
+	s.Require().Error(err)
+	schemaErr, isSchemaErr := err.(*Error)
+	s.Require().True(isSchemaErr)
+	s.Assert().Equal(ErrorSchemaReasonCodeMigrationFailed, schemaErr.ReasonCode)
+}
+
+func (s *MigrateTestSuite) assertMigratedDocument(spec string, format SpecFormat) {
+	doc, err := newMigrationDocument([]byte(spec), format)
+	s.Require().NoError(err)
+
+	version, hasVersion := doc.Version()
+	s.Assert().True(hasVersion)
+	s.Assert().Equal(testMigrationLatestVersion, version)
+
+	resourceNames, err := doc.Keys([]string{"resources"})
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"ordersQueue", "ordersTable"}, resourceNames)
+
+	queueFields, err := doc.Keys([]string{"resources", "ordersQueue"})
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"type", "dependsOn", "spec", "metadata"}, queueFields)
+
+	displayName, hasDisplayName, err := doc.Get(
+		[]string{"resources", "ordersQueue", "metadata", "displayName"},
+	)
+	s.Require().NoError(err)
+	s.Assert().True(hasDisplayName)
+	s.Assert().Equal("Orders Queue", displayName)
+
+	_, hasLegacyName, err := doc.Get([]string{"resources", "ordersQueue", "spec", "legacyName"})
+	s.Require().NoError(err)
+	s.Assert().False(hasLegacyName)
+
+	retentionPeriod, _, err := doc.Get(
+		[]string{"resources", "ordersQueue", "spec", "messageRetentionPeriod"},
+	)
+	s.Require().NoError(err)
+	s.Assert().EqualValues(86400, retentionPeriod)
+}
+
+func renameResourceDependencies(doc MigrationDocument) error {
+	resourceNames, err := doc.Keys([]string{"resources"})
+	if err != nil {
+		return err
+	}
+
+	for _, resourceName := range resourceNames {
+		path := []string{"resources", resourceName, "dependencies"}
+		_, hasDependencies, err := doc.Get(path)
+		if err != nil {
+			return err
+		}
+
+		if hasDependencies {
+			if err := doc.Rename(path, "dependsOn"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func moveLegacyResourceName(doc MigrationDocument) error {
+	resourceNames, err := doc.Keys([]string{"resources"})
+	if err != nil {
+		return err
+	}
+
+	for _, resourceName := range resourceNames {
+		// Fails for resources with a spec that is not a mapping.
+		_, err := doc.Keys([]string{"resources", resourceName, "spec"})
+		if err != nil {
+			return err
+		}
+
+		legacyNamePath := []string{"resources", resourceName, "spec", "legacyName"}
+		legacyName, hasLegacyName, err := doc.Get(legacyNamePath)
+		if err != nil {
+			return err
+		}
+
+		if hasLegacyName {
+			if _, err := doc.Delete(legacyNamePath); err != nil {
+				return err
+			}
+
+			err = doc.Set(
+				[]string{"resources", resourceName, "metadata", "displayName"},
+				legacyName,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestMigrateTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrateTestSuite))
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"fmt"
+
+	json "github.com/coreos/go-json"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/jsonutils"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"gopkg.in/yaml.v3"
+)
+
+// ReplaceStrategyWrapper provides a struct that holds a replace strategy
+// value for a resource along with source metadata for error reporting.
+type ReplaceStrategyWrapper struct {
+	Value      ReplaceStrategy
+	SourceMeta *source.Meta
+}
+
+func (t *ReplaceStrategyWrapper) MarshalYAML() (interface{}, error) {
+	return t.Value, nil
+}
+
+func (t *ReplaceStrategyWrapper) UnmarshalYAML(value *yaml.Node) error {
+	t.SourceMeta = &source.Meta{
+		Position: source.Position{
+			Line:   value.Line,
+			Column: value.Column,
+		},
+		EndPosition: source.EndSourcePositionFromYAMLScalarNode(value),
+	}
+
+	t.Value = ReplaceStrategy(value.Value)
+	return nil
+}
+
+func (t *ReplaceStrategyWrapper) MarshalJSON() ([]byte, error) {
+	escaped := jsonutils.EscapeJSONString(string(t.Value))
+	return []byte(fmt.Sprintf("\"%s\"", escaped)), nil
+}
+
+func (t *ReplaceStrategyWrapper) UnmarshalJSON(data []byte) error {
+	var strategyVal string
+	err := json.Unmarshal(data, &strategyVal)
+	if err != nil {
+		return err
+	}
+
+	t.Value = ReplaceStrategy(strategyVal)
+
+	return nil
+}
+
+func (t *ReplaceStrategyWrapper) FromJSONNode(
+	node *json.Node,
+	linePositions []int,
+	parentPath string,
+) error {
+	t.SourceMeta = source.ExtractSourcePositionFromJSONNode(
+		node,
+		linePositions,
+	)
+	stringVal := node.Value.(string)
+	t.Value = ReplaceStrategy(stringVal)
+	return nil
+}
+
+// ReplaceStrategy represents the strategy that controls the order in which
+// a resource is destroyed and created again when changes to the resource
+// can only be applied by replacing it.
+type ReplaceStrategy string
+
+func (s ReplaceStrategy) Equal(compareWith ReplaceStrategy) bool {
+	return s == compareWith
+}
+
+const (
+	// ReplaceStrategyCreateBeforeDestroy indicates that a replacement
+	// resource should be created before the existing resource is destroyed.
+	// This minimises disruption but requires that the existing resource
+	// and its replacement can exist at the same time, which is not the case
+	// for resources with a user-defined unique name that does not change.
+	ReplaceStrategyCreateBeforeDestroy ReplaceStrategy = "createBeforeDestroy"
+	// ReplaceStrategyDestroyBeforeCreate indicates that the existing resource
+	// should be destroyed before the replacement resource is created.
+	// This is useful for resources that are recreated with the same
+	// user-defined unique name.
+	ReplaceStrategyDestroyBeforeCreate ReplaceStrategy = "destroyBeforeCreate"
+)
+
+var (
+	// ValidReplaceStrategies lists all valid replace strategy values
+	// for clean validation of the replaceStrategy field on a resource.
+	ValidReplaceStrategies = []ReplaceStrategy{
+		ReplaceStrategyCreateBeforeDestroy,
+		ReplaceStrategyDestroyBeforeCreate,
+	}
+)
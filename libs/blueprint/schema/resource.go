@@ -22,6 +22,9 @@ type Resource struct {
 	Each             *substitutions.StringOrSubstitutions `yaml:"each,omitempty" json:"each,omitempty"`
 	LinkSelector     *LinkSelector                        `yaml:"linkSelector,omitempty" json:"linkSelector,omitempty"`
 	RemovalPolicy    *RemovalPolicyWrapper                `yaml:"removalPolicy,omitempty" json:"removalPolicy,omitempty"`
+	ReplaceStrategy  *ReplaceStrategyWrapper              `yaml:"replaceStrategy,omitempty" json:"replaceStrategy,omitempty"`
+	IgnoreChanges    *IgnoreChangesList                   `yaml:"ignoreChanges,omitempty" json:"ignoreChanges,omitempty"`
+	Timeouts         *ResourceTimeouts                    `yaml:"timeouts,omitempty" json:"timeouts,omitempty"`
 	Spec             *core.MappingNode                    `yaml:"spec" json:"spec"`
 	SourceMeta       *source.Meta                         `yaml:"-" json:"-"`
 	FieldsSourceMeta map[string]*source.Meta              `yaml:"-" json:"-"`
@@ -50,6 +53,9 @@ func (r *Resource) UnmarshalYAML(value *yaml.Node) error {
 	r.Each = alias.Each
 	r.LinkSelector = alias.LinkSelector
 	r.RemovalPolicy = alias.RemovalPolicy
+	r.ReplaceStrategy = alias.ReplaceStrategy
+	r.IgnoreChanges = alias.IgnoreChanges
+	r.Timeouts = alias.Timeouts
 	r.Spec = alias.Spec
 
 	return nil
@@ -180,6 +186,50 @@ func (r *Resource) FromJSONNode(
 		return err
 	}
 
+	r.ReplaceStrategy = &ReplaceStrategyWrapper{}
+	err = core.UnpackValueFromJSONMapNode(
+		nodeMap,
+		"replaceStrategy",
+		r.ReplaceStrategy,
+		linePositions,
+		parentPath,
+		/* parentIsRoot */ false,
+		/* required */ false,
+	)
+	if err != nil {
+		return err
+	}
+
+	r.IgnoreChanges = &IgnoreChangesList{}
+	err = core.UnpackValueFromJSONMapNode(
+		nodeMap,
+		"ignoreChanges",
+		r.IgnoreChanges,
+		linePositions,
+		parentPath,
+		/* parentIsRoot */ false,
+		/* required */ false,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, hasTimeouts := nodeMap["timeouts"]; hasTimeouts {
+		r.Timeouts = &ResourceTimeouts{}
+		err = core.UnpackValueFromJSONMapNode(
+			nodeMap,
+			"timeouts",
+			r.Timeouts,
+			linePositions,
+			parentPath,
+			/* parentIsRoot */ false,
+			/* required */ false,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	r.Spec = &core.MappingNode{}
 	err = core.UnpackValueFromJSONMapNode(
 		nodeMap,
@@ -202,8 +252,8 @@ func (r *Resource) FromJSONNode(
 	return nil
 }
 
-// DependsOnList provides a list of resource names
-// that a resource depends on.
+// DependsOnList provides a list of element names
+// that a resource or include depends on.
 // This can include extra information about the locations of
 // elements in the list in the original source,
 // depending on the source format.
@@ -235,6 +285,42 @@ func (t *DependsOnList) FromJSONNode(
 	return t.StringList.FromJSONNode(node, linePositions, parentPath)
 }
 
+// IgnoreChangesList provides a list of paths to fields in a resource spec
+// that should be ignored when determining the changes to be applied
+// to a resource that has already been deployed.
+// Paths are in the same format as the field paths reported in resource changes,
+// for example, "spec.tags" or "spec.replicas[0].size".
+// This can include extra information about the locations of
+// elements in the list in the original source,
+// depending on the source format.
+type IgnoreChangesList struct {
+	StringList
+}
+
+func (t *IgnoreChangesList) MarshalYAML() (any, error) {
+	return t.StringList.MarshalYAML()
+}
+
+func (t *IgnoreChangesList) UnmarshalYAML(value *yaml.Node) error {
+	return t.StringList.unmarshalYAML(value, errInvalidIgnoreChangesType, "ignore changes path")
+}
+
+func (t *IgnoreChangesList) MarshalJSON() ([]byte, error) {
+	return t.StringList.MarshalJSON()
+}
+
+func (t *IgnoreChangesList) UnmarshalJSON(data []byte) error {
+	return t.unmarshalJSON(data, errInvalidIgnoreChangesType, "ignore changes path")
+}
+
+func (t *IgnoreChangesList) FromJSONNode(
+	node *json.Node,
+	linePositions []int,
+	parentPath string,
+) error {
+	return t.StringList.FromJSONNode(node, linePositions, parentPath)
+}
+
 // ResourceTypeWrapper provides a struct that holds a resource type
 // value.
 type ResourceTypeWrapper struct {
@@ -0,0 +1,88 @@
+package schema
+
+import (
+	json "github.com/coreos/go-json"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"gopkg.in/yaml.v3"
+)
+
+// ResourceTimeouts holds the maximum amount of time to wait for a provider
+// to create, update or destroy a resource.
+// Each timeout is a duration string such as "30s", "10m" or "1h30m".
+// Timeouts set for a resource in a blueprint take precedence over the defaults
+// provided in the spec definition for the resource type.
+type ResourceTimeouts struct {
+	Create     *core.ScalarValue `yaml:"create,omitempty" json:"create,omitempty"`
+	Update     *core.ScalarValue `yaml:"update,omitempty" json:"update,omitempty"`
+	Destroy    *core.ScalarValue `yaml:"destroy,omitempty" json:"destroy,omitempty"`
+	SourceMeta *source.Meta      `yaml:"-" json:"-"`
+}
+
+func (t *ResourceTimeouts) UnmarshalYAML(value *yaml.Node) error {
+	t.SourceMeta = &source.Meta{
+		Position: source.Position{
+			Line:   value.Line,
+			Column: value.Column,
+		},
+	}
+
+	type resourceTimeoutsAlias ResourceTimeouts
+	var alias resourceTimeoutsAlias
+	if err := value.Decode(&alias); err != nil {
+		return wrapErrorWithLineInfo(err, value)
+	}
+
+	t.Create = alias.Create
+	t.Update = alias.Update
+	t.Destroy = alias.Destroy
+
+	return nil
+}
+
+func (t *ResourceTimeouts) FromJSONNode(
+	node *json.Node,
+	linePositions []int,
+	parentPath string,
+) error {
+	nodeMap, ok := node.Value.(map[string]json.Node)
+	if !ok {
+		position := source.PositionFromJSONNode(node, linePositions)
+		return errInvalidMap(&position, parentPath)
+	}
+
+	timeoutFields := []struct {
+		key    string
+		target **core.ScalarValue
+	}{
+		{key: "create", target: &t.Create},
+		{key: "update", target: &t.Update},
+		{key: "destroy", target: &t.Destroy},
+	}
+	for _, field := range timeoutFields {
+		if _, hasField := nodeMap[field.key]; !hasField {
+			continue
+		}
+
+		*field.target = &core.ScalarValue{}
+		err := core.UnpackValueFromJSONMapNode(
+			nodeMap,
+			field.key,
+			*field.target,
+			linePositions,
+			parentPath,
+			/* parentIsRoot */ false,
+			/* required */ false,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	t.SourceMeta = source.ExtractSourcePositionFromJSONNode(
+		node,
+		linePositions,
+	)
+
+	return nil
+}
@@ -15,11 +15,24 @@ type Blueprint struct {
 	Transform   *TransformValueWrapper `yaml:"transform,omitempty" json:"transform,omitempty"`
 	Variables   *VariableMap           `yaml:"variables,omitempty" json:"variables,omitempty"`
 	Values      *ValueMap              `yaml:"values,omitempty" json:"values,omitempty"`
+	Functions   *FunctionMap           `yaml:"functions,omitempty" json:"functions,omitempty"`
 	Include     *IncludeMap            `yaml:"include,omitempty" json:"include,omitempty"`
 	Resources   *ResourceMap           `yaml:"resources" json:"resources"`
 	DataSources *DataSourceMap         `yaml:"datasources,omitempty" json:"datasources,omitempty"`
 	Exports     *ExportMap             `yaml:"exports,omitempty" json:"exports,omitempty"`
+	Hooks       *HookList              `yaml:"hooks,omitempty" json:"hooks,omitempty"`
+	Links       *LinkConfigMap         `yaml:"links,omitempty" json:"links,omitempty"`
 	Metadata    *core.MappingNode      `yaml:"metadata,omitempty" json:"metadata,omitempty"`
+	// Suppressions holds the directives extracted from comments in the source
+	// document that suppress diagnostics for specific elements.
+	// This is only populated when loading from YAML, JWCC and HCL source documents.
+	Suppressions []*Suppression `yaml:"-" json:"-"`
+	// DuplicateDefinitions holds the elements that are defined more than once
+	// in the same section of the source document, only the last definition
+	// of an element is kept in the blueprint.
+	// This is only populated when loading from YAML, JWCC, HCL and blueprint language
+	// source documents.
+	DuplicateDefinitions []*DuplicateDefinition `yaml:"-" json:"-"`
 }
 
 // VariableMap provides a mapping of names to variable values
@@ -204,6 +217,97 @@ func (m *ValueMap) FromJSONNode(
 	return nil
 }
 
+// FunctionMap provides a mapping of names to user-defined
+// function definitions in a blueprint.
+// This includes extra information about the locations of
+// the keys in the original source being unmarshalled.
+// This information will not always be present, it is populated
+// when unmarshalling from YAML and JWCC source documents.
+type FunctionMap struct {
+	Values map[string]*Function
+	// Mapping of function names to their source locations.
+	SourceMeta map[string]*source.Meta
+}
+
+func (m *FunctionMap) MarshalYAML() (any, error) {
+	return m.Values, nil
+}
+
+func (m *FunctionMap) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.MappingNode {
+		return errInvalidMap(core.YAMLNodeToPosInfo(value), "functions")
+	}
+
+	m.Values = make(map[string]*Function)
+	m.SourceMeta = make(map[string]*source.Meta)
+	for i := 0; i < len(value.Content); i += 2 {
+		key := value.Content[i]
+		val := value.Content[i+1]
+
+		m.SourceMeta[key.Value] = &source.Meta{
+			Position: source.Position{
+				Line:   key.Line,
+				Column: key.Column,
+			},
+		}
+
+		var function Function
+		err := val.Decode(&function)
+		if err != nil {
+			return err
+		}
+
+		m.Values[key.Value] = &function
+	}
+
+	return nil
+}
+
+func (m *FunctionMap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Values)
+}
+
+func (m *FunctionMap) UnmarshalJSON(data []byte) error {
+	values := make(map[string]*Function)
+	err := json.Unmarshal(data, &values)
+	if err != nil {
+		return err
+	}
+
+	m.Values = values
+	return nil
+}
+
+func (m *FunctionMap) FromJSONNode(
+	node *json.Node,
+	linePositions []int,
+	parentPath string,
+) error {
+	functionNodes, ok := node.Value.(map[string]json.Node)
+	if !ok {
+		position := source.PositionFromJSONNode(node, linePositions)
+		return errInvalidMap(&position, parentPath)
+	}
+
+	m.Values = map[string]*Function{}
+	m.SourceMeta = map[string]*source.Meta{}
+	for key, functionNode := range functionNodes {
+		m.SourceMeta[key] = source.ExtractSourcePositionFromJSONNode(
+			&functionNode,
+			linePositions,
+		)
+		function := &Function{}
+		functionPath := core.CreateJSONNodePath(key, parentPath, false /* parentIsRoot */)
+		err := function.FromJSONNode(&functionNode, linePositions, functionPath)
+		if err != nil {
+			return err
+		}
+		m.Values[key] = function
+	}
+
+	return nil
+}
+
 // IncludeMap provides a mapping of names to child
 // blueprint includes.
 // This includes extra information about the locations of
@@ -564,3 +668,99 @@ func (m *ExportMap) FromJSONNode(
 
 	return nil
 }
+
+// LinkConfigMap provides a mapping of link names to configuration
+// that overrides the defaults of the link implementation for a specific
+// link between two resources in a blueprint.
+// Link names are in the format "{resourceA}::{resourceB}", where resourceA
+// is the resource that selects resourceB by label.
+// The configuration for each link is validated against the schema
+// provided by the link implementation.
+// This includes extra information about the locations of
+// the keys in the original source being unmarshalled.
+// This information will not always be present, it is populated
+// when unmarshalling from YAML and JWCC source documents.
+type LinkConfigMap struct {
+	Values map[string]*core.MappingNode
+	// Mapping of link names to their source locations.
+	SourceMeta map[string]*source.Meta
+}
+
+func (m *LinkConfigMap) MarshalYAML() (any, error) {
+	return m.Values, nil
+}
+
+func (m *LinkConfigMap) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.MappingNode {
+		return errInvalidMap(core.YAMLNodeToPosInfo(value), "links")
+	}
+
+	m.Values = make(map[string]*core.MappingNode)
+	m.SourceMeta = make(map[string]*source.Meta)
+	for i := 0; i < len(value.Content); i += 2 {
+		key := value.Content[i]
+		val := value.Content[i+1]
+
+		m.SourceMeta[key.Value] = &source.Meta{
+			Position: source.Position{
+				Line:   key.Line,
+				Column: key.Column,
+			},
+		}
+
+		var linkConfig core.MappingNode
+		err := val.Decode(&linkConfig)
+		if err != nil {
+			return err
+		}
+
+		m.Values[key.Value] = &linkConfig
+	}
+
+	return nil
+}
+
+func (m *LinkConfigMap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Values)
+}
+
+func (m *LinkConfigMap) UnmarshalJSON(data []byte) error {
+	values := make(map[string]*core.MappingNode)
+	err := json.Unmarshal(data, &values)
+	if err != nil {
+		return err
+	}
+
+	m.Values = values
+	return nil
+}
+
+func (m *LinkConfigMap) FromJSONNode(
+	node *json.Node,
+	linePositions []int,
+	parentPath string,
+) error {
+	linkConfigNodes, ok := node.Value.(map[string]json.Node)
+	if !ok {
+		position := source.PositionFromJSONNode(node, linePositions)
+		return errInvalidMap(&position, parentPath)
+	}
+
+	m.Values = map[string]*core.MappingNode{}
+	m.SourceMeta = map[string]*source.Meta{}
+	for key, linkConfigNode := range linkConfigNodes {
+		m.SourceMeta[key] = source.ExtractSourcePositionFromJSONNode(
+			&linkConfigNode,
+			linePositions,
+		)
+		linkConfig := &core.MappingNode{}
+		linkConfigPath := core.CreateJSONNodePath(key, parentPath, false /* parentIsRoot */)
+		err := linkConfig.FromJSONNode(&linkConfigNode, linePositions, linkConfigPath)
+		if err != nil {
+			return err
+		}
+		m.Values[key] = linkConfig
+	}
+
+	return nil
+}
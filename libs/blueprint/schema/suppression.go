@@ -0,0 +1,168 @@
+package schema
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+)
+
+// SuppressionDirective is the directive used in a comment in a blueprint document
+// to suppress diagnostics with specific reason codes for a single element.
+//
+// For example, in a YAML document:
+//
+//	variables:
+//	  # bluelink:disable unused_variable -- kept for the next release
+//	  legacyRegion:
+//	    type: string
+//
+// Or in a JSONC document:
+//
+//	"variables": {
+//	  // bluelink:disable unused_variable
+//	  "legacyRegion": { "type": "string" }
+//	}
+//
+// Multiple reason codes can be separated by commas or spaces and an optional
+// reason for suppressing the diagnostics can be provided after "--".
+const SuppressionDirective = "bluelink:disable"
+
+// Suppression holds a directive extracted from a comment in a blueprint document
+// that suppresses diagnostics with specific reason codes for the element
+// the comment is attached to.
+type Suppression struct {
+	// ReasonCodes holds the reason codes of the diagnostics
+	// to suppress for the element.
+	ReasonCodes []string
+	// Reason holds the justification provided for suppressing
+	// the diagnostics, this is empty when no reason is provided.
+	Reason string
+	// ElementLine is the line of the element that the directive is attached to,
+	// this is the line of the comment for a trailing comment, otherwise it is
+	// the next line that is not blank or a comment.
+	ElementLine int
+	// SourceMeta holds the position of the directive comment.
+	SourceMeta *source.Meta
+}
+
+var suppressionDirectivePattern = regexp.MustCompile(
+	`^(#|//|/\*)\s*` + regexp.QuoteMeta(SuppressionDirective) + `\s+(.*)$`,
+)
+
+// ExtractSuppressions extracts suppression directives from the comments
+// in a YAML or JSON with Commas and Comments blueprint document.
+func ExtractSuppressions(document string) []*Suppression {
+	lines := strings.Split(document, "\n")
+	suppressions := []*Suppression{}
+	// Directives on standalone comment lines are attached to the next
+	// element, so they are held until a line with content is found.
+	pending := []*Suppression{}
+	for i, line := range lines {
+		lineNumber := i + 1
+		commentStart := findCommentStart(line)
+		var match []string
+		if commentStart > -1 {
+			match = suppressionDirectivePattern.FindStringSubmatch(line[commentStart:])
+		}
+		if match == nil {
+			if !isCommentOrBlankLine(line) {
+				suppressions = append(suppressions, attachSuppressions(pending, lineNumber)...)
+				pending = []*Suppression{}
+			}
+			continue
+		}
+
+		suppression := parseSuppression(match[2])
+		if suppression == nil {
+			continue
+		}
+		suppression.SourceMeta = &source.Meta{
+			Position: source.Position{
+				Line:   lineNumber,
+				Column: commentStart + 1,
+			},
+		}
+
+		if strings.TrimSpace(line[:commentStart]) != "" {
+			suppression.ElementLine = lineNumber
+			suppressions = append(suppressions, suppression)
+		} else {
+			pending = append(pending, suppression)
+		}
+	}
+
+	if len(suppressions) == 0 {
+		return nil
+	}
+
+	return suppressions
+}
+
+// Finds the start of a comment in a line of a YAML or JWCC document,
+// skipping over comment markers in quoted strings.
+// A comment marker must be at the start of a line or follow whitespace,
+// this returns -1 when the line does not contain a comment.
+func findCommentStart(line string) int {
+	var quote byte
+	for i := 0; i < len(line); i += 1 {
+		char := line[i]
+		if quote != 0 {
+			if char == '\\' && quote == '"' {
+				// Skip the escaped character.
+				i += 1
+			} else if char == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		precededByWhitespace := i == 0 || line[i-1] == ' ' || line[i-1] == '\t'
+		// Quotes only start a string at the start of a value so that apostrophes
+		// in unquoted YAML strings are not treated as quotes.
+		startsValue := precededByWhitespace || strings.ContainsRune(":[{,", rune(line[i-1]))
+		switch {
+		case startsValue && (char == '"' || char == '\''):
+			quote = char
+		case precededByWhitespace && char == '#':
+			return i
+		case precededByWhitespace && (strings.HasPrefix(line[i:], "//") ||
+			strings.HasPrefix(line[i:], "/*")):
+			return i
+		}
+	}
+
+	return -1
+}
+
+func parseSuppression(directive string) *Suppression {
+	directive = strings.TrimSuffix(strings.TrimSpace(directive), "*/")
+	codes, reason, _ := strings.Cut(directive, "--")
+
+	reasonCodes := strings.FieldsFunc(codes, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+	if len(reasonCodes) == 0 {
+		return nil
+	}
+
+	return &Suppression{
+		ReasonCodes: reasonCodes,
+		Reason:      strings.TrimSpace(reason),
+	}
+}
+
+func attachSuppressions(suppressions []*Suppression, elementLine int) []*Suppression {
+	for _, suppression := range suppressions {
+		suppression.ElementLine = elementLine
+	}
+	return suppressions
+}
+
+func isCommentOrBlankLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed == "" ||
+		strings.HasPrefix(trimmed, "#") ||
+		strings.HasPrefix(trimmed, "//") ||
+		strings.HasPrefix(trimmed, "/*")
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuppressionTestSuite struct {
+	suite.Suite
+}
+
+func (s *SuppressionTestSuite) Test_extracts_suppressions_from_yaml_comments() {
+	suppressions := ExtractSuppressions(`version: 2025-11-02
+variables:
+  # Region for the next release.
+  # bluelink:disable unused_variable, any_type_warning -- kept for the next release
+
+  region:
+    type: string
+  environment: # bluelink:disable unused_variable
+    type: string
+  description:
+    type: string
+    default: "use #bluelink:disable to silence warnings"
+  owner:
+    type: string
+    description: it's used by the ops team # bluelink:disable any_type_warning
+`)
+
+	s.Assert().Equal(
+		[]*Suppression{
+			{
+				ReasonCodes: []string{"unused_variable", "any_type_warning"},
+				Reason:      "kept for the next release",
+				ElementLine: 6,
+				SourceMeta: &source.Meta{
+					Position: source.Position{Line: 4, Column: 3},
+				},
+			},
+			{
+				ReasonCodes: []string{"unused_variable"},
+				ElementLine: 8,
+				SourceMeta: &source.Meta{
+					Position: source.Position{Line: 8, Column: 16},
+				},
+			},
+			{
+				ReasonCodes: []string{"any_type_warning"},
+				ElementLine: 15,
+				SourceMeta: &source.Meta{
+					Position: source.Position{Line: 15, Column: 44},
+				},
+			},
+		},
+		suppressions,
+	)
+}
+
+func (s *SuppressionTestSuite) Test_extracts_suppressions_from_jwcc_comments() {
+	suppressions := ExtractSuppressions(`{
+  "variables": {
+    /* bluelink:disable unused_variable */
+    "region": { "type": "string" },
+    "environment": { "type": "string" } // bluelink:disable unused_variable
+  }
+}`)
+
+	s.Assert().Equal(
+		[]*Suppression{
+			{
+				ReasonCodes: []string{"unused_variable"},
+				ElementLine: 4,
+				SourceMeta: &source.Meta{
+					Position: source.Position{Line: 3, Column: 5},
+				},
+			},
+			{
+				ReasonCodes: []string{"unused_variable"},
+				ElementLine: 5,
+				SourceMeta: &source.Meta{
+					Position: source.Position{Line: 5, Column: 41},
+				},
+			},
+		},
+		suppressions,
+	)
+}
+
+func (s *SuppressionTestSuite) Test_ignores_directives_without_reason_codes() {
+	s.Assert().Nil(ExtractSuppressions("variables:\n  # bluelink:disable -- no codes\n  region:\n"))
+}
+
+func TestSuppressionTestSuite(t *testing.T) {
+	suite.Run(t, new(SuppressionTestSuite))
+}
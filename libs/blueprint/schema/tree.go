@@ -314,6 +314,14 @@ func variableToTreeNode(varName string, variable *Variable, parentPath string, l
 		children = append(children, allowedValuesNode)
 	}
 
+	allowedValuesFromNode := allowedValuesFromToTreeNode(
+		variable.AllowedValuesFrom,
+		variableNode.Path,
+	)
+	if allowedValuesFromNode != nil {
+		children = append(children, allowedValuesFromNode)
+	}
+
 	sortTreeNodes(children)
 	variableNode.Children = children
 	setRangeEndFromChildren(variableNode, children)
@@ -321,6 +329,53 @@ func variableToTreeNode(varName string, variable *Variable, parentPath string, l
 	return variableNode
 }
 
+func allowedValuesFromToTreeNode(allowedValuesFrom *AllowedValuesFrom, parentPath string) *TreeNode {
+	if allowedValuesFrom == nil || allowedValuesFrom.SourceMeta == nil {
+		return nil
+	}
+
+	allowedValuesFromNode := &TreeNode{
+		Label:         "allowedValuesFrom",
+		Path:          fmt.Sprintf("%s/allowedValuesFrom", parentPath),
+		Type:          TreeNodeTypeNonTerminal,
+		SchemaElement: allowedValuesFrom,
+		Range: &source.Range{
+			Start: &allowedValuesFrom.SourceMeta.Position,
+		},
+	}
+
+	children := []*TreeNode{}
+
+	dataSourceTypeNode := scalarToTreeNode(
+		"dataSourceType",
+		allowedValuesFrom.DataSourceType,
+		allowedValuesFromNode.Path,
+	)
+	if dataSourceTypeNode != nil {
+		children = append(children, dataSourceTypeNode)
+	}
+
+	fieldNode := scalarToTreeNode("field", allowedValuesFrom.Field, allowedValuesFromNode.Path)
+	if fieldNode != nil {
+		children = append(children, fieldNode)
+	}
+
+	filtersNode := dataSourceFiltersToTreeNode(
+		allowedValuesFrom.Filter,
+		allowedValuesFromNode.Path,
+		/* keyMeta */ nil,
+	)
+	if filtersNode != nil {
+		children = append(children, filtersNode)
+	}
+
+	sortTreeNodes(children)
+	allowedValuesFromNode.Children = children
+	setRangeEndFromChildren(allowedValuesFromNode, children)
+
+	return allowedValuesFromNode
+}
+
 func variableTypeToTreeNode(varType *VariableTypeWrapper, parentPath string) *TreeNode {
 	if varType == nil {
 		return nil
@@ -515,6 +570,13 @@ func includeToTreeNode(includeName string, include *Include, parentPath string,
 		children = append(children, descriptionNode)
 	}
 
+	dependsOnNode := dependsOnToTreeNode(
+		include.DependsOn, includeNode.Path, include.FieldsSourceMeta["dependsOn"],
+	)
+	if dependsOnNode != nil {
+		children = append(children, dependsOnNode)
+	}
+
 	sortTreeNodes(children)
 	includeNode.Children = children
 	setRangeEndFromChildren(includeNode, children)
@@ -598,6 +660,13 @@ func resourceToTreeNode(resourceName string, resource *Resource, parentPath stri
 		children = append(children, dependsOnNode)
 	}
 
+	ignoreChangesNode := ignoreChangesToTreeNode(
+		resource.IgnoreChanges, resourceNode.Path, resource.FieldsSourceMeta["ignoreChanges"],
+	)
+	if ignoreChangesNode != nil {
+		children = append(children, ignoreChangesNode)
+	}
+
 	eachNode := stringSubsToTreeNode("each", resource.Each, resourceNode.Path)
 	if eachNode != nil {
 		children = append(children, eachNode)
@@ -683,6 +752,17 @@ func dependsOnToTreeNode(
 	return stringListToTreeNode("dependsOn", &dependsOn.StringList, parentPath, keyMeta)
 }
 
+func ignoreChangesToTreeNode(
+	ignoreChanges *IgnoreChangesList,
+	parentPath string,
+	keyMeta *source.Meta,
+) *TreeNode {
+	if ignoreChanges == nil || len(ignoreChanges.Values) == 0 {
+		return nil
+	}
+	return stringListToTreeNode("ignoreChanges", &ignoreChanges.StringList, parentPath, keyMeta)
+}
+
 func resourceConditionToTreeNode(label string, condition *Condition, parentPath string) *TreeNode {
 	if condition == nil || condition.SourceMeta == nil {
 		return nil
@@ -31,3 +31,27 @@ func GetResourceRemovalPolicy(resource *Resource) string {
 
 	return string(resource.RemovalPolicy.Value)
 }
+
+// GetResourceReplaceStrategy safely extracts the replace strategy value from a
+// resource, returning an empty string if the wrapper or resource is nil or
+// the value is unset.
+// An empty value should be treated by callers as the default strategy
+// for the resource type.
+func GetResourceReplaceStrategy(resource *Resource) ReplaceStrategy {
+	if resource == nil || resource.ReplaceStrategy == nil {
+		return ""
+	}
+
+	return resource.ReplaceStrategy.Value
+}
+
+// GetResourceIgnoreChanges safely extracts the list of spec field paths
+// for which changes should be ignored from a resource,
+// returning nil if the list or resource is nil.
+func GetResourceIgnoreChanges(resource *Resource) []string {
+	if resource == nil || resource.IgnoreChanges == nil {
+		return nil
+	}
+
+	return resource.IgnoreChanges.Values
+}
@@ -14,6 +14,13 @@ import (
 
 // Value provides the definition of a value
 // that can be used in a blueprint.
+//
+// Values can reference the deployed state of resources and child blueprints,
+// these values are resolved in two phases, before deployment they are unknown
+// and are resolved once the resources or child blueprints they depend on
+// have been deployed (e.g. when resolving exports after a deployment).
+// For this reason, values that depend on deployed state can not be used
+// in the `each` or `condition` properties of a resource.
 type Value struct {
 	Type        *ValueTypeWrapper                    `yaml:"type" json:"type"`
 	Value       *bpcore.MappingNode                  `yaml:"value" json:"value"`
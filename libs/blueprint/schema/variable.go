@@ -18,7 +18,11 @@ type Variable struct {
 	Secret        *bpcore.ScalarValue   `yaml:"secret" json:"secret"`
 	Default       *bpcore.ScalarValue   `yaml:"default,omitempty" json:"default,omitempty"`
 	AllowedValues []*bpcore.ScalarValue `yaml:"allowedValues,omitempty" json:"allowedValues,omitempty"`
-	SourceMeta    *source.Meta          `yaml:"-" json:"-"`
+	// AllowedValuesFrom sources the allowed values for the variable
+	// from the results of a data source lookup.
+	// This can not be used in combination with AllowedValues.
+	AllowedValuesFrom *AllowedValuesFrom `yaml:"allowedValuesFrom,omitempty" json:"allowedValuesFrom,omitempty"`
+	SourceMeta        *source.Meta       `yaml:"-" json:"-"`
 }
 
 func (v *Variable) UnmarshalYAML(value *yaml.Node) error {
@@ -40,6 +44,7 @@ func (v *Variable) UnmarshalYAML(value *yaml.Node) error {
 	v.Secret = alias.Secret
 	v.Default = alias.Default
 	v.AllowedValues = alias.AllowedValues
+	v.AllowedValuesFrom = alias.AllowedValuesFrom
 
 	return nil
 }
@@ -121,6 +126,22 @@ func (v *Variable) FromJSONNode(node *json.Node, linePositions []int, parentPath
 		return err
 	}
 
+	if _, hasAllowedValuesFrom := nodeMap["allowedValuesFrom"]; hasAllowedValuesFrom {
+		v.AllowedValuesFrom = &AllowedValuesFrom{}
+		err = bpcore.UnpackValueFromJSONMapNode(
+			nodeMap,
+			"allowedValuesFrom",
+			v.AllowedValuesFrom,
+			linePositions,
+			/* parentPath */ parentPath,
+			/* parentIsRoot */ false,
+			/* required */ false,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	v.SourceMeta = source.ExtractSourcePositionFromJSONNode(
 		node,
 		linePositions,
@@ -129,6 +150,107 @@ func (v *Variable) FromJSONNode(node *json.Node, linePositions []int, parentPath
 	return nil
 }
 
+// AllowedValuesFrom provides the definition of a data source lookup
+// that produces the allowed values for a variable.
+// The data source is fetched during validation so that the allowed values
+// stay in sync with the current state of the upstream provider,
+// for example, the instance types that are available in a region.
+type AllowedValuesFrom struct {
+	// DataSourceType is the type of data source to fetch the allowed values from,
+	// for example, "aws/ec2/instanceTypes".
+	DataSourceType *bpcore.ScalarValue `yaml:"dataSourceType" json:"dataSourceType"`
+	// Field is the field in the data source output that holds the allowed values.
+	// The field can hold a single scalar value or an array of scalar values.
+	Field *bpcore.ScalarValue `yaml:"field" json:"field"`
+	// Filter holds optional filters to select the data source instance,
+	// search values must be literals as allowed values are resolved
+	// before substitutions are available.
+	Filter     *DataSourceFilters `yaml:"filter,omitempty" json:"filter,omitempty"`
+	SourceMeta *source.Meta       `yaml:"-" json:"-"`
+}
+
+func (a *AllowedValuesFrom) UnmarshalYAML(value *yaml.Node) error {
+	a.SourceMeta = &source.Meta{
+		Position: source.Position{
+			Line:   value.Line,
+			Column: value.Column,
+		},
+	}
+
+	type allowedValuesFromAlias AllowedValuesFrom
+	var alias allowedValuesFromAlias
+	if err := value.Decode(&alias); err != nil {
+		return wrapErrorWithLineInfo(err, value)
+	}
+
+	a.DataSourceType = alias.DataSourceType
+	a.Field = alias.Field
+	a.Filter = alias.Filter
+
+	return nil
+}
+
+func (a *AllowedValuesFrom) FromJSONNode(node *json.Node, linePositions []int, parentPath string) error {
+	nodeMap, ok := node.Value.(map[string]json.Node)
+	if !ok {
+		position := source.PositionFromJSONNode(node, linePositions)
+		return errInvalidMap(&position, parentPath)
+	}
+
+	a.DataSourceType = &bpcore.ScalarValue{}
+	err := bpcore.UnpackValueFromJSONMapNode(
+		nodeMap,
+		"dataSourceType",
+		a.DataSourceType,
+		linePositions,
+		/* parentPath */ parentPath,
+		/* parentIsRoot */ false,
+		/* required */ true,
+		bpcore.WithParentNode(node),
+	)
+	if err != nil {
+		return err
+	}
+
+	a.Field = &bpcore.ScalarValue{}
+	err = bpcore.UnpackValueFromJSONMapNode(
+		nodeMap,
+		"field",
+		a.Field,
+		linePositions,
+		/* parentPath */ parentPath,
+		/* parentIsRoot */ false,
+		/* required */ true,
+		bpcore.WithParentNode(node),
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, hasFilter := nodeMap["filter"]; hasFilter {
+		a.Filter = &DataSourceFilters{}
+		err = bpcore.UnpackValueFromJSONMapNode(
+			nodeMap,
+			"filter",
+			a.Filter,
+			linePositions,
+			/* parentPath */ parentPath,
+			/* parentIsRoot */ false,
+			/* required */ false,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	a.SourceMeta = source.ExtractSourcePositionFromJSONNode(
+		node,
+		linePositions,
+	)
+
+	return nil
+}
+
 // VariableTypeWrapper provides a struct that holds a variable type
 // value.
 type VariableTypeWrapper struct {
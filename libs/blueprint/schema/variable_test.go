@@ -25,6 +25,7 @@ func (s *VariableTestSuite) SetUpSuite(c *C) {
 	s.specFixtures = make(map[string][]byte)
 	fixturesToLoad := map[string]string{
 		"passYAML":              "__testdata/variables/pass.yml",
+		"passAllowedValuesFrom": "__testdata/variables/pass-allowed-values-from.yml",
 		"serialiseExpectedYAML": "__testdata/variables/serialise-expected.yml",
 		"passJSON":              "__testdata/variables/pass.json",
 		"serialiseExpectedJSON": "__testdata/variables/serialise-expected.json",
@@ -57,6 +58,23 @@ func (s *VariableTestSuite) Test_parses_valid_variable_yaml_input(c *C) {
 	c.Assert(targetVar.SourceMeta.Column, Equals, 1)
 }
 
+func (s *VariableTestSuite) Test_parses_variable_with_allowed_values_from_yaml_input(c *C) {
+	targetVar := &Variable{}
+	err := yaml.Unmarshal([]byte(s.specFixtures["passAllowedValuesFrom"]), targetVar)
+	if err != nil {
+		c.Error(err)
+		c.FailNow()
+	}
+
+	c.Assert(targetVar.AllowedValuesFrom, NotNil)
+	c.Assert(*targetVar.AllowedValuesFrom.DataSourceType.StringValue, Equals, "aws/ec2/instanceTypes")
+	c.Assert(*targetVar.AllowedValuesFrom.Field.StringValue, Equals, "instanceTypes")
+	c.Assert(targetVar.AllowedValuesFrom.Filter.Filters, HasLen, 1)
+	c.Assert(*targetVar.AllowedValuesFrom.Filter.Filters[0].Field.StringValue, Equals, "region")
+	c.Assert(targetVar.AllowedValuesFrom.SourceMeta, NotNil)
+	c.Assert(targetVar.AllowedValuesFrom.SourceMeta.Line, Equals, 4)
+}
+
 func (s *VariableTestSuite) Test_serialise_valid_variable_yaml_input(c *C) {
 	expected := &Variable{}
 	err := yaml.Unmarshal([]byte(s.specFixtures["serialiseExpectedYAML"]), expected)
@@ -29,6 +29,11 @@ func ToSchemaPB(blueprint *schema.Blueprint) (*schemapb.Blueprint, error) {
 		return nil, err
 	}
 
+	functions, err := toFunctionsPB(blueprint.Functions)
+	if err != nil {
+		return nil, err
+	}
+
 	includes, err := toIncludesPB(blueprint.Include)
 	if err != nil {
 		return nil, err
@@ -49,6 +54,16 @@ func ToSchemaPB(blueprint *schema.Blueprint) (*schemapb.Blueprint, error) {
 		return nil, err
 	}
 
+	hooks, err := toHooksPB(blueprint.Hooks)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := toLinksPB(blueprint.Links)
+	if err != nil {
+		return nil, err
+	}
+
 	metadata, err := ToMappingNodePB(blueprint.Metadata, true)
 	if err != nil {
 		return nil, err
@@ -69,10 +84,13 @@ func ToSchemaPB(blueprint *schema.Blueprint) (*schemapb.Blueprint, error) {
 		Transform:   transform,
 		Variables:   variables,
 		Values:      values,
+		Functions:   functions,
 		Include:     includes,
 		Resources:   resources,
 		DataSources: dataSources,
 		Exports:     exports,
+		Hooks:       hooks,
+		Links:       links,
 		Metadata:    metadata,
 	}, nil
 }
@@ -159,6 +177,33 @@ func toValuesPB(values *schema.ValueMap) (map[string]*schemapb.Value, error) {
 	return valuesPB, nil
 }
 
+func toFunctionsPB(functions *schema.FunctionMap) (map[string]*schemapb.Function, error) {
+	if functions == nil {
+		return nil, nil
+	}
+
+	var functionsPB = make(map[string]*schemapb.Function)
+	for k, v := range functions.Values {
+		descriptionPB, err := ToScalarValuePB(v.Description, true)
+		if err != nil {
+			return nil, err
+		}
+
+		valuePB, err := toStringOrSubstitutionsPB(v.Value, false)
+		if err != nil {
+			return nil, err
+		}
+
+		functionsPB[k] = &schemapb.Function{
+			Description: descriptionPB,
+			Parameters:  v.ParameterNames(),
+			Value:       valuePB,
+		}
+	}
+
+	return functionsPB, nil
+}
+
 func toIncludesPB(includes *schema.IncludeMap) (map[string]*schemapb.Include, error) {
 	if includes == nil {
 		return nil, nil
@@ -186,11 +231,17 @@ func toIncludesPB(includes *schema.IncludeMap) (map[string]*schemapb.Include, er
 			return nil, err
 		}
 
+		dependsOn := []string{}
+		if v.DependsOn != nil {
+			dependsOn = v.DependsOn.Values
+		}
+
 		includesPB[k] = &schemapb.Include{
 			Path:        path,
 			Variables:   variablesPB,
 			Metadata:    metadataPB,
 			Description: descriptionPB,
+			DependsOn:   dependsOn,
 		}
 	}
 
@@ -458,22 +509,68 @@ func ToResourcePB(resource *schema.Resource) (*schemapb.Resource, error) {
 		resType = string(resource.Type.Value)
 	}
 
+	ignoreChanges := []string{}
+	if resource.IgnoreChanges != nil {
+		ignoreChanges = resource.IgnoreChanges.Values
+	}
+
 	var removalPolicy *string
 	if resource.RemovalPolicy != nil && resource.RemovalPolicy.Value != "" {
 		policyVal := string(resource.RemovalPolicy.Value)
 		removalPolicy = &policyVal
 	}
 
+	var replaceStrategy *string
+	if resource.ReplaceStrategy != nil && resource.ReplaceStrategy.Value != "" {
+		strategyVal := string(resource.ReplaceStrategy.Value)
+		replaceStrategy = &strategyVal
+	}
+
+	timeoutsPB, err := toResourceTimeoutsPB(resource.Timeouts)
+	if err != nil {
+		return nil, err
+	}
+
 	return &schemapb.Resource{
-		Type:          resType,
-		Description:   descriptionPB,
-		Condition:     conditionPB,
-		Each:          eachPB,
-		Metadata:      resourceMetadataPB,
-		DependsOn:     dependsOn,
-		LinkSelector:  ToLinkSelectorPB(resource.LinkSelector),
-		Spec:          specPB,
-		RemovalPolicy: removalPolicy,
+		Type:            resType,
+		Description:     descriptionPB,
+		Condition:       conditionPB,
+		Each:            eachPB,
+		Metadata:        resourceMetadataPB,
+		DependsOn:       dependsOn,
+		LinkSelector:    ToLinkSelectorPB(resource.LinkSelector),
+		Spec:            specPB,
+		RemovalPolicy:   removalPolicy,
+		IgnoreChanges:   ignoreChanges,
+		ReplaceStrategy: replaceStrategy,
+		Timeouts:        timeoutsPB,
+	}, nil
+}
+
+func toResourceTimeoutsPB(timeouts *schema.ResourceTimeouts) (*schemapb.ResourceTimeouts, error) {
+	if timeouts == nil {
+		return nil, nil
+	}
+
+	createPB, err := ToScalarValuePB(timeouts.Create, true)
+	if err != nil {
+		return nil, err
+	}
+
+	updatePB, err := ToScalarValuePB(timeouts.Update, true)
+	if err != nil {
+		return nil, err
+	}
+
+	destroyPB, err := ToScalarValuePB(timeouts.Destroy, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemapb.ResourceTimeouts{
+		Create:  createPB,
+		Update:  updatePB,
+		Destroy: destroyPB,
 	}, nil
 }
 
@@ -495,6 +592,56 @@ func toExportsPB(exports *schema.ExportMap) (map[string]*schemapb.Export, error)
 	return exportsPB, nil
 }
 
+func toHooksPB(hooks *schema.HookList) ([]*schemapb.Hook, error) {
+	if hooks == nil {
+		return nil, nil
+	}
+
+	hooksPB := make([]*schemapb.Hook, len(hooks.Values))
+	for i, hook := range hooks.Values {
+		eventPB, err := ToScalarValuePB(hook.Event, false)
+		if err != nil {
+			return nil, err
+		}
+
+		resourcePB, err := ToScalarValuePB(hook.Resource, true)
+		if err != nil {
+			return nil, err
+		}
+
+		runPB, err := ToScalarValuePB(hook.Run, false)
+		if err != nil {
+			return nil, err
+		}
+
+		hooksPB[i] = &schemapb.Hook{
+			Event:    eventPB,
+			Resource: resourcePB,
+			Run:      runPB,
+		}
+	}
+
+	return hooksPB, nil
+}
+
+func toLinksPB(links *schema.LinkConfigMap) (map[string]*schemapb.MappingNode, error) {
+	if links == nil {
+		return nil, nil
+	}
+
+	linksPB := make(map[string]*schemapb.MappingNode)
+	for k, v := range links.Values {
+		linkConfigPB, err := ToMappingNodePB(v, false)
+		if err != nil {
+			return nil, err
+		}
+
+		linksPB[k] = linkConfigPB
+	}
+
+	return linksPB, nil
+}
+
 func toExportPB(export *schema.Export) (*schemapb.Export, error) {
 	if export == nil {
 		return nil, nil
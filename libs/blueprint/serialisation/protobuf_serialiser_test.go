@@ -201,6 +201,60 @@ func (s *ProtobufSerialiserTestSuite) Test_ToMappingNodePB_fails_for_nil_mapping
 	c.Assert(err.Error(), Equals, "expanded blueprint serialise error: required mapping node is set to nil")
 }
 
+func (s *ProtobufSerialiserTestSuite) Test_round_trips_resource_timeouts(c *C) {
+	createTimeout := "30m"
+	destroyTimeout := "10m"
+	resource := &schema.Resource{
+		Type: &schema.ResourceTypeWrapper{Value: "aws/lambda/function"},
+		Timeouts: &schema.ResourceTimeouts{
+			Create:  &core.ScalarValue{StringValue: &createTimeout},
+			Destroy: &core.ScalarValue{StringValue: &destroyTimeout},
+		},
+		Spec: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"runtime": {
+					Scalar: &core.ScalarValue{StringValue: &testRuntime},
+				},
+			},
+		},
+	}
+
+	resourcePB, err := ToResourcePB(resource)
+	c.Assert(err, IsNil)
+	c.Assert(resourcePB.Timeouts, NotNil)
+	c.Assert(resourcePB.Timeouts.Update, IsNil)
+
+	unmarshalled, err := FromResourcePB(resourcePB)
+	c.Assert(err, IsNil)
+	c.Assert(unmarshalled.Timeouts, NotNil)
+	c.Assert(unmarshalled.Timeouts.Create, NotNil)
+	c.Assert(*unmarshalled.Timeouts.Create.StringValue, Equals, createTimeout)
+	c.Assert(unmarshalled.Timeouts.Update, IsNil)
+	c.Assert(unmarshalled.Timeouts.Destroy, NotNil)
+	c.Assert(*unmarshalled.Timeouts.Destroy.StringValue, Equals, destroyTimeout)
+}
+
+func (s *ProtobufSerialiserTestSuite) Test_round_trips_resource_without_timeouts(c *C) {
+	resource := &schema.Resource{
+		Type: &schema.ResourceTypeWrapper{Value: "aws/lambda/function"},
+		Spec: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"runtime": {
+					Scalar: &core.ScalarValue{StringValue: &testRuntime},
+				},
+			},
+		},
+	}
+
+	resourcePB, err := ToResourcePB(resource)
+	c.Assert(err, IsNil)
+	c.Assert(resourcePB.Timeouts, IsNil)
+
+	unmarshalled, err := FromResourcePB(resourcePB)
+	c.Assert(err, IsNil)
+	c.Assert(unmarshalled.Timeouts, IsNil)
+}
+
 var testRuntime = "go1.x"
 var testTracingEnabled = true
 var version = "2021-12-18"
@@ -31,6 +31,11 @@ func FromSchemaPB(blueprintPB *schemapb.Blueprint) (*schema.Blueprint, error) {
 		return nil, err
 	}
 
+	functions, err := fromFunctionsPB(blueprintPB.Functions)
+	if err != nil {
+		return nil, err
+	}
+
 	includes, err := fromIncludesPB(blueprintPB.Include)
 	if err != nil {
 		return nil, err
@@ -51,6 +56,16 @@ func FromSchemaPB(blueprintPB *schemapb.Blueprint) (*schema.Blueprint, error) {
 		return nil, err
 	}
 
+	hooks, err := fromHooksPB(blueprintPB.Hooks)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := fromLinksPB(blueprintPB.Links)
+	if err != nil {
+		return nil, err
+	}
+
 	metadata, err := FromMappingNodePB(blueprintPB.Metadata, true)
 	if err != nil {
 		return nil, err
@@ -73,10 +88,13 @@ func FromSchemaPB(blueprintPB *schemapb.Blueprint) (*schema.Blueprint, error) {
 		Transform:   transform,
 		Variables:   variables,
 		Values:      values,
+		Functions:   functions,
 		Include:     includes,
 		Resources:   resources,
 		DataSources: dataSources,
 		Exports:     exports,
+		Hooks:       hooks,
+		Links:       links,
 		Metadata:    metadata,
 	}, nil
 }
@@ -158,6 +176,44 @@ func fromValuesPB(valuesPB map[string]*schemapb.Value) (*schema.ValueMap, error)
 	}, nil
 }
 
+func fromFunctionsPB(functionsPB map[string]*schemapb.Function) (*schema.FunctionMap, error) {
+	if functionsPB == nil {
+		return nil, nil
+	}
+
+	var functions = make(map[string]*schema.Function)
+	for k, v := range functionsPB {
+		description, err := FromScalarValuePB(v.Description, true)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := fromStringOrSubstitutionsPB(v.Value, false)
+		if err != nil {
+			return nil, err
+		}
+
+		parameters := (*schema.FunctionParameterList)(nil)
+		if len(v.Parameters) > 0 {
+			parameters = &schema.FunctionParameterList{
+				StringList: schema.StringList{
+					Values: v.Parameters,
+				},
+			}
+		}
+
+		functions[k] = &schema.Function{
+			Description: description,
+			Parameters:  parameters,
+			Value:       value,
+		}
+	}
+
+	return &schema.FunctionMap{
+		Values: functions,
+	}, nil
+}
+
 func fromIncludesPB(includesPB map[string]*schemapb.Include) (*schema.IncludeMap, error) {
 	if includesPB == nil {
 		return nil, nil
@@ -185,11 +241,21 @@ func fromIncludesPB(includesPB map[string]*schemapb.Include) (*schema.IncludeMap
 			return nil, err
 		}
 
+		dependsOn := (*schema.DependsOnList)(nil)
+		if len(v.DependsOn) > 0 {
+			dependsOn = &schema.DependsOnList{
+				StringList: schema.StringList{
+					Values: v.DependsOn,
+				},
+			}
+		}
+
 		includes[k] = &schema.Include{
 			Path:        path,
 			Variables:   variables,
 			Metadata:    metadata,
 			Description: description,
+			DependsOn:   dependsOn,
 		}
 	}
 
@@ -461,6 +527,15 @@ func FromResourcePB(resourcePB *schemapb.Resource) (*schema.Resource, error) {
 		return nil, err
 	}
 
+	ignoreChanges := (*schema.IgnoreChangesList)(nil)
+	if len(resourcePB.IgnoreChanges) > 0 {
+		ignoreChanges = &schema.IgnoreChangesList{
+			StringList: schema.StringList{
+				Values: resourcePB.IgnoreChanges,
+			},
+		}
+	}
+
 	var removalPolicy *schema.RemovalPolicyWrapper
 	if resourcePB.RemovalPolicy != nil {
 		removalPolicy = &schema.RemovalPolicyWrapper{
@@ -468,16 +543,58 @@ func FromResourcePB(resourcePB *schemapb.Resource) (*schema.Resource, error) {
 		}
 	}
 
+	var replaceStrategy *schema.ReplaceStrategyWrapper
+	if resourcePB.ReplaceStrategy != nil {
+		replaceStrategy = &schema.ReplaceStrategyWrapper{
+			Value: schema.ReplaceStrategy(*resourcePB.ReplaceStrategy),
+		}
+	}
+
+	timeouts, err := fromResourceTimeoutsPB(resourcePB.Timeouts)
+	if err != nil {
+		return nil, err
+	}
+
 	return &schema.Resource{
-		Type:          &schema.ResourceTypeWrapper{Value: resourcePB.Type},
-		Description:   description,
-		Each:          each,
-		Condition:     condition,
-		DependsOn:     dependsOn,
-		Metadata:      resourceMetadata,
-		LinkSelector:  FromLinkSelectorPB(resourcePB.LinkSelector),
-		Spec:          spec,
-		RemovalPolicy: removalPolicy,
+		Type:            &schema.ResourceTypeWrapper{Value: resourcePB.Type},
+		Description:     description,
+		Each:            each,
+		Condition:       condition,
+		DependsOn:       dependsOn,
+		Metadata:        resourceMetadata,
+		LinkSelector:    FromLinkSelectorPB(resourcePB.LinkSelector),
+		Spec:            spec,
+		RemovalPolicy:   removalPolicy,
+		ReplaceStrategy: replaceStrategy,
+		IgnoreChanges:   ignoreChanges,
+		Timeouts:        timeouts,
+	}, nil
+}
+
+func fromResourceTimeoutsPB(timeoutsPB *schemapb.ResourceTimeouts) (*schema.ResourceTimeouts, error) {
+	if timeoutsPB == nil {
+		return nil, nil
+	}
+
+	create, err := FromScalarValuePB(timeoutsPB.Create, true)
+	if err != nil {
+		return nil, err
+	}
+
+	update, err := FromScalarValuePB(timeoutsPB.Update, true)
+	if err != nil {
+		return nil, err
+	}
+
+	destroy, err := FromScalarValuePB(timeoutsPB.Destroy, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.ResourceTimeouts{
+		Create:  create,
+		Update:  update,
+		Destroy: destroy,
 	}, nil
 }
 
@@ -625,6 +742,60 @@ func FromLinkSelectorPB(linkSelectorPB *schemapb.LinkSelector) *schema.LinkSelec
 	}
 }
 
+func fromHooksPB(hooksPB []*schemapb.Hook) (*schema.HookList, error) {
+	if len(hooksPB) == 0 {
+		return nil, nil
+	}
+
+	hooks := make([]*schema.Hook, len(hooksPB))
+	for i, hookPB := range hooksPB {
+		event, err := FromScalarValuePB(hookPB.Event, false)
+		if err != nil {
+			return nil, err
+		}
+
+		resource, err := FromScalarValuePB(hookPB.Resource, true)
+		if err != nil {
+			return nil, err
+		}
+
+		run, err := FromScalarValuePB(hookPB.Run, false)
+		if err != nil {
+			return nil, err
+		}
+
+		hooks[i] = &schema.Hook{
+			Event:    event,
+			Resource: resource,
+			Run:      run,
+		}
+	}
+
+	return &schema.HookList{
+		Values: hooks,
+	}, nil
+}
+
+func fromLinksPB(linksPB map[string]*schemapb.MappingNode) (*schema.LinkConfigMap, error) {
+	if linksPB == nil {
+		return nil, nil
+	}
+
+	links := make(map[string]*core.MappingNode)
+	for k, v := range linksPB {
+		linkConfig, err := FromMappingNodePB(v, false)
+		if err != nil {
+			return nil, err
+		}
+
+		links[k] = linkConfig
+	}
+
+	return &schema.LinkConfigMap{
+		Values: links,
+	}, nil
+}
+
 func fromExportPB(exportPB *schemapb.Export) (*schema.Export, error) {
 	if exportPB == nil {
 		return nil, nil
@@ -218,6 +218,7 @@ func PositionFromJSONNode(node *json.Node, linePositions []int) Position {
 
 // PositionFromOffset returns the position of a character in the source
 // code based on the offset and an ordered list of line offsets.
+// Lines and columns are 1-based, including columns on the first line.
 // This treats the offset of a new line character as the end of the line
 // and not the first column of the next line.
 func PositionFromOffset(offset int, linePositions []int) Position {
@@ -229,13 +230,9 @@ func PositionFromOffset(offset int, linePositions []int) Position {
 		line = i
 	}
 
-	column := offset - linePositions[line]
+	column := offset - lineStartOffset(line, linePositions)
 	if column == 0 {
-		prevLineOffset := 0
-		if line > 0 {
-			prevLineOffset = linePositions[line-1]
-		}
-		lineLength := linePositions[line] - prevLineOffset
+		lineLength := linePositions[line] - lineStartOffset(line-1, linePositions)
 		return Position{
 			Line:   line,
 			Column: lineLength,
@@ -248,6 +245,19 @@ func PositionFromOffset(offset int, linePositions []int) Position {
 	}
 }
 
+// Returns the offset that columns on the line at the provided index
+// are counted from.
+// For all lines other than the first, this is the offset of the new line
+// character that precedes the line, the first line is treated as if it was
+// preceded by a new line character so columns are 1-based on every line.
+func lineStartOffset(line int, linePositions []int) int {
+	if line <= 0 {
+		return -1
+	}
+
+	return linePositions[line]
+}
+
 // GetLastColumnOnLine returns the last column on a given line
 // based on the provided line positions.
 // The second return value is the raw offset for the end of the line.
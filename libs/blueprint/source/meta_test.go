@@ -0,0 +1,89 @@
+package source
+
+import (
+	"testing"
+
+	"github.com/coreos/go-json"
+	"github.com/stretchr/testify/suite"
+)
+
+// The document is split across lines so that positions on the first line,
+// positions on subsequent lines and new line characters are all covered.
+const testJSONDocument = "{\"a\": 1,\n  \"b\": [true]\n}"
+
+type JSONPositionTestSuite struct {
+	rootNode      *json.Node
+	linePositions []int
+	suite.Suite
+}
+
+func (s *JSONPositionTestSuite) SetupTest() {
+	s.rootNode = &json.Node{}
+	err := json.Unmarshal([]byte(testJSONDocument), s.rootNode)
+	s.Require().NoError(err)
+	s.linePositions = linePositionsFromSource(testJSONDocument)
+}
+
+func (s *JSONPositionTestSuite) Test_position_of_first_character_in_document() {
+	position := PositionFromOffset(0, s.linePositions)
+	s.Assert().Equal(Position{Line: 1, Column: 1}, position)
+}
+
+func (s *JSONPositionTestSuite) Test_positions_on_first_line_are_1_based() {
+	fields := s.rootNode.Value.(map[string]json.Node)
+	aNode := fields["a"]
+
+	valuePosition := PositionFromJSONNode(&aNode, s.linePositions)
+	s.Assert().Equal(Position{Line: 1, Column: 7}, valuePosition)
+
+	keyMeta := ExtractSourcePositionForJSONNodeMapField(&aNode, s.linePositions)
+	s.Assert().Equal(Position{Line: 1, Column: 2}, keyMeta.Position)
+}
+
+func (s *JSONPositionTestSuite) Test_positions_on_subsequent_lines_are_1_based() {
+	fields := s.rootNode.Value.(map[string]json.Node)
+	bNode := fields["b"]
+
+	// coreos/go-json sets the start offset of arrays and objects
+	// to the character after the opening bracket.
+	valuePosition := PositionFromJSONNode(&bNode, s.linePositions)
+	s.Assert().Equal(Position{Line: 2, Column: 9}, valuePosition)
+
+	keyMeta := ExtractSourcePositionForJSONNodeMapField(&bNode, s.linePositions)
+	s.Assert().Equal(Position{Line: 2, Column: 3}, keyMeta.Position)
+}
+
+func (s *JSONPositionTestSuite) Test_new_line_characters_are_treated_as_the_end_of_the_line() {
+	firstLineEnd := PositionFromOffset(s.linePositions[1], s.linePositions)
+	s.Assert().Equal(Position{Line: 1, Column: 9}, firstLineEnd)
+
+	secondLineEnd := PositionFromOffset(s.linePositions[2], s.linePositions)
+	s.Assert().Equal(Position{Line: 2, Column: 14}, secondLineEnd)
+}
+
+func (s *JSONPositionTestSuite) Test_extracts_range_for_multi_line_node() {
+	meta := ExtractSourcePositionFromJSONNode(s.rootNode, s.linePositions)
+	s.Assert().Equal(Position{Line: 1, Column: 2}, meta.Position)
+	s.Assert().Equal(&Position{Line: 3, Column: 2}, meta.EndPosition)
+}
+
+// Mirrors core.LinePositionsFromSource, which can not be used here
+// as the core package depends on this package.
+func linePositionsFromSource(source string) []int {
+	linePositions := []int{0}
+	for i, c := range source {
+		if c == '\n' {
+			linePositions = append(linePositions, i)
+		}
+	}
+
+	if source[len(source)-1] != '\n' {
+		linePositions = append(linePositions, len(source))
+	}
+
+	return linePositions
+}
+
+func TestJSONPositionTestSuite(t *testing.T) {
+	suite.Run(t, new(JSONPositionTestSuite))
+}
@@ -0,0 +1,175 @@
+package state
+
+import (
+	"cmp"
+	"slices"
+)
+
+// DeploymentRunOperation is the type of operation
+// that a deployment run was carried out for.
+type DeploymentRunOperation string
+
+const (
+	// DeploymentRunOperationDeploy is used for runs that deploy
+	// changes to a new or existing blueprint instance.
+	DeploymentRunOperationDeploy DeploymentRunOperation = "deploy"
+	// DeploymentRunOperationDestroy is used for runs that destroy
+	// a blueprint instance or a subset of its elements.
+	DeploymentRunOperationDestroy DeploymentRunOperation = "destroy"
+	// DeploymentRunOperationReconcile is used for runs that reconcile
+	// the state of a blueprint instance with the upstream state of
+	// its resources and links.
+	DeploymentRunOperationReconcile DeploymentRunOperation = "reconcile"
+)
+
+// DeploymentRun is an audit record of a single deploy, destroy or reconcile
+// operation carried out for a blueprint instance.
+// Runs are immutable once saved and are retained after the blueprint
+// instance has been destroyed so that they can be used as an audit trail.
+type DeploymentRun struct {
+	// ID is the unique identifier for the run.
+	ID string `json:"id"`
+	// InstanceID is the ID of the blueprint instance that the run was carried out for.
+	InstanceID string `json:"instanceId"`
+	// InstanceName is the user-defined name of the blueprint instance
+	// at the time the run was carried out.
+	InstanceName string `json:"instanceName"`
+	// Operation is the type of operation that was carried out.
+	Operation DeploymentRunOperation `json:"operation"`
+	// Rollback is true when the run was carried out to roll back
+	// changes for a previous deployment.
+	Rollback bool `json:"rollback"`
+	// Metadata holds information about who carried out the run and the versions
+	// of the tools and blueprint that were used.
+	Metadata *DeploymentRunMetadata `json:"metadata,omitempty"`
+	// Status is the final status of the blueprint instance (e.g. "DEPLOYED").
+	// This is empty when the run stopped due to an unexpected error,
+	// in which case, the errors are provided in Errors.
+	Status string `json:"status,omitempty"`
+	// Succeeded is true when the run reached a successful final status.
+	Succeeded bool `json:"succeeded"`
+	// FailureReasons holds the reasons the run failed
+	// when the final status is a failure status.
+	FailureReasons []string `json:"failureReasons,omitempty"`
+	// Errors holds the unexpected errors that occurred during the run.
+	Errors []string `json:"errors,omitempty"`
+	// StartedAt is the unix timestamp in seconds when the run started.
+	StartedAt int64 `json:"startedAt"`
+	// FinishedAt is the unix timestamp in seconds when the run finished.
+	FinishedAt int64 `json:"finishedAt"`
+	// DurationMilliseconds is the duration of the run in milliseconds,
+	// this is only set when the duration is known.
+	DurationMilliseconds *float64 `json:"durationMilliseconds,omitempty"`
+	// Elements holds the outcome for each resource, child blueprint
+	// and link that was deployed or destroyed in the run.
+	Elements []*DeploymentRunElement `json:"elements"`
+}
+
+// DeploymentRunMetadata holds information about who carried out
+// a deployment run and the versions of the tools and blueprint that were used.
+type DeploymentRunMetadata struct {
+	// InitiatedBy identifies the user or system that initiated the run
+	// (e.g. a user name or the ID of an API key).
+	InitiatedBy string `json:"initiatedBy,omitempty"`
+	// ClientVersion is the version of the client (e.g. the CLI)
+	// that was used to initiate the run.
+	ClientVersion string `json:"clientVersion,omitempty"`
+	// EngineVersion is the version of the deploy engine
+	// that carried out the run.
+	EngineVersion string `json:"engineVersion,omitempty"`
+	// BlueprintSourceHash is a hash of the source of the blueprint
+	// that was deployed (e.g. a hex-encoded SHA-256 digest).
+	BlueprintSourceHash string `json:"blueprintSourceHash,omitempty"`
+}
+
+// DeploymentRunElementType is the type of element
+// that an outcome in a deployment run is for.
+type DeploymentRunElementType string
+
+const (
+	// DeploymentRunElementResource is used for resource outcomes.
+	DeploymentRunElementResource DeploymentRunElementType = "resource"
+	// DeploymentRunElementChild is used for child blueprint outcomes.
+	DeploymentRunElementChild DeploymentRunElementType = "child"
+	// DeploymentRunElementLink is used for link outcomes.
+	DeploymentRunElementLink DeploymentRunElementType = "link"
+)
+
+// DeploymentRunElement holds the outcome of a deployment run
+// for a single element of a blueprint instance.
+type DeploymentRunElement struct {
+	// Type is the type of the element.
+	Type DeploymentRunElementType `json:"type"`
+	// Name is the name of the resource, child blueprint or link in the blueprint.
+	Name string `json:"name"`
+	// ID is the ID of the resource, child blueprint instance or link.
+	ID string `json:"id,omitempty"`
+	// InstanceID is the ID of the blueprint instance that the element belongs to,
+	// this will be the ID of a child blueprint instance for elements of child blueprints.
+	InstanceID string `json:"instanceId"`
+	// Status is the final status of the element (e.g. "CREATED").
+	Status string `json:"status"`
+	// FailureReasons holds the reasons the element failed to be deployed
+	// or destroyed.
+	FailureReasons []string `json:"failureReasons,omitempty"`
+}
+
+// ListRunsParams holds parameters for listing the deployment runs
+// of a blueprint instance.
+type ListRunsParams struct {
+	// Operation filters runs by the type of operation,
+	// when empty, runs for all operations are returned.
+	Operation DeploymentRunOperation
+	// Offset is the number of items to skip for pagination.
+	Offset int
+	// Limit is the maximum number of items to return (0 = no limit).
+	Limit int
+}
+
+// ListRunsResult holds the result of listing the deployment runs
+// of a blueprint instance.
+type ListRunsResult struct {
+	// Runs contains the deployment runs for the current page,
+	// ordered from the most recent to the oldest run.
+	Runs []DeploymentRun `json:"runs"`
+	// TotalCount is the total number of matching runs before pagination.
+	TotalCount int `json:"totalCount"`
+}
+
+// FilterAndPaginateRuns applies the provided list parameters to the deployment
+// runs of a blueprint instance, runs are ordered from the most recent
+// to the oldest run based on the time they started.
+// This is useful for state container implementations that hold
+// deployment runs in memory.
+func FilterAndPaginateRuns(runs []*DeploymentRun, params ListRunsParams) ListRunsResult {
+	filtered := []DeploymentRun{}
+	for _, run := range runs {
+		if run == nil {
+			continue
+		}
+		if params.Operation != "" && run.Operation != params.Operation {
+			continue
+		}
+		filtered = append(filtered, *run)
+	}
+
+	// Most recent first.
+	slices.SortStableFunc(filtered, func(a, b DeploymentRun) int {
+		return cmp.Or(
+			cmp.Compare(b.StartedAt, a.StartedAt),
+			cmp.Compare(b.ID, a.ID),
+		)
+	})
+
+	totalCount := len(filtered)
+	start := min(max(params.Offset, 0), totalCount)
+	end := totalCount
+	if params.Limit > 0 {
+		end = min(start+params.Limit, totalCount)
+	}
+
+	return ListRunsResult{
+		Runs:       filtered[start:end],
+		TotalCount: totalCount,
+	}
+}
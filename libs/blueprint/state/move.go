@@ -0,0 +1,571 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+var (
+	// ErrInvalidMove is returned when a request to move resources
+	// between blueprint instances can not be carried out.
+	ErrInvalidMove = errors.New("invalid state move")
+)
+
+// MoveResourcesInput provides the resources to move from one blueprint instance
+// to another.
+type MoveResourcesInput struct {
+	// SourceInstanceID is the ID of the blueprint instance
+	// that the resources are currently in.
+	SourceInstanceID string
+	// TargetInstanceID is the ID of the blueprint instance
+	// that the resources should be moved to.
+	TargetInstanceID string
+	// Resources maps the logical names of the resources in the source instance
+	// to the logical names they should have in the target instance.
+	// An empty target name will keep the name the resource has in the source instance.
+	Resources map[string]string
+	// RemoveSplitLinks determines whether links between a moved resource
+	// and a resource that remains in the source instance should be removed
+	// from state.
+	// Links can not span multiple blueprint instances so when this is false,
+	// moving resources that would split a link will fail.
+	RemoveSplitLinks bool
+}
+
+// MovedElement holds the names of a resource or link
+// that was moved between blueprint instances.
+type MovedElement struct {
+	ElementID  string `json:"elementId"`
+	SourceName string `json:"sourceName"`
+	TargetName string `json:"targetName"`
+}
+
+// RemovedResourceDependency holds information about a dependency between
+// two resources that was removed because the resources are no longer
+// in the same blueprint instance.
+type RemovedResourceDependency struct {
+	InstanceID   string `json:"instanceId"`
+	ResourceName string `json:"resourceName"`
+	DependsOn    string `json:"dependsOn"`
+}
+
+// MoveResult holds the result of moving resources
+// between blueprint instances.
+type MoveResult struct {
+	SourceInstanceID string          `json:"sourceInstanceId"`
+	TargetInstanceID string          `json:"targetInstanceId"`
+	MovedResources   []*MovedElement `json:"movedResources"`
+	MovedLinks       []*MovedElement `json:"movedLinks"`
+	// RemovedLinks holds the names of links in the source instance that were removed
+	// because only one of the linked resources was moved.
+	RemovedLinks        []string                     `json:"removedLinks,omitempty"`
+	RemovedDependencies []*RemovedResourceDependency `json:"removedDependencies,omitempty"`
+	// AffectedExports holds the names of exports in the source instance that refer
+	// to moved resources, these should be removed from the source blueprint
+	// or redefined in the target blueprint.
+	AffectedExports []string `json:"affectedExports,omitempty"`
+}
+
+// MoveResources moves the state of resources from one blueprint instance
+// to another without recreating them in the upstream provider.
+// The state of each resource is moved as is, including computed fields
+// and drift state, links between moved resources are moved along with them
+// and references to renamed resources are rewritten in link names,
+// resource data mappings, link data and resource dependencies.
+//
+// This is useful when refactoring a large blueprint into multiple smaller
+// blueprints where the deployed resources should be retained.
+func MoveResources(
+	ctx context.Context,
+	container Container,
+	input MoveResourcesInput,
+) (*MoveResult, error) {
+	if input.SourceInstanceID == input.TargetInstanceID {
+		return nil, fmt.Errorf(
+			"%w: the source and target instances must be different",
+			ErrInvalidMove,
+		)
+	}
+
+	if len(input.Resources) == 0 {
+		return nil, fmt.Errorf("%w: no resources were provided to move", ErrInvalidMove)
+	}
+
+	source, err := container.Instances().Get(ctx, input.SourceInstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := container.Instances().Get(ctx, input.TargetInstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	renames, err := resolveMoveRenames(&source, &target, input.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	movedLinks, splitLinks := classifyLinksForMove(&source, renames)
+	if len(splitLinks) > 0 && !input.RemoveSplitLinks {
+		return nil, fmt.Errorf(
+			"%w: moving the resources would split the following links "+
+				"between instances: %s",
+			ErrInvalidMove,
+			strings.Join(splitLinks, ", "),
+		)
+	}
+
+	for _, linkName := range movedLinks {
+		if _, exists := target.Links[renameLink(linkName, renames)]; exists {
+			return nil, fmt.Errorf(
+				"%w: link %q already exists in the target instance",
+				ErrInvalidMove,
+				renameLink(linkName, renames),
+			)
+		}
+	}
+
+	mover := &resourceMover{
+		container: container,
+		source:    &source,
+		target:    &target,
+		renames:   renames,
+		result: &MoveResult{
+			SourceInstanceID: source.InstanceID,
+			TargetInstanceID: target.InstanceID,
+			MovedResources:   []*MovedElement{},
+			MovedLinks:       []*MovedElement{},
+		},
+	}
+
+	for _, linkName := range splitLinks {
+		err = mover.removeLink(ctx, linkName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, linkName := range movedLinks {
+		err = mover.moveLink(ctx, linkName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, resourceName := range sortedKeys(renames) {
+		err = mover.moveResource(ctx, resourceName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = mover.removeDependenciesOnMovedResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mover.result.AffectedExports = exportsReferencingResources(&source, renames)
+
+	return mover.result, nil
+}
+
+// resolveMoveRenames validates the resources to move and returns a mapping
+// of the source name to the target name for each resource.
+func resolveMoveRenames(
+	source *InstanceState,
+	target *InstanceState,
+	resources map[string]string,
+) (map[string]string, error) {
+	renames := make(map[string]string, len(resources))
+	targetNames := map[string]string{}
+	for _, sourceName := range sortedKeys(resources) {
+		if findResource(source, sourceName) == nil {
+			return nil, fmt.Errorf(
+				"%w: resource %q does not exist in the source instance",
+				ErrInvalidMove,
+				sourceName,
+			)
+		}
+
+		targetName := resources[sourceName]
+		if targetName == "" {
+			targetName = sourceName
+		}
+
+		if findResource(target, targetName) != nil {
+			return nil, fmt.Errorf(
+				"%w: resource %q already exists in the target instance",
+				ErrInvalidMove,
+				targetName,
+			)
+		}
+
+		if otherSourceName, taken := targetNames[targetName]; taken {
+			return nil, fmt.Errorf(
+				"%w: resources %q and %q can not both be moved to %q",
+				ErrInvalidMove,
+				otherSourceName,
+				sourceName,
+				targetName,
+			)
+		}
+
+		targetNames[targetName] = sourceName
+		renames[sourceName] = targetName
+	}
+
+	return renames, nil
+}
+
+// classifyLinksForMove returns the names of links in the source instance
+// where both resources are being moved and the names of links where only
+// one of the resources is being moved.
+func classifyLinksForMove(
+	source *InstanceState,
+	renames map[string]string,
+) ([]string, []string) {
+	movedLinks := []string{}
+	splitLinks := []string{}
+	for _, linkName := range sortedKeys(source.Links) {
+		resourceAName, resourceBName, _ := strings.Cut(linkName, "::")
+		_, movingA := renames[resourceAName]
+		_, movingB := renames[resourceBName]
+		if movingA && movingB {
+			movedLinks = append(movedLinks, linkName)
+		} else if movingA || movingB {
+			splitLinks = append(splitLinks, linkName)
+		}
+	}
+
+	return movedLinks, splitLinks
+}
+
+type resourceMover struct {
+	container Container
+	source    *InstanceState
+	target    *InstanceState
+	renames   map[string]string
+	result    *MoveResult
+}
+
+func (m *resourceMover) removeLink(ctx context.Context, linkName string) error {
+	link := m.source.Links[linkName]
+	if link == nil {
+		return nil
+	}
+
+	_, err := m.container.Links().Remove(ctx, link.LinkID)
+	if err != nil && !IsLinkNotFound(err) {
+		return err
+	}
+
+	m.result.RemovedLinks = append(m.result.RemovedLinks, linkName)
+	return nil
+}
+
+func (m *resourceMover) moveLink(ctx context.Context, linkName string) error {
+	link := m.source.Links[linkName]
+	if link == nil {
+		return nil
+	}
+
+	drift, err := m.container.Links().GetDrift(ctx, link.LinkID)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.container.Links().Remove(ctx, link.LinkID)
+	if err != nil {
+		return err
+	}
+
+	moved := *link
+	moved.InstanceID = m.target.InstanceID
+	moved.Name = renameLink(linkName, m.renames)
+	moved.Data = renameLinkData(link.Data, m.renames)
+	moved.ResourceDataMappings = renameResourceDataMappings(
+		link.ResourceDataMappings,
+		m.renames,
+	)
+	err = m.container.Links().Save(ctx, moved)
+	if err != nil {
+		return err
+	}
+
+	if drift.LinkID != "" {
+		drift.LinkName = moved.Name
+		renameLinkResourceDrift(drift.ResourceADrift, m.renames)
+		renameLinkResourceDrift(drift.ResourceBDrift, m.renames)
+		err = m.container.Links().SaveDrift(ctx, drift)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.result.MovedLinks = append(m.result.MovedLinks, &MovedElement{
+		ElementID:  link.LinkID,
+		SourceName: linkName,
+		TargetName: moved.Name,
+	})
+	return nil
+}
+
+func (m *resourceMover) moveResource(ctx context.Context, resourceName string) error {
+	resource := findResource(m.source, resourceName)
+
+	drift, err := m.container.Resources().GetDrift(ctx, resource.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.container.Resources().Remove(ctx, resource.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	moved := *resource
+	moved.InstanceID = m.target.InstanceID
+	moved.Name = m.renames[resourceName]
+	moved.DependsOnChildren = nil
+	moved.DependsOnResources = m.moveDependencies(resource)
+	err = m.container.Resources().Save(ctx, moved)
+	if err != nil {
+		return err
+	}
+
+	if drift.ResourceID != "" {
+		drift.ResourceName = moved.Name
+		err = m.container.Resources().SaveDrift(ctx, drift)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.result.MovedResources = append(m.result.MovedResources, &MovedElement{
+		ElementID:  resource.ResourceID,
+		SourceName: resourceName,
+		TargetName: moved.Name,
+	})
+	return nil
+}
+
+// moveDependencies rewrites the dependencies of a moved resource,
+// dependencies on other moved resources are renamed and dependencies on resources
+// or child blueprints that remain in the source instance are removed.
+func (m *resourceMover) moveDependencies(resource *ResourceState) []string {
+	dependsOn := []string{}
+	for _, dependency := range resource.DependsOnResources {
+		if targetName, moving := m.renames[dependency]; moving {
+			dependsOn = append(dependsOn, targetName)
+			continue
+		}
+
+		m.addRemovedDependency(m.target.InstanceID, m.renames[resource.Name], dependency)
+	}
+
+	for _, dependency := range resource.DependsOnChildren {
+		m.addRemovedDependency(m.target.InstanceID, m.renames[resource.Name], dependency)
+	}
+
+	if len(dependsOn) == 0 {
+		return nil
+	}
+
+	return dependsOn
+}
+
+func (m *resourceMover) removeDependenciesOnMovedResources(ctx context.Context) error {
+	for _, resourceID := range sortedKeys(m.source.Resources) {
+		resource := m.source.Resources[resourceID]
+		if resource == nil {
+			continue
+		}
+
+		if _, moving := m.renames[resource.Name]; moving {
+			continue
+		}
+
+		dependsOn := []string{}
+		for _, dependency := range resource.DependsOnResources {
+			if _, moving := m.renames[dependency]; moving {
+				m.addRemovedDependency(m.source.InstanceID, resource.Name, dependency)
+				continue
+			}
+			dependsOn = append(dependsOn, dependency)
+		}
+
+		if len(dependsOn) == len(resource.DependsOnResources) {
+			continue
+		}
+
+		updated := *resource
+		updated.DependsOnResources = dependsOn
+		err := m.container.Resources().Save(ctx, updated)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *resourceMover) addRemovedDependency(instanceID, resourceName, dependsOn string) {
+	m.result.RemovedDependencies = append(
+		m.result.RemovedDependencies,
+		&RemovedResourceDependency{
+			InstanceID:   instanceID,
+			ResourceName: resourceName,
+			DependsOn:    dependsOn,
+		},
+	)
+}
+
+func renameLink(linkName string, renames map[string]string) string {
+	resourceAName, resourceBName, _ := strings.Cut(linkName, "::")
+	return fmt.Sprintf(
+		"%s::%s",
+		renamedResource(resourceAName, renames),
+		renamedResource(resourceBName, renames),
+	)
+}
+
+// renameLinkData renames the top-level fields of link data
+// that are keyed by the names of the linked resources.
+func renameLinkData(
+	data map[string]*core.MappingNode,
+	renames map[string]string,
+) map[string]*core.MappingNode {
+	if data == nil {
+		return nil
+	}
+
+	renamed := make(map[string]*core.MappingNode, len(data))
+	for key, value := range data {
+		renamed[renamedResource(key, renames)] = value
+	}
+	return renamed
+}
+
+// renameResourceDataMappings rewrites the resource names in both
+// the "{resourceName}::{fieldPath}" keys and the "{resourceName}.{path}"
+// link data paths of resource data mappings.
+func renameResourceDataMappings(
+	mappings map[string]string,
+	renames map[string]string,
+) map[string]string {
+	if mappings == nil {
+		return nil
+	}
+
+	renamed := make(map[string]string, len(mappings))
+	for mappingKey, linkDataPath := range mappings {
+		renamed[renameResourceDataMappingKey(mappingKey, renames)] = renameLinkDataPath(
+			linkDataPath,
+			renames,
+		)
+	}
+	return renamed
+}
+
+func renameResourceDataMappingKey(mappingKey string, renames map[string]string) string {
+	resourceName, fieldPath, hasSeparator := strings.Cut(mappingKey, "::")
+	if !hasSeparator {
+		return mappingKey
+	}
+
+	return fmt.Sprintf("%s::%s", renamedResource(resourceName, renames), fieldPath)
+}
+
+func renameLinkDataPath(linkDataPath string, renames map[string]string) string {
+	resourceName, rest, hasRest := strings.Cut(linkDataPath, ".")
+	if !hasRest {
+		return renamedResource(resourceName, renames)
+	}
+
+	return fmt.Sprintf("%s.%s", renamedResource(resourceName, renames), rest)
+}
+
+func renameLinkResourceDrift(drift *LinkResourceDrift, renames map[string]string) {
+	if drift == nil {
+		return
+	}
+
+	drift.ResourceName = renamedResource(drift.ResourceName, renames)
+	for _, change := range drift.MappedFieldChanges {
+		if change != nil {
+			change.LinkDataPath = renameLinkDataPath(change.LinkDataPath, renames)
+		}
+	}
+}
+
+func renamedResource(resourceName string, renames map[string]string) string {
+	if targetName, moving := renames[resourceName]; moving {
+		return targetName
+	}
+
+	return resourceName
+}
+
+// exportsReferencingResources returns the names of exports in the given instance
+// with a field that refers to one of the provided resources.
+func exportsReferencingResources(
+	instance *InstanceState,
+	renames map[string]string,
+) []string {
+	affected := []string{}
+	for _, exportName := range sortedKeys(instance.Exports) {
+		export := instance.Exports[exportName]
+		if export == nil {
+			continue
+		}
+
+		for resourceName := range renames {
+			if exportFieldRefersToResource(export.Field, resourceName) {
+				affected = append(affected, exportName)
+				break
+			}
+		}
+	}
+
+	if len(affected) == 0 {
+		return nil
+	}
+
+	return affected
+}
+
+func exportFieldRefersToResource(field string, resourceName string) bool {
+	prefixes := []string{
+		fmt.Sprintf("resources.%s", resourceName),
+		fmt.Sprintf("resources[\"%s\"]", resourceName),
+	}
+	for _, prefix := range prefixes {
+		if field == prefix {
+			return true
+		}
+
+		if strings.HasPrefix(field, prefix) {
+			next := field[len(prefix)]
+			if next == '.' || next == '[' {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func sortedKeys[Value any](values map[string]Value) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,271 @@
+package state_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/memstate"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	moveTestSourceID = "move-source-1"
+	moveTestTargetID = "move-target-1"
+)
+
+type MoveTestSuite struct {
+	suite.Suite
+	container state.Container
+}
+
+func (s *MoveTestSuite) SetupTest() {
+	s.container = memstate.NewMemoryStateContainer()
+	err := s.container.Instances().Save(context.Background(), createMoveTestSourceInstance())
+	s.Require().NoError(err)
+	err = s.container.Instances().Save(context.Background(), state.InstanceState{
+		InstanceID:   moveTestTargetID,
+		InstanceName: "MoveTarget1",
+	})
+	s.Require().NoError(err)
+}
+
+func (s *MoveTestSuite) Test_moves_resources_and_links_with_reference_rewriting() {
+	timestamp := 1750000000
+	err := s.container.Resources().SaveDrift(context.Background(), state.ResourceDriftState{
+		ResourceID:   "resource-2",
+		ResourceName: "ordersFunction",
+		Timestamp:    &timestamp,
+	})
+	s.Require().NoError(err)
+
+	result, err := state.MoveResources(
+		context.Background(),
+		s.container,
+		state.MoveResourcesInput{
+			SourceInstanceID: moveTestSourceID,
+			TargetInstanceID: moveTestTargetID,
+			Resources: map[string]string{
+				"ordersTable":    "",
+				"ordersFunction": "handler",
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(
+		[]*state.MovedElement{
+			{ElementID: "resource-2", SourceName: "ordersFunction", TargetName: "handler"},
+			{ElementID: "resource-1", SourceName: "ordersTable", TargetName: "ordersTable"},
+		},
+		result.MovedResources,
+	)
+	s.Assert().Equal(
+		[]*state.MovedElement{
+			{
+				ElementID:  "link-1",
+				SourceName: "ordersTable::ordersFunction",
+				TargetName: "ordersTable::handler",
+			},
+		},
+		result.MovedLinks,
+	)
+	s.Assert().Equal(
+		[]*state.RemovedResourceDependency{
+			{InstanceID: moveTestSourceID, ResourceName: "ordersQueue", DependsOn: "ordersTable"},
+		},
+		result.RemovedDependencies,
+	)
+	s.Assert().Equal([]string{"ordersTableName"}, result.AffectedExports)
+
+	source, err := s.container.Instances().Get(context.Background(), moveTestSourceID)
+	s.Require().NoError(err)
+	s.Assert().Len(source.Resources, 1)
+	s.Assert().Empty(source.Links)
+	s.Assert().Empty(source.Resources["resource-3"].DependsOnResources)
+
+	target, err := s.container.Instances().Get(context.Background(), moveTestTargetID)
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		map[string]string{
+			"ordersTable": "resource-1",
+			"handler":     "resource-2",
+		},
+		target.ResourceIDs,
+	)
+
+	handler := target.Resources["resource-2"]
+	s.Assert().Equal(moveTestTargetID, handler.InstanceID)
+	s.Assert().Equal("handler", handler.Name)
+	s.Assert().Equal([]string{"arn"}, handler.ComputedFields)
+	s.Assert().Equal([]string{"ordersTable"}, handler.DependsOnResources)
+	s.Assert().True(handler.Drifted)
+
+	drift, err := s.container.Resources().GetDrift(context.Background(), "resource-2")
+	s.Require().NoError(err)
+	s.Assert().Equal("handler", drift.ResourceName)
+
+	link := target.Links["ordersTable::handler"]
+	s.Require().NotNil(link)
+	s.Assert().Equal(moveTestTargetID, link.InstanceID)
+	s.Assert().Equal(
+		map[string]string{
+			"handler::spec.environment.TABLE_NAME": "handler.tableName",
+		},
+		link.ResourceDataMappings,
+	)
+	s.Assert().Contains(link.Data, "handler")
+	s.Assert().NotContains(link.Data, "ordersFunction")
+}
+
+func (s *MoveTestSuite) Test_fails_when_moving_resources_would_split_links() {
+	_, err := state.MoveResources(
+		context.Background(),
+		s.container,
+		state.MoveResourcesInput{
+			SourceInstanceID: moveTestSourceID,
+			TargetInstanceID: moveTestTargetID,
+			Resources: map[string]string{
+				"ordersFunction": "",
+			},
+		},
+	)
+	s.Require().ErrorIs(err, state.ErrInvalidMove)
+	s.Assert().ErrorContains(err, "ordersTable::ordersFunction")
+
+	source, err := s.container.Instances().Get(context.Background(), moveTestSourceID)
+	s.Require().NoError(err)
+	s.Assert().Len(source.Resources, 3)
+	s.Assert().Contains(source.Links, "ordersTable::ordersFunction")
+}
+
+func (s *MoveTestSuite) Test_removes_split_links_when_enabled() {
+	result, err := state.MoveResources(
+		context.Background(),
+		s.container,
+		state.MoveResourcesInput{
+			SourceInstanceID: moveTestSourceID,
+			TargetInstanceID: moveTestTargetID,
+			Resources: map[string]string{
+				"ordersFunction": "",
+			},
+			RemoveSplitLinks: true,
+		},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"ordersTable::ordersFunction"}, result.RemovedLinks)
+	s.Assert().Empty(result.MovedLinks)
+	s.Assert().Equal(
+		[]*state.RemovedResourceDependency{
+			{InstanceID: moveTestTargetID, ResourceName: "ordersFunction", DependsOn: "ordersTable"},
+		},
+		result.RemovedDependencies,
+	)
+
+	source, err := s.container.Instances().Get(context.Background(), moveTestSourceID)
+	s.Require().NoError(err)
+	s.Assert().Empty(source.Links)
+
+	target, err := s.container.Instances().Get(context.Background(), moveTestTargetID)
+	s.Require().NoError(err)
+	s.Assert().Empty(target.Resources["resource-2"].DependsOnResources)
+}
+
+func (s *MoveTestSuite) Test_fails_when_resource_name_is_taken_in_target() {
+	_, err := state.MoveResources(
+		context.Background(),
+		s.container,
+		state.MoveResourcesInput{
+			SourceInstanceID: moveTestSourceID,
+			TargetInstanceID: moveTestTargetID,
+			Resources: map[string]string{
+				"ordersQueue": "",
+				"ordersTable": "ordersQueue",
+			},
+			RemoveSplitLinks: true,
+		},
+	)
+	s.Require().ErrorIs(err, state.ErrInvalidMove)
+}
+
+func createMoveTestSourceInstance() state.InstanceState {
+	return state.InstanceState{
+		InstanceID:   moveTestSourceID,
+		InstanceName: "MoveSource1",
+		ResourceIDs: map[string]string{
+			"ordersTable":    "resource-1",
+			"ordersFunction": "resource-2",
+			"ordersQueue":    "resource-3",
+		},
+		Resources: map[string]*state.ResourceState{
+			"resource-1": {
+				ResourceID: "resource-1",
+				Name:       "ordersTable",
+				Type:       "aws/dynamodb/table",
+				InstanceID: moveTestSourceID,
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"tableName": core.MappingNodeFromString("orders"),
+					},
+				},
+			},
+			"resource-2": {
+				ResourceID: "resource-2",
+				Name:       "ordersFunction",
+				Type:       "aws/lambda/function",
+				InstanceID: moveTestSourceID,
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"arn": core.MappingNodeFromString(
+							"arn:aws:lambda:us-east-1:123456789012:function:orders",
+						),
+						"environment": {
+							Fields: map[string]*core.MappingNode{
+								"TABLE_NAME": core.MappingNodeFromString("orders"),
+							},
+						},
+					},
+				},
+				ComputedFields:     []string{"arn"},
+				DependsOnResources: []string{"ordersTable"},
+			},
+			"resource-3": {
+				ResourceID:         "resource-3",
+				Name:               "ordersQueue",
+				Type:               "aws/sqs/queue",
+				InstanceID:         moveTestSourceID,
+				DependsOnResources: []string{"ordersTable"},
+			},
+		},
+		Links: map[string]*state.LinkState{
+			"ordersTable::ordersFunction": {
+				LinkID:     "link-1",
+				Name:       "ordersTable::ordersFunction",
+				InstanceID: moveTestSourceID,
+				Data: map[string]*core.MappingNode{
+					"ordersFunction": {
+						Fields: map[string]*core.MappingNode{
+							"tableName": core.MappingNodeFromString("orders"),
+						},
+					},
+				},
+				ResourceDataMappings: map[string]string{
+					"ordersFunction::spec.environment.TABLE_NAME": "ordersFunction.tableName",
+				},
+			},
+		},
+		Exports: map[string]*state.ExportState{
+			"ordersTableName": {
+				Field: "resources.ordersTable.spec.tableName",
+			},
+			"queueName": {
+				Field: "resources.ordersQueue.spec.queueName",
+			},
+		},
+	}
+}
+
+func TestMoveTestSuite(t *testing.T) {
+	suite.Run(t, new(MoveTestSuite))
+}
@@ -35,6 +35,9 @@ type Container interface {
 	Metadata() MetadataContainer
 	// Exports provides functionality to manage exported fields for blueprint instances.
 	Exports() ExportsContainer
+	// Summaries provides lightweight status summaries for blueprint instances
+	// without loading the full instance state.
+	Summaries() SummariesContainer
 }
 
 // InstancesContainer provides an interface for functionality related
@@ -100,7 +103,18 @@ type InstancesContainer interface {
 	) (newVersion int64, err error)
 	// Remove deals with removing the state for a given blueprint instance.
 	// This is not for destroying the actual deployed resources, just removing the state.
+	// Deployment runs for the instance are retained as an audit trail.
 	Remove(ctx context.Context, instanceID string) (InstanceState, error)
+	// SaveRun deals with persisting the audit record of a deploy, destroy
+	// or reconcile operation carried out for a blueprint instance.
+	// Runs are immutable, saving a run with the ID of an existing run
+	// for the same instance is a no-op.
+	SaveRun(ctx context.Context, run DeploymentRun) error
+	// ListRuns retrieves the deployment runs for a given blueprint instance
+	// ordered from the most recent to the oldest run.
+	// Runs are retained after an instance has been removed,
+	// an empty result is returned when there are no runs for the instance.
+	ListRuns(ctx context.Context, instanceID string, params ListRunsParams) (ListRunsResult, error)
 }
 
 // ListInstancesParams holds parameters for listing blueprint instances.
@@ -127,6 +141,92 @@ type InstanceSummary struct {
 	InstanceName          string              `json:"name"`
 	Status                core.InstanceStatus `json:"status"`
 	LastDeployedTimestamp int64               `json:"lastDeployedTimestamp"`
+	// ExpiresAt is the unix timestamp when an ephemeral blueprint instance
+	// expires, this is 0 for blueprint instances that do not expire.
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+}
+
+// SummariesContainer provides an interface for retrieving lightweight
+// status summaries of blueprint instances, this is primarily intended for
+// dashboards and listing instances where loading the full state of each
+// instance would be too expensive.
+type SummariesContainer interface {
+	// Get retrieves the status summary for the blueprint instance
+	// with the given ID.
+	Get(ctx context.Context, instanceID string) (InstanceStatusSummary, error)
+	// List retrieves status summaries for all blueprint instances
+	// matching the provided parameters in a single call.
+	// Summaries are ordered by instance name.
+	List(ctx context.Context, params ListInstancesParams) (ListInstanceStatusSummariesResult, error)
+}
+
+// ListInstanceStatusSummariesResult holds the result of listing
+// status summaries for blueprint instances.
+type ListInstanceStatusSummariesResult struct {
+	// Summaries contains the instance status summaries for the current page.
+	Summaries []InstanceStatusSummary `json:"summaries"`
+	// TotalCount is the total number of matching instances before pagination.
+	TotalCount int `json:"totalCount"`
+}
+
+// InstanceStatusSummary provides a lightweight summary of the status
+// of a blueprint instance and the resources and links that belong to it.
+type InstanceStatusSummary struct {
+	InstanceID   string `json:"id"`
+	InstanceName string `json:"name"`
+	// Status holds the status of the latest deployment of the instance,
+	// for an instance that is not currently being deployed this is the result
+	// of the last deployment.
+	Status                     core.InstanceStatus `json:"status"`
+	LastStatusUpdateTimestamp  int64               `json:"lastStatusUpdateTimestamp,omitempty"`
+	LastDeployedTimestamp      int64               `json:"lastDeployedTimestamp"`
+	LastDeployAttemptTimestamp int64               `json:"lastDeployAttemptTimestamp"`
+	ResourceCount              int                 `json:"resourceCount"`
+	// ResourceStatusCounts holds the number of resources in the instance
+	// for each resource status.
+	ResourceStatusCounts map[core.ResourceStatus]int `json:"resourceStatusCounts"`
+	DriftedResourceCount int                         `json:"driftedResourceCount"`
+	LinkCount            int                         `json:"linkCount"`
+	DriftedLinkCount     int                         `json:"driftedLinkCount"`
+}
+
+// SummariseInstance derives a status summary from the state
+// of a blueprint instance.
+// This is useful for state container implementations that hold
+// full instance state in memory.
+func SummariseInstance(instance *InstanceState) InstanceStatusSummary {
+	summary := InstanceStatusSummary{
+		InstanceID:                 instance.InstanceID,
+		InstanceName:               instance.InstanceName,
+		Status:                     instance.Status,
+		LastStatusUpdateTimestamp:  int64(instance.LastStatusUpdateTimestamp),
+		LastDeployedTimestamp:      int64(instance.LastDeployedTimestamp),
+		LastDeployAttemptTimestamp: int64(instance.LastDeployAttemptTimestamp),
+		ResourceStatusCounts:       map[core.ResourceStatus]int{},
+	}
+
+	for _, resource := range instance.Resources {
+		if resource == nil {
+			continue
+		}
+		summary.ResourceCount++
+		summary.ResourceStatusCounts[resource.Status]++
+		if resource.Drifted {
+			summary.DriftedResourceCount++
+		}
+	}
+
+	for _, link := range instance.Links {
+		if link == nil {
+			continue
+		}
+		summary.LinkCount++
+		if link.Drifted {
+			summary.DriftedLinkCount++
+		}
+	}
+
+	return summary
 }
 
 // ResourcesContainer provides an interface for functionality related
@@ -342,6 +442,18 @@ type ResourceState struct {
 	// the resource has since been removed from the source blueprint.
 	// An empty value is treated as the default "delete" policy.
 	RemovalPolicy string `json:"removalPolicy,omitempty"`
+	// IgnoreChanges holds the paths to fields in the resource spec
+	// for which changes should be ignored, as declared in the most recently
+	// deployed version of the source blueprint.
+	// This is persisted so that drift checks can skip reporting changes
+	// to these fields without access to the source blueprint.
+	IgnoreChanges []string `json:"ignoreChanges,omitempty"`
+	// Tainted indicates whether the resource has been flagged
+	// to be replaced the next time changes are staged for the blueprint instance,
+	// even if there are no changes to the resource spec.
+	// This is useful when a resource is known to have been corrupted externally.
+	// The flag is cleared once a replacement for the resource has been deployed.
+	Tainted bool `json:"tainted,omitempty"`
 }
 
 func (r *ResourceState) ID() string {
@@ -430,6 +542,10 @@ type ResourceDriftFieldChange struct {
 	// DriftedValue holds the value of the field in the drifted state
 	// in the upstream provider.
 	DriftedValue *core.MappingNode `json:"driftedValue"`
+	// Sensitive indicates whether the field is marked as sensitive
+	// in the resource spec schema, the values are persisted as they are
+	// to allow for reconciliation but must be redacted in any output.
+	Sensitive bool `json:"sensitive,omitempty"`
 }
 
 // LinkDriftState holds information about how a link has drifted
@@ -569,6 +685,10 @@ type InstanceState struct {
 	ChildDependencies map[string]*DependencyInfo `json:"childDependencies,omitempty"`
 	// Durations holds duration information for the latest deployment of the blueprint instance.
 	Durations *InstanceCompletionDuration `json:"durations,omitempty"`
+	// ExpiresAt holds the unix timestamp when an ephemeral blueprint instance
+	// (e.g. a preview environment for a pull request) expires and should be destroyed.
+	// This is 0 for blueprint instances that do not expire.
+	ExpiresAt int `json:"expiresAt,omitempty"`
 	// Version is used for optimistic concurrency control when updating the instance status.
 	// This is used to protect against a race between multiple deployment processes that are trying to start
 	// a deployment at the same time.
@@ -587,6 +707,12 @@ type ExportState struct {
 	// Field holds the path of a field in a blueprint element
 	// that should be exported.
 	Field string `json:"field"`
+	// Sensitive indicates whether the exported value is derived
+	// from a secret variable or a sensitive resource spec field.
+	// The value is persisted as it is so it can be consumed by other
+	// blueprints and tools but must be redacted in any output
+	// intended to be read by a user.
+	Sensitive bool `json:"sensitive,omitempty"`
 }
 
 // InstanceStatusInfo holds information about the status of a blueprint instance
@@ -598,6 +724,9 @@ type InstanceStatusInfo struct {
 	LastDeployAttemptTimestamp *int                        `json:"lastDeployAttemptTimestamp,omitempty"`
 	LastStatusUpdateTimestamp  *int                        `json:"lastStatusUpdateTimestamp,omitempty"`
 	Durations                  *InstanceCompletionDuration `json:"durations,omitempty"`
+	// ExpiresAt holds the unix timestamp when an ephemeral blueprint instance expires,
+	// when nil, the current expiry of the blueprint instance is left unchanged.
+	ExpiresAt *int `json:"expiresAt,omitempty"`
 }
 
 // ChildBlueprint holds the state of a child blueprint
@@ -682,6 +811,9 @@ type LinkState struct {
 	//
 	// {resourceName} represents the logical name of the resource in single blueprint instance.
 	ResourceDataMappings map[string]string `json:"resourceDataMappings,omitempty"`
+	// Config holds the configuration for the link defined in the `links`
+	// section of the blueprint that was used in the latest deployment of the link.
+	Config *core.MappingNode `json:"config,omitempty"`
 	// Holds the latest reasons for failures in deploying a link,
 	// this only ever holds the results of the latest deployment attempt.
 	FailureReasons []string `json:"failureReasons"`
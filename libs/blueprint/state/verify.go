@@ -0,0 +1,504 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+)
+
+// IntegrityIssueCode is the type of inconsistency found when verifying
+// the state of a blueprint instance.
+type IntegrityIssueCode string
+
+const (
+	// IntegrityIssueLinkMissingResource is used when a link refers to a resource
+	// that does not exist in the blueprint instance.
+	IntegrityIssueLinkMissingResource IntegrityIssueCode = "link_missing_resource"
+	// IntegrityIssueInvalidResourceDataMapping is used when a resource data mapping
+	// for a link refers to a resource or a resource spec field that does not exist
+	// or a link data field that does not exist.
+	IntegrityIssueInvalidResourceDataMapping IntegrityIssueCode = "invalid_resource_data_mapping"
+	// IntegrityIssueResourceInstanceIDMismatch is used when the instance ID of a resource
+	// does not match the ID of the blueprint instance that it belongs to.
+	IntegrityIssueResourceInstanceIDMismatch IntegrityIssueCode = "resource_instance_id_mismatch"
+	// IntegrityIssueLinkInstanceIDMismatch is used when the instance ID of a link
+	// does not match the ID of the blueprint instance that it belongs to.
+	IntegrityIssueLinkInstanceIDMismatch IntegrityIssueCode = "link_instance_id_mismatch"
+	// IntegrityIssueChildInstanceIDMismatch is used when a child blueprint
+	// does not have an instance ID of its own or shares the instance ID of its parent
+	// or another instance in the same tree.
+	IntegrityIssueChildInstanceIDMismatch IntegrityIssueCode = "child_instance_id_mismatch"
+)
+
+// IntegrityRepairAction is the action taken to repair an inconsistency
+// in the state of a blueprint instance.
+type IntegrityRepairAction string
+
+const (
+	// IntegrityRepairActionNone is used when an issue has not been repaired,
+	// this will be the case when verifying state without repair mode enabled.
+	IntegrityRepairActionNone IntegrityRepairAction = ""
+	// IntegrityRepairActionRemovedLink is used when a link was removed from state.
+	IntegrityRepairActionRemovedLink IntegrityRepairAction = "removed_link"
+	// IntegrityRepairActionRemovedResourceDataMapping is used when
+	// a resource data mapping was removed from a link.
+	IntegrityRepairActionRemovedResourceDataMapping IntegrityRepairAction = "removed_resource_data_mapping"
+	// IntegrityRepairActionUpdatedInstanceID is used when the instance ID
+	// of a resource or link was updated to match the instance it belongs to.
+	IntegrityRepairActionUpdatedInstanceID IntegrityRepairAction = "updated_instance_id"
+	// IntegrityRepairActionDetachedChild is used when a child blueprint was detached
+	// from its parent blueprint instance.
+	IntegrityRepairActionDetachedChild IntegrityRepairAction = "detached_child"
+)
+
+// IntegrityIssue holds information about an inconsistency found
+// when verifying the state of a blueprint instance.
+type IntegrityIssue struct {
+	Code IntegrityIssueCode `json:"code"`
+	// InstanceID is the ID of the blueprint instance that the element
+	// with the issue belongs to, this may be a child blueprint instance
+	// of the instance being verified.
+	InstanceID string `json:"instanceId"`
+	// InstancePath is the path of child blueprint names from the instance
+	// being verified to the instance that the element with the issue belongs to,
+	// (e.g. "networking.subnets").
+	// This is empty for elements in the instance being verified.
+	InstancePath string `json:"instancePath,omitempty"`
+	// ElementKind is the kind of element that has the issue.
+	ElementKind ElementKind `json:"elementKind"`
+	// ElementName is the logical name of the element that has the issue.
+	ElementName string `json:"elementName"`
+	// ElementID is the globally unique ID of the element that has the issue,
+	// where one is available.
+	ElementID string `json:"elementId,omitempty"`
+	// Message provides a human-readable description of the issue.
+	Message string `json:"message"`
+	// RepairAction is the action that was taken to repair the issue,
+	// this will be empty when the issue has not been repaired.
+	RepairAction IntegrityRepairAction `json:"repairAction,omitempty"`
+}
+
+// Repaired determines whether the issue has been repaired.
+func (i *IntegrityIssue) Repaired() bool {
+	return i.RepairAction != IntegrityRepairActionNone
+}
+
+// VerifyResult holds the result of verifying the state
+// of a blueprint instance.
+type VerifyResult struct {
+	InstanceID string            `json:"instanceId"`
+	Issues     []*IntegrityIssue `json:"issues"`
+}
+
+// Consistent determines whether no issues were found
+// when verifying the state of a blueprint instance.
+func (r *VerifyResult) Consistent() bool {
+	return len(r.Issues) == 0
+}
+
+// UnrepairedIssues returns the issues that have not been repaired.
+func (r *VerifyResult) UnrepairedIssues() []*IntegrityIssue {
+	unrepaired := []*IntegrityIssue{}
+	for _, issue := range r.Issues {
+		if !issue.Repaired() {
+			unrepaired = append(unrepaired, issue)
+		}
+	}
+	return unrepaired
+}
+
+// VerifyOptions provides options for verifying the state
+// of a blueprint instance.
+type VerifyOptions struct {
+	// Repair determines whether inconsistencies should be fixed or pruned
+	// from the state of the blueprint instance.
+	// When this is false, the state will be checked without being modified.
+	Repair bool
+}
+
+// The maximum depth of child blueprints to verify,
+// this protects against cycles in the state of child blueprints.
+const maxVerifyChildDepth = 10
+
+// Verify checks the state of the blueprint instance with the provided ID
+// and its child blueprints for inconsistencies, such as links that refer
+// to resources that do not exist, resource data mappings that point to
+// fields that do not exist and child blueprints with mismatched instance IDs.
+//
+// When repair mode is enabled in the provided options, inconsistencies
+// are fixed or pruned from state using the provided state container
+// and the action taken is recorded for each issue in the result.
+func Verify(
+	ctx context.Context,
+	container Container,
+	instanceID string,
+	opts VerifyOptions,
+) (*VerifyResult, error) {
+	instance, err := container.Instances().Get(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier := &instanceVerifier{
+		container: container,
+		opts:      opts,
+		seenIDs:   map[string]bool{},
+	}
+	err = verifier.verifyInstance(ctx, &instance, "", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifyResult{
+		InstanceID: instanceID,
+		Issues:     verifier.issues,
+	}, nil
+}
+
+type instanceVerifier struct {
+	container Container
+	opts      VerifyOptions
+	seenIDs   map[string]bool
+	issues    []*IntegrityIssue
+}
+
+func (v *instanceVerifier) verifyInstance(
+	ctx context.Context,
+	instance *InstanceState,
+	instancePath string,
+	depth int,
+) error {
+	v.seenIDs[instance.InstanceID] = true
+
+	err := v.verifyResources(ctx, instance, instancePath)
+	if err != nil {
+		return err
+	}
+
+	err = v.verifyLinks(ctx, instance, instancePath)
+	if err != nil {
+		return err
+	}
+
+	if depth >= maxVerifyChildDepth {
+		return nil
+	}
+
+	return v.verifyChildren(ctx, instance, instancePath, depth)
+}
+
+func (v *instanceVerifier) verifyResources(
+	ctx context.Context,
+	instance *InstanceState,
+	instancePath string,
+) error {
+	for _, resource := range instance.Resources {
+		if resource == nil || resource.InstanceID == instance.InstanceID {
+			continue
+		}
+
+		issue := &IntegrityIssue{
+			Code:         IntegrityIssueResourceInstanceIDMismatch,
+			InstanceID:   instance.InstanceID,
+			InstancePath: instancePath,
+			ElementKind:  ResourceElement,
+			ElementName:  resource.Name,
+			ElementID:    resource.ResourceID,
+			Message: fmt.Sprintf(
+				"resource %q has instance ID %q but belongs to instance %q",
+				resource.Name,
+				resource.InstanceID,
+				instance.InstanceID,
+			),
+		}
+		if v.opts.Repair {
+			updated := *resource
+			updated.InstanceID = instance.InstanceID
+			err := v.container.Resources().Save(ctx, updated)
+			if err != nil {
+				return err
+			}
+			issue.RepairAction = IntegrityRepairActionUpdatedInstanceID
+		}
+		v.issues = append(v.issues, issue)
+	}
+
+	return nil
+}
+
+func (v *instanceVerifier) verifyLinks(
+	ctx context.Context,
+	instance *InstanceState,
+	instancePath string,
+) error {
+	for linkName, link := range instance.Links {
+		if link == nil {
+			continue
+		}
+
+		removed, err := v.verifyLinkResources(ctx, instance, instancePath, linkName, link)
+		if err != nil {
+			return err
+		}
+		if removed {
+			// There is nothing else to check for a link that has been removed.
+			continue
+		}
+
+		err = v.verifyLinkInstanceAndMappings(ctx, instance, instancePath, linkName, link)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *instanceVerifier) verifyLinkResources(
+	ctx context.Context,
+	instance *InstanceState,
+	instancePath string,
+	linkName string,
+	link *LinkState,
+) (bool, error) {
+	resourceAName, resourceBName, _ := strings.Cut(linkName, "::")
+	missing := []string{}
+	for _, resourceName := range []string{resourceAName, resourceBName} {
+		if findResource(instance, resourceName) == nil {
+			missing = append(missing, resourceName)
+		}
+	}
+
+	if len(missing) == 0 {
+		return false, nil
+	}
+
+	issue := &IntegrityIssue{
+		Code:         IntegrityIssueLinkMissingResource,
+		InstanceID:   instance.InstanceID,
+		InstancePath: instancePath,
+		ElementKind:  LinkElement,
+		ElementName:  linkName,
+		ElementID:    link.LinkID,
+		Message: fmt.Sprintf(
+			"link %q refers to resources that do not exist in the instance: %s",
+			linkName,
+			strings.Join(missing, ", "),
+		),
+	}
+	if v.opts.Repair {
+		_, err := v.container.Links().Remove(ctx, link.LinkID)
+		if err != nil && !IsLinkNotFound(err) {
+			return false, err
+		}
+		issue.RepairAction = IntegrityRepairActionRemovedLink
+	}
+	v.issues = append(v.issues, issue)
+
+	return v.opts.Repair, nil
+}
+
+func (v *instanceVerifier) verifyLinkInstanceAndMappings(
+	ctx context.Context,
+	instance *InstanceState,
+	instancePath string,
+	linkName string,
+	link *LinkState,
+) error {
+	updated := *link
+	updated.ResourceDataMappings = copyResourceDataMappings(link.ResourceDataMappings)
+	needsSave := false
+
+	if link.InstanceID != instance.InstanceID {
+		issue := &IntegrityIssue{
+			Code:         IntegrityIssueLinkInstanceIDMismatch,
+			InstanceID:   instance.InstanceID,
+			InstancePath: instancePath,
+			ElementKind:  LinkElement,
+			ElementName:  linkName,
+			ElementID:    link.LinkID,
+			Message: fmt.Sprintf(
+				"link %q has instance ID %q but belongs to instance %q",
+				linkName,
+				link.InstanceID,
+				instance.InstanceID,
+			),
+		}
+		if v.opts.Repair {
+			updated.InstanceID = instance.InstanceID
+			needsSave = true
+			issue.RepairAction = IntegrityRepairActionUpdatedInstanceID
+		}
+		v.issues = append(v.issues, issue)
+	}
+
+	linkData := &core.MappingNode{Fields: link.Data}
+	for mappingKey, linkDataPath := range link.ResourceDataMappings {
+		reason := checkResourceDataMapping(instance, mappingKey, linkDataPath, linkData)
+		if reason == "" {
+			continue
+		}
+
+		issue := &IntegrityIssue{
+			Code:         IntegrityIssueInvalidResourceDataMapping,
+			InstanceID:   instance.InstanceID,
+			InstancePath: instancePath,
+			ElementKind:  LinkElement,
+			ElementName:  linkName,
+			ElementID:    link.LinkID,
+			Message: fmt.Sprintf(
+				"resource data mapping %q -> %q for link %q is invalid, %s",
+				mappingKey,
+				linkDataPath,
+				linkName,
+				reason,
+			),
+		}
+		if v.opts.Repair {
+			delete(updated.ResourceDataMappings, mappingKey)
+			needsSave = true
+			issue.RepairAction = IntegrityRepairActionRemovedResourceDataMapping
+		}
+		v.issues = append(v.issues, issue)
+	}
+
+	if needsSave {
+		return v.container.Links().Save(ctx, updated)
+	}
+
+	return nil
+}
+
+func (v *instanceVerifier) verifyChildren(
+	ctx context.Context,
+	instance *InstanceState,
+	instancePath string,
+	depth int,
+) error {
+	for childName, child := range instance.ChildBlueprints {
+		if child == nil {
+			continue
+		}
+
+		childPath := childInstancePath(instancePath, childName)
+		reason := ""
+		if strings.TrimSpace(child.InstanceID) == "" {
+			reason = "the child blueprint does not have an instance ID"
+		} else if v.seenIDs[child.InstanceID] {
+			reason = fmt.Sprintf(
+				"the child blueprint instance ID %q is already used by its parent "+
+					"or another instance in the same tree",
+				child.InstanceID,
+			)
+		}
+
+		if reason == "" {
+			err := v.verifyInstance(ctx, child, childPath, depth+1)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		issue := &IntegrityIssue{
+			Code:         IntegrityIssueChildInstanceIDMismatch,
+			InstanceID:   instance.InstanceID,
+			InstancePath: instancePath,
+			ElementKind:  ChildElement,
+			ElementName:  childName,
+			ElementID:    child.InstanceID,
+			Message: fmt.Sprintf(
+				"child blueprint %q in instance %q is invalid, %s",
+				childName,
+				instance.InstanceID,
+				reason,
+			),
+		}
+		if v.opts.Repair {
+			err := v.container.Children().Detach(ctx, instance.InstanceID, childName)
+			if err != nil && !IsInstanceNotFound(err) {
+				return err
+			}
+			issue.RepairAction = IntegrityRepairActionDetachedChild
+		}
+		v.issues = append(v.issues, issue)
+	}
+
+	return nil
+}
+
+// checkResourceDataMapping returns the reason why a resource data mapping
+// for a link is invalid, an empty string is returned when the mapping is valid.
+func checkResourceDataMapping(
+	instance *InstanceState,
+	mappingKey string,
+	linkDataPath string,
+	linkData *core.MappingNode,
+) string {
+	resourceName, fieldPath, hasSeparator := strings.Cut(mappingKey, "::")
+	if !hasSeparator || resourceName == "" || fieldPath == "" {
+		return "the mapping key must be in the format {resourceName}::{fieldPath}"
+	}
+
+	resource := findResource(instance, resourceName)
+	if resource == nil {
+		return fmt.Sprintf("resource %q does not exist in the instance", resourceName)
+	}
+
+	if !strings.HasPrefix(fieldPath, "spec.") && !strings.HasPrefix(fieldPath, "spec[") {
+		return fmt.Sprintf("field path %q must be a path to a field in the resource spec", fieldPath)
+	}
+
+	if !pathExists(core.ReplaceSpecWithRoot(fieldPath), resource.SpecData) {
+		return fmt.Sprintf("field %q does not exist in the spec of resource %q", fieldPath, resourceName)
+	}
+
+	if !pathExists(fmt.Sprintf("$.%s", linkDataPath), linkData) {
+		return fmt.Sprintf("field %q does not exist in the link data", linkDataPath)
+	}
+
+	return ""
+}
+
+func pathExists(path string, node *core.MappingNode) bool {
+	value, err := core.GetPathValue(path, node, core.MappingNodeMaxTraverseDepth)
+	return err == nil && value != nil
+}
+
+func findResource(instance *InstanceState, resourceName string) *ResourceState {
+	if resourceID, hasID := instance.ResourceIDs[resourceName]; hasID {
+		if resource, exists := instance.Resources[resourceID]; exists && resource != nil {
+			return resource
+		}
+	}
+
+	for _, resource := range instance.Resources {
+		if resource != nil && resource.Name == resourceName {
+			return resource
+		}
+	}
+
+	return nil
+}
+
+func childInstancePath(parentPath string, childName string) string {
+	if parentPath == "" {
+		return childName
+	}
+
+	return fmt.Sprintf("%s.%s", parentPath, childName)
+}
+
+func copyResourceDataMappings(mappings map[string]string) map[string]string {
+	if mappings == nil {
+		return nil
+	}
+
+	copied := make(map[string]string, len(mappings))
+	for key, value := range mappings {
+		copied[key] = value
+	}
+	return copied
+}
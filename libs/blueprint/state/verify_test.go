@@ -0,0 +1,190 @@
+package state_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/memstate"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	verifyTestInstanceID = "verify-instance-1"
+	verifyTestChildID    = "verify-child-1"
+)
+
+type VerifyTestSuite struct {
+	suite.Suite
+	container state.Container
+}
+
+func (s *VerifyTestSuite) SetupTest() {
+	s.container = memstate.NewMemoryStateContainer()
+	err := s.container.Instances().Save(context.Background(), createVerifyTestInstance())
+	s.Require().NoError(err)
+}
+
+func (s *VerifyTestSuite) Test_reports_no_issues_for_consistent_state() {
+	container := memstate.NewMemoryStateContainer()
+	instance := createVerifyTestInstance()
+	delete(instance.Links, "ordersTable::missingFunction")
+	instance.Links["ordersTable::ordersFunction"].ResourceDataMappings = map[string]string{
+		"ordersFunction::spec.environment.TABLE_NAME": "ordersFunction.tableName",
+	}
+	instance.Resources["resource-2"].InstanceID = verifyTestInstanceID
+	instance.ChildBlueprints["networking"].InstanceID = verifyTestChildID
+	err := container.Instances().Save(context.Background(), instance)
+	s.Require().NoError(err)
+
+	result, err := state.Verify(
+		context.Background(),
+		container,
+		verifyTestInstanceID,
+		state.VerifyOptions{},
+	)
+	s.Require().NoError(err)
+	s.Assert().True(result.Consistent())
+}
+
+func (s *VerifyTestSuite) Test_reports_issues_without_modifying_state() {
+	result, err := state.Verify(
+		context.Background(),
+		s.container,
+		verifyTestInstanceID,
+		state.VerifyOptions{},
+	)
+	s.Require().NoError(err)
+
+	s.Assert().ElementsMatch(
+		[]state.IntegrityIssueCode{
+			state.IntegrityIssueLinkMissingResource,
+			state.IntegrityIssueInvalidResourceDataMapping,
+			state.IntegrityIssueResourceInstanceIDMismatch,
+			state.IntegrityIssueChildInstanceIDMismatch,
+		},
+		issueCodes(result.Issues),
+	)
+	s.Assert().Len(result.UnrepairedIssues(), 4)
+
+	instance, err := s.container.Instances().Get(context.Background(), verifyTestInstanceID)
+	s.Require().NoError(err)
+	s.Assert().Contains(instance.Links, "ordersTable::missingFunction")
+	s.Assert().Len(instance.Links["ordersTable::ordersFunction"].ResourceDataMappings, 2)
+}
+
+func (s *VerifyTestSuite) Test_repairs_issues() {
+	result, err := state.Verify(
+		context.Background(),
+		s.container,
+		verifyTestInstanceID,
+		state.VerifyOptions{Repair: true},
+	)
+	s.Require().NoError(err)
+	s.Assert().Len(result.Issues, 4)
+	s.Assert().Empty(result.UnrepairedIssues())
+
+	instance, err := s.container.Instances().Get(context.Background(), verifyTestInstanceID)
+	s.Require().NoError(err)
+	s.Assert().NotContains(instance.Links, "ordersTable::missingFunction")
+	s.Assert().Equal(
+		map[string]string{
+			"ordersFunction::spec.environment.TABLE_NAME": "ordersFunction.tableName",
+		},
+		instance.Links["ordersTable::ordersFunction"].ResourceDataMappings,
+	)
+	s.Assert().Equal(verifyTestInstanceID, instance.Resources["resource-2"].InstanceID)
+
+	result, err = state.Verify(
+		context.Background(),
+		s.container,
+		verifyTestInstanceID,
+		state.VerifyOptions{},
+	)
+	s.Require().NoError(err)
+	s.Assert().True(result.Consistent())
+}
+
+func issueCodes(issues []*state.IntegrityIssue) []state.IntegrityIssueCode {
+	codes := make([]state.IntegrityIssueCode, len(issues))
+	for i, issue := range issues {
+		codes[i] = issue.Code
+	}
+	return codes
+}
+
+func createVerifyTestInstance() state.InstanceState {
+	return state.InstanceState{
+		InstanceID:   verifyTestInstanceID,
+		InstanceName: "VerifyInstance1",
+		ResourceIDs: map[string]string{
+			"ordersTable":    "resource-1",
+			"ordersFunction": "resource-2",
+		},
+		Resources: map[string]*state.ResourceState{
+			"resource-1": {
+				ResourceID: "resource-1",
+				Name:       "ordersTable",
+				Type:       "aws/dynamodb/table",
+				InstanceID: verifyTestInstanceID,
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"tableName": core.MappingNodeFromString("orders"),
+					},
+				},
+			},
+			"resource-2": {
+				ResourceID: "resource-2",
+				Name:       "ordersFunction",
+				Type:       "aws/lambda/function",
+				// Mismatched instance ID.
+				InstanceID: "other-instance",
+				SpecData: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"environment": {
+							Fields: map[string]*core.MappingNode{
+								"TABLE_NAME": core.MappingNodeFromString("orders"),
+							},
+						},
+					},
+				},
+			},
+		},
+		Links: map[string]*state.LinkState{
+			"ordersTable::ordersFunction": {
+				LinkID:     "link-1",
+				Name:       "ordersTable::ordersFunction",
+				InstanceID: verifyTestInstanceID,
+				Data: map[string]*core.MappingNode{
+					"ordersFunction": {
+						Fields: map[string]*core.MappingNode{
+							"tableName": core.MappingNodeFromString("orders"),
+						},
+					},
+				},
+				ResourceDataMappings: map[string]string{
+					"ordersFunction::spec.environment.TABLE_NAME": "ordersFunction.tableName",
+					// The field does not exist in the resource spec.
+					"ordersFunction::spec.environment.TABLE_ARN": "ordersFunction.tableArn",
+				},
+			},
+			"ordersTable::missingFunction": {
+				LinkID:     "link-2",
+				Name:       "ordersTable::missingFunction",
+				InstanceID: verifyTestInstanceID,
+			},
+		},
+		ChildBlueprints: map[string]*state.InstanceState{
+			"networking": {
+				// Missing instance ID.
+				InstanceID:   "",
+				InstanceName: "VerifyInstance1Networking",
+			},
+		},
+	}
+}
+
+func TestVerifyTestSuite(t *testing.T) {
+	suite.Run(t, new(VerifyTestSuite))
+}
@@ -1,9 +1,12 @@
 package subengine
 
 import (
+	nativeerrors "errors"
 	"fmt"
 
 	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
 )
 
 type resolveOnDeployError struct {
@@ -46,3 +49,28 @@ func errMustResolveOnDeployMultiple(
 		errors: errors,
 	}
 }
+
+// Determines whether an error resolving an argument of the "try" function
+// should fall back to the next argument.
+// Errors indicating that a value can only be resolved during deployment
+// or that a referenced resource has not been resolved yet must not be
+// recovered from as they signal that the substitution should be
+// resolved at a later stage.
+func isRecoverableTryArgError(err error) bool {
+	var resolveOnDeployErr *resolveOnDeployError
+	if nativeerrors.As(err, &resolveOnDeployErr) {
+		return false
+	}
+
+	var funcCallErr *function.FuncCallError
+	if nativeerrors.As(err, &funcCallErr) {
+		return true
+	}
+
+	var runErr *errors.RunError
+	if !nativeerrors.As(err, &runErr) {
+		return false
+	}
+
+	return runErr.ReasonCode != ErrorReasonCodeResourceNotResolved
+}
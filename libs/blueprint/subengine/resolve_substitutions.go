@@ -1033,6 +1033,13 @@ func (r *defaultSubstitutionResolver) resolveInMappingNode(
 	resolveOnDeployErrs := []*resolveOnDeployError{}
 
 	if mappingNode.Scalar != nil {
+		if isInstanceReferenceScalar(mappingNode.Scalar) {
+			return r.resolveInstanceExportReference(
+				ctx,
+				*mappingNode.Scalar.StringValue,
+				resolveCtx,
+			)
+		}
 		return mappingNode, nil
 	}
 
@@ -1681,6 +1688,45 @@ func (r *defaultSubstitutionResolver) resolveSubstitutionValue(
 	functionCallDeps *functionCallDependencies,
 	resolveCtx *resolveContext,
 ) (*bpcore.MappingNode, error) {
+	trace := SubstitutionTraceFromContext(ctx)
+	// Function calls are traced when they are resolved and literals are
+	// not traced as they are a part of the expression of the step that uses them.
+	if trace == nil ||
+		substitutionValue.Function != nil ||
+		r.resolveScalar(substitutionValue) != nil {
+		return r.resolveSubstitutionValueContent(
+			ctx,
+			substitutionValue,
+			functionCallDeps,
+			resolveCtx,
+		)
+	}
+
+	traceEntry := trace.begin(
+		traceFieldPath(resolveCtx),
+		SubstitutionTraceEntryTypeReference,
+		substitutionValue,
+	)
+	if r.isSecretReference(substitutionValue) {
+		trace.markSecret(traceEntry)
+	}
+
+	resolved, err := r.resolveSubstitutionValueContent(
+		ctx,
+		substitutionValue,
+		functionCallDeps,
+		resolveCtx,
+	)
+	trace.end(traceEntry, resolved, err)
+	return resolved, err
+}
+
+func (r *defaultSubstitutionResolver) resolveSubstitutionValueContent(
+	ctx context.Context,
+	substitutionValue *substitutions.Substitution,
+	functionCallDeps *functionCallDependencies,
+	resolveCtx *resolveContext,
+) (*bpcore.MappingNode, error) {
 
 	resolvedScalar := r.resolveScalar(substitutionValue)
 	if resolvedScalar != nil {
@@ -1860,7 +1906,16 @@ func (r *defaultSubstitutionResolver) resolveValue(
 		return nil, err
 	}
 
-	r.valueCache.Set(value.ValueName, computed.ResolvedValue)
+	// Values that depend on the deployed state of resources or child blueprints
+	// are not cached as they must be re-resolved once the resources or child
+	// blueprints have been deployed, for example, when exports are resolved
+	// after a deployment.
+	// Values that must be resolved on deploy are not cached so that
+	// the requirement to resolve on deploy is reported for every reference.
+	if len(computed.ResolveOnDeploy) == 0 &&
+		!valueDependsOnDeployedState(value.ValueName, r.spec.Schema(), map[string]bool{}) {
+		r.valueCache.Set(value.ValueName, computed.ResolvedValue)
+	}
 
 	if len(computed.ResolveOnDeploy) > 0 {
 		return computed.ResolvedValue.Value, errMustResolveOnDeployMultiple(
@@ -1916,6 +1971,17 @@ func (r *defaultSubstitutionResolver) resolveElemReference(
 	elemRef *substitutions.SubstitutionElemReference,
 	resolveCtx *resolveContext,
 ) (*bpcore.MappingNode, error) {
+	if resolveCtx.elemScope != nil {
+		return getPathValueFromMappingNode(
+			resolveCtx.elemScope.item,
+			elemRef.Path,
+			elemRef,
+			resolveCtx,
+			/* mappingNodeStartsAfter */ 0,
+			errMissingCurrentElementProperty,
+		)
+	}
+
 	resourceName := resourceNameFromElementID(resolveCtx.currentElementName)
 	resourceNameParts, couldBeTemplate := extractResourceTemplateNameParts(resourceName)
 	if !couldBeTemplate {
@@ -1958,6 +2024,14 @@ func (r *defaultSubstitutionResolver) resolveElemReference(
 func (r *defaultSubstitutionResolver) resolveElemIndexReference(
 	resolveCtx *resolveContext,
 ) (*bpcore.MappingNode, error) {
+	if resolveCtx.elemScope != nil {
+		return &bpcore.MappingNode{
+			Scalar: &bpcore.ScalarValue{
+				IntValue: &resolveCtx.elemScope.index,
+			},
+		}, nil
+	}
+
 	resourceName := resourceNameFromElementID(resolveCtx.currentElementName)
 	resourceNameParts, couldBeTemplate := extractResourceTemplateNameParts(resourceName)
 	if !couldBeTemplate {
@@ -1983,7 +2057,7 @@ func (r *defaultSubstitutionResolver) resolveDataSourceProperty(
 
 	cached, hasValue := r.dataSourceDataCache.Get(dataSourceProperty.DataSourceName)
 	if hasValue {
-		return extractDataSourceProperty(resolveCtx.currentElementName, resolvedDataSource, cached, dataSourceProperty)
+		return ExtractDataSourceProperty(resolveCtx.currentElementName, resolvedDataSource, cached, dataSourceProperty)
 	}
 
 	providerNamespace := provider.ExtractProviderFromItemType(resolvedDataSource.Type.Value)
@@ -2005,7 +2079,7 @@ func (r *defaultSubstitutionResolver) resolveDataSourceProperty(
 
 	r.dataSourceDataCache.Set(dataSourceProperty.DataSourceName, dataOutput.Data)
 
-	return extractDataSourceProperty(
+	return ExtractDataSourceProperty(
 		resolveCtx.currentElementName,
 		resolvedDataSource,
 		dataOutput.Data,
@@ -2013,7 +2087,11 @@ func (r *defaultSubstitutionResolver) resolveDataSourceProperty(
 	)
 }
 
-func extractDataSourceProperty(
+// ExtractDataSourceProperty extracts the value of a data source property
+// referenced in a substitution from the data fetched for the data source.
+// The field name in the property can be the name of a field in the data source
+// output or an alias defined in the data source exports.
+func ExtractDataSourceProperty(
 	parentElementName string,
 	resolvedDataSource *provider.ResolvedDataSource,
 	data map[string]*bpcore.MappingNode,
@@ -2370,12 +2448,86 @@ func (r *defaultSubstitutionResolver) resolveChildReference(
 	return getChildExportProperty(exportState, childReference, resolveCtx)
 }
 
+// resolveInstanceExportReference resolves a reference to an export
+// of another deployed blueprint instance from the state container.
+// The value of the export captured when the referenced instance
+// was last deployed is used.
+func (r *defaultSubstitutionResolver) resolveInstanceExportReference(
+	ctx context.Context,
+	value string,
+	resolveCtx *resolveContext,
+) (*bpcore.MappingNode, error) {
+	ref, err := substitutions.ParseInstanceExportReference(value)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := r.stateContainer.Instances()
+	instanceID, err := instances.LookupIDByName(ctx, ref.InstanceName)
+	if err != nil {
+		if state.IsInstanceNotFound(err) {
+			return nil, errMissingInstanceExport(
+				resolveCtx.currentElementName,
+				ref,
+				/* instanceExists */ false,
+			)
+		}
+		return nil, err
+	}
+
+	instanceState, err := instances.Get(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	exportState, hasExport := instanceState.Exports[ref.ExportName]
+	if !hasExport || exportState == nil {
+		return nil, errMissingInstanceExport(
+			resolveCtx.currentElementName,
+			ref,
+			/* instanceExists */ true,
+		)
+	}
+
+	return exportState.Value, nil
+}
+
 func (r *defaultSubstitutionResolver) resolveFunctionCall(
 	ctx context.Context,
 	function *substitutions.SubstitutionFunctionExpr,
 	functionCallDeps *functionCallDependencies,
 	resolveCtx *resolveContext,
 ) (*resolvedFunctionCallValue, error) {
+	trace := SubstitutionTraceFromContext(ctx)
+	traceEntry := trace.begin(
+		traceFieldPath(resolveCtx),
+		SubstitutionTraceEntryTypeFunctionCall,
+		&substitutions.Substitution{Function: function},
+	)
+
+	output, err := r.resolveFunctionCallOutput(
+		ctx,
+		function,
+		functionCallDeps,
+		resolveCtx,
+		traceEntry,
+	)
+	var outputValue *bpcore.MappingNode
+	if output != nil {
+		outputValue = output.value
+	}
+	trace.end(traceEntry, outputValue, err)
+
+	return output, err
+}
+
+func (r *defaultSubstitutionResolver) resolveFunctionCallOutput(
+	ctx context.Context,
+	function *substitutions.SubstitutionFunctionExpr,
+	functionCallDeps *functionCallDependencies,
+	resolveCtx *resolveContext,
+	traceEntry *SubstitutionTraceEntry,
+) (*resolvedFunctionCallValue, error) {
 
 	hasFunction, err := functionCallDeps.scopedRegistry.HasFunction(
 		ctx,
@@ -2403,6 +2555,30 @@ func (r *defaultSubstitutionResolver) resolveFunctionCall(
 		)
 	}
 
+	// Arguments of the "try" function are resolved lazily so that an error
+	// resolving one argument can fall back to the next argument.
+	if function.FunctionName == substitutions.SubstitutionFunctionTry {
+		return r.resolveTryFunctionCall(
+			ctx,
+			function,
+			functionCallDeps,
+			resolveCtx,
+		)
+	}
+
+	// Element expressions passed into higher-order functions are resolved
+	// for each item in the list instead of being passed in as a function value.
+	elemExprArgIndex := substitutions.ElemExpressionArgIndex(function)
+	if elemExprArgIndex >= 0 {
+		return r.resolveElemExpressionFunctionCall(
+			ctx,
+			function,
+			elemExprArgIndex,
+			functionCallDeps,
+			resolveCtx,
+		)
+	}
+
 	resolvedArgs := []*resolvedFunctionCallValue{}
 	for index, arg := range function.Arguments {
 		if arg.Value != nil {
@@ -2437,6 +2613,8 @@ func (r *defaultSubstitutionResolver) resolveFunctionCall(
 		return nil, err
 	}
 
+	SubstitutionTraceFromContext(ctx).setInputs(traceEntry, resolvedArgs)
+
 	transformedArgs := core.Map(resolvedArgs, transformValueForFunctionCall)
 
 	// Check if the function has variadic parameters
@@ -2486,6 +2664,134 @@ func (r *defaultSubstitutionResolver) resolveFunctionCall(
 	}, nil
 }
 
+func (r *defaultSubstitutionResolver) resolveTryFunctionCall(
+	ctx context.Context,
+	function *substitutions.SubstitutionFunctionExpr,
+	functionCallDeps *functionCallDependencies,
+	resolveCtx *resolveContext,
+) (*resolvedFunctionCallValue, error) {
+	var lastErr error
+	for index, arg := range function.Arguments {
+		if arg.Value == nil {
+			return nil, createEmptyArgError(
+				resolveCtx.currentElementName,
+				string(function.FunctionName),
+				arg,
+				index,
+			)
+		}
+
+		resolvedArg, err := r.resolveFunctionCallArg(
+			ctx,
+			arg,
+			functionCallDeps,
+			resolveCtx,
+		)
+		if err == nil {
+			return resolvedArg, nil
+		}
+
+		if !isRecoverableTryArgError(err) {
+			return nil, err
+		}
+		lastErr = err
+	}
+
+	return nil, errTryArgumentsFailed(
+		resolveCtx.currentElementName,
+		len(function.Arguments),
+		lastErr,
+	)
+}
+
+func (r *defaultSubstitutionResolver) resolveElemExpressionFunctionCall(
+	ctx context.Context,
+	function *substitutions.SubstitutionFunctionExpr,
+	elemExprArgIndex int,
+	functionCallDeps *functionCallDependencies,
+	resolveCtx *resolveContext,
+) (*resolvedFunctionCallValue, error) {
+	for index, arg := range function.Arguments {
+		if arg.Value == nil {
+			return nil, createEmptyArgError(
+				resolveCtx.currentElementName,
+				string(function.FunctionName),
+				arg,
+				index,
+			)
+		}
+	}
+
+	// The list is always the first argument of the core higher-order
+	// functions that accept element expressions.
+	resolvedList, err := r.resolveFunctionCallArg(
+		ctx,
+		function.Arguments[0],
+		functionCallDeps,
+		resolveCtx,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolvedList.value != nil && bpcore.IsScalarNone(resolvedList.value.Scalar) {
+		return resolvedList, nil
+	}
+
+	if !bpcore.IsArrayMappingNode(resolvedList.value) {
+		return nil, errElemExpressionInputNotList(
+			resolveCtx.currentElementName,
+			string(function.FunctionName),
+		)
+	}
+
+	elemExprArg := function.Arguments[elemExprArgIndex]
+	results := []*bpcore.MappingNode{}
+	for index, item := range resolvedList.value.Items {
+		resolvedExpr, err := r.resolveFunctionCallArg(
+			ctx,
+			elemExprArg,
+			functionCallDeps,
+			resolveContextWithElemScope(item, index, resolveCtx),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if resolvedExpr.value == nil {
+			return nil, errElemExpressionInvalidResult(
+				resolveCtx.currentElementName,
+				string(function.FunctionName),
+				index,
+			)
+		}
+
+		if function.FunctionName == substitutions.SubstitutionFunctionFilter {
+			if !bpcore.IsScalarBool(resolvedExpr.value.Scalar) {
+				return nil, errElemExpressionInvalidResult(
+					resolveCtx.currentElementName,
+					string(function.FunctionName),
+					index,
+				)
+			}
+
+			if *resolvedExpr.value.Scalar.BoolValue {
+				results = append(results, item)
+			}
+		} else if !bpcore.IsScalarNone(resolvedExpr.value.Scalar) {
+			// Consistent with the "map" function for function values,
+			// none results are filtered out of the output list.
+			results = append(results, resolvedExpr.value)
+		}
+	}
+
+	return &resolvedFunctionCallValue{
+		value: &bpcore.MappingNode{
+			Items: results,
+		},
+	}, nil
+}
+
 func (r *defaultSubstitutionResolver) resolveFunctionCallArg(
 	ctx context.Context,
 	arg *substitutions.SubstitutionFunctionArg,
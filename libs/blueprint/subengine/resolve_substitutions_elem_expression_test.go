@@ -0,0 +1,162 @@
+package subengine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubstitutionElemExpressionResolverTestSuite struct {
+	SubResolverTestContainer
+	suite.Suite
+}
+
+const (
+	resolveElemExpressionFixtureName = "resolve-elem-expression"
+)
+
+func (s *SubstitutionElemExpressionResolverTestSuite) SetupSuite() {
+	s.populateSpecFixtureSchemas(
+		map[string]string{
+			resolveElemExpressionFixtureName: "__testdata/sub-resolver/resolve-elem-expression-blueprint.yml",
+		},
+		&s.Suite,
+	)
+}
+
+func (s *SubstitutionElemExpressionResolverTestSuite) SetupTest() {
+	s.populateDependencies()
+}
+
+func (s *SubstitutionElemExpressionResolverTestSuite) Test_maps_items_with_element_expression() {
+	result, err := s.resolveValue("serviceNames")
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		[]string{"ORDERS-API", "ORDERS-WORKER", "BILLING-API"},
+		itemStrings(result.ResolvedValue.Value),
+	)
+}
+
+func (s *SubstitutionElemExpressionResolverTestSuite) Test_maps_items_with_element_expression_using_index() {
+	result, err := s.resolveValue("serviceLabels")
+	s.Require().NoError(err)
+	s.Require().Len(result.ResolvedValue.Value.Items, 3)
+	labels := result.ResolvedValue.Value.Items[2]
+	s.Require().Len(labels.Items, 2)
+	s.Assert().Equal("billing-api", core.StringValue(labels.Items[0]))
+	s.Assert().Equal(2, core.IntValue(labels.Items[1]))
+}
+
+func (s *SubstitutionElemExpressionResolverTestSuite) Test_filters_items_with_element_expression() {
+	result, err := s.resolveValue("publicServices")
+	s.Require().NoError(err)
+	s.Require().Len(result.ResolvedValue.Value.Items, 2)
+	s.Assert().Equal(
+		"orders-api",
+		core.StringValue(result.ResolvedValue.Value.Items[0].Fields["name"]),
+	)
+	s.Assert().Equal(
+		"billing-api",
+		core.StringValue(result.ResolvedValue.Value.Items[1].Fields["name"]),
+	)
+}
+
+func (s *SubstitutionElemExpressionResolverTestSuite) Test_filters_items_with_function_call_element_expression() {
+	result, err := s.resolveValue("ordersServices")
+	s.Require().NoError(err)
+	s.Require().Len(result.ResolvedValue.Value.Items, 2)
+	s.Assert().Equal(
+		"orders-worker",
+		core.StringValue(result.ResolvedValue.Value.Items[1].Fields["name"]),
+	)
+}
+
+func (s *SubstitutionElemExpressionResolverTestSuite) Test_fails_when_filter_element_expression_is_not_a_boolean() {
+	_, err := s.resolveValue("invalidFilter")
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Assert().Equal(ErrorReasonCodeInvalidElemExpression, runErr.ReasonCode)
+	s.Assert().Equal(
+		"run error: [values.invalidFilter]: the element expression passed into the \"filter\" function "+
+			"did not resolve to a boolean for the item at index 0",
+		runErr.Error(),
+	)
+}
+
+func (s *SubstitutionElemExpressionResolverTestSuite) Test_fails_when_input_is_not_a_list() {
+	_, err := s.resolveValue("invalidInput")
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Assert().Equal(ErrorReasonCodeInvalidElemExpression, runErr.ReasonCode)
+	s.Assert().Equal(
+		"run error: [values.invalidInput]: the first argument passed into the \"map\" function "+
+			"with an element expression must resolve to a list",
+		runErr.Error(),
+	)
+}
+
+func (s *SubstitutionElemExpressionResolverTestSuite) resolveValue(
+	valueName string,
+) (*ResolveInValueResult, error) {
+	blueprint := s.specFixtureSchemas[resolveElemExpressionFixtureName]
+	spec := internal.NewBlueprintSpecMock(blueprint)
+	subResolver := NewDefaultSubstitutionResolver(
+		&Registries{
+			FuncRegistry:       s.funcRegistry,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		s.stateContainer,
+		s.resourceCache,
+		s.resourceTemplateInputElemCache,
+		s.childExportFieldCache,
+		spec,
+		resolveElemExpressionTestParams(),
+	)
+
+	return subResolver.ResolveInValue(
+		context.TODO(),
+		valueName,
+		blueprint.Values.Values[valueName],
+		&ResolveValueTargetInfo{
+			ResolveFor: ResolveForChangeStaging,
+		},
+	)
+}
+
+func itemStrings(node *core.MappingNode) []string {
+	values := make([]string, len(node.Items))
+	for i, item := range node.Items {
+		values[i] = core.StringValue(item)
+	}
+	return values
+}
+
+func resolveElemExpressionTestParams() core.BlueprintParams {
+	rawServices := `[
+		{"name": "orders-api", "public": true},
+		{"name": "orders-worker", "public": false},
+		{"name": "billing-api", "public": true}
+	]`
+	blueprintVars := map[string]*core.ScalarValue{
+		"rawServices": {
+			StringValue: &rawServices,
+		},
+	}
+	return core.NewDefaultParams(
+		map[string]map[string]*core.ScalarValue{},
+		map[string]map[string]*core.ScalarValue{},
+		map[string]*core.ScalarValue{},
+		blueprintVars,
+	)
+}
+
+func TestSubstitutionElemExpressionResolverTestSuite(t *testing.T) {
+	suite.Run(t, new(SubstitutionElemExpressionResolverTestSuite))
+}
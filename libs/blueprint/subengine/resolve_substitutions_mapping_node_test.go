@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
 	"github.com/newstack-cloud/bluelink/libs/common/testhelpers"
 	"github.com/stretchr/testify/suite"
@@ -190,6 +192,93 @@ func (s *SubstitutionMappingNodeResolverTestSuite) Test_resolves_substitutions_i
 	s.Require().NoError(err)
 }
 
+func (s *SubstitutionMappingNodeResolverTestSuite) Test_resolves_instance_references_in_mapping_node() {
+	blueprint := s.specFixtureSchemas[resolveInMappingNodeFixtureName]
+	subResolver := s.createInstanceReferenceTestResolver(blueprint)
+
+	err := s.stateContainer.Instances().Save(context.Background(), state.InstanceState{
+		InstanceID:   "shared-network-instance",
+		InstanceName: "shared-network",
+		Exports: map[string]*state.ExportState{
+			"vpcId": {
+				Value: core.MappingNodeFromString("vpc-0a1b2c3d"),
+				Type:  schema.ExportTypeString,
+				Field: "resources.vpc.spec.vpcId",
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	result, err := subResolver.ResolveInMappingNode(
+		context.TODO(),
+		"metadata",
+		&core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"networkId": core.MappingNodeFromString(
+					"bluelink://instance/shared-network/exports/vpcId",
+				),
+				"region": core.MappingNodeFromString("eu-west-2"),
+			},
+		},
+		&ResolveMappingNodeTargetInfo{
+			ResolveFor: ResolveForChangeStaging,
+		},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		&core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"networkId": core.MappingNodeFromString("vpc-0a1b2c3d"),
+				"region":    core.MappingNodeFromString("eu-west-2"),
+			},
+		},
+		result.ResolvedMappingNode,
+	)
+}
+
+func (s *SubstitutionMappingNodeResolverTestSuite) Test_fails_to_resolve_reference_to_missing_instance() {
+	blueprint := s.specFixtureSchemas[resolveInMappingNodeFixtureName]
+	subResolver := s.createInstanceReferenceTestResolver(blueprint)
+
+	_, err := subResolver.ResolveInMappingNode(
+		context.TODO(),
+		"metadata",
+		&core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"networkId": core.MappingNodeFromString(
+					"bluelink://instance/missing-network/exports/vpcId",
+				),
+			},
+		},
+		&ResolveMappingNodeTargetInfo{
+			ResolveFor: ResolveForDeployment,
+		},
+	)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Assert().Equal(ErrorReasonCodeMissingInstanceExport, runErr.ReasonCode)
+	s.Assert().Contains(runErr.Error(), "instance \"missing-network\" does not exist")
+}
+
+func (s *SubstitutionMappingNodeResolverTestSuite) createInstanceReferenceTestResolver(
+	blueprint *schema.Blueprint,
+) SubstitutionResolver {
+	return NewDefaultSubstitutionResolver(
+		&Registries{
+			FuncRegistry:       s.funcRegistry,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		s.stateContainer,
+		s.resourceCache,
+		s.resourceTemplateInputElemCache,
+		s.childExportFieldCache,
+		internal.NewBlueprintSpecMock(blueprint),
+		resolveInMappingNodeTestParams(),
+	)
+}
+
 func partiallyResolvedMappingNode() *core.MappingNode {
 	build := "esbuild"
 	return &core.MappingNode{
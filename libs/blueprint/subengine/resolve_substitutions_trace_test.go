@@ -0,0 +1,105 @@
+package subengine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubstitutionTraceTestSuite struct {
+	SubResolverTestContainer
+	suite.Suite
+}
+
+func (s *SubstitutionTraceTestSuite) SetupSuite() {
+	s.populateSpecFixtureSchemas(
+		map[string]string{
+			resolveTryFunctionFixtureName: "__testdata/sub-resolver/resolve-try-function-blueprint.yml",
+		},
+		&s.Suite,
+	)
+}
+
+func (s *SubstitutionTraceTestSuite) SetupTest() {
+	s.populateDependencies()
+}
+
+func (s *SubstitutionTraceTestSuite) Test_records_function_calls_and_references_for_resolved_field() {
+	trace := NewSubstitutionTrace()
+	_, err := s.resolveValue(WithSubstitutionTrace(context.Background(), trace), "region")
+	s.Require().NoError(err)
+
+	entries := trace.Fields()["values.region.value"]
+	s.Require().Len(entries, 2)
+
+	s.Assert().Equal(SubstitutionTraceEntryTypeFunctionCall, entries[0].Type)
+	s.Assert().Equal("try(variables.region,\"us-east-1\")", entries[0].Expression)
+	s.Assert().Equal(0, entries[0].Depth)
+	s.Assert().Equal("us-west-2", core.StringValue(entries[0].Output))
+
+	s.Assert().Equal(SubstitutionTraceEntryTypeReference, entries[1].Type)
+	s.Assert().Equal("variables.region", entries[1].Expression)
+	s.Assert().Equal(1, entries[1].Depth)
+	s.Assert().Equal("us-west-2", core.StringValue(entries[1].Output))
+}
+
+func (s *SubstitutionTraceTestSuite) Test_records_inputs_and_errors_of_steps() {
+	trace := NewSubstitutionTrace()
+	_, err := s.resolveValue(WithSubstitutionTrace(context.Background(), trace), "regions")
+	s.Require().NoError(err)
+
+	entries := trace.Fields()["values.regions.value"]
+	s.Require().Len(entries, 4)
+
+	s.Assert().Equal("jsondecode(variables.rawConfig)", entries[1].Expression)
+	s.Assert().Equal(1, entries[1].Depth)
+	s.Assert().NotEmpty(entries[1].Error)
+	s.Assert().Nil(entries[1].Output)
+
+	s.Assert().Equal("list(\"us-east-1\")", entries[3].Expression)
+	s.Require().Len(entries[3].Inputs, 1)
+	s.Assert().Equal("us-east-1", core.StringValue(entries[3].Inputs[0]))
+}
+
+func (s *SubstitutionTraceTestSuite) Test_does_not_record_trace_when_not_enabled_for_context() {
+	_, err := s.resolveValue(context.Background(), "region")
+	s.Require().NoError(err)
+	s.Assert().Nil(SubstitutionTraceFromContext(context.Background()).Fields())
+}
+
+func (s *SubstitutionTraceTestSuite) resolveValue(
+	ctx context.Context,
+	valueName string,
+) (*ResolveInValueResult, error) {
+	blueprint := s.specFixtureSchemas[resolveTryFunctionFixtureName]
+	spec := internal.NewBlueprintSpecMock(blueprint)
+	subResolver := NewDefaultSubstitutionResolver(
+		&Registries{
+			FuncRegistry:       s.funcRegistry,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		s.stateContainer,
+		s.resourceCache,
+		s.resourceTemplateInputElemCache,
+		s.childExportFieldCache,
+		spec,
+		resolveTryFunctionTestParams(),
+	)
+
+	return subResolver.ResolveInValue(
+		ctx,
+		valueName,
+		blueprint.Values.Values[valueName],
+		&ResolveValueTargetInfo{
+			ResolveFor: ResolveForChangeStaging,
+		},
+	)
+}
+
+func TestSubstitutionTraceTestSuite(t *testing.T) {
+	suite.Run(t, new(SubstitutionTraceTestSuite))
+}
@@ -0,0 +1,123 @@
+package subengine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubstitutionTryResolverTestSuite struct {
+	SubResolverTestContainer
+	suite.Suite
+}
+
+const (
+	resolveTryFunctionFixtureName = "resolve-try-function"
+)
+
+func (s *SubstitutionTryResolverTestSuite) SetupSuite() {
+	s.populateSpecFixtureSchemas(
+		map[string]string{
+			resolveTryFunctionFixtureName: "__testdata/sub-resolver/resolve-try-function-blueprint.yml",
+		},
+		&s.Suite,
+	)
+}
+
+func (s *SubstitutionTryResolverTestSuite) SetupTest() {
+	s.populateDependencies()
+}
+
+func (s *SubstitutionTryResolverTestSuite) Test_falls_back_to_next_argument_when_function_call_fails() {
+	result, err := s.resolveValue("regions", ResolveForChangeStaging)
+	s.Require().NoError(err)
+	s.Require().Len(result.ResolvedValue.Value.Items, 1)
+	s.Assert().Equal(
+		"us-east-1",
+		core.StringValue(result.ResolvedValue.Value.Items[0]),
+	)
+}
+
+func (s *SubstitutionTryResolverTestSuite) Test_returns_first_argument_that_resolves_successfully() {
+	result, err := s.resolveValue("region", ResolveForChangeStaging)
+	s.Require().NoError(err)
+	s.Assert().Equal("us-west-2", core.StringValue(result.ResolvedValue.Value))
+}
+
+func (s *SubstitutionTryResolverTestSuite) Test_fails_when_all_arguments_fail_to_resolve() {
+	_, err := s.resolveValue("decodedRegion", ResolveForChangeStaging)
+	s.Require().Error(err)
+	runErr, isRunErr := err.(*errors.RunError)
+	s.Require().True(isRunErr)
+	s.Assert().Equal(ErrorReasonCodeTryArgumentsFailed, runErr.ReasonCode)
+	s.Assert().Contains(
+		runErr.Error(),
+		"[values.decodedRegion]: all 2 arguments passed into the \"try\" function failed to resolve",
+	)
+}
+
+func (s *SubstitutionTryResolverTestSuite) Test_does_not_fall_back_for_values_that_must_be_resolved_on_deploy() {
+	result, err := s.resolveValue("ordersTableID", ResolveForChangeStaging)
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		[]string{"values.ordersTableID.value"},
+		result.ResolveOnDeploy,
+	)
+}
+
+func (s *SubstitutionTryResolverTestSuite) resolveValue(
+	valueName string,
+	resolveFor ResolveForStage,
+) (*ResolveInValueResult, error) {
+	blueprint := s.specFixtureSchemas[resolveTryFunctionFixtureName]
+	spec := internal.NewBlueprintSpecMock(blueprint)
+	subResolver := NewDefaultSubstitutionResolver(
+		&Registries{
+			FuncRegistry:       s.funcRegistry,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		s.stateContainer,
+		s.resourceCache,
+		s.resourceTemplateInputElemCache,
+		s.childExportFieldCache,
+		spec,
+		resolveTryFunctionTestParams(),
+	)
+
+	return subResolver.ResolveInValue(
+		context.TODO(),
+		valueName,
+		blueprint.Values.Values[valueName],
+		&ResolveValueTargetInfo{
+			ResolveFor: resolveFor,
+		},
+	)
+}
+
+func resolveTryFunctionTestParams() core.BlueprintParams {
+	rawConfig := "[\"us-west-2\","
+	region := "us-west-2"
+	blueprintVars := map[string]*core.ScalarValue{
+		"rawConfig": {
+			StringValue: &rawConfig,
+		},
+		"region": {
+			StringValue: &region,
+		},
+	}
+	return core.NewDefaultParams(
+		map[string]map[string]*core.ScalarValue{},
+		map[string]map[string]*core.ScalarValue{},
+		map[string]*core.ScalarValue{},
+		blueprintVars,
+	)
+}
+
+func TestSubstitutionTryResolverTestSuite(t *testing.T) {
+	suite.Run(t, new(SubstitutionTryResolverTestSuite))
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/bradleyjkemp/cupaloy/v2"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -17,13 +19,15 @@ type SubstitutionValueResolverTestSuite struct {
 }
 
 const (
-	resolveInValueFixtureName = "resolve-in-value"
+	resolveInValueFixtureName              = "resolve-in-value"
+	resolveInValueDeployedStateFixtureName = "resolve-in-value-deployed-state"
 )
 
 func (s *SubstitutionValueResolverTestSuite) SetupSuite() {
 	s.populateSpecFixtureSchemas(
 		map[string]string{
-			resolveInValueFixtureName: "__testdata/sub-resolver/resolve-in-value-blueprint.yml",
+			resolveInValueFixtureName:              "__testdata/sub-resolver/resolve-in-value-blueprint.yml",
+			resolveInValueDeployedStateFixtureName: "__testdata/sub-resolver/resolve-in-value-deployed-state-blueprint.yml",
 		},
 		&s.Suite,
 	)
@@ -81,6 +85,75 @@ func (s *SubstitutionValueResolverTestSuite) Test_resolves_substitutions_in_valu
 	}
 }
 
+func (s *SubstitutionValueResolverTestSuite) Test_re_resolves_values_that_depend_on_deployed_resource_state() {
+	blueprint := s.specFixtureSchemas[resolveInValueDeployedStateFixtureName]
+	spec := internal.NewBlueprintSpecMock(blueprint)
+	params := resolveInValueTestParams()
+	subResolver := NewDefaultSubstitutionResolver(
+		&Registries{
+			FuncRegistry:       s.funcRegistry,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		s.stateContainer,
+		s.resourceCache,
+		s.resourceTemplateInputElemCache,
+		s.childExportFieldCache,
+		spec,
+		params,
+	)
+
+	s.resourceCache.Set("ordersTable", resolvedResourceWithDisplayName("Orders Table"))
+	result, err := subResolver.ResolveInValue(
+		context.TODO(),
+		"ordersTableLabel",
+		blueprint.Values.Values["ordersTableLabel"],
+		&ResolveValueTargetInfo{
+			ResolveFor: ResolveForChangeStaging,
+		},
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(result.ResolveOnDeploy)
+	s.Assert().Equal(
+		"Orders Table (production-env)",
+		core.StringValue(result.ResolvedValue.Value),
+	)
+
+	// The referenced value depends on the state of the ordersTable resource
+	// so must not be served from the cache once the resource has changed,
+	// for example, after the resource has been deployed.
+	s.resourceCache.Set("ordersTable", resolvedResourceWithDisplayName("Updated Orders Table"))
+	result, err = subResolver.ResolveInValue(
+		context.TODO(),
+		"ordersTableLabel",
+		blueprint.Values.Values["ordersTableLabel"],
+		&ResolveValueTargetInfo{
+			ResolveFor: ResolveForDeployment,
+		},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		"Updated Orders Table (production-env)",
+		core.StringValue(result.ResolvedValue.Value),
+	)
+}
+
+func resolvedResourceWithDisplayName(displayName string) *provider.ResolvedResource {
+	return &provider.ResolvedResource{
+		Type: &schema.ResourceTypeWrapper{
+			Value: "aws/dynamodb/table",
+		},
+		Metadata: &provider.ResolvedResourceMetadata{
+			DisplayName: core.MappingNodeFromString(displayName),
+		},
+		Spec: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{
+				"tableName": core.MappingNodeFromString("Orders"),
+			},
+		},
+	}
+}
+
 func resolveInValueTestParams() core.BlueprintParams {
 	environment := "production-env"
 	enableOrderTableTrigger := true
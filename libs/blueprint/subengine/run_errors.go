@@ -195,6 +195,25 @@ const (
 	// during deployment or change staging is due to
 	// a missing property in the current element reference.
 	ErrorReasonCodeMissingCurrentElementProperty errors.ErrorReasonCode = "missing_current_element_property"
+	// ErrorReasonCodeMissingInstanceExport
+	// is provided when the reason for an error
+	// during deployment or change staging is due to
+	// a reference to another blueprint instance where the instance
+	// or the referenced export does not exist.
+	ErrorReasonCodeMissingInstanceExport errors.ErrorReasonCode = "missing_instance_export"
+	// ErrorReasonCodeTryArgumentsFailed
+	// is provided when the reason for an error
+	// during deployment or change staging is due to
+	// all the arguments passed into the "try" function
+	// failing to resolve.
+	ErrorReasonCodeTryArgumentsFailed errors.ErrorReasonCode = "try_arguments_failed"
+	// ErrorReasonCodeInvalidElemExpression
+	// is provided when the reason for an error
+	// during deployment or change staging is due to
+	// an element expression passed into a higher-order function
+	// such as "map" or "filter" being applied to a value that is not a list
+	// or resolving to an unexpected value for an item.
+	ErrorReasonCodeInvalidElemExpression errors.ErrorReasonCode = "invalid_elem_expression"
 )
 
 func errInvalidInterpolationSubType(elementName string, resolvedValue *core.MappingNode) error {
@@ -356,6 +375,60 @@ func errEmptyFunctionOutput(elementName string, functionName string) error {
 	}
 }
 
+func errTryArgumentsFailed(elementName string, argCount int, lastErr error) error {
+	if lastErr == nil {
+		return &errors.RunError{
+			ReasonCode: ErrorReasonCodeTryArgumentsFailed,
+			Err: fmt.Errorf(
+				"[%s]: no arguments were passed into the \"try\" function, at least one argument is expected",
+				elementName,
+			),
+		}
+	}
+
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeTryArgumentsFailed,
+		Err: fmt.Errorf(
+			"[%s]: all %d arguments passed into the \"try\" function failed to resolve, "+
+				"the last argument failed with: %w",
+			elementName,
+			argCount,
+			lastErr,
+		),
+	}
+}
+
+func errElemExpressionInputNotList(elementName string, functionName string) error {
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeInvalidElemExpression,
+		Err: fmt.Errorf(
+			"[%s]: the first argument passed into the \"%s\" function with an element expression "+
+				"must resolve to a list",
+			elementName,
+			functionName,
+		),
+	}
+}
+
+func errElemExpressionInvalidResult(elementName string, functionName string, itemIndex int) error {
+	expected := "a value"
+	if functionName == string(substitutions.SubstitutionFunctionFilter) {
+		expected = "a boolean"
+	}
+
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeInvalidElemExpression,
+		Err: fmt.Errorf(
+			"[%s]: the element expression passed into the \"%s\" function "+
+				"did not resolve to %s for the item at index %d",
+			elementName,
+			functionName,
+			expected,
+			itemIndex,
+		),
+	}
+}
+
 func errHigherOrderFunctionNotSupported(elementName string, functionName string) error {
 	return &errors.RunError{
 		ReasonCode: ErrorReasonCodeHigherOrderFunctionNotSupported,
@@ -610,6 +683,27 @@ func errMissingChildExport(
 	}
 }
 
+func errMissingInstanceExport(
+	elementName string,
+	ref *substitutions.InstanceExportReference,
+	instanceExists bool,
+) error {
+	reason := fmt.Sprintf("export %q does not exist in the instance", ref.ExportName)
+	if !instanceExists {
+		reason = fmt.Sprintf("instance %q does not exist", ref.InstanceName)
+	}
+
+	return &errors.RunError{
+		ReasonCode: ErrorReasonCodeMissingInstanceExport,
+		Err: fmt.Errorf(
+			"[%s]: failed to resolve instance reference %q, %s",
+			elementName,
+			ref.String(),
+			reason,
+		),
+	}
+}
+
 func errResourceNotTemplate(
 	elementName string,
 	resourceName string,
@@ -0,0 +1,233 @@
+package subengine
+
+import (
+	"context"
+	"sync"
+
+	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+const (
+	// SubstitutionTraceKey is the key used to store a substitution trace
+	// in the context to opt in to recording how substitutions are resolved.
+	SubstitutionTraceKey = bpcore.ContextKey("substitutionTrace")
+)
+
+// SubstitutionTraceEntryType is the type of step recorded
+// in a substitution trace.
+type SubstitutionTraceEntryType string
+
+const (
+	// SubstitutionTraceEntryTypeReference is the type of a trace entry
+	// for a lookup of a reference to a variable, value, data source,
+	// resource, child blueprint or the current element of a resource template.
+	SubstitutionTraceEntryTypeReference SubstitutionTraceEntryType = "reference"
+	// SubstitutionTraceEntryTypeFunctionCall is the type of a trace entry
+	// for a function invocation.
+	SubstitutionTraceEntryTypeFunctionCall SubstitutionTraceEntryType = "functionCall"
+)
+
+// SubstitutionTraceEntry holds a single step carried out
+// when resolving the substitutions of a field in a blueprint.
+type SubstitutionTraceEntry struct {
+	Type SubstitutionTraceEntryType `json:"type"`
+	// Expression is the substitution that was resolved as it would be written
+	// in a blueprint (e.g. "variables.region" or "join(values.names, \",\")").
+	Expression string `json:"expression"`
+	// Depth is how deeply nested the step is in the substitution being resolved
+	// for the field, where 0 is a step for a top-level substitution.
+	Depth int `json:"depth"`
+	// Inputs holds the resolved arguments passed into a function call,
+	// arguments that are function values are represented as nil.
+	Inputs []*bpcore.MappingNode `json:"inputs,omitempty"`
+	// Output holds the resolved value of the step.
+	Output *bpcore.MappingNode `json:"output,omitempty"`
+	// Error holds the error message when the step failed to resolve.
+	Error string `json:"error,omitempty"`
+	// Secret is true when the step depends on a variable or value
+	// that is marked as secret, the inputs and output of secret steps
+	// should be redacted before the trace is presented to users.
+	Secret bool `json:"secret,omitempty"`
+}
+
+// SubstitutionTrace records every reference lookup and function call
+// carried out by the substitution resolver grouped by the full path of the
+// field being resolved (e.g. "resources.ordersTable.spec.tableName").
+//
+// Tracing is opt-in, a trace is recorded when it is attached to the context
+// passed into the substitution resolver with WithSubstitutionTrace.
+// A trace is intended to be used to debug resolution for a single
+// blueprint, it should not be shared between concurrent operations.
+type SubstitutionTrace struct {
+	mu     sync.Mutex
+	fields map[string][]*SubstitutionTraceEntry
+	// Steps that are being resolved in the order they were started,
+	// used to work out the depth of a step and to propagate secret
+	// dependencies to the steps that depend on them.
+	open []*openTraceEntry
+}
+
+type openTraceEntry struct {
+	fieldPath string
+	entry     *SubstitutionTraceEntry
+}
+
+// NewSubstitutionTrace creates a new empty substitution trace.
+func NewSubstitutionTrace() *SubstitutionTrace {
+	return &SubstitutionTrace{
+		fields: map[string][]*SubstitutionTraceEntry{},
+		open:   []*openTraceEntry{},
+	}
+}
+
+// WithSubstitutionTrace returns a copy of the provided context
+// that records substitution resolution in the given trace.
+func WithSubstitutionTrace(ctx context.Context, trace *SubstitutionTrace) context.Context {
+	return context.WithValue(ctx, SubstitutionTraceKey, trace)
+}
+
+// SubstitutionTraceFromContext retrieves the substitution trace from the context,
+// nil is returned when tracing has not been enabled for the context.
+func SubstitutionTraceFromContext(ctx context.Context) *SubstitutionTrace {
+	trace, _ := ctx.Value(SubstitutionTraceKey).(*SubstitutionTrace)
+	return trace
+}
+
+// Fields returns the steps recorded for each field path in the trace
+// in the order the steps were started.
+func (t *SubstitutionTrace) Fields() map[string][]*SubstitutionTraceEntry {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	fields := make(map[string][]*SubstitutionTraceEntry, len(t.fields))
+	for fieldPath, entries := range t.fields {
+		fields[fieldPath] = append([]*SubstitutionTraceEntry{}, entries...)
+	}
+	return fields
+}
+
+func (t *SubstitutionTrace) begin(
+	fieldPath string,
+	entryType SubstitutionTraceEntryType,
+	substitution *substitutions.Substitution,
+) *SubstitutionTraceEntry {
+	if t == nil {
+		return nil
+	}
+
+	// Expressions are only used for presentation, a step is still
+	// recorded when the substitution can not be converted to a string.
+	expression, _ := substitutions.SubstitutionToString("", substitution)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	depth := 0
+	for _, open := range t.open {
+		if open.fieldPath == fieldPath {
+			depth += 1
+		}
+	}
+
+	entry := &SubstitutionTraceEntry{
+		Type:       entryType,
+		Expression: expression,
+		Depth:      depth,
+	}
+	t.fields[fieldPath] = append(t.fields[fieldPath], entry)
+	t.open = append(t.open, &openTraceEntry{
+		fieldPath: fieldPath,
+		entry:     entry,
+	})
+	return entry
+}
+
+func (t *SubstitutionTrace) end(
+	entry *SubstitutionTraceEntry,
+	output *bpcore.MappingNode,
+	err error,
+) {
+	if t == nil || entry == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry.Output = output
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	for i := len(t.open) - 1; i >= 0; i -= 1 {
+		if t.open[i].entry == entry {
+			t.open = append(t.open[:i], t.open[i+1:]...)
+			return
+		}
+	}
+}
+
+// markSecret marks the provided step and all the steps that
+// are still being resolved as depending on a secret.
+func (t *SubstitutionTrace) markSecret(entry *SubstitutionTraceEntry) {
+	if t == nil || entry == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry.Secret = true
+	for _, open := range t.open {
+		open.entry.Secret = true
+	}
+}
+
+func (t *SubstitutionTrace) setInputs(
+	entry *SubstitutionTraceEntry,
+	args []*resolvedFunctionCallValue,
+) {
+	if t == nil || entry == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry.Inputs = make([]*bpcore.MappingNode, len(args))
+	for i, arg := range args {
+		entry.Inputs[i] = arg.value
+	}
+}
+
+func (r *defaultSubstitutionResolver) isSecretReference(
+	substitution *substitutions.Substitution,
+) bool {
+	if substitution.Variable != nil {
+		variable := getVariable(substitution.Variable.VariableName, r.spec.Schema())
+		return variable != nil && bpcore.BoolValueFromScalar(variable.Secret)
+	}
+
+	if substitution.ValueReference != nil {
+		value := getValue(substitution.ValueReference.ValueName, r.spec.Schema())
+		return value != nil && bpcore.BoolValueFromScalar(value.Secret)
+	}
+
+	return false
+}
+
+func traceFieldPath(resolveCtx *resolveContext) string {
+	if resolveCtx.currentElementProperty == "" {
+		return resolveCtx.currentElementName
+	}
+
+	return bpcore.ElementPropertyPath(
+		resolveCtx.currentElementName,
+		resolveCtx.currentElementProperty,
+	)
+}
@@ -32,40 +32,43 @@ func GoValueToMappingNode(value any) *core.MappingNode {
 	kindOfValue := typeofValue.Kind()
 
 	if isInt(kindOfValue) {
-		intValue := value.(int)
+		// Functions can return any of the signed integer types,
+		// (e.g. int64 for integers passed through from function arguments)
+		// all of which are represented as an int in a mapping node.
+		intValue := int(reflect.ValueOf(finalValue).Int())
 		return toIntMappingNode(intValue)
 	}
 
 	if isFloat(kindOfValue) {
-		floatValue := value.(float64)
+		floatValue := reflect.ValueOf(finalValue).Float()
 		return toFloatMappingNode(floatValue)
 	}
 
 	if kindOfValue == reflect.String {
-		stringValue := value.(string)
+		stringValue := reflect.ValueOf(finalValue).String()
 		return toStringMappingNode(stringValue)
 	}
 
 	if kindOfValue == reflect.Bool {
-		boolValue := value.(bool)
+		boolValue := reflect.ValueOf(finalValue).Bool()
 		return toBoolMappingNode(boolValue)
 	}
 
 	if kindOfValue == reflect.Slice {
 		// Special handling for byte slices ([]byte) - store as bytes
 		if typeofValue.Elem().Kind() == reflect.Uint8 {
-			byteSlice := value.([]byte)
+			byteSlice := reflect.ValueOf(finalValue).Bytes()
 			return toBytesMappingNode(byteSlice)
 		}
-		return toSliceMappingNode(value)
+		return toSliceMappingNode(finalValue)
 	}
 
 	if kindOfValue == reflect.Map {
-		return toMapMappingNode(value)
+		return toMapMappingNode(finalValue)
 	}
 
 	if kindOfValue == reflect.Struct {
-		return toStructMappingNode(value)
+		return toStructMappingNode(finalValue)
 	}
 
 	return nil
@@ -25,6 +25,26 @@ func (s *TransformMappingNodeTestSuite) Test_transform_go_int_value_to_mapping_n
 	}, mappingNode)
 }
 
+func (s *TransformMappingNodeTestSuite) Test_transform_go_int64_value_to_mapping_node() {
+	intVal := 42
+	mappingNode := GoValueToMappingNode(int64(intVal))
+	s.Assert().Equal(&core.MappingNode{
+		Scalar: &core.ScalarValue{
+			IntValue: &intVal,
+		},
+	}, mappingNode)
+}
+
+func (s *TransformMappingNodeTestSuite) Test_transform_go_float32_value_to_mapping_node() {
+	floatVal := 2.5
+	mappingNode := GoValueToMappingNode(float32(floatVal))
+	s.Assert().Equal(&core.MappingNode{
+		Scalar: &core.ScalarValue{
+			FloatValue: &floatVal,
+		},
+	}, mappingNode)
+}
+
 func (s *TransformMappingNodeTestSuite) Test_transform_go_float_value_to_mapping_node() {
 	floatVal := 4092.4029
 	mappingNode := GoValueToMappingNode(floatVal)
@@ -13,6 +13,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/subwalk"
 	"github.com/newstack-cloud/bluelink/libs/common/core"
 )
 
@@ -28,6 +29,16 @@ type resolveContext struct {
 	// This is set to true when the `currentElementProperty` ends with ".annotations".
 	isAnnotation      bool
 	partiallyResolved any
+	// elemScope is set when resolving an element expression passed into
+	// a higher-order function such as "map" or "filter", `elem` and `i`
+	// refer to the current item and index of the list being iterated over
+	// instead of the current element of a resource template.
+	elemScope *elemExpressionScope
+}
+
+type elemExpressionScope struct {
+	item  *bpcore.MappingNode
+	index int
 }
 
 func resolveContextFromParent(
@@ -44,7 +55,21 @@ func resolveContextFromParent(
 		resolveFor:             parentCtx.resolveFor,
 		partiallyResolved:      parentCtx.partiallyResolved,
 		isAnnotation:           strings.HasSuffix(currentElementProperty, ".annotations"),
+		elemScope:              parentCtx.elemScope,
+	}
+}
+
+func resolveContextWithElemScope(
+	item *bpcore.MappingNode,
+	index int,
+	parentCtx *resolveContext,
+) *resolveContext {
+	scopedCtx := resolveContextFromParent(parentCtx.currentElementProperty, parentCtx)
+	scopedCtx.elemScope = &elemExpressionScope{
+		item:  item,
+		index: index,
 	}
+	return scopedCtx
 }
 
 func resolveContextForCurrentElement(
@@ -60,6 +85,7 @@ func resolveContextForCurrentElement(
 		resolveFor:             parentCtx.resolveFor,
 		partiallyResolved:      parentCtx.partiallyResolved,
 		isAnnotation:           false,
+		elemScope:              parentCtx.elemScope,
 	}
 }
 
@@ -98,6 +124,42 @@ func getValue(
 	return schema.Values.Values[valueName]
 }
 
+// valueDependsOnDeployedState determines whether a value directly or transitively
+// references a resource property or child blueprint export.
+// A value that depends on deployed state will resolve to different results
+// before and after the resources or child blueprints it depends on are deployed.
+func valueDependsOnDeployedState(
+	valueName string,
+	blueprint *schema.Blueprint,
+	visited map[string]bool,
+) bool {
+	if visited[valueName] {
+		return false
+	}
+	visited[valueName] = true
+
+	valueSpec := getValue(valueName, blueprint)
+	if valueSpec == nil {
+		return false
+	}
+
+	dependsOnDeployedState := false
+	subwalk.WalkMappingNode(
+		valueSpec.Value,
+		func(sub *substitutions.Substitution) *substitutions.Substitution {
+			if sub.ResourceProperty != nil || sub.Child != nil {
+				dependsOnDeployedState = true
+			} else if sub.ValueReference != nil &&
+				valueDependsOnDeployedState(sub.ValueReference.ValueName, blueprint, visited) {
+				dependsOnDeployedState = true
+			}
+			return nil
+		},
+	)
+
+	return dependsOnDeployedState
+}
+
 func getDataSource(
 	valueName string,
 	schema *schema.Blueprint,
@@ -693,6 +755,12 @@ func getStringWithSubstitutions(
 	return nil
 }
 
+func isInstanceReferenceScalar(scalar *bpcore.ScalarValue) bool {
+	return scalar != nil &&
+		scalar.StringValue != nil &&
+		substitutions.IsInstanceReference(*scalar.StringValue)
+}
+
 func getDataSourceFieldByPropOrAlias(
 	data map[string]*bpcore.MappingNode,
 	fieldName string,
@@ -15,6 +15,10 @@ const (
 	// into an array or mapping.
 	SubstitutionFunctionJSONDecode SubstitutionFunctionName = "jsondecode"
 
+	// SubstitutionFunctionJSONEncode is a function that is used to encode a primitive value,
+	// array or mapping as a serialised json string.
+	SubstitutionFunctionJSONEncode SubstitutionFunctionName = "jsonencode"
+
 	// SubstitutionFunctionLen is a function that is used to get the length of a string, array
 	// or mapping.
 	SubstitutionFunctionLen SubstitutionFunctionName = "len"
@@ -38,6 +42,18 @@ const (
 	// ${map(variables.cacheClusterConfig.hosts, replace_g("http://", "https://"))}
 	SubstitutionFunctionReplace_G SubstitutionFunctionName = "replace_g"
 
+	// SubstitutionFunctionRegexMatch is a function that is used to check if a string
+	// contains a match for a regular expression.
+	SubstitutionFunctionRegexMatch SubstitutionFunctionName = "regexmatch"
+
+	// SubstitutionFunctionRegexReplace is a function that is used to replace all matches
+	// of a regular expression in a string with another string.
+	SubstitutionFunctionRegexReplace SubstitutionFunctionName = "regexreplace"
+
+	// SubstitutionFunctionRegexAll is a function that is used to extract all matches
+	// of a regular expression from a string.
+	SubstitutionFunctionRegexAll SubstitutionFunctionName = "regexall"
+
 	// SubstitutionFunctionTrim is a function that is used to remove all leading and trailing whitespace
 	// from a given string.
 	SubstitutionFunctionTrim SubstitutionFunctionName = "trim"
@@ -146,6 +162,14 @@ const (
 	// from a mapping.
 	SubstitutionFunctionVals SubstitutionFunctionName = "vals"
 
+	// SubstitutionFunctionCoalesce is a function that returns the first argument
+	// that does not resolve to none.
+	SubstitutionFunctionCoalesce SubstitutionFunctionName = "coalesce"
+
+	// SubstitutionFunctionTry is a function that returns the first argument
+	// that resolves without an error.
+	SubstitutionFunctionTry SubstitutionFunctionName = "try"
+
 	// SubstitutionFunctionMap is a function that maps a list of values
 	// to a new list of values using a function.
 	SubstitutionFunctionMap SubstitutionFunctionName = "map"
@@ -233,6 +257,38 @@ const (
 	// SubstitutionFunctionDateTime is a function that is used to get the current
 	// date and time in a specific format.
 	SubstitutionFunctionDateTime SubstitutionFunctionName = "datetime"
+
+	// SubstitutionFunctionNow is a function that is used to get the current
+	// date and time in RFC3339 format, frozen at the time changes are staged.
+	SubstitutionFunctionNow SubstitutionFunctionName = "now"
+
+	// SubstitutionFunctionFormatDate is a function that is used to format
+	// a timestamp using a format specification.
+	SubstitutionFunctionFormatDate SubstitutionFunctionName = "formatdate"
+
+	// SubstitutionFunctionTimeAdd is a function that is used to add
+	// a duration to a timestamp.
+	SubstitutionFunctionTimeAdd SubstitutionFunctionName = "timeadd"
+
+	// SubstitutionFunctionSHA256 is a function that is used to compute
+	// the hex-encoded SHA-256 hash of a string or byte array.
+	SubstitutionFunctionSHA256 SubstitutionFunctionName = "sha256"
+
+	// SubstitutionFunctionMD5 is a function that is used to compute
+	// the hex-encoded MD5 hash of a string or byte array.
+	SubstitutionFunctionMD5 SubstitutionFunctionName = "md5"
+
+	// SubstitutionFunctionBase64Encode is a function that is used to encode
+	// a string or byte array as a Base64 string.
+	SubstitutionFunctionBase64Encode SubstitutionFunctionName = "base64encode"
+
+	// SubstitutionFunctionBase64Decode is a function that is used to decode
+	// a Base64 string into a byte array.
+	SubstitutionFunctionBase64Decode SubstitutionFunctionName = "base64decode"
+
+	// SubstitutionFunctionURLEncode is a function that is used to percent-encode
+	// a string for use in a URL.
+	SubstitutionFunctionURLEncode SubstitutionFunctionName = "urlencode"
 )
 
 var (
@@ -246,11 +302,15 @@ var (
 		SubstitutionFunctionFromJSON,
 		SubstitutionFunctionFromJSON_G,
 		SubstitutionFunctionJSONDecode,
+		SubstitutionFunctionJSONEncode,
 		SubstitutionFunctionLen,
 		SubstitutionFunctionSubstr,
 		SubstitutionFunctionSubstr_G,
 		SubstitutionFunctionReplace,
 		SubstitutionFunctionReplace_G,
+		SubstitutionFunctionRegexMatch,
+		SubstitutionFunctionRegexReplace,
+		SubstitutionFunctionRegexAll,
 		SubstitutionFunctionTrim,
 		SubstitutionFunctionTrimPrefix,
 		SubstitutionFunctionTrimPrefix_G,
@@ -273,6 +333,8 @@ var (
 		SubstitutionFunctionObject,
 		SubstitutionFunctionKeys,
 		SubstitutionFunctionVals,
+		SubstitutionFunctionCoalesce,
+		SubstitutionFunctionTry,
 		SubstitutionFunctionMap,
 		SubstitutionFunctionFilter,
 		SubstitutionFunctionReduce,
@@ -293,5 +355,13 @@ var (
 		SubstitutionFunctionLE,
 		SubstitutionFunctionCWD,
 		SubstitutionFunctionDateTime,
+		SubstitutionFunctionNow,
+		SubstitutionFunctionFormatDate,
+		SubstitutionFunctionTimeAdd,
+		SubstitutionFunctionSHA256,
+		SubstitutionFunctionMD5,
+		SubstitutionFunctionBase64Encode,
+		SubstitutionFunctionBase64Decode,
+		SubstitutionFunctionURLEncode,
 	}
 )
@@ -0,0 +1,62 @@
+package substitutions
+
+// ElemExpressionFunctionArgIndices maps the core higher-order functions
+// that accept an element expression in place of a function value
+// to the index of the element expression argument.
+//
+// An element expression is a substitution that references the current
+// item and index of the list being iterated over with the `elem` and `i`
+// keywords, for example, `${map(resources.queues, elem.spec.arn)}`.
+// Within an element expression, `elem` and `i` refer to the current item
+// and index of the list instead of the current element of a resource template.
+var ElemExpressionFunctionArgIndices = map[SubstitutionFunctionName]int{
+	SubstitutionFunctionMap:    1,
+	SubstitutionFunctionFilter: 1,
+}
+
+// ElemExpressionArgIndex returns the index of the argument of the given function call
+// that is an element expression, -1 is returned when the function does not accept
+// an element expression or the argument does not reference the current element
+// or index and should be treated as a function value.
+func ElemExpressionArgIndex(funcExpr *SubstitutionFunctionExpr) int {
+	if funcExpr == nil {
+		return -1
+	}
+
+	argIndex, acceptsElemExpression := ElemExpressionFunctionArgIndices[funcExpr.FunctionName]
+	if !acceptsElemExpression || argIndex >= len(funcExpr.Arguments) {
+		return -1
+	}
+
+	arg := funcExpr.Arguments[argIndex]
+	if arg == nil || !ContainsElemReference(arg.Value) {
+		return -1
+	}
+
+	return argIndex
+}
+
+// ContainsElemReference determines whether the given substitution
+// contains a reference to the current element (`elem`) or
+// element index (`i`), including references in nested function arguments.
+func ContainsElemReference(sub *Substitution) bool {
+	if sub == nil {
+		return false
+	}
+
+	if sub.ElemReference != nil || sub.ElemIndexReference != nil {
+		return true
+	}
+
+	if sub.Function == nil {
+		return false
+	}
+
+	for _, arg := range sub.Function.Arguments {
+		if arg != nil && ContainsElemReference(arg.Value) {
+			return true
+		}
+	}
+
+	return false
+}
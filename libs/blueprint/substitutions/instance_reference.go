@@ -0,0 +1,69 @@
+package substitutions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// InstanceReferencePrefix is the prefix for string values that
+	// reference elements of other deployed blueprint instances.
+	InstanceReferencePrefix = "bluelink://"
+)
+
+var (
+	// InstanceExportReferencePattern is the pattern that a reference
+	// to an export of another deployed blueprint instance must match.
+	//
+	// Some examples that match the pattern are:
+	// - bluelink://instance/shared-network/exports/vpcId
+	// - bluelink://instance/prod.core-infra/exports/ordersQueueUrl
+	InstanceExportReferencePattern = regexp.MustCompile(
+		`^bluelink://instance/(?P<InstanceName>[^/\s]+)/exports/(?P<ExportName>` + namePattern + `)$`,
+	)
+)
+
+// InstanceExportReference is a reference to an export of another
+// deployed blueprint instance, provided in the form
+// "bluelink://instance/{instanceName}/exports/{exportName}".
+type InstanceExportReference struct {
+	InstanceName string
+	ExportName   string
+}
+
+func (r *InstanceExportReference) String() string {
+	return fmt.Sprintf(
+		"%sinstance/%s/exports/%s",
+		InstanceReferencePrefix,
+		r.InstanceName,
+		r.ExportName,
+	)
+}
+
+// IsInstanceReference determines whether the provided string value
+// is intended to be a reference to another deployed blueprint instance.
+// This only checks the prefix, ParseInstanceExportReference should be used
+// to check that the reference is valid.
+func IsInstanceReference(value string) bool {
+	return strings.HasPrefix(value, InstanceReferencePrefix)
+}
+
+// ParseInstanceExportReference parses a reference to an export
+// of another deployed blueprint instance in the form
+// "bluelink://instance/{instanceName}/exports/{exportName}".
+func ParseInstanceExportReference(value string) (*InstanceExportReference, error) {
+	match := InstanceExportReferencePattern.FindStringSubmatch(value)
+	if match == nil {
+		return nil, fmt.Errorf(
+			"invalid instance reference %q, instance references must be in the form "+
+				"\"bluelink://instance/{instanceName}/exports/{exportName}\"",
+			value,
+		)
+	}
+
+	return &InstanceExportReference{
+		InstanceName: match[InstanceExportReferencePattern.SubexpIndex("InstanceName")],
+		ExportName:   match[InstanceExportReferencePattern.SubexpIndex("ExportName")],
+	}, nil
+}
@@ -0,0 +1,54 @@
+package substitutions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type InstanceReferenceTestSuite struct {
+	suite.Suite
+}
+
+func (s *InstanceReferenceTestSuite) Test_parses_instance_export_reference() {
+	ref, err := ParseInstanceExportReference(
+		"bluelink://instance/shared-network.prod/exports/vpcId",
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		&InstanceExportReference{
+			InstanceName: "shared-network.prod",
+			ExportName:   "vpcId",
+		},
+		ref,
+	)
+	s.Assert().Equal(
+		"bluelink://instance/shared-network.prod/exports/vpcId",
+		ref.String(),
+	)
+}
+
+func (s *InstanceReferenceTestSuite) Test_fails_to_parse_invalid_instance_references() {
+	invalidRefs := []string{
+		"bluelink://instance/shared-network/exports/",
+		"bluelink://instance//exports/vpcId",
+		"bluelink://instance/shared-network/outputs/vpcId",
+		"bluelink://instance/shared-network/exports/vpc.id",
+		"bluelink://shared-network/exports/vpcId",
+	}
+
+	for _, invalidRef := range invalidRefs {
+		s.Assert().True(IsInstanceReference(invalidRef))
+		_, err := ParseInstanceExportReference(invalidRef)
+		s.Assert().Error(err, invalidRef)
+	}
+}
+
+func (s *InstanceReferenceTestSuite) Test_does_not_treat_other_strings_as_instance_references() {
+	s.Assert().False(IsInstanceReference("https://example.com/exports/vpcId"))
+	s.Assert().False(IsInstanceReference("instance/shared-network/exports/vpcId"))
+}
+
+func TestInstanceReferenceTestSuite(t *testing.T) {
+	suite.Run(t, new(InstanceReferenceTestSuite))
+}
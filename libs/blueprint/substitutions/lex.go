@@ -448,6 +448,17 @@ func takeIdentifierOrKeyword(state *lexState, sequence string, restStartPos int,
 	return utf8.RuneCountInString(value), len(value)
 }
 
+// IsReservedWord determines whether the given name is a keyword or literal
+// in the substitution language that can not be used as a name
+// in an identifier position, such as the name of a function parameter.
+func IsReservedWord(name string) bool {
+	if name == "true" || name == "false" || name == "none" {
+		return true
+	}
+
+	return deriveIdentOrKeywordTokenType(name) != tokenIdent
+}
+
 func deriveIdentOrKeywordTokenType(value string) tokenType {
 	switch value {
 	case "variables":
@@ -0,0 +1,253 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+var allowedValuesFromVariableTypes = []schema.VariableType{
+	schema.VariableTypeString,
+	schema.VariableTypeInteger,
+	schema.VariableTypeFloat,
+}
+
+// ValidateVariableAllowedValuesFrom deals with validating the definition
+// of a data source lookup for the allowed values of a variable
+// and checking the variable value against the allowed values produced
+// by the data source.
+// The value is only checked against the allowed values when a lookup is provided
+// and either a value has been provided for the variable or runtime parameters
+// are being validated.
+// A failure to fetch the allowed values from the data source produces a warning
+// as the value can not be checked until the data source is available.
+func ValidateVariableAllowedValuesFrom(
+	ctx context.Context,
+	varName string,
+	varSchema *schema.Variable,
+	varMap *schema.VariableMap,
+	params core.BlueprintParams,
+	lookup AllowedValuesLookup,
+	validateRuntimeParams bool,
+) ([]*core.Diagnostic, error) {
+	diagnostics := []*core.Diagnostic{}
+	if varSchema.AllowedValuesFrom == nil || varSchema.Type == nil {
+		return diagnostics, nil
+	}
+
+	varSourceMeta := getVarSourceMeta(varMap, varName)
+	dataSource, err := validateAllowedValuesFromDefinition(varName, varSchema, varSourceMeta)
+	if err != nil {
+		return diagnostics, err
+	}
+
+	userProvidedValue := params.BlueprintVariable(varName)
+	finalValue := fallbackToDefault(userProvidedValue, varSchema.Default)
+	usingDefault := userProvidedValue == nil
+	if lookup == nil || core.IsScalarNil(finalValue) || (usingDefault && !validateRuntimeParams) {
+		return diagnostics, nil
+	}
+
+	allowedValues, err := lookup.AllowedValues(ctx, dataSource, params)
+	if err != nil {
+		diagnostics = append(diagnostics, &core.Diagnostic{
+			Level: core.DiagnosticLevelWarning,
+			Message: fmt.Sprintf(
+				"The allowed values for variable %q could not be fetched from data source %q, "+
+					"the variable value has not been checked against the allowed values: %s",
+				varName,
+				dataSource.DataSourceType,
+				err.Error(),
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(varSourceMeta, nil),
+		})
+		return diagnostics, nil
+	}
+
+	allowedScalars := mappingNodesToScalars(allowedValues)
+	if !core.IsInScalarList(finalValue, allowedScalars) {
+		return diagnostics, errVariableValueNotAllowed(
+			varSchema.Type.Value,
+			varName,
+			finalValue,
+			allowedScalars,
+			varSourceMeta,
+			usingDefault,
+		)
+	}
+
+	return diagnostics, nil
+}
+
+func validateAllowedValuesFromDefinition(
+	varName string,
+	varSchema *schema.Variable,
+	varSourceMeta *source.Meta,
+) (*provider.AllowedValuesDataSource, error) {
+	allowedValuesFrom := varSchema.AllowedValuesFrom
+	if len(varSchema.AllowedValues) > 0 {
+		return nil, errVariableInvalidAllowedValuesFrom(
+			varName,
+			"allowedValues and allowedValuesFrom can not be used together",
+			varSourceMeta,
+		)
+	}
+
+	if !slices.Contains(allowedValuesFromVariableTypes, varSchema.Type.Value) {
+		return nil, errVariableInvalidAllowedValuesFrom(
+			varName,
+			fmt.Sprintf("%s variables do not support allowed values", varSchema.Type.Value),
+			varSourceMeta,
+		)
+	}
+
+	dataSourceType := core.StringValueFromScalar(allowedValuesFrom.DataSourceType)
+	if strings.TrimSpace(dataSourceType) == "" {
+		return nil, errVariableInvalidAllowedValuesFrom(
+			varName,
+			"a data source type must be provided",
+			varSourceMeta,
+		)
+	}
+
+	field := core.StringValueFromScalar(allowedValuesFrom.Field)
+	if strings.TrimSpace(field) == "" {
+		return nil, errVariableInvalidAllowedValuesFrom(
+			varName,
+			"a data source field must be provided",
+			varSourceMeta,
+		)
+	}
+
+	filter, err := allowedValuesFromFilter(allowedValuesFrom.Filter)
+	if err != nil {
+		return nil, errVariableInvalidAllowedValuesFrom(varName, err.Error(), varSourceMeta)
+	}
+
+	return &provider.AllowedValuesDataSource{
+		DataSourceType: dataSourceType,
+		Field:          field,
+		Filter:         filter,
+	}, nil
+}
+
+// Converts the filters for an allowed values data source lookup
+// to resolved filters, search values must not contain substitutions
+// as variables are validated before substitutions can be resolved.
+func allowedValuesFromFilter(filters *schema.DataSourceFilters) (*provider.ResolvedDataSourceFilters, error) {
+	if filters == nil || len(filters.Filters) == 0 {
+		return nil, nil
+	}
+
+	resolvedFilters := make([]*provider.ResolvedDataSourceFilter, 0, len(filters.Filters))
+	for _, filter := range filters.Filters {
+		if core.StringValueFromScalar(filter.Field) == "" || filter.Operator == nil {
+			return nil, fmt.Errorf("each filter must have a field and an operator")
+		}
+
+		searchValues := []*core.MappingNode{}
+		if filter.Search != nil {
+			for _, searchValue := range filter.Search.Values {
+				literal, isLiteral := stringOrSubstitutionsLiteral(searchValue)
+				if !isLiteral {
+					return nil, fmt.Errorf(
+						"filter search values can not contain substitutions",
+					)
+				}
+				searchValues = append(searchValues, core.MappingNodeFromString(literal))
+			}
+		}
+
+		resolvedFilters = append(resolvedFilters, &provider.ResolvedDataSourceFilter{
+			Field:    filter.Field,
+			Operator: filter.Operator,
+			Search: &provider.ResolvedDataSourceFilterSearch{
+				Values: searchValues,
+			},
+		})
+	}
+
+	return &provider.ResolvedDataSourceFilters{
+		Filters: resolvedFilters,
+	}, nil
+}
+
+func stringOrSubstitutionsLiteral(value *substitutions.StringOrSubstitutions) (string, bool) {
+	if value == nil {
+		return "", true
+	}
+
+	var sb strings.Builder
+	for _, part := range value.Values {
+		if part.SubstitutionValue != nil {
+			return "", false
+		}
+		if part.StringValue != nil {
+			sb.WriteString(*part.StringValue)
+		}
+	}
+
+	return sb.String(), true
+}
+
+func mappingNodesToScalars(nodes []*core.MappingNode) []*core.ScalarValue {
+	scalars := make([]*core.ScalarValue, 0, len(nodes))
+	for _, node := range nodes {
+		if core.IsScalarMappingNode(node) {
+			scalars = append(scalars, node.Scalar)
+		}
+	}
+	return scalars
+}
+
+// resolveResourceDefinitionAllowedValues produces the schema to validate
+// allowed values against for a resource definition, populating the allowed values
+// from a data source lookup when the schema sources allowed values from a data source.
+// When the allowed values can not be fetched, a warning is produced and the
+// returned schema will not contain any allowed values.
+func resolveResourceDefinitionAllowedValues(
+	ctx context.Context,
+	params ResourceValidationParams,
+	definitionSchema *provider.ResourceDefinitionsSchema,
+	path string,
+	location *source.Meta,
+) (*provider.ResourceDefinitionsSchema, []*core.Diagnostic) {
+	diagnostics := []*core.Diagnostic{}
+	if len(definitionSchema.AllowedValues) > 0 ||
+		definitionSchema.AllowedValuesFrom == nil ||
+		params.ValidationContext == nil ||
+		params.AllowedValuesLookup == nil {
+		return definitionSchema, diagnostics
+	}
+
+	allowedValues, err := params.AllowedValuesLookup.AllowedValues(
+		ctx,
+		definitionSchema.AllowedValuesFrom,
+		params.Params,
+	)
+	if err != nil {
+		diagnostics = append(diagnostics, &core.Diagnostic{
+			Level: core.DiagnosticLevelWarning,
+			Message: fmt.Sprintf(
+				"The allowed values for %q could not be fetched from data source %q, "+
+					"the value has not been checked against the allowed values: %s",
+				path,
+				definitionSchema.AllowedValuesFrom.DataSourceType,
+				err.Error(),
+			),
+			Range: core.DiagnosticRangeFromSourceMeta(location, nil),
+		})
+		return definitionSchema, diagnostics
+	}
+
+	schemaWithAllowedValues := *definitionSchema
+	schemaWithAllowedValues.AllowedValues = allowedValues
+	return &schemaWithAllowedValues, diagnostics
+}
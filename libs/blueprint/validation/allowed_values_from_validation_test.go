@@ -0,0 +1,289 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal/mockclock"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	. "gopkg.in/check.v1"
+)
+
+type AllowedValuesFromValidationTestSuite struct {
+	dataSourceRegistry *allowedValuesDataSourceRegistryStub
+	clock              *mockclock.AdvanceableClock
+	lookup             AllowedValuesLookup
+}
+
+var _ = Suite(&AllowedValuesFromValidationTestSuite{})
+
+func (s *AllowedValuesFromValidationTestSuite) SetUpTest(c *C) {
+	s.dataSourceRegistry = &allowedValuesDataSourceRegistryStub{
+		data: map[string]*core.MappingNode{
+			"instanceTypes": {
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("t3.micro"),
+					core.MappingNodeFromString("t3.small"),
+				},
+			},
+			"region": core.MappingNodeFromString("eu-west-2"),
+			"limits": {
+				Fields: map[string]*core.MappingNode{
+					"max": core.MappingNodeFromInt(10),
+				},
+			},
+		},
+	}
+	s.clock = mockclock.NewAdvanceableClock(time.Unix(mockclock.CurrentTimeUnixMock, 0))
+	s.lookup = NewAllowedValuesLookup(
+		s.dataSourceRegistry,
+		WithAllowedValuesLookupClock(s.clock),
+		WithAllowedValuesCacheTTL(time.Minute),
+	)
+}
+
+func (s *AllowedValuesFromValidationTestSuite) Test_succeeds_for_value_in_data_source_allowed_values(c *C) {
+	varSchema, varMap := allowedValuesFromTestVariable(nil)
+	params := allowedValuesFromTestParams("t3.small")
+
+	diagnostics, err := ValidateVariableAllowedValuesFrom(
+		context.Background(),
+		"instanceType",
+		varSchema,
+		varMap,
+		params,
+		s.lookup,
+		/* validateRuntimeParams */ true,
+	)
+	c.Assert(err, IsNil)
+	c.Assert(diagnostics, HasLen, 0)
+
+	fetchInput := s.dataSourceRegistry.lastInput
+	c.Assert(fetchInput, NotNil)
+	c.Assert(fetchInput.DataSourceWithResolvedSubs.Type.Value, Equals, "aws/ec2/instanceTypes")
+	c.Assert(fetchInput.DataSourceWithResolvedSubs.Filter.Filters, HasLen, 1)
+	c.Assert(
+		core.StringValue(fetchInput.DataSourceWithResolvedSubs.Filter.Filters[0].Search.Values[0]),
+		Equals,
+		"eu-west-2",
+	)
+}
+
+func (s *AllowedValuesFromValidationTestSuite) Test_reports_error_for_value_not_in_data_source_allowed_values(c *C) {
+	varSchema, varMap := allowedValuesFromTestVariable(nil)
+	params := allowedValuesFromTestParams("m5.large")
+
+	_, err := ValidateVariableAllowedValuesFrom(
+		context.Background(),
+		"instanceType",
+		varSchema,
+		varMap,
+		params,
+		s.lookup,
+		/* validateRuntimeParams */ true,
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*bperrors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeVariableValueNotAllowed)
+	c.Assert(loadErr.Error(), Matches, ".*t3.micro, t3.small.*")
+}
+
+func (s *AllowedValuesFromValidationTestSuite) Test_reports_warning_when_allowed_values_can_not_be_fetched(c *C) {
+	s.dataSourceRegistry.fetchErr = errors.New("service unavailable")
+	varSchema, varMap := allowedValuesFromTestVariable(nil)
+	params := allowedValuesFromTestParams("m5.large")
+
+	diagnostics, err := ValidateVariableAllowedValuesFrom(
+		context.Background(),
+		"instanceType",
+		varSchema,
+		varMap,
+		params,
+		s.lookup,
+		/* validateRuntimeParams */ true,
+	)
+	c.Assert(err, IsNil)
+	c.Assert(diagnostics, HasLen, 1)
+	c.Assert(diagnostics[0].Level, Equals, core.DiagnosticLevelWarning)
+	c.Assert(diagnostics[0].Message, Matches, ".*service unavailable.*")
+}
+
+func (s *AllowedValuesFromValidationTestSuite) Test_reports_error_when_used_with_allowed_values(c *C) {
+	varSchema, varMap := allowedValuesFromTestVariable(nil)
+	varSchema.AllowedValues = []*core.ScalarValue{core.ScalarFromString("t3.micro")}
+
+	_, err := ValidateVariableAllowedValuesFrom(
+		context.Background(),
+		"instanceType",
+		varSchema,
+		varMap,
+		allowedValuesFromTestParams("t3.micro"),
+		s.lookup,
+		/* validateRuntimeParams */ true,
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*bperrors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeVariableInvalidAllowedValuesFrom)
+	c.Assert(loadErr.Error(), Matches, ".*can not be used together.*")
+}
+
+func (s *AllowedValuesFromValidationTestSuite) Test_reports_error_for_substitution_in_filter_search(c *C) {
+	regionVar := "region"
+	varSchema, varMap := allowedValuesFromTestVariable(&substitutions.StringOrSubstitutions{
+		Values: []*substitutions.StringOrSubstitution{
+			{
+				SubstitutionValue: &substitutions.Substitution{
+					Variable: &substitutions.SubstitutionVariable{
+						VariableName: regionVar,
+					},
+				},
+			},
+		},
+	})
+
+	_, err := ValidateVariableAllowedValuesFrom(
+		context.Background(),
+		"instanceType",
+		varSchema,
+		varMap,
+		allowedValuesFromTestParams("t3.micro"),
+		s.lookup,
+		/* validateRuntimeParams */ true,
+	)
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Matches, ".*can not contain substitutions.*")
+	c.Assert(s.dataSourceRegistry.fetchCalls, Equals, 0)
+}
+
+func (s *AllowedValuesFromValidationTestSuite) Test_lookup_caches_data_source_results_until_ttl_expires(c *C) {
+	dataSource := &provider.AllowedValuesDataSource{
+		DataSourceType: "aws/ec2/instanceTypes",
+		Field:          "instanceTypes",
+	}
+
+	for range 2 {
+		values, err := s.lookup.AllowedValues(context.Background(), dataSource, core.NewDefaultParams(nil, nil, nil, nil))
+		c.Assert(err, IsNil)
+		c.Assert(values, HasLen, 2)
+	}
+	c.Assert(s.dataSourceRegistry.fetchCalls, Equals, 1)
+
+	// A scalar field is treated as a single allowed value and shares
+	// the cached data source results.
+	values, err := s.lookup.AllowedValues(
+		context.Background(),
+		&provider.AllowedValuesDataSource{
+			DataSourceType: "aws/ec2/instanceTypes",
+			Field:          "region",
+		},
+		core.NewDefaultParams(nil, nil, nil, nil),
+	)
+	c.Assert(err, IsNil)
+	c.Assert(values, DeepEquals, []*core.MappingNode{core.MappingNodeFromString("eu-west-2")})
+	c.Assert(s.dataSourceRegistry.fetchCalls, Equals, 1)
+
+	s.clock.Advance(2 * time.Minute)
+	_, err = s.lookup.AllowedValues(context.Background(), dataSource, core.NewDefaultParams(nil, nil, nil, nil))
+	c.Assert(err, IsNil)
+	c.Assert(s.dataSourceRegistry.fetchCalls, Equals, 2)
+}
+
+func (s *AllowedValuesFromValidationTestSuite) Test_lookup_reports_error_for_non_scalar_field(c *C) {
+	_, err := s.lookup.AllowedValues(
+		context.Background(),
+		&provider.AllowedValuesDataSource{
+			DataSourceType: "aws/ec2/instanceTypes",
+			Field:          "limits",
+		},
+		core.NewDefaultParams(nil, nil, nil, nil),
+	)
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Matches, ".*must be a scalar value or an array of scalar values.*")
+}
+
+func allowedValuesFromTestVariable(
+	regionSearch *substitutions.StringOrSubstitutions,
+) (*schema.Variable, *schema.VariableMap) {
+	if regionSearch == nil {
+		region := "eu-west-2"
+		regionSearch = &substitutions.StringOrSubstitutions{
+			Values: []*substitutions.StringOrSubstitution{
+				{StringValue: &region},
+			},
+		}
+	}
+
+	varSchema := &schema.Variable{
+		Type: &schema.VariableTypeWrapper{Value: schema.VariableTypeString},
+		AllowedValuesFrom: &schema.AllowedValuesFrom{
+			DataSourceType: core.ScalarFromString("aws/ec2/instanceTypes"),
+			Field:          core.ScalarFromString("instanceTypes"),
+			Filter: &schema.DataSourceFilters{
+				Filters: []*schema.DataSourceFilter{
+					{
+						Field: core.ScalarFromString("region"),
+						Operator: &schema.DataSourceFilterOperatorWrapper{
+							Value: schema.DataSourceFilterOperatorEquals,
+						},
+						Search: &schema.DataSourceFilterSearch{
+							Values: []*substitutions.StringOrSubstitutions{regionSearch},
+						},
+					},
+				},
+			},
+		},
+	}
+	varMap := &schema.VariableMap{
+		Values: map[string]*schema.Variable{
+			"instanceType": varSchema,
+		},
+		SourceMeta: map[string]*source.Meta{
+			"instanceType": {Position: source.Position{
+				Line:   1,
+				Column: 1,
+			}},
+		},
+	}
+
+	return varSchema, varMap
+}
+
+func allowedValuesFromTestParams(instanceType string) core.BlueprintParams {
+	return &core.ParamsImpl{
+		BlueprintVariables: map[string]*core.ScalarValue{
+			"instanceType": core.ScalarFromString(instanceType),
+		},
+	}
+}
+
+type allowedValuesDataSourceRegistryStub struct {
+	provider.DataSourceRegistry
+	data       map[string]*core.MappingNode
+	fetchErr   error
+	fetchCalls int
+	lastInput  *provider.DataSourceFetchInput
+}
+
+func (r *allowedValuesDataSourceRegistryStub) Fetch(
+	ctx context.Context,
+	dataSourceType string,
+	input *provider.DataSourceFetchInput,
+) (*provider.DataSourceFetchOutput, error) {
+	r.fetchCalls += 1
+	r.lastInput = input
+	if r.fetchErr != nil {
+		return nil, r.fetchErr
+	}
+
+	return &provider.DataSourceFetchOutput{
+		Data: r.data,
+	}, nil
+}
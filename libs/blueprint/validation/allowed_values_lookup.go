@@ -0,0 +1,194 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// AllowedValuesLookup provides a way to fetch allowed values for variables
+// and resource spec fields that are sourced from data sources.
+type AllowedValuesLookup interface {
+	// AllowedValues fetches the list of allowed values produced by the given
+	// data source lookup.
+	AllowedValues(
+		ctx context.Context,
+		dataSource *provider.AllowedValuesDataSource,
+		params core.BlueprintParams,
+	) ([]*core.MappingNode, error)
+}
+
+// DefaultAllowedValuesCacheTTL is the default amount of time that data source
+// results are cached for when looking up allowed values.
+const DefaultAllowedValuesCacheTTL = 5 * time.Minute
+
+type dataSourceAllowedValuesLookup struct {
+	dataSourceRegistry provider.DataSourceRegistry
+	clock              core.Clock
+	cacheTTL           time.Duration
+	cache              map[string]*cachedDataSourceData
+	mu                 sync.Mutex
+}
+
+type cachedDataSourceData struct {
+	data      map[string]*core.MappingNode
+	expiresAt time.Time
+}
+
+// AllowedValuesLookupOption is a function that configures
+// an allowed values lookup.
+type AllowedValuesLookupOption func(*dataSourceAllowedValuesLookup)
+
+// WithAllowedValuesCacheTTL sets the amount of time that data source results
+// are cached for.
+// A TTL of 0 or less disables caching.
+func WithAllowedValuesCacheTTL(ttl time.Duration) AllowedValuesLookupOption {
+	return func(lookup *dataSourceAllowedValuesLookup) {
+		lookup.cacheTTL = ttl
+	}
+}
+
+// WithAllowedValuesLookupClock sets the clock used to determine
+// when cached data source results have expired.
+func WithAllowedValuesLookupClock(clock core.Clock) AllowedValuesLookupOption {
+	return func(lookup *dataSourceAllowedValuesLookup) {
+		lookup.clock = clock
+	}
+}
+
+// NewAllowedValuesLookup creates a new allowed values lookup that fetches
+// data sources through the provided registry.
+// Results are cached by data source type and filters so that multiple
+// variables and resource fields sourcing values from the same data source
+// only trigger a single fetch.
+func NewAllowedValuesLookup(
+	dataSourceRegistry provider.DataSourceRegistry,
+	opts ...AllowedValuesLookupOption,
+) AllowedValuesLookup {
+	lookup := &dataSourceAllowedValuesLookup{
+		dataSourceRegistry: dataSourceRegistry,
+		clock:              core.SystemClock{},
+		cacheTTL:           DefaultAllowedValuesCacheTTL,
+		cache:              map[string]*cachedDataSourceData{},
+	}
+
+	for _, opt := range opts {
+		opt(lookup)
+	}
+
+	return lookup
+}
+
+func (l *dataSourceAllowedValuesLookup) AllowedValues(
+	ctx context.Context,
+	dataSource *provider.AllowedValuesDataSource,
+	params core.BlueprintParams,
+) ([]*core.MappingNode, error) {
+	data, err := l.fetchData(ctx, dataSource, params)
+	if err != nil {
+		return nil, err
+	}
+
+	value, hasValue := data[dataSource.Field]
+	if !hasValue || value == nil {
+		return nil, fmt.Errorf(
+			"field %q is not present in the output of data source %q",
+			dataSource.Field,
+			dataSource.DataSourceType,
+		)
+	}
+
+	if core.IsScalarMappingNode(value) {
+		return []*core.MappingNode{value}, nil
+	}
+
+	if value.Items == nil {
+		return nil, fmt.Errorf(
+			"field %q in the output of data source %q must be a scalar value or an array of scalar values",
+			dataSource.Field,
+			dataSource.DataSourceType,
+		)
+	}
+
+	allowedValues := make([]*core.MappingNode, 0, len(value.Items))
+	for _, item := range value.Items {
+		if !core.IsScalarMappingNode(item) {
+			return nil, fmt.Errorf(
+				"field %q in the output of data source %q must only contain scalar values",
+				dataSource.Field,
+				dataSource.DataSourceType,
+			)
+		}
+		allowedValues = append(allowedValues, item)
+	}
+
+	return allowedValues, nil
+}
+
+func (l *dataSourceAllowedValuesLookup) fetchData(
+	ctx context.Context,
+	dataSource *provider.AllowedValuesDataSource,
+	params core.BlueprintParams,
+) (map[string]*core.MappingNode, error) {
+	cacheKey, err := allowedValuesCacheKey(dataSource)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cached, hasCached := l.cache[cacheKey]
+	if hasCached && l.clock.Now().Before(cached.expiresAt) {
+		return cached.data, nil
+	}
+
+	output, err := l.dataSourceRegistry.Fetch(
+		ctx,
+		dataSource.DataSourceType,
+		&provider.DataSourceFetchInput{
+			DataSourceWithResolvedSubs: &provider.ResolvedDataSource{
+				Type: &schema.DataSourceTypeWrapper{
+					Value: dataSource.DataSourceType,
+				},
+				DataSourceMetadata: &provider.ResolvedDataSourceMetadata{},
+				Filter:             dataSource.Filter,
+			},
+			ProviderContext: provider.NewProviderContextFromParams(
+				provider.ExtractProviderFromItemType(dataSource.DataSourceType),
+				params,
+			),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.cacheTTL > 0 {
+		l.cache[cacheKey] = &cachedDataSourceData{
+			data:      output.Data,
+			expiresAt: l.clock.Now().Add(l.cacheTTL),
+		}
+	}
+
+	return output.Data, nil
+}
+
+func allowedValuesCacheKey(dataSource *provider.AllowedValuesDataSource) (string, error) {
+	if dataSource.Filter == nil || len(dataSource.Filter.Filters) == 0 {
+		return dataSource.DataSourceType, nil
+	}
+
+	filterBytes, err := json.Marshal(dataSource.Filter)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s|%s", dataSource.DataSourceType, string(filterBytes)), nil
+}
@@ -46,6 +46,12 @@ const (
 	// that is the sole version of the spec supported by the initial
 	// version of the blueprint framework.
 	Version2025_11_02 = "2025-11-02"
+
+	// LatestVersion is the latest version of the blueprint specification
+	// supported by this version of the blueprint framework.
+	// Documents written for older versions of the specification can be
+	// rewritten to this version with a schema.Migrator.
+	LatestVersion = Version2025_11_02
 )
 
 var (
@@ -1202,6 +1202,8 @@ func (r *testECSServiceResource) GetSpecDefinition(
 					},
 				},
 			},
+			// ECS services must have a unique name within a cluster.
+			DestroyBeforeCreate: true,
 		},
 	}, nil
 }
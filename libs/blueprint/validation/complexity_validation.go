@@ -0,0 +1,309 @@
+package validation
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+// ComplexityLimits holds the maximum complexity allowed for a blueprint.
+// These limits allow host applications that load blueprints from
+// untrusted sources (e.g. multi-tenant deploy engine installations)
+// to reject pathological blueprints before they are deployed.
+//
+// A limit set to 0 or less is not applied.
+type ComplexityLimits struct {
+	// MaxResources is the maximum number of resources that can be
+	// defined in a single blueprint.
+	// Resource templates are counted as a single resource as
+	// they are only expanded when a blueprint is deployed.
+	MaxResources int
+	// MaxIncludeDepth is the maximum depth of nested child blueprints,
+	// a blueprint that includes a child blueprint that includes another
+	// child blueprint has an include depth of 2.
+	MaxIncludeDepth int
+	// MaxSubstitutionDepth is the maximum nesting depth of a substitution,
+	// each function call that is used as an argument to another function call
+	// adds a level of nesting.
+	// For example, ${trim(lower(variables.name))} has a depth of 3.
+	MaxSubstitutionDepth int
+	// MaxReferenceGraphSize is the maximum number of references between
+	// elements of a blueprint, this includes references in substitutions
+	// and explicit dependencies between resources.
+	MaxReferenceGraphSize int
+}
+
+// ValidateComplexityLimits checks the number of resources, the include depth
+// and the nesting depth of substitutions in the given blueprint against the provided limits.
+// The include depth is the depth of the given blueprint in a tree of nested
+// child blueprints, where the root blueprint has a depth of 0.
+//
+// This is designed to be called before any other validation is carried out
+// so that pathological blueprints can be rejected early.
+func ValidateComplexityLimits(
+	bpSchema *schema.Blueprint,
+	includeDepth int,
+	limits *ComplexityLimits,
+) error {
+	if bpSchema == nil || limits == nil {
+		return nil
+	}
+
+	errs := []error{}
+	if limits.MaxResources > 0 && bpSchema.Resources != nil &&
+		len(bpSchema.Resources.Values) > limits.MaxResources {
+		errs = append(
+			errs,
+			errMaxResourcesExceeded(len(bpSchema.Resources.Values), limits.MaxResources),
+		)
+	}
+
+	if limits.MaxIncludeDepth > 0 && bpSchema.Include != nil &&
+		len(bpSchema.Include.Values) > 0 && includeDepth+1 > limits.MaxIncludeDepth {
+		firstInclude := sortedKeys(bpSchema.Include.Values)[0]
+		errs = append(
+			errs,
+			errMaxIncludeDepthExceeded(
+				includeDepth+1,
+				limits.MaxIncludeDepth,
+				bpSchema.Include.SourceMeta[firstInclude],
+			),
+		)
+	}
+
+	if limits.MaxSubstitutionDepth > 0 {
+		errs = append(
+			errs,
+			checkSubstitutionDepth(bpSchema, limits.MaxSubstitutionDepth)...,
+		)
+	}
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	if len(errs) > 1 {
+		return ErrMultipleValidationErrors(errs)
+	}
+
+	return nil
+}
+
+// ValidateReferenceGraphSize checks the number of references between
+// elements in the blueprint against the configured limit.
+//
+// This must only be called after all references in the blueprint
+// have been collected.
+func ValidateReferenceGraphSize(
+	refChainCollector refgraph.RefChainCollector,
+	limits *ComplexityLimits,
+) error {
+	if refChainCollector == nil || limits == nil || limits.MaxReferenceGraphSize <= 0 {
+		return nil
+	}
+
+	size := countReferences(refChainCollector)
+	if size > limits.MaxReferenceGraphSize {
+		return errMaxReferenceGraphSizeExceeded(size, limits.MaxReferenceGraphSize)
+	}
+
+	return nil
+}
+
+func countReferences(refChainCollector refgraph.RefChainCollector) int {
+	visited := map[string]bool{}
+	count := 0
+	var visit func(node *refgraph.ReferenceChainNode)
+	visit = func(node *refgraph.ReferenceChainNode) {
+		if node == nil || visited[node.ElementName] {
+			return
+		}
+		visited[node.ElementName] = true
+		count += len(node.References)
+		for _, reference := range node.References {
+			visit(reference)
+		}
+		for _, referencedBy := range node.ReferencedBy {
+			visit(referencedBy)
+		}
+	}
+
+	for _, node := range refChainCollector.ChainsByDependencies() {
+		visit(node)
+	}
+	for _, node := range refChainCollector.ChainsByLeafDependants() {
+		visit(node)
+	}
+
+	return count
+}
+
+func checkSubstitutionDepth(bpSchema *schema.Blueprint, maxDepth int) []error {
+	checker := &substitutionDepthChecker{
+		maxDepth: maxDepth,
+		errs:     []error{},
+	}
+
+	if bpSchema.Values != nil {
+		for _, valName := range sortedKeys(bpSchema.Values.Values) {
+			value := bpSchema.Values.Values[valName]
+			if value != nil {
+				checker.checkMappingNode(value.Value)
+				checker.checkStringOrSubs(value.Description)
+			}
+		}
+	}
+
+	if bpSchema.Include != nil {
+		for _, includeName := range sortedKeys(bpSchema.Include.Values) {
+			include := bpSchema.Include.Values[includeName]
+			if include != nil {
+				checker.checkStringOrSubs(include.Path)
+				checker.checkMappingNode(include.Variables)
+				checker.checkMappingNode(include.Metadata)
+				checker.checkStringOrSubs(include.Description)
+			}
+		}
+	}
+
+	if bpSchema.Resources != nil {
+		for _, resourceName := range sortedKeys(bpSchema.Resources.Values) {
+			resource := bpSchema.Resources.Values[resourceName]
+			if resource != nil {
+				checker.checkResource(resource)
+			}
+		}
+	}
+
+	if bpSchema.DataSources != nil {
+		for _, dataSourceName := range sortedKeys(bpSchema.DataSources.Values) {
+			dataSource := bpSchema.DataSources.Values[dataSourceName]
+			if dataSource != nil {
+				checker.checkDataSource(dataSource)
+			}
+		}
+	}
+
+	checker.checkMappingNode(bpSchema.Metadata)
+
+	return checker.errs
+}
+
+type substitutionDepthChecker struct {
+	maxDepth int
+	errs     []error
+}
+
+func (c *substitutionDepthChecker) checkResource(resource *schema.Resource) {
+	c.checkStringOrSubs(resource.Description)
+	c.checkStringOrSubs(resource.Each)
+	c.checkCondition(resource.Condition)
+	c.checkMappingNode(resource.Spec)
+	if resource.Metadata != nil {
+		c.checkStringOrSubs(resource.Metadata.DisplayName)
+		c.checkStringOrSubsMap(resource.Metadata.Annotations)
+		c.checkMappingNode(resource.Metadata.Custom)
+	}
+}
+
+func (c *substitutionDepthChecker) checkDataSource(dataSource *schema.DataSource) {
+	c.checkStringOrSubs(dataSource.Description)
+	if dataSource.DataSourceMetadata != nil {
+		c.checkStringOrSubs(dataSource.DataSourceMetadata.DisplayName)
+		c.checkStringOrSubsMap(dataSource.DataSourceMetadata.Annotations)
+		c.checkMappingNode(dataSource.DataSourceMetadata.Custom)
+	}
+	if dataSource.Filter != nil {
+		for _, filter := range dataSource.Filter.Filters {
+			if filter != nil && filter.Search != nil {
+				for _, searchValue := range filter.Search.Values {
+					c.checkStringOrSubs(searchValue)
+				}
+			}
+		}
+	}
+}
+
+func (c *substitutionDepthChecker) checkCondition(condition *schema.Condition) {
+	if condition == nil {
+		return
+	}
+
+	c.checkStringOrSubs(condition.StringValue)
+	for _, andCondition := range condition.And {
+		c.checkCondition(andCondition)
+	}
+	for _, orCondition := range condition.Or {
+		c.checkCondition(orCondition)
+	}
+	c.checkCondition(condition.Not)
+}
+
+func (c *substitutionDepthChecker) checkStringOrSubsMap(
+	stringOrSubsMap *schema.StringOrSubstitutionsMap,
+) {
+	if stringOrSubsMap == nil {
+		return
+	}
+
+	for _, key := range sortedKeys(stringOrSubsMap.Values) {
+		c.checkStringOrSubs(stringOrSubsMap.Values[key])
+	}
+}
+
+func (c *substitutionDepthChecker) checkMappingNode(node *core.MappingNode) {
+	if node == nil {
+		return
+	}
+
+	c.checkStringOrSubs(node.StringWithSubstitutions)
+	for _, key := range sortedKeys(node.Fields) {
+		c.checkMappingNode(node.Fields[key])
+	}
+	for _, item := range node.Items {
+		c.checkMappingNode(item)
+	}
+}
+
+func (c *substitutionDepthChecker) checkStringOrSubs(
+	stringOrSubs *substitutions.StringOrSubstitutions,
+) {
+	if stringOrSubs == nil {
+		return
+	}
+
+	for _, value := range stringOrSubs.Values {
+		if value == nil || value.SubstitutionValue == nil {
+			continue
+		}
+
+		depth := substitutionDepth(value.SubstitutionValue)
+		if depth > c.maxDepth {
+			location := value.SubstitutionValue.SourceMeta
+			if location == nil {
+				location = value.SourceMeta
+			}
+			c.errs = append(
+				c.errs,
+				errMaxSubstitutionDepthExceeded(depth, c.maxDepth, location),
+			)
+		}
+	}
+}
+
+func substitutionDepth(sub *substitutions.Substitution) int {
+	if sub == nil || sub.Function == nil {
+		return 1
+	}
+
+	maxArgDepth := 0
+	for _, arg := range sub.Function.Arguments {
+		if arg == nil || arg.Value == nil {
+			continue
+		}
+		maxArgDepth = max(maxArgDepth, substitutionDepth(arg.Value))
+	}
+
+	return maxArgDepth + 1
+}
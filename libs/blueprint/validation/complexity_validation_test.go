@@ -0,0 +1,150 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/stretchr/testify/suite"
+)
+
+type ComplexityValidationTestSuite struct {
+	suite.Suite
+}
+
+func (s *ComplexityValidationTestSuite) Test_succeeds_for_blueprint_within_limits() {
+	err := ValidateComplexityLimits(
+		createComplexityTestBlueprint(),
+		/* includeDepth */ 0,
+		&ComplexityLimits{
+			MaxResources:         2,
+			MaxIncludeDepth:      1,
+			MaxSubstitutionDepth: 3,
+		},
+	)
+	s.Assert().NoError(err)
+}
+
+func (s *ComplexityValidationTestSuite) Test_does_not_apply_limits_when_not_configured() {
+	err := ValidateComplexityLimits(
+		createComplexityTestBlueprint(),
+		/* includeDepth */ 10,
+		&ComplexityLimits{},
+	)
+	s.Assert().NoError(err)
+
+	err = ValidateComplexityLimits(createComplexityTestBlueprint(), 10, nil)
+	s.Assert().NoError(err)
+}
+
+func (s *ComplexityValidationTestSuite) Test_reports_error_when_max_resources_exceeded() {
+	err := ValidateComplexityLimits(
+		createComplexityTestBlueprint(),
+		/* includeDepth */ 0,
+		&ComplexityLimits{MaxResources: 1},
+	)
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeMaxResourcesExceeded, loadErr.ReasonCode)
+	s.Assert().Contains(loadErr.Error(), "defining 2 resources")
+}
+
+func (s *ComplexityValidationTestSuite) Test_reports_error_when_max_include_depth_exceeded() {
+	err := ValidateComplexityLimits(
+		createComplexityTestBlueprint(),
+		/* includeDepth */ 2,
+		&ComplexityLimits{MaxIncludeDepth: 2},
+	)
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeMaxIncludeDepthExceeded, loadErr.ReasonCode)
+	s.Assert().Contains(loadErr.Error(), "included at a depth of 3")
+	s.Assert().Equal(20, *loadErr.Line)
+}
+
+func (s *ComplexityValidationTestSuite) Test_reports_error_when_max_substitution_depth_exceeded() {
+	err := ValidateComplexityLimits(
+		createComplexityTestBlueprint(),
+		/* includeDepth */ 0,
+		&ComplexityLimits{MaxSubstitutionDepth: 2},
+	)
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeMaxSubstitutionDepthExceeded, loadErr.ReasonCode)
+	s.Assert().Contains(loadErr.Error(), "nesting depth of 3")
+	s.Assert().Equal(12, *loadErr.Line)
+}
+
+func (s *ComplexityValidationTestSuite) Test_reports_error_when_max_reference_graph_size_exceeded() {
+	collector := refgraph.NewRefChainCollector()
+	s.Require().NoError(collector.Collect("resources.queue", &schema.Resource{}, "", nil))
+	s.Require().NoError(collector.Collect("variables.name", &schema.Variable{}, "resources.queue", nil))
+	s.Require().NoError(collector.Collect("resources.bucket", &schema.Resource{}, "resources.queue", nil))
+
+	err := ValidateReferenceGraphSize(collector, &ComplexityLimits{MaxReferenceGraphSize: 2})
+	s.Assert().NoError(err)
+
+	err = ValidateReferenceGraphSize(collector, &ComplexityLimits{MaxReferenceGraphSize: 1})
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeMaxReferenceGraphSizeExceeded, loadErr.ReasonCode)
+	s.Assert().Contains(loadErr.Error(), "containing 2 references")
+}
+
+func createComplexityTestBlueprint() *schema.Blueprint {
+	nestedSub, _ := substitutions.ParseSubstitutionValues(
+		"",
+		"${trim(lower(variables.name))}",
+		&source.Meta{Position: source.Position{Line: 12, Column: 7}},
+		/* outputLineInfo */ true,
+		/* ignoreParentColumn */ false,
+		/* parentContextPrecedingCharCount */ 0,
+	)
+	return &schema.Blueprint{
+		Include: &schema.IncludeMap{
+			Values: map[string]*schema.Include{
+				"networking": {
+					Path: &substitutions.StringOrSubstitutions{
+						Values: []*substitutions.StringOrSubstitution{
+							{StringValue: strPtr("networking.blueprint.yml")},
+						},
+					},
+				},
+			},
+			SourceMeta: map[string]*source.Meta{
+				"networking": {Position: source.Position{Line: 20, Column: 3}},
+			},
+		},
+		Resources: &schema.ResourceMap{
+			Values: map[string]*schema.Resource{
+				"queue": {
+					Type: &schema.ResourceTypeWrapper{Value: "aws/sqs/queue"},
+					Spec: &core.MappingNode{
+						Fields: map[string]*core.MappingNode{
+							"queueName": {
+								StringWithSubstitutions: &substitutions.StringOrSubstitutions{
+									Values: nestedSub,
+								},
+							},
+						},
+					},
+				},
+				"bucket": {
+					Type: &schema.ResourceTypeWrapper{Value: "aws/s3/bucket"},
+				},
+			},
+		},
+	}
+}
+
+func TestComplexityValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(ComplexityValidationTestSuite))
+}
@@ -183,6 +183,7 @@ func validateDataSourceType(
 		return diagnostics, errDataSourceTypeNotSupported(
 			dataSourceName,
 			dataSourceType.Value,
+			suggestDataSourceTypes(ctx, dataSourceRegistry, dataSourceType.Value),
 			location,
 		)
 	}
@@ -344,6 +344,43 @@ func (s *DataSourceValidationTestSuite) Test_reports_errors_when_data_source_typ
 	)
 }
 
+func (s *DataSourceValidationTestSuite) Test_includes_suggestions_for_unsupported_data_source_type(c *C) {
+	dataSource := &schema.DataSource{
+		Type: &schema.DataSourceTypeWrapper{Value: "aws/ec2/instanc"},
+	}
+	dataSourceMap := &schema.DataSourceMap{
+		Values: map[string]*schema.DataSource{
+			"vmInstance": dataSource,
+		},
+	}
+
+	_, err := ValidateDataSource(
+		context.Background(),
+		"vmInstance",
+		dataSource,
+		dataSourceMap,
+		&ValidationContext{
+			BpSchema:           &schema.Blueprint{DataSources: dataSourceMap},
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		core.NewNopLogger(),
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := internal.UnpackLoadError(err)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidDataSource)
+	c.Assert(loadErr.Context, NotNil)
+	c.Assert(
+		loadErr.Context.Metadata["suggestions"],
+		DeepEquals,
+		[]string{"aws/ec2/instance"},
+	)
+}
+
 func (s *DataSourceValidationTestSuite) Test_reports_errors_when_filter_search_is_empty(c *C) {
 	field := "instanceConfigId"
 	aliasFor := "instanceConfigId"
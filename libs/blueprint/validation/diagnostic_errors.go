@@ -5,6 +5,7 @@ import (
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
 )
 
 // ExtractDiagnosticsAndErrors extracts diagnostics and errors from the provided diagnostics slice.
@@ -34,6 +35,110 @@ func ExtractDiagnosticsAndErrors(
 	return nonErrorDiagnostics, nil
 }
 
+// WarningDiagnosticsAsErrors converts warning diagnostics into load errors
+// with the warning severity.
+// This is useful for contexts where warnings should prevent a blueprint from
+// being loaded (e.g. validation in CI pipelines).
+// The reason code of a diagnostic is preserved when one is available in the
+// diagnostic context, otherwise the provided default reason code is used.
+func WarningDiagnosticsAsErrors(
+	diagnostics []*core.Diagnostic,
+	defaultReasonCode bperrors.ErrorReasonCode,
+) []error {
+	errs := []error{}
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Level != core.DiagnosticLevelWarning {
+			continue
+		}
+
+		reasonCode := defaultReasonCode
+		if diagnostic.Context != nil && diagnostic.Context.ReasonCode != "" {
+			reasonCode = diagnostic.Context.ReasonCode
+		}
+		errs = append(errs, validationErrorFromDiagnostic(diagnostic, reasonCode))
+	}
+
+	return errs
+}
+
+// SplitWarnings separates load errors that have the warning or info severity
+// from the provided error, converting them into diagnostics.
+// The returned error contains the remaining load errors with the error severity,
+// nil is returned when the provided error only contains warnings
+// and informational messages.
+func SplitWarnings(err error) ([]*core.Diagnostic, error) {
+	loadErr, isLoadErr := err.(*bperrors.LoadError)
+	if !isLoadErr {
+		return []*core.Diagnostic{}, err
+	}
+
+	if len(loadErr.ChildErrors) == 0 {
+		if loadErr.IsError() {
+			return []*core.Diagnostic{}, err
+		}
+		return []*core.Diagnostic{diagnosticFromLoadError(loadErr)}, nil
+	}
+
+	diagnostics := []*core.Diagnostic{}
+	remainingChildErrs := []error{}
+	for _, childErr := range loadErr.ChildErrors {
+		childDiagnostics, remainingErr := SplitWarnings(childErr)
+		diagnostics = append(diagnostics, childDiagnostics...)
+		if remainingErr != nil {
+			remainingChildErrs = append(remainingChildErrs, remainingErr)
+		}
+	}
+
+	if len(remainingChildErrs) == 0 {
+		return diagnostics, nil
+	}
+
+	if len(remainingChildErrs) == len(loadErr.ChildErrors) {
+		return diagnostics, err
+	}
+
+	loadErrCopy := *loadErr
+	loadErrCopy.ChildErrors = remainingChildErrs
+	return diagnostics, &loadErrCopy
+}
+
+func diagnosticFromLoadError(loadErr *bperrors.LoadError) *core.Diagnostic {
+	errContext := loadErr.Context
+	if errContext == nil {
+		errContext = &bperrors.ErrorContext{
+			ReasonCode: loadErr.ReasonCode,
+		}
+	}
+
+	return &core.Diagnostic{
+		Level:   core.DiagnosticLevelFromSeverity(loadErr.Severity),
+		Message: loadErr.Err.Error(),
+		Range:   diagnosticRangeFromLoadError(loadErr),
+		Context: errContext,
+	}
+}
+
+func diagnosticRangeFromLoadError(loadErr *bperrors.LoadError) *core.DiagnosticRange {
+	if loadErr.Line == nil || loadErr.Column == nil {
+		return core.DiagnosticRangeFromSourceMeta(nil, nil)
+	}
+
+	start := &source.Meta{
+		Position: source.Position{
+			Line:   *loadErr.Line,
+			Column: *loadErr.Column,
+		},
+	}
+	if loadErr.EndLine != nil && loadErr.EndColumn != nil {
+		start.EndPosition = &source.Position{
+			Line:   *loadErr.EndLine,
+			Column: *loadErr.EndColumn,
+		}
+	}
+
+	return core.DiagnosticRangeFromSourceMeta(start, nil)
+}
+
 func validationErrorFromDiagnostic(
 	diagnostic *core.Diagnostic,
 	errorReasonCode bperrors.ErrorReasonCode,
@@ -44,6 +149,7 @@ func validationErrorFromDiagnostic(
 		Err:        errors.New(diagnostic.Message),
 		Line:       &line,
 		Column:     &column,
+		Severity:   core.SeverityFromDiagnosticLevel(diagnostic.Level),
 	}
 
 	// Preserve context if available
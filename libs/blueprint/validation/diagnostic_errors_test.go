@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
@@ -88,6 +89,93 @@ func (s *DiagnosticErrorSuite) Test_extracts_diagnostics_and_validation_errors_f
 	s.Assert().Equal(1, *childErr2.Column)
 }
 
+func (s *DiagnosticErrorSuite) Test_converts_warning_diagnostics_to_errors() {
+	input := []*core.Diagnostic{
+		{
+			Level:   core.DiagnosticLevelWarning,
+			Message: "This is a test warning message.",
+			Range: &core.DiagnosticRange{
+				Start: &source.Meta{
+					Position: source.Position{
+						Line:   2,
+						Column: 5,
+					},
+				},
+			},
+			Context: &bperrors.ErrorContext{
+				ReasonCode: bperrors.ErrorReasonCodeAnyTypeWarning,
+			},
+		},
+		{
+			Level:   core.DiagnosticLevelInfo,
+			Message: "This is a test info message.",
+		},
+		{
+			Level:   core.DiagnosticLevelWarning,
+			Message: "This is another test warning message.",
+		},
+	}
+
+	errs := WarningDiagnosticsAsErrors(input, ErrorReasonCodeValidationWarning)
+	s.Require().Len(errs, 2)
+
+	firstErr, isLoadErr := errs[0].(*bperrors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(bperrors.ErrorReasonCodeAnyTypeWarning, firstErr.ReasonCode)
+	s.Assert().Equal(bperrors.ErrorSeverityWarning, firstErr.Severity)
+	s.Assert().Equal(2, *firstErr.Line)
+	s.Assert().Equal(5, *firstErr.Column)
+
+	secondErr, isLoadErr := errs[1].(*bperrors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeValidationWarning, secondErr.ReasonCode)
+	s.Assert().Equal(bperrors.ErrorSeverityWarning, secondErr.Severity)
+}
+
+func (s *DiagnosticErrorSuite) Test_splits_warnings_from_validation_errors() {
+	line := 4
+	column := 3
+	warningErr := &bperrors.LoadError{
+		ReasonCode: ErrorReasonCodeUnusedVariable,
+		Err:        errors.New("variable \"region\" is defined but is never referenced"),
+		Line:       &line,
+		Column:     &column,
+		Severity:   bperrors.ErrorSeverityWarning,
+	}
+	hardErr := &bperrors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidResource,
+		Err:        errors.New("resource \"ordersTable\" is invalid"),
+	}
+
+	diagnostics, err := SplitWarnings(
+		ErrMultipleValidationErrors([]error{warningErr, hardErr}),
+	)
+	s.Require().Len(diagnostics, 1)
+	s.Assert().Equal(core.DiagnosticLevelWarning, diagnostics[0].Level)
+	s.Assert().Equal(
+		"variable \"region\" is defined but is never referenced",
+		diagnostics[0].Message,
+	)
+	s.Assert().Equal(4, diagnostics[0].Range.Start.Line)
+	s.Assert().Equal(ErrorReasonCodeUnusedVariable, diagnostics[0].Context.ReasonCode)
+
+	loadErr, isLoadErr := err.(*bperrors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeMultipleValidationErrors, loadErr.ReasonCode)
+	s.Assert().Equal([]error{hardErr}, loadErr.ChildErrors)
+}
+
+func (s *DiagnosticErrorSuite) Test_split_warnings_returns_nil_error_when_only_warnings_are_present() {
+	diagnostics, err := SplitWarnings(&bperrors.LoadError{
+		ReasonCode: ErrorReasonCodeUnusedValue,
+		Err:        errors.New("value \"tableName\" is defined but is never referenced"),
+		Severity:   bperrors.ErrorSeverityWarning,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(diagnostics, 1)
+	s.Assert().Equal(core.DiagnosticLevelWarning, diagnostics[0].Level)
+}
+
 func TestDiagnosticErrorSuite(t *testing.T) {
 	suite.Run(t, new(DiagnosticErrorSuite))
 }
@@ -0,0 +1,26 @@
+package validation
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// ValidateDuplicateDefinitions reports an error for each element that is
+// defined more than once in the same section of the source document of a blueprint.
+// Each error is reported at the location of the duplicate definition and includes
+// the location of the first definition of the element.
+func ValidateDuplicateDefinitions(bpSchema *schema.Blueprint) error {
+	if bpSchema == nil || len(bpSchema.DuplicateDefinitions) == 0 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(bpSchema.DuplicateDefinitions))
+	for _, duplicate := range bpSchema.DuplicateDefinitions {
+		errs = append(errs, errDuplicateDefinition(duplicate))
+	}
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	return ErrMultipleValidationErrors(errs)
+}
@@ -0,0 +1,81 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/stretchr/testify/suite"
+)
+
+type DuplicateValidationTestSuite struct {
+	suite.Suite
+}
+
+func (s *DuplicateValidationTestSuite) Test_succeeds_for_blueprint_without_duplicates() {
+	err := ValidateDuplicateDefinitions(&schema.Blueprint{})
+	s.Assert().NoError(err)
+}
+
+func (s *DuplicateValidationTestSuite) Test_reports_duplicate_definition_with_both_locations() {
+	err := ValidateDuplicateDefinitions(&schema.Blueprint{
+		DuplicateDefinitions: []*schema.DuplicateDefinition{
+			{
+				Section:   "resources",
+				Name:      "ordersQueue",
+				First:     &source.Meta{Position: source.Position{Line: 8, Column: 3}},
+				Duplicate: &source.Meta{Position: source.Position{Line: 12, Column: 3}},
+			},
+		},
+	})
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeDuplicateDefinition, loadErr.ReasonCode)
+	s.Assert().Equal(
+		"blueprint load error: validation failed due to the resource \"ordersQueue\" being defined more than once, "+
+			"first defined at line 8, column 3 and defined again at line 12, column 3",
+		loadErr.Error(),
+	)
+	s.Assert().Equal(12, *loadErr.Line)
+	s.Assert().Equal(3, *loadErr.Column)
+	s.Require().NotNil(loadErr.Context)
+	s.Require().Len(loadErr.Context.SuggestedActions, 1)
+	s.Assert().Equal(
+		string(errors.ActionTypeRenameElement),
+		loadErr.Context.SuggestedActions[0].Type,
+	)
+	s.Assert().Equal(8, loadErr.Context.Metadata["firstDefinitionLine"])
+	s.Assert().Equal(12, loadErr.Context.Metadata["duplicateDefinitionLine"])
+}
+
+func (s *DuplicateValidationTestSuite) Test_reports_multiple_duplicate_definitions() {
+	err := ValidateDuplicateDefinitions(&schema.Blueprint{
+		DuplicateDefinitions: []*schema.DuplicateDefinition{
+			{
+				Section:   "variables",
+				Name:      "region",
+				First:     &source.Meta{Position: source.Position{Line: 3, Column: 3}},
+				Duplicate: &source.Meta{Position: source.Position{Line: 5, Column: 3}},
+			},
+			{
+				Section:   "datasources",
+				Name:      "network",
+				First:     &source.Meta{Position: source.Position{Line: 10, Column: 3}},
+				Duplicate: &source.Meta{Position: source.Position{Line: 14, Column: 3}},
+			},
+		},
+	})
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeMultipleValidationErrors, loadErr.ReasonCode)
+	s.Require().Len(loadErr.ChildErrors, 2)
+	s.Assert().Contains(loadErr.ChildErrors[0].Error(), "the variable \"region\"")
+	s.Assert().Contains(loadErr.ChildErrors[1].Error(), "the data source \"network\"")
+}
+
+func TestDuplicateValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(DuplicateValidationTestSuite))
+}
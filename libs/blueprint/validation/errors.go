@@ -69,6 +69,22 @@ const (
 	// ErrorReasonCodeResourceDefStringMaxLengthConstraintFailure is provided when the reason
 	// for a blueprint spec load error is due to a maximum length constraint failure for strings.
 	ErrorReasonCodeResourceDefStringMaxLengthConstraintFailure errors.ErrorReasonCode = "resource_def_string_max_length_constraint_failure"
+	// ErrorReasonCodeResourceDefUniqueConstraintFailure is provided when the reason
+	// for a blueprint spec load error is due to multiple resources using the same value
+	// for fields that must have unique values across all resources in a blueprint.
+	ErrorReasonCodeResourceDefUniqueConstraintFailure errors.ErrorReasonCode = "resource_def_unique_constraint_failure"
+	// ErrorReasonCodeResourceDefMissingConditionallyRequiredField is provided when the reason
+	// for a blueprint spec load error is due to a missing field that is required
+	// based on the value of another field in the same object.
+	ErrorReasonCodeResourceDefMissingConditionallyRequiredField errors.ErrorReasonCode = "resource_def_missing_conditionally_required_field"
+	// ErrorReasonCodeResourceDefConflictingFields is provided when the reason
+	// for a blueprint spec load error is due to fields being set together
+	// in the same object when they conflict with each other.
+	ErrorReasonCodeResourceDefConflictingFields errors.ErrorReasonCode = "resource_def_conflicting_fields"
+	// ErrorReasonCodeResourceDefExactlyOneOfConstraintFailure is provided when the reason
+	// for a blueprint spec load error is due to none or more than one of a set of fields
+	// in the same object being set when exactly one of the fields must be set.
+	ErrorReasonCodeResourceDefExactlyOneOfConstraintFailure errors.ErrorReasonCode = "resource_def_exactly_one_of_constraint_failure"
 	// ErrorReasonCodeResourceTypeSpecDefMissing is provided when the reason
 	// for a blueprint spec load error is due to a missing spec definition for a resource.
 	ErrorReasonCodeResourceTypeSpecDefMissing errors.ErrorReasonCode = "resource_type_spec_def_missing"
@@ -161,6 +177,10 @@ const (
 	// for a blueprint spec load error is due to a resource dependency in the "dependsOn"
 	// property not being a valid resource.
 	ErrorReasonCodeMissingResourceDependency errors.ErrorReasonCode = "missing_resource_dependency"
+	// ErrorReasonCodeMissingIncludeDependency is provided when the reason
+	// for a blueprint spec load error is due to an include dependency in the "dependsOn"
+	// property not being a valid include or resource.
+	ErrorReasonCodeMissingIncludeDependency errors.ErrorReasonCode = "missing_include_dependency"
 	// ErrorReasonCodeComputedFieldInBlueprint is provided when the reason
 	// for a blueprint spec load error is due to a computed field being used in a blueprint.
 	// Computed fields are not allowed to be defined in blueprints,
@@ -174,10 +194,44 @@ const (
 	// for a blueprint spec load error is due to the "each" property of a resource
 	// having a dependency on a child blueprint.
 	ErrorReasonCodeEachChildDependency errors.ErrorReasonCode = "each_child_dependency"
+	// ErrorReasonCodeConditionValueDeployedStateDependency is provided when the reason
+	// for a blueprint spec load error is due to the "condition" property of a resource
+	// referencing a value that depends on the deployed state of a resource
+	// or child blueprint.
+	// Values that depend on deployed state can only be resolved once the resources
+	// or child blueprints they depend on have been deployed, whereas conditions must be
+	// resolved before the deployment can be planned.
+	ErrorReasonCodeConditionValueDeployedStateDependency errors.ErrorReasonCode = "condition_value_deployed_state_dependency"
 	// ErrorReasonCodeSubFuncLinkArgResourceNotFound is provided when the reason
 	// for a blueprint spec load error is due to a resource not being found
 	// in an argument to the "link" substitution function.
 	ErrorReasonCodeSubFuncLinkArgResourceNotFound errors.ErrorReasonCode = "sub_func_link_arg_resource_not_found"
+	// ErrorReasonCodeSubFuncInvalidRegexPattern is provided when the reason
+	// for a blueprint spec load error is due to an invalid regular expression
+	// pattern being passed into one of the regular expression substitution functions.
+	ErrorReasonCodeSubFuncInvalidRegexPattern errors.ErrorReasonCode = "sub_func_invalid_regex_pattern"
+	// ErrorReasonCodeSubFuncInvalidBase64String is provided when the reason
+	// for a blueprint spec load error is due to a string literal that is not
+	// valid Base64 being passed into the "base64decode" substitution function.
+	ErrorReasonCodeSubFuncInvalidBase64String errors.ErrorReasonCode = "sub_func_invalid_base64_string"
+	// ErrorReasonCodeSubFuncInconsistentArgTypes is provided when the reason
+	// for a blueprint spec load error is due to the arguments of a fallback
+	// substitution function such as "try" or "coalesce" resolving to different types.
+	ErrorReasonCodeSubFuncInconsistentArgTypes errors.ErrorReasonCode = "sub_func_inconsistent_arg_types"
+	// ErrorReasonCodeSubFuncInvalidElemExpression is provided when the reason
+	// for a blueprint spec load error is due to an element expression passed into
+	// a higher-order substitution function such as "map" or "filter"
+	// resolving to an unexpected type.
+	ErrorReasonCodeSubFuncInvalidElemExpression errors.ErrorReasonCode = "sub_func_invalid_elem_expression"
+	// ErrorReasonCodeInvalidFunction is provided when the reason
+	// for a blueprint spec load error is due to an invalid user-defined function
+	// in the "functions" section of a blueprint or an invalid use of
+	// a parameter of a user-defined function.
+	ErrorReasonCodeInvalidFunction errors.ErrorReasonCode = "invalid_function"
+	// ErrorReasonCodeFunctionCycle is provided when the reason
+	// for a blueprint spec load error is due to user-defined functions
+	// calling each other in a cycle.
+	ErrorReasonCodeFunctionCycle errors.ErrorReasonCode = "function_cycle"
 	// ErrorReasonCodeVariableEmptyDefaultValue is provided when the reason
 	// for a blueprint spec load error is due to an empty default value for a variable.
 	ErrorReasonCodeVariableEmptyDefaultValue errors.ErrorReasonCode = "variable_empty_default_value"
@@ -202,6 +256,10 @@ const (
 	// ErrorReasonCodeVariableValueNotAllowed is provided when the reason
 	// for a blueprint spec load error is due to a variable value not being in the allowed values.
 	ErrorReasonCodeVariableValueNotAllowed errors.ErrorReasonCode = "variable_value_not_allowed"
+	// ErrorReasonCodeVariableInvalidAllowedValuesFrom is provided when the reason
+	// for a blueprint spec load error is due to an invalid data source lookup
+	// definition for the allowed values of a variable.
+	ErrorReasonCodeVariableInvalidAllowedValuesFrom errors.ErrorReasonCode = "variable_invalid_allowed_values_from"
 	// ErrorReasonCodeVariableInvalidSecretValue is provided when the reason
 	// for a blueprint spec load error is due to an invalid secret field value for a variable.
 	ErrorReasonCodeVariableInvalidSecretValue errors.ErrorReasonCode = "variable_invalid_secret_value"
@@ -299,6 +357,64 @@ const (
 	// ErrorReasonCodeInvalidTransformLinks is provided when the reason for a blueprint spec
 	// load error is due to invalid transform links.
 	ErrorReasonCodeInvalidTransformLinks errors.ErrorReasonCode = "invalid_transform_links"
+	// ErrorReasonCodeInvalidHook is provided when the reason for a blueprint spec
+	// load error is due to an invalid hook definition in the hooks section
+	// of a blueprint.
+	ErrorReasonCodeInvalidHook errors.ErrorReasonCode = "invalid_hook"
+	// ErrorReasonCodeInvalidLinkConfig is provided when the reason for a blueprint spec
+	// load error is due to invalid configuration for a link in the links section
+	// of a blueprint.
+	ErrorReasonCodeInvalidLinkConfig errors.ErrorReasonCode = "invalid_link_config"
+	// ErrorReasonCodeInvalidInstanceReference is provided when the reason for a blueprint spec
+	// load error is due to an invalid reference to an export of another deployed
+	// blueprint instance or a reference to an instance or export that does not exist.
+	ErrorReasonCodeInvalidInstanceReference errors.ErrorReasonCode = "invalid_instance_reference"
+	// ErrorReasonCodeUnusedVariable is provided for warnings about a variable
+	// that is defined in a blueprint but is never referenced.
+	ErrorReasonCodeUnusedVariable errors.ErrorReasonCode = "unused_variable"
+	// ErrorReasonCodeUnusedValue is provided for warnings about a value
+	// that is defined in a blueprint but is never referenced.
+	ErrorReasonCodeUnusedValue errors.ErrorReasonCode = "unused_value"
+	// ErrorReasonCodeUnusedDataSource is provided for warnings about a data source
+	// that is defined in a blueprint but is never referenced.
+	ErrorReasonCodeUnusedDataSource errors.ErrorReasonCode = "unused_data_source"
+	// ErrorReasonCodeValidationWarning is provided for warning diagnostics
+	// that are converted to load errors when warnings are treated as errors
+	// and a more specific reason code is not available.
+	ErrorReasonCodeValidationWarning errors.ErrorReasonCode = "validation_warning"
+	// ErrorReasonCodeValidationRuleViolation is provided when a blueprint
+	// violates a custom validation rule.
+	ErrorReasonCodeValidationRuleViolation errors.ErrorReasonCode = "validation_rule_violation"
+	// ErrorReasonCodeValidationRuleFailed is provided when a custom validation rule
+	// fails to run.
+	ErrorReasonCodeValidationRuleFailed errors.ErrorReasonCode = "validation_rule_failed"
+	// ErrorReasonCodeDeprecatedResourceType is provided for warnings about a resource
+	// that uses a resource type that has been deprecated by its provider.
+	ErrorReasonCodeDeprecatedResourceType errors.ErrorReasonCode = "deprecated_resource_type"
+	// ErrorReasonCodeDeprecatedResourceField is provided for warnings about a field
+	// set in a resource spec that has been deprecated by the provider of the resource type.
+	ErrorReasonCodeDeprecatedResourceField errors.ErrorReasonCode = "deprecated_resource_field"
+	// ErrorReasonCodeMaxResourcesExceeded is provided when the reason for a blueprint spec
+	// load error is due to the blueprint defining more resources than the configured limit.
+	ErrorReasonCodeMaxResourcesExceeded errors.ErrorReasonCode = "max_resources_exceeded"
+	// ErrorReasonCodeMaxIncludeDepthExceeded is provided when the reason for a blueprint spec
+	// load error is due to child blueprints being nested deeper than the configured limit.
+	ErrorReasonCodeMaxIncludeDepthExceeded errors.ErrorReasonCode = "max_include_depth_exceeded"
+	// ErrorReasonCodeMaxSubstitutionDepthExceeded is provided when the reason for a blueprint spec
+	// load error is due to a substitution being nested deeper than the configured limit.
+	ErrorReasonCodeMaxSubstitutionDepthExceeded errors.ErrorReasonCode = "max_substitution_depth_exceeded"
+	// ErrorReasonCodeMaxReferenceGraphSizeExceeded is provided when the reason for a blueprint spec
+	// load error is due to the blueprint containing more references between elements
+	// than the configured limit.
+	ErrorReasonCodeMaxReferenceGraphSizeExceeded errors.ErrorReasonCode = "max_reference_graph_size_exceeded"
+	// ErrorReasonCodeInvalidProviderConfig is provided when the reason for a blueprint spec
+	// load error is due to the configuration supplied for a provider used in the blueprint
+	// not matching the config definition of the provider.
+	ErrorReasonCodeInvalidProviderConfig errors.ErrorReasonCode = "invalid_provider_config"
+	// ErrorReasonCodeDuplicateDefinition is provided when the reason for a blueprint spec
+	// load error is due to an element being defined more than once with the same name
+	// in the same section of a blueprint document.
+	ErrorReasonCodeDuplicateDefinition errors.ErrorReasonCode = "duplicate_definition"
 )
 
 func errBlueprintMissingVersion() error {
@@ -706,6 +822,42 @@ func errVariableInvalidAllowedValuesNotSupported(
 	}
 }
 
+func errVariableInvalidAllowedValuesFrom(
+	varName string,
+	reason string,
+	varSourceMeta *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(varSourceMeta)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeVariableInvalidAllowedValuesFrom,
+		Err: fmt.Errorf(
+			"validation failed due to an invalid allowedValuesFrom definition for variable \"%s\": %s",
+			varName,
+			reason,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+		Context: &errors.ErrorContext{
+			Category:   errors.ErrorCategoryVariableType,
+			ReasonCode: ErrorReasonCodeVariableInvalidAllowedValuesFrom,
+			SuggestedActions: []errors.SuggestedAction{
+				{
+					Type:        string(errors.ActionTypeFixVariableType),
+					Title:       "Fix Allowed Values Data Source",
+					Description: "Provide a data source type and field with literal filter values for allowedValuesFrom.",
+					Priority:    1,
+				},
+			},
+			Metadata: map[string]any{
+				"variableName": varName,
+			},
+		},
+	}
+}
+
 func errVariableValueNotAllowed(
 	varType schema.VariableType,
 	varName string,
@@ -1183,6 +1335,7 @@ func errIncludeEmptyPath(includeName string, varSourceMeta *source.Meta) error {
 func errIncludeMissingRequiredVar(
 	includeName string,
 	varName string,
+	childVarLocation string,
 	sourceMeta *source.Meta,
 ) error {
 	posRange := source.PositionRangeFromSourceMeta(sourceMeta)
@@ -1190,15 +1343,17 @@ func errIncludeMissingRequiredVar(
 		ReasonCode: ErrorReasonCodeIncludeMissingRequiredVar,
 		Err: fmt.Errorf(
 			"validation failed due to required variable %q not being provided"+
-				" to include %q",
+				" to include %q%s",
 			varName,
 			includeName,
+			childLocationSuffix("variable defined at", childVarLocation),
 		),
 		Line:           posRange.Line,
 		EndLine:        posRange.EndLine,
 		Column:         posRange.Column,
 		EndColumn:      posRange.EndColumn,
 		ColumnAccuracy: posRange.ColumnAccuracy,
+		Context:        childLocationErrorContext(ErrorReasonCodeIncludeMissingRequiredVar, childVarLocation),
 	}
 }
 
@@ -1207,6 +1362,7 @@ func errIncludeVarTypeMismatch(
 	varName string,
 	actualType string,
 	expectedType string,
+	childVarLocation string,
 	sourceMeta *source.Meta,
 ) error {
 	posRange := source.PositionRangeFromSourceMeta(sourceMeta)
@@ -1214,17 +1370,19 @@ func errIncludeVarTypeMismatch(
 		ReasonCode: ErrorReasonCodeIncludeVarTypeMismatch,
 		Err: fmt.Errorf(
 			"validation failed due to variable %q provided to include %q"+
-				" having type %q, but the child blueprint expects type %q",
+				" having type %q, but the child blueprint expects type %q%s",
 			varName,
 			includeName,
 			actualType,
 			expectedType,
+			childLocationSuffix("variable defined at", childVarLocation),
 		),
 		Line:           posRange.Line,
 		EndLine:        posRange.EndLine,
 		Column:         posRange.Column,
 		EndColumn:      posRange.EndColumn,
 		ColumnAccuracy: posRange.ColumnAccuracy,
+		Context:        childLocationErrorContext(ErrorReasonCodeIncludeVarTypeMismatch, childVarLocation),
 	}
 }
 
@@ -1670,6 +1828,78 @@ func errSubFuncInvalidNumberOfArgs(
 	}
 }
 
+func errSubFuncElemExpressionInvalidType(
+	funcName string,
+	expectedType string,
+	resolveType string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeSubFuncInvalidElemExpression,
+		Err: fmt.Errorf(
+			"validation failed due to the element expression passed into substitution function \"%s\" "+
+				"resolving to type %q, the element expression is expected to resolve to %s for each item",
+			funcName,
+			resolveType,
+			expectedType,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errSubFuncFallbackMissingArgs(
+	subFunc *substitutions.SubstitutionFunctionExpr,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(subFunc.SourceMeta)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidSubstitution,
+		Err: fmt.Errorf(
+			"validation failed due to no arguments being provided for substitution function \"%s\", "+
+				"at least one argument is expected",
+			subFunc.FunctionName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errSubFuncFallbackInconsistentArgTypes(
+	subFunc *substitutions.SubstitutionFunctionExpr,
+	expectedArgIndex int,
+	expectedType string,
+	argIndex int,
+	argType string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeSubFuncInconsistentArgTypes,
+		Err: fmt.Errorf(
+			"validation failed due to inconsistent argument types for substitution function \"%s\", "+
+				"all arguments must resolve to the same type, the argument at position %d resolves to %s "+
+				"but the argument at position %d resolves to %s",
+			subFunc.FunctionName,
+			expectedArgIndex,
+			expectedType,
+			argIndex,
+			argType,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
 func errSubFuncArgTypeMismatch(
 	argIndex int,
 	expectedType string,
@@ -1749,8 +1979,31 @@ func errSubFailedToLoadFunctionDefintion(
 	funcName string,
 	location *source.Meta,
 	errInfo string,
+	suggestions []string,
 ) error {
 	posRange := source.PositionRangeFromSourceMeta(location)
+	var errContext *errors.ErrorContext
+	if len(suggestions) > 0 {
+		errContext = &errors.ErrorContext{
+			Category:   errors.ErrorCategoryFunction,
+			ReasonCode: ErrorReasonCodeInvalidSubstitution,
+			SuggestedActions: []errors.SuggestedAction{
+				{
+					Type:        string(errors.ActionTypeCheckFunctionName),
+					Title:       "Check Function Name",
+					Description: "Verify the function name is correct (case-sensitive)",
+					Priority:    1,
+				},
+			},
+			Metadata: withSuggestions(
+				map[string]any{
+					"functionName": funcName,
+				},
+				suggestions,
+			),
+		}
+	}
+
 	return &errors.LoadError{
 		ReasonCode: ErrorReasonCodeInvalidSubstitution,
 		Err: fmt.Errorf(
@@ -1763,9 +2016,19 @@ func errSubFailedToLoadFunctionDefintion(
 		Column:         posRange.Column,
 		EndColumn:      posRange.EndColumn,
 		ColumnAccuracy: posRange.ColumnAccuracy,
+		Context:        errContext,
 	}
 }
 
+// Adds "did you mean" suggestions to the metadata of an error context
+// so the CLI and language server can render hints for the user.
+func withSuggestions(metadata map[string]any, suggestions []string) map[string]any {
+	if len(suggestions) > 0 {
+		metadata["suggestions"] = suggestions
+	}
+	return metadata
+}
+
 func errSubVarNotFound(
 	varName string,
 	location *source.Meta,
@@ -2944,6 +3207,7 @@ func errInvalidValueSubType(
 	usedIn string,
 	resolvedType string,
 	expectedResolvedType string,
+	typeChain []string,
 	location *source.Meta,
 ) error {
 	posRange := source.PositionRangeFromSourceMeta(location)
@@ -2951,10 +3215,11 @@ func errInvalidValueSubType(
 		ReasonCode: ErrorReasonCodeInvalidValue,
 		Err: fmt.Errorf(
 			"validation failed due to an invalid substitution found in %q, "+
-				"resolved type %q is not supported by value of type %q",
+				"resolved type %q is not supported by value of type %q%s",
 			usedIn,
 			resolvedType,
 			expectedResolvedType,
+			typeChainInfo(typeChain, resolvedType),
 		),
 		Line:           posRange.Line,
 		EndLine:        posRange.EndLine,
@@ -2964,6 +3229,21 @@ func errInvalidValueSubType(
 	}
 }
 
+// Renders the chain of references followed to resolve the type of
+// a substitution for error messages, only chains that follow at least one
+// reference are rendered.
+func typeChainInfo(typeChain []string, resolvedType string) string {
+	if len(typeChain) < 2 {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		", the type was resolved through %s (%s)",
+		strings.Join(typeChain, " -> "),
+		resolvedType,
+	)
+}
+
 func errInvalidValueContentType(
 	valIdentifier string,
 	resolvedSubType string,
@@ -3148,6 +3428,7 @@ func errDataSourceExportTypeMissing(
 func errDataSourceTypeNotSupported(
 	dataSourceName string,
 	dataSourceType string,
+	suggestions []string,
 	wrapperLocation *source.Meta,
 ) error {
 	posRange := source.PositionRangeFromSourceMeta(wrapperLocation)
@@ -3182,11 +3463,14 @@ func errDataSourceTypeNotSupported(
 					Priority:    2,
 				},
 			},
-			Metadata: map[string]any{
-				"providerNamespace": providerNamespace,
-				"dataSourceName":    dataSourceName,
-				"dataSourceType":    dataSourceType,
-			},
+			Metadata: withSuggestions(
+				map[string]any{
+					"providerNamespace": providerNamespace,
+					"dataSourceName":    dataSourceName,
+					"dataSourceType":    dataSourceType,
+				},
+				suggestions,
+			),
 		},
 	}
 }
@@ -3216,6 +3500,7 @@ func errDataSourceAnnotationKeyContainsSubstitution(
 func errResourceTypeNotSupported(
 	resourceName string,
 	resourceType string,
+	suggestions []string,
 	wrapperLocation *source.Meta,
 ) error {
 	posRange := source.PositionRangeFromSourceMeta(wrapperLocation)
@@ -3250,12 +3535,15 @@ func errResourceTypeNotSupported(
 					Priority:    2,
 				},
 			},
-			Metadata: map[string]any{
-				"providerNamespace": pluginNamespace,
-				"resourceName":      resourceName,
-				"resourceType":      resourceType,
-				"category":          "resource",
-			},
+			Metadata: withSuggestions(
+				map[string]any{
+					"providerNamespace": pluginNamespace,
+					"resourceName":      resourceName,
+					"resourceType":      resourceType,
+					"category":          "resource",
+				},
+				suggestions,
+			),
 		},
 	}
 }
@@ -3422,6 +3710,99 @@ func errInvalidResourceRemovalPolicy(
 	}
 }
 
+func errInvalidResourceReplaceStrategy(
+	resourceName string,
+	value string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidResource,
+		Err: fmt.Errorf(
+			"validation failed due to an invalid replace strategy value %q for resource %q, "+
+				"the replaceStrategy field must be a static literal, one of "+
+				"\"createBeforeDestroy\" or \"destroyBeforeCreate\"",
+			value,
+			resourceName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errResourceReplaceStrategyConflictsWithUniqueName(
+	resourceName string,
+	resourceType string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidResource,
+		Err: fmt.Errorf(
+			"validation failed as the \"createBeforeDestroy\" replace strategy can not be used for resource %q, "+
+				"resources of type %q have a unique name that can not be shared by the existing resource "+
+				"and its replacement, the resource must be destroyed before it is created again",
+			resourceName,
+			resourceType,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errInvalidResourceTimeout(
+	resourceName string,
+	operation string,
+	value string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidResource,
+		Err: fmt.Errorf(
+			"validation failed due to an invalid %s timeout %q for resource %q, "+
+				"timeouts must be static duration strings greater than zero such as \"30s\", \"10m\" or \"1h30m\"",
+			operation,
+			value,
+			resourceName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errInvalidResourceIgnoreChangesPath(
+	resourceName string,
+	path string,
+	reason string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidResource,
+		Err: fmt.Errorf(
+			"validation failed due to an invalid ignoreChanges path %q for resource %q, %s",
+			path,
+			resourceName,
+			reason,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
 func errAnnotationKeyContainsSubstitution(
 	resourceName string,
 	annotationKey string,
@@ -3553,19 +3934,19 @@ func errSelfReferencingResourceDependency(
 	}
 }
 
-func errComputedFieldDefinedInBlueprint(
-	path string,
-	resourceName string,
+func errIncludeDependencyMissing(
+	includeName string,
+	dependencyName string,
 	location *source.Meta,
 ) error {
 	posRange := source.PositionRangeFromSourceMeta(location)
 	return &errors.LoadError{
-		ReasonCode: ErrorReasonCodeComputedFieldInBlueprint,
+		ReasonCode: ErrorReasonCodeMissingIncludeDependency,
 		Err: fmt.Errorf(
-			"validation failed due to %q being a computed field defined in the blueprint for resource %q, "+
-				"this field is computed by the provider after the resource has been created",
-			path,
-			resourceName,
+			"validation failed due to a missing dependency %q for include %q, "+
+				"the dependency must be an include or resource in the same blueprint",
+			dependencyName,
+			includeName,
 		),
 		Line:           posRange.Line,
 		EndLine:        posRange.EndLine,
@@ -3575,18 +3956,19 @@ func errComputedFieldDefinedInBlueprint(
 	}
 }
 
-func errEachResourceDependencyDetected(
-	resourceIDWithEachProp string,
+func errIncludeDependencyAmbiguous(
+	includeName string,
 	dependencyName string,
 	location *source.Meta,
 ) error {
 	posRange := source.PositionRangeFromSourceMeta(location)
 	return &errors.LoadError{
-		ReasonCode: ErrorReasonCodeEachResourceDependency,
+		ReasonCode: ErrorReasonCodeInvalidInclude,
 		Err: fmt.Errorf(
-			"validation failed due to a resource %q having a direct or transitive dependency %q in the each property, "+
-				"the each property can not depend on resources",
-			resourceIDWithEachProp,
+			"validation failed due to an ambiguous dependency %q for include %q, "+
+				"%q is the name of both an include and a resource in the same blueprint",
+			dependencyName,
+			includeName,
 			dependencyName,
 		),
 		Line:           posRange.Line,
@@ -3597,19 +3979,20 @@ func errEachResourceDependencyDetected(
 	}
 }
 
-func errEachChildDependencyDetected(
-	resourceIDWithEachProp string,
+func errIncludeDependencyContainsSubstitution(
+	includeName string,
 	dependencyName string,
 	location *source.Meta,
 ) error {
 	posRange := source.PositionRangeFromSourceMeta(location)
 	return &errors.LoadError{
-		ReasonCode: ErrorReasonCodeEachChildDependency,
+		ReasonCode: ErrorReasonCodeInvalidInclude,
 		Err: fmt.Errorf(
-			"validation failed due to a resource %q having a direct or transitive dependency "+
-				"on a child blueprint %q in the each property, "+
-				"the each property can not depend on child blueprints",
-			resourceIDWithEachProp,
+			"validation failed due to a dependency %q containing a substitution in include %q, "+
+				"the dependency name %q can not contain substitutions and must be an include "+
+				"or resource in the same blueprint",
+			dependencyName,
+			includeName,
 			dependencyName,
 		),
 		Line:           posRange.Line,
@@ -3620,21 +4003,16 @@ func errEachChildDependencyDetected(
 	}
 }
 
-func errSubFuncLinkArgResourceNotFound(
-	resourceName string,
-	argIndex int,
-	usedIn string,
+func errSelfReferencingIncludeDependency(
+	includeName string,
 	location *source.Meta,
 ) error {
 	posRange := source.PositionRangeFromSourceMeta(location)
 	return &errors.LoadError{
-		ReasonCode: ErrorReasonCodeSubFuncLinkArgResourceNotFound,
+		ReasonCode: ErrorReasonCodeInvalidInclude,
 		Err: fmt.Errorf(
-			"validation failed due to a missing resource %q being referenced in the link function"+
-				" call argument at position %d in %q",
-			resourceName,
-			argIndex,
-			usedIn,
+			"validation failed due to a self-referencing dependency in include %q",
+			includeName,
 		),
 		Line:           posRange.Line,
 		EndLine:        posRange.EndLine,
@@ -3644,12 +4022,181 @@ func errSubFuncLinkArgResourceNotFound(
 	}
 }
 
-func deriveElemRefTypeLabel(elemRefType string) string {
-	switch elemRefType {
-	case "index":
-		return "element index"
-	default:
-		return "element"
+func errComputedFieldDefinedInBlueprint(
+	path string,
+	resourceName string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeComputedFieldInBlueprint,
+		Err: fmt.Errorf(
+			"validation failed due to %q being a computed field defined in the blueprint for resource %q, "+
+				"this field is computed by the provider after the resource has been created",
+			path,
+			resourceName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errEachResourceDependencyDetected(
+	resourceIDWithEachProp string,
+	dependencyName string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeEachResourceDependency,
+		Err: fmt.Errorf(
+			"validation failed due to a resource %q having a direct or transitive dependency %q in the each property, "+
+				"the each property can not depend on resources",
+			resourceIDWithEachProp,
+			dependencyName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errEachChildDependencyDetected(
+	resourceIDWithEachProp string,
+	dependencyName string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeEachChildDependency,
+		Err: fmt.Errorf(
+			"validation failed due to a resource %q having a direct or transitive dependency "+
+				"on a child blueprint %q in the each property, "+
+				"the each property can not depend on child blueprints",
+			resourceIDWithEachProp,
+			dependencyName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errConditionValueDeployedStateDependency(
+	resourceIDWithCondition string,
+	valueName string,
+	dependencyName string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeConditionValueDeployedStateDependency,
+		Err: fmt.Errorf(
+			"validation failed due to a resource %q referencing the value %q in the condition property "+
+				"that has a direct or transitive dependency on %q, "+
+				"the condition property can not reference values that depend on the deployed state of "+
+				"resources or child blueprints",
+			resourceIDWithCondition,
+			valueName,
+			dependencyName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errSubFuncLinkArgResourceNotFound(
+	resourceName string,
+	argIndex int,
+	usedIn string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeSubFuncLinkArgResourceNotFound,
+		Err: fmt.Errorf(
+			"validation failed due to a missing resource %q being referenced in the link function"+
+				" call argument at position %d in %q",
+			resourceName,
+			argIndex,
+			usedIn,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errSubFuncInvalidRegexPattern(
+	funcName string,
+	argIndex int,
+	usedIn string,
+	compileErr error,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeSubFuncInvalidRegexPattern,
+		Err: fmt.Errorf(
+			"validation failed due to an invalid pattern being passed into the %q function"+
+				" call argument at position %d in %q, %s",
+			funcName,
+			argIndex,
+			usedIn,
+			compileErr.Error(),
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errSubFuncInvalidBase64String(
+	funcName string,
+	argIndex int,
+	usedIn string,
+	decodeErr error,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeSubFuncInvalidBase64String,
+		Err: fmt.Errorf(
+			"validation failed due to an invalid Base64-encoded string being passed into the %q function"+
+				" call argument at position %d in %q, %s",
+			funcName,
+			argIndex,
+			usedIn,
+			decodeErr.Error(),
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func deriveElemRefTypeLabel(elemRefType string) string {
+	switch elemRefType {
+	case "index":
+		return "element index"
+	default:
+		return "element"
 	}
 }
 
@@ -3747,9 +4294,12 @@ func errIncludePathNotFound(
 
 // ErrChildExportNotFound returns an error when a referenced export is not found
 // in a resolved child blueprint.
+// The child location is the path or URL of the child blueprint document
+// that was resolved, this can be empty when the location is not known.
 func ErrChildExportNotFound(
 	childName string,
 	exportName string,
+	childLocation string,
 	location *source.Meta,
 ) error {
 	posRange := source.PositionRangeFromSourceMeta(location)
@@ -3757,15 +4307,41 @@ func ErrChildExportNotFound(
 		ReasonCode: ErrorReasonCodeChildExportNotFound,
 		Err: fmt.Errorf(
 			"validation failed due to export %q not being found"+
-				" in child blueprint %q",
+				" in child blueprint %q%s",
 			exportName,
 			childName,
+			childLocationSuffix("child blueprint loaded from", childLocation),
 		),
 		Line:           posRange.Line,
 		EndLine:        posRange.EndLine,
 		Column:         posRange.Column,
 		EndColumn:      posRange.EndColumn,
 		ColumnAccuracy: posRange.ColumnAccuracy,
+		Context:        childLocationErrorContext(ErrorReasonCodeChildExportNotFound, childLocation),
+	}
+}
+
+func childLocationSuffix(label string, childLocation string) string {
+	if childLocation == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(" (%s %s)", label, childLocation)
+}
+
+func childLocationErrorContext(
+	reasonCode errors.ErrorReasonCode,
+	childLocation string,
+) *errors.ErrorContext {
+	if childLocation == "" {
+		return nil
+	}
+
+	return &errors.ErrorContext{
+		ReasonCode: reasonCode,
+		Metadata: map[string]any{
+			"childBlueprintLocation": childLocation,
+		},
 	}
 }
 
@@ -3864,3 +4440,600 @@ func errSubFuncPathFieldOnNonObject(
 		ColumnAccuracy: posRange.ColumnAccuracy,
 	}
 }
+
+func errInvalidHookEvent(
+	hookIndex int,
+	event string,
+	location *source.Meta,
+) error {
+	validHookEvents := strings.Join(
+		core.Map(
+			schema.HookEvents,
+			func(hookEvent schema.HookEvent, index int) string {
+				return string(hookEvent)
+			},
+		),
+		", ",
+	)
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidHook,
+		Err: fmt.Errorf(
+			"validation failed due to an unsupported event %q being provided for hook %d, "+
+				"the following hook events are supported: %s",
+			event,
+			hookIndex,
+			validHookEvents,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errMissingHookCommand(
+	hookIndex int,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidHook,
+		Err: fmt.Errorf(
+			"validation failed due to a missing command for hook %d, "+
+				"a non-empty \"run\" command must be provided for each hook",
+			hookIndex,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errHookResourceNotAllowed(
+	hookIndex int,
+	event string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidHook,
+		Err: fmt.Errorf(
+			"validation failed due to a resource being provided for hook %d with the %q event, "+
+				"a resource can only be provided for the beforeResourceDeploy "+
+				"and afterResourceDeploy events",
+			hookIndex,
+			event,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errHookResourceNotFound(
+	hookIndex int,
+	resourceName string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidHook,
+		Err: fmt.Errorf(
+			"validation failed due to resource %q provided for hook %d "+
+				"not being defined in the blueprint",
+			resourceName,
+			hookIndex,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errLinkConfigLinkNotFound(
+	linkName string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidLinkConfig,
+		Err: fmt.Errorf(
+			"validation failed due to configuration being provided for link %q "+
+				"that does not exist in the blueprint, link names must be in the format "+
+				"\"{resourceA}::{resourceB}\" where resourceA selects resourceB by label",
+			linkName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errLinkConfigNotSupported(
+	linkName string,
+	linkType string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidLinkConfig,
+		Err: fmt.Errorf(
+			"validation failed due to configuration being provided for link %q, "+
+				"the %q link type does not support configuration",
+			linkName,
+			linkType,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errLinkConfigContainsSubstitution(
+	linkName string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidLinkConfig,
+		Err: fmt.Errorf(
+			"validation failed due to the configuration for link %q containing a substitution, "+
+				"link configuration can only contain literal values",
+			linkName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errInvalidInstanceReference(
+	value string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidInstanceReference,
+		Err: fmt.Errorf(
+			"validation failed due to an invalid instance reference %q, "+
+				"instance references must be in the form "+
+				"\"bluelink://instance/{instanceName}/exports/{exportName}\"",
+			value,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errInstanceReferenceNotFound(
+	ref *substitutions.InstanceExportReference,
+	instanceExists bool,
+	location *source.Meta,
+) error {
+	reason := fmt.Sprintf(
+		"the export %q does not exist in the %q blueprint instance",
+		ref.ExportName,
+		ref.InstanceName,
+	)
+	if !instanceExists {
+		reason = fmt.Sprintf(
+			"the %q blueprint instance does not exist",
+			ref.InstanceName,
+		)
+	}
+
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidInstanceReference,
+		Err: fmt.Errorf(
+			"validation failed due to the instance reference %q not being resolvable, %s",
+			ref.String(),
+			reason,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func warnUnusedVariable(varName string, location *source.Meta) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeUnusedVariable,
+		Err: fmt.Errorf(
+			"variable %q is defined but is never referenced in the blueprint",
+			varName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+		Severity:       errors.ErrorSeverityWarning,
+	}
+}
+
+func warnUnusedValue(valName string, location *source.Meta) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeUnusedValue,
+		Err: fmt.Errorf(
+			"value %q is defined but is never referenced in the blueprint",
+			valName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+		Severity:       errors.ErrorSeverityWarning,
+	}
+}
+
+func warnUnusedDataSource(dataSourceName string, location *source.Meta) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeUnusedDataSource,
+		Err: fmt.Errorf(
+			"data source %q is defined but is never referenced in the blueprint",
+			dataSourceName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+		Severity:       errors.ErrorSeverityWarning,
+	}
+}
+
+func errValidationRuleFailed(ruleName string, err error) error {
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeValidationRuleFailed,
+		Err: fmt.Errorf(
+			"validation failed due to the custom validation rule %q failing to run: %s",
+			ruleName,
+			err.Error(),
+		),
+	}
+}
+
+func errMaxResourcesExceeded(resourceCount int, maxResources int) error {
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeMaxResourcesExceeded,
+		Err: fmt.Errorf(
+			"validation failed due to the blueprint defining %d resources,"+
+				" the maximum number of resources allowed in a blueprint is %d",
+			resourceCount,
+			maxResources,
+		),
+	}
+}
+
+func errMaxIncludeDepthExceeded(
+	includeDepth int,
+	maxIncludeDepth int,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeMaxIncludeDepthExceeded,
+		Err: fmt.Errorf(
+			"validation failed due to child blueprints being included at a depth of %d,"+
+				" the maximum include depth allowed is %d",
+			includeDepth,
+			maxIncludeDepth,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errMaxSubstitutionDepthExceeded(
+	depth int,
+	maxDepth int,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeMaxSubstitutionDepthExceeded,
+		Err: fmt.Errorf(
+			"validation failed due to a substitution with a nesting depth of %d,"+
+				" the maximum substitution depth allowed is %d",
+			depth,
+			maxDepth,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errMaxReferenceGraphSizeExceeded(size int, maxSize int) error {
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeMaxReferenceGraphSizeExceeded,
+		Err: fmt.Errorf(
+			"validation failed due to the blueprint containing %d references between elements,"+
+				" the maximum number of references allowed is %d",
+			size,
+			maxSize,
+		),
+	}
+}
+
+func errInvalidProviderConfig(
+	providerNamespace string,
+	message string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidProviderConfig,
+		Err: fmt.Errorf(
+			"validation failed due to invalid provider configuration: %s",
+			message,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+		Context: &errors.ErrorContext{
+			Category:   errors.ErrorCategoryProvider,
+			ReasonCode: ErrorReasonCodeInvalidProviderConfig,
+			SuggestedActions: []errors.SuggestedAction{
+				{
+					Type:  string(errors.ActionTypeCheckConfiguration),
+					Title: "Check Provider Configuration",
+					Description: fmt.Sprintf(
+						"Update the configuration supplied for the %q provider "+
+							"to match the provider's config definition.",
+						providerNamespace,
+					),
+					Priority: 1,
+				},
+			},
+			Metadata: map[string]any{
+				"providerNamespace": providerNamespace,
+			},
+		},
+	}
+}
+
+func errDuplicateDefinition(duplicate *schema.DuplicateDefinition) error {
+	elementType := duplicateDefinitionElementType(duplicate.Section)
+	firstLine, firstCol := duplicateDefinitionPosition(duplicate.First)
+	duplicateLine, duplicateCol := duplicateDefinitionPosition(duplicate.Duplicate)
+	posRange := source.PositionRangeFromSourceMeta(duplicate.Duplicate)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeDuplicateDefinition,
+		Err: fmt.Errorf(
+			"validation failed due to the %s %q being defined more than once, "+
+				"first defined at line %d, column %d and defined again at line %d, column %d",
+			elementType,
+			duplicate.Name,
+			firstLine,
+			firstCol,
+			duplicateLine,
+			duplicateCol,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+		Context: &errors.ErrorContext{
+			ReasonCode: ErrorReasonCodeDuplicateDefinition,
+			SuggestedActions: []errors.SuggestedAction{
+				{
+					Type:  string(errors.ActionTypeRenameElement),
+					Title: "Rename Duplicate Definition",
+					Description: fmt.Sprintf(
+						"Rename one of the definitions of the %s %q or remove the duplicate definition, "+
+							"only the last definition is used.",
+						elementType,
+						duplicate.Name,
+					),
+					Priority: 1,
+				},
+			},
+			Metadata: map[string]any{
+				"elementType":               elementType,
+				"elementName":               duplicate.Name,
+				"firstDefinitionLine":       firstLine,
+				"firstDefinitionColumn":     firstCol,
+				"duplicateDefinitionLine":   duplicateLine,
+				"duplicateDefinitionColumn": duplicateCol,
+			},
+		},
+	}
+}
+
+func duplicateDefinitionElementType(section string) string {
+	switch section {
+	case "variables":
+		return "variable"
+	case "values":
+		return "value"
+	case "functions":
+		return "function"
+	case "include":
+		return "include"
+	case "datasources":
+		return "data source"
+	case "exports":
+		return "export"
+	default:
+		return "resource"
+	}
+}
+
+func duplicateDefinitionPosition(location *source.Meta) (int, int) {
+	if location == nil {
+		return 0, 0
+	}
+
+	return location.Line, location.Column
+}
+
+func errFunctionInvalidName(funcName string, location *source.Meta) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidFunction,
+		Err: fmt.Errorf(
+			"validation failed due to the function name %q not being valid, "+
+				"function names must be valid substitution identifiers and can not be "+
+				"a substitution keyword such as \"variables\" or \"elem\"",
+			funcName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errFunctionNameConflict(funcName string, location *source.Meta) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidFunction,
+		Err: fmt.Errorf(
+			"validation failed due to the user-defined function %q having the same name "+
+				"as a core or provider function",
+			funcName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errFunctionInvalidParameterName(
+	funcName string,
+	paramName string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidFunction,
+		Err: fmt.Errorf(
+			"validation failed due to the parameter name %q of function %q not being valid, "+
+				"parameter names must be valid substitution identifiers and can not be "+
+				"a substitution keyword such as \"variables\" or \"elem\"",
+			paramName,
+			funcName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errFunctionDuplicateParameter(
+	funcName string,
+	paramName string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidFunction,
+		Err: fmt.Errorf(
+			"validation failed due to the parameter %q being defined more than once for function %q",
+			paramName,
+			funcName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errFunctionInvalidValue(funcName string, location *source.Meta) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidFunction,
+		Err: fmt.Errorf(
+			"validation failed due to the value of function %q not being a single substitution, "+
+				"the value of a function must be a single ${..} substitution without any surrounding text",
+			funcName,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errFunctionCycle(cycle []string, location *source.Meta) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeFunctionCycle,
+		Err: fmt.Errorf(
+			"validation failed due to a cycle between user-defined functions: %s, "+
+				"functions can not call themselves directly or indirectly",
+			strings.Join(cycle, " -> "),
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
+
+func errFunctionPathNotSupported(
+	funcName string,
+	paramName string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	target := fmt.Sprintf("the result of function %q", funcName)
+	if paramName != "" {
+		target = fmt.Sprintf("the argument passed in for parameter %q of function %q", paramName, funcName)
+	}
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeInvalidFunction,
+		Err: fmt.Errorf(
+			"validation failed due to a property path being used to access %s, "+
+				"property paths can only be used with references and function calls",
+			target,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+	}
+}
@@ -2,6 +2,7 @@ package validation
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
@@ -504,6 +505,158 @@ func errResourceDefStringMaxLengthConstraintFailure(
 	}
 }
 
+func errResourceDefUniqueConstraintFailure(
+	path string,
+	resourceType string,
+	uniqueGroup string,
+	value *core.ScalarValue,
+	conflictingPath string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeResourceDefUniqueConstraintFailure,
+		Err: fmt.Errorf(
+			"validation failed due to a value at path %q that must be unique "+
+				"but is also used at path %q, the value %q can only be used "+
+				"once for fields in the %q unique group",
+			path,
+			conflictingPath,
+			value.ToString(),
+			uniqueGroup,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+		Context: createResourceDefErrorContext(
+			ErrorReasonCodeResourceDefUniqueConstraintFailure,
+			resourceType,
+			map[string]any{
+				"path":            path,
+				"conflictingPath": conflictingPath,
+				"uniqueGroup":     uniqueGroup,
+			},
+		),
+	}
+}
+
+func errResourceDefMissingConditionallyRequiredField(
+	path string,
+	resourceType string,
+	field string,
+	condition *provider.ResourceDefinitionsCondition,
+	location *source.Meta,
+) error {
+	line, col := source.PositionFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeResourceDefMissingConditionallyRequiredField,
+		Err: fmt.Errorf(
+			"validation failed due to a missing field %q at path %q that is required when %s",
+			field,
+			path,
+			describeResourceDefCondition(condition),
+		),
+		Line:   line,
+		Column: col,
+		Context: createResourceDefErrorContext(
+			ErrorReasonCodeResourceDefMissingConditionallyRequiredField,
+			resourceType,
+			map[string]any{
+				"path":           path,
+				"missingField":   field,
+				"conditionField": condition.Field,
+			},
+		),
+	}
+}
+
+func describeResourceDefCondition(condition *provider.ResourceDefinitionsCondition) string {
+	if len(condition.Values) == 0 {
+		return fmt.Sprintf("%q is set", condition.Field)
+	}
+
+	return fmt.Sprintf(
+		"%q is set to one of the following values: %s",
+		condition.Field,
+		mappingNodesToCommaSeparatedString(condition.Values),
+	)
+}
+
+func errResourceDefConflictingFields(
+	path string,
+	resourceType string,
+	field string,
+	conflictingField string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeResourceDefConflictingFields,
+		Err: fmt.Errorf(
+			"validation failed due to conflicting fields at path %q, "+
+				"%q can not be set when %q is set",
+			path,
+			field,
+			conflictingField,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+		Context: createResourceDefErrorContext(
+			ErrorReasonCodeResourceDefConflictingFields,
+			resourceType,
+			map[string]any{
+				"path":             path,
+				"field":            field,
+				"conflictingField": conflictingField,
+			},
+		),
+	}
+}
+
+func errResourceDefExactlyOneOfConstraintFailure(
+	path string,
+	resourceType string,
+	fields []string,
+	setFields []string,
+	location *source.Meta,
+) error {
+	posRange := source.PositionRangeFromSourceMeta(location)
+	setFieldsText := "none of the fields are set"
+	if len(setFields) > 0 {
+		setFieldsText = fmt.Sprintf("found %s set", strings.Join(setFields, ", "))
+	}
+
+	return &errors.LoadError{
+		ReasonCode: ErrorReasonCodeResourceDefExactlyOneOfConstraintFailure,
+		Err: fmt.Errorf(
+			"validation failed at path %q as exactly one of the fields %s "+
+				"must be set, %s",
+			path,
+			strings.Join(fields, ", "),
+			setFieldsText,
+		),
+		Line:           posRange.Line,
+		EndLine:        posRange.EndLine,
+		Column:         posRange.Column,
+		EndColumn:      posRange.EndColumn,
+		ColumnAccuracy: posRange.ColumnAccuracy,
+		Context: createResourceDefErrorContext(
+			ErrorReasonCodeResourceDefExactlyOneOfConstraintFailure,
+			resourceType,
+			map[string]any{
+				"path":      path,
+				"fields":    fields,
+				"setFields": setFields,
+			},
+		),
+	}
+}
+
 func formatNumberOfItems(
 	numberOfItems int,
 	singularItemName string,
@@ -0,0 +1,601 @@
+package validation
+
+import (
+	"context"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+// ValidateFunctions validates the user-defined functions section of a blueprint
+// along with every call to a user-defined function in the blueprint.
+// This ensures that function names do not clash with functions provided by
+// the function registry, that parameter names are unique and can be referenced
+// in a substitution, that the value of each function is a single substitution,
+// that functions do not call each other in a cycle and that each call passes
+// exactly one argument for each parameter of the function.
+//
+// This must be called before ExpandFunctionCalls, calls to user-defined functions
+// are only expanded for blueprints that pass validation.
+func ValidateFunctions(
+	ctx context.Context,
+	bpSchema *schema.Blueprint,
+	funcRegistry provider.FunctionRegistry,
+) error {
+	if bpSchema.Functions == nil || len(bpSchema.Functions.Values) == 0 {
+		return nil
+	}
+
+	errs := []error{}
+	for _, funcName := range sortedKeys(bpSchema.Functions.Values) {
+		function := bpSchema.Functions.Values[funcName]
+		funcErrs, err := validateFunctionDefinition(ctx, funcName, function, bpSchema.Functions, funcRegistry)
+		if err != nil {
+			return err
+		}
+		errs = append(errs, funcErrs...)
+	}
+
+	errs = append(errs, checkFunctionCycles(bpSchema.Functions)...)
+
+	forEachStringOrSubstitutions(bpSchema, func(stringOrSubs *substitutions.StringOrSubstitutions) {
+		errs = append(errs, checkFunctionCallArgCounts(stringOrSubs, bpSchema.Functions)...)
+	})
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	if len(errs) > 1 {
+		return ErrMultipleValidationErrors(errs)
+	}
+
+	return nil
+}
+
+func validateFunctionDefinition(
+	ctx context.Context,
+	funcName string,
+	function *schema.Function,
+	functions *schema.FunctionMap,
+	funcRegistry provider.FunctionRegistry,
+) ([]error, error) {
+	location := functions.SourceMeta[funcName]
+	if !substitutions.NamePattern.MatchString(funcName) || substitutions.IsReservedWord(funcName) {
+		return []error{errFunctionInvalidName(funcName, location)}, nil
+	}
+
+	conflicts, err := funcRegistry.HasFunction(ctx, funcName)
+	if err != nil {
+		return nil, err
+	}
+	if conflicts {
+		return []error{errFunctionNameConflict(funcName, location)}, nil
+	}
+
+	if function == nil {
+		return []error{errFunctionInvalidValue(funcName, location)}, nil
+	}
+
+	errs := checkFunctionParameters(funcName, function)
+
+	if !isFunctionValueValid(function.Value) {
+		errs = append(
+			errs,
+			errFunctionInvalidValue(funcName, functionFieldLocation(function.Value, location)),
+		)
+		return errs, nil
+	}
+
+	errs = append(errs, checkFunctionCallArgCounts(function.Value, functions)...)
+	return errs, nil
+}
+
+func checkFunctionParameters(funcName string, function *schema.Function) []error {
+	if function.Parameters == nil {
+		return nil
+	}
+
+	errs := []error{}
+	seen := map[string]bool{}
+	for i, paramName := range function.Parameters.Values {
+		location := function.SourceMeta
+		if i < len(function.Parameters.SourceMeta) {
+			location = function.Parameters.SourceMeta[i]
+		}
+
+		if !substitutions.NamePattern.MatchString(paramName) ||
+			substitutions.IsReservedWord(paramName) {
+			errs = append(errs, errFunctionInvalidParameterName(funcName, paramName, location))
+			continue
+		}
+
+		if seen[paramName] {
+			errs = append(errs, errFunctionDuplicateParameter(funcName, paramName, location))
+			continue
+		}
+		seen[paramName] = true
+	}
+
+	return errs
+}
+
+func checkFunctionCallArgCounts(
+	stringOrSubs *substitutions.StringOrSubstitutions,
+	functions *schema.FunctionMap,
+) []error {
+	errs := []error{}
+	forEachFunctionCall(stringOrSubs, func(funcExpr *substitutions.SubstitutionFunctionExpr) {
+		function, isUserFunction := functions.Values[string(funcExpr.FunctionName)]
+		if !isUserFunction || function == nil {
+			return
+		}
+
+		paramCount := len(function.ParameterNames())
+		if len(funcExpr.Arguments) != paramCount {
+			errs = append(
+				errs,
+				errSubFuncInvalidNumberOfArgs(paramCount, len(funcExpr.Arguments), funcExpr),
+			)
+		}
+	})
+
+	return errs
+}
+
+func checkFunctionCycles(functions *schema.FunctionMap) []error {
+	calls := map[string][]string{}
+	for funcName, function := range functions.Values {
+		if function == nil {
+			continue
+		}
+
+		forEachFunctionCall(function.Value, func(funcExpr *substitutions.SubstitutionFunctionExpr) {
+			calledName := string(funcExpr.FunctionName)
+			if _, isUserFunction := functions.Values[calledName]; isUserFunction &&
+				!slices.Contains(calls[funcName], calledName) {
+				calls[funcName] = append(calls[funcName], calledName)
+			}
+		})
+	}
+
+	errs := []error{}
+	// Functions that have been fully explored, either as the start of a search
+	// or as a function called by another function.
+	visited := map[string]bool{}
+	for _, funcName := range sortedKeys(functions.Values) {
+		if visited[funcName] {
+			continue
+		}
+
+		cycle := findFunctionCycle(funcName, calls, []string{}, visited)
+		if len(cycle) > 0 {
+			errs = append(errs, errFunctionCycle(cycle, functions.SourceMeta[cycle[0]]))
+			// Only report each cycle once, starting from the first function
+			// in the cycle by name.
+			for _, cycleFuncName := range cycle {
+				visited[cycleFuncName] = true
+			}
+		}
+	}
+
+	return errs
+}
+
+func findFunctionCycle(
+	funcName string,
+	calls map[string][]string,
+	callPath []string,
+	visited map[string]bool,
+) []string {
+	if index := slices.Index(callPath, funcName); index >= 0 {
+		cycle := append([]string{}, callPath[index:]...)
+		return append(cycle, funcName)
+	}
+
+	if visited[funcName] {
+		return nil
+	}
+
+	callPath = append(callPath, funcName)
+	for _, calledName := range calls[funcName] {
+		cycle := findFunctionCycle(calledName, calls, callPath, visited)
+		if len(cycle) > 0 {
+			return cycle
+		}
+	}
+	visited[funcName] = true
+
+	return nil
+}
+
+// ExpandFunctionCalls replaces every call to a user-defined function in the
+// given blueprint with the value of the function, where references to the
+// function parameters are replaced with the arguments passed into the call.
+// Calls to user-defined functions in the arguments and function values are
+// expanded recursively.
+//
+// The blueprint is modified in place, ValidateFunctions should be called first
+// to ensure that there are no cycles between functions and that every call
+// provides the expected number of arguments.
+func ExpandFunctionCalls(bpSchema *schema.Blueprint) error {
+	if bpSchema.Functions == nil || len(bpSchema.Functions.Values) == 0 {
+		return nil
+	}
+
+	expander := &functionExpander{
+		functions: bpSchema.Functions,
+		errs:      []error{},
+	}
+	forEachStringOrSubstitutions(bpSchema, func(stringOrSubs *substitutions.StringOrSubstitutions) {
+		for _, value := range stringOrSubs.Values {
+			if value != nil && value.SubstitutionValue != nil {
+				value.SubstitutionValue = expander.expand(
+					value.SubstitutionValue,
+					/* bindings */ nil,
+					/* callStack */ []string{},
+				)
+			}
+		}
+	})
+
+	if len(expander.errs) == 1 {
+		return expander.errs[0]
+	}
+
+	if len(expander.errs) > 1 {
+		return ErrMultipleValidationErrors(expander.errs)
+	}
+
+	return nil
+}
+
+type functionExpander struct {
+	functions *schema.FunctionMap
+	errs      []error
+}
+
+type functionBindings struct {
+	funcName string
+	args     map[string]*substitutions.Substitution
+}
+
+// expand returns a copy of the given substitution with user-defined function
+// calls expanded, the input substitution is never modified as function values
+// are shared between all the calls to a function.
+func (e *functionExpander) expand(
+	sub *substitutions.Substitution,
+	bindings *functionBindings,
+	callStack []string,
+) *substitutions.Substitution {
+	if bindings != nil && sub.ResourceProperty != nil {
+		arg, isParam := bindings.args[sub.ResourceProperty.ResourceName]
+		if isParam {
+			return e.expandParamReference(sub, arg, bindings.funcName)
+		}
+	}
+
+	if sub.Function == nil {
+		return sub
+	}
+
+	args := make([]*substitutions.SubstitutionFunctionArg, len(sub.Function.Arguments))
+	for i, arg := range sub.Function.Arguments {
+		if arg == nil || arg.Value == nil {
+			args[i] = arg
+			continue
+		}
+
+		args[i] = &substitutions.SubstitutionFunctionArg{
+			Name:       arg.Name,
+			Value:      e.expand(arg.Value, bindings, callStack),
+			SourceMeta: arg.SourceMeta,
+		}
+	}
+
+	funcName := string(sub.Function.FunctionName)
+	function, isUserFunction := e.functions.Values[funcName]
+	if !isUserFunction || function == nil ||
+		!isFunctionValueValid(function.Value) ||
+		slices.Contains(callStack, funcName) {
+		// Calls to functions from the function registry are kept as they are,
+		// function definition errors and cycles are reported by ValidateFunctions.
+		funcExpr := *sub.Function
+		funcExpr.Arguments = args
+		expanded := *sub
+		expanded.Function = &funcExpr
+		return &expanded
+	}
+
+	callBindings := &functionBindings{
+		funcName: funcName,
+		args:     map[string]*substitutions.Substitution{},
+	}
+	for i, paramName := range function.ParameterNames() {
+		if i < len(args) && args[i] != nil {
+			callBindings.args[paramName] = args[i].Value
+		}
+	}
+
+	body := function.Value.Values[0].SubstitutionValue
+	expanded := e.expand(body, callBindings, append(callStack, funcName))
+	if len(sub.Function.Path) > 0 {
+		expanded = e.appendPath(expanded, sub.Function.Path, funcName, "", sub.SourceMeta)
+	}
+
+	result := *expanded
+	// Errors for the expanded value as a whole should point to the call
+	// in the blueprint instead of the function definition.
+	result.SourceMeta = sub.SourceMeta
+	return &result
+}
+
+func (e *functionExpander) expandParamReference(
+	sub *substitutions.Substitution,
+	arg *substitutions.Substitution,
+	funcName string,
+) *substitutions.Substitution {
+	paramRef := sub.ResourceProperty
+	path := []*substitutions.SubstitutionPathItem{}
+	if paramRef.ResourceEachTemplateIndex != nil {
+		path = append(path, &substitutions.SubstitutionPathItem{
+			ArrayIndex: paramRef.ResourceEachTemplateIndex,
+			SourceMeta: paramRef.SourceMeta,
+		})
+	}
+	path = append(path, paramRef.Path...)
+
+	if len(path) == 0 {
+		return arg
+	}
+
+	return e.appendPath(arg, path, funcName, paramRef.ResourceName, sub.SourceMeta)
+}
+
+func (e *functionExpander) appendPath(
+	sub *substitutions.Substitution,
+	path []*substitutions.SubstitutionPathItem,
+	funcName string,
+	paramName string,
+	location *source.Meta,
+) *substitutions.Substitution {
+	result := *sub
+	switch {
+	case sub.Function != nil:
+		funcExpr := *sub.Function
+		funcExpr.Path = concatPaths(sub.Function.Path, path)
+		result.Function = &funcExpr
+	case sub.ValueReference != nil:
+		valueRef := *sub.ValueReference
+		valueRef.Path = concatPaths(sub.ValueReference.Path, path)
+		result.ValueReference = &valueRef
+	case sub.ElemReference != nil:
+		elemRef := *sub.ElemReference
+		elemRef.Path = concatPaths(sub.ElemReference.Path, path)
+		result.ElemReference = &elemRef
+	case sub.ResourceProperty != nil:
+		resourceProp := *sub.ResourceProperty
+		resourceProp.Path = concatPaths(sub.ResourceProperty.Path, path)
+		result.ResourceProperty = &resourceProp
+	case sub.Child != nil:
+		childRef := *sub.Child
+		childRef.Path = concatPaths(sub.Child.Path, path)
+		result.Child = &childRef
+	default:
+		e.errs = append(e.errs, errFunctionPathNotSupported(funcName, paramName, location))
+		return sub
+	}
+
+	return &result
+}
+
+func concatPaths(
+	path []*substitutions.SubstitutionPathItem,
+	toAppend []*substitutions.SubstitutionPathItem,
+) []*substitutions.SubstitutionPathItem {
+	combined := make([]*substitutions.SubstitutionPathItem, 0, len(path)+len(toAppend))
+	combined = append(combined, path...)
+	return append(combined, toAppend...)
+}
+
+func isFunctionValueValid(value *substitutions.StringOrSubstitutions) bool {
+	return value != nil && isSingleSubstitution(value)
+}
+
+func functionFieldLocation(
+	stringOrSubs *substitutions.StringOrSubstitutions,
+	fallback *source.Meta,
+) *source.Meta {
+	if stringOrSubs != nil && stringOrSubs.SourceMeta != nil {
+		return stringOrSubs.SourceMeta
+	}
+
+	return fallback
+}
+
+func forEachFunctionCall(
+	stringOrSubs *substitutions.StringOrSubstitutions,
+	visit func(funcExpr *substitutions.SubstitutionFunctionExpr),
+) {
+	if stringOrSubs == nil {
+		return
+	}
+
+	for _, value := range stringOrSubs.Values {
+		if value != nil && value.SubstitutionValue != nil {
+			forEachFunctionCallInSubstitution(value.SubstitutionValue, visit)
+		}
+	}
+}
+
+func forEachFunctionCallInSubstitution(
+	sub *substitutions.Substitution,
+	visit func(funcExpr *substitutions.SubstitutionFunctionExpr),
+) {
+	if sub == nil || sub.Function == nil {
+		return
+	}
+
+	visit(sub.Function)
+	for _, arg := range sub.Function.Arguments {
+		if arg != nil {
+			forEachFunctionCallInSubstitution(arg.Value, visit)
+		}
+	}
+}
+
+// forEachStringOrSubstitutions calls the given function for every string
+// or substitutions value in the blueprint that can contain calls
+// to user-defined functions.
+func forEachStringOrSubstitutions(
+	bpSchema *schema.Blueprint,
+	visit func(stringOrSubs *substitutions.StringOrSubstitutions),
+) {
+	walker := &stringOrSubsWalker{visit: visit}
+
+	if bpSchema.Values != nil {
+		for _, valName := range sortedKeys(bpSchema.Values.Values) {
+			value := bpSchema.Values.Values[valName]
+			if value != nil {
+				walker.walkMappingNode(value.Value)
+				walker.walkStringOrSubs(value.Description)
+			}
+		}
+	}
+
+	if bpSchema.Include != nil {
+		for _, includeName := range sortedKeys(bpSchema.Include.Values) {
+			include := bpSchema.Include.Values[includeName]
+			if include != nil {
+				walker.walkStringOrSubs(include.Path)
+				walker.walkMappingNode(include.Variables)
+				walker.walkMappingNode(include.Metadata)
+				walker.walkStringOrSubs(include.Description)
+			}
+		}
+	}
+
+	if bpSchema.Resources != nil {
+		for _, resourceName := range sortedKeys(bpSchema.Resources.Values) {
+			resource := bpSchema.Resources.Values[resourceName]
+			if resource != nil {
+				walker.walkResource(resource)
+			}
+		}
+	}
+
+	if bpSchema.DataSources != nil {
+		for _, dataSourceName := range sortedKeys(bpSchema.DataSources.Values) {
+			dataSource := bpSchema.DataSources.Values[dataSourceName]
+			if dataSource != nil {
+				walker.walkDataSource(dataSource)
+			}
+		}
+	}
+
+	if bpSchema.Exports != nil {
+		for _, exportName := range sortedKeys(bpSchema.Exports.Values) {
+			export := bpSchema.Exports.Values[exportName]
+			if export != nil {
+				walker.walkStringOrSubs(export.Description)
+			}
+		}
+	}
+
+	if bpSchema.Links != nil {
+		for _, linkName := range sortedKeys(bpSchema.Links.Values) {
+			walker.walkMappingNode(bpSchema.Links.Values[linkName])
+		}
+	}
+
+	walker.walkMappingNode(bpSchema.Metadata)
+}
+
+type stringOrSubsWalker struct {
+	visit func(stringOrSubs *substitutions.StringOrSubstitutions)
+}
+
+func (w *stringOrSubsWalker) walkResource(resource *schema.Resource) {
+	w.walkStringOrSubs(resource.Description)
+	w.walkStringOrSubs(resource.Each)
+	w.walkCondition(resource.Condition)
+	w.walkMappingNode(resource.Spec)
+	if resource.Metadata != nil {
+		w.walkStringOrSubs(resource.Metadata.DisplayName)
+		w.walkStringOrSubsMap(resource.Metadata.Annotations)
+		w.walkMappingNode(resource.Metadata.Custom)
+	}
+}
+
+func (w *stringOrSubsWalker) walkDataSource(dataSource *schema.DataSource) {
+	w.walkStringOrSubs(dataSource.Description)
+	if dataSource.DataSourceMetadata != nil {
+		w.walkStringOrSubs(dataSource.DataSourceMetadata.DisplayName)
+		w.walkStringOrSubsMap(dataSource.DataSourceMetadata.Annotations)
+		w.walkMappingNode(dataSource.DataSourceMetadata.Custom)
+	}
+	if dataSource.Filter != nil {
+		for _, filter := range dataSource.Filter.Filters {
+			if filter != nil && filter.Search != nil {
+				for _, searchValue := range filter.Search.Values {
+					w.walkStringOrSubs(searchValue)
+				}
+			}
+		}
+	}
+}
+
+func (w *stringOrSubsWalker) walkCondition(condition *schema.Condition) {
+	if condition == nil {
+		return
+	}
+
+	w.walkStringOrSubs(condition.StringValue)
+	for _, andCondition := range condition.And {
+		w.walkCondition(andCondition)
+	}
+	for _, orCondition := range condition.Or {
+		w.walkCondition(orCondition)
+	}
+	w.walkCondition(condition.Not)
+}
+
+func (w *stringOrSubsWalker) walkStringOrSubsMap(
+	stringOrSubsMap *schema.StringOrSubstitutionsMap,
+) {
+	if stringOrSubsMap == nil {
+		return
+	}
+
+	for _, key := range sortedKeys(stringOrSubsMap.Values) {
+		w.walkStringOrSubs(stringOrSubsMap.Values[key])
+	}
+}
+
+func (w *stringOrSubsWalker) walkMappingNode(node *core.MappingNode) {
+	if node == nil {
+		return
+	}
+
+	w.walkStringOrSubs(node.StringWithSubstitutions)
+	for _, key := range sortedKeys(node.Fields) {
+		w.walkMappingNode(node.Fields[key])
+	}
+	for _, item := range node.Items {
+		w.walkMappingNode(item)
+	}
+}
+
+func (w *stringOrSubsWalker) walkStringOrSubs(
+	stringOrSubs *substitutions.StringOrSubstitutions,
+) {
+	if stringOrSubs == nil {
+		return
+	}
+
+	w.visit(stringOrSubs)
+}
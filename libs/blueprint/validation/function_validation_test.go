@@ -0,0 +1,253 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/corefunctions"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	. "gopkg.in/check.v1"
+)
+
+type FunctionValidationTestSuite struct {
+	functionRegistry provider.FunctionRegistry
+}
+
+var _ = Suite(&FunctionValidationTestSuite{})
+
+func (s *FunctionValidationTestSuite) SetUpTest(c *C) {
+	s.functionRegistry = &internal.FunctionRegistryMock{
+		Functions: map[string]provider.Function{
+			"join":       corefunctions.NewJoinFunction(),
+			"list":       corefunctions.NewListFunction(),
+			"to_lower":   corefunctions.NewToLowerFunction(),
+			"jsondecode": corefunctions.NewJSONDecodeFunction(),
+		},
+	}
+}
+
+const functionTestBlueprint = `
+version: 2025-11-02
+variables:
+  environment:
+    type: string
+  config:
+    type: string
+functions:
+  resourceName:
+    description: Derives a resource name for a service in the current environment.
+    parameters: [service, suffix]
+    value: "${to_lower(join(list(variables.environment, service, suffix), \"-\"))}"
+  tableName:
+    parameters: [service]
+    value: "${resourceName(service, \"table\")}"
+  configField:
+    parameters: [config]
+    value: "${config.settings[0]}"
+resources:
+  ordersTable:
+    type: aws/dynamodb/table
+    spec:
+      tableName: "${tableName(\"orders\")}"
+      region: "${configField(jsondecode(variables.config)).region}"
+      billingMode: "PAY_PER_REQUEST"
+`
+
+func (s *FunctionValidationTestSuite) Test_succeeds_and_expands_calls_for_valid_functions(c *C) {
+	blueprint := loadFunctionTestBlueprint(c, functionTestBlueprint)
+
+	err := ValidateFunctions(context.Background(), blueprint, s.functionRegistry)
+	c.Assert(err, IsNil)
+
+	err = ExpandFunctionCalls(blueprint)
+	c.Assert(err, IsNil)
+
+	spec := blueprint.Resources.Values["ordersTable"].Spec
+	tableName, err := substitutions.SubstitutionsToString(
+		"",
+		spec.Fields["tableName"].StringWithSubstitutions,
+	)
+	c.Assert(err, IsNil)
+	c.Assert(
+		tableName,
+		Equals,
+		"${to_lower(join(list(variables.environment,\"orders\",\"table\"),\"-\"))}",
+	)
+
+	regionSub := spec.Fields["region"].StringWithSubstitutions.Values[0].SubstitutionValue
+	c.Assert(regionSub.Function, NotNil)
+	c.Assert(string(regionSub.Function.FunctionName), Equals, "jsondecode")
+	c.Assert(regionSub.Function.Path, HasLen, 3)
+	c.Assert(regionSub.Function.Path[0].FieldName, Equals, "settings")
+	c.Assert(*regionSub.Function.Path[1].ArrayIndex, Equals, int64(0))
+	c.Assert(regionSub.Function.Path[2].FieldName, Equals, "region")
+}
+
+func (s *FunctionValidationTestSuite) Test_does_not_modify_function_values_when_expanding_calls(c *C) {
+	blueprint := loadFunctionTestBlueprint(c, functionTestBlueprint)
+
+	err := ExpandFunctionCalls(blueprint)
+	c.Assert(err, IsNil)
+
+	value, err := substitutions.SubstitutionsToString(
+		"",
+		blueprint.Functions.Values["tableName"].Value,
+	)
+	c.Assert(err, IsNil)
+	c.Assert(value, Equals, "${resourceName(resources.service,\"table\")}")
+}
+
+func (s *FunctionValidationTestSuite) Test_reports_error_for_call_with_invalid_number_of_args(c *C) {
+	blueprint := loadFunctionTestBlueprint(c, `
+version: 2025-11-02
+functions:
+  resourceName:
+    parameters: [service, suffix]
+    value: "${join(list(service, suffix), \"-\")}"
+resources:
+  ordersTable:
+    type: aws/dynamodb/table
+    spec:
+      tableName: "${resourceName(\"orders\")}"
+`)
+
+	err := ValidateFunctions(context.Background(), blueprint, s.functionRegistry)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidSubstitution)
+	c.Assert(
+		loadErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to an invalid number of arguments "+
+			"being provided for substitution function \"resourceName\", expected 2 but got 1",
+	)
+}
+
+func (s *FunctionValidationTestSuite) Test_reports_error_for_cycle_between_functions(c *C) {
+	blueprint := loadFunctionTestBlueprint(c, `
+version: 2025-11-02
+functions:
+  first:
+    parameters: [input]
+    value: "${second(input)}"
+  second:
+    parameters: [input]
+    value: "${to_lower(first(input))}"
+resources:
+  ordersTable:
+    type: aws/dynamodb/table
+    spec:
+      tableName: "${first(\"orders\")}"
+`)
+
+	err := ValidateFunctions(context.Background(), blueprint, s.functionRegistry)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeFunctionCycle)
+	c.Assert(
+		loadErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to a cycle between user-defined functions: "+
+			"first -> second -> first, functions can not call themselves directly or indirectly",
+	)
+}
+
+func (s *FunctionValidationTestSuite) Test_reports_error_for_function_name_conflict(c *C) {
+	blueprint := loadFunctionTestBlueprint(c, `
+version: 2025-11-02
+functions:
+  join:
+    parameters: [items]
+    value: "${to_lower(items)}"
+resources: {}
+`)
+
+	err := ValidateFunctions(context.Background(), blueprint, s.functionRegistry)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidFunction)
+	c.Assert(
+		loadErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to the user-defined function \"join\" "+
+			"having the same name as a core or provider function",
+	)
+	c.Assert(loadErr.Line, NotNil)
+	c.Assert(*loadErr.Line, Equals, 4)
+}
+
+func (s *FunctionValidationTestSuite) Test_reports_errors_for_invalid_parameters_and_value(c *C) {
+	blueprint := loadFunctionTestBlueprint(c, `
+version: 2025-11-02
+functions:
+  resourceName:
+    parameters: [service, elem, service]
+    value: "prefix-${to_lower(service)}"
+resources: {}
+`)
+
+	err := ValidateFunctions(context.Background(), blueprint, s.functionRegistry)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeMultipleValidationErrors)
+	c.Assert(loadErr.ChildErrors, HasLen, 3)
+
+	errMessages := []string{}
+	for _, childErr := range loadErr.ChildErrors {
+		errMessages = append(errMessages, childErr.Error())
+	}
+	c.Assert(errMessages, DeepEquals, []string{
+		"blueprint load error: validation failed due to the parameter name \"elem\" of function " +
+			"\"resourceName\" not being valid, parameter names must be valid substitution identifiers " +
+			"and can not be a substitution keyword such as \"variables\" or \"elem\"",
+		"blueprint load error: validation failed due to the parameter \"service\" being defined " +
+			"more than once for function \"resourceName\"",
+		"blueprint load error: validation failed due to the value of function \"resourceName\" " +
+			"not being a single substitution, the value of a function must be a single ${..} " +
+			"substitution without any surrounding text",
+	})
+}
+
+func (s *FunctionValidationTestSuite) Test_reports_error_for_path_on_literal_argument(c *C) {
+	blueprint := loadFunctionTestBlueprint(c, `
+version: 2025-11-02
+functions:
+  firstItem:
+    parameters: [items]
+    value: "${items[0]}"
+resources:
+  ordersTable:
+    type: aws/dynamodb/table
+    spec:
+      tableName: "${firstItem(\"orders\")}"
+`)
+
+	err := ValidateFunctions(context.Background(), blueprint, s.functionRegistry)
+	c.Assert(err, IsNil)
+
+	err = ExpandFunctionCalls(blueprint)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidFunction)
+	c.Assert(
+		loadErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to a property path being used to access "+
+			"the argument passed in for parameter \"items\" of function \"firstItem\", "+
+			"property paths can only be used with references and function calls",
+	)
+}
+
+func loadFunctionTestBlueprint(c *C, spec string) *schema.Blueprint {
+	blueprint, err := schema.LoadString(spec, schema.YAMLSpecFormat)
+	c.Assert(err, IsNil)
+	return blueprint
+}
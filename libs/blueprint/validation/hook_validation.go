@@ -0,0 +1,95 @@
+package validation
+
+import (
+	"strings"
+
+	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+)
+
+// ValidateHooks validates the hooks section of a blueprint.
+// This ensures that each hook has a supported event and a command to run
+// and that resources are only provided for resource events and are defined
+// in the blueprint.
+// All invalid hooks are reported, when more than one hook is invalid,
+// the returned error will wrap an error for each invalid hook.
+func ValidateHooks(
+	hooks *schema.HookList,
+	resources *schema.ResourceMap,
+) error {
+	if hooks == nil {
+		return nil
+	}
+
+	errs := []error{}
+	for i, hook := range hooks.Values {
+		err := validateHook(i, hook, resources)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	if len(errs) > 1 {
+		return ErrMultipleValidationErrors(errs)
+	}
+
+	return nil
+}
+
+func validateHook(
+	hookIndex int,
+	hook *schema.Hook,
+	resources *schema.ResourceMap,
+) error {
+	event := schema.HookEvent(bpcore.StringValueFromScalar(hook.Event))
+	if !event.IsValid() {
+		return errInvalidHookEvent(
+			hookIndex,
+			string(event),
+			hookFieldSourceMeta(hook.Event, hook.SourceMeta),
+		)
+	}
+
+	if strings.TrimSpace(bpcore.StringValueFromScalar(hook.Run)) == "" {
+		return errMissingHookCommand(
+			hookIndex,
+			hookFieldSourceMeta(hook.Run, hook.SourceMeta),
+		)
+	}
+
+	if hook.Resource == nil {
+		return nil
+	}
+
+	if !event.IsResourceEvent() {
+		return errHookResourceNotAllowed(
+			hookIndex,
+			string(event),
+			hookFieldSourceMeta(hook.Resource, hook.SourceMeta),
+		)
+	}
+
+	resourceName := bpcore.StringValueFromScalar(hook.Resource)
+	if resources == nil || resources.Values[resourceName] == nil {
+		return errHookResourceNotFound(
+			hookIndex,
+			resourceName,
+			hookFieldSourceMeta(hook.Resource, hook.SourceMeta),
+		)
+	}
+
+	return nil
+}
+
+func hookFieldSourceMeta(field *bpcore.ScalarValue, hookSourceMeta *source.Meta) *source.Meta {
+	if field != nil && field.SourceMeta != nil {
+		return field.SourceMeta
+	}
+
+	return hookSourceMeta
+}
@@ -0,0 +1,121 @@
+package validation
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	. "gopkg.in/check.v1"
+)
+
+type HookValidationTestSuite struct{}
+
+var _ = Suite(&HookValidationTestSuite{})
+
+func (s *HookValidationTestSuite) Test_succeeds_with_no_errors_for_valid_hooks(c *C) {
+	hooks := &schema.HookList{
+		Values: []*schema.Hook{
+			newTestHook("afterResourceDeploy", "ordersTable", "./scripts/invalidate-cache.sh"),
+			newTestHook("beforeResourceDeploy", "", "./scripts/check-quota.sh"),
+			newTestHook("afterDeploy", "", "make smoke-test"),
+		},
+	}
+
+	err := ValidateHooks(hooks, testHookResources())
+	c.Assert(err, IsNil)
+}
+
+func (s *HookValidationTestSuite) Test_succeeds_with_no_errors_when_hooks_are_not_defined(c *C) {
+	err := ValidateHooks(nil, testHookResources())
+	c.Assert(err, IsNil)
+}
+
+func (s *HookValidationTestSuite) Test_reports_error_for_unsupported_hook_event(c *C) {
+	hooks := &schema.HookList{
+		Values: []*schema.Hook{
+			newTestHook("afterEverything", "", "make smoke-test"),
+		},
+	}
+
+	err := ValidateHooks(hooks, testHookResources())
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidHook)
+	c.Assert(
+		loadErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to an unsupported event \"afterEverything\" "+
+			"being provided for hook 0, the following hook events are supported: "+
+			"beforeDeploy, afterDeploy, beforeResourceDeploy, afterResourceDeploy, beforeDestroy, afterDestroy",
+	)
+}
+
+func (s *HookValidationTestSuite) Test_reports_error_for_hook_with_missing_command(c *C) {
+	hooks := &schema.HookList{
+		Values: []*schema.Hook{
+			newTestHook("afterDeploy", "", "  "),
+		},
+	}
+
+	err := ValidateHooks(hooks, testHookResources())
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidHook)
+}
+
+func (s *HookValidationTestSuite) Test_reports_errors_for_invalid_hook_resources(c *C) {
+	hooks := &schema.HookList{
+		Values: []*schema.Hook{
+			newTestHook("afterDeploy", "ordersTable", "make smoke-test"),
+			newTestHook("afterResourceDeploy", "missingTable", "./scripts/invalidate-cache.sh"),
+		},
+	}
+
+	err := ValidateHooks(hooks, testHookResources())
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeMultipleValidationErrors)
+	c.Assert(loadErr.ChildErrors, HasLen, 2)
+
+	resourceNotAllowedErr, isLoadErr := loadErr.ChildErrors[0].(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(
+		resourceNotAllowedErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to a resource being provided for hook 0 "+
+			"with the \"afterDeploy\" event, a resource can only be provided for the "+
+			"beforeResourceDeploy and afterResourceDeploy events",
+	)
+
+	resourceNotFoundErr, isLoadErr := loadErr.ChildErrors[1].(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(
+		resourceNotFoundErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to resource \"missingTable\" "+
+			"provided for hook 1 not being defined in the blueprint",
+	)
+}
+
+func newTestHook(event string, resource string, run string) *schema.Hook {
+	hook := &schema.Hook{
+		Event: core.ScalarFromString(event),
+		Run:   core.ScalarFromString(run),
+	}
+	if resource != "" {
+		hook.Resource = core.ScalarFromString(resource)
+	}
+	return hook
+}
+
+func testHookResources() *schema.ResourceMap {
+	return &schema.ResourceMap{
+		Values: map[string]*schema.Resource{
+			"ordersTable": {
+				Type: &schema.ResourceTypeWrapper{Value: "aws/dynamodb/table"},
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/corefunctions"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
@@ -105,6 +106,16 @@ func ValidateInclude(
 		errs = append(errs, err)
 	}
 
+	err = validateIncludeDependencies(
+		includeName,
+		includeSchema.DependsOn,
+		valCtx.BpSchema,
+		valCtx.RefChainCollector,
+	)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
 	if len(errs) > 0 {
 		return diagnostics, ErrMultipleValidationErrors(errs)
 	}
@@ -355,12 +366,17 @@ func hasRemotePathPrefix(path *substitutions.StringOrSubstitutions) bool {
 // Checks: unknown variables (warning), missing required (error),
 // and type mismatches using substitution-aware type resolution (error).
 // This only runs when the child blueprint has been successfully loaded.
+//
+// The child location is the path or URL of the child blueprint document,
+// when provided, errors include the location of the variable definition
+// in the child blueprint.
 func ValidateIncludeVariables(
 	ctx context.Context,
 	includeName string,
 	includeSchema *schema.Include,
 	includeMap *schema.IncludeMap,
 	childBpSchema *schema.Blueprint,
+	childLocation string,
 	valCtx *ValidationContext,
 ) ([]*core.Diagnostic, error) {
 	if childBpSchema.Variables == nil {
@@ -373,18 +389,18 @@ func ValidateIncludeVariables(
 	providedVars := includeVarFields(includeSchema)
 
 	unknownDiagnostics := checkUnknownIncludeVars(
-		includeName, includeSchema, childBpSchema,
+		includeName, includeSchema, childBpSchema, childLocation,
 	)
 	diagnostics = append(diagnostics, unknownDiagnostics...)
 
 	missingErrs := checkMissingRequiredIncludeVars(
-		includeName, includeMap, childBpSchema, providedVars,
+		includeName, includeMap, childBpSchema, childLocation, providedVars,
 	)
 	errs = append(errs, missingErrs...)
 
 	typeDiagnostics, typeErrs := checkIncludeVarTypes(
 		ctx, includeName, includeIdentifier, includeSchema,
-		childBpSchema, valCtx,
+		childBpSchema, childLocation, valCtx,
 		providedVars,
 	)
 	diagnostics = append(diagnostics, typeDiagnostics...)
@@ -408,6 +424,7 @@ func checkUnknownIncludeVars(
 	includeName string,
 	includeSchema *schema.Include,
 	childBpSchema *schema.Blueprint,
+	childLocation string,
 ) []*core.Diagnostic {
 	if includeSchema.Variables == nil || includeSchema.Variables.Fields == nil {
 		return nil
@@ -420,8 +437,9 @@ func checkUnknownIncludeVars(
 				Level: core.DiagnosticLevelWarning,
 				Message: fmt.Sprintf(
 					"Variable %q provided to include %q is not defined"+
-						" in the child blueprint",
+						" in the child blueprint%s",
 					varName, includeName,
+					childLocationSuffix("loaded from", childLocation),
 				),
 				Range: core.DiagnosticRangeFromSourceMeta(
 					includeSchema.Variables.FieldsSourceMeta[varName],
@@ -437,6 +455,7 @@ func checkMissingRequiredIncludeVars(
 	includeName string,
 	includeMap *schema.IncludeMap,
 	childBpSchema *schema.Blueprint,
+	childLocation string,
 	providedVars map[string]*core.MappingNode,
 ) []error {
 	var errs []error
@@ -449,7 +468,10 @@ func checkMissingRequiredIncludeVars(
 			continue
 		}
 		errs = append(errs, errIncludeMissingRequiredVar(
-			includeName, varName, includeKeySourceMeta,
+			includeName,
+			varName,
+			childElementLocation(childLocation, childVariableSourceMeta(childBpSchema, varName)),
+			includeKeySourceMeta,
 		))
 	}
 	return errs
@@ -461,6 +483,7 @@ func checkIncludeVarTypes(
 	includeIdentifier string,
 	includeSchema *schema.Include,
 	childBpSchema *schema.Blueprint,
+	childLocation string,
 	valCtx *ValidationContext,
 	providedVars map[string]*core.MappingNode,
 ) ([]*core.Diagnostic, []error) {
@@ -494,6 +517,7 @@ func checkIncludeVarTypes(
 			errs = append(errs, errIncludeVarTypeMismatch(
 				includeName, varName, resolvedType,
 				string(childVar.Type.Value),
+				childElementLocation(childLocation, childVar.SourceMeta),
 				includeSchema.Variables.FieldsSourceMeta[varName],
 			))
 		}
@@ -502,6 +526,29 @@ func checkIncludeVarTypes(
 	return diagnostics, errs
 }
 
+func childVariableSourceMeta(childBpSchema *schema.Blueprint, varName string) *source.Meta {
+	childVar, ok := childBpSchema.Variables.Values[varName]
+	if !ok || childVar == nil {
+		return nil
+	}
+	return childVar.SourceMeta
+}
+
+// Produces a location in the form "{childLocation}:{line}:{column}"
+// for an element in a child blueprint document to be used in error messages.
+func childElementLocation(childLocation string, sourceMeta *source.Meta) string {
+	if childLocation == "" || sourceMeta == nil {
+		return childLocation
+	}
+
+	return fmt.Sprintf(
+		"%s:%d:%d",
+		childLocation,
+		sourceMeta.Line,
+		sourceMeta.Column,
+	)
+}
+
 // resolveIncludeVarType determines the type of a MappingNode variable value
 // for comparison with a child blueprint's variable type.
 // Uses ValidateSubstitution for single ${..} substitution values
@@ -670,3 +717,97 @@ func resolveBaseDir(
 	return ""
 }
 
+func validateIncludeDependencies(
+	includeName string,
+	dependsOn *schema.DependsOnList,
+	blueprint *schema.Blueprint,
+	refChainCollector refgraph.RefChainCollector,
+) error {
+	if dependsOn == nil || blueprint == nil {
+		return nil
+	}
+
+	errs := []error{}
+	for i, dependency := range dependsOn.Values {
+		if substitutions.ContainsSubstitution(dependency) {
+			errs = append(errs, errIncludeDependencyContainsSubstitution(
+				includeName,
+				dependency,
+				dependsOn.SourceMeta[i],
+			))
+			continue
+		}
+
+		if includeName == dependency {
+			errs = append(errs, errSelfReferencingIncludeDependency(
+				includeName,
+				dependsOn.SourceMeta[i],
+			))
+			continue
+		}
+
+		dependencyInclude, hasInclude := getInclude(dependency, blueprint)
+		dependencyResource, hasResource := getResource(dependency, blueprint)
+		if hasInclude && hasResource {
+			errs = append(errs, errIncludeDependencyAmbiguous(
+				includeName,
+				dependency,
+				dependsOn.SourceMeta[i],
+			))
+			continue
+		}
+
+		if !hasInclude && !hasResource {
+			errs = append(errs, errIncludeDependencyMissing(
+				includeName,
+				dependency,
+				dependsOn.SourceMeta[i],
+			))
+			continue
+		}
+
+		if refChainCollector == nil {
+			continue
+		}
+
+		// Collect reference in the ref chain collector for the dependency so that
+		// the child blueprint is deployed after its dependencies and to cover
+		// cycle detection across references, dependsOn and links.
+		referencedByChildID := core.ChildElementID(includeName)
+		dependencyTag := CreateDependencyRefTag(referencedByChildID)
+		var err error
+		if hasInclude {
+			err = refChainCollector.Collect(
+				core.ChildElementID(dependency),
+				dependencyInclude,
+				referencedByChildID,
+				[]string{dependencyTag},
+			)
+		} else {
+			err = refChainCollector.Collect(
+				core.ResourceElementID(dependency),
+				dependencyResource,
+				referencedByChildID,
+				[]string{dependencyTag},
+			)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(errs) > 0 {
+		return ErrMultipleValidationErrors(errs)
+	}
+
+	return nil
+}
+
+func getInclude(includeName string, blueprint *schema.Blueprint) (*schema.Include, bool) {
+	if blueprint.Include == nil {
+		return nil, false
+	}
+
+	include, hasInclude := blueprint.Include.Values[includeName]
+	return include, hasInclude
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
 	. "gopkg.in/check.v1"
 )
@@ -630,7 +631,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_warns_on_u
 	}
 
 	diagnostics, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           &schema.Blueprint{},
 			Params:             &core.ParamsImpl{},
@@ -670,7 +671,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_errors_on_
 	}
 
 	diagnostics, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           &schema.Blueprint{},
 			Params:             &core.ParamsImpl{},
@@ -708,7 +709,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_errors_on_
 	}
 
 	diagnostics, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           &schema.Blueprint{},
 			Params:             &core.ParamsImpl{},
@@ -726,6 +727,45 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_errors_on_
 	c.Assert(loadErr.Error(), Matches, `.*variable "region".*type "integer".*expects type "string"`)
 }
 
+func (s *IncludeValidationTestSuite) Test_validates_include_variables_reports_child_variable_location(c *C) {
+	includeSchema := &schema.Include{
+		Variables: &core.MappingNode{
+			Fields: map[string]*core.MappingNode{},
+		},
+	}
+	childBp := &schema.Blueprint{
+		Variables: &schema.VariableMap{
+			Values: map[string]*schema.Variable{
+				"requiredVar": {
+					Type: &schema.VariableTypeWrapper{Value: schema.VariableTypeString},
+					SourceMeta: &source.Meta{
+						Position: source.Position{Line: 4, Column: 5},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := ValidateIncludeVariables(
+		context.Background(), "childA", includeSchema, nil, childBp, "/blueprints/child.yml",
+		&ValidationContext{
+			BpSchema:           &schema.Blueprint{},
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := internal.UnpackLoadError(err)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeIncludeMissingRequiredVar)
+	c.Assert(loadErr.Error(), Matches, `.*"requiredVar".*variable defined at /blueprints/child\.yml:4:5.*`)
+	c.Assert(loadErr.Context, NotNil)
+	c.Assert(loadErr.Context.Metadata["childBlueprintLocation"], Equals, "/blueprints/child.yml:4:5")
+}
+
 func (s *IncludeValidationTestSuite) Test_validates_include_variables_no_diagnostics_when_all_match(c *C) {
 	includeSchema := &schema.Include{
 		Variables: &core.MappingNode{
@@ -749,7 +789,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_no_diagnos
 	}
 
 	diagnostics, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           &schema.Blueprint{},
 			Params:             &core.ParamsImpl{},
@@ -805,7 +845,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_resolves_s
 	}
 
 	diagnostics, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           parentBp,
 			Params:             &core.ParamsImpl{},
@@ -866,7 +906,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_interpolat
 	}
 
 	_, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           parentBp,
 			Params:             &core.ParamsImpl{},
@@ -915,7 +955,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_warns_non_
 	}
 
 	diagnostics, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           &schema.Blueprint{},
 			Params:             &core.ParamsImpl{},
@@ -956,7 +996,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_allows_opt
 	}
 
 	diagnostics, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           &schema.Blueprint{},
 			Params:             &core.ParamsImpl{},
@@ -983,7 +1023,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_skips_when
 	}
 
 	diagnostics, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           &schema.Blueprint{},
 			Params:             &core.ParamsImpl{},
@@ -1012,7 +1052,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_skips_when
 	}
 
 	diagnostics, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           &schema.Blueprint{},
 			Params:             &core.ParamsImpl{},
@@ -1047,7 +1087,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_errors_whe
 	}
 
 	_, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           &schema.Blueprint{},
 			Params:             &core.ParamsImpl{},
@@ -1089,7 +1129,7 @@ func (s *IncludeValidationTestSuite) Test_validates_include_variables_skips_type
 	}
 
 	_, err := ValidateIncludeVariables(
-		context.Background(), "childA", includeSchema, nil, childBp,
+		context.Background(), "childA", includeSchema, nil, childBp, "",
 		&ValidationContext{
 			BpSchema:           &schema.Blueprint{},
 			Params:             &core.ParamsImpl{},
@@ -1111,6 +1151,137 @@ func intPtr(i int) *int {
 	return &i
 }
 
+func (s *IncludeValidationTestSuite) Test_collects_references_for_include_dependencies(c *C) {
+	includeSchema := createTestValidInclude()
+	includeSchema.DependsOn = &schema.DependsOnList{
+		StringList: schema.StringList{
+			Values: []string{"networking", "ordersTable"},
+		},
+	}
+	networkingInclude := createTestValidInclude()
+	includeMap := &schema.IncludeMap{
+		Values: map[string]*schema.Include{
+			"coreInfra":  includeSchema,
+			"networking": networkingInclude,
+		},
+	}
+	ordersTable := &schema.Resource{
+		Type: &schema.ResourceTypeWrapper{Value: "aws/dynamodb/table"},
+	}
+	blueprint := &schema.Blueprint{
+		Include: includeMap,
+		Resources: &schema.ResourceMap{
+			Values: map[string]*schema.Resource{
+				"ordersTable": ordersTable,
+			},
+		},
+	}
+
+	_, err := ValidateInclude(
+		context.Background(),
+		"coreInfra",
+		includeSchema,
+		includeMap,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+	)
+	c.Assert(err, IsNil)
+
+	dependencyTag := CreateDependencyRefTag("children.coreInfra")
+	networkingNode := s.refChainCollector.Chain("children.networking")
+	c.Assert(networkingNode, NotNil)
+	c.Assert(networkingNode.Element, Equals, networkingInclude)
+
+	ordersTableNode := s.refChainCollector.Chain("resources.ordersTable")
+	c.Assert(ordersTableNode, NotNil)
+	c.Assert(ordersTableNode.Element, Equals, ordersTable)
+	c.Assert(s.refChainCollector.FindByTag(dependencyTag), HasLen, 2)
+
+	coreInfraNode := s.refChainCollector.Chain("children.coreInfra")
+	c.Assert(coreInfraNode, NotNil)
+	c.Assert(coreInfraNode.References, HasLen, 2)
+}
+
+func (s *IncludeValidationTestSuite) Test_reports_errors_for_invalid_include_dependencies(c *C) {
+	includeSchema := createTestValidInclude()
+	includeSchema.DependsOn = &schema.DependsOnList{
+		StringList: schema.StringList{
+			Values:     []string{"coreInfra", "missing", "shared"},
+			SourceMeta: []*source.Meta{nil, nil, nil},
+		},
+	}
+	includeMap := &schema.IncludeMap{
+		Values: map[string]*schema.Include{
+			"coreInfra": includeSchema,
+			"shared":    createTestValidInclude(),
+		},
+	}
+	blueprint := &schema.Blueprint{
+		Include: includeMap,
+		Resources: &schema.ResourceMap{
+			Values: map[string]*schema.Resource{
+				"shared": {
+					Type: &schema.ResourceTypeWrapper{Value: "aws/s3/bucket"},
+				},
+			},
+		},
+	}
+
+	_, err := ValidateInclude(
+		context.Background(),
+		"coreInfra",
+		includeSchema,
+		includeMap,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ChildErrors, HasLen, 1)
+	dependencyErr, isLoadErr := loadErr.ChildErrors[0].(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(dependencyErr.ChildErrors, HasLen, 3)
+
+	selfRefErr := dependencyErr.ChildErrors[0].(*errors.LoadError)
+	c.Assert(selfRefErr.ReasonCode, Equals, ErrorReasonCodeInvalidInclude)
+	c.Assert(
+		selfRefErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to a self-referencing dependency in include \"coreInfra\"",
+	)
+
+	missingErr := dependencyErr.ChildErrors[1].(*errors.LoadError)
+	c.Assert(missingErr.ReasonCode, Equals, ErrorReasonCodeMissingIncludeDependency)
+	c.Assert(
+		missingErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to a missing dependency \"missing\" for include \"coreInfra\", "+
+			"the dependency must be an include or resource in the same blueprint",
+	)
+
+	ambiguousErr := dependencyErr.ChildErrors[2].(*errors.LoadError)
+	c.Assert(ambiguousErr.ReasonCode, Equals, ErrorReasonCodeInvalidInclude)
+	c.Assert(
+		ambiguousErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to an ambiguous dependency \"shared\" for include \"coreInfra\", "+
+			"\"shared\" is the name of both an include and a resource in the same blueprint",
+	)
+}
+
 func createTestValidInclude() *schema.Include {
 	databaseName := "${variables.databaseName}"
 	fileName := "core-infra.yml"
@@ -0,0 +1,155 @@
+package validation
+
+import (
+	"context"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+// InstanceExportLookup is a function that checks whether an export
+// exists for another deployed blueprint instance that is referenced by name.
+// An error is returned only when the lookup itself fails
+// (e.g. the state container could not be reached).
+type InstanceExportLookup func(
+	ctx context.Context,
+	instanceName string,
+	exportName string,
+) (instanceExists bool, exportExists bool, err error)
+
+// ValidateInstanceReferences validates references to exports of other
+// deployed blueprint instances in the form
+// "bluelink://instance/{instanceName}/exports/{exportName}".
+// Instance references can be used as string values in resource specs,
+// values and the variables passed into child blueprints.
+//
+// When an instance export lookup is provided in the validation context,
+// the referenced instance and export must exist, otherwise only the format
+// of instance references is validated.
+func ValidateInstanceReferences(
+	ctx context.Context,
+	valCtx *ValidationContext,
+) ([]*core.Diagnostic, error) {
+	diagnostics := []*core.Diagnostic{}
+	if valCtx == nil || valCtx.BpSchema == nil {
+		return diagnostics, nil
+	}
+
+	refNodes := collectInstanceReferenceNodes(valCtx)
+
+	errs := []error{}
+	for _, refNode := range refNodes {
+		err := validateInstanceReference(ctx, refNode, valCtx.InstanceExportLookup)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 1 {
+		return diagnostics, errs[0]
+	}
+
+	if len(errs) > 1 {
+		return diagnostics, ErrMultipleValidationErrors(errs)
+	}
+
+	return diagnostics, nil
+}
+
+func validateInstanceReference(
+	ctx context.Context,
+	refNode *core.MappingNode,
+	lookup InstanceExportLookup,
+) error {
+	value := *refNode.Scalar.StringValue
+	ref, err := substitutions.ParseInstanceExportReference(value)
+	if err != nil {
+		return errInvalidInstanceReference(value, refNode.SourceMeta)
+	}
+
+	if lookup == nil {
+		return nil
+	}
+
+	instanceExists, exportExists, err := lookup(ctx, ref.InstanceName, ref.ExportName)
+	if err != nil {
+		return err
+	}
+
+	if !instanceExists || !exportExists {
+		return errInstanceReferenceNotFound(ref, instanceExists, refNode.SourceMeta)
+	}
+
+	return nil
+}
+
+// Collects mapping nodes that hold instance references in a deterministic order
+// so errors are reported consistently.
+func collectInstanceReferenceNodes(valCtx *ValidationContext) []*core.MappingNode {
+	bpSchema := valCtx.BpSchema
+	refNodes := []*core.MappingNode{}
+
+	if bpSchema.Resources != nil {
+		for _, name := range sortedKeys(bpSchema.Resources.Values) {
+			resource := bpSchema.Resources.Values[name]
+			if resource != nil {
+				collectInstanceReferencesInMappingNode(resource.Spec, &refNodes, 0)
+			}
+		}
+	}
+
+	if bpSchema.Values != nil {
+		for _, name := range sortedKeys(bpSchema.Values.Values) {
+			value := bpSchema.Values.Values[name]
+			if value != nil {
+				collectInstanceReferencesInMappingNode(value.Value, &refNodes, 0)
+			}
+		}
+	}
+
+	if bpSchema.Include != nil {
+		for _, name := range sortedKeys(bpSchema.Include.Values) {
+			include := bpSchema.Include.Values[name]
+			if include != nil {
+				collectInstanceReferencesInMappingNode(include.Variables, &refNodes, 0)
+			}
+		}
+	}
+
+	return refNodes
+}
+
+func collectInstanceReferencesInMappingNode(
+	node *core.MappingNode,
+	refNodes *[]*core.MappingNode,
+	depth int,
+) {
+	if node == nil || depth >= core.MappingNodeMaxTraverseDepth {
+		return
+	}
+
+	if node.Scalar != nil &&
+		node.Scalar.StringValue != nil &&
+		substitutions.IsInstanceReference(*node.Scalar.StringValue) {
+		*refNodes = append(*refNodes, node)
+		return
+	}
+
+	for _, key := range sortedKeys(node.Fields) {
+		collectInstanceReferencesInMappingNode(node.Fields[key], refNodes, depth+1)
+	}
+
+	for _, item := range node.Items {
+		collectInstanceReferencesInMappingNode(item, refNodes, depth+1)
+	}
+}
+
+func sortedKeys[Value any](values map[string]Value) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,220 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/stretchr/testify/suite"
+)
+
+type InstanceReferenceValidationTestSuite struct {
+	suite.Suite
+}
+
+func (s *InstanceReferenceValidationTestSuite) Test_succeeds_for_valid_instance_references() {
+	valCtx := &ValidationContext{
+		BpSchema: createInstanceReferenceTestBlueprint(
+			"bluelink://instance/shared-network/exports/vpcId",
+		),
+		InstanceExportLookup: createTestInstanceExportLookup(),
+	}
+
+	diagnostics, err := ValidateInstanceReferences(context.Background(), valCtx)
+	s.Require().NoError(err)
+	s.Assert().Empty(diagnostics)
+}
+
+func (s *InstanceReferenceValidationTestSuite) Test_only_validates_format_without_instance_export_lookup() {
+	valCtx := &ValidationContext{
+		BpSchema: createInstanceReferenceTestBlueprint(
+			"bluelink://instance/unknown-network/exports/vpcId",
+		),
+	}
+
+	diagnostics, err := ValidateInstanceReferences(context.Background(), valCtx)
+	s.Require().NoError(err)
+	s.Assert().Empty(diagnostics)
+}
+
+func (s *InstanceReferenceValidationTestSuite) Test_reports_error_for_invalid_instance_reference() {
+	valCtx := &ValidationContext{
+		BpSchema: createInstanceReferenceTestBlueprint(
+			"bluelink://instance/shared-network/outputs/vpcId",
+		),
+		InstanceExportLookup: createTestInstanceExportLookup(),
+	}
+
+	_, err := ValidateInstanceReferences(context.Background(), valCtx)
+	s.assertInstanceReferenceError(
+		err,
+		"invalid instance reference \"bluelink://instance/shared-network/outputs/vpcId\"",
+	)
+	s.Assert().Equal(12, *err.(*errors.LoadError).Line)
+}
+
+func (s *InstanceReferenceValidationTestSuite) Test_reports_error_for_instance_that_does_not_exist() {
+	valCtx := &ValidationContext{
+		BpSchema: createInstanceReferenceTestBlueprint(
+			"bluelink://instance/unknown-network/exports/vpcId",
+		),
+		InstanceExportLookup: createTestInstanceExportLookup(),
+	}
+
+	_, err := ValidateInstanceReferences(context.Background(), valCtx)
+	s.assertInstanceReferenceError(
+		err,
+		"the \"unknown-network\" blueprint instance does not exist",
+	)
+}
+
+func (s *InstanceReferenceValidationTestSuite) Test_reports_error_for_export_that_does_not_exist() {
+	valCtx := &ValidationContext{
+		BpSchema: createInstanceReferenceTestBlueprint(
+			"bluelink://instance/shared-network/exports/subnetIds",
+		),
+		InstanceExportLookup: createTestInstanceExportLookup(),
+	}
+
+	_, err := ValidateInstanceReferences(context.Background(), valCtx)
+	s.assertInstanceReferenceError(
+		err,
+		"the export \"subnetIds\" does not exist in the \"shared-network\" blueprint instance",
+	)
+}
+
+func (s *InstanceReferenceValidationTestSuite) Test_reports_errors_for_references_in_values_and_includes() {
+	bpSchema := createInstanceReferenceTestBlueprint(
+		"bluelink://instance/shared-network/exports/vpcId",
+	)
+	bpSchema.Values = &schema.ValueMap{
+		Values: map[string]*schema.Value{
+			"networkId": {
+				Value: core.MappingNodeFromString(
+					"bluelink://instance/unknown-network/exports/vpcId",
+				),
+			},
+		},
+	}
+	bpSchema.Include = &schema.IncludeMap{
+		Values: map[string]*schema.Include{
+			"coreInfra": {
+				Variables: &core.MappingNode{
+					Fields: map[string]*core.MappingNode{
+						"vpcId": core.MappingNodeFromString(
+							"bluelink://instance/shared-network/exports/missing",
+						),
+					},
+				},
+			},
+		},
+	}
+	valCtx := &ValidationContext{
+		BpSchema:             bpSchema,
+		InstanceExportLookup: createTestInstanceExportLookup(),
+	}
+
+	_, err := ValidateInstanceReferences(context.Background(), valCtx)
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeMultipleValidationErrors, loadErr.ReasonCode)
+	s.Require().Len(loadErr.ChildErrors, 2)
+	s.Assert().Contains(
+		loadErr.ChildErrors[0].Error(),
+		"the \"unknown-network\" blueprint instance does not exist",
+	)
+	s.Assert().Contains(
+		loadErr.ChildErrors[1].Error(),
+		"the export \"missing\" does not exist in the \"shared-network\" blueprint instance",
+	)
+}
+
+func (s *InstanceReferenceValidationTestSuite) Test_returns_error_when_instance_export_lookup_fails() {
+	valCtx := &ValidationContext{
+		BpSchema: createInstanceReferenceTestBlueprint(
+			"bluelink://instance/shared-network/exports/vpcId",
+		),
+		InstanceExportLookup: func(
+			ctx context.Context,
+			instanceName string,
+			exportName string,
+		) (bool, bool, error) {
+			return false, false, fmt.Errorf("state container unavailable")
+		},
+	}
+
+	_, err := ValidateInstanceReferences(context.Background(), valCtx)
+	s.Require().Error(err)
+	s.Assert().Equal("state container unavailable", err.Error())
+}
+
+func (s *InstanceReferenceValidationTestSuite) assertInstanceReferenceError(
+	err error,
+	expectedMessage string,
+) {
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeInvalidInstanceReference, loadErr.ReasonCode)
+	s.Assert().Contains(loadErr.Error(), expectedMessage)
+}
+
+func createInstanceReferenceTestBlueprint(vpcIDRef string) *schema.Blueprint {
+	return &schema.Blueprint{
+		Resources: &schema.ResourceMap{
+			Values: map[string]*schema.Resource{
+				"orderFunction": {
+					Type: &schema.ResourceTypeWrapper{Value: "aws/lambda/function"},
+					Spec: &core.MappingNode{
+						Fields: map[string]*core.MappingNode{
+							"vpcConfig": {
+								Fields: map[string]*core.MappingNode{
+									"vpcId": {
+										Scalar: core.ScalarFromString(vpcIDRef),
+										SourceMeta: &source.Meta{
+											Position: source.Position{Line: 12, Column: 16},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createTestInstanceExportLookup() InstanceExportLookup {
+	instanceExports := map[string][]string{
+		"shared-network": {"vpcId"},
+	}
+
+	return func(
+		ctx context.Context,
+		instanceName string,
+		exportName string,
+	) (bool, bool, error) {
+		exports, instanceExists := instanceExports[instanceName]
+		if !instanceExists {
+			return false, false, nil
+		}
+
+		for _, export := range exports {
+			if export == exportName {
+				return true, true, nil
+			}
+		}
+
+		return true, false, nil
+	}
+}
+
+func TestInstanceReferenceValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(InstanceReferenceValidationTestSuite))
+}
@@ -0,0 +1,195 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+)
+
+// ValidateLinkConfig validates the links section of a blueprint
+// that holds configuration for specific links between resources.
+// This ensures that configuration is only provided for links that exist
+// in the provided link chains, that the link implementation supports configuration
+// and that the configuration conforms to the schema provided by the link implementation.
+// Link configuration must only contain literal values, substitutions are not supported.
+//
+// This must only be called after the provided link chains have been checked
+// for cycles.
+// All invalid link configuration is reported, when configuration for more than one link
+// is invalid, the returned error will wrap an error for each invalid link.
+func ValidateLinkConfig(
+	ctx context.Context,
+	linkChains []*links.ChainLinkNode,
+	bpSchema *schema.Blueprint,
+	params core.BlueprintParams,
+) ([]*core.Diagnostic, error) {
+	diagnostics := []*core.Diagnostic{}
+	if bpSchema == nil || bpSchema.Links == nil || len(bpSchema.Links.Values) == 0 {
+		return diagnostics, nil
+	}
+
+	linkImpls := map[string]provider.Link{}
+	collectLinkImplsByName(linkChains, linkImpls, map[string]bool{})
+
+	// Validate links in a deterministic order so errors are reported consistently.
+	linkNames := make([]string, 0, len(bpSchema.Links.Values))
+	for linkName := range bpSchema.Links.Values {
+		linkNames = append(linkNames, linkName)
+	}
+	slices.Sort(linkNames)
+
+	errs := []error{}
+	for _, linkName := range linkNames {
+		linkDiagnostics, err := validateLinkConfig(
+			ctx,
+			linkName,
+			bpSchema.Links.Values[linkName],
+			bpSchema.Links.SourceMeta[linkName],
+			linkImpls[linkName],
+			bpSchema,
+			params,
+		)
+		diagnostics = append(diagnostics, linkDiagnostics...)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 1 {
+		return diagnostics, errs[0]
+	}
+
+	if len(errs) > 1 {
+		return diagnostics, ErrMultipleValidationErrors(errs)
+	}
+
+	return diagnostics, nil
+}
+
+func validateLinkConfig(
+	ctx context.Context,
+	linkName string,
+	linkConfig *core.MappingNode,
+	location *source.Meta,
+	linkImpl provider.Link,
+	bpSchema *schema.Blueprint,
+	params core.BlueprintParams,
+) ([]*core.Diagnostic, error) {
+	if linkImpl == nil {
+		return nil, errLinkConfigLinkNotFound(linkName, location)
+	}
+
+	subsNode := findMappingNodeWithSubstitutions(linkConfig, 0)
+	if subsNode != nil {
+		return nil, errLinkConfigContainsSubstitution(
+			linkName,
+			selectMappingNodeLocation(subsNode, location),
+		)
+	}
+
+	linkCtx := provider.NewLinkContextFromParams(params)
+	configSchema, err := getLinkConfigSchema(ctx, linkImpl, linkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if configSchema == nil {
+		linkTypeOutput, err := linkImpl.GetType(ctx, &provider.LinkGetTypeInput{
+			LinkContext: linkCtx,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return nil, errLinkConfigNotSupported(linkName, linkTypeOutput.Type, location)
+	}
+
+	return validateResourceDefinition(
+		ctx,
+		ResourceValidationParams{
+			ResourceName: linkName,
+			ValidationContext: &ValidationContext{
+				BpSchema: bpSchema,
+				Params:   params,
+			},
+		},
+		linkConfig,
+		location,
+		configSchema,
+		fmt.Sprintf("links.%s", linkName),
+		/* depth */ 0,
+	)
+}
+
+// Retrieves the schema for blueprint-level configuration of a link,
+// nil is returned when the link implementation does not support configuration.
+func getLinkConfigSchema(
+	ctx context.Context,
+	linkImpl provider.Link,
+	linkCtx provider.LinkContext,
+) (*provider.ResourceDefinitionsSchema, error) {
+	configDefProvider, supportsConfig := linkImpl.(provider.LinkConfigDefinitionProvider)
+	if !supportsConfig {
+		return nil, nil
+	}
+
+	output, err := configDefProvider.GetConfigDefinition(
+		ctx,
+		&provider.LinkGetConfigDefinitionInput{
+			LinkContext: linkCtx,
+		},
+	)
+	if err != nil || output == nil {
+		return nil, err
+	}
+
+	return output.Schema, nil
+}
+
+func collectLinkImplsByName(
+	linkChains []*links.ChainLinkNode,
+	linkImpls map[string]provider.Link,
+	visited map[string]bool,
+) {
+	for _, node := range linkChains {
+		if visited[node.ResourceName] {
+			continue
+		}
+		visited[node.ResourceName] = true
+
+		for targetName, linkImpl := range node.LinkImplementations {
+			linkImpls[core.LogicalLinkName(node.ResourceName, targetName)] = linkImpl
+		}
+
+		collectLinkImplsByName(node.LinksTo, linkImpls, visited)
+	}
+}
+
+func findMappingNodeWithSubstitutions(node *core.MappingNode, depth int) *core.MappingNode {
+	if node == nil || depth >= core.MappingNodeMaxTraverseDepth {
+		return nil
+	}
+
+	if node.StringWithSubstitutions != nil {
+		return node
+	}
+
+	for _, field := range node.Fields {
+		if found := findMappingNodeWithSubstitutions(field, depth+1); found != nil {
+			return found
+		}
+	}
+
+	for _, item := range node.Items {
+		if found := findMappingNodeWithSubstitutions(item, depth+1); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/links"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/stretchr/testify/suite"
+)
+
+type LinkConfigValidationTestSuite struct {
+	suite.Suite
+}
+
+func (s *LinkConfigValidationTestSuite) Test_succeeds_for_valid_link_config() {
+	linkChains := createLinkConfigTestChains(createConfigSchemaLink())
+	bpSchema := createLinkConfigTestBlueprint(map[string]*core.MappingNode{
+		"orderFunction::ordersTable": {
+			Fields: map[string]*core.MappingNode{
+				"permissionsScope": core.MappingNodeFromString("read"),
+				"envVarPrefix":     core.MappingNodeFromString("ORDERS_"),
+			},
+		},
+	})
+
+	diagnostics, err := ValidateLinkConfig(
+		context.Background(),
+		linkChains,
+		bpSchema,
+		createParams(),
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(diagnostics)
+}
+
+func (s *LinkConfigValidationTestSuite) Test_succeeds_when_no_link_config_is_provided() {
+	linkChains := createLinkConfigTestChains(&testConfigurableLink{
+		linkType: "aws/lambda/function::aws/dynamodb/table",
+	})
+
+	diagnostics, err := ValidateLinkConfig(
+		context.Background(),
+		linkChains,
+		&schema.Blueprint{},
+		createParams(),
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(diagnostics)
+}
+
+func (s *LinkConfigValidationTestSuite) Test_reports_error_for_link_that_does_not_exist() {
+	linkChains := createLinkConfigTestChains(createConfigSchemaLink())
+	bpSchema := createLinkConfigTestBlueprint(map[string]*core.MappingNode{
+		"ordersTable::orderFunction": {
+			Fields: map[string]*core.MappingNode{
+				"permissionsScope": core.MappingNodeFromString("read"),
+			},
+		},
+	})
+
+	_, err := ValidateLinkConfig(
+		context.Background(),
+		linkChains,
+		bpSchema,
+		createParams(),
+	)
+	s.assertLinkConfigError(
+		err,
+		"configuration being provided for link \"ordersTable::orderFunction\" "+
+			"that does not exist in the blueprint",
+	)
+	s.Assert().Equal(4, *err.(*errors.LoadError).Line)
+}
+
+func (s *LinkConfigValidationTestSuite) Test_reports_error_for_link_that_does_not_support_config() {
+	linkChains := createLinkConfigTestChains(&testConfigurableLink{
+		linkType: "aws/lambda/function::aws/dynamodb/table",
+	})
+	bpSchema := createLinkConfigTestBlueprint(map[string]*core.MappingNode{
+		"orderFunction::ordersTable": {
+			Fields: map[string]*core.MappingNode{
+				"permissionsScope": core.MappingNodeFromString("read"),
+			},
+		},
+	})
+
+	_, err := ValidateLinkConfig(
+		context.Background(),
+		linkChains,
+		bpSchema,
+		createParams(),
+	)
+	s.assertLinkConfigError(
+		err,
+		"the \"aws/lambda/function::aws/dynamodb/table\" link type does not support configuration",
+	)
+}
+
+func (s *LinkConfigValidationTestSuite) Test_reports_error_for_link_config_with_substitutions() {
+	linkChains := createLinkConfigTestChains(createConfigSchemaLink())
+	envPrefixVar := "envPrefix"
+	bpSchema := createLinkConfigTestBlueprint(map[string]*core.MappingNode{
+		"orderFunction::ordersTable": {
+			Fields: map[string]*core.MappingNode{
+				"envVarPrefix": {
+					StringWithSubstitutions: &substitutions.StringOrSubstitutions{
+						Values: []*substitutions.StringOrSubstitution{
+							{
+								SubstitutionValue: &substitutions.Substitution{
+									Variable: &substitutions.SubstitutionVariable{
+										VariableName: envPrefixVar,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	_, err := ValidateLinkConfig(
+		context.Background(),
+		linkChains,
+		bpSchema,
+		createParams(),
+	)
+	s.assertLinkConfigError(
+		err,
+		"the configuration for link \"orderFunction::ordersTable\" containing a substitution",
+	)
+}
+
+func (s *LinkConfigValidationTestSuite) Test_reports_error_for_link_config_that_does_not_match_schema() {
+	linkChains := createLinkConfigTestChains(createConfigSchemaLink())
+	bpSchema := createLinkConfigTestBlueprint(map[string]*core.MappingNode{
+		"orderFunction::ordersTable": {
+			Fields: map[string]*core.MappingNode{
+				"permissionsScope": core.MappingNodeFromInt(10),
+			},
+		},
+	})
+
+	_, err := ValidateLinkConfig(
+		context.Background(),
+		linkChains,
+		bpSchema,
+		createParams(),
+	)
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Require().Len(loadErr.ChildErrors, 1)
+	s.Assert().Contains(
+		loadErr.ChildErrors[0].Error(),
+		"links.orderFunction::ordersTable.permissionsScope",
+	)
+}
+
+func (s *LinkConfigValidationTestSuite) assertLinkConfigError(err error, expectedMessage string) {
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeInvalidLinkConfig, loadErr.ReasonCode)
+	s.Assert().Contains(loadErr.Error(), expectedMessage)
+}
+
+func createConfigSchemaLink() *testConfigSchemaLink {
+	return &testConfigSchemaLink{
+		testConfigurableLink: testConfigurableLink{
+			linkType: "aws/lambda/function::aws/dynamodb/table",
+		},
+		configSchema: &provider.ResourceDefinitionsSchema{
+			Type: provider.ResourceDefinitionsSchemaTypeObject,
+			Attributes: map[string]*provider.ResourceDefinitionsSchema{
+				"permissionsScope": {
+					Type: provider.ResourceDefinitionsSchemaTypeString,
+					AllowedValues: []*core.MappingNode{
+						core.MappingNodeFromString("read"),
+						core.MappingNodeFromString("write"),
+						core.MappingNodeFromString("readWrite"),
+					},
+				},
+				"envVarPrefix": {
+					Type: provider.ResourceDefinitionsSchemaTypeString,
+				},
+			},
+		},
+	}
+}
+
+func createLinkConfigTestChains(linkImpl provider.Link) []*links.ChainLinkNode {
+	nodeA := createTestChainLinkNode(
+		"orderFunction",
+		"aws/lambda/function",
+		nil,
+		map[string]provider.Link{
+			"ordersTable": linkImpl,
+		},
+	)
+	nodeB := createTestChainLinkNode(
+		"ordersTable",
+		"aws/dynamodb/table",
+		nil,
+		nil,
+	)
+	nodeA.LinksTo = []*links.ChainLinkNode{nodeB}
+	nodeB.LinkedFrom = []*links.ChainLinkNode{nodeA}
+	return []*links.ChainLinkNode{nodeA}
+}
+
+func createLinkConfigTestBlueprint(linkConfig map[string]*core.MappingNode) *schema.Blueprint {
+	sourceMeta := map[string]*source.Meta{}
+	for linkName := range linkConfig {
+		sourceMeta[linkName] = &source.Meta{
+			Position: source.Position{Line: 4, Column: 3},
+		}
+	}
+
+	return &schema.Blueprint{
+		Links: &schema.LinkConfigMap{
+			Values:     linkConfig,
+			SourceMeta: sourceMeta,
+		},
+	}
+}
+
+func TestLinkConfigValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(LinkConfigValidationTestSuite))
+}
@@ -279,3 +279,19 @@ func (l *testConfigurableLink) ValidateLink(
 	}
 	return &provider.LinkValidateOutput{}, nil
 }
+
+// testConfigSchemaLink is a mock link implementation that supports
+// blueprint-level configuration for testing ValidateLinkConfig.
+type testConfigSchemaLink struct {
+	testConfigurableLink
+	configSchema *provider.ResourceDefinitionsSchema
+}
+
+func (l *testConfigSchemaLink) GetConfigDefinition(
+	ctx context.Context,
+	input *provider.LinkGetConfigDefinitionInput,
+) (*provider.LinkGetConfigDefinitionOutput, error) {
+	return &provider.LinkGetConfigDefinitionOutput{
+		Schema: l.configSchema,
+	}, nil
+}
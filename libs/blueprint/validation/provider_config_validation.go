@@ -0,0 +1,135 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+)
+
+// ValidateProviderConfig checks the configuration supplied in the blueprint params
+// for each provider used by resources and data sources in the given blueprint
+// against the config definition of the provider.
+// This checks for missing required fields, values that do not match the type
+// of a config field, values that are not in the list of allowed values and unexpected
+// fields along with any custom validation defined by the provider.
+//
+// Providers that are used in the blueprint but are not in the given map of providers
+// are skipped, these are reported when validating resources and data sources.
+// Errors are reported at the location of the first resource or data source in the blueprint
+// that uses the provider as provider configuration is not defined in the blueprint.
+func ValidateProviderConfig(
+	ctx context.Context,
+	bpSchema *schema.Blueprint,
+	params core.BlueprintParams,
+	providers map[string]provider.Provider,
+) ([]*core.Diagnostic, error) {
+	diagnostics := []*core.Diagnostic{}
+	if bpSchema == nil || params == nil {
+		return diagnostics, nil
+	}
+
+	usages := collectProviderUsages(bpSchema)
+	errs := []error{}
+	for _, providerNamespace := range sortedKeys(usages) {
+		providerImpl, hasProvider := providers[providerNamespace]
+		if !hasProvider {
+			continue
+		}
+
+		configDefinition, err := providerImpl.ConfigDefinition(ctx)
+		if err != nil {
+			return diagnostics, err
+		}
+		if configDefinition == nil {
+			continue
+		}
+
+		configDiagnostics, err := core.ValidateConfigDefinition(
+			providerNamespace,
+			"provider",
+			params.ProviderConfig(providerNamespace),
+			configDefinition,
+		)
+		if err != nil {
+			return diagnostics, err
+		}
+
+		location := usages[providerNamespace]
+		for _, diagnostic := range configDiagnostics {
+			if diagnostic.Level == core.DiagnosticLevelError {
+				errs = append(
+					errs,
+					errInvalidProviderConfig(providerNamespace, diagnostic.Message, location),
+				)
+			} else {
+				diagnostics = append(diagnostics, &core.Diagnostic{
+					Level:   diagnostic.Level,
+					Message: diagnostic.Message,
+					Range:   core.DiagnosticRangeFromSourceMeta(location, nil),
+				})
+			}
+		}
+	}
+
+	if len(errs) == 1 {
+		return diagnostics, errs[0]
+	}
+
+	if len(errs) > 1 {
+		return diagnostics, ErrMultipleValidationErrors(errs)
+	}
+
+	return diagnostics, nil
+}
+
+// Collects the providers used by resources and data sources in a blueprint,
+// mapping each provider namespace to the location of the type of the
+// first element in the blueprint that uses the provider.
+func collectProviderUsages(bpSchema *schema.Blueprint) map[string]*source.Meta {
+	usages := map[string]*source.Meta{}
+	addUsage := func(elementType string, location *source.Meta) {
+		providerNamespace := provider.ExtractProviderFromItemType(elementType)
+		if providerNamespace == "" {
+			return
+		}
+
+		current, hasUsage := usages[providerNamespace]
+		if !hasUsage || isEarlierLocation(location, current) {
+			usages[providerNamespace] = location
+		}
+	}
+
+	if bpSchema.Resources != nil {
+		for _, resource := range bpSchema.Resources.Values {
+			if resource != nil && resource.Type != nil {
+				addUsage(resource.Type.Value, resource.Type.SourceMeta)
+			}
+		}
+	}
+
+	if bpSchema.DataSources != nil {
+		for _, dataSource := range bpSchema.DataSources.Values {
+			if dataSource != nil && dataSource.Type != nil {
+				addUsage(dataSource.Type.Value, dataSource.Type.SourceMeta)
+			}
+		}
+	}
+
+	return usages
+}
+
+func isEarlierLocation(location *source.Meta, current *source.Meta) bool {
+	if location == nil {
+		return false
+	}
+
+	if current == nil {
+		return true
+	}
+
+	return location.Line < current.Line ||
+		(location.Line == current.Line && location.Column < current.Column)
+}
@@ -0,0 +1,177 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProviderConfigValidationTestSuite struct {
+	suite.Suite
+	providers map[string]provider.Provider
+}
+
+func (s *ProviderConfigValidationTestSuite) SetupTest() {
+	s.providers = map[string]provider.Provider{
+		"aws": &internal.ProviderMock{
+			NamespaceValue: "aws",
+			ProviderConfigDef: &core.ConfigDefinition{
+				Fields: map[string]*core.ConfigFieldDefinition{
+					"region": {
+						Type:     core.ScalarTypeString,
+						Required: true,
+						AllowedValues: []*core.ScalarValue{
+							core.ScalarFromString("us-east-1"),
+							core.ScalarFromString("eu-west-2"),
+						},
+					},
+					"maxRetries": {
+						Type: core.ScalarTypeInteger,
+					},
+				},
+			},
+		},
+		"unconfigured": &internal.ProviderMock{
+			NamespaceValue: "unconfigured",
+		},
+	}
+}
+
+func (s *ProviderConfigValidationTestSuite) Test_succeeds_for_valid_provider_config() {
+	params := core.NewDefaultParams(
+		map[string]map[string]*core.ScalarValue{
+			"aws": {
+				"region":     core.ScalarFromString("eu-west-2"),
+				"maxRetries": core.ScalarFromInt(3),
+			},
+		},
+		nil, nil, nil,
+	)
+
+	diagnostics, err := ValidateProviderConfig(
+		context.Background(),
+		createProviderConfigTestBlueprint(),
+		params,
+		s.providers,
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(diagnostics)
+}
+
+func (s *ProviderConfigValidationTestSuite) Test_reports_error_for_missing_required_field() {
+	params := core.NewDefaultParams(
+		map[string]map[string]*core.ScalarValue{},
+		nil, nil, nil,
+	)
+
+	_, err := ValidateProviderConfig(
+		context.Background(),
+		createProviderConfigTestBlueprint(),
+		params,
+		s.providers,
+	)
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeInvalidProviderConfig, loadErr.ReasonCode)
+	s.Assert().Contains(loadErr.Error(), "The \"aws\" provider configuration requires the field \"region\".")
+	// The error is reported at the type of the first element that uses the provider.
+	s.Assert().Equal(8, *loadErr.Line)
+	s.Require().NotNil(loadErr.Context)
+	s.Assert().Equal(errors.ErrorCategoryProvider, loadErr.Context.Category)
+	s.Require().Len(loadErr.Context.SuggestedActions, 1)
+	s.Assert().Equal(
+		string(errors.ActionTypeCheckConfiguration),
+		loadErr.Context.SuggestedActions[0].Type,
+	)
+	s.Assert().Equal("aws", loadErr.Context.Metadata["providerNamespace"])
+}
+
+func (s *ProviderConfigValidationTestSuite) Test_reports_errors_for_invalid_types_and_values() {
+	params := core.NewDefaultParams(
+		map[string]map[string]*core.ScalarValue{
+			"aws": {
+				"region":     core.ScalarFromString("ap-south-1"),
+				"maxRetries": core.ScalarFromString("three"),
+				"profile":    core.ScalarFromString("default"),
+			},
+		},
+		nil, nil, nil,
+	)
+
+	_, err := ValidateProviderConfig(
+		context.Background(),
+		createProviderConfigTestBlueprint(),
+		params,
+		s.providers,
+	)
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeMultipleValidationErrors, loadErr.ReasonCode)
+	s.Require().Len(loadErr.ChildErrors, 3)
+	for _, childErr := range loadErr.ChildErrors {
+		childLoadErr, isChildLoadErr := childErr.(*errors.LoadError)
+		s.Require().True(isChildLoadErr)
+		s.Assert().Equal(ErrorReasonCodeInvalidProviderConfig, childLoadErr.ReasonCode)
+	}
+}
+
+func (s *ProviderConfigValidationTestSuite) Test_skips_providers_not_used_in_blueprint() {
+	params := core.NewDefaultParams(
+		map[string]map[string]*core.ScalarValue{},
+		nil, nil, nil,
+	)
+
+	diagnostics, err := ValidateProviderConfig(
+		context.Background(),
+		&schema.Blueprint{
+			Resources: &schema.ResourceMap{
+				Values: map[string]*schema.Resource{
+					"queue": {
+						Type: &schema.ResourceTypeWrapper{Value: "unconfigured/queue"},
+					},
+					"bucket": {
+						Type: &schema.ResourceTypeWrapper{Value: "missing/bucket"},
+					},
+				},
+			},
+		},
+		params,
+		s.providers,
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(diagnostics)
+}
+
+func createProviderConfigTestBlueprint() *schema.Blueprint {
+	return &schema.Blueprint{
+		Resources: &schema.ResourceMap{
+			Values: map[string]*schema.Resource{
+				"ordersTable": {
+					Type: &schema.ResourceTypeWrapper{
+						Value:      "aws/dynamodb/table",
+						SourceMeta: &source.Meta{Position: source.Position{Line: 14, Column: 11}},
+					},
+				},
+				"ordersQueue": {
+					Type: &schema.ResourceTypeWrapper{
+						Value:      "aws/sqs/queue",
+						SourceMeta: &source.Meta{Position: source.Position{Line: 8, Column: 11}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestProviderConfigValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(ProviderConfigValidationTestSuite))
+}
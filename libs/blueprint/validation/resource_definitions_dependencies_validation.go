@@ -0,0 +1,160 @@
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+)
+
+// Validates the constraints between attributes of an object in a resource spec
+// that are defined with the `RequiredIf`, `ConflictsWith` and `ExactlyOneOf`
+// fields of the attribute schemas.
+// Attributes are checked in the order of their names so that the same
+// errors are reported every time a resource is validated.
+func validateResourceDefinitionFieldDependencies(
+	params ResourceValidationParams,
+	node *core.MappingNode,
+	parentLocation *source.Meta,
+	objectSchema *provider.ResourceDefinitionsSchema,
+	path string,
+) []error {
+	errs := []error{}
+	// Conflicts and exactly one of constraints can be defined
+	// on each of the attributes involved so they are tracked
+	// to avoid reporting the same issue more than once.
+	checkedConflicts := map[string]bool{}
+	checkedExactlyOneOf := map[string]bool{}
+
+	for _, attrName := range sortedKeys(objectSchema.Attributes) {
+		attrSchema := objectSchema.Attributes[attrName]
+		if attrSchema == nil {
+			continue
+		}
+
+		attrPath := fmt.Sprintf("%s.%s", path, attrName)
+		attrSet := isResourceDefinitionFieldSet(node, attrName)
+
+		if !attrSet &&
+			attrSchema.RequiredIf != nil &&
+			// Missing required fields are already reported.
+			!slices.Contains(objectSchema.Required, attrName) &&
+			isResourceDefinitionConditionMet(node, attrSchema.RequiredIf) {
+			errs = append(errs, errResourceDefMissingConditionallyRequiredField(
+				attrPath,
+				params.ResourceType,
+				attrName,
+				attrSchema.RequiredIf,
+				parentLocation,
+			))
+		}
+
+		if attrSet {
+			for _, conflictingField := range attrSchema.ConflictsWith {
+				conflictKey := fieldSetKey([]string{attrName, conflictingField})
+				if !checkedConflicts[conflictKey] && isResourceDefinitionFieldSet(node, conflictingField) {
+					checkedConflicts[conflictKey] = true
+					errs = append(errs, errResourceDefConflictingFields(
+						attrPath,
+						params.ResourceType,
+						attrName,
+						conflictingField,
+						selectFieldLocation(node.FieldsSourceMeta, attrName, parentLocation),
+					))
+				}
+			}
+		}
+
+		if len(attrSchema.ExactlyOneOf) > 0 {
+			exactlyOneOfKey := fieldSetKey(attrSchema.ExactlyOneOf)
+			if !checkedExactlyOneOf[exactlyOneOfKey] {
+				checkedExactlyOneOf[exactlyOneOfKey] = true
+				err := validateResourceDefinitionExactlyOneOf(
+					params,
+					node,
+					parentLocation,
+					attrSchema.ExactlyOneOf,
+					path,
+				)
+				if err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateResourceDefinitionExactlyOneOf(
+	params ResourceValidationParams,
+	node *core.MappingNode,
+	parentLocation *source.Meta,
+	fields []string,
+	path string,
+) error {
+	sortedFields := slices.Sorted(slices.Values(fields))
+	setFields := []string{}
+	for _, field := range sortedFields {
+		if isResourceDefinitionFieldSet(node, field) {
+			setFields = append(setFields, field)
+		}
+	}
+
+	if len(setFields) == 1 {
+		return nil
+	}
+
+	// When none of the fields are set, the object is highlighted,
+	// otherwise the second field that is set is highlighted as the first
+	// field that is set would be valid on its own.
+	location := parentLocation
+	if len(setFields) > 1 {
+		location = selectFieldLocation(node.FieldsSourceMeta, setFields[1], parentLocation)
+	}
+
+	return errResourceDefExactlyOneOfConstraintFailure(
+		path,
+		params.ResourceType,
+		sortedFields,
+		setFields,
+		location,
+	)
+}
+
+func isResourceDefinitionFieldSet(node *core.MappingNode, field string) bool {
+	fieldNode, hasField := node.Fields[field]
+	return hasField && !isMappingNodeEmpty(fieldNode)
+}
+
+func isResourceDefinitionConditionMet(
+	node *core.MappingNode,
+	condition *provider.ResourceDefinitionsCondition,
+) bool {
+	if !isResourceDefinitionFieldSet(node, condition.Field) {
+		return false
+	}
+
+	if len(condition.Values) == 0 {
+		return true
+	}
+
+	conditionNode := node.Fields[condition.Field]
+	return core.IsScalarMappingNode(conditionNode) &&
+		slices.ContainsFunc(
+			condition.Values,
+			func(value *core.MappingNode) bool {
+				return core.IsScalarMappingNode(value) &&
+					conditionNode.Scalar.Equal(value.Scalar)
+			},
+		)
+}
+
+// Creates a key for a set of field names that is the same
+// regardless of the order of the field names.
+func fieldSetKey(fields []string) string {
+	return strings.Join(slices.Sorted(slices.Values(fields)), ",")
+}
@@ -0,0 +1,204 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResourceDefinitionsDependenciesValidationTestSuite struct {
+	suite.Suite
+}
+
+func (s *ResourceDefinitionsDependenciesValidationTestSuite) Test_succeeds_when_field_dependencies_are_satisfied() {
+	err := validateFieldDependenciesTestSpec(map[string]*core.MappingNode{
+		"protocol":       core.MappingNodeFromString("https"),
+		"certificateArn": core.MappingNodeFromString("arn:aws:acm:eu-west-2:123456789012:certificate/1"),
+		"subnetIds": {
+			Items: []*core.MappingNode{
+				core.MappingNodeFromString("subnet-1"),
+			},
+		},
+		"sourceCode": core.MappingNodeFromString("exports.handler = () => {}"),
+	})
+	s.Assert().NoError(err)
+}
+
+func (s *ResourceDefinitionsDependenciesValidationTestSuite) Test_succeeds_when_condition_for_required_field_is_not_met() {
+	err := validateFieldDependenciesTestSpec(map[string]*core.MappingNode{
+		"protocol":   core.MappingNodeFromString("http"),
+		"sourceCode": core.MappingNodeFromString("exports.handler = () => {}"),
+	})
+	s.Assert().NoError(err)
+}
+
+func (s *ResourceDefinitionsDependenciesValidationTestSuite) Test_reports_error_for_missing_conditionally_required_fields() {
+	err := validateFieldDependenciesTestSpec(map[string]*core.MappingNode{
+		"protocol":       core.MappingNodeFromString("https"),
+		"securityGroups": core.MappingNodeFromString("sg-1"),
+		"sourceCode":     core.MappingNodeFromString("exports.handler = () => {}"),
+	})
+
+	childErrs := s.childLoadErrors(err)
+	s.Require().Len(childErrs, 2)
+	s.Assert().Equal(ErrorReasonCodeResourceDefMissingConditionallyRequiredField, childErrs[0].ReasonCode)
+	s.Assert().Equal(
+		"blueprint load error: validation failed due to a missing field \"certificateArn\" "+
+			"at path \"resources.testLoadBalancer.spec.certificateArn\" that is required when "+
+			"\"protocol\" is set to one of the following values: https, tls",
+		childErrs[0].Error(),
+	)
+	s.Assert().Equal(1, *childErrs[0].Line)
+	s.Assert().Equal(
+		"blueprint load error: validation failed due to a missing field \"subnetIds\" "+
+			"at path \"resources.testLoadBalancer.spec.subnetIds\" that is required when "+
+			"\"securityGroups\" is set",
+		childErrs[1].Error(),
+	)
+}
+
+func (s *ResourceDefinitionsDependenciesValidationTestSuite) Test_reports_error_for_conflicting_fields() {
+	err := validateFieldDependenciesTestSpec(map[string]*core.MappingNode{
+		"sourceCode":   core.MappingNodeFromString("exports.handler = () => {}"),
+		"sourceBucket": core.MappingNodeFromString("code-bucket"),
+		"protocol":     core.MappingNodeFromString("http"),
+	})
+
+	childErrs := s.childLoadErrors(err)
+	// The conflict is defined on both fields but is only reported once
+	// along with the failure for the exactly one of constraint.
+	s.Require().Len(childErrs, 2)
+	s.Assert().Equal(ErrorReasonCodeResourceDefConflictingFields, childErrs[0].ReasonCode)
+	s.Assert().Equal(
+		"blueprint load error: validation failed due to conflicting fields at path "+
+			"\"resources.testLoadBalancer.spec.sourceBucket\", \"sourceBucket\" can not be set "+
+			"when \"sourceCode\" is set",
+		childErrs[0].Error(),
+	)
+	s.Assert().Equal(4, *childErrs[0].Line)
+	s.Assert().Equal(5, *childErrs[0].Column)
+	s.Assert().Equal(4, *childErrs[0].EndLine)
+	s.Assert().Equal(17, *childErrs[0].EndColumn)
+
+	s.Assert().Equal(ErrorReasonCodeResourceDefExactlyOneOfConstraintFailure, childErrs[1].ReasonCode)
+	s.Assert().Equal(
+		"blueprint load error: validation failed at path \"resources.testLoadBalancer.spec\" "+
+			"as exactly one of the fields sourceBucket, sourceCode must be set, "+
+			"found sourceBucket, sourceCode set",
+		childErrs[1].Error(),
+	)
+	s.Assert().Equal(6, *childErrs[1].Line)
+}
+
+func (s *ResourceDefinitionsDependenciesValidationTestSuite) Test_reports_error_when_none_of_exactly_one_of_fields_are_set() {
+	err := validateFieldDependenciesTestSpec(map[string]*core.MappingNode{
+		"protocol": core.MappingNodeFromString("http"),
+	})
+
+	childErrs := s.childLoadErrors(err)
+	s.Require().Len(childErrs, 1)
+	s.Assert().Equal(ErrorReasonCodeResourceDefExactlyOneOfConstraintFailure, childErrs[0].ReasonCode)
+	s.Assert().Equal(
+		"blueprint load error: validation failed at path \"resources.testLoadBalancer.spec\" "+
+			"as exactly one of the fields sourceBucket, sourceCode must be set, "+
+			"none of the fields are set",
+		childErrs[0].Error(),
+	)
+	s.Assert().Equal(1, *childErrs[0].Line)
+}
+
+func (s *ResourceDefinitionsDependenciesValidationTestSuite) childLoadErrors(err error) []*errors.LoadError {
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Require().Equal(ErrorReasonCodeMultipleValidationErrors, loadErr.ReasonCode)
+
+	childErrs := []*errors.LoadError{}
+	for _, childErr := range loadErr.ChildErrors {
+		childLoadErr, isChildLoadErr := childErr.(*errors.LoadError)
+		s.Require().True(isChildLoadErr)
+		childErrs = append(childErrs, childLoadErr)
+	}
+	return childErrs
+}
+
+func validateFieldDependenciesTestSpec(fields map[string]*core.MappingNode) error {
+	_, err := validateResourceDefinition(
+		context.Background(),
+		ResourceValidationParams{
+			ResourceName:      "testLoadBalancer",
+			ResourceType:      "test/loadBalancer",
+			ValidationContext: &ValidationContext{},
+		},
+		&core.MappingNode{
+			Fields: fields,
+			FieldsSourceMeta: map[string]*source.Meta{
+				"sourceBucket": {
+					Position:    source.Position{Line: 4, Column: 5},
+					EndPosition: &source.Position{Line: 4, Column: 17},
+				},
+				"sourceCode": {
+					Position:    source.Position{Line: 6, Column: 5},
+					EndPosition: &source.Position{Line: 6, Column: 15},
+				},
+			},
+		},
+		&source.Meta{Position: source.Position{Line: 1, Column: 3}},
+		fieldDependenciesTestSchema(),
+		"resources.testLoadBalancer.spec",
+		/* depth */ 0,
+	)
+	return err
+}
+
+func fieldDependenciesTestSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type: provider.ResourceDefinitionsSchemaTypeObject,
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"protocol": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+			},
+			"certificateArn": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				RequiredIf: &provider.ResourceDefinitionsCondition{
+					Field: "protocol",
+					Values: []*core.MappingNode{
+						core.MappingNodeFromString("https"),
+						core.MappingNodeFromString("tls"),
+					},
+				},
+			},
+			"securityGroups": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+			},
+			"subnetIds": {
+				Type: provider.ResourceDefinitionsSchemaTypeArray,
+				Items: &provider.ResourceDefinitionsSchema{
+					Type: provider.ResourceDefinitionsSchemaTypeString,
+				},
+				RequiredIf: &provider.ResourceDefinitionsCondition{
+					Field: "securityGroups",
+				},
+			},
+			"sourceBucket": {
+				Type:          provider.ResourceDefinitionsSchemaTypeString,
+				ConflictsWith: []string{"sourceCode"},
+				ExactlyOneOf:  []string{"sourceCode", "sourceBucket"},
+			},
+			"sourceCode": {
+				Type:          provider.ResourceDefinitionsSchemaTypeString,
+				ConflictsWith: []string{"sourceBucket"},
+				ExactlyOneOf:  []string{"sourceBucket", "sourceCode"},
+			},
+		},
+	}
+}
+
+func TestResourceDefinitionsDependenciesValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(ResourceDefinitionsDependenciesValidationTestSuite))
+}
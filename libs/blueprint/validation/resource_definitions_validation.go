@@ -51,6 +51,40 @@ func validateResourceDefinition(
 		)
 	}
 
+	if validateAgainstSchema.Deprecated && !isEmpty {
+		diagnostics = append(
+			diagnostics,
+			deprecatedResourceFieldDiagnostic(
+				path,
+				params.ResourceName,
+				params.ResourceType,
+				validateAgainstSchema.DeprecationMessage,
+				selectMappingNodeLocation(spec, parentLocation),
+			),
+		)
+	}
+
+	typeDiagnostics, err := validateResourceDefinitionForType(
+		ctx,
+		params,
+		spec,
+		parentLocation,
+		validateAgainstSchema,
+		path,
+		depth,
+	)
+	return append(diagnostics, typeDiagnostics...), err
+}
+
+func validateResourceDefinitionForType(
+	ctx context.Context,
+	params ResourceValidationParams,
+	spec *core.MappingNode,
+	parentLocation *source.Meta,
+	validateAgainstSchema *provider.ResourceDefinitionsSchema,
+	path string,
+	depth int,
+) ([]*core.Diagnostic, error) {
 	switch validateAgainstSchema.Type {
 	case provider.ResourceDefinitionsSchemaTypeObject:
 		return validateResourceDefinitionObject(
@@ -129,7 +163,7 @@ func validateResourceDefinition(
 			depth,
 		)
 	default:
-		return diagnostics, provider.ErrUnknownResourceDefSchemaType(
+		return []*core.Diagnostic{}, provider.ErrUnknownResourceDefSchemaType(
 			validateAgainstSchema.Type,
 			params.ResourceType,
 		)
@@ -225,6 +259,17 @@ func validateResourceDefinitionObject(
 		}
 	}
 
+	errs = append(
+		errs,
+		validateResourceDefinitionFieldDependencies(
+			params,
+			node,
+			parentLocation,
+			validateAgainstSchema,
+			path,
+		)...,
+	)
+
 	for fieldName := range node.Fields {
 		fieldPath := fmt.Sprintf("%s.%s", path, fieldName)
 		if _, hasAttr := validateAgainstSchema.Attributes[fieldName]; !hasAttr {
@@ -516,10 +561,18 @@ func validateResourceDefinitionString(
 		)
 	}
 
-	if len(schema.AllowedValues) > 0 {
+	allowedValuesSchema, allowedValuesFromDiagnostics := resolveResourceDefinitionAllowedValues(
+		ctx,
+		params,
+		schema,
+		path,
+		selectMappingNodeLocation(node, parentLocation),
+	)
+	diagnostics = append(diagnostics, allowedValuesFromDiagnostics...)
+	if len(allowedValuesSchema.AllowedValues) > 0 {
 		allowedValueDiagnostics, err := validateResourceDefinitionAllowedValues(
 			node,
-			schema,
+			allowedValuesSchema,
 			params.ResourceType,
 			path,
 			selectMappingNodeLocation(node, parentLocation),
@@ -651,10 +704,18 @@ func validateResourceDefinitionInteger(
 		)
 	}
 
-	if len(schema.AllowedValues) > 0 {
+	allowedValuesSchema, allowedValuesFromDiagnostics := resolveResourceDefinitionAllowedValues(
+		ctx,
+		params,
+		schema,
+		path,
+		selectMappingNodeLocation(node, parentLocation),
+	)
+	diagnostics = append(diagnostics, allowedValuesFromDiagnostics...)
+	if len(allowedValuesSchema.AllowedValues) > 0 {
 		allowedValueDiagnostics, err := validateResourceDefinitionAllowedValues(
 			node,
-			schema,
+			allowedValuesSchema,
 			params.ResourceType,
 			path,
 			selectMappingNodeLocation(node, parentLocation),
@@ -772,10 +833,18 @@ func validateResourceDefinitionFloat(
 		)
 	}
 
-	if len(schema.AllowedValues) > 0 {
+	allowedValuesSchema, allowedValuesFromDiagnostics := resolveResourceDefinitionAllowedValues(
+		ctx,
+		params,
+		schema,
+		path,
+		selectMappingNodeLocation(node, parentLocation),
+	)
+	diagnostics = append(diagnostics, allowedValuesFromDiagnostics...)
+	if len(allowedValuesSchema.AllowedValues) > 0 {
 		allowedValueDiagnostics, err := validateResourceDefinitionAllowedValues(
 			node,
-			schema,
+			allowedValuesSchema,
 			params.ResourceType,
 			path,
 			selectMappingNodeLocation(node, parentLocation),
@@ -1016,6 +1085,7 @@ func validateResourceDefinitionSubstitution(
 					RefChainCollector:  params.RefChainCollector,
 					ResourceRegistry:   params.ResourceRegistry,
 					DataSourceRegistry: params.DataSourceRegistry,
+					ElementUsage:       params.ElementUsage,
 				},
 				params.ResourceDerivedFromTemplate,
 				resourceIdentifier,
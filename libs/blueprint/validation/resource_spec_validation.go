@@ -3,8 +3,10 @@ package validation
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
@@ -41,6 +43,18 @@ func ValidateResourceSpec(
 		return diagnostics, err
 	}
 
+	if specDefinition.Deprecated {
+		diagnostics = append(
+			diagnostics,
+			deprecatedResourceTypeDiagnostic(
+				params.ResourceName,
+				params.ResourceType,
+				specDefinition.DeprecationMessage,
+				resource.Type.SourceMeta,
+			),
+		)
+	}
+
 	var errs []error
 	path := fmt.Sprintf("resources.%s.spec", params.ResourceName)
 	specDiagnostics, err := validateResourceDefinition(
@@ -57,6 +71,17 @@ func ValidateResourceSpec(
 		errs = append(errs, err)
 	}
 
+	err = validateResourceIgnoreChanges(
+		params.ResourceName,
+		params.ResourceType,
+		resource.IgnoreChanges,
+		specDefinition.Schema,
+		resourceLocation,
+	)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
 	providerNamespace := provider.ExtractProviderFromItemType(params.ResourceType)
 	customOutput, err := params.ResourceRegistry.CustomValidate(
 		ctx,
@@ -70,6 +95,7 @@ func ValidateResourceSpec(
 		},
 	)
 	if customOutput != nil {
+		resolveSpecPathRanges(customOutput.Diagnostics, resource.Spec, resourceLocation)
 		diagnostics = append(diagnostics, customOutput.Diagnostics...)
 	}
 	if err != nil {
@@ -83,6 +109,27 @@ func ValidateResourceSpec(
 	return diagnostics, nil
 }
 
+// Resolves the source ranges for diagnostics from custom validation
+// that refer to a field in the resource spec without an explicit range.
+// When there is no source metadata for the field or any of its ancestors,
+// the location of the resource is used.
+func resolveSpecPathRanges(
+	diagnostics []*core.Diagnostic,
+	spec *core.MappingNode,
+	resourceLocation *source.Meta,
+) {
+	for _, diagnostic := range diagnostics {
+		if diagnostic == nil || diagnostic.SpecPath == "" || diagnostic.Range != nil {
+			continue
+		}
+
+		diagnostic.Range = core.DiagnosticRangeFromSpecPath(diagnostic.SpecPath, spec)
+		if diagnostic.Range == nil && resourceLocation != nil {
+			diagnostic.Range = core.DiagnosticRangeFromSourceMeta(resourceLocation, nil)
+		}
+	}
+}
+
 func loadResourceSpecDefinition(
 	ctx context.Context,
 	resourceType string,
@@ -127,3 +174,196 @@ func loadResourceSpecDefinition(
 
 	return specDefOutput.SpecDefinition, nil
 }
+
+func validateResourceIgnoreChanges(
+	resourceName string,
+	resourceType string,
+	ignoreChanges *schema.IgnoreChangesList,
+	specSchema *provider.ResourceDefinitionsSchema,
+	resourceLocation *source.Meta,
+) error {
+	if ignoreChanges == nil {
+		return nil
+	}
+
+	var errs []error
+	for i, path := range ignoreChanges.Values {
+		location := resourceLocation
+		if i < len(ignoreChanges.SourceMeta) && ignoreChanges.SourceMeta[i] != nil {
+			location = ignoreChanges.SourceMeta[i]
+		}
+
+		if !strings.HasPrefix(path, "spec.") && !strings.HasPrefix(path, "spec[") {
+			errs = append(errs, errInvalidResourceIgnoreChangesPath(
+				resourceName,
+				path,
+				"paths must start with \"spec.\" and refer to a field in the resource spec",
+				location,
+			))
+			continue
+		}
+
+		segments, err := core.ParsePathPattern(core.ReplaceSpecWithRoot(path))
+		if err != nil {
+			errs = append(errs, errInvalidResourceIgnoreChangesPath(
+				resourceName,
+				path,
+				err.Error(),
+				location,
+			))
+			continue
+		}
+
+		inSchema := pathSegmentsInSpecSchema(
+			segments,
+			specSchema,
+			/* depth */ 0,
+		)
+		if !inSchema {
+			errs = append(errs, errInvalidResourceIgnoreChangesPath(
+				resourceName,
+				path,
+				fmt.Sprintf(
+					"the path does not exist in the spec definition for the %q resource type",
+					resourceType,
+				),
+				location,
+			))
+		}
+	}
+
+	if len(errs) > 0 {
+		return ErrMultipleValidationErrors(errs)
+	}
+
+	return nil
+}
+
+// pathSegmentsInSpecSchema determines whether a parsed spec field path
+// can be resolved against a resource spec definition schema.
+func pathSegmentsInSpecSchema(
+	segments []*core.PathPatternSegment,
+	specSchema *provider.ResourceDefinitionsSchema,
+	depth int,
+) bool {
+	if len(segments) == 0 || depth >= core.MappingNodeMaxTraverseDepth {
+		return true
+	}
+
+	if specSchema == nil {
+		// A free-form map or an array with no declared item schema
+		// can hold any nested structure.
+		return true
+	}
+
+	segment := segments[0]
+	switch specSchema.Type {
+	case provider.ResourceDefinitionsSchemaTypeObject:
+		if segment.IsArrayAccessor() {
+			return false
+		}
+		if segment.AnyFieldName {
+			for _, attrSchema := range specSchema.Attributes {
+				if pathSegmentsInSpecSchema(segments[1:], attrSchema, depth+1) {
+					return true
+				}
+			}
+			return false
+		}
+		attrSchema, hasAttr := specSchema.Attributes[segment.FieldName]
+		if !hasAttr {
+			return false
+		}
+		return pathSegmentsInSpecSchema(segments[1:], attrSchema, depth+1)
+	case provider.ResourceDefinitionsSchemaTypeMap:
+		if segment.IsArrayAccessor() {
+			return false
+		}
+		return pathSegmentsInSpecSchema(segments[1:], specSchema.MapValues, depth+1)
+	case provider.ResourceDefinitionsSchemaTypeArray:
+		if !segment.IsArrayAccessor() {
+			return false
+		}
+		return pathSegmentsInSpecSchema(segments[1:], specSchema.Items, depth+1)
+	case provider.ResourceDefinitionsSchemaTypeUnion:
+		for _, unionSchema := range specSchema.OneOf {
+			if pathSegmentsInSpecSchema(segments, unionSchema, depth) {
+				return true
+			}
+		}
+		return false
+	default:
+		// Scalar values do not have any nested fields.
+		return false
+	}
+}
+
+// Deprecated resource types and fields are reported as warnings
+// as they are still supported by the provider, users should be able
+// to deploy blueprints that use them while they plan a migration.
+func deprecatedResourceTypeDiagnostic(
+	resourceName string,
+	resourceType string,
+	deprecationMessage string,
+	location *source.Meta,
+) *core.Diagnostic {
+	return &core.Diagnostic{
+		Level: core.DiagnosticLevelWarning,
+		Message: withDeprecationMessage(
+			fmt.Sprintf(
+				"resource %q uses the deprecated resource type %q",
+				resourceName,
+				resourceType,
+			),
+			deprecationMessage,
+		),
+		Range: core.DiagnosticRangeFromSourceMeta(location, nil),
+		Context: &errors.ErrorContext{
+			ReasonCode: ErrorReasonCodeDeprecatedResourceType,
+			Metadata: map[string]any{
+				"resourceName":       resourceName,
+				"resourceType":       resourceType,
+				"deprecationMessage": deprecationMessage,
+			},
+		},
+	}
+}
+
+func deprecatedResourceFieldDiagnostic(
+	path string,
+	resourceName string,
+	resourceType string,
+	deprecationMessage string,
+	location *source.Meta,
+) *core.Diagnostic {
+	return &core.Diagnostic{
+		Level: core.DiagnosticLevelWarning,
+		Message: withDeprecationMessage(
+			fmt.Sprintf(
+				"resource %q sets the deprecated field %q of the %q resource type",
+				resourceName,
+				path,
+				resourceType,
+			),
+			deprecationMessage,
+		),
+		Range: core.DiagnosticRangeFromSourceMeta(location, nil),
+		Context: &errors.ErrorContext{
+			ReasonCode: ErrorReasonCodeDeprecatedResourceField,
+			Metadata: map[string]any{
+				"resourceName":       resourceName,
+				"resourceType":       resourceType,
+				"path":               path,
+				"deprecationMessage": deprecationMessage,
+			},
+		},
+	}
+}
+
+func withDeprecationMessage(message string, deprecationMessage string) string {
+	if strings.TrimSpace(deprecationMessage) == "" {
+		return message
+	}
+
+	return fmt.Sprintf("%s: %s", message, deprecationMessage)
+}
@@ -39,9 +39,10 @@ func (s *ResourceSpecValidationTestSuite) SetUpTest(c *C) {
 	s.refChainCollector = refgraph.NewRefChainCollector()
 	s.resourceRegistry = internal.NewResourceRegistryMock(
 		map[string]provider.Resource{
-			"test/missingSpecDef":  newTestResourceMissingSpecDef(),
-			"test/missingSchema":   newTestResourceMissingSchema(),
-			"test/exampleResource": newSpecValidationTestExampleResource(),
+			"test/missingSpecDef":            newTestResourceMissingSpecDef(),
+			"test/missingSchema":             newTestResourceMissingSchema(),
+			"test/exampleResource":           newSpecValidationTestExampleResource(),
+			"test/deprecatedExampleResource": newSpecValidationTestDeprecatedExampleResource(),
 		},
 	)
 	s.dataSourceRegistry = &internal.DataSourceRegistryMock{
@@ -315,6 +316,100 @@ func (s *ResourceSpecValidationTestSuite) Test_reports_error_for_computed_field_
 	)
 }
 
+func (s *ResourceSpecValidationTestSuite) Test_reports_warning_for_deprecated_field_defined_in_blueprint(c *C) {
+	resource := createTestValidResource()
+	resource.Spec.Fields["legacyName"] = core.MappingNodeFromString("legacy-handler")
+
+	resourceMap := &schema.ResourceMap{
+		Values: map[string]*schema.Resource{
+			"testHandler": resource,
+		},
+	}
+
+	blueprint := &schema.Blueprint{
+		Resources: resourceMap,
+	}
+
+	diagnostics, err := ValidateResource(
+		context.Background(),
+		"testHandler",
+		resource,
+		resourceMap,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* resourceDerivedFromTemplate */ false,
+		core.NewNopLogger(),
+	)
+	c.Assert(err, IsNil)
+	c.Assert(diagnostics, HasLen, 1)
+	c.Assert(diagnostics[0].Level, Equals, core.DiagnosticLevelWarning)
+	c.Assert(
+		diagnostics[0].Message,
+		Equals,
+		"resource \"testHandler\" sets the deprecated field \"resources.testHandler.spec.legacyName\" "+
+			"of the \"test/exampleResource\" resource type: use optionalString instead",
+	)
+	c.Assert(diagnostics[0].Context.ReasonCode, Equals, ErrorReasonCodeDeprecatedResourceField)
+	c.Assert(
+		diagnostics[0].Context.Metadata["path"],
+		Equals,
+		"resources.testHandler.spec.legacyName",
+	)
+}
+
+func (s *ResourceSpecValidationTestSuite) Test_reports_warning_for_deprecated_resource_type(c *C) {
+	resource := createTestValidResource()
+	resource.Type = &schema.ResourceTypeWrapper{Value: "test/deprecatedExampleResource"}
+
+	resourceMap := &schema.ResourceMap{
+		Values: map[string]*schema.Resource{
+			"testHandler": resource,
+		},
+	}
+
+	blueprint := &schema.Blueprint{
+		Resources: resourceMap,
+	}
+
+	diagnostics, err := ValidateResource(
+		context.Background(),
+		"testHandler",
+		resource,
+		resourceMap,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* resourceDerivedFromTemplate */ false,
+		core.NewNopLogger(),
+	)
+	c.Assert(err, IsNil)
+	c.Assert(diagnostics, HasLen, 1)
+	c.Assert(diagnostics[0].Level, Equals, core.DiagnosticLevelWarning)
+	c.Assert(
+		diagnostics[0].Message,
+		Equals,
+		"resource \"testHandler\" uses the deprecated resource type \"test/deprecatedExampleResource\": "+
+			"use test/exampleResource instead",
+	)
+	c.Assert(diagnostics[0].Context.ReasonCode, Equals, ErrorReasonCodeDeprecatedResourceType)
+	c.Assert(
+		diagnostics[0].Context.Metadata["resourceType"],
+		Equals,
+		"test/deprecatedExampleResource",
+	)
+}
+
 func (s *ResourceSpecValidationTestSuite) Test_reports_error_for_missing_required_field(c *C) {
 	resource := createTestValidResource()
 	floatVal := 4039.21
@@ -947,6 +1042,47 @@ func substitutionFunctionNode(
 // Test resources
 //////////////////////////////////////////////////
 
+func (s *ResourceSpecValidationTestSuite) Test_resolves_ranges_for_custom_diagnostics_with_spec_paths(c *C) {
+	handler := "test.handler"
+	spec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"handler": {
+				Scalar: &core.ScalarValue{StringValue: &handler},
+				SourceMeta: &source.Meta{
+					Position:    source.Position{Line: 6, Column: 16},
+					EndPosition: &source.Position{Line: 6, Column: 28},
+				},
+			},
+		},
+	}
+	resourceLocation := &source.Meta{Position: source.Position{Line: 3, Column: 3}}
+	explicitRange := &core.DiagnosticRange{
+		Start: &source.Meta{Position: source.Position{Line: 1, Column: 1}},
+	}
+	diagnostics := []*core.Diagnostic{
+		{Level: core.DiagnosticLevelError, Message: "invalid handler", SpecPath: "$.handler"},
+		{Level: core.DiagnosticLevelError, Message: "missing runtime", SpecPath: "$.runtime"},
+		{Level: core.DiagnosticLevelWarning, Message: "explicit range", SpecPath: "$.handler", Range: explicitRange},
+		{Level: core.DiagnosticLevelWarning, Message: "no spec path"},
+	}
+
+	resolveSpecPathRanges(diagnostics, spec, resourceLocation)
+
+	c.Assert(diagnostics[0].Range, DeepEquals, &core.DiagnosticRange{
+		Start: &source.Meta{
+			Position:    source.Position{Line: 6, Column: 16},
+			EndPosition: &source.Position{Line: 6, Column: 28},
+		},
+		End: &source.Meta{Position: source.Position{Line: 6, Column: 28}},
+	})
+	c.Assert(diagnostics[1].Range, DeepEquals, &core.DiagnosticRange{
+		Start: resourceLocation,
+		End:   &source.Meta{Position: source.Position{Line: 4, Column: 1}},
+	})
+	c.Assert(diagnostics[2].Range, Equals, explicitRange)
+	c.Assert(diagnostics[3].Range, IsNil)
+}
+
 func createTestValidResource() *schema.Resource {
 	mappingItemId1 := "testId1"
 	mappingItemId2 := "testId2"
@@ -1268,6 +1404,37 @@ func (r *testResourceMissingSchema) Destroy(
 	return nil
 }
 
+type specValidationTestDeprecatedExampleResource struct {
+	specValidationTestExampleResource
+}
+
+func newSpecValidationTestDeprecatedExampleResource() provider.Resource {
+	return &specValidationTestDeprecatedExampleResource{}
+}
+
+func (r *specValidationTestDeprecatedExampleResource) GetType(
+	ctx context.Context,
+	input *provider.ResourceGetTypeInput,
+) (*provider.ResourceGetTypeOutput, error) {
+	return &provider.ResourceGetTypeOutput{
+		Type: "test/deprecatedExampleResource",
+	}, nil
+}
+
+func (r *specValidationTestDeprecatedExampleResource) GetSpecDefinition(
+	ctx context.Context,
+	input *provider.ResourceGetSpecDefinitionInput,
+) (*provider.ResourceGetSpecDefinitionOutput, error) {
+	output, err := r.specValidationTestExampleResource.GetSpecDefinition(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	output.SpecDefinition.Deprecated = true
+	output.SpecDefinition.DeprecationMessage = "use test/exampleResource instead"
+	return output, nil
+}
+
 type specValidationTestExampleResource struct{}
 
 func newSpecValidationTestExampleResource() provider.Resource {
@@ -1388,6 +1555,12 @@ func (r *specValidationTestExampleResource) GetSpecDefinition(
 					"optionalString": {
 						Type: provider.ResourceDefinitionsSchemaTypeString,
 					},
+					"legacyName": {
+						Type:               provider.ResourceDefinitionsSchemaTypeString,
+						Nullable:           true,
+						Deprecated:         true,
+						DeprecationMessage: "use optionalString instead",
+					},
 					"nullable": {
 						Type:     provider.ResourceDefinitionsSchemaTypeString,
 						Nullable: true,
@@ -0,0 +1,190 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+)
+
+// ValidateResourceUniqueness validates that fields in resource specs
+// with a unique group defined in the resource type schema
+// do not share the same value with other fields in the same group
+// across all the resources in a blueprint.
+// Errors are grouped by the name of the resource that uses a value
+// that has already been used by another resource, resources are checked
+// in the order of their names so the same resource is reported for a conflict
+// every time a blueprint is validated.
+//
+// Values that contain ${..} substitutions are not checked as there is no way
+// to know the final value during the validation phase.
+// Resources with spec definitions that can not be loaded are skipped,
+// the errors for these are reported when validating individual resources.
+func ValidateResourceUniqueness(
+	ctx context.Context,
+	valCtx *ValidationContext,
+) map[string][]error {
+	resourceErrors := map[string][]error{}
+	if valCtx.BpSchema.Resources == nil || valCtx.ResourceRegistry == nil {
+		return resourceErrors
+	}
+
+	uniquenessCtx := &resourceUniquenessContext{
+		usedValues: map[string]string{},
+	}
+	specDefinitions := map[string]*provider.ResourceSpecDefinition{}
+	for _, resourceName := range sortedKeys(valCtx.BpSchema.Resources.Values) {
+		resource := valCtx.BpSchema.Resources.Values[resourceName]
+		if resource == nil || resource.Type == nil {
+			continue
+		}
+
+		specDefinition := uniquenessSpecDefinition(
+			ctx,
+			resource.Type.Value,
+			resourceName,
+			specDefinitions,
+			valCtx,
+		)
+		if specDefinition == nil {
+			continue
+		}
+
+		uniquenessCtx.resourceType = resource.Type.Value
+		uniquenessCtx.errs = []error{}
+		collectUniqueValues(
+			uniquenessCtx,
+			resource.Spec,
+			resource.SourceMeta,
+			specDefinition.Schema,
+			fmt.Sprintf("resources.%s.spec", resourceName),
+			/* depth */ 0,
+		)
+		if len(uniquenessCtx.errs) > 0 {
+			resourceErrors[resourceName] = uniquenessCtx.errs
+		}
+	}
+
+	return resourceErrors
+}
+
+func uniquenessSpecDefinition(
+	ctx context.Context,
+	resourceType string,
+	resourceName string,
+	specDefinitions map[string]*provider.ResourceSpecDefinition,
+	valCtx *ValidationContext,
+) *provider.ResourceSpecDefinition {
+	if specDefinition, loaded := specDefinitions[resourceType]; loaded {
+		return specDefinition
+	}
+
+	specDefinition, err := loadResourceSpecDefinition(
+		ctx,
+		resourceType,
+		resourceName,
+		/* location */ nil,
+		valCtx.Params,
+		valCtx.ResourceRegistry,
+	)
+	if err != nil {
+		specDefinition = nil
+	}
+	specDefinitions[resourceType] = specDefinition
+	return specDefinition
+}
+
+type resourceUniquenessContext struct {
+	resourceType string
+	// Maps unique group and value pairs to the path of the first field
+	// that the value was used for.
+	usedValues map[string]string
+	errs       []error
+}
+
+func collectUniqueValues(
+	uniquenessCtx *resourceUniquenessContext,
+	node *core.MappingNode,
+	parentLocation *source.Meta,
+	fieldSchema *provider.ResourceDefinitionsSchema,
+	path string,
+	depth int,
+) {
+	if node == nil || fieldSchema == nil || depth >= core.MappingNodeMaxTraverseDepth {
+		return
+	}
+
+	switch fieldSchema.Type {
+	case provider.ResourceDefinitionsSchemaTypeObject:
+		for _, attrName := range sortedKeys(fieldSchema.Attributes) {
+			collectUniqueValues(
+				uniquenessCtx,
+				node.Fields[attrName],
+				selectFieldLocation(node.FieldsSourceMeta, attrName, parentLocation),
+				fieldSchema.Attributes[attrName],
+				fmt.Sprintf("%s.%s", path, attrName),
+				depth+1,
+			)
+		}
+	case provider.ResourceDefinitionsSchemaTypeMap:
+		for _, key := range sortedKeys(node.Fields) {
+			collectUniqueValues(
+				uniquenessCtx,
+				node.Fields[key],
+				selectFieldLocation(node.FieldsSourceMeta, key, parentLocation),
+				fieldSchema.MapValues,
+				fmt.Sprintf("%s.%s", path, key),
+				depth+1,
+			)
+		}
+	case provider.ResourceDefinitionsSchemaTypeArray:
+		for itemIndex, item := range node.Items {
+			collectUniqueValues(
+				uniquenessCtx,
+				item,
+				parentLocation,
+				fieldSchema.Items,
+				fmt.Sprintf("%s[%d]", path, itemIndex),
+				depth+1,
+			)
+		}
+	default:
+		checkUniqueValue(uniquenessCtx, node, parentLocation, fieldSchema, path)
+	}
+}
+
+func checkUniqueValue(
+	uniquenessCtx *resourceUniquenessContext,
+	node *core.MappingNode,
+	parentLocation *source.Meta,
+	fieldSchema *provider.ResourceDefinitionsSchema,
+	path string,
+) {
+	// Values that contain substitutions are represented as strings
+	// with substitutions and are skipped as they are only known
+	// after substitutions have been resolved.
+	if fieldSchema.UniqueGroup == "" || node.Scalar == nil {
+		return
+	}
+
+	usageKey := fmt.Sprintf("%s\x00%s", fieldSchema.UniqueGroup, node.Scalar.ToString())
+	firstUsagePath, used := uniquenessCtx.usedValues[usageKey]
+	if !used {
+		uniquenessCtx.usedValues[usageKey] = path
+		return
+	}
+
+	uniquenessCtx.errs = append(
+		uniquenessCtx.errs,
+		errResourceDefUniqueConstraintFailure(
+			path,
+			uniquenessCtx.resourceType,
+			fieldSchema.UniqueGroup,
+			node.Scalar,
+			firstUsagePath,
+			selectMappingNodeLocation(node, parentLocation),
+		),
+	)
+}
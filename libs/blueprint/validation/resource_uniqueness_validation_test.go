@@ -0,0 +1,267 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/internal"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResourceUniquenessValidationTestSuite struct {
+	suite.Suite
+	valCtx *ValidationContext
+}
+
+func (s *ResourceUniquenessValidationTestSuite) SetupTest() {
+	s.valCtx = &ValidationContext{
+		Params: &core.ParamsImpl{},
+		ResourceRegistry: internal.NewResourceRegistryMock(
+			map[string]provider.Resource{
+				"test/bucket": &uniquenessTestResource{
+					schema: &provider.ResourceDefinitionsSchema{
+						Type: provider.ResourceDefinitionsSchemaTypeObject,
+						Attributes: map[string]*provider.ResourceDefinitionsSchema{
+							"bucketName": {
+								Type:        provider.ResourceDefinitionsSchemaTypeString,
+								UniqueGroup: "test/bucketName",
+							},
+							"aliases": {
+								Type: provider.ResourceDefinitionsSchemaTypeArray,
+								Items: &provider.ResourceDefinitionsSchema{
+									Type:        provider.ResourceDefinitionsSchemaTypeString,
+									UniqueGroup: "test/bucketName",
+								},
+							},
+							"region": {
+								Type: provider.ResourceDefinitionsSchemaTypeString,
+							},
+						},
+					},
+				},
+				"test/bucketReplica": &uniquenessTestResource{
+					schema: &provider.ResourceDefinitionsSchema{
+						Type: provider.ResourceDefinitionsSchemaTypeObject,
+						Attributes: map[string]*provider.ResourceDefinitionsSchema{
+							"replicaBucketName": {
+								Type:        provider.ResourceDefinitionsSchemaTypeString,
+								UniqueGroup: "test/bucketName",
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+}
+
+func (s *ResourceUniquenessValidationTestSuite) Test_succeeds_when_unique_values_are_not_shared() {
+	s.valCtx.BpSchema = uniquenessTestBlueprint(map[string]*schema.Resource{
+		"ordersBucket": uniquenessTestResourceSchema(
+			"test/bucket",
+			map[string]*core.MappingNode{
+				"bucketName": core.MappingNodeFromString("orders"),
+				"region":     core.MappingNodeFromString("eu-west-2"),
+			},
+		),
+		"invoicesBucket": uniquenessTestResourceSchema(
+			"test/bucket",
+			map[string]*core.MappingNode{
+				"bucketName": core.MappingNodeFromString("invoices"),
+				// Fields without a unique group can share values.
+				"region": core.MappingNodeFromString("eu-west-2"),
+			},
+		),
+	})
+
+	resourceErrors := ValidateResourceUniqueness(context.Background(), s.valCtx)
+	s.Assert().Empty(resourceErrors)
+}
+
+func (s *ResourceUniquenessValidationTestSuite) Test_reports_errors_for_values_shared_across_resources() {
+	s.valCtx.BpSchema = uniquenessTestBlueprint(map[string]*schema.Resource{
+		"archiveBucket": uniquenessTestResourceSchema(
+			"test/bucket",
+			map[string]*core.MappingNode{
+				"bucketName": core.MappingNodeFromString("orders"),
+			},
+		),
+		"ordersBucket": uniquenessTestResourceSchema(
+			"test/bucket",
+			map[string]*core.MappingNode{
+				"bucketName": {
+					Scalar: &core.ScalarValue{
+						StringValue: uniquenessTestStringPtr("orders"),
+					},
+					SourceMeta: &source.Meta{
+						Position:    source.Position{Line: 12, Column: 19},
+						EndPosition: &source.Position{Line: 12, Column: 25},
+					},
+				},
+			},
+		),
+		"ordersReplica": uniquenessTestResourceSchema(
+			"test/bucketReplica",
+			map[string]*core.MappingNode{
+				"replicaBucketName": core.MappingNodeFromString("orders"),
+			},
+		),
+	})
+
+	resourceErrors := ValidateResourceUniqueness(context.Background(), s.valCtx)
+	s.Assert().Len(resourceErrors, 2)
+	s.Assert().NotContains(resourceErrors, "archiveBucket")
+
+	s.Assert().Len(resourceErrors["ordersBucket"], 1)
+	loadErr, isLoadErr := resourceErrors["ordersBucket"][0].(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeResourceDefUniqueConstraintFailure, loadErr.ReasonCode)
+	s.Assert().Equal(
+		"blueprint load error: validation failed due to a value at path "+
+			"\"resources.ordersBucket.spec.bucketName\" that must be unique but is also used at path "+
+			"\"resources.archiveBucket.spec.bucketName\", the value \"orders\" can only be used "+
+			"once for fields in the \"test/bucketName\" unique group",
+		loadErr.Error(),
+	)
+	s.Assert().Equal(12, *loadErr.Line)
+	s.Assert().Equal(19, *loadErr.Column)
+	s.Assert().Equal(12, *loadErr.EndLine)
+	s.Assert().Equal(25, *loadErr.EndColumn)
+
+	s.Assert().Len(resourceErrors["ordersReplica"], 1)
+	loadErr, isLoadErr = resourceErrors["ordersReplica"][0].(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(
+		"test/bucketReplica",
+		loadErr.Context.Metadata["resourceType"],
+	)
+	s.Assert().Equal(
+		"resources.archiveBucket.spec.bucketName",
+		loadErr.Context.Metadata["conflictingPath"],
+	)
+}
+
+func (s *ResourceUniquenessValidationTestSuite) Test_reports_errors_for_values_shared_within_arrays() {
+	s.valCtx.BpSchema = uniquenessTestBlueprint(map[string]*schema.Resource{
+		"ordersBucket": uniquenessTestResourceSchema(
+			"test/bucket",
+			map[string]*core.MappingNode{
+				"bucketName": core.MappingNodeFromString("orders"),
+				"aliases": {
+					Items: []*core.MappingNode{
+						core.MappingNodeFromString("orders-alias"),
+						core.MappingNodeFromString("orders"),
+					},
+				},
+			},
+		),
+	})
+
+	resourceErrors := ValidateResourceUniqueness(context.Background(), s.valCtx)
+	s.Assert().Len(resourceErrors["ordersBucket"], 1)
+	loadErr, isLoadErr := resourceErrors["ordersBucket"][0].(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	// Fields are checked in the order of their names, so the alias
+	// is the first use of the value.
+	s.Assert().Equal(
+		"resources.ordersBucket.spec.bucketName",
+		loadErr.Context.Metadata["path"],
+	)
+	s.Assert().Equal(
+		"resources.ordersBucket.spec.aliases[1]",
+		loadErr.Context.Metadata["conflictingPath"],
+	)
+}
+
+func (s *ResourceUniquenessValidationTestSuite) Test_skips_values_with_substitutions_and_unknown_resource_types() {
+	s.valCtx.BpSchema = uniquenessTestBlueprint(map[string]*schema.Resource{
+		"ordersBucket": uniquenessTestResourceSchema(
+			"test/bucket",
+			map[string]*core.MappingNode{
+				"bucketName": uniquenessTestEnvironmentBucketName(),
+			},
+		),
+		"ordersBucketCopy": uniquenessTestResourceSchema(
+			"test/bucket",
+			map[string]*core.MappingNode{
+				"bucketName": uniquenessTestEnvironmentBucketName(),
+			},
+		),
+		"ordersWidget": uniquenessTestResourceSchema(
+			"test/unknownWidget",
+			map[string]*core.MappingNode{
+				"bucketName": core.MappingNodeFromString("orders"),
+			},
+		),
+	})
+
+	resourceErrors := ValidateResourceUniqueness(context.Background(), s.valCtx)
+	s.Assert().Empty(resourceErrors)
+}
+
+func uniquenessTestBlueprint(resources map[string]*schema.Resource) *schema.Blueprint {
+	return &schema.Blueprint{
+		Resources: &schema.ResourceMap{
+			Values: resources,
+		},
+	}
+}
+
+func uniquenessTestResourceSchema(
+	resourceType string,
+	specFields map[string]*core.MappingNode,
+) *schema.Resource {
+	return &schema.Resource{
+		Type: &schema.ResourceTypeWrapper{Value: resourceType},
+		Spec: &core.MappingNode{
+			Fields: specFields,
+		},
+	}
+}
+
+func uniquenessTestEnvironmentBucketName() *core.MappingNode {
+	return &core.MappingNode{
+		StringWithSubstitutions: &substitutions.StringOrSubstitutions{
+			Values: []*substitutions.StringOrSubstitution{
+				{StringValue: uniquenessTestStringPtr("orders-")},
+				{
+					SubstitutionValue: &substitutions.Substitution{
+						Variable: &substitutions.SubstitutionVariable{
+							VariableName: "environment",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func uniquenessTestStringPtr(value string) *string {
+	return &value
+}
+
+type uniquenessTestResource struct {
+	provider.Resource
+	schema *provider.ResourceDefinitionsSchema
+}
+
+func (r *uniquenessTestResource) GetSpecDefinition(
+	ctx context.Context,
+	input *provider.ResourceGetSpecDefinitionInput,
+) (*provider.ResourceGetSpecDefinitionOutput, error) {
+	return &provider.ResourceGetSpecDefinitionOutput{
+		SpecDefinition: &provider.ResourceSpecDefinition{
+			Schema: r.schema,
+		},
+	}, nil
+}
+
+func TestResourceUniquenessValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(ResourceUniquenessValidationTestSuite))
+}
@@ -3,9 +3,12 @@ package validation
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
@@ -156,6 +159,29 @@ func ValidateResource(
 		errs = append(errs, validateRemovalPolicyErr)
 	}
 
+	logger.Debug("Validating resource replace strategy")
+	validateReplaceStrategyErr := validateResourceReplaceStrategy(
+		ctx,
+		name,
+		resource,
+		resourceMap,
+		/* typeIsValid */ resource.Type != nil && validateTypeErr == nil,
+		valCtx,
+	)
+	if validateReplaceStrategyErr != nil {
+		errs = append(errs, validateReplaceStrategyErr)
+	}
+
+	logger.Debug("Validating resource timeouts")
+	validateTimeoutsErr := validateResourceTimeouts(
+		name,
+		resource.Timeouts,
+		resourceMap,
+	)
+	if validateTimeoutsErr != nil {
+		errs = append(errs, validateTimeoutsErr)
+	}
+
 	// Only validate spec if type validation passed - there's no point validating
 	// the spec when we can't look up the spec definition for an unknown resource type.
 	if resource.Type != nil && validateTypeErr == nil {
@@ -225,6 +251,7 @@ func validateResourceType(
 		return diagnostics, errResourceTypeNotSupported(
 			resourceName,
 			resourceType.Value,
+			suggestResourceTypes(ctx, resourceRegistry, resourceType.Value),
 			location,
 		)
 	}
@@ -861,6 +888,86 @@ func checkEachResourceOrChildDependencies(
 	return errs
 }
 
+// ValidateResourceConditionDependencies validates the values referenced
+// in the `condition` property of a resource.
+// Conditions can reference resources directly as they are known on deploy,
+// however, values referenced in a condition must not depend on the deployed state
+// of resources or child blueprints as values that depend on deployed state are only
+// resolved once the resources or child blueprints have been deployed.
+// This should be called after all validation of a blueprint has been carried out
+// and the full set of references have been collected.
+func ValidateResourceConditionDependencies(
+	blueprint *schema.Blueprint,
+	refChainCollector refgraph.RefChainCollector,
+) error {
+	if blueprint.Resources == nil {
+		return nil
+	}
+
+	var errs []error
+	for resourceName, resource := range blueprint.Resources.Values {
+		if resource.Condition == nil {
+			continue
+		}
+
+		resourceIdentifier := bpcore.ResourceElementID(resourceName)
+		conditionTag := CreateSubRefPropTag(resourceIdentifier, "condition")
+		nodes := refChainCollector.FindByTag(conditionTag)
+		for _, node := range nodes {
+			if _, isValue := node.Element.(*schema.Value); !isValue {
+				continue
+			}
+
+			dependencyName := findDeployedStateDependency(
+				node.References,
+				map[string]bool{node.ElementName: true},
+			)
+			if dependencyName != "" {
+				errs = append(errs, errConditionValueDeployedStateDependency(
+					resourceIdentifier,
+					node.ElementName,
+					dependencyName,
+					resource.Condition.SourceMeta,
+				))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return ErrMultipleValidationErrors(errs)
+	}
+
+	return nil
+}
+
+// findDeployedStateDependency returns the name of the first resource or child
+// blueprint found in the provided reference chain nodes or their references,
+// an empty string is returned if there are no resources or child blueprints
+// in the reference chains.
+func findDeployedStateDependency(
+	nodes []*refgraph.ReferenceChainNode,
+	visited map[string]bool,
+) string {
+	for _, node := range nodes {
+		if visited[node.ElementName] {
+			continue
+		}
+		visited[node.ElementName] = true
+
+		switch node.Element.(type) {
+		case *schema.Resource, *schema.Include:
+			return node.ElementName
+		}
+
+		dependencyName := findDeployedStateDependency(node.References, visited)
+		if dependencyName != "" {
+			return dependencyName
+		}
+	}
+
+	return ""
+}
+
 func validateResourceLinkSelector(
 	resourceName string,
 	linkSelector *schema.LinkSelector,
@@ -954,6 +1061,129 @@ func validateResourceRemovalPolicy(
 	)
 }
 
+func validateResourceReplaceStrategy(
+	ctx context.Context,
+	resourceName string,
+	resource *schema.Resource,
+	resourceMap *schema.ResourceMap,
+	typeIsValid bool,
+	valCtx *ValidationContext,
+) error {
+	replaceStrategy := resource.ReplaceStrategy
+	if replaceStrategy == nil || replaceStrategy.Value == "" {
+		return nil
+	}
+
+	location := replaceStrategy.SourceMeta
+	if location == nil {
+		location = getResourceSourceMeta(resourceMap, resourceName)
+	}
+
+	if !slices.Contains(schema.ValidReplaceStrategies, replaceStrategy.Value) {
+		return errInvalidResourceReplaceStrategy(
+			resourceName,
+			string(replaceStrategy.Value),
+			location,
+		)
+	}
+
+	if replaceStrategy.Value != schema.ReplaceStrategyCreateBeforeDestroy ||
+		!typeIsValid {
+		return nil
+	}
+
+	// Resource types that must be destroyed before they are created again
+	// have a user-defined unique name that can not be shared by an existing
+	// resource and its replacement.
+	resourceType := resource.Type.Value
+	specDefOutput, err := valCtx.ResourceRegistry.GetSpecDefinition(
+		ctx,
+		resourceType,
+		&provider.ResourceGetSpecDefinitionInput{
+			ProviderContext: provider.NewProviderContextFromParams(
+				provider.ExtractProviderFromItemType(resourceType),
+				valCtx.Params,
+			),
+		},
+	)
+	if err != nil {
+		// Errors loading the spec definition are reported
+		// when validating the resource spec.
+		return nil
+	}
+
+	if specDefOutput != nil &&
+		specDefOutput.SpecDefinition != nil &&
+		specDefOutput.SpecDefinition.DestroyBeforeCreate {
+		return errResourceReplaceStrategyConflictsWithUniqueName(
+			resourceName,
+			resourceType,
+			location,
+		)
+	}
+
+	return nil
+}
+
+func validateResourceTimeouts(
+	resourceName string,
+	timeouts *schema.ResourceTimeouts,
+	resourceMap *schema.ResourceMap,
+) error {
+	if timeouts == nil {
+		return nil
+	}
+
+	errs := []error{}
+	operationTimeouts := []struct {
+		operation string
+		value     *bpcore.ScalarValue
+	}{
+		{operation: "create", value: timeouts.Create},
+		{operation: "update", value: timeouts.Update},
+		{operation: "destroy", value: timeouts.Destroy},
+	}
+	for _, operationTimeout := range operationTimeouts {
+		if operationTimeout.value == nil {
+			continue
+		}
+
+		location := operationTimeout.value.SourceMeta
+		if location == nil {
+			location = timeouts.SourceMeta
+		}
+		if location == nil {
+			location = getResourceSourceMeta(resourceMap, resourceName)
+		}
+
+		if operationTimeout.value.StringValue == nil {
+			errs = append(errs, errInvalidResourceTimeout(
+				resourceName,
+				operationTimeout.operation,
+				operationTimeout.value.ToString(),
+				location,
+			))
+			continue
+		}
+
+		duration, err := time.ParseDuration(*operationTimeout.value.StringValue)
+		if err != nil || duration <= 0 {
+			errs = append(errs, errInvalidResourceTimeout(
+				resourceName,
+				operationTimeout.operation,
+				*operationTimeout.value.StringValue,
+				location,
+			))
+		}
+	}
+
+	if len(errs) > 0 {
+		return ErrMultipleValidationErrors(errs)
+	}
+
+	return nil
+}
+
 func allConditionValuesNil(condition *schema.Condition) bool {
 	return condition.And == nil && condition.Or == nil &&
 		condition.Not == nil && condition.StringValue == nil
@@ -204,6 +204,44 @@ func (s *ResourceValidationTestSuite) Test_reports_errors_when_resource_type_is_
 	)
 }
 
+func (s *ResourceValidationTestSuite) Test_includes_suggestions_for_unsupported_resource_type(c *C) {
+	resource := &schema.Resource{
+		Type: &schema.ResourceTypeWrapper{Value: "aws/ecs/servce"},
+	}
+	resourceMap := &schema.ResourceMap{
+		Values: map[string]*schema.Resource{
+			"orderService": resource,
+		},
+	}
+
+	_, err := ValidateResource(
+		context.Background(),
+		"orderService",
+		resource,
+		resourceMap,
+		&ValidationContext{
+			BpSchema:           &schema.Blueprint{Resources: resourceMap},
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* resourceDerivedFromTemplate */ false,
+		core.NewNopLogger(),
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := internal.UnpackLoadError(err)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidResource)
+	c.Assert(loadErr.Context, NotNil)
+	c.Assert(
+		loadErr.Context.Metadata["suggestions"],
+		DeepEquals,
+		[]string{"aws/ecs/service"},
+	)
+}
+
 func (s *ResourceValidationTestSuite) Test_reports_error_when_providing_a_display_name_with_wrong_sub_type(c *C) {
 	resource := newTestInvalidDisplayNameResource()
 	resourceMap := &schema.ResourceMap{
@@ -887,6 +925,288 @@ func (s *ResourceValidationTestSuite) Test_reports_error_when_removal_policy_is_
 	)
 }
 
+func (s *ResourceValidationTestSuite) Test_accepts_destroy_before_create_replace_strategy(c *C) {
+	resource := newTestValidResource()
+	resource.ReplaceStrategy = &schema.ReplaceStrategyWrapper{
+		Value: schema.ReplaceStrategyDestroyBeforeCreate,
+	}
+	resourceMap := &schema.ResourceMap{
+		Values: map[string]*schema.Resource{
+			"testService": resource,
+		},
+	}
+	blueprint := &schema.Blueprint{
+		Resources: resourceMap,
+	}
+
+	diagnostics, err := ValidateResource(
+		context.Background(),
+		"testService",
+		resource,
+		resourceMap,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* resourceDerivedFromTemplate */ false,
+		core.NewNopLogger(),
+	)
+	c.Assert(diagnostics, HasLen, 0)
+	c.Assert(err, IsNil)
+}
+
+func (s *ResourceValidationTestSuite) Test_reports_error_when_replace_strategy_is_invalid(c *C) {
+	resource := newTestValidResource()
+	resource.ReplaceStrategy = &schema.ReplaceStrategyWrapper{
+		Value: "replaceInPlace",
+	}
+	resourceMap := &schema.ResourceMap{
+		Values: map[string]*schema.Resource{
+			"testService": resource,
+		},
+	}
+	blueprint := &schema.Blueprint{
+		Resources: resourceMap,
+	}
+
+	diagnostics, err := ValidateResource(
+		context.Background(),
+		"testService",
+		resource,
+		resourceMap,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* resourceDerivedFromTemplate */ false,
+		core.NewNopLogger(),
+	)
+	c.Assert(diagnostics, HasLen, 0)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := internal.UnpackLoadError(err)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidResource)
+	c.Assert(
+		loadErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to an invalid replace strategy value \"replaceInPlace\" for resource \"testService\", "+
+			"the replaceStrategy field must be a static literal, one of \"createBeforeDestroy\" or \"destroyBeforeCreate\"",
+	)
+}
+
+func (s *ResourceValidationTestSuite) Test_reports_error_when_create_before_destroy_is_used_for_resource_with_unique_name(c *C) {
+	resource := newTestValidResource()
+	resource.ReplaceStrategy = &schema.ReplaceStrategyWrapper{
+		Value: schema.ReplaceStrategyCreateBeforeDestroy,
+	}
+	resourceMap := &schema.ResourceMap{
+		Values: map[string]*schema.Resource{
+			"testService": resource,
+		},
+	}
+	blueprint := &schema.Blueprint{
+		Resources: resourceMap,
+	}
+
+	diagnostics, err := ValidateResource(
+		context.Background(),
+		"testService",
+		resource,
+		resourceMap,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* resourceDerivedFromTemplate */ false,
+		core.NewNopLogger(),
+	)
+	c.Assert(diagnostics, HasLen, 0)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := internal.UnpackLoadError(err)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidResource)
+	c.Assert(
+		loadErr.Error(),
+		Equals,
+		"blueprint load error: validation failed as the \"createBeforeDestroy\" replace strategy can not be used for resource \"testService\", "+
+			"resources of type \"aws/ecs/service\" have a unique name that can not be shared by the existing resource "+
+			"and its replacement, the resource must be destroyed before it is created again",
+	)
+}
+
+func (s *ResourceValidationTestSuite) Test_accepts_valid_resource_timeouts(c *C) {
+	resource := newTestValidResource()
+	resource.Timeouts = &schema.ResourceTimeouts{
+		Create:  core.ScalarFromString("30m"),
+		Destroy: core.ScalarFromString("1h30m"),
+	}
+	resourceMap := &schema.ResourceMap{
+		Values: map[string]*schema.Resource{
+			"testService": resource,
+		},
+	}
+	blueprint := &schema.Blueprint{
+		Resources: resourceMap,
+	}
+
+	diagnostics, err := ValidateResource(
+		context.Background(),
+		"testService",
+		resource,
+		resourceMap,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* resourceDerivedFromTemplate */ false,
+		core.NewNopLogger(),
+	)
+	c.Assert(diagnostics, HasLen, 0)
+	c.Assert(err, IsNil)
+}
+
+func (s *ResourceValidationTestSuite) Test_reports_errors_for_invalid_resource_timeouts(c *C) {
+	resource := newTestValidResource()
+	resource.Timeouts = &schema.ResourceTimeouts{
+		Create:  core.ScalarFromString("ten minutes"),
+		Update:  core.ScalarFromInt(600),
+		Destroy: core.ScalarFromString("-5m"),
+	}
+	resourceMap := &schema.ResourceMap{
+		Values: map[string]*schema.Resource{
+			"testService": resource,
+		},
+	}
+	blueprint := &schema.Blueprint{
+		Resources: resourceMap,
+	}
+
+	_, err := ValidateResource(
+		context.Background(),
+		"testService",
+		resource,
+		resourceMap,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* resourceDerivedFromTemplate */ false,
+		core.NewNopLogger(),
+	)
+	c.Assert(err, NotNil)
+	timeoutErr, isLoadErr := internal.UnpackLoadError(err)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(timeoutErr.ReasonCode, Equals, ErrorReasonCodeInvalidResource)
+	c.Assert(
+		timeoutErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to an invalid create timeout \"ten minutes\" for resource \"testService\", "+
+			"timeouts must be static duration strings greater than zero such as \"30s\", \"10m\" or \"1h30m\"",
+	)
+}
+
+func (s *ResourceValidationTestSuite) Test_succeeds_for_ignore_changes_paths_in_spec_definition(c *C) {
+	resource := newTestValidResource()
+	resource.IgnoreChanges = &schema.IgnoreChangesList{
+		StringList: schema.StringList{
+			Values: []string{"spec.serviceName"},
+		},
+	}
+	resourceMap := &schema.ResourceMap{
+		Values: map[string]*schema.Resource{
+			"testService": resource,
+		},
+	}
+	blueprint := &schema.Blueprint{
+		Resources: resourceMap,
+	}
+
+	diagnostics, err := ValidateResource(
+		context.Background(),
+		"testService",
+		resource,
+		resourceMap,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* resourceDerivedFromTemplate */ false,
+		core.NewNopLogger(),
+	)
+	c.Assert(diagnostics, HasLen, 0)
+	c.Assert(err, IsNil)
+}
+
+func (s *ResourceValidationTestSuite) Test_reports_error_when_ignore_changes_path_is_not_in_spec_definition(c *C) {
+	resource := newTestValidResource()
+	resource.IgnoreChanges = &schema.IgnoreChangesList{
+		StringList: schema.StringList{
+			Values: []string{"spec.serviceName.nested"},
+		},
+	}
+	resourceMap := &schema.ResourceMap{
+		Values: map[string]*schema.Resource{
+			"testService": resource,
+		},
+	}
+	blueprint := &schema.Blueprint{
+		Resources: resourceMap,
+	}
+
+	diagnostics, err := ValidateResource(
+		context.Background(),
+		"testService",
+		resource,
+		resourceMap,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* resourceDerivedFromTemplate */ false,
+		core.NewNopLogger(),
+	)
+	c.Assert(diagnostics, HasLen, 0)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := internal.UnpackLoadError(err)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidResource)
+	c.Assert(
+		loadErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to an invalid ignoreChanges path "+
+			"\"spec.serviceName.nested\" for resource \"testService\", "+
+			"the path does not exist in the spec definition for the \"aws/ecs/service\" resource type",
+	)
+}
+
 func (s *ResourceValidationTestSuite) Test_reports_error_when_resource_has_a_missing_dependency(c *C) {
 	resource := newTestValidResource()
 	resource.DependsOn = &schema.DependsOnList{
@@ -1372,6 +1692,167 @@ func (s *ResourceValidationTestSuite) Test_reports_error_when_resource_indirectl
 	)
 }
 
+func (s *ResourceValidationTestSuite) Test_reports_error_when_resource_condition_references_value_depending_on_a_resource(c *C) {
+	resource := newTestValidResource()
+	resource.Condition = &schema.Condition{
+		StringValue: &substitutions.StringOrSubstitutions{
+			Values: []*substitutions.StringOrSubstitution{
+				{
+					SubstitutionValue: &substitutions.Substitution{
+						ValueReference: &substitutions.SubstitutionValueReference{
+							ValueName: "testServiceEnabled",
+						},
+					},
+				},
+			},
+		},
+		SourceMeta: &source.Meta{
+			Position: source.Position{
+				Line:   1,
+				Column: 15,
+			},
+		},
+	}
+	testServiceResource := newTestValidResource()
+	testServiceEnabledValue := &schema.Value{
+		Type: &schema.ValueTypeWrapper{Value: "boolean"},
+		Value: &core.MappingNode{
+			StringWithSubstitutions: &substitutions.StringOrSubstitutions{
+				Values: []*substitutions.StringOrSubstitution{
+					{
+						SubstitutionValue: &substitutions.Substitution{
+							ValueReference: &substitutions.SubstitutionValueReference{
+								ValueName: "testServiceConfig",
+								Path: []*substitutions.SubstitutionPathItem{
+									{FieldName: "enabled"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	testServiceConfigValue := &schema.Value{
+		Type: &schema.ValueTypeWrapper{Value: "object"},
+		Value: &core.MappingNode{
+			StringWithSubstitutions: &substitutions.StringOrSubstitutions{
+				Values: []*substitutions.StringOrSubstitution{
+					{
+						SubstitutionValue: &substitutions.Substitution{
+							ResourceProperty: &substitutions.SubstitutionResourceProperty{
+								ResourceName: "testService",
+								Path: []*substitutions.SubstitutionPathItem{
+									{FieldName: "spec"},
+									{FieldName: "config"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	blueprint := &schema.Blueprint{
+		Resources: &schema.ResourceMap{
+			Values: map[string]*schema.Resource{
+				"testCluster": resource,
+				"testService": testServiceResource,
+			},
+		},
+		Values: &schema.ValueMap{
+			Values: map[string]*schema.Value{
+				"testServiceEnabled": testServiceEnabledValue,
+				"testServiceConfig":  testServiceConfigValue,
+			},
+		},
+	}
+
+	testServiceEnabledValueID := "values.testServiceEnabled"
+	testServiceConfigValueID := "values.testServiceConfig"
+	refChainCollector := refgraph.NewRefChainCollector()
+	refChainCollector.Collect(
+		testServiceEnabledValueID,
+		testServiceEnabledValue,
+		testClusterID,
+		[]string{CreateSubRefPropTag(testClusterID, "condition")},
+	)
+	refChainCollector.Collect(
+		testServiceConfigValueID,
+		testServiceConfigValue,
+		testServiceEnabledValueID,
+		[]string{CreateSubRefTag(testServiceEnabledValueID)},
+	)
+	refChainCollector.Collect(
+		"resources.testService",
+		testServiceResource,
+		testServiceConfigValueID,
+		[]string{CreateSubRefTag(testServiceConfigValueID)},
+	)
+
+	err := ValidateResourceConditionDependencies(
+		blueprint,
+		refChainCollector,
+	)
+
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := internal.UnpackLoadError(err)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeConditionValueDeployedStateDependency)
+	c.Assert(
+		loadErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to a resource \"resources.testCluster\" referencing the value "+
+			"\"values.testServiceEnabled\" in the condition property that has a direct or transitive dependency on "+
+			"\"resources.testService\", the condition property can not reference values that depend on the "+
+			"deployed state of resources or child blueprints",
+	)
+}
+
+func (s *ResourceValidationTestSuite) Test_passes_validation_when_resource_condition_directly_references_another_resource(c *C) {
+	resource := newTestValidResource()
+	resource.Condition = &schema.Condition{
+		StringValue: &substitutions.StringOrSubstitutions{
+			Values: []*substitutions.StringOrSubstitution{
+				{
+					SubstitutionValue: &substitutions.Substitution{
+						ResourceProperty: &substitutions.SubstitutionResourceProperty{
+							ResourceName: "testService",
+							Path: []*substitutions.SubstitutionPathItem{
+								{FieldName: "spec"},
+								{FieldName: "enabled"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	testServiceResource := newTestValidResource()
+	blueprint := &schema.Blueprint{
+		Resources: &schema.ResourceMap{
+			Values: map[string]*schema.Resource{
+				"testCluster": resource,
+				"testService": testServiceResource,
+			},
+		},
+	}
+
+	refChainCollector := refgraph.NewRefChainCollector()
+	refChainCollector.Collect(
+		"resources.testService",
+		testServiceResource,
+		testClusterID,
+		[]string{CreateSubRefPropTag(testClusterID, "condition")},
+	)
+
+	err := ValidateResourceConditionDependencies(
+		blueprint,
+		refChainCollector,
+	)
+	c.Assert(err, IsNil)
+}
+
 func newTestValidResource() *schema.Resource {
 	serviceName := "testService"
 	displayNamePrefix := "Service-"
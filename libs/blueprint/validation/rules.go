@@ -0,0 +1,160 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// Rule is a custom validation rule that is applied to a blueprint
+// in addition to the core validators.
+// Rules allow organisations to enforce their own conventions
+// such as naming conventions, mandatory tags or forbidden resource types
+// without modifying the core validators.
+type Rule interface {
+	// Name returns the unique name of the rule
+	// (e.g. "acme/mandatory-cost-centre-tag").
+	Name() string
+	// Validate applies the rule to a blueprint, returning diagnostics
+	// for any violations of the rule.
+	// Diagnostics with the error level will cause validation to fail,
+	// warning and info diagnostics are reported to the user.
+	// An error should only be returned when the rule itself fails to run.
+	Validate(ctx context.Context, input *RuleInput) ([]*core.Diagnostic, error)
+}
+
+// RuleInput provides the parsed blueprint along with the resolved
+// registries to a custom validation rule.
+type RuleInput struct {
+	BpSchema           *schema.Blueprint
+	Params             core.BlueprintParams
+	FuncRegistry       provider.FunctionRegistry
+	ResourceRegistry   resourcehelpers.Registry
+	DataSourceRegistry provider.DataSourceRegistry
+}
+
+// RuleRegistry holds the custom validation rules that are applied
+// when loading or validating a blueprint.
+type RuleRegistry interface {
+	// Register adds a rule to the registry,
+	// an error is returned if a rule with the same name is already registered.
+	Register(rule Rule) error
+	// Rules returns all the registered rules, ordered by name.
+	Rules() []Rule
+}
+
+// NewRuleRegistry creates a new registry for custom validation rules
+// that is populated with the provided rules.
+func NewRuleRegistry(rules ...Rule) (RuleRegistry, error) {
+	registry := &ruleRegistry{
+		rules: map[string]Rule{},
+	}
+	for _, rule := range rules {
+		if err := registry.Register(rule); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+type ruleRegistry struct {
+	rules map[string]Rule
+}
+
+func (r *ruleRegistry) Register(rule Rule) error {
+	name := rule.Name()
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("validation rule name must not be empty")
+	}
+
+	if _, exists := r.rules[name]; exists {
+		return fmt.Errorf("validation rule %q is already registered", name)
+	}
+	r.rules[name] = rule
+	return nil
+}
+
+func (r *ruleRegistry) Rules() []Rule {
+	rules := make([]Rule, 0, len(r.rules))
+	for _, name := range sortedKeys(r.rules) {
+		rules = append(rules, r.rules[name])
+	}
+	return rules
+}
+
+// ValidateRules applies the custom validation rules in the provided registry
+// to a blueprint.
+// Warning and info diagnostics emitted by rules are returned as diagnostics,
+// error diagnostics are converted into validation errors.
+// All rules are applied, when more than one rule fails, the returned error
+// will wrap an error for each failure.
+func ValidateRules(
+	ctx context.Context,
+	registry RuleRegistry,
+	input *RuleInput,
+) ([]*core.Diagnostic, error) {
+	diagnostics := []*core.Diagnostic{}
+	if registry == nil || input == nil || input.BpSchema == nil {
+		return diagnostics, nil
+	}
+
+	errs := []error{}
+	for _, rule := range registry.Rules() {
+		ruleDiagnostics, err := rule.Validate(ctx, input)
+		if err != nil {
+			errs = append(errs, errValidationRuleFailed(rule.Name(), err))
+			continue
+		}
+
+		for _, diagnostic := range ruleDiagnostics {
+			diagnostics = append(diagnostics, withRuleContext(diagnostic, rule.Name()))
+		}
+	}
+
+	nonErrorDiagnostics, err := ExtractDiagnosticsAndErrors(
+		diagnostics,
+		ErrorReasonCodeValidationRuleViolation,
+	)
+	if err != nil {
+		errs = append(errs, err.(*errors.LoadError).ChildErrors...)
+	}
+
+	if len(errs) == 1 {
+		return nonErrorDiagnostics, errs[0]
+	}
+
+	if len(errs) > 1 {
+		return nonErrorDiagnostics, ErrMultipleValidationErrors(errs)
+	}
+
+	return nonErrorDiagnostics, nil
+}
+
+// Tags a diagnostic emitted by a rule with the name of the rule
+// so that users can identify the rule that was violated.
+func withRuleContext(diagnostic *core.Diagnostic, ruleName string) *core.Diagnostic {
+	diagnosticCopy := *diagnostic
+	if diagnosticCopy.Context == nil {
+		diagnosticCopy.Context = &errors.ErrorContext{
+			ReasonCode: ErrorReasonCodeValidationRuleViolation,
+			Metadata: map[string]any{
+				"rule": ruleName,
+			},
+		}
+	}
+
+	if diagnosticCopy.Level < core.DiagnosticLevelError ||
+		diagnosticCopy.Level > core.DiagnosticLevelInfo {
+		// Default to an error for rules that do not set a valid level
+		// so that violations are not silently ignored.
+		diagnosticCopy.Level = core.DiagnosticLevelError
+	}
+
+	return &diagnosticCopy
+}
@@ -0,0 +1,213 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/stretchr/testify/suite"
+)
+
+type RulesTestSuite struct {
+	suite.Suite
+}
+
+func (s *RulesTestSuite) Test_returns_no_diagnostics_for_blueprint_that_satisfies_rules() {
+	registry, err := NewRuleRegistry(
+		&testMandatoryTagsRule{tag: "costCentre", level: core.DiagnosticLevelError},
+	)
+	s.Require().NoError(err)
+
+	diagnostics, err := ValidateRules(
+		context.Background(),
+		registry,
+		&RuleInput{BpSchema: createRulesTestBlueprint(map[string]string{"costCentre": "1234"})},
+	)
+	s.Require().NoError(err)
+	s.Assert().Empty(diagnostics)
+}
+
+func (s *RulesTestSuite) Test_reports_error_for_rule_violation() {
+	registry, err := NewRuleRegistry(
+		&testMandatoryTagsRule{tag: "costCentre", level: core.DiagnosticLevelError},
+	)
+	s.Require().NoError(err)
+
+	diagnostics, err := ValidateRules(
+		context.Background(),
+		registry,
+		&RuleInput{BpSchema: createRulesTestBlueprint(map[string]string{})},
+	)
+	s.Require().Error(err)
+	s.Assert().Empty(diagnostics)
+
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeValidationRuleViolation, loadErr.ReasonCode)
+	s.Assert().Contains(
+		loadErr.Error(),
+		"resource \"ordersTable\" is missing the mandatory \"costCentre\" tag",
+	)
+	s.Assert().Equal(8, *loadErr.Line)
+}
+
+func (s *RulesTestSuite) Test_returns_warning_diagnostics_tagged_with_rule_name() {
+	registry, err := NewRuleRegistry(
+		&testMandatoryTagsRule{tag: "owner", level: core.DiagnosticLevelWarning},
+	)
+	s.Require().NoError(err)
+
+	diagnostics, err := ValidateRules(
+		context.Background(),
+		registry,
+		&RuleInput{BpSchema: createRulesTestBlueprint(map[string]string{})},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(diagnostics, 1)
+	s.Assert().Equal(core.DiagnosticLevelWarning, diagnostics[0].Level)
+	s.Assert().Equal(ErrorReasonCodeValidationRuleViolation, diagnostics[0].Context.ReasonCode)
+	s.Assert().Equal("test/mandatory-tag-owner", diagnostics[0].Context.Metadata["rule"])
+}
+
+func (s *RulesTestSuite) Test_reports_error_for_rule_that_fails_to_run() {
+	registry, err := NewRuleRegistry(
+		&testMandatoryTagsRule{tag: "owner", level: core.DiagnosticLevelWarning},
+		&testFailingRule{},
+	)
+	s.Require().NoError(err)
+
+	diagnostics, err := ValidateRules(
+		context.Background(),
+		registry,
+		&RuleInput{BpSchema: createRulesTestBlueprint(map[string]string{})},
+	)
+	s.Require().Error(err)
+	// Diagnostics from other rules are still collected when a rule fails to run.
+	s.Assert().Len(diagnostics, 1)
+
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeValidationRuleFailed, loadErr.ReasonCode)
+	s.Assert().Contains(
+		loadErr.Error(),
+		"custom validation rule \"test/failing\" failing to run: policy service unavailable",
+	)
+}
+
+func (s *RulesTestSuite) Test_fails_to_register_rule_with_duplicate_name() {
+	registry, err := NewRuleRegistry(
+		&testMandatoryTagsRule{tag: "owner", level: core.DiagnosticLevelWarning},
+	)
+	s.Require().NoError(err)
+
+	err = registry.Register(
+		&testMandatoryTagsRule{tag: "owner", level: core.DiagnosticLevelError},
+	)
+	s.Require().Error(err)
+	s.Assert().Equal(
+		"validation rule \"test/mandatory-tag-owner\" is already registered",
+		err.Error(),
+	)
+}
+
+func (s *RulesTestSuite) Test_returns_rules_ordered_by_name() {
+	registry, err := NewRuleRegistry(
+		&testMandatoryTagsRule{tag: "owner"},
+		&testFailingRule{},
+		&testMandatoryTagsRule{tag: "costCentre"},
+	)
+	s.Require().NoError(err)
+
+	ruleNames := []string{}
+	for _, rule := range registry.Rules() {
+		ruleNames = append(ruleNames, rule.Name())
+	}
+	s.Assert().Equal(
+		[]string{
+			"test/failing",
+			"test/mandatory-tag-costCentre",
+			"test/mandatory-tag-owner",
+		},
+		ruleNames,
+	)
+}
+
+type testMandatoryTagsRule struct {
+	tag   string
+	level core.DiagnosticLevel
+}
+
+func (r *testMandatoryTagsRule) Name() string {
+	return fmt.Sprintf("test/mandatory-tag-%s", r.tag)
+}
+
+func (r *testMandatoryTagsRule) Validate(
+	ctx context.Context,
+	input *RuleInput,
+) ([]*core.Diagnostic, error) {
+	diagnostics := []*core.Diagnostic{}
+	for _, name := range sortedKeys(input.BpSchema.Resources.Values) {
+		resource := input.BpSchema.Resources.Values[name]
+		tags := resource.Spec.Fields["tags"]
+		if tags == nil || tags.Fields[r.tag] == nil {
+			diagnostics = append(diagnostics, &core.Diagnostic{
+				Level: r.level,
+				Message: fmt.Sprintf(
+					"resource %q is missing the mandatory %q tag",
+					name,
+					r.tag,
+				),
+				Range: core.DiagnosticRangeFromSourceMeta(resource.SourceMeta, nil),
+			})
+		}
+	}
+	return diagnostics, nil
+}
+
+type testFailingRule struct{}
+
+func (r *testFailingRule) Name() string {
+	return "test/failing"
+}
+
+func (r *testFailingRule) Validate(
+	ctx context.Context,
+	input *RuleInput,
+) ([]*core.Diagnostic, error) {
+	return nil, fmt.Errorf("policy service unavailable")
+}
+
+func createRulesTestBlueprint(tags map[string]string) *schema.Blueprint {
+	tagFields := map[string]*core.MappingNode{}
+	for key, value := range tags {
+		tagFields[key] = core.MappingNodeFromString(value)
+	}
+
+	return &schema.Blueprint{
+		Resources: &schema.ResourceMap{
+			Values: map[string]*schema.Resource{
+				"ordersTable": {
+					Type: &schema.ResourceTypeWrapper{Value: "aws/dynamodb/table"},
+					Spec: &core.MappingNode{
+						Fields: map[string]*core.MappingNode{
+							"tags": {
+								Fields: tagFields,
+							},
+						},
+					},
+					SourceMeta: &source.Meta{
+						Position: source.Position{Line: 8, Column: 3},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRulesTestSuite(t *testing.T) {
+	suite.Run(t, new(RulesTestSuite))
+}
@@ -0,0 +1,341 @@
+package validation
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+)
+
+const (
+	// SARIFVersion is the version of the SARIF specification
+	// that validation results are serialised to.
+	SARIFVersion = "2.1.0"
+	// SARIFSchemaURI is the location of the JSON schema for
+	// the version of the SARIF specification that validation results
+	// are serialised to.
+	SARIFSchemaURI = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	// The rule ID used for results that do not have a reason code,
+	// SARIF requires results to be associated with a rule to be
+	// grouped by tools like GitHub code scanning.
+	sarifDefaultRuleID   = "blueprint_validation"
+	defaultSARIFToolName = "bluelink"
+)
+
+// SARIFOptions provides options for serialising blueprint
+// validation results to SARIF.
+type SARIFOptions struct {
+	// BlueprintFile is the URI of the blueprint file that was validated,
+	// this should be relative to the root of the repository for tools
+	// like GitHub code scanning to be able to map results to source files.
+	BlueprintFile string
+	// ToolName is the name of the tool that produced the results.
+	// When not set, "bluelink" is used.
+	ToolName string
+	// ToolVersion is the version of the tool that produced the results.
+	ToolVersion string
+	// ToolInformationURI is a link to documentation for the tool
+	// that produced the results.
+	ToolInformationURI string
+	// SuppressedDiagnostics holds the diagnostics that were suppressed by directives
+	// in the blueprint document, these are included as results with an in-source
+	// suppression so they are recorded without being reported as active issues.
+	SuppressedDiagnostics []*SuppressedDiagnostic
+}
+
+// SARIFLog is the top-level object of a SARIF 2.1.0 log file.
+type SARIFLog struct {
+	Version string      `json:"version"`
+	Schema  string      `json:"$schema"`
+	Runs    []*SARIFRun `json:"runs"`
+}
+
+// SARIFRun holds the results of a single run of a tool.
+type SARIFRun struct {
+	Tool    *SARIFTool     `json:"tool"`
+	Results []*SARIFResult `json:"results"`
+}
+
+// SARIFTool describes the tool that produced the results of a run.
+type SARIFTool struct {
+	Driver *SARIFToolComponent `json:"driver"`
+}
+
+// SARIFToolComponent describes the component of a tool
+// that produced the results of a run along with the rules
+// that the results are associated with.
+type SARIFToolComponent struct {
+	Name           string                      `json:"name"`
+	Version        string                      `json:"version,omitempty"`
+	InformationURI string                      `json:"informationUri,omitempty"`
+	Rules          []*SARIFReportingDescriptor `json:"rules,omitempty"`
+}
+
+// SARIFReportingDescriptor describes a rule that results are associated with,
+// for blueprint validation, a rule is derived from an error reason code.
+type SARIFReportingDescriptor struct {
+	ID string `json:"id"`
+}
+
+// SARIFResult is a single result (error, warning or note)
+// produced by validating a blueprint.
+type SARIFResult struct {
+	RuleID     string           `json:"ruleId"`
+	RuleIndex  int              `json:"ruleIndex"`
+	Level      string           `json:"level"`
+	Message    *SARIFMessage    `json:"message"`
+	Locations  []*SARIFLocation `json:"locations,omitempty"`
+	Properties map[string]any   `json:"properties,omitempty"`
+	// Suppressions is only set for results that were suppressed
+	// by directives in the blueprint document.
+	Suppressions []*SARIFSuppression `json:"suppressions,omitempty"`
+}
+
+// SARIFSuppression describes a suppression of a result.
+type SARIFSuppression struct {
+	// Kind is "inSource" for results suppressed by directives
+	// in the blueprint document.
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+// SARIFMessage holds the text of a message in a SARIF log.
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+// SARIFLocation is the location in the source blueprint that a result applies to.
+type SARIFLocation struct {
+	PhysicalLocation *SARIFPhysicalLocation `json:"physicalLocation"`
+}
+
+// SARIFPhysicalLocation is a location in a file.
+type SARIFPhysicalLocation struct {
+	ArtifactLocation *SARIFArtifactLocation `json:"artifactLocation"`
+	Region           *SARIFRegion           `json:"region,omitempty"`
+}
+
+// SARIFArtifactLocation holds the URI of a file.
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SARIFRegion is a region of text in a file,
+// lines and columns are 1-based.
+type SARIFRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+// ToSARIF converts the diagnostics and the error produced by validating
+// a blueprint into a SARIF 2.1.0 log so validation results can be ingested
+// by tools such as GitHub code scanning.
+// The provided error is expected to be a load error that may be the root of a tree
+// of load errors (e.g. an error created with ErrMultipleValidationErrors),
+// each leaf of the tree is converted into a result.
+// Errors that are not load errors are converted into a result without a location.
+func ToSARIF(
+	diagnostics []*core.Diagnostic,
+	err error,
+	opts *SARIFOptions,
+) *SARIFLog {
+	if opts == nil {
+		opts = &SARIFOptions{}
+	}
+
+	allDiagnostics := append([]*core.Diagnostic{}, diagnostics...)
+	if err != nil {
+		allDiagnostics = append(allDiagnostics, diagnosticsFromError(err)...)
+	}
+
+	toolName := opts.ToolName
+	if toolName == "" {
+		toolName = defaultSARIFToolName
+	}
+
+	ruleIDs := []string{}
+	results := []*SARIFResult{}
+	addResult := func(diagnostic *core.Diagnostic) *SARIFResult {
+		ruleID := sarifRuleID(diagnostic)
+		ruleIndex := slices.Index(ruleIDs, ruleID)
+		if ruleIndex == -1 {
+			ruleIDs = append(ruleIDs, ruleID)
+			ruleIndex = len(ruleIDs) - 1
+		}
+
+		result := sarifResultFromDiagnostic(diagnostic, ruleID, ruleIndex, opts.BlueprintFile)
+		results = append(results, result)
+		return result
+	}
+
+	for _, diagnostic := range allDiagnostics {
+		addResult(diagnostic)
+	}
+
+	for _, suppressed := range opts.SuppressedDiagnostics {
+		result := addResult(suppressed.Diagnostic)
+		result.Suppressions = []*SARIFSuppression{
+			{
+				Kind:          "inSource",
+				Justification: suppressed.Reason,
+			},
+		}
+	}
+
+	rules := make([]*SARIFReportingDescriptor, 0, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		rules = append(rules, &SARIFReportingDescriptor{ID: ruleID})
+	}
+
+	return &SARIFLog{
+		Version: SARIFVersion,
+		Schema:  SARIFSchemaURI,
+		Runs: []*SARIFRun{
+			{
+				Tool: &SARIFTool{
+					Driver: &SARIFToolComponent{
+						Name:           toolName,
+						Version:        opts.ToolVersion,
+						InformationURI: opts.ToolInformationURI,
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
+
+// ToSARIFJSON converts the diagnostics and the error produced by validating
+// a blueprint into an indented SARIF 2.1.0 JSON document.
+// See ToSARIF for details on how validation results are converted.
+func ToSARIFJSON(
+	diagnostics []*core.Diagnostic,
+	err error,
+	opts *SARIFOptions,
+) ([]byte, error) {
+	return json.MarshalIndent(ToSARIF(diagnostics, err, opts), "", "  ")
+}
+
+// Flattens a tree of load errors into a diagnostic for each leaf error.
+func diagnosticsFromError(err error) []*core.Diagnostic {
+	loadErr, isLoadErr := err.(*bperrors.LoadError)
+	if !isLoadErr {
+		return []*core.Diagnostic{
+			{
+				Level:   core.DiagnosticLevelError,
+				Message: err.Error(),
+			},
+		}
+	}
+
+	if len(loadErr.ChildErrors) == 0 {
+		diagnostic := diagnosticFromLoadError(loadErr)
+		if loadErr.Line == nil {
+			// Avoid pointing to the first line of the blueprint
+			// for errors that are not associated with a location.
+			diagnostic.Range = nil
+		}
+		if diagnostic.Context.ReasonCode == "" {
+			contextCopy := *diagnostic.Context
+			contextCopy.ReasonCode = loadErr.ReasonCode
+			diagnostic.Context = &contextCopy
+		}
+		return []*core.Diagnostic{diagnostic}
+	}
+
+	diagnostics := []*core.Diagnostic{}
+	for _, childErr := range loadErr.ChildErrors {
+		diagnostics = append(diagnostics, diagnosticsFromError(childErr)...)
+	}
+	return diagnostics
+}
+
+func sarifRuleID(diagnostic *core.Diagnostic) string {
+	if diagnostic.Context == nil || diagnostic.Context.ReasonCode == "" {
+		return sarifDefaultRuleID
+	}
+
+	return string(diagnostic.Context.ReasonCode)
+}
+
+func sarifResultFromDiagnostic(
+	diagnostic *core.Diagnostic,
+	ruleID string,
+	ruleIndex int,
+	blueprintFile string,
+) *SARIFResult {
+	result := &SARIFResult{
+		RuleID:    ruleID,
+		RuleIndex: ruleIndex,
+		Level:     sarifLevel(diagnostic.Level),
+		Message: &SARIFMessage{
+			Text: diagnostic.Message,
+		},
+	}
+
+	if blueprintFile != "" {
+		result.Locations = []*SARIFLocation{
+			{
+				PhysicalLocation: &SARIFPhysicalLocation{
+					ArtifactLocation: &SARIFArtifactLocation{
+						URI: blueprintFile,
+					},
+					Region: sarifRegion(diagnostic.Range),
+				},
+			},
+		}
+	}
+
+	if diagnostic.Context != nil && len(diagnostic.Context.SuggestedActions) > 0 {
+		result.Properties = map[string]any{
+			"suggestedActions": diagnostic.Context.SuggestedActions,
+		}
+	}
+
+	return result
+}
+
+func sarifLevel(level core.DiagnosticLevel) string {
+	switch level {
+	case core.DiagnosticLevelWarning:
+		return "warning"
+	case core.DiagnosticLevelInfo:
+		return "note"
+	default:
+		return "error"
+	}
+}
+
+func sarifRegion(diagnosticRange *core.DiagnosticRange) *SARIFRegion {
+	if diagnosticRange == nil ||
+		diagnosticRange.Start == nil ||
+		diagnosticRange.Start.Line < 1 {
+		return nil
+	}
+
+	region := &SARIFRegion{
+		StartLine: diagnosticRange.Start.Line,
+	}
+	columnsAccurate := diagnosticRange.ColumnAccuracy == nil ||
+		*diagnosticRange.ColumnAccuracy == source.ColumnAccuracyExact
+	if columnsAccurate && diagnosticRange.Start.Column > 0 {
+		region.StartColumn = diagnosticRange.Start.Column
+	}
+
+	end := diagnosticRange.End
+	if end != nil && end.Line >= region.StartLine {
+		region.EndLine = end.Line
+		if region.StartColumn > 0 && end.Column > 0 &&
+			(end.Line > region.StartLine || end.Column >= region.StartColumn) {
+			region.EndColumn = end.Column
+		}
+	}
+
+	return region
+}
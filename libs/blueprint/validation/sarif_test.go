@@ -0,0 +1,207 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/stretchr/testify/suite"
+)
+
+type SARIFTestSuite struct {
+	suite.Suite
+}
+
+func (s *SARIFTestSuite) Test_converts_validation_error_tree_and_diagnostics_to_sarif() {
+	line := 10
+	column := 5
+	endLine := 10
+	endColumn := 22
+	err := ErrMultipleValidationErrors([]error{
+		&errors.LoadError{
+			ReasonCode: ErrorReasonCodeInvalidResource,
+			Err:        fmt.Errorf("validation failed due to an invalid resource"),
+			Line:       &line,
+			Column:     &column,
+			EndLine:    &endLine,
+			EndColumn:  &endColumn,
+			Context: &errors.ErrorContext{
+				SuggestedActions: []errors.SuggestedAction{
+					{
+						Type:  string(errors.ActionTypeCheckResourceType),
+						Title: "Check the resource type",
+					},
+				},
+			},
+		},
+		ErrMultipleValidationErrors([]error{
+			&errors.LoadError{
+				ReasonCode: ErrorReasonCodeMissingVersion,
+				Err:        fmt.Errorf("validation failed due to a missing version"),
+			},
+		}),
+	})
+	diagnostics := []*core.Diagnostic{
+		{
+			Level:   core.DiagnosticLevelWarning,
+			Message: "variable \"region\" is defined but is never referenced in the blueprint",
+			Range: core.DiagnosticRangeFromSourceMeta(
+				&source.Meta{Position: source.Position{Line: 3, Column: 3}},
+				nil,
+			),
+			Context: &errors.ErrorContext{
+				ReasonCode: ErrorReasonCodeUnusedVariable,
+			},
+		},
+		{
+			Level:   core.DiagnosticLevelInfo,
+			Message: "an informational message",
+		},
+	}
+
+	sarifLog := ToSARIF(
+		diagnostics,
+		err,
+		&SARIFOptions{
+			BlueprintFile: "infra/project.blueprint.yml",
+			ToolVersion:   "1.2.0",
+		},
+	)
+
+	s.Assert().Equal(SARIFVersion, sarifLog.Version)
+	s.Require().Len(sarifLog.Runs, 1)
+	run := sarifLog.Runs[0]
+	s.Assert().Equal("bluelink", run.Tool.Driver.Name)
+	s.Assert().Equal("1.2.0", run.Tool.Driver.Version)
+	s.Assert().Equal(
+		[]*SARIFReportingDescriptor{
+			{ID: string(ErrorReasonCodeUnusedVariable)},
+			{ID: sarifDefaultRuleID},
+			{ID: string(ErrorReasonCodeInvalidResource)},
+			{ID: string(ErrorReasonCodeMissingVersion)},
+		},
+		run.Tool.Driver.Rules,
+	)
+
+	s.Require().Len(run.Results, 4)
+	s.Assert().Equal("warning", run.Results[0].Level)
+	s.Assert().Equal(0, run.Results[0].RuleIndex)
+	s.Assert().Equal(
+		&SARIFRegion{StartLine: 3, StartColumn: 3, EndLine: 4, EndColumn: 1},
+		run.Results[0].Locations[0].PhysicalLocation.Region,
+	)
+
+	s.Assert().Equal("note", run.Results[1].Level)
+	s.Assert().Equal(sarifDefaultRuleID, run.Results[1].RuleID)
+
+	s.Assert().Equal("error", run.Results[2].Level)
+	s.Assert().Equal(string(ErrorReasonCodeInvalidResource), run.Results[2].RuleID)
+	s.Assert().Equal(2, run.Results[2].RuleIndex)
+	s.Assert().Equal(
+		"validation failed due to an invalid resource",
+		run.Results[2].Message.Text,
+	)
+	s.Assert().Equal(
+		&SARIFPhysicalLocation{
+			ArtifactLocation: &SARIFArtifactLocation{URI: "infra/project.blueprint.yml"},
+			Region: &SARIFRegion{
+				StartLine:   10,
+				StartColumn: 5,
+				EndLine:     10,
+				EndColumn:   22,
+			},
+		},
+		run.Results[2].Locations[0].PhysicalLocation,
+	)
+	s.Assert().Equal(
+		[]errors.SuggestedAction{
+			{
+				Type:  string(errors.ActionTypeCheckResourceType),
+				Title: "Check the resource type",
+			},
+		},
+		run.Results[2].Properties["suggestedActions"],
+	)
+
+	s.Assert().Equal(string(ErrorReasonCodeMissingVersion), run.Results[3].RuleID)
+	s.Assert().Nil(run.Results[3].Locations[0].PhysicalLocation.Region)
+}
+
+func (s *SARIFTestSuite) Test_converts_error_that_is_not_a_load_error_to_sarif_json() {
+	sarifJSON, err := ToSARIFJSON(
+		nil,
+		fmt.Errorf("failed to read blueprint file"),
+		nil,
+	)
+	s.Require().NoError(err)
+
+	sarifLog := map[string]any{}
+	s.Require().NoError(json.Unmarshal(sarifJSON, &sarifLog))
+	s.Assert().Equal("2.1.0", sarifLog["version"])
+	s.Assert().Equal(SARIFSchemaURI, sarifLog["$schema"])
+
+	runs := sarifLog["runs"].([]any)
+	s.Require().Len(runs, 1)
+	results := runs[0].(map[string]any)["results"].([]any)
+	s.Require().Len(results, 1)
+	result := results[0].(map[string]any)
+	s.Assert().Equal("error", result["level"])
+	s.Assert().Equal(sarifDefaultRuleID, result["ruleId"])
+	s.Assert().Equal(
+		map[string]any{"text": "failed to read blueprint file"},
+		result["message"],
+	)
+	s.Assert().NotContains(result, "locations")
+}
+
+func (s *SARIFTestSuite) Test_includes_suppressed_diagnostics_as_suppressed_results() {
+	sarifLog := ToSARIF(
+		nil,
+		nil,
+		&SARIFOptions{
+			BlueprintFile: "blueprint.yml",
+			SuppressedDiagnostics: []*SuppressedDiagnostic{
+				{
+					Diagnostic: &core.Diagnostic{
+						Level:   core.DiagnosticLevelWarning,
+						Message: "variable \"region\" is defined but is never referenced in the blueprint",
+						Range: core.DiagnosticRangeFromSourceMeta(
+							&source.Meta{Position: source.Position{Line: 4, Column: 3}},
+							nil,
+						),
+						Context: &errors.ErrorContext{
+							ReasonCode: ErrorReasonCodeUnusedVariable,
+						},
+					},
+					ElementID: "variables.region",
+					Reason:    "kept for the next release",
+				},
+			},
+		},
+	)
+
+	run := sarifLog.Runs[0]
+	s.Require().Len(run.Results, 1)
+	s.Assert().Equal(string(ErrorReasonCodeUnusedVariable), run.Results[0].RuleID)
+	s.Assert().Equal("warning", run.Results[0].Level)
+	s.Assert().Equal(
+		[]*SARIFSuppression{
+			{
+				Kind:          "inSource",
+				Justification: "kept for the next release",
+			},
+		},
+		run.Results[0].Suppressions,
+	)
+	s.Assert().Equal(
+		[]*SARIFReportingDescriptor{{ID: string(ErrorReasonCodeUnusedVariable)}},
+		run.Tool.Driver.Rules,
+	)
+}
+
+func TestSARIFTestSuite(t *testing.T) {
+	suite.Run(t, new(SARIFTestSuite))
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/corefunctions"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/function"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
@@ -18,6 +19,14 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/common/core"
 )
 
+// Maps the core regular expression functions to the index of
+// the pattern argument.
+var regexFuncPatternArgIndices = map[string]int{
+	string(substitutions.SubstitutionFunctionRegexMatch):   1,
+	string(substitutions.SubstitutionFunctionRegexReplace): 1,
+	string(substitutions.SubstitutionFunctionRegexAll):     1,
+}
+
 // ValidateSubstitution validates a substitution usage in a blueprint.
 //
 // usedIn is the path to the element in the blueprint where the substitution is used.
@@ -80,7 +89,7 @@ func ValidateSubstitution(
 	}
 
 	if sub.Variable != nil {
-		return validateVariableSubstitution(sub.Variable, valCtx.BpSchema)
+		return validateVariableSubstitution(sub.Variable, valCtx)
 	}
 
 	if sub.ValueReference != nil {
@@ -97,6 +106,7 @@ func ValidateSubstitution(
 			"element",
 			sub.ElemReference.SourceMeta,
 			valCtx.BpSchema,
+			valCtx.inElemExpression,
 			usedInResourceDerivedFromTemplate,
 			usedIn,
 		)
@@ -107,6 +117,7 @@ func ValidateSubstitution(
 			"index",
 			sub.ElemIndexReference.SourceMeta,
 			valCtx.BpSchema,
+			valCtx.inElemExpression,
 			usedInResourceDerivedFromTemplate,
 			usedIn,
 		)
@@ -147,10 +158,12 @@ func ValidateSubstitution(
 
 func validateVariableSubstitution(
 	subVar *substitutions.SubstitutionVariable,
-	bpSchema *schema.Blueprint,
+	valCtx *ValidationContext,
 ) (string, []*bpcore.Diagnostic, error) {
 	diagnostics := []*bpcore.Diagnostic{}
 	varName := subVar.VariableName
+	bpSchema := valCtx.BpSchema
+	valCtx.ElementUsage.markVariable(varName)
 
 	if bpSchema.Variables == nil || bpSchema.Variables.Values == nil {
 		return "", diagnostics, errSubVarNotFound(varName, subVar.SourceMeta)
@@ -177,6 +190,7 @@ func validateValueSubstitution(
 ) (string, []*bpcore.Diagnostic, error) {
 	diagnostics := []*bpcore.Diagnostic{}
 	valName := subVal.ValueName
+	valCtx.ElementUsage.markValue(valName)
 
 	if valCtx.BpSchema.Values == nil || valCtx.BpSchema.Values.Values == nil {
 		return "", diagnostics, errSubValNotFound(valName, subVal.SourceMeta)
@@ -226,11 +240,19 @@ func validateElemReferenceSubstitution(
 	elemRefType string,
 	location *source.Meta,
 	bpSchema *schema.Blueprint,
+	inElemExpression bool,
 	derivedFromTemplate bool,
 	usedIn string,
 ) (string, []*bpcore.Diagnostic, error) {
 	diagnostics := []*bpcore.Diagnostic{}
 
+	if inElemExpression {
+		// Element references in an element expression refer to the current
+		// item of the list passed into a higher-order function, the type of
+		// the item isn't known until the list is resolved.
+		return elemReferenceType(elemRefType), diagnostics, nil
+	}
+
 	if !strings.HasPrefix(usedIn, "resources.") {
 		return "", diagnostics, errSubElemRefNotInResource(elemRefType, location)
 	}
@@ -256,11 +278,15 @@ func validateElemReferenceSubstitution(
 
 	// The type of an element reference isn't known until runtime
 	// as it dependent on the `each` property of the resource.
-	resolvedType := string(substitutions.ResolvedSubExprTypeAny)
+	return elemReferenceType(elemRefType), diagnostics, nil
+}
+
+func elemReferenceType(elemRefType string) string {
 	if elemRefType == "index" {
-		resolvedType = string(substitutions.ResolvedSubExprTypeInteger)
+		return string(substitutions.ResolvedSubExprTypeInteger)
 	}
-	return resolvedType, diagnostics, nil
+
+	return string(substitutions.ResolvedSubExprTypeAny)
 }
 
 func validateResourcePropertySubstitution(
@@ -847,6 +873,7 @@ func validateDataSourcePropertySubstitution(
 ) (string, []*bpcore.Diagnostic, error) {
 	diagnostics := []*bpcore.Diagnostic{}
 	dataSourceName := subDataSourceProp.DataSourceName
+	valCtx.ElementUsage.markDataSource(dataSourceName)
 	if valCtx.BpSchema.DataSources == nil || valCtx.BpSchema.DataSources.Values == nil {
 		return "", diagnostics, errSubDataSourceNotFound(dataSourceName, subDataSourceProp.SourceMeta)
 	}
@@ -1122,18 +1149,29 @@ func validateFunctionSubstitution(
 		)
 	}
 
+	if isFallbackFunction(funcName) && len(subFunc.Arguments) == 0 {
+		return "", diagnostics, errSubFuncFallbackMissingArgs(subFunc)
+	}
+
 	var errs []error
+	argTypes := make([]string, len(subFunc.Arguments))
+	elemExprArgIndex := substitutions.ElemExpressionArgIndex(subFunc)
 	for i, arg := range subFunc.Arguments {
 		nextLocation := (*source.Meta)(nil)
 		if i+1 < len(subFunc.Arguments) {
 			nextLocation = subFunc.Arguments[i+1].SourceMeta
 		}
 
+		argValCtx := valCtx
+		if i == elemExprArgIndex {
+			argValCtx = valCtx.withElemExpressionScope()
+		}
+
 		resolveType, argDiagnostics, err := validateSubFuncArgument(
 			ctx,
 			arg,
 			nextLocation,
-			valCtx,
+			argValCtx,
 			usedInResourceDerivedFromTemplate,
 			usedIn,
 			usedInPropertyPath,
@@ -1143,9 +1181,14 @@ func validateFunctionSubstitution(
 		if err != nil {
 			errs = append(errs, err)
 		}
+		argTypes[i] = resolveType
 
 		if err == nil {
-			err = checkSubFuncArgType(defOutput.Definition, i, arg.Value, resolveType, funcName, arg.SourceMeta)
+			if i == elemExprArgIndex {
+				err = checkElemExpressionType(funcName, resolveType, arg.SourceMeta)
+			} else {
+				err = checkSubFuncArgType(defOutput.Definition, i, arg.Value, resolveType, funcName, arg.SourceMeta)
+			}
 			if err != nil {
 				errs = append(errs, err)
 			}
@@ -1157,6 +1200,20 @@ func validateFunctionSubstitution(
 		if err != nil {
 			errs = append(errs, err)
 		}
+
+		// Regular expression patterns provided as string literals can be compiled
+		// ahead of time to catch invalid patterns before deployment.
+		err = validateRegexFuncPatternArg(funcName, i, arg, usedIn)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		// Base64-encoded string literals can be decoded ahead of time
+		// for the same reason.
+		err = validateBase64DecodeFuncArg(funcName, i, arg, usedIn)
+		if err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	if len(errs) > 0 {
@@ -1164,6 +1221,16 @@ func validateFunctionSubstitution(
 	}
 
 	returnType := subFunctionReturnType(defOutput)
+	if isFallbackFunction(funcName) {
+		fallbackType, err := validateFallbackFuncArgTypes(subFunc, argTypes)
+		if err != nil {
+			return "", diagnostics, err
+		}
+		if fallbackType != "" {
+			returnType = fallbackType
+		}
+	}
+
 	if len(subFunc.Path) > 0 {
 		resolvedType, pathDiagnostics, err := validateFunctionReturnPath(
 			subFunc,
@@ -1263,10 +1330,15 @@ func validateFunction(
 		},
 	)
 	if err != nil {
+		var suggestions []string
+		if !hasFunc && !isCoreFunc {
+			suggestions = suggestFunctions(ctx, funcRegistry, funcName)
+		}
 		return nil, diagnostics, errSubFailedToLoadFunctionDefintion(
 			funcName,
 			subFunc.SourceMeta,
 			"the function may not be configured with the loaded providers",
+			suggestions,
 		)
 	}
 
@@ -1302,6 +1374,117 @@ func validateLinkFuncArg(
 	return nil
 }
 
+// Checks the type of an element expression passed into a higher-order function
+// in place of a function value, an element expression must resolve to a value
+// for each item and predicates for the "filter" function must resolve to a boolean.
+func checkElemExpressionType(
+	funcName string,
+	resolveType string,
+	location *source.Meta,
+) error {
+	if resolveType == string(substitutions.ResolvedSubExprTypeFunction) {
+		return errSubFuncElemExpressionInvalidType(funcName, "a value", resolveType, location)
+	}
+
+	if funcName == string(substitutions.SubstitutionFunctionFilter) &&
+		resolveType != "" &&
+		resolveType != string(substitutions.ResolvedSubExprTypeAny) &&
+		resolveType != string(substitutions.ResolvedSubExprTypeBoolean) {
+		return errSubFuncElemExpressionInvalidType(
+			funcName,
+			string(substitutions.ResolvedSubExprTypeBoolean),
+			resolveType,
+			location,
+		)
+	}
+
+	return nil
+}
+
+// Fallback functions return one of their arguments, so all arguments
+// must resolve to the same type for the result type to be known
+// when validating the blueprint.
+func isFallbackFunction(funcName string) bool {
+	return funcName == string(substitutions.SubstitutionFunctionTry) ||
+		funcName == string(substitutions.SubstitutionFunctionCoalesce)
+}
+
+// Checks that the arguments of a fallback function resolve to the same type,
+// arguments with types that can not be determined until the substitution
+// is resolved are skipped.
+// Returns the type shared by the arguments or an empty string if
+// none of the argument types could be determined.
+func validateFallbackFuncArgTypes(
+	subFunc *substitutions.SubstitutionFunctionExpr,
+	argTypes []string,
+) (string, error) {
+	sharedType := ""
+	sharedTypeArgIndex := -1
+	for i, argType := range argTypes {
+		if argType == "" || argType == string(substitutions.ResolvedSubExprTypeAny) {
+			continue
+		}
+
+		if sharedType == "" {
+			sharedType = argType
+			sharedTypeArgIndex = i
+			continue
+		}
+
+		if argType != sharedType {
+			return "", errSubFuncFallbackInconsistentArgTypes(
+				subFunc,
+				sharedTypeArgIndex,
+				sharedType,
+				i,
+				argType,
+				subFunc.Arguments[i].SourceMeta,
+			)
+		}
+	}
+
+	return sharedType, nil
+}
+
+func validateRegexFuncPatternArg(
+	funcName string,
+	index int,
+	arg *substitutions.SubstitutionFunctionArg,
+	usedIn string,
+) error {
+	patternIndex, isRegexFunc := regexFuncPatternArgIndices[funcName]
+	if !isRegexFunc || index != patternIndex ||
+		arg.Value == nil || arg.Value.StringValue == nil {
+		return nil
+	}
+
+	_, err := corefunctions.CompileRegexPattern(*arg.Value.StringValue)
+	if err != nil {
+		return errSubFuncInvalidRegexPattern(funcName, index, usedIn, err, arg.SourceMeta)
+	}
+
+	return nil
+}
+
+func validateBase64DecodeFuncArg(
+	funcName string,
+	index int,
+	arg *substitutions.SubstitutionFunctionArg,
+	usedIn string,
+) error {
+	if funcName != string(substitutions.SubstitutionFunctionBase64Decode) ||
+		index != 0 || arg.Value == nil || arg.Value.StringValue == nil {
+		return nil
+	}
+
+	_, err := corefunctions.DecodeBase64String(*arg.Value.StringValue)
+	if err != nil {
+		return errSubFuncInvalidBase64String(funcName, index, usedIn, err, arg.SourceMeta)
+	}
+
+	return nil
+}
+
 func checkSubFuncArgType(
 	definition *function.Definition,
 	argIndex int,
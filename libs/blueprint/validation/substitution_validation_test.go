@@ -30,13 +30,20 @@ var _ = Suite(&SubstitutionValidationTestSuite{})
 func (s *SubstitutionValidationTestSuite) SetUpTest(c *C) {
 	s.functionRegistry = &internal.FunctionRegistryMock{
 		Functions: map[string]provider.Function{
-			"trim":       corefunctions.NewTrimFunction(),
-			"trimprefix": corefunctions.NewTrimPrefixFunction(),
-			"list":       corefunctions.NewListFunction(),
-			"object":     corefunctions.NewObjectFunction(),
-			"datetime":   corefunctions.NewDateTimeFunction(&mockclock.StaticClock{}),
-			"link":       corefunctions.NewLinkFunction(nil, nil),
-			"jsondecode": corefunctions.NewJSONDecodeFunction(),
+			"trim":         corefunctions.NewTrimFunction(),
+			"trimprefix":   corefunctions.NewTrimPrefixFunction(),
+			"list":         corefunctions.NewListFunction(),
+			"object":       corefunctions.NewObjectFunction(),
+			"datetime":     corefunctions.NewDateTimeFunction(&mockclock.StaticClock{}),
+			"link":         corefunctions.NewLinkFunction(nil, nil),
+			"jsondecode":   corefunctions.NewJSONDecodeFunction(),
+			"regexreplace": corefunctions.NewRegexReplaceFunction(),
+			"base64decode": corefunctions.NewBase64DecodeFunction(),
+			"coalesce":     corefunctions.NewCoalesceFunction(),
+			"try":          corefunctions.NewTryFunction(),
+			"map":          corefunctions.NewMapFunction(),
+			"filter":       corefunctions.NewFilterFunction(),
+			"has_prefix":   corefunctions.NewHasPrefixFunction(),
 		},
 	}
 	s.refChainCollector = refgraph.NewRefChainCollector()
@@ -1373,6 +1380,52 @@ func (s *SubstitutionValidationTestSuite) Test_produces_warning_diagnostic_when_
 	)
 }
 
+func (s *SubstitutionValidationTestSuite) Test_includes_suggestions_for_unknown_function(c *C) {
+	subInputStr := "${trimprefx(variables.environment, \"prod-\")}"
+	stringOrSubs := &substitutions.StringOrSubstitutions{}
+	err := yaml.Unmarshal([]byte(subInputStr), stringOrSubs)
+	if err != nil {
+		c.Fatalf("Failed to parse substitution: %v", err)
+	}
+
+	blueprint := &schema.Blueprint{
+		Variables: &schema.VariableMap{
+			Values: map[string]*schema.Variable{
+				"environment": {
+					Type: &schema.VariableTypeWrapper{Value: schema.VariableTypeString},
+				},
+			},
+		},
+	}
+
+	_, _, err = ValidateSubstitution(
+		context.TODO(),
+		stringOrSubs.Values[0].SubstitutionValue,
+		/* nextLocation */ nil,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.functionRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* usedInResourceDerivedFromTemplate */ false,
+		"resources.exampleResource2",
+		"",
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := internal.UnpackLoadError(err)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidSubstitution)
+	c.Assert(loadErr.Context, NotNil)
+	c.Assert(
+		loadErr.Context.Metadata["suggestions"],
+		DeepEquals,
+		[]string{"trimprefix", "trimprefix_g", "trimsuffix"},
+	)
+}
+
 func (s *SubstitutionValidationTestSuite) Test_fails_validation_for_referenced_resource_type_missing_spec(c *C) {
 	subInputStr := "${resources.exampleResource1.spec[\"id\"]}"
 	stringOrSubs := &substitutions.StringOrSubstitutions{}
@@ -2457,6 +2510,308 @@ func (s *SubstitutionValidationTestSuite) Test_fails_validation_for_a_link_func_
 	)
 }
 
+func (s *SubstitutionValidationTestSuite) Test_fails_validation_for_an_invalid_regex_pattern_literal(c *C) {
+	subInputStr := "${regexreplace(variables.environment, \"^env-[a-z\", \"\")}"
+	stringOrSubs := &substitutions.StringOrSubstitutions{}
+	err := yaml.Unmarshal([]byte(subInputStr), stringOrSubs)
+	if err != nil {
+		c.Fatalf("Failed to parse substitution: %v", err)
+	}
+
+	blueprint := &schema.Blueprint{
+		Variables: &schema.VariableMap{
+			Values: map[string]*schema.Variable{
+				"environment": {
+					Type: &schema.VariableTypeWrapper{Value: schema.VariableTypeString},
+				},
+			},
+		},
+	}
+
+	_, _, err = ValidateSubstitution(
+		context.TODO(),
+		stringOrSubs.Values[0].SubstitutionValue,
+		/* nextLocation */ nil,
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.functionRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* usedInResourceDerivedFromTemplate */ false,
+		"values.serviceName",
+		"",
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := internal.UnpackLoadError(err)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeSubFuncInvalidRegexPattern)
+	c.Assert(
+		loadErr.Err.Error(),
+		Equals,
+		"validation failed due to an invalid pattern being passed into the \"regexreplace\" function "+
+			"call argument at position 1 in \"values.serviceName\", invalid regular expression "+
+			"\"^env-[a-z\": missing closing ] at offset 5 (\"[a-z\")",
+	)
+}
+
+func (s *SubstitutionValidationTestSuite) Test_fails_validation_for_an_invalid_base64_string_literal(c *C) {
+	subInputStr := "${base64decode(\"not-valid-base64!\")}"
+	stringOrSubs := &substitutions.StringOrSubstitutions{}
+	err := yaml.Unmarshal([]byte(subInputStr), stringOrSubs)
+	if err != nil {
+		c.Fatalf("Failed to parse substitution: %v", err)
+	}
+
+	_, _, err = ValidateSubstitution(
+		context.TODO(),
+		stringOrSubs.Values[0].SubstitutionValue,
+		/* nextLocation */ nil,
+		&ValidationContext{
+			BpSchema:           &schema.Blueprint{},
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.functionRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+		/* usedInResourceDerivedFromTemplate */ false,
+		"values.certificate",
+		"",
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := internal.UnpackLoadError(err)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeSubFuncInvalidBase64String)
+	c.Assert(
+		loadErr.Err.Error(),
+		Equals,
+		"validation failed due to an invalid Base64-encoded string being passed into the \"base64decode\" function "+
+			"call argument at position 0 in \"values.certificate\", illegal base64 data at input byte 3",
+	)
+}
+
+func (s *SubstitutionValidationTestSuite) Test_resolves_shared_argument_type_for_fallback_functions(c *C) {
+	subInputStr := "${try(variables.instanceCount, 3)}"
+	stringOrSubs := &substitutions.StringOrSubstitutions{}
+	err := yaml.Unmarshal([]byte(subInputStr), stringOrSubs)
+	if err != nil {
+		c.Fatalf("Failed to parse substitution: %v", err)
+	}
+
+	resolveType, _, err := ValidateSubstitution(
+		context.TODO(),
+		stringOrSubs.Values[0].SubstitutionValue,
+		/* nextLocation */ nil,
+		s.fallbackFunctionValidationContext(),
+		/* usedInResourceDerivedFromTemplate */ false,
+		"values.instanceCount",
+		"",
+	)
+	c.Assert(err, IsNil)
+	c.Assert(resolveType, Equals, string(substitutions.ResolvedSubExprTypeInteger))
+}
+
+func (s *SubstitutionValidationTestSuite) Test_fails_validation_for_fallback_function_with_inconsistent_argument_types(c *C) {
+	subInputStr := "${coalesce(variables.instanceCount, \"three\")}"
+	stringOrSubs := &substitutions.StringOrSubstitutions{}
+	err := yaml.Unmarshal([]byte(subInputStr), stringOrSubs)
+	if err != nil {
+		c.Fatalf("Failed to parse substitution: %v", err)
+	}
+
+	_, _, err = ValidateSubstitution(
+		context.TODO(),
+		stringOrSubs.Values[0].SubstitutionValue,
+		/* nextLocation */ nil,
+		s.fallbackFunctionValidationContext(),
+		/* usedInResourceDerivedFromTemplate */ false,
+		"values.instanceCount",
+		"",
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeSubFuncInconsistentArgTypes)
+	c.Assert(
+		loadErr.Err.Error(),
+		Equals,
+		"validation failed due to inconsistent argument types for substitution function \"coalesce\", "+
+			"all arguments must resolve to the same type, the argument at position 0 resolves to integer "+
+			"but the argument at position 1 resolves to string",
+	)
+}
+
+func (s *SubstitutionValidationTestSuite) Test_fails_validation_for_fallback_function_without_arguments(c *C) {
+	subInputStr := "${try()}"
+	stringOrSubs := &substitutions.StringOrSubstitutions{}
+	err := yaml.Unmarshal([]byte(subInputStr), stringOrSubs)
+	if err != nil {
+		c.Fatalf("Failed to parse substitution: %v", err)
+	}
+
+	_, _, err = ValidateSubstitution(
+		context.TODO(),
+		stringOrSubs.Values[0].SubstitutionValue,
+		/* nextLocation */ nil,
+		s.fallbackFunctionValidationContext(),
+		/* usedInResourceDerivedFromTemplate */ false,
+		"values.instanceCount",
+		"",
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeInvalidSubstitution)
+	c.Assert(
+		loadErr.Err.Error(),
+		Equals,
+		"validation failed due to no arguments being provided for substitution function \"try\", "+
+			"at least one argument is expected",
+	)
+}
+
+func (s *SubstitutionValidationTestSuite) fallbackFunctionValidationContext() *ValidationContext {
+	return &ValidationContext{
+		BpSchema: &schema.Blueprint{
+			Variables: &schema.VariableMap{
+				Values: map[string]*schema.Variable{
+					"instanceCount": {
+						Type: &schema.VariableTypeWrapper{Value: schema.VariableTypeInteger},
+					},
+				},
+			},
+		},
+		Params:             &core.ParamsImpl{},
+		FuncRegistry:       s.functionRegistry,
+		RefChainCollector:  s.refChainCollector,
+		ResourceRegistry:   s.resourceRegistry,
+		DataSourceRegistry: s.dataSourceRegistry,
+	}
+}
+
+func (s *SubstitutionValidationTestSuite) Test_passes_validation_for_map_with_element_expression(c *C) {
+	subInputStr := "${map(jsondecode(variables.config), list(trim(elem.name), i))}"
+	stringOrSubs := &substitutions.StringOrSubstitutions{}
+	err := yaml.Unmarshal([]byte(subInputStr), stringOrSubs)
+	if err != nil {
+		c.Fatalf("Failed to parse substitution: %v", err)
+	}
+
+	resolveType, _, err := ValidateSubstitution(
+		context.TODO(),
+		stringOrSubs.Values[0].SubstitutionValue,
+		/* nextLocation */ nil,
+		s.elemExpressionValidationContext(),
+		/* usedInResourceDerivedFromTemplate */ false,
+		"values.serviceNames",
+		"",
+	)
+	c.Assert(err, IsNil)
+	c.Assert(resolveType, Equals, string(substitutions.ResolvedSubExprTypeArray))
+}
+
+func (s *SubstitutionValidationTestSuite) Test_passes_validation_for_filter_with_element_expression(c *C) {
+	subInputStr := "${filter(jsondecode(variables.config), has_prefix(elem.name, \"orders-\"))}"
+	stringOrSubs := &substitutions.StringOrSubstitutions{}
+	err := yaml.Unmarshal([]byte(subInputStr), stringOrSubs)
+	if err != nil {
+		c.Fatalf("Failed to parse substitution: %v", err)
+	}
+
+	resolveType, _, err := ValidateSubstitution(
+		context.TODO(),
+		stringOrSubs.Values[0].SubstitutionValue,
+		/* nextLocation */ nil,
+		s.elemExpressionValidationContext(),
+		/* usedInResourceDerivedFromTemplate */ false,
+		"values.orderServices",
+		"",
+	)
+	c.Assert(err, IsNil)
+	c.Assert(resolveType, Equals, string(substitutions.ResolvedSubExprTypeArray))
+}
+
+func (s *SubstitutionValidationTestSuite) Test_fails_validation_for_filter_element_expression_not_resolving_to_boolean(c *C) {
+	subInputStr := "${filter(jsondecode(variables.config), trim(elem.name))}"
+	stringOrSubs := &substitutions.StringOrSubstitutions{}
+	err := yaml.Unmarshal([]byte(subInputStr), stringOrSubs)
+	if err != nil {
+		c.Fatalf("Failed to parse substitution: %v", err)
+	}
+
+	_, _, err = ValidateSubstitution(
+		context.TODO(),
+		stringOrSubs.Values[0].SubstitutionValue,
+		/* nextLocation */ nil,
+		s.elemExpressionValidationContext(),
+		/* usedInResourceDerivedFromTemplate */ false,
+		"values.orderServices",
+		"",
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeMultipleValidationErrors)
+	c.Assert(loadErr.ChildErrors, HasLen, 1)
+	childErr, isLoadErr := loadErr.ChildErrors[0].(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(childErr.ReasonCode, Equals, ErrorReasonCodeSubFuncInvalidElemExpression)
+	c.Assert(
+		childErr.Err.Error(),
+		Equals,
+		"validation failed due to the element expression passed into substitution function \"filter\" "+
+			"resolving to type \"string\", the element expression is expected to resolve to boolean for each item",
+	)
+}
+
+func (s *SubstitutionValidationTestSuite) Test_fails_validation_for_element_reference_outside_of_element_expression(c *C) {
+	subInputStr := "${list(elem.name)}"
+	stringOrSubs := &substitutions.StringOrSubstitutions{}
+	err := yaml.Unmarshal([]byte(subInputStr), stringOrSubs)
+	if err != nil {
+		c.Fatalf("Failed to parse substitution: %v", err)
+	}
+
+	_, _, err = ValidateSubstitution(
+		context.TODO(),
+		stringOrSubs.Values[0].SubstitutionValue,
+		/* nextLocation */ nil,
+		s.elemExpressionValidationContext(),
+		/* usedInResourceDerivedFromTemplate */ false,
+		"values.serviceNames",
+		"",
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ChildErrors, HasLen, 1)
+	childErr, isLoadErr := loadErr.ChildErrors[0].(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(childErr.ReasonCode, Equals, ErrorReasonCodeInvalidSubstitution)
+}
+
+func (s *SubstitutionValidationTestSuite) elemExpressionValidationContext() *ValidationContext {
+	return &ValidationContext{
+		BpSchema: &schema.Blueprint{
+			Variables: &schema.VariableMap{
+				Values: map[string]*schema.Variable{
+					"config": {
+						Type: &schema.VariableTypeWrapper{Value: schema.VariableTypeString},
+					},
+				},
+			},
+		},
+		Params:             &core.ParamsImpl{},
+		FuncRegistry:       s.functionRegistry,
+		RefChainCollector:  s.refChainCollector,
+		ResourceRegistry:   s.resourceRegistry,
+		DataSourceRegistry: s.dataSourceRegistry,
+	}
+}
+
 func (s *SubstitutionValidationTestSuite) Test_produces_warning_for_resource_spec_array_index(c *C) {
 	subInputStr := "${resources.exampleResource1.spec.ids[0].name}"
 	stringOrSubs := &substitutions.StringOrSubstitutions{}
@@ -2621,10 +2976,10 @@ func (s *SubstitutionValidationTestSuite) Test_produces_warning_for_child_export
 		stringOrSubs.Values[0].SubstitutionValue,
 		/* nextLocation */ nil,
 		&ValidationContext{
-			BpSchema:     blueprint,
-			Params:       &core.ParamsImpl{},
-			FuncRegistry: s.functionRegistry,
-			RefChainCollector: s.refChainCollector,
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.functionRegistry,
+			RefChainCollector:  s.refChainCollector,
 			ResourceRegistry:   s.resourceRegistry,
 			DataSourceRegistry: s.dataSourceRegistry,
 			ChildExportLookup: func(
@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"context"
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/resourcehelpers"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/newstack-cloud/bluelink/libs/common/strsim"
+)
+
+// The maximum number of "did you mean" suggestions
+// to include in the context of an error.
+const maxSuggestions = 3
+
+// Suggestions are a best-effort hint for the user, failing to list the
+// available resource types should not change the error that is reported,
+// so registry errors are ignored and no suggestions are returned.
+func suggestResourceTypes(
+	ctx context.Context,
+	resourceRegistry resourcehelpers.Registry,
+	resourceType string,
+) []string {
+	resourceTypes, err := resourceRegistry.ListResourceTypes(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return strsim.FindSimilar(resourceType, resourceTypes, maxSuggestions, 0)
+}
+
+func suggestDataSourceTypes(
+	ctx context.Context,
+	dataSourceRegistry provider.DataSourceRegistry,
+	dataSourceType string,
+) []string {
+	dataSourceTypes, err := dataSourceRegistry.ListDataSourceTypes(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return strsim.FindSimilar(dataSourceType, dataSourceTypes, maxSuggestions, 0)
+}
+
+func suggestFunctions(
+	ctx context.Context,
+	funcRegistry provider.FunctionRegistry,
+	funcName string,
+) []string {
+	funcNames, err := funcRegistry.ListFunctions(ctx)
+	if err != nil {
+		funcNames = []string{}
+	}
+
+	for _, coreFunc := range substitutions.CoreSubstitutionFunctions {
+		if !slices.Contains(funcNames, string(coreFunc)) {
+			funcNames = append(funcNames, string(coreFunc))
+		}
+	}
+
+	return strsim.FindSimilar(funcName, funcNames, maxSuggestions, 0)
+}
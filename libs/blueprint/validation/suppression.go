@@ -0,0 +1,223 @@
+package validation
+
+import (
+	"slices"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+)
+
+// SuppressedDiagnostic holds a diagnostic that was suppressed by a
+// suppression directive in a blueprint document.
+// Suppressed diagnostics are reported separately from other diagnostics
+// so that audits can see what has been silenced in a blueprint.
+type SuppressedDiagnostic struct {
+	Diagnostic *core.Diagnostic `json:"diagnostic"`
+	// ElementID is the ID of the element that the suppression
+	// directive is attached to (e.g. "variables.legacyRegion").
+	ElementID string `json:"elementId"`
+	// Reason holds the justification provided in the suppression directive,
+	// this is empty when no reason is provided.
+	Reason string `json:"reason,omitempty"`
+	// DirectiveSourceMeta holds the position of the suppression directive
+	// in the blueprint document.
+	DirectiveSourceMeta *source.Meta `json:"directiveSourceMeta,omitempty"`
+}
+
+// ApplySuppressions removes warning and informational diagnostics that are suppressed
+// by directives in the provided blueprint from the provided diagnostics and validation errors.
+// Load errors with the warning or info severity in the validation errors are also suppressed.
+// A directive only applies to diagnostics that are positioned within the element
+// it is attached to, diagnostics without a position can not be suppressed.
+// Errors can not be suppressed as they prevent a blueprint from being loaded.
+//
+// This returns the remaining diagnostics and validation errors along with
+// the diagnostics that were suppressed.
+func ApplySuppressions(
+	bpSchema *schema.Blueprint,
+	diagnostics []*core.Diagnostic,
+	validationErrors []error,
+) ([]*core.Diagnostic, []error, []*SuppressedDiagnostic) {
+	if bpSchema == nil || len(bpSchema.Suppressions) == 0 {
+		return diagnostics, validationErrors, nil
+	}
+
+	suppressor := newElementSuppressor(bpSchema)
+	suppressed := []*SuppressedDiagnostic{}
+
+	remainingDiagnostics := []*core.Diagnostic{}
+	for _, diagnostic := range diagnostics {
+		suppressedDiagnostic := suppressor.suppress(diagnostic)
+		if suppressedDiagnostic != nil {
+			suppressed = append(suppressed, suppressedDiagnostic)
+		} else {
+			remainingDiagnostics = append(remainingDiagnostics, diagnostic)
+		}
+	}
+
+	remainingErrors := []error{}
+	for _, err := range validationErrors {
+		suppressedErrors, remainingErr := suppressLoadErrors(err, suppressor)
+		suppressed = append(suppressed, suppressedErrors...)
+		if remainingErr != nil {
+			remainingErrors = append(remainingErrors, remainingErr)
+		}
+	}
+
+	return remainingDiagnostics, remainingErrors, suppressed
+}
+
+// Removes load errors with the warning or info severity that are suppressed
+// from a tree of load errors, following the same approach as SplitWarnings.
+func suppressLoadErrors(
+	err error,
+	suppressor *elementSuppressor,
+) ([]*SuppressedDiagnostic, error) {
+	loadErr, isLoadErr := err.(*bperrors.LoadError)
+	if !isLoadErr {
+		return nil, err
+	}
+
+	if len(loadErr.ChildErrors) == 0 {
+		if loadErr.IsError() {
+			return nil, err
+		}
+
+		suppressedDiagnostic := suppressor.suppress(diagnosticFromLoadError(loadErr))
+		if suppressedDiagnostic == nil {
+			return nil, err
+		}
+		return []*SuppressedDiagnostic{suppressedDiagnostic}, nil
+	}
+
+	suppressed := []*SuppressedDiagnostic{}
+	remainingChildErrs := []error{}
+	for _, childErr := range loadErr.ChildErrors {
+		childSuppressed, remainingErr := suppressLoadErrors(childErr, suppressor)
+		suppressed = append(suppressed, childSuppressed...)
+		if remainingErr != nil {
+			remainingChildErrs = append(remainingChildErrs, remainingErr)
+		}
+	}
+
+	if len(remainingChildErrs) == 0 {
+		return suppressed, nil
+	}
+
+	if len(remainingChildErrs) == len(loadErr.ChildErrors) {
+		return suppressed, err
+	}
+
+	loadErrCopy := *loadErr
+	loadErrCopy.ChildErrors = remainingChildErrs
+	return suppressed, &loadErrCopy
+}
+
+type elementSuppressor struct {
+	// Elements ordered by the line they start on
+	// in the source document.
+	elements     []*suppressibleElement
+	suppressions []*schema.Suppression
+}
+
+type suppressibleElement struct {
+	id         string
+	sourceMeta *source.Meta
+}
+
+func newElementSuppressor(bpSchema *schema.Blueprint) *elementSuppressor {
+	elements := []*suppressibleElement{}
+	if bpSchema.Variables != nil {
+		elements = appendSuppressibleElements(elements, bpSchema.Variables.SourceMeta, core.VariableElementID)
+	}
+	if bpSchema.Values != nil {
+		elements = appendSuppressibleElements(elements, bpSchema.Values.SourceMeta, core.ValueElementID)
+	}
+	if bpSchema.Include != nil {
+		elements = appendSuppressibleElements(elements, bpSchema.Include.SourceMeta, core.ChildElementID)
+	}
+	if bpSchema.Resources != nil {
+		elements = appendSuppressibleElements(elements, bpSchema.Resources.SourceMeta, core.ResourceElementID)
+	}
+	if bpSchema.DataSources != nil {
+		elements = appendSuppressibleElements(elements, bpSchema.DataSources.SourceMeta, core.DataSourceElementID)
+	}
+	if bpSchema.Exports != nil {
+		elements = appendSuppressibleElements(elements, bpSchema.Exports.SourceMeta, core.ExportElementID)
+	}
+
+	slices.SortFunc(elements, func(a, b *suppressibleElement) int {
+		return a.sourceMeta.Line - b.sourceMeta.Line
+	})
+
+	return &elementSuppressor{
+		elements:     elements,
+		suppressions: bpSchema.Suppressions,
+	}
+}
+
+func appendSuppressibleElements(
+	elements []*suppressibleElement,
+	sourceMeta map[string]*source.Meta,
+	elementID func(string) string,
+) []*suppressibleElement {
+	for name, meta := range sourceMeta {
+		if meta != nil {
+			elements = append(elements, &suppressibleElement{
+				id:         elementID(name),
+				sourceMeta: meta,
+			})
+		}
+	}
+	return elements
+}
+
+func (s *elementSuppressor) suppress(diagnostic *core.Diagnostic) *SuppressedDiagnostic {
+	if diagnostic.Level == core.DiagnosticLevelError ||
+		diagnostic.Context == nil ||
+		diagnostic.Range == nil ||
+		diagnostic.Range.Start == nil {
+		return nil
+	}
+
+	element := s.elementAtLine(diagnostic.Range.Start.Line)
+	if element == nil {
+		return nil
+	}
+
+	for _, suppression := range s.suppressions {
+		if suppression.ElementLine == element.sourceMeta.Line &&
+			slices.Contains(suppression.ReasonCodes, string(diagnostic.Context.ReasonCode)) {
+			return &SuppressedDiagnostic{
+				Diagnostic:          diagnostic,
+				ElementID:           element.id,
+				Reason:              suppression.Reason,
+				DirectiveSourceMeta: suppression.SourceMeta,
+			}
+		}
+	}
+
+	return nil
+}
+
+// Finds the element that contains the provided line, an element
+// spans from the line it starts on to the end position when it is known
+// (JWCC source documents), otherwise to the line before the next element.
+func (s *elementSuppressor) elementAtLine(line int) *suppressibleElement {
+	var found *suppressibleElement
+	for _, element := range s.elements {
+		if element.sourceMeta.Line > line {
+			break
+		}
+		found = element
+	}
+
+	if found != nil && found.sourceMeta.EndPosition != nil &&
+		found.sourceMeta.EndPosition.Line < line {
+		return nil
+	}
+
+	return found
+}
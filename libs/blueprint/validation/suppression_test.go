@@ -0,0 +1,138 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuppressionTestSuite struct {
+	suite.Suite
+}
+
+const suppressionTestBlueprint = `version: 2025-11-02
+variables:
+  # bluelink:disable unused_variable -- kept for the next release
+  region:
+    type: string
+  environment: # bluelink:disable unused_value
+    type: string
+values:
+  tableName:
+    type: string
+    value: orders
+resources: {}
+`
+
+func (s *SuppressionTestSuite) Test_suppresses_warnings_for_the_element_the_directive_is_attached_to() {
+	bpSchema, err := schema.LoadString(suppressionTestBlueprint, schema.YAMLSpecFormat)
+	s.Require().NoError(err)
+
+	unusedErr := ValidateUnusedElements(bpSchema, NewElementUsage())
+	s.Require().Error(unusedErr)
+
+	regionAnyTypeWarning := suppressionTestDiagnostic(
+		core.DiagnosticLevelWarning,
+		errors.ErrorReasonCodeAnyTypeWarning,
+		5,
+	)
+	diagnostics, validationErrors, suppressed := ApplySuppressions(
+		bpSchema,
+		[]*core.Diagnostic{regionAnyTypeWarning},
+		[]error{unusedErr},
+	)
+
+	s.Require().Len(suppressed, 1)
+	s.Assert().Equal("variables.region", suppressed[0].ElementID)
+	s.Assert().Equal("kept for the next release", suppressed[0].Reason)
+	s.Assert().Equal(3, suppressed[0].DirectiveSourceMeta.Line)
+	s.Assert().Equal(ErrorReasonCodeUnusedVariable, suppressed[0].Diagnostic.Context.ReasonCode)
+	s.Assert().Equal(core.DiagnosticLevelWarning, suppressed[0].Diagnostic.Level)
+
+	// Diagnostics with reason codes that are not listed in the directive
+	// for the element are not suppressed.
+	s.Assert().Equal([]*core.Diagnostic{regionAnyTypeWarning}, diagnostics)
+	s.Require().Len(validationErrors, 1)
+	remainingWarnings, remainingErr := SplitWarnings(validationErrors[0])
+	s.Assert().NoError(remainingErr)
+	s.Require().Len(remainingWarnings, 2)
+	s.Assert().Contains(remainingWarnings[0].Message, "variable \"environment\"")
+	s.Assert().Contains(remainingWarnings[1].Message, "value \"tableName\"")
+}
+
+func (s *SuppressionTestSuite) Test_does_not_suppress_errors() {
+	bpSchema, err := schema.LoadString(suppressionTestBlueprint, schema.YAMLSpecFormat)
+	s.Require().NoError(err)
+
+	errorDiagnostic := suppressionTestDiagnostic(
+		core.DiagnosticLevelError,
+		ErrorReasonCodeUnusedVariable,
+		4,
+	)
+	diagnostics, _, suppressed := ApplySuppressions(
+		bpSchema,
+		[]*core.Diagnostic{errorDiagnostic},
+		nil,
+	)
+
+	s.Assert().Empty(suppressed)
+	s.Assert().Equal([]*core.Diagnostic{errorDiagnostic}, diagnostics)
+}
+
+func (s *SuppressionTestSuite) Test_suppresses_diagnostics_in_jwcc_document() {
+	bpSchema, err := schema.LoadString(
+		`{
+			"version": "2025-11-02",
+			"variables": {
+				// bluelink:disable unused_variable
+				"region": {
+					"type": "string"
+				},
+				"environment": {
+					"type": "string"
+				}
+			},
+			"resources": {}
+		}`,
+		schema.JWCCSpecFormat,
+	)
+	s.Require().NoError(err)
+
+	unusedErr := ValidateUnusedElements(bpSchema, NewElementUsage())
+	s.Require().Error(unusedErr)
+
+	_, validationErrors, suppressed := ApplySuppressions(bpSchema, nil, []error{unusedErr})
+	s.Require().Len(suppressed, 1)
+	s.Assert().Equal("variables.region", suppressed[0].ElementID)
+
+	s.Require().Len(validationErrors, 1)
+	remainingWarnings, _ := SplitWarnings(validationErrors[0])
+	s.Require().Len(remainingWarnings, 1)
+	s.Assert().Contains(remainingWarnings[0].Message, "variable \"environment\"")
+}
+
+func suppressionTestDiagnostic(
+	level core.DiagnosticLevel,
+	reasonCode errors.ErrorReasonCode,
+	line int,
+) *core.Diagnostic {
+	return &core.Diagnostic{
+		Level:   level,
+		Message: "test diagnostic",
+		Range: core.DiagnosticRangeFromSourceMeta(
+			&source.Meta{Position: source.Position{Line: line, Column: 5}},
+			nil,
+		),
+		Context: &errors.ErrorContext{
+			ReasonCode: reasonCode,
+		},
+	}
+}
+
+func TestSuppressionTestSuite(t *testing.T) {
+	suite.Run(t, new(SuppressionTestSuite))
+}
@@ -0,0 +1,107 @@
+package validation
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+)
+
+// ElementUsage keeps track of the variables, values and data sources
+// that are referenced in substitutions while validating a blueprint.
+// This is used to report variables, values and data sources that are never used.
+type ElementUsage struct {
+	variables   map[string]bool
+	values      map[string]bool
+	dataSources map[string]bool
+}
+
+// NewElementUsage creates a new tracker for the variables, values
+// and data sources referenced in a blueprint.
+func NewElementUsage() *ElementUsage {
+	return &ElementUsage{
+		variables:   map[string]bool{},
+		values:      map[string]bool{},
+		dataSources: map[string]bool{},
+	}
+}
+
+func (u *ElementUsage) markVariable(varName string) {
+	if u != nil {
+		u.variables[varName] = true
+	}
+}
+
+func (u *ElementUsage) markValue(valName string) {
+	if u != nil {
+		u.values[valName] = true
+	}
+}
+
+func (u *ElementUsage) markDataSource(dataSourceName string) {
+	if u != nil {
+		u.dataSources[dataSourceName] = true
+	}
+}
+
+// ValidateUnusedElements reports variables, values and data sources defined
+// in a blueprint that are not referenced anywhere in the blueprint.
+// References can be made from resources, data sources, values, includes,
+// exports and blueprint-wide metadata.
+// Unused elements are reported as load errors with the warning severity,
+// the caller decides whether warnings should prevent the blueprint from being loaded.
+//
+// This must only be called after all the substitutions in the blueprint
+// have been validated with the provided element usage tracker.
+func ValidateUnusedElements(
+	bpSchema *schema.Blueprint,
+	usage *ElementUsage,
+) error {
+	if bpSchema == nil || usage == nil {
+		return nil
+	}
+
+	errs := []error{}
+	if bpSchema.Variables != nil {
+		for _, varName := range sortedKeys(bpSchema.Variables.Values) {
+			if !usage.variables[varName] {
+				errs = append(
+					errs,
+					warnUnusedVariable(varName, bpSchema.Variables.SourceMeta[varName]),
+				)
+			}
+		}
+	}
+
+	if bpSchema.Values != nil {
+		for _, valName := range sortedKeys(bpSchema.Values.Values) {
+			if !usage.values[valName] {
+				errs = append(
+					errs,
+					warnUnusedValue(valName, bpSchema.Values.SourceMeta[valName]),
+				)
+			}
+		}
+	}
+
+	if bpSchema.DataSources != nil {
+		for _, dataSourceName := range sortedKeys(bpSchema.DataSources.Values) {
+			if !usage.dataSources[dataSourceName] {
+				errs = append(
+					errs,
+					warnUnusedDataSource(
+						dataSourceName,
+						bpSchema.DataSources.SourceMeta[dataSourceName],
+					),
+				)
+			}
+		}
+	}
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	if len(errs) > 1 {
+		return ErrMultipleValidationErrors(errs)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/errors"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/refgraph"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnusedElementValidationTestSuite struct {
+	suite.Suite
+}
+
+func (s *UnusedElementValidationTestSuite) Test_succeeds_when_all_elements_are_used() {
+	bpSchema := createUnusedElementsTestBlueprint()
+	usage := NewElementUsage()
+	s.markReferences(bpSchema, usage, "variables.environment", "variables.region", "values.tableName")
+	// Data source references are validated against the data source registry,
+	// so they are marked directly to avoid the need for a registry in these tests.
+	usage.markDataSource("network")
+
+	err := ValidateUnusedElements(bpSchema, usage)
+	s.Assert().NoError(err)
+}
+
+func (s *UnusedElementValidationTestSuite) Test_reports_unused_variables_values_and_data_sources_as_warnings() {
+	bpSchema := createUnusedElementsTestBlueprint()
+	usage := NewElementUsage()
+	s.markReferences(bpSchema, usage, "variables.environment")
+
+	err := ValidateUnusedElements(bpSchema, usage)
+	s.Require().Error(err)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeMultipleValidationErrors, loadErr.ReasonCode)
+	s.Require().Len(loadErr.ChildErrors, 3)
+
+	unusedVarErr, isLoadErr := loadErr.ChildErrors[0].(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeUnusedVariable, unusedVarErr.ReasonCode)
+	s.Assert().Equal(errors.ErrorSeverityWarning, unusedVarErr.Severity)
+	s.Assert().False(unusedVarErr.IsError())
+	s.Assert().Contains(unusedVarErr.Error(), "variable \"region\" is defined but is never referenced")
+	s.Assert().Equal(5, *unusedVarErr.Line)
+
+	unusedValErr, isLoadErr := loadErr.ChildErrors[1].(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeUnusedValue, unusedValErr.ReasonCode)
+	s.Assert().Equal(errors.ErrorSeverityWarning, unusedValErr.Severity)
+	s.Assert().Contains(unusedValErr.Error(), "value \"tableName\" is defined but is never referenced")
+
+	unusedDataSourceErr, isLoadErr := loadErr.ChildErrors[2].(*errors.LoadError)
+	s.Require().True(isLoadErr)
+	s.Assert().Equal(ErrorReasonCodeUnusedDataSource, unusedDataSourceErr.ReasonCode)
+	s.Assert().Equal(errors.ErrorSeverityWarning, unusedDataSourceErr.Severity)
+	s.Assert().Contains(
+		unusedDataSourceErr.Error(),
+		"data source \"network\" is defined but is never referenced",
+	)
+	s.Assert().Equal(12, *unusedDataSourceErr.Line)
+	s.Assert().Equal(3, *unusedDataSourceErr.Column)
+}
+
+func (s *UnusedElementValidationTestSuite) Test_does_not_report_unused_elements_without_usage_tracker() {
+	err := ValidateUnusedElements(createUnusedElementsTestBlueprint(), nil)
+	s.Assert().NoError(err)
+}
+
+func (s *UnusedElementValidationTestSuite) markReferences(
+	bpSchema *schema.Blueprint,
+	usage *ElementUsage,
+	references ...string,
+) {
+	valCtx := &ValidationContext{
+		BpSchema:          bpSchema,
+		Params:            createParams(),
+		RefChainCollector: refgraph.NewRefChainCollector(),
+		ElementUsage:      usage,
+	}
+	for _, reference := range references {
+		sub, err := substitutions.ParseSubstitution(
+			"test",
+			reference,
+			&source.Meta{Position: source.Position{}},
+			/* outputLineInfo */ false,
+			/* ignoreParentColumn */ true,
+		)
+		s.Require().NoError(err)
+		_, _, err = ValidateSubstitution(
+			context.Background(),
+			sub,
+			nil,
+			valCtx,
+			/* usedInResourceDerivedFromTemplate */ false,
+			"resources.ordersTable",
+			"spec.tableName",
+		)
+		s.Require().NoError(err)
+	}
+}
+
+func createUnusedElementsTestBlueprint() *schema.Blueprint {
+	return &schema.Blueprint{
+		Variables: &schema.VariableMap{
+			Values: map[string]*schema.Variable{
+				"environment": {
+					Type: &schema.VariableTypeWrapper{Value: schema.VariableTypeString},
+				},
+				"region": {
+					Type: &schema.VariableTypeWrapper{Value: schema.VariableTypeString},
+				},
+			},
+			SourceMeta: map[string]*source.Meta{
+				"environment": {Position: source.Position{Line: 3, Column: 3}},
+				"region":      {Position: source.Position{Line: 5, Column: 3}},
+			},
+		},
+		Values: &schema.ValueMap{
+			Values: map[string]*schema.Value{
+				"tableName": {
+					Type:  &schema.ValueTypeWrapper{Value: schema.ValueTypeString},
+					Value: core.MappingNodeFromString("orders"),
+				},
+			},
+		},
+		DataSources: &schema.DataSourceMap{
+			Values: map[string]*schema.DataSource{
+				"network": {
+					Type: &schema.DataSourceTypeWrapper{Value: "aws/vpc"},
+				},
+			},
+			SourceMeta: map[string]*source.Meta{
+				"network": {Position: source.Position{Line: 12, Column: 3}},
+			},
+		},
+	}
+}
+
+func TestUnusedElementValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(UnusedElementValidationTestSuite))
+}
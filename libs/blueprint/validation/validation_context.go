@@ -18,4 +18,28 @@ type ValidationContext struct {
 	ResourceRegistry   resourcehelpers.Registry
 	DataSourceRegistry provider.DataSourceRegistry
 	ChildExportLookup  ChildExportTypeLookup
+	// AllowedValuesLookup is used to fetch allowed values for variables
+	// and resource spec fields that source their allowed values from data sources.
+	// When not set, values are not checked against data source allowed values.
+	AllowedValuesLookup AllowedValuesLookup
+	// InstanceExportLookup is used to check that other deployed blueprint instances
+	// and their exports referenced in the blueprint exist.
+	// When not set, only the format of instance references is validated.
+	InstanceExportLookup InstanceExportLookup
+	// ElementUsage is used to keep track of the variables, values
+	// and data sources referenced in substitutions.
+	// When not set, references to variables, values and data sources are not tracked.
+	ElementUsage *ElementUsage
+	// inElemExpression is set when validating an element expression passed
+	// into a higher-order function such as "map" or "filter", where `elem`
+	// and `i` refer to the current item and index of the list being iterated over.
+	inElemExpression bool
+}
+
+// withElemExpressionScope returns a copy of the validation context
+// to validate an element expression with.
+func (c *ValidationContext) withElemExpressionScope() *ValidationContext {
+	scoped := *c
+	scoped.inElemExpression = true
+	return &scoped
 }
@@ -0,0 +1,323 @@
+package validation
+
+import (
+	"slices"
+
+	bpcore "github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+)
+
+// InferredValueType holds the type inferred for the content of a blueprint value
+// along with the chain of references that were followed to resolve the type.
+type InferredValueType struct {
+	// Type is the resolved type of the value content,
+	// "any" is used when the type can not be determined before deployment.
+	Type string
+	// Chain holds the elements that were followed to resolve the type in order,
+	// starting with the value itself.
+	// (e.g. ["values.port", "values.networkPort", "children.network.port"])
+	Chain []string
+}
+
+// InferValueTypes infers the types of the content of all the values
+// in the given blueprint, following references to other values, variables,
+// child blueprint exports and data source fields.
+// The child export lookup is optional, when not provided, references to child
+// blueprint exports are resolved as "any".
+func InferValueTypes(
+	bpSchema *schema.Blueprint,
+	childExportLookup ChildExportTypeLookup,
+) map[string]*InferredValueType {
+	inferredTypes := map[string]*InferredValueType{}
+	if bpSchema == nil || bpSchema.Values == nil {
+		return inferredTypes
+	}
+
+	for valName := range bpSchema.Values.Values {
+		inferredTypes[valName] = InferValueType(valName, bpSchema, childExportLookup)
+	}
+
+	return inferredTypes
+}
+
+// InferValueType infers the type of the content of a single value in the given
+// blueprint, following references to other values, variables,
+// child blueprint exports and data source fields.
+// Returns nil if the value does not exist in the blueprint.
+func InferValueType(
+	valName string,
+	bpSchema *schema.Blueprint,
+	childExportLookup ChildExportTypeLookup,
+) *InferredValueType {
+	inferrer := &valueTypeInferrer{
+		bpSchema:          bpSchema,
+		childExportLookup: childExportLookup,
+	}
+	return inferrer.inferValue(valName, []string{})
+}
+
+// Infers the type of a substitution used in the content of the value
+// with the given name, the returned chain does not include the value itself.
+func inferValueSubstitutionType(
+	valName string,
+	sub *substitutions.Substitution,
+	valCtx *ValidationContext,
+) *InferredValueType {
+	inferrer := &valueTypeInferrer{
+		bpSchema:          valCtx.BpSchema,
+		childExportLookup: valCtx.ChildExportLookup,
+	}
+	return inferrer.inferSubstitution(sub, []string{bpcore.ValueElementID(valName)})
+}
+
+type valueTypeInferrer struct {
+	bpSchema          *schema.Blueprint
+	childExportLookup ChildExportTypeLookup
+}
+
+func (i *valueTypeInferrer) inferValue(valName string, visited []string) *InferredValueType {
+	valSchema := getValueSchema(i.bpSchema, valName)
+	if valSchema == nil {
+		return nil
+	}
+
+	valIdentifier := bpcore.ValueElementID(valName)
+	if slices.Contains(visited, valIdentifier) {
+		// Reference cycles are reported when validating references,
+		// the type of a value in a cycle can not be inferred.
+		return anyValueType(valIdentifier)
+	}
+
+	inferred := i.inferContent(valSchema.Value, withVisited(visited, valIdentifier))
+	return &InferredValueType{
+		Type:  inferred.Type,
+		Chain: append([]string{valIdentifier}, inferred.Chain...),
+	}
+}
+
+func (i *valueTypeInferrer) inferContent(
+	content *bpcore.MappingNode,
+	visited []string,
+) *InferredValueType {
+	if content == nil {
+		return anyValueType()
+	}
+
+	if !bpcore.IsStringWithSubsMappingNode(content) {
+		return &InferredValueType{
+			Type:  resolvedTypeForMappingNode(content),
+			Chain: []string{},
+		}
+	}
+
+	stringOrSubs := content.StringWithSubstitutions.Values
+	if len(stringOrSubs) != 1 || stringOrSubs[0].SubstitutionValue == nil {
+		// Interpolated strings and string literals always resolve to strings.
+		return &InferredValueType{
+			Type:  string(substitutions.ResolvedSubExprTypeString),
+			Chain: []string{},
+		}
+	}
+
+	return i.inferSubstitution(stringOrSubs[0].SubstitutionValue, visited)
+}
+
+func (i *valueTypeInferrer) inferSubstitution(
+	sub *substitutions.Substitution,
+	visited []string,
+) *InferredValueType {
+	switch {
+	case sub.StringValue != nil:
+		return literalValueType(substitutions.ResolvedSubExprTypeString)
+	case sub.IntValue != nil:
+		return literalValueType(substitutions.ResolvedSubExprTypeInteger)
+	case sub.FloatValue != nil:
+		return literalValueType(substitutions.ResolvedSubExprTypeFloat)
+	case sub.BoolValue != nil:
+		return literalValueType(substitutions.ResolvedSubExprTypeBoolean)
+	case sub.Variable != nil:
+		return i.inferVariable(sub)
+	case sub.ValueReference != nil:
+		return i.inferValueReference(sub, visited)
+	case sub.Child != nil:
+		return i.inferChildExport(sub)
+	case sub.DataSourceProperty != nil:
+		return i.inferDataSourceField(sub)
+	}
+
+	// The types of function calls and resource properties depend on
+	// provider definitions that are not available for inference.
+	return anyValueType(substitutionRefString(sub)...)
+}
+
+func (i *valueTypeInferrer) inferVariable(sub *substitutions.Substitution) *InferredValueType {
+	chain := substitutionRefString(sub)
+	if i.bpSchema.Variables == nil {
+		return anyValueType(chain...)
+	}
+
+	varSchema, hasVar := i.bpSchema.Variables.Values[sub.Variable.VariableName]
+	if !hasVar || varSchema == nil {
+		return anyValueType(chain...)
+	}
+
+	return &InferredValueType{
+		Type:  subVarType(varSchema.Type),
+		Chain: chain,
+	}
+}
+
+func (i *valueTypeInferrer) inferValueReference(
+	sub *substitutions.Substitution,
+	visited []string,
+) *InferredValueType {
+	valRef := sub.ValueReference
+	valSchema := getValueSchema(i.bpSchema, valRef.ValueName)
+	if valSchema == nil {
+		return anyValueType(substitutionRefString(sub)...)
+	}
+
+	if len(valRef.Path) == 0 {
+		referenced := i.inferValue(valRef.ValueName, visited)
+		if valSchema.Type == nil || valSchema.Type.Value == "" {
+			return referenced
+		}
+		// The declared type of the referenced value takes precedence,
+		// content that does not match the declared type is reported
+		// when validating the referenced value.
+		return &InferredValueType{
+			Type:  subValType(valSchema.Type),
+			Chain: referenced.Chain,
+		}
+	}
+
+	valIdentifier := bpcore.ValueElementID(valRef.ValueName)
+	chain := substitutionRefString(sub)
+	if slices.Contains(visited, valIdentifier) {
+		return anyValueType(chain...)
+	}
+
+	target := mappingNodeAtPath(valSchema.Value, valRef.Path)
+	if target == nil {
+		return anyValueType(chain...)
+	}
+
+	inferred := i.inferContent(target, withVisited(visited, valIdentifier))
+	return &InferredValueType{
+		Type:  inferred.Type,
+		Chain: append(chain, inferred.Chain...),
+	}
+}
+
+func (i *valueTypeInferrer) inferChildExport(sub *substitutions.Substitution) *InferredValueType {
+	subChild := sub.Child
+	chain := substitutionRefString(sub)
+	if i.childExportLookup == nil || len(subChild.Path) == 0 ||
+		subChild.Path[0].FieldName == "" {
+		return anyValueType(chain...)
+	}
+
+	exportSchema, err := i.childExportLookup(
+		subChild.ChildName,
+		subChild.Path[0].FieldName,
+		subChild.SourceMeta,
+	)
+	if err != nil || exportSchema == nil || exportSchema.Type == nil ||
+		len(subChild.Path) > 1 {
+		return anyValueType(chain...)
+	}
+
+	return &InferredValueType{
+		Type:  subTypeFromExportType(exportSchema.Type.Value),
+		Chain: chain,
+	}
+}
+
+func (i *valueTypeInferrer) inferDataSourceField(sub *substitutions.Substitution) *InferredValueType {
+	subDataSourceProp := sub.DataSourceProperty
+	chain := substitutionRefString(sub)
+	if i.bpSchema.DataSources == nil {
+		return anyValueType(chain...)
+	}
+
+	dataSource, hasDataSource := i.bpSchema.DataSources.Values[subDataSourceProp.DataSourceName]
+	if !hasDataSource || dataSource == nil || dataSource.Exports == nil {
+		return anyValueType(chain...)
+	}
+
+	field, hasField := dataSource.Exports.Values[subDataSourceProp.FieldName]
+	if !hasField || field == nil || field.Type == nil ||
+		// Element types of data source arrays are not known before deployment.
+		subDataSourceProp.PrimitiveArrIndex != nil {
+		return anyValueType(chain...)
+	}
+
+	return &InferredValueType{
+		Type:  subDataSourceFieldType(field.Type.Value),
+		Chain: chain,
+	}
+}
+
+func withVisited(visited []string, elementID string) []string {
+	return append(slices.Clone(visited), elementID)
+}
+
+func mappingNodeAtPath(
+	node *bpcore.MappingNode,
+	path []*substitutions.SubstitutionPathItem,
+) *bpcore.MappingNode {
+	current := node
+	for _, pathItem := range path {
+		if current == nil {
+			return nil
+		}
+
+		if pathItem.ArrayIndex != nil {
+			idx := int(*pathItem.ArrayIndex)
+			if idx < 0 || idx >= len(current.Items) {
+				return nil
+			}
+			current = current.Items[idx]
+		} else {
+			current = current.Fields[pathItem.FieldName]
+		}
+	}
+
+	return current
+}
+
+func getValueSchema(bpSchema *schema.Blueprint, valName string) *schema.Value {
+	if bpSchema == nil || bpSchema.Values == nil {
+		return nil
+	}
+
+	return bpSchema.Values.Values[valName]
+}
+
+func substitutionRefString(sub *substitutions.Substitution) []string {
+	refString, err := substitutions.SubstitutionToString("", sub)
+	if err != nil {
+		return []string{}
+	}
+
+	return []string{refString}
+}
+
+func literalValueType(resolvedType substitutions.ResolvedSubExprType) *InferredValueType {
+	return &InferredValueType{
+		Type:  string(resolvedType),
+		Chain: []string{},
+	}
+}
+
+func anyValueType(chain ...string) *InferredValueType {
+	if chain == nil {
+		chain = []string{}
+	}
+
+	return &InferredValueType{
+		Type:  string(substitutions.ResolvedSubExprTypeAny),
+		Chain: chain,
+	}
+}
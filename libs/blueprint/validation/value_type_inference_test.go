@@ -0,0 +1,140 @@
+package validation
+
+import (
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
+	. "gopkg.in/check.v1"
+)
+
+type ValueTypeInferenceTestSuite struct{}
+
+var _ = Suite(&ValueTypeInferenceTestSuite{})
+
+const valueTypeInferenceTestBlueprint = `
+version: 2025-11-02
+variables:
+  environment:
+    type: string
+  instanceCount:
+    type: integer
+include:
+  network:
+    path: network.blueprint.yml
+datasources:
+  vpc:
+    type: aws/vpc
+    exports:
+      cidrBlock:
+        type: string
+      subnetCount:
+        type: integer
+values:
+  staticPort:
+    type: integer
+    value: 8080
+  label:
+    type: string
+    value: "orders-${variables.environment}"
+  networkId:
+    type: string
+    value: "${children.network.vpcId}"
+  vpcId:
+    type: integer
+    value: "${values.networkId}"
+  networkConfig:
+    type: object
+    value:
+      instances: "${variables.instanceCount}"
+      subnets: "${datasources.vpc.subnetCount}"
+  instances:
+    type: integer
+    value: "${values.networkConfig.instances}"
+  subnets:
+    type: integer
+    value: "${values.networkConfig.subnets}"
+  decoded:
+    type: object
+    value: "${jsondecode(variables.environment)}"
+  cycleA:
+    type: string
+    value: "${values.cycleB}"
+  cycleB:
+    type: string
+    value: "${values.cycleA}"
+`
+
+func (s *ValueTypeInferenceTestSuite) Test_infers_types_for_values_following_references(c *C) {
+	blueprint, err := schema.LoadString(valueTypeInferenceTestBlueprint, schema.YAMLSpecFormat)
+	c.Assert(err, IsNil)
+
+	inferredTypes := InferValueTypes(blueprint, testNetworkChildExportLookup)
+
+	c.Assert(inferredTypes["staticPort"], DeepEquals, &InferredValueType{
+		Type:  "integer",
+		Chain: []string{"values.staticPort"},
+	})
+	c.Assert(inferredTypes["label"], DeepEquals, &InferredValueType{
+		Type:  "string",
+		Chain: []string{"values.label"},
+	})
+	c.Assert(inferredTypes["networkId"], DeepEquals, &InferredValueType{
+		Type:  "string",
+		Chain: []string{"values.networkId", "children.network.vpcId"},
+	})
+	c.Assert(inferredTypes["vpcId"], DeepEquals, &InferredValueType{
+		Type:  "string",
+		Chain: []string{"values.vpcId", "values.networkId", "children.network.vpcId"},
+	})
+	c.Assert(inferredTypes["instances"], DeepEquals, &InferredValueType{
+		Type: "integer",
+		Chain: []string{
+			"values.instances",
+			"values.networkConfig.instances",
+			"variables.instanceCount",
+		},
+	})
+	c.Assert(inferredTypes["subnets"], DeepEquals, &InferredValueType{
+		Type: "integer",
+		Chain: []string{
+			"values.subnets",
+			"values.networkConfig.subnets",
+			"datasources.vpc.subnetCount",
+		},
+	})
+	c.Assert(inferredTypes["decoded"].Type, Equals, "any")
+	c.Assert(inferredTypes["cycleA"], DeepEquals, &InferredValueType{
+		Type:  "string",
+		Chain: []string{"values.cycleA", "values.cycleB", "values.cycleA"},
+	})
+}
+
+func (s *ValueTypeInferenceTestSuite) Test_infers_any_for_child_exports_without_lookup(c *C) {
+	blueprint, err := schema.LoadString(valueTypeInferenceTestBlueprint, schema.YAMLSpecFormat)
+	c.Assert(err, IsNil)
+
+	inferred := InferValueType("networkId", blueprint, nil)
+	c.Assert(inferred, DeepEquals, &InferredValueType{
+		Type:  "any",
+		Chain: []string{"values.networkId", "children.network.vpcId"},
+	})
+}
+
+func (s *ValueTypeInferenceTestSuite) Test_returns_nil_for_missing_value(c *C) {
+	blueprint, err := schema.LoadString(valueTypeInferenceTestBlueprint, schema.YAMLSpecFormat)
+	c.Assert(err, IsNil)
+
+	c.Assert(InferValueType("missing", blueprint, nil), IsNil)
+}
+
+func testNetworkChildExportLookup(
+	childName string,
+	exportName string,
+	location *source.Meta,
+) (*schema.Export, error) {
+	if childName == "network" && exportName == "vpcId" {
+		return &schema.Export{
+			Type: &schema.ExportTypeWrapper{Value: schema.ExportTypeString},
+		}, nil
+	}
+	return nil, nil
+}
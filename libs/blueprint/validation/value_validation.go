@@ -132,6 +132,7 @@ func validateValueContent(
 	if bpcore.IsStringWithSubsMappingNode(valSchema.Value) {
 		return validateValueContentForStringWithSubs(
 			ctx,
+			valName,
 			valIdentifier,
 			valSchema,
 			valCtx,
@@ -184,6 +185,7 @@ func validateValueContentType(
 
 func validateValueContentForStringWithSubs(
 	ctx context.Context,
+	valName string,
 	valIdentifier string,
 	valSchema *schema.Value,
 	valCtx *ValidationContext,
@@ -228,6 +230,20 @@ func validateValueContentForStringWithSubs(
 				errs = append(errs, err)
 			} else {
 				diagnostics = append(diagnostics, subDiagnostics...)
+				// Inference follows references to other values, child blueprint exports
+				// and data source fields to determine the type that the substitution
+				// will resolve to when the type of the referenced element is not
+				// enough to determine the type.
+				inferred := inferValueSubstitutionType(
+					valName,
+					stringOrSub.SubstitutionValue,
+					valCtx,
+				)
+				typeChain := []string{valIdentifier}
+				if inferred.Type != string(substitutions.ResolvedSubExprTypeAny) {
+					resolvedType = inferred.Type
+					typeChain = append(typeChain, inferred.Chain...)
+				}
 				if resolvedType != expectedResolveType &&
 					// Allow any type to account for functions like jsondecode() that can return any type.
 					// This means the user is responsible for ensuring the type of the value is correct.
@@ -236,6 +252,7 @@ func validateValueContentForStringWithSubs(
 						valIdentifier,
 						resolvedType,
 						expectedResolveType,
+						typeChain,
 						stringOrSub.SourceMeta,
 					))
 				}
@@ -669,3 +669,58 @@ func (s *ValueValidationTestSuite) Test_reports_error_when_nested_sub_reference_
 		"blueprint load error: validation failed due to the variable \"missingVariable\" not existing in the blueprint",
 	)
 }
+
+func (s *ValueValidationTestSuite) Test_reports_error_with_type_chain_for_value_referencing_string_child_export(c *C) {
+	blueprint, err := schema.LoadString(valueTypeInferenceTestBlueprint, schema.YAMLSpecFormat)
+	c.Assert(err, IsNil)
+
+	_, err = ValidateValue(
+		context.TODO(),
+		"vpcId",
+		blueprint.Values.Values["vpcId"],
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+			ChildExportLookup:  testNetworkChildExportLookup,
+		},
+	)
+	c.Assert(err, NotNil)
+	loadErr, isLoadErr := err.(*errors.LoadError)
+	c.Assert(isLoadErr, Equals, true)
+	c.Assert(loadErr.ReasonCode, Equals, ErrorReasonCodeMultipleValidationErrors)
+	childErr, isChildErr := loadErr.ChildErrors[0].(*errors.LoadError)
+	c.Assert(isChildErr, Equals, true)
+	c.Assert(childErr.ReasonCode, Equals, ErrorReasonCodeInvalidValue)
+	c.Assert(
+		childErr.Error(),
+		Equals,
+		"blueprint load error: validation failed due to an invalid substitution found in \"values.vpcId\", "+
+			"resolved type \"string\" is not supported by value of type \"integer\", "+
+			"the type was resolved through values.vpcId -> values.networkId -> children.network.vpcId (string)",
+	)
+}
+
+func (s *ValueValidationTestSuite) Test_passes_validation_for_value_referencing_field_of_value_with_integer_sub(c *C) {
+	blueprint, err := schema.LoadString(valueTypeInferenceTestBlueprint, schema.YAMLSpecFormat)
+	c.Assert(err, IsNil)
+
+	diagnostics, err := ValidateValue(
+		context.TODO(),
+		"instances",
+		blueprint.Values.Values["instances"],
+		&ValidationContext{
+			BpSchema:           blueprint,
+			Params:             &core.ParamsImpl{},
+			FuncRegistry:       s.funcRegistry,
+			RefChainCollector:  s.refChainCollector,
+			ResourceRegistry:   s.resourceRegistry,
+			DataSourceRegistry: s.dataSourceRegistry,
+		},
+	)
+	c.Assert(err, IsNil)
+	c.Assert(diagnostics, HasLen, 0)
+}
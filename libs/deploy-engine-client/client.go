@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
@@ -480,6 +481,34 @@ func (c *Client) GetChangeset(
 	return changeset, nil
 }
 
+// GetChangesetPlan retrieves the changes for a change set as a versioned,
+// machine-readable plan document that can be used for policy checks
+// and plan review tooling.
+// A plan is only available once the change staging process has completed successfully.
+// This is the `GET {baseURL}/v1/deployments/changes/{id}/plan` API endpoint.
+func (c *Client) GetChangesetPlan(
+	ctx context.Context,
+	changesetID string,
+) (*changes.Plan, error) {
+	url := fmt.Sprintf(
+		"%s/v1/deployments/changes/%s/plan",
+		c.endpoint,
+		changesetID,
+	)
+
+	plan := &changes.Plan{}
+	err := c.getResource(
+		ctx,
+		url,
+		plan,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
 // StreamChangeStagingEvents streams events from the change staging process
 // for the given change set ID.
 // This will produce a stream of events as they occur or that have recently occurred.
@@ -704,6 +733,37 @@ func (c *Client) ListBlueprintInstances(
 	return result, nil
 }
 
+// ListBlueprintInstanceSummaries retrieves a paginated list of status summaries
+// for blueprint instances.
+// Summaries contain resource status counts, drift counts and deployment timestamps
+// without the full instance state, making them suitable for dashboards.
+// The params allow filtering by name and paginating results.
+// This is the `GET {baseURL}/v1/deployments/instance-summaries` API endpoint.
+func (c *Client) ListBlueprintInstanceSummaries(
+	ctx context.Context,
+	params state.ListInstancesParams,
+) (state.ListInstanceStatusSummariesResult, error) {
+	url := fmt.Sprintf(
+		"%s/v1/deployments/instance-summaries",
+		c.endpoint,
+	)
+
+	queryParams := buildListInstancesQueryParams(params)
+
+	result := state.ListInstanceStatusSummariesResult{}
+	err := c.getResourceWithQueryParams(
+		ctx,
+		url,
+		queryParams,
+		&result,
+	)
+	if err != nil {
+		return state.ListInstanceStatusSummariesResult{}, err
+	}
+
+	return result, nil
+}
+
 func buildListInstancesQueryParams(params state.ListInstancesParams) map[string]string {
 	queryParams := map[string]string{}
 	if params.Search != "" {
@@ -748,6 +808,49 @@ func (c *Client) GetBlueprintInstanceExports(
 	return exports, nil
 }
 
+// GetResourceStateAt retrieves the state of a resource in a blueprint
+// instance as of a previous point in time along with the changes that
+// have been made to the resource spec since.
+// The point in time can be a unix timestamp in seconds or the ID of
+// a change set that has been deployed to the blueprint instance.
+// This is the `GET {baseURL}/v1/deployments/instances/{id}/resources/{name}/state` API endpoint.
+//
+// The instanceID parameter can be either the unique instance ID or
+// the user-defined instance name.
+func (c *Client) GetResourceStateAt(
+	ctx context.Context,
+	instanceID string,
+	resourceName string,
+	point *manage.RevisionPoint,
+) (*manage.ResourceStateAtRevision, error) {
+	url := fmt.Sprintf(
+		"%s/v1/deployments/instances/%s/resources/%s/state",
+		c.endpoint,
+		instanceID,
+		resourceName,
+	)
+
+	queryParams := map[string]string{}
+	if point.Revision != "" {
+		queryParams["revision"] = point.Revision
+	} else {
+		queryParams["at"] = strconv.FormatInt(point.Timestamp, 10)
+	}
+
+	resourceStateAt := &manage.ResourceStateAtRevision{}
+	err := c.getResourceWithQueryParams(
+		ctx,
+		url,
+		queryParams,
+		resourceStateAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resourceStateAt, nil
+}
+
 // DestroyBlueprintInstance destroys a blueprint deployment instance.
 // This will start the destroy process for the provided change set.
 // It will return a response containing the current state of the blueprint instance
@@ -963,6 +1066,203 @@ func (c *Client) ApplyReconciliation(
 	return result, nil
 }
 
+// ListImportCandidates lists the resources in a blueprint that are not tracked
+// in the state of a blueprint instance along with existing resources in providers
+// that each unmatched resource can be imported from.
+// This is a synchronous operation that does not modify any state.
+//
+// The instanceID parameter can be either the unique instance ID or
+// the user-defined instance name, when there is no matching instance,
+// it is treated as the name of a new instance and all resources in the blueprint
+// are unmatched.
+//
+// This is the `POST {baseURL}/v1/deployments/instances/{id}/import/candidates` API endpoint.
+func (c *Client) ListImportCandidates(
+	ctx context.Context,
+	instanceID string,
+	payload *types.ListImportCandidatesPayload,
+) (*container.ImportCandidatesResult, error) {
+	url := fmt.Sprintf(
+		"%s/v1/deployments/instances/%s/import/candidates",
+		c.endpoint,
+		instanceID,
+	)
+
+	result := &container.ImportCandidatesResult{}
+	err := c.postAndGetResource(
+		ctx,
+		url,
+		payload,
+		result,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ImportResources adopts existing resources in providers into the state of
+// a blueprint instance using provider-specific import IDs.
+// This is a synchronous operation that returns the resources that were imported
+// and the resources that could not be imported.
+//
+// The instanceID parameter can be either the unique instance ID or
+// the user-defined instance name, when there is no matching instance,
+// a new instance is created with the provided value as its name.
+//
+// This is the `POST {baseURL}/v1/deployments/instances/{id}/import` API endpoint.
+func (c *Client) ImportResources(
+	ctx context.Context,
+	instanceID string,
+	payload *types.ImportResourcesPayload,
+) (*container.ImportResourcesResult, error) {
+	url := fmt.Sprintf(
+		"%s/v1/deployments/instances/%s/import",
+		c.endpoint,
+		instanceID,
+	)
+
+	result := &container.ImportResourcesResult{}
+	err := c.postAndGetResource(
+		ctx,
+		url,
+		payload,
+		result,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ExportGraph renders the dependency graph of resources, child blueprints
+// and links in a blueprint in DOT, Mermaid or JSON format.
+// The graph is resolved in the same way as when staging changes,
+// so it reflects the order in which the elements of the blueprint
+// would be deployed.
+// This is a synchronous operation that does not modify any state.
+//
+// This is the `POST {baseURL}/v1/deployments/graph` API endpoint.
+func (c *Client) ExportGraph(
+	ctx context.Context,
+	payload *types.ExportGraphPayload,
+) (*types.ExportGraphResponse, error) {
+	url := fmt.Sprintf(
+		"%s/v1/deployments/graph",
+		c.endpoint,
+	)
+
+	response := &types.ExportGraphResponse{}
+	err := c.postAndGetResource(
+		ctx,
+		url,
+		payload,
+		response,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// RenderBlueprint produces a fully resolved version of a blueprint
+// with resource templates expanded, resource spec defaults populated,
+// conditions applied and substitutions resolved where possible.
+// Values that can only be known after deployment are marked with
+// a placeholder and the paths to them are listed in the rendered blueprint.
+// When tracing is enabled in the payload, the steps carried out to resolve
+// each field are included in the rendered blueprint.
+// This is a synchronous operation that does not modify any state.
+//
+// This is the `POST {baseURL}/v1/deployments/render` API endpoint.
+func (c *Client) RenderBlueprint(
+	ctx context.Context,
+	payload *types.RenderBlueprintPayload,
+) (*container.RenderedBlueprint, error) {
+	url := fmt.Sprintf(
+		"%s/v1/deployments/render",
+		c.endpoint,
+	)
+
+	response := &container.RenderedBlueprint{}
+	err := c.postAndGetResource(
+		ctx,
+		url,
+		payload,
+		response,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// EvaluateExpression evaluates a substitution expression against a blueprint,
+// using the registered functions and resources of the deploy engine.
+// When an instance ID or name is provided in the payload, computed fields of
+// resources are resolved from the state of the blueprint instance, otherwise
+// values that can only be known after deployment are marked with a placeholder.
+// Values that depend on secret variables or values are redacted.
+// This is a synchronous operation that does not modify any state.
+//
+// This is the `POST {baseURL}/v1/deployments/evaluate` API endpoint.
+func (c *Client) EvaluateExpression(
+	ctx context.Context,
+	payload *types.EvaluateExpressionPayload,
+) (*container.EvaluatedExpression, error) {
+	url := fmt.Sprintf(
+		"%s/v1/deployments/evaluate",
+		c.endpoint,
+	)
+
+	response := &container.EvaluatedExpression{}
+	err := c.postAndGetResource(
+		ctx,
+		url,
+		payload,
+		response,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetResourceTypeDocs retrieves the description and examples for a resource type
+// from the provider or transformer plugin loaded by the deploy engine
+// that the resource type belongs to.
+//
+// This is the `GET {baseURL}/v1/plugins/resource-types/docs` API endpoint.
+func (c *Client) GetResourceTypeDocs(
+	ctx context.Context,
+	resourceType string,
+) (*types.ResourceTypeDocsResponse, error) {
+	url := fmt.Sprintf(
+		"%s/v1/plugins/resource-types/docs",
+		c.endpoint,
+	)
+
+	response := &types.ResourceTypeDocsResponse{}
+	err := c.getResourceWithQueryParams(
+		ctx,
+		url,
+		map[string]string{
+			"type": resourceType,
+		},
+		response,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 // CleanupReconciliationResults triggers cleanup of old reconciliation results.
 // This is an asynchronous operation that returns immediately after triggering the cleanup.
 // Reconciliation results older than the configured retention period will be removed.
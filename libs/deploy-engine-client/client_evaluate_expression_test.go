@@ -0,0 +1,50 @@
+// Tests for the EvaluateExpression method in the DeployEngine client.
+package deployengine
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/errors"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+)
+
+func (s *ClientSuite) Test_evaluate_expression() {
+	client := s.createOAuth2ImportTestClient()
+
+	result, err := client.EvaluateExpression(
+		context.Background(),
+		&types.EvaluateExpressionPayload{
+			BlueprintDocumentInfo: types.BlueprintDocumentInfo{
+				FileSourceScheme: "file",
+				BlueprintFile:    "/path/to/blueprint.yaml",
+			},
+			Expression: "resources.ordersTable.spec.tableName",
+		},
+	)
+	s.Require().NoError(err)
+
+	s.Assert().Equal("orders", core.StringValue(result.Value))
+	s.Assert().False(result.KnownOnDeploy)
+}
+
+func (s *ClientSuite) Test_evaluate_expression_fails_for_unauthorised_client() {
+	// Create a new client with invalid API key auth.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodAPIKey),
+		WithClientAPIKey("invalid-api-key"),
+	)
+	s.Require().NoError(err)
+
+	_, err = client.EvaluateExpression(
+		context.Background(),
+		&types.EvaluateExpressionPayload{},
+	)
+	s.Require().Error(err)
+
+	clientErr, isClientErr := err.(*errors.ClientError)
+	s.Require().True(isClientErr)
+	s.Assert().Equal(http.StatusUnauthorized, clientErr.StatusCode)
+}
@@ -0,0 +1,49 @@
+// Tests for the ExportGraph method in the DeployEngine client.
+package deployengine
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/errors"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+)
+
+func (s *ClientSuite) Test_export_graph() {
+	client := s.createOAuth2ImportTestClient()
+
+	result, err := client.ExportGraph(
+		context.Background(),
+		&types.ExportGraphPayload{
+			BlueprintDocumentInfo: types.BlueprintDocumentInfo{
+				FileSourceScheme: "file",
+				BlueprintFile:    "/path/to/blueprint.yaml",
+			},
+			Format: "dot",
+		},
+	)
+	s.Require().NoError(err)
+
+	s.Assert().Equal("dot", result.Format)
+	s.Assert().Equal("digraph blueprint {\n  rankdir=LR;\n}\n", result.Graph)
+}
+
+func (s *ClientSuite) Test_export_graph_fails_for_unauthorised_client() {
+	// Create a new client with invalid API key auth.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodAPIKey),
+		WithClientAPIKey("invalid-api-key"),
+	)
+	s.Require().NoError(err)
+
+	_, err = client.ExportGraph(
+		context.Background(),
+		&types.ExportGraphPayload{},
+	)
+	s.Require().Error(err)
+
+	clientErr, isClientErr := err.(*errors.ClientError)
+	s.Require().True(isClientErr)
+	s.Assert().Equal(http.StatusUnauthorized, clientErr.StatusCode)
+}
@@ -0,0 +1,104 @@
+// Tests for the GetChangesetPlan method in the DeployEngine client.
+package deployengine
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/errors"
+)
+
+func (s *ClientSuite) Test_get_changeset_plan() {
+	// Create a new client with OAuth2.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodOAuth2),
+		WithClientOAuth2Config(&OAuth2Config{
+			TokenEndpoint: fmt.Sprintf(
+				"%s/oauth2/v1/token",
+				s.oauthServer.URL,
+			),
+			ClientID:     testClientID,
+			ClientSecret: testClientSecret,
+		}),
+	)
+	s.Require().NoError(err)
+
+	plan, err := client.GetChangesetPlan(
+		context.Background(),
+		testChangesetID,
+	)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(
+		&changes.Plan{
+			FormatVersion: changes.PlanFormatVersion,
+			Summary: changes.ChangesSummary{
+				Update: 1,
+				Delete: 2,
+				Retain: 1,
+			},
+			Resources: []*changes.PlanResourceChange{
+				{
+					ResourceName: "resource-1",
+					Action:       changes.PlanActionUpdate,
+					Fields: []*changes.PlanFieldChange{
+						{
+							Path:   "spec.name",
+							Action: changes.PlanActionCreate,
+							Before: core.MappingNodeFromString("old-name"),
+							After:  core.MappingNodeFromString("new-name"),
+						},
+					},
+				},
+				{
+					ResourceName: "resource-2",
+					Action:       changes.PlanActionDelete,
+				},
+				{
+					ResourceName: "resource-3",
+					Action:       changes.PlanActionDelete,
+				},
+				{
+					ResourceName: "resource-4",
+					Action:       changes.PlanActionRetain,
+				},
+			},
+			Links:    []*changes.PlanLinkChange{},
+			Children: []*changes.PlanChildChange{},
+			Exports:  []*changes.PlanExportChange{},
+		},
+		plan,
+	)
+}
+
+func (s *ClientSuite) Test_get_changeset_plan_fails_for_unauthorised_client() {
+	// Create a new client with invalid API key auth.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodAPIKey),
+		WithClientAPIKey("invalid-api-key"),
+	)
+	s.Require().NoError(err)
+
+	_, err = client.GetChangesetPlan(
+		context.Background(),
+		testChangesetID,
+	)
+	s.Require().Error(err)
+
+	clientErr, isClientErr := err.(*errors.ClientError)
+	s.Require().True(isClientErr)
+
+	s.Assert().Equal(
+		http.StatusUnauthorized,
+		clientErr.StatusCode,
+	)
+	s.Assert().Equal(
+		"Unauthorized",
+		clientErr.Message,
+	)
+}
@@ -0,0 +1,221 @@
+// Tests for the GetResourceStateAt method in the DeployEngine client.
+package deployengine
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint-state/manage"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/errors"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/internal/testutils"
+)
+
+func (s *ClientSuite) Test_get_resource_state_at_revision() {
+	// Create a new client with OAuth2.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodOAuth2),
+		WithClientOAuth2Config(&OAuth2Config{
+			TokenEndpoint: fmt.Sprintf(
+				"%s/oauth2/v1/token",
+				s.oauthServer.URL,
+			),
+			ClientID:     testClientID,
+			ClientSecret: testClientSecret,
+		}),
+	)
+	s.Require().NoError(err)
+
+	resourceStateAt, err := client.GetResourceStateAt(
+		context.Background(),
+		testInstanceID,
+		"ordersTable",
+		&manage.RevisionPoint{Revision: "test-revision-id"},
+	)
+	s.Require().NoError(err)
+
+	s.Assert().Equal("ordersTable", resourceStateAt.ResourceName)
+	s.Assert().Equal("test-revision-id", resourceStateAt.Revision)
+	s.Assert().True(resourceStateAt.Existed)
+	s.Assert().Equal(
+		"previousValue",
+		core.StringValue(resourceStateAt.State.SpecData.Fields["name"]),
+	)
+	s.Require().Len(resourceStateAt.ModifiedFields, 1)
+	s.Assert().Equal("spec.name", resourceStateAt.ModifiedFields[0].FieldPath)
+}
+
+func (s *ClientSuite) Test_get_resource_state_at_timestamp() {
+	// Create a new client with OAuth2.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodOAuth2),
+		WithClientOAuth2Config(&OAuth2Config{
+			TokenEndpoint: fmt.Sprintf(
+				"%s/oauth2/v1/token",
+				s.oauthServer.URL,
+			),
+			ClientID:     testClientID,
+			ClientSecret: testClientSecret,
+		}),
+	)
+	s.Require().NoError(err)
+
+	resourceStateAt, err := client.GetResourceStateAt(
+		context.Background(),
+		testInstanceID,
+		"ordersTable",
+		&manage.RevisionPoint{Timestamp: 1746534000},
+	)
+	s.Require().NoError(err)
+
+	s.Assert().Equal("revision-at-1746534000", resourceStateAt.Revision)
+}
+
+func (s *ClientSuite) Test_get_resource_state_at_fails_for_unauthorised_client() {
+	// Create a new client with invalid API key auth.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodAPIKey),
+		WithClientAPIKey("invalid-api-key"),
+	)
+	s.Require().NoError(err)
+
+	_, err = client.GetResourceStateAt(
+		context.Background(),
+		testInstanceID,
+		"ordersTable",
+		&manage.RevisionPoint{Revision: "test-revision-id"},
+	)
+	s.Require().Error(err)
+
+	clientErr, isClientErr := err.(*errors.ClientError)
+	s.Require().True(isClientErr)
+
+	s.Assert().Equal(
+		http.StatusUnauthorized,
+		clientErr.StatusCode,
+	)
+	s.Assert().Equal(
+		"Unauthorized",
+		clientErr.Message,
+	)
+}
+
+func (s *ClientSuite) Test_get_resource_state_at_fails_due_to_invalid_json_response() {
+	// Create a new client with OAuth2.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodOAuth2),
+		WithClientOAuth2Config(&OAuth2Config{
+			TokenEndpoint: fmt.Sprintf(
+				"%s/oauth2/v1/token",
+				s.oauthServer.URL,
+			),
+			ClientID:     testClientID,
+			ClientSecret: testClientSecret,
+		}),
+		// Override the default HTTP transport to opt out of retry behaviour.
+		WithClientHTTPRoundTripper(testutils.CreateDefaultTransport),
+	)
+	s.Require().NoError(err)
+
+	_, err = client.GetResourceStateAt(
+		context.Background(),
+		deserialiseErrorTriggerID,
+		"ordersTable",
+		&manage.RevisionPoint{Revision: "test-revision-id"},
+	)
+	s.Require().Error(err)
+
+	deserialiseErr, isDeserialiseErr := err.(*errors.DeserialiseError)
+	s.Require().True(isDeserialiseErr)
+
+	s.Assert().Equal(
+		"deserialise error: failed to decode response: unexpected EOF",
+		deserialiseErr.Error(),
+	)
+}
+
+func (s *ClientSuite) Test_get_resource_state_at_fails_due_to_internal_server_error() {
+	// Create a new client with OAuth2.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodOAuth2),
+		WithClientOAuth2Config(&OAuth2Config{
+			TokenEndpoint: fmt.Sprintf(
+				"%s/oauth2/v1/token",
+				s.oauthServer.URL,
+			),
+			ClientID:     testClientID,
+			ClientSecret: testClientSecret,
+		}),
+		// Override the default HTTP transport to opt out of retry behaviour.
+		WithClientHTTPRoundTripper(testutils.CreateDefaultTransport),
+	)
+	s.Require().NoError(err)
+
+	_, err = client.GetResourceStateAt(
+		context.Background(),
+		internalServerErrorTriggerID,
+		"ordersTable",
+		&manage.RevisionPoint{Revision: "test-revision-id"},
+	)
+	s.Require().Error(err)
+
+	clientErr, isClientErr := err.(*errors.ClientError)
+	s.Require().True(isClientErr)
+
+	s.Assert().Equal(
+		http.StatusInternalServerError,
+		clientErr.StatusCode,
+	)
+	s.Assert().Equal(
+		"an unexpected error occurred",
+		clientErr.Message,
+	)
+}
+
+func (s *ClientSuite) Test_get_resource_state_at_fails_due_to_network_error() {
+	// Create a new client with OAuth2.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodOAuth2),
+		WithClientOAuth2Config(&OAuth2Config{
+			TokenEndpoint: fmt.Sprintf(
+				"%s/oauth2/v1/token",
+				s.oauthServer.URL,
+			),
+			ClientID:     testClientID,
+			ClientSecret: testClientSecret,
+		}),
+		// Override the default HTTP transport to opt out of retry behaviour.
+		WithClientHTTPRoundTripper(testutils.CreateDefaultTransport),
+	)
+	s.Require().NoError(err)
+
+	_, err = client.GetResourceStateAt(
+		context.Background(),
+		networkErrorTriggerID,
+		"ordersTable",
+		&manage.RevisionPoint{Revision: "test-revision-id"},
+	)
+	s.Require().Error(err)
+
+	clientErr, isClientErr := err.(*errors.RequestError)
+	s.Require().True(isClientErr)
+
+	expectedErrorMessage := fmt.Sprintf(
+		"request error: Get \"%s%s%s%s\": EOF",
+		s.deployEngineServer.URL,
+		"/v1/deployments/instances/",
+		networkErrorTriggerID,
+		"/resources/ordersTable/state?revision=test-revision-id",
+	)
+	s.Assert().Equal(
+		expectedErrorMessage,
+		clientErr.Error(),
+	)
+}
@@ -0,0 +1,96 @@
+// Tests for the GetResourceTypeDocs method in the DeployEngine client.
+package deployengine
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/errors"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/internal/testutils"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+)
+
+func (s *ClientSuite) Test_get_resource_type_docs() {
+	client := s.createOAuth2ImportTestClient()
+
+	result, err := client.GetResourceTypeDocs(
+		context.Background(),
+		"aws/dynamodb/table",
+	)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(
+		&types.ResourceTypeDocsResponse{
+			Type:                 "aws/dynamodb/table",
+			PlainTextSummary:     "A DynamoDB table.",
+			MarkdownSummary:      "A **DynamoDB** table.",
+			PlainTextDescription: "A DynamoDB table that stores items.",
+			MarkdownDescription:  "A **DynamoDB** table that stores items.",
+			PlainTextExamples: []string{
+				"resources:\n  ordersTable:\n    type: aws/dynamodb/table",
+			},
+			MarkdownExamples: []string{
+				"```yaml\nresources:\n  ordersTable:\n    type: aws/dynamodb/table\n```",
+			},
+		},
+		result,
+	)
+}
+
+func (s *ClientSuite) Test_get_resource_type_docs_fails_due_to_internal_server_error() {
+	// Create a new client with OAuth2.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodOAuth2),
+		WithClientOAuth2Config(&OAuth2Config{
+			TokenEndpoint: fmt.Sprintf(
+				"%s/oauth2/v1/token",
+				s.oauthServer.URL,
+			),
+			ClientID:     testClientID,
+			ClientSecret: testClientSecret,
+		}),
+		// Override the default HTTP transport to opt out of retry behaviour.
+		WithClientHTTPRoundTripper(testutils.CreateDefaultTransport),
+	)
+	s.Require().NoError(err)
+
+	_, err = client.GetResourceTypeDocs(
+		context.Background(),
+		internalServerErrorTriggerID,
+	)
+	s.Require().Error(err)
+
+	clientErr, isClientErr := err.(*errors.ClientError)
+	s.Require().True(isClientErr)
+
+	s.Assert().Equal(
+		http.StatusInternalServerError,
+		clientErr.StatusCode,
+	)
+	s.Assert().Equal(
+		"an unexpected error occurred",
+		clientErr.Message,
+	)
+}
+
+func (s *ClientSuite) Test_get_resource_type_docs_fails_for_unauthorised_client() {
+	// Create a new client with invalid API key auth.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodAPIKey),
+		WithClientAPIKey("invalid-api-key"),
+	)
+	s.Require().NoError(err)
+
+	_, err = client.GetResourceTypeDocs(
+		context.Background(),
+		"aws/dynamodb/table",
+	)
+	s.Require().Error(err)
+
+	clientErr, isClientErr := err.(*errors.ClientError)
+	s.Require().True(isClientErr)
+	s.Assert().Equal(http.StatusUnauthorized, clientErr.StatusCode)
+}
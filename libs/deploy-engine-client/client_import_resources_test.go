@@ -0,0 +1,101 @@
+// Tests for the ListImportCandidates and ImportResources methods in the DeployEngine client.
+package deployengine
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/errors"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+)
+
+func (s *ClientSuite) Test_list_import_candidates() {
+	client := s.createOAuth2ImportTestClient()
+
+	result, err := client.ListImportCandidates(
+		context.Background(),
+		"test-instance-100",
+		&types.ListImportCandidatesPayload{
+			BlueprintDocumentInfo: types.BlueprintDocumentInfo{
+				FileSourceScheme: "file",
+				BlueprintFile:    "/path/to/blueprint.yaml",
+			},
+			MaxCandidates: 10,
+		},
+	)
+	s.Require().NoError(err)
+
+	s.Assert().Equal("test-instance-100", result.InstanceID)
+	s.Require().Len(result.Resources, 1)
+	s.Assert().Equal("resource-1", result.Resources[0].ResourceName)
+	s.Assert().True(result.Resources[0].ListingSupported)
+	s.Require().Len(result.Resources[0].Candidates, 1)
+	s.Assert().Equal("external-resource-1", result.Resources[0].Candidates[0].ImportID)
+}
+
+func (s *ClientSuite) Test_import_resources() {
+	client := s.createOAuth2ImportTestClient()
+
+	result, err := client.ImportResources(
+		context.Background(),
+		"test-instance-100",
+		&types.ImportResourcesPayload{
+			BlueprintDocumentInfo: types.BlueprintDocumentInfo{
+				FileSourceScheme: "file",
+				BlueprintFile:    "/path/to/blueprint.yaml",
+			},
+			Mappings: []*types.ImportResourceMappingPayload{
+				{
+					ResourceName: "resource-1",
+					ImportID:     "external-resource-1",
+				},
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.Assert().Equal("test-instance-100", result.InstanceID)
+	s.Require().Len(result.Imported, 1)
+	s.Assert().Equal("resource-1-id", result.Imported[0].ResourceID)
+	s.Assert().Equal("external-resource-1", result.Imported[0].ImportID)
+	s.Assert().Empty(result.Errors)
+}
+
+func (s *ClientSuite) Test_import_resources_fails_for_unauthorised_client() {
+	// Create a new client with invalid API key auth.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodAPIKey),
+		WithClientAPIKey("invalid-api-key"),
+	)
+	s.Require().NoError(err)
+
+	_, err = client.ImportResources(
+		context.Background(),
+		"test-instance-100",
+		&types.ImportResourcesPayload{},
+	)
+	s.Require().Error(err)
+
+	clientErr, isClientErr := err.(*errors.ClientError)
+	s.Require().True(isClientErr)
+	s.Assert().Equal(http.StatusUnauthorized, clientErr.StatusCode)
+}
+
+func (s *ClientSuite) createOAuth2ImportTestClient() *Client {
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodOAuth2),
+		WithClientOAuth2Config(&OAuth2Config{
+			TokenEndpoint: fmt.Sprintf(
+				"%s/oauth2/v1/token",
+				s.oauthServer.URL,
+			),
+			ClientID:     testClientID,
+			ClientSecret: testClientSecret,
+		}),
+	)
+	s.Require().NoError(err)
+	return client
+}
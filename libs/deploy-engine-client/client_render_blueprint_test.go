@@ -0,0 +1,60 @@
+// Tests for the RenderBlueprint method in the DeployEngine client.
+package deployengine
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/errors"
+	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
+)
+
+func (s *ClientSuite) Test_render_blueprint() {
+	client := s.createOAuth2ImportTestClient()
+
+	result, err := client.RenderBlueprint(
+		context.Background(),
+		&types.RenderBlueprintPayload{
+			BlueprintDocumentInfo: types.BlueprintDocumentInfo{
+				FileSourceScheme: "file",
+				BlueprintFile:    "/path/to/blueprint.yaml",
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.Assert().Equal("2025-11-02", result.Version)
+	s.Require().Contains(result.Resources, "ordersTable")
+	spec := result.Resources["ordersTable"].Spec
+	s.Assert().Equal("orders", core.StringValue(spec.Fields["tableName"]))
+	s.Assert().Equal(
+		container.KnownOnDeployPlaceholder,
+		core.StringValue(spec.Fields["arn"]),
+	)
+	s.Assert().Equal(
+		[]string{"resources.ordersTable.spec.arn"},
+		result.ResolveOnDeploy,
+	)
+}
+
+func (s *ClientSuite) Test_render_blueprint_fails_for_unauthorised_client() {
+	// Create a new client with invalid API key auth.
+	client, err := NewClient(
+		WithClientEndpoint(s.deployEngineServer.URL),
+		WithClientAuthMethod(AuthMethodAPIKey),
+		WithClientAPIKey("invalid-api-key"),
+	)
+	s.Require().NoError(err)
+
+	_, err = client.RenderBlueprint(
+		context.Background(),
+		&types.RenderBlueprintPayload{},
+	)
+	s.Require().Error(err)
+
+	clientErr, isClientErr := err.(*errors.ClientError)
+	s.Require().True(isClientErr)
+	s.Assert().Equal(http.StatusUnauthorized, clientErr.StatusCode)
+}
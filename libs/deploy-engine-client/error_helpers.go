@@ -81,5 +81,6 @@ func createClientError(resp *http.Response) *errors.ClientError {
 		Code:                  errResp.Code,
 		ValidationErrors:      errResp.Errors,
 		ValidationDiagnostics: errResp.Diagnostics,
+		ValidationErrorGroups: errResp.ErrorGroups,
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
 	"github.com/newstack-cloud/bluelink/libs/deploy-engine-client/types"
 )
 
@@ -89,6 +90,12 @@ type ClientError struct {
 	// document.
 	// This will usually be populated for 422 responses.
 	ValidationDiagnostics []*core.Diagnostic
+	// An optional list of error groups that holds the errors that the validation
+	// diagnostics were derived from, grouped by blueprint element and reason code
+	// with duplicate messages removed.
+	// This can be used to present a large number of validation errors in a more
+	// digestible form, see bperrors.FormatErrorGroups.
+	ValidationErrorGroups []*bperrors.ErrorGroup
 	// DriftBlockedResponse is populated for 409 responses when an operation
 	// is blocked due to drift detection. Contains the reconciliation result
 	// and changeset ID for continuing after reconciliation.
@@ -106,10 +113,11 @@ type ValidationError struct {
 // Response is a struct that represents a JSON error response
 // from the Deploy Engine API.
 type Response struct {
-	Message     string             `json:"message"`
-	Code        string             `json:"code,omitempty"`
-	Errors      []*ValidationError `json:"errors,omitempty"`
-	Diagnostics []*core.Diagnostic `json:"validationDiagnostics,omitempty"`
+	Message     string                 `json:"message"`
+	Code        string                 `json:"code,omitempty"`
+	Errors      []*ValidationError     `json:"errors,omitempty"`
+	Diagnostics []*core.Diagnostic     `json:"validationDiagnostics,omitempty"`
+	ErrorGroups []*bperrors.ErrorGroup `json:"validationErrorGroups,omitempty"`
 }
 
 func (e *ClientError) Error() string {
@@ -211,3 +219,20 @@ func IsDeployChangesetError(err error) (*ClientError, bool) {
 	}
 	return nil, false
 }
+
+// ErrorCodeBlastRadiusExceeded is the error code returned when a deployment
+// is blocked because the change set exceeds the blast radius limits
+// (max changes or max deletes) provided in the request.
+const ErrorCodeBlastRadiusExceeded = "BLAST_RADIUS_EXCEEDED"
+
+// IsBlastRadiusExceededError checks if the error is a client error
+// indicating that a deployment was blocked because the change set
+// exceeds the blast radius limits provided in the request.
+// The deployment can be retried with the blast radius override set
+// to explicitly allow the changes.
+func IsBlastRadiusExceededError(err error) (*ClientError, bool) {
+	if clientErr, ok := err.(*ClientError); ok {
+		return clientErr, clientErr.Code == ErrorCodeBlastRadiusExceeded
+	}
+	return nil, false
+}
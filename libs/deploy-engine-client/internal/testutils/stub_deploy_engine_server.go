@@ -27,6 +27,7 @@ import (
 
 const (
 	testFailingStreamEventID = "test-failing-stream-event-id"
+	stubDOTGraph             = "digraph blueprint {\n  rankdir=LR;\n}\n"
 )
 
 type TestServerConfig struct {
@@ -102,6 +103,11 @@ func CreateDeployEngineServer(
 		ctrl.getChangesetHandler,
 	).Methods("GET")
 
+	router.HandleFunc(
+		"/v1/deployments/changes/{id}/plan",
+		ctrl.getChangesetPlanHandler,
+	).Methods("GET")
+
 	router.HandleFunc(
 		"/v1/deployments/changes/{id}/stream",
 		ctrl.streamChangeStagingEventsHandler,
@@ -137,6 +143,11 @@ func CreateDeployEngineServer(
 		ctrl.getBlueprintInstanceExportsHandler,
 	).Methods("GET")
 
+	router.HandleFunc(
+		"/v1/deployments/instances/{id}/resources/{name}/state",
+		ctrl.getResourceStateAtHandler,
+	).Methods("GET")
+
 	router.HandleFunc(
 		"/v1/deployments/instances/{id}/destroy",
 		ctrl.destroyBlueprintInstanceHandler,
@@ -167,6 +178,36 @@ func CreateDeployEngineServer(
 		ctrl.applyReconciliationHandler,
 	).Methods("POST")
 
+	router.HandleFunc(
+		"/v1/deployments/instances/{id}/import/candidates",
+		ctrl.listImportCandidatesHandler,
+	).Methods("POST")
+
+	router.HandleFunc(
+		"/v1/deployments/instances/{id}/import",
+		ctrl.importResourcesHandler,
+	).Methods("POST")
+
+	router.HandleFunc(
+		"/v1/deployments/graph",
+		ctrl.exportGraphHandler,
+	).Methods("POST")
+
+	router.HandleFunc(
+		"/v1/deployments/render",
+		ctrl.renderBlueprintHandler,
+	).Methods("POST")
+
+	router.HandleFunc(
+		"/v1/deployments/evaluate",
+		ctrl.evaluateExpressionHandler,
+	).Methods("POST")
+
+	router.HandleFunc(
+		"/v1/plugins/resource-types/docs",
+		ctrl.getResourceTypeDocsHandler,
+	).Methods("GET")
+
 	router.HandleFunc(
 		"/v1/deployments/reconciliation-results/cleanup",
 		ctrl.cleanupReconciliationResultsHandler,
@@ -368,6 +409,26 @@ func (c *stubDeployEngineController) getChangesetHandler(
 	w.Write(respBytes)
 }
 
+func (c *stubDeployEngineController) getChangesetPlanHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	// For GET requests, the error trigger will be in
+	// the id path parameter.
+	vars := mux.Vars(r)
+	id := vars["id"]
+	exitEarly := c.handleIDErrorTriggers(w, id, http.StatusOK)
+	if exitEarly {
+		return
+	}
+
+	respBytes, _ := json.Marshal(changes.BuildPlan(stubChanges))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(respBytes)
+}
+
 func (c *stubDeployEngineController) streamChangeStagingEventsHandler(
 	w http.ResponseWriter,
 	r *http.Request,
@@ -521,6 +582,67 @@ func (c *stubDeployEngineController) getBlueprintInstanceExportsHandler(
 	w.Write(respBytes)
 }
 
+func (c *stubDeployEngineController) getResourceStateAtHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	// For GET requests, the error trigger will be in
+	// the id path parameter.
+	vars := mux.Vars(r)
+	id := vars["id"]
+	exitEarly := c.handleIDErrorTriggers(w, id, http.StatusOK)
+	if exitEarly {
+		return
+	}
+
+	// Echo the revision from the query so the client can be checked
+	// for passing the point in time through to the server.
+	revision := r.URL.Query().Get("revision")
+	if revision == "" {
+		revision = fmt.Sprintf("revision-at-%s", r.URL.Query().Get("at"))
+	}
+
+	resourceStateAt := &manage.ResourceStateAtRevision{
+		ResourceName: vars["name"],
+		At:           c.clock.Now().Unix(),
+		Revision:     revision,
+		Existed:      true,
+		State: &state.ResourceState{
+			ResourceID: "test-resource-id",
+			Name:       vars["name"],
+			SpecData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"name": core.MappingNodeFromString("previousValue"),
+				},
+			},
+		},
+		Current: &state.ResourceState{
+			ResourceID: "test-resource-id",
+			Name:       vars["name"],
+			SpecData: &core.MappingNode{
+				Fields: map[string]*core.MappingNode{
+					"name": core.MappingNodeFromString("currentValue"),
+				},
+			},
+		},
+		ModifiedFields: []provider.FieldChange{
+			{
+				FieldPath: "spec.name",
+				PrevValue: core.MappingNodeFromString("previousValue"),
+				NewValue:  core.MappingNodeFromString("currentValue"),
+			},
+		},
+		NewFields:     []provider.FieldChange{},
+		RemovedFields: []string{},
+	}
+
+	respBytes, _ := json.Marshal(resourceStateAt)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(respBytes)
+}
+
 func (c *stubDeployEngineController) destroyBlueprintInstanceHandler(
 	w http.ResponseWriter,
 	r *http.Request,
@@ -717,6 +839,144 @@ func (c *stubDeployEngineController) applyReconciliationHandler(
 	w.Write(respBytes)
 }
 
+func (c *stubDeployEngineController) listImportCandidatesHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	// For POST requests, the error trigger will be in
+	// the id path parameter.
+	vars := mux.Vars(r)
+	id := vars["id"]
+	exitEarly := c.handleIDErrorTriggers(w, id, http.StatusOK)
+	if exitEarly {
+		return
+	}
+
+	result := stubImportCandidatesResult(id)
+	respBytes, _ := json.Marshal(result)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(respBytes)
+}
+
+func (c *stubDeployEngineController) importResourcesHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	// For POST requests, the error trigger will be in
+	// the id path parameter.
+	vars := mux.Vars(r)
+	id := vars["id"]
+	exitEarly := c.handleIDErrorTriggers(w, id, http.StatusOK)
+	if exitEarly {
+		return
+	}
+
+	result := stubImportResourcesResult(id)
+	respBytes, _ := json.Marshal(result)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(respBytes)
+}
+
+func (c *stubDeployEngineController) exportGraphHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	payload := map[string]any{}
+	if decodeRequestBody(w, r, &payload) {
+		return
+	}
+
+	respBytes, _ := json.Marshal(map[string]any{
+		"format": payload["format"],
+		"graph":  stubDOTGraph,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(respBytes)
+}
+
+func (c *stubDeployEngineController) renderBlueprintHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	payload := map[string]any{}
+	if decodeRequestBody(w, r, &payload) {
+		return
+	}
+
+	respBytes, _ := json.Marshal(map[string]any{
+		"version": "2025-11-02",
+		"resources": map[string]any{
+			"ordersTable": map[string]any{
+				"type": "aws/dynamodb/table",
+				"spec": map[string]any{
+					"tableName": "orders",
+					"arn":       "(known on deploy)",
+				},
+			},
+		},
+		"resolveOnDeploy": []string{"resources.ordersTable.spec.arn"},
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(respBytes)
+}
+
+func (c *stubDeployEngineController) evaluateExpressionHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	payload := map[string]any{}
+	if decodeRequestBody(w, r, &payload) {
+		return
+	}
+
+	respBytes, _ := json.Marshal(map[string]any{
+		"value":         "orders",
+		"knownOnDeploy": false,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(respBytes)
+}
+
+func (c *stubDeployEngineController) getResourceTypeDocsHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	// The error trigger will be in the type query parameter.
+	resourceType := r.URL.Query().Get("type")
+	exitEarly := c.handleIDErrorTriggers(w, resourceType, http.StatusOK)
+	if exitEarly {
+		return
+	}
+
+	respBytes, _ := json.Marshal(map[string]any{
+		"type":                 resourceType,
+		"plainTextSummary":     "A DynamoDB table.",
+		"markdownSummary":      "A **DynamoDB** table.",
+		"plainTextDescription": "A DynamoDB table that stores items.",
+		"markdownDescription":  "A **DynamoDB** table that stores items.",
+		"plainTextExamples": []string{
+			"resources:\n  ordersTable:\n    type: " + resourceType,
+		},
+		"markdownExamples": []string{
+			"```yaml\nresources:\n  ordersTable:\n    type: " + resourceType + "\n```",
+		},
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(respBytes)
+}
+
 func (c *stubDeployEngineController) handleIDErrorTriggers(
 	w http.ResponseWriter,
 	id string,
@@ -946,3 +1206,36 @@ func stubApplyReconciliationResult(instanceID string) *container.ApplyReconcilia
 		Errors:           []container.ReconciliationError{},
 	}
 }
+
+func stubImportCandidatesResult(instanceID string) *container.ImportCandidatesResult {
+	return &container.ImportCandidatesResult{
+		InstanceID: instanceID,
+		Resources: []*container.UnmatchedResource{
+			{
+				ResourceName:     "resource-1",
+				ResourceType:     "test/resource",
+				ListingSupported: true,
+				Candidates: []*provider.ExternalResource{
+					{
+						ImportID: "external-resource-1",
+						Name:     "External Resource 1",
+					},
+				},
+			},
+		},
+	}
+}
+
+func stubImportResourcesResult(instanceID string) *container.ImportResourcesResult {
+	return &container.ImportResourcesResult{
+		InstanceID: instanceID,
+		Imported: []*container.ImportedResource{
+			{
+				ResourceName: "resource-1",
+				ResourceID:   "resource-1-id",
+				ImportID:     "external-resource-1",
+			},
+		},
+		Errors: []*container.ImportError{},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/changes"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	bperrors "github.com/newstack-cloud/bluelink/libs/blueprint/errors"
 )
 
 // BlueprintValidationEvent holds the data for a blueprint validation
@@ -87,6 +88,13 @@ type CreateChangesetPayload struct {
 	// Drift detection checks for external changes to resources that were made
 	// outside of the deploy engine.
 	SkipDriftCheck bool `json:"skipDriftCheck"`
+	// Verify, when true, verifies the staged changes with the resource providers
+	// once change staging has completed.
+	// Resources that support dry run deployments will be validated against the
+	// upstream provider to catch errors that can not be determined by diffing
+	// the blueprint against the current state (e.g. permissions or quota limits).
+	// The results are included in the change staging complete event.
+	Verify bool `json:"verify"`
 	// Config values for the change staging process
 	// that will be used in plugins and passed into the blueprint.
 	Config *BlueprintOperationConfig `json:"config"`
@@ -233,8 +241,12 @@ type LinkChangesEventData struct {
 // CompleteChangesEventData holds the data for a complete changes event
 // that is sent to a change staging stream for a change set.
 type CompleteChangesEventData struct {
-	Changes   *changes.BlueprintChanges `json:"changes"`
-	Timestamp int64                     `json:"timestamp"`
+	Changes *changes.BlueprintChanges `json:"changes"`
+	// Verification holds the results of verifying the staged changes
+	// with the resource providers, this is only populated when
+	// verification was requested for the change set.
+	Verification *container.VerifyChangesResult `json:"verification,omitempty"`
+	Timestamp    int64                          `json:"timestamp"`
 }
 
 // BlueprintInstancePayload represents the payload
@@ -272,6 +284,26 @@ type BlueprintInstancePayload struct {
 	// is already in an active state (e.g., Deploying, Updating).
 	// This is an escape hatch for recovering from stuck states.
 	Force bool `json:"force"`
+	// MaxChanges is the maximum number of resources and child blueprints
+	// that can be created, updated, recreated or removed in the deployment.
+	// When the change set exceeds this limit, the deployment will not proceed
+	// unless OverrideBlastRadius is set.
+	// When set to 0, the number of changes is not limited.
+	MaxChanges int `json:"maxChanges,omitempty"`
+	// MaxDeletes is the maximum number of resources and child blueprints
+	// that can be removed or recreated in the deployment.
+	// When the change set exceeds this limit, the deployment will not proceed
+	// unless OverrideBlastRadius is set.
+	// When set to 0, the number of deletes is not limited.
+	MaxDeletes int `json:"maxDeletes,omitempty"`
+	// OverrideBlastRadius explicitly allows the deployment to proceed
+	// when the change set exceeds MaxChanges or MaxDeletes.
+	OverrideBlastRadius bool `json:"overrideBlastRadius,omitempty"`
+	// TTLSeconds marks the blueprint instance as ephemeral (e.g. a preview environment
+	// for a pull request), once the deployment succeeds, the instance will expire
+	// after the provided number of seconds and will be destroyed by the deploy engine.
+	// When set to 0, the current expiry of the instance is left unchanged.
+	TTLSeconds int64 `json:"ttlSeconds,omitempty"`
 	// Config values for the deployment process
 	// that will be used in plugins and passed into the blueprint.
 	Config *BlueprintOperationConfig `json:"config"`
@@ -587,7 +619,11 @@ type StreamErrorMessageEvent struct {
 	ID          string             `json:"id"`
 	Message     string             `json:"message"`
 	Diagnostics []*core.Diagnostic `json:"diagnostics"`
-	Timestamp   int64              `json:"timestamp"`
+	// ErrorGroups holds the errors that the diagnostics were derived from
+	// grouped by blueprint element and reason code with duplicate messages removed,
+	// this is only populated for errors with multiple child errors.
+	ErrorGroups []*bperrors.ErrorGroup `json:"errorGroups,omitempty"`
+	Timestamp   int64                  `json:"timestamp"`
 }
 
 // CheckReconciliationPayload represents the payload for checking
@@ -603,6 +639,12 @@ type CheckReconciliationPayload struct {
 	// LinkNames specifies which links to check when Scope is "specific".
 	// Ignored for other scopes.
 	LinkNames []string `json:"linkNames,omitempty"`
+	// IncludeDependents expands the resources to check when Scope is "specific"
+	// to include the resources that depend on the named resources along with
+	// the links connected to them and the resources those links update
+	// through resource data mappings.
+	// Ignored for other scopes.
+	IncludeDependents bool `json:"includeDependents,omitempty"`
 	// IncludeChildren controls whether to recursively check child blueprints.
 	// If nil or not provided, defaults to true.
 	IncludeChildren *bool `json:"includeChildren,omitempty"`
@@ -610,6 +652,10 @@ type CheckReconciliationPayload struct {
 	// Used when Scope is "specific".
 	// Format: "childA" for first level, "childA.childB" for nested.
 	ChildPath string `json:"childPath,omitempty"`
+	// CheckDataSources enables drift checking for data sources.
+	// Data sources are re-fetched and the fields referenced by blueprint exports
+	// are compared against the export values captured in the instance state.
+	CheckDataSources bool `json:"checkDataSources,omitempty"`
 	// Config values for the reconciliation check
 	// that will be used in plugins.
 	Config *BlueprintOperationConfig `json:"config"`
@@ -628,6 +674,89 @@ type ApplyReconciliationPayload struct {
 	Config *BlueprintOperationConfig `json:"config"`
 }
 
+// ListImportCandidatesPayload represents the payload for listing
+// existing resources in providers that unmatched blueprint resources
+// can be imported from.
+type ListImportCandidatesPayload struct {
+	BlueprintDocumentInfo
+	// ResourceNames restricts the unmatched resources to list candidates for,
+	// when empty, candidates are listed for all unmatched resources.
+	ResourceNames []string `json:"resourceNames,omitempty"`
+	// MaxCandidates is the maximum number of candidates to list for each resource.
+	MaxCandidates int `json:"maxCandidates,omitempty"`
+	// Config values for listing import candidates
+	// that will be used in plugins.
+	Config *BlueprintOperationConfig `json:"config"`
+}
+
+// ImportResourcesPayload represents the payload for importing
+// existing resources into the state of a blueprint instance.
+type ImportResourcesPayload struct {
+	BlueprintDocumentInfo
+	// Mappings maps blueprint resources to the provider-specific
+	// import IDs of the existing resources to adopt.
+	Mappings []*ImportResourceMappingPayload `json:"mappings"`
+	// Config values for importing resources
+	// that will be used in plugins.
+	Config *BlueprintOperationConfig `json:"config"`
+}
+
+// ExportGraphPayload represents the payload for exporting
+// the dependency graph of a blueprint.
+type ExportGraphPayload struct {
+	BlueprintDocumentInfo
+	// Format is the format to render the dependency graph in,
+	// this can be one of "dot", "mermaid" or "json".
+	Format string `json:"format"`
+	// Config values for resolving the dependency graph
+	// that will be used in plugins.
+	Config *BlueprintOperationConfig `json:"config"`
+}
+
+// RenderBlueprintPayload represents the payload for rendering
+// a fully resolved version of a blueprint.
+type RenderBlueprintPayload struct {
+	BlueprintDocumentInfo
+	// Config values for resolving the blueprint
+	// that will be used in plugins.
+	Config *BlueprintOperationConfig `json:"config"`
+	// Trace determines whether to record the reference lookups and function calls
+	// carried out to resolve each field and include them in the rendered blueprint.
+	Trace bool `json:"trace,omitempty"`
+}
+
+// EvaluateExpressionPayload represents the payload for evaluating
+// a substitution expression against a blueprint.
+type EvaluateExpressionPayload struct {
+	BlueprintDocumentInfo
+	// Expression is the substitution to evaluate without the surrounding
+	// "${" and "}" (e.g. "resources.ordersTable.spec.arn").
+	Expression string `json:"expression"`
+	// The ID of an existing blueprint instance to resolve computed
+	// fields of resources from.
+	// This should be left empty if the `InstanceName` field is provided.
+	InstanceID string `json:"instanceId,omitempty"`
+	// The user-defined name of an existing blueprint instance to resolve
+	// computed fields of resources from.
+	// This should be left empty if the `InstanceID` field is provided.
+	InstanceName string `json:"instanceName,omitempty"`
+	// Config values for resolving the expression
+	// that will be used in plugins.
+	Config *BlueprintOperationConfig `json:"config"`
+	// Trace determines whether to record the reference lookups and function calls
+	// carried out to evaluate the expression and include them in the response.
+	Trace bool `json:"trace,omitempty"`
+}
+
+// ImportResourceMappingPayload maps a resource in a blueprint to an existing
+// resource in the upstream provider.
+type ImportResourceMappingPayload struct {
+	// ResourceName is the logical name of the resource in the blueprint.
+	ResourceName string `json:"resourceName"`
+	// ImportID is the provider-specific identifier of the existing resource.
+	ImportID string `json:"importId"`
+}
+
 // ResourceReconcileActionPayload specifies the action to take for a resource.
 type ResourceReconcileActionPayload struct {
 	// ResourceID is the unique identifier for the resource.
@@ -637,13 +766,28 @@ type ResourceReconcileActionPayload struct {
 	// Format: "childA" for first level, "childA.childB" for nested.
 	ChildPath string `json:"childPath,omitempty"`
 	// Action is the reconciliation action to apply.
-	// Valid values: "accept_external", "update_status", "manual_cleanup_required"
+	// Valid values: "accept_external", "update_status", "manual_cleanup_required", "reapply_blueprint"
 	Action string `json:"action"`
 	// ExternalState is required when Action is "accept_external".
 	// This is the state that will be persisted.
 	ExternalState *core.MappingNode `json:"externalState,omitempty"`
 	// NewStatus is the status to set for the resource.
 	NewStatus string `json:"newStatus"`
+	// FieldActions allows accepting external state for a subset of the resource's
+	// spec fields when Action is "accept_external".
+	// Fields that are not accepted keep their persisted values.
+	// When empty, the entire external state is accepted.
+	FieldActions []FieldReconcileActionPayload `json:"fieldActions,omitempty"`
+}
+
+// FieldReconcileActionPayload specifies the action to take for a single field
+// of a resource's spec.
+type FieldReconcileActionPayload struct {
+	// FieldPath is the path to the field in the resource (e.g. "spec.timeout").
+	FieldPath string `json:"fieldPath"`
+	// Action is the field reconciliation action to apply.
+	// Valid values: "accept_external", "keep_persisted"
+	Action string `json:"action"`
 }
 
 // LinkReconcileActionPayload specifies the action to take for a link.
@@ -655,7 +799,7 @@ type LinkReconcileActionPayload struct {
 	// Format: "childA" for first level, "childA.childB" for nested.
 	ChildPath string `json:"childPath,omitempty"`
 	// Action is the reconciliation action to apply.
-	// Valid values: "accept_external", "update_status", "manual_cleanup_required"
+	// Valid values: "accept_external", "update_status", "manual_cleanup_required", "reapply_blueprint"
 	Action string `json:"action"`
 	// NewStatus is the status to set for the link.
 	NewStatus string `json:"newStatus"`
@@ -40,3 +40,35 @@ type CleanupOperationResponse struct {
 	// Data contains the CleanupOperation.
 	Data *manage.CleanupOperation `json:"data"`
 }
+
+// ExportGraphResponse holds a rendered dependency graph for a blueprint.
+type ExportGraphResponse struct {
+	// Format is the format that the dependency graph was rendered in.
+	Format string `json:"format"`
+	// Graph is the rendered dependency graph.
+	Graph string `json:"graph"`
+}
+
+// ResourceTypeDocsResponse holds the documentation for a resource type
+// provided by one of the plugins loaded by the deploy engine.
+type ResourceTypeDocsResponse struct {
+	// Type is the resource type that the documentation is for.
+	Type string `json:"type"`
+	// PlainTextSummary is a short summary of the resource type in plain text.
+	PlainTextSummary string `json:"plainTextSummary"`
+	// MarkdownSummary is a short summary of the resource type
+	// that can be formatted in markdown.
+	MarkdownSummary string `json:"markdownSummary"`
+	// PlainTextDescription is the full description of the resource type
+	// in plain text.
+	PlainTextDescription string `json:"plainTextDescription"`
+	// MarkdownDescription is the full description of the resource type
+	// that can be formatted in markdown.
+	MarkdownDescription string `json:"markdownDescription"`
+	// PlainTextExamples holds the examples of how to use the resource type
+	// in plain text.
+	PlainTextExamples []string `json:"plainTextExamples"`
+	// MarkdownExamples holds the examples of how to use the resource type
+	// that can be formatted in markdown.
+	MarkdownExamples []string `json:"markdownExamples"`
+}
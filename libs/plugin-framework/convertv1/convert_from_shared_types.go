@@ -287,6 +287,7 @@ func FromPBFunctionDefinition(
 		Parameters:           params,
 		Return:               returnDef,
 		Internal:             pbFuncDef.Internal,
+		Volatility:           function.Volatility(pbFuncDef.Volatility),
 	}, nil
 }
 
@@ -928,6 +929,7 @@ func FromPBDeployResourceRequest(
 		ResourceID:      req.ResourceId,
 		Changes:         changes,
 		ProviderContext: providerCtx,
+		DryRun:          req.DryRun,
 	}, nil
 }
 
@@ -940,7 +942,7 @@ func FromPBResourceSpecDefinition(
 		return nil, nil
 	}
 
-	resourceDefinitionSchema, err := fromPBResourceDefinitionsSchema(pbSpecDef.Schema)
+	resourceDefinitionSchema, err := FromPBResourceDefinitionsSchema(pbSpecDef.Schema)
 	if err != nil {
 		return nil, err
 	}
@@ -950,10 +952,15 @@ func FromPBResourceSpecDefinition(
 		IDField:             pbSpecDef.IdField,
 		DestroyBeforeCreate: pbSpecDef.DestroyBeforeCreate,
 		TaggingSupport:      FromPBTaggingSupport(pbSpecDef.TaggingSupport),
+		SupportsDryRun:      pbSpecDef.SupportsDryRun,
+		Deprecated:          pbSpecDef.Deprecated,
+		DeprecationMessage:  pbSpecDef.DeprecationMessage,
 	}, nil
 }
 
-func fromPBResourceDefinitionsSchema(
+// FromPBResourceDefinitionsSchema converts a sharedtypesv1.ResourceDefinitionsSchema
+// from a protobuf message to a provider.ResourceDefinitionsSchema.
+func FromPBResourceDefinitionsSchema(
 	pbSchema *sharedtypesv1.ResourceDefinitionsSchema,
 ) (*provider.ResourceDefinitionsSchema, error) {
 	if pbSchema == nil {
@@ -965,12 +972,12 @@ func fromPBResourceDefinitionsSchema(
 		return nil, err
 	}
 
-	items, err := fromPBResourceDefinitionsSchema(pbSchema.Items)
+	items, err := FromPBResourceDefinitionsSchema(pbSchema.Items)
 	if err != nil {
 		return nil, err
 	}
 
-	mapValues, err := fromPBResourceDefinitionsSchema(pbSchema.MapValues)
+	mapValues, err := FromPBResourceDefinitionsSchema(pbSchema.MapValues)
 	if err != nil {
 		return nil, err
 	}
@@ -1014,6 +1021,14 @@ func fromPBResourceDefinitionsSchema(
 		return nil, err
 	}
 
+	requiredIf, err := fromPBResourceDefinitionsCondition(
+		pbSchema.RequiredIfField,
+		pbSchema.RequiredIfValues,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &provider.ResourceDefinitionsSchema{
 		Type:                     provider.ResourceDefinitionsSchemaType(pbSchema.Type),
 		Label:                    pbSchema.Label,
@@ -1041,6 +1056,31 @@ func fromPBResourceDefinitionsSchema(
 		IgnoreDrift:              pbSchema.IgnoreDrift,
 		TrackDrift:               pbSchema.TrackDrift,
 		SortArrayByField:         pbSchema.SortArrayByField,
+		Deprecated:               pbSchema.Deprecated,
+		DeprecationMessage:       pbSchema.DeprecationMessage,
+		UniqueGroup:              pbSchema.UniqueGroup,
+		RequiredIf:               requiredIf,
+		ConflictsWith:            pbSchema.ConflictsWith,
+		ExactlyOneOf:             pbSchema.ExactlyOneOf,
+	}, nil
+}
+
+func fromPBResourceDefinitionsCondition(
+	field string,
+	pbValues []*schemapb.MappingNode,
+) (*provider.ResourceDefinitionsCondition, error) {
+	if field == "" {
+		return nil, nil
+	}
+
+	values, err := FromPBMappingNodeSlice(pbValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceDefinitionsCondition{
+		Field:  field,
+		Values: values,
 	}, nil
 }
 
@@ -1053,7 +1093,7 @@ func fromPBResourceDefinitionsSchemaMap(
 
 	schemaMap := make(map[string]*provider.ResourceDefinitionsSchema, len(pbSchemaMap))
 	for key, pbSchema := range pbSchemaMap {
-		schema, err := fromPBResourceDefinitionsSchema(pbSchema)
+		schema, err := FromPBResourceDefinitionsSchema(pbSchema)
 		if err != nil {
 			return nil, err
 		}
@@ -1072,7 +1112,7 @@ func fromPBResourceDefinitionsSchemaSlice(
 
 	schemaSlice := make([]*provider.ResourceDefinitionsSchema, len(pbSchemaSlice))
 	for i, pbSchema := range pbSchemaSlice {
-		schema, err := fromPBResourceDefinitionsSchema(pbSchema)
+		schema, err := FromPBResourceDefinitionsSchema(pbSchema)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,63 @@
+package convertv1
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sharedtypesv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// Verifies that the constraints between the fields of a resource spec
+// survive the gRPC boundary: the schema is converted to its protobuf form,
+// serialised and converted back without loss.
+func Test_resource_definitions_schema_field_constraints_round_trip(t *testing.T) {
+	schema := &provider.ResourceDefinitionsSchema{
+		Type: provider.ResourceDefinitionsSchemaTypeObject,
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"protocol": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+			},
+			"certificateArn": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				RequiredIf: &provider.ResourceDefinitionsCondition{
+					Field: "protocol",
+					Values: []*core.MappingNode{
+						core.MappingNodeFromString("https"),
+					},
+				},
+			},
+			"sourceBucket": {
+				Type:          provider.ResourceDefinitionsSchemaTypeString,
+				ConflictsWith: []string{"sourceCode"},
+				ExactlyOneOf:  []string{"sourceBucket", "sourceCode"},
+				UniqueGroup:   "test/bucketName",
+			},
+			"sourceCode": {
+				Type:          provider.ResourceDefinitionsSchemaTypeString,
+				ConflictsWith: []string{"sourceBucket"},
+				ExactlyOneOf:  []string{"sourceBucket", "sourceCode"},
+			},
+		},
+	}
+
+	pbSchema, err := ToPBResourceDefinitionsSchema(schema)
+	require.NoError(t, err)
+
+	serialised, err := proto.Marshal(pbSchema)
+	require.NoError(t, err)
+	deserialised := &sharedtypesv1.ResourceDefinitionsSchema{}
+	require.NoError(t, proto.Unmarshal(serialised, deserialised))
+
+	converted, err := FromPBResourceDefinitionsSchema(deserialised)
+	require.NoError(t, err)
+
+	assert.Equal(t, schema.Attributes["certificateArn"].RequiredIf, converted.Attributes["certificateArn"].RequiredIf)
+	assert.Nil(t, converted.Attributes["protocol"].RequiredIf)
+	assert.Equal(t, []string{"sourceCode"}, converted.Attributes["sourceBucket"].ConflictsWith)
+	assert.Equal(t, []string{"sourceBucket", "sourceCode"}, converted.Attributes["sourceCode"].ExactlyOneOf)
+	assert.Equal(t, "test/bucketName", converted.Attributes["sourceBucket"].UniqueGroup)
+}
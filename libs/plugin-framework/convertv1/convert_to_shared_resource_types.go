@@ -2,6 +2,7 @@ package convertv1
 
 import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schemapb"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/serialisation"
 	commoncore "github.com/newstack-cloud/bluelink/libs/common/core"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/errorsv1"
@@ -75,6 +76,13 @@ func ToPBResourceDefinitionsSchema(
 		return nil, err
 	}
 
+	requiredIfField, requiredIfValues, err := toPBResourceDefinitionsCondition(
+		schema.RequiredIf,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &sharedtypesv1.ResourceDefinitionsSchema{
 		Type:                     string(schema.Type),
 		Label:                    schema.Label,
@@ -102,9 +110,31 @@ func ToPBResourceDefinitionsSchema(
 		IgnoreDrift:              schema.IgnoreDrift,
 		TrackDrift:               schema.TrackDrift,
 		SortArrayByField:         schema.SortArrayByField,
+		Deprecated:               schema.Deprecated,
+		DeprecationMessage:       schema.DeprecationMessage,
+		UniqueGroup:              schema.UniqueGroup,
+		RequiredIfField:          requiredIfField,
+		RequiredIfValues:         requiredIfValues,
+		ConflictsWith:            schema.ConflictsWith,
+		ExactlyOneOf:             schema.ExactlyOneOf,
 	}, nil
 }
 
+func toPBResourceDefinitionsCondition(
+	condition *provider.ResourceDefinitionsCondition,
+) (string, []*schemapb.MappingNode, error) {
+	if condition == nil {
+		return "", nil, nil
+	}
+
+	values, err := ToPBMappingNodeSlice(condition.Values)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return condition.Field, values, nil
+}
+
 func toPBSchemaAttributes(
 	attributes map[string]*provider.ResourceDefinitionsSchema,
 ) (map[string]*sharedtypesv1.ResourceDefinitionsSchema, error) {
@@ -153,6 +153,7 @@ func ToPBDeployResourceRequest(
 		ResourceId:   input.ResourceID,
 		Changes:      changes,
 		Context:      providerContext,
+		DryRun:       input.DryRun,
 	}, nil
 }
 
@@ -1111,6 +1112,7 @@ func toPBFunctionDefinition(
 		Parameters:           pbParams,
 		Return:               pbReturn,
 		Internal:             definition.Internal,
+		Volatility:           string(definition.Volatility),
 	}, nil
 }
 
@@ -30,6 +30,7 @@ const (
 	PluginActionProviderDeployResource                = PluginAction("Provider::DeployResource")
 	PluginActionProviderCheckResourceHasStabilised    = PluginAction("Provider::CheckResourceHasStabilised")
 	PluginActionProviderGetResourceExternalState      = PluginAction("Provider::GetResourceExternalState")
+	PluginActionProviderListResources                 = PluginAction("Provider::ListResources")
 	PluginActionProviderDestroyResource               = PluginAction("Provider::DestroyResource")
 
 	PluginActionProviderStageLinkChanges                 = PluginAction("Provider::StageLinkChanges")
@@ -39,6 +40,7 @@ const (
 	PluginActionProviderGetLinkPriorityResource          = PluginAction("Provider::GetLinkPriorityResource")
 	PluginActionProviderGetLinkTypeDescription           = PluginAction("Provider::GetLinkTypeDescription")
 	PluginActionProviderGetLinkAnnotationDefinitions     = PluginAction("Provider::GetLinkAnnotationDefinitions")
+	PluginActionProviderGetLinkConfigDefinition          = PluginAction("Provider::GetLinkConfigDefinition")
 	PluginActionProviderGetLinkKind                      = PluginAction("Provider::GetLinkKind")
 	PluginActionProviderGetLinkIntermediaryExternalState = PluginAction("Provider::GetLinkIntermediaryExternalState")
 	PluginActionProviderGetLinkCardinality               = PluginAction("Provider::GetLinkCardinality")
@@ -53,8 +53,10 @@ func attachDetailsToErrorResponse(
 	errorResponse *sharedtypesv1.ErrorResponse,
 ) {
 	failureReasons := []string{}
+	fieldFailures := []*provider.SpecFieldFailure{}
 	if badInputErr != nil {
 		failureReasons = badInputErr.FailureReasons
+		fieldFailures = badInputErr.FieldFailures
 	}
 
 	errorWithFailureReasons, ok := inputErr.(provider.ErrorFailureReasons)
@@ -62,12 +64,21 @@ func attachDetailsToErrorResponse(
 		failureReasons = errorWithFailureReasons.GetFailureReasons()
 	}
 
-	if len(failureReasons) == 0 {
+	errorWithFieldFailures, ok := inputErr.(provider.ErrorFieldFailures)
+	if ok {
+		fieldFailures = errorWithFieldFailures.GetFieldFailures()
+	}
+
+	if len(failureReasons) == 0 && len(fieldFailures) == 0 {
 		return
 	}
 
-	details := map[string]any{
-		"failureReasons": failureReasons,
+	details := map[string]any{}
+	if len(failureReasons) > 0 {
+		details["failureReasons"] = failureReasons
+	}
+	if len(fieldFailures) > 0 {
+		details["fieldFailures"] = fieldFailuresToDetails(fieldFailures)
 	}
 	// We'll ignore the error for details conversion,
 	// worst case scenario is that details has a nil value in the case that
@@ -97,6 +108,7 @@ func CreateErrorFromResponse(errorResponse *sharedtypesv1.ErrorResponse, action
 		badInputErr := &provider.BadInputError{
 			ChildError:     createPluginResponseError(errorResponse, action, details),
 			FailureReasons: failureReasonsFromErrorResponse(errorResponse, details),
+			FieldFailures:  fieldFailuresFromDetails(details),
 		}
 		// For deployment actions, `BadInputError` is not treated in a special way
 		// like retry errors are.
@@ -121,6 +133,7 @@ func createGeneralErrorFromResponse(
 		return &provider.ResourceDeployError{
 			ChildError:     createPluginResponseError(errorResponse, action, details),
 			FailureReasons: failureReasonsFromErrorResponse(errorResponse, details),
+			FieldFailures:  fieldFailuresFromDetails(details),
 		}
 	case PluginActionProviderDestroyResource:
 		return &provider.ResourceDestroyError{
@@ -216,6 +229,7 @@ func createDeploymentErrorFromBadInput(
 		return &provider.ResourceDeployError{
 			ChildError:     badInputErr,
 			FailureReasons: badInputErr.FailureReasons,
+			FieldFailures:  badInputErr.FieldFailures,
 		}
 	case PluginActionProviderDestroyResource:
 		return &provider.ResourceDestroyError{
@@ -262,6 +276,48 @@ func failureReasonsFromErrorResponse(
 	return []string{errorResponse.Message}
 }
 
+func fieldFailuresToDetails(fieldFailures []*provider.SpecFieldFailure) []any {
+	details := make([]any, len(fieldFailures))
+	for i, fieldFailure := range fieldFailures {
+		details[i] = map[string]any{
+			"specPath": fieldFailure.SpecPath,
+			"reason":   fieldFailure.Reason,
+		}
+	}
+	return details
+}
+
+func fieldFailuresFromDetails(details any) []*provider.SpecFieldFailure {
+	// Field failures are extracted from details if it is a map[string]any
+	// and contains a key "fieldFailures".
+	detailsMap, isMap := details.(map[string]any)
+	if !isMap {
+		return nil
+	}
+
+	fieldFailureDetails, hasFieldFailures := detailsMap["fieldFailures"].([]any)
+	if !hasFieldFailures {
+		return nil
+	}
+
+	fieldFailures := []*provider.SpecFieldFailure{}
+	for _, fieldFailureDetail := range fieldFailureDetails {
+		fieldFailureMap, isMap := fieldFailureDetail.(map[string]any)
+		if !isMap {
+			continue
+		}
+
+		specPath, _ := fieldFailureMap["specPath"].(string)
+		reason, _ := fieldFailureMap["reason"].(string)
+		fieldFailures = append(fieldFailures, &provider.SpecFieldFailure{
+			SpecPath: specPath,
+			Reason:   reason,
+		})
+	}
+
+	return fieldFailures
+}
+
 // ErrUnexpectedResponseType is returned when an unexpected response type is returned
 // for a plugin action.
 func ErrUnexpectedResponseType(action PluginAction) error {
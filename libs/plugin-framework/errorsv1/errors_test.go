@@ -354,6 +354,37 @@ func (s *ErrorsTestSuite) Test_create_link_intermediary_resources_update_bad_inp
 	)
 }
 
+func (s *ErrorsTestSuite) Test_round_trips_field_failures_for_resource_deploy_error() {
+	fieldFailures := []*provider.SpecFieldFailure{
+		{
+			SpecPath: "$.memorySize",
+			Reason:   "memory size must be at least 128MB",
+		},
+		{
+			SpecPath: "$.environment.variables[\"API_KEY\"]",
+			Reason:   "environment variable values must not be empty",
+		},
+	}
+	errorResponse := CreateResponseFromError(
+		&provider.ResourceDeployError{
+			FailureReasons: []string{"function configuration is invalid"},
+			FieldFailures:  fieldFailures,
+		},
+	)
+
+	goError := CreateErrorFromResponse(
+		errorResponse,
+		PluginActionProviderDeployResource,
+	)
+	var resourceDeployErr *provider.ResourceDeployError
+	s.Require().True(provider.AsResourceDeployError(goError, &resourceDeployErr))
+	s.Assert().Equal(
+		[]string{"function configuration is invalid"},
+		resourceDeployErr.FailureReasons,
+	)
+	s.Assert().Equal(fieldFailures, resourceDeployErr.FieldFailures)
+}
+
 func TestErrorsTestSuite(t *testing.T) {
 	suite.Run(t, new(ErrorsTestSuite))
 }
@@ -37,6 +37,28 @@ func (s *ProviderPluginV1Suite) Test_stage_link_changes() {
 	)
 }
 
+func (s *ProviderPluginV1Suite) Test_stage_link_changes_with_link_config() {
+	link, err := s.provider.Link(
+		context.Background(),
+		lambdaFunctionResourceType,
+		dynamoDBTableResourceType,
+	)
+	s.Require().NoError(err)
+
+	input := linkStageChangesWithConfigInput()
+	output, err := link.StageChanges(
+		context.Background(),
+		input,
+	)
+	s.Require().NoError(err)
+	expected := testprovider.LinkLambdaDynamoDBChangesWithConfigOutput(input.LinkConfig)
+	testutils.AssertLinkChangesEquals(
+		expected.Changes,
+		output.Changes,
+		&s.Suite,
+	)
+}
+
 func (s *ProviderPluginV1Suite) Test_stage_link_changes_fails_for_unexpected_host() {
 	link, err := s.providerWrongHost.Link(
 		context.Background(),
@@ -425,6 +447,73 @@ func (s *ProviderPluginV1Suite) Test_link_annotation_definitions_reports_expecte
 	)
 }
 
+func (s *ProviderPluginV1Suite) Test_link_get_config_definition() {
+	link, err := s.provider.Link(
+		context.Background(),
+		lambdaFunctionResourceType,
+		dynamoDBTableResourceType,
+	)
+	s.Require().NoError(err)
+
+	configDefProvider, ok := link.(provider.LinkConfigDefinitionProvider)
+	s.Require().True(ok)
+
+	output, err := configDefProvider.GetConfigDefinition(
+		context.Background(),
+		linkGetConfigDefinitionInput(),
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		testprovider.LinkLambdaFunctionDDBTableConfigSchema(),
+		output.Schema,
+	)
+}
+
+func (s *ProviderPluginV1Suite) Test_link_get_config_definition_fails_for_unexpected_host() {
+	link, err := s.providerWrongHost.Link(
+		context.Background(),
+		lambdaFunctionResourceType,
+		dynamoDBTableResourceType,
+	)
+	s.Require().NoError(err)
+
+	configDefProvider, ok := link.(provider.LinkConfigDefinitionProvider)
+	s.Require().True(ok)
+
+	_, err = configDefProvider.GetConfigDefinition(
+		context.Background(),
+		linkGetConfigDefinitionInput(),
+	)
+	testutils.AssertInvalidHost(
+		err,
+		errorsv1.PluginActionProviderGetLinkConfigDefinition,
+		testWrongHostID,
+		&s.Suite,
+	)
+}
+
+func (s *ProviderPluginV1Suite) Test_link_get_config_definition_reports_expected_error_for_failure() {
+	link, err := s.failingProvider.Link(
+		context.Background(),
+		lambdaFunctionResourceType,
+		dynamoDBTableResourceType,
+	)
+	s.Require().NoError(err)
+
+	configDefProvider, ok := link.(provider.LinkConfigDefinitionProvider)
+	s.Require().True(ok)
+
+	_, err = configDefProvider.GetConfigDefinition(
+		context.Background(),
+		linkGetConfigDefinitionInput(),
+	)
+	s.Assert().Error(err)
+	s.Assert().Contains(
+		err.Error(),
+		"internal error occurred when retrieving config definition for link",
+	)
+}
+
 func (s *ProviderPluginV1Suite) Test_link_get_kind() {
 	link, err := s.provider.Link(
 		context.Background(),
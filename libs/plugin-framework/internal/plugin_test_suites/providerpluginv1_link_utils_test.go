@@ -213,6 +213,22 @@ func linkGetAnnotationDefnitionsInput() *provider.LinkGetAnnotationDefinitionsIn
 	}
 }
 
+func linkGetConfigDefinitionInput() *provider.LinkGetConfigDefinitionInput {
+	return &provider.LinkGetConfigDefinitionInput{
+		LinkContext: testutils.CreateTestLinkContext(),
+	}
+}
+
+func linkStageChangesWithConfigInput() *provider.LinkStageChangesInput {
+	input := linkStageChangesInput()
+	input.LinkConfig = &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"envVarPrefix": core.MappingNodeFromString("ORDERS_"),
+		},
+	}
+	return input
+}
+
 func linkGetKindInput() *provider.LinkGetKindInput {
 	return &provider.LinkGetKindInput{
 		LinkContext: testutils.CreateTestLinkContext(),
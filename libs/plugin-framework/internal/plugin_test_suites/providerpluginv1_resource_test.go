@@ -795,6 +795,64 @@ func (s *ProviderPluginV1Suite) Test_get_resource_external_state_reports_expecte
 	)
 }
 
+func (s *ProviderPluginV1Suite) Test_list_resources() {
+	resource, err := s.provider.Resource(context.Background(), lambdaFunctionResourceType)
+	s.Require().NoError(err)
+
+	lister, isLister := resource.(provider.ResourceLister)
+	s.Require().True(isLister)
+
+	output, err := lister.ListResources(
+		context.Background(),
+		resourceListInput(),
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		testprovider.ResourceLambdaFunctionListOutput(),
+		output,
+	)
+}
+
+func (s *ProviderPluginV1Suite) Test_list_resources_fails_for_unexpected_host() {
+	resource, err := s.providerWrongHost.Resource(
+		context.Background(),
+		lambdaFunctionResourceType,
+	)
+	s.Require().NoError(err)
+
+	lister, isLister := resource.(provider.ResourceLister)
+	s.Require().True(isLister)
+
+	_, err = lister.ListResources(
+		context.Background(),
+		resourceListInput(),
+	)
+	testutils.AssertInvalidHost(
+		err,
+		errorsv1.PluginActionProviderListResources,
+		testWrongHostID,
+		&s.Suite,
+	)
+}
+
+func (s *ProviderPluginV1Suite) Test_list_resources_reports_expected_error_for_failure() {
+	resource, err := s.failingProvider.Resource(context.Background(), lambdaFunctionResourceType)
+	s.Require().NoError(err)
+
+	lister, isLister := resource.(provider.ResourceLister)
+	s.Require().True(isLister)
+
+	_, err = lister.ListResources(
+		context.Background(),
+		resourceListInput(),
+	)
+	s.Assert().Error(err)
+	s.Assert().Contains(
+		err.Error(),
+		"internal error occurred when listing resources",
+	)
+}
+
 func (s *ProviderPluginV1Suite) Test_destroy_resource() {
 	resource, err := s.provider.Resource(context.Background(), lambdaFunctionResourceType)
 	s.Require().NoError(err)
@@ -5,6 +5,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/state"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/internal/testutils"
 )
 
 func createDeployResourceChanges(mustRecreate bool) *provider.Changes {
@@ -164,3 +165,13 @@ func createDeployResourceInfo() provider.ResourceInfo {
 		},
 	}
 }
+
+func resourceListInput() *provider.ResourceListInput {
+	return &provider.ResourceListInput{
+		ResourceType:    lambdaFunctionResourceType,
+		InstanceName:    "test-instance",
+		ResourceName:    "processOrderFunction",
+		MaxResults:      10,
+		ProviderContext: testutils.CreateTestProviderContext("aws"),
+	}
+}
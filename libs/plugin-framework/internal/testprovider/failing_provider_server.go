@@ -204,6 +204,16 @@ func (p *failingProviderServer) GetResourceExternalState(
 	)
 }
 
+func (p *failingProviderServer) ListResources(
+	ctx context.Context,
+	req *providerserverv1.ListResourcesRequest,
+) (*providerserverv1.ListResourcesResponse, error) {
+	return nil, status.Error(
+		codes.Unknown,
+		"internal error occurred when listing resources",
+	)
+}
+
 func (p *failingProviderServer) DestroyResource(
 	ctx context.Context,
 	req *sharedtypesv1.DestroyResourceRequest,
@@ -284,6 +294,16 @@ func (p *failingProviderServer) GetLinkAnnotationDefinitions(
 	)
 }
 
+func (p *failingProviderServer) GetLinkConfigDefinition(
+	ctx context.Context,
+	req *providerserverv1.LinkRequest,
+) (*providerserverv1.LinkConfigDefinitionResponse, error) {
+	return nil, status.Error(
+		codes.Unknown,
+		"internal error occurred when retrieving config definition for link",
+	)
+}
+
 func (p *failingProviderServer) GetLinkKind(
 	ctx context.Context,
 	req *providerserverv1.LinkRequest,
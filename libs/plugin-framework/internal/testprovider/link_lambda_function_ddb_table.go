@@ -21,6 +21,7 @@ func linkLambdaFunctionDynamoDBTable() provider.Link {
 		PlainTextSummary:                 descriptionInfo.PlainTextSummary,
 		FormattedSummary:                 descriptionInfo.MarkdownSummary,
 		AnnotationDefinitions:            LinkLambdaFunctionDDBTableAnnotations(),
+		ConfigSchema:                     LinkLambdaFunctionDDBTableConfigSchema(),
 		CardinalityA:                     LinkLambdaFunctionDDBTableCardinalityA(),
 		CardinalityB:                     LinkLambdaFunctionDDBTableCardinalityB(),
 		ValidateFunc:                     linkLambdaFunctionDDBTableValidate,
@@ -63,13 +64,49 @@ func LinkLambdaFunctionDDBTableAnnotations() map[string]*provider.LinkAnnotation
 	}
 }
 
+func LinkLambdaFunctionDDBTableConfigSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type: provider.ResourceDefinitionsSchemaTypeObject,
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"envVarPrefix": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+				Description: "The prefix to use for environment variables populated " +
+					"with details about the DynamoDB table.",
+				Default: core.MappingNodeFromString("TABLE_"),
+			},
+		},
+	}
+}
+
 func linkLambdaFunctionDDBTableStageChanges(
 	ctx context.Context,
 	input *provider.LinkStageChangesInput,
 ) (*provider.LinkStageChangesOutput, error) {
+	if input.LinkConfig != nil {
+		return LinkLambdaDynamoDBChangesWithConfigOutput(input.LinkConfig), nil
+	}
+
 	return LinkLambdaDynamoDBChangesOutput(), nil
 }
 
+// LinkLambdaDynamoDBChangesWithConfigOutput derives the staged changes
+// from the provided link configuration so tests can verify that link configuration
+// is passed through to the plugin.
+func LinkLambdaDynamoDBChangesWithConfigOutput(
+	linkConfig *core.MappingNode,
+) *provider.LinkStageChangesOutput {
+	output := LinkLambdaDynamoDBChangesOutput()
+	envVarPrefix := core.StringValue(linkConfig.Fields["envVarPrefix"])
+	output.Changes.NewFields = append(
+		output.Changes.NewFields,
+		&provider.FieldChange{
+			FieldPath: "saveOrderFunction.environmentVariables." + envVarPrefix + "ordersTable",
+			NewValue:  core.MappingNodeFromString("orders-updated"),
+		},
+	)
+	return output
+}
+
 func LinkLambdaDynamoDBChangesOutput() *provider.LinkStageChangesOutput {
 	return &provider.LinkStageChangesOutput{
 		Changes: &provider.LinkChanges{
@@ -40,6 +40,7 @@ func resourceLambdaFunction() provider.Resource {
 		DestroyFunc:          destroyLambdaFunction,
 		CustomValidateFunc:   customValidateLambdaFunction,
 		GetExternalStateFunc: getLambdaFunctionExternalState,
+		ListResourcesFunc:    listLambdaFunctions,
 	}
 }
 
@@ -135,6 +136,11 @@ func ResourceLambdaFunctionValidateOutput() *provider.ResourceValidateOutput {
 					ColumnAccuracy: &colAccuracy,
 				},
 			},
+			{
+				Level:    core.DiagnosticLevelError,
+				Message:  "The memory size must be at least 128MB",
+				SpecPath: "$.memorySize",
+			},
 		},
 	}
 }
@@ -185,6 +191,29 @@ func getLambdaFunctionExternalState(
 	}, nil
 }
 
+func listLambdaFunctions(
+	ctx context.Context,
+	input *provider.ResourceListInput,
+) (*provider.ResourceListOutput, error) {
+	return ResourceLambdaFunctionListOutput(), nil
+}
+
+func ResourceLambdaFunctionListOutput() *provider.ResourceListOutput {
+	return &provider.ResourceListOutput{
+		Resources: []*provider.ExternalResource{
+			{
+				ImportID:    "arn:aws:lambda:us-west-2:123456789012:function:processOrderFunction_0",
+				Name:        "Process-Order-Function-0",
+				Description: "us-west-2",
+			},
+			{
+				ImportID: "arn:aws:lambda:us-west-2:123456789012:function:processOrderFunction_1",
+				Name:     "Process-Order-Function-1",
+			},
+		},
+	}
+}
+
 func ResourceLambdaFunctionExternalState() *core.MappingNode {
 	return &core.MappingNode{
 		Fields: map[string]*core.MappingNode{
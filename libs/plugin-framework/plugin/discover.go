@@ -40,6 +40,8 @@ type PluginPathInfo struct {
 	ID string
 	// The version of the plugin extracted from the path.
 	Version string
+	// The plugin root directory that the plugin was discovered in.
+	RootDir string
 }
 
 // DiscoverPlugins handles the discovery of plugins
@@ -114,7 +116,11 @@ func discoverPluginsInDir(
 			slices.Contains(pluginFileExpectedDepths, depth) {
 			fullPluginPath := filepath.Join(currentDirPath, pluginFileName)
 			relativePluginPath := strings.TrimPrefix(fullPluginPath, pluginRootDirPath)
-			pluginPathInfo, isValidPath := extractPluginPathInfo(fullPluginPath, relativePluginPath)
+			pluginPathInfo, isValidPath := extractPluginPathInfo(
+				fullPluginPath,
+				relativePluginPath,
+				pluginRootDirPath,
+			)
 			if isValidPath {
 				logger.Debug(
 					fmt.Sprintf("found valid plugin at path %s", fullPluginPath),
@@ -127,7 +133,11 @@ func discoverPluginsInDir(
 	return nil
 }
 
-func extractPluginPathInfo(fullPluginPath string, relativePluginPath string) (*PluginPathInfo, bool) {
+func extractPluginPathInfo(
+	fullPluginPath string,
+	relativePluginPath string,
+	pluginRootDirPath string,
+) (*PluginPathInfo, bool) {
 	pluginDir := filepath.Dir(relativePluginPath)
 	pluginDirParts := core.Filter(
 		strings.Split(pluginDir, string(filepath.Separator)),
@@ -149,6 +159,7 @@ func extractPluginPathInfo(fullPluginPath string, relativePluginPath string) (*P
 		PluginType:   pluginType,
 		ID:           pluginID,
 		Version:      pluginVersion,
+		RootDir:      pluginRootDirPath,
 	}, true
 }
 
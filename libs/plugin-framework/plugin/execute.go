@@ -5,6 +5,8 @@ import (
 	"os/exec"
 	"path"
 	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/pluginservicev1"
 )
 
 // PluginExecutor is an interface that represents the executor of a plugin.
@@ -49,6 +51,12 @@ func (e *osCmdExecutor) Execute(
 	cmd := exec.Command(pluginBinary)
 	cmd.Env = os.Environ()
 	cmd.Env = addEnvVars(cmd.Env, e.env)
+	// The plugin ID is provided to each plugin so that it can identify
+	// itself when making calls to the plugin service.
+	cmd.Env = addEnvVars(
+		cmd.Env,
+		map[string]string{pluginservicev1.CallerPluginIDEnvVar: pluginID},
+	)
 	pluginLogFile, err := e.openLogFile(pluginID)
 	if err != nil {
 		return nil, err
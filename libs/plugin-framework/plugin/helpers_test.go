@@ -35,12 +35,14 @@ func loadExpectedPluginPaths() []*PluginPathInfo {
 			PluginType:   "provider",
 			ID:           "bluelink/aws",
 			Version:      "1.0.0",
+			RootDir:      "/root/.bluelink/deploy-engine/plugins/bin",
 		},
 		{
 			AbsolutePath: "/root/.bluelink/deploy-engine/plugins/bin/transformers/celerity/celerity/2.0.1/plugin",
 			PluginType:   "transformer",
 			ID:           "celerity/celerity",
 			Version:      "2.0.1",
+			RootDir:      "/root/.bluelink/deploy-engine/plugins/bin",
 		},
 		{
 			AbsolutePath: "/usr/local/bluelink/deploy-engine/plugins/bin/providers" +
@@ -48,6 +50,7 @@ func loadExpectedPluginPaths() []*PluginPathInfo {
 			PluginType: "provider",
 			ID:         "registry.customhost.com/bluelink/azure",
 			Version:    "3.2.0",
+			RootDir:    "/usr/local/bluelink/deploy-engine/plugins/bin",
 		},
 	}
 }
@@ -0,0 +1,171 @@
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	// ChecksumManifestFileName is the name of the file in a plugin root directory
+	// that holds the checksums of plugin binaries recorded at install time.
+	ChecksumManifestFileName = "checksums.json"
+)
+
+var (
+	// ErrPluginIntegrityCheckFailed is returned when the checksum of a plugin binary
+	// does not match the checksum recorded when the plugin was installed.
+	ErrPluginIntegrityCheckFailed = errors.New("plugin integrity check failed")
+)
+
+// ChecksumManifest holds the checksums of installed plugin binaries
+// for a plugin root directory.
+// Checksum manifests are written by tools that install plugins
+// such as the Bluelink CLI.
+type ChecksumManifest struct {
+	// Plugins maps the path of a plugin binary relative to the plugin root directory
+	// to the checksum recorded when the plugin was installed.
+	// Paths always use "/" as the separator, regardless of the host OS.
+	Plugins map[string]*PluginChecksum `json:"plugins"`
+}
+
+// PluginChecksum holds the checksum recorded for a plugin binary
+// when the plugin was installed.
+type PluginChecksum struct {
+	// PluginID is the ID of the plugin that the binary belongs to.
+	PluginID string `json:"pluginId"`
+	// SHA256 is the hex-encoded SHA256 checksum of the plugin binary.
+	SHA256 string `json:"sha256"`
+}
+
+// PluginIntegrityError provides details about a plugin binary that failed
+// integrity verification.
+type PluginIntegrityError struct {
+	PluginID         string
+	PluginPath       string
+	ExpectedChecksum string
+	ActualChecksum   string
+}
+
+func (e *PluginIntegrityError) Error() string {
+	return fmt.Sprintf(
+		"%s: the checksum of the binary for plugin %q at %s (%s) does not match "+
+			"the checksum recorded when the plugin was installed (%s), "+
+			"the plugin binary may have been tampered with and will not be launched, "+
+			"run `bluelink plugins reinstall %s` to restore the plugin",
+		ErrPluginIntegrityCheckFailed.Error(),
+		e.PluginID,
+		e.PluginPath,
+		e.ActualChecksum,
+		e.ExpectedChecksum,
+		e.PluginID,
+	)
+}
+
+func (e *PluginIntegrityError) Unwrap() error {
+	return ErrPluginIntegrityCheckFailed
+}
+
+// LoadChecksumManifest loads the checksum manifest for the given plugin root directory.
+// This returns nil without an error if the plugin root directory does not contain
+// a checksum manifest.
+func LoadChecksumManifest(fs afero.Fs, pluginRootDir string) (*ChecksumManifest, error) {
+	manifestPath := filepath.Join(pluginRootDir, ChecksumManifestFileName)
+	data, err := afero.ReadFile(fs, manifestPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read plugin checksum manifest: %w", err)
+	}
+
+	manifest := &ChecksumManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf(
+			"failed to parse plugin checksum manifest %s: %w",
+			manifestPath,
+			err,
+		)
+	}
+
+	if manifest.Plugins == nil {
+		manifest.Plugins = map[string]*PluginChecksum{}
+	}
+
+	return manifest, nil
+}
+
+// Checksum returns the checksum recorded for the plugin binary
+// at the given path relative to the plugin root directory.
+func (m *ChecksumManifest) Checksum(relativePath string) (*PluginChecksum, bool) {
+	checksum, hasChecksum := m.Plugins[filepath.ToSlash(relativePath)]
+	return checksum, hasChecksum
+}
+
+// VerifyPluginIntegrity verifies the checksum of the plugin binary
+// against the checksum recorded in the provided manifest.
+// This returns true if the plugin had a recorded checksum that was verified
+// and false if the manifest does not contain a checksum for the plugin.
+// A *PluginIntegrityError is returned if the checksum of the plugin binary
+// does not match the recorded checksum.
+func VerifyPluginIntegrity(
+	fs afero.Fs,
+	manifest *ChecksumManifest,
+	plugin *PluginPathInfo,
+) (bool, error) {
+	if manifest == nil {
+		return false, nil
+	}
+
+	relativePath := strings.TrimPrefix(
+		strings.TrimPrefix(plugin.AbsolutePath, plugin.RootDir),
+		string(filepath.Separator),
+	)
+	expected, hasChecksum := manifest.Checksum(relativePath)
+	if !hasChecksum {
+		return false, nil
+	}
+
+	actual, err := calculateFileChecksum(fs, plugin.AbsolutePath)
+	if err != nil {
+		return false, fmt.Errorf(
+			"failed to calculate checksum for plugin %q: %w",
+			plugin.ID,
+			err,
+		)
+	}
+
+	if !strings.EqualFold(actual, expected.SHA256) {
+		return false, &PluginIntegrityError{
+			PluginID:         plugin.ID,
+			PluginPath:       plugin.AbsolutePath,
+			ExpectedChecksum: expected.SHA256,
+			ActualChecksum:   actual,
+		}
+	}
+
+	return true, nil
+}
+
+func calculateFileChecksum(fs afero.Fs, path string) (string, error) {
+	file, err := fs.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -148,8 +148,14 @@ func (l *Launcher) Launch(ctx context.Context) (*PluginMaps, error) {
 	l.logger.Info(
 		fmt.Sprintf("found %d plugins, launching ...", len(plugins)),
 	)
+	checksumManifests := map[string]*ChecksumManifest{}
 	for _, plugin := range plugins {
-		err := l.launchPlugin(ctx, plugin, 1 /* attemptNumber */)
+		err := l.verifyPluginIntegrity(plugin, checksumManifests)
+		if err != nil {
+			return nil, err
+		}
+
+		err = l.launchPlugin(ctx, plugin, 1 /* attemptNumber */)
 		if err != nil {
 			return nil, err
 		}
@@ -184,6 +190,47 @@ func (l *Launcher) Launch(ctx context.Context) (*PluginMaps, error) {
 	}, nil
 }
 
+// verifyPluginIntegrity verifies the checksum of a plugin binary against the
+// checksum recorded when the plugin was installed before the plugin is launched.
+// Plugins in root directories without a checksum manifest and plugins without
+// a recorded checksum (e.g. plugins copied into the plugin directory manually)
+// are launched without verification.
+func (l *Launcher) verifyPluginIntegrity(
+	plugin *PluginPathInfo,
+	checksumManifests map[string]*ChecksumManifest,
+) error {
+	manifest, loaded := checksumManifests[plugin.RootDir]
+	if !loaded {
+		var err error
+		manifest, err = LoadChecksumManifest(l.fs, plugin.RootDir)
+		if err != nil {
+			return err
+		}
+		checksumManifests[plugin.RootDir] = manifest
+	}
+
+	verified, err := VerifyPluginIntegrity(l.fs, manifest, plugin)
+	if err != nil {
+		l.logger.Error(
+			"plugin failed integrity verification",
+			core.StringLogField("plugin", plugin.ID),
+			core.StringLogField("pluginPath", plugin.AbsolutePath),
+			core.ErrorLogField("error", err),
+		)
+		return err
+	}
+
+	if !verified {
+		l.logger.Debug(
+			"no recorded checksum found for plugin, skipping integrity verification",
+			core.StringLogField("plugin", plugin.ID),
+			core.StringLogField("pluginPath", plugin.AbsolutePath),
+		)
+	}
+
+	return nil
+}
+
 func (l *Launcher) launchPlugin(
 	ctx context.Context,
 	plugin *PluginPathInfo,
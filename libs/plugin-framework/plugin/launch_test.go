@@ -2,7 +2,11 @@ package plugin
 
 import (
 	context "context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -114,6 +118,69 @@ func (s *LaunchSuite) Test_launches_plugins_with_transform_plugin_name_key() {
 	s.assertHasTransformer(pluginMaps, "celerity", TransformerKeyTypePluginName)
 }
 
+func (s *LaunchSuite) Test_launches_plugins_with_verified_checksums() {
+	s.writeChecksumManifest(testPluginRootPaths[0], map[string]*PluginChecksum{
+		"providers/bluelink/aws/1.0.0/plugin": {
+			PluginID: "bluelink/aws",
+			SHA256:   s.checksum(s.expected[0].AbsolutePath),
+		},
+		"transformers/celerity/celerity/2.0.1/plugin": {
+			PluginID: "celerity/celerity",
+			SHA256:   s.checksum(s.expected[1].AbsolutePath),
+		},
+	})
+
+	pluginMaps, err := s.launcher.Launch(context.Background())
+	s.Require().NoError(err)
+
+	s.Assert().Len(pluginMaps.Providers, 2)
+	s.Assert().Len(pluginMaps.Transformers, 1)
+}
+
+func (s *LaunchSuite) Test_refuses_to_launch_plugin_with_checksum_mismatch() {
+	s.writeChecksumManifest(testPluginRootPaths[0], map[string]*PluginChecksum{
+		"providers/bluelink/aws/1.0.0/plugin": {
+			PluginID: "bluelink/aws",
+			SHA256:   s.checksum(s.expected[0].AbsolutePath),
+		},
+	})
+	// Replace the plugin binary after the checksum has been recorded.
+	err := afero.WriteFile(s.fs, s.expected[0].AbsolutePath, []byte{2, 2, 2, 2}, 0755)
+	s.Require().NoError(err)
+
+	_, err = s.launcher.Launch(context.Background())
+	s.Require().Error(err)
+	s.Assert().ErrorIs(err, ErrPluginIntegrityCheckFailed)
+
+	integrityErr, isIntegrityErr := err.(*PluginIntegrityError)
+	s.Require().True(isIntegrityErr)
+	s.Assert().Equal("bluelink/aws", integrityErr.PluginID)
+	s.Assert().Equal(s.expected[0].AbsolutePath, integrityErr.PluginPath)
+	s.Assert().Contains(err.Error(), "bluelink plugins reinstall bluelink/aws")
+}
+
+func (s *LaunchSuite) writeChecksumManifest(
+	rootDir string,
+	checksums map[string]*PluginChecksum,
+) {
+	data, err := json.Marshal(&ChecksumManifest{Plugins: checksums})
+	s.Require().NoError(err)
+	err = afero.WriteFile(
+		s.fs,
+		filepath.Join(rootDir, ChecksumManifestFileName),
+		data,
+		0644,
+	)
+	s.Require().NoError(err)
+}
+
+func (s *LaunchSuite) checksum(path string) string {
+	data, err := afero.ReadFile(s.fs, path)
+	s.Require().NoError(err)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
 func (s *LaunchSuite) assertHasProvider(
 	pluginMaps *PluginMaps,
 	namespace string,
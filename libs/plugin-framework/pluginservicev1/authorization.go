@@ -0,0 +1,245 @@
+package pluginservicev1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/utils"
+	"github.com/spf13/afero"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// CallerPluginIDEnvVar is the name of the environment variable
+	// that the host sets for each plugin process that it launches
+	// to identify the plugin when it makes calls to the plugin service.
+	CallerPluginIDEnvVar = "BLUELINK_PLUGIN_ID"
+	// CallerPluginIDMetadataKey is the gRPC metadata key used to pass
+	// the ID of the plugin that is making a call to the plugin service.
+	CallerPluginIDMetadataKey = "bluelink-caller-plugin-id"
+	// CapabilityManifestFileName is the default name of the file
+	// that holds the capability manifest for plugins.
+	CapabilityManifestFileName = "capabilities.json"
+	// AnyResourceNamespace can be used in the resource namespaces
+	// of the capabilities for a plugin to allow the plugin
+	// to call resource services for all resource types.
+	AnyResourceNamespace = "*"
+)
+
+// ServiceCapability is a group of host services that can be invoked
+// by plugins through the plugin service.
+type ServiceCapability string
+
+const (
+	// ServiceCapabilityFunctions allows a plugin to call functions
+	// and retrieve function definitions through the plugin service.
+	ServiceCapabilityFunctions ServiceCapability = "functions"
+	// ServiceCapabilityResourceLookup allows a plugin to look up
+	// resources in the state of blueprint instances.
+	ServiceCapabilityResourceLookup ServiceCapability = "resourceLookup"
+	// ServiceCapabilityResourceDeploy allows a plugin to deploy
+	// resources through the plugin service.
+	ServiceCapabilityResourceDeploy ServiceCapability = "resourceDeploy"
+	// ServiceCapabilityResourceDestroy allows a plugin to destroy
+	// resources through the plugin service.
+	ServiceCapabilityResourceDestroy ServiceCapability = "resourceDestroy"
+	// ServiceCapabilityResourceLock allows a plugin to acquire
+	// locks on resources in blueprint instances.
+	ServiceCapabilityResourceLock ServiceCapability = "resourceLock"
+)
+
+// CapabilityManifest holds the host services that plugins are allowed
+// to invoke through the plugin service.
+//
+// Plugins that are not listed in the manifest are given the default capabilities
+// of the manifest, when no default capabilities are provided, plugins
+// can call functions and can look up, deploy, destroy and lock resources
+// that belong to the plugin's own namespace.
+//
+// For example:
+//
+//	{
+//	  "plugins": {
+//	    "bluelink/aws": {
+//	      "services": ["functions", "resourceLookup", "resourceDeploy"],
+//	      "resourceNamespaces": ["aws", "core"]
+//	    }
+//	  }
+//	}
+type CapabilityManifest struct {
+	// Plugins maps plugin IDs to the capabilities of the plugin.
+	Plugins map[string]*PluginCapabilities `json:"plugins"`
+	// Default holds the capabilities for plugins
+	// that are not listed in the manifest.
+	Default *PluginCapabilities `json:"default,omitempty"`
+}
+
+// PluginCapabilities holds the host services that a plugin
+// is allowed to invoke through the plugin service.
+type PluginCapabilities struct {
+	// Services is the list of groups of host services that the plugin
+	// is allowed to invoke.
+	Services []ServiceCapability `json:"services"`
+	// ResourceNamespaces is the list of resource type namespaces
+	// (e.g. "aws" for "aws/lambda/function") that the plugin is allowed to
+	// look up, deploy or destroy resources for.
+	// When empty, the plugin can only call resource services for
+	// resource types in the plugin's own namespace.
+	// "*" can be used to allow all resource types.
+	ResourceNamespaces []string `json:"resourceNamespaces,omitempty"`
+}
+
+// LoadCapabilityManifest loads a capability manifest from the JSON file
+// at the given path.
+func LoadCapabilityManifest(fs afero.Fs, manifestPath string) (*CapabilityManifest, error) {
+	data, err := afero.ReadFile(fs, filepath.Clean(manifestPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugin capability manifest: %w", err)
+	}
+
+	manifest := &CapabilityManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf(
+			"failed to parse plugin capability manifest %s: %w",
+			manifestPath,
+			err,
+		)
+	}
+
+	if manifest.Plugins == nil {
+		manifest.Plugins = map[string]*PluginCapabilities{}
+	}
+
+	return manifest, nil
+}
+
+// Authorize checks whether the plugin with the given ID is allowed to
+// invoke host services in the provided capability group.
+// The resource type should be provided for resource services that target
+// a specific type of resource and should be empty for other services.
+func (m *CapabilityManifest) Authorize(
+	pluginID string,
+	capability ServiceCapability,
+	resourceType string,
+) error {
+	capabilities := m.capabilitiesForPlugin(pluginID)
+	if !slices.Contains(capabilities.Services, capability) {
+		return &ServiceCallUnauthorizedError{
+			PluginID: pluginID,
+			Reason:   fmt.Sprintf("the %q capability has not been granted", capability),
+		}
+	}
+
+	if resourceType == "" {
+		return nil
+	}
+
+	resourceNamespaces := capabilities.ResourceNamespaces
+	if len(resourceNamespaces) == 0 {
+		resourceNamespaces = []string{utils.ExtractPluginNamespace(pluginID)}
+	}
+
+	resourceNamespace := strings.Split(resourceType, "/")[0]
+	if !slices.Contains(resourceNamespaces, AnyResourceNamespace) &&
+		!slices.Contains(resourceNamespaces, resourceNamespace) {
+		return &ServiceCallUnauthorizedError{
+			PluginID: pluginID,
+			Reason: fmt.Sprintf(
+				"resources of type %q are outside of the resource namespaces that the plugin can access",
+				resourceType,
+			),
+		}
+	}
+
+	return nil
+}
+
+func (m *CapabilityManifest) capabilitiesForPlugin(pluginID string) *PluginCapabilities {
+	capabilities, hasCapabilities := m.Plugins[pluginID]
+	if hasCapabilities && capabilities != nil {
+		return capabilities
+	}
+
+	if m.Default != nil {
+		return m.Default
+	}
+
+	return DefaultPluginCapabilities()
+}
+
+// DefaultPluginCapabilities returns the capabilities for plugins
+// that are not listed in a capability manifest that does not
+// provide default capabilities.
+// This allows plugins to call functions and to look up, deploy,
+// destroy and lock resources in the plugin's own namespace.
+func DefaultPluginCapabilities() *PluginCapabilities {
+	return &PluginCapabilities{
+		Services: []ServiceCapability{
+			ServiceCapabilityFunctions,
+			ServiceCapabilityResourceLookup,
+			ServiceCapabilityResourceDeploy,
+			ServiceCapabilityResourceDestroy,
+			ServiceCapabilityResourceLock,
+		},
+	}
+}
+
+// ServiceCallUnauthorizedError is returned when a plugin attempts to
+// invoke a host service that it has not been granted access to.
+type ServiceCallUnauthorizedError struct {
+	PluginID string
+	Reason   string
+}
+
+func (e *ServiceCallUnauthorizedError) Error() string {
+	if e.PluginID == "" {
+		return fmt.Sprintf("plugin service call not authorized: %s", e.Reason)
+	}
+
+	return fmt.Sprintf(
+		"plugin service call not authorized for plugin %q: %s",
+		e.PluginID,
+		e.Reason,
+	)
+}
+
+// CallerPluginIDFromContext extracts the ID of the plugin
+// that made a call to the plugin service from the incoming gRPC metadata.
+func CallerPluginIDFromContext(ctx context.Context) string {
+	md, hasMetadata := metadata.FromIncomingContext(ctx)
+	if !hasMetadata {
+		return ""
+	}
+
+	values := md.Get(CallerPluginIDMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// CallerPluginIDUnaryInterceptor creates a gRPC client interceptor that
+// attaches the ID of the calling plugin to all requests made to the plugin service.
+func CallerPluginIDUnaryInterceptor(pluginID string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		ctxWithCaller := metadata.AppendToOutgoingContext(
+			ctx,
+			CallerPluginIDMetadataKey,
+			pluginID,
+		)
+		return invoker(ctxWithCaller, method, req, reply, cc, opts...)
+	}
+}
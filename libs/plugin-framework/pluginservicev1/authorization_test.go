@@ -0,0 +1,192 @@
+package pluginservicev1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	sharedtypesv1 "github.com/newstack-cloud/bluelink/libs/plugin-framework/sharedtypesv1"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/metadata"
+)
+
+const testCapabilityManifest = `{
+  "plugins": {
+    "bluelink/aws": {
+      "services": ["functions", "resourceLookup", "resourceDeploy"],
+      "resourceNamespaces": ["aws", "core"]
+    },
+    "bluelink/gcloud": {
+      "services": ["resourceLookup"]
+    }
+  }
+}`
+
+type AuthorizationSuite struct {
+	manifest *CapabilityManifest
+	suite.Suite
+}
+
+func (s *AuthorizationSuite) SetupTest() {
+	fs := afero.NewMemMapFs()
+	err := afero.WriteFile(fs, "/plugins/capabilities.json", []byte(testCapabilityManifest), 0644)
+	s.Require().NoError(err)
+
+	manifest, err := LoadCapabilityManifest(fs, "/plugins/capabilities.json")
+	s.Require().NoError(err)
+	s.manifest = manifest
+}
+
+func (s *AuthorizationSuite) Test_authorizes_granted_capabilities_for_listed_resource_namespaces() {
+	s.Assert().NoError(
+		s.manifest.Authorize("bluelink/aws", ServiceCapabilityFunctions, ""),
+	)
+	s.Assert().NoError(
+		s.manifest.Authorize("bluelink/aws", ServiceCapabilityResourceDeploy, "aws/lambda/function"),
+	)
+	s.Assert().NoError(
+		s.manifest.Authorize("bluelink/aws", ServiceCapabilityResourceLookup, "core/config"),
+	)
+}
+
+func (s *AuthorizationSuite) Test_rejects_capabilities_that_have_not_been_granted() {
+	err := s.manifest.Authorize("bluelink/aws", ServiceCapabilityResourceDestroy, "aws/lambda/function")
+	s.Require().Error(err)
+	s.Assert().Equal(
+		"plugin service call not authorized for plugin \"bluelink/aws\": "+
+			"the \"resourceDestroy\" capability has not been granted",
+		err.Error(),
+	)
+}
+
+func (s *AuthorizationSuite) Test_rejects_resource_types_outside_of_listed_resource_namespaces() {
+	err := s.manifest.Authorize("bluelink/aws", ServiceCapabilityResourceLookup, "gcloud/storage/bucket")
+	s.Require().Error(err)
+	s.Assert().Equal(
+		"plugin service call not authorized for plugin \"bluelink/aws\": "+
+			"resources of type \"gcloud/storage/bucket\" are outside of the resource "+
+			"namespaces that the plugin can access",
+		err.Error(),
+	)
+}
+
+func (s *AuthorizationSuite) Test_limits_resource_services_to_own_namespace_by_default() {
+	s.Assert().NoError(
+		s.manifest.Authorize("bluelink/gcloud", ServiceCapabilityResourceLookup, "gcloud/storage/bucket"),
+	)
+	s.Assert().Error(
+		s.manifest.Authorize("bluelink/gcloud", ServiceCapabilityResourceLookup, "aws/dynamodb/table"),
+	)
+}
+
+func (s *AuthorizationSuite) Test_applies_default_capabilities_to_plugins_not_in_manifest() {
+	s.Assert().NoError(
+		s.manifest.Authorize("bluelink/azure", ServiceCapabilityFunctions, ""),
+	)
+	s.Assert().NoError(
+		s.manifest.Authorize("bluelink/azure", ServiceCapabilityResourceDestroy, "azure/storage/account"),
+	)
+	s.Assert().Error(
+		s.manifest.Authorize("bluelink/azure", ServiceCapabilityResourceDestroy, "aws/dynamodb/table"),
+	)
+
+	s.manifest.Default = &PluginCapabilities{
+		Services: []ServiceCapability{ServiceCapabilityFunctions},
+	}
+	s.Assert().Error(
+		s.manifest.Authorize("bluelink/azure", ServiceCapabilityResourceDestroy, "azure/storage/account"),
+	)
+}
+
+func (s *AuthorizationSuite) Test_service_server_rejects_calls_from_unidentified_plugins() {
+	server := s.createServiceServer()
+
+	response, err := server.LookupResourceInState(
+		context.Background(),
+		&LookupResourceInStateRequest{
+			ResourceType: "aws/dynamodb/table",
+		},
+	)
+	s.Require().NoError(err)
+	errorResponse := response.GetErrorResponse()
+	s.Require().NotNil(errorResponse)
+	s.Assert().Equal(
+		"plugin service call not authorized: the plugin making the call could not be identified",
+		errorResponse.Message,
+	)
+}
+
+func (s *AuthorizationSuite) Test_service_server_rejects_calls_from_unregistered_plugins() {
+	server := s.createServiceServer()
+
+	response, err := server.AcquireResourceLock(
+		callerContext("bluelink/unknown"),
+		&AcquireResourceLockRequest{},
+	)
+	s.Require().NoError(err)
+	errorResponse := response.GetErrorResponse()
+	s.Require().NotNil(errorResponse)
+	s.Assert().Equal(
+		"plugin service call not authorized for plugin \"bluelink/unknown\": "+
+			"the plugin is not registered with the host",
+		errorResponse.Message,
+	)
+}
+
+func (s *AuthorizationSuite) Test_service_server_rejects_calls_outside_of_plugin_capabilities() {
+	server := s.createServiceServer()
+
+	response, err := server.DestroyResource(
+		callerContext("bluelink/aws"),
+		&sharedtypesv1.DestroyResourceRequest{
+			ResourceType: &sharedtypesv1.ResourceType{
+				Type: "aws/lambda/function",
+			},
+		},
+	)
+	s.Require().NoError(err)
+	errorResponse := response.GetErrorResponse()
+	s.Require().NotNil(errorResponse)
+	s.Assert().Contains(
+		errorResponse.Message,
+		"the \"resourceDestroy\" capability has not been granted",
+	)
+}
+
+func (s *AuthorizationSuite) createServiceServer() ServiceServer {
+	manager := NewManager(
+		map[PluginType]string{
+			PluginType_PLUGIN_TYPE_PROVIDER: "1.0",
+		},
+		func(_ *PluginInstanceInfo, _ string) (any, func(), error) {
+			return nil, func() {}, nil
+		},
+		testHostID,
+	)
+	err := manager.RegisterPlugin(&PluginInstanceInfo{
+		PluginType:       PluginType_PLUGIN_TYPE_PROVIDER,
+		ID:               "bluelink/aws",
+		ProtocolVersions: []string{"1.0"},
+	})
+	s.Require().NoError(err)
+
+	return NewServiceServer(
+		manager,
+		provider.NewFunctionRegistry(map[string]provider.Provider{}),
+		/* resourceService */ nil,
+		testHostID,
+		WithCapabilityManifest(s.manifest),
+	)
+}
+
+func callerContext(pluginID string) context.Context {
+	return metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(CallerPluginIDMetadataKey, pluginID),
+	)
+}
+
+func TestAuthorizationSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizationSuite))
+}
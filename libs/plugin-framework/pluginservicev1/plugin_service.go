@@ -31,6 +31,7 @@ type pluginServiceServer struct {
 	hostID                       string
 	pluginToPluginCallTimeout    int
 	resourceStabilisationTimeout int
+	capabilityManifest           *CapabilityManifest
 }
 
 // ServiceServerOption is a function that configures a service server.
@@ -62,6 +63,21 @@ func WithResourceStabilisationTimeout(timeout int) ServiceServerOption {
 	}
 }
 
+// WithCapabilityManifest is a service server option that scopes
+// the host services that each plugin can invoke through the plugin service
+// to the capabilities defined in the provided manifest.
+// Plugins identify themselves by attaching their plugin ID to requests
+// (see `NewEnvServiceClient`), calls from plugins that can not be identified
+// or are not registered with the host are rejected.
+//
+// When not provided, all registered and unregistered plugins can invoke
+// all host services exposed by the plugin service.
+func WithCapabilityManifest(manifest *CapabilityManifest) ServiceServerOption {
+	return func(s *pluginServiceServer) {
+		s.capabilityManifest = manifest
+	}
+}
+
 // NewServiceServer creates a new gRPC server for the plugin service
 // that manages registration and deregistration of plugins along with
 // allowing a subset of plugin functionality to make calls to other plugins.
@@ -160,6 +176,11 @@ func (s *pluginServiceServer) CallFunction(
 	ctx context.Context,
 	req *sharedtypesv1.FunctionCallRequest,
 ) (*sharedtypesv1.FunctionCallResponse, error) {
+	err := s.authorize(ctx, ServiceCapabilityFunctions, "")
+	if err != nil {
+		return convertv1.ToPBFunctionCallErrorResponse(err), nil
+	}
+
 	input, err := convertv1.FromPBFunctionCallRequest(req, s.functionRegistry)
 	if err != nil {
 		return convertv1.ToPBFunctionCallErrorResponse(err), nil
@@ -192,6 +213,11 @@ func (s *pluginServiceServer) GetFunctionDefinition(
 	ctx context.Context,
 	req *sharedtypesv1.FunctionDefinitionRequest,
 ) (*sharedtypesv1.FunctionDefinitionResponse, error) {
+	err := s.authorize(ctx, ServiceCapabilityFunctions, "")
+	if err != nil {
+		return convertv1.ToPBFunctionDefinitionErrorResponse(err), nil
+	}
+
 	input, err := convertv1.FromPBFunctionDefinitionRequest(req)
 	if err != nil {
 		return convertv1.ToPBFunctionDefinitionErrorResponse(err), nil
@@ -224,6 +250,11 @@ func (s *pluginServiceServer) HasFunction(
 	ctx context.Context,
 	req *HasFunctionRequest,
 ) (*HasFunctionResponse, error) {
+	err := s.authorize(ctx, ServiceCapabilityFunctions, "")
+	if err != nil {
+		return toHasFunctionErrorRespponse(err), nil
+	}
+
 	ctxWithTimeout, cancel := context.WithTimeout(
 		ctx,
 		time.Duration(s.pluginToPluginCallTimeout)*time.Millisecond,
@@ -248,6 +279,11 @@ func (s *pluginServiceServer) ListFunctions(
 	ctx context.Context,
 	_ *emptypb.Empty,
 ) (*ListFunctionsResponse, error) {
+	err := s.authorize(ctx, ServiceCapabilityFunctions, "")
+	if err != nil {
+		return toListFunctionsErrorResponse(err), nil
+	}
+
 	ctxWithTimeout, cancel := context.WithTimeout(
 		ctx,
 		time.Duration(s.pluginToPluginCallTimeout)*time.Millisecond,
@@ -272,6 +308,15 @@ func (s *pluginServiceServer) DeployResource(
 	ctx context.Context,
 	req *DeployResourceServiceRequest,
 ) (*sharedtypesv1.DeployResourceResponse, error) {
+	err := s.authorize(
+		ctx,
+		ServiceCapabilityResourceDeploy,
+		convertv1.ResourceTypeToString(req.DeployRequest.GetResourceType()),
+	)
+	if err != nil {
+		return convertv1.ToPBDeployResourceErrorResponse(err), nil
+	}
+
 	input, err := convertv1.FromPBDeployResourceRequest(req.DeployRequest)
 	if err != nil {
 		return convertv1.ToPBDeployResourceErrorResponse(err), nil
@@ -317,6 +362,15 @@ func (s *pluginServiceServer) DestroyResource(
 	ctx context.Context,
 	req *sharedtypesv1.DestroyResourceRequest,
 ) (*sharedtypesv1.DestroyResourceResponse, error) {
+	err := s.authorize(
+		ctx,
+		ServiceCapabilityResourceDestroy,
+		convertv1.ResourceTypeToString(req.GetResourceType()),
+	)
+	if err != nil {
+		return convertv1.ToPBDestroyResourceErrorResponse(err), nil
+	}
+
 	input, err := convertv1.FromPBDestroyResourceRequest(req)
 	if err != nil {
 		return convertv1.ToPBDestroyResourceErrorResponse(err), nil
@@ -350,6 +404,11 @@ func (s *pluginServiceServer) LookupResourceInState(
 	ctx context.Context,
 	req *LookupResourceInStateRequest,
 ) (*LookupResourceInStateResponse, error) {
+	err := s.authorize(ctx, ServiceCapabilityResourceLookup, req.GetResourceType())
+	if err != nil {
+		return toPBLookupResourceInStateErrorResponse(err), nil
+	}
+
 	input, err := fromPBLookupResourceInStateRequest(req)
 	if err != nil {
 		return toPBLookupResourceInStateErrorResponse(err), nil
@@ -379,6 +438,11 @@ func (s *pluginServiceServer) AcquireResourceLock(
 	ctx context.Context,
 	req *AcquireResourceLockRequest,
 ) (*AcquireResourceLockResponse, error) {
+	err := s.authorize(ctx, ServiceCapabilityResourceLock, "")
+	if err != nil {
+		return toPBAcquireResourceLockErrorResponse(err), nil
+	}
+
 	input, err := fromPBAcquireResourceLockRequest(req)
 	if err != nil {
 		return toPBAcquireResourceLockErrorResponse(err), nil
@@ -401,6 +465,39 @@ func (s *pluginServiceServer) AcquireResourceLock(
 	}, nil
 }
 
+// Checks whether the plugin making a call to the plugin service
+// has been granted access to the given capability in the capability manifest.
+func (s *pluginServiceServer) authorize(
+	ctx context.Context,
+	capability ServiceCapability,
+	resourceType string,
+) error {
+	if s.capabilityManifest == nil {
+		return nil
+	}
+
+	pluginID := CallerPluginIDFromContext(ctx)
+	if pluginID == "" {
+		return &ServiceCallUnauthorizedError{
+			Reason: "the plugin making the call could not be identified",
+		}
+	}
+
+	if !s.isRegisteredPlugin(pluginID) {
+		return &ServiceCallUnauthorizedError{
+			PluginID: pluginID,
+			Reason:   "the plugin is not registered with the host",
+		}
+	}
+
+	return s.capabilityManifest.Authorize(pluginID, capability, resourceType)
+}
+
+func (s *pluginServiceServer) isRegisteredPlugin(pluginID string) bool {
+	return s.manager.GetPlugin(PluginType_PLUGIN_TYPE_PROVIDER, pluginID) != nil ||
+		s.manager.GetPlugin(PluginType_PLUGIN_TYPE_TRANSFORMER, pluginID) != nil
+}
+
 func toPBLookupResourceInStateErrorResponse(err error) *LookupResourceInStateResponse {
 	return &LookupResourceInStateResponse{
 		Response: &LookupResourceInStateResponse_ErrorResponse{
@@ -11,17 +11,31 @@ import (
 
 // NewEnvServiceClient creates a new plugin service client
 // from the current environment.
+// When the host has provided the ID of the plugin in the environment,
+// the ID will be attached to all requests made to the plugin service
+// so the host can scope the services that the plugin can invoke.
 func NewEnvServiceClient() (ServiceClient, func(), error) {
 	servicePort := os.Getenv("BLUELINK_BUILD_ENGINE_PLUGIN_SERVICE_PORT")
 	if servicePort == "" {
 		servicePort = strconv.Itoa(DefaultPort)
 	}
 
-	conn, err := grpc.NewClient(
-		fmt.Sprintf("127.0.0.1:%s", servicePort),
+	dialOpts := []grpc.DialOption{
 		grpc.WithTransportCredentials(
 			insecure.NewCredentials(),
 		),
+	}
+	callerPluginID := os.Getenv(CallerPluginIDEnvVar)
+	if callerPluginID != "" {
+		dialOpts = append(
+			dialOpts,
+			grpc.WithUnaryInterceptor(CallerPluginIDUnaryInterceptor(callerPluginID)),
+		)
+	}
+
+	conn, err := grpc.NewClient(
+		fmt.Sprintf("127.0.0.1:%s", servicePort),
+		dialOpts...,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -395,3 +395,22 @@ func fromPBValidateLinkResponse(
 		Diagnostics: diagnostics,
 	}, nil
 }
+
+func fromPBExternalResources(
+	pbResources []*ExternalResource,
+) []*provider.ExternalResource {
+	resources := make([]*provider.ExternalResource, 0, len(pbResources))
+	for _, pbResource := range pbResources {
+		if pbResource == nil {
+			continue
+		}
+
+		resources = append(resources, &provider.ExternalResource{
+			ImportID:    pbResource.ImportId,
+			Name:        pbResource.Name,
+			Description: pbResource.Description,
+		})
+	}
+
+	return resources
+}
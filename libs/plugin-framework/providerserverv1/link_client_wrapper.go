@@ -94,6 +94,14 @@ func (l *linkProviderClientWrapper) buildStageChangesRequest(
 		return nil, err
 	}
 
+	linkConfigPB, err := serialisation.ToMappingNodePB(
+		input.LinkConfig,
+		/* optional */ true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &StageLinkChangesRequest{
 		LinkType: &LinkType{
 			Type: core.LinkType(
@@ -106,6 +114,7 @@ func (l *linkProviderClientWrapper) buildStageChangesRequest(
 		ResourceBChanges: resourceBChangesPB,
 		CurrentLinkState: currentLinkStatePB,
 		Context:          linkCtx,
+		LinkConfig:       linkConfigPB,
 	}, nil
 }
 
@@ -213,6 +222,14 @@ func (l *linkProviderClientWrapper) buildUpdateResourceRequest(
 		return nil, err
 	}
 
+	linkConfigPB, err := serialisation.ToMappingNodePB(
+		input.LinkConfig,
+		/* optional */ true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &UpdateLinkResourceRequest{
 		LinkType: &LinkType{
 			Type: core.LinkType(
@@ -228,6 +245,7 @@ func (l *linkProviderClientWrapper) buildUpdateResourceRequest(
 		UpdateType:        LinkUpdateType(input.LinkUpdateType),
 		CurrentLinkState:  currentLinkStatePB,
 		Context:           linkCtx,
+		LinkConfig:        linkConfigPB,
 	}, nil
 }
 
@@ -307,6 +325,14 @@ func (l *linkProviderClientWrapper) buildUpdateIntermediaryResourcesRequest(
 		return nil, err
 	}
 
+	linkConfigPB, err := serialisation.ToMappingNodePB(
+		input.LinkConfig,
+		/* optional */ true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &UpdateLinkIntermediaryResourcesRequest{
 		LinkType: &LinkType{
 			Type: core.LinkType(
@@ -323,6 +349,7 @@ func (l *linkProviderClientWrapper) buildUpdateIntermediaryResourcesRequest(
 		UpdateType:       LinkUpdateType(input.LinkUpdateType),
 		CurrentLinkState: currentLinkStatePB,
 		Context:          linkCtx,
+		LinkConfig:       linkConfigPB,
 	}, nil
 }
 
@@ -599,6 +626,56 @@ func (l *linkProviderClientWrapper) GetAnnotationDefinitions(
 	)
 }
 
+func (l *linkProviderClientWrapper) GetConfigDefinition(
+	ctx context.Context,
+	input *provider.LinkGetConfigDefinitionInput,
+) (*provider.LinkGetConfigDefinitionOutput, error) {
+	request, err := l.buildLinkRequest(input.LinkContext)
+	if err != nil {
+		return nil, errorsv1.CreateGeneralError(
+			err,
+			errorsv1.PluginActionProviderGetLinkConfigDefinition,
+		)
+	}
+
+	response, err := l.client.GetLinkConfigDefinition(ctx, request)
+	if err != nil {
+		return nil, errorsv1.CreateGeneralError(
+			err,
+			errorsv1.PluginActionProviderGetLinkConfigDefinition,
+		)
+	}
+
+	switch result := response.Response.(type) {
+	case *LinkConfigDefinitionResponse_ConfigDefinition:
+		schema, err := convertv1.FromPBResourceDefinitionsSchema(
+			result.ConfigDefinition.Schema,
+		)
+		if err != nil {
+			return nil, errorsv1.CreateGeneralError(
+				err,
+				errorsv1.PluginActionProviderGetLinkConfigDefinition,
+			)
+		}
+
+		return &provider.LinkGetConfigDefinitionOutput{
+			Schema: schema,
+		}, nil
+	case *LinkConfigDefinitionResponse_ErrorResponse:
+		return nil, errorsv1.CreateErrorFromResponse(
+			result.ErrorResponse,
+			errorsv1.PluginActionProviderGetLinkConfigDefinition,
+		)
+	}
+
+	return nil, errorsv1.CreateGeneralError(
+		errorsv1.ErrUnexpectedResponseType(
+			errorsv1.PluginActionProviderGetLinkConfigDefinition,
+		),
+		errorsv1.PluginActionProviderGetLinkConfigDefinition,
+	)
+}
+
 func (l *linkProviderClientWrapper) GetKind(
 	ctx context.Context,
 	input *provider.LinkGetKindInput,
@@ -2316,6 +2316,309 @@ func (*GetResourceExternalStateResponse_ResourceSpecState) isGetResourceExternal
 func (*GetResourceExternalStateResponse_ErrorResponse) isGetResourceExternalStateResponse_Response() {
 }
 
+// ListResourcesRequest is the request that contains
+// the input data needed to list existing resources of a given type
+// in the upstream provider.
+type ListResourcesRequest struct {
+	state        protoimpl.MessageState      `protogen:"open.v1"`
+	ResourceType *sharedtypesv1.ResourceType `protobuf:"bytes,1,opt,name=resource_type,json=resourceType" json:"resource_type,omitempty"`
+	// The ID of the host making the request
+	// to the provider.
+	HostId string `protobuf:"bytes,2,opt,name=host_id,json=hostId" json:"host_id,omitempty"`
+	// The user-defined name of the blueprint instance
+	// that resources are being listed for.
+	InstanceName string `protobuf:"bytes,3,opt,name=instance_name,json=instanceName" json:"instance_name,omitempty"`
+	// The logical name of the resource in the blueprint
+	// that resources are being listed for.
+	ResourceName string `protobuf:"bytes,4,opt,name=resource_name,json=resourceName" json:"resource_name,omitempty"`
+	// The maximum number of resources to return,
+	// when zero, the provider can choose a sensible default.
+	MaxResults    int32                          `protobuf:"varint,5,opt,name=max_results,json=maxResults" json:"max_results,omitempty"`
+	Context       *sharedtypesv1.ProviderContext `protobuf:"bytes,6,opt,name=context" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcesRequest) Reset() {
+	*x = ListResourcesRequest{}
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesRequest) ProtoMessage() {}
+
+func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListResourcesRequest) GetResourceType() *sharedtypesv1.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *ListResourcesRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *ListResourcesRequest) GetContext() *sharedtypesv1.ProviderContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// ListResourcesResponse is the response
+// containing existing resources in the upstream provider
+// that can be imported into a blueprint instance.
+type ListResourcesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Response:
+	//
+	//	*ListResourcesResponse_CompleteResponse
+	//	*ListResourcesResponse_ErrorResponse
+	Response      isListResourcesResponse_Response `protobuf_oneof:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcesResponse) Reset() {
+	*x = ListResourcesResponse{}
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesResponse) ProtoMessage() {}
+
+func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListResourcesResponse) GetResponse() isListResourcesResponse_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ListResourcesResponse) GetCompleteResponse() *ListResourcesCompleteResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ListResourcesResponse_CompleteResponse); ok {
+			return x.CompleteResponse
+		}
+	}
+	return nil
+}
+
+func (x *ListResourcesResponse) GetErrorResponse() *sharedtypesv1.ErrorResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ListResourcesResponse_ErrorResponse); ok {
+			return x.ErrorResponse
+		}
+	}
+	return nil
+}
+
+type isListResourcesResponse_Response interface {
+	isListResourcesResponse_Response()
+}
+
+type ListResourcesResponse_CompleteResponse struct {
+	CompleteResponse *ListResourcesCompleteResponse `protobuf:"bytes,1,opt,name=complete_response,json=completeResponse,oneof"`
+}
+
+type ListResourcesResponse_ErrorResponse struct {
+	ErrorResponse *sharedtypesv1.ErrorResponse `protobuf:"bytes,2,opt,name=error_response,json=errorResponse,oneof"`
+}
+
+func (*ListResourcesResponse_CompleteResponse) isListResourcesResponse_Response() {}
+
+func (*ListResourcesResponse_ErrorResponse) isListResourcesResponse_Response() {}
+
+// ListResourcesCompleteResponse holds the existing resources
+// listed for a resource type.
+type ListResourcesCompleteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the resource type supports listing existing resources,
+	// resources will always be empty when this is false.
+	ListingSupported bool                `protobuf:"varint,1,opt,name=listing_supported,json=listingSupported" json:"listing_supported,omitempty"`
+	Resources        []*ExternalResource `protobuf:"bytes,2,rep,name=resources" json:"resources,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListResourcesCompleteResponse) Reset() {
+	*x = ListResourcesCompleteResponse{}
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesCompleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesCompleteResponse) ProtoMessage() {}
+
+func (x *ListResourcesCompleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesCompleteResponse.ProtoReflect.Descriptor instead.
+func (*ListResourcesCompleteResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListResourcesCompleteResponse) GetListingSupported() bool {
+	if x != nil {
+		return x.ListingSupported
+	}
+	return false
+}
+
+func (x *ListResourcesCompleteResponse) GetResources() []*ExternalResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// ExternalResource describes an existing resource in the upstream provider
+// that can be imported into a blueprint instance.
+type ExternalResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The provider-specific identifier of the resource
+	// (e.g. an ARN for AWS resources).
+	ImportId string `protobuf:"bytes,1,opt,name=import_id,json=importId" json:"import_id,omitempty"`
+	// A human-friendly name for the resource.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// Additional information about the resource
+	// such as its region or creation time.
+	Description   string `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalResource) Reset() {
+	*x = ExternalResource{}
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalResource) ProtoMessage() {}
+
+func (x *ExternalResource) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalResource.ProtoReflect.Descriptor instead.
+func (*ExternalResource) Descriptor() ([]byte, []int) {
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExternalResource) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *ExternalResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExternalResource) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 // ProviderRequest is the request input
 // for general provider requests that only require
 // a host ID.
@@ -2330,7 +2633,7 @@ type ProviderRequest struct {
 
 func (x *ProviderRequest) Reset() {
 	*x = ProviderRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[24]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2342,7 +2645,7 @@ func (x *ProviderRequest) String() string {
 func (*ProviderRequest) ProtoMessage() {}
 
 func (x *ProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[24]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2355,7 +2658,7 @@ func (x *ProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderRequest.ProtoReflect.Descriptor instead.
 func (*ProviderRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{24}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ProviderRequest) GetHostId() string {
@@ -2385,7 +2688,7 @@ type ResourceRequest struct {
 
 func (x *ResourceRequest) Reset() {
 	*x = ResourceRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[25]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2397,7 +2700,7 @@ func (x *ResourceRequest) String() string {
 func (*ResourceRequest) ProtoMessage() {}
 
 func (x *ResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[25]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2410,7 +2713,7 @@ func (x *ResourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceRequest.ProtoReflect.Descriptor instead.
 func (*ResourceRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{25}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ResourceRequest) GetResourceType() *sharedtypesv1.ResourceType {
@@ -2454,7 +2757,7 @@ type DataSourceRequest struct {
 
 func (x *DataSourceRequest) Reset() {
 	*x = DataSourceRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[26]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2466,7 +2769,7 @@ func (x *DataSourceRequest) String() string {
 func (*DataSourceRequest) ProtoMessage() {}
 
 func (x *DataSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[26]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2479,7 +2782,7 @@ func (x *DataSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceRequest.ProtoReflect.Descriptor instead.
 func (*DataSourceRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{26}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DataSourceRequest) GetDataSourceType() *DataSourceType {
@@ -2523,7 +2826,7 @@ type CustomVariableTypeRequest struct {
 
 func (x *CustomVariableTypeRequest) Reset() {
 	*x = CustomVariableTypeRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[27]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2535,7 +2838,7 @@ func (x *CustomVariableTypeRequest) String() string {
 func (*CustomVariableTypeRequest) ProtoMessage() {}
 
 func (x *CustomVariableTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[27]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2548,7 +2851,7 @@ func (x *CustomVariableTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomVariableTypeRequest.ProtoReflect.Descriptor instead.
 func (*CustomVariableTypeRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{27}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *CustomVariableTypeRequest) GetCustomVariableType() *CustomVariableType {
@@ -2584,13 +2887,16 @@ type StageLinkChangesRequest struct {
 	ResourceBChanges *sharedtypesv1.Changes `protobuf:"bytes,4,opt,name=resource_b_changes,json=resourceBChanges" json:"resource_b_changes,omitempty"`
 	CurrentLinkState *LinkState             `protobuf:"bytes,5,opt,name=current_link_state,json=currentLinkState" json:"current_link_state,omitempty"`
 	Context          *LinkContext           `protobuf:"bytes,6,opt,name=context" json:"context,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Configuration for the link from the `links` section of the blueprint,
+	// this is only set for links that support configuration.
+	LinkConfig    *schemapb.MappingNode `protobuf:"bytes,7,opt,name=link_config,json=linkConfig" json:"link_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StageLinkChangesRequest) Reset() {
 	*x = StageLinkChangesRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[28]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2602,7 +2908,7 @@ func (x *StageLinkChangesRequest) String() string {
 func (*StageLinkChangesRequest) ProtoMessage() {}
 
 func (x *StageLinkChangesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[28]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2615,7 +2921,7 @@ func (x *StageLinkChangesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StageLinkChangesRequest.ProtoReflect.Descriptor instead.
 func (*StageLinkChangesRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{28}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *StageLinkChangesRequest) GetLinkType() *LinkType {
@@ -2660,6 +2966,13 @@ func (x *StageLinkChangesRequest) GetContext() *LinkContext {
 	return nil
 }
 
+func (x *StageLinkChangesRequest) GetLinkConfig() *schemapb.MappingNode {
+	if x != nil {
+		return x.LinkConfig
+	}
+	return nil
+}
+
 // StageLinkChangesResponse is the response
 // containing the result of staging changes for a link.
 type StageLinkChangesResponse struct {
@@ -2675,7 +2988,7 @@ type StageLinkChangesResponse struct {
 
 func (x *StageLinkChangesResponse) Reset() {
 	*x = StageLinkChangesResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[29]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2687,7 +3000,7 @@ func (x *StageLinkChangesResponse) String() string {
 func (*StageLinkChangesResponse) ProtoMessage() {}
 
 func (x *StageLinkChangesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[29]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2700,7 +3013,7 @@ func (x *StageLinkChangesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StageLinkChangesResponse.ProtoReflect.Descriptor instead.
 func (*StageLinkChangesResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{29}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *StageLinkChangesResponse) GetResponse() isStageLinkChangesResponse_Response {
@@ -2755,7 +3068,7 @@ type StageLinkChangesCompleteResponse struct {
 
 func (x *StageLinkChangesCompleteResponse) Reset() {
 	*x = StageLinkChangesCompleteResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[30]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2767,7 +3080,7 @@ func (x *StageLinkChangesCompleteResponse) String() string {
 func (*StageLinkChangesCompleteResponse) ProtoMessage() {}
 
 func (x *StageLinkChangesCompleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[30]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2780,7 +3093,7 @@ func (x *StageLinkChangesCompleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StageLinkChangesCompleteResponse.ProtoReflect.Descriptor instead.
 func (*StageLinkChangesCompleteResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{30}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *StageLinkChangesCompleteResponse) GetChanges() *sharedtypesv1.LinkChanges {
@@ -2805,13 +3118,16 @@ type UpdateLinkResourceRequest struct {
 	UpdateType        LinkUpdateType              `protobuf:"varint,7,opt,name=update_type,json=updateType,enum=providerserverv1.LinkUpdateType" json:"update_type,omitempty"`
 	CurrentLinkState  *LinkState                  `protobuf:"bytes,8,opt,name=current_link_state,json=currentLinkState" json:"current_link_state,omitempty"`
 	Context           *LinkContext                `protobuf:"bytes,9,opt,name=context" json:"context,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Configuration for the link from the `links` section of the blueprint,
+	// this is only set for links that support configuration.
+	LinkConfig    *schemapb.MappingNode `protobuf:"bytes,10,opt,name=link_config,json=linkConfig" json:"link_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateLinkResourceRequest) Reset() {
 	*x = UpdateLinkResourceRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[31]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2823,7 +3139,7 @@ func (x *UpdateLinkResourceRequest) String() string {
 func (*UpdateLinkResourceRequest) ProtoMessage() {}
 
 func (x *UpdateLinkResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[31]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2836,7 +3152,7 @@ func (x *UpdateLinkResourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLinkResourceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateLinkResourceRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{31}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *UpdateLinkResourceRequest) GetLinkType() *LinkType {
@@ -2902,6 +3218,13 @@ func (x *UpdateLinkResourceRequest) GetContext() *LinkContext {
 	return nil
 }
 
+func (x *UpdateLinkResourceRequest) GetLinkConfig() *schemapb.MappingNode {
+	if x != nil {
+		return x.LinkConfig
+	}
+	return nil
+}
+
 // UpdateLinkResourceResponse is the response
 // containing the result of updating a resource as a part
 // of a link.
@@ -2918,7 +3241,7 @@ type UpdateLinkResourceResponse struct {
 
 func (x *UpdateLinkResourceResponse) Reset() {
 	*x = UpdateLinkResourceResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[32]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2930,7 +3253,7 @@ func (x *UpdateLinkResourceResponse) String() string {
 func (*UpdateLinkResourceResponse) ProtoMessage() {}
 
 func (x *UpdateLinkResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[32]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2943,7 +3266,7 @@ func (x *UpdateLinkResourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLinkResourceResponse.ProtoReflect.Descriptor instead.
 func (*UpdateLinkResourceResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{32}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UpdateLinkResourceResponse) GetResponse() isUpdateLinkResourceResponse_Response {
@@ -3002,7 +3325,7 @@ type UpdateLinkResourceCompleteResponse struct {
 
 func (x *UpdateLinkResourceCompleteResponse) Reset() {
 	*x = UpdateLinkResourceCompleteResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[33]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3014,7 +3337,7 @@ func (x *UpdateLinkResourceCompleteResponse) String() string {
 func (*UpdateLinkResourceCompleteResponse) ProtoMessage() {}
 
 func (x *UpdateLinkResourceCompleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[33]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3027,7 +3350,7 @@ func (x *UpdateLinkResourceCompleteResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateLinkResourceCompleteResponse.ProtoReflect.Descriptor instead.
 func (*UpdateLinkResourceCompleteResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{33}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *UpdateLinkResourceCompleteResponse) GetLinkData() *schemapb.MappingNode {
@@ -3060,13 +3383,16 @@ type UpdateLinkIntermediaryResourcesRequest struct {
 	UpdateType       LinkUpdateType              `protobuf:"varint,8,opt,name=update_type,json=updateType,enum=providerserverv1.LinkUpdateType" json:"update_type,omitempty"`
 	CurrentLinkState *LinkState                  `protobuf:"bytes,9,opt,name=current_link_state,json=currentLinkState" json:"current_link_state,omitempty"`
 	Context          *LinkContext                `protobuf:"bytes,10,opt,name=context" json:"context,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Configuration for the link from the `links` section of the blueprint,
+	// this is only set for links that support configuration.
+	LinkConfig    *schemapb.MappingNode `protobuf:"bytes,11,opt,name=link_config,json=linkConfig" json:"link_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateLinkIntermediaryResourcesRequest) Reset() {
 	*x = UpdateLinkIntermediaryResourcesRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[34]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3078,7 +3404,7 @@ func (x *UpdateLinkIntermediaryResourcesRequest) String() string {
 func (*UpdateLinkIntermediaryResourcesRequest) ProtoMessage() {}
 
 func (x *UpdateLinkIntermediaryResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[34]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3091,7 +3417,7 @@ func (x *UpdateLinkIntermediaryResourcesRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use UpdateLinkIntermediaryResourcesRequest.ProtoReflect.Descriptor instead.
 func (*UpdateLinkIntermediaryResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{34}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *UpdateLinkIntermediaryResourcesRequest) GetLinkType() *LinkType {
@@ -3164,6 +3490,13 @@ func (x *UpdateLinkIntermediaryResourcesRequest) GetContext() *LinkContext {
 	return nil
 }
 
+func (x *UpdateLinkIntermediaryResourcesRequest) GetLinkConfig() *schemapb.MappingNode {
+	if x != nil {
+		return x.LinkConfig
+	}
+	return nil
+}
+
 // UpdateLinkIntermediaryResourcesResponse is the response
 // containing the result of updating intermediary resources for a link.
 type UpdateLinkIntermediaryResourcesResponse struct {
@@ -3179,7 +3512,7 @@ type UpdateLinkIntermediaryResourcesResponse struct {
 
 func (x *UpdateLinkIntermediaryResourcesResponse) Reset() {
 	*x = UpdateLinkIntermediaryResourcesResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[35]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3191,7 +3524,7 @@ func (x *UpdateLinkIntermediaryResourcesResponse) String() string {
 func (*UpdateLinkIntermediaryResourcesResponse) ProtoMessage() {}
 
 func (x *UpdateLinkIntermediaryResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[35]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3204,7 +3537,7 @@ func (x *UpdateLinkIntermediaryResourcesResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use UpdateLinkIntermediaryResourcesResponse.ProtoReflect.Descriptor instead.
 func (*UpdateLinkIntermediaryResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{35}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *UpdateLinkIntermediaryResourcesResponse) GetResponse() isUpdateLinkIntermediaryResourcesResponse_Response {
@@ -3263,7 +3596,7 @@ type UpdateLinkIntermediaryResourcesCompleteResponse struct {
 
 func (x *UpdateLinkIntermediaryResourcesCompleteResponse) Reset() {
 	*x = UpdateLinkIntermediaryResourcesCompleteResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[36]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3275,7 +3608,7 @@ func (x *UpdateLinkIntermediaryResourcesCompleteResponse) String() string {
 func (*UpdateLinkIntermediaryResourcesCompleteResponse) ProtoMessage() {}
 
 func (x *UpdateLinkIntermediaryResourcesCompleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[36]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3288,7 +3621,7 @@ func (x *UpdateLinkIntermediaryResourcesCompleteResponse) ProtoReflect() protore
 
 // Deprecated: Use UpdateLinkIntermediaryResourcesCompleteResponse.ProtoReflect.Descriptor instead.
 func (*UpdateLinkIntermediaryResourcesCompleteResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{36}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *UpdateLinkIntermediaryResourcesCompleteResponse) GetIntermediaryResourceStates() []*LinkIntermediaryResourceState {
@@ -3327,7 +3660,7 @@ type LinkPriorityResourceResponse struct {
 
 func (x *LinkPriorityResourceResponse) Reset() {
 	*x = LinkPriorityResourceResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[37]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3339,7 +3672,7 @@ func (x *LinkPriorityResourceResponse) String() string {
 func (*LinkPriorityResourceResponse) ProtoMessage() {}
 
 func (x *LinkPriorityResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[37]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3352,7 +3685,7 @@ func (x *LinkPriorityResourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkPriorityResourceResponse.ProtoReflect.Descriptor instead.
 func (*LinkPriorityResourceResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{37}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *LinkPriorityResourceResponse) GetResponse() isLinkPriorityResourceResponse_Response {
@@ -3412,7 +3745,7 @@ type CustomValidateDataSourceRequest struct {
 
 func (x *CustomValidateDataSourceRequest) Reset() {
 	*x = CustomValidateDataSourceRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[38]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3424,7 +3757,7 @@ func (x *CustomValidateDataSourceRequest) String() string {
 func (*CustomValidateDataSourceRequest) ProtoMessage() {}
 
 func (x *CustomValidateDataSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[38]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3437,7 +3770,7 @@ func (x *CustomValidateDataSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomValidateDataSourceRequest.ProtoReflect.Descriptor instead.
 func (*CustomValidateDataSourceRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{38}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CustomValidateDataSourceRequest) GetDataSourceType() *DataSourceType {
@@ -3483,7 +3816,7 @@ type CustomValidateDataSourceResponse struct {
 
 func (x *CustomValidateDataSourceResponse) Reset() {
 	*x = CustomValidateDataSourceResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[39]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3495,7 +3828,7 @@ func (x *CustomValidateDataSourceResponse) String() string {
 func (*CustomValidateDataSourceResponse) ProtoMessage() {}
 
 func (x *CustomValidateDataSourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[39]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3508,7 +3841,7 @@ func (x *CustomValidateDataSourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomValidateDataSourceResponse.ProtoReflect.Descriptor instead.
 func (*CustomValidateDataSourceResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{39}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *CustomValidateDataSourceResponse) GetResponse() isCustomValidateDataSourceResponse_Response {
@@ -3565,7 +3898,7 @@ type CustomValidateDataSourceCompleteResponse struct {
 
 func (x *CustomValidateDataSourceCompleteResponse) Reset() {
 	*x = CustomValidateDataSourceCompleteResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[40]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3577,7 +3910,7 @@ func (x *CustomValidateDataSourceCompleteResponse) String() string {
 func (*CustomValidateDataSourceCompleteResponse) ProtoMessage() {}
 
 func (x *CustomValidateDataSourceCompleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[40]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3590,7 +3923,7 @@ func (x *CustomValidateDataSourceCompleteResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use CustomValidateDataSourceCompleteResponse.ProtoReflect.Descriptor instead.
 func (*CustomValidateDataSourceCompleteResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{40}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CustomValidateDataSourceCompleteResponse) GetDiagnostics() []*sharedtypesv1.Diagnostic {
@@ -3615,7 +3948,7 @@ type DataSourceSpecDefinitionResponse struct {
 
 func (x *DataSourceSpecDefinitionResponse) Reset() {
 	*x = DataSourceSpecDefinitionResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[41]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3627,7 +3960,7 @@ func (x *DataSourceSpecDefinitionResponse) String() string {
 func (*DataSourceSpecDefinitionResponse) ProtoMessage() {}
 
 func (x *DataSourceSpecDefinitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[41]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3640,7 +3973,7 @@ func (x *DataSourceSpecDefinitionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceSpecDefinitionResponse.ProtoReflect.Descriptor instead.
 func (*DataSourceSpecDefinitionResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{41}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *DataSourceSpecDefinitionResponse) GetResponse() isDataSourceSpecDefinitionResponse_Response {
@@ -3701,7 +4034,7 @@ type DataSourceFilterFieldsResponse struct {
 
 func (x *DataSourceFilterFieldsResponse) Reset() {
 	*x = DataSourceFilterFieldsResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[42]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3713,7 +4046,7 @@ func (x *DataSourceFilterFieldsResponse) String() string {
 func (*DataSourceFilterFieldsResponse) ProtoMessage() {}
 
 func (x *DataSourceFilterFieldsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[42]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3726,7 +4059,7 @@ func (x *DataSourceFilterFieldsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceFilterFieldsResponse.ProtoReflect.Descriptor instead.
 func (*DataSourceFilterFieldsResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{42}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *DataSourceFilterFieldsResponse) GetResponse() isDataSourceFilterFieldsResponse_Response {
@@ -3788,7 +4121,7 @@ type FetchDataSourceRequest struct {
 
 func (x *FetchDataSourceRequest) Reset() {
 	*x = FetchDataSourceRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[43]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3800,7 +4133,7 @@ func (x *FetchDataSourceRequest) String() string {
 func (*FetchDataSourceRequest) ProtoMessage() {}
 
 func (x *FetchDataSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[43]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3813,7 +4146,7 @@ func (x *FetchDataSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchDataSourceRequest.ProtoReflect.Descriptor instead.
 func (*FetchDataSourceRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{43}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *FetchDataSourceRequest) GetDataSourceType() *DataSourceType {
@@ -3859,7 +4192,7 @@ type FetchDataSourceResponse struct {
 
 func (x *FetchDataSourceResponse) Reset() {
 	*x = FetchDataSourceResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[44]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3871,7 +4204,7 @@ func (x *FetchDataSourceResponse) String() string {
 func (*FetchDataSourceResponse) ProtoMessage() {}
 
 func (x *FetchDataSourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[44]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3884,7 +4217,7 @@ func (x *FetchDataSourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchDataSourceResponse.ProtoReflect.Descriptor instead.
 func (*FetchDataSourceResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{44}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *FetchDataSourceResponse) GetResponse() isFetchDataSourceResponse_Response {
@@ -3943,7 +4276,7 @@ type CustomVariableTypeOptionsResponse struct {
 
 func (x *CustomVariableTypeOptionsResponse) Reset() {
 	*x = CustomVariableTypeOptionsResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[45]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3955,7 +4288,7 @@ func (x *CustomVariableTypeOptionsResponse) String() string {
 func (*CustomVariableTypeOptionsResponse) ProtoMessage() {}
 
 func (x *CustomVariableTypeOptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[45]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3968,7 +4301,7 @@ func (x *CustomVariableTypeOptionsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CustomVariableTypeOptionsResponse.ProtoReflect.Descriptor instead.
 func (*CustomVariableTypeOptionsResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{45}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *CustomVariableTypeOptionsResponse) GetResponse() isCustomVariableTypeOptionsResponse_Response {
@@ -4023,7 +4356,7 @@ type CustomVariableTypeOptions struct {
 
 func (x *CustomVariableTypeOptions) Reset() {
 	*x = CustomVariableTypeOptions{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[46]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4035,7 +4368,7 @@ func (x *CustomVariableTypeOptions) String() string {
 func (*CustomVariableTypeOptions) ProtoMessage() {}
 
 func (x *CustomVariableTypeOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[46]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4048,7 +4381,7 @@ func (x *CustomVariableTypeOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomVariableTypeOptions.ProtoReflect.Descriptor instead.
 func (*CustomVariableTypeOptions) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{46}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *CustomVariableTypeOptions) GetOptions() map[string]*CustomVariableTypeOption {
@@ -4077,7 +4410,7 @@ type CustomVariableTypeOption struct {
 
 func (x *CustomVariableTypeOption) Reset() {
 	*x = CustomVariableTypeOption{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[47]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4089,7 +4422,7 @@ func (x *CustomVariableTypeOption) String() string {
 func (*CustomVariableTypeOption) ProtoMessage() {}
 
 func (x *CustomVariableTypeOption) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[47]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4102,7 +4435,7 @@ func (x *CustomVariableTypeOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomVariableTypeOption.ProtoReflect.Descriptor instead.
 func (*CustomVariableTypeOption) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{47}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *CustomVariableTypeOption) GetValue() *schemapb.ScalarValue {
@@ -4148,7 +4481,7 @@ type CustomVariableTypeResponse struct {
 
 func (x *CustomVariableTypeResponse) Reset() {
 	*x = CustomVariableTypeResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[48]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4160,7 +4493,7 @@ func (x *CustomVariableTypeResponse) String() string {
 func (*CustomVariableTypeResponse) ProtoMessage() {}
 
 func (x *CustomVariableTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[48]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4173,7 +4506,7 @@ func (x *CustomVariableTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomVariableTypeResponse.ProtoReflect.Descriptor instead.
 func (*CustomVariableTypeResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{48}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *CustomVariableTypeResponse) GetResponse() isCustomVariableTypeResponse_Response {
@@ -4231,7 +4564,7 @@ type CustomVariableTypeInfo struct {
 
 func (x *CustomVariableTypeInfo) Reset() {
 	*x = CustomVariableTypeInfo{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[49]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4243,7 +4576,7 @@ func (x *CustomVariableTypeInfo) String() string {
 func (*CustomVariableTypeInfo) ProtoMessage() {}
 
 func (x *CustomVariableTypeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[49]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4256,7 +4589,7 @@ func (x *CustomVariableTypeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomVariableTypeInfo.ProtoReflect.Descriptor instead.
 func (*CustomVariableTypeInfo) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{49}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *CustomVariableTypeInfo) GetType() *CustomVariableType {
@@ -4285,7 +4618,7 @@ type FetchDataSourceCompleteResponse struct {
 
 func (x *FetchDataSourceCompleteResponse) Reset() {
 	*x = FetchDataSourceCompleteResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[50]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4297,7 +4630,7 @@ func (x *FetchDataSourceCompleteResponse) String() string {
 func (*FetchDataSourceCompleteResponse) ProtoMessage() {}
 
 func (x *FetchDataSourceCompleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[50]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4310,7 +4643,7 @@ func (x *FetchDataSourceCompleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchDataSourceCompleteResponse.ProtoReflect.Descriptor instead.
 func (*FetchDataSourceCompleteResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{50}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *FetchDataSourceCompleteResponse) GetData() map[string]*schemapb.MappingNode {
@@ -4335,7 +4668,7 @@ type ResolvedDataSource struct {
 
 func (x *ResolvedDataSource) Reset() {
 	*x = ResolvedDataSource{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[51]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4347,7 +4680,7 @@ func (x *ResolvedDataSource) String() string {
 func (*ResolvedDataSource) ProtoMessage() {}
 
 func (x *ResolvedDataSource) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[51]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4360,7 +4693,7 @@ func (x *ResolvedDataSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedDataSource.ProtoReflect.Descriptor instead.
 func (*ResolvedDataSource) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{51}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ResolvedDataSource) GetType() *DataSourceType {
@@ -4411,7 +4744,7 @@ type ResolvedDataSourceMetadata struct {
 
 func (x *ResolvedDataSourceMetadata) Reset() {
 	*x = ResolvedDataSourceMetadata{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[52]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4423,7 +4756,7 @@ func (x *ResolvedDataSourceMetadata) String() string {
 func (*ResolvedDataSourceMetadata) ProtoMessage() {}
 
 func (x *ResolvedDataSourceMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[52]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4436,7 +4769,7 @@ func (x *ResolvedDataSourceMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedDataSourceMetadata.ProtoReflect.Descriptor instead.
 func (*ResolvedDataSourceMetadata) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{52}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ResolvedDataSourceMetadata) GetDisplayName() *schemapb.MappingNode {
@@ -4473,7 +4806,7 @@ type ResolvedDataSourceFilter struct {
 
 func (x *ResolvedDataSourceFilter) Reset() {
 	*x = ResolvedDataSourceFilter{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[53]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4485,7 +4818,7 @@ func (x *ResolvedDataSourceFilter) String() string {
 func (*ResolvedDataSourceFilter) ProtoMessage() {}
 
 func (x *ResolvedDataSourceFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[53]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4498,7 +4831,7 @@ func (x *ResolvedDataSourceFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedDataSourceFilter.ProtoReflect.Descriptor instead.
 func (*ResolvedDataSourceFilter) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{53}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ResolvedDataSourceFilter) GetField() *schemapb.ScalarValue {
@@ -4533,7 +4866,7 @@ type ResolvedDataSourceFilterSearch struct {
 
 func (x *ResolvedDataSourceFilterSearch) Reset() {
 	*x = ResolvedDataSourceFilterSearch{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[54]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4545,7 +4878,7 @@ func (x *ResolvedDataSourceFilterSearch) String() string {
 func (*ResolvedDataSourceFilterSearch) ProtoMessage() {}
 
 func (x *ResolvedDataSourceFilterSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[54]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4558,7 +4891,7 @@ func (x *ResolvedDataSourceFilterSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedDataSourceFilterSearch.ProtoReflect.Descriptor instead.
 func (*ResolvedDataSourceFilterSearch) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{54}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ResolvedDataSourceFilterSearch) GetValues() []*schemapb.MappingNode {
@@ -4579,7 +4912,7 @@ type DataSourceFilterFields struct {
 
 func (x *DataSourceFilterFields) Reset() {
 	*x = DataSourceFilterFields{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[55]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4591,7 +4924,7 @@ func (x *DataSourceFilterFields) String() string {
 func (*DataSourceFilterFields) ProtoMessage() {}
 
 func (x *DataSourceFilterFields) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[55]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4604,7 +4937,7 @@ func (x *DataSourceFilterFields) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceFilterFields.ProtoReflect.Descriptor instead.
 func (*DataSourceFilterFields) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{55}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *DataSourceFilterFields) GetFilterFields() map[string]*DataSourceFilterFieldSchema {
@@ -4628,7 +4961,7 @@ type DataSourceFilterFieldSchema struct {
 
 func (x *DataSourceFilterFieldSchema) Reset() {
 	*x = DataSourceFilterFieldSchema{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[56]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4640,7 +4973,7 @@ func (x *DataSourceFilterFieldSchema) String() string {
 func (*DataSourceFilterFieldSchema) ProtoMessage() {}
 
 func (x *DataSourceFilterFieldSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[56]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4653,7 +4986,7 @@ func (x *DataSourceFilterFieldSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceFilterFieldSchema.ProtoReflect.Descriptor instead.
 func (*DataSourceFilterFieldSchema) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{56}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *DataSourceFilterFieldSchema) GetType() string {
@@ -4704,7 +5037,7 @@ type ResolvedDataSourceFieldExport struct {
 
 func (x *ResolvedDataSourceFieldExport) Reset() {
 	*x = ResolvedDataSourceFieldExport{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[57]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4716,7 +5049,7 @@ func (x *ResolvedDataSourceFieldExport) String() string {
 func (*ResolvedDataSourceFieldExport) ProtoMessage() {}
 
 func (x *ResolvedDataSourceFieldExport) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[57]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4729,7 +5062,7 @@ func (x *ResolvedDataSourceFieldExport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedDataSourceFieldExport.ProtoReflect.Descriptor instead.
 func (*ResolvedDataSourceFieldExport) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{57}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ResolvedDataSourceFieldExport) GetType() string {
@@ -4768,7 +5101,7 @@ type DataSourceSpecDefinition struct {
 
 func (x *DataSourceSpecDefinition) Reset() {
 	*x = DataSourceSpecDefinition{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[58]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4780,7 +5113,7 @@ func (x *DataSourceSpecDefinition) String() string {
 func (*DataSourceSpecDefinition) ProtoMessage() {}
 
 func (x *DataSourceSpecDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[58]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4793,7 +5126,7 @@ func (x *DataSourceSpecDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceSpecDefinition.ProtoReflect.Descriptor instead.
 func (*DataSourceSpecDefinition) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{58}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *DataSourceSpecDefinition) GetFields() map[string]*DataSourceSpecSchema {
@@ -4834,7 +5167,7 @@ type DataSourceSpecSchema struct {
 
 func (x *DataSourceSpecSchema) Reset() {
 	*x = DataSourceSpecSchema{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[59]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4846,7 +5179,7 @@ func (x *DataSourceSpecSchema) String() string {
 func (*DataSourceSpecSchema) ProtoMessage() {}
 
 func (x *DataSourceSpecSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[59]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4859,7 +5192,7 @@ func (x *DataSourceSpecSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceSpecSchema.ProtoReflect.Descriptor instead.
 func (*DataSourceSpecSchema) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{59}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *DataSourceSpecSchema) GetType() DataSourceSpecSchemaType {
@@ -4926,7 +5259,7 @@ type DataSourceTypeResponse struct {
 
 func (x *DataSourceTypeResponse) Reset() {
 	*x = DataSourceTypeResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[60]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4938,7 +5271,7 @@ func (x *DataSourceTypeResponse) String() string {
 func (*DataSourceTypeResponse) ProtoMessage() {}
 
 func (x *DataSourceTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[60]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4951,7 +5284,7 @@ func (x *DataSourceTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceTypeResponse.ProtoReflect.Descriptor instead.
 func (*DataSourceTypeResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{60}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *DataSourceTypeResponse) GetResponse() isDataSourceTypeResponse_Response {
@@ -5009,7 +5342,7 @@ type DataSourceTypeInfo struct {
 
 func (x *DataSourceTypeInfo) Reset() {
 	*x = DataSourceTypeInfo{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[61]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5021,7 +5354,7 @@ func (x *DataSourceTypeInfo) String() string {
 func (*DataSourceTypeInfo) ProtoMessage() {}
 
 func (x *DataSourceTypeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[61]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5034,7 +5367,7 @@ func (x *DataSourceTypeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceTypeInfo.ProtoReflect.Descriptor instead.
 func (*DataSourceTypeInfo) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{61}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *DataSourceTypeInfo) GetType() *DataSourceType {
@@ -5051,6 +5384,137 @@ func (x *DataSourceTypeInfo) GetLabel() string {
 	return ""
 }
 
+// LinkConfigDefinitionResponse is the response
+// containing the schema for blueprint-level configuration of a link.
+type LinkConfigDefinitionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Response:
+	//
+	//	*LinkConfigDefinitionResponse_ConfigDefinition
+	//	*LinkConfigDefinitionResponse_ErrorResponse
+	Response      isLinkConfigDefinitionResponse_Response `protobuf_oneof:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkConfigDefinitionResponse) Reset() {
+	*x = LinkConfigDefinitionResponse{}
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkConfigDefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkConfigDefinitionResponse) ProtoMessage() {}
+
+func (x *LinkConfigDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkConfigDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*LinkConfigDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LinkConfigDefinitionResponse) GetResponse() isLinkConfigDefinitionResponse_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *LinkConfigDefinitionResponse) GetConfigDefinition() *LinkConfigDefinition {
+	if x != nil {
+		if x, ok := x.Response.(*LinkConfigDefinitionResponse_ConfigDefinition); ok {
+			return x.ConfigDefinition
+		}
+	}
+	return nil
+}
+
+func (x *LinkConfigDefinitionResponse) GetErrorResponse() *sharedtypesv1.ErrorResponse {
+	if x != nil {
+		if x, ok := x.Response.(*LinkConfigDefinitionResponse_ErrorResponse); ok {
+			return x.ErrorResponse
+		}
+	}
+	return nil
+}
+
+type isLinkConfigDefinitionResponse_Response interface {
+	isLinkConfigDefinitionResponse_Response()
+}
+
+type LinkConfigDefinitionResponse_ConfigDefinition struct {
+	ConfigDefinition *LinkConfigDefinition `protobuf:"bytes,1,opt,name=config_definition,json=configDefinition,oneof"`
+}
+
+type LinkConfigDefinitionResponse_ErrorResponse struct {
+	ErrorResponse *sharedtypesv1.ErrorResponse `protobuf:"bytes,2,opt,name=error_response,json=errorResponse,oneof"`
+}
+
+func (*LinkConfigDefinitionResponse_ConfigDefinition) isLinkConfigDefinitionResponse_Response() {}
+
+func (*LinkConfigDefinitionResponse_ErrorResponse) isLinkConfigDefinitionResponse_Response() {}
+
+// LinkConfigDefinition holds the schema for blueprint-level
+// configuration of a link.
+// The schema is not set for links that do not support configuration.
+type LinkConfigDefinition struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Schema        *sharedtypesv1.ResourceDefinitionsSchema `protobuf:"bytes,1,opt,name=schema" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkConfigDefinition) Reset() {
+	*x = LinkConfigDefinition{}
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkConfigDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkConfigDefinition) ProtoMessage() {}
+
+func (x *LinkConfigDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkConfigDefinition.ProtoReflect.Descriptor instead.
+func (*LinkConfigDefinition) Descriptor() ([]byte, []int) {
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LinkConfigDefinition) GetSchema() *sharedtypesv1.ResourceDefinitionsSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
 // LinkPriorityResourceInfo contains information about the priority resource
 // for a link.
 type LinkPriorityResourceInfo struct {
@@ -5063,7 +5527,7 @@ type LinkPriorityResourceInfo struct {
 
 func (x *LinkPriorityResourceInfo) Reset() {
 	*x = LinkPriorityResourceInfo{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[62]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5075,7 +5539,7 @@ func (x *LinkPriorityResourceInfo) String() string {
 func (*LinkPriorityResourceInfo) ProtoMessage() {}
 
 func (x *LinkPriorityResourceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[62]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5088,7 +5552,7 @@ func (x *LinkPriorityResourceInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkPriorityResourceInfo.ProtoReflect.Descriptor instead.
 func (*LinkPriorityResourceInfo) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{62}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *LinkPriorityResourceInfo) GetPriorityResource() LinkPriorityResource {
@@ -5120,7 +5584,7 @@ type LinkKindResponse struct {
 
 func (x *LinkKindResponse) Reset() {
 	*x = LinkKindResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[63]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5132,7 +5596,7 @@ func (x *LinkKindResponse) String() string {
 func (*LinkKindResponse) ProtoMessage() {}
 
 func (x *LinkKindResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[63]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5145,7 +5609,7 @@ func (x *LinkKindResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkKindResponse.ProtoReflect.Descriptor instead.
 func (*LinkKindResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{63}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *LinkKindResponse) GetResponse() isLinkKindResponse_Response {
@@ -5199,7 +5663,7 @@ type LinkKindInfo struct {
 
 func (x *LinkKindInfo) Reset() {
 	*x = LinkKindInfo{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[64]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5211,7 +5675,7 @@ func (x *LinkKindInfo) String() string {
 func (*LinkKindInfo) ProtoMessage() {}
 
 func (x *LinkKindInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[64]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5224,7 +5688,7 @@ func (x *LinkKindInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkKindInfo.ProtoReflect.Descriptor instead.
 func (*LinkKindInfo) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{64}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *LinkKindInfo) GetKind() LinkKind {
@@ -5278,7 +5742,7 @@ type LinkState struct {
 
 func (x *LinkState) Reset() {
 	*x = LinkState{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[65]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5290,7 +5754,7 @@ func (x *LinkState) String() string {
 func (*LinkState) ProtoMessage() {}
 
 func (x *LinkState) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[65]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5303,7 +5767,7 @@ func (x *LinkState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkState.ProtoReflect.Descriptor instead.
 func (*LinkState) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{65}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *LinkState) GetId() string {
@@ -5417,7 +5881,7 @@ type LinkIntermediaryResourceState struct {
 
 func (x *LinkIntermediaryResourceState) Reset() {
 	*x = LinkIntermediaryResourceState{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[66]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5429,7 +5893,7 @@ func (x *LinkIntermediaryResourceState) String() string {
 func (*LinkIntermediaryResourceState) ProtoMessage() {}
 
 func (x *LinkIntermediaryResourceState) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[66]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5442,7 +5906,7 @@ func (x *LinkIntermediaryResourceState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkIntermediaryResourceState.ProtoReflect.Descriptor instead.
 func (*LinkIntermediaryResourceState) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{66}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *LinkIntermediaryResourceState) GetResourceId() string {
@@ -5530,7 +5994,7 @@ type LinkCompletionDurations struct {
 
 func (x *LinkCompletionDurations) Reset() {
 	*x = LinkCompletionDurations{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[67]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5542,7 +6006,7 @@ func (x *LinkCompletionDurations) String() string {
 func (*LinkCompletionDurations) ProtoMessage() {}
 
 func (x *LinkCompletionDurations) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[67]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5555,7 +6019,7 @@ func (x *LinkCompletionDurations) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkCompletionDurations.ProtoReflect.Descriptor instead.
 func (*LinkCompletionDurations) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{67}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *LinkCompletionDurations) GetResourceAUpdate() *LinkComponentCompletionDurations {
@@ -5602,7 +6066,7 @@ type LinkComponentCompletionDurations struct {
 
 func (x *LinkComponentCompletionDurations) Reset() {
 	*x = LinkComponentCompletionDurations{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[68]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5614,7 +6078,7 @@ func (x *LinkComponentCompletionDurations) String() string {
 func (*LinkComponentCompletionDurations) ProtoMessage() {}
 
 func (x *LinkComponentCompletionDurations) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[68]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5627,7 +6091,7 @@ func (x *LinkComponentCompletionDurations) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkComponentCompletionDurations.ProtoReflect.Descriptor instead.
 func (*LinkComponentCompletionDurations) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{68}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *LinkComponentCompletionDurations) GetTotalDuration() *wrapperspb.DoubleValue {
@@ -5663,7 +6127,7 @@ type LinkRequest struct {
 
 func (x *LinkRequest) Reset() {
 	*x = LinkRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[69]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5675,7 +6139,7 @@ func (x *LinkRequest) String() string {
 func (*LinkRequest) ProtoMessage() {}
 
 func (x *LinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[69]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5688,7 +6152,7 @@ func (x *LinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkRequest.ProtoReflect.Descriptor instead.
 func (*LinkRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{69}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *LinkRequest) GetLinkType() *LinkType {
@@ -5746,7 +6210,7 @@ type GetLinkIntermediaryExternalStateRequest struct {
 
 func (x *GetLinkIntermediaryExternalStateRequest) Reset() {
 	*x = GetLinkIntermediaryExternalStateRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[70]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5758,7 +6222,7 @@ func (x *GetLinkIntermediaryExternalStateRequest) String() string {
 func (*GetLinkIntermediaryExternalStateRequest) ProtoMessage() {}
 
 func (x *GetLinkIntermediaryExternalStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[70]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5771,7 +6235,7 @@ func (x *GetLinkIntermediaryExternalStateRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use GetLinkIntermediaryExternalStateRequest.ProtoReflect.Descriptor instead.
 func (*GetLinkIntermediaryExternalStateRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{70}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetLinkIntermediaryExternalStateRequest) GetLinkType() *LinkType {
@@ -5866,7 +6330,7 @@ type GetLinkIntermediaryExternalStateResponse struct {
 
 func (x *GetLinkIntermediaryExternalStateResponse) Reset() {
 	*x = GetLinkIntermediaryExternalStateResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[71]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5878,7 +6342,7 @@ func (x *GetLinkIntermediaryExternalStateResponse) String() string {
 func (*GetLinkIntermediaryExternalStateResponse) ProtoMessage() {}
 
 func (x *GetLinkIntermediaryExternalStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[71]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5891,7 +6355,7 @@ func (x *GetLinkIntermediaryExternalStateResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use GetLinkIntermediaryExternalStateResponse.ProtoReflect.Descriptor instead.
 func (*GetLinkIntermediaryExternalStateResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{71}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *GetLinkIntermediaryExternalStateResponse) GetResponse() isGetLinkIntermediaryExternalStateResponse_Response {
@@ -5949,7 +6413,7 @@ type GetLinkIntermediaryExternalStateCompleteResponse struct {
 
 func (x *GetLinkIntermediaryExternalStateCompleteResponse) Reset() {
 	*x = GetLinkIntermediaryExternalStateCompleteResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[72]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5961,7 +6425,7 @@ func (x *GetLinkIntermediaryExternalStateCompleteResponse) String() string {
 func (*GetLinkIntermediaryExternalStateCompleteResponse) ProtoMessage() {}
 
 func (x *GetLinkIntermediaryExternalStateCompleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[72]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5974,7 +6438,7 @@ func (x *GetLinkIntermediaryExternalStateCompleteResponse) ProtoReflect() protor
 
 // Deprecated: Use GetLinkIntermediaryExternalStateCompleteResponse.ProtoReflect.Descriptor instead.
 func (*GetLinkIntermediaryExternalStateCompleteResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{72}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *GetLinkIntermediaryExternalStateCompleteResponse) GetIntermediaryStates() map[string]*IntermediaryExternalState {
@@ -6012,7 +6476,7 @@ type ValidateLinkRequest struct {
 
 func (x *ValidateLinkRequest) Reset() {
 	*x = ValidateLinkRequest{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[73]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6024,7 +6488,7 @@ func (x *ValidateLinkRequest) String() string {
 func (*ValidateLinkRequest) ProtoMessage() {}
 
 func (x *ValidateLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[73]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6037,7 +6501,7 @@ func (x *ValidateLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateLinkRequest.ProtoReflect.Descriptor instead.
 func (*ValidateLinkRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{73}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ValidateLinkRequest) GetResourceASpec() *schemapb.MappingNode {
@@ -6117,7 +6581,7 @@ type ValidateLinkResponse struct {
 
 func (x *ValidateLinkResponse) Reset() {
 	*x = ValidateLinkResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[74]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6129,7 +6593,7 @@ func (x *ValidateLinkResponse) String() string {
 func (*ValidateLinkResponse) ProtoMessage() {}
 
 func (x *ValidateLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[74]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6142,7 +6606,7 @@ func (x *ValidateLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateLinkResponse.ProtoReflect.Descriptor instead.
 func (*ValidateLinkResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{74}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *ValidateLinkResponse) GetResponse() isValidateLinkResponse_Response {
@@ -6197,7 +6661,7 @@ type ValidateLinkCompleteResponse struct {
 
 func (x *ValidateLinkCompleteResponse) Reset() {
 	*x = ValidateLinkCompleteResponse{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[75]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6209,7 +6673,7 @@ func (x *ValidateLinkCompleteResponse) String() string {
 func (*ValidateLinkCompleteResponse) ProtoMessage() {}
 
 func (x *ValidateLinkCompleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[75]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6222,7 +6686,7 @@ func (x *ValidateLinkCompleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateLinkCompleteResponse.ProtoReflect.Descriptor instead.
 func (*ValidateLinkCompleteResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{75}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *ValidateLinkCompleteResponse) GetDiagnostics() []*sharedtypesv1.Diagnostic {
@@ -6250,7 +6714,7 @@ type IntermediaryExternalState struct {
 
 func (x *IntermediaryExternalState) Reset() {
 	*x = IntermediaryExternalState{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[76]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6262,7 +6726,7 @@ func (x *IntermediaryExternalState) String() string {
 func (*IntermediaryExternalState) ProtoMessage() {}
 
 func (x *IntermediaryExternalState) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[76]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6275,7 +6739,7 @@ func (x *IntermediaryExternalState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntermediaryExternalState.ProtoReflect.Descriptor instead.
 func (*IntermediaryExternalState) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{76}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *IntermediaryExternalState) GetResourceId() string {
@@ -6324,7 +6788,7 @@ type LinkContext struct {
 
 func (x *LinkContext) Reset() {
 	*x = LinkContext{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[77]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6336,7 +6800,7 @@ func (x *LinkContext) String() string {
 func (*LinkContext) ProtoMessage() {}
 
 func (x *LinkContext) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[77]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6349,7 +6813,7 @@ func (x *LinkContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkContext.ProtoReflect.Descriptor instead.
 func (*LinkContext) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{77}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *LinkContext) GetProviderConfigVariables() map[string]*schemapb.ScalarValue {
@@ -6376,7 +6840,7 @@ type DataSourceType struct {
 
 func (x *DataSourceType) Reset() {
 	*x = DataSourceType{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[78]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6388,7 +6852,7 @@ func (x *DataSourceType) String() string {
 func (*DataSourceType) ProtoMessage() {}
 
 func (x *DataSourceType) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[78]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6401,7 +6865,7 @@ func (x *DataSourceType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceType.ProtoReflect.Descriptor instead.
 func (*DataSourceType) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{78}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *DataSourceType) GetType() string {
@@ -6422,7 +6886,7 @@ type CustomVariableType struct {
 
 func (x *CustomVariableType) Reset() {
 	*x = CustomVariableType{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[79]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6434,7 +6898,7 @@ func (x *CustomVariableType) String() string {
 func (*CustomVariableType) ProtoMessage() {}
 
 func (x *CustomVariableType) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[79]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6447,7 +6911,7 @@ func (x *CustomVariableType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomVariableType.ProtoReflect.Descriptor instead.
 func (*CustomVariableType) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{79}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *CustomVariableType) GetType() string {
@@ -6469,7 +6933,7 @@ type LinkType struct {
 
 func (x *LinkType) Reset() {
 	*x = LinkType{}
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[80]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6481,7 +6945,7 @@ func (x *LinkType) String() string {
 func (*LinkType) ProtoMessage() {}
 
 func (x *LinkType) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[80]
+	mi := &file_plugin_framework_providerserverv1_provider_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6494,7 +6958,7 @@ func (x *LinkType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkType.ProtoReflect.Descriptor instead.
 func (*LinkType) Descriptor() ([]byte, []int) {
-	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{80}
+	return file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *LinkType) GetType() string {
@@ -6765,194 +7229,250 @@ var file_plugin_framework_providerserverv1_provider_proto_rawDesc = string([]byt
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
 	0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x11,
 	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64,
-	0x22, 0xa6, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
-	0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31,
-	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x11, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x4a, 0x0a, 0x10, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x64, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68,
-	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xc6,
-	0x01, 0x0a, 0x19, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x14,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x12, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x65, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xca, 0x01,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1d, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x09, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x10, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xa6,
+	0x01, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a,
 	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
 	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x50,
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xfb, 0x02, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x67,
-	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07,
-	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
-	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76,
-	0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x41, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x12, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52,
-	0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x73, 0x12, 0x49, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6e,
-	0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c,
-	0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x61, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x20, 0x53, 0x74, 0x61, 0x67,
-	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x73, 0x22, 0x9e, 0x04, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e,
-	0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x37, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a,
+	0x10, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73,
 	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4b, 0x0a, 0x13, 0x6f, 0x74,
-	0x68, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x49, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xc6, 0x01, 0x0a,
+	0x19, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x14, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x12,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xb1, 0x03, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x61, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x41, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x12, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x10, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
 	0x49, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72,
 	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c,
 	0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
 	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72,
 	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c,
 	0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x63, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x65, 0x78, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x6c,
+	0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xd0, 0x01, 0x0a, 0x18, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76,
+	0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x20,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xd4, 0x04, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4b,
+	0x0a, 0x13, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x41, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x10, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xd4, 0x01,
+	0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x84, 0x01,
+	0x0a, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x73, 0x1a, 0x47, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf5, 0x04,
+	0x0a, 0x26, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x43, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
 	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
-	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x22, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x30, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x34, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0b,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x84, 0x01, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x47, 0x0a, 0x19, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xbf, 0x04, 0x0a, 0x26, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37,
-	0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c,
-	0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x41, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x62, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69,
-	0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e,
-	0x6b, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41,
-	0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x49, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6e,
-	0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x27, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xee, 0x01, 0x0a, 0x27, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79,
 	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x70, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65,
@@ -7317,793 +7837,824 @@ var file_plugin_framework_providerserverv1_provider_proto_rawDesc = string([]byt
 	0x20, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
 	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
 	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xc2, 0x01,
-	0x0a, 0x18, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x53, 0x0a, 0x11, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x10, 0x70,
-	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x51, 0x0a, 0x16, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x14, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
-	0x6b, 0x69, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x48,
-	0x00, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x3e, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
-	0x6e, 0x64, 0x22, 0xfc, 0x05, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x70,
-	0x72, 0x65, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x65, 0x4c, 0x69,
-	0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x6c,
-	0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x41, 0x0a, 0x1d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f,
-	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x71, 0x0a, 0x1c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xc8, 0x01,
+	0x0a, 0x1c, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55,
+	0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x76,
 	0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e,
-	0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x1a, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c,
-	0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x09, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x1a, 0x4c, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xf1, 0x03, 0x0a, 0x1d, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x14, 0x4c, 0x69, 0x6e, 0x6b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x40, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x22, 0xc2, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x53, 0x0a, 0x11, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x10, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x16, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x14, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6e, 0x6b,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x6b, 0x4b,
+	0x69, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0xfc, 0x05, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
 	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x4b, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x0d, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36,
-	0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x15, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x41, 0x0a, 0x1d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x6c,
-	0x61, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x41, 0x0a, 0x12, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f,
-	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x89, 0x03, 0x0a, 0x17, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x5e, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x5e, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x69, 0x0a, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72,
-	0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x4a, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x63,
+	0x69, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70,
+	0x72, 0x65, 0x63, 0x69, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x1c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x19, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a,
+	0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15,
+	0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x41, 0x0a, 0x1d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x6c, 0x61,
+	0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x71, 0x0a, 0x1c, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x1a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12,
+	0x47, 0x0a, 0x09, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x4c, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf1, 0x03, 0x0a, 0x1d, 0x4c, 0x69, 0x6e, 0x6b, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4b, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x65, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x41, 0x0a, 0x1d, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x1a, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x41,
+	0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x89, 0x03, 0x0a, 0x17, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5e, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x61, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
 	0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
 	0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x15, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0e,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x94, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x61,
-	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x6e,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x22, 0xc1, 0x04, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x37, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
-	0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a,
-	0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x62,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x62, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x69, 0x0a, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x15, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x43, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x6e, 0x6b, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2b, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x10, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x98, 0x01,
+	0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
+	0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x69,
+	0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xc1, 0x04, 0x0a, 0x27, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68,
 	0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x42, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x49, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c,
-	0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x10, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x4c,
-	0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x42, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
-	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb4, 0x02, 0x0a, 0x30, 0x47,
-	0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61,
-	0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x8b, 0x01, 0x0a, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x5a, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
+	0x63, 0x65, 0x41, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x62, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x19,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x17, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xf0, 0x01, 0x0a,
+	0x28, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
 	0x69, 0x61, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x72, 0x0a,
-	0x17, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xb4, 0x02, 0x0a, 0x30, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x5a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74,
 	0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xf3, 0x04, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0f, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x41, 0x53, 0x70, 0x65, 0x63, 0x12, 0x3b, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x62, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x53,
-	0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x41, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x1a, 0x72, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x41, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf3, 0x04, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b,
+	0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x5f, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0d, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x53, 0x70, 0x65, 0x63, 0x12, 0x3b, 0x0a, 0x0f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x42, 0x53, 0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x42, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76,
 	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x54, 0x79, 0x70, 0x65, 0x12, 0x58, 0x0a,
-	0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0b, 0x6c, 0x69,
-	0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74,
-	0x49, 0x64, 0x1a, 0x53, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a,
+	0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0c,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x1a, 0x53, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc8, 0x01, 0x0a,
+	0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x19, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x08, 0x73, 0x70, 0x65, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x22, 0xa2, 0x03, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x76, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x11, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x5f, 0x0a, 0x1c,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a,
+	0x15, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
 	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
 	0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc8, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5d, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x45, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x5b, 0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
-	0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22,
-	0xab, 0x01, 0x0a, 0x19, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x73, 0x70, 0x65,
-	0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0xa2, 0x03,
-	0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x76, 0x0a,
-	0x19, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x3a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x17, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x5f, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x63, 0x61, 0x6c,
-	0x61, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x24, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0x1e, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x2a, 0xab, 0x01, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x53, 0x70, 0x65, 0x63, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1b, 0x0a, 0x17, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53,
-	0x50, 0x45, 0x43, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
-	0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43,
-	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41,
-	0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x46,
-	0x4c, 0x4f, 0x41, 0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53,
-	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x45,
-	0x41, 0x4e, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x04,
-	0x2a, 0x73, 0x0a, 0x14, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52,
-	0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x49, 0x4e,
-	0x4b, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x41, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
-	0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43,
-	0x45, 0x5f, 0x42, 0x10, 0x02, 0x2a, 0x32, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e,
-	0x64, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48,
-	0x41, 0x52, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x53, 0x4f, 0x46, 0x54, 0x10, 0x01, 0x2a, 0x68, 0x0a, 0x0e, 0x4c, 0x69, 0x6e,
-	0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4c,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x24, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x28, 0x0a,
+	0x12, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1e, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0xab, 0x01, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53,
+	0x50, 0x45, 0x43, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f,
+	0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x41, 0x52,
+	0x52, 0x41, 0x59, 0x10, 0x04, 0x2a, 0x73, 0x0a, 0x14, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a,
+	0x1b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52,
+	0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1c,
+	0x0a, 0x18, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x10, 0x02, 0x2a, 0x32, 0x0a, 0x08, 0x4c, 0x69,
+	0x6e, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x48, 0x41, 0x52, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x4f, 0x46, 0x54, 0x10, 0x01, 0x2a, 0x68,
+	0x0a, 0x0e, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x1c, 0x0a,
+	0x18, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4c,
 	0x49, 0x4e, 0x4b, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x53,
-	0x54, 0x52, 0x4f, 0x59, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x55,
-	0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
-	0x45, 0x10, 0x02, 0x2a, 0xf5, 0x05, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4c,
-	0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
-	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d,
-	0x0a, 0x19, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52,
-	0x45, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x23, 0x0a,
-	0x1f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x43, 0x4b,
-	0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43,
-	0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x28, 0x0a, 0x24, 0x4c, 0x49,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0xf5, 0x05, 0x0a, 0x0a, 0x4c, 0x69, 0x6e,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x49,
 	0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
-	0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
-	0x54, 0x45, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x49, 0x4e, 0x47, 0x10, 0x07,
-	0x12, 0x19, 0x0a, 0x15, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x45, 0x44, 0x10, 0x08, 0x12, 0x1e, 0x0a, 0x1a, 0x4c,
-	0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52,
-	0x4f, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x12, 0x24, 0x0a, 0x20, 0x4c,
-	0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52,
-	0x4f, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10,
-	0x0a, 0x12, 0x27, 0x0a, 0x23, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43,
-	0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x29, 0x0a, 0x25, 0x4c, 0x49,
-	0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f,
-	0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
-	0x45, 0x54, 0x45, 0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x0d, 0x12,
-	0x17, 0x0a, 0x13, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
-	0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x0e, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46,
-	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f,
-	0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x10, 0x12, 0x26, 0x0a, 0x22,
+	0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47,
+	0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f,
+	0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12,
+	0x28, 0x0a, 0x24, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x43,
+	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59,
+	0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x45, 0x44, 0x10, 0x08,
+	0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09,
+	0x12, 0x24, 0x0a, 0x20, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f,
+	0x42, 0x41, 0x43, 0x4b, 0x10, 0x0a, 0x12, 0x27, 0x0a, 0x23, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x5f, 0x52, 0x4f,
+	0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0b, 0x12,
+	0x29, 0x0a, 0x25, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44,
+	0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f,
+	0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x49,
+	0x4e, 0x47, 0x10, 0x0d, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x0e, 0x12, 0x1d, 0x0a,
+	0x19, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x23, 0x0a, 0x1f,
 	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41,
-	0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c,
-	0x45, 0x44, 0x10, 0x11, 0x12, 0x28, 0x0a, 0x24, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42,
-	0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x12, 0x12, 0x22,
-	0x0a, 0x1e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52,
-	0x45, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x45, 0x44,
-	0x10, 0x13, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55,
-	0x50, 0x54, 0x45, 0x44, 0x10, 0x14, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x5f, 0x49, 0x4e,
-	0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x45, 0x44, 0x10, 0x15, 0x2a, 0xda, 0x09, 0x0a, 0x11,
-	0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e,
-	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x2b, 0x0a, 0x27, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49,
+	0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10,
+	0x10, 0x12, 0x26, 0x0a, 0x22, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x11, 0x12, 0x28, 0x0a, 0x24, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
+	0x45, 0x10, 0x12, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52,
+	0x55, 0x50, 0x54, 0x45, 0x44, 0x10, 0x13, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x45, 0x44, 0x10, 0x14, 0x12, 0x23, 0x0a, 0x1f, 0x4c,
+	0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52,
+	0x4f, 0x59, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x45, 0x44, 0x10, 0x15,
+	0x2a, 0xda, 0x09, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53,
+	0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x2b, 0x0a, 0x27, 0x50, 0x52, 0x45, 0x43, 0x49,
+	0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x41, 0x10, 0x01, 0x12, 0x2a, 0x0a, 0x26, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x30, 0x0a, 0x2c, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x36, 0x0a, 0x32, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c,
+	0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x04, 0x12, 0x39, 0x0a, 0x35, 0x50, 0x52,
+	0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x3b, 0x0a, 0x37, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45,
+	0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52,
+	0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
+	0x10, 0x06, 0x12, 0x2b, 0x0a, 0x27, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49,
 	0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x49,
-	0x4e, 0x47, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x10, 0x01, 0x12,
+	0x4e, 0x47, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x10, 0x07, 0x12,
 	0x2a, 0x0a, 0x26, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
 	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
-	0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x30, 0x0a, 0x2c, 0x50,
+	0x42, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x30, 0x0a, 0x2c, 0x50,
 	0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x5f, 0x55, 0x50,
-	0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x36, 0x0a,
+	0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x12, 0x36, 0x0a,
 	0x32, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x5f,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x5f,
 	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x42,
-	0x41, 0x43, 0x4b, 0x10, 0x04, 0x12, 0x39, 0x0a, 0x35, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45,
+	0x41, 0x43, 0x4b, 0x10, 0x0a, 0x12, 0x39, 0x0a, 0x35, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45,
 	0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53,
-	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52,
-	0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52,
+	0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0b,
 	0x12, 0x3b, 0x0a, 0x37, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b,
 	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
-	0x5f, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41,
-	0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x06, 0x12, 0x2b, 0x0a,
-	0x27, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x10, 0x07, 0x12, 0x2a, 0x0a, 0x26, 0x50, 0x52,
-	0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x5f, 0x55, 0x50, 0x44,
-	0x41, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x30, 0x0a, 0x2c, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53,
-	0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f,
-	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x12, 0x36, 0x0a, 0x32, 0x50, 0x52, 0x45, 0x43,
-	0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
-	0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x0a,
-	0x12, 0x39, 0x0a, 0x35, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
 	0x5f, 0x42, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41,
-	0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x3b, 0x0a, 0x37, 0x50,
-	0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x5f, 0x55, 0x50,
-	0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f,
-	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x0c, 0x12, 0x37, 0x0a, 0x33, 0x50, 0x52, 0x45, 0x43,
-	0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45,
-	0x44, 0x49, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x53, 0x10,
-	0x0d, 0x12, 0x36, 0x0a, 0x32, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e,
-	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45,
-	0x44, 0x49, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x53, 0x5f,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x0e, 0x12, 0x3c, 0x0a, 0x38, 0x50, 0x52, 0x45,
-	0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x46,
-	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x41, 0x0a, 0x3d, 0x50, 0x52, 0x45, 0x43, 0x49,
-	0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49,
-	0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f,
-	0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c,
-	0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x10, 0x12, 0x44, 0x0a, 0x40, 0x50, 0x52,
-	0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x52, 0x59, 0x5f, 0x52,
-	0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52,
-	0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x11,
-	0x12, 0x46, 0x0a, 0x42, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44,
-	0x49, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50,
-	0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x4f,
-	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x12, 0x12, 0x35, 0x0a, 0x31, 0x50, 0x52, 0x45, 0x43,
-	0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
-	0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x45, 0x44, 0x10, 0x13, 0x12,
-	0x35, 0x0a, 0x31, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
-	0x42, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55,
-	0x50, 0x54, 0x45, 0x44, 0x10, 0x14, 0x12, 0x40, 0x0a, 0x3c, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53,
+	0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x0c, 0x12, 0x37, 0x0a,
+	0x33, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x53, 0x10, 0x0d, 0x12, 0x36, 0x0a, 0x32, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53,
 	0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e,
 	0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
-	0x52, 0x55, 0x50, 0x54, 0x45, 0x44, 0x10, 0x15, 0x32, 0xee, 0x25, 0x0a, 0x08, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x63, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x65, 0x66, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4c,
-	0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x65, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x17, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
-	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x52, 0x43, 0x45, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x0e, 0x12, 0x3c,
+	0x0a, 0x38, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41,
+	0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x41, 0x0a, 0x3d,
+	0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x52, 0x59,
+	0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x10, 0x12,
+	0x44, 0x0a, 0x40, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49,
+	0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x11, 0x12, 0x46, 0x0a, 0x42, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45,
+	0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41,
+	0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x12, 0x12, 0x35, 0x0a,
+	0x31, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x5f,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54,
+	0x45, 0x44, 0x10, 0x13, 0x12, 0x35, 0x0a, 0x31, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x45, 0x44, 0x10, 0x14, 0x12, 0x40, 0x0a, 0x3c, 0x50,
+	0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x52, 0x59, 0x5f,
+	0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x45, 0x44, 0x10, 0x15, 0x32, 0xbe, 0x27,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
+	0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76,
 	0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70,
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
-	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74,
-	0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x16, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2f,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21,
 	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
-	0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x30, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x16, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x11,
+	0x43, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x54,
+	0x6f, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x44, 0x65, 0x70, 0x73,
 	0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
 	0x72, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
-	0x70, 0x65, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x12, 0x21, 0x2e, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69,
-	0x6e, 0x6b, 0x54, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x44, 0x65, 0x70, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72,
+	0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x44, 0x65, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x18, 0x49, 0x73, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72,
 	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
-	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x73, 0x65, 0x64, 0x44, 0x65, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x73, 0x0a, 0x18, 0x49, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x21,
-	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x5b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0e,
-	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24,
-	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a,
-	0x15, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x61, 0x73, 0x53, 0x74, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x12, 0x2b, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48,
-	0x61, 0x73, 0x53, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x61, 0x73, 0x53,
-	0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x44, 0x65, 0x73,
-	0x74, 0x72, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x73,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5f, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x74, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x61,
+	0x73, 0x53, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x12, 0x2b, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x48, 0x61, 0x73, 0x53, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x73, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x48, 0x61, 0x73, 0x53, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x26, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73,
 	0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73,
-	0x74, 0x72, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a,
-	0x10, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x73, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x55, 0x70,
+	0x74, 0x72, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x41, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x12, 0x2b,
 	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
 	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72,
-	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x42, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x98, 0x01, 0x0a, 0x1f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x38, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
 	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x98, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e,
-	0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x39, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x50,
-	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x1c,
-	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x70,
+	0x22, 0x00, 0x12, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70,
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
 	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x52, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d,
-	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x9b, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e,
 	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x9b, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
 	0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c,
 	0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x61, 0x72, 0x64,
-	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x61, 0x72, 0x64, 0x69,
-	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5f, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
-	0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x72,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x2e, 0x70, 0x72,
 	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12,
-	0x23, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x18, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70,
-	0x65, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x70,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x70,
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
 	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
-	0x70, 0x65, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76,
+	0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a,
-	0x0f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31,
-	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a,
-	0x20, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a,
+	0x18, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x76,
 	0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x74, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x78, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x79, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
 	0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a,
-	0x1d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x2b,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b,
 	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76,
 	0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0c, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x29, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76,
-	0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59,
-	0x0a, 0x0c, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
-	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0x2e, 0x46,
-	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x50, 0x5a, 0x49, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x65, 0x77, 0x73, 0x74, 0x61, 0x63, 0x6b,
-	0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x62, 0x6c, 0x75, 0x65, 0x6c, 0x69, 0x6e, 0x6b, 0x2f,
-	0x6c, 0x69, 0x62, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2d, 0x66, 0x72, 0x61, 0x6d,
-	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x92, 0x03, 0x02, 0x08, 0x02, 0x62, 0x08, 0x65, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x70, 0xe8, 0x07,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x50,
+	0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x65, 0x77,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x62, 0x6c, 0x75, 0x65,
+	0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2d, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x76, 0x31, 0x92, 0x03, 0x02, 0x08, 0x02,
+	0x62, 0x08, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x70, 0xe8, 0x07,
 })
 
 var (
@@ -8119,7 +8670,7 @@ func file_plugin_framework_providerserverv1_provider_proto_rawDescGZIP() []byte
 }
 
 var file_plugin_framework_providerserverv1_provider_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_plugin_framework_providerserverv1_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
+var file_plugin_framework_providerserverv1_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
 var file_plugin_framework_providerserverv1_provider_proto_goTypes = []any{
 	(DataSourceSpecSchemaType)(0),                            // 0: providerserverv1.DataSourceSpecSchemaType
 	(LinkPriorityResource)(0),                                // 1: providerserverv1.LinkPriorityResource
@@ -8151,375 +8702,397 @@ var file_plugin_framework_providerserverv1_provider_proto_goTypes = []any{
 	(*IsResourceCommonTerminalResponse)(nil),                 // 27: providerserverv1.IsResourceCommonTerminalResponse
 	(*GetResourceExternalStateRequest)(nil),                  // 28: providerserverv1.GetResourceExternalStateRequest
 	(*GetResourceExternalStateResponse)(nil),                 // 29: providerserverv1.GetResourceExternalStateResponse
-	(*ProviderRequest)(nil),                                  // 30: providerserverv1.ProviderRequest
-	(*ResourceRequest)(nil),                                  // 31: providerserverv1.ResourceRequest
-	(*DataSourceRequest)(nil),                                // 32: providerserverv1.DataSourceRequest
-	(*CustomVariableTypeRequest)(nil),                        // 33: providerserverv1.CustomVariableTypeRequest
-	(*StageLinkChangesRequest)(nil),                          // 34: providerserverv1.StageLinkChangesRequest
-	(*StageLinkChangesResponse)(nil),                         // 35: providerserverv1.StageLinkChangesResponse
-	(*StageLinkChangesCompleteResponse)(nil),                 // 36: providerserverv1.StageLinkChangesCompleteResponse
-	(*UpdateLinkResourceRequest)(nil),                        // 37: providerserverv1.UpdateLinkResourceRequest
-	(*UpdateLinkResourceResponse)(nil),                       // 38: providerserverv1.UpdateLinkResourceResponse
-	(*UpdateLinkResourceCompleteResponse)(nil),               // 39: providerserverv1.UpdateLinkResourceCompleteResponse
-	(*UpdateLinkIntermediaryResourcesRequest)(nil),           // 40: providerserverv1.UpdateLinkIntermediaryResourcesRequest
-	(*UpdateLinkIntermediaryResourcesResponse)(nil),          // 41: providerserverv1.UpdateLinkIntermediaryResourcesResponse
-	(*UpdateLinkIntermediaryResourcesCompleteResponse)(nil),  // 42: providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse
-	(*LinkPriorityResourceResponse)(nil),                     // 43: providerserverv1.LinkPriorityResourceResponse
-	(*CustomValidateDataSourceRequest)(nil),                  // 44: providerserverv1.CustomValidateDataSourceRequest
-	(*CustomValidateDataSourceResponse)(nil),                 // 45: providerserverv1.CustomValidateDataSourceResponse
-	(*CustomValidateDataSourceCompleteResponse)(nil),         // 46: providerserverv1.CustomValidateDataSourceCompleteResponse
-	(*DataSourceSpecDefinitionResponse)(nil),                 // 47: providerserverv1.DataSourceSpecDefinitionResponse
-	(*DataSourceFilterFieldsResponse)(nil),                   // 48: providerserverv1.DataSourceFilterFieldsResponse
-	(*FetchDataSourceRequest)(nil),                           // 49: providerserverv1.FetchDataSourceRequest
-	(*FetchDataSourceResponse)(nil),                          // 50: providerserverv1.FetchDataSourceResponse
-	(*CustomVariableTypeOptionsResponse)(nil),                // 51: providerserverv1.CustomVariableTypeOptionsResponse
-	(*CustomVariableTypeOptions)(nil),                        // 52: providerserverv1.CustomVariableTypeOptions
-	(*CustomVariableTypeOption)(nil),                         // 53: providerserverv1.CustomVariableTypeOption
-	(*CustomVariableTypeResponse)(nil),                       // 54: providerserverv1.CustomVariableTypeResponse
-	(*CustomVariableTypeInfo)(nil),                           // 55: providerserverv1.CustomVariableTypeInfo
-	(*FetchDataSourceCompleteResponse)(nil),                  // 56: providerserverv1.FetchDataSourceCompleteResponse
-	(*ResolvedDataSource)(nil),                               // 57: providerserverv1.ResolvedDataSource
-	(*ResolvedDataSourceMetadata)(nil),                       // 58: providerserverv1.ResolvedDataSourceMetadata
-	(*ResolvedDataSourceFilter)(nil),                         // 59: providerserverv1.ResolvedDataSourceFilter
-	(*ResolvedDataSourceFilterSearch)(nil),                   // 60: providerserverv1.ResolvedDataSourceFilterSearch
-	(*DataSourceFilterFields)(nil),                           // 61: providerserverv1.DataSourceFilterFields
-	(*DataSourceFilterFieldSchema)(nil),                      // 62: providerserverv1.DataSourceFilterFieldSchema
-	(*ResolvedDataSourceFieldExport)(nil),                    // 63: providerserverv1.ResolvedDataSourceFieldExport
-	(*DataSourceSpecDefinition)(nil),                         // 64: providerserverv1.DataSourceSpecDefinition
-	(*DataSourceSpecSchema)(nil),                             // 65: providerserverv1.DataSourceSpecSchema
-	(*DataSourceTypeResponse)(nil),                           // 66: providerserverv1.DataSourceTypeResponse
-	(*DataSourceTypeInfo)(nil),                               // 67: providerserverv1.DataSourceTypeInfo
-	(*LinkPriorityResourceInfo)(nil),                         // 68: providerserverv1.LinkPriorityResourceInfo
-	(*LinkKindResponse)(nil),                                 // 69: providerserverv1.LinkKindResponse
-	(*LinkKindInfo)(nil),                                     // 70: providerserverv1.LinkKindInfo
-	(*LinkState)(nil),                                        // 71: providerserverv1.LinkState
-	(*LinkIntermediaryResourceState)(nil),                    // 72: providerserverv1.LinkIntermediaryResourceState
-	(*LinkCompletionDurations)(nil),                          // 73: providerserverv1.LinkCompletionDurations
-	(*LinkComponentCompletionDurations)(nil),                 // 74: providerserverv1.LinkComponentCompletionDurations
-	(*LinkRequest)(nil),                                      // 75: providerserverv1.LinkRequest
-	(*GetLinkIntermediaryExternalStateRequest)(nil),          // 76: providerserverv1.GetLinkIntermediaryExternalStateRequest
-	(*GetLinkIntermediaryExternalStateResponse)(nil),         // 77: providerserverv1.GetLinkIntermediaryExternalStateResponse
-	(*GetLinkIntermediaryExternalStateCompleteResponse)(nil), // 78: providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse
-	(*ValidateLinkRequest)(nil),                              // 79: providerserverv1.ValidateLinkRequest
-	(*ValidateLinkResponse)(nil),                             // 80: providerserverv1.ValidateLinkResponse
-	(*ValidateLinkCompleteResponse)(nil),                     // 81: providerserverv1.ValidateLinkCompleteResponse
-	(*IntermediaryExternalState)(nil),                        // 82: providerserverv1.IntermediaryExternalState
-	(*LinkContext)(nil),                                      // 83: providerserverv1.LinkContext
-	(*DataSourceType)(nil),                                   // 84: providerserverv1.DataSourceType
-	(*CustomVariableType)(nil),                               // 85: providerserverv1.CustomVariableType
-	(*LinkType)(nil),                                         // 86: providerserverv1.LinkType
-	nil,                                                      // 87: providerserverv1.UpdateLinkResourceCompleteResponse.ResourceDataMappingsEntry
-	nil,                                                      // 88: providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse.ResourceDataMappingsEntry
-	nil,                                                      // 89: providerserverv1.CustomVariableTypeOptions.OptionsEntry
-	nil,                                                      // 90: providerserverv1.FetchDataSourceCompleteResponse.DataEntry
-	nil,                                                      // 91: providerserverv1.ResolvedDataSource.ExportsEntry
-	nil,                                                      // 92: providerserverv1.DataSourceFilterFields.FilterFieldsEntry
-	nil,                                                      // 93: providerserverv1.DataSourceSpecDefinition.FieldsEntry
-	nil,                                                      // 94: providerserverv1.LinkState.DataEntry
-	nil,                                                      // 95: providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse.IntermediaryStatesEntry
-	nil,                                                      // 96: providerserverv1.ValidateLinkRequest.AnnotationsEntry
-	nil,                                                      // 97: providerserverv1.LinkContext.ProviderConfigVariablesEntry
-	nil,                                                      // 98: providerserverv1.LinkContext.ContextVariablesEntry
-	(*sharedtypesv1.ErrorResponse)(nil),                      // 99: sharedtypesv1.ErrorResponse
-	(*sharedtypesv1.ResourceType)(nil),                       // 100: sharedtypesv1.ResourceType
-	(*schemapb.Resource)(nil),                                // 101: schema.Resource
-	(*sharedtypesv1.ProviderContext)(nil),                    // 102: sharedtypesv1.ProviderContext
-	(*sharedtypesv1.Diagnostic)(nil),                         // 103: sharedtypesv1.Diagnostic
-	(*sharedtypesv1.ResourceSpecDefinition)(nil),             // 104: sharedtypesv1.ResourceSpecDefinition
-	(*sharedtypesv1.CanLinkTo)(nil),                          // 105: sharedtypesv1.CanLinkTo
-	(*sharedtypesv1.ResourceCommonTerminalInfo)(nil),         // 106: sharedtypesv1.ResourceCommonTerminalInfo
-	(*schemapb.MappingNode)(nil),                             // 107: schema.MappingNode
-	(*sharedtypesv1.ResourceMetadataState)(nil),              // 108: sharedtypesv1.ResourceMetadataState
-	(*sharedtypesv1.Changes)(nil),                            // 109: sharedtypesv1.Changes
-	(*sharedtypesv1.LinkChanges)(nil),                        // 110: sharedtypesv1.LinkChanges
-	(*sharedtypesv1.ResourceInfo)(nil),                       // 111: sharedtypesv1.ResourceInfo
-	(*schemapb.DataSource)(nil),                              // 112: schema.DataSource
-	(*schemapb.ScalarValue)(nil),                             // 113: schema.ScalarValue
-	(sharedtypesv1.ResourceStatus)(0),                        // 114: sharedtypesv1.ResourceStatus
-	(sharedtypesv1.PreciseResourceStatus)(0),                 // 115: sharedtypesv1.PreciseResourceStatus
-	(*wrapperspb.DoubleValue)(nil),                           // 116: google.protobuf.DoubleValue
-	(*sharedtypesv1.DeployResourceRequest)(nil),              // 117: sharedtypesv1.DeployResourceRequest
-	(*sharedtypesv1.ResourceHasStabilisedRequest)(nil),       // 118: sharedtypesv1.ResourceHasStabilisedRequest
-	(*sharedtypesv1.DestroyResourceRequest)(nil),             // 119: sharedtypesv1.DestroyResourceRequest
-	(*sharedtypesv1.FunctionDefinitionRequest)(nil),          // 120: sharedtypesv1.FunctionDefinitionRequest
-	(*sharedtypesv1.FunctionCallRequest)(nil),                // 121: sharedtypesv1.FunctionCallRequest
-	(*sharedtypesv1.ConfigDefinitionResponse)(nil),           // 122: sharedtypesv1.ConfigDefinitionResponse
-	(*sharedtypesv1.ResourceTypeResponse)(nil),               // 123: sharedtypesv1.ResourceTypeResponse
-	(*sharedtypesv1.TypeDescriptionResponse)(nil),            // 124: sharedtypesv1.TypeDescriptionResponse
-	(*sharedtypesv1.ExamplesResponse)(nil),                   // 125: sharedtypesv1.ExamplesResponse
-	(*sharedtypesv1.DeployResourceResponse)(nil),             // 126: sharedtypesv1.DeployResourceResponse
-	(*sharedtypesv1.ResourceHasStabilisedResponse)(nil),      // 127: sharedtypesv1.ResourceHasStabilisedResponse
-	(*sharedtypesv1.DestroyResourceResponse)(nil),            // 128: sharedtypesv1.DestroyResourceResponse
-	(*sharedtypesv1.LinkAnnotationDefinitionsResponse)(nil),  // 129: sharedtypesv1.LinkAnnotationDefinitionsResponse
-	(*sharedtypesv1.LinkCardinalityResponse)(nil),            // 130: sharedtypesv1.LinkCardinalityResponse
-	(*sharedtypesv1.FunctionDefinitionResponse)(nil),         // 131: sharedtypesv1.FunctionDefinitionResponse
-	(*sharedtypesv1.FunctionCallResponse)(nil),               // 132: sharedtypesv1.FunctionCallResponse
+	(*ListResourcesRequest)(nil),                             // 30: providerserverv1.ListResourcesRequest
+	(*ListResourcesResponse)(nil),                            // 31: providerserverv1.ListResourcesResponse
+	(*ListResourcesCompleteResponse)(nil),                    // 32: providerserverv1.ListResourcesCompleteResponse
+	(*ExternalResource)(nil),                                 // 33: providerserverv1.ExternalResource
+	(*ProviderRequest)(nil),                                  // 34: providerserverv1.ProviderRequest
+	(*ResourceRequest)(nil),                                  // 35: providerserverv1.ResourceRequest
+	(*DataSourceRequest)(nil),                                // 36: providerserverv1.DataSourceRequest
+	(*CustomVariableTypeRequest)(nil),                        // 37: providerserverv1.CustomVariableTypeRequest
+	(*StageLinkChangesRequest)(nil),                          // 38: providerserverv1.StageLinkChangesRequest
+	(*StageLinkChangesResponse)(nil),                         // 39: providerserverv1.StageLinkChangesResponse
+	(*StageLinkChangesCompleteResponse)(nil),                 // 40: providerserverv1.StageLinkChangesCompleteResponse
+	(*UpdateLinkResourceRequest)(nil),                        // 41: providerserverv1.UpdateLinkResourceRequest
+	(*UpdateLinkResourceResponse)(nil),                       // 42: providerserverv1.UpdateLinkResourceResponse
+	(*UpdateLinkResourceCompleteResponse)(nil),               // 43: providerserverv1.UpdateLinkResourceCompleteResponse
+	(*UpdateLinkIntermediaryResourcesRequest)(nil),           // 44: providerserverv1.UpdateLinkIntermediaryResourcesRequest
+	(*UpdateLinkIntermediaryResourcesResponse)(nil),          // 45: providerserverv1.UpdateLinkIntermediaryResourcesResponse
+	(*UpdateLinkIntermediaryResourcesCompleteResponse)(nil),  // 46: providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse
+	(*LinkPriorityResourceResponse)(nil),                     // 47: providerserverv1.LinkPriorityResourceResponse
+	(*CustomValidateDataSourceRequest)(nil),                  // 48: providerserverv1.CustomValidateDataSourceRequest
+	(*CustomValidateDataSourceResponse)(nil),                 // 49: providerserverv1.CustomValidateDataSourceResponse
+	(*CustomValidateDataSourceCompleteResponse)(nil),         // 50: providerserverv1.CustomValidateDataSourceCompleteResponse
+	(*DataSourceSpecDefinitionResponse)(nil),                 // 51: providerserverv1.DataSourceSpecDefinitionResponse
+	(*DataSourceFilterFieldsResponse)(nil),                   // 52: providerserverv1.DataSourceFilterFieldsResponse
+	(*FetchDataSourceRequest)(nil),                           // 53: providerserverv1.FetchDataSourceRequest
+	(*FetchDataSourceResponse)(nil),                          // 54: providerserverv1.FetchDataSourceResponse
+	(*CustomVariableTypeOptionsResponse)(nil),                // 55: providerserverv1.CustomVariableTypeOptionsResponse
+	(*CustomVariableTypeOptions)(nil),                        // 56: providerserverv1.CustomVariableTypeOptions
+	(*CustomVariableTypeOption)(nil),                         // 57: providerserverv1.CustomVariableTypeOption
+	(*CustomVariableTypeResponse)(nil),                       // 58: providerserverv1.CustomVariableTypeResponse
+	(*CustomVariableTypeInfo)(nil),                           // 59: providerserverv1.CustomVariableTypeInfo
+	(*FetchDataSourceCompleteResponse)(nil),                  // 60: providerserverv1.FetchDataSourceCompleteResponse
+	(*ResolvedDataSource)(nil),                               // 61: providerserverv1.ResolvedDataSource
+	(*ResolvedDataSourceMetadata)(nil),                       // 62: providerserverv1.ResolvedDataSourceMetadata
+	(*ResolvedDataSourceFilter)(nil),                         // 63: providerserverv1.ResolvedDataSourceFilter
+	(*ResolvedDataSourceFilterSearch)(nil),                   // 64: providerserverv1.ResolvedDataSourceFilterSearch
+	(*DataSourceFilterFields)(nil),                           // 65: providerserverv1.DataSourceFilterFields
+	(*DataSourceFilterFieldSchema)(nil),                      // 66: providerserverv1.DataSourceFilterFieldSchema
+	(*ResolvedDataSourceFieldExport)(nil),                    // 67: providerserverv1.ResolvedDataSourceFieldExport
+	(*DataSourceSpecDefinition)(nil),                         // 68: providerserverv1.DataSourceSpecDefinition
+	(*DataSourceSpecSchema)(nil),                             // 69: providerserverv1.DataSourceSpecSchema
+	(*DataSourceTypeResponse)(nil),                           // 70: providerserverv1.DataSourceTypeResponse
+	(*DataSourceTypeInfo)(nil),                               // 71: providerserverv1.DataSourceTypeInfo
+	(*LinkConfigDefinitionResponse)(nil),                     // 72: providerserverv1.LinkConfigDefinitionResponse
+	(*LinkConfigDefinition)(nil),                             // 73: providerserverv1.LinkConfigDefinition
+	(*LinkPriorityResourceInfo)(nil),                         // 74: providerserverv1.LinkPriorityResourceInfo
+	(*LinkKindResponse)(nil),                                 // 75: providerserverv1.LinkKindResponse
+	(*LinkKindInfo)(nil),                                     // 76: providerserverv1.LinkKindInfo
+	(*LinkState)(nil),                                        // 77: providerserverv1.LinkState
+	(*LinkIntermediaryResourceState)(nil),                    // 78: providerserverv1.LinkIntermediaryResourceState
+	(*LinkCompletionDurations)(nil),                          // 79: providerserverv1.LinkCompletionDurations
+	(*LinkComponentCompletionDurations)(nil),                 // 80: providerserverv1.LinkComponentCompletionDurations
+	(*LinkRequest)(nil),                                      // 81: providerserverv1.LinkRequest
+	(*GetLinkIntermediaryExternalStateRequest)(nil),          // 82: providerserverv1.GetLinkIntermediaryExternalStateRequest
+	(*GetLinkIntermediaryExternalStateResponse)(nil),         // 83: providerserverv1.GetLinkIntermediaryExternalStateResponse
+	(*GetLinkIntermediaryExternalStateCompleteResponse)(nil), // 84: providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse
+	(*ValidateLinkRequest)(nil),                              // 85: providerserverv1.ValidateLinkRequest
+	(*ValidateLinkResponse)(nil),                             // 86: providerserverv1.ValidateLinkResponse
+	(*ValidateLinkCompleteResponse)(nil),                     // 87: providerserverv1.ValidateLinkCompleteResponse
+	(*IntermediaryExternalState)(nil),                        // 88: providerserverv1.IntermediaryExternalState
+	(*LinkContext)(nil),                                      // 89: providerserverv1.LinkContext
+	(*DataSourceType)(nil),                                   // 90: providerserverv1.DataSourceType
+	(*CustomVariableType)(nil),                               // 91: providerserverv1.CustomVariableType
+	(*LinkType)(nil),                                         // 92: providerserverv1.LinkType
+	nil,                                                      // 93: providerserverv1.UpdateLinkResourceCompleteResponse.ResourceDataMappingsEntry
+	nil,                                                      // 94: providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse.ResourceDataMappingsEntry
+	nil,                                                      // 95: providerserverv1.CustomVariableTypeOptions.OptionsEntry
+	nil,                                                      // 96: providerserverv1.FetchDataSourceCompleteResponse.DataEntry
+	nil,                                                      // 97: providerserverv1.ResolvedDataSource.ExportsEntry
+	nil,                                                      // 98: providerserverv1.DataSourceFilterFields.FilterFieldsEntry
+	nil,                                                      // 99: providerserverv1.DataSourceSpecDefinition.FieldsEntry
+	nil,                                                      // 100: providerserverv1.LinkState.DataEntry
+	nil,                                                      // 101: providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse.IntermediaryStatesEntry
+	nil,                                                      // 102: providerserverv1.ValidateLinkRequest.AnnotationsEntry
+	nil,                                                      // 103: providerserverv1.LinkContext.ProviderConfigVariablesEntry
+	nil,                                                      // 104: providerserverv1.LinkContext.ContextVariablesEntry
+	(*sharedtypesv1.ErrorResponse)(nil),                      // 105: sharedtypesv1.ErrorResponse
+	(*sharedtypesv1.ResourceType)(nil),                       // 106: sharedtypesv1.ResourceType
+	(*schemapb.Resource)(nil),                                // 107: schema.Resource
+	(*sharedtypesv1.ProviderContext)(nil),                    // 108: sharedtypesv1.ProviderContext
+	(*sharedtypesv1.Diagnostic)(nil),                         // 109: sharedtypesv1.Diagnostic
+	(*sharedtypesv1.ResourceSpecDefinition)(nil),             // 110: sharedtypesv1.ResourceSpecDefinition
+	(*sharedtypesv1.CanLinkTo)(nil),                          // 111: sharedtypesv1.CanLinkTo
+	(*sharedtypesv1.ResourceCommonTerminalInfo)(nil),         // 112: sharedtypesv1.ResourceCommonTerminalInfo
+	(*schemapb.MappingNode)(nil),                             // 113: schema.MappingNode
+	(*sharedtypesv1.ResourceMetadataState)(nil),              // 114: sharedtypesv1.ResourceMetadataState
+	(*sharedtypesv1.Changes)(nil),                            // 115: sharedtypesv1.Changes
+	(*sharedtypesv1.LinkChanges)(nil),                        // 116: sharedtypesv1.LinkChanges
+	(*sharedtypesv1.ResourceInfo)(nil),                       // 117: sharedtypesv1.ResourceInfo
+	(*schemapb.DataSource)(nil),                              // 118: schema.DataSource
+	(*schemapb.ScalarValue)(nil),                             // 119: schema.ScalarValue
+	(*sharedtypesv1.ResourceDefinitionsSchema)(nil),          // 120: sharedtypesv1.ResourceDefinitionsSchema
+	(sharedtypesv1.ResourceStatus)(0),                        // 121: sharedtypesv1.ResourceStatus
+	(sharedtypesv1.PreciseResourceStatus)(0),                 // 122: sharedtypesv1.PreciseResourceStatus
+	(*wrapperspb.DoubleValue)(nil),                           // 123: google.protobuf.DoubleValue
+	(*sharedtypesv1.DeployResourceRequest)(nil),              // 124: sharedtypesv1.DeployResourceRequest
+	(*sharedtypesv1.ResourceHasStabilisedRequest)(nil),       // 125: sharedtypesv1.ResourceHasStabilisedRequest
+	(*sharedtypesv1.DestroyResourceRequest)(nil),             // 126: sharedtypesv1.DestroyResourceRequest
+	(*sharedtypesv1.FunctionDefinitionRequest)(nil),          // 127: sharedtypesv1.FunctionDefinitionRequest
+	(*sharedtypesv1.FunctionCallRequest)(nil),                // 128: sharedtypesv1.FunctionCallRequest
+	(*sharedtypesv1.ConfigDefinitionResponse)(nil),           // 129: sharedtypesv1.ConfigDefinitionResponse
+	(*sharedtypesv1.ResourceTypeResponse)(nil),               // 130: sharedtypesv1.ResourceTypeResponse
+	(*sharedtypesv1.TypeDescriptionResponse)(nil),            // 131: sharedtypesv1.TypeDescriptionResponse
+	(*sharedtypesv1.ExamplesResponse)(nil),                   // 132: sharedtypesv1.ExamplesResponse
+	(*sharedtypesv1.DeployResourceResponse)(nil),             // 133: sharedtypesv1.DeployResourceResponse
+	(*sharedtypesv1.ResourceHasStabilisedResponse)(nil),      // 134: sharedtypesv1.ResourceHasStabilisedResponse
+	(*sharedtypesv1.DestroyResourceResponse)(nil),            // 135: sharedtypesv1.DestroyResourceResponse
+	(*sharedtypesv1.LinkAnnotationDefinitionsResponse)(nil),  // 136: sharedtypesv1.LinkAnnotationDefinitionsResponse
+	(*sharedtypesv1.LinkCardinalityResponse)(nil),            // 137: sharedtypesv1.LinkCardinalityResponse
+	(*sharedtypesv1.FunctionDefinitionResponse)(nil),         // 138: sharedtypesv1.FunctionDefinitionResponse
+	(*sharedtypesv1.FunctionCallResponse)(nil),               // 139: sharedtypesv1.FunctionCallResponse
 }
 var file_plugin_framework_providerserverv1_provider_proto_depIdxs = []int32{
 	7,   // 0: providerserverv1.NamespaceResponse.namespace:type_name -> providerserverv1.Namespace
-	99,  // 1: providerserverv1.NamespaceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	105, // 1: providerserverv1.NamespaceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
 	9,   // 2: providerserverv1.ResourceTypesResponse.resource_types:type_name -> providerserverv1.ResourceTypes
-	99,  // 3: providerserverv1.ResourceTypesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	100, // 4: providerserverv1.ResourceTypes.resource_types:type_name -> sharedtypesv1.ResourceType
+	105, // 3: providerserverv1.ResourceTypesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	106, // 4: providerserverv1.ResourceTypes.resource_types:type_name -> sharedtypesv1.ResourceType
 	11,  // 5: providerserverv1.LinkTypesResponse.link_types:type_name -> providerserverv1.LinkTypes
-	99,  // 6: providerserverv1.LinkTypesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	86,  // 7: providerserverv1.LinkTypes.link_types:type_name -> providerserverv1.LinkType
+	105, // 6: providerserverv1.LinkTypesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	92,  // 7: providerserverv1.LinkTypes.link_types:type_name -> providerserverv1.LinkType
 	13,  // 8: providerserverv1.DataSourceTypesResponse.data_source_types:type_name -> providerserverv1.DataSourceTypes
-	99,  // 9: providerserverv1.DataSourceTypesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	84,  // 10: providerserverv1.DataSourceTypes.data_source_types:type_name -> providerserverv1.DataSourceType
+	105, // 9: providerserverv1.DataSourceTypesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	90,  // 10: providerserverv1.DataSourceTypes.data_source_types:type_name -> providerserverv1.DataSourceType
 	15,  // 11: providerserverv1.CustomVariableTypesResponse.custom_variable_types:type_name -> providerserverv1.CustomVariableTypes
-	99,  // 12: providerserverv1.CustomVariableTypesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	85,  // 13: providerserverv1.CustomVariableTypes.custom_variable_types:type_name -> providerserverv1.CustomVariableType
+	105, // 12: providerserverv1.CustomVariableTypesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	91,  // 13: providerserverv1.CustomVariableTypes.custom_variable_types:type_name -> providerserverv1.CustomVariableType
 	17,  // 14: providerserverv1.FunctionListResponse.function_list:type_name -> providerserverv1.FunctionList
-	99,  // 15: providerserverv1.FunctionListResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	105, // 15: providerserverv1.FunctionListResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
 	19,  // 16: providerserverv1.RetryPolicyResponse.retry_policy:type_name -> providerserverv1.RetryPolicy
-	99,  // 17: providerserverv1.RetryPolicyResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	100, // 18: providerserverv1.CustomValidateResourceRequest.resource_type:type_name -> sharedtypesv1.ResourceType
-	101, // 19: providerserverv1.CustomValidateResourceRequest.schema_resource:type_name -> schema.Resource
-	102, // 20: providerserverv1.CustomValidateResourceRequest.context:type_name -> sharedtypesv1.ProviderContext
+	105, // 17: providerserverv1.RetryPolicyResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	106, // 18: providerserverv1.CustomValidateResourceRequest.resource_type:type_name -> sharedtypesv1.ResourceType
+	107, // 19: providerserverv1.CustomValidateResourceRequest.schema_resource:type_name -> schema.Resource
+	108, // 20: providerserverv1.CustomValidateResourceRequest.context:type_name -> sharedtypesv1.ProviderContext
 	22,  // 21: providerserverv1.CustomValidateResourceResponse.complete_response:type_name -> providerserverv1.CustomValidateResourceCompleteResponse
-	99,  // 22: providerserverv1.CustomValidateResourceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	103, // 23: providerserverv1.CustomValidateResourceCompleteResponse.diagnostics:type_name -> sharedtypesv1.Diagnostic
-	104, // 24: providerserverv1.ResourceSpecDefinitionResponse.spec_definition:type_name -> sharedtypesv1.ResourceSpecDefinition
-	99,  // 25: providerserverv1.ResourceSpecDefinitionResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	105, // 26: providerserverv1.CanResourceLinkToResponse.resource_types:type_name -> sharedtypesv1.CanLinkTo
-	99,  // 27: providerserverv1.CanResourceLinkToResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	105, // 22: providerserverv1.CustomValidateResourceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	109, // 23: providerserverv1.CustomValidateResourceCompleteResponse.diagnostics:type_name -> sharedtypesv1.Diagnostic
+	110, // 24: providerserverv1.ResourceSpecDefinitionResponse.spec_definition:type_name -> sharedtypesv1.ResourceSpecDefinition
+	105, // 25: providerserverv1.ResourceSpecDefinitionResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	111, // 26: providerserverv1.CanResourceLinkToResponse.resource_types:type_name -> sharedtypesv1.CanLinkTo
+	105, // 27: providerserverv1.CanResourceLinkToResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
 	26,  // 28: providerserverv1.ResourceStabilisedDepsResponse.stabilised_dependencies:type_name -> providerserverv1.StabilisedDependencies
-	99,  // 29: providerserverv1.ResourceStabilisedDepsResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	100, // 30: providerserverv1.StabilisedDependencies.resource_types:type_name -> sharedtypesv1.ResourceType
-	106, // 31: providerserverv1.IsResourceCommonTerminalResponse.data:type_name -> sharedtypesv1.ResourceCommonTerminalInfo
-	99,  // 32: providerserverv1.IsResourceCommonTerminalResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	100, // 33: providerserverv1.GetResourceExternalStateRequest.resource_type:type_name -> sharedtypesv1.ResourceType
-	107, // 34: providerserverv1.GetResourceExternalStateRequest.current_resource_spec:type_name -> schema.MappingNode
-	108, // 35: providerserverv1.GetResourceExternalStateRequest.current_resource_metadata:type_name -> sharedtypesv1.ResourceMetadataState
-	102, // 36: providerserverv1.GetResourceExternalStateRequest.context:type_name -> sharedtypesv1.ProviderContext
-	107, // 37: providerserverv1.GetResourceExternalStateResponse.resource_spec_state:type_name -> schema.MappingNode
-	99,  // 38: providerserverv1.GetResourceExternalStateResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	100, // 39: providerserverv1.ResourceRequest.resource_type:type_name -> sharedtypesv1.ResourceType
-	102, // 40: providerserverv1.ResourceRequest.context:type_name -> sharedtypesv1.ProviderContext
-	84,  // 41: providerserverv1.DataSourceRequest.data_source_type:type_name -> providerserverv1.DataSourceType
-	102, // 42: providerserverv1.DataSourceRequest.context:type_name -> sharedtypesv1.ProviderContext
-	85,  // 43: providerserverv1.CustomVariableTypeRequest.custom_variable_type:type_name -> providerserverv1.CustomVariableType
-	102, // 44: providerserverv1.CustomVariableTypeRequest.context:type_name -> sharedtypesv1.ProviderContext
-	86,  // 45: providerserverv1.StageLinkChangesRequest.link_type:type_name -> providerserverv1.LinkType
-	109, // 46: providerserverv1.StageLinkChangesRequest.resource_a_changes:type_name -> sharedtypesv1.Changes
-	109, // 47: providerserverv1.StageLinkChangesRequest.resource_b_changes:type_name -> sharedtypesv1.Changes
-	71,  // 48: providerserverv1.StageLinkChangesRequest.current_link_state:type_name -> providerserverv1.LinkState
-	83,  // 49: providerserverv1.StageLinkChangesRequest.context:type_name -> providerserverv1.LinkContext
-	36,  // 50: providerserverv1.StageLinkChangesResponse.complete_response:type_name -> providerserverv1.StageLinkChangesCompleteResponse
-	99,  // 51: providerserverv1.StageLinkChangesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	110, // 52: providerserverv1.StageLinkChangesCompleteResponse.changes:type_name -> sharedtypesv1.LinkChanges
-	86,  // 53: providerserverv1.UpdateLinkResourceRequest.link_type:type_name -> providerserverv1.LinkType
-	110, // 54: providerserverv1.UpdateLinkResourceRequest.changes:type_name -> sharedtypesv1.LinkChanges
-	111, // 55: providerserverv1.UpdateLinkResourceRequest.resource_info:type_name -> sharedtypesv1.ResourceInfo
-	111, // 56: providerserverv1.UpdateLinkResourceRequest.other_resource_info:type_name -> sharedtypesv1.ResourceInfo
-	3,   // 57: providerserverv1.UpdateLinkResourceRequest.update_type:type_name -> providerserverv1.LinkUpdateType
-	71,  // 58: providerserverv1.UpdateLinkResourceRequest.current_link_state:type_name -> providerserverv1.LinkState
-	83,  // 59: providerserverv1.UpdateLinkResourceRequest.context:type_name -> providerserverv1.LinkContext
-	39,  // 60: providerserverv1.UpdateLinkResourceResponse.complete_response:type_name -> providerserverv1.UpdateLinkResourceCompleteResponse
-	99,  // 61: providerserverv1.UpdateLinkResourceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	107, // 62: providerserverv1.UpdateLinkResourceCompleteResponse.link_data:type_name -> schema.MappingNode
-	87,  // 63: providerserverv1.UpdateLinkResourceCompleteResponse.resource_data_mappings:type_name -> providerserverv1.UpdateLinkResourceCompleteResponse.ResourceDataMappingsEntry
-	86,  // 64: providerserverv1.UpdateLinkIntermediaryResourcesRequest.link_type:type_name -> providerserverv1.LinkType
-	111, // 65: providerserverv1.UpdateLinkIntermediaryResourcesRequest.resource_a_info:type_name -> sharedtypesv1.ResourceInfo
-	111, // 66: providerserverv1.UpdateLinkIntermediaryResourcesRequest.resource_b_info:type_name -> sharedtypesv1.ResourceInfo
-	110, // 67: providerserverv1.UpdateLinkIntermediaryResourcesRequest.changes:type_name -> sharedtypesv1.LinkChanges
-	3,   // 68: providerserverv1.UpdateLinkIntermediaryResourcesRequest.update_type:type_name -> providerserverv1.LinkUpdateType
-	71,  // 69: providerserverv1.UpdateLinkIntermediaryResourcesRequest.current_link_state:type_name -> providerserverv1.LinkState
-	83,  // 70: providerserverv1.UpdateLinkIntermediaryResourcesRequest.context:type_name -> providerserverv1.LinkContext
-	42,  // 71: providerserverv1.UpdateLinkIntermediaryResourcesResponse.complete_response:type_name -> providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse
-	99,  // 72: providerserverv1.UpdateLinkIntermediaryResourcesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	72,  // 73: providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse.intermediary_resource_states:type_name -> providerserverv1.LinkIntermediaryResourceState
-	107, // 74: providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse.link_data:type_name -> schema.MappingNode
-	88,  // 75: providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse.resource_data_mappings:type_name -> providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse.ResourceDataMappingsEntry
-	68,  // 76: providerserverv1.LinkPriorityResourceResponse.priority_info:type_name -> providerserverv1.LinkPriorityResourceInfo
-	99,  // 77: providerserverv1.LinkPriorityResourceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	84,  // 78: providerserverv1.CustomValidateDataSourceRequest.data_source_type:type_name -> providerserverv1.DataSourceType
-	112, // 79: providerserverv1.CustomValidateDataSourceRequest.schema_data_source:type_name -> schema.DataSource
-	102, // 80: providerserverv1.CustomValidateDataSourceRequest.context:type_name -> sharedtypesv1.ProviderContext
-	46,  // 81: providerserverv1.CustomValidateDataSourceResponse.complete_response:type_name -> providerserverv1.CustomValidateDataSourceCompleteResponse
-	99,  // 82: providerserverv1.CustomValidateDataSourceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	103, // 83: providerserverv1.CustomValidateDataSourceCompleteResponse.diagnostics:type_name -> sharedtypesv1.Diagnostic
-	64,  // 84: providerserverv1.DataSourceSpecDefinitionResponse.spec_definition:type_name -> providerserverv1.DataSourceSpecDefinition
-	99,  // 85: providerserverv1.DataSourceSpecDefinitionResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	61,  // 86: providerserverv1.DataSourceFilterFieldsResponse.filter_fields:type_name -> providerserverv1.DataSourceFilterFields
-	99,  // 87: providerserverv1.DataSourceFilterFieldsResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	84,  // 88: providerserverv1.FetchDataSourceRequest.data_source_type:type_name -> providerserverv1.DataSourceType
-	57,  // 89: providerserverv1.FetchDataSourceRequest.data_source_with_resolved_subs:type_name -> providerserverv1.ResolvedDataSource
-	102, // 90: providerserverv1.FetchDataSourceRequest.context:type_name -> sharedtypesv1.ProviderContext
-	56,  // 91: providerserverv1.FetchDataSourceResponse.complete_response:type_name -> providerserverv1.FetchDataSourceCompleteResponse
-	99,  // 92: providerserverv1.FetchDataSourceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	52,  // 93: providerserverv1.CustomVariableTypeOptionsResponse.options:type_name -> providerserverv1.CustomVariableTypeOptions
-	99,  // 94: providerserverv1.CustomVariableTypeOptionsResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	89,  // 95: providerserverv1.CustomVariableTypeOptions.options:type_name -> providerserverv1.CustomVariableTypeOptions.OptionsEntry
-	113, // 96: providerserverv1.CustomVariableTypeOption.value:type_name -> schema.ScalarValue
-	55,  // 97: providerserverv1.CustomVariableTypeResponse.custom_var_type_info:type_name -> providerserverv1.CustomVariableTypeInfo
-	99,  // 98: providerserverv1.CustomVariableTypeResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	85,  // 99: providerserverv1.CustomVariableTypeInfo.type:type_name -> providerserverv1.CustomVariableType
-	90,  // 100: providerserverv1.FetchDataSourceCompleteResponse.data:type_name -> providerserverv1.FetchDataSourceCompleteResponse.DataEntry
-	84,  // 101: providerserverv1.ResolvedDataSource.type:type_name -> providerserverv1.DataSourceType
-	58,  // 102: providerserverv1.ResolvedDataSource.data_source_metadata:type_name -> providerserverv1.ResolvedDataSourceMetadata
-	59,  // 103: providerserverv1.ResolvedDataSource.filter:type_name -> providerserverv1.ResolvedDataSourceFilter
-	91,  // 104: providerserverv1.ResolvedDataSource.exports:type_name -> providerserverv1.ResolvedDataSource.ExportsEntry
-	107, // 105: providerserverv1.ResolvedDataSource.description:type_name -> schema.MappingNode
-	107, // 106: providerserverv1.ResolvedDataSourceMetadata.display_name:type_name -> schema.MappingNode
-	107, // 107: providerserverv1.ResolvedDataSourceMetadata.annotations:type_name -> schema.MappingNode
-	107, // 108: providerserverv1.ResolvedDataSourceMetadata.custom:type_name -> schema.MappingNode
-	113, // 109: providerserverv1.ResolvedDataSourceFilter.field:type_name -> schema.ScalarValue
-	60,  // 110: providerserverv1.ResolvedDataSourceFilter.search:type_name -> providerserverv1.ResolvedDataSourceFilterSearch
-	107, // 111: providerserverv1.ResolvedDataSourceFilterSearch.values:type_name -> schema.MappingNode
-	92,  // 112: providerserverv1.DataSourceFilterFields.filter_fields:type_name -> providerserverv1.DataSourceFilterFields.FilterFieldsEntry
-	113, // 113: providerserverv1.ResolvedDataSourceFieldExport.alias_for:type_name -> schema.ScalarValue
-	107, // 114: providerserverv1.ResolvedDataSourceFieldExport.description:type_name -> schema.MappingNode
-	93,  // 115: providerserverv1.DataSourceSpecDefinition.fields:type_name -> providerserverv1.DataSourceSpecDefinition.FieldsEntry
-	0,   // 116: providerserverv1.DataSourceSpecSchema.type:type_name -> providerserverv1.DataSourceSpecSchemaType
-	65,  // 117: providerserverv1.DataSourceSpecSchema.items:type_name -> providerserverv1.DataSourceSpecSchema
-	67,  // 118: providerserverv1.DataSourceTypeResponse.data_source_type_info:type_name -> providerserverv1.DataSourceTypeInfo
-	99,  // 119: providerserverv1.DataSourceTypeResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	84,  // 120: providerserverv1.DataSourceTypeInfo.type:type_name -> providerserverv1.DataSourceType
-	1,   // 121: providerserverv1.LinkPriorityResourceInfo.priority_resource:type_name -> providerserverv1.LinkPriorityResource
-	100, // 122: providerserverv1.LinkPriorityResourceInfo.priority_resource_type:type_name -> sharedtypesv1.ResourceType
-	70,  // 123: providerserverv1.LinkKindResponse.link_kind_info:type_name -> providerserverv1.LinkKindInfo
-	99,  // 124: providerserverv1.LinkKindResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	2,   // 125: providerserverv1.LinkKindInfo.kind:type_name -> providerserverv1.LinkKind
-	4,   // 126: providerserverv1.LinkState.status:type_name -> providerserverv1.LinkStatus
-	5,   // 127: providerserverv1.LinkState.precise_status:type_name -> providerserverv1.PreciseLinkStatus
-	72,  // 128: providerserverv1.LinkState.intermediary_resource_states:type_name -> providerserverv1.LinkIntermediaryResourceState
-	94,  // 129: providerserverv1.LinkState.data:type_name -> providerserverv1.LinkState.DataEntry
-	73,  // 130: providerserverv1.LinkState.durations:type_name -> providerserverv1.LinkCompletionDurations
-	114, // 131: providerserverv1.LinkIntermediaryResourceState.status:type_name -> sharedtypesv1.ResourceStatus
-	115, // 132: providerserverv1.LinkIntermediaryResourceState.precise_status:type_name -> sharedtypesv1.PreciseResourceStatus
-	107, // 133: providerserverv1.LinkIntermediaryResourceState.resource_spec_data:type_name -> schema.MappingNode
-	74,  // 134: providerserverv1.LinkCompletionDurations.resource_a_update:type_name -> providerserverv1.LinkComponentCompletionDurations
-	74,  // 135: providerserverv1.LinkCompletionDurations.resource_b_update:type_name -> providerserverv1.LinkComponentCompletionDurations
-	74,  // 136: providerserverv1.LinkCompletionDurations.intermediary_resources:type_name -> providerserverv1.LinkComponentCompletionDurations
-	116, // 137: providerserverv1.LinkCompletionDurations.total_duration:type_name -> google.protobuf.DoubleValue
-	116, // 138: providerserverv1.LinkComponentCompletionDurations.total_duration:type_name -> google.protobuf.DoubleValue
-	86,  // 139: providerserverv1.LinkRequest.link_type:type_name -> providerserverv1.LinkType
-	83,  // 140: providerserverv1.LinkRequest.context:type_name -> providerserverv1.LinkContext
-	86,  // 141: providerserverv1.GetLinkIntermediaryExternalStateRequest.link_type:type_name -> providerserverv1.LinkType
-	111, // 142: providerserverv1.GetLinkIntermediaryExternalStateRequest.resource_a_info:type_name -> sharedtypesv1.ResourceInfo
-	111, // 143: providerserverv1.GetLinkIntermediaryExternalStateRequest.resource_b_info:type_name -> sharedtypesv1.ResourceInfo
-	71,  // 144: providerserverv1.GetLinkIntermediaryExternalStateRequest.current_link_state:type_name -> providerserverv1.LinkState
-	83,  // 145: providerserverv1.GetLinkIntermediaryExternalStateRequest.context:type_name -> providerserverv1.LinkContext
-	78,  // 146: providerserverv1.GetLinkIntermediaryExternalStateResponse.complete_response:type_name -> providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse
-	99,  // 147: providerserverv1.GetLinkIntermediaryExternalStateResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	95,  // 148: providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse.intermediary_states:type_name -> providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse.IntermediaryStatesEntry
-	107, // 149: providerserverv1.ValidateLinkRequest.resource_a_spec:type_name -> schema.MappingNode
-	107, // 150: providerserverv1.ValidateLinkRequest.resource_b_spec:type_name -> schema.MappingNode
-	100, // 151: providerserverv1.ValidateLinkRequest.resource_a_type:type_name -> sharedtypesv1.ResourceType
-	100, // 152: providerserverv1.ValidateLinkRequest.resource_b_type:type_name -> sharedtypesv1.ResourceType
-	96,  // 153: providerserverv1.ValidateLinkRequest.annotations:type_name -> providerserverv1.ValidateLinkRequest.AnnotationsEntry
-	83,  // 154: providerserverv1.ValidateLinkRequest.link_context:type_name -> providerserverv1.LinkContext
-	81,  // 155: providerserverv1.ValidateLinkResponse.complete_response:type_name -> providerserverv1.ValidateLinkCompleteResponse
-	99,  // 156: providerserverv1.ValidateLinkResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
-	103, // 157: providerserverv1.ValidateLinkCompleteResponse.diagnostics:type_name -> sharedtypesv1.Diagnostic
-	107, // 158: providerserverv1.IntermediaryExternalState.spec_data:type_name -> schema.MappingNode
-	97,  // 159: providerserverv1.LinkContext.provider_config_variables:type_name -> providerserverv1.LinkContext.ProviderConfigVariablesEntry
-	98,  // 160: providerserverv1.LinkContext.context_variables:type_name -> providerserverv1.LinkContext.ContextVariablesEntry
-	53,  // 161: providerserverv1.CustomVariableTypeOptions.OptionsEntry.value:type_name -> providerserverv1.CustomVariableTypeOption
-	107, // 162: providerserverv1.FetchDataSourceCompleteResponse.DataEntry.value:type_name -> schema.MappingNode
-	63,  // 163: providerserverv1.ResolvedDataSource.ExportsEntry.value:type_name -> providerserverv1.ResolvedDataSourceFieldExport
-	62,  // 164: providerserverv1.DataSourceFilterFields.FilterFieldsEntry.value:type_name -> providerserverv1.DataSourceFilterFieldSchema
-	65,  // 165: providerserverv1.DataSourceSpecDefinition.FieldsEntry.value:type_name -> providerserverv1.DataSourceSpecSchema
-	107, // 166: providerserverv1.LinkState.DataEntry.value:type_name -> schema.MappingNode
-	82,  // 167: providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse.IntermediaryStatesEntry.value:type_name -> providerserverv1.IntermediaryExternalState
-	113, // 168: providerserverv1.ValidateLinkRequest.AnnotationsEntry.value:type_name -> schema.ScalarValue
-	113, // 169: providerserverv1.LinkContext.ProviderConfigVariablesEntry.value:type_name -> schema.ScalarValue
-	113, // 170: providerserverv1.LinkContext.ContextVariablesEntry.value:type_name -> schema.ScalarValue
-	30,  // 171: providerserverv1.Provider.GetNamespace:input_type -> providerserverv1.ProviderRequest
-	30,  // 172: providerserverv1.Provider.GetConfigDefinition:input_type -> providerserverv1.ProviderRequest
-	30,  // 173: providerserverv1.Provider.ListResourceTypes:input_type -> providerserverv1.ProviderRequest
-	30,  // 174: providerserverv1.Provider.ListLinkTypes:input_type -> providerserverv1.ProviderRequest
-	30,  // 175: providerserverv1.Provider.ListDataSourceTypes:input_type -> providerserverv1.ProviderRequest
-	30,  // 176: providerserverv1.Provider.ListCustomVariableTypes:input_type -> providerserverv1.ProviderRequest
-	30,  // 177: providerserverv1.Provider.ListFunctions:input_type -> providerserverv1.ProviderRequest
-	30,  // 178: providerserverv1.Provider.GetRetryPolicy:input_type -> providerserverv1.ProviderRequest
-	20,  // 179: providerserverv1.Provider.CustomValidateResource:input_type -> providerserverv1.CustomValidateResourceRequest
-	31,  // 180: providerserverv1.Provider.GetResourceSpecDefinition:input_type -> providerserverv1.ResourceRequest
-	31,  // 181: providerserverv1.Provider.CanResourceLinkTo:input_type -> providerserverv1.ResourceRequest
-	31,  // 182: providerserverv1.Provider.GetResourceStabilisedDeps:input_type -> providerserverv1.ResourceRequest
-	31,  // 183: providerserverv1.Provider.IsResourceCommonTerminal:input_type -> providerserverv1.ResourceRequest
-	31,  // 184: providerserverv1.Provider.GetResourceType:input_type -> providerserverv1.ResourceRequest
-	31,  // 185: providerserverv1.Provider.GetResourceTypeDescription:input_type -> providerserverv1.ResourceRequest
-	31,  // 186: providerserverv1.Provider.GetResourceExamples:input_type -> providerserverv1.ResourceRequest
-	117, // 187: providerserverv1.Provider.DeployResource:input_type -> sharedtypesv1.DeployResourceRequest
-	118, // 188: providerserverv1.Provider.ResourceHasStabilised:input_type -> sharedtypesv1.ResourceHasStabilisedRequest
-	28,  // 189: providerserverv1.Provider.GetResourceExternalState:input_type -> providerserverv1.GetResourceExternalStateRequest
-	119, // 190: providerserverv1.Provider.DestroyResource:input_type -> sharedtypesv1.DestroyResourceRequest
-	34,  // 191: providerserverv1.Provider.StageLinkChanges:input_type -> providerserverv1.StageLinkChangesRequest
-	37,  // 192: providerserverv1.Provider.UpdateLinkResourceA:input_type -> providerserverv1.UpdateLinkResourceRequest
-	37,  // 193: providerserverv1.Provider.UpdateLinkResourceB:input_type -> providerserverv1.UpdateLinkResourceRequest
-	40,  // 194: providerserverv1.Provider.UpdateLinkIntermediaryResources:input_type -> providerserverv1.UpdateLinkIntermediaryResourcesRequest
-	75,  // 195: providerserverv1.Provider.GetLinkPriorityResource:input_type -> providerserverv1.LinkRequest
-	75,  // 196: providerserverv1.Provider.GetLinkTypeDescription:input_type -> providerserverv1.LinkRequest
-	75,  // 197: providerserverv1.Provider.GetLinkAnnotationDefinitions:input_type -> providerserverv1.LinkRequest
-	75,  // 198: providerserverv1.Provider.GetLinkKind:input_type -> providerserverv1.LinkRequest
-	76,  // 199: providerserverv1.Provider.GetLinkIntermediaryExternalState:input_type -> providerserverv1.GetLinkIntermediaryExternalStateRequest
-	75,  // 200: providerserverv1.Provider.GetLinkCardinality:input_type -> providerserverv1.LinkRequest
-	79,  // 201: providerserverv1.Provider.ValidateLink:input_type -> providerserverv1.ValidateLinkRequest
-	32,  // 202: providerserverv1.Provider.GetDataSourceType:input_type -> providerserverv1.DataSourceRequest
-	32,  // 203: providerserverv1.Provider.GetDataSourceTypeDescription:input_type -> providerserverv1.DataSourceRequest
-	32,  // 204: providerserverv1.Provider.GetDataSourceExamples:input_type -> providerserverv1.DataSourceRequest
-	44,  // 205: providerserverv1.Provider.CustomValidateDataSource:input_type -> providerserverv1.CustomValidateDataSourceRequest
-	32,  // 206: providerserverv1.Provider.GetDataSourceSpecDefinition:input_type -> providerserverv1.DataSourceRequest
-	32,  // 207: providerserverv1.Provider.GetDataSourceFilterFields:input_type -> providerserverv1.DataSourceRequest
-	49,  // 208: providerserverv1.Provider.FetchDataSource:input_type -> providerserverv1.FetchDataSourceRequest
-	33,  // 209: providerserverv1.Provider.GetCustomVariableType:input_type -> providerserverv1.CustomVariableTypeRequest
-	33,  // 210: providerserverv1.Provider.GetCustomVariableTypeDescription:input_type -> providerserverv1.CustomVariableTypeRequest
-	33,  // 211: providerserverv1.Provider.GetCustomVariableTypeOptions:input_type -> providerserverv1.CustomVariableTypeRequest
-	33,  // 212: providerserverv1.Provider.GetCustomVariableTypeExamples:input_type -> providerserverv1.CustomVariableTypeRequest
-	120, // 213: providerserverv1.Provider.GetFunctionDefinition:input_type -> sharedtypesv1.FunctionDefinitionRequest
-	121, // 214: providerserverv1.Provider.CallFunction:input_type -> sharedtypesv1.FunctionCallRequest
-	6,   // 215: providerserverv1.Provider.GetNamespace:output_type -> providerserverv1.NamespaceResponse
-	122, // 216: providerserverv1.Provider.GetConfigDefinition:output_type -> sharedtypesv1.ConfigDefinitionResponse
-	8,   // 217: providerserverv1.Provider.ListResourceTypes:output_type -> providerserverv1.ResourceTypesResponse
-	10,  // 218: providerserverv1.Provider.ListLinkTypes:output_type -> providerserverv1.LinkTypesResponse
-	12,  // 219: providerserverv1.Provider.ListDataSourceTypes:output_type -> providerserverv1.DataSourceTypesResponse
-	14,  // 220: providerserverv1.Provider.ListCustomVariableTypes:output_type -> providerserverv1.CustomVariableTypesResponse
-	16,  // 221: providerserverv1.Provider.ListFunctions:output_type -> providerserverv1.FunctionListResponse
-	18,  // 222: providerserverv1.Provider.GetRetryPolicy:output_type -> providerserverv1.RetryPolicyResponse
-	21,  // 223: providerserverv1.Provider.CustomValidateResource:output_type -> providerserverv1.CustomValidateResourceResponse
-	23,  // 224: providerserverv1.Provider.GetResourceSpecDefinition:output_type -> providerserverv1.ResourceSpecDefinitionResponse
-	24,  // 225: providerserverv1.Provider.CanResourceLinkTo:output_type -> providerserverv1.CanResourceLinkToResponse
-	25,  // 226: providerserverv1.Provider.GetResourceStabilisedDeps:output_type -> providerserverv1.ResourceStabilisedDepsResponse
-	27,  // 227: providerserverv1.Provider.IsResourceCommonTerminal:output_type -> providerserverv1.IsResourceCommonTerminalResponse
-	123, // 228: providerserverv1.Provider.GetResourceType:output_type -> sharedtypesv1.ResourceTypeResponse
-	124, // 229: providerserverv1.Provider.GetResourceTypeDescription:output_type -> sharedtypesv1.TypeDescriptionResponse
-	125, // 230: providerserverv1.Provider.GetResourceExamples:output_type -> sharedtypesv1.ExamplesResponse
-	126, // 231: providerserverv1.Provider.DeployResource:output_type -> sharedtypesv1.DeployResourceResponse
-	127, // 232: providerserverv1.Provider.ResourceHasStabilised:output_type -> sharedtypesv1.ResourceHasStabilisedResponse
-	29,  // 233: providerserverv1.Provider.GetResourceExternalState:output_type -> providerserverv1.GetResourceExternalStateResponse
-	128, // 234: providerserverv1.Provider.DestroyResource:output_type -> sharedtypesv1.DestroyResourceResponse
-	35,  // 235: providerserverv1.Provider.StageLinkChanges:output_type -> providerserverv1.StageLinkChangesResponse
-	38,  // 236: providerserverv1.Provider.UpdateLinkResourceA:output_type -> providerserverv1.UpdateLinkResourceResponse
-	38,  // 237: providerserverv1.Provider.UpdateLinkResourceB:output_type -> providerserverv1.UpdateLinkResourceResponse
-	41,  // 238: providerserverv1.Provider.UpdateLinkIntermediaryResources:output_type -> providerserverv1.UpdateLinkIntermediaryResourcesResponse
-	43,  // 239: providerserverv1.Provider.GetLinkPriorityResource:output_type -> providerserverv1.LinkPriorityResourceResponse
-	124, // 240: providerserverv1.Provider.GetLinkTypeDescription:output_type -> sharedtypesv1.TypeDescriptionResponse
-	129, // 241: providerserverv1.Provider.GetLinkAnnotationDefinitions:output_type -> sharedtypesv1.LinkAnnotationDefinitionsResponse
-	69,  // 242: providerserverv1.Provider.GetLinkKind:output_type -> providerserverv1.LinkKindResponse
-	77,  // 243: providerserverv1.Provider.GetLinkIntermediaryExternalState:output_type -> providerserverv1.GetLinkIntermediaryExternalStateResponse
-	130, // 244: providerserverv1.Provider.GetLinkCardinality:output_type -> sharedtypesv1.LinkCardinalityResponse
-	80,  // 245: providerserverv1.Provider.ValidateLink:output_type -> providerserverv1.ValidateLinkResponse
-	66,  // 246: providerserverv1.Provider.GetDataSourceType:output_type -> providerserverv1.DataSourceTypeResponse
-	124, // 247: providerserverv1.Provider.GetDataSourceTypeDescription:output_type -> sharedtypesv1.TypeDescriptionResponse
-	125, // 248: providerserverv1.Provider.GetDataSourceExamples:output_type -> sharedtypesv1.ExamplesResponse
-	45,  // 249: providerserverv1.Provider.CustomValidateDataSource:output_type -> providerserverv1.CustomValidateDataSourceResponse
-	47,  // 250: providerserverv1.Provider.GetDataSourceSpecDefinition:output_type -> providerserverv1.DataSourceSpecDefinitionResponse
-	48,  // 251: providerserverv1.Provider.GetDataSourceFilterFields:output_type -> providerserverv1.DataSourceFilterFieldsResponse
-	50,  // 252: providerserverv1.Provider.FetchDataSource:output_type -> providerserverv1.FetchDataSourceResponse
-	54,  // 253: providerserverv1.Provider.GetCustomVariableType:output_type -> providerserverv1.CustomVariableTypeResponse
-	124, // 254: providerserverv1.Provider.GetCustomVariableTypeDescription:output_type -> sharedtypesv1.TypeDescriptionResponse
-	51,  // 255: providerserverv1.Provider.GetCustomVariableTypeOptions:output_type -> providerserverv1.CustomVariableTypeOptionsResponse
-	125, // 256: providerserverv1.Provider.GetCustomVariableTypeExamples:output_type -> sharedtypesv1.ExamplesResponse
-	131, // 257: providerserverv1.Provider.GetFunctionDefinition:output_type -> sharedtypesv1.FunctionDefinitionResponse
-	132, // 258: providerserverv1.Provider.CallFunction:output_type -> sharedtypesv1.FunctionCallResponse
-	215, // [215:259] is the sub-list for method output_type
-	171, // [171:215] is the sub-list for method input_type
-	171, // [171:171] is the sub-list for extension type_name
-	171, // [171:171] is the sub-list for extension extendee
-	0,   // [0:171] is the sub-list for field type_name
+	105, // 29: providerserverv1.ResourceStabilisedDepsResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	106, // 30: providerserverv1.StabilisedDependencies.resource_types:type_name -> sharedtypesv1.ResourceType
+	112, // 31: providerserverv1.IsResourceCommonTerminalResponse.data:type_name -> sharedtypesv1.ResourceCommonTerminalInfo
+	105, // 32: providerserverv1.IsResourceCommonTerminalResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	106, // 33: providerserverv1.GetResourceExternalStateRequest.resource_type:type_name -> sharedtypesv1.ResourceType
+	113, // 34: providerserverv1.GetResourceExternalStateRequest.current_resource_spec:type_name -> schema.MappingNode
+	114, // 35: providerserverv1.GetResourceExternalStateRequest.current_resource_metadata:type_name -> sharedtypesv1.ResourceMetadataState
+	108, // 36: providerserverv1.GetResourceExternalStateRequest.context:type_name -> sharedtypesv1.ProviderContext
+	113, // 37: providerserverv1.GetResourceExternalStateResponse.resource_spec_state:type_name -> schema.MappingNode
+	105, // 38: providerserverv1.GetResourceExternalStateResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	106, // 39: providerserverv1.ListResourcesRequest.resource_type:type_name -> sharedtypesv1.ResourceType
+	108, // 40: providerserverv1.ListResourcesRequest.context:type_name -> sharedtypesv1.ProviderContext
+	32,  // 41: providerserverv1.ListResourcesResponse.complete_response:type_name -> providerserverv1.ListResourcesCompleteResponse
+	105, // 42: providerserverv1.ListResourcesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	33,  // 43: providerserverv1.ListResourcesCompleteResponse.resources:type_name -> providerserverv1.ExternalResource
+	106, // 44: providerserverv1.ResourceRequest.resource_type:type_name -> sharedtypesv1.ResourceType
+	108, // 45: providerserverv1.ResourceRequest.context:type_name -> sharedtypesv1.ProviderContext
+	90,  // 46: providerserverv1.DataSourceRequest.data_source_type:type_name -> providerserverv1.DataSourceType
+	108, // 47: providerserverv1.DataSourceRequest.context:type_name -> sharedtypesv1.ProviderContext
+	91,  // 48: providerserverv1.CustomVariableTypeRequest.custom_variable_type:type_name -> providerserverv1.CustomVariableType
+	108, // 49: providerserverv1.CustomVariableTypeRequest.context:type_name -> sharedtypesv1.ProviderContext
+	92,  // 50: providerserverv1.StageLinkChangesRequest.link_type:type_name -> providerserverv1.LinkType
+	115, // 51: providerserverv1.StageLinkChangesRequest.resource_a_changes:type_name -> sharedtypesv1.Changes
+	115, // 52: providerserverv1.StageLinkChangesRequest.resource_b_changes:type_name -> sharedtypesv1.Changes
+	77,  // 53: providerserverv1.StageLinkChangesRequest.current_link_state:type_name -> providerserverv1.LinkState
+	89,  // 54: providerserverv1.StageLinkChangesRequest.context:type_name -> providerserverv1.LinkContext
+	113, // 55: providerserverv1.StageLinkChangesRequest.link_config:type_name -> schema.MappingNode
+	40,  // 56: providerserverv1.StageLinkChangesResponse.complete_response:type_name -> providerserverv1.StageLinkChangesCompleteResponse
+	105, // 57: providerserverv1.StageLinkChangesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	116, // 58: providerserverv1.StageLinkChangesCompleteResponse.changes:type_name -> sharedtypesv1.LinkChanges
+	92,  // 59: providerserverv1.UpdateLinkResourceRequest.link_type:type_name -> providerserverv1.LinkType
+	116, // 60: providerserverv1.UpdateLinkResourceRequest.changes:type_name -> sharedtypesv1.LinkChanges
+	117, // 61: providerserverv1.UpdateLinkResourceRequest.resource_info:type_name -> sharedtypesv1.ResourceInfo
+	117, // 62: providerserverv1.UpdateLinkResourceRequest.other_resource_info:type_name -> sharedtypesv1.ResourceInfo
+	3,   // 63: providerserverv1.UpdateLinkResourceRequest.update_type:type_name -> providerserverv1.LinkUpdateType
+	77,  // 64: providerserverv1.UpdateLinkResourceRequest.current_link_state:type_name -> providerserverv1.LinkState
+	89,  // 65: providerserverv1.UpdateLinkResourceRequest.context:type_name -> providerserverv1.LinkContext
+	113, // 66: providerserverv1.UpdateLinkResourceRequest.link_config:type_name -> schema.MappingNode
+	43,  // 67: providerserverv1.UpdateLinkResourceResponse.complete_response:type_name -> providerserverv1.UpdateLinkResourceCompleteResponse
+	105, // 68: providerserverv1.UpdateLinkResourceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	113, // 69: providerserverv1.UpdateLinkResourceCompleteResponse.link_data:type_name -> schema.MappingNode
+	93,  // 70: providerserverv1.UpdateLinkResourceCompleteResponse.resource_data_mappings:type_name -> providerserverv1.UpdateLinkResourceCompleteResponse.ResourceDataMappingsEntry
+	92,  // 71: providerserverv1.UpdateLinkIntermediaryResourcesRequest.link_type:type_name -> providerserverv1.LinkType
+	117, // 72: providerserverv1.UpdateLinkIntermediaryResourcesRequest.resource_a_info:type_name -> sharedtypesv1.ResourceInfo
+	117, // 73: providerserverv1.UpdateLinkIntermediaryResourcesRequest.resource_b_info:type_name -> sharedtypesv1.ResourceInfo
+	116, // 74: providerserverv1.UpdateLinkIntermediaryResourcesRequest.changes:type_name -> sharedtypesv1.LinkChanges
+	3,   // 75: providerserverv1.UpdateLinkIntermediaryResourcesRequest.update_type:type_name -> providerserverv1.LinkUpdateType
+	77,  // 76: providerserverv1.UpdateLinkIntermediaryResourcesRequest.current_link_state:type_name -> providerserverv1.LinkState
+	89,  // 77: providerserverv1.UpdateLinkIntermediaryResourcesRequest.context:type_name -> providerserverv1.LinkContext
+	113, // 78: providerserverv1.UpdateLinkIntermediaryResourcesRequest.link_config:type_name -> schema.MappingNode
+	46,  // 79: providerserverv1.UpdateLinkIntermediaryResourcesResponse.complete_response:type_name -> providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse
+	105, // 80: providerserverv1.UpdateLinkIntermediaryResourcesResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	78,  // 81: providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse.intermediary_resource_states:type_name -> providerserverv1.LinkIntermediaryResourceState
+	113, // 82: providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse.link_data:type_name -> schema.MappingNode
+	94,  // 83: providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse.resource_data_mappings:type_name -> providerserverv1.UpdateLinkIntermediaryResourcesCompleteResponse.ResourceDataMappingsEntry
+	74,  // 84: providerserverv1.LinkPriorityResourceResponse.priority_info:type_name -> providerserverv1.LinkPriorityResourceInfo
+	105, // 85: providerserverv1.LinkPriorityResourceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	90,  // 86: providerserverv1.CustomValidateDataSourceRequest.data_source_type:type_name -> providerserverv1.DataSourceType
+	118, // 87: providerserverv1.CustomValidateDataSourceRequest.schema_data_source:type_name -> schema.DataSource
+	108, // 88: providerserverv1.CustomValidateDataSourceRequest.context:type_name -> sharedtypesv1.ProviderContext
+	50,  // 89: providerserverv1.CustomValidateDataSourceResponse.complete_response:type_name -> providerserverv1.CustomValidateDataSourceCompleteResponse
+	105, // 90: providerserverv1.CustomValidateDataSourceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	109, // 91: providerserverv1.CustomValidateDataSourceCompleteResponse.diagnostics:type_name -> sharedtypesv1.Diagnostic
+	68,  // 92: providerserverv1.DataSourceSpecDefinitionResponse.spec_definition:type_name -> providerserverv1.DataSourceSpecDefinition
+	105, // 93: providerserverv1.DataSourceSpecDefinitionResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	65,  // 94: providerserverv1.DataSourceFilterFieldsResponse.filter_fields:type_name -> providerserverv1.DataSourceFilterFields
+	105, // 95: providerserverv1.DataSourceFilterFieldsResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	90,  // 96: providerserverv1.FetchDataSourceRequest.data_source_type:type_name -> providerserverv1.DataSourceType
+	61,  // 97: providerserverv1.FetchDataSourceRequest.data_source_with_resolved_subs:type_name -> providerserverv1.ResolvedDataSource
+	108, // 98: providerserverv1.FetchDataSourceRequest.context:type_name -> sharedtypesv1.ProviderContext
+	60,  // 99: providerserverv1.FetchDataSourceResponse.complete_response:type_name -> providerserverv1.FetchDataSourceCompleteResponse
+	105, // 100: providerserverv1.FetchDataSourceResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	56,  // 101: providerserverv1.CustomVariableTypeOptionsResponse.options:type_name -> providerserverv1.CustomVariableTypeOptions
+	105, // 102: providerserverv1.CustomVariableTypeOptionsResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	95,  // 103: providerserverv1.CustomVariableTypeOptions.options:type_name -> providerserverv1.CustomVariableTypeOptions.OptionsEntry
+	119, // 104: providerserverv1.CustomVariableTypeOption.value:type_name -> schema.ScalarValue
+	59,  // 105: providerserverv1.CustomVariableTypeResponse.custom_var_type_info:type_name -> providerserverv1.CustomVariableTypeInfo
+	105, // 106: providerserverv1.CustomVariableTypeResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	91,  // 107: providerserverv1.CustomVariableTypeInfo.type:type_name -> providerserverv1.CustomVariableType
+	96,  // 108: providerserverv1.FetchDataSourceCompleteResponse.data:type_name -> providerserverv1.FetchDataSourceCompleteResponse.DataEntry
+	90,  // 109: providerserverv1.ResolvedDataSource.type:type_name -> providerserverv1.DataSourceType
+	62,  // 110: providerserverv1.ResolvedDataSource.data_source_metadata:type_name -> providerserverv1.ResolvedDataSourceMetadata
+	63,  // 111: providerserverv1.ResolvedDataSource.filter:type_name -> providerserverv1.ResolvedDataSourceFilter
+	97,  // 112: providerserverv1.ResolvedDataSource.exports:type_name -> providerserverv1.ResolvedDataSource.ExportsEntry
+	113, // 113: providerserverv1.ResolvedDataSource.description:type_name -> schema.MappingNode
+	113, // 114: providerserverv1.ResolvedDataSourceMetadata.display_name:type_name -> schema.MappingNode
+	113, // 115: providerserverv1.ResolvedDataSourceMetadata.annotations:type_name -> schema.MappingNode
+	113, // 116: providerserverv1.ResolvedDataSourceMetadata.custom:type_name -> schema.MappingNode
+	119, // 117: providerserverv1.ResolvedDataSourceFilter.field:type_name -> schema.ScalarValue
+	64,  // 118: providerserverv1.ResolvedDataSourceFilter.search:type_name -> providerserverv1.ResolvedDataSourceFilterSearch
+	113, // 119: providerserverv1.ResolvedDataSourceFilterSearch.values:type_name -> schema.MappingNode
+	98,  // 120: providerserverv1.DataSourceFilterFields.filter_fields:type_name -> providerserverv1.DataSourceFilterFields.FilterFieldsEntry
+	119, // 121: providerserverv1.ResolvedDataSourceFieldExport.alias_for:type_name -> schema.ScalarValue
+	113, // 122: providerserverv1.ResolvedDataSourceFieldExport.description:type_name -> schema.MappingNode
+	99,  // 123: providerserverv1.DataSourceSpecDefinition.fields:type_name -> providerserverv1.DataSourceSpecDefinition.FieldsEntry
+	0,   // 124: providerserverv1.DataSourceSpecSchema.type:type_name -> providerserverv1.DataSourceSpecSchemaType
+	69,  // 125: providerserverv1.DataSourceSpecSchema.items:type_name -> providerserverv1.DataSourceSpecSchema
+	71,  // 126: providerserverv1.DataSourceTypeResponse.data_source_type_info:type_name -> providerserverv1.DataSourceTypeInfo
+	105, // 127: providerserverv1.DataSourceTypeResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	90,  // 128: providerserverv1.DataSourceTypeInfo.type:type_name -> providerserverv1.DataSourceType
+	73,  // 129: providerserverv1.LinkConfigDefinitionResponse.config_definition:type_name -> providerserverv1.LinkConfigDefinition
+	105, // 130: providerserverv1.LinkConfigDefinitionResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	120, // 131: providerserverv1.LinkConfigDefinition.schema:type_name -> sharedtypesv1.ResourceDefinitionsSchema
+	1,   // 132: providerserverv1.LinkPriorityResourceInfo.priority_resource:type_name -> providerserverv1.LinkPriorityResource
+	106, // 133: providerserverv1.LinkPriorityResourceInfo.priority_resource_type:type_name -> sharedtypesv1.ResourceType
+	76,  // 134: providerserverv1.LinkKindResponse.link_kind_info:type_name -> providerserverv1.LinkKindInfo
+	105, // 135: providerserverv1.LinkKindResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	2,   // 136: providerserverv1.LinkKindInfo.kind:type_name -> providerserverv1.LinkKind
+	4,   // 137: providerserverv1.LinkState.status:type_name -> providerserverv1.LinkStatus
+	5,   // 138: providerserverv1.LinkState.precise_status:type_name -> providerserverv1.PreciseLinkStatus
+	78,  // 139: providerserverv1.LinkState.intermediary_resource_states:type_name -> providerserverv1.LinkIntermediaryResourceState
+	100, // 140: providerserverv1.LinkState.data:type_name -> providerserverv1.LinkState.DataEntry
+	79,  // 141: providerserverv1.LinkState.durations:type_name -> providerserverv1.LinkCompletionDurations
+	121, // 142: providerserverv1.LinkIntermediaryResourceState.status:type_name -> sharedtypesv1.ResourceStatus
+	122, // 143: providerserverv1.LinkIntermediaryResourceState.precise_status:type_name -> sharedtypesv1.PreciseResourceStatus
+	113, // 144: providerserverv1.LinkIntermediaryResourceState.resource_spec_data:type_name -> schema.MappingNode
+	80,  // 145: providerserverv1.LinkCompletionDurations.resource_a_update:type_name -> providerserverv1.LinkComponentCompletionDurations
+	80,  // 146: providerserverv1.LinkCompletionDurations.resource_b_update:type_name -> providerserverv1.LinkComponentCompletionDurations
+	80,  // 147: providerserverv1.LinkCompletionDurations.intermediary_resources:type_name -> providerserverv1.LinkComponentCompletionDurations
+	123, // 148: providerserverv1.LinkCompletionDurations.total_duration:type_name -> google.protobuf.DoubleValue
+	123, // 149: providerserverv1.LinkComponentCompletionDurations.total_duration:type_name -> google.protobuf.DoubleValue
+	92,  // 150: providerserverv1.LinkRequest.link_type:type_name -> providerserverv1.LinkType
+	89,  // 151: providerserverv1.LinkRequest.context:type_name -> providerserverv1.LinkContext
+	92,  // 152: providerserverv1.GetLinkIntermediaryExternalStateRequest.link_type:type_name -> providerserverv1.LinkType
+	117, // 153: providerserverv1.GetLinkIntermediaryExternalStateRequest.resource_a_info:type_name -> sharedtypesv1.ResourceInfo
+	117, // 154: providerserverv1.GetLinkIntermediaryExternalStateRequest.resource_b_info:type_name -> sharedtypesv1.ResourceInfo
+	77,  // 155: providerserverv1.GetLinkIntermediaryExternalStateRequest.current_link_state:type_name -> providerserverv1.LinkState
+	89,  // 156: providerserverv1.GetLinkIntermediaryExternalStateRequest.context:type_name -> providerserverv1.LinkContext
+	84,  // 157: providerserverv1.GetLinkIntermediaryExternalStateResponse.complete_response:type_name -> providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse
+	105, // 158: providerserverv1.GetLinkIntermediaryExternalStateResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	101, // 159: providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse.intermediary_states:type_name -> providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse.IntermediaryStatesEntry
+	113, // 160: providerserverv1.ValidateLinkRequest.resource_a_spec:type_name -> schema.MappingNode
+	113, // 161: providerserverv1.ValidateLinkRequest.resource_b_spec:type_name -> schema.MappingNode
+	106, // 162: providerserverv1.ValidateLinkRequest.resource_a_type:type_name -> sharedtypesv1.ResourceType
+	106, // 163: providerserverv1.ValidateLinkRequest.resource_b_type:type_name -> sharedtypesv1.ResourceType
+	102, // 164: providerserverv1.ValidateLinkRequest.annotations:type_name -> providerserverv1.ValidateLinkRequest.AnnotationsEntry
+	89,  // 165: providerserverv1.ValidateLinkRequest.link_context:type_name -> providerserverv1.LinkContext
+	87,  // 166: providerserverv1.ValidateLinkResponse.complete_response:type_name -> providerserverv1.ValidateLinkCompleteResponse
+	105, // 167: providerserverv1.ValidateLinkResponse.error_response:type_name -> sharedtypesv1.ErrorResponse
+	109, // 168: providerserverv1.ValidateLinkCompleteResponse.diagnostics:type_name -> sharedtypesv1.Diagnostic
+	113, // 169: providerserverv1.IntermediaryExternalState.spec_data:type_name -> schema.MappingNode
+	103, // 170: providerserverv1.LinkContext.provider_config_variables:type_name -> providerserverv1.LinkContext.ProviderConfigVariablesEntry
+	104, // 171: providerserverv1.LinkContext.context_variables:type_name -> providerserverv1.LinkContext.ContextVariablesEntry
+	57,  // 172: providerserverv1.CustomVariableTypeOptions.OptionsEntry.value:type_name -> providerserverv1.CustomVariableTypeOption
+	113, // 173: providerserverv1.FetchDataSourceCompleteResponse.DataEntry.value:type_name -> schema.MappingNode
+	67,  // 174: providerserverv1.ResolvedDataSource.ExportsEntry.value:type_name -> providerserverv1.ResolvedDataSourceFieldExport
+	66,  // 175: providerserverv1.DataSourceFilterFields.FilterFieldsEntry.value:type_name -> providerserverv1.DataSourceFilterFieldSchema
+	69,  // 176: providerserverv1.DataSourceSpecDefinition.FieldsEntry.value:type_name -> providerserverv1.DataSourceSpecSchema
+	113, // 177: providerserverv1.LinkState.DataEntry.value:type_name -> schema.MappingNode
+	88,  // 178: providerserverv1.GetLinkIntermediaryExternalStateCompleteResponse.IntermediaryStatesEntry.value:type_name -> providerserverv1.IntermediaryExternalState
+	119, // 179: providerserverv1.ValidateLinkRequest.AnnotationsEntry.value:type_name -> schema.ScalarValue
+	119, // 180: providerserverv1.LinkContext.ProviderConfigVariablesEntry.value:type_name -> schema.ScalarValue
+	119, // 181: providerserverv1.LinkContext.ContextVariablesEntry.value:type_name -> schema.ScalarValue
+	34,  // 182: providerserverv1.Provider.GetNamespace:input_type -> providerserverv1.ProviderRequest
+	34,  // 183: providerserverv1.Provider.GetConfigDefinition:input_type -> providerserverv1.ProviderRequest
+	34,  // 184: providerserverv1.Provider.ListResourceTypes:input_type -> providerserverv1.ProviderRequest
+	34,  // 185: providerserverv1.Provider.ListLinkTypes:input_type -> providerserverv1.ProviderRequest
+	34,  // 186: providerserverv1.Provider.ListDataSourceTypes:input_type -> providerserverv1.ProviderRequest
+	34,  // 187: providerserverv1.Provider.ListCustomVariableTypes:input_type -> providerserverv1.ProviderRequest
+	34,  // 188: providerserverv1.Provider.ListFunctions:input_type -> providerserverv1.ProviderRequest
+	34,  // 189: providerserverv1.Provider.GetRetryPolicy:input_type -> providerserverv1.ProviderRequest
+	20,  // 190: providerserverv1.Provider.CustomValidateResource:input_type -> providerserverv1.CustomValidateResourceRequest
+	35,  // 191: providerserverv1.Provider.GetResourceSpecDefinition:input_type -> providerserverv1.ResourceRequest
+	35,  // 192: providerserverv1.Provider.CanResourceLinkTo:input_type -> providerserverv1.ResourceRequest
+	35,  // 193: providerserverv1.Provider.GetResourceStabilisedDeps:input_type -> providerserverv1.ResourceRequest
+	35,  // 194: providerserverv1.Provider.IsResourceCommonTerminal:input_type -> providerserverv1.ResourceRequest
+	35,  // 195: providerserverv1.Provider.GetResourceType:input_type -> providerserverv1.ResourceRequest
+	35,  // 196: providerserverv1.Provider.GetResourceTypeDescription:input_type -> providerserverv1.ResourceRequest
+	35,  // 197: providerserverv1.Provider.GetResourceExamples:input_type -> providerserverv1.ResourceRequest
+	124, // 198: providerserverv1.Provider.DeployResource:input_type -> sharedtypesv1.DeployResourceRequest
+	125, // 199: providerserverv1.Provider.ResourceHasStabilised:input_type -> sharedtypesv1.ResourceHasStabilisedRequest
+	28,  // 200: providerserverv1.Provider.GetResourceExternalState:input_type -> providerserverv1.GetResourceExternalStateRequest
+	30,  // 201: providerserverv1.Provider.ListResources:input_type -> providerserverv1.ListResourcesRequest
+	126, // 202: providerserverv1.Provider.DestroyResource:input_type -> sharedtypesv1.DestroyResourceRequest
+	38,  // 203: providerserverv1.Provider.StageLinkChanges:input_type -> providerserverv1.StageLinkChangesRequest
+	41,  // 204: providerserverv1.Provider.UpdateLinkResourceA:input_type -> providerserverv1.UpdateLinkResourceRequest
+	41,  // 205: providerserverv1.Provider.UpdateLinkResourceB:input_type -> providerserverv1.UpdateLinkResourceRequest
+	44,  // 206: providerserverv1.Provider.UpdateLinkIntermediaryResources:input_type -> providerserverv1.UpdateLinkIntermediaryResourcesRequest
+	81,  // 207: providerserverv1.Provider.GetLinkPriorityResource:input_type -> providerserverv1.LinkRequest
+	81,  // 208: providerserverv1.Provider.GetLinkTypeDescription:input_type -> providerserverv1.LinkRequest
+	81,  // 209: providerserverv1.Provider.GetLinkAnnotationDefinitions:input_type -> providerserverv1.LinkRequest
+	81,  // 210: providerserverv1.Provider.GetLinkConfigDefinition:input_type -> providerserverv1.LinkRequest
+	81,  // 211: providerserverv1.Provider.GetLinkKind:input_type -> providerserverv1.LinkRequest
+	82,  // 212: providerserverv1.Provider.GetLinkIntermediaryExternalState:input_type -> providerserverv1.GetLinkIntermediaryExternalStateRequest
+	81,  // 213: providerserverv1.Provider.GetLinkCardinality:input_type -> providerserverv1.LinkRequest
+	85,  // 214: providerserverv1.Provider.ValidateLink:input_type -> providerserverv1.ValidateLinkRequest
+	36,  // 215: providerserverv1.Provider.GetDataSourceType:input_type -> providerserverv1.DataSourceRequest
+	36,  // 216: providerserverv1.Provider.GetDataSourceTypeDescription:input_type -> providerserverv1.DataSourceRequest
+	36,  // 217: providerserverv1.Provider.GetDataSourceExamples:input_type -> providerserverv1.DataSourceRequest
+	48,  // 218: providerserverv1.Provider.CustomValidateDataSource:input_type -> providerserverv1.CustomValidateDataSourceRequest
+	36,  // 219: providerserverv1.Provider.GetDataSourceSpecDefinition:input_type -> providerserverv1.DataSourceRequest
+	36,  // 220: providerserverv1.Provider.GetDataSourceFilterFields:input_type -> providerserverv1.DataSourceRequest
+	53,  // 221: providerserverv1.Provider.FetchDataSource:input_type -> providerserverv1.FetchDataSourceRequest
+	37,  // 222: providerserverv1.Provider.GetCustomVariableType:input_type -> providerserverv1.CustomVariableTypeRequest
+	37,  // 223: providerserverv1.Provider.GetCustomVariableTypeDescription:input_type -> providerserverv1.CustomVariableTypeRequest
+	37,  // 224: providerserverv1.Provider.GetCustomVariableTypeOptions:input_type -> providerserverv1.CustomVariableTypeRequest
+	37,  // 225: providerserverv1.Provider.GetCustomVariableTypeExamples:input_type -> providerserverv1.CustomVariableTypeRequest
+	127, // 226: providerserverv1.Provider.GetFunctionDefinition:input_type -> sharedtypesv1.FunctionDefinitionRequest
+	128, // 227: providerserverv1.Provider.CallFunction:input_type -> sharedtypesv1.FunctionCallRequest
+	6,   // 228: providerserverv1.Provider.GetNamespace:output_type -> providerserverv1.NamespaceResponse
+	129, // 229: providerserverv1.Provider.GetConfigDefinition:output_type -> sharedtypesv1.ConfigDefinitionResponse
+	8,   // 230: providerserverv1.Provider.ListResourceTypes:output_type -> providerserverv1.ResourceTypesResponse
+	10,  // 231: providerserverv1.Provider.ListLinkTypes:output_type -> providerserverv1.LinkTypesResponse
+	12,  // 232: providerserverv1.Provider.ListDataSourceTypes:output_type -> providerserverv1.DataSourceTypesResponse
+	14,  // 233: providerserverv1.Provider.ListCustomVariableTypes:output_type -> providerserverv1.CustomVariableTypesResponse
+	16,  // 234: providerserverv1.Provider.ListFunctions:output_type -> providerserverv1.FunctionListResponse
+	18,  // 235: providerserverv1.Provider.GetRetryPolicy:output_type -> providerserverv1.RetryPolicyResponse
+	21,  // 236: providerserverv1.Provider.CustomValidateResource:output_type -> providerserverv1.CustomValidateResourceResponse
+	23,  // 237: providerserverv1.Provider.GetResourceSpecDefinition:output_type -> providerserverv1.ResourceSpecDefinitionResponse
+	24,  // 238: providerserverv1.Provider.CanResourceLinkTo:output_type -> providerserverv1.CanResourceLinkToResponse
+	25,  // 239: providerserverv1.Provider.GetResourceStabilisedDeps:output_type -> providerserverv1.ResourceStabilisedDepsResponse
+	27,  // 240: providerserverv1.Provider.IsResourceCommonTerminal:output_type -> providerserverv1.IsResourceCommonTerminalResponse
+	130, // 241: providerserverv1.Provider.GetResourceType:output_type -> sharedtypesv1.ResourceTypeResponse
+	131, // 242: providerserverv1.Provider.GetResourceTypeDescription:output_type -> sharedtypesv1.TypeDescriptionResponse
+	132, // 243: providerserverv1.Provider.GetResourceExamples:output_type -> sharedtypesv1.ExamplesResponse
+	133, // 244: providerserverv1.Provider.DeployResource:output_type -> sharedtypesv1.DeployResourceResponse
+	134, // 245: providerserverv1.Provider.ResourceHasStabilised:output_type -> sharedtypesv1.ResourceHasStabilisedResponse
+	29,  // 246: providerserverv1.Provider.GetResourceExternalState:output_type -> providerserverv1.GetResourceExternalStateResponse
+	31,  // 247: providerserverv1.Provider.ListResources:output_type -> providerserverv1.ListResourcesResponse
+	135, // 248: providerserverv1.Provider.DestroyResource:output_type -> sharedtypesv1.DestroyResourceResponse
+	39,  // 249: providerserverv1.Provider.StageLinkChanges:output_type -> providerserverv1.StageLinkChangesResponse
+	42,  // 250: providerserverv1.Provider.UpdateLinkResourceA:output_type -> providerserverv1.UpdateLinkResourceResponse
+	42,  // 251: providerserverv1.Provider.UpdateLinkResourceB:output_type -> providerserverv1.UpdateLinkResourceResponse
+	45,  // 252: providerserverv1.Provider.UpdateLinkIntermediaryResources:output_type -> providerserverv1.UpdateLinkIntermediaryResourcesResponse
+	47,  // 253: providerserverv1.Provider.GetLinkPriorityResource:output_type -> providerserverv1.LinkPriorityResourceResponse
+	131, // 254: providerserverv1.Provider.GetLinkTypeDescription:output_type -> sharedtypesv1.TypeDescriptionResponse
+	136, // 255: providerserverv1.Provider.GetLinkAnnotationDefinitions:output_type -> sharedtypesv1.LinkAnnotationDefinitionsResponse
+	72,  // 256: providerserverv1.Provider.GetLinkConfigDefinition:output_type -> providerserverv1.LinkConfigDefinitionResponse
+	75,  // 257: providerserverv1.Provider.GetLinkKind:output_type -> providerserverv1.LinkKindResponse
+	83,  // 258: providerserverv1.Provider.GetLinkIntermediaryExternalState:output_type -> providerserverv1.GetLinkIntermediaryExternalStateResponse
+	137, // 259: providerserverv1.Provider.GetLinkCardinality:output_type -> sharedtypesv1.LinkCardinalityResponse
+	86,  // 260: providerserverv1.Provider.ValidateLink:output_type -> providerserverv1.ValidateLinkResponse
+	70,  // 261: providerserverv1.Provider.GetDataSourceType:output_type -> providerserverv1.DataSourceTypeResponse
+	131, // 262: providerserverv1.Provider.GetDataSourceTypeDescription:output_type -> sharedtypesv1.TypeDescriptionResponse
+	132, // 263: providerserverv1.Provider.GetDataSourceExamples:output_type -> sharedtypesv1.ExamplesResponse
+	49,  // 264: providerserverv1.Provider.CustomValidateDataSource:output_type -> providerserverv1.CustomValidateDataSourceResponse
+	51,  // 265: providerserverv1.Provider.GetDataSourceSpecDefinition:output_type -> providerserverv1.DataSourceSpecDefinitionResponse
+	52,  // 266: providerserverv1.Provider.GetDataSourceFilterFields:output_type -> providerserverv1.DataSourceFilterFieldsResponse
+	54,  // 267: providerserverv1.Provider.FetchDataSource:output_type -> providerserverv1.FetchDataSourceResponse
+	58,  // 268: providerserverv1.Provider.GetCustomVariableType:output_type -> providerserverv1.CustomVariableTypeResponse
+	131, // 269: providerserverv1.Provider.GetCustomVariableTypeDescription:output_type -> sharedtypesv1.TypeDescriptionResponse
+	55,  // 270: providerserverv1.Provider.GetCustomVariableTypeOptions:output_type -> providerserverv1.CustomVariableTypeOptionsResponse
+	132, // 271: providerserverv1.Provider.GetCustomVariableTypeExamples:output_type -> sharedtypesv1.ExamplesResponse
+	138, // 272: providerserverv1.Provider.GetFunctionDefinition:output_type -> sharedtypesv1.FunctionDefinitionResponse
+	139, // 273: providerserverv1.Provider.CallFunction:output_type -> sharedtypesv1.FunctionCallResponse
+	228, // [228:274] is the sub-list for method output_type
+	182, // [182:228] is the sub-list for method input_type
+	182, // [182:182] is the sub-list for extension type_name
+	182, // [182:182] is the sub-list for extension extendee
+	0,   // [0:182] is the sub-list for field type_name
 }
 
 func init() { file_plugin_framework_providerserverv1_provider_proto_init() }
@@ -8579,59 +9152,67 @@ func file_plugin_framework_providerserverv1_provider_proto_init() {
 		(*GetResourceExternalStateResponse_ResourceSpecState)(nil),
 		(*GetResourceExternalStateResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[29].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[25].OneofWrappers = []any{
+		(*ListResourcesResponse_CompleteResponse)(nil),
+		(*ListResourcesResponse_ErrorResponse)(nil),
+	}
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[33].OneofWrappers = []any{
 		(*StageLinkChangesResponse_CompleteResponse)(nil),
 		(*StageLinkChangesResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[32].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[36].OneofWrappers = []any{
 		(*UpdateLinkResourceResponse_CompleteResponse)(nil),
 		(*UpdateLinkResourceResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[35].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[39].OneofWrappers = []any{
 		(*UpdateLinkIntermediaryResourcesResponse_CompleteResponse)(nil),
 		(*UpdateLinkIntermediaryResourcesResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[37].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[41].OneofWrappers = []any{
 		(*LinkPriorityResourceResponse_PriorityInfo)(nil),
 		(*LinkPriorityResourceResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[39].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[43].OneofWrappers = []any{
 		(*CustomValidateDataSourceResponse_CompleteResponse)(nil),
 		(*CustomValidateDataSourceResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[41].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[45].OneofWrappers = []any{
 		(*DataSourceSpecDefinitionResponse_SpecDefinition)(nil),
 		(*DataSourceSpecDefinitionResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[42].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[46].OneofWrappers = []any{
 		(*DataSourceFilterFieldsResponse_FilterFields)(nil),
 		(*DataSourceFilterFieldsResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[44].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[48].OneofWrappers = []any{
 		(*FetchDataSourceResponse_CompleteResponse)(nil),
 		(*FetchDataSourceResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[45].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[49].OneofWrappers = []any{
 		(*CustomVariableTypeOptionsResponse_Options)(nil),
 		(*CustomVariableTypeOptionsResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[48].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[52].OneofWrappers = []any{
 		(*CustomVariableTypeResponse_CustomVarTypeInfo)(nil),
 		(*CustomVariableTypeResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[60].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[64].OneofWrappers = []any{
 		(*DataSourceTypeResponse_DataSourceTypeInfo)(nil),
 		(*DataSourceTypeResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[63].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[66].OneofWrappers = []any{
+		(*LinkConfigDefinitionResponse_ConfigDefinition)(nil),
+		(*LinkConfigDefinitionResponse_ErrorResponse)(nil),
+	}
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[69].OneofWrappers = []any{
 		(*LinkKindResponse_LinkKindInfo)(nil),
 		(*LinkKindResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[71].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[77].OneofWrappers = []any{
 		(*GetLinkIntermediaryExternalStateResponse_CompleteResponse)(nil),
 		(*GetLinkIntermediaryExternalStateResponse_ErrorResponse)(nil),
 	}
-	file_plugin_framework_providerserverv1_provider_proto_msgTypes[74].OneofWrappers = []any{
+	file_plugin_framework_providerserverv1_provider_proto_msgTypes[80].OneofWrappers = []any{
 		(*ValidateLinkResponse_CompleteResponse)(nil),
 		(*ValidateLinkResponse_ErrorResponse)(nil),
 	}
@@ -8641,7 +9222,7 @@ func file_plugin_framework_providerserverv1_provider_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugin_framework_providerserverv1_provider_proto_rawDesc), len(file_plugin_framework_providerserverv1_provider_proto_rawDesc)),
 			NumEnums:      6,
-			NumMessages:   93,
+			NumMessages:   99,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
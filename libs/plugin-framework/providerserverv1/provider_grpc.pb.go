@@ -39,6 +39,7 @@ const (
 	Provider_DeployResource_FullMethodName                   = "/providerserverv1.Provider/DeployResource"
 	Provider_ResourceHasStabilised_FullMethodName            = "/providerserverv1.Provider/ResourceHasStabilised"
 	Provider_GetResourceExternalState_FullMethodName         = "/providerserverv1.Provider/GetResourceExternalState"
+	Provider_ListResources_FullMethodName                    = "/providerserverv1.Provider/ListResources"
 	Provider_DestroyResource_FullMethodName                  = "/providerserverv1.Provider/DestroyResource"
 	Provider_StageLinkChanges_FullMethodName                 = "/providerserverv1.Provider/StageLinkChanges"
 	Provider_UpdateLinkResourceA_FullMethodName              = "/providerserverv1.Provider/UpdateLinkResourceA"
@@ -47,6 +48,7 @@ const (
 	Provider_GetLinkPriorityResource_FullMethodName          = "/providerserverv1.Provider/GetLinkPriorityResource"
 	Provider_GetLinkTypeDescription_FullMethodName           = "/providerserverv1.Provider/GetLinkTypeDescription"
 	Provider_GetLinkAnnotationDefinitions_FullMethodName     = "/providerserverv1.Provider/GetLinkAnnotationDefinitions"
+	Provider_GetLinkConfigDefinition_FullMethodName          = "/providerserverv1.Provider/GetLinkConfigDefinition"
 	Provider_GetLinkKind_FullMethodName                      = "/providerserverv1.Provider/GetLinkKind"
 	Provider_GetLinkIntermediaryExternalState_FullMethodName = "/providerserverv1.Provider/GetLinkIntermediaryExternalState"
 	Provider_GetLinkCardinality_FullMethodName               = "/providerserverv1.Provider/GetLinkCardinality"
@@ -170,6 +172,10 @@ type ProviderClient interface {
 	// GetResourceExternalState deals with getting the state of the resource from the resource provider.
 	// (e.g. AWS or Google Cloud)
 	GetResourceExternalState(ctx context.Context, in *GetResourceExternalStateRequest, opts ...grpc.CallOption) (*GetResourceExternalStateResponse, error)
+	// ListResources deals with listing existing resources of a resource type
+	// in the upstream provider that can be imported into a blueprint instance.
+	// Resource types that do not support listing respond with listing_supported set to false.
+	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
 	// DestroyResource deals with destroying a resource instance if its current
 	// state is successfully deployed or cleaning up a corrupt or partially deployed
 	// resource instance.
@@ -226,6 +232,10 @@ type ProviderClient interface {
 	// Annotations provide a way to fine tune the behaviour of a link in a blueprint spec
 	// in the linked resource metadata sections.
 	GetLinkAnnotationDefinitions(ctx context.Context, in *LinkRequest, opts ...grpc.CallOption) (*sharedtypesv1.LinkAnnotationDefinitionsResponse, error)
+	// GetLinkConfigDefinition retrieves the schema for blueprint-level configuration
+	// of a link that can be set in the `links` section of a blueprint.
+	// Links that do not support configuration return a definition without a schema.
+	GetLinkConfigDefinition(ctx context.Context, in *LinkRequest, opts ...grpc.CallOption) (*LinkConfigDefinitionResponse, error)
 	// GetKind tells us whether the link is a "hard" or "soft" link.
 	// A hard link is where the priority resource type must be created first.
 	// A soft link is where it does not matter which resource type in the relationship
@@ -496,6 +506,16 @@ func (c *providerClient) GetResourceExternalState(ctx context.Context, in *GetRe
 	return out, nil
 }
 
+func (c *providerClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResourcesResponse)
+	err := c.cc.Invoke(ctx, Provider_ListResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providerClient) DestroyResource(ctx context.Context, in *sharedtypesv1.DestroyResourceRequest, opts ...grpc.CallOption) (*sharedtypesv1.DestroyResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(sharedtypesv1.DestroyResourceResponse)
@@ -576,6 +596,16 @@ func (c *providerClient) GetLinkAnnotationDefinitions(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *providerClient) GetLinkConfigDefinition(ctx context.Context, in *LinkRequest, opts ...grpc.CallOption) (*LinkConfigDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkConfigDefinitionResponse)
+	err := c.cc.Invoke(ctx, Provider_GetLinkConfigDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providerClient) GetLinkKind(ctx context.Context, in *LinkRequest, opts ...grpc.CallOption) (*LinkKindResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LinkKindResponse)
@@ -850,6 +880,10 @@ type ProviderServer interface {
 	// GetResourceExternalState deals with getting the state of the resource from the resource provider.
 	// (e.g. AWS or Google Cloud)
 	GetResourceExternalState(context.Context, *GetResourceExternalStateRequest) (*GetResourceExternalStateResponse, error)
+	// ListResources deals with listing existing resources of a resource type
+	// in the upstream provider that can be imported into a blueprint instance.
+	// Resource types that do not support listing respond with listing_supported set to false.
+	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
 	// DestroyResource deals with destroying a resource instance if its current
 	// state is successfully deployed or cleaning up a corrupt or partially deployed
 	// resource instance.
@@ -906,6 +940,10 @@ type ProviderServer interface {
 	// Annotations provide a way to fine tune the behaviour of a link in a blueprint spec
 	// in the linked resource metadata sections.
 	GetLinkAnnotationDefinitions(context.Context, *LinkRequest) (*sharedtypesv1.LinkAnnotationDefinitionsResponse, error)
+	// GetLinkConfigDefinition retrieves the schema for blueprint-level configuration
+	// of a link that can be set in the `links` section of a blueprint.
+	// Links that do not support configuration return a definition without a schema.
+	GetLinkConfigDefinition(context.Context, *LinkRequest) (*LinkConfigDefinitionResponse, error)
 	// GetKind tells us whether the link is a "hard" or "soft" link.
 	// A hard link is where the priority resource type must be created first.
 	// A soft link is where it does not matter which resource type in the relationship
@@ -1043,6 +1081,9 @@ func (UnimplementedProviderServer) ResourceHasStabilised(context.Context, *share
 func (UnimplementedProviderServer) GetResourceExternalState(context.Context, *GetResourceExternalStateRequest) (*GetResourceExternalStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResourceExternalState not implemented")
 }
+func (UnimplementedProviderServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
+}
 func (UnimplementedProviderServer) DestroyResource(context.Context, *sharedtypesv1.DestroyResourceRequest) (*sharedtypesv1.DestroyResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyResource not implemented")
 }
@@ -1067,6 +1108,9 @@ func (UnimplementedProviderServer) GetLinkTypeDescription(context.Context, *Link
 func (UnimplementedProviderServer) GetLinkAnnotationDefinitions(context.Context, *LinkRequest) (*sharedtypesv1.LinkAnnotationDefinitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLinkAnnotationDefinitions not implemented")
 }
+func (UnimplementedProviderServer) GetLinkConfigDefinition(context.Context, *LinkRequest) (*LinkConfigDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkConfigDefinition not implemented")
+}
 func (UnimplementedProviderServer) GetLinkKind(context.Context, *LinkRequest) (*LinkKindResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLinkKind not implemented")
 }
@@ -1481,6 +1525,24 @@ func _Provider_GetResourceExternalState_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_ListResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Provider_DestroyResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(sharedtypesv1.DestroyResourceRequest)
 	if err := dec(in); err != nil {
@@ -1625,6 +1687,24 @@ func _Provider_GetLinkAnnotationDefinitions_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_GetLinkConfigDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetLinkConfigDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_GetLinkConfigDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetLinkConfigDefinition(ctx, req.(*LinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Provider_GetLinkKind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LinkRequest)
 	if err := dec(in); err != nil {
@@ -2014,6 +2094,10 @@ var Provider_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResourceExternalState",
 			Handler:    _Provider_GetResourceExternalState_Handler,
 		},
+		{
+			MethodName: "ListResources",
+			Handler:    _Provider_ListResources_Handler,
+		},
 		{
 			MethodName: "DestroyResource",
 			Handler:    _Provider_DestroyResource_Handler,
@@ -2046,6 +2130,10 @@ var Provider_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLinkAnnotationDefinitions",
 			Handler:    _Provider_GetLinkAnnotationDefinitions_Handler,
 		},
+		{
+			MethodName: "GetLinkConfigDefinition",
+			Handler:    _Provider_GetLinkConfigDefinition_Handler,
+		},
 		{
 			MethodName: "GetLinkKind",
 			Handler:    _Provider_GetLinkKind_Handler,
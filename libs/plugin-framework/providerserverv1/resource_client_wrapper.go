@@ -472,6 +472,7 @@ func (r *resourceProviderClientWrapper) Deploy(
 			ResourceId:   input.ResourceID,
 			Changes:      resourceChangesPB,
 			Context:      providerCtx,
+			DryRun:       input.DryRun,
 		},
 	)
 	if err != nil {
@@ -677,6 +678,62 @@ func (r *resourceProviderClientWrapper) GetExternalState(
 	)
 }
 
+func (r *resourceProviderClientWrapper) ListResources(
+	ctx context.Context,
+	input *provider.ResourceListInput,
+) (*provider.ResourceListOutput, error) {
+	providerCtx, err := convertv1.ToPBProviderContext(input.ProviderContext)
+	if err != nil {
+		return nil, errorsv1.CreateGeneralError(
+			err,
+			errorsv1.PluginActionProviderListResources,
+		)
+	}
+
+	response, err := r.client.ListResources(
+		ctx,
+		&ListResourcesRequest{
+			ResourceType: &sharedtypesv1.ResourceType{
+				Type: r.resourceType,
+			},
+			HostId:       r.hostID,
+			InstanceName: input.InstanceName,
+			ResourceName: input.ResourceName,
+			MaxResults:   int32(input.MaxResults),
+			Context:      providerCtx,
+		},
+	)
+	if err != nil {
+		return nil, errorsv1.CreateGeneralError(
+			err,
+			errorsv1.PluginActionProviderListResources,
+		)
+	}
+
+	switch result := response.Response.(type) {
+	case *ListResourcesResponse_CompleteResponse:
+		if !result.CompleteResponse.ListingSupported {
+			return nil, provider.ErrResourceListingNotSupported
+		}
+
+		return &provider.ResourceListOutput{
+			Resources: fromPBExternalResources(result.CompleteResponse.Resources),
+		}, nil
+	case *ListResourcesResponse_ErrorResponse:
+		return nil, errorsv1.CreateErrorFromResponse(
+			result.ErrorResponse,
+			errorsv1.PluginActionProviderListResources,
+		)
+	}
+
+	return nil, errorsv1.CreateGeneralError(
+		errorsv1.ErrUnexpectedResponseType(
+			errorsv1.PluginActionProviderListResources,
+		),
+		errorsv1.PluginActionProviderListResources,
+	)
+}
+
 func (r *resourceProviderClientWrapper) Destroy(
 	ctx context.Context,
 	input *provider.ResourceDestroyInput,
@@ -0,0 +1,27 @@
+package plugintestutils
+
+import (
+	"context"
+
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/providerv1"
+	"github.com/stretchr/testify/suite"
+)
+
+// AssertResourceExamplesValid verifies all the blueprint examples attached to
+// resource definitions in the provided provider plugin definition,
+// failing the test for each example that is not valid
+// for the schema of its resource type.
+// This is intended to be used in the test suite of a provider plugin so that
+// examples are verified as a part of the plugin's CI pipeline.
+func AssertResourceExamplesValid(
+	pluginDefinition *providerv1.ProviderPluginDefinition,
+	testSuite *suite.Suite,
+) {
+	exampleErrors := providerv1.VerifyResourceExamples(
+		context.Background(),
+		pluginDefinition,
+	)
+	for _, exampleErr := range exampleErrors {
+		testSuite.Fail(exampleErr.Error())
+	}
+}
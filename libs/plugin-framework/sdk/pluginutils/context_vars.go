@@ -6,6 +6,11 @@ import "github.com/newstack-cloud/bluelink/libs/blueprint/core"
 // or as a part of the blueprint framework's context variables.
 const SessionIDKey = "bluelink.sessionId"
 
+// RunIDKey is the plain text key used to store the ID of the current run
+// of a plugin operation (e.g. a deployment) in a Go context
+// or as a part of the blueprint framework's context variables.
+const RunIDKey = "bluelink.runId"
+
 // ContextKey provides a unique key type for Bluelink context variables.
 type ContextKey string
 
@@ -17,4 +22,7 @@ var (
 	// ContextSessionIDKey is the context key used to store the session ID
 	// in a Go context.
 	ContextSessionIDKey = core.ContextKey(SessionIDKey)
+	// ContextRunIDKey is the context key used to store the ID
+	// of the current run of a plugin operation in a Go context.
+	ContextRunIDKey = core.ContextKey(RunIDKey)
 )
@@ -0,0 +1,328 @@
+package pluginutils
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+)
+
+// LogLevel is the severity level of a log message
+// written by a plugin logger.
+type LogLevel int
+
+const (
+	// LogLevelDebug is for verbose messages that are useful
+	// when debugging a plugin.
+	LogLevelDebug LogLevel = iota
+	// LogLevelInfo is for messages about the normal operation of a plugin.
+	LogLevelInfo
+	// LogLevelWarn is for messages about unexpected situations
+	// that a plugin can recover from.
+	LogLevelWarn
+	// LogLevelError is for messages about failures in a plugin.
+	LogLevelError
+	// LogLevelFatal is for messages about failures that a plugin
+	// can not recover from.
+	LogLevelFatal
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "debug"
+	case LogLevelInfo:
+		return "info"
+	case LogLevelWarn:
+		return "warn"
+	case LogLevelError:
+		return "error"
+	case LogLevelFatal:
+		return "fatal"
+	default:
+		return "unknown"
+	}
+}
+
+// RedactedValue is the value that sensitive values are replaced with
+// when redacted for logging.
+const RedactedValue = provider.RedactedSensitiveValue
+
+// PluginLoggerOption is a function that can be used to configure
+// a plugin logger.
+type PluginLoggerOption func(*pluginLoggerConfig)
+
+type pluginLoggerConfig struct {
+	writer   io.Writer
+	minLevel LogLevel
+	clock    core.Clock
+	exit     func(code int)
+}
+
+// WithLogWriter sets the writer that log messages will be written to.
+// When not set, log messages are written to stderr which the plugin host
+// captures in the log file for the plugin.
+func WithLogWriter(writer io.Writer) PluginLoggerOption {
+	return func(c *pluginLoggerConfig) {
+		c.writer = writer
+	}
+}
+
+// WithMinLogLevel sets the minimum level of log messages
+// that will be written.
+// When not set, messages at the info level and above are written.
+func WithMinLogLevel(level LogLevel) PluginLoggerOption {
+	return func(c *pluginLoggerConfig) {
+		c.minLevel = level
+	}
+}
+
+// WithLogClock sets the clock used to produce timestamps
+// for log messages.
+func WithLogClock(clock core.Clock) PluginLoggerOption {
+	return func(c *pluginLoggerConfig) {
+		c.clock = clock
+	}
+}
+
+// NewPluginLogger creates a logger for plugin authors to use instead of
+// ad-hoc fmt or log package usage in plugins.
+// Each log message is written as a single line of JSON to the host's
+// log channel for the plugin, which is the stderr stream of the plugin
+// process by default.
+// Combine with LoggerFromContext to attach information about the
+// resource being operated on to log messages.
+func NewPluginLogger(opts ...PluginLoggerOption) core.Logger {
+	config := &pluginLoggerConfig{
+		writer:   os.Stderr,
+		minLevel: LogLevelInfo,
+		clock:    core.SystemClock{},
+		exit:     os.Exit,
+	}
+
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	return &pluginLogger{
+		config: config,
+		mu:     &sync.Mutex{},
+	}
+}
+
+type pluginLogger struct {
+	config *pluginLoggerConfig
+	name   string
+	fields []core.LogField
+	// Shared between all loggers derived from the same root logger
+	// to prevent interleaving of messages written to the same writer.
+	mu *sync.Mutex
+}
+
+func (l *pluginLogger) Debug(msg string, fields ...core.LogField) {
+	l.log(LogLevelDebug, msg, fields)
+}
+
+func (l *pluginLogger) Info(msg string, fields ...core.LogField) {
+	l.log(LogLevelInfo, msg, fields)
+}
+
+func (l *pluginLogger) Warn(msg string, fields ...core.LogField) {
+	l.log(LogLevelWarn, msg, fields)
+}
+
+func (l *pluginLogger) Error(msg string, fields ...core.LogField) {
+	l.log(LogLevelError, msg, fields)
+}
+
+func (l *pluginLogger) Fatal(msg string, fields ...core.LogField) {
+	l.log(LogLevelFatal, msg, fields)
+	l.config.exit(1)
+}
+
+func (l *pluginLogger) WithFields(fields ...core.LogField) core.Logger {
+	return &pluginLogger{
+		config: l.config,
+		name:   l.name,
+		fields: append(slices.Clone(l.fields), fields...),
+		mu:     l.mu,
+	}
+}
+
+func (l *pluginLogger) Named(name string) core.Logger {
+	fullName := name
+	if l.name != "" {
+		fullName = strings.Join([]string{l.name, name}, ".")
+	}
+
+	return &pluginLogger{
+		config: l.config,
+		name:   fullName,
+		fields: l.fields,
+		mu:     l.mu,
+	}
+}
+
+func (l *pluginLogger) log(level LogLevel, msg string, fields []core.LogField) {
+	if level < l.config.minLevel {
+		return
+	}
+
+	entry := map[string]any{}
+	for _, field := range l.fields {
+		entry[field.Key] = logFieldValue(field)
+	}
+	for _, field := range fields {
+		entry[field.Key] = logFieldValue(field)
+	}
+	entry["level"] = level.String()
+	entry["ts"] = l.config.clock.Now().UTC().Format(time.RFC3339Nano)
+	entry["msg"] = msg
+	if l.name != "" {
+		entry["logger"] = l.name
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = l.config.writer.Write(append(line, '\n'))
+}
+
+func logFieldValue(field core.LogField) any {
+	switch field.Type {
+	case core.StringLogFieldType:
+		return field.String
+	case core.IntegerLogFieldType:
+		return field.Integer
+	case core.FloatLogFieldType:
+		return field.Float
+	case core.BoolLogFieldType:
+		return field.Bool
+	case core.ErrorLogFieldType:
+		if field.Err == nil {
+			return nil
+		}
+		return field.Err.Error()
+	case core.ErrorsLogFieldType:
+		if errs, ok := field.Interface.([]error); ok {
+			messages := make([]string, 0, len(errs))
+			for _, err := range errs {
+				messages = append(messages, err.Error())
+			}
+			return messages
+		}
+		return field.Interface
+	default:
+		return field.Interface
+	}
+}
+
+// ResourceLogContext holds information about a resource that
+// a plugin is operating on that is attached to log messages.
+type ResourceLogContext struct {
+	InstanceID   string
+	ResourceID   string
+	ResourceName string
+	ResourceType string
+}
+
+type resourceLogContextKey struct{}
+
+// ContextWithResourceLogContext returns a copy of the given context that holds
+// information about the resource a plugin is operating on, to be attached to log
+// messages for loggers derived with LoggerFromContext.
+//
+// Resource definitions created with the provider SDK populate this automatically
+// for deploy, destroy, stabilisation and external state operations.
+func ContextWithResourceLogContext(
+	ctx context.Context,
+	resourceLogContext *ResourceLogContext,
+) context.Context {
+	return context.WithValue(ctx, resourceLogContextKey{}, resourceLogContext)
+}
+
+// ContextWithRunID returns a copy of the given context that holds the ID
+// of the current run of a plugin operation (e.g. a deployment) to be
+// attached to log messages for loggers derived with LoggerFromContext.
+func ContextWithRunID(ctx context.Context, runID string) context.Context {
+	return context.WithValue(ctx, ContextRunIDKey, runID)
+}
+
+// LoggerFromContext derives a logger from the provided logger
+// that attaches the resource ID, resource type, run ID and session ID
+// stored in the given context to all log messages.
+// Values that are not present in the context are omitted.
+func LoggerFromContext(ctx context.Context, logger core.Logger) core.Logger {
+	fields := []core.LogField{}
+
+	resourceLogContext, ok := ctx.Value(resourceLogContextKey{}).(*ResourceLogContext)
+	if ok && resourceLogContext != nil {
+		fields = appendStringLogField(fields, "instanceId", resourceLogContext.InstanceID)
+		fields = appendStringLogField(fields, "resourceId", resourceLogContext.ResourceID)
+		fields = appendStringLogField(fields, "resourceName", resourceLogContext.ResourceName)
+		fields = appendStringLogField(fields, "resourceType", resourceLogContext.ResourceType)
+	}
+
+	runID, _ := ctx.Value(ContextRunIDKey).(string)
+	fields = appendStringLogField(fields, "runId", runID)
+
+	sessionID, _ := ctx.Value(ContextSessionIDKey).(string)
+	fields = appendStringLogField(fields, "sessionId", sessionID)
+
+	if len(fields) == 0 {
+		return logger
+	}
+
+	return logger.WithFields(fields...)
+}
+
+func appendStringLogField(fields []core.LogField, key string, value string) []core.LogField {
+	if value == "" {
+		return fields
+	}
+
+	return append(fields, core.StringLogField(key, value))
+}
+
+// RedactedLogField creates a log field holding the JSON representation of the
+// provided resource spec value where all values for fields marked as sensitive
+// in the given schema are replaced with RedactedValue.
+func RedactedLogField(
+	key string,
+	value *core.MappingNode,
+	schema *provider.ResourceDefinitionsSchema,
+) core.LogField {
+	redacted := RedactSensitiveValues(value, schema)
+	if redacted == nil {
+		return core.StringLogField(key, "null")
+	}
+
+	serialised, err := json.Marshal(redacted)
+	if err != nil {
+		return core.ErrorLogField(key, err)
+	}
+
+	return core.StringLogField(key, string(serialised))
+}
+
+// RedactSensitiveValues produces a copy of the provided resource spec value
+// where all values for fields marked as sensitive in the given schema
+// are replaced with RedactedValue.
+// The provided value is not modified.
+func RedactSensitiveValues(
+	value *core.MappingNode,
+	schema *provider.ResourceDefinitionsSchema,
+) *core.MappingNode {
+	return provider.RedactSensitiveValues(value, schema)
+}
@@ -0,0 +1,186 @@
+package pluginutils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/stretchr/testify/suite"
+)
+
+type LoggingTestSuite struct {
+	suite.Suite
+}
+
+func (s *LoggingTestSuite) Test_writes_json_log_messages_with_context_fields() {
+	buf := &bytes.Buffer{}
+	logger := NewPluginLogger(
+		WithLogWriter(buf),
+		WithLogClock(&staticLogClock{}),
+	)
+
+	ctx := ContextWithResourceLogContext(
+		context.Background(),
+		&ResourceLogContext{
+			InstanceID:   "instance-1",
+			ResourceID:   "resource-1",
+			ResourceName: "ordersTable",
+			ResourceType: "aws/dynamodb/table",
+		},
+	)
+	ctx = ContextWithRunID(ctx, "run-1")
+
+	LoggerFromContext(ctx, logger).Named("tables").Info(
+		"creating table",
+		core.IntegerLogField("attempt", 2),
+		core.ErrorLogField("lastError", errors.New("throttled")),
+	)
+
+	entries := parseLogEntries(s, buf)
+	s.Require().Len(entries, 1)
+	s.Assert().Equal(
+		map[string]any{
+			"level":        "info",
+			"ts":           "2024-01-01T00:00:00Z",
+			"msg":          "creating table",
+			"logger":       "tables",
+			"instanceId":   "instance-1",
+			"resourceId":   "resource-1",
+			"resourceName": "ordersTable",
+			"resourceType": "aws/dynamodb/table",
+			"runId":        "run-1",
+			"attempt":      float64(2),
+			"lastError":    "throttled",
+		},
+		entries[0],
+	)
+}
+
+func (s *LoggingTestSuite) Test_omits_messages_below_min_level() {
+	buf := &bytes.Buffer{}
+	logger := NewPluginLogger(
+		WithLogWriter(buf),
+		WithMinLogLevel(LogLevelWarn),
+	)
+
+	logger.Debug("debug message")
+	logger.Info("info message")
+	logger.Warn("warn message")
+	logger.Error("error message")
+
+	entries := parseLogEntries(s, buf)
+	s.Require().Len(entries, 2)
+	s.Assert().Equal("warn", entries[0]["level"])
+	s.Assert().Equal("error", entries[1]["level"])
+}
+
+func (s *LoggingTestSuite) Test_logger_from_context_without_values_returns_same_logger() {
+	logger := NewPluginLogger(WithLogWriter(&bytes.Buffer{}))
+	s.Assert().Same(logger, LoggerFromContext(context.Background(), logger))
+}
+
+func (s *LoggingTestSuite) Test_redacts_sensitive_values_in_log_field() {
+	spec := &core.MappingNode{
+		Fields: map[string]*core.MappingNode{
+			"name":     core.MappingNodeFromString("orders-db"),
+			"password": core.MappingNodeFromString("hunter2"),
+			"credentials": {
+				Items: []*core.MappingNode{
+					{
+						Fields: map[string]*core.MappingNode{
+							"user":   core.MappingNodeFromString("admin"),
+							"apiKey": core.MappingNodeFromString("secret-key"),
+						},
+					},
+				},
+			},
+			"environment": {
+				Fields: map[string]*core.MappingNode{
+					"DB_TOKEN": core.MappingNodeFromString("secret-token"),
+				},
+			},
+		},
+	}
+
+	field := RedactedLogField("spec", spec, redactionTestSchema())
+	s.Assert().Equal(core.StringLogFieldType, field.Type)
+	s.Assert().NotContains(field.String, "hunter2")
+	s.Assert().NotContains(field.String, "secret-key")
+	s.Assert().NotContains(field.String, "secret-token")
+	s.Assert().Contains(field.String, "orders-db")
+	s.Assert().Contains(field.String, "admin")
+	s.Assert().Equal(3, strings.Count(field.String, RedactedValue))
+
+	// The original spec must not be modified by redaction.
+	s.Assert().Equal("hunter2", core.StringValue(spec.Fields["password"]))
+}
+
+func redactionTestSchema() *provider.ResourceDefinitionsSchema {
+	return &provider.ResourceDefinitionsSchema{
+		Type: provider.ResourceDefinitionsSchemaTypeObject,
+		Attributes: map[string]*provider.ResourceDefinitionsSchema{
+			"name": {
+				Type: provider.ResourceDefinitionsSchemaTypeString,
+			},
+			"password": {
+				Type:      provider.ResourceDefinitionsSchemaTypeString,
+				Sensitive: true,
+			},
+			"credentials": {
+				Type: provider.ResourceDefinitionsSchemaTypeArray,
+				Items: &provider.ResourceDefinitionsSchema{
+					Type: provider.ResourceDefinitionsSchemaTypeObject,
+					Attributes: map[string]*provider.ResourceDefinitionsSchema{
+						"user": {
+							Type: provider.ResourceDefinitionsSchemaTypeString,
+						},
+						"apiKey": {
+							Type:      provider.ResourceDefinitionsSchemaTypeString,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"environment": {
+				Type: provider.ResourceDefinitionsSchemaTypeMap,
+				MapValues: &provider.ResourceDefinitionsSchema{
+					Type:      provider.ResourceDefinitionsSchemaTypeString,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+func parseLogEntries(s *LoggingTestSuite, buf *bytes.Buffer) []map[string]any {
+	entries := []map[string]any{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		entry := map[string]any{}
+		s.Require().NoError(json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+type staticLogClock struct{}
+
+func (c *staticLogClock) Now() time.Time {
+	return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (c *staticLogClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func TestLoggingTestSuite(t *testing.T) {
+	suite.Run(t, new(LoggingTestSuite))
+}
@@ -0,0 +1,269 @@
+package providerv1
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/specmerge"
+)
+
+// ComputedFieldTag is the struct tag used to map fields of a provider API
+// response struct to computed field paths in a resource spec.
+//
+// Example:
+//
+//	type functionOutput struct {
+//		Arn      *string `bluelink:"spec.arn"`
+//		Version  string  `bluelink:"spec.version,omitempty"`
+//		Internal string  `bluelink:"-"`
+//	}
+const ComputedFieldTag = "bluelink"
+
+// ComputedFieldValuesFromStruct produces the computed field values for a resource
+// from a struct where fields are tagged with the computed field path that the
+// value of the field should be assigned to (e.g. `bluelink:"spec.arn"`).
+// This is intended to be used to populate the ComputedFieldValues of the output
+// of deploying a resource from a structured provider API response.
+//
+// Untagged struct fields and fields tagged with "-" are skipped,
+// untagged fields that hold structs (or pointers to structs) are traversed
+// to collect tagged fields of the nested struct.
+// Fields with a nil value are skipped, the "omitempty" tag option can be used
+// to also skip fields with a zero value.
+//
+// Tagged values can be strings, booleans, integers, floats, *core.MappingNode,
+// slices, maps with string keys or structs,
+// where fields of structs are named using the "json" tag of a field
+// or the field name when a json tag is not present.
+func ComputedFieldValuesFromStruct(response any) (map[string]*core.MappingNode, error) {
+	computedFieldValues := map[string]*core.MappingNode{}
+	structValue, isNil := derefValue(reflect.ValueOf(response))
+	if isNil {
+		return computedFieldValues, nil
+	}
+
+	if structValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf(
+			"expected a struct or pointer to a struct to extract computed fields from, got %s",
+			structValue.Type(),
+		)
+	}
+
+	err := collectComputedFieldValues(structValue, computedFieldValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return computedFieldValues, nil
+}
+
+// ComputedFieldValuesFromStruct produces the computed field values for a resource
+// from a struct with fields tagged with computed field paths,
+// see the package-level ComputedFieldValuesFromStruct function for details
+// on how struct fields are mapped to computed fields.
+//
+// In addition to the package-level function, every tagged path is checked against the
+// computed fields in the resource definition schema so that typos in struct tags
+// are caught instead of silently producing values for fields that do not exist.
+func (r *ResourceDefinition) ComputedFieldValuesFromStruct(
+	response any,
+) (map[string]*core.MappingNode, error) {
+	computedFieldValues, err := ComputedFieldValuesFromStruct(response)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Schema == nil {
+		return computedFieldValues, nil
+	}
+
+	expectedComputedFields := specmerge.CollectComputedFields(r.Schema, "spec")
+	fieldPaths := make([]string, 0, len(computedFieldValues))
+	for fieldPath := range computedFieldValues {
+		fieldPaths = append(fieldPaths, fieldPath)
+	}
+	slices.Sort(fieldPaths)
+
+	for _, fieldPath := range fieldPaths {
+		if !specmerge.IsComputedFieldInList(expectedComputedFields, fieldPath) {
+			return nil, fmt.Errorf(
+				"%q is not a computed field in the spec of the %q resource type",
+				fieldPath,
+				r.Type,
+			)
+		}
+	}
+
+	return computedFieldValues, nil
+}
+
+func collectComputedFieldValues(
+	structValue reflect.Value,
+	computedFieldValues map[string]*core.MappingNode,
+) error {
+	structType := structValue.Type()
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, hasTag := field.Tag.Lookup(ComputedFieldTag)
+		if tag == "-" {
+			continue
+		}
+
+		fieldValue, isNil := derefValue(structValue.Field(i))
+		if isNil {
+			continue
+		}
+
+		if !hasTag || tag == "" {
+			if fieldValue.Kind() == reflect.Struct && fieldValue.Type() != mappingNodeType {
+				err := collectComputedFieldValues(fieldValue, computedFieldValues)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		fieldPath, options, _ := strings.Cut(tag, ",")
+		if options == "omitempty" && fieldValue.IsZero() {
+			continue
+		}
+
+		node, err := valueToMappingNode(fieldValue)
+		if err != nil {
+			return fmt.Errorf("failed to convert value for computed field %q: %w", fieldPath, err)
+		}
+		computedFieldValues[fieldPath] = node
+	}
+
+	return nil
+}
+
+var mappingNodeType = reflect.TypeOf(core.MappingNode{})
+
+func valueToMappingNode(value reflect.Value) (*core.MappingNode, error) {
+	if value.Type() == mappingNodeType {
+		node := value.Interface().(core.MappingNode)
+		return &node, nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return core.MappingNodeFromString(value.String()), nil
+	case reflect.Bool:
+		return core.MappingNodeFromBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return core.MappingNodeFromInt(int(value.Int())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return core.MappingNodeFromInt(int(value.Uint())), nil
+	case reflect.Float32, reflect.Float64:
+		return core.MappingNodeFromFloat(value.Float()), nil
+	case reflect.Slice, reflect.Array:
+		return sliceToMappingNode(value)
+	case reflect.Map:
+		return mapToMappingNode(value)
+	case reflect.Struct:
+		return structToMappingNode(value)
+	default:
+		return nil, fmt.Errorf("unsupported type: %s", value.Type())
+	}
+}
+
+func sliceToMappingNode(value reflect.Value) (*core.MappingNode, error) {
+	items := make([]*core.MappingNode, 0, value.Len())
+	for i := range value.Len() {
+		item, isNil := derefValue(value.Index(i))
+		if isNil {
+			items = append(items, &core.MappingNode{})
+			continue
+		}
+
+		itemNode, err := valueToMappingNode(item)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, itemNode)
+	}
+
+	return &core.MappingNode{Items: items}, nil
+}
+
+func mapToMappingNode(value reflect.Value) (*core.MappingNode, error) {
+	if value.Type().Key().Kind() != reflect.String {
+		return nil, fmt.Errorf("unsupported map key type: %s", value.Type().Key())
+	}
+
+	fields := make(map[string]*core.MappingNode, value.Len())
+	iter := value.MapRange()
+	for iter.Next() {
+		mapValue, isNil := derefValue(iter.Value())
+		if isNil {
+			continue
+		}
+
+		fieldNode, err := valueToMappingNode(mapValue)
+		if err != nil {
+			return nil, err
+		}
+		fields[iter.Key().String()] = fieldNode
+	}
+
+	return &core.MappingNode{Fields: fields}, nil
+}
+
+func structToMappingNode(value reflect.Value) (*core.MappingNode, error) {
+	fields := map[string]*core.MappingNode{}
+	structType := value.Type()
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldName := field.Name
+		if jsonTag, hasJSONTag := field.Tag.Lookup("json"); hasJSONTag {
+			jsonName, _, _ := strings.Cut(jsonTag, ",")
+			if jsonName == "-" {
+				continue
+			}
+			if jsonName != "" {
+				fieldName = jsonName
+			}
+		}
+
+		fieldValue, isNil := derefValue(value.Field(i))
+		if isNil {
+			continue
+		}
+
+		fieldNode, err := valueToMappingNode(fieldValue)
+		if err != nil {
+			return nil, err
+		}
+		fields[fieldName] = fieldNode
+	}
+
+	return &core.MappingNode{Fields: fields}, nil
+}
+
+// derefValue follows pointers and interfaces to the underlying value,
+// reporting whether a nil value was found along the way.
+// Pointers to mapping nodes are dereferenced to the mapping node struct.
+func derefValue(value reflect.Value) (reflect.Value, bool) {
+	for value.IsValid() &&
+		(value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return value, true
+		}
+		value = value.Elem()
+	}
+
+	return value, !value.IsValid()
+}
@@ -0,0 +1,158 @@
+package providerv1
+
+import (
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/stretchr/testify/suite"
+)
+
+type ComputedFieldsTestSuite struct {
+	suite.Suite
+}
+
+type testFunctionConfig struct {
+	Timeout int    `json:"timeout"`
+	Handler string `json:"handler,omitempty"`
+	Ignored string `json:"-"`
+}
+
+type testFunctionState struct {
+	State string `bluelink:"spec.state"`
+}
+
+type testFunctionOutput struct {
+	Arn          *string            `bluelink:"spec.arn"`
+	Version      string             `bluelink:"spec.version,omitempty"`
+	CodeSize     int64              `bluelink:"spec.codeSize"`
+	Layers       []string           `bluelink:"spec.layers"`
+	Tags         map[string]string  `bluelink:"spec.tags"`
+	Config       testFunctionConfig `bluelink:"spec.config"`
+	Endpoint     *string            `bluelink:"spec.endpoint"`
+	Internal     string             `bluelink:"-"`
+	Untagged     string
+	StateDetails *testFunctionState
+}
+
+func (s *ComputedFieldsTestSuite) Test_maps_tagged_struct_fields_to_computed_field_values() {
+	arn := "arn:aws:lambda:us-east-1:123456789012:function:orders"
+	computedFieldValues, err := ComputedFieldValuesFromStruct(&testFunctionOutput{
+		Arn:      &arn,
+		CodeSize: 2048,
+		Layers:   []string{"layer-1", "layer-2"},
+		Tags:     map[string]string{"team": "orders"},
+		Config: testFunctionConfig{
+			Timeout: 30,
+			Ignored: "ignored",
+		},
+		Internal: "internal",
+		Untagged: "untagged",
+		StateDetails: &testFunctionState{
+			State: "Active",
+		},
+	})
+	s.Require().NoError(err)
+
+	s.Equal(
+		map[string]*core.MappingNode{
+			"spec.arn":      core.MappingNodeFromString(arn),
+			"spec.codeSize": core.MappingNodeFromInt(2048),
+			"spec.layers": {
+				Items: []*core.MappingNode{
+					core.MappingNodeFromString("layer-1"),
+					core.MappingNodeFromString("layer-2"),
+				},
+			},
+			"spec.tags": {
+				Fields: map[string]*core.MappingNode{
+					"team": core.MappingNodeFromString("orders"),
+				},
+			},
+			"spec.config": {
+				Fields: map[string]*core.MappingNode{
+					"timeout": core.MappingNodeFromInt(30),
+					"handler": core.MappingNodeFromString(""),
+				},
+			},
+			"spec.state": core.MappingNodeFromString("Active"),
+		},
+		computedFieldValues,
+	)
+}
+
+func (s *ComputedFieldsTestSuite) Test_returns_empty_values_for_nil_response() {
+	var output *testFunctionOutput
+	computedFieldValues, err := ComputedFieldValuesFromStruct(output)
+	s.Require().NoError(err)
+	s.Empty(computedFieldValues)
+}
+
+func (s *ComputedFieldsTestSuite) Test_fails_for_non_struct_response() {
+	_, err := ComputedFieldValuesFromStruct(map[string]string{"arn": "arn"})
+	s.Require().Error(err)
+	s.Equal(
+		"expected a struct or pointer to a struct to extract computed fields from, got map[string]string",
+		err.Error(),
+	)
+}
+
+func (s *ComputedFieldsTestSuite) Test_fails_for_unsupported_field_type() {
+	_, err := ComputedFieldValuesFromStruct(struct {
+		Handler func() `bluelink:"spec.handler"`
+	}{
+		Handler: func() {},
+	})
+	s.Require().Error(err)
+	s.Equal(
+		"failed to convert value for computed field \"spec.handler\": unsupported type: func()",
+		err.Error(),
+	)
+}
+
+func (s *ComputedFieldsTestSuite) Test_resource_definition_checks_paths_against_computed_fields() {
+	resourceDef := &ResourceDefinition{
+		Type: "test/lambda/function",
+		Schema: &provider.ResourceDefinitionsSchema{
+			Type: provider.ResourceDefinitionsSchemaTypeObject,
+			Attributes: map[string]*provider.ResourceDefinitionsSchema{
+				"arn": {
+					Type:     provider.ResourceDefinitionsSchemaTypeString,
+					Computed: true,
+				},
+				"functionName": {
+					Type: provider.ResourceDefinitionsSchemaTypeString,
+				},
+			},
+		},
+	}
+
+	arn := "arn:aws:lambda:us-east-1:123456789012:function:orders"
+	computedFieldValues, err := resourceDef.ComputedFieldValuesFromStruct(struct {
+		Arn *string `bluelink:"spec.arn"`
+	}{
+		Arn: &arn,
+	})
+	s.Require().NoError(err)
+	s.Equal(
+		map[string]*core.MappingNode{
+			"spec.arn": core.MappingNodeFromString(arn),
+		},
+		computedFieldValues,
+	)
+
+	_, err = resourceDef.ComputedFieldValuesFromStruct(struct {
+		Arn *string `bluelink:"spec.ar"`
+	}{
+		Arn: &arn,
+	})
+	s.Require().Error(err)
+	s.Equal(
+		"\"spec.ar\" is not a computed field in the spec of the \"test/lambda/function\" resource type",
+		err.Error(),
+	)
+}
+
+func TestComputedFieldsTestSuite(t *testing.T) {
+	suite.Run(t, new(ComputedFieldsTestSuite))
+}
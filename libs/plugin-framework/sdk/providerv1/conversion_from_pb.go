@@ -62,6 +62,23 @@ func fromPBGetResourceExternalStateRequest(
 	}, nil
 }
 
+func fromPBListResourcesRequest(
+	req *providerserverv1.ListResourcesRequest,
+) (*provider.ResourceListInput, error) {
+	providerCtx, err := convertv1.FromPBProviderContext(req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ResourceListInput{
+		ResourceType:    convertv1.ResourceTypeToString(req.ResourceType),
+		InstanceName:    req.InstanceName,
+		ResourceName:    req.ResourceName,
+		MaxResults:      int(req.MaxResults),
+		ProviderContext: providerCtx,
+	}, nil
+}
+
 func fromPBStageLinkChangesRequest(
 	req *providerserverv1.StageLinkChangesRequest,
 ) (*provider.LinkStageChangesInput, error) {
@@ -86,11 +103,20 @@ func fromPBStageLinkChangesRequest(
 		return nil, err
 	}
 
+	linkConfig, err := serialisation.FromMappingNodePB(
+		req.LinkConfig,
+		/* optional */ true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &provider.LinkStageChangesInput{
 		ResourceAChanges: resourceAChanges,
 		ResourceBChanges: resourceBChanges,
 		CurrentLinkState: currentLinkState,
 		LinkContext:      linkContext,
+		LinkConfig:       linkConfig,
 	}, nil
 }
 
@@ -243,6 +269,14 @@ func fromPBUpdateLinkResourceRequest(
 		return nil, err
 	}
 
+	linkConfig, err := serialisation.FromMappingNodePB(
+		req.LinkConfig,
+		/* optional */ true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &provider.LinkUpdateResourceInput{
 		Changes:           &changes,
 		ResourceInfo:      &resourceInfo,
@@ -251,6 +285,7 @@ func fromPBUpdateLinkResourceRequest(
 		CurrentLinkState:  currentLinkState,
 		InstanceName:      req.InstanceName,
 		LinkContext:       linkContext,
+		LinkConfig:        linkConfig,
 	}, nil
 }
 
@@ -283,6 +318,14 @@ func fromPBLinkIntermediaryResourceRequest(
 		return nil, err
 	}
 
+	linkConfig, err := serialisation.FromMappingNodePB(
+		req.LinkConfig,
+		/* optional */ true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &provider.LinkUpdateIntermediaryResourcesInput{
 		ResourceAInfo:    &resourceAInfo,
 		ResourceBInfo:    &resourceBInfo,
@@ -292,6 +335,7 @@ func fromPBLinkIntermediaryResourceRequest(
 		LinkUpdateType:   provider.LinkUpdateType(req.UpdateType),
 		CurrentLinkState: currentLinkState,
 		LinkContext:      linkContext,
+		LinkConfig:       linkConfig,
 		ResourceService:  resourceService,
 	}, nil
 }
@@ -335,6 +379,19 @@ func fromPBLinkRequestForAnnotationDefinitions(
 	}, nil
 }
 
+func fromPBLinkRequestForConfigDefinition(
+	req *providerserverv1.LinkRequest,
+) (*provider.LinkGetConfigDefinitionInput, error) {
+	linkContext, err := fromPBLinkContext(req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.LinkGetConfigDefinitionInput{
+		LinkContext: linkContext,
+	}, nil
+}
+
 func fromPBLinkRequestForKind(
 	req *providerserverv1.LinkRequest,
 ) (*provider.LinkGetKindInput, error) {
@@ -228,6 +228,9 @@ func toPBResourceSpecDefinitionResponse(
 				Schema:              schema,
 				IdField:             output.SpecDefinition.IDField,
 				DestroyBeforeCreate: output.SpecDefinition.DestroyBeforeCreate,
+				SupportsDryRun:      output.SpecDefinition.SupportsDryRun,
+				Deprecated:          output.SpecDefinition.Deprecated,
+				DeprecationMessage:  output.SpecDefinition.DeprecationMessage,
 			},
 		},
 	}, nil
@@ -413,6 +416,60 @@ func toResourceExternalStateErrorResponse(
 	}
 }
 
+func toListResourcesResponse(
+	output *provider.ResourceListOutput,
+) *providerserverv1.ListResourcesResponse {
+	if output == nil {
+		return &providerserverv1.ListResourcesResponse{
+			Response: &providerserverv1.ListResourcesResponse_ErrorResponse{
+				ErrorResponse: sharedtypesv1.NoResponsePBError(),
+			},
+		}
+	}
+
+	resources := make([]*providerserverv1.ExternalResource, 0, len(output.Resources))
+	for _, resource := range output.Resources {
+		if resource == nil {
+			continue
+		}
+
+		resources = append(resources, &providerserverv1.ExternalResource{
+			ImportId:    resource.ImportID,
+			Name:        resource.Name,
+			Description: resource.Description,
+		})
+	}
+
+	return &providerserverv1.ListResourcesResponse{
+		Response: &providerserverv1.ListResourcesResponse_CompleteResponse{
+			CompleteResponse: &providerserverv1.ListResourcesCompleteResponse{
+				ListingSupported: true,
+				Resources:        resources,
+			},
+		},
+	}
+}
+
+func toListResourcesNotSupportedResponse() *providerserverv1.ListResourcesResponse {
+	return &providerserverv1.ListResourcesResponse{
+		Response: &providerserverv1.ListResourcesResponse_CompleteResponse{
+			CompleteResponse: &providerserverv1.ListResourcesCompleteResponse{
+				ListingSupported: false,
+			},
+		},
+	}
+}
+
+func toListResourcesErrorResponse(
+	err error,
+) *providerserverv1.ListResourcesResponse {
+	return &providerserverv1.ListResourcesResponse{
+		Response: &providerserverv1.ListResourcesResponse_ErrorResponse{
+			ErrorResponse: errorsv1.CreateResponseFromError(err),
+		},
+	}
+}
+
 func toStageLinkChangesErrorResponse(
 	err error,
 ) *providerserverv1.StageLinkChangesResponse {
@@ -704,6 +761,41 @@ func toPBGetLinkAnnotationDefinitionsResponse(
 	}, nil
 }
 
+func toGetLinkConfigDefinitionErrorResponse(
+	err error,
+) *providerserverv1.LinkConfigDefinitionResponse {
+	return &providerserverv1.LinkConfigDefinitionResponse{
+		Response: &providerserverv1.LinkConfigDefinitionResponse_ErrorResponse{
+			ErrorResponse: errorsv1.CreateResponseFromError(err),
+		},
+	}
+}
+
+func toPBGetLinkConfigDefinitionResponse(
+	output *provider.LinkGetConfigDefinitionOutput,
+) (*providerserverv1.LinkConfigDefinitionResponse, error) {
+	if output == nil {
+		return &providerserverv1.LinkConfigDefinitionResponse{
+			Response: &providerserverv1.LinkConfigDefinitionResponse_ErrorResponse{
+				ErrorResponse: sharedtypesv1.NoResponsePBError(),
+			},
+		}, nil
+	}
+
+	schema, err := convertv1.ToPBResourceDefinitionsSchema(output.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return &providerserverv1.LinkConfigDefinitionResponse{
+		Response: &providerserverv1.LinkConfigDefinitionResponse_ConfigDefinition{
+			ConfigDefinition: &providerserverv1.LinkConfigDefinition{
+				Schema: schema,
+			},
+		},
+	}, nil
+}
+
 func toPBLinkAnnotationDefinition(
 	definition *provider.LinkAnnotationDefinition,
 ) (*sharedtypesv1.LinkAnnotationDefinition, error) {
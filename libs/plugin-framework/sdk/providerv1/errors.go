@@ -38,6 +38,13 @@ func errResourceUpdateFunctionMissing(resourceType string) error {
 	)
 }
 
+func errResourceDryRunNotSupported(resourceType string) error {
+	return fmt.Errorf(
+		"dry run deployments are not supported for resource type %q",
+		resourceType,
+	)
+}
+
 func errResourceGetExternalStateFunctionMissing(resourceType string) error {
 	return fmt.Errorf(
 		"get external state function missing in resource definition for resource type %q",
@@ -55,6 +55,13 @@ type LinkDefinition struct {
 	// e.g. "aws/lambda/function::aws.lambda.dynamodb.accessType" -> LinkAnnotationDefinition
 	AnnotationDefinitions map[string]*provider.LinkAnnotationDefinition
 
+	// The schema for blueprint-level configuration of the link that can be set
+	// in the `links` section of a blueprint.
+	// This must be an object schema where each attribute is a field that can be set
+	// in the configuration for the link.
+	// Nil means the link can not be configured in the `links` section of a blueprint.
+	ConfigSchema *provider.ResourceDefinitionsSchema
+
 	// Cardinality on the A side of the link: how many B's each A may link to.
 	// Zero value means no constraint.
 	CardinalityA provider.LinkCardinality
@@ -189,6 +196,15 @@ func (l *LinkDefinition) GetAnnotationDefinitions(
 	}, nil
 }
 
+func (l *LinkDefinition) GetConfigDefinition(
+	ctx context.Context,
+	input *provider.LinkGetConfigDefinitionInput,
+) (*provider.LinkGetConfigDefinitionOutput, error) {
+	return &provider.LinkGetConfigDefinitionOutput{
+		Schema: l.ConfigSchema,
+	}, nil
+}
+
 func (l *LinkDefinition) GetPriorityResource(
 	ctx context.Context,
 	input *provider.LinkGetPriorityResourceInput,
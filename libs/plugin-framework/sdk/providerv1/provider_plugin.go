@@ -2,6 +2,7 @@ package providerv1
 
 import (
 	"context"
+	"errors"
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/plugin-framework/convertv1"
@@ -588,6 +589,47 @@ func (p *blueprintProviderPluginImpl) GetResourceExternalState(
 	return response, nil
 }
 
+func (p *blueprintProviderPluginImpl) ListResources(
+	ctx context.Context,
+	req *providerserverv1.ListResourcesRequest,
+) (*providerserverv1.ListResourcesResponse, error) {
+	err := p.checkHostID(req.HostId)
+	if err != nil {
+		return toListResourcesErrorResponse(err), nil
+	}
+
+	resource, err := p.bpProvider.Resource(
+		ctx,
+		convertv1.ResourceTypeToString(req.ResourceType),
+	)
+	if err != nil {
+		return toListResourcesErrorResponse(err), nil
+	}
+
+	lister, isLister := resource.(provider.ResourceLister)
+	if !isLister {
+		return toListResourcesNotSupportedResponse(), nil
+	}
+
+	listResourcesInput, err := fromPBListResourcesRequest(req)
+	if err != nil {
+		return toListResourcesErrorResponse(err), nil
+	}
+
+	output, err := lister.ListResources(
+		ctx,
+		listResourcesInput,
+	)
+	if errors.Is(err, provider.ErrResourceListingNotSupported) {
+		return toListResourcesNotSupportedResponse(), nil
+	}
+	if err != nil {
+		return toListResourcesErrorResponse(err), nil
+	}
+
+	return toListResourcesResponse(output), nil
+}
+
 func (p *blueprintProviderPluginImpl) DestroyResource(
 	ctx context.Context,
 	req *sharedtypesv1.DestroyResourceRequest,
@@ -911,6 +953,59 @@ func (p *blueprintProviderPluginImpl) GetLinkAnnotationDefinitions(
 	return response, nil
 }
 
+func (p *blueprintProviderPluginImpl) GetLinkConfigDefinition(
+	ctx context.Context,
+	req *providerserverv1.LinkRequest,
+) (*providerserverv1.LinkConfigDefinitionResponse, error) {
+	err := p.checkHostID(req.HostId)
+	if err != nil {
+		return toGetLinkConfigDefinitionErrorResponse(err), nil
+	}
+
+	linkTypeInfo, err := extractLinkTypeInfo(req.LinkType)
+	if err != nil {
+		return toGetLinkConfigDefinitionErrorResponse(err), nil
+	}
+
+	link, err := p.bpProvider.Link(
+		ctx,
+		linkTypeInfo.resourceTypeA,
+		linkTypeInfo.resourceTypeB,
+	)
+	if err != nil {
+		return toGetLinkConfigDefinitionErrorResponse(err), nil
+	}
+
+	configDefProvider, supportsConfig := link.(provider.LinkConfigDefinitionProvider)
+	if !supportsConfig {
+		// Links that do not support configuration are represented by
+		// a definition without a schema.
+		return toPBGetLinkConfigDefinitionResponse(
+			&provider.LinkGetConfigDefinitionOutput{},
+		)
+	}
+
+	getConfigDefInput, err := fromPBLinkRequestForConfigDefinition(req)
+	if err != nil {
+		return toGetLinkConfigDefinitionErrorResponse(err), nil
+	}
+
+	output, err := configDefProvider.GetConfigDefinition(
+		ctx,
+		getConfigDefInput,
+	)
+	if err != nil {
+		return toGetLinkConfigDefinitionErrorResponse(err), nil
+	}
+
+	response, err := toPBGetLinkConfigDefinitionResponse(output)
+	if err != nil {
+		return toGetLinkConfigDefinitionErrorResponse(err), nil
+	}
+
+	return response, nil
+}
+
 func (p *blueprintProviderPluginImpl) GetLinkKind(
 	ctx context.Context,
 	req *providerserverv1.LinkRequest,
@@ -5,6 +5,8 @@ import (
 
 	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/plugin-framework/sdk/pluginutils"
 )
 
 // ResourceDefinition is a template to be used for defining resources
@@ -50,6 +52,13 @@ type ResourceDefinition struct {
 	// This will be used in documentation and tooling.
 	FormattedExamples []string
 
+	// A list of runnable blueprint examples that demonstrate how to use the resource.
+	// These are rendered as YAML code blocks after the plain text and formatted
+	// examples and can be verified against the resource schema
+	// with VerifyResourceExamples.
+	// This will be used in documentation and tooling.
+	BlueprintExamples []*ResourceExample
+
 	// The schema of the resource specification that comes under the `spec` field
 	// of a resource in a blueprint.
 	Schema *provider.ResourceDefinitionsSchema
@@ -74,9 +83,10 @@ type ResourceDefinition struct {
 	// as some providers may not allow the use of the same name for a resource until a certain
 	// period of time has passed since the resource was destroyed.
 	//
-	// To keep things simple for the practitioner, this is not something that can be set
-	// in a blueprint spec, it is up to the developers of providers to set this
-	// based on the nature of the resource and how critical it will be deemed for most use cases.
+	// This is the default for the resource type that should be set based on the nature
+	// of the resource and how critical it will be deemed for most use cases,
+	// practitioners can override this for a resource in a blueprint
+	// with the "replaceStrategy" field.
 	DestroyBeforeCreate bool
 
 	// TaggingSupport indicates how the resource type supports external tagging.
@@ -85,6 +95,18 @@ type ResourceDefinition struct {
 	// Use provider.TaggingSupportNone (default) for resources that do not support tags.
 	TaggingSupport provider.TaggingSupport
 
+	// Specifies whether the resource type is deprecated and should no longer
+	// be used in new blueprints.
+	// Blueprints that use a deprecated resource type are still valid,
+	// users will be warned about the deprecation during validation and change staging.
+	Deprecated bool
+
+	// Guidance for users of a deprecated resource type, this should include
+	// the resource type that replaces the deprecated type where there is one.
+	// Deprecated fields in the resource spec should be marked with the `Deprecated`
+	// and `DeprecationMessage` fields of the schema.
+	DeprecationMessage string
+
 	// Specifies whether this resource is expected to have a common use-case
 	// as a terminal resource that does not link out to other resources.
 	// This is useful for providing useful warnings to users about their blueprints
@@ -120,6 +142,15 @@ type ResourceDefinition struct {
 		input *provider.ResourceGetExternalStateInput,
 	) (*provider.ResourceGetExternalStateOutput, error)
 
+	// A function to list existing resources of the resource type in the upstream system
+	// that can be imported into a blueprint instance.
+	// When this is not provided, listing resources is not supported for the resource type
+	// and resources can only be imported with an explicitly provided import ID.
+	ListResourcesFunc func(
+		ctx context.Context,
+		input *provider.ResourceListInput,
+	) (*provider.ResourceListOutput, error)
+
 	// A function to create the resource in the upstream provider.
 	CreateFunc func(
 		ctx context.Context,
@@ -132,6 +163,19 @@ type ResourceDefinition struct {
 		input *provider.ResourceDeployInput,
 	) (*provider.ResourceDeployOutput, error)
 
+	// A function to validate the deployment of the resource against the upstream
+	// provider without making any changes.
+	// This should make use of validate-only APIs provided by the upstream provider
+	// where available to catch errors that can not be determined from static analysis
+	// of the changes such as insufficient permissions or quota limits.
+	// When provided, the resource will report support for dry runs in its spec definition
+	// and this function will be called instead of CreateFunc or UpdateFunc for dry run
+	// deployments.
+	DryRunFunc func(
+		ctx context.Context,
+		input *provider.ResourceDeployInput,
+	) (*provider.ResourceDeployOutput, error)
+
 	// A function to delete the resource in the upstream provider.
 	DestroyFunc func(
 		ctx context.Context,
@@ -182,6 +226,9 @@ func (r *ResourceDefinition) GetSpecDefinition(
 			IDField:             r.IDField,
 			DestroyBeforeCreate: r.DestroyBeforeCreate,
 			TaggingSupport:      r.TaggingSupport,
+			SupportsDryRun:      r.DryRunFunc != nil,
+			Deprecated:          r.Deprecated,
+			DeprecationMessage:  r.DeprecationMessage,
 		},
 	}, nil
 }
@@ -249,9 +296,22 @@ func (r *ResourceDefinition) GetExamples(
 	ctx context.Context,
 	input *provider.ResourceGetExamplesInput,
 ) (*provider.ResourceGetExamplesOutput, error) {
+	if len(r.BlueprintExamples) == 0 {
+		return &provider.ResourceGetExamplesOutput{
+			MarkdownExamples:  r.FormattedExamples,
+			PlainTextExamples: r.PlainTextExamples,
+		}, nil
+	}
+
 	return &provider.ResourceGetExamplesOutput{
-		MarkdownExamples:  r.FormattedExamples,
-		PlainTextExamples: r.PlainTextExamples,
+		MarkdownExamples: append(
+			append([]string{}, r.FormattedExamples...),
+			renderFormattedResourceExamples(r.BlueprintExamples)...,
+		),
+		PlainTextExamples: append(
+			append([]string{}, r.PlainTextExamples...),
+			renderPlainTextResourceExamples(r.BlueprintExamples)...,
+		),
 	}, nil
 }
 
@@ -267,6 +327,23 @@ func (r *ResourceDefinition) Deploy(
 		return nil, errResourceUpdateFunctionMissing(r.Type)
 	}
 
+	ctx = r.withLogContext(
+		ctx,
+		&pluginutils.ResourceLogContext{
+			InstanceID:   input.InstanceID,
+			ResourceID:   input.ResourceID,
+			ResourceName: deployInputResourceName(input),
+		},
+		input.ProviderContext,
+	)
+
+	if input.DryRun {
+		if r.DryRunFunc == nil {
+			return nil, errResourceDryRunNotSupported(r.Type)
+		}
+		return r.DryRunFunc(ctx, input)
+	}
+
 	// The blueprint framework will only populate the `CurrentResourceState` field
 	// of the input if the resource already exists in the blueprint state container,
 	// meaning the resource is being updated.
@@ -275,7 +352,8 @@ func (r *ResourceDefinition) Deploy(
 	if hasCurrentResourceState && input.Changes.MustRecreate {
 		var resourceDeployOutput *provider.ResourceDeployOutput
 		var err error
-		if !r.DestroyBeforeCreate {
+		destroyBeforeCreate := r.destroyBeforeCreate(input.Changes)
+		if !destroyBeforeCreate {
 			resourceDeployOutput, err = r.CreateFunc(ctx, input)
 			if err != nil {
 				return nil, err
@@ -292,7 +370,7 @@ func (r *ResourceDefinition) Deploy(
 			return nil, err
 		}
 
-		if r.DestroyBeforeCreate {
+		if destroyBeforeCreate {
 			resourceDeployOutput, err = r.CreateFunc(ctx, input)
 		}
 
@@ -306,11 +384,33 @@ func (r *ResourceDefinition) Deploy(
 	return r.CreateFunc(ctx, input)
 }
 
+// Determines whether the resource should be destroyed before it is created again
+// when it must be recreated, the replace strategy set for the resource
+// in a blueprint takes precedence over the default for the resource type.
+func (r *ResourceDefinition) destroyBeforeCreate(changes *provider.Changes) bool {
+	switch changes.ReplaceStrategy {
+	case schema.ReplaceStrategyDestroyBeforeCreate:
+		return true
+	case schema.ReplaceStrategyCreateBeforeDestroy:
+		return false
+	default:
+		return r.DestroyBeforeCreate
+	}
+}
+
 func (r *ResourceDefinition) HasStabilised(
 	ctx context.Context,
 	input *provider.ResourceHasStabilisedInput,
 ) (*provider.ResourceHasStabilisedOutput, error) {
 	if r.StabilisedFunc != nil {
+		ctx = r.withLogContext(
+			ctx,
+			&pluginutils.ResourceLogContext{
+				InstanceID: input.InstanceID,
+				ResourceID: input.ResourceID,
+			},
+			input.ProviderContext,
+		)
 		return r.StabilisedFunc(ctx, input)
 	}
 
@@ -327,9 +427,36 @@ func (r *ResourceDefinition) GetExternalState(
 		return nil, errResourceGetExternalStateFunctionMissing(r.Type)
 	}
 
+	ctx = r.withLogContext(
+		ctx,
+		&pluginutils.ResourceLogContext{
+			InstanceID:   input.InstanceID,
+			ResourceID:   input.ResourceID,
+			ResourceName: input.ResourceName,
+		},
+		input.ProviderContext,
+	)
 	return r.GetExternalStateFunc(ctx, input)
 }
 
+func (r *ResourceDefinition) ListResources(
+	ctx context.Context,
+	input *provider.ResourceListInput,
+) (*provider.ResourceListOutput, error) {
+	if r.ListResourcesFunc == nil {
+		return nil, provider.ErrResourceListingNotSupported
+	}
+
+	ctx = r.withLogContext(
+		ctx,
+		&pluginutils.ResourceLogContext{
+			ResourceName: input.ResourceName,
+		},
+		input.ProviderContext,
+	)
+	return r.ListResourcesFunc(ctx, input)
+}
+
 func (r *ResourceDefinition) Destroy(
 	ctx context.Context,
 	input *provider.ResourceDestroyInput,
@@ -338,9 +465,57 @@ func (r *ResourceDefinition) Destroy(
 		return errResourceDestroyFunctionMissing(r.Type)
 	}
 
+	resourceName := ""
+	if input.ResourceState != nil {
+		resourceName = input.ResourceState.Name
+	}
+	ctx = r.withLogContext(
+		ctx,
+		&pluginutils.ResourceLogContext{
+			InstanceID:   input.InstanceID,
+			ResourceID:   input.ResourceID,
+			ResourceName: resourceName,
+		},
+		input.ProviderContext,
+	)
 	return r.DestroyFunc(ctx, input)
 }
 
+// withLogContext attaches information about the resource being operated on
+// to the context so that loggers derived with pluginutils.LoggerFromContext
+// include it in log messages.
+// The run ID is taken from the provider context variables when it has not
+// already been set in the Go context.
+func (r *ResourceDefinition) withLogContext(
+	ctx context.Context,
+	resourceLogContext *pluginutils.ResourceLogContext,
+	providerContext provider.Context,
+) context.Context {
+	resourceLogContext.ResourceType = r.Type
+	ctx = pluginutils.ContextWithResourceLogContext(ctx, resourceLogContext)
+
+	_, hasRunID := ctx.Value(pluginutils.ContextRunIDKey).(string)
+	if hasRunID || providerContext == nil {
+		return ctx
+	}
+
+	runIDVar, _ := providerContext.ContextVariable(pluginutils.RunIDKey)
+	runID := core.StringValueFromScalar(runIDVar)
+	if runID == "" {
+		return ctx
+	}
+
+	return pluginutils.ContextWithRunID(ctx, runID)
+}
+
+func deployInputResourceName(input *provider.ResourceDeployInput) string {
+	if input.Changes == nil {
+		return ""
+	}
+
+	return input.Changes.AppliedResourceInfo.ResourceName
+}
+
 func isCurrentResourceStatePopulated(
 	input *provider.ResourceDeployInput,
 ) bool {
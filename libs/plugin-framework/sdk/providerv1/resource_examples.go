@@ -0,0 +1,187 @@
+package providerv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/container"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/core"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/transform"
+)
+
+// ResourceExample is a runnable example of how to use a resource type
+// in a blueprint.
+// Unlike plain text and formatted examples, blueprint examples are complete
+// blueprint documents that can be verified against the schema of the resource type
+// with VerifyResourceExamples, ensuring that examples in documentation and tooling
+// do not drift from the resource type's schema.
+type ResourceExample struct {
+	// A short title for the example.
+	// Example: "Function with a custom runtime"
+	Title string
+	// An optional description of the example that can be formatted using markdown.
+	Description string
+	// The source of a complete blueprint document in YAML format
+	// that contains at least one resource of the resource type the example
+	// is attached to.
+	Blueprint string
+}
+
+// ResourceExampleError holds information about a blueprint example
+// for a resource type that failed verification.
+type ResourceExampleError struct {
+	ResourceType string
+	// The position of the example in the list of blueprint examples
+	// for the resource type.
+	ExampleIndex int
+	ExampleTitle string
+	Err          error
+}
+
+func (e *ResourceExampleError) Error() string {
+	return fmt.Sprintf(
+		"blueprint example %d (%q) for resource type %q is not valid: %s",
+		e.ExampleIndex,
+		e.ExampleTitle,
+		e.ResourceType,
+		e.Err.Error(),
+	)
+}
+
+// VerifyResourceExamples validates all the blueprint examples attached to resource
+// definitions in the provided plugin definition against the schemas
+// of the resource types.
+// This is intended to be used in the CI pipeline of a provider plugin
+// (e.g. in a unit test) to catch examples that are no longer valid.
+// Resources in the plugin definition that are not defined with the ResourceDefinition
+// helper struct are skipped.
+func VerifyResourceExamples(
+	ctx context.Context,
+	pluginDefinition *ProviderPluginDefinition,
+) []*ResourceExampleError {
+	loader := container.NewDefaultLoader(
+		map[string]provider.Provider{
+			pluginDefinition.ProviderNamespace: pluginDefinition,
+		},
+		map[string]transform.SpecTransformer{},
+		/* stateContainer */ nil,
+		/* childResolver */ nil,
+	)
+	params := core.NewDefaultParams(
+		map[string]map[string]*core.ScalarValue{},
+		map[string]map[string]*core.ScalarValue{},
+		map[string]*core.ScalarValue{},
+		map[string]*core.ScalarValue{},
+	)
+
+	exampleErrors := []*ResourceExampleError{}
+	for _, resource := range pluginDefinition.Resources {
+		resourceDefinition, isResourceDefinition := resource.(*ResourceDefinition)
+		if !isResourceDefinition {
+			continue
+		}
+
+		for i, example := range resourceDefinition.BlueprintExamples {
+			err := verifyResourceExample(ctx, loader, resourceDefinition.Type, example, params)
+			if err != nil {
+				exampleErrors = append(exampleErrors, &ResourceExampleError{
+					ResourceType: resourceDefinition.Type,
+					ExampleIndex: i,
+					ExampleTitle: example.Title,
+					Err:          err,
+				})
+			}
+		}
+	}
+
+	return exampleErrors
+}
+
+func verifyResourceExample(
+	ctx context.Context,
+	loader container.Loader,
+	resourceType string,
+	example *ResourceExample,
+	params core.BlueprintParams,
+) error {
+	result, err := loader.ValidateString(
+		ctx,
+		example.Blueprint,
+		schema.YAMLSpecFormat,
+		params,
+	)
+	if err != nil {
+		return err
+	}
+
+	errorMessages := []string{}
+	for _, diagnostic := range result.Diagnostics {
+		if diagnostic.Level == core.DiagnosticLevelError {
+			errorMessages = append(errorMessages, diagnostic.Message)
+		}
+	}
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("%s", strings.Join(errorMessages, "; "))
+	}
+
+	if !blueprintContainsResourceType(result.Schema, resourceType) {
+		return fmt.Errorf(
+			"the example blueprint does not contain any resources of type %q",
+			resourceType,
+		)
+	}
+
+	return nil
+}
+
+func blueprintContainsResourceType(blueprint *schema.Blueprint, resourceType string) bool {
+	if blueprint == nil || blueprint.Resources == nil {
+		return false
+	}
+
+	for _, resource := range blueprint.Resources.Values {
+		if resource.Type != nil && resource.Type.Value == resourceType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func renderFormattedResourceExamples(examples []*ResourceExample) []string {
+	rendered := []string{}
+	for _, example := range examples {
+		var sb strings.Builder
+		if example.Title != "" {
+			sb.WriteString("**")
+			sb.WriteString(example.Title)
+			sb.WriteString("**\n\n")
+		}
+		if example.Description != "" {
+			sb.WriteString(example.Description)
+			sb.WriteString("\n\n")
+		}
+		sb.WriteString("```yaml\n")
+		sb.WriteString(strings.TrimSpace(example.Blueprint))
+		sb.WriteString("\n```")
+		rendered = append(rendered, sb.String())
+	}
+	return rendered
+}
+
+func renderPlainTextResourceExamples(examples []*ResourceExample) []string {
+	rendered := []string{}
+	for _, example := range examples {
+		var sb strings.Builder
+		if example.Title != "" {
+			sb.WriteString(example.Title)
+			sb.WriteString("\n\n")
+		}
+		sb.WriteString(strings.TrimSpace(example.Blueprint))
+		rendered = append(rendered, sb.String())
+	}
+	return rendered
+}
@@ -0,0 +1,168 @@
+package providerv1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResourceExamplesTestSuite struct {
+	suite.Suite
+}
+
+func (s *ResourceExamplesTestSuite) Test_verifies_valid_blueprint_examples() {
+	pluginDefinition := createExamplesTestPluginDefinition(
+		&ResourceExample{
+			Title:     "Basic function",
+			Blueprint: validFunctionExample,
+		},
+	)
+
+	exampleErrors := VerifyResourceExamples(context.Background(), pluginDefinition)
+	s.Assert().Empty(exampleErrors)
+}
+
+func (s *ResourceExamplesTestSuite) Test_reports_examples_that_do_not_match_the_resource_schema() {
+	pluginDefinition := createExamplesTestPluginDefinition(
+		&ResourceExample{
+			Title:     "Basic function",
+			Blueprint: validFunctionExample,
+		},
+		&ResourceExample{
+			Title:     "Function missing handler",
+			Blueprint: functionMissingHandlerExample,
+		},
+	)
+
+	exampleErrors := VerifyResourceExamples(context.Background(), pluginDefinition)
+	s.Require().Len(exampleErrors, 1)
+	s.Assert().Equal("example/function", exampleErrors[0].ResourceType)
+	s.Assert().Equal(1, exampleErrors[0].ExampleIndex)
+	s.Assert().Equal("Function missing handler", exampleErrors[0].ExampleTitle)
+	s.Assert().Error(exampleErrors[0].Err)
+}
+
+func (s *ResourceExamplesTestSuite) Test_reports_examples_that_do_not_use_the_resource_type() {
+	pluginDefinition := createExamplesTestPluginDefinition(
+		&ResourceExample{
+			Title:     "No function",
+			Blueprint: exampleWithoutFunction,
+		},
+	)
+
+	exampleErrors := VerifyResourceExamples(context.Background(), pluginDefinition)
+	s.Require().Len(exampleErrors, 1)
+	s.Assert().ErrorContains(
+		exampleErrors[0].Err,
+		"does not contain any resources of type \"example/function\"",
+	)
+}
+
+func (s *ResourceExamplesTestSuite) Test_includes_blueprint_examples_in_resource_examples() {
+	resourceDefinition := &ResourceDefinition{
+		Type:              "example/function",
+		FormattedExamples: []string{"Existing example"},
+		BlueprintExamples: []*ResourceExample{
+			{
+				Title:       "Basic function",
+				Description: "Deploys a function with a handler.",
+				Blueprint:   validFunctionExample,
+			},
+		},
+	}
+
+	output, err := resourceDefinition.GetExamples(
+		context.Background(),
+		&provider.ResourceGetExamplesInput{},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(output.MarkdownExamples, 2)
+	s.Assert().Equal("Existing example", output.MarkdownExamples[0])
+	s.Assert().Equal(
+		"**Basic function**\n\nDeploys a function with a handler.\n\n```yaml\n"+
+			trimmedValidFunctionExample+"\n```",
+		output.MarkdownExamples[1],
+	)
+	s.Require().Len(output.PlainTextExamples, 1)
+	s.Assert().Equal(
+		"Basic function\n\n"+trimmedValidFunctionExample,
+		output.PlainTextExamples[0],
+	)
+}
+
+func createExamplesTestPluginDefinition(examples ...*ResourceExample) *ProviderPluginDefinition {
+	return &ProviderPluginDefinition{
+		ProviderNamespace: "example",
+		Resources: map[string]provider.Resource{
+			"example/function": &ResourceDefinition{
+				Type: "example/function",
+				Schema: &provider.ResourceDefinitionsSchema{
+					Type: provider.ResourceDefinitionsSchemaTypeObject,
+					Attributes: map[string]*provider.ResourceDefinitionsSchema{
+						"handler": {
+							Type: provider.ResourceDefinitionsSchemaTypeString,
+						},
+						"memory": {
+							Type: provider.ResourceDefinitionsSchemaTypeInteger,
+						},
+					},
+					Required: []string{"handler"},
+				},
+				BlueprintExamples: examples,
+			},
+			"example/queue": &ResourceDefinition{
+				Type: "example/queue",
+				Schema: &provider.ResourceDefinitionsSchema{
+					Type: provider.ResourceDefinitionsSchemaTypeObject,
+					Attributes: map[string]*provider.ResourceDefinitionsSchema{
+						"queueName": {
+							Type: provider.ResourceDefinitionsSchemaTypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const trimmedValidFunctionExample = `version: 2025-11-02
+resources:
+  ordersFunction:
+    type: example/function
+    spec:
+      handler: orders.handler
+      memory: 256`
+
+const validFunctionExample = `
+version: 2025-11-02
+resources:
+  ordersFunction:
+    type: example/function
+    spec:
+      handler: orders.handler
+      memory: 256
+`
+
+const functionMissingHandlerExample = `
+version: 2025-11-02
+resources:
+  ordersFunction:
+    type: example/function
+    spec:
+      memory: 256
+`
+
+const exampleWithoutFunction = `
+version: 2025-11-02
+resources:
+  ordersQueue:
+    type: example/queue
+    spec:
+      queueName: orders
+`
+
+func TestResourceExamplesTestSuite(t *testing.T) {
+	suite.Run(t, new(ResourceExamplesTestSuite))
+}
@@ -19,12 +19,19 @@ func NoResponsePBError() *ErrorResponse {
 	}
 }
 
+// The diagnostic context metadata key used to carry the spec path of a diagnostic
+// between plugins and the host, the key is removed from the metadata when
+// converting back to a core diagnostic.
+const specPathMetadataKey = "bluelink.specPath"
+
 // ToPBDiagnostics converts a slice of core Diagnostics to a slice of protobuf Diagnostics
 // for a gRPC plugin response.
 func ToPBDiagnostics(diagnostics []*core.Diagnostic) ([]*Diagnostic, error) {
 	pbDiagnostics := make([]*Diagnostic, len(diagnostics))
 	for i, diag := range diagnostics {
-		diagContext, err := toPBDiagnosticContext(diag.Context)
+		diagContext, err := toPBDiagnosticContext(
+			withSpecPathMetadata(diag.Context, diag.SpecPath),
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -39,6 +46,26 @@ func ToPBDiagnostics(diagnostics []*core.Diagnostic) ([]*Diagnostic, error) {
 	return pbDiagnostics, nil
 }
 
+func withSpecPathMetadata(coreContext *errors.ErrorContext, specPath string) *errors.ErrorContext {
+	if specPath == "" {
+		return coreContext
+	}
+
+	contextWithSpecPath := &errors.ErrorContext{}
+	if coreContext != nil {
+		*contextWithSpecPath = *coreContext
+	}
+
+	metadata := make(map[string]any, len(contextWithSpecPath.Metadata)+1)
+	for key, value := range contextWithSpecPath.Metadata {
+		metadata[key] = value
+	}
+	metadata[specPathMetadataKey] = specPath
+	contextWithSpecPath.Metadata = metadata
+
+	return contextWithSpecPath
+}
+
 func toPBDiagnosticRange(coreRange *core.DiagnosticRange) *DiagnosticRange {
 	if coreRange == nil {
 		return nil
@@ -136,16 +163,44 @@ func ToCoreDiagnostics(diagnostics []*Diagnostic) ([]*core.Diagnostic, error) {
 			return nil, err
 		}
 
+		coreContext, specPath := extractSpecPathMetadata(coreContext)
 		coreDiagnostics[i] = &core.Diagnostic{
-			Level:   core.DiagnosticLevel(diag.Level),
-			Message: diag.Message,
-			Range:   toCoreDiagnosticRange(diag.Range),
-			Context: coreContext,
+			Level:    core.DiagnosticLevel(diag.Level),
+			Message:  diag.Message,
+			Range:    toCoreDiagnosticRange(diag.Range),
+			Context:  coreContext,
+			SpecPath: specPath,
 		}
 	}
 	return coreDiagnostics, nil
 }
 
+func extractSpecPathMetadata(coreContext *errors.ErrorContext) (*errors.ErrorContext, string) {
+	if coreContext == nil {
+		return nil, ""
+	}
+
+	specPath, hasSpecPath := coreContext.Metadata[specPathMetadataKey].(string)
+	if !hasSpecPath {
+		return coreContext, ""
+	}
+
+	delete(coreContext.Metadata, specPathMetadataKey)
+	if len(coreContext.Metadata) == 0 {
+		coreContext.Metadata = nil
+	}
+
+	if coreContext.Category == "" &&
+		coreContext.ReasonCode == "" &&
+		len(coreContext.SuggestedActions) == 0 &&
+		coreContext.Metadata == nil {
+		// The context was only created to carry the spec path.
+		return nil, specPath
+	}
+
+	return coreContext, specPath
+}
+
 func toCoreDiagnosticRange(pbRange *DiagnosticRange) *core.DiagnosticRange {
 	if pbRange == nil {
 		return nil
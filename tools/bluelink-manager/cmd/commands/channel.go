@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/github"
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/ui"
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/versions"
+)
+
+const channelFlagUsage = "Release channel to install from: stable, beta or nightly (default: the last used channel or stable)"
+
+// resolveChannel resolves the release channel to use, the channel provided
+// as a flag takes precedence over the channel recorded from a previous
+// install or update.
+func resolveChannel(flagValue string, manifest *versions.Manifest) (github.Channel, error) {
+	if flagValue != "" {
+		return github.ParseChannel(flagValue)
+	}
+	return github.ParseChannel(manifest.Channel)
+}
+
+// warnIncompatibleVersions prints a warning for each pair of component versions
+// that are known to be incompatible with each other.
+func warnIncompatibleVersions(installed map[string]string) {
+	for _, incompatibility := range versions.CheckCompatibility(installed) {
+		ui.Warn("Incompatible versions: %s", incompatibility)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/service"
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/shell"
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/ui"
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/versions"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +21,7 @@ type installOptions struct {
 	noPlugins     bool
 	corePlugins   string
 	force         bool
+	channel       string
 }
 
 func setupInstallCommand(rootCmd *cobra.Command) {
@@ -54,6 +56,7 @@ This command will:
 		"Comma-separated list of core plugins to install (default: newstack-cloud/aws)",
 	)
 	installCmd.Flags().BoolVar(&opts.force, "force", false, "Force reinstall/regenerate config")
+	installCmd.Flags().StringVar(&opts.channel, "channel", "", channelFlagUsage)
 
 	rootCmd.AddCommand(installCmd)
 }
@@ -74,13 +77,24 @@ func runInstall(opts *installOptions) error {
 		return err
 	}
 
+	manifest, err := versions.Load()
+	if err != nil {
+		return err
+	}
+
+	channel, err := resolveChannel(opts.channel, manifest)
+	if err != nil {
+		return err
+	}
+	ui.Info("Release channel: %s", channel)
+
 	// Resolve versions
 	client := github.NewClient()
 
 	cliVersion := opts.cliVersion
 	if cliVersion == "" {
 		ui.Info("Fetching latest CLI version...")
-		cliVersion, err = client.GetLatestVersion("apps/cli")
+		cliVersion, err = client.GetLatestVersion("apps/cli", channel)
 		if err != nil {
 			return err
 		}
@@ -89,7 +103,7 @@ func runInstall(opts *installOptions) error {
 	engineVersion := opts.engineVersion
 	if engineVersion == "" {
 		ui.Info("Fetching latest Deploy Engine version...")
-		engineVersion, err = client.GetLatestVersion("apps/deploy-engine")
+		engineVersion, err = client.GetLatestVersion("apps/deploy-engine", channel)
 		if err != nil {
 			return err
 		}
@@ -98,7 +112,7 @@ func runInstall(opts *installOptions) error {
 	lsVersion := opts.lsVersion
 	if lsVersion == "" {
 		ui.Info("Fetching latest Blueprint LS version...")
-		lsVersion, err = client.GetLatestVersion("tools/blueprint-ls")
+		lsVersion, err = client.GetLatestVersion("tools/blueprint-ls", channel)
 		if err != nil {
 			return err
 		}
@@ -110,6 +124,12 @@ func runInstall(opts *installOptions) error {
 	ui.Info("  Blueprint LS:  v%s", lsVersion)
 	ui.Println()
 
+	warnIncompatibleVersions(map[string]string{
+		versions.ComponentCLI:          cliVersion,
+		versions.ComponentDeployEngine: engineVersion,
+		versions.ComponentBlueprintLS:  lsVersion,
+	})
+
 	// Download components
 	if err := client.DownloadComponent(
 		"Bluelink CLI",
@@ -142,6 +162,14 @@ func runInstall(opts *installOptions) error {
 		return err
 	}
 
+	manifest.Channel = string(channel)
+	manifest.SetVersion(versions.ComponentCLI, cliVersion)
+	manifest.SetVersion(versions.ComponentDeployEngine, engineVersion)
+	manifest.SetVersion(versions.ComponentBlueprintLS, lsVersion)
+	if err := manifest.Save(); err != nil {
+		ui.Warn("Failed to record installed versions: %v", err)
+	}
+
 	// Configure authentication
 	if err := config.ConfigureAuth(opts.force); err != nil {
 		return err
@@ -83,6 +83,15 @@ func (s *InstallCommandSuite) Test_has_force_flag() {
 	s.Equal("false", flag.DefValue)
 }
 
+func (s *InstallCommandSuite) Test_has_channel_flag() {
+	rootCmd := NewRootCmd()
+	installCmd, _, _ := rootCmd.Find([]string{"install"})
+
+	flag := installCmd.Flag("channel")
+	s.NotNil(flag)
+	s.Equal("", flag.DefValue)
+}
+
 func (s *InstallCommandSuite) Test_help_contains_usage_info() {
 	rootCmd := NewRootCmd()
 	buf := new(bytes.Buffer)
@@ -1,25 +1,42 @@
 package commands
 
 import (
+	"fmt"
+
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/github"
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/paths"
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/ui"
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/upgrade"
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/versions"
 	"github.com/spf13/cobra"
 )
 
+type selfUpdateOptions struct {
+	channel string
+}
+
 func setupSelfUpdateCommand(rootCmd *cobra.Command) {
+	opts := &selfUpdateOptions{}
+
 	selfUpdateCmd := &cobra.Command{
 		Use:   "self-update",
 		Short: "Update bluelink-manager itself to the latest version",
+		Long: `Update bluelink-manager itself to the latest version in the selected
+release channel.
+
+The previous version is restored if the new version fails to download
+or fails signature or checksum verification.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runSelfUpdate()
+			return runSelfUpdate(opts)
 		},
 	}
 
+	selfUpdateCmd.Flags().StringVar(&opts.channel, "channel", "", channelFlagUsage)
+
 	rootCmd.AddCommand(selfUpdateCmd)
 }
 
-func runSelfUpdate() error {
+func runSelfUpdate(opts *selfUpdateOptions) error {
 	ui.Info("Checking for updates...")
 
 	platform, err := paths.DetectPlatform()
@@ -27,19 +44,34 @@ func runSelfUpdate() error {
 		return err
 	}
 
+	manifest, err := versions.Load()
+	if err != nil {
+		return err
+	}
+
+	channel, err := resolveChannel(opts.channel, manifest)
+	if err != nil {
+		return err
+	}
+
 	client := github.NewClient()
 
-	latestVersion, err := client.GetLatestVersion("tools/bluelink-manager")
+	latestVersion, err := client.GetLatestVersion("tools/bluelink-manager", channel)
 	if err != nil {
 		return err
 	}
 
 	if latestVersion == Version {
-		ui.Info("Already at latest version (%s)", Version)
+		ui.Info("Already at latest version in the %s channel (%s)", channel, Version)
 		return nil
 	}
 
-	ui.Info("Updating from v%s to v%s...", Version, latestVersion)
+	ui.Info("Updating from v%s to v%s (%s channel)...", Version, latestVersion, channel)
+
+	backup := upgrade.NewBackup(paths.BinDir())
+	if err := backup.Add(versions.ComponentManager); err != nil {
+		return err
+	}
 
 	if err := client.DownloadComponent(
 		"bluelink-manager",
@@ -49,7 +81,24 @@ func runSelfUpdate() error {
 		"bluelink-manager",
 		platform,
 	); err != nil {
-		return err
+		if restoreErr := backup.Restore(); restoreErr != nil {
+			return fmt.Errorf("self-update failed: %w (rollback failed: %v)", err, restoreErr)
+		}
+		return fmt.Errorf(
+			"self-update failed and was rolled back to v%s: %w",
+			Version,
+			err,
+		)
+	}
+
+	if err := backup.Discard(); err != nil {
+		ui.Warn("Failed to remove previous version: %v", err)
+	}
+
+	manifest.Channel = string(channel)
+	manifest.SetVersion(versions.ComponentManager, latestVersion)
+	if err := manifest.Save(); err != nil {
+		ui.Warn("Failed to record installed version: %v", err)
 	}
 
 	ui.Success("Updated to bluelink-manager v%s", latestVersion)
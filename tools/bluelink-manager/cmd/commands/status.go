@@ -1,12 +1,15 @@
 package commands
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/github"
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/paths"
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/service"
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/ui"
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/versions"
 	"github.com/spf13/cobra"
 )
 
@@ -14,6 +17,12 @@ func setupStatusCommand(rootCmd *cobra.Command) {
 	statusCmd := &cobra.Command{
 		Use:   "status",
 		Short: "Show Bluelink installation and service status",
+		Long: `Show the installed Bluelink components and their versions, the status
+of the Deploy Engine service and whether the installed versions of the CLI,
+Deploy Engine (plugin host) and Blueprint Language Server are compatible
+with each other.
+
+Exits with an error if the installed versions are known to be incompatible.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runStatus()
 		},
@@ -30,9 +39,22 @@ func runStatus() error {
 	ui.Println("Installation: %s", paths.InstallDir())
 	ui.Println()
 
+	manifest, err := versions.Load()
+	if err != nil {
+		return err
+	}
+
+	channel, err := github.ParseChannel(manifest.Channel)
+	if err != nil {
+		return err
+	}
+	ui.Println("Release channel: %s", channel)
+	ui.Println()
+
 	// Check installed components
 	ui.Bold("Components:")
 	binDir := paths.BinDir()
+	installed := map[string]string{}
 
 	components := []struct {
 		name   string
@@ -45,12 +67,23 @@ func runStatus() error {
 	}
 
 	for _, c := range components {
-		path := filepath.Join(binDir, c.binary)
-		if _, err := os.Stat(path); err == nil {
-			ui.Println("  %s: installed", c.name)
-		} else {
+		path := filepath.Join(binDir, paths.BinaryFileName(c.binary))
+		if _, err := os.Stat(path); err != nil {
 			ui.PrintRed("  %s: not installed", c.name)
+			continue
+		}
+
+		version := manifest.Version(c.name)
+		if c.name == versions.ComponentManager {
+			version = Version
+		}
+		if version == "" {
+			ui.Println("  %s: installed (unknown version)", c.name)
+			continue
 		}
+
+		installed[c.name] = version
+		ui.Println("  %s: installed (v%s)", c.name, version)
 	}
 
 	ui.Println()
@@ -65,5 +98,27 @@ func runStatus() error {
 		ui.PrintYellow("  Deploy Engine: stopped")
 	}
 
+	ui.Println()
+	ui.Bold("Compatibility:")
+
+	incompatibilities := versions.CheckCompatibility(installed)
+	for _, incompatibility := range incompatibilities {
+		ui.PrintRed("  %s", incompatibility)
+	}
+
+	if len(incompatibilities) > 0 {
+		ui.Println()
+		ui.Info("Run 'bluelink-manager update' to install compatible versions")
+		return fmt.Errorf("installed Bluelink components are not compatible")
+	}
+
+	if len(installed) < len(components) {
+		ui.PrintYellow("  Unable to verify components with unknown versions")
+		ui.Info("Run 'bluelink-manager update' to record installed versions")
+		return nil
+	}
+
+	ui.PrintGreen("  All installed components are compatible")
+
 	return nil
 }
@@ -1,10 +1,14 @@
 package commands
 
 import (
+	"fmt"
+
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/github"
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/paths"
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/service"
 	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/ui"
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/upgrade"
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/versions"
 	"github.com/spf13/cobra"
 )
 
@@ -12,6 +16,7 @@ type updateOptions struct {
 	cliVersion    string
 	engineVersion string
 	lsVersion     string
+	channel       string
 }
 
 func setupUpdateCommand(rootCmd *cobra.Command) {
@@ -24,7 +29,11 @@ func setupUpdateCommand(rootCmd *cobra.Command) {
 to their latest versions.
 
 The Deploy Engine service will be stopped during the update and
-restarted afterwards.`,
+restarted afterwards.
+
+If any component fails to download, fails signature or checksum
+verification, or the Deploy Engine fails to start with the new version,
+all components are rolled back to their previous versions.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runUpdate(opts)
 		},
@@ -33,6 +42,7 @@ restarted afterwards.`,
 	updateCmd.Flags().StringVar(&opts.cliVersion, "cli-version", "", "CLI version to install (default: latest)")
 	updateCmd.Flags().StringVar(&opts.engineVersion, "engine-version", "", "Deploy Engine version to install (default: latest)")
 	updateCmd.Flags().StringVar(&opts.lsVersion, "ls-version", "", "Blueprint LS version to install (default: latest)")
+	updateCmd.Flags().StringVar(&opts.channel, "channel", "", channelFlagUsage)
 
 	rootCmd.AddCommand(updateCmd)
 }
@@ -46,7 +56,19 @@ func runUpdate(opts *updateOptions) error {
 		return err
 	}
 
+	manifest, err := versions.Load()
+	if err != nil {
+		return err
+	}
+
+	channel, err := resolveChannel(opts.channel, manifest)
+	if err != nil {
+		return err
+	}
+	ui.Info("Release channel: %s", channel)
+
 	// Stop service before updating
+	wasRunning, _ := service.IsRunning()
 	ui.Info("Stopping Deploy Engine...")
 	_ = service.Stop() // Ignore error if not running
 
@@ -55,7 +77,7 @@ func runUpdate(opts *updateOptions) error {
 
 	cliVersion := opts.cliVersion
 	if cliVersion == "" {
-		cliVersion, err = client.GetLatestVersion("apps/cli")
+		cliVersion, err = client.GetLatestVersion("apps/cli", channel)
 		if err != nil {
 			return err
 		}
@@ -63,7 +85,7 @@ func runUpdate(opts *updateOptions) error {
 
 	engineVersion := opts.engineVersion
 	if engineVersion == "" {
-		engineVersion, err = client.GetLatestVersion("apps/deploy-engine")
+		engineVersion, err = client.GetLatestVersion("apps/deploy-engine", channel)
 		if err != nil {
 			return err
 		}
@@ -71,7 +93,7 @@ func runUpdate(opts *updateOptions) error {
 
 	lsVersion := opts.lsVersion
 	if lsVersion == "" {
-		lsVersion, err = client.GetLatestVersion("tools/blueprint-ls")
+		lsVersion, err = client.GetLatestVersion("tools/blueprint-ls", channel)
 		if err != nil {
 			return err
 		}
@@ -83,46 +105,85 @@ func runUpdate(opts *updateOptions) error {
 	ui.Info("  Blueprint LS:  v%s", lsVersion)
 	ui.Println()
 
-	// Download components
-	if err := client.DownloadComponent(
-		"Bluelink CLI",
-		"apps/cli",
-		cliVersion,
-		"bluelink",
-		"bluelink",
-		platform,
-	); err != nil {
-		return err
-	}
-	if err := client.DownloadComponent(
-		"Deploy Engine",
-		"apps/deploy-engine",
-		engineVersion,
-		"deploy-engine",
-		"deploy-engine",
-		platform,
-	); err != nil {
-		return err
+	warnIncompatibleVersions(map[string]string{
+		versions.ComponentCLI:          cliVersion,
+		versions.ComponentDeployEngine: engineVersion,
+		versions.ComponentBlueprintLS:  lsVersion,
+	})
+
+	// Download components, keeping the previous versions
+	// so they can be restored if the update fails.
+	components := []struct {
+		name      string
+		tagPrefix string
+		version   string
+		binary    string
+	}{
+		{"Bluelink CLI", "apps/cli", cliVersion, versions.ComponentCLI},
+		{"Deploy Engine", "apps/deploy-engine", engineVersion, versions.ComponentDeployEngine},
+		{"Blueprint LS", "tools/blueprint-ls", lsVersion, versions.ComponentBlueprintLS},
 	}
-	if err := client.DownloadComponent(
-		"Blueprint LS",
-		"tools/blueprint-ls",
-		lsVersion,
-		"blueprint-ls",
-		"blueprint-ls",
-		platform,
-	); err != nil {
-		return err
+
+	backup := upgrade.NewBackup(paths.BinDir())
+	for _, c := range components {
+		if err := backup.Add(c.binary); err != nil {
+			return rollbackUpdate(backup, wasRunning, err)
+		}
+		if err := client.DownloadComponent(
+			c.name,
+			c.tagPrefix,
+			c.version,
+			c.binary,
+			c.binary,
+			platform,
+		); err != nil {
+			return rollbackUpdate(backup, wasRunning, err)
+		}
 	}
 
 	// Start service again
 	ui.Info("Starting Deploy Engine...")
 	if err := service.Start(); err != nil {
+		if wasRunning {
+			return rollbackUpdate(backup, wasRunning, err)
+		}
 		return err
 	}
 
+	if err := backup.Discard(); err != nil {
+		ui.Warn("Failed to remove previous versions: %v", err)
+	}
+
+	manifest.Channel = string(channel)
+	for _, c := range components {
+		manifest.SetVersion(c.binary, c.version)
+	}
+	if err := manifest.Save(); err != nil {
+		ui.Warn("Failed to record installed versions: %v", err)
+	}
+
 	ui.Println()
 	ui.Success("Bluelink updated successfully!")
 
 	return nil
 }
+
+// rollbackUpdate restores the previous versions of components after a failed update,
+// restarting the Deploy Engine service if it was running before the update.
+func rollbackUpdate(backup *upgrade.Backup, restartService bool, cause error) error {
+	ui.Error("Update failed: %v", cause)
+	ui.Info("Rolling back to the previous versions...")
+
+	if err := backup.Restore(); err != nil {
+		return fmt.Errorf("update failed: %w (rollback failed: %v)", cause, err)
+	}
+
+	if restartService {
+		ui.Info("Starting Deploy Engine...")
+		if err := service.Start(); err != nil {
+			ui.Warn("Failed to start Deploy Engine after rollback: %v", err)
+		}
+	}
+
+	return fmt.Errorf("update failed and was rolled back to the previous versions: %w", cause)
+}
@@ -38,6 +38,24 @@ func (s *UpdateCommandSuite) Test_has_ls_version_flag() {
 	s.Equal("", flag.DefValue)
 }
 
+func (s *UpdateCommandSuite) Test_has_channel_flag() {
+	rootCmd := NewRootCmd()
+	updateCmd, _, _ := rootCmd.Find([]string{"update"})
+
+	flag := updateCmd.Flag("channel")
+	s.NotNil(flag)
+	s.Equal("", flag.DefValue)
+}
+
+func (s *UpdateCommandSuite) Test_self_update_has_channel_flag() {
+	rootCmd := NewRootCmd()
+	selfUpdateCmd, _, _ := rootCmd.Find([]string{"self-update"})
+
+	flag := selfUpdateCmd.Flag("channel")
+	s.NotNil(flag)
+	s.Equal("", flag.DefValue)
+}
+
 func (s *UpdateCommandSuite) Test_help_contains_usage_info() {
 	rootCmd := NewRootCmd()
 	buf := new(bytes.Buffer)
@@ -0,0 +1,75 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Channel is a release channel that determines which releases
+// are considered when resolving the latest version of a component.
+type Channel string
+
+const (
+	// ChannelStable only considers full releases.
+	ChannelStable Channel = "stable"
+	// ChannelBeta considers full releases along with beta
+	// and release candidate pre-releases.
+	ChannelBeta Channel = "beta"
+	// ChannelNightly considers all releases, including nightly builds.
+	ChannelNightly Channel = "nightly"
+)
+
+// Channels holds all the supported release channels.
+var Channels = []Channel{ChannelStable, ChannelBeta, ChannelNightly}
+
+// ParseChannel parses a release channel from a string,
+// an empty string resolves to the stable channel.
+func ParseChannel(value string) (Channel, error) {
+	if value == "" {
+		return ChannelStable, nil
+	}
+
+	for _, channel := range Channels {
+		if string(channel) == strings.ToLower(value) {
+			return channel, nil
+		}
+	}
+
+	return "", fmt.Errorf(
+		"unsupported release channel %q, expected one of: stable, beta, nightly",
+		value,
+	)
+}
+
+// includesRelease determines whether a release with the given version
+// is available in the channel.
+func (c Channel) includesRelease(version string, prerelease bool) bool {
+	_, preReleaseLabel, hasPreReleaseLabel := strings.Cut(version, "-")
+
+	switch c {
+	case ChannelNightly:
+		return true
+	case ChannelBeta:
+		if !hasPreReleaseLabel {
+			return true
+		}
+		return strings.HasPrefix(preReleaseLabel, "beta") ||
+			strings.HasPrefix(preReleaseLabel, "rc")
+	default:
+		return !prerelease && !hasPreReleaseLabel
+	}
+}
+
+// selectLatestVersion selects the latest version for a component from a list
+// of releases ordered from newest to oldest, as returned by the GitHub API.
+func selectLatestVersion(releases []Release, tagPrefix string, channel Channel) (string, bool) {
+	prefix := tagPrefix + "/v"
+	for _, release := range releases {
+		version, found := strings.CutPrefix(release.TagName, prefix)
+		if found && channel.includesRelease(version, release.Prerelease) {
+			return version, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,75 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ChannelSuite struct {
+	suite.Suite
+}
+
+var testReleases = []Release{
+	{TagName: "apps/cli/v0.7.0-nightly.20261015", Prerelease: true},
+	{TagName: "apps/deploy-engine/v0.9.0"},
+	{TagName: "apps/cli/v0.6.0-beta.1", Prerelease: true},
+	{TagName: "apps/cli/v0.6.0-rc.1", Prerelease: true},
+	{TagName: "apps/cli/v0.5.1"},
+	{TagName: "apps/cli/v0.5.0"},
+}
+
+func (s *ChannelSuite) Test_ParseChannel_defaults_to_stable() {
+	channel, err := ParseChannel("")
+
+	s.NoError(err)
+	s.Equal(ChannelStable, channel)
+}
+
+func (s *ChannelSuite) Test_ParseChannel_parses_supported_channels() {
+	for _, value := range []string{"stable", "beta", "nightly", "Beta"} {
+		_, err := ParseChannel(value)
+		s.NoError(err, value)
+	}
+}
+
+func (s *ChannelSuite) Test_ParseChannel_fails_for_unsupported_channel() {
+	_, err := ParseChannel("canary")
+
+	s.ErrorContains(err, "unsupported release channel \"canary\"")
+}
+
+func (s *ChannelSuite) Test_stable_channel_selects_latest_full_release() {
+	version, found := selectLatestVersion(testReleases, "apps/cli", ChannelStable)
+
+	s.True(found)
+	s.Equal("0.5.1", version)
+}
+
+func (s *ChannelSuite) Test_beta_channel_selects_latest_beta_or_release_candidate() {
+	version, found := selectLatestVersion(testReleases, "apps/cli", ChannelBeta)
+
+	s.True(found)
+	s.Equal("0.6.0-beta.1", version)
+}
+
+func (s *ChannelSuite) Test_nightly_channel_selects_latest_release() {
+	version, found := selectLatestVersion(testReleases, "apps/cli", ChannelNightly)
+
+	s.True(found)
+	s.Equal("0.7.0-nightly.20261015", version)
+}
+
+func (s *ChannelSuite) Test_returns_not_found_when_no_release_in_channel() {
+	releases := []Release{
+		{TagName: "tools/blueprint-ls/v0.5.0-beta.1", Prerelease: true},
+	}
+
+	_, found := selectLatestVersion(releases, "tools/blueprint-ls", ChannelStable)
+
+	s.False(found)
+}
+
+func TestChannelSuite(t *testing.T) {
+	suite.Run(t, new(ChannelSuite))
+}
@@ -38,11 +38,13 @@ func NewClient() *Client {
 
 // Release represents a GitHub release.
 type Release struct {
-	TagName string `json:"tag_name"`
+	TagName    string `json:"tag_name"`
+	Prerelease bool   `json:"prerelease"`
 }
 
-// GetLatestVersion fetches the latest version for a component.
-func (c *Client) GetLatestVersion(tagPrefix string) (string, error) {
+// GetLatestVersion fetches the latest version for a component
+// that is available in the provided release channel.
+func (c *Client) GetLatestVersion(tagPrefix string, channel Channel) (string, error) {
 	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/releases", repoOwner, repoName)
 
 	req, err := http.NewRequest("GET", url, nil)
@@ -66,19 +68,18 @@ func (c *Client) GetLatestVersion(tagPrefix string) (string, error) {
 		return "", fmt.Errorf("failed to parse releases: %w", err)
 	}
 
-	// Find the latest release matching our tag prefix
-	prefix := tagPrefix + "/v"
-	for _, release := range releases {
-		version, found := strings.CutPrefix(release.TagName, prefix)
-		if found {
-			return version, nil
-		}
+	version, found := selectLatestVersion(releases, tagPrefix, channel)
+	if !found {
+		return "", fmt.Errorf("no release found for %s in the %s channel", tagPrefix, channel)
 	}
 
-	return "", fmt.Errorf("no release found for %s", tagPrefix)
+	return version, nil
 }
 
 // DownloadComponent downloads and installs a component.
+// The downloaded archive is only installed if the release checksums
+// have a valid signature (when a release signing key is configured)
+// and the checksum of the archive matches.
 func (c *Client) DownloadComponent(name, tagPrefix, version, archiveName, binaryName string, platform paths.Platform) error {
 	ui.Info("Downloading %s v%s...", name, version)
 
@@ -104,6 +105,7 @@ func (c *Client) DownloadComponent(name, tagPrefix, version, archiveName, binary
 		repoName,
 		tag,
 	)
+	signatureURL := checksumsURL + ".sig"
 
 	// Download archive to temp file
 	tmpFile, err := os.CreateTemp("", "bluelink-*"+ext)
@@ -117,9 +119,9 @@ func (c *Client) DownloadComponent(name, tagPrefix, version, archiveName, binary
 		return fmt.Errorf("failed to download %s: %w", name, err)
 	}
 
-	// Verify checksum
-	if err := c.verifyChecksum(tmpFile.Name(), archive, checksumsURL); err != nil {
-		ui.Warn("Checksum verification: %v", err)
+	// Verify signature and checksum
+	if err := c.verifyDownload(tmpFile.Name(), archive, checksumsURL, signatureURL); err != nil {
+		return fmt.Errorf("failed to verify %s: %w", name, err)
 	}
 
 	// Extract binary
@@ -146,32 +148,56 @@ func (c *Client) downloadFile(url string, dest *os.File) error {
 	return err
 }
 
-func (c *Client) verifyChecksum(filePath, archiveName, checksumsURL string) error {
-	// Download checksums
-	resp, err := c.httpClient.Get(checksumsURL)
+func (c *Client) fetch(url string) ([]byte, error) {
+	resp, err := c.httpClient.Get(url)
 	if err != nil {
-		return fmt.Errorf("failed to download checksums: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("checksums not available")
+		return nil, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func (c *Client) verifyDownload(filePath, archiveName, checksumsURL, signatureURL string) error {
+	checksums, err := c.fetch(checksumsURL)
+	if err != nil {
+		return fmt.Errorf("failed to download checksums: %w", err)
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	signingKey, err := loadReleaseSigningKey()
 	if err != nil {
 		return err
 	}
 
+	if signingKey == nil {
+		ui.Warn("No release signing key configured, skipping signature verification")
+	} else {
+		signature, err := c.fetch(signatureURL)
+		if err != nil {
+			return fmt.Errorf("failed to download checksums signature: %w", err)
+		}
+
+		if err := verifySignature(signingKey, checksums, signature); err != nil {
+			return err
+		}
+		ui.Info("Signature verified for checksums")
+	}
+
+	return verifyChecksum(filePath, archiveName, checksums)
+}
+
+func verifyChecksum(filePath, archiveName string, checksums []byte) error {
 	// Find expected checksum
 	var expectedHash string
-	for line := range strings.SplitSeq(string(body), "\n") {
-		if strings.Contains(line, archiveName) {
-			parts := strings.Fields(line)
-			if len(parts) >= 1 {
-				expectedHash = parts[0]
-				break
-			}
+	for line := range strings.SplitSeq(string(checksums), "\n") {
+		parts := strings.Fields(line)
+		if len(parts) >= 2 && parts[1] == archiveName {
+			expectedHash = parts[0]
+			break
 		}
 	}
 
@@ -0,0 +1,73 @@
+package github
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+)
+
+// ReleaseSigningKey is the base64-encoded, ASCII-armored public key
+// used to verify the signatures of release checksums.
+// This is set at build time via ldflags.
+var ReleaseSigningKey = ""
+
+// releaseSigningKeyFileEnvVar is the name of the environment variable that can be used
+// to provide a path to an ASCII-armored public key file that takes precedence
+// over the key embedded at build time.
+const releaseSigningKeyFileEnvVar = "BLUELINK_RELEASE_SIGNING_KEY_FILE"
+
+// loadReleaseSigningKey loads the ASCII-armored public key used to verify
+// release signatures, returning nil if no key has been configured.
+func loadReleaseSigningKey() ([]byte, error) {
+	if keyFile := os.Getenv(releaseSigningKeyFileEnvVar); keyFile != "" {
+		key, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read release signing key: %w", err)
+		}
+		return key, nil
+	}
+
+	if ReleaseSigningKey == "" {
+		return nil, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(ReleaseSigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode release signing key: %w", err)
+	}
+	return key, nil
+}
+
+// verifySignature verifies a detached signature for the signed content
+// with the provided ASCII-armored public key.
+// Both binary and ASCII-armored signatures are supported.
+func verifySignature(armoredKey, signed, signature []byte) error {
+	keyRing, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(armoredKey))
+	if err != nil {
+		return fmt.Errorf("failed to read release signing key: %w", err)
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(signature), []byte("-----BEGIN PGP SIGNATURE-----")) {
+		_, err = openpgp.CheckArmoredDetachedSignature(
+			keyRing,
+			bytes.NewReader(signed),
+			bytes.NewReader(signature),
+			nil,
+		)
+	} else {
+		_, err = openpgp.CheckDetachedSignature(
+			keyRing,
+			bytes.NewReader(signed),
+			bytes.NewReader(signature),
+			nil,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("signature verification failed: %w", err)
+	}
+
+	return nil
+}
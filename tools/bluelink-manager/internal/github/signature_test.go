@@ -0,0 +1,144 @@
+package github
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignatureSuite struct {
+	suite.Suite
+	entity     *openpgp.Entity
+	armoredKey []byte
+}
+
+func (s *SignatureSuite) SetupSuite() {
+	entity, err := openpgp.NewEntity("Bluelink Test", "", "test@bluelink.dev", nil)
+	s.Require().NoError(err)
+	s.entity = entity
+
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(entity.Serialize(w))
+	s.Require().NoError(w.Close())
+	s.armoredKey = buf.Bytes()
+}
+
+func (s *SignatureSuite) TearDownTest() {
+	ReleaseSigningKey = ""
+	os.Unsetenv(releaseSigningKeyFileEnvVar)
+}
+
+func (s *SignatureSuite) Test_verifies_binary_detached_signature() {
+	checksums := []byte("abc123  bluelink_0.5.1_linux_amd64.tar.gz\n")
+	var signature bytes.Buffer
+	s.Require().NoError(openpgp.DetachSign(&signature, s.entity, bytes.NewReader(checksums), nil))
+
+	err := verifySignature(s.armoredKey, checksums, signature.Bytes())
+
+	s.NoError(err)
+}
+
+func (s *SignatureSuite) Test_verifies_armored_detached_signature() {
+	checksums := []byte("abc123  bluelink_0.5.1_linux_amd64.tar.gz\n")
+	var signature bytes.Buffer
+	s.Require().NoError(
+		openpgp.ArmoredDetachSign(&signature, s.entity, bytes.NewReader(checksums), nil),
+	)
+
+	err := verifySignature(s.armoredKey, checksums, signature.Bytes())
+
+	s.NoError(err)
+}
+
+func (s *SignatureSuite) Test_fails_for_tampered_checksums() {
+	checksums := []byte("abc123  bluelink_0.5.1_linux_amd64.tar.gz\n")
+	var signature bytes.Buffer
+	s.Require().NoError(openpgp.DetachSign(&signature, s.entity, bytes.NewReader(checksums), nil))
+
+	tampered := []byte("def456  bluelink_0.5.1_linux_amd64.tar.gz\n")
+	err := verifySignature(s.armoredKey, tampered, signature.Bytes())
+
+	s.ErrorContains(err, "signature verification failed")
+}
+
+func (s *SignatureSuite) Test_loads_no_key_when_not_configured() {
+	key, err := loadReleaseSigningKey()
+
+	s.NoError(err)
+	s.Nil(key)
+}
+
+func (s *SignatureSuite) Test_loads_embedded_key() {
+	ReleaseSigningKey = base64.StdEncoding.EncodeToString(s.armoredKey)
+
+	key, err := loadReleaseSigningKey()
+
+	s.NoError(err)
+	s.Equal(s.armoredKey, key)
+}
+
+func (s *SignatureSuite) Test_key_file_takes_precedence_over_embedded_key() {
+	ReleaseSigningKey = base64.StdEncoding.EncodeToString([]byte("embedded"))
+	keyFile := filepath.Join(s.T().TempDir(), "release-key.asc")
+	s.Require().NoError(os.WriteFile(keyFile, s.armoredKey, 0644))
+	os.Setenv(releaseSigningKeyFileEnvVar, keyFile)
+
+	key, err := loadReleaseSigningKey()
+
+	s.NoError(err)
+	s.Equal(s.armoredKey, key)
+}
+
+func (s *SignatureSuite) Test_verifies_archive_checksum() {
+	archivePath, checksum := s.createTestArchive()
+	checksums := fmt.Sprintf(
+		"%s  bluelink_0.5.1_linux_amd64.tar.gz.sbom.json\n%s  bluelink_0.5.1_linux_amd64.tar.gz\n",
+		"0000",
+		checksum,
+	)
+
+	err := verifyChecksum(archivePath, "bluelink_0.5.1_linux_amd64.tar.gz", []byte(checksums))
+
+	s.NoError(err)
+}
+
+func (s *SignatureSuite) Test_fails_for_checksum_mismatch() {
+	archivePath, _ := s.createTestArchive()
+	checksums := "0000  bluelink_0.5.1_linux_amd64.tar.gz\n"
+
+	err := verifyChecksum(archivePath, "bluelink_0.5.1_linux_amd64.tar.gz", []byte(checksums))
+
+	s.ErrorContains(err, "checksum mismatch")
+}
+
+func (s *SignatureSuite) Test_fails_for_missing_checksum() {
+	archivePath, _ := s.createTestArchive()
+
+	err := verifyChecksum(archivePath, "bluelink_0.5.1_linux_amd64.tar.gz", []byte{})
+
+	s.ErrorContains(err, "checksum not found")
+}
+
+func (s *SignatureSuite) createTestArchive() (string, string) {
+	content := []byte("test archive content")
+	archivePath := filepath.Join(s.T().TempDir(), "archive.tar.gz")
+	s.Require().NoError(os.WriteFile(archivePath, content, 0644))
+
+	hash := sha256.Sum256(content)
+	return archivePath, hex.EncodeToString(hash[:])
+}
+
+func TestSignatureSuite(t *testing.T) {
+	suite.Run(t, new(SignatureSuite))
+}
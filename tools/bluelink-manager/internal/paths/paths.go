@@ -78,6 +78,21 @@ func BinDir() string {
 	return filepath.Join(InstallDir(), "bin")
 }
 
+// BinaryFileName returns the file name of a Bluelink binary
+// for the current platform.
+func BinaryFileName(binaryName string) string {
+	if IsWindows() {
+		return binaryName + ".exe"
+	}
+	return binaryName
+}
+
+// VersionsFile returns the path to the file that records the versions
+// of installed components and the selected release channel.
+func VersionsFile() string {
+	return filepath.Join(InstallDir(), "versions.json")
+}
+
 // ConfigDir returns the directory for Bluelink configuration.
 func ConfigDir() string {
 	return filepath.Join(InstallDir(), "config")
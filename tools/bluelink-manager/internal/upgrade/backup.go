@@ -0,0 +1,99 @@
+package upgrade
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/paths"
+)
+
+const previousSuffix = ".previous"
+
+// Backup keeps track of binaries that have been moved aside during an upgrade
+// so that the previous versions can be restored if the upgrade fails.
+type Backup struct {
+	binDir  string
+	entries []backupEntry
+}
+
+type backupEntry struct {
+	path        string
+	backupPath  string
+	hadPrevious bool
+}
+
+// NewBackup creates a new backup for binaries in the provided directory.
+func NewBackup(binDir string) *Backup {
+	return &Backup{
+		binDir: binDir,
+	}
+}
+
+// Add moves the current version of a binary aside before a new version
+// is installed in its place.
+// Moving the binary instead of overwriting it also allows a running binary
+// (e.g. bluelink-manager itself) to be replaced.
+func (b *Backup) Add(binaryName string) error {
+	path := filepath.Join(b.binDir, paths.BinaryFileName(binaryName))
+	backupPath := path + previousSuffix
+
+	// Clean up a backup left behind by a previous upgrade.
+	if err := os.Remove(backupPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove stale backup of %s: %w", binaryName, err)
+	}
+
+	err := os.Rename(path, backupPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to back up %s: %w", binaryName, err)
+	}
+
+	b.entries = append(b.entries, backupEntry{
+		path:        path,
+		backupPath:  backupPath,
+		hadPrevious: err == nil,
+	})
+	return nil
+}
+
+// Restore removes newly installed binaries and moves the previous versions
+// back into place, in the reverse order they were backed up.
+func (b *Backup) Restore() error {
+	var errs []error
+	for i := len(b.entries) - 1; i >= 0; i-- {
+		entry := b.entries[i]
+		if err := os.Remove(entry.path); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+			continue
+		}
+
+		if entry.hadPrevious {
+			if err := os.Rename(entry.backupPath, entry.path); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	b.entries = nil
+
+	return errors.Join(errs...)
+}
+
+// Discard removes the previous versions of binaries once an upgrade
+// has succeeded.
+// On Windows, the previous version of a running binary can not be removed,
+// in which case it will be cleaned up by the next upgrade.
+func (b *Backup) Discard() error {
+	var errs []error
+	for _, entry := range b.entries {
+		if !entry.hadPrevious {
+			continue
+		}
+		if err := os.Remove(entry.backupPath); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+	b.entries = nil
+
+	return errors.Join(errs...)
+}
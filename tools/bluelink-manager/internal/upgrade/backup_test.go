@@ -0,0 +1,89 @@
+package upgrade
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/paths"
+	"github.com/stretchr/testify/suite"
+)
+
+type BackupSuite struct {
+	suite.Suite
+	binDir string
+}
+
+func (s *BackupSuite) SetupTest() {
+	s.binDir = s.T().TempDir()
+}
+
+func (s *BackupSuite) Test_Restore_reinstates_previous_binaries() {
+	s.writeBinary("bluelink", "v0.5.0")
+	s.writeBinary("deploy-engine", "v0.8.0")
+
+	backup := NewBackup(s.binDir)
+	s.Require().NoError(backup.Add("bluelink"))
+	s.writeBinary("bluelink", "v0.5.1")
+	s.Require().NoError(backup.Add("deploy-engine"))
+
+	s.Require().NoError(backup.Restore())
+
+	s.Equal("v0.5.0", s.readBinary("bluelink"))
+	s.Equal("v0.8.0", s.readBinary("deploy-engine"))
+	s.NoFileExists(s.binaryPath("bluelink") + previousSuffix)
+}
+
+func (s *BackupSuite) Test_Restore_removes_binaries_that_were_not_previously_installed() {
+	backup := NewBackup(s.binDir)
+	s.Require().NoError(backup.Add("blueprint-ls"))
+	s.writeBinary("blueprint-ls", "v0.4.0")
+
+	s.Require().NoError(backup.Restore())
+
+	s.NoFileExists(s.binaryPath("blueprint-ls"))
+}
+
+func (s *BackupSuite) Test_Discard_removes_previous_binaries() {
+	s.writeBinary("bluelink-manager", "v0.1.0")
+
+	backup := NewBackup(s.binDir)
+	s.Require().NoError(backup.Add("bluelink-manager"))
+	s.writeBinary("bluelink-manager", "v0.2.0")
+
+	s.Require().NoError(backup.Discard())
+
+	s.Equal("v0.2.0", s.readBinary("bluelink-manager"))
+	s.NoFileExists(s.binaryPath("bluelink-manager") + previousSuffix)
+}
+
+func (s *BackupSuite) Test_Add_replaces_stale_backup() {
+	s.writeBinary("bluelink", "v0.5.0")
+	s.Require().NoError(
+		os.WriteFile(s.binaryPath("bluelink")+previousSuffix, []byte("stale"), 0755),
+	)
+
+	backup := NewBackup(s.binDir)
+	s.Require().NoError(backup.Add("bluelink"))
+	s.Require().NoError(backup.Restore())
+
+	s.Equal("v0.5.0", s.readBinary("bluelink"))
+}
+
+func (s *BackupSuite) binaryPath(name string) string {
+	return filepath.Join(s.binDir, paths.BinaryFileName(name))
+}
+
+func (s *BackupSuite) writeBinary(name, content string) {
+	s.Require().NoError(os.WriteFile(s.binaryPath(name), []byte(content), 0755))
+}
+
+func (s *BackupSuite) readBinary(name string) string {
+	content, err := os.ReadFile(s.binaryPath(name))
+	s.Require().NoError(err)
+	return string(content)
+}
+
+func TestBackupSuite(t *testing.T) {
+	suite.Run(t, new(BackupSuite))
+}
@@ -0,0 +1,154 @@
+package versions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// requirement describes a minimum version of a component that is required
+// when another component is at or above a given version.
+type requirement struct {
+	component          string
+	minVersion         string
+	requires           string
+	requiresMinVersion string
+	reason             string
+}
+
+// requirements holds the known compatibility constraints between
+// the CLI, Deploy Engine and Blueprint Language Server.
+var requirements = []requirement{
+	{
+		component:          ComponentCLI,
+		minVersion:         "0.5.0",
+		requires:           ComponentDeployEngine,
+		requiresMinVersion: "0.8.0",
+		reason:             "blueprint language support",
+	},
+	{
+		component:          ComponentCLI,
+		minVersion:         "0.5.0",
+		requires:           ComponentBlueprintLS,
+		requiresMinVersion: "0.4.0",
+		reason:             "blueprint language support",
+	},
+}
+
+// Incompatibility describes an installed component that does not meet
+// the minimum version required by another installed component.
+type Incompatibility struct {
+	Component          string
+	Version            string
+	Requires           string
+	RequiresMinVersion string
+	InstalledVersion   string
+	Reason             string
+}
+
+func (i *Incompatibility) String() string {
+	return fmt.Sprintf(
+		"%s v%s requires %s >= v%s for %s (installed: v%s)",
+		i.Component,
+		i.Version,
+		i.Requires,
+		i.RequiresMinVersion,
+		i.Reason,
+		i.InstalledVersion,
+	)
+}
+
+// CheckCompatibility checks the provided installed component versions
+// against the known compatibility constraints.
+// Components with unknown (empty) or unparseable versions are skipped.
+func CheckCompatibility(installed map[string]string) []*Incompatibility {
+	incompatibilities := []*Incompatibility{}
+	for _, req := range requirements {
+		version := installed[req.component]
+		requiredVersion := installed[req.requires]
+		if version == "" || requiredVersion == "" {
+			continue
+		}
+
+		applies, err := Compare(version, req.minVersion)
+		if err != nil || applies < 0 {
+			continue
+		}
+
+		satisfied, err := Compare(requiredVersion, req.requiresMinVersion)
+		if err != nil || satisfied >= 0 {
+			continue
+		}
+
+		incompatibilities = append(incompatibilities, &Incompatibility{
+			Component:          req.component,
+			Version:            version,
+			Requires:           req.requires,
+			RequiresMinVersion: req.requiresMinVersion,
+			InstalledVersion:   requiredVersion,
+			Reason:             req.reason,
+		})
+	}
+
+	return incompatibilities
+}
+
+// Compare compares two semantic versions (with an optional "v" prefix),
+// returning -1 if a < b, 0 if a == b and 1 if a > b.
+// Pre-release versions are ordered before the release they precede,
+// pre-release labels are compared lexically.
+func Compare(a, b string) (int, error) {
+	aCore, aPreRelease, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	bCore, bPreRelease, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range aCore {
+		if aCore[i] != bCore[i] {
+			if aCore[i] < bCore[i] {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+
+	switch {
+	case aPreRelease == bPreRelease:
+		return 0, nil
+	case aPreRelease == "":
+		return 1, nil
+	case bPreRelease == "":
+		return -1, nil
+	case aPreRelease < bPreRelease:
+		return -1, nil
+	default:
+		return 1, nil
+	}
+}
+
+func parseVersion(version string) ([3]int, string, error) {
+	parsed := [3]int{}
+	trimmed := strings.TrimPrefix(version, "v")
+	// Build metadata does not affect precedence.
+	trimmed, _, _ = strings.Cut(trimmed, "+")
+	core, preRelease, _ := strings.Cut(trimmed, "-")
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return parsed, "", fmt.Errorf("invalid version %q", version)
+	}
+
+	for i, part := range parts {
+		value, err := strconv.Atoi(part)
+		if err != nil || value < 0 {
+			return parsed, "", fmt.Errorf("invalid version %q", version)
+		}
+		parsed[i] = value
+	}
+
+	return parsed, preRelease, nil
+}
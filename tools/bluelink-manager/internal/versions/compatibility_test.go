@@ -0,0 +1,91 @@
+package versions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CompatibilitySuite struct {
+	suite.Suite
+}
+
+func (s *CompatibilitySuite) Test_Compare_orders_versions() {
+	cases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"0.5.0", "0.5.0", 0},
+		{"v0.5.1", "0.5.0", 1},
+		{"0.4.9", "0.5.0", -1},
+		{"1.0.0", "0.10.0", 1},
+		{"0.10.0", "0.9.0", 1},
+		{"0.5.0-beta.1", "0.5.0", -1},
+		{"0.5.0", "0.5.0-rc.1", 1},
+		{"0.5.0-beta.1", "0.5.0-rc.1", -1},
+		{"0.5.0+build.1", "0.5.0", 0},
+	}
+
+	for _, c := range cases {
+		result, err := Compare(c.a, c.b)
+		s.NoError(err)
+		s.Equal(c.expected, result, "%s vs %s", c.a, c.b)
+	}
+}
+
+func (s *CompatibilitySuite) Test_Compare_fails_for_invalid_version() {
+	_, err := Compare("0.5", "0.5.0")
+
+	s.ErrorContains(err, "invalid version \"0.5\"")
+}
+
+func (s *CompatibilitySuite) Test_compatible_versions_have_no_incompatibilities() {
+	incompatibilities := CheckCompatibility(map[string]string{
+		ComponentCLI:          "0.5.1",
+		ComponentDeployEngine: "0.8.1",
+		ComponentBlueprintLS:  "0.4.0",
+	})
+
+	s.Empty(incompatibilities)
+}
+
+func (s *CompatibilitySuite) Test_older_cli_is_compatible_with_older_components() {
+	incompatibilities := CheckCompatibility(map[string]string{
+		ComponentCLI:          "0.4.0",
+		ComponentDeployEngine: "0.7.0",
+		ComponentBlueprintLS:  "0.3.0",
+	})
+
+	s.Empty(incompatibilities)
+}
+
+func (s *CompatibilitySuite) Test_reports_components_below_required_version() {
+	incompatibilities := CheckCompatibility(map[string]string{
+		ComponentCLI:          "0.5.1",
+		ComponentDeployEngine: "0.7.0",
+		ComponentBlueprintLS:  "0.3.0",
+	})
+
+	s.Require().Len(incompatibilities, 2)
+	s.Equal(ComponentDeployEngine, incompatibilities[0].Requires)
+	s.Equal(
+		"bluelink v0.5.1 requires deploy-engine >= v0.8.0 for blueprint language support (installed: v0.7.0)",
+		incompatibilities[0].String(),
+	)
+	s.Equal(ComponentBlueprintLS, incompatibilities[1].Requires)
+	s.Equal("0.3.0", incompatibilities[1].InstalledVersion)
+}
+
+func (s *CompatibilitySuite) Test_skips_components_with_unknown_versions() {
+	incompatibilities := CheckCompatibility(map[string]string{
+		ComponentCLI:         "0.5.1",
+		ComponentBlueprintLS: "0.4.0",
+	})
+
+	s.Empty(incompatibilities)
+}
+
+func TestCompatibilitySuite(t *testing.T) {
+	suite.Run(t, new(CompatibilitySuite))
+}
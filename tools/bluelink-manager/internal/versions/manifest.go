@@ -0,0 +1,105 @@
+package versions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/paths"
+)
+
+const (
+	// ComponentCLI is the name of the Bluelink CLI component.
+	ComponentCLI = "bluelink"
+	// ComponentDeployEngine is the name of the Deploy Engine component
+	// that also hosts provider and transformer plugins.
+	ComponentDeployEngine = "deploy-engine"
+	// ComponentBlueprintLS is the name of the Blueprint Language Server component.
+	ComponentBlueprintLS = "blueprint-ls"
+	// ComponentManager is the name of the bluelink-manager component.
+	ComponentManager = "bluelink-manager"
+)
+
+// Manifest records the versions of installed components
+// along with the release channel used for updates.
+type Manifest struct {
+	Channel    string                       `json:"channel,omitempty"`
+	Components map[string]*ComponentVersion `json:"components"`
+}
+
+// ComponentVersion holds the installed version of a component
+// and the version that was installed before the last upgrade.
+type ComponentVersion struct {
+	Version         string `json:"version"`
+	PreviousVersion string `json:"previousVersion,omitempty"`
+}
+
+// Load reads the versions manifest from the installation directory.
+// An empty manifest is returned if components have not yet been installed
+// by a version of the manager that records versions.
+func Load() (*Manifest, error) {
+	manifest := &Manifest{
+		Components: map[string]*ComponentVersion{},
+	}
+
+	data, err := os.ReadFile(paths.VersionsFile())
+	if os.IsNotExist(err) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read versions file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse versions file: %w", err)
+	}
+	if manifest.Components == nil {
+		manifest.Components = map[string]*ComponentVersion{}
+	}
+
+	return manifest, nil
+}
+
+// Save writes the versions manifest to the installation directory.
+func (m *Manifest) Save() error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	versionsFile := paths.VersionsFile()
+	if err := os.MkdirAll(filepath.Dir(versionsFile), 0755); err != nil {
+		return fmt.Errorf("failed to create installation directory: %w", err)
+	}
+
+	if err := os.WriteFile(versionsFile, data, 0644); err != nil {
+		return fmt.Errorf("failed to write versions file: %w", err)
+	}
+
+	return nil
+}
+
+// Version returns the installed version of a component,
+// an empty string is returned if the version is not known.
+func (m *Manifest) Version(component string) string {
+	if installed, ok := m.Components[component]; ok {
+		return installed.Version
+	}
+	return ""
+}
+
+// SetVersion records the installed version of a component,
+// keeping track of the previously installed version.
+func (m *Manifest) SetVersion(component, version string) {
+	installed, ok := m.Components[component]
+	if !ok {
+		m.Components[component] = &ComponentVersion{Version: version}
+		return
+	}
+
+	if installed.Version != version {
+		installed.PreviousVersion = installed.Version
+		installed.Version = version
+	}
+}
@@ -0,0 +1,77 @@
+package versions
+
+import (
+	"os"
+	"testing"
+
+	"github.com/newstack-cloud/bluelink/tools/bluelink-manager/internal/paths"
+	"github.com/stretchr/testify/suite"
+)
+
+type ManifestSuite struct {
+	suite.Suite
+	originalInstallDir string
+}
+
+func (s *ManifestSuite) SetupTest() {
+	s.originalInstallDir = os.Getenv("BLUELINK_INSTALL_DIR")
+	os.Setenv("BLUELINK_INSTALL_DIR", s.T().TempDir())
+}
+
+func (s *ManifestSuite) TearDownTest() {
+	if s.originalInstallDir != "" {
+		os.Setenv("BLUELINK_INSTALL_DIR", s.originalInstallDir)
+	} else {
+		os.Unsetenv("BLUELINK_INSTALL_DIR")
+	}
+}
+
+func (s *ManifestSuite) Test_Load_returns_empty_manifest_when_file_does_not_exist() {
+	manifest, err := Load()
+
+	s.NoError(err)
+	s.Equal("", manifest.Channel)
+	s.Empty(manifest.Components)
+	s.Equal("", manifest.Version(ComponentCLI))
+}
+
+func (s *ManifestSuite) Test_Save_and_Load_round_trip() {
+	manifest, err := Load()
+	s.Require().NoError(err)
+
+	manifest.Channel = "beta"
+	manifest.SetVersion(ComponentCLI, "0.5.1")
+	manifest.SetVersion(ComponentDeployEngine, "0.8.1")
+	s.Require().NoError(manifest.Save())
+
+	loaded, err := Load()
+
+	s.NoError(err)
+	s.Equal("beta", loaded.Channel)
+	s.Equal("0.5.1", loaded.Version(ComponentCLI))
+	s.Equal("0.8.1", loaded.Version(ComponentDeployEngine))
+}
+
+func (s *ManifestSuite) Test_Load_fails_for_invalid_file() {
+	s.Require().NoError(os.WriteFile(paths.VersionsFile(), []byte("{"), 0644))
+
+	_, err := Load()
+
+	s.ErrorContains(err, "failed to parse versions file")
+}
+
+func (s *ManifestSuite) Test_SetVersion_records_previous_version() {
+	manifest, err := Load()
+	s.Require().NoError(err)
+
+	manifest.SetVersion(ComponentBlueprintLS, "0.3.0")
+	manifest.SetVersion(ComponentBlueprintLS, "0.4.0")
+	manifest.SetVersion(ComponentBlueprintLS, "0.4.0")
+
+	s.Equal("0.4.0", manifest.Components[ComponentBlueprintLS].Version)
+	s.Equal("0.3.0", manifest.Components[ComponentBlueprintLS].PreviousVersion)
+}
+
+func TestManifestSuite(t *testing.T) {
+	suite.Run(t, new(ManifestSuite))
+}
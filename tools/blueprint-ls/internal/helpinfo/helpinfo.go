@@ -7,6 +7,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/validation"
 )
 
 // RenderVariableInfo renders variable information for use in help info.
@@ -51,6 +52,23 @@ func RenderValueInfo(valueName string, value *schema.Value) string {
 	)
 }
 
+// RenderInferredValueTypeInfo renders the type inferred for the content of a value
+// along with the references followed to resolve the type for use in help info.
+// An empty string is returned when the value content does not reference
+// other elements, as the inferred type adds nothing to the declared type.
+func RenderInferredValueTypeInfo(inferred *validation.InferredValueType) string {
+	if inferred == nil || len(inferred.Chain) < 2 {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		"**inferred type:** `%s`\n\n"+
+			"**resolved through:** `%s`\n\n",
+		inferred.Type,
+		strings.Join(inferred.Chain, " -> "),
+	)
+}
+
 // RenderChildInfo renders child blueprint information for use in help info.
 func RenderChildInfo(childName string, child *schema.Include) string {
 	path := ""
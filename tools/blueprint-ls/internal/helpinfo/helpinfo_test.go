@@ -7,6 +7,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/provider"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/validation"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -79,6 +80,25 @@ func (s *HelpInfoSuite) TestRenderValueInfo_NilType() {
 	s.Contains(result, "**type:** `unknown`")
 }
 
+func (s *HelpInfoSuite) TestRenderInferredValueTypeInfo_WithReferenceChain() {
+	inferred := &validation.InferredValueType{
+		Type:  "string",
+		Chain: []string{"values.vpcId", "values.networkId", "children.network.vpcId"},
+	}
+	result := RenderInferredValueTypeInfo(inferred)
+	s.Contains(result, "**inferred type:** `string`")
+	s.Contains(result, "`values.vpcId -> values.networkId -> children.network.vpcId`")
+}
+
+func (s *HelpInfoSuite) TestRenderInferredValueTypeInfo_WithoutReferences() {
+	inferred := &validation.InferredValueType{
+		Type:  "integer",
+		Chain: []string{"values.port"},
+	}
+	s.Empty(RenderInferredValueTypeInfo(inferred))
+	s.Empty(RenderInferredValueTypeInfo(nil))
+}
+
 func (s *HelpInfoSuite) TestRenderChildInfo_WithPathAndDescription() {
 	child := &schema.Include{
 		Path: &substitutions.StringOrSubstitutions{
@@ -40,6 +40,7 @@ var bpResourceFields = []bpFieldInfo{
 	{label: "select by label", description: "Selects resources to link by label.", insert: "select by label {\n\t$0\n}"},
 	{label: "dependsOn", description: "Explicit dependencies on other resources."},
 	{label: "removalPolicy", description: "What happens to the resource on removal (\"delete\" or \"retain\")."},
+	{label: "replaceStrategy", description: "Order of replacement (\"createBeforeDestroy\" or \"destroyBeforeCreate\")."},
 }
 
 var bpVariableFields = []bpFieldInfo{
@@ -46,7 +46,10 @@ func (s *DiagnosticErrorService) BlueprintErrorToDiagnostics(
 	loadErr, isLoadErr := err.(*errors.LoadError)
 	if isLoadErr {
 		s.collectLoadErrors(loadErr, &diagnostics, &enhanced, nil, docURI)
-		return diagnostics, enhanced
+		return collapseTemplatedResourceDiagnostics(
+			diagnostics,
+			errors.GroupErrors(loadErr),
+		), enhanced
 	}
 
 	schemaErr, isSchemaErr := err.(*schema.Error)
@@ -76,6 +79,60 @@ func (s *DiagnosticErrorService) BlueprintErrorToDiagnostics(
 	return getGeneralErrorDiagnostics(err), enhanced
 }
 
+// Collapses the diagnostics for identical errors across the resources expanded
+// from a resource template into a single diagnostic that lists the expanded resources,
+// this avoids a long list of near-identical diagnostics for large resource templates.
+// The error groups are used to determine which messages only differ
+// by the name of the expanded resource.
+func collapseTemplatedResourceDiagnostics(
+	diagnostics []lsp.Diagnostic,
+	groups []*errors.ErrorGroup,
+) []lsp.Diagnostic {
+	expandedResources := map[string][]string{}
+	duplicateMessages := map[string]bool{}
+	for _, group := range groups {
+		for _, groupedErr := range group.Errors {
+			if len(groupedErr.Elements) < 2 {
+				continue
+			}
+
+			expandedResources[groupedErr.Message] = groupedErr.Elements
+			firstElement := groupedErr.Elements[0]
+			for _, element := range groupedErr.Elements[1:] {
+				duplicateMessage := strings.ReplaceAll(
+					groupedErr.Message,
+					fmt.Sprintf("%q", firstElement),
+					fmt.Sprintf("%q", element),
+				)
+				duplicateMessages[duplicateMessage] = true
+			}
+		}
+	}
+
+	if len(expandedResources) == 0 {
+		return diagnostics
+	}
+
+	collapsed := make([]lsp.Diagnostic, 0, len(diagnostics))
+	for _, diag := range diagnostics {
+		if duplicateMessages[diag.Message] {
+			continue
+		}
+
+		if elements, isTemplated := expandedResources[diag.Message]; isTemplated {
+			diag.Message = fmt.Sprintf(
+				"%s\n\nThis error occurred for %d resources expanded from the same template: %s",
+				diag.Message,
+				len(elements),
+				strings.Join(elements, ", "),
+			)
+		}
+		collapsed = append(collapsed, diag)
+	}
+
+	return collapsed
+}
+
 // Handles the blueprint-language parse/lex error types, mapping
 // each child's source.Meta to a positioned diagnostic. It returns true when err
 // was one of the lang error types (and therefore fully handled).
@@ -32,6 +32,47 @@ func TestDiagnosticErrorServiceSuite(t *testing.T) {
 	suite.Run(t, new(DiagnosticErrorServiceSuite))
 }
 
+func (s *DiagnosticErrorServiceSuite) Test_collapses_identical_errors_for_templated_resources() {
+	line := 12
+	col := 3
+
+	loadErr := &errors.LoadError{
+		ReasonCode: "multiple_validation_errors",
+		Err:        fmt.Errorf("validation failed due to multiple errors"),
+		ChildErrors: []error{
+			&errors.LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        fmt.Errorf("resource \"orderQueue_0\" is missing a spec"),
+				Line:       &line,
+				Column:     &col,
+			},
+			&errors.LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        fmt.Errorf("resource \"orderQueue_1\" is missing a spec"),
+				Line:       &line,
+				Column:     &col,
+			},
+			&errors.LoadError{
+				ReasonCode: "invalid_resource",
+				Err:        fmt.Errorf("resource \"ordersTable\" is missing a spec"),
+				Line:       &line,
+				Column:     &col,
+			},
+		},
+	}
+
+	diagnostics, _ := s.service.BlueprintErrorToDiagnostics(loadErr, "file:///test.yaml")
+
+	s.Require().Len(diagnostics, 2)
+	s.Assert().Equal(
+		"resource \"orderQueue_0\" is missing a spec\n\n"+
+			"This error occurred for 2 resources expanded from the same template: "+
+			"orderQueue_0, orderQueue_1",
+		diagnostics[0].Message,
+	)
+	s.Assert().Equal("resource \"ordersTable\" is missing a spec", diagnostics[1].Message)
+}
+
 func (s *DiagnosticErrorServiceSuite) Test_load_error_with_exact_end_position() {
 	// When a LoadError has exact column accuracy and end positions,
 	// the diagnostic range should use the precise start and end positions.
@@ -8,6 +8,7 @@ import (
 	"github.com/newstack-cloud/bluelink/libs/blueprint/schema"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/source"
 	"github.com/newstack-cloud/bluelink/libs/blueprint/substitutions"
+	"github.com/newstack-cloud/bluelink/libs/blueprint/validation"
 	"github.com/newstack-cloud/bluelink/tools/blueprint-ls/internal/docmodel"
 	"github.com/newstack-cloud/bluelink/tools/blueprint-ls/internal/helpinfo"
 	"github.com/newstack-cloud/bluelink/tools/blueprint-ls/internal/linkinfo"
@@ -126,7 +127,7 @@ func (s *HoverService) getHoverContentByKind(
 	case docmodel.SchemaElementVariableRef:
 		return getVarRefHoverContent(hoverCtx.TreeNode, blueprint)
 	case docmodel.SchemaElementValueRef:
-		return getValRefHoverContent(hoverCtx.TreeNode, blueprint)
+		return s.getValRefHoverContent(hoverCtx.TreeNode, blueprint, docURI)
 	case docmodel.SchemaElementChildRef:
 		return getChildRefHoverContent(hoverCtx.TreeNode, blueprint)
 	case docmodel.SchemaElementResourceRef:
@@ -149,7 +150,7 @@ func (s *HoverService) getHoverContentByKind(
 	case docmodel.SchemaElementVariable:
 		return getVariableNameHoverContent(hoverCtx.TreeNode, blueprint)
 	case docmodel.SchemaElementValue:
-		return getValueNameHoverContent(hoverCtx.TreeNode, blueprint)
+		return s.getValueNameHoverContent(hoverCtx.TreeNode, blueprint, docURI)
 	case docmodel.SchemaElementDataSource:
 		return getDataSourceNameHoverContent(hoverCtx.TreeNode, blueprint)
 	case docmodel.SchemaElementInclude:
@@ -324,11 +325,38 @@ func (s *HoverService) getResourceTypeHoverContent(
 	}
 
 	return &HoverContent{
-		Value: description,
+		Value: description + s.getResourceTypeExamplesContent(ctx, resType.Value),
 		Range: rangeToLSPRange(node.Range),
 	}, nil
 }
 
+func (s *HoverService) getResourceTypeExamplesContent(
+	ctx *common.LSPContext,
+	resourceType string,
+) string {
+	examplesOutput, err := s.resourceRegistry.GetExamples(
+		ctx.Context,
+		resourceType,
+		&provider.ResourceGetExamplesInput{},
+	)
+	if err != nil {
+		// Examples are supplementary to the type description,
+		// so failing to fetch them should not prevent hover content
+		// from being displayed.
+		s.logger.Debug(
+			"Failed to fetch examples for resource type hover content",
+			zap.Error(err),
+		)
+		return ""
+	}
+
+	if len(examplesOutput.MarkdownExamples) == 0 {
+		return ""
+	}
+
+	return "\n\n**Examples**\n\n" + strings.Join(examplesOutput.MarkdownExamples, "\n\n")
+}
+
 func (s *HoverService) getDataSourceTypeHoverContent(
 	ctx *common.LSPContext,
 	node *schema.TreeNode,
@@ -559,6 +587,51 @@ func (s *HoverService) resolveChildExportInfo(
 	return exportInfo
 }
 
+// Infers the type of the content of a value, resolving the types of child blueprint
+// exports from child blueprints that can be loaded from the local file system.
+func (s *HoverService) inferValueType(
+	blueprint *schema.Blueprint,
+	valueName string,
+	docURI string,
+) *validation.InferredValueType {
+	var childExportLookup validation.ChildExportTypeLookup
+	if s.childResolver != nil {
+		childExportLookup = func(
+			childName string,
+			exportName string,
+			_ *source.Meta,
+		) (*schema.Export, error) {
+			return s.resolveChildExport(blueprint, childName, exportName, docURI), nil
+		}
+	}
+
+	return validation.InferValueType(valueName, blueprint, childExportLookup)
+}
+
+func (s *HoverService) resolveChildExport(
+	blueprint *schema.Blueprint,
+	childName string,
+	exportName string,
+	docURI string,
+) *schema.Export {
+	if blueprint.Include == nil {
+		return nil
+	}
+
+	include, ok := blueprint.Include.Values[childName]
+	if !ok || include == nil {
+		return nil
+	}
+
+	childInfo := s.childResolver.ResolveChildExports(docURI, include)
+	if childInfo == nil || childInfo.Blueprint == nil ||
+		childInfo.Blueprint.Exports == nil {
+		return nil
+	}
+
+	return childInfo.Blueprint.Exports.Values[exportName]
+}
+
 func (s *HoverService) getElemPathItemHoverContent(
 	ctx *common.LSPContext,
 	hoverCtx *docmodel.HoverContext,
@@ -656,9 +729,10 @@ func getVarRefHoverContent(
 	}, nil
 }
 
-func getValRefHoverContent(
+func (s *HoverService) getValRefHoverContent(
 	node *schema.TreeNode,
 	blueprint *schema.Blueprint,
+	docURI string,
 ) (*HoverContent, error) {
 
 	valRef, isValRef := node.SchemaElement.(*substitutions.SubstitutionValueReference)
@@ -671,7 +745,10 @@ func getValRefHoverContent(
 		return &HoverContent{}, nil
 	}
 
-	content := helpinfo.RenderValueInfo(node.Label, value)
+	content := helpinfo.RenderValueInfo(node.Label, value) +
+		helpinfo.RenderInferredValueTypeInfo(
+			s.inferValueType(blueprint, valRef.ValueName, docURI),
+		)
 
 	return &HoverContent{
 		Value: content,
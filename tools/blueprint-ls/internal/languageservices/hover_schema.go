@@ -58,9 +58,10 @@ func getVariableNameHoverContent(
 	}, nil
 }
 
-func getValueNameHoverContent(
+func (s *HoverService) getValueNameHoverContent(
 	node *schema.TreeNode,
 	blueprint *schema.Blueprint,
+	docURI string,
 ) (*HoverContent, error) {
 	name := extractElementName(node.Path)
 	value := getValue(blueprint, name)
@@ -69,7 +70,8 @@ func getValueNameHoverContent(
 	}
 
 	return &HoverContent{
-		Value: helpinfo.RenderValueHoverInfo(name, value),
+		Value: helpinfo.RenderValueHoverInfo(name, value) +
+			helpinfo.RenderInferredValueTypeInfo(s.inferValueType(blueprint, name, docURI)),
 		Range: safeRangeToLSPRange(node.Range),
 	}, nil
 }
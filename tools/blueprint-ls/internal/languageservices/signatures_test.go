@@ -140,8 +140,11 @@ func (s *SignatureServiceSuite) Test_produces_function_signature_definition_with
 			Documentation: lsp.MarkupContent{
 				Kind: lsp.MarkupKindMarkdown,
 				Value: "Maps a list of values to a new list of values using a provided function.\n\n" +
+					"An element expression can be passed in place of a function, " +
+					"where `elem` and `i` refer to the current item and index of the list.\n\n" +
 					"**Examples:**\n\n" +
-					"```\n${map(\n  datasources.network.subnets,\n  compose(to_upper, getattr(\"id\")\n)}\n```",
+					"```\n${map(\n  datasources.network.subnets,\n  compose(to_upper, getattr(\"id\")\n)}\n```\n\n" +
+					"```\n${map(datasources.network.subnets, to_upper(elem.id))}\n```",
 			},
 			Parameters: []*lsp.ParameterInformation{
 				{
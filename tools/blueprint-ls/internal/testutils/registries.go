@@ -122,6 +122,18 @@ func (r *ResourceRegistryMock) GetTypeDescription(
 	return defOutput, nil
 }
 
+func (r *ResourceRegistryMock) GetExamples(
+	ctx context.Context,
+	resourceType string,
+	input *provider.ResourceGetExamplesInput,
+) (*provider.ResourceGetExamplesOutput, error) {
+	res, ok := r.Resources[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("resource %s not found", resourceType)
+	}
+	return res.GetExamples(ctx, input)
+}
+
 func (r *ResourceRegistryMock) ListResourceTypes(
 	ctx context.Context,
 ) ([]string, error) {
@@ -0,0 +1,237 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/newstack-cloud/bluelink/tools/plugin-packager/internal/packager"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// gpgPrivateKeyEnvVar is the environment variable that can hold the armored GPG
+	// private key used to sign checksums when a key file is not provided.
+	gpgPrivateKeyEnvVar = "BLUELINK_PLUGIN_GPG_PRIVATE_KEY"
+	// gpgPassphraseEnvVar is the environment variable that holds the passphrase
+	// for an encrypted GPG private key.
+	gpgPassphraseEnvVar = "BLUELINK_PLUGIN_GPG_PASSPHRASE"
+)
+
+type packageOptions struct {
+	sourceDir          string
+	pkg                string
+	namespace          string
+	name               string
+	version            string
+	pluginType         string
+	supportedProtocols []string
+	dependencies       []string
+	platforms          string
+	outputDir          string
+	ldflags            string
+	downloadBaseURL    string
+	gpgKeyFile         string
+	sigstore           bool
+	sigstoreKey        string
+}
+
+func NewRootCmd() *cobra.Command {
+	opts := &packageOptions{}
+
+	rootCmd := &cobra.Command{
+		Use:   "bluelink-plugin-packager",
+		Short: "Build and package Bluelink plugins for distribution",
+		Long: `Bluelink Plugin Packager cross-compiles a plugin for the standard platform matrix
+and produces everything needed to publish a plugin version to a registry in one step:
+
+  1. An archive for each platform containing the plugin executable
+  2. A SHA256SUMS file with the checksums of all archives
+  3. A GPG signature and/or sigstore bundle for the SHA256SUMS file
+  4. A registry-ready metadata manifest for the plugin version
+
+The GPG private key can be provided with --gpg-key-file or the ` + gpgPrivateKeyEnvVar + `
+environment variable, the passphrase for an encrypted key is read from ` + gpgPassphraseEnvVar + `.`,
+		Example: `  bluelink-plugin-packager --namespace newstack-cloud --name aws --version 1.2.0 \
+    --gpg-key-file signing-key.asc --download-base-url https://example.com/plugins/aws/1.2.0`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPackage(cmd, opts)
+		},
+	}
+
+	flags := rootCmd.Flags()
+	flags.StringVar(&opts.sourceDir, "source-dir", ".", "Directory containing the Go module for the plugin")
+	flags.StringVar(&opts.pkg, "package", ".", "Go package to build relative to the source directory")
+	flags.StringVar(&opts.namespace, "namespace", "", "Registry namespace the plugin is published under")
+	flags.StringVar(&opts.name, "name", "", "Name of the plugin (e.g. aws)")
+	flags.StringVar(&opts.version, "version", "", "Version of the plugin being packaged")
+	flags.StringVar(&opts.pluginType, "type", "provider", "Type of the plugin (provider or transformer)")
+	flags.StringSliceVar(
+		&opts.supportedProtocols,
+		"protocols",
+		[]string{"1.0"},
+		"Plugin protocol versions supported by the plugin",
+	)
+	flags.StringArrayVar(
+		&opts.dependencies,
+		"dependency",
+		[]string{},
+		"Plugin dependency in the format {pluginId}={versionConstraint}, can be repeated",
+	)
+	flags.StringVar(
+		&opts.platforms,
+		"platforms",
+		"",
+		"Comma-separated list of {os}/{arch} platforms to build for (default: standard platform matrix)",
+	)
+	flags.StringVar(&opts.outputDir, "output-dir", "dist", "Directory to write packaged files to")
+	flags.StringVar(&opts.ldflags, "ldflags", "", "Additional linker flags to pass to the Go compiler")
+	flags.StringVar(
+		&opts.downloadBaseURL,
+		"download-base-url",
+		"",
+		"Base URL the packaged files will be served from, used to populate download URLs in the manifest",
+	)
+	flags.StringVar(&opts.gpgKeyFile, "gpg-key-file", "", "Path to an armored GPG private key used to sign checksums")
+	flags.BoolVar(&opts.sigstore, "sigstore", false, "Sign checksums with sigstore using the cosign CLI")
+	flags.StringVar(
+		&opts.sigstoreKey,
+		"sigstore-key",
+		"",
+		"Key reference to pass to cosign, keyless signing is used when not set",
+	)
+
+	_ = rootCmd.MarkFlagRequired("name")
+	_ = rootCmd.MarkFlagRequired("version")
+
+	setupVersionCommand(rootCmd)
+
+	return rootCmd
+}
+
+func runPackage(cmd *cobra.Command, opts *packageOptions) error {
+	config, err := createPackagerConfig(cmd, opts)
+	if err != nil {
+		return err
+	}
+
+	pkgr := packager.NewPackager(
+		packager.NewGoBuilder(cmd.OutOrStdout(), cmd.ErrOrStderr()),
+		cmd.OutOrStdout(),
+	)
+	manifest, err := pkgr.Package(cmd.Context(), config)
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf(
+		"Packaged %s %s for %d platforms in %s\n",
+		manifest.Name,
+		manifest.Version,
+		len(manifest.Packages),
+		config.OutputDir,
+	)
+	return nil
+}
+
+func createPackagerConfig(cmd *cobra.Command, opts *packageOptions) (*packager.Config, error) {
+	platforms := packager.DefaultPlatforms
+	if opts.platforms != "" {
+		parsed, err := packager.ParsePlatforms(opts.platforms)
+		if err != nil {
+			return nil, err
+		}
+		platforms = parsed
+	}
+
+	dependencies, err := parseDependencies(opts.dependencies)
+	if err != nil {
+		return nil, err
+	}
+
+	signers, err := createSigners(cmd, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &packager.Config{
+		SourceDir:          opts.sourceDir,
+		Package:            opts.pkg,
+		Namespace:          opts.namespace,
+		Name:               opts.name,
+		Version:            opts.version,
+		Type:               opts.pluginType,
+		SupportedProtocols: opts.supportedProtocols,
+		Dependencies:       dependencies,
+		Platforms:          platforms,
+		OutputDir:          opts.outputDir,
+		LDFlags:            opts.ldflags,
+		DownloadBaseURL:    opts.downloadBaseURL,
+		Signers:            signers,
+	}, nil
+}
+
+func createSigners(cmd *cobra.Command, opts *packageOptions) ([]packager.Signer, error) {
+	signers := []packager.Signer{}
+
+	privateKey, err := loadGPGPrivateKey(opts.gpgKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(privateKey) > 0 {
+		gpgSigner, err := packager.NewGPGSigner(privateKey, []byte(os.Getenv(gpgPassphraseEnvVar)))
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, gpgSigner)
+	} else {
+		cmd.PrintErrln(
+			"Warning: no GPG private key provided, plugin installers require a GPG " +
+				"signature for SHA256SUMS to verify packages",
+		)
+	}
+
+	if opts.sigstore {
+		signers = append(
+			signers,
+			packager.NewSigstoreSigner(opts.sigstoreKey, cmd.OutOrStdout(), cmd.ErrOrStderr()),
+		)
+	}
+
+	return signers, nil
+}
+
+func loadGPGPrivateKey(keyFile string) ([]byte, error) {
+	if keyFile == "" {
+		return []byte(os.Getenv(gpgPrivateKeyEnvVar)), nil
+	}
+
+	privateKey, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GPG private key file: %w", err)
+	}
+
+	return privateKey, nil
+}
+
+func parseDependencies(rawDependencies []string) (map[string]string, error) {
+	if len(rawDependencies) == 0 {
+		return nil, nil
+	}
+
+	dependencies := make(map[string]string, len(rawDependencies))
+	for _, rawDependency := range rawDependencies {
+		pluginID, constraint, ok := strings.Cut(rawDependency, "=")
+		if !ok || pluginID == "" || constraint == "" {
+			return nil, fmt.Errorf(
+				"invalid dependency %q, expected the format {pluginId}={versionConstraint}",
+				rawDependency,
+			)
+		}
+		dependencies[pluginID] = constraint
+	}
+
+	return dependencies, nil
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"log"
+
+	"github.com/newstack-cloud/bluelink/tools/plugin-packager/cmd/commands"
+)
+
+func main() {
+	rootCmd := commands.NewRootCmd()
+	if err := rootCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,19 @@
+module github.com/newstack-cloud/bluelink/tools/plugin-packager
+
+go 1.25.0
+
+require (
+	github.com/ProtonMail/go-crypto v1.3.0
+	github.com/spf13/cobra v1.10.2
+	github.com/stretchr/testify v1.11.1
+)
+
+require (
+	github.com/cloudflare/circl v1.6.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/inconshreveable/mousetrap v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/spf13/pflag v1.0.10 // indirect
+	golang.org/x/sys v0.42.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,118 @@
+package packager
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// PluginExecutableName is the name of the plugin executable in a package archive,
+	// the plugin host only launches executables with this name regardless of the
+	// target operating system.
+	PluginExecutableName = "plugin"
+	// ShasumsFileName is the name of the file that holds the checksums
+	// for all the package archives of a plugin version.
+	ShasumsFileName = "SHA256SUMS"
+)
+
+// ArchiveFileName produces the file name for the package archive of a plugin
+// for the given platform, this matches the format that plugin registries
+// serve packages with.
+func ArchiveFileName(pluginName string, version string, platform Platform) string {
+	return fmt.Sprintf("%s_%s_%s_%s.tar.gz", pluginName, version, platform.OS, platform.Arch)
+}
+
+// createArchive creates a gzipped tarball containing the plugin binary
+// at the root of the archive.
+// Timestamps and ownership are fixed so that the same binary
+// always produces the same archive.
+func createArchive(binaryPath string, archivePath string) error {
+	binary, err := os.Open(binaryPath)
+	if err != nil {
+		return fmt.Errorf("failed to open plugin binary: %w", err)
+	}
+	defer binary.Close()
+
+	info, err := binary.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat plugin binary: %w", err)
+	}
+
+	archiveFile, err := os.Create(archivePath)
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	defer archiveFile.Close()
+
+	gzw := gzip.NewWriter(archiveFile)
+	tw := tar.NewWriter(gzw)
+
+	err = tw.WriteHeader(&tar.Header{
+		Name:     PluginExecutableName,
+		Mode:     0755,
+		Size:     info.Size(),
+		ModTime:  time.Unix(0, 0),
+		Typeflag: tar.TypeReg,
+		Format:   tar.FormatPAX,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write archive header: %w", err)
+	}
+
+	if _, err := io.Copy(tw, binary); err != nil {
+		return fmt.Errorf("failed to write plugin binary to archive: %w", err)
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finalise archive: %w", err)
+	}
+
+	if err := gzw.Close(); err != nil {
+		return fmt.Errorf("failed to finalise archive compression: %w", err)
+	}
+
+	return nil
+}
+
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// shasumsContent produces the contents of a SHA256SUMS file
+// in the format produced by sha256sum, sorted by file name.
+func shasumsContent(checksums map[string]string) []byte {
+	fileNames := make([]string, 0, len(checksums))
+	for fileName := range checksums {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	var sb strings.Builder
+	for _, fileName := range fileNames {
+		sb.WriteString(checksums[fileName])
+		sb.WriteString("  ")
+		sb.WriteString(fileName)
+		sb.WriteString("\n")
+	}
+
+	return []byte(sb.String())
+}
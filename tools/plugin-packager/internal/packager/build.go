@@ -0,0 +1,73 @@
+package packager
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+)
+
+// BuildInput holds the information needed to build
+// a plugin binary for a single platform.
+type BuildInput struct {
+	// SourceDir is the directory containing the Go module for the plugin.
+	SourceDir string
+	// Package is the Go package to build relative to SourceDir
+	// (e.g. "." or "./cmd/plugin").
+	Package string
+	// Platform is the target platform to build the binary for.
+	Platform Platform
+	// OutputPath is the path to write the built binary to.
+	OutputPath string
+	// LDFlags holds additional linker flags to pass to the Go compiler.
+	LDFlags string
+}
+
+// Builder builds a plugin binary for a target platform.
+type Builder interface {
+	Build(ctx context.Context, input *BuildInput) error
+}
+
+type goBuilder struct {
+	goBinary string
+	stdout   io.Writer
+	stderr   io.Writer
+}
+
+// NewGoBuilder creates a builder that cross-compiles plugins
+// using the Go toolchain available on the PATH.
+// Plugins are built with CGO disabled so that binaries can be
+// cross-compiled for every platform from a single host.
+func NewGoBuilder(stdout io.Writer, stderr io.Writer) Builder {
+	return &goBuilder{
+		goBinary: "go",
+		stdout:   stdout,
+		stderr:   stderr,
+	}
+}
+
+func (b *goBuilder) Build(ctx context.Context, input *BuildInput) error {
+	args := []string{"build", "-trimpath"}
+	if input.LDFlags != "" {
+		args = append(args, "-ldflags", input.LDFlags)
+	}
+	args = append(args, "-o", input.OutputPath, input.Package)
+
+	cmd := exec.CommandContext(ctx, b.goBinary, args...)
+	cmd.Dir = input.SourceDir
+	cmd.Stdout = b.stdout
+	cmd.Stderr = b.stderr
+	cmd.Env = append(
+		os.Environ(),
+		"GOOS="+input.Platform.OS,
+		"GOARCH="+input.Platform.Arch,
+		"CGO_ENABLED=0",
+	)
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to build plugin for %s: %w", input.Platform, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package packager
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Manifest is the registry-ready metadata for a packaged plugin version.
+// Registries can serve the package metadata for each platform directly
+// from the packages in the manifest.
+type Manifest struct {
+	Namespace          string             `json:"namespace"`
+	Name               string             `json:"name"`
+	Version            string             `json:"version"`
+	Type               string             `json:"type,omitempty"`
+	SupportedProtocols []string           `json:"supportedProtocols,omitempty"`
+	Dependencies       map[string]string  `json:"dependencies,omitempty"`
+	ShasumsFile        string             `json:"shasumsFile"`
+	Signatures         []*Signature       `json:"signatures,omitempty"`
+	Packages           []*PackageMetadata `json:"packages"`
+}
+
+// PackageMetadata holds the metadata for the package of a plugin for a single platform,
+// this has the same shape as the package metadata that plugin installers
+// retrieve from a registry.
+type PackageMetadata struct {
+	Filename            string            `json:"filename"`
+	DownloadURL         string            `json:"downloadUrl,omitempty"`
+	OS                  string            `json:"os"`
+	Arch                string            `json:"arch"`
+	Shasum              string            `json:"shasum"`
+	ShasumsURL          string            `json:"shasumsUrl,omitempty"`
+	ShasumsSignatureURL string            `json:"shasumsSignatureUrl,omitempty"`
+	SigningKeys         map[string]string `json:"signingKeys,omitempty"`
+	Dependencies        map[string]string `json:"dependencies,omitempty"`
+}
+
+// ManifestFileName produces the file name for the metadata manifest
+// of a plugin version.
+func ManifestFileName(pluginName string, version string) string {
+	return fmt.Sprintf("%s_%s_manifest.json", pluginName, version)
+}
+
+// LoadManifest loads a metadata manifest produced by the packager.
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+func writeManifest(path string, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	return nil
+}
+
+func downloadURL(baseURL string, fileName string) string {
+	if baseURL == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(baseURL, "/") + "/" + fileName
+}
+
+func findSignature(signatures []*Signature, kind SignatureKind) *Signature {
+	for _, signature := range signatures {
+		if signature.Kind == kind {
+			return signature
+		}
+	}
+	return nil
+}
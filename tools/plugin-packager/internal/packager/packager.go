@@ -0,0 +1,225 @@
+package packager
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+)
+
+// Config holds the configuration for packaging a plugin version.
+type Config struct {
+	// SourceDir is the directory containing the Go module for the plugin.
+	SourceDir string
+	// Package is the Go package to build relative to SourceDir,
+	// defaults to ".".
+	Package string
+	// Namespace is the registry namespace the plugin is published under
+	// (e.g. "newstack-cloud").
+	Namespace string
+	// Name is the name of the plugin (e.g. "aws").
+	Name string
+	// Version is the version of the plugin being packaged.
+	Version string
+	// Type is the type of the plugin ("provider" or "transformer").
+	Type string
+	// SupportedProtocols holds the plugin protocol versions
+	// that the plugin supports (e.g. "1.0").
+	SupportedProtocols []string
+	// Dependencies holds the plugins that the plugin depends on
+	// mapped to version constraints.
+	Dependencies map[string]string
+	// Platforms holds the platforms to build the plugin for,
+	// defaults to DefaultPlatforms.
+	Platforms []Platform
+	// OutputDir is the directory to write archives, checksums,
+	// signatures and the metadata manifest to.
+	OutputDir string
+	// LDFlags holds additional linker flags to pass to the Go compiler.
+	LDFlags string
+	// DownloadBaseURL is the base URL that the packaged files will be served from.
+	// When set, download URLs are included in the package metadata in the manifest.
+	DownloadBaseURL string
+	// Signers are used to sign the SHA256SUMS file.
+	Signers []Signer
+}
+
+// Packager builds, archives, checksums and signs plugins for distribution
+// through a plugin registry.
+type Packager struct {
+	builder Builder
+	out     io.Writer
+}
+
+// NewPackager creates a new packager that builds plugin binaries
+// with the given builder and writes progress messages to out.
+func NewPackager(builder Builder, out io.Writer) *Packager {
+	return &Packager{
+		builder: builder,
+		out:     out,
+	}
+}
+
+// Package builds the plugin for every configured platform, producing an archive for each
+// platform, a SHA256SUMS file for the archives, signatures for the SHA256SUMS file
+// and a registry-ready metadata manifest in the output directory.
+func (p *Packager) Package(ctx context.Context, config *Config) (*Manifest, error) {
+	if err := validateConfig(config); err != nil {
+		return nil, err
+	}
+
+	platforms := config.Platforms
+	if len(platforms) == 0 {
+		platforms = DefaultPlatforms
+	}
+
+	if err := os.MkdirAll(config.OutputDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	buildDir, err := os.MkdirTemp("", "bluelink-plugin-packager-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create build directory: %w", err)
+	}
+	defer os.RemoveAll(buildDir)
+
+	checksums := map[string]string{}
+	packages := make([]*PackageMetadata, 0, len(platforms))
+	for _, platform := range platforms {
+		pkg, err := p.packagePlatform(ctx, config, platform, buildDir)
+		if err != nil {
+			return nil, err
+		}
+		checksums[pkg.Filename] = pkg.Shasum
+		packages = append(packages, pkg)
+	}
+
+	shasumsPath := filepath.Join(config.OutputDir, ShasumsFileName)
+	if err := os.WriteFile(shasumsPath, shasumsContent(checksums), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", ShasumsFileName, err)
+	}
+
+	var signatures []*Signature
+	signingKeys := map[string]string{}
+	for _, signer := range config.Signers {
+		signature, err := signer.Sign(ctx, shasumsPath)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(p.out, "Signed %s (%s)\n", ShasumsFileName, signature.Kind)
+		signatures = append(signatures, signature)
+		maps.Copy(signingKeys, signer.SigningKeys())
+	}
+
+	manifest := &Manifest{
+		Namespace:          config.Namespace,
+		Name:               config.Name,
+		Version:            config.Version,
+		Type:               config.Type,
+		SupportedProtocols: config.SupportedProtocols,
+		Dependencies:       config.Dependencies,
+		ShasumsFile:        ShasumsFileName,
+		Signatures:         signatures,
+		Packages:           packages,
+	}
+	populatePackageMetadata(manifest, config, signingKeys)
+
+	manifestPath := filepath.Join(config.OutputDir, ManifestFileName(config.Name, config.Version))
+	if err := writeManifest(manifestPath, manifest); err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(p.out, "Wrote manifest %s\n", manifestPath)
+
+	return manifest, nil
+}
+
+func (p *Packager) packagePlatform(
+	ctx context.Context,
+	config *Config,
+	platform Platform,
+	buildDir string,
+) (*PackageMetadata, error) {
+	fmt.Fprintf(p.out, "Building %s %s for %s\n", config.Name, config.Version, platform)
+
+	binaryPath := filepath.Join(buildDir, platform.OS+"_"+platform.Arch, PluginExecutableName)
+	if err := os.MkdirAll(filepath.Dir(binaryPath), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create build directory for %s: %w", platform, err)
+	}
+
+	err := p.builder.Build(ctx, &BuildInput{
+		SourceDir:  config.SourceDir,
+		Package:    buildPackage(config),
+		Platform:   platform,
+		OutputPath: binaryPath,
+		LDFlags:    config.LDFlags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	archiveName := ArchiveFileName(config.Name, config.Version, platform)
+	archivePath := filepath.Join(config.OutputDir, archiveName)
+	if err := createArchive(binaryPath, archivePath); err != nil {
+		return nil, fmt.Errorf("failed to package plugin for %s: %w", platform, err)
+	}
+
+	checksum, err := fileSHA256(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate checksum for %s: %w", archiveName, err)
+	}
+
+	return &PackageMetadata{
+		Filename: archiveName,
+		OS:       platform.OS,
+		Arch:     platform.Arch,
+		Shasum:   checksum,
+	}, nil
+}
+
+func populatePackageMetadata(manifest *Manifest, config *Config, signingKeys map[string]string) {
+	shasumsURL := downloadURL(config.DownloadBaseURL, manifest.ShasumsFile)
+	shasumsSignatureURL := ""
+	gpgSignature := findSignature(manifest.Signatures, SignatureKindGPG)
+	if gpgSignature != nil {
+		shasumsSignatureURL = downloadURL(config.DownloadBaseURL, gpgSignature.FileName)
+	}
+
+	for _, pkg := range manifest.Packages {
+		pkg.DownloadURL = downloadURL(config.DownloadBaseURL, pkg.Filename)
+		pkg.ShasumsURL = shasumsURL
+		pkg.ShasumsSignatureURL = shasumsSignatureURL
+		if len(signingKeys) > 0 {
+			pkg.SigningKeys = signingKeys
+		}
+		pkg.Dependencies = config.Dependencies
+	}
+}
+
+func buildPackage(config *Config) string {
+	if config.Package == "" {
+		return "."
+	}
+	return config.Package
+}
+
+func validateConfig(config *Config) error {
+	if config == nil {
+		return fmt.Errorf("packager config is required")
+	}
+
+	if config.Name == "" {
+		return fmt.Errorf("plugin name is required")
+	}
+
+	if config.Version == "" {
+		return fmt.Errorf("plugin version is required")
+	}
+
+	if config.OutputDir == "" {
+		return fmt.Errorf("output directory is required")
+	}
+
+	return nil
+}
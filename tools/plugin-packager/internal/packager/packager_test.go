@@ -0,0 +1,232 @@
+package packager
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/suite"
+)
+
+type PackagerTestSuite struct {
+	suite.Suite
+	outputDir string
+	builder   *stubBuilder
+	packager  *Packager
+}
+
+func (s *PackagerTestSuite) SetupTest() {
+	s.outputDir = s.T().TempDir()
+	s.builder = &stubBuilder{}
+	s.packager = NewPackager(s.builder, io.Discard)
+}
+
+func (s *PackagerTestSuite) Test_packages_plugin_for_each_platform() {
+	manifest, err := s.packager.Package(context.Background(), &Config{
+		Namespace:          "newstack-cloud",
+		Name:               "aws",
+		Version:            "1.2.0",
+		Type:               "provider",
+		SupportedProtocols: []string{"1.0"},
+		Platforms: []Platform{
+			{OS: "linux", Arch: "amd64"},
+			{OS: "darwin", Arch: "arm64"},
+		},
+		OutputDir: s.outputDir,
+	})
+	s.Require().NoError(err)
+
+	s.Assert().Equal(
+		[]string{"linux/amd64", "darwin/arm64"},
+		s.builder.builtPlatforms,
+	)
+	s.Require().Len(manifest.Packages, 2)
+	s.Assert().Equal("aws_1.2.0_linux_amd64.tar.gz", manifest.Packages[0].Filename)
+	s.Assert().Equal("aws_1.2.0_darwin_arm64.tar.gz", manifest.Packages[1].Filename)
+
+	for _, pkg := range manifest.Packages {
+		archivePath := filepath.Join(s.outputDir, pkg.Filename)
+		checksum, err := fileSHA256(archivePath)
+		s.Require().NoError(err)
+		s.Assert().Equal(checksum, pkg.Shasum)
+
+		name, contents := s.readArchive(archivePath)
+		s.Assert().Equal(PluginExecutableName, name)
+		s.Assert().Equal(fmt.Sprintf("binary for %s/%s", pkg.OS, pkg.Arch), contents)
+	}
+
+	shasums, err := os.ReadFile(filepath.Join(s.outputDir, ShasumsFileName))
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		fmt.Sprintf(
+			"%s  aws_1.2.0_darwin_arm64.tar.gz\n%s  aws_1.2.0_linux_amd64.tar.gz\n",
+			manifest.Packages[1].Shasum,
+			manifest.Packages[0].Shasum,
+		),
+		string(shasums),
+	)
+
+	savedManifest, err := LoadManifest(filepath.Join(s.outputDir, "aws_1.2.0_manifest.json"))
+	s.Require().NoError(err)
+	s.Assert().Equal(manifest, savedManifest)
+}
+
+func (s *PackagerTestSuite) Test_signs_checksums_and_populates_registry_metadata() {
+	privateKey, entity := createTestGPGKey(s)
+	signer, err := NewGPGSigner(privateKey, nil)
+	s.Require().NoError(err)
+
+	manifest, err := s.packager.Package(context.Background(), &Config{
+		Namespace: "newstack-cloud",
+		Name:      "aws",
+		Version:   "1.2.0",
+		Dependencies: map[string]string{
+			"newstack-cloud/core": "^1.0.0",
+		},
+		Platforms:       []Platform{{OS: "linux", Arch: "arm64"}},
+		OutputDir:       s.outputDir,
+		DownloadBaseURL: "https://example.com/plugins/aws/1.2.0/",
+		Signers:         []Signer{signer},
+	})
+	s.Require().NoError(err)
+
+	s.Assert().Equal(
+		[]*Signature{{Kind: SignatureKindGPG, FileName: "SHA256SUMS.sig"}},
+		manifest.Signatures,
+	)
+
+	shasums, err := os.ReadFile(filepath.Join(s.outputDir, ShasumsFileName))
+	s.Require().NoError(err)
+	signature, err := os.ReadFile(filepath.Join(s.outputDir, "SHA256SUMS.sig"))
+	s.Require().NoError(err)
+	_, err = openpgp.CheckArmoredDetachedSignature(
+		openpgp.EntityList{entity},
+		bytes.NewReader(shasums),
+		bytes.NewReader(signature),
+		nil,
+	)
+	s.Require().NoError(err)
+
+	s.Require().Len(manifest.Packages, 1)
+	pkg := manifest.Packages[0]
+	s.Assert().Equal(
+		"https://example.com/plugins/aws/1.2.0/aws_1.2.0_linux_arm64.tar.gz",
+		pkg.DownloadURL,
+	)
+	s.Assert().Equal("https://example.com/plugins/aws/1.2.0/SHA256SUMS", pkg.ShasumsURL)
+	s.Assert().Equal("https://example.com/plugins/aws/1.2.0/SHA256SUMS.sig", pkg.ShasumsSignatureURL)
+	s.Assert().Equal(map[string]string{"newstack-cloud/core": "^1.0.0"}, pkg.Dependencies)
+
+	// The public key in the metadata must be usable to verify the signature
+	// in the same way as plugin installers.
+	keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(pkg.SigningKeys["gpg_public_key"]))
+	s.Require().NoError(err)
+	_, err = openpgp.CheckArmoredDetachedSignature(
+		keyring,
+		bytes.NewReader(shasums),
+		bytes.NewReader(signature),
+		nil,
+	)
+	s.Require().NoError(err)
+}
+
+func (s *PackagerTestSuite) Test_fails_when_build_fails() {
+	s.builder.err = fmt.Errorf("compilation failed")
+
+	_, err := s.packager.Package(context.Background(), &Config{
+		Name:      "aws",
+		Version:   "1.2.0",
+		OutputDir: s.outputDir,
+	})
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "compilation failed")
+}
+
+func (s *PackagerTestSuite) Test_fails_for_missing_version() {
+	_, err := s.packager.Package(context.Background(), &Config{
+		Name:      "aws",
+		OutputDir: s.outputDir,
+	})
+	s.Require().Error(err)
+	s.Assert().Equal("plugin version is required", err.Error())
+}
+
+func (s *PackagerTestSuite) Test_parses_platforms() {
+	platforms, err := ParsePlatforms("linux/amd64, darwin/arm64,linux/amd64")
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		[]Platform{
+			{OS: "linux", Arch: "amd64"},
+			{OS: "darwin", Arch: "arm64"},
+		},
+		platforms,
+	)
+}
+
+func (s *PackagerTestSuite) Test_fails_to_parse_invalid_platform() {
+	_, err := ParsePlatforms("linux-amd64")
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "invalid platform \"linux-amd64\"")
+}
+
+func (s *PackagerTestSuite) readArchive(archivePath string) (string, string) {
+	file, err := os.Open(archivePath)
+	s.Require().NoError(err)
+	defer file.Close()
+
+	gzr, err := gzip.NewReader(file)
+	s.Require().NoError(err)
+	tr := tar.NewReader(gzr)
+
+	header, err := tr.Next()
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(0755), header.Mode)
+	contents, err := io.ReadAll(tr)
+	s.Require().NoError(err)
+
+	_, err = tr.Next()
+	s.Assert().Equal(io.EOF, err)
+
+	return header.Name, string(contents)
+}
+
+func createTestGPGKey(s *PackagerTestSuite) ([]byte, *openpgp.Entity) {
+	entity, err := openpgp.NewEntity("Plugin Packager Test", "", "test@example.com", nil)
+	s.Require().NoError(err)
+
+	buf := &bytes.Buffer{}
+	w, err := armor.Encode(buf, openpgp.PrivateKeyType, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(entity.SerializePrivate(w, nil))
+	s.Require().NoError(w.Close())
+
+	return buf.Bytes(), entity
+}
+
+type stubBuilder struct {
+	builtPlatforms []string
+	err            error
+}
+
+func (b *stubBuilder) Build(ctx context.Context, input *BuildInput) error {
+	if b.err != nil {
+		return b.err
+	}
+
+	b.builtPlatforms = append(b.builtPlatforms, input.Platform.String())
+	contents := fmt.Sprintf("binary for %s", input.Platform)
+	return os.WriteFile(input.OutputPath, []byte(contents), 0755)
+}
+
+func TestPackagerTestSuite(t *testing.T) {
+	suite.Run(t, new(PackagerTestSuite))
+}
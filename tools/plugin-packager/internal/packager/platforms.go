@@ -0,0 +1,63 @@
+package packager
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Platform is a target operating system and architecture
+// that a plugin is built for.
+type Platform struct {
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+}
+
+func (p Platform) String() string {
+	return fmt.Sprintf("%s/%s", p.OS, p.Arch)
+}
+
+// DefaultPlatforms is the standard platform matrix that plugins are built for,
+// this matches the platforms that the Bluelink CLI and Deploy Engine are released for
+// and the platforms that plugin installers will request packages for.
+var DefaultPlatforms = []Platform{
+	{OS: "darwin", Arch: "amd64"},
+	{OS: "darwin", Arch: "arm64"},
+	{OS: "linux", Arch: "amd64"},
+	{OS: "linux", Arch: "arm64"},
+	{OS: "windows", Arch: "amd64"},
+	{OS: "windows", Arch: "arm64"},
+}
+
+// ParsePlatforms parses a comma-separated list of platforms
+// in the "{os}/{arch}" format (e.g. "linux/amd64,darwin/arm64").
+func ParsePlatforms(value string) ([]Platform, error) {
+	platforms := []Platform{}
+	seen := map[string]bool{}
+	for rawPlatform := range strings.SplitSeq(value, ",") {
+		rawPlatform = strings.TrimSpace(rawPlatform)
+		if rawPlatform == "" {
+			continue
+		}
+
+		os, arch, ok := strings.Cut(rawPlatform, "/")
+		if !ok || os == "" || arch == "" || strings.Contains(arch, "/") {
+			return nil, fmt.Errorf(
+				"invalid platform %q, expected the format {os}/{arch} (e.g. linux/amd64)",
+				rawPlatform,
+			)
+		}
+
+		platform := Platform{OS: os, Arch: arch}
+		if seen[platform.String()] {
+			continue
+		}
+		seen[platform.String()] = true
+		platforms = append(platforms, platform)
+	}
+
+	if len(platforms) == 0 {
+		return nil, fmt.Errorf("at least one platform must be provided")
+	}
+
+	return platforms, nil
+}
@@ -0,0 +1,176 @@
+package packager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+)
+
+// SignatureKind is the type of signature produced for the checksums of a plugin version.
+type SignatureKind string
+
+const (
+	// SignatureKindGPG is an armored detached GPG signature,
+	// this is the signature that plugin installers verify against the signing keys
+	// provided by a registry.
+	SignatureKindGPG SignatureKind = "gpg"
+	// SignatureKindSigstore is a sigstore bundle produced by cosign.
+	SignatureKindSigstore SignatureKind = "sigstore"
+)
+
+// gpgSigningKeyName is the key used for the GPG public key in the signing keys
+// of the registry package metadata.
+const gpgSigningKeyName = "gpg_public_key"
+
+// Signature holds information about a signature file produced
+// for the checksums of a plugin version.
+type Signature struct {
+	Kind     SignatureKind `json:"kind"`
+	FileName string        `json:"fileName"`
+}
+
+// Signer produces a detached signature for a file.
+type Signer interface {
+	// Sign produces a detached signature for the file at the given path,
+	// writing the signature to a file alongside the signed file.
+	Sign(ctx context.Context, filePath string) (*Signature, error)
+	// SigningKeys returns the public keys that can be used to verify signatures
+	// produced by the signer, keyed by the name expected by plugin registries.
+	SigningKeys() map[string]string
+}
+
+type gpgSigner struct {
+	entity    *openpgp.Entity
+	publicKey string
+}
+
+// NewGPGSigner creates a signer that produces armored detached GPG signatures
+// using the first private key in the provided armored key ring.
+// The passphrase is only used when the private key is encrypted.
+func NewGPGSigner(armoredPrivateKey []byte, passphrase []byte) (Signer, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(armoredPrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GPG private key: %w", err)
+	}
+
+	if len(entities) == 0 || entities[0].PrivateKey == nil {
+		return nil, fmt.Errorf("no GPG private key found in the provided key ring")
+	}
+
+	entity := entities[0]
+	if entity.PrivateKey.Encrypted {
+		if err := entity.DecryptPrivateKeys(passphrase); err != nil {
+			return nil, fmt.Errorf("failed to decrypt GPG private key: %w", err)
+		}
+	}
+
+	publicKey, err := armoredPublicKey(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gpgSigner{
+		entity:    entity,
+		publicKey: publicKey,
+	}, nil
+}
+
+func (s *gpgSigner) Sign(ctx context.Context, filePath string) (*Signature, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file to sign: %w", err)
+	}
+	defer file.Close()
+
+	signaturePath := filePath + ".sig"
+	signatureFile, err := os.Create(signaturePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature file: %w", err)
+	}
+	defer signatureFile.Close()
+
+	if err := openpgp.ArmoredDetachSign(signatureFile, s.entity, file, nil); err != nil {
+		return nil, fmt.Errorf("failed to sign %s: %w", filepath.Base(filePath), err)
+	}
+
+	return &Signature{
+		Kind:     SignatureKindGPG,
+		FileName: filepath.Base(signaturePath),
+	}, nil
+}
+
+func (s *gpgSigner) SigningKeys() map[string]string {
+	return map[string]string{
+		gpgSigningKeyName: s.publicKey,
+	}
+}
+
+func armoredPublicKey(entity *openpgp.Entity) (string, error) {
+	buf := &bytes.Buffer{}
+	w, err := armor.Encode(buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode GPG public key: %w", err)
+	}
+
+	if err := entity.Serialize(w); err != nil {
+		return "", fmt.Errorf("failed to serialise GPG public key: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode GPG public key: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+type sigstoreSigner struct {
+	cosignBinary string
+	keyRef       string
+	stdout       io.Writer
+	stderr       io.Writer
+}
+
+// NewSigstoreSigner creates a signer that produces sigstore bundles
+// using the cosign CLI available on the PATH.
+// When keyRef is empty, keyless signing is used which requires an OIDC identity,
+// this is usually provided by the CI environment.
+func NewSigstoreSigner(keyRef string, stdout io.Writer, stderr io.Writer) Signer {
+	return &sigstoreSigner{
+		cosignBinary: "cosign",
+		keyRef:       keyRef,
+		stdout:       stdout,
+		stderr:       stderr,
+	}
+}
+
+func (s *sigstoreSigner) Sign(ctx context.Context, filePath string) (*Signature, error) {
+	bundlePath := filePath + ".sigstore.json"
+	args := []string{"sign-blob", "--yes", "--bundle", bundlePath}
+	if s.keyRef != "" {
+		args = append(args, "--key", s.keyRef)
+	}
+	args = append(args, filePath)
+
+	cmd := exec.CommandContext(ctx, s.cosignBinary, args...)
+	cmd.Stdout = s.stdout
+	cmd.Stderr = s.stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to sign %s with cosign: %w", filepath.Base(filePath), err)
+	}
+
+	return &Signature{
+		Kind:     SignatureKindSigstore,
+		FileName: filepath.Base(bundlePath),
+	}, nil
+}
+
+func (s *sigstoreSigner) SigningKeys() map[string]string {
+	return nil
+}
@@ -0,0 +1,36 @@
+#!/usr/bin/env bash
+
+POSITIONAL=()
+while [[ $# -gt 0 ]]
+do
+key="$1"
+
+case $key in
+    -h|--help)
+    HELP=yes
+    shift # past argument
+    ;;
+    *)    # unknown option
+    POSITIONAL+=("$1") # save it in an array for later
+    shift # past argument
+    ;;
+esac
+done
+set -- "${POSITIONAL[@]}" # restore positional parameters
+
+function help {
+  cat << EOF
+Test runner
+Runs tests for the application:
+bash scripts/run-tests.sh
+EOF
+}
+
+if [ -n "$HELP" ]; then
+  help
+  exit 0
+fi
+
+set -e
+
+go test -timeout 30000ms -v ./...